@@ -182,7 +182,13 @@ class CustomResourceMemberlistProvider(MemberlistProvider, EnforceOverrides):
     ) -> Memberlist:
         if "members" not in api_response_spec:
             return []
-        return [m["member_id"] for m in api_response_spec["members"]]
+        # draining members are being decommissioned, requests are routed to the
+        # other members
+        return [
+            m["member_id"]
+            for m in api_response_spec["members"]
+            if not m.get("draining", False)
+        ]
 
     def _notify(self, memberlist: Memberlist) -> None:
         for callback in self.callbacks:
@@ -1,7 +1,10 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"io"
+	"os"
 	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/coordinator/grpc"
@@ -9,7 +12,9 @@ import (
 
 	"github.com/chroma-core/chroma/go/cmd/flag"
 	"github.com/chroma-core/chroma/go/pkg/utils"
+	"github.com/chroma-core/chroma/go/shared/otel"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 var (
@@ -17,6 +22,13 @@ var (
 		GrpcConfig: &grpcutils.GrpcConfig{},
 	}
 
+	selfTest       bool
+	printConfig    bool
+	metricsAddress string
+
+	// secretFlags are redacted by --print-config.
+	secretFlags = map[string]bool{"password": true, "page-token-keys": true, "confirmation-token-keys": true}
+
 	Cmd = &cobra.Command{
 		Use:   "coordinator",
 		Short: "Start a coordinator",
@@ -39,7 +51,12 @@ func init() {
 	Cmd.Flags().StringVar(&conf.DBConfig.DBName, "db-name", "sysdb", "MetaTable db name")
 	Cmd.Flags().IntVar(&conf.DBConfig.MaxIdleConns, "max-idle-conns", 10, "MetaTable max idle connections")
 	Cmd.Flags().IntVar(&conf.DBConfig.MaxOpenConns, "max-open-conns", 10, "MetaTable max open connections")
+	Cmd.Flags().IntVar(&conf.DBConfig.BackgroundMaxOpenConns, "background-max-open-conns", 2, "MetaTable max open connections of background jobs, 0 shares the request pool")
 	Cmd.Flags().StringVar(&conf.DBConfig.SslMode, "ssl-mode", "disable", "SSL mode for database connection")
+	Cmd.Flags().StringVar(&conf.DBConfig.ReadAddress, "db-read-address", "", "MetaTable read replica address, empty reads from the primary. Reads may lag behind writes")
+	Cmd.Flags().DurationVar(&conf.DBConfig.ReadTimeout, "db-read-timeout", 0, "Statement timeout of MetaTable read transactions, 0 uses the server default")
+	Cmd.Flags().DurationVar(&conf.DBConfig.WriteTimeout, "db-write-timeout", 0, "Statement timeout of MetaTable write transactions, 0 uses the server default")
+	Cmd.Flags().StringVar(&conf.DBConfig.ClusterID, "cluster-id", "", "Logical cluster whose MetaTable rows this sysdb serves, clusters sharing a database must use different ids")
 
 	// Notification
 	Cmd.Flags().StringVar(&conf.NotificationStoreProvider, "notification-store-provider", "memory", "Notification store provider")
@@ -54,14 +71,133 @@ func init() {
 	// Query service memberlist
 	Cmd.Flags().StringVar(&conf.QueryServiceMemberlistName, "query-memberlist-name", "query-service-memberlist", "Query service memberlist name")
 	Cmd.Flags().StringVar(&conf.QueryServicePodLabel, "query-pod-label", "query-service", "Query pod label")
+	Cmd.Flags().DurationVar(&conf.DecommissionDrainPeriod, "decommission-drain-period", 2*time.Minute, "How long a decommissioned query node stays in the memberlist after collections stopped being placed on it")
+	Cmd.Flags().DurationVar(&conf.HeartbeatTimeout, "heartbeat-timeout", 30*time.Second, "How long after its last heartbeat a node is reported as not alive")
 	Cmd.Flags().DurationVar(&conf.WatchInterval, "watch-interval", 10*time.Second, "Watch interval")
 
+	// Limits
+	Cmd.Flags().Int64Var(&conf.MaxCollectionsPerDatabase, "max-collections-per-database", 0, "Maximum number of collections per database, 0 means unlimited")
+	Cmd.Flags().IntVar(&conf.MaxMetadataKeys, "max-metadata-keys", 0, "Maximum number of keys in collection metadata, 0 means unlimited")
+	Cmd.Flags().IntVar(&conf.MaxMetadataKeyLength, "max-metadata-key-length", 0, "Maximum length of a collection metadata key, 0 means unlimited")
+	Cmd.Flags().IntVar(&conf.MaxMetadataSizeBytes, "max-metadata-size-bytes", 0, "Maximum serialized size of collection metadata, 0 means unlimited")
+	Cmd.Flags().Int32Var(&conf.MaxReplicationFactor, "max-replication-factor", 3, "Maximum number of query nodes a collection can be assigned to, 0 means unlimited")
+
+	// Compaction
+	Cmd.Flags().IntVar(&conf.FlushCompactionMaxRetries, "flush-compaction-max-retries", 3, "Number of retries of a flush compaction that lost a serialization conflict")
+
+	// Confirmation
+	Cmd.Flags().DurationVar(&conf.ConfirmationTokenTTL, "confirmation-token-ttl", 5*time.Minute, "How long a confirmation token for a destructive operation stays valid")
+	Cmd.Flags().StringSliceVar(&conf.ConfirmationTokenKeys, "confirmation-token-keys", nil, "Keys of at least 16 bytes that sign confirmation tokens, the first signs and all verify, shared by the replicas; empty uses a random key per process")
+	Cmd.Flags().StringSliceVar(&conf.PageTokenKeys, "page-token-keys", nil, "Keys of at least 16 bytes that sign page tokens, the first signs and all verify, shared by the replicas; empty uses a random key per process")
+
+	// Maintenance
+	Cmd.Flags().IntVar(&conf.MaintenanceWindowStartHour, "maintenance-window-start-hour", 3, "UTC hour at which the daily table maintenance window opens")
+	Cmd.Flags().DurationVar(&conf.MaintenanceWindowDuration, "maintenance-window-duration", 0, "Length of the daily table maintenance window, 0 disables table maintenance")
+	Cmd.Flags().BoolVar(&conf.MaintenanceVacuum, "maintenance-vacuum", false, "Run VACUUM (ANALYZE) instead of ANALYZE during table maintenance")
+	Cmd.Flags().StringSliceVar(&conf.MaintenanceTables, "maintenance-tables", []string{"record_logs", "collection_metadata"}, "Tables analyzed or vacuumed during table maintenance")
+
+	// Leader election
+	Cmd.Flags().DurationVar(&conf.LeaderLeaseTTL, "leader-lease-ttl", 0, "TTL of the sysdb leader lease, 0 disables leader election and every replica serves writes")
+	Cmd.Flags().StringVar(&conf.LeaderID, "leader-id", "", "Unique id of this replica as a leader lease holder, defaults to the hostname")
+	Cmd.Flags().StringVar(&conf.LeaderAddress, "leader-address", "", "Address clients are redirected to while this replica is the leader, or the address of a writable sysdb with --read-only")
+
+	// Read-only replica
+	Cmd.Flags().BoolVar(&conf.ReadOnly, "read-only", false, "Serve only reads from a read-only replicated database, such as a replica in another region, and reject writes with READ_ONLY_REPLICA")
+
+	// Load shedding
+	Cmd.Flags().Int64Var(&conf.MaxInFlightWriteTx, "max-in-flight-write-tx", 0, "Open write transactions above which metadata edits and stats updates are rejected, 0 disables the check")
+	Cmd.Flags().DurationVar(&conf.MaxPoolWait, "max-pool-wait", 0, "Average write pool connection wait above which metadata edits and stats updates are rejected, 0 disables the check")
+
+	// Priority classes
+	Cmd.Flags().IntVar(&conf.MaxConcurrentCompactionRequests, "max-concurrent-compaction-requests", 0, "Maximum concurrent compaction requests, 0 is unlimited")
+	Cmd.Flags().IntVar(&conf.MaxConcurrentAdminRequests, "max-concurrent-admin-requests", 4, "Maximum concurrent admin and listing requests, 0 is unlimited")
+	Cmd.Flags().IntVar(&conf.MaxConcurrentRequestsPerTenant, "max-concurrent-requests-per-tenant", 2, "Maximum concurrent batch deletes, full listings and scans of a single tenant, 0 is unlimited")
+
+	// Query sampling
+	Cmd.Flags().Float64Var(&conf.QuerySampleRate, "query-sample-rate", 0, "Fraction of GetCollections and GetSegments calls whose SQL is recorded for GetQuerySamples, 0 disables sampling")
+	Cmd.Flags().IntVar(&conf.QuerySampleBufferSize, "query-sample-buffer-size", 256, "Number of sampled statements kept")
+
+	// Log lag
+	Cmd.Flags().StringVar(&conf.LogServiceAddress, "log-service-address", "", "Address of the log service read for the log lag of collections, empty disables log lag")
+	Cmd.Flags().DurationVar(&conf.LogLagCacheTTL, "log-lag-cache-ttl", 5*time.Second, "How long the log lag of a collection is cached")
+	Cmd.Flags().DurationVar(&conf.LogOffsetReconcileInterval, "log-offset-reconcile-interval", 0, "How often the log positions of collections are compared with the offsets of their logs, 0 disables the comparison")
+
+	// Collection stats
+	Cmd.Flags().DurationVar(&conf.CollectionStatsRefreshInterval, "collection-stats-refresh-interval", 5*time.Minute, "How often the collection_stats rollup read by dashboards is refreshed, 0 disables the rollup")
+	Cmd.Flags().DurationVar(&conf.CollectionSizeHistoryRetention, "collection-size-history-retention", 90*24*time.Hour, "How long the daily snapshots of the size of collections are kept, 0 disables the snapshots")
+	Cmd.Flags().DurationVar(&conf.SoftDeleteRetention, "soft-delete-retention", 0, "How long soft deleted collections can be restored before the sysdb purges them, 0 disables the soft delete cleaner")
+	Cmd.Flags().DurationVar(&conf.SoftDeleteCleanerInterval, "soft-delete-cleaner-interval", time.Hour, "How often the soft delete cleaner runs")
+	Cmd.Flags().Int32Var(&conf.SoftDeleteCleanerBatchSize, "soft-delete-cleaner-batch-size", 100, "Most soft deleted collections purged by a run of the cleaner")
+	Cmd.Flags().Int32Var(&conf.SoftDeleteCleanerMaxAttempts, "soft-delete-cleaner-max-attempts", 3, "Failed purges of a soft deleted collection after which the cleaner dead letters it")
+	Cmd.Flags().IntVar(&conf.JobWorkerConcurrency, "job-worker-concurrency", 2, "Jobs of the job queue the leader runs at once, 0 disables the jobs and the async operations")
+	Cmd.Flags().DurationVar(&conf.JobPollInterval, "job-poll-interval", 5*time.Second, "How often idle job workers look for due jobs")
+	Cmd.Flags().IntVar(&conf.ScanCollectionsRate, "scan-collections-rate", 1000, "Collections per second the ScanCollections streams of a replica read together, 0 does not limit them")
+
+	// Feature flags
+	Cmd.Flags().DurationVar(&conf.FeatureFlagCacheTTL, "feature-flag-cache-ttl", 30*time.Second, "How long feature flags are cached before they are read again")
+	Cmd.Flags().DurationVar(&conf.MaintenanceModeCacheTTL, "maintenance-mode-cache-ttl", 5*time.Second, "How long the maintenance mode is cached before it is read again")
+
+	// Naming
+	Cmd.Flags().StringSliceVar(&conf.ReservedNamePrefixes, "reserved-name-prefixes", []string{"_system"}, "Prefixes that new tenant, database and collection names may not start with")
+	Cmd.Flags().IntVar(&conf.MaxNameLength, "max-name-length", 0, "Maximum length in characters of tenant, database and collection names, 0 means unlimited")
+	Cmd.Flags().StringVar(&conf.TenantNamePattern, "tenant-name-pattern", "", "Regular expression new tenant names must match as a whole, empty allows every name")
+	Cmd.Flags().StringVar(&conf.DatabaseNamePattern, "database-name-pattern", "", "Regular expression new database names must match as a whole, empty allows every name")
+	Cmd.Flags().StringVar(&conf.CollectionNamePattern, "collection-name-pattern", "", "Regular expression new and renamed collection names must match as a whole, empty allows every name")
+
+	// Write hooks
+	Cmd.Flags().StringSliceVar(&conf.PreWriteHooks, "pre-write-hooks", nil, "Registered pre-write hooks run before mutating operations, in order")
+	Cmd.Flags().StringSliceVar(&conf.PostCommitHooks, "post-commit-hooks", nil, "Registered post-commit hooks run on the notifications delivered from the outbox, in order")
+	Cmd.Flags().DurationVar(&conf.OutboxPollInterval, "outbox-poll-interval", 0, "How often the leader delivers the pending notifications of the outbox, 0 only delivers them when it is elected")
+
+	// Tenant last compaction batching
+	Cmd.Flags().DurationVar(&conf.TenantLastCompactionFlushInterval, "tenant-last-compaction-flush-interval", 0, "How often the tenant last compaction times set by compactors are written in one batch, 0 writes them as they are set")
+	Cmd.Flags().IntVar(&conf.TenantLastCompactionMaxPending, "tenant-last-compaction-max-pending", 1000, "Number of tenants with a buffered last compaction time that triggers a batch before the interval is over")
+
+	// Object store
+	flag.ObjectStore(Cmd, &conf.ObjectStore)
+
+	// Metrics
+	Cmd.Flags().StringVar(&metricsAddress, "metrics-address", ":9464", "Address Prometheus scrapes the metrics from at /metrics, empty disables the metrics")
+
+	// Startup
+	Cmd.Flags().BoolVar(&printConfig, "print-config", false, "Print the effective configuration with secrets redacted, validate it and exit")
+	Cmd.Flags().BoolVar(&selfTest, "selftest", false, "Check the database, object store, memberlist permissions and gRPC port, print a JSON report and exit, non-zero when a check failed")
+
 	// Compaction service Memberlist
 	Cmd.Flags().StringVar(&conf.CompactionServiceMemberlistName, "compaction-memberlist-name", "compaction-service-memberlist", "Compaction memberlist name")
 	Cmd.Flags().StringVar(&conf.CompactionServicePodLabel, "compaction-pod-label", "compaction-service", "Compaction pod label")
 }
 
-func exec(*cobra.Command, []string) {
+func exec(cmd *cobra.Command, _ []string) {
+	if printConfig {
+		cmd.Flags().VisitAll(func(f *pflag.Flag) {
+			value := f.Value.String()
+			if secretFlags[f.Name] && value != "" {
+				value = utils.Redacted
+			}
+			fmt.Printf("%s=%s\n", f.Name, value)
+		})
+	}
+	if err := conf.Validate(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if printConfig {
+		return
+	}
+	if selfTest {
+		report := grpc.SelfTest(context.Background(), conf)
+		if err := report.Write(os.Stdout); err != nil || !report.OK {
+			os.Exit(1)
+		}
+		return
+	}
+	if metricsAddress != "" {
+		if _, err := otel.InitMetrics(metricsAddress); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
 	utils.RunProcess(func() (io.Closer, error) {
 		return grpc.New(conf)
 	})
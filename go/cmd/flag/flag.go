@@ -3,6 +3,7 @@ package flag
 import (
 	"fmt"
 
+	"github.com/chroma-core/chroma/go/pkg/objectstore"
 	"github.com/spf13/cobra"
 )
 
@@ -13,3 +14,16 @@ const (
 func GRPCAddr(cmd *cobra.Command, conf *string) {
 	cmd.Flags().StringVarP(conf, "grpc-addr", "g", fmt.Sprintf("0.0.0.0:%d", DefaultGRPCPort), "GRPC service bind address")
 }
+
+// ObjectStore registers the flags selecting and configuring the object store.
+func ObjectStore(cmd *cobra.Command, conf *objectstore.Config) {
+	cmd.Flags().StringVar(&conf.Provider, "object-store-provider", "", "Object store provider, s3, gcs or azure, empty disables the object store")
+	cmd.Flags().StringVar(&conf.S3.Bucket, "s3-bucket", "", "S3 bucket")
+	cmd.Flags().StringVar(&conf.S3.Region, "s3-region", "", "S3 region")
+	cmd.Flags().StringVar(&conf.S3.Endpoint, "s3-endpoint", "", "S3 endpoint, e.g. of MinIO or Ceph, empty uses AWS")
+	cmd.Flags().BoolVar(&conf.S3.UsePathStyle, "s3-path-style", false, "Address S3 buckets by path instead of subdomain")
+	cmd.Flags().StringVar(&conf.S3.CACertFile, "s3-ca-cert-file", "", "PEM file of the certificates trusted for the S3 endpoint in addition to the system ones")
+	cmd.Flags().StringVar(&conf.GCS.Bucket, "gcs-bucket", "", "GCS bucket")
+	cmd.Flags().StringVar(&conf.Azure.Container, "azure-container", "", "Azure Blob Storage container")
+	cmd.Flags().StringVar(&conf.Azure.ServiceURL, "azure-service-url", "", "Azure Blob Storage service URL, authenticated with the default Azure credential")
+}
@@ -2,10 +2,13 @@ package main
 
 import (
 	"context"
+	"flag"
+	"fmt"
 	"github.com/chroma-core/chroma/go/pkg/log/configuration"
 	"github.com/chroma-core/chroma/go/pkg/log/purging"
 	"github.com/chroma-core/chroma/go/pkg/log/repository"
 	"github.com/chroma-core/chroma/go/pkg/log/server"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
 	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
 	"github.com/chroma-core/chroma/go/pkg/utils"
 	libs "github.com/chroma-core/chroma/go/shared/libs"
@@ -15,9 +18,14 @@ import (
 	"go.uber.org/automaxprocs/maxprocs"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"net"
+	"os"
 )
 
+var printConfigFlag = flag.Bool("print-config", false, "Print the effective configuration with secrets redacted, validate it and exit")
+var selfTestFlag = flag.Bool("selftest", false, "Check the database and the port, print a JSON report and exit, non-zero when a check failed")
+
 func main() {
 	ctx := context.Background()
 
@@ -27,21 +35,44 @@ func main() {
 	if _, err := maxprocs.Set(); err != nil {
 		log.Fatal("can't set maxprocs", zap.Error(err))
 	}
+	flag.Parse()
+	config, err := configuration.LoadLogServiceConfiguration()
+	if *printConfigFlag {
+		configuration.NewLogServiceConfiguration().Print(os.Stdout)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if *printConfigFlag {
+		return
+	}
+	if *selfTestFlag {
+		report := selfTest(ctx, config)
+		if err := report.Write(os.Stdout); err != nil || !report.OK {
+			os.Exit(1)
+		}
+		return
+	}
 	log.Info("Starting log service")
-	config := configuration.NewLogServiceConfiguration()
-	err := otel.InitTracing(ctx, &otel.TracingConfig{
+	err = otel.InitTracing(ctx, &otel.TracingConfig{
 		Service:  "log-service",
 		Endpoint: config.OPTL_TRACING_ENDPOINT,
 	})
 	if err != nil {
 		log.Fatal("failed to initialize tracing", zap.Error(err))
 	}
+	if config.METRICS_ADDRESS != "" {
+		if _, err := otel.InitMetrics(config.METRICS_ADDRESS); err != nil {
+			log.Fatal("failed to initialize metrics", zap.Error(err))
+		}
+	}
 	conn, err := libs.NewPgConnection(ctx, config)
 	if err != nil {
 		log.Fatal("failed to connect to postgres", zap.Error(err))
 	}
 	lr := repository.NewLogRepository(conn)
-	server := server.NewLogServer(lr)
+	server := server.NewLogServer(lr, config.COMPACTION_MAX_COLLECTIONS_PER_TENANT)
 	var listener net.Listener
 	listener, err = net.Listen("tcp", ":"+config.PORT)
 	if err != nil {
@@ -50,7 +81,18 @@ func main() {
 	s := grpc.NewServer(grpc.UnaryInterceptor(otel.ServerGrpcInterceptor))
 	logservicepb.RegisterLogServiceServer(s, server)
 	log.Info("log service started", zap.String("address", listener.Addr().String()))
-	go purging.RunPurging(ctx, lr)
+	var scrubber *purging.Scrubber
+	if config.SCRUB_INTERVAL > 0 {
+		sysdbConn, err := grpc.Dial(config.SYSDB_ADDRESS, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Fatal("failed to connect to sysdb", zap.Error(err))
+		}
+		scrubber = purging.NewScrubber(lr, coordinatorpb.NewSysDBClient(sysdbConn), config.SCRUB_PURGE, config.SCRUB_INTERVAL)
+	}
+	go purging.RunPurging(ctx, lr, repository.RetentionPolicy{
+		MaxAge:   config.RETENTION_MAX_AGE,
+		MaxBytes: config.RETENTION_MAX_BYTES,
+	}, scrubber)
 	if err := s.Serve(listener); err != nil {
 		log.Fatal("failed to serve", zap.Error(err))
 	}
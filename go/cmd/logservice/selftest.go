@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+
+	"github.com/chroma-core/chroma/go/database/log/migrations"
+	"github.com/chroma-core/chroma/go/pkg/log/configuration"
+	"github.com/chroma-core/chroma/go/pkg/selftest"
+	libs "github.com/chroma-core/chroma/go/shared/libs"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// selfTest checks that the log service can reach its database, that the schema
+// is up to date and that its port is free. The log service uses neither an
+// object store nor a memberlist.
+func selfTest(ctx context.Context, config *configuration.LogServiceConfiguration) *selftest.Report {
+	var conn *pgxpool.Pool
+	checks := []selftest.Check{
+		selftest.Ping(func(ctx context.Context) error {
+			var err error
+			conn, err = libs.NewPgConnection(ctx, config)
+			if err != nil {
+				return err
+			}
+			return conn.Ping(ctx)
+		}),
+		selftest.SchemaVersion(func(ctx context.Context) (string, error) {
+			if conn == nil {
+				return "", selftest.Skip("database is not reachable")
+			}
+			var version string
+			err := conn.QueryRow(ctx, selftest.AtlasRevisionQuery).Scan(&version)
+			return version, err
+		}, migrations.FS),
+		selftest.PortBinding(":" + config.PORT),
+	}
+	report := selftest.Run(ctx, "log-service", 0, checks)
+	if conn != nil {
+		conn.Close()
+	}
+	return report
+}
@@ -4,10 +4,23 @@
 
 package log
 
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
 type Collection struct {
 	ID                              string
 	RecordCompactionOffsetPosition  int64
 	RecordEnumerationOffsetPosition int64
+	TenantID                        string
+}
+
+type ProducerSequence struct {
+	CollectionID string
+	ProducerID   string
+	Sequence     int64
+	RecordCount  int64
+	UpdatedAt    int64
 }
 
 type RecordLog struct {
@@ -16,3 +29,9 @@ type RecordLog struct {
 	Timestamp    int64
 	Record       []byte
 }
+
+type RetentionPolicy struct {
+	TenantID      string
+	MaxAgeSeconds pgtype.Int8
+	MaxBytes      pgtype.Int8
+}
@@ -7,30 +7,81 @@ package log
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteCollection = `-- name: DeleteCollection :exec
+DELETE FROM collection WHERE id = $1
+`
+
+func (q *Queries) DeleteCollection(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, deleteCollection, id)
+	return err
+}
+
+const deleteProducerSequencesForCollection = `-- name: DeleteProducerSequencesForCollection :exec
+DELETE FROM producer_sequence WHERE collection_id = $1
+`
+
+func (q *Queries) DeleteProducerSequencesForCollection(ctx context.Context, collectionID string) error {
+	_, err := q.db.Exec(ctx, deleteProducerSequencesForCollection, collectionID)
+	return err
+}
+
+const deleteRecordsForCollection = `-- name: DeleteRecordsForCollection :one
+with deleted as (
+    delete from record_log r where r.collection_id = $1
+    returning octet_length(r.record) as size
 )
+select count(*)::bigint as record_count, coalesce(sum(size), 0)::bigint as size_bytes from deleted
+`
+
+type DeleteRecordsForCollectionRow struct {
+	RecordCount int64
+	SizeBytes   int64
+}
+
+func (q *Queries) DeleteRecordsForCollection(ctx context.Context, collectionID string) (DeleteRecordsForCollectionRow, error) {
+	row := q.db.QueryRow(ctx, deleteRecordsForCollection, collectionID)
+	var i DeleteRecordsForCollectionRow
+	err := row.Scan(&i.RecordCount, &i.SizeBytes)
+	return i, err
+}
 
 const getAllCollectionsToCompact = `-- name: GetAllCollectionsToCompact :many
 with summary as (
-    select r.collection_id, r.offset, r.timestamp, row_number() over(partition by r.collection_id order by r.offset) as rank
+    select r.collection_id, r.offset, r.timestamp, c.tenant_id, row_number() over(partition by r.collection_id order by r.offset) as rank
     from record_log r, collection c
     where r.collection_id = c.id
     and (c.record_enumeration_offset_position - c.record_compaction_offset_position) >= $1
     and r.offset > c.record_compaction_offset_position
+), ranked as (
+    select s.collection_id, s.offset, s.timestamp, s.tenant_id,
+        row_number() over(partition by coalesce(nullif(s.tenant_id, ''), s.collection_id) order by s.timestamp) as tenant_rank
+    from summary s
+    where s.rank = 1
 )
-select collection_id, "offset", timestamp, rank from summary
-where rank=1
-order by timestamp
+select collection_id, "offset", timestamp, tenant_id, tenant_rank from ranked
+where $2::bigint <= 0 or tenant_rank <= $2::bigint
+order by tenant_rank, timestamp
 `
 
+type GetAllCollectionsToCompactParams struct {
+	MinCompactionSize int64
+	MaxPerTenant      int64
+}
+
 type GetAllCollectionsToCompactRow struct {
 	CollectionID string
 	Offset       int64
 	Timestamp    int64
-	Rank         int64
+	TenantID     string
+	TenantRank   int64
 }
 
-func (q *Queries) GetAllCollectionsToCompact(ctx context.Context, minCompactionSize int64) ([]GetAllCollectionsToCompactRow, error) {
-	rows, err := q.db.Query(ctx, getAllCollectionsToCompact, minCompactionSize)
+func (q *Queries) GetAllCollectionsToCompact(ctx context.Context, arg GetAllCollectionsToCompactParams) ([]GetAllCollectionsToCompactRow, error) {
+	rows, err := q.db.Query(ctx, getAllCollectionsToCompact, arg.MinCompactionSize, arg.MaxPerTenant)
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +93,8 @@ func (q *Queries) GetAllCollectionsToCompact(ctx context.Context, minCompactionS
 			&i.CollectionID,
 			&i.Offset,
 			&i.Timestamp,
-			&i.Rank,
+			&i.TenantID,
+			&i.TenantRank,
 		); err != nil {
 			return nil, err
 		}
@@ -55,7 +107,7 @@ func (q *Queries) GetAllCollectionsToCompact(ctx context.Context, minCompactionS
 }
 
 const getCollectionForUpdate = `-- name: GetCollectionForUpdate :one
-SELECT id, record_compaction_offset_position, record_enumeration_offset_position
+SELECT id, record_compaction_offset_position, record_enumeration_offset_position, tenant_id
 FROM collection
 WHERE id = $1
 FOR UPDATE
@@ -64,7 +116,99 @@ FOR UPDATE
 func (q *Queries) GetCollectionForUpdate(ctx context.Context, id string) (Collection, error) {
 	row := q.db.QueryRow(ctx, getCollectionForUpdate, id)
 	var i Collection
-	err := row.Scan(&i.ID, &i.RecordCompactionOffsetPosition, &i.RecordEnumerationOffsetPosition)
+	err := row.Scan(
+		&i.ID,
+		&i.RecordCompactionOffsetPosition,
+		&i.RecordEnumerationOffsetPosition,
+		&i.TenantID,
+	)
+	return i, err
+}
+
+const getCollectionIdsAfter = `-- name: GetCollectionIdsAfter :many
+SELECT id FROM collection WHERE id > $1 ORDER BY id LIMIT $2
+`
+
+type GetCollectionIdsAfterParams struct {
+	ID    string
+	Limit int32
+}
+
+func (q *Queries) GetCollectionIdsAfter(ctx context.Context, arg GetCollectionIdsAfterParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, getCollectionIdsAfter, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCollectionsLogLag = `-- name: GetCollectionsLogLag :many
+select c.id as collection_id,
+    (c.record_enumeration_offset_position - c.record_compaction_offset_position)::bigint as uncompacted_records,
+    coalesce((select min(r.timestamp) from record_log r where r.collection_id = c.id and r.offset > c.record_compaction_offset_position), 0)::bigint as oldest_uncompacted_ts,
+    c.record_enumeration_offset_position::bigint as max_offset
+from collection c
+where c.id = any($1::text[])
+`
+
+type GetCollectionsLogLagRow struct {
+	CollectionID        string
+	UncompactedRecords  int64
+	OldestUncompactedTs int64
+	MaxOffset           int64
+}
+
+func (q *Queries) GetCollectionsLogLag(ctx context.Context, collectionIds []string) ([]GetCollectionsLogLagRow, error) {
+	rows, err := q.db.Query(ctx, getCollectionsLogLag, collectionIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCollectionsLogLagRow
+	for rows.Next() {
+		var i GetCollectionsLogLagRow
+		if err := rows.Scan(&i.CollectionID, &i.UncompactedRecords, &i.OldestUncompactedTs, &i.MaxOffset); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProducerSequence = `-- name: GetProducerSequence :one
+SELECT collection_id, producer_id, sequence, record_count, updated_at FROM producer_sequence WHERE collection_id = $1 AND producer_id = $2
+`
+
+type GetProducerSequenceParams struct {
+	CollectionID string
+	ProducerID   string
+}
+
+func (q *Queries) GetProducerSequence(ctx context.Context, arg GetProducerSequenceParams) (ProducerSequence, error) {
+	row := q.db.QueryRow(ctx, getProducerSequence, arg.CollectionID, arg.ProducerID)
+	var i ProducerSequence
+	err := row.Scan(
+		&i.CollectionID,
+		&i.ProducerID,
+		&i.Sequence,
+		&i.RecordCount,
+		&i.UpdatedAt,
+	)
 	return i, err
 }
 
@@ -109,20 +253,49 @@ func (q *Queries) GetRecordsForCollection(ctx context.Context, arg GetRecordsFor
 	return items, nil
 }
 
+const getRetainedLogVolume = `-- name: GetRetainedLogVolume :one
+select count(*)::bigint as record_count, coalesce(sum(octet_length(r.record)), 0)::bigint as size_bytes
+from record_log r inner join collection c on r.collection_id = c.id
+where r.offset <= c.record_compaction_offset_position
+`
+
+type GetRetainedLogVolumeRow struct {
+	RecordCount int64
+	SizeBytes   int64
+}
+
+func (q *Queries) GetRetainedLogVolume(ctx context.Context) (GetRetainedLogVolumeRow, error) {
+	row := q.db.QueryRow(ctx, getRetainedLogVolume)
+	var i GetRetainedLogVolumeRow
+	err := row.Scan(&i.RecordCount, &i.SizeBytes)
+	return i, err
+}
+
 const insertCollection = `-- name: InsertCollection :one
-INSERT INTO collection (id, record_enumeration_offset_position, record_compaction_offset_position) values($1, $2, $3) returning id, record_compaction_offset_position, record_enumeration_offset_position
+INSERT INTO collection (id, record_enumeration_offset_position, record_compaction_offset_position, tenant_id) values($1, $2, $3, $4) returning id, record_compaction_offset_position, record_enumeration_offset_position, tenant_id
 `
 
 type InsertCollectionParams struct {
 	ID                              string
 	RecordEnumerationOffsetPosition int64
 	RecordCompactionOffsetPosition  int64
+	TenantID                        string
 }
 
 func (q *Queries) InsertCollection(ctx context.Context, arg InsertCollectionParams) (Collection, error) {
-	row := q.db.QueryRow(ctx, insertCollection, arg.ID, arg.RecordEnumerationOffsetPosition, arg.RecordCompactionOffsetPosition)
+	row := q.db.QueryRow(ctx, insertCollection,
+		arg.ID,
+		arg.RecordEnumerationOffsetPosition,
+		arg.RecordCompactionOffsetPosition,
+		arg.TenantID,
+	)
 	var i Collection
-	err := row.Scan(&i.ID, &i.RecordCompactionOffsetPosition, &i.RecordEnumerationOffsetPosition)
+	err := row.Scan(
+		&i.ID,
+		&i.RecordCompactionOffsetPosition,
+		&i.RecordEnumerationOffsetPosition,
+		&i.TenantID,
+	)
 	return i, err
 }
 
@@ -133,15 +306,53 @@ type InsertRecordParams struct {
 	Timestamp    int64
 }
 
-const purgeRecords = `-- name: PurgeRecords :exec
-DELETE FROM record_log r using collection c where r.collection_id = c.id and r.offset < c.record_compaction_offset_position
+const purgeProducerSequences = `-- name: PurgeProducerSequences :exec
+DELETE FROM producer_sequence WHERE updated_at < $1
 `
 
-func (q *Queries) PurgeRecords(ctx context.Context) error {
-	_, err := q.db.Exec(ctx, purgeRecords)
+func (q *Queries) PurgeProducerSequences(ctx context.Context, updatedAt int64) error {
+	_, err := q.db.Exec(ctx, purgeProducerSequences, updatedAt)
 	return err
 }
 
+const purgeRecords = `-- name: PurgeRecords :one
+with compacted as (
+    select r.collection_id, r.offset, r.timestamp,
+        sum(octet_length(r.record)) over(partition by r.collection_id order by r.offset desc) as retained_bytes,
+        coalesce(p.max_age_seconds, $1::bigint) as max_age_seconds,
+        coalesce(p.max_bytes, $2::bigint) as max_bytes
+    from record_log r
+    inner join collection c on r.collection_id = c.id
+    left join retention_policy p on c.tenant_id = p.tenant_id
+    where r.offset < c.record_compaction_offset_position
+), deleted as (
+    delete from record_log r using compacted e
+    where r.collection_id = e.collection_id and r.offset = e.offset
+    and ((e.max_age_seconds >= 0 and e.timestamp < $3::bigint - e.max_age_seconds * 1000000000)
+        or (e.max_bytes >= 0 and e.retained_bytes > e.max_bytes))
+    returning octet_length(r.record) as size
+)
+select count(*)::bigint as record_count, coalesce(sum(size), 0)::bigint as size_bytes from deleted
+`
+
+type PurgeRecordsParams struct {
+	DefaultMaxAgeSeconds int64
+	DefaultMaxBytes      int64
+	Now                  int64
+}
+
+type PurgeRecordsRow struct {
+	RecordCount int64
+	SizeBytes   int64
+}
+
+func (q *Queries) PurgeRecords(ctx context.Context, arg PurgeRecordsParams) (PurgeRecordsRow, error) {
+	row := q.db.QueryRow(ctx, purgeRecords, arg.DefaultMaxAgeSeconds, arg.DefaultMaxBytes, arg.Now)
+	var i PurgeRecordsRow
+	err := row.Scan(&i.RecordCount, &i.SizeBytes)
+	return i, err
+}
+
 const updateCollectionCompactionOffsetPosition = `-- name: UpdateCollectionCompactionOffsetPosition :exec
 UPDATE collection set record_compaction_offset_position = $2 where id = $1
 `
@@ -169,3 +380,57 @@ func (q *Queries) UpdateCollectionEnumerationOffsetPosition(ctx context.Context,
 	_, err := q.db.Exec(ctx, updateCollectionEnumerationOffsetPosition, arg.ID, arg.RecordEnumerationOffsetPosition)
 	return err
 }
+
+const updateCollectionTenant = `-- name: UpdateCollectionTenant :exec
+UPDATE collection set tenant_id = $2 where id = $1 and tenant_id = ''
+`
+
+type UpdateCollectionTenantParams struct {
+	ID       string
+	TenantID string
+}
+
+func (q *Queries) UpdateCollectionTenant(ctx context.Context, arg UpdateCollectionTenantParams) error {
+	_, err := q.db.Exec(ctx, updateCollectionTenant, arg.ID, arg.TenantID)
+	return err
+}
+
+const upsertProducerSequence = `-- name: UpsertProducerSequence :exec
+INSERT INTO producer_sequence (collection_id, producer_id, sequence, record_count, updated_at) values($1, $2, $3, $4, $5)
+ON CONFLICT (collection_id, producer_id) DO UPDATE SET sequence = excluded.sequence, record_count = excluded.record_count, updated_at = excluded.updated_at
+`
+
+type UpsertProducerSequenceParams struct {
+	CollectionID string
+	ProducerID   string
+	Sequence     int64
+	RecordCount  int64
+	UpdatedAt    int64
+}
+
+func (q *Queries) UpsertProducerSequence(ctx context.Context, arg UpsertProducerSequenceParams) error {
+	_, err := q.db.Exec(ctx, upsertProducerSequence,
+		arg.CollectionID,
+		arg.ProducerID,
+		arg.Sequence,
+		arg.RecordCount,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const upsertRetentionPolicy = `-- name: UpsertRetentionPolicy :exec
+INSERT INTO retention_policy (tenant_id, max_age_seconds, max_bytes) values($1, $2, $3)
+ON CONFLICT (tenant_id) DO UPDATE SET max_age_seconds = excluded.max_age_seconds, max_bytes = excluded.max_bytes
+`
+
+type UpsertRetentionPolicyParams struct {
+	TenantID      string
+	MaxAgeSeconds pgtype.Int8
+	MaxBytes      pgtype.Int8
+}
+
+func (q *Queries) UpsertRetentionPolicy(ctx context.Context, arg UpsertRetentionPolicyParams) error {
+	_, err := q.db.Exec(ctx, upsertRetentionPolicy, arg.TenantID, arg.MaxAgeSeconds, arg.MaxBytes)
+	return err
+}
@@ -0,0 +1,9 @@
+-- Modify "collection" table
+ALTER TABLE "public"."collection" ADD COLUMN "tenant_id" text NOT NULL DEFAULT '';
+-- Create "retention_policy" table
+CREATE TABLE "public"."retention_policy" (
+  "tenant_id" text NOT NULL,
+  "max_age_seconds" bigint NULL,
+  "max_bytes" bigint NULL,
+  PRIMARY KEY ("tenant_id")
+);
@@ -0,0 +1,9 @@
+-- Create "producer_sequence" table
+CREATE TABLE "public"."producer_sequence" (
+  "collection_id" text NOT NULL,
+  "producer_id" text NOT NULL,
+  "sequence" bigint NOT NULL,
+  "record_count" bigint NOT NULL,
+  "updated_at" bigint NOT NULL,
+  PRIMARY KEY ("collection_id", "producer_id")
+);
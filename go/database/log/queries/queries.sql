@@ -12,15 +12,20 @@ SELECT * FROM record_log r WHERE r.collection_id = $1 AND r.offset >= $2 and r.t
 
 -- name: GetAllCollectionsToCompact :many
 with summary as (
-    select r.collection_id, r.offset, r.timestamp, row_number() over(partition by r.collection_id order by r.offset) as rank
+    select r.collection_id, r.offset, r.timestamp, c.tenant_id, row_number() over(partition by r.collection_id order by r.offset) as rank
     from record_log r, collection c
     where r.collection_id = c.id
     and (c.record_enumeration_offset_position - c.record_compaction_offset_position) >= sqlc.arg(min_compaction_size)
     and r.offset > c.record_compaction_offset_position
+), ranked as (
+    select s.collection_id, s.offset, s.timestamp, s.tenant_id,
+        row_number() over(partition by coalesce(nullif(s.tenant_id, ''), s.collection_id) order by s.timestamp) as tenant_rank
+    from summary s
+    where s.rank = 1
 )
-select * from summary
-where rank=1
-order by timestamp;
+select * from ranked
+where sqlc.arg(max_per_tenant)::bigint <= 0 or tenant_rank <= sqlc.arg(max_per_tenant)::bigint
+order by tenant_rank, timestamp;
 
 -- name: UpdateCollectionCompactionOffsetPosition :exec
 UPDATE collection set record_compaction_offset_position = $2 where id = $1;
@@ -29,7 +34,69 @@ UPDATE collection set record_compaction_offset_position = $2 where id = $1;
 UPDATE collection set record_enumeration_offset_position = $2 where id = $1;
 
 -- name: InsertCollection :one
-INSERT INTO collection (id, record_enumeration_offset_position, record_compaction_offset_position) values($1, $2, $3) returning *;
+INSERT INTO collection (id, record_enumeration_offset_position, record_compaction_offset_position, tenant_id) values($1, $2, $3, $4) returning *;
 
--- name: PurgeRecords :exec
-DELETE FROM record_log r using collection c where r.collection_id = c.id and r.offset < c.record_compaction_offset_position;
+-- name: UpdateCollectionTenant :exec
+UPDATE collection set tenant_id = $2 where id = $1 and tenant_id = '';
+
+-- name: PurgeRecords :one
+with compacted as (
+    select r.collection_id, r.offset, r.timestamp,
+        sum(octet_length(r.record)) over(partition by r.collection_id order by r.offset desc) as retained_bytes,
+        coalesce(p.max_age_seconds, sqlc.arg(default_max_age_seconds)::bigint) as max_age_seconds,
+        coalesce(p.max_bytes, sqlc.arg(default_max_bytes)::bigint) as max_bytes
+    from record_log r
+    inner join collection c on r.collection_id = c.id
+    left join retention_policy p on c.tenant_id = p.tenant_id
+    where r.offset < c.record_compaction_offset_position
+), deleted as (
+    delete from record_log r using compacted e
+    where r.collection_id = e.collection_id and r.offset = e.offset
+    and ((e.max_age_seconds >= 0 and e.timestamp < sqlc.arg(now)::bigint - e.max_age_seconds * 1000000000)
+        or (e.max_bytes >= 0 and e.retained_bytes > e.max_bytes))
+    returning octet_length(r.record) as size
+)
+select count(*)::bigint as record_count, coalesce(sum(size), 0)::bigint as size_bytes from deleted;
+
+-- name: GetRetainedLogVolume :one
+select count(*)::bigint as record_count, coalesce(sum(octet_length(r.record)), 0)::bigint as size_bytes
+from record_log r inner join collection c on r.collection_id = c.id
+where r.offset <= c.record_compaction_offset_position;
+
+-- name: UpsertRetentionPolicy :exec
+INSERT INTO retention_policy (tenant_id, max_age_seconds, max_bytes) values($1, $2, $3)
+ON CONFLICT (tenant_id) DO UPDATE SET max_age_seconds = excluded.max_age_seconds, max_bytes = excluded.max_bytes;
+
+-- name: GetProducerSequence :one
+SELECT * FROM producer_sequence WHERE collection_id = $1 AND producer_id = $2;
+
+-- name: UpsertProducerSequence :exec
+INSERT INTO producer_sequence (collection_id, producer_id, sequence, record_count, updated_at) values($1, $2, $3, $4, $5)
+ON CONFLICT (collection_id, producer_id) DO UPDATE SET sequence = excluded.sequence, record_count = excluded.record_count, updated_at = excluded.updated_at;
+
+-- name: PurgeProducerSequences :exec
+DELETE FROM producer_sequence WHERE updated_at < $1;
+
+-- name: GetCollectionIdsAfter :many
+SELECT id FROM collection WHERE id > $1 ORDER BY id LIMIT $2;
+
+-- name: GetCollectionsLogLag :many
+select c.id as collection_id,
+    (c.record_enumeration_offset_position - c.record_compaction_offset_position)::bigint as uncompacted_records,
+    coalesce((select min(r.timestamp) from record_log r where r.collection_id = c.id and r.offset > c.record_compaction_offset_position), 0)::bigint as oldest_uncompacted_ts,
+    c.record_enumeration_offset_position::bigint as max_offset
+from collection c
+where c.id = any(sqlc.arg(collection_ids)::text[]);
+
+-- name: DeleteRecordsForCollection :one
+with deleted as (
+    delete from record_log r where r.collection_id = $1
+    returning octet_length(r.record) as size
+)
+select count(*)::bigint as record_count, coalesce(sum(size), 0)::bigint as size_bytes from deleted;
+
+-- name: DeleteProducerSequencesForCollection :exec
+DELETE FROM producer_sequence WHERE collection_id = $1;
+
+-- name: DeleteCollection :exec
+DELETE FROM collection WHERE id = $1;
@@ -1,8 +1,10 @@
 CREATE TABLE collection (
                         id text PRIMARY KEY,
                         record_compaction_offset_position bigint NOT NULL,
-                        record_enumeration_offset_position bigint NOT NULL
+                        record_enumeration_offset_position bigint NOT NULL,
+                        tenant_id text NOT NULL DEFAULT ''
                         );
 
 -- The `record_compaction_offset_position` column indicates the offset position of the latest compaction.
 -- The `record_enenumeration_offset_position` column denotes the incremental offset for the most recent record in a collection.
+-- The `tenant_id` column is set by the first push that names the tenant and selects its retention policy.
@@ -0,0 +1,11 @@
+CREATE TABLE producer_sequence (
+                        collection_id text NOT NULL,
+                        producer_id text NOT NULL,
+                        sequence bigint NOT NULL,
+                        record_count bigint NOT NULL,
+                        updated_at bigint NOT NULL,
+                        PRIMARY KEY(collection_id, producer_id)
+);
+
+-- The `sequence` column is the sequence number of the last push of a producer to a collection
+-- and `record_count` the number of records it appended, returned again to retries of that push.
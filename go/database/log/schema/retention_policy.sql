@@ -0,0 +1,8 @@
+CREATE TABLE retention_policy (
+                        tenant_id text PRIMARY KEY,
+                        max_age_seconds bigint,
+                        max_bytes bigint
+                        );
+
+-- Overrides the deployment retention of compacted records for the collections of a tenant.
+-- A NULL column falls back to the deployment setting, a negative one is unlimited.
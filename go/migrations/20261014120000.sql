@@ -0,0 +1,6 @@
+-- Modify "collections" table
+ALTER TABLE "public"."collections" ADD COLUMN "total_records_post_compaction" bigint NULL DEFAULT 0, ADD COLUMN "size_bytes_post_compaction" bigint NULL DEFAULT 0;
+-- Create index "idx_database_total_records" to table: "collections"
+CREATE INDEX "idx_database_total_records" ON "public"."collections" ("database_id", "total_records_post_compaction" DESC);
+-- Create index "idx_database_size_bytes" to table: "collections"
+CREATE INDEX "idx_database_size_bytes" ON "public"."collections" ("database_id", "size_bytes_post_compaction" DESC);
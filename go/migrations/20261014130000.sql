@@ -0,0 +1,26 @@
+-- Create "archived_collections" table
+CREATE TABLE "public"."archived_collections" (
+  "id" bigserial NOT NULL,
+  "collection_id" text NULL,
+  "database_id" text NULL,
+  "record" jsonb NOT NULL,
+  "reason" text NULL,
+  "actor" text NULL,
+  "archived_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_archived_collection_id" to table: "archived_collections"
+CREATE INDEX "idx_archived_collection_id" ON "public"."archived_collections" ("collection_id");
+-- Create "archived_segments" table
+CREATE TABLE "public"."archived_segments" (
+  "id" bigserial NOT NULL,
+  "segment_id" text NULL,
+  "collection_id" text NULL,
+  "record" jsonb NOT NULL,
+  "reason" text NULL,
+  "actor" text NULL,
+  "archived_at" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_archived_segment_id" to table: "archived_segments"
+CREATE INDEX "idx_archived_segment_id" ON "public"."archived_segments" ("segment_id");
@@ -0,0 +1,2 @@
+-- Modify "collections" table
+ALTER TABLE "public"."collections" ADD COLUMN "fencing_token" bigint NULL DEFAULT 0, ADD COLUMN "fencing_owner" text NULL;
@@ -0,0 +1,2 @@
+-- Modify "collections" table
+ALTER TABLE "public"."collections" ADD COLUMN "state" text NOT NULL DEFAULT 'ready';
@@ -0,0 +1,2 @@
+-- Modify "collections" table
+ALTER TABLE "public"."collections" ADD COLUMN "reindex_pending" boolean NOT NULL DEFAULT false, ADD COLUMN "reindex_from_dimension" integer NULL;
@@ -0,0 +1,16 @@
+-- Modify "archived_collections" table
+ALTER TABLE "public"."archived_collections" ALTER COLUMN "archived_at" TYPE timestamptz USING "archived_at" AT TIME ZONE 'UTC';
+-- Modify "archived_segments" table
+ALTER TABLE "public"."archived_segments" ALTER COLUMN "archived_at" TYPE timestamptz USING "archived_at" AT TIME ZONE 'UTC';
+-- Modify "collection_metadata" table
+ALTER TABLE "public"."collection_metadata" ALTER COLUMN "created_at" TYPE timestamptz USING "created_at" AT TIME ZONE 'UTC', ALTER COLUMN "updated_at" TYPE timestamptz USING "updated_at" AT TIME ZONE 'UTC';
+-- Modify "collections" table
+ALTER TABLE "public"."collections" ALTER COLUMN "created_at" TYPE timestamptz USING "created_at" AT TIME ZONE 'UTC', ALTER COLUMN "updated_at" TYPE timestamptz USING "updated_at" AT TIME ZONE 'UTC';
+-- Modify "databases" table
+ALTER TABLE "public"."databases" ALTER COLUMN "created_at" TYPE timestamptz USING "created_at" AT TIME ZONE 'UTC', ALTER COLUMN "updated_at" TYPE timestamptz USING "updated_at" AT TIME ZONE 'UTC';
+-- Modify "segment_metadata" table
+ALTER TABLE "public"."segment_metadata" ALTER COLUMN "created_at" TYPE timestamptz USING "created_at" AT TIME ZONE 'UTC', ALTER COLUMN "updated_at" TYPE timestamptz USING "updated_at" AT TIME ZONE 'UTC';
+-- Modify "segments" table
+ALTER TABLE "public"."segments" ALTER COLUMN "created_at" TYPE timestamptz USING "created_at" AT TIME ZONE 'UTC', ALTER COLUMN "updated_at" TYPE timestamptz USING "updated_at" AT TIME ZONE 'UTC';
+-- Modify "tenants" table
+ALTER TABLE "public"."tenants" ALTER COLUMN "created_at" TYPE timestamptz USING "created_at" AT TIME ZONE 'UTC', ALTER COLUMN "updated_at" TYPE timestamptz USING "updated_at" AT TIME ZONE 'UTC';
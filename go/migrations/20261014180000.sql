@@ -0,0 +1,32 @@
+-- Every id written by the sysdb is a types.UniqueID, so the text columns convert
+-- in place. Abort before altering anything if a row holds an id that is not a
+-- uuid; such rows have to be fixed or removed by hand before the migration is retried.
+DO $$
+BEGIN
+  IF EXISTS (SELECT 1 FROM "public"."collections" WHERE "id" !~* '^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$' OR "database_id" !~* '^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$')
+    OR EXISTS (SELECT 1 FROM "public"."databases" WHERE "id" !~* '^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$')
+    OR EXISTS (SELECT 1 FROM "public"."segments" WHERE "id" !~* '^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$' OR "collection_id" !~* '^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$')
+    OR EXISTS (SELECT 1 FROM "public"."collection_metadata" WHERE "collection_id" !~* '^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$')
+    OR EXISTS (SELECT 1 FROM "public"."segment_metadata" WHERE "segment_id" !~* '^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$')
+    OR EXISTS (SELECT 1 FROM "public"."notifications" WHERE "collection_id" !~* '^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$')
+    OR EXISTS (SELECT 1 FROM "public"."archived_collections" WHERE "collection_id" !~* '^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$' OR "database_id" !~* '^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$')
+    OR EXISTS (SELECT 1 FROM "public"."archived_segments" WHERE "segment_id" !~* '^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$' OR "collection_id" !~* '^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$') THEN
+    RAISE EXCEPTION 'sysdb contains ids that are not uuids';
+  END IF;
+END $$;
+-- Modify "archived_collections" table
+ALTER TABLE "public"."archived_collections" ALTER COLUMN "collection_id" TYPE uuid USING "collection_id"::uuid, ALTER COLUMN "database_id" TYPE uuid USING "database_id"::uuid;
+-- Modify "archived_segments" table
+ALTER TABLE "public"."archived_segments" ALTER COLUMN "segment_id" TYPE uuid USING "segment_id"::uuid, ALTER COLUMN "collection_id" TYPE uuid USING "collection_id"::uuid;
+-- Modify "collection_metadata" table
+ALTER TABLE "public"."collection_metadata" ALTER COLUMN "collection_id" TYPE uuid USING "collection_id"::uuid;
+-- Modify "collections" table
+ALTER TABLE "public"."collections" ALTER COLUMN "id" TYPE uuid USING "id"::uuid, ALTER COLUMN "database_id" TYPE uuid USING "database_id"::uuid;
+-- Modify "databases" table
+ALTER TABLE "public"."databases" ALTER COLUMN "id" TYPE uuid USING "id"::uuid;
+-- Modify "notifications" table
+ALTER TABLE "public"."notifications" ALTER COLUMN "collection_id" TYPE uuid USING "collection_id"::uuid;
+-- Modify "segment_metadata" table
+ALTER TABLE "public"."segment_metadata" ALTER COLUMN "segment_id" TYPE uuid USING "segment_id"::uuid;
+-- Modify "segments" table
+ALTER TABLE "public"."segments" ALTER COLUMN "id" TYPE uuid USING "id"::uuid, ALTER COLUMN "collection_id" TYPE uuid USING "collection_id"::uuid;
@@ -0,0 +1,8 @@
+-- Modify "collections" table
+ALTER TABLE "public"."collections" ADD COLUMN "deleted_at" timestamptz NULL, ADD COLUMN "deleted_by" text NULL;
+-- Modify "databases" table
+ALTER TABLE "public"."databases" ADD COLUMN "deleted_at" timestamptz NULL, ADD COLUMN "deleted_by" text NULL;
+-- Modify "segments" table
+ALTER TABLE "public"."segments" ADD COLUMN "deleted_at" timestamptz NULL, ADD COLUMN "deleted_by" text NULL;
+-- Modify "tenants" table
+ALTER TABLE "public"."tenants" ADD COLUMN "deleted_at" timestamptz NULL, ADD COLUMN "deleted_by" text NULL;
@@ -0,0 +1,50 @@
+-- Modify "collections" table
+ALTER TABLE "public"."collections" ADD COLUMN "tenant_id" text NOT NULL DEFAULT '';
+-- Modify "collection_metadata" table
+ALTER TABLE "public"."collection_metadata" ADD COLUMN "tenant_id" text NOT NULL DEFAULT '';
+-- Modify "segments" table
+ALTER TABLE "public"."segments" ADD COLUMN "tenant_id" text NOT NULL DEFAULT '';
+-- Backfill the partition key from the owning database
+UPDATE "public"."collections" SET "tenant_id" = "databases"."tenant_id" FROM "public"."databases" WHERE "collections"."database_id" = "databases"."id";
+UPDATE "public"."collection_metadata" SET "tenant_id" = "collections"."tenant_id" FROM "public"."collections" WHERE "collection_metadata"."collection_id" = "collections"."id";
+UPDATE "public"."segments" SET "tenant_id" = "collections"."tenant_id" FROM "public"."collections" WHERE "segments"."collection_id" = "collections"."id";
+-- Create "partition_sysdb_by_tenant" procedure
+--
+-- Partitioning is optional and is not applied by this migration. Operators of
+-- very large deployments run CALL partition_sysdb_by_tenant(16) during a
+-- maintenance window to rebuild collections, collection_metadata and segments as
+-- tables hash partitioned by tenant_id. The primary keys and the collection name
+-- index gain tenant_id, which PostgreSQL requires of unique indexes on
+-- partitioned tables. The rows are copied, so the tables are locked for the
+-- duration of the call.
+CREATE PROCEDURE "public"."partition_sysdb_by_tenant"(partition_count integer)
+LANGUAGE plpgsql
+AS $$
+DECLARE
+  t text;
+  i integer;
+BEGIN
+  IF partition_count < 2 THEN
+    RAISE EXCEPTION 'partition_count must be at least 2';
+  END IF;
+  FOREACH t IN ARRAY ARRAY['collections', 'collection_metadata', 'segments'] LOOP
+    IF EXISTS (SELECT 1 FROM pg_partitioned_table WHERE partrelid = format('public.%I', t)::regclass) THEN
+      RAISE EXCEPTION 'table % is already partitioned', t;
+    END IF;
+    EXECUTE format('ALTER TABLE public.%I RENAME TO %I', t, t || '_unpartitioned');
+    EXECUTE format('ALTER TABLE public.%I RENAME CONSTRAINT %I TO %I', t || '_unpartitioned', t || '_pkey', t || '_unpartitioned_pkey');
+    EXECUTE format('CREATE TABLE public.%I (LIKE public.%I INCLUDING DEFAULTS) PARTITION BY HASH (tenant_id)', t, t || '_unpartitioned');
+    FOR i IN 0..partition_count - 1 LOOP
+      EXECUTE format('CREATE TABLE public.%I PARTITION OF public.%I FOR VALUES WITH (MODULUS %s, REMAINDER %s)', t || '_p' || i, t, partition_count, i);
+    END LOOP;
+    EXECUTE format('INSERT INTO public.%I SELECT * FROM public.%I', t, t || '_unpartitioned');
+    EXECUTE format('DROP TABLE public.%I', t || '_unpartitioned');
+  END LOOP;
+  ALTER TABLE "public"."collections" ADD CONSTRAINT "collections_pkey" PRIMARY KEY ("tenant_id", "id");
+  CREATE UNIQUE INDEX "idx_name" ON "public"."collections" ("tenant_id", "name", "database_id");
+  CREATE INDEX "idx_database_total_records" ON "public"."collections" ("database_id", "total_records_post_compaction" DESC);
+  CREATE INDEX "idx_database_size_bytes" ON "public"."collections" ("database_id", "size_bytes_post_compaction" DESC);
+  ALTER TABLE "public"."collection_metadata" ADD CONSTRAINT "collection_metadata_pkey" PRIMARY KEY ("tenant_id", "collection_id", "key");
+  ALTER TABLE "public"."segments" ADD CONSTRAINT "segments_pkey" PRIMARY KEY ("tenant_id", "collection_id", "id");
+END;
+$$;
@@ -0,0 +1,11 @@
+-- Create "schema_backfills" table
+CREATE TABLE "public"."schema_backfills" (
+  "name" text NOT NULL,
+  "target_table" text NOT NULL,
+  "target_column" text NOT NULL,
+  "phase" text NOT NULL,
+  "rows_backfilled" bigint NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("name")
+);
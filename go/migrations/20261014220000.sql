@@ -0,0 +1,9 @@
+-- Create "leases" table
+CREATE TABLE "public"."leases" (
+  "name" text NOT NULL,
+  "holder" text NOT NULL,
+  "address" text NOT NULL DEFAULT '',
+  "epoch" bigint NOT NULL DEFAULT 0,
+  "expires_at" timestamptz NOT NULL,
+  PRIMARY KEY ("name")
+);
@@ -0,0 +1,10 @@
+-- Create "collection_lifecycle_counts" table
+CREATE TABLE "public"."collection_lifecycle_counts" (
+  "tenant_id" text NOT NULL,
+  "day" date NOT NULL,
+  "created" bigint NOT NULL DEFAULT 0,
+  "deleted" bigint NOT NULL DEFAULT 0,
+  "restored" bigint NOT NULL DEFAULT 0,
+  "purged" bigint NOT NULL DEFAULT 0,
+  PRIMARY KEY ("tenant_id", "day")
+);
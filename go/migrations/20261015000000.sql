@@ -0,0 +1,10 @@
+-- Create "collection_acls" table
+CREATE TABLE "public"."collection_acls" (
+  "collection_id" uuid NOT NULL,
+  "principal" text NOT NULL,
+  "permissions" integer NOT NULL DEFAULT 0,
+  "tenant_id" text NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("collection_id", "principal")
+);
@@ -0,0 +1,4 @@
+-- Modify "tenants" table
+ALTER TABLE "public"."tenants" ADD COLUMN "kms_key_id" text NULL;
+-- Modify "collections" table
+ALTER TABLE "public"."collections" ADD COLUMN "kms_key_id" text NULL;
@@ -0,0 +1,10 @@
+-- Create "tenant_quotas" table
+CREATE TABLE "public"."tenant_quotas" (
+  "tenant_id" text NOT NULL,
+  "max_collections" bigint NULL,
+  "max_records" bigint NULL,
+  "max_size_bytes" bigint NULL,
+  "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("tenant_id")
+);
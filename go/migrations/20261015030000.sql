@@ -0,0 +1,19 @@
+-- Create "usage_counters" table
+CREATE TABLE "public"."usage_counters" (
+  "tenant_id" text NOT NULL,
+  "database_id" text NOT NULL,
+  "record_count" bigint NOT NULL DEFAULT 0,
+  "size_bytes" bigint NOT NULL DEFAULT 0,
+  "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("tenant_id", "database_id")
+);
+-- Backfill the counters of the live collections
+INSERT INTO "public"."usage_counters" ("tenant_id", "database_id", "record_count", "size_bytes")
+SELECT "databases"."tenant_id", "collections"."database_id"::text, SUM("collections"."total_records_post_compaction"), SUM("collections"."size_bytes_post_compaction")
+FROM "public"."collections" INNER JOIN "public"."databases" ON "collections"."database_id" = "databases"."id"
+WHERE "collections"."is_deleted" = false
+GROUP BY "databases"."tenant_id", "collections"."database_id";
+INSERT INTO "public"."usage_counters" ("tenant_id", "database_id", "record_count", "size_bytes")
+SELECT "tenant_id", '', SUM("record_count"), SUM("size_bytes")
+FROM "public"."usage_counters"
+GROUP BY "tenant_id";
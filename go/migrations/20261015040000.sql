@@ -0,0 +1,10 @@
+-- Create "collection_log_routes" table
+CREATE TABLE "public"."collection_log_routes" (
+  "collection_id" uuid NOT NULL,
+  "log_address" text NOT NULL DEFAULT '',
+  "version" bigint NOT NULL DEFAULT 0,
+  "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("collection_id")
+);
+-- Create index "idx_collection_log_routes_updated_at" to table: "collection_log_routes"
+CREATE INDEX "idx_collection_log_routes_updated_at" ON "public"."collection_log_routes" ("updated_at");
@@ -0,0 +1,2 @@
+-- Modify "collections" table
+ALTER TABLE "public"."collections" ADD COLUMN "last_compactor" text NULL, ADD COLUMN "last_compacted_at" timestamptz NULL;
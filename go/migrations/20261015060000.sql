@@ -0,0 +1,10 @@
+-- Create "compaction_requests" table
+CREATE TABLE "public"."compaction_requests" (
+  "collection_id" uuid NOT NULL,
+  "tenant_id" text NOT NULL DEFAULT '',
+  "requested_by" text NOT NULL DEFAULT '',
+  "requested_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("collection_id")
+);
+-- Create index "idx_compaction_requests_requested_at" to table: "compaction_requests"
+CREATE INDEX "idx_compaction_requests_requested_at" ON "public"."compaction_requests" ("requested_at");
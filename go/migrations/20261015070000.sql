@@ -0,0 +1,10 @@
+-- Create "database_quotas" table
+CREATE TABLE "public"."database_quotas" (
+  "database_id" uuid NOT NULL,
+  "max_collections" bigint NULL,
+  "max_records" bigint NULL,
+  "max_size_bytes" bigint NULL,
+  "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("database_id")
+);
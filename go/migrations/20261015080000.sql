@@ -0,0 +1,15 @@
+-- Create "segment_files" table
+CREATE TABLE "public"."segment_files" (
+  "segment_id" uuid NOT NULL,
+  "path" text NOT NULL,
+  "collection_id" uuid NOT NULL,
+  "tenant_id" text NOT NULL DEFAULT '',
+  "file_type" text NOT NULL DEFAULT '',
+  "content_hash" text NULL,
+  "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("segment_id", "path")
+);
+-- Create index "idx_segment_files_collection_id" to table: "segment_files"
+CREATE INDEX "idx_segment_files_collection_id" ON "public"."segment_files" ("collection_id");
+-- Create index "idx_segment_files_content_hash" to table: "segment_files"
+CREATE INDEX "idx_segment_files_content_hash" ON "public"."segment_files" ("content_hash");
@@ -0,0 +1,2 @@
+-- Modify "segment_files" table
+ALTER TABLE "public"."segment_files" ADD COLUMN "size_bytes" bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,11 @@
+-- Create "feature_flags" table
+CREATE TABLE "public"."feature_flags" (
+  "name" text NOT NULL,
+  "tenant_id" text NOT NULL DEFAULT '',
+  "enabled" boolean NOT NULL DEFAULT false,
+  "rollout_percentage" integer NOT NULL DEFAULT 100,
+  "description" text NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("name", "tenant_id")
+);
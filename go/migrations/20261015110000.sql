@@ -0,0 +1,42 @@
+-- Modify "archived_collections" table
+ALTER TABLE "public"."archived_collections" ADD COLUMN "cluster_id" text NOT NULL DEFAULT '';
+-- Modify "archived_segments" table
+ALTER TABLE "public"."archived_segments" ADD COLUMN "cluster_id" text NOT NULL DEFAULT '';
+-- Modify "collections" table
+ALTER TABLE "public"."collections" ADD COLUMN "cluster_id" text NOT NULL DEFAULT '';
+-- Modify "collection_acls" table
+ALTER TABLE "public"."collection_acls" ADD COLUMN "cluster_id" text NOT NULL DEFAULT '';
+-- Modify "collection_lifecycle_counts" table
+ALTER TABLE "public"."collection_lifecycle_counts" ADD COLUMN "cluster_id" text NOT NULL DEFAULT '', DROP CONSTRAINT "collection_lifecycle_counts_pkey", ADD PRIMARY KEY ("cluster_id", "tenant_id", "day");
+-- Modify "collection_log_routes" table
+ALTER TABLE "public"."collection_log_routes" ADD COLUMN "cluster_id" text NOT NULL DEFAULT '';
+-- Modify "collection_metadata" table
+ALTER TABLE "public"."collection_metadata" ADD COLUMN "cluster_id" text NOT NULL DEFAULT '';
+-- Modify "compaction_requests" table
+ALTER TABLE "public"."compaction_requests" ADD COLUMN "cluster_id" text NOT NULL DEFAULT '';
+-- Modify "databases" table
+ALTER TABLE "public"."databases" ADD COLUMN "cluster_id" text NOT NULL DEFAULT '';
+-- Drop index "idx_tenantid_name" from table: "databases"
+DROP INDEX "public"."idx_tenantid_name";
+-- Create index "idx_tenantid_name" to table: "databases"
+CREATE UNIQUE INDEX "idx_tenantid_name" ON "public"."databases" ("cluster_id", "name", "tenant_id");
+-- Modify "database_quotas" table
+ALTER TABLE "public"."database_quotas" ADD COLUMN "cluster_id" text NOT NULL DEFAULT '';
+-- Modify "feature_flags" table
+ALTER TABLE "public"."feature_flags" ADD COLUMN "cluster_id" text NOT NULL DEFAULT '', DROP CONSTRAINT "feature_flags_pkey", ADD PRIMARY KEY ("cluster_id", "name", "tenant_id");
+-- Modify "leases" table
+ALTER TABLE "public"."leases" ADD COLUMN "cluster_id" text NOT NULL DEFAULT '', DROP CONSTRAINT "leases_pkey", ADD PRIMARY KEY ("cluster_id", "name");
+-- Modify "notifications" table
+ALTER TABLE "public"."notifications" ADD COLUMN "cluster_id" text NOT NULL DEFAULT '';
+-- Modify "segments" table
+ALTER TABLE "public"."segments" ADD COLUMN "cluster_id" text NOT NULL DEFAULT '';
+-- Modify "segment_files" table
+ALTER TABLE "public"."segment_files" ADD COLUMN "cluster_id" text NOT NULL DEFAULT '';
+-- Modify "segment_metadata" table
+ALTER TABLE "public"."segment_metadata" ADD COLUMN "cluster_id" text NOT NULL DEFAULT '';
+-- Modify "tenants" table
+ALTER TABLE "public"."tenants" ADD COLUMN "cluster_id" text NOT NULL DEFAULT '', DROP CONSTRAINT "tenants_pkey", ADD PRIMARY KEY ("cluster_id", "id");
+-- Modify "tenant_quotas" table
+ALTER TABLE "public"."tenant_quotas" ADD COLUMN "cluster_id" text NOT NULL DEFAULT '', DROP CONSTRAINT "tenant_quotas_pkey", ADD PRIMARY KEY ("cluster_id", "tenant_id");
+-- Modify "usage_counters" table
+ALTER TABLE "public"."usage_counters" ADD COLUMN "cluster_id" text NOT NULL DEFAULT '', DROP CONSTRAINT "usage_counters_pkey", ADD PRIMARY KEY ("cluster_id", "tenant_id", "database_id");
@@ -0,0 +1,8 @@
+-- Create "tenant_regions" table
+CREATE TABLE "public"."tenant_regions" (
+  "cluster_id" text NOT NULL DEFAULT '',
+  "tenant_id" text NOT NULL,
+  "region" text NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("cluster_id", "tenant_id", "region")
+);
@@ -0,0 +1,2 @@
+-- Modify "collections" table
+ALTER TABLE "public"."collections" ADD COLUMN "replication_factor" integer NOT NULL DEFAULT 1;
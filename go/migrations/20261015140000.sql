@@ -0,0 +1,9 @@
+-- Create "node_decommissions" table
+CREATE TABLE "public"."node_decommissions" (
+  "cluster_id" text NOT NULL DEFAULT '',
+  "node_id" text NOT NULL,
+  "drain_until" timestamptz NOT NULL,
+  "collections" bigint NOT NULL DEFAULT 0,
+  "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("cluster_id", "node_id")
+);
@@ -0,0 +1,7 @@
+-- Create "node_heartbeats" table
+CREATE TABLE "public"."node_heartbeats" (
+  "cluster_id" text NOT NULL DEFAULT '',
+  "node_id" text NOT NULL,
+  "last_seen" timestamptz NOT NULL,
+  PRIMARY KEY ("cluster_id", "node_id")
+);
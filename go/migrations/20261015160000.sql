@@ -0,0 +1,17 @@
+-- Create "collection_stats" table
+CREATE TABLE "public"."collection_stats" (
+  "cluster_id" text NOT NULL DEFAULT '',
+  "collection_id" uuid NOT NULL,
+  "tenant_id" text NOT NULL,
+  "database_name" text NOT NULL,
+  "total_records" bigint NOT NULL DEFAULT 0,
+  "size_bytes" bigint NOT NULL DEFAULT 0,
+  "segment_count" integer NOT NULL DEFAULT 0,
+  "last_compacted_at" timestamptz NULL,
+  "uncompacted_records" bigint NULL,
+  "oldest_uncompacted_at" timestamptz NULL,
+  "refreshed_at" timestamptz NOT NULL,
+  PRIMARY KEY ("cluster_id", "collection_id")
+);
+-- Create index "idx_collection_stats_database" to table: "collection_stats"
+CREATE INDEX "idx_collection_stats_database" ON "public"."collection_stats" ("tenant_id", "database_name");
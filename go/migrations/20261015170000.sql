@@ -0,0 +1,12 @@
+-- Create "collection_size_history" table
+CREATE TABLE "public"."collection_size_history" (
+  "cluster_id" text NOT NULL DEFAULT '',
+  "collection_id" uuid NOT NULL,
+  "day" date NOT NULL,
+  "tenant_id" text NOT NULL,
+  "total_records" bigint NOT NULL DEFAULT 0,
+  "size_bytes" bigint NOT NULL DEFAULT 0,
+  PRIMARY KEY ("cluster_id", "collection_id", "day")
+);
+-- Create index "idx_collection_size_history_day" to table: "collection_size_history"
+CREATE INDEX "idx_collection_size_history_day" ON "public"."collection_size_history" ("day");
@@ -0,0 +1,2 @@
+-- Modify "collection_lifecycle_counts" table
+ALTER TABLE "public"."collection_lifecycle_counts" ADD COLUMN "compacted" bigint NOT NULL DEFAULT 0, ADD COLUMN "records_compacted" bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,13 @@
+-- Create "cleanup_dead_letters" table
+CREATE TABLE "public"."cleanup_dead_letters" (
+  "cluster_id" text NOT NULL DEFAULT '',
+  "collection_id" uuid NOT NULL,
+  "task" text NOT NULL,
+  "tenant_id" text NOT NULL DEFAULT '',
+  "attempts" integer NOT NULL,
+  "last_error" text NOT NULL,
+  "first_failed_at" timestamptz NOT NULL,
+  "last_failed_at" timestamptz NOT NULL,
+  "dead_lettered" boolean NOT NULL DEFAULT false,
+  PRIMARY KEY ("cluster_id", "collection_id", "task")
+);
@@ -0,0 +1,10 @@
+-- Create "collection_metadata_history" table
+CREATE TABLE "public"."collection_metadata_history" (
+  "cluster_id" text NOT NULL DEFAULT '',
+  "collection_id" uuid NOT NULL,
+  "revision" bigint NOT NULL,
+  "tenant_id" text NOT NULL,
+  "metadata" jsonb NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("cluster_id", "collection_id", "revision")
+);
@@ -0,0 +1,6 @@
+-- Modify "collections" table
+ALTER TABLE "public"."collections" ADD COLUMN "distance_function" text NOT NULL DEFAULT 'l2';
+-- Backfill the distance function from the HNSW space of the collection
+UPDATE "public"."collections" SET "distance_function" = "collection_metadata"."str_value" FROM "public"."collection_metadata" WHERE "collection_metadata"."collection_id" = "collections"."id" AND "collection_metadata"."key" = 'hnsw:space' AND "collection_metadata"."str_value" IN ('l2', 'cosine', 'ip');
+-- Create index "idx_distance_function_dimension" to table: "collections"
+CREATE INDEX "idx_distance_function_dimension" ON "public"."collections" ("distance_function", "dimension");
@@ -0,0 +1,19 @@
+-- Create "embedding_functions" table
+CREATE TABLE "public"."embedding_functions" (
+  "id" uuid NOT NULL,
+  "cluster_id" text NOT NULL DEFAULT '',
+  "tenant_id" text NOT NULL,
+  "name" text NOT NULL,
+  "provider" text NOT NULL,
+  "model" text NOT NULL DEFAULT '',
+  "params" jsonb NOT NULL DEFAULT '{}',
+  "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_embedding_function_tenant_name" to table: "embedding_functions"
+CREATE UNIQUE INDEX "idx_embedding_function_tenant_name" ON "public"."embedding_functions" ("cluster_id", "tenant_id", "name");
+-- Modify "collections" table
+ALTER TABLE "public"."collections" ADD COLUMN "embedding_function_id" uuid NULL;
+-- Create index "idx_embedding_function_id" to table: "collections"
+CREATE INDEX "idx_embedding_function_id" ON "public"."collections" ("embedding_function_id");
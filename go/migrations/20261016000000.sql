@@ -0,0 +1,11 @@
+-- Create "collection_schema_fields" table
+CREATE TABLE "public"."collection_schema_fields" (
+  "cluster_id" text NOT NULL DEFAULT '',
+  "collection_id" uuid NOT NULL,
+  "name" text NOT NULL,
+  "type" text NOT NULL,
+  "indexed" boolean NOT NULL DEFAULT false,
+  "tenant_id" text NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("cluster_id", "collection_id", "name")
+);
@@ -0,0 +1,21 @@
+-- Create "collection_templates" table
+CREATE TABLE "public"."collection_templates" (
+  "id" uuid NOT NULL,
+  "cluster_id" text NOT NULL DEFAULT '',
+  "tenant_id" text NOT NULL,
+  "name" text NOT NULL,
+  "dimension" integer NULL,
+  "distance_function" text NULL,
+  "replication_factor" integer NULL,
+  "metadata" jsonb NOT NULL DEFAULT '[]',
+  "max_records" bigint NULL,
+  "max_size_bytes" bigint NULL,
+  "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_collection_template_tenant_name" to table: "collection_templates"
+CREATE UNIQUE INDEX "idx_collection_template_tenant_name" ON "public"."collection_templates" ("cluster_id", "tenant_id", "name");
+-- Modify "collections" table
+ALTER TABLE "public"."collections" ADD COLUMN "template_id" uuid NULL;
+-- Create index "idx_template_id" to table: "collections"
+CREATE INDEX "idx_template_id" ON "public"."collections" ("template_id");
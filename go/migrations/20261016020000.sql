@@ -0,0 +1,8 @@
+-- Create "maintenance_modes" table
+CREATE TABLE "public"."maintenance_modes" (
+  "cluster_id" text NOT NULL DEFAULT '',
+  "enabled" boolean NOT NULL DEFAULT false,
+  "reason" text NOT NULL DEFAULT '',
+  "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("cluster_id")
+);
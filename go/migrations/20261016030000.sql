@@ -0,0 +1,23 @@
+-- Create "jobs" table
+CREATE TABLE "public"."jobs" (
+  "id" uuid NOT NULL,
+  "cluster_id" text NOT NULL DEFAULT '',
+  "job_type" text NOT NULL,
+  "tenant_id" text NOT NULL DEFAULT '',
+  "payload" jsonb NOT NULL DEFAULT '{}',
+  "state" text NOT NULL,
+  "attempts" integer NOT NULL DEFAULT 0,
+  "max_attempts" integer NOT NULL,
+  "last_error" text NOT NULL DEFAULT '',
+  "next_run_at" timestamptz NOT NULL,
+  "locked_by" text NOT NULL DEFAULT '',
+  "locked_until" timestamptz NULL,
+  "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "finished_at" timestamptz NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idx_jobs_state_next_run_at" to table: "jobs"
+CREATE INDEX "idx_jobs_state_next_run_at" ON "public"."jobs" ("cluster_id", "state", "next_run_at");
+-- Create index "idx_jobs_tenant_id" to table: "jobs"
+CREATE INDEX "idx_jobs_tenant_id" ON "public"."jobs" ("tenant_id");
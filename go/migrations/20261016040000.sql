@@ -0,0 +1,6 @@
+-- Create index "idx_collection_metadata_tenant_key_str_value" to table: "collection_metadata"
+CREATE INDEX "idx_collection_metadata_tenant_key_str_value" ON "public"."collection_metadata" ("tenant_id", "key", (md5(str_value)));
+-- Create index "idx_collection_metadata_tenant_key_int_value" to table: "collection_metadata"
+CREATE INDEX "idx_collection_metadata_tenant_key_int_value" ON "public"."collection_metadata" ("tenant_id", "key", "int_value");
+-- Create index "idx_collection_metadata_tenant_key_float_value" to table: "collection_metadata"
+CREATE INDEX "idx_collection_metadata_tenant_key_float_value" ON "public"."collection_metadata" ("tenant_id", "key", "float_value");
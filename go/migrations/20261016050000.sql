@@ -0,0 +1,6 @@
+-- Create "collection_log_route_revisions" sequence
+CREATE SEQUENCE "public"."collection_log_route_revisions";
+-- Modify "collection_log_routes" table
+ALTER TABLE "public"."collection_log_routes" ADD COLUMN "revision" bigint NOT NULL DEFAULT 0;
+-- Create index "idx_collection_log_routes_revision" to table: "collection_log_routes"
+CREATE INDEX "idx_collection_log_routes_revision" ON "public"."collection_log_routes" ("revision");
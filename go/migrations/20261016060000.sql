@@ -0,0 +1,47 @@
+-- Replace "partition_sysdb_by_tenant" procedure
+--
+-- The indexes that are not unique are read from pg_indexes before the tables
+-- are rebuilt and created again on the partitioned tables as they were, so that
+-- the procedure keeps the indexes added by later migrations. The primary keys
+-- and the collection name index gain tenant_id, which PostgreSQL requires of
+-- unique indexes on partitioned tables.
+CREATE OR REPLACE PROCEDURE "public"."partition_sysdb_by_tenant"(partition_count integer)
+LANGUAGE plpgsql
+AS $$
+DECLARE
+  t text;
+  i integer;
+  index_definitions text[];
+  index_definition text;
+BEGIN
+  IF partition_count < 2 THEN
+    RAISE EXCEPTION 'partition_count must be at least 2';
+  END IF;
+  SELECT coalesce(array_agg(indexdef), ARRAY[]::text[]) INTO index_definitions
+  FROM pg_indexes
+  WHERE schemaname = 'public'
+    AND tablename IN ('collections', 'collection_metadata', 'segments')
+    AND indexdef NOT LIKE 'CREATE UNIQUE INDEX %';
+  FOREACH t IN ARRAY ARRAY['collections', 'collection_metadata', 'segments'] LOOP
+    IF EXISTS (SELECT 1 FROM pg_partitioned_table WHERE partrelid = format('public.%I', t)::regclass) THEN
+      RAISE EXCEPTION 'table % is already partitioned', t;
+    END IF;
+    EXECUTE format('ALTER TABLE public.%I RENAME TO %I', t, t || '_unpartitioned');
+    EXECUTE format('ALTER TABLE public.%I RENAME CONSTRAINT %I TO %I', t || '_unpartitioned', t || '_pkey', t || '_unpartitioned_pkey');
+    EXECUTE format('CREATE TABLE public.%I (LIKE public.%I INCLUDING DEFAULTS) PARTITION BY HASH (tenant_id)', t, t || '_unpartitioned');
+    FOR i IN 0..partition_count - 1 LOOP
+      EXECUTE format('CREATE TABLE public.%I PARTITION OF public.%I FOR VALUES WITH (MODULUS %s, REMAINDER %s)', t || '_p' || i, t, partition_count, i);
+    END LOOP;
+    EXECUTE format('INSERT INTO public.%I SELECT * FROM public.%I', t, t || '_unpartitioned');
+    EXECUTE format('DROP TABLE public.%I', t || '_unpartitioned');
+  END LOOP;
+  ALTER TABLE "public"."collections" ADD CONSTRAINT "collections_pkey" PRIMARY KEY ("tenant_id", "id");
+  CREATE UNIQUE INDEX "idx_name" ON "public"."collections" ("tenant_id", "name", "database_id");
+  ALTER TABLE "public"."collection_metadata" ADD CONSTRAINT "collection_metadata_pkey" PRIMARY KEY ("tenant_id", "collection_id", "key");
+  ALTER TABLE "public"."segments" ADD CONSTRAINT "segments_pkey" PRIMARY KEY ("tenant_id", "collection_id", "id");
+  -- the definitions name the tables, which are the partitioned ones by now
+  FOREACH index_definition IN ARRAY index_definitions LOOP
+    EXECUTE index_definition;
+  END LOOP;
+END;
+$$;
@@ -0,0 +1,8 @@
+// Package migrations embeds the sysdb migrations so that binaries know the
+// schema version they were built for.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,28 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+)
+
+// CollectionAlreadyExistsError is returned for a collection given the name of
+// another collection of its database. It unwraps to
+// ErrCollectionUniqueConstraintViolation.
+type CollectionAlreadyExistsError struct {
+	Name string
+}
+
+func (e *CollectionAlreadyExistsError) Error() string {
+	return fmt.Sprintf("collection %q already exists in the database", e.Name)
+}
+
+func (e *CollectionAlreadyExistsError) Unwrap() error {
+	return ErrCollectionUniqueConstraintViolation
+}
+
+// IsCollectionAlreadyExists tells whether err is a collection name taken in
+// its database.
+func IsCollectionAlreadyExists(err error) bool {
+	var exists *CollectionAlreadyExistsError
+	return errors.As(err, &exists)
+}
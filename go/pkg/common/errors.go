@@ -8,6 +8,11 @@ var (
 	// Tenant errors
 	ErrTenantNotFound                  = errors.New("tenant not found")
 	ErrTenantUniqueConstraintViolation = errors.New("tenant unique constraint violation")
+	ErrTenantNotEmpty                  = errors.New("tenant has live databases, use force to delete them")
+	ErrTenantBatchTooLarge             = errors.New("too many tenants in batch")
+	ErrCountCollectionsTenantRequired  = errors.New("tenant is required to count collections")
+	ErrDeleteTenantWithProgressAsync   = errors.New("DeleteTenantWithProgress deletes the tenant within the call, async is not supported")
+	ErrInvalidTenantBatch              = errors.New("tenants of a batch need distinct names")
 
 	// Database errors
 	ErrDatabaseNotFound                  = errors.New("database not found")
@@ -22,10 +27,39 @@ var (
 	ErrCollectionLogPositionStale            = errors.New("collection log position Stale")
 	ErrCollectionVersionStale                = errors.New("collection version stale")
 	ErrCollectionVersionInvalid              = errors.New("collection version invalid")
+	ErrCollectionFencingTokenStale           = errors.New("collection fencing token stale")
+	ErrCollectionRevisionMismatch            = errors.New("collection revision does not match the expected revision")
+	ErrCollectionRevisionNotInHistory        = errors.New("collection revision is not in the metadata history")
+	ErrInvalidCollectionRevision             = errors.New("collection revision must not be negative")
+	ErrCollectionLimitExceeded               = errors.New("collection limit exceeded for database")
+	ErrInvalidCollectionSizeOrderBy          = errors.New("invalid collection size order by")
+	ErrInvalidCollectionSizeLimit            = errors.New("collection size limit must be positive")
+	ErrInvalidCollectionStatsPage            = errors.New("collection stats limit must be positive and offset must not be negative")
+	ErrInvalidCollectionSizeHistoryDays      = errors.New("collection size history days must not be negative")
+	ErrCollectionNotIncomplete               = errors.New("collection has segments and is not incomplete")
+	ErrCollectionReindexStale                = errors.New("collection dimension changed while it was reindexed")
+	ErrInvalidCollectionsToGcFilter          = errors.New("collections to gc filter must not be negative")
+	ErrCollectionBatchTooLarge               = errors.New("too many collections in batch")
+	ErrNoQueryNodes                          = errors.New("no query node to place the collection on")
+	ErrInvalidSimulatedMemberlist            = errors.New("simulated memberlist must list at least one query node and no empty node id")
+	ErrInvalidScanBatchSize                  = errors.New("scan batch size must be between 1 and 1000")
+	ErrScanSnapshotExpired                   = errors.New("collection scan snapshot expired, restart the scan")
 
 	// Collection metadata errors
 	ErrUnknownCollectionMetadataType = errors.New("collection metadata value type not supported")
 	ErrInvalidMetadataUpdate         = errors.New("invalid metadata update, reest metadata true and metadata value not empty")
+	ErrMetadataTooManyKeys           = errors.New("metadata has too many keys")
+	ErrMetadataKeyTooLong            = errors.New("metadata key is too long")
+	ErrMetadataTooLarge              = errors.New("metadata serialized size is too large")
+	ErrInvalidMetadataFilter         = errors.New("invalid collection metadata filter")
+	ErrInvalidMetadataPatch          = errors.New("metadata patch is empty or sets and deletes the same key")
+	ErrInvalidFieldMask              = errors.New("field mask names an unknown collection field")
+	ErrMinVersionWithoutID           = errors.New("min version requires a collection id")
+	ErrCollectionIDsWithFilters      = errors.New("ids cannot be combined with id, name, paging, filters or min version")
+
+	// Collection configuration errors
+	ErrInvalidCollectionConfiguration = errors.New("invalid collection configuration")
+	ErrInvalidReplicationFactor       = errors.New("replication factor must be at least 1 and at most the configured maximum")
 
 	// Segment errors
 	ErrSegmentIDFormat                  = errors.New("segment id format error")
@@ -33,7 +67,111 @@ var (
 	ErrSegmentUniqueConstraintViolation = errors.New("unique constraint violation")
 	ErrSegmentDeleteNonExistingSegment  = errors.New("delete non existing segment")
 	ErrSegmentUpdateNonExistingSegment  = errors.New("update non existing segment")
+	ErrSegmentCollectionMismatch        = errors.New("segment belongs to a different collection")
+	ErrSegmentFileInfoMismatch          = errors.New("content hashes and sizes must match the file paths")
+
+	// Transaction errors
+	ErrTransactionConflict = errors.New("transaction conflict")
 
 	// Segment metadata errors
 	ErrUnknownSegmentMetadataType = errors.New("segment metadata value type not supported")
+
+	// Confirmation errors
+	ErrUnknownDestructiveOperation = errors.New("unknown destructive operation")
+	ErrInvalidConfirmationToken    = errors.New("confirmation token is invalid or expired")
+
+	// Maintenance errors
+	ErrUnknownMaintenanceTable  = errors.New("table is not maintained by the maintenance job")
+	ErrInvalidMaintenanceWindow = errors.New("maintenance window start hour must be between 0 and 23")
+
+	// Lifecycle errors
+	ErrUnknownLifecycleEvent = errors.New("unknown collection lifecycle event")
+
+	// Collection ACL errors
+	ErrUnknownCollectionPermission = errors.New("unknown collection permission")
+	ErrInvalidCollectionAclEntry   = errors.New("collection acl entry needs a principal and at least one permission")
+
+	// Quota errors
+	ErrInvalidTenantQuota = errors.New("quota limits must not be negative")
+
+	// Collection schema errors
+	ErrInvalidCollectionSchema       = errors.New("collection schema fields need distinct non-empty names and a known type")
+	ErrSchemaValidationBatchTooLarge = errors.New("too many metadata to validate in batch")
+
+	// Embedding function errors
+	ErrEmbeddingFunctionNotFound                  = errors.New("embedding function not found")
+	ErrEmbeddingFunctionIDFormat                  = errors.New("embedding function id format error")
+	ErrEmbeddingFunctionUniqueConstraintViolation = errors.New("embedding function unique constraint violation")
+	ErrEmbeddingFunctionInUse                     = errors.New("embedding function is referenced by collections")
+	ErrInvalidEmbeddingFunction                   = errors.New("embedding function needs a name, a provider and params that are a JSON object")
+	ErrInvalidEmbeddingFunctionUpdate             = errors.New("embedding function id and reset embedding function cannot both be set")
+
+	// Collection template errors
+	ErrCollectionTemplateNotFound                  = errors.New("collection template not found")
+	ErrCollectionTemplateIDFormat                  = errors.New("collection template id format error")
+	ErrCollectionTemplateUniqueConstraintViolation = errors.New("collection template unique constraint violation")
+	ErrInvalidCollectionTemplate                   = errors.New("collection template needs a tenant, a name and quota limits that are not negative")
+
+	// Feature flag errors
+	ErrInvalidFeatureFlag  = errors.New("feature flag needs a name and a rollout percentage between 0 and 100")
+	ErrFeatureFlagNotFound = errors.New("feature flag not found")
+
+	// Pagination errors
+	ErrInvalidPageToken   = errors.New("page token is invalid or was returned for another query")
+	ErrPageTokenWithStart = errors.New("page_token cannot be combined with offset or start_after")
+
+	// Maintenance mode errors
+	ErrMaintenanceReasonRequired = errors.New("maintenance mode needs a reason to be enabled")
+
+	// Tenant placement errors
+	ErrInvalidTenantPlacement = errors.New("allowed regions must be non-empty names without whitespace")
+
+	// Tenant activity errors
+	ErrInvalidTenantActivityWindow = errors.New("tenant activity window must be between 1 and 366 days")
+
+	// Soft delete cleaner errors
+	ErrSoftDeleteCleanerDisabled = errors.New("soft delete cleaner is disabled, set a soft delete retention")
+	ErrSoftDeleteCleanerRunning  = errors.New("soft delete cleaner is already running")
+	ErrInvalidSoftDeleteLimit    = errors.New("soft delete cleaner limit must not be negative")
+	ErrCleanupDeadLetterNotFound = errors.New("cleanup task has no failure recorded for the collection")
+	ErrUnknownCleanupTask        = errors.New("unknown cleanup task")
+
+	// Job queue errors
+	ErrJobNotFound     = errors.New("job not found")
+	ErrJobIDFormat     = errors.New("job id format error")
+	ErrJobLeaseLost    = errors.New("job is no longer held by this worker")
+	ErrJobFinished     = errors.New("job is already finished")
+	ErrInvalidJobState = errors.New("unknown job state")
+	ErrInvalidJobPage  = errors.New("job page limit must be between 1 and 1000 and offset must not be negative")
+	// ErrJobWorkersDisabled rejects the operations run as jobs when no worker
+	// would run them.
+	ErrJobWorkersDisabled = errors.New("job workers are disabled, set a job worker concurrency")
+
+	// Node decommission errors
+	ErrNodeNotFound             = errors.New("node is not a member of the query memberlist")
+	ErrNodeDecommissionNotFound = errors.New("node is not being decommissioned")
+	ErrLastQueryNode            = errors.New("cannot decommission the last query node in service")
+
+	// Node heartbeat errors
+	ErrInvalidNodeID = errors.New("node id must not be empty")
+
+	// Log routing errors
+	ErrInvalidLogAddress       = errors.New("log address must be host:port")
+	ErrLogServiceNotConfigured = errors.New("log service address is not configured")
+
+	// Migration errors
+	ErrInvalidMigrationIdentifier = errors.New("invalid expand/contract migration")
+	ErrMigrationPhaseOrder        = errors.New("expand/contract migration phases must run in order")
+
+	// Object storage errors
+	ErrObjectNotFound             = errors.New("object not found")
+	ErrObjectStoreNoBucket        = errors.New("object store bucket is not configured")
+	ErrInvalidObjectPartSize      = errors.New("multipart part size must be at least 5 MiB")
+	ErrObjectStoreNoEndpoint      = errors.New("object store endpoint is not configured")
+	ErrUnknownObjectStoreProvider = errors.New("unknown object store provider")
+	ErrInvalidObjectStoreCACert   = errors.New("object store CA certificate file has no PEM certificate")
+
+	// Write hook errors
+	ErrUnknownPreWriteHook   = errors.New("unknown pre-write hook")
+	ErrUnknownPostCommitHook = errors.New("unknown post-commit hook")
 )
@@ -0,0 +1,92 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// NameKind is the kind of entity a name is given to.
+type NameKind string
+
+const (
+	NameKindTenant     NameKind = "tenant"
+	NameKindDatabase   NameKind = "database"
+	NameKindCollection NameKind = "collection"
+)
+
+var (
+	ErrNameEmpty    = errors.New("name is empty")
+	ErrNameTooLong  = errors.New("name is too long")
+	ErrNameReserved = errors.New("name starts with a reserved prefix")
+	ErrNameInvalid  = errors.New("name does not match the naming pattern")
+)
+
+// NameError is returned for a name that a NamingPolicy rejects. Err is one of
+// ErrNameEmpty, ErrNameTooLong, ErrNameReserved and ErrNameInvalid, except for
+// an empty collection name, which is ErrCollectionNameEmpty.
+type NameError struct {
+	Kind NameKind
+	Name string
+	Err  error
+}
+
+func (e *NameError) Error() string {
+	return fmt.Sprintf("invalid %s name %q: %v", e.Kind, e.Name, e.Err)
+}
+
+func (e *NameError) Unwrap() error {
+	return e.Err
+}
+
+// IsNameError tells whether err is a name rejected by a NamingPolicy.
+func IsNameError(err error) bool {
+	var nameErr *NameError
+	return errors.As(err, &nameErr)
+}
+
+// NamingPolicy constrains the names given to new tenants, databases or
+// collections. Names are never empty, the other constraints are only enforced
+// when they are set.
+type NamingPolicy struct {
+	// ReservedPrefixes are kept for names created by chroma itself.
+	ReservedPrefixes []string
+	// Pattern must match the name, CompileNamePattern anchors it to the whole
+	// name.
+	Pattern *regexp.Regexp
+	// MaxLength is the maximum length of a name in characters.
+	MaxLength int
+}
+
+// CompileNamePattern compiles a NamingPolicy pattern so that it matches whole
+// names only. An empty pattern is nil, which allows every name.
+func CompileNamePattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	return regexp.Compile("^(?:" + pattern + ")$")
+}
+
+// Validate returns a *NameError when name is not allowed for an entity of kind.
+func (p NamingPolicy) Validate(kind NameKind, name string) error {
+	if name == "" {
+		if kind == NameKindCollection {
+			return &NameError{Kind: kind, Name: name, Err: ErrCollectionNameEmpty}
+		}
+		return &NameError{Kind: kind, Name: name, Err: ErrNameEmpty}
+	}
+	if p.MaxLength > 0 && utf8.RuneCountInString(name) > p.MaxLength {
+		return &NameError{Kind: kind, Name: name, Err: ErrNameTooLong}
+	}
+	for _, prefix := range p.ReservedPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return &NameError{Kind: kind, Name: name, Err: ErrNameReserved}
+		}
+	}
+	if p.Pattern != nil && !p.Pattern.MatchString(name) {
+		return &NameError{Kind: kind, Name: name, Err: ErrNameInvalid}
+	}
+	return nil
+}
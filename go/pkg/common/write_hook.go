@@ -0,0 +1,27 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+)
+
+// WriteRejectedError is returned for a mutating operation that a pre-write hook
+// rejected. Err is the error of the hook.
+type WriteRejectedError struct {
+	Hook string
+	Err  error
+}
+
+func (e *WriteRejectedError) Error() string {
+	return fmt.Sprintf("rejected by pre-write hook %s: %v", e.Hook, e.Err)
+}
+
+func (e *WriteRejectedError) Unwrap() error {
+	return e.Err
+}
+
+// IsWriteRejected tells whether err is an operation rejected by a pre-write hook.
+func IsWriteRejected(err error) bool {
+	var rejected *WriteRejectedError
+	return errors.As(err, &rejected)
+}
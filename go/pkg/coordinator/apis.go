@@ -2,8 +2,12 @@ package coordinator
 
 import (
 	"context"
+	"errors"
+	"math/rand"
+	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
 	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/chroma-core/chroma/go/pkg/types"
@@ -11,6 +15,50 @@ import (
 	"go.uber.org/zap"
 )
 
+const flushCompactionRetryBackoff = 10 * time.Millisecond
+
+// defaultIncompleteCollectionMinAge keeps collections whose segments are still
+// being created by a client from being reported as incomplete.
+const defaultIncompleteCollectionMinAge = 10 * time.Minute
+
+// defaultInconsistentCollectionMinAge gives the delete notification of a soft
+// deleted collection time to clean up its segments before it is reported.
+const defaultInconsistentCollectionMinAge = time.Hour
+
+// maxBatchUpdateCollectionMetadata, maxBatchDeleteCollections and
+// maxBatchCheckCollections are the largest number of collections a single batch
+// call may patch, delete or check, maxBatchGetCollections the largest number
+// read by ID at once, maxBatchCreateTenants the largest number of tenants a
+// single batch call may create.
+const (
+	maxBatchUpdateCollectionMetadata = 10000
+	maxBatchDeleteCollections        = 1000
+	maxBatchCheckCollections         = 1000
+	maxBatchGetCollections           = 1000
+	maxBatchCreateTenants            = 1000
+)
+
+// defaultCollectionsToGcLimit and maxCollectionsToGcLimit bound the page size of
+// GetCollectionsToGc so that a single call cannot scan the whole table.
+const (
+	defaultCollectionsToGcLimit = 100
+	maxCollectionsToGcLimit     = 1000
+)
+
+// defaultCompactionRequestsLimit and maxCompactionRequestsLimit bound the number
+// of queued collections returned by GetCompactionRequests.
+const (
+	defaultCompactionRequestsLimit = 100
+	maxCompactionRequestsLimit     = 1000
+)
+
+// defaultDuplicateSegmentFilesLimit and maxDuplicateSegmentFilesLimit bound the
+// number of content hashes returned by GetDuplicateSegmentFiles.
+const (
+	defaultDuplicateSegmentFilesLimit = 100
+	maxDuplicateSegmentFilesLimit     = 1000
+)
+
 // ICoordinator is an interface that defines the methods for interacting with the
 // Chroma Coordinator. It is designed in a way that can be run standalone without
 // spinning off the GRPC service.
@@ -18,20 +66,99 @@ type ICoordinator interface {
 	common.Component
 	ResetState(ctx context.Context) error
 	CreateCollection(ctx context.Context, createCollection *model.CreateCollection) (*model.Collection, error)
-	GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, dataName string, limit *int32, offset *int32) ([]*model.Collection, error)
+	GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, dataName string, limit *int32, offset *int32, metadataFilters []*model.CollectionMetadataFilter, fields *model.CollectionFields) ([]*model.Collection, error)
+	GetCollectionsByIDs(ctx context.Context, collectionIDs []types.UniqueID, fields *model.CollectionFields) ([]*model.Collection, error)
+	WaitForCollectionVersion(ctx context.Context, collectionID types.UniqueID, minVersion int32) error
 	DeleteCollection(ctx context.Context, deleteCollection *model.DeleteCollection) error
-	UpdateCollection(ctx context.Context, updateCollection *model.UpdateCollection) (*model.Collection, error)
+	UpdateCollection(ctx context.Context, updateCollection *model.UpdateCollection) (*model.Collection, []string, error)
+	RenameCollection(ctx context.Context, rename *model.RenameCollection) (*model.Collection, error)
+	GetCollectionMetadataDiff(ctx context.Context, collectionID types.UniqueID, revision int64) (*model.CollectionMetadataDiff, error)
+	SetCollectionAclEntry(ctx context.Context, entry *model.CollectionAclEntry) error
+	DeleteCollectionAclEntry(ctx context.Context, collectionID types.UniqueID, principal string) error
+	GetCollectionAcl(ctx context.Context, collectionID types.UniqueID) ([]*model.CollectionAclEntry, error)
+	DeleteCollections(ctx context.Context, tenantID string, databaseName string, collectionIDs []types.UniqueID, actor string) ([]*model.CollectionBatchResult, error)
+	RestoreCollection(ctx context.Context, collectionID types.UniqueID, name *string) (*model.Collection, error)
+	BatchUpdateCollectionMetadata(ctx context.Context, collectionIDs []types.UniqueID, patch *model.CollectionMetadataPatch) ([]*model.CollectionBatchResult, error)
+	GetIncompleteCollections(ctx context.Context, minAge time.Duration) ([]*model.IncompleteCollection, error)
+	RepairIncompleteCollection(ctx context.Context, collectionID types.UniqueID, actor string) error
+	GetInconsistentCollections(ctx context.Context, minAge time.Duration) ([]*model.InconsistentCollection, error)
+	CheckConsistencyWithProgress(ctx context.Context, minAge time.Duration, report func(*model.OperationProgress, []*model.InconsistentCollection) error) error
+	CompleteCollectionReindex(ctx context.Context, collectionID types.UniqueID, dimension *int32) error
 	CreateSegment(ctx context.Context, createSegment *model.CreateSegment) error
-	GetSegments(ctx context.Context, segmentID types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID) ([]*model.Segment, error)
-	DeleteSegment(ctx context.Context, segmentID types.UniqueID) error
+	GetSegments(ctx context.Context, segmentID types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID, limit *int32, offset *int32) ([]*model.Segment, error)
+	DeleteSegment(ctx context.Context, segmentID types.UniqueID, reason string, actor string) error
 	UpdateSegment(ctx context.Context, updateSegment *model.UpdateSegment) (*model.Segment, error)
 	CreateDatabase(ctx context.Context, createDatabase *model.CreateDatabase) (*model.Database, error)
 	GetDatabase(ctx context.Context, getDatabase *model.GetDatabase) (*model.Database, error)
 	CreateTenant(ctx context.Context, createTenant *model.CreateTenant) (*model.Tenant, error)
+	BatchCreateTenants(ctx context.Context, tenants []*model.BatchCreateTenant) ([]*model.TenantBatchResult, error)
 	GetTenant(ctx context.Context, getTenant *model.GetTenant) (*model.Tenant, error)
+	DeleteTenant(ctx context.Context, deleteTenant *model.DeleteTenant) error
+	DeleteTenantAsync(ctx context.Context, deleteTenant *model.DeleteTenant) (*model.Job, error)
+	DeleteTenantWithProgress(ctx context.Context, deleteTenant *model.DeleteTenant, report func(*model.OperationProgress) error) error
+	GetJob(ctx context.Context, jobID types.UniqueID) (*model.Job, error)
+	ListJobs(ctx context.Context, filter *model.JobFilter) ([]*model.Job, error)
+	CancelJob(ctx context.Context, jobID types.UniqueID) (*model.Job, error)
+	RequestConfirmationToken(ctx context.Context, operation model.DestructiveOperation, target string) (*model.ConfirmationToken, error)
 	SetTenantLastCompactionTime(ctx context.Context, tenantID string, lastCompactionTime int64) error
 	GetTenantsLastCompactionTime(ctx context.Context, tenantIDs []string) ([]*dbmodel.Tenant, error)
 	FlushCollectionCompaction(ctx context.Context, flushCollectionCompaction *model.FlushCollectionCompaction) (*model.FlushCollectionInfo, error)
+	AcquireCollectionFencingToken(ctx context.Context, collectionID types.UniqueID, owner string) (int64, error)
+	GetCollectionsBySize(ctx context.Context, tenantID string, databaseName string, orderBy model.CollectionSizeOrderBy, limit int32) ([]*model.CollectionSize, error)
+	ListCollectionStats(ctx context.Context, tenantID string, databaseName string, limit int32, offset int32) ([]*model.CollectionStats, error)
+	GetCollectionSizeHistory(ctx context.Context, collectionID types.UniqueID, days int32) ([]*model.CollectionSizeSnapshot, error)
+	GetApproximateCounts(ctx context.Context, tenantID string, databaseName string) (*model.ApproximateCounts, error)
+	CountCollections(ctx context.Context, tenantID string, databaseName string, includeSoftDeleted bool) (int64, error)
+	ScanCollections(ctx context.Context, cursor *model.CollectionScanCursor, batchSize int32, send func([]*model.Collection, *model.CollectionScanCursor) error) error
+	GetCollectionsToGc(ctx context.Context, filter *model.CollectionsToGcFilter) ([]*model.CollectionToGc, *types.UniqueID, error)
+	SetTenantQuota(ctx context.Context, quota *model.TenantQuota) error
+	GetTenantQuota(ctx context.Context, tenantID string) (*model.TenantQuota, error)
+	CheckQuota(ctx context.Context, tenantID string, databaseName string, delta *model.QuotaDelta) (*model.QuotaCheck, error)
+	SetDatabaseQuota(ctx context.Context, quota *model.DatabaseQuota) error
+	GetEffectiveQuota(ctx context.Context, tenantID string, databaseName string) (*model.EffectiveQuota, error)
+	SetTenantPlacement(ctx context.Context, placement *model.TenantPlacement) error
+	GetTenantPlacement(ctx context.Context, tenantID string) (*model.TenantPlacement, error)
+	GetTenantActivity(ctx context.Context, tenantID string, days int32) ([]*model.TenantActivity, *model.TenantActivity, error)
+	GetSoftDeleteCleanerStatus(ctx context.Context) *model.SoftDeleteCleanerStatus
+	RunSoftDeleteCleaner(ctx context.Context, limit int32) (*model.SoftDeleteCleanerRun, error)
+	ListCleanupDeadLetters(ctx context.Context, task string, deadLetteredOnly bool) ([]*model.CleanupDeadLetter, error)
+	DeleteCleanupDeadLetter(ctx context.Context, collectionID types.UniqueID, task string) error
+	SetFeatureFlag(ctx context.Context, flag *model.FeatureFlag) error
+	DeleteFeatureFlag(ctx context.Context, name string, tenantID string) error
+	ListFeatureFlags(ctx context.Context) ([]*model.FeatureFlag, error)
+	IsFeatureEnabled(ctx context.Context, name string, tenantID string) bool
+	SetMaintenanceMode(ctx context.Context, enabled bool, reason string) error
+	GetMaintenanceMode(ctx context.Context) (*model.MaintenanceMode, error)
+	InMaintenance(ctx context.Context) (bool, string)
+	CreateEmbeddingFunction(ctx context.Context, embeddingFunction *model.EmbeddingFunction) (*model.EmbeddingFunction, error)
+	GetEmbeddingFunctions(ctx context.Context, id types.UniqueID, tenantID string, name *string) ([]*model.EmbeddingFunction, error)
+	UpdateEmbeddingFunction(ctx context.Context, update *model.UpdateEmbeddingFunction) (*model.EmbeddingFunction, error)
+	DeleteEmbeddingFunction(ctx context.Context, id types.UniqueID) error
+	SetCollectionSchema(ctx context.Context, collectionID types.UniqueID, fields []*model.CollectionSchemaField) error
+	GetCollectionSchema(ctx context.Context, collectionID types.UniqueID) ([]*model.CollectionSchemaField, error)
+	ValidateCollectionMetadata(ctx context.Context, collectionID types.UniqueID, metadatas []*model.CollectionMetadata[model.CollectionMetadataValueType]) ([]*model.CollectionSchemaViolation, error)
+	CreateCollectionTemplate(ctx context.Context, template *model.CollectionTemplate) (*model.CollectionTemplate, error)
+	GetCollectionTemplates(ctx context.Context, id types.UniqueID, tenantID string, name *string) ([]*model.CollectionTemplate, error)
+	DeleteCollectionTemplate(ctx context.Context, id types.UniqueID) error
+	CreateCollectionFromTemplate(ctx context.Context, createFromTemplate *model.CreateCollectionFromTemplate) (*model.Collection, error)
+	SetCollectionLogRoute(ctx context.Context, collectionID types.UniqueID, logAddress string) (*model.CollectionLogRoute, error)
+	ResolveCollectionLogRoutes(ctx context.Context, collectionIDs []types.UniqueID, changedAfter *int64) ([]*model.CollectionLogRoute, int64, error)
+	CheckCollections(ctx context.Context, collectionIDs []types.UniqueID) ([]*model.CollectionExistence, error)
+	RequestCompaction(ctx context.Context, collectionID types.UniqueID, actor string) error
+	GetCompactionRequests(ctx context.Context, limit int32) ([]*model.CompactionRequest, error)
+	GetDuplicateSegmentFiles(ctx context.Context, tenantID string, limit int32) ([]*model.DuplicateSegmentFiles, error)
+	GetCollectionSegmentFiles(ctx context.Context, collectionID types.UniqueID) ([]*model.SegmentFile, error)
+	GetStorageAttribution(ctx context.Context, tenantID string) (*model.StorageAttribution, error)
+	GetCollectionPlacement(ctx context.Context, collectionID types.UniqueID) (*model.CollectionPlacement, error)
+	SimulateCollectionPlacement(ctx context.Context, queryNodes []string, tenantID string, databaseName string, limit int32) (*model.PlacementSimulation, error)
+	DecommissionNode(ctx context.Context, nodeID string) (*model.NodeDecommissionProgress, error)
+	CancelNodeDecommission(ctx context.Context, nodeID string) error
+	RecordNodeHeartbeat(ctx context.Context, nodeID string) error
+	GetNodeLiveness(ctx context.Context) ([]*model.NodeLiveness, error)
+	LoadCollectionLogLag(ctx context.Context, collections []*model.Collection) error
+	IsLeader() bool
+	LeaderAddress() string
+	GetQuerySamples() []model.QuerySample
 }
 
 func (s *Coordinator) ResetState(ctx context.Context) error {
@@ -39,6 +166,12 @@ func (s *Coordinator) ResetState(ctx context.Context) error {
 }
 
 func (s *Coordinator) CreateDatabase(ctx context.Context, createDatabase *model.CreateDatabase) (*model.Database, error) {
+	if err := s.beforeWrite(ctx, &WriteRequest{Operation: WriteCreateDatabase, Tenant: createDatabase.Tenant, Database: createDatabase.Name, Request: createDatabase}); err != nil {
+		return nil, err
+	}
+	if err := s.verifyName(common.NameKindDatabase, createDatabase.Name); err != nil {
+		return nil, err
+	}
 	database, err := s.catalog.CreateDatabase(ctx, createDatabase, createDatabase.Ts)
 	if err != nil {
 		return nil, err
@@ -55,6 +188,12 @@ func (s *Coordinator) GetDatabase(ctx context.Context, getDatabase *model.GetDat
 }
 
 func (s *Coordinator) CreateTenant(ctx context.Context, createTenant *model.CreateTenant) (*model.Tenant, error) {
+	if err := s.beforeWrite(ctx, &WriteRequest{Operation: WriteCreateTenant, Tenant: createTenant.Name, Request: createTenant}); err != nil {
+		return nil, err
+	}
+	if err := s.verifyName(common.NameKindTenant, createTenant.Name); err != nil {
+		return nil, err
+	}
 	tenant, err := s.catalog.CreateTenant(ctx, createTenant, createTenant.Ts)
 	if err != nil {
 		return nil, err
@@ -62,6 +201,48 @@ func (s *Coordinator) CreateTenant(ctx context.Context, createTenant *model.Crea
 	return tenant, nil
 }
 
+// BatchCreateTenants creates the tenants, with their database and quota when
+// they are set, and returns one result per tenant. The batch is rejected as a
+// whole when a name is repeated or not allowed, or a quota limit is negative.
+func (s *Coordinator) BatchCreateTenants(ctx context.Context, tenants []*model.BatchCreateTenant) ([]*model.TenantBatchResult, error) {
+	if len(tenants) > maxBatchCreateTenants {
+		return nil, common.ErrTenantBatchTooLarge
+	}
+	if err := s.beforeWrite(ctx, &WriteRequest{Operation: WriteBatchCreateTenants, Request: tenants}); err != nil {
+		return nil, err
+	}
+	names := make(map[string]bool, len(tenants))
+	for _, tenant := range tenants {
+		if names[tenant.Name] {
+			return nil, common.ErrInvalidTenantBatch
+		}
+		names[tenant.Name] = true
+		if err := s.verifyName(common.NameKindTenant, tenant.Name); err != nil {
+			return nil, err
+		}
+		if tenant.DatabaseName != "" {
+			if err := s.verifyName(common.NameKindDatabase, tenant.DatabaseName); err != nil {
+				return nil, err
+			}
+		}
+		if tenant.Quota == nil {
+			continue
+		}
+		for _, limit := range []*int64{tenant.Quota.MaxCollections, tenant.Quota.MaxRecords, tenant.Quota.MaxSizeBytes} {
+			if limit != nil && *limit < 0 {
+				return nil, common.ErrInvalidTenantQuota
+			}
+		}
+	}
+	results := s.catalog.BatchCreateTenants(ctx, tenants)
+	for _, tenant := range tenants {
+		if tenant.Quota != nil {
+			s.quotas.invalidate(tenant.Name)
+		}
+	}
+	return results, nil
+}
+
 func (s *Coordinator) GetTenant(ctx context.Context, getTenant *model.GetTenant) (*model.Tenant, error) {
 	tenant, err := s.catalog.GetTenants(ctx, getTenant, getTenant.Ts)
 	if err != nil {
@@ -70,8 +251,63 @@ func (s *Coordinator) GetTenant(ctx context.Context, getTenant *model.GetTenant)
 	return tenant, nil
 }
 
+// DeleteTenant deletes an empty tenant. Deleting a tenant that still has databases
+// needs force and a confirmation token from RequestConfirmationToken.
+func (s *Coordinator) DeleteTenant(ctx context.Context, deleteTenant *model.DeleteTenant) error {
+	if err := s.verifyDeleteTenant(ctx, deleteTenant); err != nil {
+		return err
+	}
+	return s.catalog.DeleteTenant(ctx, deleteTenant)
+}
+
+func (s *Coordinator) verifyDeleteTenant(ctx context.Context, deleteTenant *model.DeleteTenant) error {
+	if err := s.beforeWrite(ctx, &WriteRequest{Operation: WriteDeleteTenant, Tenant: deleteTenant.Name, Request: deleteTenant}); err != nil {
+		return err
+	}
+	if deleteTenant.Force {
+		if err := s.confirmationTokens.verify(model.ForceDeleteTenant, deleteTenant.Name, deleteTenant.ConfirmationToken); err != nil {
+			log.Error("force delete tenant without a valid confirmation token", zap.String("tenant", deleteTenant.Name))
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Coordinator) RequestConfirmationToken(ctx context.Context, operation model.DestructiveOperation, target string) (*model.ConfirmationToken, error) {
+	switch operation {
+	case model.ForceDeleteTenant:
+		log.Info("issuing confirmation token", zap.Int("operation", int(operation)), zap.String("target", target))
+		return s.confirmationTokens.issue(operation, target), nil
+	default:
+		return nil, common.ErrUnknownDestructiveOperation
+	}
+}
+
 func (s *Coordinator) CreateCollection(ctx context.Context, createCollection *model.CreateCollection) (*model.Collection, error) {
 	log.Info("create collection", zap.Any("createCollection", createCollection))
+	if err := s.beforeWrite(ctx, &WriteRequest{Operation: WriteCreateCollection, Tenant: createCollection.TenantID, Database: createCollection.DatabaseName, Request: createCollection}); err != nil {
+		return nil, err
+	}
+	if err := s.verifyName(common.NameKindCollection, createCollection.Name); err != nil {
+		return nil, err
+	}
+	if err := verifyCollectionMetadata(createCollection.Metadata, s.config); err != nil {
+		return nil, err
+	}
+	if err := verifyDimension(createCollection.Dimension); err != nil {
+		return nil, err
+	}
+	if err := resolveDistanceFunction(createCollection); err != nil {
+		return nil, err
+	}
+	if err := verifyReplicationFactor(createCollection.ReplicationFactor, s.config); err != nil {
+		return nil, err
+	}
+	for _, segment := range createCollection.Segments {
+		if err := verifyCreateSegment(segment); err != nil {
+			return nil, err
+		}
+	}
 	collection, err := s.catalog.CreateCollection(ctx, createCollection, createCollection.Ts)
 	if err != nil {
 		return nil, err
@@ -79,18 +315,217 @@ func (s *Coordinator) CreateCollection(ctx context.Context, createCollection *mo
 	return collection, nil
 }
 
-func (s *Coordinator) GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32) ([]*model.Collection, error) {
-	return s.catalog.GetCollections(ctx, collectionID, collectionName, tenantID, databaseName, limit, offset)
+func (s *Coordinator) GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32, metadataFilters []*model.CollectionMetadataFilter, fields *model.CollectionFields) ([]*model.Collection, error) {
+	ctx = s.sampleQueries(ctx, "GetCollections")
+	return s.catalog.GetCollections(ctx, collectionID, collectionName, tenantID, databaseName, limit, offset, metadataFilters, fields)
+}
+
+// GetCollectionsByIDs resolves many collections at once, e.g. for a compactor
+// that needs the collections of its whole batch, instead of a GetCollections
+// per collection. Collections that do not exist are left out.
+func (s *Coordinator) GetCollectionsByIDs(ctx context.Context, collectionIDs []types.UniqueID, fields *model.CollectionFields) ([]*model.Collection, error) {
+	if len(collectionIDs) > maxBatchGetCollections {
+		return nil, common.ErrCollectionBatchTooLarge
+	}
+	ctx = s.sampleQueries(ctx, "GetCollections")
+	return s.catalog.GetCollectionsByIDs(ctx, collectionIDs, fields)
 }
 
 func (s *Coordinator) DeleteCollection(ctx context.Context, deleteCollection *model.DeleteCollection) error {
+	if err := s.beforeWrite(ctx, &WriteRequest{Operation: WriteDeleteCollection, Tenant: deleteCollection.TenantID, Database: deleteCollection.DatabaseName, Request: deleteCollection}); err != nil {
+		return err
+	}
 	return s.catalog.DeleteCollection(ctx, deleteCollection)
 }
 
-func (s *Coordinator) UpdateCollection(ctx context.Context, collection *model.UpdateCollection) (*model.Collection, error) {
+// UpdateCollection returns the updated collection and the fields whose value
+// changed, see model.CollectionFieldName.
+func (s *Coordinator) UpdateCollection(ctx context.Context, collection *model.UpdateCollection) (*model.Collection, []string, error) {
+	if err := s.beforeCollectionWrite(ctx, &WriteRequest{Operation: WriteUpdateCollection, Tenant: collection.TenantID, Database: collection.DatabaseName, CollectionID: collection.ID, Request: collection}); err != nil {
+		return nil, nil, err
+	}
+	if collection.Name != nil {
+		if err := s.verifyName(common.NameKindCollection, *collection.Name); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := verifyCollectionMetadata(collection.Metadata, s.config); err != nil {
+		return nil, nil, err
+	}
+	if err := verifyDimension(collection.Dimension); err != nil {
+		return nil, nil, err
+	}
+	if err := verifyReplicationFactor(collection.ReplicationFactor, s.config); err != nil {
+		return nil, nil, err
+	}
 	return s.catalog.UpdateCollection(ctx, collection, collection.Ts)
 }
 
+// RenameCollection renames a collection atomically. A name taken by another
+// collection of the database fails it with a *common.CollectionAlreadyExistsError,
+// also when a concurrent rename or create took the name first.
+func (s *Coordinator) RenameCollection(ctx context.Context, rename *model.RenameCollection) (*model.Collection, error) {
+	if err := s.beforeCollectionWrite(ctx, &WriteRequest{Operation: WriteRenameCollection, CollectionID: rename.ID, Request: rename}); err != nil {
+		return nil, err
+	}
+	if err := s.verifyName(common.NameKindCollection, rename.Name); err != nil {
+		return nil, err
+	}
+	return s.catalog.RenameCollection(ctx, rename)
+}
+
+// GetCollectionMetadataDiff returns what changed in the metadata of a collection
+// since revision, the revision of the collection when it was read.
+func (s *Coordinator) GetCollectionMetadataDiff(ctx context.Context, collectionID types.UniqueID, revision int64) (*model.CollectionMetadataDiff, error) {
+	if revision < 0 {
+		return nil, common.ErrInvalidCollectionRevision
+	}
+	return s.catalog.GetCollectionMetadataDiff(ctx, collectionID, revision)
+}
+
+func (s *Coordinator) SetCollectionAclEntry(ctx context.Context, entry *model.CollectionAclEntry) error {
+	if err := s.beforeCollectionWrite(ctx, &WriteRequest{Operation: WriteSetCollectionAclEntry, CollectionID: entry.CollectionID, Request: entry}); err != nil {
+		return err
+	}
+	if entry.Principal == "" || entry.Permissions == 0 {
+		return common.ErrInvalidCollectionAclEntry
+	}
+	return s.catalog.SetCollectionAclEntry(ctx, entry)
+}
+
+func (s *Coordinator) DeleteCollectionAclEntry(ctx context.Context, collectionID types.UniqueID, principal string) error {
+	entry := &model.CollectionAclEntry{CollectionID: collectionID, Principal: principal}
+	if err := s.beforeCollectionWrite(ctx, &WriteRequest{Operation: WriteDeleteCollectionAclEntry, CollectionID: collectionID, Request: entry}); err != nil {
+		return err
+	}
+	if entry.Principal == "" {
+		return common.ErrInvalidCollectionAclEntry
+	}
+	return s.catalog.DeleteCollectionAclEntry(ctx, collectionID, entry.Principal)
+}
+
+func (s *Coordinator) GetCollectionAcl(ctx context.Context, collectionID types.UniqueID) ([]*model.CollectionAclEntry, error) {
+	return s.catalog.GetCollectionAcl(ctx, collectionID)
+}
+
+// DeleteCollections soft deletes the collections of a database and returns one
+// result per collection.
+func (s *Coordinator) DeleteCollections(ctx context.Context, tenantID string, databaseName string, collectionIDs []types.UniqueID, actor string) ([]*model.CollectionBatchResult, error) {
+	if len(collectionIDs) > maxBatchDeleteCollections {
+		return nil, common.ErrCollectionBatchTooLarge
+	}
+	if err := s.beforeWrite(ctx, &WriteRequest{Operation: WriteDeleteCollections, Tenant: tenantID, Database: databaseName, Request: collectionIDs}); err != nil {
+		return nil, err
+	}
+	return s.catalog.DeleteCollections(ctx, tenantID, databaseName, collectionIDs, actor), nil
+}
+
+// RestoreCollection undoes the soft delete of a collection that was not purged
+// yet. It fails with ErrCollectionUniqueConstraintViolation when the original
+// name of the collection was reused, in which case the caller can pass a new one.
+func (s *Coordinator) RestoreCollection(ctx context.Context, collectionID types.UniqueID, name *string) (*model.Collection, error) {
+	if err := s.beforeCollectionWrite(ctx, &WriteRequest{Operation: WriteRestoreCollection, CollectionID: collectionID, Request: name}); err != nil {
+		return nil, err
+	}
+	if name != nil {
+		if err := s.verifyName(common.NameKindCollection, *name); err != nil {
+			return nil, err
+		}
+	}
+	return s.catalog.RestoreCollection(ctx, collectionID, name)
+}
+
+// CheckCollections tells for every one of the collections whether it exists,
+// e.g. for services that keep data of collections to find orphans.
+func (s *Coordinator) CheckCollections(ctx context.Context, collectionIDs []types.UniqueID) ([]*model.CollectionExistence, error) {
+	if len(collectionIDs) > maxBatchCheckCollections {
+		return nil, common.ErrCollectionBatchTooLarge
+	}
+	return s.catalog.CheckCollections(ctx, collectionIDs)
+}
+
+// BatchUpdateCollectionMetadata applies patch to the metadata of every collection
+// in collectionIDs and returns one result per collection. The patched metadata of
+// each collection is held to the same limits as UpdateCollection.
+func (s *Coordinator) BatchUpdateCollectionMetadata(ctx context.Context, collectionIDs []types.UniqueID, patch *model.CollectionMetadataPatch) ([]*model.CollectionBatchResult, error) {
+	if len(collectionIDs) > maxBatchUpdateCollectionMetadata {
+		return nil, common.ErrCollectionBatchTooLarge
+	}
+	if err := s.beforeBatchUpdateCollectionMetadata(ctx, collectionIDs, patch); err != nil {
+		return nil, err
+	}
+	if err := verifyCollectionMetadataPatch(patch); err != nil {
+		return nil, err
+	}
+	validate := func(metadata *model.CollectionMetadata[model.CollectionMetadataValueType]) error {
+		return verifyCollectionMetadata(metadata, s.config)
+	}
+	return s.catalog.BatchUpdateCollectionMetadata(ctx, collectionIDs, patch, validate), nil
+}
+
+// beforeBatchUpdateCollectionMetadata runs the pre-write hooks once for every
+// database of the collections. The collections that do not exist are left to the
+// catalog, which reports them in the results.
+func (s *Coordinator) beforeBatchUpdateCollectionMetadata(ctx context.Context, collectionIDs []types.UniqueID, patch *model.CollectionMetadataPatch) error {
+	if len(s.preWriteHooks) == 0 {
+		return nil
+	}
+	checked := make(map[string]bool)
+	for _, collectionID := range collectionIDs {
+		database, err := s.catalog.GetCollectionDatabase(ctx, collectionID)
+		if errors.Is(err, common.ErrCollectionNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if checked[database.ID] {
+			continue
+		}
+		checked[database.ID] = true
+		if err := s.beforeWrite(ctx, &WriteRequest{Operation: WriteBatchUpdateCollectionMetadata, Tenant: database.Tenant, Database: database.Name, Request: patch}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetIncompleteCollections returns the collections without segments that are
+// older than minAge. A zero minAge uses defaultIncompleteCollectionMinAge.
+func (s *Coordinator) GetIncompleteCollections(ctx context.Context, minAge time.Duration) ([]*model.IncompleteCollection, error) {
+	if minAge <= 0 {
+		minAge = defaultIncompleteCollectionMinAge
+	}
+	return s.catalog.GetIncompleteCollections(ctx, time.Now().Add(-minAge))
+}
+
+func (s *Coordinator) RepairIncompleteCollection(ctx context.Context, collectionID types.UniqueID, actor string) error {
+	return s.catalog.RepairIncompleteCollection(ctx, collectionID, actor)
+}
+
+// GetInconsistentCollections returns the collections stuck in an intermediate
+// state. Soft deleted collections are only reported once they were deleted at
+// least minAge ago, and a zero minAge uses defaultInconsistentCollectionMinAge.
+func (s *Coordinator) GetInconsistentCollections(ctx context.Context, minAge time.Duration) ([]*model.InconsistentCollection, error) {
+	if minAge <= 0 {
+		minAge = defaultInconsistentCollectionMinAge
+	}
+	collections, err := s.catalog.GetInconsistentCollections(ctx, time.Now().Add(-minAge), nil)
+	if err != nil {
+		return nil, err
+	}
+	// found by the reconciler regardless of minAge, the log position of a
+	// collection is never ahead of its log, even briefly
+	if s.logOffsetReconciler != nil {
+		collections = append(collections, s.logOffsetReconciler.latestFindings()...)
+	}
+	return collections, nil
+}
+
+func (s *Coordinator) CompleteCollectionReindex(ctx context.Context, collectionID types.UniqueID, dimension *int32) error {
+	return s.catalog.CompleteCollectionReindex(ctx, collectionID, dimension)
+}
+
 func (s *Coordinator) CreateSegment(ctx context.Context, segment *model.CreateSegment) error {
 	if err := verifyCreateSegment(segment); err != nil {
 		return err
@@ -102,12 +537,13 @@ func (s *Coordinator) CreateSegment(ctx context.Context, segment *model.CreateSe
 	return nil
 }
 
-func (s *Coordinator) GetSegments(ctx context.Context, segmentID types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID) ([]*model.Segment, error) {
-	return s.catalog.GetSegments(ctx, segmentID, segmentType, scope, collectionID)
+func (s *Coordinator) GetSegments(ctx context.Context, segmentID types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID, limit *int32, offset *int32) ([]*model.Segment, error) {
+	ctx = s.sampleQueries(ctx, "GetSegments")
+	return s.catalog.GetSegments(ctx, segmentID, segmentType, scope, collectionID, limit, offset)
 }
 
-func (s *Coordinator) DeleteSegment(ctx context.Context, segmentID types.UniqueID) error {
-	return s.catalog.DeleteSegment(ctx, segmentID)
+func (s *Coordinator) DeleteSegment(ctx context.Context, segmentID types.UniqueID, reason string, actor string) error {
+	return s.catalog.DeleteSegment(ctx, segmentID, reason, actor)
 }
 
 func (s *Coordinator) UpdateSegment(ctx context.Context, updateSegment *model.UpdateSegment) (*model.Segment, error) {
@@ -118,19 +554,65 @@ func (s *Coordinator) UpdateSegment(ctx context.Context, updateSegment *model.Up
 	return segment, nil
 }
 
-func verifyCollectionMetadata(metadata *model.CollectionMetadata[model.CollectionMetadataValueType]) error {
+// verifyCollectionMetadata checks the value types, enforces the metadata limits
+// from the config and validates the HNSW configuration keys. A zero limit is not
+// enforced.
+func verifyCollectionMetadata(metadata *model.CollectionMetadata[model.CollectionMetadataValueType], config Config) error {
 	if metadata == nil {
 		return nil
 	}
-	for _, value := range metadata.Metadata {
-		switch (value).(type) {
+	if config.MaxMetadataKeys > 0 && len(metadata.Metadata) > config.MaxMetadataKeys {
+		return common.ErrMetadataTooManyKeys
+	}
+	size := 0
+	for key, value := range metadata.Metadata {
+		if config.MaxMetadataKeyLength > 0 && len(key) > config.MaxMetadataKeyLength {
+			return common.ErrMetadataKeyTooLong
+		}
+		size += len(key)
+		switch v := (value).(type) {
 		case *model.CollectionMetadataValueStringType:
+			size += len(v.Value)
 		case *model.CollectionMetadataValueInt64Type:
+			size += 8
 		case *model.CollectionMetadataValueFloat64Type:
+			size += 8
+		case *model.CollectionMetadataValueBoolType:
+			size += 1
 		default:
 			return common.ErrUnknownCollectionMetadataType
 		}
 	}
+	if config.MaxMetadataSizeBytes > 0 && size > config.MaxMetadataSizeBytes {
+		return common.ErrMetadataTooLarge
+	}
+	return verifyCollectionConfiguration(metadata)
+}
+
+// verifyReplicationFactor accepts a nil factor, which keeps the current or
+// default factor.
+func verifyReplicationFactor(replicationFactor *int32, config Config) error {
+	if replicationFactor == nil {
+		return nil
+	}
+	if *replicationFactor < 1 || (config.MaxReplicationFactor > 0 && *replicationFactor > config.MaxReplicationFactor) {
+		return common.ErrInvalidReplicationFactor
+	}
+	return nil
+}
+
+func verifyCollectionMetadataPatch(patch *model.CollectionMetadataPatch) error {
+	if patch.Set == nil || patch.Set.Empty() {
+		if len(patch.Delete) == 0 {
+			return common.ErrInvalidMetadataPatch
+		}
+		return nil
+	}
+	for _, key := range patch.Delete {
+		if _, ok := patch.Set.Metadata[key]; ok {
+			return common.ErrInvalidMetadataPatch
+		}
+	}
 	return nil
 }
 
@@ -157,14 +639,162 @@ func verifySegmentMetadata(metadata *model.SegmentMetadata[model.SegmentMetadata
 	return nil
 }
 
+// SetTenantLastCompactionTime only buffers the time when batching is enabled,
+// it is written with the next batch and unknown tenants are not reported.
 func (s *Coordinator) SetTenantLastCompactionTime(ctx context.Context, tenantID string, lastCompactionTime int64) error {
+	if s.compactionBatcher != nil {
+		s.compactionBatcher.add(ctx, tenantID, lastCompactionTime)
+		return nil
+	}
 	return s.catalog.SetTenantLastCompactionTime(ctx, tenantID, lastCompactionTime)
 }
 
+// GetTenantsLastCompactionTime returns the times still waiting to be written by
+// this coordinator when they are later than the stored ones.
 func (s *Coordinator) GetTenantsLastCompactionTime(ctx context.Context, tenantIDs []string) ([]*dbmodel.Tenant, error) {
-	return s.catalog.GetTenantsLastCompactionTime(ctx, tenantIDs)
+	tenants, err := s.catalog.GetTenantsLastCompactionTime(ctx, tenantIDs)
+	if err != nil || s.compactionBatcher == nil {
+		return tenants, err
+	}
+	for _, tenant := range tenants {
+		if pending, ok := s.compactionBatcher.latest(tenant.ID); ok && pending > tenant.LastCompactionTime {
+			tenant.LastCompactionTime = pending
+		}
+	}
+	return tenants, nil
 }
 
+// FlushCollectionCompaction retries the flush when its transaction loses a
+// serialization conflict, up to FlushCompactionMaxRetries times. Stale log
+// positions and versions are not retried: they are decided under a row lock
+// and would fail the same way again.
 func (s *Coordinator) FlushCollectionCompaction(ctx context.Context, flushCollectionCompaction *model.FlushCollectionCompaction) (*model.FlushCollectionInfo, error) {
-	return s.catalog.FlushCollectionCompaction(ctx, flushCollectionCompaction)
+	backoff := flushCompactionRetryBackoff
+	for attempt := 0; ; attempt++ {
+		flushCollectionInfo, err := s.catalog.FlushCollectionCompaction(ctx, flushCollectionCompaction)
+		if err == nil {
+			s.versionWaiters.notify(flushCollectionCompaction.ID)
+		}
+		if err == nil || !errors.Is(err, common.ErrTransactionConflict) || attempt >= s.config.FlushCompactionMaxRetries {
+			return flushCollectionInfo, err
+		}
+		log.Info("retrying flush collection compaction", zap.String("collectionID", flushCollectionCompaction.ID.String()), zap.Int("attempt", attempt+1), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff + time.Duration(rand.Int63n(int64(backoff)))):
+		}
+		backoff *= 2
+	}
+}
+
+func (s *Coordinator) AcquireCollectionFencingToken(ctx context.Context, collectionID types.UniqueID, owner string) (int64, error) {
+	return s.catalog.AcquireCollectionFencingToken(ctx, collectionID, owner)
+}
+
+func (s *Coordinator) GetCollectionsBySize(ctx context.Context, tenantID string, databaseName string, orderBy model.CollectionSizeOrderBy, limit int32) ([]*model.CollectionSize, error) {
+	if limit <= 0 {
+		return nil, common.ErrInvalidCollectionSizeLimit
+	}
+	return s.catalog.GetCollectionsBySize(ctx, tenantID, databaseName, orderBy, limit)
+}
+
+// GetCollectionsToGc returns a page of the collections the garbage collector
+// should look at, and the ID to start the next page after if there is one. A zero
+// limit uses defaultCollectionsToGcLimit and larger limits are capped at
+// maxCollectionsToGcLimit.
+func (s *Coordinator) GetCollectionsToGc(ctx context.Context, filter *model.CollectionsToGcFilter) ([]*model.CollectionToGc, *types.UniqueID, error) {
+	if filter.OlderThan < 0 || filter.MinVersions < 0 || filter.Limit < 0 {
+		return nil, nil, common.ErrInvalidCollectionsToGcFilter
+	}
+	limit := filter.Limit
+	switch {
+	case limit == 0:
+		limit = defaultCollectionsToGcLimit
+	case limit > maxCollectionsToGcLimit:
+		limit = maxCollectionsToGcLimit
+	}
+	// read one more row to find out whether there is a next page
+	pageFilter := *filter
+	pageFilter.Limit = limit + 1
+	collections, err := s.catalog.GetCollectionsToGc(ctx, &pageFilter, time.Now().Add(-filter.OlderThan))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(collections) <= int(limit) {
+		return collections, nil, nil
+	}
+	collections = collections[:limit]
+	next := collections[limit-1].ID
+	return collections, &next, nil
+}
+
+// RequestCompaction queues the collection to be compacted ahead of the usual
+// thresholds. Requesting a queued collection again keeps its place in the queue.
+func (s *Coordinator) RequestCompaction(ctx context.Context, collectionID types.UniqueID, actor string) error {
+	return s.catalog.RequestCompaction(ctx, collectionID, actor)
+}
+
+// GetCompactionRequests returns the queued collections, longest queued first. A
+// zero limit uses defaultCompactionRequestsLimit and larger limits are capped at
+// maxCompactionRequestsLimit.
+func (s *Coordinator) GetCompactionRequests(ctx context.Context, limit int32) ([]*model.CompactionRequest, error) {
+	switch {
+	case limit <= 0:
+		limit = defaultCompactionRequestsLimit
+	case limit > maxCompactionRequestsLimit:
+		limit = maxCompactionRequestsLimit
+	}
+	return s.catalog.GetCompactionRequests(ctx, limit)
+}
+
+// GetDuplicateSegmentFiles returns the files that share their content with other
+// files, grouped by content hash. A zero limit uses
+// defaultDuplicateSegmentFilesLimit and larger limits are capped at
+// maxDuplicateSegmentFilesLimit.
+func (s *Coordinator) GetDuplicateSegmentFiles(ctx context.Context, tenantID string, limit int32) ([]*model.DuplicateSegmentFiles, error) {
+	switch {
+	case limit <= 0:
+		limit = defaultDuplicateSegmentFilesLimit
+	case limit > maxDuplicateSegmentFilesLimit:
+		limit = maxDuplicateSegmentFilesLimit
+	}
+	return s.catalog.GetDuplicateSegmentFiles(ctx, tenantID, limit)
+}
+
+func (s *Coordinator) GetCollectionSegmentFiles(ctx context.Context, collectionID types.UniqueID) ([]*model.SegmentFile, error) {
+	return s.catalog.GetCollectionSegmentFiles(ctx, collectionID)
+}
+
+func (s *Coordinator) GetStorageAttribution(ctx context.Context, tenantID string) (*model.StorageAttribution, error) {
+	return s.catalog.GetStorageAttribution(ctx, tenantID)
+}
+
+func (s *Coordinator) GetApproximateCounts(ctx context.Context, tenantID string, databaseName string) (*model.ApproximateCounts, error) {
+	return s.catalog.GetApproximateCounts(ctx, tenantID, databaseName)
+}
+
+// CountCollections counts the collections of a tenant without loading them.
+// Unlike GetApproximateCounts the count is exact, so it needs a tenant to stay
+// cheap.
+func (s *Coordinator) CountCollections(ctx context.Context, tenantID string, databaseName string, includeSoftDeleted bool) (int64, error) {
+	if tenantID == "" {
+		return 0, common.ErrCountCollectionsTenantRequired
+	}
+	return s.catalog.CountCollections(ctx, tenantID, databaseName, includeSoftDeleted)
+}
+
+// sampleQueries marks a QuerySampleRate fraction of calls so that their
+// statements are recorded.
+func (s *Coordinator) sampleQueries(ctx context.Context, operation string) context.Context {
+	if s.config.QuerySampleRate <= 0 || rand.Float64() >= s.config.QuerySampleRate {
+		return ctx
+	}
+	return dbcore.WithQuerySample(ctx, operation)
+}
+
+// GetQuerySamples returns the statements recorded for sampled GetCollections
+// and GetSegments calls, oldest first.
+func (s *Coordinator) GetQuerySamples() []model.QuerySample {
+	return dbcore.GetQuerySamples()
 }
@@ -2,12 +2,18 @@ package coordinator
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"sort"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dao"
+	"github.com/chroma-core/chroma/go/pkg/metastore/mocks"
 	"github.com/pingcap/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"gorm.io/gorm"
 
@@ -43,7 +49,7 @@ func (suite *APIsTestSuite) SetupTest() {
 	suite.databaseName = "database_" + suite.T().Name()
 	DbId, err := dao.CreateTestTenantAndDatabase(suite.db, suite.tenantName, suite.databaseName)
 	suite.NoError(err)
-	suite.databaseId = DbId
+	suite.databaseId = DbId.String()
 	suite.sampleCollections = SampleCollections(suite.tenantName, suite.databaseName)
 	for index, collection := range suite.sampleCollections {
 		collection.ID = types.NewUniqueID()
@@ -106,7 +112,7 @@ func testCollection(t *rapid.T) {
 
 			_, err := c.CreateCollection(ctx, collection)
 			if err != nil {
-				if err == common.ErrCollectionNameEmpty && collection.Name == "" {
+				if errors.Is(err, common.ErrCollectionNameEmpty) && collection.Name == "" {
 					t.Logf("expected error for empty collection name")
 				} else {
 					t.Fatalf("error creating collection: %v", err)
@@ -114,7 +120,7 @@ func testCollection(t *rapid.T) {
 			}
 			if err == nil {
 				// verify the correctness
-				collectionList, err := c.GetCollections(ctx, collection.ID, nil, common.DefaultTenant, common.DefaultDatabase, nil, nil)
+				collectionList, err := c.GetCollections(ctx, collection.ID, nil, common.DefaultTenant, common.DefaultDatabase, nil, nil, nil, nil)
 				if err != nil {
 					t.Fatalf("error getting collections: %v", err)
 				}
@@ -233,6 +239,10 @@ func SampleCollections(tenantID string, databaseName string) []*model.Collection
 			Dimension:    &dimension,
 			TenantID:     tenantID,
 			DatabaseName: databaseName,
+			// set by CreateCollection
+			DistanceFunction:  model.DefaultDistanceFunction,
+			State:             model.CollectionStateCreating,
+			ReplicationFactor: 1,
 		},
 		{
 			ID:           types.MustParse("f444f1d7-d06c-4357-ac22-5a4a1f92d761"),
@@ -241,6 +251,10 @@ func SampleCollections(tenantID string, databaseName string) []*model.Collection
 			Dimension:    nil,
 			TenantID:     tenantID,
 			DatabaseName: databaseName,
+			// set by CreateCollection
+			DistanceFunction:  model.DefaultDistanceFunction,
+			State:             model.CollectionStateCreating,
+			ReplicationFactor: 1,
 		},
 		{
 			ID:           types.MustParse("43babc1a-e403-4a50-91a9-16621ba29ab0"),
@@ -249,20 +263,33 @@ func SampleCollections(tenantID string, databaseName string) []*model.Collection
 			Dimension:    nil,
 			TenantID:     tenantID,
 			DatabaseName: databaseName,
+			// set by CreateCollection
+			DistanceFunction:  model.DefaultDistanceFunction,
+			State:             model.CollectionStateCreating,
+			ReplicationFactor: 1,
 		},
 	}
 	return sampleCollections
 }
 
+// withoutUpdatedAt clears the update time the database sets on collections, so
+// that they compare equal to the samples.
+func withoutUpdatedAt(collections []*model.Collection) []*model.Collection {
+	for _, collection := range collections {
+		collection.UpdatedAt = time.Time{}
+	}
+	return collections
+}
+
 func (suite *APIsTestSuite) TestCreateGetDeleteCollections() {
 	ctx := context.Background()
-	results, err := suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, suite.tenantName, suite.databaseName, nil, nil)
+	results, err := suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, suite.tenantName, suite.databaseName, nil, nil, nil, nil)
 	suite.NoError(err)
 
 	sort.Slice(results, func(i, j int) bool {
 		return results[i].Name < results[j].Name
 	})
-	suite.Equal(suite.sampleCollections, results)
+	suite.Equal(suite.sampleCollections, withoutUpdatedAt(results))
 
 	// Duplicate create fails
 	_, err = suite.coordinator.CreateCollection(ctx, &model.CreateCollection{
@@ -275,16 +302,16 @@ func (suite *APIsTestSuite) TestCreateGetDeleteCollections() {
 
 	// Find by name
 	for _, collection := range suite.sampleCollections {
-		result, err := suite.coordinator.GetCollections(ctx, types.NilUniqueID(), &collection.Name, suite.tenantName, suite.databaseName, nil, nil)
+		result, err := suite.coordinator.GetCollections(ctx, types.NilUniqueID(), &collection.Name, suite.tenantName, suite.databaseName, nil, nil, nil, nil)
 		suite.NoError(err)
-		suite.Equal([]*model.Collection{collection}, result)
+		suite.Equal([]*model.Collection{collection}, withoutUpdatedAt(result))
 	}
 
 	// Find by id
 	for _, collection := range suite.sampleCollections {
-		result, err := suite.coordinator.GetCollections(ctx, collection.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+		result, err := suite.coordinator.GetCollections(ctx, collection.ID, nil, suite.tenantName, suite.databaseName, nil, nil, nil, nil)
 		suite.NoError(err)
-		suite.Equal([]*model.Collection{collection}, result)
+		suite.Equal([]*model.Collection{collection}, withoutUpdatedAt(result))
 	}
 
 	// Delete
@@ -297,13 +324,13 @@ func (suite *APIsTestSuite) TestCreateGetDeleteCollections() {
 	err = suite.coordinator.DeleteCollection(ctx, deleteCollection)
 	suite.NoError(err)
 
-	results, err = suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, suite.tenantName, suite.databaseName, nil, nil)
+	results, err = suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, suite.tenantName, suite.databaseName, nil, nil, nil, nil)
 	suite.NoError(err)
 
 	suite.NotContains(results, c1)
 	suite.Len(results, len(suite.sampleCollections)-1)
-	suite.ElementsMatch(results, suite.sampleCollections[1:])
-	byIDResult, err := suite.coordinator.GetCollections(ctx, c1.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+	suite.ElementsMatch(withoutUpdatedAt(results), suite.sampleCollections[1:])
+	byIDResult, err := suite.coordinator.GetCollections(ctx, c1.ID, nil, suite.tenantName, suite.databaseName, nil, nil, nil, nil)
 	suite.NoError(err)
 	suite.Empty(byIDResult)
 
@@ -321,46 +348,296 @@ func (suite *APIsTestSuite) TestUpdateCollections() {
 		Dimension:    suite.sampleCollections[0].Dimension,
 		TenantID:     suite.sampleCollections[0].TenantID,
 		DatabaseName: suite.sampleCollections[0].DatabaseName,
+
+		DistanceFunction:  suite.sampleCollections[0].DistanceFunction,
+		State:             suite.sampleCollections[0].State,
+		ReplicationFactor: suite.sampleCollections[0].ReplicationFactor,
 	}
 
 	// Update name
 	coll.Name = "new_name"
-	result, err := suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Name: &coll.Name})
+	coll.Revision = 1
+	result, changedFields, err := suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Name: &coll.Name})
 	suite.NoError(err)
-	suite.Equal(coll, result)
-	resultList, err := suite.coordinator.GetCollections(ctx, types.NilUniqueID(), &coll.Name, suite.tenantName, suite.databaseName, nil, nil)
+	suite.Equal(coll, withoutUpdatedAt([]*model.Collection{result})[0])
+	suite.Equal([]string{model.CollectionFieldName}, changedFields)
+	resultList, err := suite.coordinator.GetCollections(ctx, types.NilUniqueID(), &coll.Name, suite.tenantName, suite.databaseName, nil, nil, nil, nil)
 	suite.NoError(err)
-	suite.Equal([]*model.Collection{coll}, resultList)
+	suite.Equal([]*model.Collection{coll}, withoutUpdatedAt(resultList))
 
 	// Update dimension
 	newDimension := int32(128)
 	coll.Dimension = &newDimension
-	result, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Dimension: coll.Dimension})
+	coll.Revision = 2
+	result, changedFields, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Dimension: coll.Dimension})
 	suite.NoError(err)
-	suite.Equal(coll, result)
-	resultList, err = suite.coordinator.GetCollections(ctx, coll.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+	suite.Equal(coll, withoutUpdatedAt([]*model.Collection{result})[0])
+	suite.Equal([]string{model.CollectionFieldDimension}, changedFields)
+	resultList, err = suite.coordinator.GetCollections(ctx, coll.ID, nil, suite.tenantName, suite.databaseName, nil, nil, nil, nil)
 	suite.NoError(err)
-	suite.Equal([]*model.Collection{coll}, resultList)
+	suite.Equal([]*model.Collection{coll}, withoutUpdatedAt(resultList))
 
 	// Reset the metadata
 	newMetadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
 	newMetadata.Add("test_str2", &model.CollectionMetadataValueStringType{Value: "str2"})
 	coll.Metadata = newMetadata
-	result, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Metadata: coll.Metadata})
+	coll.Revision = 3
+	result, changedFields, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Metadata: coll.Metadata})
 	suite.NoError(err)
-	suite.Equal(coll, result)
-	resultList, err = suite.coordinator.GetCollections(ctx, coll.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+	suite.Equal(coll, withoutUpdatedAt([]*model.Collection{result})[0])
+	suite.Equal([]string{model.CollectionFieldMetadata}, changedFields)
+	resultList, err = suite.coordinator.GetCollections(ctx, coll.ID, nil, suite.tenantName, suite.databaseName, nil, nil, nil, nil)
 	suite.NoError(err)
-	suite.Equal([]*model.Collection{coll}, resultList)
+	suite.Equal([]*model.Collection{coll}, withoutUpdatedAt(resultList))
 
 	// Delete all metadata keys
 	coll.Metadata = nil
-	result, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Metadata: coll.Metadata, ResetMetadata: true})
+	coll.Revision = 4
+	result, changedFields, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Metadata: coll.Metadata, ResetMetadata: true})
+	suite.NoError(err)
+	suite.Equal(coll, withoutUpdatedAt([]*model.Collection{result})[0])
+	suite.Equal([]string{model.CollectionFieldMetadata}, changedFields)
+	resultList, err = suite.coordinator.GetCollections(ctx, coll.ID, nil, suite.tenantName, suite.databaseName, nil, nil, nil, nil)
+	suite.NoError(err)
+	suite.Equal([]*model.Collection{coll}, withoutUpdatedAt(resultList))
+
+	// Fields set to their current value are not changed
+	replicationFactor := int32(1)
+	result, changedFields, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Name: &coll.Name, ReplicationFactor: &replicationFactor})
+	suite.NoError(err)
+	suite.Equal(coll, withoutUpdatedAt([]*model.Collection{result})[0])
+	suite.Empty(changedFields)
+
+	// Several fields at the expected revision at once
+	newName := "newer_name"
+	replicationFactor = 2
+	expectedRevision := int64(4)
+	result, changedFields, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Name: &newName, ReplicationFactor: &replicationFactor, ExpectedRevision: &expectedRevision})
+	suite.NoError(err)
+	suite.Equal(int64(5), result.Revision)
+	suite.Equal([]string{model.CollectionFieldName, model.CollectionFieldReplicationFactor}, changedFields)
+
+	// A stale revision does not change anything
+	_, _, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: coll.ID, Name: &coll.Name, ExpectedRevision: &expectedRevision})
+	suite.ErrorIs(err, common.ErrCollectionRevisionMismatch)
+	resultList, err = suite.coordinator.GetCollections(ctx, coll.ID, nil, suite.tenantName, suite.databaseName, nil, nil, nil, nil)
+	suite.NoError(err)
+	suite.Equal(newName, resultList[0].Name)
+}
+
+func (suite *APIsTestSuite) TestGetCollectionMetadataDiff() {
+	ctx := context.Background()
+	collection := suite.sampleCollections[0]
+
+	// revision 1 changes the name only, revision 2 the metadata
+	newName := collection.Name + "_renamed"
+	_, _, err := suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: collection.ID, Name: &newName})
+	suite.NoError(err)
+	newMetadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	newMetadata.Add("test_str", &model.CollectionMetadataValueStringType{Value: "changed"})
+	newMetadata.Add("test_int", &model.CollectionMetadataValueInt64Type{Value: 1})
+	newMetadata.Add("test_bool", &model.CollectionMetadataValueBoolType{Value: true})
+	_, _, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: collection.ID, Metadata: newMetadata})
+	suite.NoError(err)
+
+	for _, revision := range []int64{0, 1} {
+		diff, err := suite.coordinator.GetCollectionMetadataDiff(ctx, collection.ID, revision)
+		suite.NoError(err)
+		suite.Equal(revision, diff.FromRevision)
+		suite.Equal(int64(2), diff.ToRevision)
+		suite.Equal([]*model.CollectionMetadataChange{
+			{Key: "test_bool", To: &model.CollectionMetadataValueBoolType{Value: true}},
+			{Key: "test_float", From: &model.CollectionMetadataValueFloat64Type{Value: 1.3}},
+			{Key: "test_str", From: &model.CollectionMetadataValueStringType{Value: "str1"}, To: &model.CollectionMetadataValueStringType{Value: "changed"}},
+		}, diff.Changes)
+	}
+
+	diff, err := suite.coordinator.GetCollectionMetadataDiff(ctx, collection.ID, 2)
+	suite.NoError(err)
+	suite.Empty(diff.Changes)
+
+	_, err = suite.coordinator.GetCollectionMetadataDiff(ctx, collection.ID, 3)
+	suite.ErrorIs(err, common.ErrCollectionRevisionNotInHistory)
+	_, err = suite.coordinator.GetCollectionMetadataDiff(ctx, types.NewUniqueID(), 0)
+	suite.ErrorIs(err, common.ErrCollectionNotFound)
+}
+
+func (suite *APIsTestSuite) TestCollectionDistanceFunction() {
+	ctx := context.Background()
+	dimension := int32(1536)
+	cosine := "cosine"
+	collection, err := suite.coordinator.CreateCollection(ctx, &model.CreateCollection{
+		ID:               types.NewUniqueID(),
+		Name:             "test_distance_function",
+		Dimension:        &dimension,
+		DistanceFunction: &cosine,
+		TenantID:         suite.tenantName,
+		DatabaseName:     suite.databaseName,
+	})
+	suite.NoError(err)
+	suite.Equal("cosine", collection.DistanceFunction)
+	suite.Equal(&model.CollectionMetadataValueStringType{Value: "cosine"}, collection.Metadata.Get("hnsw:space"))
+
+	filters := []*model.CollectionMetadataFilter{
+		{Field: model.CollectionFilterDistanceFunction, Operator: model.MetadataFilterEQ, Value: &model.CollectionMetadataValueStringType{Value: "cosine"}},
+		{Field: model.CollectionFilterDimension, Operator: model.MetadataFilterEQ, Value: &model.CollectionMetadataValueInt64Type{Value: 1536}},
+	}
+	collections, err := suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, suite.tenantName, suite.databaseName, nil, nil, filters, nil)
+	suite.NoError(err)
+	suite.Len(collections, 1)
+	suite.Equal(collection.ID, collections[0].ID)
+
+	// the sample collections default to l2
+	collections, err = suite.coordinator.GetCollections(ctx, suite.sampleCollections[0].ID, nil, suite.tenantName, suite.databaseName, nil, nil, nil, nil)
+	suite.NoError(err)
+	suite.Equal("l2", collections[0].DistanceFunction)
+
+	zero := int32(0)
+	_, err = suite.coordinator.CreateCollection(ctx, &model.CreateCollection{
+		ID:           types.NewUniqueID(),
+		Name:         "test_zero_dimension",
+		Dimension:    &zero,
+		TenantID:     suite.tenantName,
+		DatabaseName: suite.databaseName,
+	})
+	suite.ErrorIs(err, common.ErrInvalidCollectionConfiguration)
+}
+
+func (suite *APIsTestSuite) TestEmbeddingFunctions() {
+	ctx := context.Background()
+	embeddingFunction, err := suite.coordinator.CreateEmbeddingFunction(ctx, &model.EmbeddingFunction{
+		TenantID: suite.tenantName,
+		Name:     "openai_small",
+		Provider: "openai",
+		Model:    "text-embedding-3-small",
+		Params:   `{"dimensions": 1536}`,
+	})
+	suite.NoError(err)
+	_, err = suite.coordinator.CreateEmbeddingFunction(ctx, &model.EmbeddingFunction{TenantID: suite.tenantName, Name: "openai_small", Provider: "openai"})
+	suite.ErrorIs(err, common.ErrEmbeddingFunctionUniqueConstraintViolation)
+
+	collection, err := suite.coordinator.CreateCollection(ctx, &model.CreateCollection{
+		ID:                  types.NewUniqueID(),
+		Name:                "test_embedding_function",
+		TenantID:            suite.tenantName,
+		DatabaseName:        suite.databaseName,
+		EmbeddingFunctionID: &embeddingFunction.ID,
+	})
+	suite.NoError(err)
+	suite.Equal(embeddingFunction.ID, *collection.EmbeddingFunctionID)
+
+	// the embedding function of another tenant cannot be referenced
+	_, _, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: suite.sampleCollections[0].ID, EmbeddingFunctionID: &suite.sampleCollections[1].ID})
+	suite.ErrorIs(err, common.ErrEmbeddingFunctionNotFound)
+	_, changedFields, err := suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: suite.sampleCollections[0].ID, EmbeddingFunctionID: &embeddingFunction.ID})
+	suite.NoError(err)
+	suite.Equal([]string{model.CollectionFieldEmbeddingFunction}, changedFields)
+
+	params := `{"dimensions": 512}`
+	updated, err := suite.coordinator.UpdateEmbeddingFunction(ctx, &model.UpdateEmbeddingFunction{ID: embeddingFunction.ID, Params: &params})
+	suite.NoError(err)
+	suite.Equal("text-embedding-3-small", updated.Model)
+	name := "openai_small"
+	embeddingFunctions, err := suite.coordinator.GetEmbeddingFunctions(ctx, types.NilUniqueID(), suite.tenantName, &name)
+	suite.NoError(err)
+	suite.Len(embeddingFunctions, 1)
+	suite.JSONEq(params, embeddingFunctions[0].Params)
+
+	err = suite.coordinator.DeleteEmbeddingFunction(ctx, embeddingFunction.ID)
+	suite.ErrorIs(err, common.ErrEmbeddingFunctionInUse)
+
+	// the embedding function is deleted once no collection references it
+	_, _, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: collection.ID, EmbeddingFunctionID: &embeddingFunction.ID, ResetEmbeddingFunction: true})
+	suite.ErrorIs(err, common.ErrInvalidEmbeddingFunctionUpdate)
+	for _, id := range []types.UniqueID{collection.ID, suite.sampleCollections[0].ID} {
+		_, changedFields, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: id, ResetEmbeddingFunction: true})
+		suite.NoError(err)
+		suite.Equal([]string{model.CollectionFieldEmbeddingFunction}, changedFields)
+	}
+	_, changedFields, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{ID: collection.ID, ResetEmbeddingFunction: true})
+	suite.NoError(err)
+	suite.Empty(changedFields)
+	err = suite.coordinator.DeleteEmbeddingFunction(ctx, embeddingFunction.ID)
+	suite.NoError(err)
+}
+
+func (suite *APIsTestSuite) TestCollectionSchema() {
+	ctx := context.Background()
+	collectionID := suite.sampleCollections[0].ID
+	err := suite.coordinator.SetCollectionSchema(ctx, collectionID, []*model.CollectionSchemaField{
+		{Name: "year", Type: model.CollectionSchemaFieldInt, Indexed: true},
+		{Name: "author", Type: model.CollectionSchemaFieldString},
+	})
+	suite.NoError(err)
+	fields, err := suite.coordinator.GetCollectionSchema(ctx, collectionID)
 	suite.NoError(err)
-	suite.Equal(coll, result)
-	resultList, err = suite.coordinator.GetCollections(ctx, coll.ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+	suite.Equal([]*model.CollectionSchemaField{
+		{Name: "author", Type: model.CollectionSchemaFieldString},
+		{Name: "year", Type: model.CollectionSchemaFieldInt, Indexed: true},
+	}, fields)
+
+	metadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	metadata.Add("year", &model.CollectionMetadataValueFloat64Type{Value: 1965.5})
+	violations, err := suite.coordinator.ValidateCollectionMetadata(ctx, collectionID, []*model.CollectionMetadata[model.CollectionMetadataValueType]{metadata})
 	suite.NoError(err)
-	suite.Equal([]*model.Collection{coll}, resultList)
+	suite.Len(violations, 1)
+
+	// setting a schema replaces the previous one
+	err = suite.coordinator.SetCollectionSchema(ctx, collectionID, nil)
+	suite.NoError(err)
+	fields, err = suite.coordinator.GetCollectionSchema(ctx, collectionID)
+	suite.NoError(err)
+	suite.Empty(fields)
+
+	err = suite.coordinator.SetCollectionSchema(ctx, types.NewUniqueID(), nil)
+	suite.ErrorIs(err, common.ErrCollectionNotFound)
+	_, err = suite.coordinator.GetCollectionSchema(ctx, types.NewUniqueID())
+	suite.ErrorIs(err, common.ErrCollectionNotFound)
+}
+
+func (suite *APIsTestSuite) TestCollectionTemplates() {
+	ctx := context.Background()
+	dimension := int32(384)
+	maxRecords := int64(1000)
+	defaults := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	defaults.Add("hnsw:space", &model.CollectionMetadataValueStringType{Value: "ip"})
+	defaults.Add("team", &model.CollectionMetadataValueStringType{Value: "platform"})
+	template, err := suite.coordinator.CreateCollectionTemplate(ctx, &model.CollectionTemplate{
+		TenantID:   suite.tenantName,
+		Name:       "documents",
+		Dimension:  &dimension,
+		Metadata:   defaults,
+		MaxRecords: &maxRecords,
+	})
+	suite.NoError(err)
+	suite.Equal("ip", *template.DistanceFunction)
+	_, err = suite.coordinator.CreateCollectionTemplate(ctx, &model.CollectionTemplate{TenantID: suite.tenantName, Name: "documents"})
+	suite.ErrorIs(err, common.ErrCollectionTemplateUniqueConstraintViolation)
+
+	collection, err := suite.coordinator.CreateCollectionFromTemplate(ctx, &model.CreateCollectionFromTemplate{
+		TemplateName: "documents",
+		Collection: &model.CreateCollection{
+			ID:           types.NewUniqueID(),
+			Name:         "test_collection_template",
+			TenantID:     suite.tenantName,
+			DatabaseName: suite.databaseName,
+		},
+	})
+	suite.NoError(err)
+	suite.Equal(dimension, *collection.Dimension)
+	suite.Equal("ip", collection.DistanceFunction)
+	suite.Equal(template.ID, *collection.TemplateID)
+	suite.True(defaults.Equals(collection.Metadata))
+
+	// deleting the template detaches its collections
+	err = suite.coordinator.DeleteCollectionTemplate(ctx, template.ID)
+	suite.NoError(err)
+	collections, err := suite.coordinator.GetCollections(ctx, collection.ID, nil, suite.tenantName, suite.databaseName, nil, nil, nil, nil)
+	suite.NoError(err)
+	suite.Nil(collections[0].TemplateID)
+	suite.Equal(dimension, *collections[0].Dimension)
+	err = suite.coordinator.DeleteCollectionTemplate(ctx, template.ID)
+	suite.ErrorIs(err, common.ErrCollectionTemplateNotFound)
 }
 
 func (suite *APIsTestSuite) TestCreateUpdateWithDatabase() {
@@ -386,24 +663,24 @@ func (suite *APIsTestSuite) TestCreateUpdateWithDatabase() {
 	})
 	suite.NoError(err)
 	newName1 := "new_name_1"
-	_, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{
+	_, _, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{
 		ID:   suite.sampleCollections[1].ID,
 		Name: &newName1,
 	})
 	suite.NoError(err)
-	result, err := suite.coordinator.GetCollections(ctx, suite.sampleCollections[1].ID, nil, suite.tenantName, suite.databaseName, nil, nil)
+	result, err := suite.coordinator.GetCollections(ctx, suite.sampleCollections[1].ID, nil, suite.tenantName, suite.databaseName, nil, nil, nil, nil)
 	suite.NoError(err)
 	suite.Len(result, 1)
 	suite.Equal(newName1, result[0].Name)
 
 	newName0 := "new_name_0"
-	_, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{
+	_, _, err = suite.coordinator.UpdateCollection(ctx, &model.UpdateCollection{
 		ID:   suite.sampleCollections[0].ID,
 		Name: &newName0,
 	})
 	suite.NoError(err)
 	//suite.Equal(newName0, collection.Name)
-	result, err = suite.coordinator.GetCollections(ctx, suite.sampleCollections[0].ID, nil, suite.tenantName, newDatabaseName, nil, nil)
+	result, err = suite.coordinator.GetCollections(ctx, suite.sampleCollections[0].ID, nil, suite.tenantName, newDatabaseName, nil, nil, nil, nil)
 	suite.NoError(err)
 	suite.Len(result, 1)
 	suite.Equal(newName0, result[0].Name)
@@ -441,15 +718,15 @@ func (suite *APIsTestSuite) TestGetMultipleWithDatabase() {
 		suite.NoError(err)
 		suite.sampleCollections[index] = collection
 	}
-	result, err := suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, suite.tenantName, newDatabaseName, nil, nil)
+	result, err := suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, suite.tenantName, newDatabaseName, nil, nil, nil, nil)
 	suite.NoError(err)
 	suite.Equal(len(suite.sampleCollections), len(result))
 	sort.Slice(result, func(i, j int) bool {
 		return result[i].Name < result[j].Name
 	})
-	suite.Equal(suite.sampleCollections, result)
+	suite.Equal(suite.sampleCollections, withoutUpdatedAt(result))
 
-	result, err = suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, suite.tenantName, suite.databaseName, nil, nil)
+	result, err = suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, suite.tenantName, suite.databaseName, nil, nil, nil, nil)
 	suite.NoError(err)
 	suite.Equal(len(suite.sampleCollections), len(result))
 
@@ -526,22 +803,22 @@ func (suite *APIsTestSuite) TestCreateDatabaseWithTenants() {
 	expected := []*model.Collection{suite.sampleCollections[0]}
 	expected[0].TenantID = newTenantName
 	expected[0].DatabaseName = newDatabaseName
-	result, err := suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, newTenantName, newDatabaseName, nil, nil)
+	result, err := suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, newTenantName, newDatabaseName, nil, nil, nil, nil)
 	suite.NoError(err)
 	suite.Len(result, 1)
-	suite.Equal(expected[0], result[0])
+	suite.Equal(expected[0], withoutUpdatedAt(result)[0])
 
 	expected = []*model.Collection{suite.sampleCollections[1]}
 	expected[0].TenantID = suite.tenantName
 	expected[0].DatabaseName = newDatabaseName
-	result, err = suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, suite.tenantName, newDatabaseName, nil, nil)
+	result, err = suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, suite.tenantName, newDatabaseName, nil, nil, nil, nil)
 	suite.NoError(err)
 	suite.Len(result, 1)
-	suite.Equal(expected[0], result[0])
+	suite.Equal(expected[0], withoutUpdatedAt(result)[0])
 
 	// A new tenant DOES NOT have a default database. This does not error, instead 0
 	// results are returned
-	result, err = suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, newTenantName, suite.databaseName, nil, nil)
+	result, err = suite.coordinator.GetCollections(ctx, types.NilUniqueID(), nil, newTenantName, suite.databaseName, nil, nil, nil, nil)
 	suite.NoError(err)
 	suite.Equal(0, len(result))
 
@@ -623,6 +900,39 @@ func (suite *APIsTestSuite) TestCreateGetDeleteTenants() {
 	suite.NoError(err)
 }
 
+func (suite *APIsTestSuite) TestBatchCreateTenants() {
+	ctx := context.Background()
+	maxCollections := int64(10)
+	results, err := suite.coordinator.BatchCreateTenants(ctx, []*model.BatchCreateTenant{
+		{Name: "batch_tenant1", DatabaseName: "batch_database", Quota: &model.TenantQuota{MaxCollections: &maxCollections}},
+		{Name: suite.tenantName},
+		{Name: "batch_tenant2"},
+	})
+	suite.NoError(err)
+	suite.Len(results, 3)
+	suite.NoError(results[0].Err)
+	suite.ErrorIs(results[1].Err, common.ErrTenantUniqueConstraintViolation)
+	suite.NoError(results[2].Err)
+
+	// a failing tenant does not roll back the others
+	database, err := suite.coordinator.GetDatabase(ctx, &model.GetDatabase{Name: "batch_database", Tenant: "batch_tenant1"})
+	suite.NoError(err)
+	suite.Equal("batch_database", database.Name)
+	quota, err := suite.coordinator.GetTenantQuota(ctx, "batch_tenant1")
+	suite.NoError(err)
+	suite.Equal(maxCollections, *quota.MaxCollections)
+	_, err = suite.coordinator.GetTenant(ctx, &model.GetTenant{Name: "batch_tenant2"})
+	suite.NoError(err)
+
+	_, err = suite.coordinator.BatchCreateTenants(ctx, []*model.BatchCreateTenant{{Name: "batch_tenant3"}, {Name: "batch_tenant3"}})
+	suite.ErrorIs(err, common.ErrInvalidTenantBatch)
+	negative := int64(-1)
+	_, err = suite.coordinator.BatchCreateTenants(ctx, []*model.BatchCreateTenant{{Name: "batch_tenant3", Quota: &model.TenantQuota{MaxRecords: &negative}}})
+	suite.ErrorIs(err, common.ErrInvalidTenantQuota)
+	_, err = suite.coordinator.GetTenant(ctx, &model.GetTenant{Name: "batch_tenant3"})
+	suite.ErrorIs(err, common.ErrTenantNotFound)
+}
+
 func SampleSegments(sampleCollections []*model.Collection) []*model.Segment {
 	metadata1 := model.NewSegmentMetadata[model.SegmentMetadataValueType]()
 	metadata1.Set("test_str", &model.SegmentMetadataValueStringType{Value: "str1"})
@@ -686,7 +996,7 @@ func (suite *APIsTestSuite) TestCreateGetDeleteSegments() {
 
 	var results []*model.Segment
 	for _, segment := range sampleSegments {
-		result, err := c.GetSegments(ctx, segment.ID, nil, nil, types.NilUniqueID())
+		result, err := c.GetSegments(ctx, segment.ID, nil, nil, types.NilUniqueID(), nil, nil)
 		suite.NoError(err)
 		suite.Equal([]*model.Segment{segment}, result)
 		results = append(results, result...)
@@ -708,55 +1018,55 @@ func (suite *APIsTestSuite) TestCreateGetDeleteSegments() {
 
 	// Find by id
 	for _, segment := range sampleSegments {
-		result, err := c.GetSegments(ctx, segment.ID, nil, nil, types.NilUniqueID())
+		result, err := c.GetSegments(ctx, segment.ID, nil, nil, types.NilUniqueID(), nil, nil)
 		suite.NoError(err)
 		suite.Equal([]*model.Segment{segment}, result)
 	}
 
 	// Find by type
 	testTypeA := "test_type_a"
-	result, err := c.GetSegments(ctx, types.NilUniqueID(), &testTypeA, nil, types.NilUniqueID())
+	result, err := c.GetSegments(ctx, types.NilUniqueID(), &testTypeA, nil, types.NilUniqueID(), nil, nil)
 	suite.NoError(err)
 	suite.Equal(sampleSegments[:1], result)
 
 	testTypeB := "test_type_b"
-	result, err = c.GetSegments(ctx, types.NilUniqueID(), &testTypeB, nil, types.NilUniqueID())
+	result, err = c.GetSegments(ctx, types.NilUniqueID(), &testTypeB, nil, types.NilUniqueID(), nil, nil)
 	suite.NoError(err)
 	suite.ElementsMatch(sampleSegments[1:], result)
 
 	// Find by collection ID
-	result, err = c.GetSegments(ctx, types.NilUniqueID(), nil, nil, suite.sampleCollections[0].ID)
+	result, err = c.GetSegments(ctx, types.NilUniqueID(), nil, nil, suite.sampleCollections[0].ID, nil, nil)
 	suite.NoError(err)
 	suite.Equal(sampleSegments[:1], result)
 
 	// Find by type and collection ID (positive case)
-	result, err = c.GetSegments(ctx, types.NilUniqueID(), &testTypeA, nil, suite.sampleCollections[0].ID)
+	result, err = c.GetSegments(ctx, types.NilUniqueID(), &testTypeA, nil, suite.sampleCollections[0].ID, nil, nil)
 	suite.NoError(err)
 	suite.Equal(sampleSegments[:1], result)
 
 	// Find by type and collection ID (negative case)
-	result, err = c.GetSegments(ctx, types.NilUniqueID(), &testTypeB, nil, suite.sampleCollections[0].ID)
+	result, err = c.GetSegments(ctx, types.NilUniqueID(), &testTypeB, nil, suite.sampleCollections[0].ID, nil, nil)
 	suite.NoError(err)
 	suite.Empty(result)
 
 	// Delete
 	s1 := sampleSegments[0]
-	err = c.DeleteSegment(ctx, s1.ID)
+	err = c.DeleteSegment(ctx, s1.ID, "", "")
 	suite.NoError(err)
 
-	results, err = c.GetSegments(ctx, types.NilUniqueID(), nil, nil, types.NilUniqueID())
+	results, err = c.GetSegments(ctx, types.NilUniqueID(), nil, nil, types.NilUniqueID(), nil, nil)
 	suite.NoError(err)
 	suite.NotContains(results, s1)
 	suite.Len(results, len(sampleSegments)-1)
 	suite.ElementsMatch(results, sampleSegments[1:])
 
 	// Duplicate delete throws an exception
-	err = c.DeleteSegment(ctx, s1.ID)
+	err = c.DeleteSegment(ctx, s1.ID, "", "")
 	suite.Error(err)
 
 	// clean up segments
 	for _, segment := range sampleSegments {
-		_ = c.DeleteSegment(ctx, segment.ID)
+		_ = c.DeleteSegment(ctx, segment.ID, "", "")
 	}
 }
 
@@ -817,7 +1127,7 @@ func (suite *APIsTestSuite) TestUpdateSegment() {
 		ID:         segment.ID,
 		Metadata:   segment.Metadata})
 	suite.NoError(err)
-	result, err := suite.coordinator.GetSegments(ctx, segment.ID, nil, nil, types.NilUniqueID())
+	result, err := suite.coordinator.GetSegments(ctx, segment.ID, nil, nil, types.NilUniqueID(), nil, nil)
 	suite.NoError(err)
 	suite.Equal([]*model.Segment{segment}, result)
 
@@ -828,7 +1138,7 @@ func (suite *APIsTestSuite) TestUpdateSegment() {
 		ID:         segment.ID,
 		Metadata:   segment.Metadata})
 	suite.NoError(err)
-	result, err = suite.coordinator.GetSegments(ctx, segment.ID, nil, nil, types.NilUniqueID())
+	result, err = suite.coordinator.GetSegments(ctx, segment.ID, nil, nil, types.NilUniqueID(), nil, nil)
 	suite.NoError(err)
 	suite.Equal([]*model.Segment{segment}, result)
 
@@ -841,7 +1151,7 @@ func (suite *APIsTestSuite) TestUpdateSegment() {
 		ID:         segment.ID,
 		Metadata:   newMetadata})
 	suite.NoError(err)
-	result, err = suite.coordinator.GetSegments(ctx, segment.ID, nil, nil, types.NilUniqueID())
+	result, err = suite.coordinator.GetSegments(ctx, segment.ID, nil, nil, types.NilUniqueID(), nil, nil)
 	suite.NoError(err)
 	suite.Equal([]*model.Segment{segment}, result)
 
@@ -854,7 +1164,7 @@ func (suite *APIsTestSuite) TestUpdateSegment() {
 		ResetMetadata: true},
 	)
 	suite.NoError(err)
-	result, err = suite.coordinator.GetSegments(ctx, segment.ID, nil, nil, types.NilUniqueID())
+	result, err = suite.coordinator.GetSegments(ctx, segment.ID, nil, nil, types.NilUniqueID(), nil, nil)
 	suite.NoError(err)
 	suite.Equal([]*model.Segment{segment}, result)
 }
@@ -863,3 +1173,170 @@ func TestAPIsTestSuite(t *testing.T) {
 	testSuite := new(APIsTestSuite)
 	suite.Run(t, testSuite)
 }
+
+func TestVerifyCollectionMetadataLimits(t *testing.T) {
+	metadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	metadata.Add("str", &model.CollectionMetadataValueStringType{Value: "value"})
+	metadata.Add("int", &model.CollectionMetadataValueInt64Type{Value: 1})
+	metadata.Add("bool", &model.CollectionMetadataValueBoolType{Value: true})
+
+	// no limits configured
+	if err := verifyCollectionMetadata(metadata, Config{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// str(3+5) + int(3+8) + bool(4+1) = 24 bytes
+	if err := verifyCollectionMetadata(metadata, Config{MaxMetadataSizeBytes: 24}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cases := []struct {
+		config   Config
+		expected error
+	}{
+		{Config{MaxMetadataKeys: 2}, common.ErrMetadataTooManyKeys},
+		{Config{MaxMetadataKeyLength: 3}, common.ErrMetadataKeyTooLong},
+		{Config{MaxMetadataSizeBytes: 23}, common.ErrMetadataTooLarge},
+	}
+	for _, c := range cases {
+		if err := verifyCollectionMetadata(metadata, c.config); err != c.expected {
+			t.Errorf("expected %v, got %v", c.expected, err)
+		}
+	}
+}
+
+func TestVerifyReplicationFactor(t *testing.T) {
+	factor := func(v int32) *int32 { return &v }
+	cases := []struct {
+		factor   *int32
+		config   Config
+		expected error
+	}{
+		{nil, Config{MaxReplicationFactor: 3}, nil},
+		{factor(3), Config{MaxReplicationFactor: 3}, nil},
+		{factor(10), Config{}, nil},
+		{factor(0), Config{MaxReplicationFactor: 3}, common.ErrInvalidReplicationFactor},
+		{factor(-1), Config{}, common.ErrInvalidReplicationFactor},
+		{factor(4), Config{MaxReplicationFactor: 3}, common.ErrInvalidReplicationFactor},
+	}
+	for _, c := range cases {
+		if err := verifyReplicationFactor(c.factor, c.config); err != c.expected {
+			t.Errorf("expected %v, got %v", c.expected, err)
+		}
+	}
+}
+
+func TestFlushCollectionCompactionRetriesConflicts(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{
+		ctx:     context.Background(),
+		config:  Config{FlushCompactionMaxRetries: 2},
+		catalog: catalog,
+	}
+	flushCollectionCompaction := &model.FlushCollectionCompaction{
+		ID: types.MustParse("00000000-0000-0000-0000-000000000001"),
+	}
+	conflict := fmt.Errorf("%w: could not serialize access", common.ErrTransactionConflict)
+
+	// a conflict is retried until the flush succeeds
+	catalog.On("FlushCollectionCompaction", mock.Anything, flushCollectionCompaction).Return(nil, conflict).Once()
+	catalog.On("FlushCollectionCompaction", mock.Anything, flushCollectionCompaction).Return(&model.FlushCollectionInfo{CollectionVersion: 1}, nil).Once()
+	flushCollectionInfo, err := c.FlushCollectionCompaction(context.Background(), flushCollectionCompaction)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), flushCollectionInfo.CollectionVersion)
+
+	// retries are bounded
+	catalog.On("FlushCollectionCompaction", mock.Anything, flushCollectionCompaction).Return(nil, conflict).Times(3)
+	_, err = c.FlushCollectionCompaction(context.Background(), flushCollectionCompaction)
+	assert.ErrorIs(t, err, common.ErrTransactionConflict)
+
+	// a stale version is not retried
+	catalog.On("FlushCollectionCompaction", mock.Anything, flushCollectionCompaction).Return(nil, common.ErrCollectionVersionStale).Once()
+	_, err = c.FlushCollectionCompaction(context.Background(), flushCollectionCompaction)
+	assert.ErrorIs(t, err, common.ErrCollectionVersionStale)
+
+	catalog.AssertExpectations(t)
+}
+
+func TestGetCollectionsToGcPagination(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{
+		ctx:     context.Background(),
+		catalog: catalog,
+	}
+	page := []*model.CollectionToGc{
+		{ID: types.MustParse("00000000-0000-0000-0000-000000000001")},
+		{ID: types.MustParse("00000000-0000-0000-0000-000000000002")},
+		{ID: types.MustParse("00000000-0000-0000-0000-000000000003")},
+	}
+
+	// one extra row is read to detect the next page
+	catalog.On("GetCollectionsToGc", mock.Anything, mock.MatchedBy(func(filter *model.CollectionsToGcFilter) bool {
+		return filter.Limit == 3
+	}), mock.Anything).Return(page, nil).Once()
+	collections, next, err := c.GetCollectionsToGc(context.Background(), &model.CollectionsToGcFilter{Limit: 2})
+	assert.NoError(t, err)
+	assert.Len(t, collections, 2)
+	assert.Equal(t, page[1].ID, *next)
+
+	// a short page is the last one
+	catalog.On("GetCollectionsToGc", mock.Anything, mock.MatchedBy(func(filter *model.CollectionsToGcFilter) bool {
+		return filter.Limit == 4
+	}), mock.Anything).Return(page, nil).Once()
+	collections, next, err = c.GetCollectionsToGc(context.Background(), &model.CollectionsToGcFilter{Limit: 3})
+	assert.NoError(t, err)
+	assert.Len(t, collections, 3)
+	assert.Nil(t, next)
+
+	// the limit defaults and is capped
+	catalog.On("GetCollectionsToGc", mock.Anything, mock.MatchedBy(func(filter *model.CollectionsToGcFilter) bool {
+		return filter.Limit == defaultCollectionsToGcLimit+1
+	}), mock.Anything).Return(nil, nil).Once()
+	_, _, err = c.GetCollectionsToGc(context.Background(), &model.CollectionsToGcFilter{})
+	assert.NoError(t, err)
+	catalog.On("GetCollectionsToGc", mock.Anything, mock.MatchedBy(func(filter *model.CollectionsToGcFilter) bool {
+		return filter.Limit == maxCollectionsToGcLimit+1
+	}), mock.Anything).Return(nil, nil).Once()
+	_, _, err = c.GetCollectionsToGc(context.Background(), &model.CollectionsToGcFilter{Limit: 5000})
+	assert.NoError(t, err)
+
+	_, _, err = c.GetCollectionsToGc(context.Background(), &model.CollectionsToGcFilter{MinVersions: -1})
+	assert.ErrorIs(t, err, common.ErrInvalidCollectionsToGcFilter)
+	catalog.AssertExpectations(t)
+}
+
+func TestBatchCreateTenantsValidation(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{ctx: context.Background(), catalog: catalog}
+
+	tenants := make([]*model.BatchCreateTenant, maxBatchCreateTenants+1)
+	for i := range tenants {
+		tenants[i] = &model.BatchCreateTenant{Name: "tenant" + strconv.Itoa(i)}
+	}
+	_, err := c.BatchCreateTenants(context.Background(), tenants)
+	assert.ErrorIs(t, err, common.ErrTenantBatchTooLarge)
+	_, err = c.BatchCreateTenants(context.Background(), []*model.BatchCreateTenant{{Name: "tenant0"}, {Name: "tenant0"}})
+	assert.ErrorIs(t, err, common.ErrInvalidTenantBatch)
+	_, err = c.BatchCreateTenants(context.Background(), []*model.BatchCreateTenant{{Name: ""}})
+	assert.ErrorIs(t, err, common.ErrNameEmpty)
+
+	results := []*model.TenantBatchResult{{Name: "tenant0"}, {Name: "tenant1", Err: common.ErrTenantUniqueConstraintViolation}}
+	catalog.On("BatchCreateTenants", mock.Anything, tenants[:2]).Return(results)
+	batchResults, err := c.BatchCreateTenants(context.Background(), tenants[:2])
+	assert.NoError(t, err)
+	assert.Equal(t, results, batchResults)
+	catalog.AssertExpectations(t)
+}
+
+func TestCountCollections(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{ctx: context.Background(), catalog: catalog}
+
+	_, err := c.CountCollections(context.Background(), "", "db", false)
+	assert.ErrorIs(t, err, common.ErrCountCollectionsTenantRequired)
+
+	catalog.On("CountCollections", mock.Anything, "tenant", "", true).Return(int64(7), nil)
+	count, err := c.CountCollections(context.Background(), "tenant", "", true)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), count)
+	catalog.AssertExpectations(t)
+}
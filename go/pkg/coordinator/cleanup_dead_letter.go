@@ -0,0 +1,44 @@
+package coordinator
+
+import (
+	"context"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+)
+
+// cleanupTasks are the background cleanup tasks that record their failures.
+var cleanupTasks = []string{dbmodel.CleanupTaskPurge}
+
+func validateCleanupTask(task string) error {
+	for _, known := range cleanupTasks {
+		if task == known {
+			return nil
+		}
+	}
+	return common.ErrUnknownCleanupTask
+}
+
+// ListCleanupDeadLetters returns the collections a cleanup task keeps failing
+// on, of every task when task is empty, and only the ones the task stopped
+// retrying when deadLetteredOnly is set.
+func (s *Coordinator) ListCleanupDeadLetters(ctx context.Context, task string, deadLetteredOnly bool) ([]*model.CleanupDeadLetter, error) {
+	if task != "" {
+		if err := validateCleanupTask(task); err != nil {
+			return nil, err
+		}
+	}
+	return s.catalog.ListCleanupDeadLetters(ctx, task, deadLetteredOnly)
+}
+
+// DeleteCleanupDeadLetter forgets the failures of a cleanup task on a
+// collection, e.g. once the cause is fixed, so that the task tries the
+// collection again at its next run.
+func (s *Coordinator) DeleteCleanupDeadLetter(ctx context.Context, collectionID types.UniqueID, task string) error {
+	if err := validateCleanupTask(task); err != nil {
+		return err
+	}
+	return s.catalog.DeleteCleanupDeadLetter(ctx, collectionID, task)
+}
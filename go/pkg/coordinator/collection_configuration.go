@@ -0,0 +1,149 @@
+package coordinator
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+)
+
+// hnswConfigurationPrefix marks the collection metadata keys that configure the
+// HNSW index. Query and compaction nodes read them when they build the index.
+const hnswConfigurationPrefix = "hnsw:"
+
+// hnswSpaceKey is the distance function of the collection, also stored in its
+// distance_function column.
+const hnswSpaceKey = model.DistanceFunctionMetadataKey
+
+var hnswSpaces = []string{"l2", "cosine", "ip"}
+
+// hnswConfigurationValidators mirrors the HNSW parameters accepted by the python
+// client, including the persistent index ones.
+var hnswConfigurationValidators = map[string]func(value model.CollectionMetadataValueType) error{
+	hnswSpaceKey:           validateHnswSpace,
+	"hnsw:construction_ef": validateIntInRange(1, math.MaxInt32),
+	"hnsw:search_ef":       validateIntInRange(1, math.MaxInt32),
+	"hnsw:M":               validateIntInRange(2, math.MaxInt32),
+	"hnsw:num_threads":     validateIntInRange(1, math.MaxInt32),
+	"hnsw:resize_factor":   validateResizeFactor,
+	"hnsw:batch_size":      validateIntInRange(3, math.MaxInt32),
+	"hnsw:sync_threshold":  validateIntInRange(3, math.MaxInt32),
+}
+
+// verifyCollectionConfiguration rejects unknown HNSW parameters and parameters
+// with a wrong type or an out of range value. The returned error wraps
+// ErrInvalidCollectionConfiguration and names the offending key, the first in
+// order when several are invalid.
+func verifyCollectionConfiguration(metadata *model.CollectionMetadata[model.CollectionMetadataValueType]) error {
+	if metadata == nil {
+		return nil
+	}
+	keys := make([]string, 0, len(metadata.Metadata))
+	for key := range metadata.Metadata {
+		if strings.HasPrefix(key, hnswConfigurationPrefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := metadata.Metadata[key]
+		validator, ok := hnswConfigurationValidators[key]
+		if !ok {
+			return fmt.Errorf("%w: unknown parameter %s", common.ErrInvalidCollectionConfiguration, key)
+		}
+		if err := validator(value); err != nil {
+			return fmt.Errorf("%w: %s %s", common.ErrInvalidCollectionConfiguration, key, err.Error())
+		}
+	}
+	return nil
+}
+
+// resolveDistanceFunction sets the distance function of a new collection from
+// the hnsw:space metadata when the request does not set it, and the other way
+// around, so that the column and the metadata read by the query nodes agree. It
+// expects metadata verified by verifyCollectionConfiguration.
+func resolveDistanceFunction(createCollection *model.CreateCollection) error {
+	var space *string
+	if createCollection.Metadata != nil {
+		if v, ok := createCollection.Metadata.Get(hnswSpaceKey).(*model.CollectionMetadataValueStringType); ok {
+			space = &v.Value
+		}
+	}
+	if createCollection.DistanceFunction == nil {
+		distanceFunction := model.DefaultDistanceFunction
+		if space != nil {
+			distanceFunction = *space
+		}
+		createCollection.DistanceFunction = &distanceFunction
+		return nil
+	}
+	distanceFunction := *createCollection.DistanceFunction
+	if err := validateHnswSpace(&model.CollectionMetadataValueStringType{Value: distanceFunction}); err != nil {
+		return fmt.Errorf("%w: distance function %s", common.ErrInvalidCollectionConfiguration, err.Error())
+	}
+	if space != nil {
+		if *space != distanceFunction {
+			return fmt.Errorf("%w: distance function %q does not match %s %q", common.ErrInvalidCollectionConfiguration, distanceFunction, hnswSpaceKey, *space)
+		}
+		return nil
+	}
+	if createCollection.Metadata == nil {
+		createCollection.Metadata = model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	}
+	createCollection.Metadata.Add(hnswSpaceKey, &model.CollectionMetadataValueStringType{Value: distanceFunction})
+	return nil
+}
+
+// verifyDimension accepts a nil dimension, which is set by the first records
+// added to the collection.
+func verifyDimension(dimension *int32) error {
+	if dimension != nil && *dimension <= 0 {
+		return fmt.Errorf("%w: dimension must be positive, got %d", common.ErrInvalidCollectionConfiguration, *dimension)
+	}
+	return nil
+}
+
+func validateHnswSpace(value model.CollectionMetadataValueType) error {
+	v, ok := value.(*model.CollectionMetadataValueStringType)
+	if !ok {
+		return fmt.Errorf("must be a string")
+	}
+	for _, space := range hnswSpaces {
+		if v.Value == space {
+			return nil
+		}
+	}
+	return fmt.Errorf("must be one of %s, got %q", strings.Join(hnswSpaces, ", "), v.Value)
+}
+
+func validateIntInRange(min int64, max int64) func(value model.CollectionMetadataValueType) error {
+	return func(value model.CollectionMetadataValueType) error {
+		v, ok := value.(*model.CollectionMetadataValueInt64Type)
+		if !ok {
+			return fmt.Errorf("must be an integer")
+		}
+		if v.Value < min || v.Value > max {
+			return fmt.Errorf("must be between %d and %d, got %d", min, max, v.Value)
+		}
+		return nil
+	}
+}
+
+func validateResizeFactor(value model.CollectionMetadataValueType) error {
+	var factor float64
+	switch v := value.(type) {
+	case *model.CollectionMetadataValueFloat64Type:
+		factor = v.Value
+	case *model.CollectionMetadataValueInt64Type:
+		factor = float64(v.Value)
+	default:
+		return fmt.Errorf("must be a number")
+	}
+	if factor <= 1 {
+		return fmt.Errorf("must be greater than 1, got %v", factor)
+	}
+	return nil
+}
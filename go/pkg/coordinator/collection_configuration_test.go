@@ -0,0 +1,107 @@
+package coordinator
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+)
+
+func TestVerifyCollectionConfiguration(t *testing.T) {
+	valid := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	valid.Add("hnsw:space", &model.CollectionMetadataValueStringType{Value: "cosine"})
+	valid.Add("hnsw:M", &model.CollectionMetadataValueInt64Type{Value: 16})
+	valid.Add("hnsw:resize_factor", &model.CollectionMetadataValueFloat64Type{Value: 1.2})
+	valid.Add("not_a_parameter", &model.CollectionMetadataValueStringType{Value: "ignored"})
+	if err := verifyCollectionConfiguration(valid); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	cases := []struct {
+		key   string
+		value model.CollectionMetadataValueType
+	}{
+		{"hnsw:unknown", &model.CollectionMetadataValueInt64Type{Value: 1}},
+		{"hnsw:space", &model.CollectionMetadataValueStringType{Value: "manhattan"}},
+		{"hnsw:space", &model.CollectionMetadataValueInt64Type{Value: 1}},
+		{"hnsw:M", &model.CollectionMetadataValueInt64Type{Value: 0}},
+		{"hnsw:search_ef", &model.CollectionMetadataValueFloat64Type{Value: 10.5}},
+		{"hnsw:construction_ef", &model.CollectionMetadataValueInt64Type{Value: 1 << 40}},
+		{"hnsw:resize_factor", &model.CollectionMetadataValueFloat64Type{Value: 0.5}},
+		{"hnsw:batch_size", &model.CollectionMetadataValueInt64Type{Value: 2}},
+	}
+	for _, c := range cases {
+		metadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+		metadata.Add(c.key, c.value)
+		err := verifyCollectionConfiguration(metadata)
+		if !errors.Is(err, common.ErrInvalidCollectionConfiguration) {
+			t.Errorf("%s: expected invalid configuration, got %v", c.key, err)
+		}
+	}
+
+	// the first invalid key in order is named, however the map is iterated
+	invalid := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	invalid.Add("hnsw:search_ef", &model.CollectionMetadataValueInt64Type{Value: 0})
+	invalid.Add("hnsw:M", &model.CollectionMetadataValueInt64Type{Value: 0})
+	invalid.Add("hnsw:batch_size", &model.CollectionMetadataValueInt64Type{Value: 0})
+	for i := 0; i < 20; i++ {
+		err := verifyCollectionConfiguration(invalid)
+		if err == nil || !strings.Contains(err.Error(), "hnsw:M ") {
+			t.Fatalf("expected hnsw:M to be named, got %v", err)
+		}
+	}
+}
+
+func TestResolveDistanceFunction(t *testing.T) {
+	// defaults to l2
+	create := &model.CreateCollection{}
+	if err := resolveDistanceFunction(create); err != nil || *create.DistanceFunction != "l2" {
+		t.Fatalf("expected l2, got %v", err)
+	}
+
+	// taken from the metadata
+	metadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	metadata.Add("hnsw:space", &model.CollectionMetadataValueStringType{Value: "ip"})
+	create = &model.CreateCollection{Metadata: metadata}
+	if err := resolveDistanceFunction(create); err != nil || *create.DistanceFunction != "ip" {
+		t.Fatalf("expected ip, got %v", err)
+	}
+
+	// copied to the metadata
+	cosine := "cosine"
+	create = &model.CreateCollection{DistanceFunction: &cosine}
+	if err := resolveDistanceFunction(create); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	space, ok := create.Metadata.Get("hnsw:space").(*model.CollectionMetadataValueStringType)
+	if !ok || space.Value != "cosine" {
+		t.Fatalf("expected hnsw:space cosine, got %v", create.Metadata.Get("hnsw:space"))
+	}
+
+	// conflicting or unknown
+	for _, create := range []*model.CreateCollection{
+		{DistanceFunction: &cosine, Metadata: metadata},
+		{DistanceFunction: new(string)},
+	} {
+		err := resolveDistanceFunction(create)
+		if !errors.Is(err, common.ErrInvalidCollectionConfiguration) {
+			t.Errorf("expected invalid configuration, got %v", err)
+		}
+	}
+}
+
+func TestVerifyDimension(t *testing.T) {
+	dimension := int32(0)
+	if err := verifyDimension(&dimension); !errors.Is(err, common.ErrInvalidCollectionConfiguration) {
+		t.Errorf("expected invalid configuration, got %v", err)
+	}
+	dimension = 1536
+	if err := verifyDimension(&dimension); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := verifyDimension(nil); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
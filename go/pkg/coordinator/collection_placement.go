@@ -0,0 +1,253 @@
+package coordinator
+
+import (
+	"context"
+	"encoding/binary"
+	"hash/fnv"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/chroma-core/chroma/go/pkg/utils"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+const defaultQueryMembersCacheTTL = 5 * time.Second
+
+// MemberlistSource lists the members of a memberlist by id.
+type MemberlistSource func(ctx context.Context) ([]string, error)
+
+// queryMembersCache keeps the query nodes in service, the members of the query
+// memberlist that are not decommissioned, for a few seconds so that placements
+// do not read them on every call. When a read fails the members of the last
+// read are served until the next attempt.
+type queryMembersCache struct {
+	source        MemberlistSource
+	decommissions func(ctx context.Context) ([]*model.NodeDecommission, error)
+	ttl           time.Duration
+	now           func() time.Time
+
+	mu        sync.Mutex
+	members   []string
+	expiresAt time.Time
+}
+
+func newQueryMembersCache(source MemberlistSource, decommissions func(ctx context.Context) ([]*model.NodeDecommission, error)) *queryMembersCache {
+	return &queryMembersCache{
+		source:        source,
+		decommissions: decommissions,
+		ttl:           defaultQueryMembersCacheTTL,
+		now:           time.Now,
+	}
+}
+
+// get returns the members sorted by id.
+func (c *queryMembersCache) get(ctx context.Context) ([]string, error) {
+	now := c.now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if now.Before(c.expiresAt) {
+		return c.members, nil
+	}
+	members, err := c.inService(ctx)
+	if err != nil {
+		if c.members == nil {
+			return nil, err
+		}
+		log.Warn("failed to read the query memberlist, serving the last known members", zap.Error(err))
+		c.expiresAt = now.Add(c.ttl)
+		return c.members, nil
+	}
+	slices.Sort(members)
+	c.members = members
+	c.expiresAt = now.Add(c.ttl)
+	return c.members, nil
+}
+
+func (c *queryMembersCache) inService(ctx context.Context) ([]string, error) {
+	members, err := c.source(ctx)
+	if err != nil {
+		return nil, err
+	}
+	decommissions, err := c.decommissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return membersInService(members, decommissions), nil
+}
+
+// invalidate makes the next get read the members again.
+func (c *queryMembersCache) invalidate() {
+	c.mu.Lock()
+	c.expiresAt = time.Time{}
+	c.mu.Unlock()
+}
+
+func membersInService(members []string, decommissions []*model.NodeDecommission) []string {
+	inService := make([]string, 0, len(members))
+	for _, member := range members {
+		if !slices.ContainsFunc(decommissions, func(d *model.NodeDecommission) bool { return d.NodeID == member }) {
+			inService = append(inService, member)
+		}
+	}
+	return inService
+}
+
+// SetQueryMemberlist sets where the query nodes that collections are placed on
+// are read from. Without it GetCollectionPlacement fails.
+func (s *Coordinator) SetQueryMemberlist(source MemberlistSource) {
+	s.queryMembers = newQueryMembersCache(source, s.catalog.GetNodeDecommissions)
+}
+
+// GetCollectionPlacement assigns a collection to as many query nodes as its
+// replication factor, by rendezvous hashing of the collection id over the query
+// nodes in service. Every replica computes the same placement from the same
+// memberlist.
+func (s *Coordinator) GetCollectionPlacement(ctx context.Context, collectionID types.UniqueID) (*model.CollectionPlacement, error) {
+	if s.queryMembers == nil {
+		return nil, common.ErrNoQueryNodes
+	}
+	collections, err := s.catalog.GetCollections(ctx, collectionID, nil, "", "", nil, nil, nil, &model.CollectionFields{})
+	if err != nil {
+		return nil, err
+	}
+	if len(collections) == 0 {
+		return nil, common.ErrCollectionNotFound
+	}
+	replicationFactor := max(collections[0].ReplicationFactor, 1)
+
+	members, err := s.queryMembers.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(members) == 0 {
+		return nil, common.ErrNoQueryNodes
+	}
+	nodes, err := utils.AssignN(collectionID.String(), members, utils.Murmur3Hasher, int(replicationFactor))
+	if err != nil {
+		return nil, err
+	}
+	return &model.CollectionPlacement{
+		CollectionID:      collectionID,
+		ReplicationFactor: replicationFactor,
+		QueryNodes:        nodes,
+		Version:           placementVersion(members, replicationFactor),
+	}, nil
+}
+
+// placementVersion hashes what a placement is computed from besides the
+// collection id. members must be sorted.
+func placementVersion(members []string, replicationFactor int32) uint64 {
+	hasher := fnv.New64a()
+	hasher.Write(binary.BigEndian.AppendUint32(nil, uint32(replicationFactor)))
+	for _, member := range members {
+		hasher.Write([]byte(member))
+		hasher.Write([]byte{0})
+	}
+	return hasher.Sum64()
+}
+
+const (
+	simulationPageSize     int32 = 1000
+	defaultSimulationMoves int32 = 100
+)
+
+// SimulateCollectionPlacement places every collection of the tenant and
+// database, every collection when they are empty, on both the query nodes in
+// service and queryNodes, so that operators see which collections a scaling
+// event would move before executing it. queryNodes is used as given, the
+// decommissions in effect do not apply to it. At most limit moves are
+// returned, 100 when limit is not positive.
+func (s *Coordinator) SimulateCollectionPlacement(ctx context.Context, queryNodes []string, tenantID string, databaseName string, limit int32) (*model.PlacementSimulation, error) {
+	if len(queryNodes) == 0 || slices.Contains(queryNodes, "") {
+		return nil, common.ErrInvalidSimulatedMemberlist
+	}
+	simulated := slices.Clone(queryNodes)
+	slices.Sort(simulated)
+	simulated = slices.Compact(simulated)
+	if s.queryMembers == nil {
+		return nil, common.ErrNoQueryNodes
+	}
+	current, err := s.queryMembers.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(current) == 0 {
+		return nil, common.ErrNoQueryNodes
+	}
+	if limit <= 0 {
+		limit = defaultSimulationMoves
+	}
+
+	result := &model.PlacementSimulation{}
+	loads := make(map[string]*model.NodeLoad)
+	load := func(nodeID string) *model.NodeLoad {
+		if loads[nodeID] == nil {
+			loads[nodeID] = &model.NodeLoad{NodeID: nodeID}
+		}
+		return loads[nodeID]
+	}
+	for offset := int32(0); ; offset += simulationPageSize {
+		pageSize, pageOffset := simulationPageSize, offset
+		collections, err := s.catalog.GetCollections(ctx, types.NilUniqueID(), nil, tenantID, databaseName, &pageSize, &pageOffset, nil, &model.CollectionFields{})
+		if err != nil {
+			return nil, err
+		}
+		for _, collection := range collections {
+			replicationFactor := int(max(collection.ReplicationFactor, 1))
+			currentNodes, err := utils.AssignN(collection.ID.String(), current, utils.Murmur3Hasher, replicationFactor)
+			if err != nil {
+				return nil, err
+			}
+			simulatedNodes, err := utils.AssignN(collection.ID.String(), simulated, utils.Murmur3Hasher, replicationFactor)
+			if err != nil {
+				return nil, err
+			}
+			for _, node := range currentNodes {
+				load(node).CurrentCollections++
+			}
+			for _, node := range simulatedNodes {
+				load(node).SimulatedCollections++
+			}
+			result.TotalCollections++
+			if sameNodes(currentNodes, simulatedNodes) {
+				continue
+			}
+			result.MovedCollections++
+			if len(result.Moves) < int(limit) {
+				result.Moves = append(result.Moves, &model.CollectionPlacementMove{
+					CollectionID:   collection.ID,
+					CurrentNodes:   currentNodes,
+					SimulatedNodes: simulatedNodes,
+				})
+			}
+		}
+		if int32(len(collections)) < simulationPageSize {
+			break
+		}
+	}
+
+	// nodes without collections are listed too, so that an idle node stands out
+	for _, node := range append(slices.Clone(current), simulated...) {
+		load(node)
+	}
+	result.NodeLoads = make([]*model.NodeLoad, 0, len(loads))
+	for _, nodeLoad := range loads {
+		result.NodeLoads = append(result.NodeLoads, nodeLoad)
+	}
+	slices.SortFunc(result.NodeLoads, func(a, b *model.NodeLoad) int { return strings.Compare(a.NodeID, b.NodeID) })
+	return result, nil
+}
+
+// sameNodes tells whether two placements use the same nodes, in any order.
+func sameNodes(a []string, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
@@ -0,0 +1,114 @@
+package coordinator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/mocks"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetCollectionPlacement(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{ctx: context.Background(), catalog: catalog}
+	ctx := context.Background()
+	collectionID := types.NewUniqueID()
+
+	_, err := c.GetCollectionPlacement(ctx, collectionID)
+	assert.ErrorIs(t, err, common.ErrNoQueryNodes)
+
+	members := []string{"query-2", "query-0", "query-1"}
+	var sourceErr error
+	c.SetQueryMemberlist(func(ctx context.Context) ([]string, error) { return members, sourceErr })
+	now := time.Now()
+	c.queryMembers.now = func() time.Time { return now }
+	catalog.On("GetNodeDecommissions", mock.Anything).Return([]*model.NodeDecommission{}, nil)
+
+	collection := &model.Collection{ID: collectionID, ReplicationFactor: 2}
+	catalog.On("GetCollections", mock.Anything, collectionID, (*string)(nil), "", "", (*int32)(nil), (*int32)(nil), mock.Anything, &model.CollectionFields{}).Return([]*model.Collection{collection}, nil)
+	placement, err := c.GetCollectionPlacement(ctx, collectionID)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), placement.ReplicationFactor)
+	assert.Len(t, placement.QueryNodes, 2)
+	assert.NotEqual(t, placement.QueryNodes[0], placement.QueryNodes[1])
+
+	// the last known members are served while the memberlist cannot be read
+	now = now.Add(time.Minute)
+	members, sourceErr = nil, errors.New("unavailable")
+	again, err := c.GetCollectionPlacement(ctx, collectionID)
+	assert.NoError(t, err)
+	assert.Equal(t, placement, again)
+
+	// a new member changes the version
+	now = now.Add(time.Minute)
+	members, sourceErr = []string{"query-0", "query-1", "query-2", "query-3"}, nil
+	changed, err := c.GetCollectionPlacement(ctx, collectionID)
+	assert.NoError(t, err)
+	assert.NotEqual(t, placement.Version, changed.Version)
+
+	// and so does the replication factor
+	collection.ReplicationFactor = 3
+	changed, err = c.GetCollectionPlacement(ctx, collectionID)
+	assert.NoError(t, err)
+	assert.Len(t, changed.QueryNodes, 3)
+	assert.NotEqual(t, placementVersion([]string{"query-0", "query-1", "query-2", "query-3"}, 2), changed.Version)
+
+	missingID := types.NewUniqueID()
+	catalog.On("GetCollections", mock.Anything, missingID, (*string)(nil), "", "", (*int32)(nil), (*int32)(nil), mock.Anything, &model.CollectionFields{}).Return([]*model.Collection{}, nil)
+	_, err = c.GetCollectionPlacement(ctx, missingID)
+	assert.ErrorIs(t, err, common.ErrCollectionNotFound)
+}
+
+func TestSimulateCollectionPlacement(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{ctx: context.Background(), catalog: catalog}
+	ctx := context.Background()
+
+	_, err := c.SimulateCollectionPlacement(ctx, nil, "", "", 0)
+	assert.ErrorIs(t, err, common.ErrInvalidSimulatedMemberlist)
+	_, err = c.SimulateCollectionPlacement(ctx, []string{"query-0", ""}, "", "", 0)
+	assert.ErrorIs(t, err, common.ErrInvalidSimulatedMemberlist)
+	_, err = c.SimulateCollectionPlacement(ctx, []string{"query-0"}, "", "", 0)
+	assert.ErrorIs(t, err, common.ErrNoQueryNodes)
+
+	current := []string{"query-0", "query-1", "query-2"}
+	c.SetQueryMemberlist(func(ctx context.Context) ([]string, error) { return current, nil })
+	catalog.On("GetNodeDecommissions", mock.Anything).Return([]*model.NodeDecommission{}, nil)
+	collections := make([]*model.Collection, 0, 50)
+	for i := 0; i < 50; i++ {
+		collections = append(collections, &model.Collection{ID: types.NewUniqueID(), ReplicationFactor: int32(i%2 + 1)})
+	}
+	catalog.On("GetCollections", mock.Anything, types.NilUniqueID(), (*string)(nil), "tenant", "database", mock.Anything, mock.Anything, mock.Anything, &model.CollectionFields{}).Return(collections, nil)
+
+	// the same memberlist moves nothing
+	simulation, err := c.SimulateCollectionPlacement(ctx, []string{"query-2", "query-1", "query-0", "query-1"}, "tenant", "database", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(50), simulation.TotalCollections)
+	assert.Equal(t, int64(0), simulation.MovedCollections)
+	assert.Empty(t, simulation.Moves)
+	assert.Len(t, simulation.NodeLoads, 3)
+	for _, load := range simulation.NodeLoads {
+		assert.Equal(t, load.CurrentCollections, load.SimulatedCollections)
+	}
+
+	// removing a node only moves the collections placed on it
+	simulation, err = c.SimulateCollectionPlacement(ctx, []string{"query-0", "query-1"}, "tenant", "database", 2)
+	assert.NoError(t, err)
+	assert.Len(t, simulation.NodeLoads, 3)
+	assert.Equal(t, "query-2", simulation.NodeLoads[2].NodeID)
+	assert.Equal(t, int64(0), simulation.NodeLoads[2].SimulatedCollections)
+	assert.Greater(t, simulation.NodeLoads[2].CurrentCollections, int64(0))
+	assert.Positive(t, simulation.MovedCollections)
+	assert.LessOrEqual(t, simulation.MovedCollections, simulation.NodeLoads[2].CurrentCollections)
+	assert.Len(t, simulation.Moves, min(2, int(simulation.MovedCollections)))
+	for _, move := range simulation.Moves {
+		assert.Contains(t, move.CurrentNodes, "query-2")
+		assert.NotContains(t, move.SimulatedNodes, "query-2")
+	}
+}
@@ -0,0 +1,117 @@
+package coordinator
+
+import (
+	"context"
+	"sort"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+)
+
+// maxSchemaValidationBatch bounds the metadata validated by one call, about
+// the records of a large add.
+const maxSchemaValidationBatch = 1000
+
+// SetCollectionSchema replaces the schema of the document metadata of a
+// collection. Keys that the schema does not declare can hold values of any type.
+func (s *Coordinator) SetCollectionSchema(ctx context.Context, collectionID types.UniqueID, fields []*model.CollectionSchemaField) error {
+	if err := s.beforeCollectionWrite(ctx, &WriteRequest{Operation: WriteSetCollectionSchema, CollectionID: collectionID, Request: fields}); err != nil {
+		return err
+	}
+	if err := verifyCollectionSchema(fields); err != nil {
+		return err
+	}
+	return s.catalog.SetCollectionSchema(ctx, collectionID, fields)
+}
+
+func (s *Coordinator) GetCollectionSchema(ctx context.Context, collectionID types.UniqueID) ([]*model.CollectionSchemaField, error) {
+	return s.catalog.GetCollectionSchema(ctx, collectionID)
+}
+
+// ValidateCollectionMetadata checks a batch of document metadata against the
+// schema of the collection and returns the values of the wrong type, ordered
+// by metadata and key.
+func (s *Coordinator) ValidateCollectionMetadata(ctx context.Context, collectionID types.UniqueID, metadatas []*model.CollectionMetadata[model.CollectionMetadataValueType]) ([]*model.CollectionSchemaViolation, error) {
+	if len(metadatas) > maxSchemaValidationBatch {
+		return nil, common.ErrSchemaValidationBatchTooLarge
+	}
+	fields, err := s.catalog.GetCollectionSchema(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	return checkCollectionSchema(fields, metadatas), nil
+}
+
+func verifyCollectionSchema(fields []*model.CollectionSchemaField) error {
+	names := make(map[string]struct{}, len(fields))
+	for _, field := range fields {
+		if field.Name == "" || !isSchemaFieldType(field.Type) {
+			return common.ErrInvalidCollectionSchema
+		}
+		if _, ok := names[field.Name]; ok {
+			return common.ErrInvalidCollectionSchema
+		}
+		names[field.Name] = struct{}{}
+	}
+	return nil
+}
+
+func isSchemaFieldType(fieldType model.CollectionSchemaFieldType) bool {
+	switch fieldType {
+	case model.CollectionSchemaFieldString, model.CollectionSchemaFieldInt, model.CollectionSchemaFieldFloat, model.CollectionSchemaFieldBool:
+		return true
+	default:
+		return false
+	}
+}
+
+// checkCollectionSchema accepts integers for float fields, since clients
+// serialize whole floats as integers.
+func checkCollectionSchema(fields []*model.CollectionSchemaField, metadatas []*model.CollectionMetadata[model.CollectionMetadataValueType]) []*model.CollectionSchemaViolation {
+	violations := []*model.CollectionSchemaViolation{}
+	if len(fields) == 0 {
+		return violations
+	}
+	fieldTypes := make(map[string]model.CollectionSchemaFieldType, len(fields))
+	for _, field := range fields {
+		fieldTypes[field.Name] = field.Type
+	}
+	for index, metadata := range metadatas {
+		if metadata == nil {
+			continue
+		}
+		keys := make([]string, 0, len(metadata.Metadata))
+		for key := range metadata.Metadata {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			expected, ok := fieldTypes[key]
+			if !ok {
+				continue
+			}
+			actual := schemaFieldTypeOf(metadata.Metadata[key])
+			if actual == expected || (expected == model.CollectionSchemaFieldFloat && actual == model.CollectionSchemaFieldInt) {
+				continue
+			}
+			violations = append(violations, &model.CollectionSchemaViolation{Index: int32(index), Key: key, Expected: expected, Actual: actual})
+		}
+	}
+	return violations
+}
+
+func schemaFieldTypeOf(value model.CollectionMetadataValueType) model.CollectionSchemaFieldType {
+	switch value.(type) {
+	case *model.CollectionMetadataValueStringType:
+		return model.CollectionSchemaFieldString
+	case *model.CollectionMetadataValueInt64Type:
+		return model.CollectionSchemaFieldInt
+	case *model.CollectionMetadataValueFloat64Type:
+		return model.CollectionSchemaFieldFloat
+	case *model.CollectionMetadataValueBoolType:
+		return model.CollectionSchemaFieldBool
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,56 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/mocks"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSetCollectionSchema_Invalid(t *testing.T) {
+	c := &Coordinator{ctx: context.Background(), catalog: &mocks.Catalog{}}
+	for _, fields := range [][]*model.CollectionSchemaField{
+		{{Type: model.CollectionSchemaFieldString}},
+		{{Name: "year", Type: "date"}},
+		{{Name: "year", Type: model.CollectionSchemaFieldInt}, {Name: "year", Type: model.CollectionSchemaFieldFloat}},
+	} {
+		err := c.SetCollectionSchema(context.Background(), types.NewUniqueID(), fields)
+		assert.ErrorIs(t, err, common.ErrInvalidCollectionSchema)
+	}
+}
+
+func TestValidateCollectionMetadata(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{ctx: context.Background(), catalog: catalog}
+	collectionID := types.NewUniqueID()
+	catalog.On("GetCollectionSchema", mock.Anything, collectionID).Return([]*model.CollectionSchemaField{
+		{Name: "title", Type: model.CollectionSchemaFieldString},
+		{Name: "year", Type: model.CollectionSchemaFieldInt},
+		{Name: "score", Type: model.CollectionSchemaFieldFloat},
+	}, nil)
+
+	valid := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	valid.Add("title", &model.CollectionMetadataValueStringType{Value: "dune"})
+	// ints are accepted for floats and undeclared keys hold any type
+	valid.Add("score", &model.CollectionMetadataValueInt64Type{Value: 4})
+	valid.Add("draft", &model.CollectionMetadataValueBoolType{Value: true})
+	invalid := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	invalid.Add("year", &model.CollectionMetadataValueStringType{Value: "1965"})
+	invalid.Add("title", &model.CollectionMetadataValueFloat64Type{Value: 1.5})
+
+	violations, err := c.ValidateCollectionMetadata(context.Background(), collectionID, []*model.CollectionMetadata[model.CollectionMetadataValueType]{valid, nil, invalid})
+	assert.NoError(t, err)
+	assert.Equal(t, []*model.CollectionSchemaViolation{
+		{Index: 2, Key: "title", Expected: model.CollectionSchemaFieldString, Actual: model.CollectionSchemaFieldFloat},
+		{Index: 2, Key: "year", Expected: model.CollectionSchemaFieldInt, Actual: model.CollectionSchemaFieldString},
+	}, violations)
+
+	_, err = c.ValidateCollectionMetadata(context.Background(), collectionID, make([]*model.CollectionMetadata[model.CollectionMetadataValueType], maxSchemaValidationBatch+1))
+	assert.ErrorIs(t, err, common.ErrSchemaValidationBatchTooLarge)
+	catalog.AssertNumberOfCalls(t, "GetCollectionSchema", 1)
+}
@@ -0,0 +1,127 @@
+package coordinator
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// collectionSizeHistoryCheckInterval is how often the job checks whether the
+// snapshot of the day was taken. A failed snapshot is retried at the next
+// check.
+const collectionSizeHistoryCheckInterval = time.Hour
+
+const oneDay = 24 * time.Hour
+
+// collectionSizeHistoryJob snapshots the size of every collection once a day,
+// and prunes the snapshots older than the retention, so that growth trends are
+// available without an external metrics pipeline.
+type collectionSizeHistoryJob struct {
+	metaDomain dbmodel.IMetaDomain
+	retention  time.Duration
+	now        func() time.Time
+
+	runs metric.Int64Counter
+
+	lastSnapshot time.Time
+	cancel       context.CancelFunc
+	wg           sync.WaitGroup
+}
+
+// newCollectionSizeHistoryJob returns nil when the config does not enable the
+// job.
+func newCollectionSizeHistoryJob(config Config, metaDomain dbmodel.IMetaDomain) (*collectionSizeHistoryJob, error) {
+	if config.CollectionSizeHistoryRetention <= 0 {
+		return nil, nil
+	}
+	runs, err := otel.Meter("chroma.coordinator").Int64Counter("sysdb.collection_size_history.snapshots",
+		metric.WithDescription("Number of daily collection size snapshots by status"))
+	if err != nil {
+		return nil, err
+	}
+	return &collectionSizeHistoryJob{
+		metaDomain: metaDomain,
+		retention:  config.CollectionSizeHistoryRetention,
+		now:        time.Now,
+		runs:       runs,
+	}, nil
+}
+
+func (j *collectionSizeHistoryJob) Start(ctx context.Context) {
+	ctx, j.cancel = context.WithCancel(ctx)
+	j.wg.Add(1)
+	go func() {
+		defer j.wg.Done()
+		ticker := time.NewTicker(collectionSizeHistoryCheckInterval)
+		defer ticker.Stop()
+		for {
+			j.runIfDue(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	log.Info("collection size history job started", zap.Duration("retention", j.retention))
+}
+
+func (j *collectionSizeHistoryJob) Stop() {
+	if j.cancel != nil {
+		j.cancel()
+	}
+	j.wg.Wait()
+}
+
+// runIfDue takes the snapshot of the day unless this replica already took it.
+// Another replica that took it before a leader change is harmless, the
+// snapshot of a day is only written once.
+func (j *collectionSizeHistoryJob) runIfDue(ctx context.Context) {
+	today := j.now().UTC().Truncate(oneDay)
+	if !j.lastSnapshot.Before(today) {
+		return
+	}
+	err := j.run(ctx, today)
+	status := "success"
+	if err != nil {
+		status = "failure"
+		log.Error("collection size snapshot failed", zap.Time("day", today), zap.Error(err))
+	} else {
+		j.lastSnapshot = today
+		log.Info("collection size snapshot taken", zap.Time("day", today))
+	}
+	j.runs.Add(ctx, 1, metric.WithAttributes(attribute.String("status", status)))
+}
+
+func (j *collectionSizeHistoryJob) run(ctx context.Context, today time.Time) error {
+	historyDb := j.metaDomain.CollectionSizeHistoryDb(ctx)
+	err := historyDb.Snapshot(today)
+	if err != nil {
+		return err
+	}
+	return historyDb.DeleteBefore(today.Add(-j.retention))
+}
+
+// GetCollectionSizeHistory returns the daily snapshots of the size of a
+// collection over the last days, oldest first. Zero days returns every
+// snapshot retained.
+func (s *Coordinator) GetCollectionSizeHistory(ctx context.Context, collectionID types.UniqueID, days int32) ([]*model.CollectionSizeSnapshot, error) {
+	if days < 0 {
+		return nil, common.ErrInvalidCollectionSizeHistoryDays
+	}
+	var since time.Time
+	if days > 0 {
+		since = time.Now().UTC().Truncate(oneDay).Add(-time.Duration(days-1) * oneDay)
+	}
+	return s.catalog.GetCollectionSizeHistory(ctx, collectionID, since)
+}
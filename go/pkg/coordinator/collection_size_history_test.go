@@ -0,0 +1,59 @@
+package coordinator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel/mocks"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCollectionSizeHistoryJob_RunIfDue(t *testing.T) {
+	job, err := newCollectionSizeHistoryJob(Config{}, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+
+	mockMetaDomain := &mocks.IMetaDomain{}
+	mockHistoryDb := &mocks.ICollectionSizeHistoryDb{}
+	mockMetaDomain.On("CollectionSizeHistoryDb", mock.Anything).Return(mockHistoryDb)
+	job, err = newCollectionSizeHistoryJob(Config{CollectionSizeHistoryRetention: 30 * 24 * time.Hour}, mockMetaDomain)
+	assert.NoError(t, err)
+	now := time.Date(2026, 10, 14, 9, 30, 0, 0, time.UTC)
+	job.now = func() time.Time { return now }
+
+	today := time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)
+	mockHistoryDb.On("Snapshot", today).Return(errors.New("canceling statement due to statement timeout")).Once()
+	mockHistoryDb.On("Snapshot", today).Return(nil)
+	mockHistoryDb.On("DeleteBefore", time.Date(2026, 9, 14, 0, 0, 0, 0, time.UTC)).Return(nil)
+
+	// a failed snapshot is retried at the next check
+	job.runIfDue(context.Background())
+	mockHistoryDb.AssertNumberOfCalls(t, "DeleteBefore", 0)
+	now = now.Add(time.Hour)
+	job.runIfDue(context.Background())
+	mockHistoryDb.AssertNumberOfCalls(t, "Snapshot", 2)
+	mockHistoryDb.AssertNumberOfCalls(t, "DeleteBefore", 1)
+
+	// and a snapshot is only taken once a day
+	now = now.Add(time.Hour)
+	job.runIfDue(context.Background())
+	mockHistoryDb.AssertNumberOfCalls(t, "Snapshot", 2)
+
+	tomorrow := today.Add(24 * time.Hour)
+	mockHistoryDb.On("Snapshot", tomorrow).Return(nil)
+	mockHistoryDb.On("DeleteBefore", time.Date(2026, 9, 15, 0, 0, 0, 0, time.UTC)).Return(nil)
+	now = tomorrow.Add(time.Minute)
+	job.runIfDue(context.Background())
+	mockHistoryDb.AssertNumberOfCalls(t, "Snapshot", 3)
+}
+
+func TestGetCollectionSizeHistory_InvalidDays(t *testing.T) {
+	c := &Coordinator{ctx: context.Background()}
+	_, err := c.GetCollectionSizeHistory(context.Background(), types.NewUniqueID(), -1)
+	assert.ErrorIs(t, err, common.ErrInvalidCollectionSizeHistoryDays)
+}
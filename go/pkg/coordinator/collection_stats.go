@@ -0,0 +1,163 @@
+package coordinator
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
+	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// collectionStatsLagBatchSize is how many collections the log lag is read for
+// in a single call to the log service.
+const collectionStatsLagBatchSize = 500
+
+// collectionStatsJob refreshes the collection_stats rollup every interval, so
+// that dashboards read their aggregates from it instead of the hot tables. The
+// log lag is only rolled up when the coordinator has a log service.
+type collectionStatsJob struct {
+	metaDomain dbmodel.IMetaDomain
+	lags       logLagSource
+	interval   time.Duration
+	now        func() time.Time
+
+	runs     metric.Int64Counter
+	duration metric.Float64Histogram
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// newCollectionStatsJob returns nil when the config does not enable the job.
+func newCollectionStatsJob(config Config, metaDomain dbmodel.IMetaDomain, lags logLagSource) (*collectionStatsJob, error) {
+	if config.CollectionStatsRefreshInterval <= 0 {
+		return nil, nil
+	}
+	meter := otel.Meter("chroma.coordinator")
+	runs, err := meter.Int64Counter("sysdb.collection_stats.refreshes",
+		metric.WithDescription("Number of collection stats refreshes by status"))
+	if err != nil {
+		return nil, err
+	}
+	duration, err := meter.Float64Histogram("sysdb.collection_stats.refresh_duration",
+		metric.WithDescription("Duration of collection stats refreshes"),
+		metric.WithUnit("ms"))
+	if err != nil {
+		return nil, err
+	}
+	return &collectionStatsJob{
+		metaDomain: metaDomain,
+		lags:       lags,
+		interval:   config.CollectionStatsRefreshInterval,
+		now:        time.Now,
+		runs:       runs,
+		duration:   duration,
+	}, nil
+}
+
+func (j *collectionStatsJob) Start(ctx context.Context) {
+	ctx, j.cancel = context.WithCancel(ctx)
+	j.wg.Add(1)
+	go func() {
+		defer j.wg.Done()
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+		for {
+			j.run(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	log.Info("collection stats job started", zap.Duration("interval", j.interval), zap.Bool("logLag", j.lags != nil))
+}
+
+func (j *collectionStatsJob) Stop() {
+	if j.cancel != nil {
+		j.cancel()
+	}
+	j.wg.Wait()
+}
+
+func (j *collectionStatsJob) run(ctx context.Context) {
+	started := time.Now()
+	err := j.refresh(ctx)
+	elapsed := time.Since(started)
+	status := "success"
+	if err != nil {
+		status = "failure"
+		log.Error("collection stats refresh failed", zap.Error(err))
+	} else {
+		log.Info("collection stats refreshed", zap.Duration("elapsed", elapsed))
+	}
+	attributes := metric.WithAttributes(attribute.String("status", status))
+	j.runs.Add(ctx, 1, attributes)
+	j.duration.Record(ctx, float64(elapsed.Microseconds())/1000, attributes)
+}
+
+func (j *collectionStatsJob) refresh(ctx context.Context) error {
+	statsDb := j.metaDomain.CollectionStatsDb(ctx)
+	err := statsDb.Refresh(j.now().UTC())
+	if err != nil || j.lags == nil {
+		return err
+	}
+	collectionIDs, err := statsDb.GetCollectionIDs()
+	if err != nil {
+		return err
+	}
+	for start := 0; start < len(collectionIDs); start += collectionStatsLagBatchSize {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		batch := collectionIDs[start:min(start+collectionStatsLagBatchSize, len(collectionIDs))]
+		req := &logservicepb.GetCollectionLogLagRequest{CollectionIds: make([]string, 0, len(batch))}
+		for _, collectionID := range batch {
+			req.CollectionIds = append(req.CollectionIds, collectionID.String())
+		}
+		res, err := j.lags.GetCollectionLogLag(ctx, req)
+		if err != nil {
+			return err
+		}
+		fetched := make(map[string]*logservicepb.CollectionLogLag, len(res.Lags))
+		for _, lagpb := range res.Lags {
+			fetched[lagpb.CollectionId] = lagpb
+		}
+		lags := make([]*dbmodel.CollectionLogLagStats, 0, len(batch))
+		for _, collectionID := range batch {
+			// collections that never received a record have no lag
+			lag := &dbmodel.CollectionLogLagStats{CollectionID: collectionID}
+			if lagpb, ok := fetched[collectionID.String()]; ok {
+				lag.UncompactedRecords = lagpb.UncompactedRecords
+				if lagpb.OldestUncompactedTs > 0 {
+					oldest := time.Unix(0, lagpb.OldestUncompactedTs).UTC()
+					lag.OldestUncompactedAt = &oldest
+				}
+			}
+			lags = append(lags, lag)
+		}
+		err = statsDb.UpdateLogLag(lags)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListCollectionStats returns the rolled up stats of the collections of a
+// tenant, of one of its databases when databaseName is set, largest first.
+func (s *Coordinator) ListCollectionStats(ctx context.Context, tenantID string, databaseName string, limit int32, offset int32) ([]*model.CollectionStats, error) {
+	if limit <= 0 || offset < 0 {
+		return nil, common.ErrInvalidCollectionStatsPage
+	}
+	return s.catalog.ListCollectionStats(ctx, tenantID, databaseName, limit, offset)
+}
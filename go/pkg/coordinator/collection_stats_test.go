@@ -0,0 +1,76 @@
+package coordinator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel/mocks"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCollectionStatsJob_Config(t *testing.T) {
+	job, err := newCollectionStatsJob(Config{}, nil, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+
+	job, err = newCollectionStatsJob(Config{CollectionStatsRefreshInterval: time.Minute}, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, job.interval)
+}
+
+func TestCollectionStatsJob_Refresh(t *testing.T) {
+	mockMetaDomain := &mocks.IMetaDomain{}
+	mockStatsDb := &mocks.ICollectionStatsDb{}
+	mockMetaDomain.On("CollectionStatsDb", mock.Anything).Return(mockStatsDb)
+
+	now := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+	lagging, compacted := dbmodel.NewCollectionID(types.NewUniqueID()), dbmodel.NewCollectionID(types.NewUniqueID())
+	source := &fakeLogLagSource{lags: []*logservicepb.CollectionLogLag{
+		{CollectionId: lagging.String(), UncompactedRecords: 42, OldestUncompactedTs: now.Add(-time.Minute).UnixNano()},
+	}}
+	mockStatsDb.On("Refresh", now).Return(nil)
+	mockStatsDb.On("GetCollectionIDs").Return([]dbmodel.CollectionID{compacted, lagging}, nil)
+	mockStatsDb.On("UpdateLogLag", mock.Anything).Return(nil)
+
+	job, err := newCollectionStatsJob(Config{CollectionStatsRefreshInterval: time.Minute}, mockMetaDomain, source)
+	assert.NoError(t, err)
+	job.now = func() time.Time { return now }
+	assert.NoError(t, job.refresh(context.Background()))
+
+	assert.Equal(t, [][]string{{compacted.String(), lagging.String()}}, source.requests)
+	oldest := now.Add(-time.Minute)
+	mockStatsDb.AssertCalled(t, "UpdateLogLag", []*dbmodel.CollectionLogLagStats{
+		// a collection the log does not know has no lag
+		{CollectionID: compacted},
+		{CollectionID: lagging, UncompactedRecords: 42, OldestUncompactedAt: &oldest},
+	})
+}
+
+func TestCollectionStatsJob_RefreshWithoutLogService(t *testing.T) {
+	mockMetaDomain := &mocks.IMetaDomain{}
+	mockStatsDb := &mocks.ICollectionStatsDb{}
+	mockMetaDomain.On("CollectionStatsDb", mock.Anything).Return(mockStatsDb)
+	mockStatsDb.On("Refresh", mock.Anything).Return(errors.New("canceling statement due to statement timeout")).Once()
+	mockStatsDb.On("Refresh", mock.Anything).Return(nil)
+
+	job, err := newCollectionStatsJob(Config{CollectionStatsRefreshInterval: time.Minute}, mockMetaDomain, nil)
+	assert.NoError(t, err)
+	assert.Error(t, job.refresh(context.Background()))
+	assert.NoError(t, job.refresh(context.Background()))
+	mockStatsDb.AssertNotCalled(t, "GetCollectionIDs")
+}
+
+func TestListCollectionStats(t *testing.T) {
+	c := &Coordinator{ctx: context.Background()}
+	_, err := c.ListCollectionStats(context.Background(), "tenant", "", 0, 0)
+	assert.ErrorIs(t, err, common.ErrInvalidCollectionStatsPage)
+	_, err = c.ListCollectionStats(context.Background(), "tenant", "", 10, -1)
+	assert.ErrorIs(t, err, common.ErrInvalidCollectionStatsPage)
+}
@@ -0,0 +1,136 @@
+package coordinator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+)
+
+// CreateCollectionTemplate registers a collection template for a tenant. A nil
+// ID is replaced with a new one. The configuration is verified like the one of
+// a new collection, and the distance function is resolved against the
+// hnsw:space metadata so that every collection of the template gets the same.
+func (s *Coordinator) CreateCollectionTemplate(ctx context.Context, template *model.CollectionTemplate) (*model.CollectionTemplate, error) {
+	if template.ID == types.NilUniqueID() {
+		template.ID = types.NewUniqueID()
+	}
+	if template.TenantID == "" || template.Name == "" {
+		return nil, common.ErrInvalidCollectionTemplate
+	}
+	if err := s.beforeWrite(ctx, &WriteRequest{Operation: WriteCreateCollectionTemplate, Tenant: template.TenantID, Request: template}); err != nil {
+		return nil, err
+	}
+	for _, limit := range []*int64{template.MaxRecords, template.MaxSizeBytes} {
+		if limit != nil && *limit < 0 {
+			return nil, common.ErrInvalidCollectionTemplate
+		}
+	}
+	if err := verifyCollectionMetadata(template.Metadata, s.config); err != nil {
+		return nil, err
+	}
+	if err := verifyDimension(template.Dimension); err != nil {
+		return nil, err
+	}
+	if err := verifyReplicationFactor(template.ReplicationFactor, s.config); err != nil {
+		return nil, err
+	}
+	resolved := &model.CreateCollection{Metadata: template.Metadata, DistanceFunction: template.DistanceFunction}
+	if err := resolveDistanceFunction(resolved); err != nil {
+		return nil, err
+	}
+	template.Metadata = resolved.Metadata
+	template.DistanceFunction = resolved.DistanceFunction
+	return s.catalog.CreateCollectionTemplate(ctx, template)
+}
+
+// GetCollectionTemplates returns the template with the ID when it is set, and
+// the templates of the tenant otherwise, narrowed to the one with the name when
+// it is set.
+func (s *Coordinator) GetCollectionTemplates(ctx context.Context, id types.UniqueID, tenantID string, name *string) ([]*model.CollectionTemplate, error) {
+	if id == types.NilUniqueID() && tenantID == "" {
+		return nil, common.ErrInvalidCollectionTemplate
+	}
+	return s.catalog.GetCollectionTemplates(ctx, id, tenantID, name)
+}
+
+func (s *Coordinator) DeleteCollectionTemplate(ctx context.Context, id types.UniqueID) error {
+	if len(s.preWriteHooks) > 0 {
+		templates, err := s.catalog.GetCollectionTemplates(ctx, id, "", nil)
+		if err != nil {
+			return err
+		}
+		if len(templates) == 0 {
+			return common.ErrCollectionTemplateNotFound
+		}
+		if err := s.beforeWrite(ctx, &WriteRequest{Operation: WriteDeleteCollectionTemplate, Tenant: templates[0].TenantID, Request: id}); err != nil {
+			return err
+		}
+	}
+	return s.catalog.DeleteCollectionTemplate(ctx, id)
+}
+
+// CreateCollectionFromTemplate creates a collection with the dimension,
+// distance function, replication factor and default metadata of a template of
+// its tenant, and goes through the checks of CreateCollection. The collection
+// must leave the fields the template sets unset.
+func (s *Coordinator) CreateCollectionFromTemplate(ctx context.Context, createFromTemplate *model.CreateCollectionFromTemplate) (*model.Collection, error) {
+	createCollection := createFromTemplate.Collection
+	var templates []*model.CollectionTemplate
+	var err error
+	if createFromTemplate.TemplateID != types.NilUniqueID() {
+		templates, err = s.catalog.GetCollectionTemplates(ctx, createFromTemplate.TemplateID, "", nil)
+	} else if createFromTemplate.TemplateName != "" {
+		templates, err = s.catalog.GetCollectionTemplates(ctx, types.NilUniqueID(), createCollection.TenantID, &createFromTemplate.TemplateName)
+	} else {
+		return nil, common.ErrInvalidCollectionTemplate
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(templates) == 0 || templates[0].TenantID != createCollection.TenantID {
+		return nil, common.ErrCollectionTemplateNotFound
+	}
+	template := templates[0]
+	if createCollection.Dimension != nil || createCollection.DistanceFunction != nil || createCollection.ReplicationFactor != nil {
+		return nil, fmt.Errorf("%w: the dimension, distance function and replication factor are set by the template", common.ErrInvalidCollectionConfiguration)
+	}
+
+	metadata, err := mergeTemplateMetadata(template.Metadata, createCollection.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	createCollection.Metadata = metadata
+	createCollection.Dimension = template.Dimension
+	createCollection.DistanceFunction = template.DistanceFunction
+	createCollection.ReplicationFactor = template.ReplicationFactor
+	createCollection.TemplateID = &template.ID
+	return s.CreateCollection(ctx, createCollection)
+}
+
+// mergeTemplateMetadata returns the default metadata of a template with the
+// metadata of a new collection over it. The collection can override the
+// defaults but not the HNSW parameters, which configure the index the template
+// standardizes.
+func mergeTemplateMetadata(defaults *model.CollectionMetadata[model.CollectionMetadataValueType], metadata *model.CollectionMetadata[model.CollectionMetadataValueType]) (*model.CollectionMetadata[model.CollectionMetadataValueType], error) {
+	if defaults == nil {
+		return metadata, nil
+	}
+	merged := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	for key, value := range defaults.Metadata {
+		merged.Add(key, value)
+	}
+	if metadata == nil {
+		return merged, nil
+	}
+	for key, value := range metadata.Metadata {
+		if current, ok := defaults.Metadata[key]; ok && strings.HasPrefix(key, hnswConfigurationPrefix) && !current.Equals(value) {
+			return nil, fmt.Errorf("%w: %s is set by the template", common.ErrInvalidCollectionConfiguration, key)
+		}
+		merged.Add(key, value)
+	}
+	return merged, nil
+}
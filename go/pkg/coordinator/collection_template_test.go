@@ -0,0 +1,87 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/mocks"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateCollectionTemplate(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{ctx: context.Background(), catalog: catalog}
+
+	metadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	metadata.Add("hnsw:space", &model.CollectionMetadataValueStringType{Value: "cosine"})
+	catalog.On("CreateCollectionTemplate", mock.Anything, mock.MatchedBy(func(in *model.CollectionTemplate) bool {
+		return in.ID != types.NilUniqueID() && *in.DistanceFunction == "cosine"
+	})).Return(&model.CollectionTemplate{Name: "documents"}, nil)
+	_, err := c.CreateCollectionTemplate(context.Background(), &model.CollectionTemplate{TenantID: "tenant", Name: "documents", Metadata: metadata})
+	assert.NoError(t, err)
+
+	negative := int64(-1)
+	for _, template := range []*model.CollectionTemplate{
+		{Name: "documents"},
+		{TenantID: "tenant"},
+		{TenantID: "tenant", Name: "documents", MaxRecords: &negative},
+	} {
+		_, err := c.CreateCollectionTemplate(context.Background(), template)
+		assert.ErrorIs(t, err, common.ErrInvalidCollectionTemplate)
+	}
+	distanceFunction := "l2"
+	_, err = c.CreateCollectionTemplate(context.Background(), &model.CollectionTemplate{TenantID: "tenant", Name: "documents", Metadata: metadata, DistanceFunction: &distanceFunction})
+	assert.ErrorIs(t, err, common.ErrInvalidCollectionConfiguration)
+	catalog.AssertNumberOfCalls(t, "CreateCollectionTemplate", 1)
+}
+
+func TestCreateCollectionFromTemplate(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{ctx: context.Background(), catalog: catalog}
+
+	dimension := int32(384)
+	distanceFunction := "cosine"
+	defaults := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	defaults.Add("hnsw:space", &model.CollectionMetadataValueStringType{Value: "cosine"})
+	defaults.Add("team", &model.CollectionMetadataValueStringType{Value: "platform"})
+	template := &model.CollectionTemplate{ID: types.NewUniqueID(), TenantID: "tenant", Name: "documents", Dimension: &dimension, DistanceFunction: &distanceFunction, Metadata: defaults}
+	name := "documents"
+	catalog.On("GetCollectionTemplates", mock.Anything, types.NilUniqueID(), "tenant", &name).Return([]*model.CollectionTemplate{template}, nil)
+	catalog.On("GetCollectionTemplates", mock.Anything, template.ID, "", (*string)(nil)).Return([]*model.CollectionTemplate{template}, nil)
+	catalog.On("CreateCollection", mock.Anything, mock.MatchedBy(func(in *model.CreateCollection) bool {
+		team := in.Metadata.Get("team").(*model.CollectionMetadataValueStringType)
+		return *in.Dimension == 384 && *in.DistanceFunction == "cosine" && *in.TemplateID == template.ID && team.Value == "search"
+	}), mock.Anything).Return(&model.Collection{Name: "articles"}, nil)
+
+	// the collection overrides the default metadata that is not an index parameter
+	metadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	metadata.Add("team", &model.CollectionMetadataValueStringType{Value: "search"})
+	_, err := c.CreateCollectionFromTemplate(context.Background(), &model.CreateCollectionFromTemplate{
+		TemplateName: "documents",
+		Collection:   &model.CreateCollection{ID: types.NewUniqueID(), Name: "articles", TenantID: "tenant", DatabaseName: "database", Metadata: metadata},
+	})
+	assert.NoError(t, err)
+
+	metadata.Add("hnsw:space", &model.CollectionMetadataValueStringType{Value: "ip"})
+	_, err = c.CreateCollectionFromTemplate(context.Background(), &model.CreateCollectionFromTemplate{
+		TemplateID: template.ID,
+		Collection: &model.CreateCollection{ID: types.NewUniqueID(), Name: "articles", TenantID: "tenant", DatabaseName: "database", Metadata: metadata},
+	})
+	assert.ErrorIs(t, err, common.ErrInvalidCollectionConfiguration)
+	_, err = c.CreateCollectionFromTemplate(context.Background(), &model.CreateCollectionFromTemplate{
+		TemplateID: template.ID,
+		Collection: &model.CreateCollection{ID: types.NewUniqueID(), Name: "articles", TenantID: "tenant", DatabaseName: "database", Dimension: &dimension},
+	})
+	assert.ErrorIs(t, err, common.ErrInvalidCollectionConfiguration)
+	// templates are only used by the collections of their tenant
+	_, err = c.CreateCollectionFromTemplate(context.Background(), &model.CreateCollectionFromTemplate{
+		TemplateID: template.ID,
+		Collection: &model.CreateCollection{ID: types.NewUniqueID(), Name: "articles", TenantID: "other_tenant", DatabaseName: "database"},
+	})
+	assert.ErrorIs(t, err, common.ErrCollectionTemplateNotFound)
+	catalog.AssertNumberOfCalls(t, "CreateCollection", 1)
+}
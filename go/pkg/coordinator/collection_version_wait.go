@@ -0,0 +1,121 @@
+package coordinator
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+)
+
+const (
+	// maxCollectionVersionWait bounds the wait of the callers without a
+	// deadline, and of the ones with a later deadline.
+	maxCollectionVersionWait = 30 * time.Second
+	// collectionVersionWaitMargin is kept from the deadline of the caller to
+	// read the collection once the wait is over.
+	collectionVersionWaitMargin = 100 * time.Millisecond
+	// collectionVersionPollInterval is how often the version is read again while
+	// waiting. Compactions flushed through this coordinator wake the waiters
+	// right away, the others, flushed through the leader when this is a
+	// standby, are only seen by polling.
+	collectionVersionPollInterval = time.Second
+)
+
+// collectionVersionWaiters wakes up the callers waiting for a new version of a
+// collection. The zero value is ready to use.
+type collectionVersionWaiters struct {
+	mu      sync.Mutex
+	waiters map[types.UniqueID]*collectionVersionWaiter
+}
+
+// collectionVersionWaiter is shared by the callers waiting for the same
+// collection, and removed once the last of them stops waiting.
+type collectionVersionWaiter struct {
+	ch      chan struct{}
+	waiting int
+}
+
+// wait returns a channel closed by the next notify of the collection, and a
+// function to call once the caller stops waiting on it.
+func (w *collectionVersionWaiters) wait(collectionID types.UniqueID) (<-chan struct{}, func()) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.waiters == nil {
+		w.waiters = make(map[types.UniqueID]*collectionVersionWaiter)
+	}
+	waiter, ok := w.waiters[collectionID]
+	if !ok {
+		waiter = &collectionVersionWaiter{ch: make(chan struct{})}
+		w.waiters[collectionID] = waiter
+	}
+	waiter.waiting++
+	return waiter.ch, func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		waiter.waiting--
+		// a notify already removed the waiter it closed
+		if waiter.waiting == 0 && w.waiters[collectionID] == waiter {
+			delete(w.waiters, collectionID)
+		}
+	}
+}
+
+func (w *collectionVersionWaiters) notify(collectionID types.UniqueID) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if waiter, ok := w.waiters[collectionID]; ok {
+		close(waiter.ch)
+		delete(w.waiters, collectionID)
+	}
+}
+
+// WaitForCollectionVersion blocks until the version of the collection is at
+// least minVersion, so that query nodes waiting for a compaction to land do not
+// have to poll. It gives up without an error at the deadline of ctx, less
+// collectionVersionWaitMargin, or after maxCollectionVersionWait, and when the
+// collection does not exist, leaving it to the caller to read the collection
+// and compare its version.
+func (s *Coordinator) WaitForCollectionVersion(ctx context.Context, collectionID types.UniqueID, minVersion int32) error {
+	deadline := time.Now().Add(maxCollectionVersionWait)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Add(-collectionVersionWaitMargin).Before(deadline) {
+		deadline = ctxDeadline.Add(-collectionVersionWaitMargin)
+	}
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+	ticker := time.NewTicker(collectionVersionPollInterval)
+	defer ticker.Stop()
+	for {
+		done, err := s.waitForCollectionFlush(ctx, collectionID, minVersion, timer.C, ticker.C)
+		if done || err != nil {
+			return err
+		}
+	}
+}
+
+// waitForCollectionFlush reads the version of the collection and waits for the
+// next flush or poll. It reports whether the wait of WaitForCollectionVersion
+// is over.
+func (s *Coordinator) waitForCollectionFlush(ctx context.Context, collectionID types.UniqueID, minVersion int32, deadline <-chan time.Time, poll <-chan time.Time) (bool, error) {
+	// subscribe before reading, so that a flush between the read and the wait
+	// is not missed
+	flushed, release := s.versionWaiters.wait(collectionID)
+	defer release()
+	collections, err := s.catalog.GetCollections(ctx, collectionID, nil, "", "", nil, nil, nil, &model.CollectionFields{})
+	if err != nil {
+		return true, err
+	}
+	if len(collections) == 0 || collections[0].Version >= minVersion {
+		return true, nil
+	}
+	select {
+	case <-ctx.Done():
+		return true, ctx.Err()
+	case <-deadline:
+		return true, nil
+	case <-flushed:
+	case <-poll:
+	}
+	return false, nil
+}
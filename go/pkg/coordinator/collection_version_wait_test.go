@@ -0,0 +1,58 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/mocks"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWaitForCollectionVersion(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{ctx: context.Background(), catalog: catalog}
+	collectionID := types.NewUniqueID()
+	getCollections := func() *mock.Call {
+		return catalog.On("GetCollections", mock.Anything, collectionID, (*string)(nil), "", "", (*int32)(nil), (*int32)(nil), mock.Anything, &model.CollectionFields{})
+	}
+	getCollections().Return([]*model.Collection{{ID: collectionID, Version: 2}}, nil).Once()
+	getCollections().Return([]*model.Collection{{ID: collectionID, Version: 3}}, nil).Once()
+
+	// the flush of the compaction wakes the waiter before the next poll
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		c.versionWaiters.notify(collectionID)
+	}()
+	start := time.Now()
+	err := c.WaitForCollectionVersion(context.Background(), collectionID, 3)
+	assert.NoError(t, err)
+	assert.Less(t, time.Since(start), collectionVersionPollInterval)
+	catalog.AssertNumberOfCalls(t, "GetCollections", 2)
+
+	// the wait is over shortly before the deadline of the caller
+	getCollections().Return([]*model.Collection{{ID: collectionID, Version: 3}}, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), collectionVersionWaitMargin+50*time.Millisecond)
+	defer cancel()
+	err = c.WaitForCollectionVersion(ctx, collectionID, 4)
+	assert.NoError(t, err)
+	assert.NoError(t, ctx.Err())
+
+	// the waiters of a collection that is not flushed are removed
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	err = c.WaitForCollectionVersion(ctx, collectionID, 4)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, c.versionWaiters.waiters)
+}
+
+func TestWaitForCollectionVersion_NotFound(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{ctx: context.Background(), catalog: catalog}
+	collectionID := types.NewUniqueID()
+	catalog.On("GetCollections", mock.Anything, collectionID, (*string)(nil), "", "", (*int32)(nil), (*int32)(nil), mock.Anything, &model.CollectionFields{}).Return([]*model.Collection{}, nil)
+	assert.NoError(t, c.WaitForCollectionVersion(context.Background(), collectionID, 1))
+}
@@ -0,0 +1,28 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/mocks"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetCompactionRequests_ClampsLimit(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{ctx: context.Background(), catalog: catalog}
+	catalog.On("GetCompactionRequests", mock.Anything, mock.Anything).Return([]*model.CompactionRequest{}, nil)
+
+	for limit, expected := range map[int32]int32{
+		0:    defaultCompactionRequestsLimit,
+		-1:   defaultCompactionRequestsLimit,
+		10:   10,
+		5000: maxCompactionRequestsLimit,
+	} {
+		_, err := c.GetCompactionRequests(context.Background(), limit)
+		assert.NoError(t, err)
+		catalog.AssertCalled(t, "GetCompactionRequests", mock.Anything, expected)
+	}
+}
@@ -0,0 +1,164 @@
+package coordinator
+
+import (
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+)
+
+// Config holds the limits enforced by the Coordinator. Zero values disable the
+// corresponding check.
+type Config struct {
+	// MaxCollectionsPerDatabase is the maximum number of collections allowed in a
+	// single database. It is checked inside the CreateCollection transaction.
+	MaxCollectionsPerDatabase int64
+
+	// MaxMetadataKeys is the maximum number of keys in a collection's metadata.
+	MaxMetadataKeys int
+	// MaxMetadataKeyLength is the maximum length in bytes of a metadata key.
+	MaxMetadataKeyLength int
+	// MaxMetadataSizeBytes is the maximum serialized size of a collection's
+	// metadata, counting every key and value.
+	MaxMetadataSizeBytes int
+
+	// MaxReplicationFactor is the maximum number of query nodes a collection can
+	// ask to be assigned to.
+	MaxReplicationFactor int32
+
+	// FlushCompactionMaxRetries is the number of times a FlushCollectionCompaction
+	// that lost a serialization conflict is retried.
+	FlushCompactionMaxRetries int
+
+	// ConfirmationTokenTTL is how long a confirmation token for a destructive
+	// operation stays valid. Zero uses a default of five minutes.
+	ConfirmationTokenTTL time.Duration
+	// ConfirmationTokenKeys sign the confirmation tokens with the first key and
+	// verify them with all of them. Empty uses a key of this process only.
+	ConfirmationTokenKeys []string
+
+	// MaintenanceWindowStartHour is the UTC hour at which the daily maintenance
+	// window opens.
+	MaintenanceWindowStartHour int
+	// MaintenanceWindowDuration is the length of the maintenance window. Zero
+	// disables the maintenance job.
+	MaintenanceWindowDuration time.Duration
+	// MaintenanceVacuum runs VACUUM (ANALYZE) instead of ANALYZE.
+	MaintenanceVacuum bool
+	// MaintenanceTables are the tables maintained by the job. Empty maintains
+	// record_logs and collection_metadata.
+	MaintenanceTables []string
+
+	// LeaderLeaseTTL is the ttl of the lease held by the sysdb replica that
+	// serves writes and runs background jobs. Zero disables leader election
+	// and every replica acts as the leader.
+	LeaderLeaseTTL time.Duration
+	// LeaderID identifies this replica as a lease holder. It must be unique
+	// across replicas.
+	LeaderID string
+	// LeaderAddress is the address clients are redirected to while this
+	// replica is the leader. On a read-only replica it is the address of a
+	// sysdb that serves writes.
+	LeaderAddress string
+
+	// DecommissionDrainPeriod is how long a decommissioned query node stays in
+	// the query memberlist after collections stopped being placed on it, so
+	// that clients have refreshed their placements before it is removed. Zero
+	// uses a default of two minutes.
+	DecommissionDrainPeriod time.Duration
+	// HeartbeatTimeout is how long after its last heartbeat a node is reported
+	// as not alive. Zero uses a default of 30 seconds.
+	HeartbeatTimeout time.Duration
+
+	// ReadOnly runs a replica against a read-only copy of the database, such
+	// as a replica in another region. It serves reads only, and neither elects
+	// a leader nor runs the notification processor or background jobs.
+	ReadOnly bool
+
+	// QuerySampleRate is the fraction of GetCollections and GetSegments calls
+	// whose statements are recorded for GetQuerySamples. Zero disables
+	// sampling.
+	QuerySampleRate float64
+	// QuerySampleBufferSize is the number of statements kept. Zero keeps 256.
+	QuerySampleBufferSize int
+
+	// QuotaCacheTTL is how long CheckQuota serves the quota and usage of a
+	// tenant without reading them again. Zero uses a default of ten seconds.
+	QuotaCacheTTL time.Duration
+
+	// FeatureFlagCacheTTL is how long IsFeatureEnabled serves the feature flags
+	// without reading them again. Zero uses a default of 30 seconds.
+	FeatureFlagCacheTTL time.Duration
+
+	// MaintenanceModeCacheTTL is how long the maintenance mode is served without
+	// reading it again, so how long other replicas take to see a change. Zero
+	// uses a default of five seconds.
+	MaintenanceModeCacheTTL time.Duration
+
+	// LogServiceAddress is the address of the log service, read for the log lag
+	// of collections. Empty disables log lag.
+	LogServiceAddress string
+	// LogLagCacheTTL is how long the log lag of a collection is served without
+	// asking the log service again. Zero uses a default of five seconds.
+	LogLagCacheTTL time.Duration
+	// LogOffsetReconcileInterval is how often the log positions of collections
+	// are compared with the last offsets of their logs. Zero disables the
+	// comparison, which also needs LogServiceAddress.
+	LogOffsetReconcileInterval time.Duration
+
+	// CollectionStatsRefreshInterval is how often the collection_stats rollup
+	// is refreshed. Zero disables the rollup.
+	CollectionStatsRefreshInterval time.Duration
+	// CollectionSizeHistoryRetention is how long the daily snapshots of the
+	// size of collections are kept. Zero disables the snapshots.
+	CollectionSizeHistoryRetention time.Duration
+
+	// SoftDeleteRetention is how long soft deleted collections can be restored
+	// before the cleaner purges them. Zero disables the cleaner.
+	SoftDeleteRetention time.Duration
+	// SoftDeleteCleanerInterval is how often the cleaner runs. Zero uses a
+	// default of an hour.
+	SoftDeleteCleanerInterval time.Duration
+	// SoftDeleteCleanerBatchSize is the most collections a run purges. Zero
+	// uses a default of 100.
+	SoftDeleteCleanerBatchSize int32
+	// SoftDeleteCleanerMaxAttempts is how many times the cleaner tries to purge
+	// a collection before it dead letters it. Zero uses a default of 3.
+	SoftDeleteCleanerMaxAttempts int32
+
+	// JobWorkerConcurrency is the number of jobs of the job queue the leader
+	// runs at once. Zero disables the workers, and the operations that are run
+	// as jobs.
+	JobWorkerConcurrency int
+	// JobPollInterval is how often idle workers look for due jobs. Zero uses a
+	// default of five seconds.
+	JobPollInterval time.Duration
+
+	// ScanCollectionsRate is the most collections per second the scans of
+	// ScanCollections read on this replica, all scans together. Zero does not
+	// limit them.
+	ScanCollectionsRate int
+
+	// TenantNaming, DatabaseNaming and CollectionNaming constrain the names of
+	// new tenants, databases and collections, and of renamed collections. Empty
+	// names are rejected regardless.
+	TenantNaming     common.NamingPolicy
+	DatabaseNaming   common.NamingPolicy
+	CollectionNaming common.NamingPolicy
+
+	// PreWriteHooks are the names of the registered pre-write hooks to run
+	// before mutating operations, in the order they run.
+	PreWriteHooks []string
+	// PostCommitHooks are the names of the registered post-commit hooks to run
+	// on the notifications delivered from the outbox, in the order they run.
+	PostCommitHooks []string
+	// OutboxPollInterval is how often the leader delivers the pending
+	// notifications of the outbox. Zero only delivers them when it is elected.
+	OutboxPollInterval time.Duration
+
+	// TenantLastCompactionFlushInterval is how often the buffered tenant last
+	// compaction times are written. Zero writes them as they are set.
+	TenantLastCompactionFlushInterval time.Duration
+	// TenantLastCompactionMaxPending is the number of tenants with a buffered
+	// time that triggers a write before the interval is over, 1000 when zero.
+	TenantLastCompactionMaxPending int
+}
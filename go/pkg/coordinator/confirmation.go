@@ -0,0 +1,84 @@
+package coordinator
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"strconv"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+)
+
+const defaultConfirmationTokenTTL = 5 * time.Minute
+
+// confirmationTokens issues and verifies the tokens required by destructive
+// operations. A token is bound to an operation and its target and is signed with
+// the first key. Every key verifies tokens, so that keys can be rotated without
+// failing the operations being confirmed.
+type confirmationTokens struct {
+	keys [][]byte
+	ttl  time.Duration
+	now  func() time.Time
+}
+
+// newConfirmationTokens signs with a key that only lives in this process when
+// keys is empty, the tokens then do not survive a restart or a leader change.
+func newConfirmationTokens(keys []string, ttl time.Duration) (*confirmationTokens, error) {
+	c := &confirmationTokens{ttl: ttl, now: time.Now}
+	for _, key := range keys {
+		c.keys = append(c.keys, []byte(key))
+	}
+	if len(c.keys) == 0 {
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+		c.keys = append(c.keys, key)
+	}
+	if c.ttl <= 0 {
+		c.ttl = defaultConfirmationTokenTTL
+	}
+	return c, nil
+}
+
+func (c *confirmationTokens) issue(operation model.DestructiveOperation, target string) *model.ConfirmationToken {
+	expiresAt := c.now().Add(c.ttl).Unix()
+	payload := make([]byte, 8)
+	binary.BigEndian.PutUint64(payload, uint64(expiresAt))
+	token := append(payload, c.sign(c.keys[0], operation, target, expiresAt)...)
+	return &model.ConfirmationToken{
+		Token:     base64.RawURLEncoding.EncodeToString(token),
+		ExpiresAt: expiresAt,
+	}
+}
+
+func (c *confirmationTokens) verify(operation model.DestructiveOperation, target string, token string) error {
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(decoded) != 8+sha256.Size {
+		return common.ErrInvalidConfirmationToken
+	}
+	expiresAt := int64(binary.BigEndian.Uint64(decoded[:8]))
+	if c.now().Unix() > expiresAt {
+		return common.ErrInvalidConfirmationToken
+	}
+	for _, key := range c.keys {
+		if hmac.Equal(decoded[8:], c.sign(key, operation, target, expiresAt)) {
+			return nil
+		}
+	}
+	return common.ErrInvalidConfirmationToken
+}
+
+func (c *confirmationTokens) sign(key []byte, operation model.DestructiveOperation, target string, expiresAt int64) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(strconv.Itoa(int(operation))))
+	mac.Write([]byte{0})
+	mac.Write([]byte(target))
+	mac.Write([]byte{0})
+	mac.Write([]byte(strconv.FormatInt(expiresAt, 10)))
+	return mac.Sum(nil)
+}
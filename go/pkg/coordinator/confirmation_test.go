@@ -0,0 +1,53 @@
+package coordinator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfirmationTokens(t *testing.T) {
+	tokens, err := newConfirmationTokens(nil, time.Minute)
+	assert.NoError(t, err)
+	now := time.Unix(1700000000, 0)
+	tokens.now = func() time.Time { return now }
+
+	token := tokens.issue(model.ForceDeleteTenant, "tenant1")
+	assert.Equal(t, now.Add(time.Minute).Unix(), token.ExpiresAt)
+	assert.NoError(t, tokens.verify(model.ForceDeleteTenant, "tenant1", token.Token))
+
+	// bound to the target
+	assert.ErrorIs(t, tokens.verify(model.ForceDeleteTenant, "tenant2", token.Token), common.ErrInvalidConfirmationToken)
+
+	// malformed tokens
+	assert.ErrorIs(t, tokens.verify(model.ForceDeleteTenant, "tenant1", ""), common.ErrInvalidConfirmationToken)
+	assert.ErrorIs(t, tokens.verify(model.ForceDeleteTenant, "tenant1", "not a token"), common.ErrInvalidConfirmationToken)
+
+	// expired
+	now = now.Add(2 * time.Minute)
+	assert.ErrorIs(t, tokens.verify(model.ForceDeleteTenant, "tenant1", token.Token), common.ErrInvalidConfirmationToken)
+
+	// signed with another key
+	other, err := newConfirmationTokens(nil, time.Minute)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, other.verify(model.ForceDeleteTenant, "tenant1", tokens.issue(model.ForceDeleteTenant, "tenant1").Token), common.ErrInvalidConfirmationToken)
+}
+
+func TestConfirmationTokens_Keys(t *testing.T) {
+	// the replicas sharing the keys verify each other's tokens
+	leader, err := newConfirmationTokens([]string{"0123456789abcdef"}, time.Minute)
+	assert.NoError(t, err)
+	standby, err := newConfirmationTokens([]string{"0123456789abcdef"}, time.Minute)
+	assert.NoError(t, err)
+	token := leader.issue(model.ForceDeleteTenant, "tenant1")
+	assert.NoError(t, standby.verify(model.ForceDeleteTenant, "tenant1", token.Token))
+
+	// a rotated key still verifies the tokens it signed
+	rotated, err := newConfirmationTokens([]string{"fedcba9876543210", "0123456789abcdef"}, time.Minute)
+	assert.NoError(t, err)
+	assert.NoError(t, rotated.verify(model.ForceDeleteTenant, "tenant1", token.Token))
+	assert.ErrorIs(t, leader.verify(model.ForceDeleteTenant, "tenant1", rotated.issue(model.ForceDeleteTenant, "tenant1").Token), common.ErrInvalidConfirmationToken)
+}
@@ -3,12 +3,18 @@ package coordinator
 import (
 	"context"
 	"log"
+	"os"
 
+	"github.com/chroma-core/chroma/go/pkg/featureflag"
 	"github.com/chroma-core/chroma/go/pkg/metastore"
 	"github.com/chroma-core/chroma/go/pkg/metastore/coordinator"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dao"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
 	"github.com/chroma-core/chroma/go/pkg/notification"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"gorm.io/gorm"
 )
 
@@ -19,38 +25,257 @@ var _ ICoordinator = (*Coordinator)(nil)
 // support other functionalities such as membership managed and propagation.
 type Coordinator struct {
 	ctx                   context.Context
+	config                Config
 	notificationProcessor notification.NotificationProcessor
 	catalog               metastore.Catalog
+	confirmationTokens    *confirmationTokens
+	maintenanceJob        *maintenanceJob
+	collectionStatsJob    *collectionStatsJob
+	sizeHistoryJob        *collectionSizeHistoryJob
+	softDeleteCleaner     *softDeleteCleaner
+	leaderElector         *leaderElector
+	quotas                *quotaCache
+	featureFlags          *featureflag.Cache
+	logLags               *logLagCache
+	queryMembers          *queryMembersCache
+	preWriteHooks         []namedPreWriteHook
+	versionWaiters        collectionVersionWaiters
+	compactionBatcher     *tenantCompactionBatcher
+	logOffsetReconciler   *logOffsetReconciler
+	maintenanceMode       *maintenanceModeCache
+	jobWorker             *jobWorker
+	scanLimiter           *rate.Limiter
 }
 
 func NewCoordinator(ctx context.Context, db *gorm.DB, notificationStore notification.NotificationStore, notifier notification.Notifier) (*Coordinator, error) {
+	return NewCoordinatorWithConfig(ctx, Config{}, db, notificationStore, notifier)
+}
+
+func NewCoordinatorWithConfig(ctx context.Context, config Config, db *gorm.DB, notificationStore notification.NotificationStore, notifier notification.Notifier) (*Coordinator, error) {
 	s := &Coordinator{
-		ctx: ctx,
+		ctx:    ctx,
+		config: config,
+	}
+
+	preWriteHooks, err := newPreWriteHooks(config.PreWriteHooks)
+	if err != nil {
+		return nil, err
 	}
+	s.preWriteHooks = preWriteHooks
 
+	confirmationTokens, err := newConfirmationTokens(config.ConfirmationTokenKeys, config.ConfirmationTokenTTL)
+	if err != nil {
+		return nil, err
+	}
+	s.confirmationTokens = confirmationTokens
+
+	postCommitHooks, err := newPostCommitHooks(config.PostCommitHooks)
+	if err != nil {
+		return nil, err
+	}
+	if len(postCommitHooks) > 0 {
+		notifier, err = newPostCommitNotifier(notifier, postCommitHooks)
+		if err != nil {
+			return nil, err
+		}
+	}
 	notificationProcessor := notification.NewSimpleNotificationProcessor(ctx, notificationStore, notifier)
+	notificationProcessor.SetPollInterval(config.OutboxPollInterval)
 	s.notificationProcessor = notificationProcessor
 
 	// catalog
 	txnImpl := dbcore.NewTxImpl()
 	metaDomain := dao.NewMetaDomain()
-	s.catalog = coordinator.NewTableCatalogWithNotification(txnImpl, metaDomain, notificationStore)
+	catalog := coordinator.NewTableCatalogWithNotification(txnImpl, metaDomain, notificationStore)
+	catalog.SetMaxCollectionsPerDatabase(config.MaxCollectionsPerDatabase)
+	s.catalog = catalog
+	s.quotas = newQuotaCache(catalog, config.QuotaCacheTTL)
+	s.featureFlags = featureflag.NewCache(catalog.GetFeatureFlags, config.FeatureFlagCacheTTL)
+	s.maintenanceMode = newMaintenanceModeCache(catalog, config.MaintenanceModeCacheTTL)
+	s.scanLimiter = newScanLimiter(config.ScanCollectionsRate)
+
+	if config.LogServiceAddress != "" {
+		// the connection is established lazily, so a log service that is down
+		// does not keep the coordinator from starting
+		logConn, err := grpc.Dial(config.LogServiceAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return nil, err
+		}
+		s.logLags = newLogLagCache(logservicepb.NewLogServiceClient(logConn), config.LogLagCacheTTL)
+	}
+
+	maintenanceJob, err := newMaintenanceJob(config, metaDomain)
+	if err != nil {
+		return nil, err
+	}
+	s.maintenanceJob = maintenanceJob
+
+	var lags logLagSource
+	if s.logLags != nil {
+		lags = s.logLags.source
+	}
+	collectionStatsJob, err := newCollectionStatsJob(config, metaDomain, lags)
+	if err != nil {
+		return nil, err
+	}
+	s.collectionStatsJob = collectionStatsJob
+
+	logOffsetReconciler, err := newLogOffsetReconciler(config, metaDomain, lags)
+	if err != nil {
+		return nil, err
+	}
+	s.logOffsetReconciler = logOffsetReconciler
+
+	sizeHistoryJob, err := newCollectionSizeHistoryJob(config, metaDomain)
+	if err != nil {
+		return nil, err
+	}
+	s.sizeHistoryJob = sizeHistoryJob
+
+	softDeleteCleaner, err := newSoftDeleteCleaner(config, catalog)
+	if err != nil {
+		return nil, err
+	}
+	s.softDeleteCleaner = softDeleteCleaner
+
+	jobWorker, err := newJobWorker(config, catalog, s.jobHandlers())
+	if err != nil {
+		return nil, err
+	}
+	s.jobWorker = jobWorker
+
+	compactionBatcher, err := newTenantCompactionBatcher(config, catalog)
+	if err != nil {
+		return nil, err
+	}
+	s.compactionBatcher = compactionBatcher
+
+	if config.QuerySampleRate > 0 {
+		dbcore.SetQuerySampleBufferSize(config.QuerySampleBufferSize)
+	}
+
+	if config.LeaderLeaseTTL > 0 && !config.ReadOnly {
+		if config.LeaderID == "" {
+			config.LeaderID, err = os.Hostname()
+			if err != nil {
+				return nil, err
+			}
+		}
+		s.leaderElector = newLeaderElector(metaDomain, config.LeaderID, config.LeaderAddress, config.LeaderLeaseTTL)
+		// background jobs only run on the leader
+		s.leaderElector.onElected = s.startBackgroundJobs
+		s.leaderElector.onDemoted = s.stopBackgroundJobs
+	}
 	return s, nil
 }
 
 func (s *Coordinator) Start() error {
-	err := s.notificationProcessor.Start()
-	if err != nil {
-		log.Printf("Failed to start notification processor: %v", err)
-		return err
+	if s.config.ReadOnly {
+		// every job below writes to the database
+		return nil
+	}
+	// not a leader only job, it writes the times the leader was sent
+	if s.compactionBatcher != nil {
+		s.compactionBatcher.Start(dbcore.WithBackgroundPool(s.ctx))
+	}
+	if s.leaderElector != nil {
+		s.leaderElector.Start(dbcore.WithBackgroundPool(s.ctx))
+	} else {
+		s.startBackgroundJobs()
 	}
 	return nil
 }
 
 func (s *Coordinator) Stop() error {
-	err := s.notificationProcessor.Stop()
-	if err != nil {
-		log.Printf("Failed to stop notification processor: %v", err)
+	if s.config.ReadOnly {
+		return nil
+	}
+	if s.leaderElector != nil {
+		s.leaderElector.Stop()
+	} else {
+		s.stopBackgroundJobs()
+	}
+	if s.compactionBatcher != nil {
+		s.compactionBatcher.Stop()
 	}
 	return nil
 }
+
+// startBackgroundJobs starts the jobs that only run on the leader. They use
+// the background pool so that they cannot starve requests of connections.
+func (s *Coordinator) startBackgroundJobs() {
+	ctx := dbcore.WithBackgroundPool(s.ctx)
+	// replicas sharing the outbox would each deliver its notifications
+	if s.notificationProcessor != nil {
+		err := s.notificationProcessor.Start()
+		if err != nil {
+			log.Printf("Failed to start notification processor: %v", err)
+		}
+	}
+	if s.maintenanceJob != nil {
+		s.maintenanceJob.Start(ctx)
+	}
+	if s.collectionStatsJob != nil {
+		s.collectionStatsJob.Start(ctx)
+	}
+	if s.logOffsetReconciler != nil {
+		s.logOffsetReconciler.Start(ctx)
+	}
+	if s.sizeHistoryJob != nil {
+		s.sizeHistoryJob.Start(ctx)
+	}
+	if s.softDeleteCleaner != nil {
+		s.softDeleteCleaner.Start(ctx)
+	}
+	if s.jobWorker != nil {
+		s.jobWorker.Start(ctx)
+	}
+}
+
+func (s *Coordinator) stopBackgroundJobs() {
+	if s.notificationProcessor != nil {
+		err := s.notificationProcessor.Stop()
+		if err != nil {
+			log.Printf("Failed to stop notification processor: %v", err)
+		}
+	}
+	if s.maintenanceJob != nil {
+		s.maintenanceJob.Stop()
+	}
+	if s.collectionStatsJob != nil {
+		s.collectionStatsJob.Stop()
+	}
+	if s.logOffsetReconciler != nil {
+		s.logOffsetReconciler.Stop()
+	}
+	if s.sizeHistoryJob != nil {
+		s.sizeHistoryJob.Stop()
+	}
+	if s.softDeleteCleaner != nil {
+		s.softDeleteCleaner.Stop()
+	}
+	if s.jobWorker != nil {
+		s.jobWorker.Stop()
+	}
+}
+
+// IsLeader reports whether this replica may serve writes. It is always true
+// when leader election is disabled, and always false on a read-only replica.
+func (s *Coordinator) IsLeader() bool {
+	if s.config.ReadOnly {
+		return false
+	}
+	if s.leaderElector == nil {
+		return true
+	}
+	return s.leaderElector.IsLeader()
+}
+
+// LeaderAddress returns the address of the current leader, for redirecting
+// clients that sent a write to a standby.
+func (s *Coordinator) LeaderAddress() string {
+	if s.leaderElector == nil {
+		return s.config.LeaderAddress
+	}
+	return s.leaderElector.LeaderAddress()
+}
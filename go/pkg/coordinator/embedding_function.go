@@ -0,0 +1,76 @@
+package coordinator
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+)
+
+// CreateEmbeddingFunction registers an embedding function for a tenant. A nil
+// ID is replaced with a new one, and empty params with an empty JSON object.
+func (s *Coordinator) CreateEmbeddingFunction(ctx context.Context, embeddingFunction *model.EmbeddingFunction) (*model.EmbeddingFunction, error) {
+	if embeddingFunction.ID == types.NilUniqueID() {
+		embeddingFunction.ID = types.NewUniqueID()
+	}
+	if embeddingFunction.Params == "" {
+		embeddingFunction.Params = "{}"
+	}
+	if err := s.beforeWrite(ctx, &WriteRequest{Operation: WriteCreateEmbeddingFunction, Tenant: embeddingFunction.TenantID, Request: embeddingFunction}); err != nil {
+		return nil, err
+	}
+	if embeddingFunction.TenantID == "" || embeddingFunction.Name == "" || embeddingFunction.Provider == "" || !isJSONObject(embeddingFunction.Params) {
+		return nil, common.ErrInvalidEmbeddingFunction
+	}
+	return s.catalog.CreateEmbeddingFunction(ctx, embeddingFunction)
+}
+
+// GetEmbeddingFunctions returns the embedding function with the ID when it is
+// set, and the embedding functions of the tenant otherwise, narrowed to the one
+// with the name when it is set.
+func (s *Coordinator) GetEmbeddingFunctions(ctx context.Context, id types.UniqueID, tenantID string, name *string) ([]*model.EmbeddingFunction, error) {
+	if id == types.NilUniqueID() && tenantID == "" {
+		return nil, common.ErrInvalidEmbeddingFunction
+	}
+	return s.catalog.GetEmbeddingFunctions(ctx, id, tenantID, name)
+}
+
+func (s *Coordinator) UpdateEmbeddingFunction(ctx context.Context, update *model.UpdateEmbeddingFunction) (*model.EmbeddingFunction, error) {
+	if err := s.beforeEmbeddingFunctionWrite(ctx, WriteUpdateEmbeddingFunction, update.ID, update); err != nil {
+		return nil, err
+	}
+	if (update.Name != nil && *update.Name == "") || (update.Provider != nil && *update.Provider == "") || (update.Params != nil && !isJSONObject(*update.Params)) {
+		return nil, common.ErrInvalidEmbeddingFunction
+	}
+	return s.catalog.UpdateEmbeddingFunction(ctx, update)
+}
+
+func (s *Coordinator) DeleteEmbeddingFunction(ctx context.Context, id types.UniqueID) error {
+	if err := s.beforeEmbeddingFunctionWrite(ctx, WriteDeleteEmbeddingFunction, id, id); err != nil {
+		return err
+	}
+	return s.catalog.DeleteEmbeddingFunction(ctx, id)
+}
+
+// beforeEmbeddingFunctionWrite runs the pre-write hooks on an operation on the
+// embedding function with the ID, with its tenant.
+func (s *Coordinator) beforeEmbeddingFunctionWrite(ctx context.Context, operation WriteOperation, id types.UniqueID, request any) error {
+	if len(s.preWriteHooks) == 0 {
+		return nil
+	}
+	embeddingFunctions, err := s.catalog.GetEmbeddingFunctions(ctx, id, "", nil)
+	if err != nil {
+		return err
+	}
+	if len(embeddingFunctions) == 0 {
+		return common.ErrEmbeddingFunctionNotFound
+	}
+	return s.beforeWrite(ctx, &WriteRequest{Operation: operation, Tenant: embeddingFunctions[0].TenantID, Request: request})
+}
+
+func isJSONObject(params string) bool {
+	var object map[string]interface{}
+	return json.Unmarshal([]byte(params), &object) == nil && object != nil
+}
@@ -0,0 +1,52 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/mocks"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateEmbeddingFunction(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{ctx: context.Background(), catalog: catalog}
+
+	catalog.On("CreateEmbeddingFunction", mock.Anything, mock.MatchedBy(func(in *model.EmbeddingFunction) bool {
+		return in.ID != types.NilUniqueID() && in.Params == "{}"
+	})).Return(&model.EmbeddingFunction{Name: "openai"}, nil)
+	_, err := c.CreateEmbeddingFunction(context.Background(), &model.EmbeddingFunction{TenantID: "tenant", Name: "openai", Provider: "openai"})
+	assert.NoError(t, err)
+
+	for _, embeddingFunction := range []*model.EmbeddingFunction{
+		{Name: "openai", Provider: "openai"},
+		{TenantID: "tenant", Provider: "openai"},
+		{TenantID: "tenant", Name: "openai"},
+		{TenantID: "tenant", Name: "openai", Provider: "openai", Params: "[1536]"},
+		{TenantID: "tenant", Name: "openai", Provider: "openai", Params: "{"},
+	} {
+		_, err := c.CreateEmbeddingFunction(context.Background(), embeddingFunction)
+		assert.ErrorIs(t, err, common.ErrInvalidEmbeddingFunction)
+	}
+	catalog.AssertNumberOfCalls(t, "CreateEmbeddingFunction", 1)
+}
+
+func TestUpdateEmbeddingFunction_Invalid(t *testing.T) {
+	c := &Coordinator{ctx: context.Background(), catalog: &mocks.Catalog{}}
+	empty := ""
+	params := `{"dimensions": 1536`
+	for _, update := range []*model.UpdateEmbeddingFunction{
+		{Name: &empty},
+		{Provider: &empty},
+		{Params: &params},
+	} {
+		_, err := c.UpdateEmbeddingFunction(context.Background(), update)
+		assert.ErrorIs(t, err, common.ErrInvalidEmbeddingFunction)
+	}
+	_, err := c.GetEmbeddingFunctions(context.Background(), types.NilUniqueID(), "", nil)
+	assert.ErrorIs(t, err, common.ErrInvalidEmbeddingFunction)
+}
@@ -0,0 +1,41 @@
+package coordinator
+
+import (
+	"context"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+)
+
+// SetFeatureFlag replaces the default of a flag, or its override for a tenant.
+// Other replicas pick up the change once their cached flags expire.
+func (s *Coordinator) SetFeatureFlag(ctx context.Context, flag *model.FeatureFlag) error {
+	if flag.Name == "" || flag.RolloutPercentage < 0 || flag.RolloutPercentage > 100 {
+		return common.ErrInvalidFeatureFlag
+	}
+	err := s.catalog.SetFeatureFlag(ctx, flag)
+	if err != nil {
+		return err
+	}
+	s.featureFlags.Invalidate()
+	return nil
+}
+
+func (s *Coordinator) DeleteFeatureFlag(ctx context.Context, name string, tenantID string) error {
+	err := s.catalog.DeleteFeatureFlag(ctx, name, tenantID)
+	if err != nil {
+		return err
+	}
+	s.featureFlags.Invalidate()
+	return nil
+}
+
+func (s *Coordinator) ListFeatureFlags(ctx context.Context) ([]*model.FeatureFlag, error) {
+	return s.catalog.GetFeatureFlags(ctx)
+}
+
+// IsFeatureEnabled tells whether a flag is on for a tenant. It is served from
+// the flag cache and is off when the flags cannot be read.
+func (s *Coordinator) IsFeatureEnabled(ctx context.Context, name string, tenantID string) bool {
+	return s.featureFlags.Enabled(ctx, name, tenantID)
+}
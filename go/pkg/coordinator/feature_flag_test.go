@@ -0,0 +1,49 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/featureflag"
+	"github.com/chroma-core/chroma/go/pkg/metastore/mocks"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFeatureFlags(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{
+		ctx:          context.Background(),
+		catalog:      catalog,
+		featureFlags: featureflag.NewCache(catalog.GetFeatureFlags, time.Hour),
+	}
+	ctx := context.Background()
+
+	catalog.On("GetFeatureFlags", mock.Anything).Return([]*model.FeatureFlag{}, nil).Once()
+	assert.False(t, c.IsFeatureEnabled(ctx, "new_cache", "tenant"))
+
+	// setting a flag is visible right away on this replica
+	flag := &model.FeatureFlag{Name: "new_cache", TenantID: "tenant", Enabled: true}
+	catalog.On("SetFeatureFlag", mock.Anything, flag).Return(nil)
+	catalog.On("GetFeatureFlags", mock.Anything).Return([]*model.FeatureFlag{flag}, nil).Once()
+	assert.NoError(t, c.SetFeatureFlag(ctx, flag))
+	assert.True(t, c.IsFeatureEnabled(ctx, "new_cache", "tenant"))
+	assert.True(t, c.IsFeatureEnabled(ctx, "new_cache", "tenant"))
+	catalog.AssertNumberOfCalls(t, "GetFeatureFlags", 2)
+
+	catalog.On("DeleteFeatureFlag", mock.Anything, "new_cache", "tenant").Return(nil)
+	catalog.On("GetFeatureFlags", mock.Anything).Return([]*model.FeatureFlag{}, nil).Once()
+	assert.NoError(t, c.DeleteFeatureFlag(ctx, "new_cache", "tenant"))
+	assert.False(t, c.IsFeatureEnabled(ctx, "new_cache", "tenant"))
+
+	for _, invalid := range []*model.FeatureFlag{
+		{Name: "", Enabled: true, RolloutPercentage: 100},
+		{Name: "new_gc", Enabled: true, RolloutPercentage: 101},
+		{Name: "new_gc", Enabled: true, RolloutPercentage: -1},
+	} {
+		assert.ErrorIs(t, c.SetFeatureFlag(ctx, invalid), common.ErrInvalidFeatureFlag)
+	}
+}
@@ -0,0 +1,237 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// GetQuerySamples returns the statements recorded on this replica for sampled
+// GetCollections and GetSegments calls.
+func (s *Server) GetQuerySamples(ctx context.Context, req *coordinatorpb.GetQuerySamplesRequest) (*coordinatorpb.GetQuerySamplesResponse, error) {
+	res := &coordinatorpb.GetQuerySamplesResponse{}
+	samples := s.coordinator.GetQuerySamples()
+	res.Samples = make([]*coordinatorpb.QuerySample, 0, len(samples))
+	for _, sample := range samples {
+		res.Samples = append(res.Samples, convertQuerySampleToProto(sample))
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+// DecommissionNode starts the decommission of a query node, or returns its
+// progress when it is already decommissioned. With cancel set it puts the node
+// back in service instead.
+func (s *Server) DecommissionNode(ctx context.Context, req *coordinatorpb.DecommissionNodeRequest) (*coordinatorpb.DecommissionNodeResponse, error) {
+	res := &coordinatorpb.DecommissionNodeResponse{}
+	nodeID := req.GetNodeId()
+	if req.GetCancel() {
+		err := s.coordinator.CancelNodeDecommission(ctx, nodeID)
+		if err != nil {
+			log.Error("error cancelling node decommission", zap.String("nodeID", nodeID), zap.Error(err))
+			if err == common.ErrNodeDecommissionNotFound {
+				res.Status = failResponseWithError(err, 404)
+			} else {
+				res.Status = failResponseWithError(err, errorCode)
+			}
+			return res, nil
+		}
+		res.Status = setResponseStatus(successCode)
+		return res, nil
+	}
+
+	progress, err := s.coordinator.DecommissionNode(ctx, nodeID)
+	if err != nil {
+		log.Error("error decommissioning node", zap.String("nodeID", nodeID), zap.Error(err))
+		if err == common.ErrNodeNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else if err == common.ErrLastQueryNode {
+			res.Status = failResponseWithError(err, 409)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.State = string(progress.State)
+	res.StartedAt = progress.Decommission.StartedAt.Unix()
+	res.DrainUntil = progress.Decommission.DrainUntil.Unix()
+	res.Collections = progress.Decommission.Collections
+	res.RemainingCollections = progress.RemainingCollections
+	res.Progress = progress.Progress()
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) Heartbeat(ctx context.Context, req *coordinatorpb.HeartbeatRequest) (*coordinatorpb.HeartbeatResponse, error) {
+	res := &coordinatorpb.HeartbeatResponse{}
+	err := s.coordinator.RecordNodeHeartbeat(ctx, req.GetNodeId())
+	if err != nil {
+		log.Error("error recording node heartbeat", zap.String("nodeID", req.GetNodeId()), zap.Error(err))
+		if err == common.ErrInvalidNodeID {
+			res.Status = failResponseWithError(err, 400)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+// GetNodeLiveness returns the last heartbeat of the query nodes, so that a node
+// in the memberlist that stopped sending them stands out.
+func (s *Server) GetNodeLiveness(ctx context.Context, req *coordinatorpb.GetNodeLivenessRequest) (*coordinatorpb.GetNodeLivenessResponse, error) {
+	res := &coordinatorpb.GetNodeLivenessResponse{}
+	nodes, err := s.coordinator.GetNodeLiveness(ctx)
+	if err != nil {
+		log.Error("error getting node liveness", zap.Error(err))
+		res.Status = failResponseWithError(err, errorCode)
+		return res, nil
+	}
+	res.Nodes = make([]*coordinatorpb.NodeLiveness, 0, len(nodes))
+	for _, node := range nodes {
+		nodepb := &coordinatorpb.NodeLiveness{
+			NodeId:       node.NodeID,
+			InMemberlist: node.InMemberlist,
+			Alive:        node.Alive,
+		}
+		if !node.LastSeen.IsZero() {
+			nodepb.LastSeen = node.LastSeen.Unix()
+		}
+		res.Nodes = append(res.Nodes, nodepb)
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+// GetSoftDeleteCleanerStatus returns the progress of the soft delete cleaner of
+// the leader.
+func (s *Server) GetSoftDeleteCleanerStatus(ctx context.Context, req *coordinatorpb.GetSoftDeleteCleanerStatusRequest) (*coordinatorpb.GetSoftDeleteCleanerStatusResponse, error) {
+	status := s.coordinator.GetSoftDeleteCleanerStatus(ctx)
+	res := &coordinatorpb.GetSoftDeleteCleanerStatusResponse{
+		Enabled:          status.Enabled,
+		Running:          status.Running,
+		RetentionSeconds: int64(status.Retention.Seconds()),
+		TotalScanned:     status.TotalScanned,
+		TotalPurged:      status.TotalPurged,
+		TotalFailed:      status.TotalFailed,
+	}
+	if !status.NextRunAt.IsZero() {
+		res.NextRunAt = status.NextRunAt.Unix()
+	}
+	if status.LastRun != nil {
+		res.LastRun = convertSoftDeleteCleanerRunToProto(status.LastRun)
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+// RunSoftDeleteCleaner runs the soft delete cleaner right away and returns the
+// counts of the run once it is over.
+func (s *Server) RunSoftDeleteCleaner(ctx context.Context, req *coordinatorpb.RunSoftDeleteCleanerRequest) (*coordinatorpb.RunSoftDeleteCleanerResponse, error) {
+	res := &coordinatorpb.RunSoftDeleteCleanerResponse{}
+	run, err := s.coordinator.RunSoftDeleteCleaner(ctx, req.Limit)
+	if run != nil {
+		res.Run = convertSoftDeleteCleanerRunToProto(run)
+	}
+	if err != nil {
+		log.Error("error running soft delete cleaner", zap.Int32("limit", req.Limit), zap.Error(err))
+		switch err {
+		case common.ErrInvalidSoftDeleteLimit, common.ErrSoftDeleteCleanerDisabled:
+			res.Status = failResponseWithError(err, 400)
+		case common.ErrSoftDeleteCleanerRunning:
+			res.Status = failResponseWithError(err, 409)
+		default:
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+// ListCleanupDeadLetters returns the collections a background cleanup task
+// keeps failing on.
+func (s *Server) ListCleanupDeadLetters(ctx context.Context, req *coordinatorpb.ListCleanupDeadLettersRequest) (*coordinatorpb.ListCleanupDeadLettersResponse, error) {
+	res := &coordinatorpb.ListCleanupDeadLettersResponse{}
+	entries, err := s.coordinator.ListCleanupDeadLetters(ctx, req.Task, req.DeadLetteredOnly)
+	if err != nil {
+		log.Error("error listing cleanup dead letters", zap.String("task", req.Task), zap.Error(err))
+		if err == common.ErrUnknownCleanupTask {
+			res.Status = failResponseWithError(err, 400)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Entries = make([]*coordinatorpb.CleanupDeadLetter, 0, len(entries))
+	for _, entry := range entries {
+		res.Entries = append(res.Entries, convertCleanupDeadLetterToProto(entry))
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+// DeleteCleanupDeadLetter forgets the failures of a cleanup task on a
+// collection, so that the task retries it.
+func (s *Server) DeleteCleanupDeadLetter(ctx context.Context, req *coordinatorpb.DeleteCleanupDeadLetterRequest) (*coordinatorpb.DeleteCleanupDeadLetterResponse, error) {
+	res := &coordinatorpb.DeleteCleanupDeadLetterResponse{}
+	collectionID, err := types.ToUniqueID(&req.CollectionId)
+	if err != nil {
+		log.Error("collection id format error", zap.String("collection.id", req.CollectionId))
+		res.Status = failResponseWithError(common.ErrCollectionIDFormat, 400)
+		return res, nil
+	}
+	err = s.coordinator.DeleteCleanupDeadLetter(ctx, collectionID, req.Task)
+	if err != nil {
+		log.Error("error deleting cleanup dead letter", zap.String("collection.id", req.CollectionId), zap.String("task", req.Task), zap.Error(err))
+		switch err {
+		case common.ErrUnknownCleanupTask:
+			res.Status = failResponseWithError(err, 400)
+		case common.ErrCleanupDeadLetterNotFound:
+			res.Status = failResponseWithError(err, 404)
+		default:
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) SetMaintenanceMode(ctx context.Context, req *coordinatorpb.SetMaintenanceModeRequest) (*coordinatorpb.SetMaintenanceModeResponse, error) {
+	res := &coordinatorpb.SetMaintenanceModeResponse{}
+	err := s.coordinator.SetMaintenanceMode(ctx, req.GetEnabled(), req.GetReason())
+	if err != nil {
+		log.Error("error setting maintenance mode", zap.Bool("enabled", req.GetEnabled()), zap.Error(err))
+		if err == common.ErrMaintenanceReasonRequired {
+			res.Status = failResponseWithError(err, 400)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) GetMaintenanceMode(ctx context.Context, req *coordinatorpb.GetMaintenanceModeRequest) (*coordinatorpb.GetMaintenanceModeResponse, error) {
+	res := &coordinatorpb.GetMaintenanceModeResponse{}
+	mode, err := s.coordinator.GetMaintenanceMode(ctx)
+	if err != nil {
+		log.Error("error getting maintenance mode", zap.Error(err))
+		res.Status = failResponseWithError(err, errorCode)
+		return res, nil
+	}
+	res.Enabled = mode.Enabled
+	res.Reason = mode.Reason
+	if !mode.UpdatedAt.IsZero() {
+		res.UpdatedAt = mode.UpdatedAt.Unix()
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
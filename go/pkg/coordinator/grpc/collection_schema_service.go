@@ -0,0 +1,106 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+func (s *Server) SetCollectionSchema(ctx context.Context, req *coordinatorpb.SetCollectionSchemaRequest) (*coordinatorpb.SetCollectionSchemaResponse, error) {
+	res := &coordinatorpb.SetCollectionSchemaResponse{}
+	collectionID, err := types.ToUniqueID(&req.CollectionId)
+	if err != nil {
+		log.Error("collection id format error", zap.String("collection.id", req.CollectionId))
+		res.Status = failResponseWithError(common.ErrCollectionIDFormat, 400)
+		return res, nil
+	}
+	fields, err := convertCollectionSchemaFieldsToModel(req.GetFields())
+	if err != nil {
+		res.Status = failResponseWithError(err, 400)
+		return res, nil
+	}
+	err = s.coordinator.SetCollectionSchema(ctx, collectionID, fields)
+	if err != nil {
+		log.Error("error setting collection schema", zap.String("collection.id", req.CollectionId), zap.Error(err))
+		if err == common.ErrInvalidCollectionSchema {
+			res.Status = failResponseWithError(err, 400)
+		} else if err == common.ErrCollectionNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) GetCollectionSchema(ctx context.Context, req *coordinatorpb.GetCollectionSchemaRequest) (*coordinatorpb.GetCollectionSchemaResponse, error) {
+	res := &coordinatorpb.GetCollectionSchemaResponse{}
+	collectionID, err := types.ToUniqueID(&req.CollectionId)
+	if err != nil {
+		log.Error("collection id format error", zap.String("collection.id", req.CollectionId))
+		res.Status = failResponseWithError(common.ErrCollectionIDFormat, 400)
+		return res, nil
+	}
+	fields, err := s.coordinator.GetCollectionSchema(ctx, collectionID)
+	if err != nil {
+		log.Error("error getting collection schema", zap.String("collection.id", req.CollectionId), zap.Error(err))
+		if err == common.ErrCollectionNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Fields = convertCollectionSchemaFieldsToProto(fields)
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) ValidateCollectionMetadata(ctx context.Context, req *coordinatorpb.ValidateCollectionMetadataRequest) (*coordinatorpb.ValidateCollectionMetadataResponse, error) {
+	res := &coordinatorpb.ValidateCollectionMetadataResponse{}
+	collectionID, err := types.ToUniqueID(&req.CollectionId)
+	if err != nil {
+		log.Error("collection id format error", zap.String("collection.id", req.CollectionId))
+		res.Status = failResponseWithError(common.ErrCollectionIDFormat, 400)
+		return res, nil
+	}
+	metadatas := make([]*model.CollectionMetadata[model.CollectionMetadataValueType], 0, len(req.GetMetadatas()))
+	for _, metadatapb := range req.GetMetadatas() {
+		metadata, err := convertCollectionMetadataToModel(metadatapb)
+		if err != nil {
+			res.Status = failResponseWithError(err, 400)
+			return res, nil
+		}
+		metadatas = append(metadatas, metadata)
+	}
+	violations, err := s.coordinator.ValidateCollectionMetadata(ctx, collectionID, metadatas)
+	if err != nil {
+		log.Error("error validating collection metadata", zap.String("collection.id", req.CollectionId), zap.Error(err))
+		if err == common.ErrSchemaValidationBatchTooLarge {
+			res.Status = failResponseWithError(err, 400)
+		} else if err == common.ErrCollectionNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Violations = make([]*coordinatorpb.CollectionSchemaViolation, 0, len(violations))
+	for _, violation := range violations {
+		res.Violations = append(res.Violations, &coordinatorpb.CollectionSchemaViolation{
+			Index:    violation.Index,
+			Key:      violation.Key,
+			Expected: convertCollectionSchemaFieldTypeToProto(violation.Expected),
+			Actual:   convertCollectionSchemaFieldTypeToProto(violation.Actual),
+		})
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/grpcutils"
 
@@ -19,6 +20,8 @@ import (
 const errorCode = 500
 const successCode = 200
 const success = "ok"
+const notModifiedCode = 304
+const notModified = "not modified"
 
 func (s *Server) ResetState(context.Context, *emptypb.Empty) (*coordinatorpb.ResetStateResponse, error) {
 	log.Info("reset state")
@@ -85,6 +88,14 @@ func (s *Server) CreateCollection(ctx context.Context, req *coordinatorpb.Create
 		res.Created = false
 		if err == common.ErrCollectionUniqueConstraintViolation {
 			res.Status = failResponseWithError(err, 409)
+		} else if err == common.ErrCollectionLimitExceeded {
+			res.Status = failResponseWithError(err, 429)
+		} else if err == common.ErrEmbeddingFunctionNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else if common.IsWriteRejected(err) {
+			res.Status = failResponseWithError(err, 403)
+		} else if common.IsNameError(err) || isMetadataLimitError(err) || isInvalidConfigurationError(err) || err == common.ErrSegmentCollectionMismatch {
+			res.Status = failResponseWithError(err, 400)
 		} else {
 			res.Status = failResponseWithError(err, errorCode)
 		}
@@ -105,6 +116,24 @@ func (s *Server) GetCollections(ctx context.Context, req *coordinatorpb.GetColle
 
 	res := &coordinatorpb.GetCollectionsResponse{}
 
+	if len(req.Ids) > 0 {
+		return s.getCollectionsByIDs(ctx, req)
+	}
+
+	if req.PageToken != nil {
+		if offset != nil {
+			res.Status = failResponseWithError(common.ErrPageTokenWithStart, 400)
+			return res, nil
+		}
+		position, err := s.pageTokens.verify(coordinatorpb.SysDB_GetCollections_FullMethodName, pageQuery(req, getCollectionsPageFields...), *req.PageToken)
+		if err != nil {
+			log.Error("invalid page token", zap.Error(err))
+			res.Status = failResponseWithError(err, 400)
+			return res, nil
+		}
+		offset = &position.Offset
+	}
+
 	parsedCollectionID, err := types.ToUniqueID(collectionID)
 	if err != nil {
 		log.Error("collection id format error", zap.String("collectionpd.id", *collectionID))
@@ -112,15 +141,115 @@ func (s *Server) GetCollections(ctx context.Context, req *coordinatorpb.GetColle
 		return res, nil
 	}
 
-	collections, err := s.coordinator.GetCollections(ctx, parsedCollectionID, collectionName, tenantID, databaseName, limit, offset)
+	metadataFilters, err := convertCollectionMetadataFiltersToModel(req.MetadataFilters)
+	if err != nil {
+		log.Error("error converting collection metadata filters", zap.Error(err))
+		res.Status = failResponseWithError(err, 400)
+		return res, nil
+	}
+	metadataFilters = append(metadataFilters, convertCollectionColumnFiltersToModel(req)...)
+
+	fields, err := convertCollectionFieldMaskToModel(req.FieldMask)
+	if err != nil {
+		log.Error("error converting collection field mask", zap.Error(err))
+		res.Status = failResponseWithError(err, 400)
+		return res, nil
+	}
+
+	if req.MinVersion != nil {
+		if parsedCollectionID == types.NilUniqueID() {
+			res.Status = failResponseWithError(common.ErrMinVersionWithoutID, 400)
+			return res, nil
+		}
+		if err := s.coordinator.WaitForCollectionVersion(ctx, parsedCollectionID, *req.MinVersion); err != nil {
+			log.Error("error waiting for collection version", zap.String("collectionID", parsedCollectionID.String()), zap.Int32("minVersion", *req.MinVersion), zap.Error(err))
+			res.Status = failResponseWithError(err, errorCode)
+			return res, nil
+		}
+	}
+
+	collections, err := s.coordinator.GetCollections(ctx, parsedCollectionID, collectionName, tenantID, databaseName, limit, offset, metadataFilters, fields)
 	if err != nil {
 		log.Error("error getting collections", zap.Error(err))
-		res.Status = failResponseWithError(err, errorCode)
+		if errors.Is(err, common.ErrInvalidMetadataFilter) {
+			res.Status = failResponseWithError(err, 400)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	if next, ok := nextPageOffset(limit, offset, len(collections)); ok {
+		res.NextPageToken = s.pageTokens.issue(coordinatorpb.SysDB_GetCollections_FullMethodName, pageQuery(req, getCollectionsPageFields...), pagePosition{Offset: next})
+	}
+	return s.writeCollectionsResponse(ctx, req, res, collections, fields)
+}
+
+// getCollectionsByIDs serves a GetCollections with ids.
+func (s *Server) getCollectionsByIDs(ctx context.Context, req *coordinatorpb.GetCollectionsRequest) (*coordinatorpb.GetCollectionsResponse, error) {
+	res := &coordinatorpb.GetCollectionsResponse{}
+	if req.Id != nil || req.Name != nil || req.Limit != nil || req.Offset != nil || req.PageToken != nil ||
+		len(req.MetadataFilters) > 0 || req.Dimension != nil || req.DistanceFunction != nil || req.MinVersion != nil {
+		res.Status = failResponseWithError(common.ErrCollectionIDsWithFilters, 400)
+		return res, nil
+	}
+	collectionIDs := make([]types.UniqueID, 0, len(req.Ids))
+	for _, id := range req.Ids {
+		collectionID, err := types.Parse(id)
+		if err != nil {
+			log.Error("collection id format error", zap.String("collectionpd.id", id))
+			res.Status = failResponseWithError(common.ErrCollectionIDFormat, 400)
+			return res, nil
+		}
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+	fields, err := convertCollectionFieldMaskToModel(req.FieldMask)
+	if err != nil {
+		log.Error("error converting collection field mask", zap.Error(err))
+		res.Status = failResponseWithError(err, 400)
+		return res, nil
+	}
+
+	collections, err := s.coordinator.GetCollectionsByIDs(ctx, collectionIDs, fields)
+	if err != nil {
+		log.Error("error getting collections by ids", zap.Int("count", len(collectionIDs)), zap.Error(err))
+		if errors.Is(err, common.ErrCollectionBatchTooLarge) {
+			res.Status = failResponseWithError(err, 400)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	filtered := collections[:0]
+	for _, collection := range collections {
+		if req.Tenant != "" && collection.TenantID != req.Tenant {
+			continue
+		}
+		if req.Database != "" && collection.DatabaseName != req.Database {
+			continue
+		}
+		filtered = append(filtered, collection)
+	}
+	return s.writeCollectionsResponse(ctx, req, res, filtered, fields)
+}
+
+// writeCollectionsResponse fills in the collections of a GetCollections
+// response, or only its etag when the caller already has them.
+func (s *Server) writeCollectionsResponse(ctx context.Context, req *coordinatorpb.GetCollectionsRequest, res *coordinatorpb.GetCollectionsResponse, collections []*model.Collection, fields *model.CollectionFields) (*coordinatorpb.GetCollectionsResponse, error) {
+	if req.IncludeLogLag && fields.IncludesLogLag() {
+		// the lag is informational, so the collections are returned without it
+		// rather than failing the call
+		if err := s.coordinator.LoadCollectionLogLag(ctx, collections); err != nil {
+			log.Warn("error loading collection log lag", zap.Error(err))
+		}
+	}
+	res.Etag = collectionsETag(collections, req.FieldMask)
+	if req.IfNoneMatch != nil && *req.IfNoneMatch == res.Etag {
+		res.Status = &coordinatorpb.Status{Reason: notModified, Code: notModifiedCode}
 		return res, nil
 	}
 	res.Collections = make([]*coordinatorpb.Collection, 0, len(collections))
 	for _, collection := range collections {
-		collectionpb := convertCollectionToProto(collection)
+		collectionpb := maskCollection(convertCollectionToProto(collection), req.FieldMask)
 		res.Collections = append(res.Collections, collectionpb)
 	}
 	log.Info("collection service collections", zap.Any("collections", res.Collections))
@@ -141,12 +270,17 @@ func (s *Server) DeleteCollection(ctx context.Context, req *coordinatorpb.Delete
 		ID:           parsedCollectionID,
 		TenantID:     req.GetTenant(),
 		DatabaseName: req.GetDatabase(),
+		Reason:       req.GetReason(),
+		Actor:        req.GetActor(),
 	}
 	err = s.coordinator.DeleteCollection(ctx, deleteCollection)
 	if err != nil {
 		if errors.Is(err, common.ErrCollectionDeleteNonExistingCollection) {
 			log.Error("ErrCollectionDeleteNonExistingCollection", zap.String("collectionpd.id", collectionID))
 			res.Status = failResponseWithError(err, 404)
+		} else if common.IsWriteRejected(err) {
+			log.Error(err.Error(), zap.String("collectionpd.id", collectionID))
+			res.Status = failResponseWithError(err, 403)
 		} else {
 			log.Error(err.Error(), zap.String("collectionpd.id", collectionID))
 			res.Status = failResponseWithError(err, errorCode)
@@ -168,10 +302,25 @@ func (s *Server) UpdateCollection(ctx context.Context, req *coordinatorpb.Update
 		return res, nil
 	}
 
+	var embeddingFunctionID *types.UniqueID
+	if update, ok := req.EmbeddingFunctionUpdate.(*coordinatorpb.UpdateCollectionRequest_EmbeddingFunctionId); ok {
+		embeddingFunctionID, err = convertEmbeddingFunctionIDToModel(&update.EmbeddingFunctionId)
+		if err != nil {
+			res.Status = failResponseWithError(err, 400)
+			return res, nil
+		}
+	}
+
 	updateCollection := &model.UpdateCollection{
-		ID:        parsedCollectionID,
-		Name:      req.Name,
-		Dimension: req.Dimension,
+		ID:             parsedCollectionID,
+		Name:           req.Name,
+		Dimension:      req.Dimension,
+		RequestReindex: req.GetRequestReindex(),
+
+		ReplicationFactor:      req.ReplicationFactor,
+		ExpectedRevision:       req.ExpectedRevision,
+		EmbeddingFunctionID:    embeddingFunctionID,
+		ResetEmbeddingFunction: req.GetResetEmbeddingFunction(),
 	}
 
 	resetMetadata := req.GetResetMetadata()
@@ -203,18 +352,402 @@ func (s *Server) UpdateCollection(ctx context.Context, req *coordinatorpb.Update
 		}
 	}
 
-	_, err = s.coordinator.UpdateCollection(ctx, updateCollection)
+	collection, changedFields, err := s.coordinator.UpdateCollection(ctx, updateCollection)
 
 	if err != nil {
 		log.Error("error updating collection", zap.Error(err))
-		if err == common.ErrCollectionUniqueConstraintViolation {
+		if err == common.ErrCollectionUniqueConstraintViolation || err == common.ErrCollectionRevisionMismatch {
+			res.Status = failResponseWithError(err, 409)
+		} else if err == common.ErrEmbeddingFunctionNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else if common.IsWriteRejected(err) {
+			res.Status = failResponseWithError(err, 403)
+		} else if common.IsNameError(err) || isMetadataLimitError(err) || isInvalidConfigurationError(err) {
+			res.Status = failResponseWithError(err, 400)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+
+	res.ChangedFields = changedFields
+	res.Revision = collection.Revision
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) SetCollectionAclEntry(ctx context.Context, req *coordinatorpb.SetCollectionAclEntryRequest) (*coordinatorpb.SetCollectionAclEntryResponse, error) {
+	res := &coordinatorpb.SetCollectionAclEntryResponse{}
+	collectionID, err := types.ToUniqueID(&req.CollectionId)
+	if err != nil {
+		log.Error("collection id format error", zap.String("collection.id", req.CollectionId))
+		res.Status = failResponseWithError(common.ErrCollectionIDFormat, 400)
+		return res, nil
+	}
+	permissions, err := model.ParseCollectionPermissions(req.GetEntry().GetPermissions())
+	if err != nil {
+		res.Status = failResponseWithError(err, 400)
+		return res, nil
+	}
+	err = s.coordinator.SetCollectionAclEntry(ctx, &model.CollectionAclEntry{
+		CollectionID: collectionID,
+		Principal:    req.GetEntry().GetPrincipal(),
+		Permissions:  permissions,
+	})
+	if err != nil {
+		log.Error("error setting collection acl entry", zap.Error(err))
+		if err == common.ErrInvalidCollectionAclEntry {
+			res.Status = failResponseWithError(err, 400)
+		} else if err == common.ErrCollectionNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) DeleteCollectionAclEntry(ctx context.Context, req *coordinatorpb.DeleteCollectionAclEntryRequest) (*coordinatorpb.DeleteCollectionAclEntryResponse, error) {
+	res := &coordinatorpb.DeleteCollectionAclEntryResponse{}
+	collectionID, err := types.ToUniqueID(&req.CollectionId)
+	if err != nil {
+		log.Error("collection id format error", zap.String("collection.id", req.CollectionId))
+		res.Status = failResponseWithError(common.ErrCollectionIDFormat, 400)
+		return res, nil
+	}
+	err = s.coordinator.DeleteCollectionAclEntry(ctx, collectionID, req.Principal)
+	if err != nil {
+		log.Error("error deleting collection acl entry", zap.Error(err))
+		if err == common.ErrInvalidCollectionAclEntry {
+			res.Status = failResponseWithError(err, 400)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) GetCollectionAcl(ctx context.Context, req *coordinatorpb.GetCollectionAclRequest) (*coordinatorpb.GetCollectionAclResponse, error) {
+	res := &coordinatorpb.GetCollectionAclResponse{}
+	collectionID, err := types.ToUniqueID(&req.CollectionId)
+	if err != nil {
+		log.Error("collection id format error", zap.String("collection.id", req.CollectionId))
+		res.Status = failResponseWithError(common.ErrCollectionIDFormat, 400)
+		return res, nil
+	}
+	entries, err := s.coordinator.GetCollectionAcl(ctx, collectionID)
+	if err != nil {
+		log.Error("error getting collection acl", zap.Error(err))
+		res.Status = failResponseWithError(err, errorCode)
+		return res, nil
+	}
+	res.Entries = convertCollectionAclToProto(entries)
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) SetCollectionLogRoute(ctx context.Context, req *coordinatorpb.SetCollectionLogRouteRequest) (*coordinatorpb.SetCollectionLogRouteResponse, error) {
+	res := &coordinatorpb.SetCollectionLogRouteResponse{}
+	collectionID, err := types.ToUniqueID(&req.CollectionId)
+	if err != nil {
+		log.Error("collection id format error", zap.String("collection.id", req.CollectionId))
+		res.Status = failResponseWithError(common.ErrCollectionIDFormat, 400)
+		return res, nil
+	}
+	route, err := s.coordinator.SetCollectionLogRoute(ctx, collectionID, req.LogAddress)
+	if err != nil {
+		log.Error("error setting collection log route", zap.Error(err))
+		if err == common.ErrInvalidLogAddress {
+			res.Status = failResponseWithError(err, 400)
+		} else if err == common.ErrCollectionNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Route = convertCollectionLogRouteToProto(route)
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) ResolveCollectionLogRoutes(ctx context.Context, req *coordinatorpb.ResolveCollectionLogRoutesRequest) (*coordinatorpb.ResolveCollectionLogRoutesResponse, error) {
+	res := &coordinatorpb.ResolveCollectionLogRoutesResponse{}
+	collectionIDs := make([]types.UniqueID, 0, len(req.CollectionIds))
+	for _, id := range req.CollectionIds {
+		collectionID, err := types.ToUniqueID(&id)
+		if err != nil {
+			log.Error("collection id format error", zap.String("collection.id", id))
+			res.Status = failResponseWithError(common.ErrCollectionIDFormat, 400)
+			return res, nil
+		}
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+	routes, asOf, err := s.coordinator.ResolveCollectionLogRoutes(ctx, collectionIDs, req.ChangedAfter)
+	if err != nil {
+		log.Error("error resolving collection log routes", zap.Error(err))
+		res.Status = failResponseWithError(err, errorCode)
+		return res, nil
+	}
+	res.Routes = make([]*coordinatorpb.CollectionLogRoute, 0, len(routes))
+	for _, route := range routes {
+		res.Routes = append(res.Routes, convertCollectionLogRouteToProto(route))
+	}
+	res.AsOf = asOf
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) CheckCollections(ctx context.Context, req *coordinatorpb.CheckCollectionsRequest) (*coordinatorpb.CheckCollectionsResponse, error) {
+	res := &coordinatorpb.CheckCollectionsResponse{}
+	collectionIDs := make([]types.UniqueID, 0, len(req.CollectionIds))
+	for _, id := range req.CollectionIds {
+		collectionID, err := types.ToUniqueID(&id)
+		if err != nil {
+			log.Error("collection id format error", zap.String("collection.id", id))
+			res.Status = failResponseWithError(common.ErrCollectionIDFormat, 400)
+			return res, nil
+		}
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+	collections, err := s.coordinator.CheckCollections(ctx, collectionIDs)
+	if err != nil {
+		log.Error("error checking collections", zap.Error(err))
+		if err == common.ErrCollectionBatchTooLarge {
+			res.Status = failResponseWithError(err, 400)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Collections = make([]*coordinatorpb.CollectionExistence, 0, len(collections))
+	for _, collection := range collections {
+		res.Collections = append(res.Collections, &coordinatorpb.CollectionExistence{
+			CollectionId: collection.ID.String(),
+			Exists:       collection.Exists,
+			IsDeleted:    collection.IsDeleted,
+		})
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) RequestCompaction(ctx context.Context, req *coordinatorpb.RequestCompactionRequest) (*coordinatorpb.RequestCompactionResponse, error) {
+	res := &coordinatorpb.RequestCompactionResponse{}
+	collectionID, err := types.ToUniqueID(&req.CollectionId)
+	if err != nil {
+		log.Error("collection id format error", zap.String("collection.id", req.CollectionId))
+		res.Status = failResponseWithError(common.ErrCollectionIDFormat, 400)
+		return res, nil
+	}
+	err = s.coordinator.RequestCompaction(ctx, collectionID, req.Actor)
+	if err != nil {
+		log.Error("error requesting compaction", zap.Error(err))
+		if err == common.ErrCollectionNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) GetCompactionRequests(ctx context.Context, req *coordinatorpb.GetCompactionRequestsRequest) (*coordinatorpb.GetCompactionRequestsResponse, error) {
+	res := &coordinatorpb.GetCompactionRequestsResponse{}
+	requests, err := s.coordinator.GetCompactionRequests(ctx, req.GetLimit())
+	if err != nil {
+		log.Error("error getting compaction requests", zap.Error(err))
+		res.Status = failResponseWithError(err, errorCode)
+		return res, nil
+	}
+	res.Requests = make([]*coordinatorpb.CompactionRequest, 0, len(requests))
+	for _, request := range requests {
+		res.Requests = append(res.Requests, &coordinatorpb.CompactionRequest{
+			CollectionId:    request.CollectionID.String(),
+			Tenant:          request.TenantID,
+			RequestedBy:     request.RequestedBy,
+			RequestedAt:     request.RequestedAt,
+			LastCompactor:   request.LastCompactor,
+			LastCompactedAt: request.LastCompactedAt,
+		})
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) DeleteCollections(ctx context.Context, req *coordinatorpb.DeleteCollectionsRequest) (*coordinatorpb.DeleteCollectionsResponse, error) {
+	res := &coordinatorpb.DeleteCollectionsResponse{}
+	collectionIDs := make([]types.UniqueID, 0, len(req.Ids))
+	for _, id := range req.Ids {
+		collectionID, err := types.ToUniqueID(&id)
+		if err != nil {
+			log.Error("collection id format error", zap.String("collection.id", id))
+			res.Status = failResponseWithError(common.ErrCollectionIDFormat, 400)
+			return res, nil
+		}
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+
+	results, err := s.coordinator.DeleteCollections(ctx, req.Tenant, req.Database, collectionIDs, req.Actor)
+	if err != nil {
+		log.Error("error deleting collections", zap.Error(err))
+		if err == common.ErrCollectionBatchTooLarge {
+			res.Status = failResponseWithError(err, 400)
+		} else if common.IsWriteRejected(err) {
+			res.Status = failResponseWithError(err, 403)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Results = convertCollectionBatchResultsToProto(results, func(err error) int32 {
+		if err == common.ErrCollectionDeleteNonExistingCollection {
+			return 404
+		}
+		return errorCode
+	})
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) RestoreCollection(ctx context.Context, req *coordinatorpb.RestoreCollectionRequest) (*coordinatorpb.RestoreCollectionResponse, error) {
+	res := &coordinatorpb.RestoreCollectionResponse{}
+	collectionID, err := types.ToUniqueID(&req.Id)
+	if err != nil {
+		log.Error("collection id format error", zap.String("collection.id", req.Id))
+		res.Status = failResponseWithError(common.ErrCollectionIDFormat, 400)
+		return res, nil
+	}
+	collection, err := s.coordinator.RestoreCollection(ctx, collectionID, req.Name)
+	if err != nil {
+		log.Error("error restoring collection", zap.String("collection.id", req.Id), zap.Error(err))
+		switch {
+		case common.IsNameError(err):
+			res.Status = failResponseWithError(err, 400)
+		case err == common.ErrCollectionNotFound:
+			res.Status = failResponseWithError(err, 404)
+		case err == common.ErrCollectionUniqueConstraintViolation:
+			res.Status = failResponseWithError(err, 409)
+		case err == common.ErrCollectionLimitExceeded:
+			res.Status = failResponseWithError(err, 429)
+		default:
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Collection = convertCollectionToProto(collection)
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) RenameCollection(ctx context.Context, req *coordinatorpb.RenameCollectionRequest) (*coordinatorpb.RenameCollectionResponse, error) {
+	res := &coordinatorpb.RenameCollectionResponse{}
+	collectionID, err := types.ToUniqueID(&req.Id)
+	if err != nil {
+		log.Error("collection id format error", zap.String("collection.id", req.Id))
+		res.Status = failResponseWithError(common.ErrCollectionIDFormat, 400)
+		return res, nil
+	}
+	collection, err := s.coordinator.RenameCollection(ctx, &model.RenameCollection{
+		ID:               collectionID,
+		Name:             req.Name,
+		ExpectedRevision: req.ExpectedRevision,
+	})
+	if err != nil {
+		log.Error("error renaming collection", zap.String("collection.id", req.Id), zap.String("name", req.Name), zap.Error(err))
+		switch {
+		case common.IsNameError(err):
+			res.Status = failResponseWithError(err, 400)
+		case err == common.ErrCollectionNotFound:
+			res.Status = failResponseWithError(err, 404)
+		case common.IsCollectionAlreadyExists(err) || err == common.ErrCollectionRevisionMismatch:
 			res.Status = failResponseWithError(err, 409)
+		case common.IsWriteRejected(err):
+			res.Status = failResponseWithError(err, 403)
+		default:
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Collection = convertCollectionToProto(collection)
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) BatchUpdateCollectionMetadata(ctx context.Context, req *coordinatorpb.BatchUpdateCollectionMetadataRequest) (*coordinatorpb.BatchUpdateCollectionMetadataResponse, error) {
+	res := &coordinatorpb.BatchUpdateCollectionMetadataResponse{}
+
+	collectionIDs := make([]types.UniqueID, 0, len(req.CollectionIds))
+	for _, id := range req.CollectionIds {
+		collectionID, err := types.ToUniqueID(&id)
+		if err != nil {
+			log.Error("collection id format error", zap.String("collection.id", id))
+			res.Status = failResponseWithError(common.ErrCollectionIDFormat, 400)
+			return res, nil
+		}
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+	setMetadata, err := convertCollectionMetadataToModel(req.SetMetadata)
+	if err != nil {
+		log.Error("error converting collection metadata to model", zap.Error(err))
+		res.Status = failResponseWithError(err, 400)
+		return res, nil
+	}
+	patch := &model.CollectionMetadataPatch{
+		Set:    setMetadata,
+		Delete: req.DeleteKeys,
+	}
+
+	results, err := s.coordinator.BatchUpdateCollectionMetadata(ctx, collectionIDs, patch)
+	if err != nil {
+		log.Error("error batch updating collection metadata", zap.Error(err))
+		if err == common.ErrCollectionBatchTooLarge || err == common.ErrInvalidMetadataPatch {
+			res.Status = failResponseWithError(err, 400)
+		} else if common.IsWriteRejected(err) {
+			res.Status = failResponseWithError(err, 403)
 		} else {
 			res.Status = failResponseWithError(err, errorCode)
 		}
 		return res, nil
 	}
+	res.Results = convertCollectionBatchResultsToProto(results, func(err error) int32 {
+		if err == common.ErrCollectionNotFound {
+			return 404
+		} else if isMetadataLimitError(err) || isInvalidConfigurationError(err) {
+			return 400
+		}
+		return errorCode
+	})
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
 
+func (s *Server) CompleteCollectionReindex(ctx context.Context, req *coordinatorpb.CompleteCollectionReindexRequest) (*coordinatorpb.CompleteCollectionReindexResponse, error) {
+	res := &coordinatorpb.CompleteCollectionReindexResponse{}
+	collectionID, err := types.ToUniqueID(&req.CollectionId)
+	if err != nil {
+		log.Error("collection id format error", zap.String("collection.id", req.CollectionId))
+		res.Status = failResponseWithError(common.ErrCollectionIDFormat, 400)
+		return res, nil
+	}
+	err = s.coordinator.CompleteCollectionReindex(ctx, collectionID, req.Dimension)
+	if err != nil {
+		log.Error("error completing collection reindex", zap.Error(err))
+		if err == common.ErrCollectionNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else if err == common.ErrCollectionReindexStale {
+			res.Status = failResponseWithError(err, 409)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
 	res.Status = setResponseStatus(successCode)
 	return res, nil
 }
@@ -238,12 +771,36 @@ func (s *Server) FlushCollectionCompaction(ctx context.Context, req *coordinator
 			return nil, err
 		}
 		filePaths := make(map[string][]string)
+		contentHashes := make(map[string][]string)
+		sizeBytes := make(map[string][]int64)
 		for key, filePath := range flushSegmentCompaction.FilePaths {
 			filePaths[key] = filePath.Paths
+			if len(filePath.ContentHashes) != 0 && len(filePath.ContentHashes) != len(filePath.Paths) {
+				grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("content_hashes", common.ErrSegmentFileInfoMismatch.Error())
+				if err != nil {
+					return nil, err
+				}
+				return nil, grpcError
+			}
+			if len(filePath.SizeBytes) != 0 && len(filePath.SizeBytes) != len(filePath.Paths) {
+				grpcError, err := grpcutils.BuildInvalidArgumentGrpcError("size_bytes", common.ErrSegmentFileInfoMismatch.Error())
+				if err != nil {
+					return nil, err
+				}
+				return nil, grpcError
+			}
+			if len(filePath.ContentHashes) != 0 {
+				contentHashes[key] = filePath.ContentHashes
+			}
+			if len(filePath.SizeBytes) != 0 {
+				sizeBytes[key] = filePath.SizeBytes
+			}
 		}
 		segmentCompactionInfo = append(segmentCompactionInfo, &model.FlushSegmentCompaction{
-			ID:        segmentID,
-			FilePaths: filePaths,
+			ID:            segmentID,
+			FilePaths:     filePaths,
+			ContentHashes: contentHashes,
+			SizeBytes:     sizeBytes,
 		})
 	}
 	FlushCollectionCompaction := &model.FlushCollectionCompaction{
@@ -252,10 +809,22 @@ func (s *Server) FlushCollectionCompaction(ctx context.Context, req *coordinator
 		LogPosition:              req.LogPosition,
 		CurrentCollectionVersion: req.CollectionVersion,
 		FlushSegmentCompactions:  segmentCompactionInfo,
+
+		TotalRecordsPostCompaction: req.TotalRecordsPostCompaction,
+		SizeBytesPostCompaction:    req.SizeBytesPostCompaction,
+		FencingToken:               req.FencingToken,
+		CompactorID:                req.CompactorId,
+	}
+	if req.CompactionStartedAt != nil {
+		startedAt := time.UnixMilli(*req.CompactionStartedAt)
+		FlushCollectionCompaction.CompactionStartedAt = &startedAt
 	}
 	flushCollectionInfo, err := s.coordinator.FlushCollectionCompaction(ctx, FlushCollectionCompaction)
 	if err != nil {
 		log.Error("error FlushCollectionCompaction", zap.Error(err))
+		if err == common.ErrCollectionFencingTokenStale {
+			return nil, grpcutils.BuildFailedPreconditionGrpcError(err.Error())
+		}
 		return nil, grpcutils.BuildInternalGrpcError(err.Error())
 	}
 	res := &coordinatorpb.FlushCollectionCompactionResponse{
@@ -266,6 +835,373 @@ func (s *Server) FlushCollectionCompaction(ctx context.Context, req *coordinator
 	return res, nil
 }
 
+func (s *Server) AcquireCollectionFencingToken(ctx context.Context, req *coordinatorpb.AcquireCollectionFencingTokenRequest) (*coordinatorpb.AcquireCollectionFencingTokenResponse, error) {
+	res := &coordinatorpb.AcquireCollectionFencingTokenResponse{}
+	collectionID, err := types.ToUniqueID(&req.CollectionId)
+	if err != nil || collectionID == types.NilUniqueID() {
+		log.Error("collection id format error", zap.String("collectionpd.id", req.CollectionId))
+		res.Status = failResponseWithError(common.ErrCollectionIDFormat, 400)
+		return res, nil
+	}
+	fencingToken, err := s.coordinator.AcquireCollectionFencingToken(ctx, collectionID, req.Owner)
+	if err != nil {
+		log.Error("error acquiring fencing token", zap.String("collectionID", req.CollectionId), zap.Error(err))
+		if err == common.ErrCollectionNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.FencingToken = fencingToken
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) ListCollectionStats(ctx context.Context, req *coordinatorpb.ListCollectionStatsRequest) (*coordinatorpb.ListCollectionStatsResponse, error) {
+	res := &coordinatorpb.ListCollectionStatsResponse{}
+	offset := req.Offset
+	if req.PageToken != nil {
+		if offset != 0 {
+			res.Status = failResponseWithError(common.ErrPageTokenWithStart, 400)
+			return res, nil
+		}
+		position, err := s.pageTokens.verify(coordinatorpb.SysDB_ListCollectionStats_FullMethodName, pageQuery(req, listCollectionStatsPageFields...), *req.PageToken)
+		if err != nil {
+			log.Error("invalid page token", zap.Error(err))
+			res.Status = failResponseWithError(err, 400)
+			return res, nil
+		}
+		offset = position.Offset
+	}
+	stats, err := s.coordinator.ListCollectionStats(ctx, req.Tenant, req.Database, req.Limit, offset)
+	if err != nil {
+		log.Error("error listing collection stats", zap.String("tenant", req.Tenant), zap.String("database", req.Database), zap.Error(err))
+		if err == common.ErrInvalidCollectionStatsPage {
+			res.Status = failResponseWithError(err, 400)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Stats = make([]*coordinatorpb.CollectionStats, 0, len(stats))
+	for _, collectionStats := range stats {
+		res.Stats = append(res.Stats, convertCollectionStatsToProto(collectionStats))
+	}
+	if next, ok := nextPageOffset(&req.Limit, &offset, len(stats)); ok {
+		res.NextPageToken = s.pageTokens.issue(coordinatorpb.SysDB_ListCollectionStats_FullMethodName, pageQuery(req, listCollectionStatsPageFields...), pagePosition{Offset: next})
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) GetCollectionSizeHistory(ctx context.Context, req *coordinatorpb.GetCollectionSizeHistoryRequest) (*coordinatorpb.GetCollectionSizeHistoryResponse, error) {
+	res := &coordinatorpb.GetCollectionSizeHistoryResponse{}
+	collectionID, err := types.ToUniqueID(&req.CollectionId)
+	if err != nil {
+		log.Error("collection id format error", zap.String("collection.id", req.CollectionId))
+		res.Status = failResponseWithError(common.ErrCollectionIDFormat, 400)
+		return res, nil
+	}
+	snapshots, err := s.coordinator.GetCollectionSizeHistory(ctx, collectionID, req.Days)
+	if err != nil {
+		log.Error("error getting collection size history", zap.String("collection.id", req.CollectionId), zap.Error(err))
+		if err == common.ErrInvalidCollectionSizeHistoryDays {
+			res.Status = failResponseWithError(err, 400)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Snapshots = make([]*coordinatorpb.CollectionSizeSnapshot, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		res.Snapshots = append(res.Snapshots, &coordinatorpb.CollectionSizeSnapshot{
+			Day:          snapshot.Day.Unix(),
+			TotalRecords: snapshot.TotalRecords,
+			SizeBytes:    snapshot.SizeBytes,
+		})
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) GetCollectionMetadataDiff(ctx context.Context, req *coordinatorpb.GetCollectionMetadataDiffRequest) (*coordinatorpb.GetCollectionMetadataDiffResponse, error) {
+	res := &coordinatorpb.GetCollectionMetadataDiffResponse{}
+	collectionID, err := types.ToUniqueID(&req.CollectionId)
+	if err != nil {
+		log.Error("collection id format error", zap.String("collection.id", req.CollectionId))
+		res.Status = failResponseWithError(common.ErrCollectionIDFormat, 400)
+		return res, nil
+	}
+	diff, err := s.coordinator.GetCollectionMetadataDiff(ctx, collectionID, req.Revision)
+	if err != nil {
+		log.Error("error getting collection metadata diff", zap.String("collection.id", req.CollectionId), zap.Int64("revision", req.Revision), zap.Error(err))
+		switch err {
+		case common.ErrInvalidCollectionRevision:
+			res.Status = failResponseWithError(err, 400)
+		case common.ErrCollectionNotFound, common.ErrCollectionRevisionNotInHistory:
+			res.Status = failResponseWithError(err, 404)
+		default:
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Changes = convertCollectionMetadataDiffToProto(diff)
+	res.FromRevision = diff.FromRevision
+	res.ToRevision = diff.ToRevision
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) GetCollectionsBySize(ctx context.Context, req *coordinatorpb.GetCollectionsBySizeRequest) (*coordinatorpb.GetCollectionsBySizeResponse, error) {
+	res := &coordinatorpb.GetCollectionsBySizeResponse{}
+
+	orderBy, err := convertCollectionSizeOrderByToModel(req.OrderBy)
+	if err != nil {
+		log.Error("error converting collection size order by", zap.Error(err))
+		res.Status = failResponseWithError(err, 400)
+		return res, nil
+	}
+
+	collectionSizes, err := s.coordinator.GetCollectionsBySize(ctx, req.Tenant, req.Database, orderBy, req.Limit)
+	if err != nil {
+		log.Error("error getting collections by size", zap.Error(err))
+		if err == common.ErrInvalidCollectionSizeLimit {
+			res.Status = failResponseWithError(err, 400)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Collections = make([]*coordinatorpb.CollectionSize, 0, len(collectionSizes))
+	for _, collectionSize := range collectionSizes {
+		res.Collections = append(res.Collections, convertCollectionSizeToProto(collectionSize))
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) GetApproximateCounts(ctx context.Context, req *coordinatorpb.GetApproximateCountsRequest) (*coordinatorpb.GetApproximateCountsResponse, error) {
+	res := &coordinatorpb.GetApproximateCountsResponse{}
+	counts, err := s.coordinator.GetApproximateCounts(ctx, req.Tenant, req.Database)
+	if err != nil {
+		log.Error("error getting approximate counts", zap.Error(err))
+		res.Status = failResponseWithError(err, errorCode)
+		return res, nil
+	}
+	res.CollectionCount = counts.CollectionCount
+	res.RecordCount = counts.RecordCount
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) CountCollections(ctx context.Context, req *coordinatorpb.CountCollectionsRequest) (*coordinatorpb.CountCollectionsResponse, error) {
+	res := &coordinatorpb.CountCollectionsResponse{}
+	count, err := s.coordinator.CountCollections(ctx, req.Tenant, req.GetDatabase(), req.IncludeSoftDeleted)
+	if err != nil {
+		log.Error("error counting collections", zap.String("tenant", req.Tenant), zap.String("database", req.GetDatabase()), zap.Error(err))
+		if err == common.ErrCountCollectionsTenantRequired {
+			res.Status = failResponseWithError(err, 400)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Count = count
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) ListIncompleteCollections(ctx context.Context, req *coordinatorpb.ListIncompleteCollectionsRequest) (*coordinatorpb.ListIncompleteCollectionsResponse, error) {
+	res := &coordinatorpb.ListIncompleteCollectionsResponse{}
+	minAge := time.Duration(req.GetMinAgeSeconds()) * time.Second
+	collections, err := s.coordinator.GetIncompleteCollections(ctx, minAge)
+	if err != nil {
+		log.Error("error listing incomplete collections", zap.Error(err))
+		res.Status = failResponseWithError(err, errorCode)
+		return res, nil
+	}
+	res.Collections = make([]*coordinatorpb.IncompleteCollection, 0, len(collections))
+	for _, collection := range collections {
+		res.Collections = append(res.Collections, convertIncompleteCollectionToProto(collection))
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) ListInconsistentCollections(ctx context.Context, req *coordinatorpb.ListInconsistentCollectionsRequest) (*coordinatorpb.ListInconsistentCollectionsResponse, error) {
+	res := &coordinatorpb.ListInconsistentCollectionsResponse{}
+	minAge := time.Duration(req.GetMinAgeSeconds()) * time.Second
+	collections, err := s.coordinator.GetInconsistentCollections(ctx, minAge)
+	if err != nil {
+		log.Error("error listing inconsistent collections", zap.Error(err))
+		res.Status = failResponseWithError(err, errorCode)
+		return res, nil
+	}
+	res.Collections = make([]*coordinatorpb.InconsistentCollection, 0, len(collections))
+	for _, collection := range collections {
+		res.Collections = append(res.Collections, convertInconsistentCollectionToProto(collection))
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+// CheckConsistencyWithProgress finds the collections ListInconsistentCollections
+// lists, streaming the collections found by each check as it completes. The
+// last message carries the status.
+func (s *Server) CheckConsistencyWithProgress(req *coordinatorpb.ListInconsistentCollectionsRequest, stream coordinatorpb.SysDB_CheckConsistencyWithProgressServer) error {
+	minAge := time.Duration(req.GetMinAgeSeconds()) * time.Second
+	err := s.coordinator.CheckConsistencyWithProgress(stream.Context(), minAge, func(progress *model.OperationProgress, collections []*model.InconsistentCollection) error {
+		res := &coordinatorpb.CheckConsistencyProgress{
+			Progress:    convertOperationProgressToProto(progress),
+			Collections: make([]*coordinatorpb.InconsistentCollection, 0, len(collections)),
+		}
+		for _, collection := range collections {
+			res.Collections = append(res.Collections, convertInconsistentCollectionToProto(collection))
+		}
+		return stream.Send(res)
+	})
+	res := &coordinatorpb.CheckConsistencyProgress{}
+	if err != nil {
+		log.Error("error checking consistency", zap.Error(err))
+		res.Status = failResponseWithError(err, errorCode)
+		return stream.Send(res)
+	}
+	res.Status = setResponseStatus(successCode)
+	return stream.Send(res)
+}
+
+// ScanCollections streams the catalog to backup and indexing jobs, in batches
+// that each carry the token resuming the scan after them.
+func (s *Server) ScanCollections(req *coordinatorpb.ScanCollectionsRequest, stream coordinatorpb.SysDB_ScanCollectionsServer) error {
+	query := pageQuery(req, scanCollectionsPageFields...)
+	var cursor *model.CollectionScanCursor
+	if req.ResumeToken != nil {
+		position, err := s.pageTokens.verify(coordinatorpb.SysDB_ScanCollections_FullMethodName, query, *req.ResumeToken)
+		if err == nil {
+			cursor, err = scanCursorFromPosition(position)
+		}
+		if err != nil {
+			log.Error("invalid resume token", zap.Error(err))
+			return stream.Send(&coordinatorpb.ScanCollectionsResponse{Status: failResponseWithError(err, 400)})
+		}
+	}
+	err := s.coordinator.ScanCollections(stream.Context(), cursor, req.GetBatchSize(), func(collections []*model.Collection, next *model.CollectionScanCursor) error {
+		res := &coordinatorpb.ScanCollectionsResponse{
+			Collections: make([]*coordinatorpb.Collection, 0, len(collections)),
+			ResumeToken: s.pageTokens.issue(coordinatorpb.SysDB_ScanCollections_FullMethodName, query, scanCursorPosition(next)),
+		}
+		for _, collection := range collections {
+			res.Collections = append(res.Collections, convertCollectionToProto(collection))
+		}
+		return stream.Send(res)
+	})
+	res := &coordinatorpb.ScanCollectionsResponse{}
+	if err != nil {
+		log.Error("error scanning collections", zap.Error(err))
+		if err == common.ErrInvalidScanBatchSize {
+			res.Status = failResponseWithError(err, 400)
+		} else if err == common.ErrScanSnapshotExpired {
+			res.Status = failResponseWithError(err, 409)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return stream.Send(res)
+	}
+	res.Status = setResponseStatus(successCode)
+	return stream.Send(res)
+}
+
+func (s *Server) RepairIncompleteCollection(ctx context.Context, req *coordinatorpb.RepairIncompleteCollectionRequest) (*coordinatorpb.RepairIncompleteCollectionResponse, error) {
+	res := &coordinatorpb.RepairIncompleteCollectionResponse{}
+	collectionID, err := types.ToUniqueID(&req.Id)
+	if err != nil {
+		log.Error("collection id format error", zap.String("collection.id", req.Id))
+		res.Status = failResponseWithError(common.ErrCollectionIDFormat, 400)
+		return res, nil
+	}
+	err = s.coordinator.RepairIncompleteCollection(ctx, collectionID, req.Actor)
+	if err != nil {
+		log.Error("error repairing incomplete collection", zap.Error(err))
+		if err == common.ErrCollectionNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else if err == common.ErrCollectionNotIncomplete {
+			res.Status = failResponseWithError(err, 409)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) GetCollectionsToGc(ctx context.Context, req *coordinatorpb.GetCollectionsToGcRequest) (*coordinatorpb.GetCollectionsToGcResponse, error) {
+	res := &coordinatorpb.GetCollectionsToGcResponse{}
+	filter := &model.CollectionsToGcFilter{
+		OlderThan:   time.Duration(req.GetOlderThanSeconds()) * time.Second,
+		TenantID:    req.TenantId,
+		MinVersions: req.GetMinVersions(),
+		Limit:       req.GetLimit(),
+	}
+	startAfterID := req.StartAfter
+	if req.PageToken != nil {
+		if startAfterID != nil {
+			res.Status = failResponseWithError(common.ErrPageTokenWithStart, 400)
+			return res, nil
+		}
+		position, err := s.pageTokens.verify(coordinatorpb.SysDB_GetCollectionsToGc_FullMethodName, pageQuery(req, getCollectionsToGcPageFields...), *req.PageToken)
+		if err != nil {
+			log.Error("invalid page token", zap.Error(err))
+			res.Status = failResponseWithError(err, 400)
+			return res, nil
+		}
+		startAfterID = &position.After
+	}
+	if startAfterID != nil {
+		startAfter, err := types.ToUniqueID(startAfterID)
+		if err != nil {
+			log.Error("collection id format error", zap.String("start_after", *startAfterID))
+			res.Status = failResponseWithError(common.ErrCollectionIDFormat, 400)
+			return res, nil
+		}
+		filter.StartAfter = &startAfter
+	}
+
+	collections, next, err := s.coordinator.GetCollectionsToGc(ctx, filter)
+	if err != nil {
+		log.Error("error getting collections to gc", zap.Error(err))
+		if err == common.ErrInvalidCollectionsToGcFilter {
+			res.Status = failResponseWithError(err, 400)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Collections = make([]*coordinatorpb.CollectionToGc, 0, len(collections))
+	for _, collection := range collections {
+		res.Collections = append(res.Collections, convertCollectionToGcToProto(collection))
+	}
+	if next != nil {
+		nextStartAfter := next.String()
+		res.NextStartAfter = &nextStartAfter
+		res.NextPageToken = s.pageTokens.issue(coordinatorpb.SysDB_GetCollectionsToGc_FullMethodName, pageQuery(req, getCollectionsToGcPageFields...), pagePosition{After: nextStartAfter})
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func isMetadataLimitError(err error) bool {
+	return errors.Is(err, common.ErrMetadataTooManyKeys) ||
+		errors.Is(err, common.ErrMetadataKeyTooLong) ||
+		errors.Is(err, common.ErrMetadataTooLarge)
+}
+
+func isInvalidConfigurationError(err error) bool {
+	return errors.Is(err, common.ErrInvalidCollectionConfiguration) ||
+		errors.Is(err, common.ErrInvalidReplicationFactor)
+}
+
 func failResponseWithError(err error, code int32) *coordinatorpb.Status {
 	return &coordinatorpb.Status{
 		Reason: err.Error(),
@@ -12,6 +12,7 @@ import (
 	"github.com/chroma-core/chroma/go/pkg/metastore/coordinator"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dao"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
 	"github.com/pingcap/log"
 	"github.com/stretchr/testify/suite"
@@ -30,7 +31,7 @@ type CollectionServiceTestSuite struct {
 	s            *Server
 	tenantName   string
 	databaseName string
-	databaseId   string
+	databaseId   dbmodel.DatabaseID
 }
 
 func (suite *CollectionServiceTestSuite) SetupSuite() {
@@ -107,13 +108,15 @@ func testCollection(t *rapid.T) {
 			ctx := context.Background()
 			res, err := s.CreateCollection(ctx, createCollectionRequest)
 			if err != nil {
-				if err == common.ErrCollectionNameEmpty && createCollectionRequest.Name == "" {
+				t.Fatalf("error creating collection: %v", err)
+			}
+			if res.Status.Code != successCode {
+				if res.Status.Code == 400 && createCollectionRequest.Name == "" {
 					t.Logf("expected error for empty collection name")
 					collectionsWithErrors = append(collectionsWithErrors, res.Collection)
-				} else {
-					t.Fatalf("error creating collection: %v", err)
-					collectionsWithErrors = append(collectionsWithErrors, res.Collection)
+					return
 				}
+				t.Fatalf("error creating collection: %s", res.Status.Reason)
 			}
 
 			getCollectionsRequest := coordinatorpb.GetCollectionsRequest{
@@ -196,8 +199,9 @@ func (suite *CollectionServiceTestSuite) TestServer_FlushCollectionCompaction()
 	log.Info("TestServer_FlushCollectionCompaction")
 	// create test collection
 	collectionName := "collection_service_test_flush_collection_compaction"
-	collectionID, err := dao.CreateTestCollection(suite.db, collectionName, 128, suite.databaseId)
+	dbCollectionID, err := dao.CreateTestCollection(suite.db, collectionName, 128, suite.databaseId)
 	suite.NoError(err)
+	collectionID := dbCollectionID.String()
 
 	// flush collection compaction
 	getSegmentReq := coordinatorpb.GetSegmentsRequest{
@@ -324,7 +328,7 @@ func (suite *CollectionServiceTestSuite) TestServer_FlushCollectionCompaction()
 	validateDatabase(suite, collectionID, collection, filePaths)
 
 	// clean up
-	err = dao.CleanUpTestCollection(suite.db, collectionID)
+	err = dao.CleanUpTestCollection(suite.db, dbCollectionID)
 	suite.NoError(err)
 }
 
@@ -0,0 +1,136 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+func (s *Server) CreateCollectionTemplate(ctx context.Context, req *coordinatorpb.CreateCollectionTemplateRequest) (*coordinatorpb.CreateCollectionTemplateResponse, error) {
+	res := &coordinatorpb.CreateCollectionTemplateResponse{}
+	template, err := convertCollectionTemplateToModel(req.GetTemplate())
+	if err != nil {
+		res.Status = failResponseWithError(err, 400)
+		return res, nil
+	}
+	created, err := s.coordinator.CreateCollectionTemplate(ctx, template)
+	if err != nil {
+		log.Error("error creating collection template", zap.String("tenant", template.TenantID), zap.String("name", template.Name), zap.Error(err))
+		if err == common.ErrInvalidCollectionTemplate || isMetadataLimitError(err) || isInvalidConfigurationError(err) {
+			res.Status = failResponseWithError(err, 400)
+		} else if err == common.ErrTenantNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else if err == common.ErrCollectionTemplateUniqueConstraintViolation {
+			res.Status = failResponseWithError(err, 409)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Template = convertCollectionTemplateToProto(created)
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) GetCollectionTemplates(ctx context.Context, req *coordinatorpb.GetCollectionTemplatesRequest) (*coordinatorpb.GetCollectionTemplatesResponse, error) {
+	res := &coordinatorpb.GetCollectionTemplatesResponse{}
+	id := types.NilUniqueID()
+	if req.Id != nil {
+		parsed, err := types.Parse(req.GetId())
+		if err != nil {
+			res.Status = failResponseWithError(common.ErrCollectionTemplateIDFormat, 400)
+			return res, nil
+		}
+		id = parsed
+	}
+	templates, err := s.coordinator.GetCollectionTemplates(ctx, id, req.GetTenant(), req.Name)
+	if err != nil {
+		log.Error("error getting collection templates", zap.String("tenant", req.GetTenant()), zap.Error(err))
+		if err == common.ErrInvalidCollectionTemplate {
+			res.Status = failResponseWithError(err, 400)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Templates = make([]*coordinatorpb.CollectionTemplate, 0, len(templates))
+	for _, template := range templates {
+		res.Templates = append(res.Templates, convertCollectionTemplateToProto(template))
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) DeleteCollectionTemplate(ctx context.Context, req *coordinatorpb.DeleteCollectionTemplateRequest) (*coordinatorpb.DeleteCollectionTemplateResponse, error) {
+	res := &coordinatorpb.DeleteCollectionTemplateResponse{}
+	id, err := types.Parse(req.GetId())
+	if err != nil {
+		res.Status = failResponseWithError(common.ErrCollectionTemplateIDFormat, 400)
+		return res, nil
+	}
+	err = s.coordinator.DeleteCollectionTemplate(ctx, id)
+	if err != nil {
+		log.Error("error deleting collection template", zap.String("id", req.GetId()), zap.Error(err))
+		if err == common.ErrCollectionTemplateNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) CreateCollectionFromTemplate(ctx context.Context, req *coordinatorpb.CreateCollectionFromTemplateRequest) (*coordinatorpb.CreateCollectionFromTemplateResponse, error) {
+	res := &coordinatorpb.CreateCollectionFromTemplateResponse{}
+	templateID := types.NilUniqueID()
+	if req.TemplateId != nil {
+		parsed, err := types.Parse(req.GetTemplateId())
+		if err != nil {
+			res.Status = failResponseWithError(common.ErrCollectionTemplateIDFormat, 400)
+			return res, nil
+		}
+		templateID = parsed
+	}
+	if req.Collection == nil {
+		res.Status = failResponseWithError(common.ErrCollectionIDFormat, 400)
+		return res, nil
+	}
+	createCollection, err := convertToCreateCollectionModel(req.Collection)
+	if err != nil {
+		log.Error("error converting to create collection model", zap.Error(err))
+		res.Status = failResponseWithError(err, 400)
+		return res, nil
+	}
+	collection, err := s.coordinator.CreateCollectionFromTemplate(ctx, &model.CreateCollectionFromTemplate{
+		TemplateID:   templateID,
+		TemplateName: req.GetTemplateName(),
+		Collection:   createCollection,
+	})
+	if err != nil {
+		log.Error("error creating collection from template", zap.String("template.id", req.GetTemplateId()), zap.String("template.name", req.GetTemplateName()), zap.Error(err))
+		if err == common.ErrCollectionUniqueConstraintViolation {
+			res.Status = failResponseWithError(err, 409)
+		} else if err == common.ErrCollectionLimitExceeded {
+			res.Status = failResponseWithError(err, 429)
+		} else if err == common.ErrCollectionTemplateNotFound || err == common.ErrEmbeddingFunctionNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else if common.IsWriteRejected(err) {
+			res.Status = failResponseWithError(err, 403)
+		} else if err == common.ErrInvalidCollectionTemplate || common.IsNameError(err) || isMetadataLimitError(err) || isInvalidConfigurationError(err) || err == common.ErrSegmentCollectionMismatch {
+			res.Status = failResponseWithError(err, 400)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Collection = convertCollectionToProto(collection)
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
@@ -0,0 +1,133 @@
+package grpc
+
+import (
+	"net"
+	"slices"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/coordinator"
+	"github.com/chroma-core/chroma/go/pkg/objectstore"
+	"github.com/chroma-core/chroma/go/pkg/utils"
+)
+
+// Validate checks the configuration of the coordinator and returns a
+// *utils.ConfigError listing every invalid field, named after its flag.
+func (c *Config) Validate() error {
+	v := &utils.ConfigValidator{}
+	if c.GrpcConfig != nil {
+		_, _, err := net.SplitHostPort(c.GrpcConfig.BindAddress)
+		v.Check(err == nil, "grpc-addr", "is %q, must be host:port", c.GrpcConfig.BindAddress)
+	}
+
+	v.OneOf("system-catalog-provider", c.SystemCatalogProvider, "memory", "database")
+	if c.SystemCatalogProvider == "database" {
+		db := c.DBConfig
+		v.Check(db.Address != "", "db-address", "must be set")
+		v.Check(db.Port > 0 && db.Port <= 65535, "db-port", "is %d, must be between 1 and 65535", db.Port)
+		v.Check(db.DBName != "", "db-name", "must be set")
+		v.Check(db.Username != "", "username", "must be set")
+		v.Check(db.MaxOpenConns > 0, "max-open-conns", "is %d, must be positive", db.MaxOpenConns)
+		v.Check(db.MaxIdleConns >= 0, "max-idle-conns", "is %d, must not be negative", db.MaxIdleConns)
+		v.Check(db.BackgroundMaxOpenConns >= 0, "background-max-open-conns", "is %d, must not be negative", db.BackgroundMaxOpenConns)
+		v.OneOf("ssl-mode", db.SslMode, "disable", "allow", "prefer", "require", "verify-ca", "verify-full")
+		v.Check(db.ReadTimeout >= 0, "db-read-timeout", "is %s, must not be negative", db.ReadTimeout)
+		v.Check(db.WriteTimeout >= 0, "db-write-timeout", "is %s, must not be negative", db.WriteTimeout)
+	}
+
+	v.OneOf("notification-store-provider", c.NotificationStoreProvider, "memory", "database")
+	v.OneOf("notifier-provider", c.NotifierProvider, "memory")
+
+	if !c.Testing {
+		v.Check(c.KubernetesNamespace != "", "kubernetes-namespace", "must be set")
+		v.Check(c.ReconcileInterval > 0, "reconcile-interval", "is %s, must be positive", c.ReconcileInterval)
+		v.Check(c.WatchInterval > 0, "watch-interval", "is %s, must be positive", c.WatchInterval)
+		v.Check(c.QueryServiceMemberlistName != "", "query-memberlist-name", "must be set")
+		v.Check(c.CompactionServiceMemberlistName != "", "compaction-memberlist-name", "must be set")
+	}
+
+	v.Check(c.MaxCollectionsPerDatabase >= 0, "max-collections-per-database", "is %d, must not be negative", c.MaxCollectionsPerDatabase)
+	v.Check(c.MaxMetadataKeys >= 0, "max-metadata-keys", "is %d, must not be negative", c.MaxMetadataKeys)
+	v.Check(c.MaxMetadataKeyLength >= 0, "max-metadata-key-length", "is %d, must not be negative", c.MaxMetadataKeyLength)
+	v.Check(c.MaxMetadataSizeBytes >= 0, "max-metadata-size-bytes", "is %d, must not be negative", c.MaxMetadataSizeBytes)
+	v.Check(c.MaxReplicationFactor >= 0, "max-replication-factor", "is %d, must not be negative", c.MaxReplicationFactor)
+	v.Check(c.FlushCompactionMaxRetries >= 0, "flush-compaction-max-retries", "is %d, must not be negative", c.FlushCompactionMaxRetries)
+	v.Check(c.ConfirmationTokenTTL >= 0, "confirmation-token-ttl", "is %s, must not be negative", c.ConfirmationTokenTTL)
+	for _, key := range c.PageTokenKeys {
+		v.Check(len(key) >= minPageTokenKeySize, "page-token-keys", "has a key of %d bytes, keys must have at least %d", len(key), minPageTokenKeySize)
+	}
+	for _, key := range c.ConfirmationTokenKeys {
+		v.Check(len(key) >= minPageTokenKeySize, "confirmation-token-keys", "has a key of %d bytes, keys must have at least %d", len(key), minPageTokenKeySize)
+	}
+
+	v.Check(c.MaintenanceWindowStartHour >= 0 && c.MaintenanceWindowStartHour <= 23, "maintenance-window-start-hour", "is %d, must be between 0 and 23", c.MaintenanceWindowStartHour)
+	v.Check(c.MaintenanceWindowDuration >= 0 && c.MaintenanceWindowDuration <= 24*time.Hour, "maintenance-window-duration", "is %s, must be between 0 and 24h", c.MaintenanceWindowDuration)
+
+	v.Check(c.DecommissionDrainPeriod >= 0, "decommission-drain-period", "is %s, must not be negative", c.DecommissionDrainPeriod)
+	v.Check(c.HeartbeatTimeout >= 0, "heartbeat-timeout", "is %s, must not be negative", c.HeartbeatTimeout)
+	v.Check(c.LeaderLeaseTTL >= 0, "leader-lease-ttl", "is %s, must not be negative", c.LeaderLeaseTTL)
+	v.Check(!c.ReadOnly || c.LeaderLeaseTTL == 0, "leader-lease-ttl", "is %s, must be 0 with --read-only", c.LeaderLeaseTTL)
+	v.Check(c.MaxInFlightWriteTx >= 0, "max-in-flight-write-tx", "is %d, must not be negative", c.MaxInFlightWriteTx)
+	v.Check(c.MaxPoolWait >= 0, "max-pool-wait", "is %s, must not be negative", c.MaxPoolWait)
+	v.Check(c.MaxConcurrentCompactionRequests >= 0, "max-concurrent-compaction-requests", "is %d, must not be negative", c.MaxConcurrentCompactionRequests)
+	v.Check(c.MaxConcurrentAdminRequests >= 0, "max-concurrent-admin-requests", "is %d, must not be negative", c.MaxConcurrentAdminRequests)
+	v.Check(c.MaxConcurrentRequestsPerTenant >= 0, "max-concurrent-requests-per-tenant", "is %d, must not be negative", c.MaxConcurrentRequestsPerTenant)
+
+	v.Check(c.QuerySampleRate >= 0 && c.QuerySampleRate <= 1, "query-sample-rate", "is %v, must be between 0 and 1", c.QuerySampleRate)
+	v.Check(c.QuerySampleBufferSize >= 0, "query-sample-buffer-size", "is %d, must not be negative", c.QuerySampleBufferSize)
+	v.Check(c.CollectionStatsRefreshInterval >= 0, "collection-stats-refresh-interval", "is %s, must not be negative", c.CollectionStatsRefreshInterval)
+	v.Check(c.CollectionSizeHistoryRetention >= 0, "collection-size-history-retention", "is %s, must not be negative", c.CollectionSizeHistoryRetention)
+	v.Check(c.SoftDeleteRetention >= 0, "soft-delete-retention", "is %s, must not be negative", c.SoftDeleteRetention)
+	v.Check(c.SoftDeleteCleanerInterval >= 0, "soft-delete-cleaner-interval", "is %s, must not be negative", c.SoftDeleteCleanerInterval)
+	v.Check(c.SoftDeleteCleanerBatchSize >= 0, "soft-delete-cleaner-batch-size", "is %d, must not be negative", c.SoftDeleteCleanerBatchSize)
+	v.Check(c.SoftDeleteCleanerMaxAttempts >= 0, "soft-delete-cleaner-max-attempts", "is %d, must not be negative", c.SoftDeleteCleanerMaxAttempts)
+	v.Check(c.JobWorkerConcurrency >= 0, "job-worker-concurrency", "is %d, must not be negative", c.JobWorkerConcurrency)
+	v.Check(c.JobPollInterval >= 0, "job-poll-interval", "is %s, must not be negative", c.JobPollInterval)
+	v.Check(c.ScanCollectionsRate >= 0, "scan-collections-rate", "is %d, must not be negative", c.ScanCollectionsRate)
+	v.Check(c.LogLagCacheTTL >= 0, "log-lag-cache-ttl", "is %s, must not be negative", c.LogLagCacheTTL)
+	v.Check(c.LogOffsetReconcileInterval >= 0, "log-offset-reconcile-interval", "is %s, must not be negative", c.LogOffsetReconcileInterval)
+	v.Check(c.FeatureFlagCacheTTL >= 0, "feature-flag-cache-ttl", "is %s, must not be negative", c.FeatureFlagCacheTTL)
+	v.Check(c.MaintenanceModeCacheTTL >= 0, "maintenance-mode-cache-ttl", "is %s, must not be negative", c.MaintenanceModeCacheTTL)
+
+	v.Check(c.MaxNameLength >= 0, "max-name-length", "is %d, must not be negative", c.MaxNameLength)
+	v.Check(!slices.Contains(c.ReservedNamePrefixes, ""), "reserved-name-prefixes", "must not list an empty prefix, which reserves every name")
+	validateNamePattern(v, "tenant-name-pattern", c.TenantNamePattern)
+	validateNamePattern(v, "database-name-pattern", c.DatabaseNamePattern)
+	validateNamePattern(v, "collection-name-pattern", c.CollectionNamePattern)
+
+	registered := coordinator.PreWriteHookNames()
+	for _, hook := range c.PreWriteHooks {
+		v.Check(slices.Contains(registered, hook), "pre-write-hooks", "lists %q, must be one of the registered hooks %v", hook, registered)
+	}
+	registered = coordinator.PostCommitHookNames()
+	for _, hook := range c.PostCommitHooks {
+		v.Check(slices.Contains(registered, hook), "post-commit-hooks", "lists %q, must be one of the registered hooks %v", hook, registered)
+	}
+	v.Check(c.OutboxPollInterval >= 0, "outbox-poll-interval", "is %s, must not be negative", c.OutboxPollInterval)
+	v.Check(len(c.PostCommitHooks) == 0 || c.OutboxPollInterval > 0, "outbox-poll-interval", "must be positive with --post-commit-hooks")
+	v.Check(c.TenantLastCompactionFlushInterval >= 0, "tenant-last-compaction-flush-interval", "is %s, must not be negative", c.TenantLastCompactionFlushInterval)
+	v.Check(c.TenantLastCompactionMaxPending >= 0, "tenant-last-compaction-max-pending", "is %d, must not be negative", c.TenantLastCompactionMaxPending)
+
+	validateObjectStore(v, c.ObjectStore)
+	return v.Err()
+}
+
+func validateNamePattern(v *utils.ConfigValidator, field string, pattern string) {
+	_, err := common.CompileNamePattern(pattern)
+	v.Check(err == nil, field, "is %q, must be a valid regular expression", pattern)
+}
+
+func validateObjectStore(v *utils.ConfigValidator, cfg objectstore.Config) {
+	switch cfg.Provider {
+	case "":
+	case objectstore.ProviderS3:
+		v.Check(cfg.S3.Bucket != "", "s3-bucket", "must be set with the s3 object store provider")
+	case objectstore.ProviderGCS:
+		v.Check(cfg.GCS.Bucket != "", "gcs-bucket", "must be set with the gcs object store provider")
+	case objectstore.ProviderAzure:
+		v.Check(cfg.Azure.Container != "", "azure-container", "must be set with the azure object store provider")
+		v.Check(cfg.Azure.ServiceURL != "" || cfg.Azure.ConnectionString != "", "azure-service-url", "must be set with the azure object store provider")
+	default:
+		v.OneOf("object-store-provider", cfg.Provider, objectstore.ProviderS3, objectstore.ProviderGCS, objectstore.ProviderAzure)
+	}
+}
@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/objectstore"
+	"github.com/chroma-core/chroma/go/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func validTestConfig() Config {
+	return Config{
+		GrpcConfig:            &grpcutils.GrpcConfig{BindAddress: "0.0.0.0:50051"},
+		SystemCatalogProvider: "database",
+		DBConfig: dbcore.DBConfig{
+			Username:     "chroma",
+			Address:      "postgres",
+			Port:         5432,
+			DBName:       "sysdb",
+			MaxOpenConns: 10,
+			SslMode:      "disable",
+		},
+		NotificationStoreProvider:       "memory",
+		NotifierProvider:                "memory",
+		KubernetesNamespace:             "chroma",
+		ReconcileInterval:               5 * time.Second,
+		WatchInterval:                   10 * time.Second,
+		QueryServiceMemberlistName:      "query-service-memberlist",
+		CompactionServiceMemberlistName: "compaction-service-memberlist",
+		MaintenanceWindowStartHour:      3,
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	config := validTestConfig()
+	assert.NoError(t, config.Validate())
+
+	// every invalid field is reported at once
+	config.DBConfig.Port = 0
+	config.SystemCatalogProvider = "database"
+	config.DBConfig.SslMode = "on"
+	config.MaintenanceWindowStartHour = 24
+	config.QuerySampleRate = 2
+	config.ObjectStore = objectstore.Config{Provider: objectstore.ProviderS3}
+	err := config.Validate()
+	var configErr *utils.ConfigError
+	if !assert.ErrorAs(t, err, &configErr) {
+		return
+	}
+	assert.Len(t, configErr.Problems, 5)
+	assert.Contains(t, err.Error(), "db-port: is 0, must be between 1 and 65535")
+	assert.Contains(t, err.Error(), `ssl-mode: is "on", must be one of disable, allow, prefer, require, verify-ca, verify-full`)
+	assert.Contains(t, err.Error(), "maintenance-window-start-hour")
+	assert.Contains(t, err.Error(), "query-sample-rate")
+	assert.Contains(t, err.Error(), "s3-bucket")
+
+	// the database is not checked with the memory catalog
+	config = validTestConfig()
+	config.SystemCatalogProvider = "memory"
+	config.DBConfig = dbcore.DBConfig{}
+	assert.NoError(t, config.Validate())
+
+	config.SystemCatalogProvider = "sqlite"
+	config.ObjectStore.Provider = "hdfs"
+	err = config.Validate()
+	assert.ErrorAs(t, err, &configErr)
+	assert.Len(t, configErr.Problems, 2)
+
+	config = validTestConfig()
+	config.ReservedNamePrefixes = []string{"_system", ""}
+	config.CollectionNamePattern = "[a-z"
+	err = config.Validate()
+	assert.ErrorAs(t, err, &configErr)
+	assert.Len(t, configErr.Problems, 2)
+	assert.Contains(t, err.Error(), "reserved-name-prefixes")
+	assert.Contains(t, err.Error(), `collection-name-pattern: is "[a-z"`)
+
+	config = validTestConfig()
+	config.PageTokenKeys = []string{"0123456789abcdef", "short"}
+	err = config.Validate()
+	assert.ErrorAs(t, err, &configErr)
+	assert.Len(t, configErr.Problems, 1)
+	assert.Contains(t, err.Error(), "page-token-keys: has a key of 5 bytes")
+
+	config = validTestConfig()
+	config.PreWriteHooks = []string{"not-registered"}
+	err = config.Validate()
+	assert.ErrorAs(t, err, &configErr)
+	assert.Contains(t, err.Error(), `pre-write-hooks: lists "not-registered"`)
+
+	config = validTestConfig()
+	config.PostCommitHooks = []string{"not-registered"}
+	err = config.Validate()
+	assert.ErrorAs(t, err, &configErr)
+	assert.Len(t, configErr.Problems, 2)
+	assert.Contains(t, err.Error(), `post-commit-hooks: lists "not-registered"`)
+	assert.Contains(t, err.Error(), "outbox-poll-interval: must be positive with --post-commit-hooks")
+}
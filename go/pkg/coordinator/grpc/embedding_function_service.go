@@ -0,0 +1,122 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+func (s *Server) CreateEmbeddingFunction(ctx context.Context, req *coordinatorpb.CreateEmbeddingFunctionRequest) (*coordinatorpb.CreateEmbeddingFunctionResponse, error) {
+	res := &coordinatorpb.CreateEmbeddingFunctionResponse{}
+	embeddingFunction, err := convertEmbeddingFunctionToModel(req.GetEmbeddingFunction())
+	if err != nil {
+		res.Status = failResponseWithError(err, 400)
+		return res, nil
+	}
+	created, err := s.coordinator.CreateEmbeddingFunction(ctx, embeddingFunction)
+	if err != nil {
+		log.Error("error creating embedding function", zap.String("tenant", embeddingFunction.TenantID), zap.String("name", embeddingFunction.Name), zap.Error(err))
+		if err == common.ErrInvalidEmbeddingFunction {
+			res.Status = failResponseWithError(err, 400)
+		} else if err == common.ErrTenantNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else if err == common.ErrEmbeddingFunctionUniqueConstraintViolation {
+			res.Status = failResponseWithError(err, 409)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.EmbeddingFunction = convertEmbeddingFunctionToProto(created)
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) GetEmbeddingFunctions(ctx context.Context, req *coordinatorpb.GetEmbeddingFunctionsRequest) (*coordinatorpb.GetEmbeddingFunctionsResponse, error) {
+	res := &coordinatorpb.GetEmbeddingFunctionsResponse{}
+	id := types.NilUniqueID()
+	if req.Id != nil {
+		parsed, err := convertEmbeddingFunctionIDToModel(req.Id)
+		if err != nil {
+			res.Status = failResponseWithError(err, 400)
+			return res, nil
+		}
+		id = *parsed
+	}
+	embeddingFunctions, err := s.coordinator.GetEmbeddingFunctions(ctx, id, req.GetTenant(), req.Name)
+	if err != nil {
+		log.Error("error getting embedding functions", zap.String("tenant", req.GetTenant()), zap.Error(err))
+		if err == common.ErrInvalidEmbeddingFunction {
+			res.Status = failResponseWithError(err, 400)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.EmbeddingFunctions = make([]*coordinatorpb.EmbeddingFunction, 0, len(embeddingFunctions))
+	for _, embeddingFunction := range embeddingFunctions {
+		res.EmbeddingFunctions = append(res.EmbeddingFunctions, convertEmbeddingFunctionToProto(embeddingFunction))
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) UpdateEmbeddingFunction(ctx context.Context, req *coordinatorpb.UpdateEmbeddingFunctionRequest) (*coordinatorpb.UpdateEmbeddingFunctionResponse, error) {
+	res := &coordinatorpb.UpdateEmbeddingFunctionResponse{}
+	id, err := types.Parse(req.GetId())
+	if err != nil {
+		res.Status = failResponseWithError(common.ErrEmbeddingFunctionIDFormat, 400)
+		return res, nil
+	}
+	updated, err := s.coordinator.UpdateEmbeddingFunction(ctx, &model.UpdateEmbeddingFunction{
+		ID:       id,
+		Name:     req.Name,
+		Provider: req.Provider,
+		Model:    req.Model,
+		Params:   req.Params,
+	})
+	if err != nil {
+		log.Error("error updating embedding function", zap.String("id", req.GetId()), zap.Error(err))
+		if err == common.ErrInvalidEmbeddingFunction {
+			res.Status = failResponseWithError(err, 400)
+		} else if err == common.ErrEmbeddingFunctionNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else if err == common.ErrEmbeddingFunctionUniqueConstraintViolation {
+			res.Status = failResponseWithError(err, 409)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.EmbeddingFunction = convertEmbeddingFunctionToProto(updated)
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) DeleteEmbeddingFunction(ctx context.Context, req *coordinatorpb.DeleteEmbeddingFunctionRequest) (*coordinatorpb.DeleteEmbeddingFunctionResponse, error) {
+	res := &coordinatorpb.DeleteEmbeddingFunctionResponse{}
+	id, err := types.Parse(req.GetId())
+	if err != nil {
+		res.Status = failResponseWithError(common.ErrEmbeddingFunctionIDFormat, 400)
+		return res, nil
+	}
+	err = s.coordinator.DeleteEmbeddingFunction(ctx, id)
+	if err != nil {
+		log.Error("error deleting embedding function", zap.String("id", req.GetId()), zap.Error(err))
+		if err == common.ErrEmbeddingFunctionNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else if err == common.ErrEmbeddingFunctionInUse {
+			res.Status = failResponseWithError(err, 409)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
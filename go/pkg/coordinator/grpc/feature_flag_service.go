@@ -0,0 +1,65 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+func (s *Server) SetFeatureFlag(ctx context.Context, req *coordinatorpb.SetFeatureFlagRequest) (*coordinatorpb.SetFeatureFlagResponse, error) {
+	res := &coordinatorpb.SetFeatureFlagResponse{}
+	if req.Flag == nil {
+		res.Status = failResponseWithError(common.ErrInvalidFeatureFlag, 400)
+		return res, nil
+	}
+	flag := convertFeatureFlagToModel(req.GetFlag())
+	err := s.coordinator.SetFeatureFlag(ctx, flag)
+	if err != nil {
+		log.Error("error setting feature flag", zap.String("name", flag.Name), zap.String("tenant", flag.TenantID), zap.Error(err))
+		if err == common.ErrInvalidFeatureFlag {
+			res.Status = failResponseWithError(err, 400)
+		} else if err == common.ErrTenantNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) DeleteFeatureFlag(ctx context.Context, req *coordinatorpb.DeleteFeatureFlagRequest) (*coordinatorpb.DeleteFeatureFlagResponse, error) {
+	res := &coordinatorpb.DeleteFeatureFlagResponse{}
+	err := s.coordinator.DeleteFeatureFlag(ctx, req.GetName(), req.GetTenant())
+	if err != nil {
+		log.Error("error deleting feature flag", zap.String("name", req.GetName()), zap.String("tenant", req.GetTenant()), zap.Error(err))
+		if err == common.ErrFeatureFlagNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) ListFeatureFlags(ctx context.Context, req *coordinatorpb.ListFeatureFlagsRequest) (*coordinatorpb.ListFeatureFlagsResponse, error) {
+	res := &coordinatorpb.ListFeatureFlagsResponse{}
+	flags, err := s.coordinator.ListFeatureFlags(ctx)
+	if err != nil {
+		log.Error("error listing feature flags", zap.Error(err))
+		res.Status = failResponseWithError(err, errorCode)
+		return res, nil
+	}
+	res.Flags = make([]*coordinatorpb.FeatureFlag, 0, len(flags))
+	for _, flag := range flags {
+		res.Flags = append(res.Flags, convertFeatureFlagToProto(flag))
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
@@ -0,0 +1,16 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetFeatureFlagWithoutFlag(t *testing.T) {
+	s := &Server{}
+	res, err := s.SetFeatureFlag(context.Background(), &coordinatorpb.SetFeatureFlagRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(400), res.Status.Code)
+}
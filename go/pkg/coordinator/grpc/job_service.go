@@ -0,0 +1,107 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// GetJobStatus returns a job of the job queue, e.g. the deletion of a tenant
+// queued by an async DeleteTenant.
+func (s *Server) GetJobStatus(ctx context.Context, req *coordinatorpb.GetJobStatusRequest) (*coordinatorpb.GetJobStatusResponse, error) {
+	res := &coordinatorpb.GetJobStatusResponse{}
+	jobID, err := types.Parse(req.GetId())
+	if err != nil {
+		res.Status = failResponseWithError(common.ErrJobIDFormat, 400)
+		return res, nil
+	}
+	job, err := s.coordinator.GetJob(ctx, jobID)
+	if err != nil {
+		log.Error("error getting job", zap.String("jobID", req.GetId()), zap.Error(err))
+		if err == common.ErrJobNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Job = convertJobToProto(job)
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+// ListJobs lists the jobs of the job queue, e.g. the failed jobs of a tenant.
+func (s *Server) ListJobs(ctx context.Context, req *coordinatorpb.ListJobsRequest) (*coordinatorpb.ListJobsResponse, error) {
+	res := &coordinatorpb.ListJobsResponse{}
+	offset := req.Offset
+	if req.PageToken != nil {
+		if offset != 0 {
+			res.Status = failResponseWithError(common.ErrPageTokenWithStart, 400)
+			return res, nil
+		}
+		position, err := s.pageTokens.verify(coordinatorpb.SysDB_ListJobs_FullMethodName, pageQuery(req, listJobsPageFields...), *req.PageToken)
+		if err != nil {
+			log.Error("invalid page token", zap.Error(err))
+			res.Status = failResponseWithError(err, 400)
+			return res, nil
+		}
+		offset = position.Offset
+	}
+	jobs, err := s.coordinator.ListJobs(ctx, &model.JobFilter{
+		TenantID: req.GetTenant(),
+		Type:     req.GetType(),
+		State:    model.JobState(req.GetState()),
+		Limit:    req.Limit,
+		Offset:   offset,
+	})
+	if err != nil {
+		log.Error("error listing jobs", zap.String("tenant", req.GetTenant()), zap.String("type", req.GetType()), zap.String("state", req.GetState()), zap.Error(err))
+		if err == common.ErrInvalidJobState || err == common.ErrInvalidJobPage {
+			res.Status = failResponseWithError(err, 400)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Jobs = make([]*coordinatorpb.Job, 0, len(jobs))
+	for _, job := range jobs {
+		res.Jobs = append(res.Jobs, convertJobToProto(job))
+	}
+	if next, ok := nextPageOffset(&req.Limit, &offset, len(jobs)); ok {
+		res.NextPageToken = s.pageTokens.issue(coordinatorpb.SysDB_ListJobs_FullMethodName, pageQuery(req, listJobsPageFields...), pagePosition{Offset: next})
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+// CancelJob cancels a pending or running job and returns it canceled. A job
+// that is already finished is a conflict.
+func (s *Server) CancelJob(ctx context.Context, req *coordinatorpb.CancelJobRequest) (*coordinatorpb.CancelJobResponse, error) {
+	res := &coordinatorpb.CancelJobResponse{}
+	jobID, err := types.Parse(req.GetId())
+	if err != nil {
+		res.Status = failResponseWithError(common.ErrJobIDFormat, 400)
+		return res, nil
+	}
+	job, err := s.coordinator.CancelJob(ctx, jobID)
+	if err != nil {
+		log.Error("error canceling job", zap.String("jobID", req.GetId()), zap.Error(err))
+		switch err {
+		case common.ErrJobNotFound:
+			res.Status = failResponseWithError(err, 404)
+		case common.ErrJobFinished:
+			res.Status = failResponseWithError(err, 409)
+		default:
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Job = convertJobToProto(job)
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
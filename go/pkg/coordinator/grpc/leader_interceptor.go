@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"google.golang.org/grpc"
+)
+
+const sysdbServicePrefix = "/chroma.SysDB/"
+
+// readMethods are the SysDB RPCs that any replica serves. Every other SysDB
+// RPC is only served by the leader.
+var readMethods = map[string]bool{
+	coordinatorpb.SysDB_GetDatabase_FullMethodName:                    true,
+	coordinatorpb.SysDB_GetTenant_FullMethodName:                      true,
+	coordinatorpb.SysDB_GetSegments_FullMethodName:                    true,
+	coordinatorpb.SysDB_GetCollections_FullMethodName:                 true,
+	coordinatorpb.SysDB_GetLastCompactionTimeForTenant_FullMethodName: true,
+	coordinatorpb.SysDB_GetCollectionsBySize_FullMethodName:           true,
+	coordinatorpb.SysDB_GetApproximateCounts_FullMethodName:           true,
+	coordinatorpb.SysDB_CountCollections_FullMethodName:               true,
+	coordinatorpb.SysDB_ScanCollections_FullMethodName:                true,
+	coordinatorpb.SysDB_ListIncompleteCollections_FullMethodName:      true,
+	coordinatorpb.SysDB_ListDuplicateSegmentFiles_FullMethodName:      true,
+	coordinatorpb.SysDB_GetCollectionSegmentFiles_FullMethodName:      true,
+	coordinatorpb.SysDB_GetStorageAttribution_FullMethodName:          true,
+	coordinatorpb.SysDB_ListFeatureFlags_FullMethodName:               true,
+	coordinatorpb.SysDB_GetCollectionsToGc_FullMethodName:             true,
+	coordinatorpb.SysDB_GetCollectionAcl_FullMethodName:               true,
+	coordinatorpb.SysDB_GetTenantQuota_FullMethodName:                 true,
+	coordinatorpb.SysDB_CheckQuota_FullMethodName:                     true,
+	coordinatorpb.SysDB_GetEffectiveQuota_FullMethodName:              true,
+	coordinatorpb.SysDB_ResolveCollectionLogRoutes_FullMethodName:     true,
+	coordinatorpb.SysDB_CheckCollections_FullMethodName:               true,
+	coordinatorpb.SysDB_GetCompactionRequests_FullMethodName:          true,
+	coordinatorpb.SysDB_GetTenantPlacement_FullMethodName:             true,
+	coordinatorpb.SysDB_CheckTenantPlacement_FullMethodName:           true,
+	coordinatorpb.SysDB_GetCollectionPlacement_FullMethodName:         true,
+	coordinatorpb.SysDB_GetNodeLiveness_FullMethodName:                true,
+	coordinatorpb.SysDB_SimulateCollectionPlacement_FullMethodName:    true,
+	coordinatorpb.SysDB_ListCollectionStats_FullMethodName:            true,
+	coordinatorpb.SysDB_GetCollectionSizeHistory_FullMethodName:       true,
+	coordinatorpb.SysDB_GetTenantActivity_FullMethodName:              true,
+	coordinatorpb.SysDB_ListCleanupDeadLetters_FullMethodName:         true,
+	coordinatorpb.SysDB_GetCollectionMetadataDiff_FullMethodName:      true,
+	coordinatorpb.SysDB_GetEmbeddingFunctions_FullMethodName:          true,
+	coordinatorpb.SysDB_GetCollectionSchema_FullMethodName:            true,
+	coordinatorpb.SysDB_ValidateCollectionMetadata_FullMethodName:     true,
+	coordinatorpb.SysDB_GetCollectionTemplates_FullMethodName:         true,
+	coordinatorpb.SysDB_GetMaintenanceMode_FullMethodName:             true,
+	coordinatorpb.SysDB_GetJobStatus_FullMethodName:                   true,
+	coordinatorpb.SysDB_ListJobs_FullMethodName:                       true,
+	coordinatorpb.SysDB_GetQuerySamples_FullMethodName:                true,
+}
+
+// leaderReadMethods are the SysDB RPCs that do not write but are only served by
+// the leader, since only the leader runs the log offset reconciler and the soft
+// delete cleaner. Every SysDB RPC in neither set is a write.
+var leaderReadMethods = map[string]bool{
+	coordinatorpb.SysDB_ListInconsistentCollections_FullMethodName:  true,
+	coordinatorpb.SysDB_CheckConsistencyWithProgress_FullMethodName: true,
+	coordinatorpb.SysDB_GetSoftDeleteCleanerStatus_FullMethodName:   true,
+}
+
+// isWriteMethod reports whether method is a SysDB RPC that writes.
+func isWriteMethod(method string) bool {
+	return strings.HasPrefix(method, sysdbServicePrefix) && !readMethods[method] && !leaderReadMethods[method]
+}
+
+// leaderInterceptor rejects writes and leader reads on a standby with a NOT_LEADER error that
+// carries the address of the leader, so that clients can redirect. A read-only
+// replica rejects them with READ_ONLY_REPLICA instead, which clients must not
+// retry against it.
+func (s *Server) leaderInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := s.checkLeader(info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (s *Server) leaderStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := s.checkLeader(info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+func (s *Server) checkLeader(method string) error {
+	if strings.HasPrefix(method, sysdbServicePrefix) && !readMethods[method] {
+		if s.readOnly {
+			return grpcutils.BuildReadOnlyReplicaGrpcError(s.coordinator.LeaderAddress())
+		}
+		if !s.coordinator.IsLeader() {
+			return grpcutils.BuildNotLeaderGrpcError(s.coordinator.LeaderAddress())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/coordinator"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type standbyCoordinator struct {
+	coordinator.ICoordinator
+}
+
+func (standbyCoordinator) IsLeader() bool        { return false }
+func (standbyCoordinator) LeaderAddress() string { return "sysdb-0:50051" }
+
+func TestLeaderInterceptor(t *testing.T) {
+	s := &Server{coordinator: standbyCoordinator{}}
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+
+	// reads and other services are served by a standby
+	for _, method := range []string{coordinatorpb.SysDB_GetCollections_FullMethodName, coordinatorpb.SysDB_GetQuerySamples_FullMethodName, "/grpc.health.v1.Health/Check"} {
+		res, err := s.leaderInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", res)
+	}
+
+	_, err := s.leaderInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: coordinatorpb.SysDB_CreateCollection_FullMethodName}, handler)
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.Unavailable, st.Code())
+	assert.Len(t, st.Details(), 1)
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	assert.True(t, ok)
+	assert.Equal(t, "NOT_LEADER", info.Reason)
+	assert.Equal(t, "sysdb-0:50051", info.Metadata["leader"])
+}
+
+func TestLeaderInterceptor_ReadOnly(t *testing.T) {
+	s := &Server{coordinator: standbyCoordinator{}, readOnly: true}
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+
+	res, err := s.leaderInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: coordinatorpb.SysDB_GetCollections_FullMethodName}, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", res)
+
+	_, err = s.leaderInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: coordinatorpb.SysDB_CreateCollection_FullMethodName}, handler)
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	assert.Len(t, st.Details(), 1)
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	assert.True(t, ok)
+	assert.Equal(t, "READ_ONLY_REPLICA", info.Reason)
+	assert.Equal(t, "sysdb-0:50051", info.Metadata["primary"])
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context { return s.ctx }
+
+func TestLeaderStreamInterceptor(t *testing.T) {
+	s := &Server{coordinator: standbyCoordinator{}}
+	handler := func(srv any, ss grpc.ServerStream) error { return nil }
+	ss := &testServerStream{ctx: context.Background()}
+
+	err := s.leaderStreamInterceptor(nil, ss, &grpc.StreamServerInfo{FullMethod: coordinatorpb.SysDB_ScanCollections_FullMethodName}, handler)
+	assert.NoError(t, err)
+
+	// the consistency check needs the findings of the log offset reconciler,
+	// which only runs on the leader
+	err = s.leaderStreamInterceptor(nil, ss, &grpc.StreamServerInfo{FullMethod: coordinatorpb.SysDB_CheckConsistencyWithProgress_FullMethodName}, handler)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	// a streaming write is rejected by a standby like a unary one
+	err = s.leaderStreamInterceptor(nil, ss, &grpc.StreamServerInfo{FullMethod: coordinatorpb.SysDB_DeleteTenantWithProgress_FullMethodName}, handler)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
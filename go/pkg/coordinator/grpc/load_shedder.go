@@ -0,0 +1,102 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// loadSampleInterval is how often the pool wait time is sampled.
+const loadSampleInterval = time.Second
+
+// sheddableMethods are the mutations that are rejected first when the write
+// database is saturated. Flushes, deletes and creates are always let through.
+var sheddableMethods = map[string]bool{
+	coordinatorpb.SysDB_UpdateCollection_FullMethodName:               true,
+	coordinatorpb.SysDB_RenameCollection_FullMethodName:               true,
+	coordinatorpb.SysDB_BatchUpdateCollectionMetadata_FullMethodName:  true,
+	coordinatorpb.SysDB_UpdateSegment_FullMethodName:                  true,
+	coordinatorpb.SysDB_SetLastCompactionTimeForTenant_FullMethodName: true,
+}
+
+// loadShedder rejects sheddable mutations with a retryable error while the
+// number of open write transactions or the average wait for a connection of
+// the write pool is above its threshold.
+type loadShedder struct {
+	maxInFlightWriteTx int64
+	maxPoolWait        time.Duration
+	load               func() dbcore.WriteLoad
+	now                func() time.Time
+	rejected           metric.Int64Counter
+
+	mu          sync.Mutex
+	lastSample  time.Time
+	lastLoad    dbcore.WriteLoad
+	averageWait time.Duration
+}
+
+// newLoadShedder returns nil when no threshold is set.
+func newLoadShedder(maxInFlightWriteTx int64, maxPoolWait time.Duration) (*loadShedder, error) {
+	if maxInFlightWriteTx <= 0 && maxPoolWait <= 0 {
+		return nil, nil
+	}
+	rejected, err := otel.Meter("chroma.coordinator").Int64Counter("sysdb.load_shed.rejected",
+		metric.WithDescription("Number of mutations rejected because the write database was saturated"))
+	if err != nil {
+		return nil, err
+	}
+	return &loadShedder{
+		maxInFlightWriteTx: maxInFlightWriteTx,
+		maxPoolWait:        maxPoolWait,
+		load:               dbcore.GetWriteLoad,
+		now:                time.Now,
+		rejected:           rejected,
+	}, nil
+}
+
+// poolWait returns the average time a connection acquisition waited during
+// the last sample interval.
+func (l *loadShedder) poolWait() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	if now.Sub(l.lastSample) < loadSampleInterval {
+		return l.averageWait
+	}
+	load := l.load()
+	waits := load.WaitCount - l.lastLoad.WaitCount
+	if waits > 0 && !l.lastSample.IsZero() {
+		l.averageWait = (load.WaitDuration - l.lastLoad.WaitDuration) / time.Duration(waits)
+	} else {
+		l.averageWait = 0
+	}
+	l.lastSample = now
+	l.lastLoad = load
+	return l.averageWait
+}
+
+func (l *loadShedder) overloaded() bool {
+	if l.maxInFlightWriteTx > 0 && l.load().InFlightWriteTx >= l.maxInFlightWriteTx {
+		return true
+	}
+	return l.maxPoolWait > 0 && l.poolWait() >= l.maxPoolWait
+}
+
+func (l *loadShedder) interceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if sheddableMethods[info.FullMethod] && l.overloaded() {
+		log.Warn("shedding mutation, write database is saturated", zap.String("method", info.FullMethod))
+		l.rejected.Add(ctx, 1, metric.WithAttributes(attribute.String("rpc.method", info.FullMethod)))
+		return nil, grpcutils.BuildOverloadedGrpcError(loadSampleInterval)
+	}
+	return handler(ctx, req)
+}
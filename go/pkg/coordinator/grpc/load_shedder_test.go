@@ -0,0 +1,51 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLoadShedder(t *testing.T) {
+	shedder, err := newLoadShedder(0, 0)
+	assert.NoError(t, err)
+	assert.Nil(t, shedder)
+
+	shedder, err = newLoadShedder(8, 50*time.Millisecond)
+	assert.NoError(t, err)
+	now := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+	shedder.now = func() time.Time { return now }
+	load := dbcore.WriteLoad{InFlightWriteTx: 2, WaitCount: 100, WaitDuration: time.Second}
+	shedder.load = func() dbcore.WriteLoad { return load }
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+	call := func(method string) error {
+		_, err := shedder.interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	assert.NoError(t, call(coordinatorpb.SysDB_UpdateCollection_FullMethodName))
+
+	// ten waits of 100ms each during the last interval
+	now = now.Add(time.Second)
+	load = dbcore.WriteLoad{InFlightWriteTx: 2, WaitCount: 110, WaitDuration: 2 * time.Second}
+	err = call(coordinatorpb.SysDB_UpdateCollection_FullMethodName)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.NoError(t, call(coordinatorpb.SysDB_FlushCollectionCompaction_FullMethodName))
+
+	// no waits, but too many open write transactions
+	now = now.Add(time.Second)
+	load = dbcore.WriteLoad{InFlightWriteTx: 8, WaitCount: 110, WaitDuration: 2 * time.Second}
+	err = call(coordinatorpb.SysDB_SetLastCompactionTimeForTenant_FullMethodName)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.NoError(t, call(coordinatorpb.SysDB_DeleteCollection_FullMethodName))
+
+	load.InFlightWriteTx = 1
+	assert.NoError(t, call(coordinatorpb.SysDB_UpdateSegment_FullMethodName))
+}
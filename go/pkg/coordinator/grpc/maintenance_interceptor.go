@@ -0,0 +1,40 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"google.golang.org/grpc"
+)
+
+// maintenanceRetryDelay is the delay suggested to the writes rejected during
+// maintenance.
+const maintenanceRetryDelay = 5 * time.Second
+
+// maintenanceInterceptor rejects the writes with a retryable MAINTENANCE error
+// while the sysdb is in maintenance. SetMaintenanceMode is let through, so that
+// maintenance can be ended.
+func (s *Server) maintenanceInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := s.checkMaintenance(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (s *Server) maintenanceStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := s.checkMaintenance(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+func (s *Server) checkMaintenance(ctx context.Context, method string) error {
+	if isWriteMethod(method) && method != coordinatorpb.SysDB_SetMaintenanceMode_FullMethodName {
+		if inMaintenance, reason := s.coordinator.InMaintenance(ctx); inMaintenance {
+			return grpcutils.BuildMaintenanceGrpcError(reason, maintenanceRetryDelay)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/coordinator"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type maintenanceCoordinator struct {
+	coordinator.ICoordinator
+}
+
+func (maintenanceCoordinator) InMaintenance(ctx context.Context) (bool, string) {
+	return true, "region failover"
+}
+
+func TestMaintenanceInterceptor(t *testing.T) {
+	s := &Server{coordinator: maintenanceCoordinator{}}
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+
+	// reads, leader reads, other services and the end of maintenance are served
+	for _, method := range []string{
+		coordinatorpb.SysDB_GetCollections_FullMethodName,
+		coordinatorpb.SysDB_ListInconsistentCollections_FullMethodName,
+		coordinatorpb.SysDB_GetSoftDeleteCleanerStatus_FullMethodName,
+		"/grpc.health.v1.Health/Check",
+		coordinatorpb.SysDB_SetMaintenanceMode_FullMethodName,
+	} {
+		res, err := s.maintenanceInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", res)
+	}
+
+	_, err := s.maintenanceInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: coordinatorpb.SysDB_CreateCollection_FullMethodName}, handler)
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.Unavailable, st.Code())
+	assert.Len(t, st.Details(), 2)
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	assert.True(t, ok)
+	assert.Equal(t, "MAINTENANCE", info.Reason)
+	assert.Equal(t, "region failover", info.Metadata["reason"])
+	retry, ok := st.Details()[1].(*errdetails.RetryInfo)
+	assert.True(t, ok)
+	assert.Equal(t, maintenanceRetryDelay, retry.RetryDelay.AsDuration())
+}
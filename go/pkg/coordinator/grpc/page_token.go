@@ -0,0 +1,178 @@
+package grpc
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"strings"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// pageTokenVersion is the first byte of the payload of a token. Tokens of
+// another version are rejected, so that the encoding can change without an old
+// token being misread.
+const pageTokenVersion = 1
+
+// minPageTokenKeySize is the size of the smallest key accepted to sign tokens.
+const minPageTokenKeySize = 16
+
+// pageTokenQueryHashSize is how much of the hash of the query a token keeps.
+const pageTokenQueryHashSize = 16
+
+// pagePosition is where the next page of a list RPC starts: at Offset for the
+// RPCs paged by offset, after the key After for the others.
+type pagePosition struct {
+	Offset int32
+	After  string
+}
+
+// pageTokens issues and verifies the next_page_token of the list RPCs. A token
+// is bound to the RPC and to the filters of the request it was issued for, and
+// is signed with the first key. Every key verifies tokens, so that keys can be
+// rotated without failing the scans in progress.
+type pageTokens struct {
+	keys [][]byte
+}
+
+// newPageTokens signs with a key that only lives in this process when keys is
+// empty, the tokens are then only valid on this replica until it restarts.
+func newPageTokens(keys []string) (*pageTokens, error) {
+	p := &pageTokens{}
+	for _, key := range keys {
+		p.keys = append(p.keys, []byte(key))
+	}
+	if len(p.keys) == 0 {
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+		p.keys = append(p.keys, key)
+	}
+	return p, nil
+}
+
+func (p *pageTokens) issue(method string, query proto.Message, position pagePosition) string {
+	payload := make([]byte, 0, 1+pageTokenQueryHashSize+4+len(position.After))
+	payload = append(payload, pageTokenVersion)
+	payload = append(payload, pageQueryHash(method, query)...)
+	payload = binary.BigEndian.AppendUint32(payload, uint32(position.Offset))
+	payload = append(payload, position.After...)
+	return base64.RawURLEncoding.EncodeToString(append(payload, p.sign(p.keys[0], payload)...))
+}
+
+// verify returns common.ErrInvalidPageToken when the token was altered, or was
+// issued for another RPC or other filters.
+func (p *pageTokens) verify(method string, query proto.Message, token string) (pagePosition, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(decoded) < 1+pageTokenQueryHashSize+4+sha256.Size {
+		return pagePosition{}, common.ErrInvalidPageToken
+	}
+	payload, signature := decoded[:len(decoded)-sha256.Size], decoded[len(decoded)-sha256.Size:]
+	signed := false
+	for _, key := range p.keys {
+		if hmac.Equal(signature, p.sign(key, payload)) {
+			signed = true
+			break
+		}
+	}
+	if !signed || payload[0] != pageTokenVersion {
+		return pagePosition{}, common.ErrInvalidPageToken
+	}
+	if !hmac.Equal(payload[1:1+pageTokenQueryHashSize], pageQueryHash(method, query)) {
+		return pagePosition{}, common.ErrInvalidPageToken
+	}
+	offset := int32(binary.BigEndian.Uint32(payload[1+pageTokenQueryHashSize:]))
+	if offset < 0 {
+		return pagePosition{}, common.ErrInvalidPageToken
+	}
+	return pagePosition{
+		Offset: offset,
+		After:  string(payload[1+pageTokenQueryHashSize+4:]),
+	}, nil
+}
+
+func (p *pageTokens) sign(key []byte, payload []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// pageQueryHash hashes the RPC and its filters. query is marshaled
+// deterministically, so equal requests hash the same.
+func pageQueryHash(method string, query proto.Message) []byte {
+	marshaled, _ := proto.MarshalOptions{Deterministic: true}.Marshal(query)
+	hash := sha256.New()
+	hash.Write([]byte(method))
+	hash.Write([]byte{0})
+	hash.Write(marshaled)
+	return hash.Sum(nil)[:pageTokenQueryHashSize]
+}
+
+// pageQuery returns a copy of req without the fields that do not select what
+// is listed, the paging fields and the ones that only shape the response, so
+// that a token stays valid when they change from page to page.
+func pageQuery(req proto.Message, fields ...protoreflect.Name) proto.Message {
+	query := proto.Clone(req)
+	descriptor := query.ProtoReflect().Descriptor()
+	for _, name := range fields {
+		query.ProtoReflect().Clear(descriptor.Fields().ByName(name))
+	}
+	return query
+}
+
+// The fields of the list requests left out of the query their tokens are bound
+// to.
+var (
+	getCollectionsPageFields      = []protoreflect.Name{"limit", "offset", "page_token", "include_log_lag", "field_mask", "if_none_match", "min_version"}
+	getSegmentsPageFields         = []protoreflect.Name{"limit", "offset", "page_token"}
+	getCollectionsToGcPageFields  = []protoreflect.Name{"limit", "start_after", "page_token"}
+	listCollectionStatsPageFields = []protoreflect.Name{"limit", "offset", "page_token"}
+	listJobsPageFields            = []protoreflect.Name{"limit", "offset", "page_token"}
+	scanCollectionsPageFields     = []protoreflect.Name{"batch_size", "resume_token"}
+)
+
+// nextPageOffset returns the offset of the page after a page of n results, or
+// false when the page is not full, so that it is the last one.
+func nextPageOffset(limit *int32, offset *int32, n int) (int32, bool) {
+	if limit == nil || *limit <= 0 || n < int(*limit) {
+		return 0, false
+	}
+	next := int32(n)
+	if offset != nil {
+		next += *offset
+	}
+	return next, true
+}
+
+// scanCursorPosition keeps the snapshot of a scan of ScanCollections in the
+// position of its resume token with the last collection the scan read.
+func scanCursorPosition(cursor *model.CollectionScanCursor) pagePosition {
+	position := cursor.Snapshot + "/"
+	if cursor.After != nil {
+		position += cursor.After.String()
+	}
+	return pagePosition{After: position}
+}
+
+func scanCursorFromPosition(position pagePosition) (*model.CollectionScanCursor, error) {
+	snapshot, after, ok := strings.Cut(position.After, "/")
+	if !ok || snapshot == "" {
+		return nil, common.ErrInvalidPageToken
+	}
+	cursor := &model.CollectionScanCursor{Snapshot: snapshot}
+	if after != "" {
+		id, err := types.Parse(after)
+		if err != nil {
+			return nil, common.ErrInvalidPageToken
+		}
+		cursor.After = &id
+	}
+	return cursor, nil
+}
@@ -0,0 +1,92 @@
+package grpc
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPageTokens(t *testing.T) {
+	tokens, err := newPageTokens([]string{"0123456789abcdef"})
+	assert.NoError(t, err)
+	method := coordinatorpb.SysDB_GetCollections_FullMethodName
+	limit, offset := int32(10), int32(20)
+	req := &coordinatorpb.GetCollectionsRequest{Tenant: "tenant", Database: "database", Limit: &limit, Offset: &offset}
+	token := tokens.issue(method, pageQuery(req, getCollectionsPageFields...), pagePosition{Offset: 30})
+
+	// the next page may change the paging fields
+	next := &coordinatorpb.GetCollectionsRequest{Tenant: "tenant", Database: "database", Limit: proto.Int32(50), PageToken: &token, IncludeLogLag: true}
+	position, err := tokens.verify(method, pageQuery(next, getCollectionsPageFields...), token)
+	assert.NoError(t, err)
+	assert.Equal(t, pagePosition{Offset: 30}, position)
+
+	// but not the filters, nor the RPC
+	other := &coordinatorpb.GetCollectionsRequest{Tenant: "tenant", Database: "other", PageToken: &token}
+	_, err = tokens.verify(method, pageQuery(other, getCollectionsPageFields...), token)
+	assert.ErrorIs(t, err, common.ErrInvalidPageToken)
+	_, err = tokens.verify(coordinatorpb.SysDB_GetSegments_FullMethodName, pageQuery(next, getCollectionsPageFields...), token)
+	assert.ErrorIs(t, err, common.ErrInvalidPageToken)
+
+	// an altered token is rejected
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	assert.NoError(t, err)
+	decoded[1+pageTokenQueryHashSize+3]++
+	_, err = tokens.verify(method, pageQuery(next, getCollectionsPageFields...), base64.RawURLEncoding.EncodeToString(decoded))
+	assert.ErrorIs(t, err, common.ErrInvalidPageToken)
+	_, err = tokens.verify(method, pageQuery(next, getCollectionsPageFields...), "not a token")
+	assert.ErrorIs(t, err, common.ErrInvalidPageToken)
+}
+
+func TestPageTokens_KeyRotation(t *testing.T) {
+	old, err := newPageTokens([]string{"0123456789abcdef"})
+	assert.NoError(t, err)
+	req := &coordinatorpb.GetCollectionsToGcRequest{TenantId: proto.String("tenant")}
+	method := coordinatorpb.SysDB_GetCollectionsToGc_FullMethodName
+	token := old.issue(method, pageQuery(req, getCollectionsToGcPageFields...), pagePosition{After: "collection"})
+
+	rotated, err := newPageTokens([]string{"fedcba9876543210", "0123456789abcdef"})
+	assert.NoError(t, err)
+	position, err := rotated.verify(method, pageQuery(req, getCollectionsToGcPageFields...), token)
+	assert.NoError(t, err)
+	assert.Equal(t, "collection", position.After)
+
+	// a replica with a key of its own does not accept the tokens of the others
+	local, err := newPageTokens(nil)
+	assert.NoError(t, err)
+	_, err = local.verify(method, pageQuery(req, getCollectionsToGcPageFields...), token)
+	assert.ErrorIs(t, err, common.ErrInvalidPageToken)
+}
+
+func TestNextPageOffset(t *testing.T) {
+	limit, offset := int32(10), int32(20)
+	next, ok := nextPageOffset(&limit, &offset, 10)
+	assert.True(t, ok)
+	assert.Equal(t, int32(30), next)
+	next, ok = nextPageOffset(&limit, nil, 10)
+	assert.True(t, ok)
+	assert.Equal(t, int32(10), next)
+	_, ok = nextPageOffset(&limit, &offset, 9)
+	assert.False(t, ok)
+	_, ok = nextPageOffset(nil, &offset, 10)
+	assert.False(t, ok)
+}
+
+func TestScanCursorPosition(t *testing.T) {
+	after := types.NewUniqueID()
+	cursor := &model.CollectionScanCursor{Snapshot: "00000003-0000001B-1", After: &after}
+	decoded, err := scanCursorFromPosition(scanCursorPosition(cursor))
+	assert.NoError(t, err)
+	assert.Equal(t, cursor.Snapshot, decoded.Snapshot)
+	assert.Equal(t, after, *decoded.After)
+
+	for _, position := range []string{"", "00000003-0000001B-1", "/", "00000003-0000001B-1/not-a-uuid"} {
+		_, err = scanCursorFromPosition(pagePosition{After: position})
+		assert.ErrorIs(t, err, common.ErrInvalidPageToken)
+	}
+}
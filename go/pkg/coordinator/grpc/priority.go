@@ -0,0 +1,177 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// priorityMetadataKey is the request metadata a client sets to lower the
+// priority of its requests, e.g. a bulk job sending reads as "admin".
+const priorityMetadataKey = "chroma-priority"
+
+type priorityClass int
+
+const (
+	priorityQuery priorityClass = iota
+	priorityCompaction
+	priorityAdmin
+)
+
+var priorityClassNames = map[string]priorityClass{
+	"query":      priorityQuery,
+	"compaction": priorityCompaction,
+	"admin":      priorityAdmin,
+}
+
+// methodPriorities are the default classes of the RPCs that are not on the
+// query path. Every other RPC is a query.
+var methodPriorities = map[string]priorityClass{
+	coordinatorpb.SysDB_FlushCollectionCompaction_FullMethodName:      priorityCompaction,
+	coordinatorpb.SysDB_AcquireCollectionFencingToken_FullMethodName:  priorityCompaction,
+	coordinatorpb.SysDB_GetLastCompactionTimeForTenant_FullMethodName: priorityCompaction,
+	coordinatorpb.SysDB_SetLastCompactionTimeForTenant_FullMethodName: priorityCompaction,
+	coordinatorpb.SysDB_GetCompactionRequests_FullMethodName:          priorityCompaction,
+	coordinatorpb.SysDB_GetCollectionsBySize_FullMethodName:           priorityAdmin,
+	coordinatorpb.SysDB_GetApproximateCounts_FullMethodName:           priorityAdmin,
+	coordinatorpb.SysDB_CountCollections_FullMethodName:               priorityAdmin,
+	coordinatorpb.SysDB_ScanCollections_FullMethodName:                priorityAdmin,
+	coordinatorpb.SysDB_DeleteTenantWithProgress_FullMethodName:       priorityAdmin,
+	coordinatorpb.SysDB_CheckConsistencyWithProgress_FullMethodName:   priorityAdmin,
+	coordinatorpb.SysDB_ListIncompleteCollections_FullMethodName:      priorityAdmin,
+	coordinatorpb.SysDB_ListInconsistentCollections_FullMethodName:    priorityAdmin,
+	coordinatorpb.SysDB_ListDuplicateSegmentFiles_FullMethodName:      priorityAdmin,
+	coordinatorpb.SysDB_GetCollectionSegmentFiles_FullMethodName:      priorityAdmin,
+	coordinatorpb.SysDB_GetStorageAttribution_FullMethodName:          priorityAdmin,
+	coordinatorpb.SysDB_SetFeatureFlag_FullMethodName:                 priorityAdmin,
+	coordinatorpb.SysDB_DeleteFeatureFlag_FullMethodName:              priorityAdmin,
+	coordinatorpb.SysDB_ListFeatureFlags_FullMethodName:               priorityAdmin,
+	coordinatorpb.SysDB_SetTenantPlacement_FullMethodName:             priorityAdmin,
+	coordinatorpb.SysDB_GetTenantPlacement_FullMethodName:             priorityAdmin,
+	coordinatorpb.SysDB_DecommissionNode_FullMethodName:               priorityAdmin,
+	coordinatorpb.SysDB_GetNodeLiveness_FullMethodName:                priorityAdmin,
+	coordinatorpb.SysDB_SimulateCollectionPlacement_FullMethodName:    priorityAdmin,
+	coordinatorpb.SysDB_ListCollectionStats_FullMethodName:            priorityAdmin,
+	coordinatorpb.SysDB_GetCollectionSizeHistory_FullMethodName:       priorityAdmin,
+	coordinatorpb.SysDB_GetTenantActivity_FullMethodName:              priorityAdmin,
+	coordinatorpb.SysDB_GetSoftDeleteCleanerStatus_FullMethodName:     priorityAdmin,
+	coordinatorpb.SysDB_RunSoftDeleteCleaner_FullMethodName:           priorityAdmin,
+	coordinatorpb.SysDB_ListCleanupDeadLetters_FullMethodName:         priorityAdmin,
+	coordinatorpb.SysDB_DeleteCleanupDeadLetter_FullMethodName:        priorityAdmin,
+	coordinatorpb.SysDB_BatchCreateTenants_FullMethodName:             priorityAdmin,
+	coordinatorpb.SysDB_GetCollectionMetadataDiff_FullMethodName:      priorityAdmin,
+	coordinatorpb.SysDB_CreateEmbeddingFunction_FullMethodName:        priorityAdmin,
+	coordinatorpb.SysDB_GetEmbeddingFunctions_FullMethodName:          priorityAdmin,
+	coordinatorpb.SysDB_UpdateEmbeddingFunction_FullMethodName:        priorityAdmin,
+	coordinatorpb.SysDB_DeleteEmbeddingFunction_FullMethodName:        priorityAdmin,
+	coordinatorpb.SysDB_SetCollectionSchema_FullMethodName:            priorityAdmin,
+	coordinatorpb.SysDB_CreateCollectionTemplate_FullMethodName:       priorityAdmin,
+	coordinatorpb.SysDB_GetCollectionTemplates_FullMethodName:         priorityAdmin,
+	coordinatorpb.SysDB_DeleteCollectionTemplate_FullMethodName:       priorityAdmin,
+	coordinatorpb.SysDB_SetMaintenanceMode_FullMethodName:             priorityAdmin,
+	coordinatorpb.SysDB_GetMaintenanceMode_FullMethodName:             priorityAdmin,
+	coordinatorpb.SysDB_RepairIncompleteCollection_FullMethodName:     priorityAdmin,
+	coordinatorpb.SysDB_GetQuerySamples_FullMethodName:                priorityAdmin,
+	coordinatorpb.SysDB_GetCollectionsToGc_FullMethodName:             priorityAdmin,
+	coordinatorpb.SysDB_BatchUpdateCollectionMetadata_FullMethodName:  priorityAdmin,
+	coordinatorpb.SysDB_DeleteCollections_FullMethodName:              priorityAdmin,
+	coordinatorpb.SysDB_RestoreCollection_FullMethodName:              priorityAdmin,
+	coordinatorpb.SysDB_SetTenantQuota_FullMethodName:                 priorityAdmin,
+	coordinatorpb.SysDB_GetJobStatus_FullMethodName:                   priorityAdmin,
+	coordinatorpb.SysDB_ListJobs_FullMethodName:                       priorityAdmin,
+	coordinatorpb.SysDB_CancelJob_FullMethodName:                      priorityAdmin,
+	coordinatorpb.SysDB_GetTenantQuota_FullMethodName:                 priorityAdmin,
+	coordinatorpb.SysDB_SetDatabaseQuota_FullMethodName:               priorityAdmin,
+	coordinatorpb.SysDB_GetEffectiveQuota_FullMethodName:              priorityAdmin,
+	coordinatorpb.SysDB_SetCollectionLogRoute_FullMethodName:          priorityAdmin,
+	coordinatorpb.SysDB_CheckCollections_FullMethodName:               priorityAdmin,
+}
+
+// requestPriority returns the class of a request. The priority metadata can
+// only lower the default class of the method, so that a client cannot move
+// admin listing onto the query path.
+func requestPriority(ctx context.Context, method string) priorityClass {
+	priority := methodPriorities[method]
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(priorityMetadataKey); len(values) > 0 {
+		if requested, ok := priorityClassNames[strings.ToLower(values[0])]; ok && requested > priority {
+			priority = requested
+		}
+	}
+	return priority
+}
+
+// priorityAdmission bounds the number of concurrent compaction and admin
+// requests. Query requests are never limited. Admin requests also run on the
+// background pool, so they cannot take the connections of the query path.
+type priorityAdmission struct {
+	slots map[priorityClass]chan struct{}
+}
+
+func newPriorityAdmission(maxConcurrentCompaction int, maxConcurrentAdmin int) *priorityAdmission {
+	a := &priorityAdmission{slots: map[priorityClass]chan struct{}{}}
+	if maxConcurrentCompaction > 0 {
+		a.slots[priorityCompaction] = make(chan struct{}, maxConcurrentCompaction)
+	}
+	if maxConcurrentAdmin > 0 {
+		a.slots[priorityAdmin] = make(chan struct{}, maxConcurrentAdmin)
+	}
+	return a
+}
+
+func (a *priorityAdmission) interceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, release, err := a.admit(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return handler(ctx, req)
+}
+
+// streamInterceptor holds the slot of a streaming RPC until its stream ends,
+// so that a long admin operation counts against the admin slots all along.
+func (a *priorityAdmission) streamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, release, err := a.admit(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+}
+
+// admit waits for a slot of the priority of method and returns the context to
+// serve it with and the func that releases the slot.
+func (a *priorityAdmission) admit(ctx context.Context, method string) (context.Context, func(), error) {
+	if !strings.HasPrefix(method, sysdbServicePrefix) {
+		return ctx, func() {}, nil
+	}
+	priority := requestPriority(ctx, method)
+	if priority == priorityAdmin {
+		ctx = dbcore.WithBackgroundPool(ctx)
+	}
+	slots, ok := a.slots[priority]
+	if !ok {
+		return ctx, func() {}, nil
+	}
+	select {
+	case slots <- struct{}{}:
+		return ctx, func() { <-slots }, nil
+	case <-ctx.Done():
+		return nil, nil, status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+// contextServerStream serves a stream with the context of its interceptor.
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,84 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestRequestPriority(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, priorityQuery, requestPriority(ctx, coordinatorpb.SysDB_GetCollections_FullMethodName))
+	assert.Equal(t, priorityCompaction, requestPriority(ctx, coordinatorpb.SysDB_FlushCollectionCompaction_FullMethodName))
+	assert.Equal(t, priorityAdmin, requestPriority(ctx, coordinatorpb.SysDB_ListIncompleteCollections_FullMethodName))
+
+	// the metadata lowers the priority but cannot raise it
+	adminCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(priorityMetadataKey, "admin"))
+	assert.Equal(t, priorityAdmin, requestPriority(adminCtx, coordinatorpb.SysDB_GetCollections_FullMethodName))
+	queryCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(priorityMetadataKey, "query"))
+	assert.Equal(t, priorityAdmin, requestPriority(queryCtx, coordinatorpb.SysDB_GetCollectionsBySize_FullMethodName))
+}
+
+func TestPriorityAdmission(t *testing.T) {
+	admission := newPriorityAdmission(0, 1)
+	release := make(chan struct{})
+	started := make(chan struct{})
+	blocking := func(ctx context.Context, req any) (any, error) {
+		close(started)
+		<-release
+		return "ok", nil
+	}
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+	admin := &grpc.UnaryServerInfo{FullMethod: coordinatorpb.SysDB_ListIncompleteCollections_FullMethodName}
+
+	go admission.interceptor(context.Background(), nil, admin, blocking)
+	<-started
+
+	// queries are never limited
+	res, err := admission.interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: coordinatorpb.SysDB_GetCollections_FullMethodName}, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", res)
+
+	// a second admin request waits for the slot
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = admission.interceptor(ctx, nil, admin, handler)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+	close(release)
+	assert.Eventually(t, func() bool {
+		_, err := admission.interceptor(context.Background(), nil, admin, handler)
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestPriorityAdmissionStream(t *testing.T) {
+	admission := newPriorityAdmission(0, 1)
+	admin := &grpc.StreamServerInfo{FullMethod: coordinatorpb.SysDB_DeleteTenantWithProgress_FullMethodName}
+	release := make(chan struct{})
+	started := make(chan struct{})
+	go admission.streamInterceptor(nil, &testServerStream{ctx: context.Background()}, admin, func(srv any, ss grpc.ServerStream) error {
+		close(started)
+		<-release
+		return nil
+	})
+	<-started
+
+	// the slot is held until the stream ends
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := admission.streamInterceptor(nil, &testServerStream{ctx: ctx}, admin, func(srv any, ss grpc.ServerStream) error { return nil })
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+	close(release)
+	assert.Eventually(t, func() bool {
+		return admission.streamInterceptor(nil, &testServerStream{ctx: context.Background()}, admin, func(srv any, ss grpc.ServerStream) error { return nil }) == nil
+	}, time.Second, 10*time.Millisecond)
+}
@@ -1,12 +1,19 @@
 package grpc
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
 	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
 	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func convertCollectionMetadataToModel(collectionMetadata *coordinatorpb.UpdateMetadata) (*model.CollectionMetadata[model.CollectionMetadataValueType], error) {
@@ -47,6 +54,33 @@ func convertCollectionToProto(collection *model.Collection) *coordinatorpb.Colle
 		Database:    collection.DatabaseName,
 		LogPosition: collection.LogPosition,
 		Version:     collection.Version,
+		Revision:    collection.Revision,
+		State:       convertCollectionStateToProto(collection.State),
+
+		ReindexPending:       collection.ReindexPending,
+		ReindexFromDimension: collection.ReindexFromDimension,
+		KmsKeyId:             collection.KmsKeyID,
+		ReplicationFactor:    collection.ReplicationFactor,
+		DistanceFunction:     collection.DistanceFunction,
+		Acl:                  convertCollectionAclToProto(collection.Acl),
+		LastCompactor:        collection.LastCompactor,
+		LastCompactedAt:      collection.LastCompactedAt,
+	}
+	if collection.EmbeddingFunctionID != nil {
+		embeddingFunctionID := collection.EmbeddingFunctionID.String()
+		collectionpb.EmbeddingFunctionId = &embeddingFunctionID
+	}
+	if collection.TemplateID != nil {
+		templateID := collection.TemplateID.String()
+		collectionpb.TemplateId = &templateID
+	}
+	// collections that were not read back from their row have no etag
+	if !collection.UpdatedAt.IsZero() {
+		collectionpb.Etag = collection.ETag()
+	}
+	if collection.LogLag != nil {
+		collectionpb.UncompactedRecords = &collection.LogLag.UncompactedRecords
+		collectionpb.OldestUncompactedAt = collection.LogLag.OldestUncompactedAt
 	}
 	if collection.Metadata == nil {
 		return collectionpb
@@ -57,6 +91,43 @@ func convertCollectionToProto(collection *model.Collection) *coordinatorpb.Colle
 	return collectionpb
 }
 
+func convertCollectionAclToProto(entries []*model.CollectionAclEntry) []*coordinatorpb.CollectionAclEntry {
+	if len(entries) == 0 {
+		return nil
+	}
+	entriespb := make([]*coordinatorpb.CollectionAclEntry, 0, len(entries))
+	for _, entry := range entries {
+		entriespb = append(entriespb, &coordinatorpb.CollectionAclEntry{
+			Principal:   entry.Principal,
+			Permissions: entry.Permissions.Names(),
+		})
+	}
+	return entriespb
+}
+
+func convertCollectionLogRouteToProto(route *model.CollectionLogRoute) *coordinatorpb.CollectionLogRoute {
+	return &coordinatorpb.CollectionLogRoute{
+		CollectionId: route.CollectionID.String(),
+		LogAddress:   route.LogAddress,
+		Version:      route.Version,
+		UpdatedAt:    route.UpdatedAt,
+		Revision:     route.Revision,
+	}
+}
+
+func convertCollectionStateToProto(state model.CollectionState) coordinatorpb.CollectionState {
+	switch state {
+	case model.CollectionStateCreating:
+		return coordinatorpb.CollectionState_CREATING
+	case model.CollectionStateDegraded:
+		return coordinatorpb.CollectionState_DEGRADED
+	case model.CollectionStateDeleting:
+		return coordinatorpb.CollectionState_DELETING
+	default:
+		return coordinatorpb.CollectionState_READY
+	}
+}
+
 func convertCollectionMetadataToProto(collectionMetadata *model.CollectionMetadata[model.CollectionMetadataValueType]) *coordinatorpb.UpdateMetadata {
 	if collectionMetadata == nil {
 		return nil
@@ -65,38 +136,60 @@ func convertCollectionMetadataToProto(collectionMetadata *model.CollectionMetada
 		Metadata: make(map[string]*coordinatorpb.UpdateMetadataValue),
 	}
 	for key, value := range collectionMetadata.Metadata {
-		switch v := (value).(type) {
-		case *model.CollectionMetadataValueBoolType:
-			metadatapb.Metadata[key] = &coordinatorpb.UpdateMetadataValue{
-				Value: &coordinatorpb.UpdateMetadataValue_BoolValue{
-					BoolValue: v.Value,
-				},
-			}
-		case *model.CollectionMetadataValueStringType:
-			metadatapb.Metadata[key] = &coordinatorpb.UpdateMetadataValue{
-				Value: &coordinatorpb.UpdateMetadataValue_StringValue{
-					StringValue: v.Value,
-				},
-			}
-		case *model.CollectionMetadataValueInt64Type:
-			metadatapb.Metadata[key] = &coordinatorpb.UpdateMetadataValue{
-				Value: &coordinatorpb.UpdateMetadataValue_IntValue{
-					IntValue: v.Value,
-				},
-			}
-		case *model.CollectionMetadataValueFloat64Type:
-			metadatapb.Metadata[key] = &coordinatorpb.UpdateMetadataValue{
-				Value: &coordinatorpb.UpdateMetadataValue_FloatValue{
-					FloatValue: v.Value,
-				},
-			}
-		default:
-			log.Error("collection metadata value type not supported", zap.Any("metadata value", value))
+		if valuepb := convertCollectionMetadataValueToProto(value); valuepb != nil {
+			metadatapb.Metadata[key] = valuepb
 		}
 	}
 	return metadatapb
 }
 
+// convertCollectionMetadataValueToProto returns nil for a nil value.
+func convertCollectionMetadataValueToProto(value model.CollectionMetadataValueType) *coordinatorpb.UpdateMetadataValue {
+	switch v := (value).(type) {
+	case nil:
+		return nil
+	case *model.CollectionMetadataValueBoolType:
+		return &coordinatorpb.UpdateMetadataValue{
+			Value: &coordinatorpb.UpdateMetadataValue_BoolValue{
+				BoolValue: v.Value,
+			},
+		}
+	case *model.CollectionMetadataValueStringType:
+		return &coordinatorpb.UpdateMetadataValue{
+			Value: &coordinatorpb.UpdateMetadataValue_StringValue{
+				StringValue: v.Value,
+			},
+		}
+	case *model.CollectionMetadataValueInt64Type:
+		return &coordinatorpb.UpdateMetadataValue{
+			Value: &coordinatorpb.UpdateMetadataValue_IntValue{
+				IntValue: v.Value,
+			},
+		}
+	case *model.CollectionMetadataValueFloat64Type:
+		return &coordinatorpb.UpdateMetadataValue{
+			Value: &coordinatorpb.UpdateMetadataValue_FloatValue{
+				FloatValue: v.Value,
+			},
+		}
+	default:
+		log.Error("collection metadata value type not supported", zap.Any("metadata value", value))
+		return nil
+	}
+}
+
+func convertCollectionMetadataDiffToProto(diff *model.CollectionMetadataDiff) []*coordinatorpb.CollectionMetadataChange {
+	changes := make([]*coordinatorpb.CollectionMetadataChange, 0, len(diff.Changes))
+	for _, change := range diff.Changes {
+		changes = append(changes, &coordinatorpb.CollectionMetadataChange{
+			Key:  change.Key,
+			From: convertCollectionMetadataValueToProto(change.From),
+			To:   convertCollectionMetadataValueToProto(change.To),
+		})
+	}
+	return changes
+}
+
 func convertToCreateCollectionModel(req *coordinatorpb.CreateCollectionRequest) (*model.CreateCollection, error) {
 	collectionID, err := types.ToUniqueID(&req.Id)
 	if err != nil {
@@ -110,6 +203,23 @@ func convertToCreateCollectionModel(req *coordinatorpb.CreateCollectionRequest)
 		return nil, err
 	}
 
+	embeddingFunctionID, err := convertEmbeddingFunctionIDToModel(req.EmbeddingFunctionId)
+	if err != nil {
+		return nil, err
+	}
+
+	segments := make([]*model.CreateSegment, 0, len(req.Segments))
+	for _, segmentpb := range req.Segments {
+		segment, err := convertSegmentToModel(segmentpb)
+		if err != nil {
+			return nil, err
+		}
+		if segment.CollectionID == types.NilUniqueID() {
+			segment.CollectionID = collectionID
+		}
+		segments = append(segments, segment)
+	}
+
 	return &model.CreateCollection{
 		ID:           collectionID,
 		Name:         req.Name,
@@ -118,9 +228,218 @@ func convertToCreateCollectionModel(req *coordinatorpb.CreateCollectionRequest)
 		GetOrCreate:  req.GetGetOrCreate(),
 		TenantID:     req.GetTenant(),
 		DatabaseName: req.GetDatabase(),
+
+		CreateDatabaseIfNotExists: req.GetCreateDatabaseIfNotExists(),
+		Segments:                  segments,
+		KmsKeyID:                  req.KmsKeyId,
+		ReplicationFactor:         req.ReplicationFactor,
+		DistanceFunction:          req.DistanceFunction,
+		EmbeddingFunctionID:       embeddingFunctionID,
 	}, nil
 }
 
+// convertCollectionColumnFiltersToModel turns the dimension and distance function
+// of a GetCollectionsRequest into equality filters on their columns.
+func convertCollectionColumnFiltersToModel(req *coordinatorpb.GetCollectionsRequest) []*model.CollectionMetadataFilter {
+	var filters []*model.CollectionMetadataFilter
+	if req.Dimension != nil {
+		filters = append(filters, &model.CollectionMetadataFilter{
+			Field:    model.CollectionFilterDimension,
+			Operator: model.MetadataFilterEQ,
+			Value:    &model.CollectionMetadataValueInt64Type{Value: int64(*req.Dimension)},
+		})
+	}
+	if req.DistanceFunction != nil {
+		filters = append(filters, &model.CollectionMetadataFilter{
+			Field:    model.CollectionFilterDistanceFunction,
+			Operator: model.MetadataFilterEQ,
+			Value:    &model.CollectionMetadataValueStringType{Value: *req.DistanceFunction},
+		})
+	}
+	return filters
+}
+
+// convertCollectionFieldMaskToModel checks that a GetCollectionsRequest field
+// mask only names top level fields of Collection, and returns the parts of the
+// collections to load for them. An empty mask selects every field.
+func convertCollectionFieldMaskToModel(mask *fieldmaskpb.FieldMask) (*model.CollectionFields, error) {
+	if len(mask.GetPaths()) == 0 {
+		return nil, nil
+	}
+	descriptors := (&coordinatorpb.Collection{}).ProtoReflect().Descriptor().Fields()
+	fields := &model.CollectionFields{}
+	for _, path := range mask.Paths {
+		if descriptors.ByName(protoreflect.Name(path)) == nil {
+			return nil, fmt.Errorf("%w: %q", common.ErrInvalidFieldMask, path)
+		}
+		switch path {
+		case "metadata":
+			fields.Metadata = true
+		case "acl":
+			fields.Acl = true
+		case "uncompacted_records", "oldest_uncompacted_at":
+			fields.LogLag = true
+		}
+	}
+	return fields, nil
+}
+
+// maskCollection returns a copy of collectionpb with only the fields named by
+// a mask validated by convertCollectionFieldMaskToModel, or collectionpb itself
+// when the mask is empty.
+func maskCollection(collectionpb *coordinatorpb.Collection, mask *fieldmaskpb.FieldMask) *coordinatorpb.Collection {
+	if len(mask.GetPaths()) == 0 {
+		return collectionpb
+	}
+	src := collectionpb.ProtoReflect()
+	descriptors := src.Descriptor().Fields()
+	masked := &coordinatorpb.Collection{}
+	for _, path := range mask.Paths {
+		field := descriptors.ByName(protoreflect.Name(path))
+		// Set would mark unset optional fields as present with their zero value
+		if src.Has(field) {
+			masked.ProtoReflect().Set(field, src.Get(field))
+		}
+	}
+	return masked
+}
+
+// collectionsETag is the etag of a GetCollections response. It covers the etags
+// of the collections and the parts of the response that do not change their
+// rows, the acl, the log lag and the field mask.
+func collectionsETag(collections []*model.Collection, mask *fieldmaskpb.FieldMask) string {
+	h := sha256.New()
+	for _, path := range mask.GetPaths() {
+		fmt.Fprintf(h, "mask %s\n", path)
+	}
+	for _, collection := range collections {
+		fmt.Fprintf(h, "collection %s %s\n", collection.ID, collection.ETag())
+		for _, entry := range collection.Acl {
+			fmt.Fprintf(h, "acl %s %d\n", entry.Principal, entry.Permissions)
+		}
+		if lag := collection.LogLag; lag != nil {
+			fmt.Fprintf(h, "lag %d", lag.UncompactedRecords)
+			if lag.OldestUncompactedAt != nil {
+				fmt.Fprintf(h, " %d", *lag.OldestUncompactedAt)
+			}
+			fmt.Fprintln(h)
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)[:16])
+}
+
+func convertCollectionMetadataFiltersToModel(filterspb []*coordinatorpb.CollectionMetadataFilter) ([]*model.CollectionMetadataFilter, error) {
+	if len(filterspb) == 0 {
+		return nil, nil
+	}
+	filters := make([]*model.CollectionMetadataFilter, 0, len(filterspb))
+	for _, filterpb := range filterspb {
+		filter := &model.CollectionMetadataFilter{
+			Key: filterpb.Key,
+		}
+		switch c := filterpb.Comparison.(type) {
+		case *coordinatorpb.CollectionMetadataFilter_Exists:
+			if c.Exists {
+				filter.Operator = model.MetadataFilterExists
+			} else {
+				filter.Operator = model.MetadataFilterNotExists
+			}
+		case *coordinatorpb.CollectionMetadataFilter_SingleStringOperand:
+			filter.Value = &model.CollectionMetadataValueStringType{Value: c.SingleStringOperand.Value}
+			filter.Operator = convertGenericComparatorToModel(c.SingleStringOperand.Comparator)
+		case *coordinatorpb.CollectionMetadataFilter_SingleBoolOperand:
+			filter.Value = &model.CollectionMetadataValueBoolType{Value: c.SingleBoolOperand.Value}
+			filter.Operator = convertGenericComparatorToModel(c.SingleBoolOperand.Comparator)
+		case *coordinatorpb.CollectionMetadataFilter_SingleIntOperand:
+			filter.Value = &model.CollectionMetadataValueInt64Type{Value: c.SingleIntOperand.Value}
+			switch comparator := c.SingleIntOperand.Comparator.(type) {
+			case *coordinatorpb.SingleIntComparison_GenericComparator:
+				filter.Operator = convertGenericComparatorToModel(comparator.GenericComparator)
+			case *coordinatorpb.SingleIntComparison_NumberComparator:
+				filter.Operator = convertNumberComparatorToModel(comparator.NumberComparator)
+			default:
+				return nil, common.ErrInvalidMetadataFilter
+			}
+		case *coordinatorpb.CollectionMetadataFilter_SingleDoubleOperand:
+			filter.Value = &model.CollectionMetadataValueFloat64Type{Value: c.SingleDoubleOperand.Value}
+			switch comparator := c.SingleDoubleOperand.Comparator.(type) {
+			case *coordinatorpb.SingleDoubleComparison_GenericComparator:
+				filter.Operator = convertGenericComparatorToModel(comparator.GenericComparator)
+			case *coordinatorpb.SingleDoubleComparison_NumberComparator:
+				filter.Operator = convertNumberComparatorToModel(comparator.NumberComparator)
+			default:
+				return nil, common.ErrInvalidMetadataFilter
+			}
+		default:
+			log.Error("collection metadata filter comparison not supported", zap.Any("filter", filterpb))
+			return nil, common.ErrInvalidMetadataFilter
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+func convertGenericComparatorToModel(comparator coordinatorpb.GenericComparator) model.MetadataFilterOperator {
+	if comparator == coordinatorpb.GenericComparator_NE {
+		return model.MetadataFilterNE
+	}
+	return model.MetadataFilterEQ
+}
+
+func convertNumberComparatorToModel(comparator coordinatorpb.NumberComparator) model.MetadataFilterOperator {
+	switch comparator {
+	case coordinatorpb.NumberComparator_GT:
+		return model.MetadataFilterGT
+	case coordinatorpb.NumberComparator_GTE:
+		return model.MetadataFilterGTE
+	case coordinatorpb.NumberComparator_LT:
+		return model.MetadataFilterLT
+	default:
+		return model.MetadataFilterLTE
+	}
+}
+
+func convertCollectionSizeOrderByToModel(orderBy coordinatorpb.CollectionSizeOrderBy) (model.CollectionSizeOrderBy, error) {
+	switch orderBy {
+	case coordinatorpb.CollectionSizeOrderBy_TOTAL_RECORDS:
+		return model.CollectionSizeOrderByTotalRecords, nil
+	case coordinatorpb.CollectionSizeOrderBy_SIZE_BYTES:
+		return model.CollectionSizeOrderBySizeBytes, nil
+	default:
+		return 0, common.ErrInvalidCollectionSizeOrderBy
+	}
+}
+
+func convertCollectionSizeToProto(collectionSize *model.CollectionSize) *coordinatorpb.CollectionSize {
+	return &coordinatorpb.CollectionSize{
+		Id:                         collectionSize.ID.String(),
+		Name:                       collectionSize.Name,
+		TotalRecordsPostCompaction: collectionSize.TotalRecordsPostCompaction,
+		SizeBytesPostCompaction:    collectionSize.SizeBytesPostCompaction,
+	}
+}
+
+func convertCollectionStatsToProto(stats *model.CollectionStats) *coordinatorpb.CollectionStats {
+	statspb := &coordinatorpb.CollectionStats{
+		CollectionId: stats.CollectionID.String(),
+		Tenant:       stats.TenantID,
+		Database:     stats.DatabaseName,
+		TotalRecords: stats.TotalRecords,
+		SizeBytes:    stats.SizeBytes,
+		SegmentCount: stats.SegmentCount,
+		RefreshedAt:  stats.RefreshedAt.Unix(),
+	}
+	if stats.LastCompactedAt != nil {
+		lastCompactedAt := stats.LastCompactedAt.Unix()
+		statspb.LastCompactedAt = &lastCompactedAt
+	}
+	if stats.LogLag != nil {
+		statspb.UncompactedRecords = &stats.LogLag.UncompactedRecords
+		statspb.OldestUncompactedAt = stats.LogLag.OldestUncompactedAt
+	}
+	return statspb
+}
+
 func convertSegmentMetadataToModel(segmentMetadata *coordinatorpb.UpdateMetadata) (*model.SegmentMetadata[model.SegmentMetadataValueType], error) {
 	if segmentMetadata == nil {
 		return nil, nil
@@ -245,3 +564,356 @@ func convertSegmentToModel(segmentpb *coordinatorpb.Segment) (*model.CreateSegme
 		Metadata:     metadata,
 	}, nil
 }
+
+func convertIncompleteCollectionToProto(collection *model.IncompleteCollection) *coordinatorpb.IncompleteCollection {
+	return &coordinatorpb.IncompleteCollection{
+		Id:        collection.ID.String(),
+		Name:      collection.Name,
+		Tenant:    collection.TenantID,
+		Database:  collection.DatabaseName,
+		CreatedAt: collection.CreatedAt,
+	}
+}
+
+func convertInconsistentCollectionToProto(collection *model.InconsistentCollection) *coordinatorpb.InconsistentCollection {
+	segmentIDs := make([]string, 0, len(collection.SegmentIDs))
+	for _, segmentID := range collection.SegmentIDs {
+		segmentIDs = append(segmentIDs, segmentID.String())
+	}
+	return &coordinatorpb.InconsistentCollection{
+		Id:          collection.ID.String(),
+		Name:        collection.Name,
+		Tenant:      collection.TenantID,
+		Database:    collection.DatabaseName,
+		Kind:        string(collection.Kind),
+		Description: collection.Description,
+		SegmentIds:  segmentIDs,
+	}
+}
+
+func convertSegmentFilesToProto(files []*model.SegmentFile) []*coordinatorpb.SegmentFile {
+	filespb := make([]*coordinatorpb.SegmentFile, 0, len(files))
+	for _, file := range files {
+		filepb := &coordinatorpb.SegmentFile{
+			SegmentId:        file.SegmentID.String(),
+			CollectionId:     file.CollectionID.String(),
+			FileType:         file.FileType,
+			Path:             file.Path,
+			OtherCollections: file.OtherCollections,
+			SizeBytes:        file.SizeBytes,
+		}
+		if file.ContentHash != "" {
+			filepb.ContentHash = &file.ContentHash
+		}
+		filespb = append(filespb, filepb)
+	}
+	return filespb
+}
+
+// convertCollectionBatchResultsToProto converts the results of a batch
+// operation, using code for the status code of failed collections.
+func convertCollectionBatchResultsToProto(results []*model.CollectionBatchResult, code func(err error) int32) []*coordinatorpb.CollectionResult {
+	protoResults := make([]*coordinatorpb.CollectionResult, 0, len(results))
+	for _, result := range results {
+		status := setResponseStatus(successCode)
+		if result.Err != nil {
+			status = failResponseWithError(result.Err, code(result.Err))
+		}
+		protoResults = append(protoResults, &coordinatorpb.CollectionResult{
+			CollectionId: result.ID.String(),
+			Status:       status,
+		})
+	}
+	return protoResults
+}
+
+func convertCollectionToGcToProto(collection *model.CollectionToGc) *coordinatorpb.CollectionToGc {
+	return &coordinatorpb.CollectionToGc{
+		Id:        collection.ID.String(),
+		Name:      collection.Name,
+		TenantId:  collection.TenantID,
+		Version:   collection.Version,
+		UpdatedAt: collection.UpdatedAt,
+		IsDeleted: collection.IsDeleted,
+	}
+}
+
+func convertQuerySampleToProto(sample model.QuerySample) *coordinatorpb.QuerySample {
+	return &coordinatorpb.QuerySample{
+		Operation:   sample.Operation,
+		Sql:         sample.SQL,
+		Params:      sample.Params,
+		Rows:        sample.Rows,
+		DurationUs:  sample.Duration.Microseconds(),
+		StartedAtUs: sample.StartedAt.UnixMicro(),
+		Error:       sample.Error,
+	}
+}
+
+func convertTenantQuotaToModel(tenantID string, quotapb *coordinatorpb.TenantQuota) *model.TenantQuota {
+	return &model.TenantQuota{
+		TenantID:       tenantID,
+		MaxCollections: quotapb.MaxCollections,
+		MaxRecords:     quotapb.MaxRecords,
+		MaxSizeBytes:   quotapb.MaxSizeBytes,
+	}
+}
+
+func convertDatabaseQuotaToModel(tenantID string, databaseName string, quotapb *coordinatorpb.TenantQuota) *model.DatabaseQuota {
+	return &model.DatabaseQuota{
+		TenantID:       tenantID,
+		DatabaseName:   databaseName,
+		MaxCollections: quotapb.MaxCollections,
+		MaxRecords:     quotapb.MaxRecords,
+		MaxSizeBytes:   quotapb.MaxSizeBytes,
+	}
+}
+
+// convertFeatureFlagToModel rolls the flag out to every tenant when the
+// percentage is unset.
+func convertFeatureFlagToModel(flagpb *coordinatorpb.FeatureFlag) *model.FeatureFlag {
+	rolloutPercentage := int32(100)
+	if flagpb.RolloutPercentage != nil {
+		rolloutPercentage = *flagpb.RolloutPercentage
+	}
+	return &model.FeatureFlag{
+		Name:              flagpb.GetName(),
+		TenantID:          flagpb.GetTenant(),
+		Enabled:           flagpb.GetEnabled(),
+		RolloutPercentage: rolloutPercentage,
+		Description:       flagpb.GetDescription(),
+	}
+}
+
+func convertFeatureFlagToProto(flag *model.FeatureFlag) *coordinatorpb.FeatureFlag {
+	return &coordinatorpb.FeatureFlag{
+		Name:              flag.Name,
+		Tenant:            flag.TenantID,
+		Enabled:           flag.Enabled,
+		RolloutPercentage: &flag.RolloutPercentage,
+		Description:       flag.Description,
+	}
+}
+
+// convertEmbeddingFunctionIDToModel returns nil when the id is not set.
+func convertEmbeddingFunctionIDToModel(idpb *string) (*types.UniqueID, error) {
+	if idpb == nil {
+		return nil, nil
+	}
+	id, err := types.Parse(*idpb)
+	if err != nil {
+		return nil, common.ErrEmbeddingFunctionIDFormat
+	}
+	return &id, nil
+}
+
+func convertEmbeddingFunctionToModel(embeddingFunctionpb *coordinatorpb.EmbeddingFunction) (*model.EmbeddingFunction, error) {
+	id := types.NilUniqueID()
+	if embeddingFunctionpb.GetId() != "" {
+		var err error
+		id, err = types.Parse(embeddingFunctionpb.GetId())
+		if err != nil {
+			return nil, common.ErrEmbeddingFunctionIDFormat
+		}
+	}
+	return &model.EmbeddingFunction{
+		ID:       id,
+		TenantID: embeddingFunctionpb.GetTenant(),
+		Name:     embeddingFunctionpb.GetName(),
+		Provider: embeddingFunctionpb.GetProvider(),
+		Model:    embeddingFunctionpb.GetModel(),
+		Params:   embeddingFunctionpb.GetParams(),
+	}, nil
+}
+
+func convertEmbeddingFunctionToProto(embeddingFunction *model.EmbeddingFunction) *coordinatorpb.EmbeddingFunction {
+	return &coordinatorpb.EmbeddingFunction{
+		Id:        embeddingFunction.ID.String(),
+		Tenant:    embeddingFunction.TenantID,
+		Name:      embeddingFunction.Name,
+		Provider:  embeddingFunction.Provider,
+		Model:     embeddingFunction.Model,
+		Params:    embeddingFunction.Params,
+		CreatedAt: embeddingFunction.CreatedAt.Unix(),
+		UpdatedAt: embeddingFunction.UpdatedAt.Unix(),
+	}
+}
+
+var collectionSchemaFieldTypes = map[coordinatorpb.CollectionSchemaFieldType]model.CollectionSchemaFieldType{
+	coordinatorpb.CollectionSchemaFieldType_FIELD_TYPE_STRING: model.CollectionSchemaFieldString,
+	coordinatorpb.CollectionSchemaFieldType_FIELD_TYPE_INT:    model.CollectionSchemaFieldInt,
+	coordinatorpb.CollectionSchemaFieldType_FIELD_TYPE_FLOAT:  model.CollectionSchemaFieldFloat,
+	coordinatorpb.CollectionSchemaFieldType_FIELD_TYPE_BOOL:   model.CollectionSchemaFieldBool,
+}
+
+func convertCollectionSchemaFieldsToModel(fieldpbs []*coordinatorpb.CollectionSchemaField) ([]*model.CollectionSchemaField, error) {
+	fields := make([]*model.CollectionSchemaField, 0, len(fieldpbs))
+	for _, fieldpb := range fieldpbs {
+		fieldType, ok := collectionSchemaFieldTypes[fieldpb.GetType()]
+		if !ok {
+			return nil, common.ErrInvalidCollectionSchema
+		}
+		fields = append(fields, &model.CollectionSchemaField{
+			Name:    fieldpb.GetName(),
+			Type:    fieldType,
+			Indexed: fieldpb.GetIndexed(),
+		})
+	}
+	return fields, nil
+}
+
+func convertCollectionSchemaFieldsToProto(fields []*model.CollectionSchemaField) []*coordinatorpb.CollectionSchemaField {
+	fieldpbs := make([]*coordinatorpb.CollectionSchemaField, 0, len(fields))
+	for _, field := range fields {
+		fieldpbs = append(fieldpbs, &coordinatorpb.CollectionSchemaField{
+			Name:    field.Name,
+			Type:    convertCollectionSchemaFieldTypeToProto(field.Type),
+			Indexed: field.Indexed,
+		})
+	}
+	return fieldpbs
+}
+
+func convertCollectionSchemaFieldTypeToProto(fieldType model.CollectionSchemaFieldType) coordinatorpb.CollectionSchemaFieldType {
+	for fieldTypepb, modelType := range collectionSchemaFieldTypes {
+		if modelType == fieldType {
+			return fieldTypepb
+		}
+	}
+	return coordinatorpb.CollectionSchemaFieldType_FIELD_TYPE_STRING
+}
+
+func convertCollectionTemplateToModel(templatepb *coordinatorpb.CollectionTemplate) (*model.CollectionTemplate, error) {
+	if templatepb == nil {
+		return nil, common.ErrInvalidCollectionTemplate
+	}
+	id := types.NilUniqueID()
+	if templatepb.GetId() != "" {
+		var err error
+		id, err = types.Parse(templatepb.GetId())
+		if err != nil {
+			return nil, common.ErrCollectionTemplateIDFormat
+		}
+	}
+	metadata, err := convertCollectionMetadataToModel(templatepb.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+	return &model.CollectionTemplate{
+		ID:                id,
+		TenantID:          templatepb.GetTenant(),
+		Name:              templatepb.GetName(),
+		Dimension:         templatepb.Dimension,
+		DistanceFunction:  templatepb.DistanceFunction,
+		ReplicationFactor: templatepb.ReplicationFactor,
+		Metadata:          metadata,
+		MaxRecords:        templatepb.MaxRecords,
+		MaxSizeBytes:      templatepb.MaxSizeBytes,
+	}, nil
+}
+
+func convertCollectionTemplateToProto(template *model.CollectionTemplate) *coordinatorpb.CollectionTemplate {
+	templatepb := &coordinatorpb.CollectionTemplate{
+		Id:                template.ID.String(),
+		Tenant:            template.TenantID,
+		Name:              template.Name,
+		Dimension:         template.Dimension,
+		DistanceFunction:  template.DistanceFunction,
+		ReplicationFactor: template.ReplicationFactor,
+		MaxRecords:        template.MaxRecords,
+		MaxSizeBytes:      template.MaxSizeBytes,
+		CreatedAt:         template.CreatedAt.Unix(),
+	}
+	if template.Metadata != nil {
+		templatepb.Metadata = convertCollectionMetadataToProto(template.Metadata)
+	}
+	return templatepb
+}
+
+func convertTenantQuotaToProto(quota *model.TenantQuota) *coordinatorpb.TenantQuota {
+	return &coordinatorpb.TenantQuota{
+		MaxCollections: quota.MaxCollections,
+		MaxRecords:     quota.MaxRecords,
+		MaxSizeBytes:   quota.MaxSizeBytes,
+	}
+}
+
+func convertTenantPlacementToModel(tenantID string, placementpb *coordinatorpb.TenantPlacement) *model.TenantPlacement {
+	return &model.TenantPlacement{
+		TenantID:       tenantID,
+		AllowedRegions: placementpb.GetAllowedRegions(),
+	}
+}
+
+func convertTenantPlacementToProto(placement *model.TenantPlacement) *coordinatorpb.TenantPlacement {
+	return &coordinatorpb.TenantPlacement{
+		AllowedRegions: placement.AllowedRegions,
+	}
+}
+
+func convertTenantActivityToProto(activity *model.TenantActivity) *coordinatorpb.TenantActivity {
+	return &coordinatorpb.TenantActivity{
+		Day:                 activity.Day.Unix(),
+		CollectionsCreated:  activity.CollectionsCreated,
+		CollectionsDeleted:  activity.CollectionsDeleted,
+		CollectionsRestored: activity.CollectionsRestored,
+		CollectionsPurged:   activity.CollectionsPurged,
+		Compactions:         activity.Compactions,
+		RecordsCompacted:    activity.RecordsCompacted,
+	}
+}
+
+func convertSoftDeleteCleanerRunToProto(run *model.SoftDeleteCleanerRun) *coordinatorpb.SoftDeleteCleanerRun {
+	runpb := &coordinatorpb.SoftDeleteCleanerRun{
+		StartedAt: run.StartedAt.Unix(),
+		Manual:    run.Manual,
+		Scanned:   run.Scanned,
+		Purged:    run.Purged,
+		Failed:    run.Failed,
+		Error:     run.Error,
+	}
+	if !run.FinishedAt.IsZero() {
+		runpb.FinishedAt = run.FinishedAt.Unix()
+	}
+	return runpb
+}
+
+func convertCleanupDeadLetterToProto(entry *model.CleanupDeadLetter) *coordinatorpb.CleanupDeadLetter {
+	return &coordinatorpb.CleanupDeadLetter{
+		CollectionId:  entry.CollectionID.String(),
+		Task:          entry.Task,
+		Tenant:        entry.TenantID,
+		Attempts:      entry.Attempts,
+		LastError:     entry.LastError,
+		FirstFailedAt: entry.FirstFailedAt.Unix(),
+		LastFailedAt:  entry.LastFailedAt.Unix(),
+		DeadLettered:  entry.DeadLettered,
+	}
+}
+
+func convertJobToProto(job *model.Job) *coordinatorpb.Job {
+	jobpb := &coordinatorpb.Job{
+		Id:          job.ID.String(),
+		Type:        job.Type,
+		Tenant:      job.TenantID,
+		State:       string(job.State),
+		Attempts:    job.Attempts,
+		MaxAttempts: job.MaxAttempts,
+		LastError:   job.LastError,
+		NextRunAt:   job.NextRunAt.Unix(),
+		CreatedAt:   job.CreatedAt.Unix(),
+		UpdatedAt:   job.UpdatedAt.Unix(),
+	}
+	if job.FinishedAt != nil {
+		jobpb.FinishedAt = job.FinishedAt.Unix()
+	}
+	return jobpb
+}
+
+func convertOperationProgressToProto(progress *model.OperationProgress) *coordinatorpb.OperationProgress {
+	return &coordinatorpb.OperationProgress{
+		Processed:  progress.Processed,
+		Total:      progress.Total,
+		EtaSeconds: int64(progress.ETA.Round(time.Second) / time.Second),
+	}
+}
@@ -2,11 +2,14 @@ package grpc
 
 import (
 	"testing"
+	"time"
 
+	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
 	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func TestConvertCollectionMetadataToModel(t *testing.T) {
@@ -195,3 +198,171 @@ func TestConvertSegmentToProto(t *testing.T) {
 	assert.Nil(t, segmentpb.Collection)
 	assert.Nil(t, segmentpb.Metadata)
 }
+
+func TestConvertCollectionMetadataFiltersToModel(t *testing.T) {
+	// Test case 1: no filters
+	filters, err := convertCollectionMetadataFiltersToModel(nil)
+	assert.Nil(t, filters)
+	assert.Nil(t, err)
+
+	// Test case 2: range and existence filters
+	filterspb := []*coordinatorpb.CollectionMetadataFilter{
+		{
+			Key: "size",
+			Comparison: &coordinatorpb.CollectionMetadataFilter_SingleIntOperand{
+				SingleIntOperand: &coordinatorpb.SingleIntComparison{
+					Value: 10,
+					Comparator: &coordinatorpb.SingleIntComparison_NumberComparator{
+						NumberComparator: coordinatorpb.NumberComparator_GTE,
+					},
+				},
+			},
+		},
+		{
+			Key: "owner",
+			Comparison: &coordinatorpb.CollectionMetadataFilter_Exists{
+				Exists: false,
+			},
+		},
+	}
+	filters, err = convertCollectionMetadataFiltersToModel(filterspb)
+	assert.Nil(t, err)
+	assert.Equal(t, []*model.CollectionMetadataFilter{
+		{
+			Key:      "size",
+			Operator: model.MetadataFilterGTE,
+			Value:    &model.CollectionMetadataValueInt64Type{Value: 10},
+		},
+		{
+			Key:      "owner",
+			Operator: model.MetadataFilterNotExists,
+		},
+	}, filters)
+
+	// Test case 3: comparison not set
+	filters, err = convertCollectionMetadataFiltersToModel([]*coordinatorpb.CollectionMetadataFilter{{Key: "size"}})
+	assert.Nil(t, filters)
+	assert.ErrorIs(t, err, common.ErrInvalidMetadataFilter)
+}
+
+func TestConvertCollectionColumnFiltersToModel(t *testing.T) {
+	assert.Nil(t, convertCollectionColumnFiltersToModel(&coordinatorpb.GetCollectionsRequest{}))
+
+	dimension := int32(1536)
+	distanceFunction := "cosine"
+	filters := convertCollectionColumnFiltersToModel(&coordinatorpb.GetCollectionsRequest{Dimension: &dimension, DistanceFunction: &distanceFunction})
+	assert.Equal(t, []*model.CollectionMetadataFilter{
+		{
+			Field:    model.CollectionFilterDimension,
+			Operator: model.MetadataFilterEQ,
+			Value:    &model.CollectionMetadataValueInt64Type{Value: 1536},
+		},
+		{
+			Field:    model.CollectionFilterDistanceFunction,
+			Operator: model.MetadataFilterEQ,
+			Value:    &model.CollectionMetadataValueStringType{Value: "cosine"},
+		},
+	}, filters)
+}
+
+func TestConvertEmbeddingFunctionToModel(t *testing.T) {
+	embeddingFunction, err := convertEmbeddingFunctionToModel(&coordinatorpb.EmbeddingFunction{Tenant: "tenant", Name: "openai", Provider: "openai"})
+	assert.NoError(t, err)
+	assert.Equal(t, types.NilUniqueID(), embeddingFunction.ID)
+	assert.Equal(t, "openai", embeddingFunction.Provider)
+
+	_, err = convertEmbeddingFunctionToModel(&coordinatorpb.EmbeddingFunction{Id: "not-a-uuid"})
+	assert.ErrorIs(t, err, common.ErrEmbeddingFunctionIDFormat)
+
+	id, err := convertEmbeddingFunctionIDToModel(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, id)
+}
+
+func TestConvertCollectionSchemaFields(t *testing.T) {
+	fieldpbs := []*coordinatorpb.CollectionSchemaField{
+		{Name: "title"},
+		{Name: "score", Type: coordinatorpb.CollectionSchemaFieldType_FIELD_TYPE_FLOAT, Indexed: true},
+	}
+	fields, err := convertCollectionSchemaFieldsToModel(fieldpbs)
+	assert.NoError(t, err)
+	assert.Equal(t, model.CollectionSchemaFieldString, fields[0].Type)
+	assert.Equal(t, &model.CollectionSchemaField{Name: "score", Type: model.CollectionSchemaFieldFloat, Indexed: true}, fields[1])
+	fieldpb := convertCollectionSchemaFieldsToProto(fields)[1]
+	assert.Equal(t, coordinatorpb.CollectionSchemaFieldType_FIELD_TYPE_FLOAT, fieldpb.Type)
+	assert.True(t, fieldpb.Indexed)
+
+	_, err = convertCollectionSchemaFieldsToModel([]*coordinatorpb.CollectionSchemaField{{Name: "year", Type: 9}})
+	assert.ErrorIs(t, err, common.ErrInvalidCollectionSchema)
+}
+
+func TestConvertCollectionTemplateToModel(t *testing.T) {
+	maxRecords := int64(1000)
+	template, err := convertCollectionTemplateToModel(&coordinatorpb.CollectionTemplate{Tenant: "tenant", Name: "documents", MaxRecords: &maxRecords})
+	assert.NoError(t, err)
+	assert.Equal(t, types.NilUniqueID(), template.ID)
+	assert.Nil(t, template.Metadata)
+	assert.Equal(t, &maxRecords, convertCollectionTemplateToProto(template).MaxRecords)
+
+	_, err = convertCollectionTemplateToModel(&coordinatorpb.CollectionTemplate{Id: "not-a-uuid"})
+	assert.ErrorIs(t, err, common.ErrCollectionTemplateIDFormat)
+	_, err = convertCollectionTemplateToModel(nil)
+	assert.ErrorIs(t, err, common.ErrInvalidCollectionTemplate)
+}
+
+func TestConvertCollectionFieldMask(t *testing.T) {
+	fields, err := convertCollectionFieldMaskToModel(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, fields)
+
+	mask := &fieldmaskpb.FieldMask{Paths: []string{"id", "name", "version", "kms_key_id", "acl"}}
+	fields, err = convertCollectionFieldMaskToModel(mask)
+	assert.NoError(t, err)
+	assert.Equal(t, &model.CollectionFields{Acl: true}, fields)
+
+	_, err = convertCollectionFieldMaskToModel(&fieldmaskpb.FieldMask{Paths: []string{"id", "logPosition"}})
+	assert.ErrorIs(t, err, common.ErrInvalidFieldMask)
+	_, err = convertCollectionFieldMaskToModel(&fieldmaskpb.FieldMask{Paths: []string{"metadata.metadata"}})
+	assert.ErrorIs(t, err, common.ErrInvalidFieldMask)
+
+	dimension := int32(384)
+	collectionpb := &coordinatorpb.Collection{
+		Id:          "00000000-0000-0000-0000-000000000001",
+		Name:        "documents",
+		Dimension:   &dimension,
+		LogPosition: 10,
+		Version:     3,
+	}
+	masked := maskCollection(collectionpb, mask)
+	assert.Equal(t, collectionpb.Id, masked.Id)
+	assert.Equal(t, collectionpb.Name, masked.Name)
+	assert.Equal(t, collectionpb.Version, masked.Version)
+	assert.Nil(t, masked.Dimension)
+	assert.Nil(t, masked.KmsKeyId)
+	assert.Zero(t, masked.LogPosition)
+	assert.Same(t, collectionpb, maskCollection(collectionpb, &fieldmaskpb.FieldMask{}))
+}
+
+func TestCollectionsETag(t *testing.T) {
+	updatedAt := time.Date(2026, 10, 14, 9, 30, 0, 0, time.UTC)
+	collection := &model.Collection{ID: types.NewUniqueID(), Version: 3, UpdatedAt: updatedAt}
+	collections := []*model.Collection{collection}
+	etag := collectionsETag(collections, nil)
+	assert.Equal(t, etag, collectionsETag(collections, &fieldmaskpb.FieldMask{}))
+	assert.Equal(t, collection.ETag(), convertCollectionToProto(collection).Etag)
+
+	collection.Acl = []*model.CollectionAclEntry{{CollectionID: collection.ID, Principal: "alice", Permissions: model.CollectionPermissionRead}}
+	withAcl := collectionsETag(collections, nil)
+	assert.NotEqual(t, etag, withAcl)
+	assert.NotEqual(t, withAcl, collectionsETag(collections, &fieldmaskpb.FieldMask{Paths: []string{"id"}}))
+
+	collection.UpdatedAt = updatedAt.Add(time.Microsecond)
+	assert.NotEqual(t, withAcl, collectionsETag(collections, nil))
+	collection.UpdatedAt = updatedAt
+	assert.Equal(t, withAcl, collectionsETag(collections, nil))
+	collection.Version++
+	assert.NotEqual(t, withAcl, collectionsETag(collections, nil))
+
+	// collections that were not read back from their row have no etag
+	assert.Empty(t, convertCollectionToProto(&model.Collection{ID: types.NewUniqueID()}).Etag)
+}
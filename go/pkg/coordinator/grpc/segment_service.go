@@ -66,7 +66,21 @@ func (s *Server) GetSegments(ctx context.Context, req *coordinatorpb.GetSegments
 		scopeString := scope.String()
 		scopeValue = &scopeString
 	}
-	segments, err := s.coordinator.GetSegments(ctx, parsedSegmentID, segmentType, scopeValue, parsedCollectionID)
+	offset := req.Offset
+	if req.PageToken != nil {
+		if offset != nil {
+			res.Status = failResponseWithError(common.ErrPageTokenWithStart, 400)
+			return res, nil
+		}
+		position, err := s.pageTokens.verify(coordinatorpb.SysDB_GetSegments_FullMethodName, pageQuery(req, getSegmentsPageFields...), *req.PageToken)
+		if err != nil {
+			log.Error("invalid page token", zap.Error(err))
+			res.Status = failResponseWithError(err, 400)
+			return res, nil
+		}
+		offset = &position.Offset
+	}
+	segments, err := s.coordinator.GetSegments(ctx, parsedSegmentID, segmentType, scopeValue, parsedCollectionID, req.Limit, offset)
 	if err != nil {
 		log.Error("get segments error", zap.Error(err))
 		res.Status = failResponseWithError(err, errorCode)
@@ -79,6 +93,9 @@ func (s *Server) GetSegments(ctx context.Context, req *coordinatorpb.GetSegments
 		segmentpbList = append(segmentpbList, segmentpb)
 	}
 	res.Segments = segmentpbList
+	if next, ok := nextPageOffset(req.Limit, offset, len(segments)); ok {
+		res.NextPageToken = s.pageTokens.issue(coordinatorpb.SysDB_GetSegments_FullMethodName, pageQuery(req, getSegmentsPageFields...), pagePosition{Offset: next})
+	}
 	res.Status = setResponseStatus(successCode)
 	return res, nil
 }
@@ -92,7 +109,7 @@ func (s *Server) DeleteSegment(ctx context.Context, req *coordinatorpb.DeleteSeg
 		res.Status = failResponseWithError(common.ErrSegmentIDFormat, errorCode)
 		return res, nil
 	}
-	err = s.coordinator.DeleteSegment(ctx, parsedSegmentID)
+	err = s.coordinator.DeleteSegment(ctx, parsedSegmentID, req.GetReason(), req.GetActor())
 	if err != nil {
 		if err == common.ErrSegmentDeleteNonExistingSegment {
 			log.Error(err.Error(), zap.String("segment.id", segmentID))
@@ -142,3 +159,127 @@ func (s *Server) UpdateSegment(ctx context.Context, req *coordinatorpb.UpdateSeg
 	res.Status = setResponseStatus(successCode)
 	return res, nil
 }
+
+func (s *Server) ListDuplicateSegmentFiles(ctx context.Context, req *coordinatorpb.ListDuplicateSegmentFilesRequest) (*coordinatorpb.ListDuplicateSegmentFilesResponse, error) {
+	res := &coordinatorpb.ListDuplicateSegmentFilesResponse{}
+	duplicates, err := s.coordinator.GetDuplicateSegmentFiles(ctx, req.GetTenant(), req.GetLimit())
+	if err != nil {
+		log.Error("error listing duplicate segment files", zap.Error(err))
+		res.Status = failResponseWithError(err, errorCode)
+		return res, nil
+	}
+	res.Duplicates = make([]*coordinatorpb.DuplicateSegmentFiles, 0, len(duplicates))
+	for _, duplicate := range duplicates {
+		res.Duplicates = append(res.Duplicates, &coordinatorpb.DuplicateSegmentFiles{
+			ContentHash: duplicate.ContentHash,
+			Files:       convertSegmentFilesToProto(duplicate.Files),
+		})
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) GetCollectionSegmentFiles(ctx context.Context, req *coordinatorpb.GetCollectionSegmentFilesRequest) (*coordinatorpb.GetCollectionSegmentFilesResponse, error) {
+	res := &coordinatorpb.GetCollectionSegmentFilesResponse{}
+	collectionID, err := types.ToUniqueID(&req.CollectionId)
+	if err != nil {
+		log.Error("collection id format error", zap.String("collection.id", req.CollectionId))
+		res.Status = failResponseWithError(common.ErrCollectionIDFormat, 400)
+		return res, nil
+	}
+	files, err := s.coordinator.GetCollectionSegmentFiles(ctx, collectionID)
+	if err != nil {
+		log.Error("error getting collection segment files", zap.Error(err))
+		if err == common.ErrCollectionNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Files = convertSegmentFilesToProto(files)
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) GetCollectionPlacement(ctx context.Context, req *coordinatorpb.GetCollectionPlacementRequest) (*coordinatorpb.GetCollectionPlacementResponse, error) {
+	res := &coordinatorpb.GetCollectionPlacementResponse{}
+	collectionID, err := types.ToUniqueID(&req.CollectionId)
+	if err != nil {
+		log.Error("collection id format error", zap.String("collection.id", req.CollectionId))
+		res.Status = failResponseWithError(common.ErrCollectionIDFormat, 400)
+		return res, nil
+	}
+	placement, err := s.coordinator.GetCollectionPlacement(ctx, collectionID)
+	if err != nil {
+		log.Error("error getting collection placement", zap.String("collection.id", req.CollectionId), zap.Error(err))
+		if err == common.ErrCollectionNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.QueryNodes = placement.QueryNodes
+	res.ReplicationFactor = placement.ReplicationFactor
+	res.Version = placement.Version
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) SimulateCollectionPlacement(ctx context.Context, req *coordinatorpb.SimulateCollectionPlacementRequest) (*coordinatorpb.SimulateCollectionPlacementResponse, error) {
+	res := &coordinatorpb.SimulateCollectionPlacementResponse{}
+	simulation, err := s.coordinator.SimulateCollectionPlacement(ctx, req.GetQueryNodes(), req.GetTenant(), req.GetDatabase(), req.GetLimit())
+	if err != nil {
+		log.Error("error simulating collection placement", zap.Strings("queryNodes", req.GetQueryNodes()), zap.Error(err))
+		if err == common.ErrInvalidSimulatedMemberlist {
+			res.Status = failResponseWithError(err, 400)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.TotalCollections = simulation.TotalCollections
+	res.MovedCollections = simulation.MovedCollections
+	res.Moves = make([]*coordinatorpb.CollectionPlacementMove, 0, len(simulation.Moves))
+	for _, move := range simulation.Moves {
+		res.Moves = append(res.Moves, &coordinatorpb.CollectionPlacementMove{
+			CollectionId:   move.CollectionID.String(),
+			CurrentNodes:   move.CurrentNodes,
+			SimulatedNodes: move.SimulatedNodes,
+		})
+	}
+	res.NodeLoads = make([]*coordinatorpb.NodeLoad, 0, len(simulation.NodeLoads))
+	for _, load := range simulation.NodeLoads {
+		res.NodeLoads = append(res.NodeLoads, &coordinatorpb.NodeLoad{
+			NodeId:               load.NodeID,
+			CurrentCollections:   load.CurrentCollections,
+			SimulatedCollections: load.SimulatedCollections,
+		})
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) GetStorageAttribution(ctx context.Context, req *coordinatorpb.GetStorageAttributionRequest) (*coordinatorpb.GetStorageAttributionResponse, error) {
+	res := &coordinatorpb.GetStorageAttributionResponse{}
+	attribution, err := s.coordinator.GetStorageAttribution(ctx, req.GetTenant())
+	if err != nil {
+		log.Error("error getting storage attribution", zap.String("tenant", req.GetTenant()), zap.Error(err))
+		res.Status = failResponseWithError(err, errorCode)
+		return res, nil
+	}
+	res.UniqueBytes = attribution.UniqueBytes
+	res.SharedBytes = attribution.SharedBytes
+	res.DistinctBytes = attribution.DistinctBytes
+	res.Collections = make([]*coordinatorpb.CollectionStorageAttribution, 0, len(attribution.Collections))
+	for _, collection := range attribution.Collections {
+		res.Collections = append(res.Collections, &coordinatorpb.CollectionStorageAttribution{
+			CollectionId: collection.CollectionID.String(),
+			UniqueBytes:  collection.UniqueBytes,
+			SharedBytes:  collection.SharedBytes,
+		})
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
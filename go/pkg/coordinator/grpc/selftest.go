@@ -0,0 +1,55 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/chroma-core/chroma/go/migrations"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/selftest"
+	"github.com/chroma-core/chroma/go/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// SelfTest checks that the coordinator can reach the database, that its schema
+// is up to date, that the object store and the memberlists are usable and that
+// the gRPC port is free, without starting the coordinator.
+func SelfTest(ctx context.Context, config Config) *selftest.Report {
+	var db *gorm.DB
+	usesDatabase := config.SystemCatalogProvider == "database"
+	checks := []selftest.Check{
+		selftest.Ping(func(ctx context.Context) error {
+			if !usesDatabase {
+				return selftest.Skip("system catalog provider is " + config.SystemCatalogProvider)
+			}
+			var err error
+			db, err = dbcore.ConnectPostgres(config.DBConfig)
+			if err != nil {
+				return err
+			}
+			sqlDB, err := db.DB()
+			if err != nil {
+				return err
+			}
+			return sqlDB.PingContext(ctx)
+		}),
+		selftest.SchemaVersion(func(ctx context.Context) (string, error) {
+			if db == nil {
+				return "", selftest.Skip("database is not reachable")
+			}
+			var version string
+			err := db.WithContext(ctx).Raw(selftest.AtlasRevisionQuery).Scan(&version).Error
+			return version, err
+		}, migrations.FS),
+		selftest.ObjectStore(config.ObjectStore),
+	}
+	if !config.Testing {
+		memberlists := []string{config.QueryServiceMemberlistName, config.CompactionServiceMemberlistName}
+		permissions := []selftest.Permission{{Resource: "pods", Verbs: []string{"list", "watch"}}}
+		for _, memberlist := range memberlists {
+			permissions = append(permissions, selftest.Permission{Group: "chroma.cluster", Resource: "memberlists", Name: memberlist, Verbs: []string{"get", "update"}})
+		}
+		checks = append(checks, selftest.KubernetesPermissions("memberlist_permissions", utils.GetKubernetesInterface, config.KubernetesNamespace, permissions))
+	}
+	checks = append(checks, selftest.PortBinding(config.GrpcConfig.BindAddress))
+	return selftest.Run(ctx, "sysdb", 0, checks)
+}
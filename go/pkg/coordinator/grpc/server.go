@@ -7,11 +7,13 @@ import (
 
 	"github.com/chroma-core/chroma/go/pkg/grpcutils"
 
+	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/coordinator"
 	"github.com/chroma-core/chroma/go/pkg/memberlist_manager"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dao"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
 	"github.com/chroma-core/chroma/go/pkg/notification"
+	"github.com/chroma-core/chroma/go/pkg/objectstore"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
 	"github.com/chroma-core/chroma/go/pkg/utils"
 	"github.com/pingcap/log"
@@ -46,6 +48,8 @@ type Config struct {
 	// Query service memberlist config
 	QueryServiceMemberlistName string
 	QueryServicePodLabel       string
+	DecommissionDrainPeriod    time.Duration
+	HeartbeatTimeout           time.Duration
 
 	// Watcher config
 	WatchInterval time.Duration
@@ -54,6 +58,100 @@ type Config struct {
 	CompactionServiceMemberlistName string
 	CompactionServicePodLabel       string
 
+	// Limits config
+	MaxCollectionsPerDatabase int64
+	MaxMetadataKeys           int
+	MaxMetadataKeyLength      int
+	MaxMetadataSizeBytes      int
+	MaxReplicationFactor      int32
+
+	// Compaction config
+	FlushCompactionMaxRetries int
+
+	// Confirmation config
+	ConfirmationTokenTTL time.Duration
+	// ConfirmationTokenKeys sign the confirmation tokens of the destructive
+	// operations like PageTokenKeys sign the page tokens.
+	ConfirmationTokenKeys []string
+
+	// PageTokenKeys sign the page tokens of the list RPCs with the first key and
+	// verify them with all of them. Empty uses a key of this process only.
+	PageTokenKeys []string
+
+	// Maintenance config
+	MaintenanceWindowStartHour int
+	MaintenanceWindowDuration  time.Duration
+	MaintenanceVacuum          bool
+	MaintenanceTables          []string
+
+	// Leader election config
+	LeaderLeaseTTL time.Duration
+	LeaderID       string
+	LeaderAddress  string
+
+	// Read-only replica config
+	ReadOnly bool
+
+	// Load shedding config
+	MaxInFlightWriteTx int64
+	MaxPoolWait        time.Duration
+
+	// Priority config
+	MaxConcurrentCompactionRequests int
+	MaxConcurrentAdminRequests      int
+	MaxConcurrentRequestsPerTenant  int
+
+	// Query sampling config
+	QuerySampleRate       float64
+	QuerySampleBufferSize int
+
+	// Log lag config
+	LogServiceAddress string
+	LogLagCacheTTL    time.Duration
+
+	LogOffsetReconcileInterval time.Duration
+
+	// Collection stats config
+	CollectionStatsRefreshInterval time.Duration
+	CollectionSizeHistoryRetention time.Duration
+
+	// Soft delete cleaner config
+	SoftDeleteRetention          time.Duration
+	SoftDeleteCleanerInterval    time.Duration
+	SoftDeleteCleanerBatchSize   int32
+	SoftDeleteCleanerMaxAttempts int32
+
+	// Job queue config
+	JobWorkerConcurrency int
+	JobPollInterval      time.Duration
+
+	// Catalog scan config
+	ScanCollectionsRate int
+
+	// Feature flag config
+	FeatureFlagCacheTTL time.Duration
+
+	MaintenanceModeCacheTTL time.Duration
+
+	// Naming config
+	ReservedNamePrefixes  []string
+	MaxNameLength         int
+	TenantNamePattern     string
+	DatabaseNamePattern   string
+	CollectionNamePattern string
+
+	// Write hook config
+	PreWriteHooks      []string
+	PostCommitHooks    []string
+	OutboxPollInterval time.Duration
+
+	// Tenant last compaction batching config
+	TenantLastCompactionFlushInterval time.Duration
+	TenantLastCompactionMaxPending    int
+
+	// Object store config, only used by the self test so far
+	ObjectStore objectstore.Config
+
 	// Config for testing
 	Testing bool
 }
@@ -67,6 +165,8 @@ type Server struct {
 	coordinator  coordinator.ICoordinator
 	grpcServer   grpcutils.GrpcServer
 	healthServer *health.Server
+	readOnly     bool
+	pageTokens   *pageTokens
 }
 
 func New(config Config) (*Server, error) {
@@ -74,10 +174,17 @@ func New(config Config) (*Server, error) {
 		return NewWithGrpcProvider(config, grpcutils.Default, nil)
 	} else if config.SystemCatalogProvider == "database" {
 		dBConfig := config.DBConfig
+		dBConfig.ReadOnly = config.ReadOnly
 		db, err := dbcore.ConnectPostgres(dBConfig)
 		if err != nil {
 			return nil, err
 		}
+		if dBConfig.ClusterID != "" && !config.ReadOnly {
+			err = dbcore.CreateClusterDefaults(db)
+			if err != nil {
+				return nil, err
+			}
+		}
 		return NewWithGrpcProvider(config, grpcutils.Default, db)
 	} else {
 		return nil, errors.New("invalid system catalog provider, only memory and database are supported")
@@ -88,7 +195,19 @@ func NewWithGrpcProvider(config Config, provider grpcutils.GrpcProvider, db *gor
 	ctx := context.Background()
 	s := &Server{
 		healthServer: health.NewServer(),
+		readOnly:     config.ReadOnly,
+	}
+	pageTokens, err := newPageTokens(config.PageTokenKeys)
+	if err != nil {
+		return nil, err
+	}
+	if len(config.PageTokenKeys) == 0 {
+		log.Warn("no page token keys are configured, page tokens are only valid on this replica until it restarts")
 	}
+	if len(config.ConfirmationTokenKeys) == 0 {
+		log.Warn("no confirmation token keys are configured, confirmation tokens are only valid on this replica until it restarts")
+	}
+	s.pageTokens = pageTokens
 
 	var notificationStore notification.NotificationStore
 	if config.NotificationStoreProvider == "memory" {
@@ -109,7 +228,76 @@ func NewWithGrpcProvider(config Config, provider grpcutils.GrpcProvider, db *gor
 	} else {
 		return nil, errors.New("invalid notifier provider, only memory are supported")
 	}
-	coordinator, err := coordinator.NewCoordinator(ctx, db, notificationStore, notifier)
+	tenantNaming, err := namingPolicy(config, config.TenantNamePattern)
+	if err != nil {
+		return nil, err
+	}
+	databaseNaming, err := namingPolicy(config, config.DatabaseNamePattern)
+	if err != nil {
+		return nil, err
+	}
+	collectionNaming, err := namingPolicy(config, config.CollectionNamePattern)
+	if err != nil {
+		return nil, err
+	}
+	coordinatorConfig := coordinator.Config{
+		MaxCollectionsPerDatabase: config.MaxCollectionsPerDatabase,
+		MaxMetadataKeys:           config.MaxMetadataKeys,
+		MaxMetadataKeyLength:      config.MaxMetadataKeyLength,
+		MaxMetadataSizeBytes:      config.MaxMetadataSizeBytes,
+		MaxReplicationFactor:      config.MaxReplicationFactor,
+		ConfirmationTokenTTL:      config.ConfirmationTokenTTL,
+		ConfirmationTokenKeys:     config.ConfirmationTokenKeys,
+		FlushCompactionMaxRetries: config.FlushCompactionMaxRetries,
+
+		MaintenanceWindowStartHour: config.MaintenanceWindowStartHour,
+		MaintenanceWindowDuration:  config.MaintenanceWindowDuration,
+		MaintenanceVacuum:          config.MaintenanceVacuum,
+		MaintenanceTables:          config.MaintenanceTables,
+
+		LeaderLeaseTTL: config.LeaderLeaseTTL,
+		LeaderID:       config.LeaderID,
+		LeaderAddress:  config.LeaderAddress,
+		ReadOnly:       config.ReadOnly,
+
+		DecommissionDrainPeriod: config.DecommissionDrainPeriod,
+		HeartbeatTimeout:        config.HeartbeatTimeout,
+
+		QuerySampleRate:       config.QuerySampleRate,
+		QuerySampleBufferSize: config.QuerySampleBufferSize,
+
+		LogServiceAddress: config.LogServiceAddress,
+		LogLagCacheTTL:    config.LogLagCacheTTL,
+
+		LogOffsetReconcileInterval: config.LogOffsetReconcileInterval,
+
+		CollectionStatsRefreshInterval: config.CollectionStatsRefreshInterval,
+		CollectionSizeHistoryRetention: config.CollectionSizeHistoryRetention,
+
+		SoftDeleteRetention:          config.SoftDeleteRetention,
+		SoftDeleteCleanerInterval:    config.SoftDeleteCleanerInterval,
+		SoftDeleteCleanerBatchSize:   config.SoftDeleteCleanerBatchSize,
+		SoftDeleteCleanerMaxAttempts: config.SoftDeleteCleanerMaxAttempts,
+		JobWorkerConcurrency:         config.JobWorkerConcurrency,
+		JobPollInterval:              config.JobPollInterval,
+		ScanCollectionsRate:          config.ScanCollectionsRate,
+
+		FeatureFlagCacheTTL: config.FeatureFlagCacheTTL,
+
+		MaintenanceModeCacheTTL: config.MaintenanceModeCacheTTL,
+
+		TenantNaming:     tenantNaming,
+		DatabaseNaming:   databaseNaming,
+		CollectionNaming: collectionNaming,
+
+		PreWriteHooks:      config.PreWriteHooks,
+		PostCommitHooks:    config.PostCommitHooks,
+		OutboxPollInterval: config.OutboxPollInterval,
+
+		TenantLastCompactionFlushInterval: config.TenantLastCompactionFlushInterval,
+		TenantLastCompactionMaxPending:    config.TenantLastCompactionMaxPending,
+	}
+	coordinator, err := coordinator.NewCoordinatorWithConfig(ctx, coordinatorConfig, db, notificationStore, notifier)
 	if err != nil {
 		return nil, err
 	}
@@ -118,17 +306,22 @@ func NewWithGrpcProvider(config Config, provider grpcutils.GrpcProvider, db *gor
 	if !config.Testing {
 		namespace := config.KubernetesNamespace
 		// Create memberlist manager for query service
-		queryMemberlistManager, err := createMemberlistManager(namespace, config.QueryServiceMemberlistName, config.QueryServicePodLabel, config.WatchInterval, config.ReconcileInterval, config.ReconcileCount)
+		queryMemberlistManager, queryMemberlistStore, err := createMemberlistManager(namespace, config.QueryServiceMemberlistName, config.QueryServicePodLabel, config.WatchInterval, config.ReconcileInterval, config.ReconcileCount)
 		if err != nil {
 			return nil, err
 		}
 
 		// Create memberlist manager for compaction service
-		compactionMemberlistManager, err := createMemberlistManager(namespace, config.CompactionServiceMemberlistName, config.CompactionServicePodLabel, config.WatchInterval, config.ReconcileInterval, config.ReconcileCount)
+		compactionMemberlistManager, _, err := createMemberlistManager(namespace, config.CompactionServiceMemberlistName, config.CompactionServicePodLabel, config.WatchInterval, config.ReconcileInterval, config.ReconcileCount)
 		if err != nil {
 			return nil, err
 		}
 
+		// collections are placed on the members of the query memberlist, which
+		// marks the decommissioned nodes draining and then leaves them out
+		coordinator.SetQueryMemberlist(queryMemberlistStore.ListMemberIDs)
+		queryMemberlistManager.SetDecommissionedMembers(coordinator.DecommissionedMembers)
+
 		// Start the memberlist manager for query service
 		err = queryMemberlistManager.Start()
 		if err != nil {
@@ -140,6 +333,27 @@ func NewWithGrpcProvider(config Config, provider grpcutils.GrpcProvider, db *gor
 			return nil, err
 		}
 
+		config.GrpcConfig.UnaryInterceptors = append(config.GrpcConfig.UnaryInterceptors, s.leaderInterceptor, s.maintenanceInterceptor)
+		config.GrpcConfig.StreamInterceptors = append(config.GrpcConfig.StreamInterceptors, s.leaderStreamInterceptor, s.maintenanceStreamInterceptor)
+		// the tenant limit is checked first, so that the requests of a tenant
+		// over its limit do not wait for the admin slots
+		tenantLimiter, err := newTenantLimiter(config.MaxConcurrentRequestsPerTenant)
+		if err != nil {
+			return nil, err
+		}
+		if tenantLimiter != nil {
+			config.GrpcConfig.UnaryInterceptors = append(config.GrpcConfig.UnaryInterceptors, tenantLimiter.interceptor)
+		}
+		admission := newPriorityAdmission(config.MaxConcurrentCompactionRequests, config.MaxConcurrentAdminRequests)
+		config.GrpcConfig.UnaryInterceptors = append(config.GrpcConfig.UnaryInterceptors, admission.interceptor)
+		config.GrpcConfig.StreamInterceptors = append(config.GrpcConfig.StreamInterceptors, admission.streamInterceptor)
+		loadShedder, err := newLoadShedder(config.MaxInFlightWriteTx, config.MaxPoolWait)
+		if err != nil {
+			return nil, err
+		}
+		if loadShedder != nil {
+			config.GrpcConfig.UnaryInterceptors = append(config.GrpcConfig.UnaryInterceptors, loadShedder.interceptor)
+		}
 		s.grpcServer, err = provider.StartGrpcServer("coordinator", config.GrpcConfig, func(registrar grpc.ServiceRegistrar) {
 			coordinatorpb.RegisterSysDBServer(registrar, s)
 		})
@@ -150,22 +364,36 @@ func NewWithGrpcProvider(config Config, provider grpcutils.GrpcProvider, db *gor
 	return s, nil
 }
 
-func createMemberlistManager(namespace string, memberlistName string, podLabel string, watchInterval time.Duration, reconcileInterval time.Duration, reconcileCount uint) (*memberlist_manager.MemberlistManager, error) {
+// namingPolicy applies the reserved prefixes and the maximum length, which are
+// shared by tenants, databases and collections, along with pattern.
+func namingPolicy(config Config, pattern string) (common.NamingPolicy, error) {
+	compiled, err := common.CompileNamePattern(pattern)
+	if err != nil {
+		return common.NamingPolicy{}, err
+	}
+	return common.NamingPolicy{
+		ReservedPrefixes: config.ReservedNamePrefixes,
+		Pattern:          compiled,
+		MaxLength:        config.MaxNameLength,
+	}, nil
+}
+
+func createMemberlistManager(namespace string, memberlistName string, podLabel string, watchInterval time.Duration, reconcileInterval time.Duration, reconcileCount uint) (*memberlist_manager.MemberlistManager, *memberlist_manager.CRMemberlistStore, error) {
 	log.Info("Creating memberlist manager for {}", zap.String("memberlist", memberlistName))
 	clientset, err := utils.GetKubernetesInterface()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	dynamicClient, err := utils.GetKubernetesDynamicInterface()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	nodeWatcher := memberlist_manager.NewKubernetesWatcher(clientset, namespace, podLabel, watchInterval)
 	memberlistStore := memberlist_manager.NewCRMemberlistStore(dynamicClient, namespace, memberlistName)
 	memberlist_manager := memberlist_manager.NewMemberlistManager(nodeWatcher, memberlistStore)
 	memberlist_manager.SetReconcileInterval(reconcileInterval)
 	memberlist_manager.SetReconcileCount(reconcileCount)
-	return memberlist_manager, nil
+	return memberlist_manager, memberlistStore, nil
 }
 
 func (s *Server) Close() error {
@@ -11,6 +11,7 @@ import (
 	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/chroma-core/chroma/go/pkg/types"
 )
 
 func (s *Server) CreateDatabase(ctx context.Context, req *coordinatorpb.CreateDatabaseRequest) (*coordinatorpb.CreateDatabaseResponse, error) {
@@ -20,16 +21,29 @@ func (s *Server) CreateDatabase(ctx context.Context, req *coordinatorpb.CreateDa
 		Name:   req.GetName(),
 		Tenant: req.GetTenant(),
 	}
-	_, err := s.coordinator.CreateDatabase(ctx, createDatabase)
+	database, err := s.coordinator.CreateDatabase(ctx, createDatabase)
 	if err != nil {
 		if errors.Is(err, common.ErrDatabaseUniqueConstraintViolation) {
 			res.Status = failResponseWithError(err, 409)
 			return res, err
 		}
+		if common.IsNameError(err) {
+			res.Status = failResponseWithError(err, 400)
+			return res, nil
+		}
+		if common.IsWriteRejected(err) {
+			res.Status = failResponseWithError(err, 403)
+			return res, nil
+		}
 
 		res.Status = failResponseWithError(err, errorCode)
 		return res, nil
 	}
+	res.Database = &coordinatorpb.Database{
+		Id:     database.ID,
+		Name:   database.Name,
+		Tenant: database.Tenant,
+	}
 	res.Status = setResponseStatus(successCode)
 	return res, nil
 }
@@ -37,6 +51,7 @@ func (s *Server) CreateDatabase(ctx context.Context, req *coordinatorpb.CreateDa
 func (s *Server) GetDatabase(ctx context.Context, req *coordinatorpb.GetDatabaseRequest) (*coordinatorpb.GetDatabaseResponse, error) {
 	res := &coordinatorpb.GetDatabaseResponse{}
 	getDatabase := &model.GetDatabase{
+		ID:     req.GetId(),
 		Name:   req.GetName(),
 		Tenant: req.GetTenant(),
 	}
@@ -47,6 +62,7 @@ func (s *Server) GetDatabase(ctx context.Context, req *coordinatorpb.GetDatabase
 			return res, nil
 		}
 		res.Status = failResponseWithError(err, errorCode)
+		return res, nil
 	}
 	res.Database = &coordinatorpb.Database{
 		Id:     database.ID,
@@ -60,7 +76,8 @@ func (s *Server) GetDatabase(ctx context.Context, req *coordinatorpb.GetDatabase
 func (s *Server) CreateTenant(ctx context.Context, req *coordinatorpb.CreateTenantRequest) (*coordinatorpb.CreateTenantResponse, error) {
 	res := &coordinatorpb.CreateTenantResponse{}
 	createTenant := &model.CreateTenant{
-		Name: req.GetName(),
+		Name:     req.GetName(),
+		KmsKeyID: req.KmsKeyId,
 	}
 	_, err := s.coordinator.CreateTenant(ctx, createTenant)
 	if err != nil {
@@ -68,9 +85,59 @@ func (s *Server) CreateTenant(ctx context.Context, req *coordinatorpb.CreateTena
 			res.Status = failResponseWithError(err, 409)
 			return res, nil
 		}
+		if common.IsNameError(err) {
+			res.Status = failResponseWithError(err, 400)
+			return res, nil
+		}
+		if common.IsWriteRejected(err) {
+			res.Status = failResponseWithError(err, 403)
+			return res, nil
+		}
+		res.Status = failResponseWithError(err, errorCode)
+		return res, nil
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+// BatchCreateTenants reports the outcome of every tenant in its results, the
+// status of the response only fails when the batch as a whole is rejected.
+func (s *Server) BatchCreateTenants(ctx context.Context, req *coordinatorpb.BatchCreateTenantsRequest) (*coordinatorpb.BatchCreateTenantsResponse, error) {
+	res := &coordinatorpb.BatchCreateTenantsResponse{}
+	tenants := make([]*model.BatchCreateTenant, 0, len(req.GetTenants()))
+	for _, tenantpb := range req.GetTenants() {
+		tenant := &model.BatchCreateTenant{
+			Name:         tenantpb.GetName(),
+			KmsKeyID:     tenantpb.KmsKeyId,
+			DatabaseName: tenantpb.GetDatabase(),
+		}
+		if tenantpb.GetQuota() != nil {
+			tenant.Quota = convertTenantQuotaToModel(tenant.Name, tenantpb.GetQuota())
+		}
+		tenants = append(tenants, tenant)
+	}
+	results, err := s.coordinator.BatchCreateTenants(ctx, tenants)
+	if err != nil {
+		if err == common.ErrTenantBatchTooLarge || err == common.ErrInvalidTenantBatch || err == common.ErrInvalidTenantQuota || common.IsNameError(err) {
+			res.Status = failResponseWithError(err, 400)
+			return res, nil
+		}
+		if common.IsWriteRejected(err) {
+			res.Status = failResponseWithError(err, 403)
+			return res, nil
+		}
 		res.Status = failResponseWithError(err, errorCode)
 		return res, nil
 	}
+	for _, result := range results {
+		status := setResponseStatus(successCode)
+		if result.Err == common.ErrTenantUniqueConstraintViolation || result.Err == common.ErrDatabaseUniqueConstraintViolation {
+			status = failResponseWithError(result.Err, 409)
+		} else if result.Err != nil {
+			status = failResponseWithError(result.Err, errorCode)
+		}
+		res.Results = append(res.Results, &coordinatorpb.TenantResult{Tenant: result.Name, Status: status})
+	}
 	res.Status = setResponseStatus(successCode)
 	return res, nil
 }
@@ -90,12 +157,107 @@ func (s *Server) GetTenant(ctx context.Context, req *coordinatorpb.GetTenantRequ
 		return res, nil
 	}
 	res.Tenant = &coordinatorpb.Tenant{
-		Name: tenant.Name,
+		Name:     tenant.Name,
+		KmsKeyId: tenant.KmsKeyID,
 	}
 	res.Status = setResponseStatus(successCode)
 	return res, nil
 }
 
+func (s *Server) DeleteTenant(ctx context.Context, req *coordinatorpb.DeleteTenantRequest) (*coordinatorpb.DeleteTenantResponse, error) {
+	res := &coordinatorpb.DeleteTenantResponse{}
+	deleteTenant := &model.DeleteTenant{
+		Name:              req.GetName(),
+		Force:             req.GetForce(),
+		ConfirmationToken: req.GetConfirmationToken(),
+		Actor:             req.GetActor(),
+	}
+	var err error
+	if req.GetAsync() {
+		var job *model.Job
+		job, err = s.coordinator.DeleteTenantAsync(ctx, deleteTenant)
+		if job != nil {
+			res.JobId = job.ID.String()
+		}
+	} else {
+		err = s.coordinator.DeleteTenant(ctx, deleteTenant)
+	}
+	if err != nil {
+		log.Error("error DeleteTenant", zap.String("tenant", req.GetName()), zap.Bool("async", req.GetAsync()), zap.Error(err))
+		res.Status = deleteTenantFailure(err)
+		return res, nil
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+// DeleteTenantWithProgress deletes a tenant like DeleteTenant, streaming the
+// collections purged so far. The last message carries the status.
+func (s *Server) DeleteTenantWithProgress(req *coordinatorpb.DeleteTenantRequest, stream coordinatorpb.SysDB_DeleteTenantWithProgressServer) error {
+	res := &coordinatorpb.DeleteTenantProgress{}
+	if req.GetAsync() {
+		res.Status = failResponseWithError(common.ErrDeleteTenantWithProgressAsync, 400)
+		return stream.Send(res)
+	}
+	deleteTenant := &model.DeleteTenant{
+		Name:              req.GetName(),
+		Force:             req.GetForce(),
+		ConfirmationToken: req.GetConfirmationToken(),
+		Actor:             req.GetActor(),
+	}
+	err := s.coordinator.DeleteTenantWithProgress(stream.Context(), deleteTenant, func(progress *model.OperationProgress) error {
+		res.Progress = convertOperationProgressToProto(progress)
+		return stream.Send(&coordinatorpb.DeleteTenantProgress{Progress: res.Progress})
+	})
+	if err != nil {
+		log.Error("error DeleteTenantWithProgress", zap.String("tenant", req.GetName()), zap.Error(err))
+		res.Status = deleteTenantFailure(err)
+		return stream.Send(res)
+	}
+	res.Status = setResponseStatus(successCode)
+	return stream.Send(res)
+}
+
+func deleteTenantFailure(err error) *coordinatorpb.Status {
+	switch {
+	case err == common.ErrTenantNotFound:
+		return failResponseWithError(err, 404)
+	case err == common.ErrTenantNotEmpty:
+		return failResponseWithError(err, 409)
+	case err == common.ErrInvalidConfirmationToken || common.IsWriteRejected(err):
+		return failResponseWithError(err, 403)
+	case err == common.ErrJobWorkersDisabled:
+		return failResponseWithError(err, 400)
+	default:
+		return failResponseWithError(err, errorCode)
+	}
+}
+
+func (s *Server) RequestConfirmationToken(ctx context.Context, req *coordinatorpb.RequestConfirmationTokenRequest) (*coordinatorpb.RequestConfirmationTokenResponse, error) {
+	res := &coordinatorpb.RequestConfirmationTokenResponse{}
+	var operation model.DestructiveOperation
+	switch req.GetOperation() {
+	case coordinatorpb.DestructiveOperation_FORCE_DELETE_TENANT:
+		operation = model.ForceDeleteTenant
+	default:
+		res.Status = failResponseWithError(common.ErrUnknownDestructiveOperation, 400)
+		return res, nil
+	}
+	token, err := s.coordinator.RequestConfirmationToken(ctx, operation, req.GetTarget())
+	if err != nil {
+		if err == common.ErrUnknownDestructiveOperation {
+			res.Status = failResponseWithError(err, 400)
+			return res, nil
+		}
+		res.Status = failResponseWithError(err, errorCode)
+		return res, nil
+	}
+	res.Token = token.Token
+	res.ExpiresAt = token.ExpiresAt
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
 func (s *Server) SetLastCompactionTimeForTenant(ctx context.Context, req *coordinatorpb.SetLastCompactionTimeForTenantRequest) (*emptypb.Empty, error) {
 	err := s.coordinator.SetTenantLastCompactionTime(ctx, req.TenantLastCompactionTime.TenantId, req.TenantLastCompactionTime.LastCompactionTime)
 	if err != nil {
@@ -121,3 +283,200 @@ func (s *Server) GetLastCompactionTimeForTenant(ctx context.Context, req *coordi
 	}
 	return res, nil
 }
+
+func (s *Server) SetTenantQuota(ctx context.Context, req *coordinatorpb.SetTenantQuotaRequest) (*coordinatorpb.SetTenantQuotaResponse, error) {
+	res := &coordinatorpb.SetTenantQuotaResponse{}
+	if req.GetQuota() == nil {
+		res.Status = failResponseWithError(common.ErrInvalidTenantQuota, 400)
+		return res, nil
+	}
+	quota := convertTenantQuotaToModel(req.GetTenant(), req.GetQuota())
+	err := s.coordinator.SetTenantQuota(ctx, quota)
+	if err != nil {
+		log.Error("error setting tenant quota", zap.String("tenant", req.GetTenant()), zap.Error(err))
+		if err == common.ErrInvalidTenantQuota {
+			res.Status = failResponseWithError(err, 400)
+		} else if err == common.ErrTenantNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) GetTenantQuota(ctx context.Context, req *coordinatorpb.GetTenantQuotaRequest) (*coordinatorpb.GetTenantQuotaResponse, error) {
+	res := &coordinatorpb.GetTenantQuotaResponse{}
+	quota, err := s.coordinator.GetTenantQuota(ctx, req.GetTenant())
+	if err != nil {
+		log.Error("error getting tenant quota", zap.String("tenant", req.GetTenant()), zap.Error(err))
+		res.Status = failResponseWithError(err, errorCode)
+		return res, nil
+	}
+	res.Quota = convertTenantQuotaToProto(quota)
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) SetTenantPlacement(ctx context.Context, req *coordinatorpb.SetTenantPlacementRequest) (*coordinatorpb.SetTenantPlacementResponse, error) {
+	res := &coordinatorpb.SetTenantPlacementResponse{}
+	placement := convertTenantPlacementToModel(req.GetTenant(), req.GetPlacement())
+	err := s.coordinator.SetTenantPlacement(ctx, placement)
+	if err != nil {
+		log.Error("error setting tenant placement", zap.String("tenant", req.GetTenant()), zap.Error(err))
+		if err == common.ErrInvalidTenantPlacement {
+			res.Status = failResponseWithError(err, 400)
+		} else if err == common.ErrTenantNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) GetTenantPlacement(ctx context.Context, req *coordinatorpb.GetTenantPlacementRequest) (*coordinatorpb.GetTenantPlacementResponse, error) {
+	res := &coordinatorpb.GetTenantPlacementResponse{}
+	placement, err := s.coordinator.GetTenantPlacement(ctx, req.GetTenant())
+	if err != nil {
+		log.Error("error getting tenant placement", zap.String("tenant", req.GetTenant()), zap.Error(err))
+		res.Status = failResponseWithError(err, errorCode)
+		return res, nil
+	}
+	res.Placement = convertTenantPlacementToProto(placement)
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) GetTenantActivity(ctx context.Context, req *coordinatorpb.GetTenantActivityRequest) (*coordinatorpb.GetTenantActivityResponse, error) {
+	res := &coordinatorpb.GetTenantActivityResponse{}
+	days, total, err := s.coordinator.GetTenantActivity(ctx, req.GetTenant(), req.GetDays())
+	if err != nil {
+		log.Error("error getting tenant activity", zap.String("tenant", req.GetTenant()), zap.Int32("days", req.GetDays()), zap.Error(err))
+		if err == common.ErrInvalidTenantActivityWindow {
+			res.Status = failResponseWithError(err, 400)
+		} else if err == common.ErrTenantNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Days = make([]*coordinatorpb.TenantActivity, 0, len(days))
+	for _, activity := range days {
+		res.Days = append(res.Days, convertTenantActivityToProto(activity))
+	}
+	res.Total = convertTenantActivityToProto(total)
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) CheckTenantPlacement(ctx context.Context, req *coordinatorpb.CheckTenantPlacementRequest) (*coordinatorpb.CheckTenantPlacementResponse, error) {
+	res := &coordinatorpb.CheckTenantPlacementResponse{}
+	placement, err := s.coordinator.GetTenantPlacement(ctx, req.GetTenant())
+	if err != nil {
+		log.Error("error checking tenant placement", zap.String("tenant", req.GetTenant()), zap.String("region", req.GetRegion()), zap.Error(err))
+		res.Status = failResponseWithError(err, errorCode)
+		return res, nil
+	}
+	res.Allowed = placement.Allows(req.GetRegion())
+	res.Placement = convertTenantPlacementToProto(placement)
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) CheckQuota(ctx context.Context, req *coordinatorpb.CheckQuotaRequest) (*coordinatorpb.CheckQuotaResponse, error) {
+	res := &coordinatorpb.CheckQuotaResponse{}
+	delta := &model.QuotaDelta{
+		Collections: req.GetCollectionsDelta(),
+		Records:     req.GetRecordsDelta(),
+		SizeBytes:   req.GetSizeBytesDelta(),
+	}
+	if req.CollectionId != nil {
+		collectionID, err := types.ToUniqueID(req.CollectionId)
+		if err != nil {
+			log.Error("collection id format error", zap.String("collection.id", req.GetCollectionId()))
+			res.Status = failResponseWithError(common.ErrCollectionIDFormat, 400)
+			return res, nil
+		}
+		delta.CollectionID = &collectionID
+	}
+	check, err := s.coordinator.CheckQuota(ctx, req.GetTenant(), req.GetDatabase(), delta)
+	if err != nil {
+		log.Error("error checking quota", zap.String("tenant", req.GetTenant()), zap.Error(err))
+		if err == common.ErrDatabaseNotFound || err == common.ErrCollectionNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Allowed = check.Allowed()
+	for _, violation := range check.Violations {
+		protoViolation := &coordinatorpb.QuotaViolation{
+			Resource: string(violation.Resource),
+			Limit:    violation.Limit,
+			Usage:    violation.Usage,
+			Delta:    violation.Delta,
+			Database: violation.DatabaseName,
+		}
+		if violation.CollectionID != nil {
+			collectionID := violation.CollectionID.String()
+			protoViolation.CollectionId = &collectionID
+		}
+		res.Violations = append(res.Violations, protoViolation)
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) SetDatabaseQuota(ctx context.Context, req *coordinatorpb.SetDatabaseQuotaRequest) (*coordinatorpb.SetDatabaseQuotaResponse, error) {
+	res := &coordinatorpb.SetDatabaseQuotaResponse{}
+	if req.GetQuota() == nil {
+		res.Status = failResponseWithError(common.ErrInvalidTenantQuota, 400)
+		return res, nil
+	}
+	quota := convertDatabaseQuotaToModel(req.GetTenant(), req.GetDatabase(), req.GetQuota())
+	err := s.coordinator.SetDatabaseQuota(ctx, quota)
+	if err != nil {
+		log.Error("error setting database quota", zap.String("tenant", req.GetTenant()), zap.String("database", req.GetDatabase()), zap.Error(err))
+		if err == common.ErrInvalidTenantQuota {
+			res.Status = failResponseWithError(err, 400)
+		} else if err == common.ErrDatabaseNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
+
+func (s *Server) GetEffectiveQuota(ctx context.Context, req *coordinatorpb.GetEffectiveQuotaRequest) (*coordinatorpb.GetEffectiveQuotaResponse, error) {
+	res := &coordinatorpb.GetEffectiveQuotaResponse{}
+	quota, err := s.coordinator.GetEffectiveQuota(ctx, req.GetTenant(), req.GetDatabase())
+	if err != nil {
+		log.Error("error getting effective quota", zap.String("tenant", req.GetTenant()), zap.String("database", req.GetDatabase()), zap.Error(err))
+		if err == common.ErrDatabaseNotFound {
+			res.Status = failResponseWithError(err, 404)
+		} else {
+			res.Status = failResponseWithError(err, errorCode)
+		}
+		return res, nil
+	}
+	res.Quota = &coordinatorpb.TenantQuota{
+		MaxCollections: quota.MaxCollections,
+		MaxRecords:     quota.MaxRecords,
+		MaxSizeBytes:   quota.MaxSizeBytes,
+	}
+	for _, resource := range quota.Overridden {
+		res.Overridden = append(res.Overridden, string(resource))
+	}
+	res.Status = setResponseStatus(successCode)
+	return res, nil
+}
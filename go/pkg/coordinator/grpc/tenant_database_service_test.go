@@ -13,6 +13,7 @@ import (
 	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
 	"github.com/pingcap/log"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/genproto/googleapis/rpc/code"
 	codes "google.golang.org/grpc/codes"
@@ -105,3 +106,13 @@ func TestTenantDatabaseServiceTestSuite(t *testing.T) {
 	testSuite := new(TenantDatabaseServiceTestSuite)
 	suite.Run(t, testSuite)
 }
+
+func TestSetTenantQuotaWithoutQuota(t *testing.T) {
+	s := &Server{}
+	res, err := s.SetTenantQuota(context.Background(), &coordinatorpb.SetTenantQuotaRequest{Tenant: "tenant"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(400), res.Status.Code)
+	databaseRes, err := s.SetDatabaseQuota(context.Background(), &coordinatorpb.SetDatabaseQuotaRequest{Tenant: "tenant", Database: "database"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(400), databaseRes.Status.Code)
+}
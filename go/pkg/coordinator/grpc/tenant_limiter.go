@@ -0,0 +1,116 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/grpcutils"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// tenantLimitRetryDelay is how long a client is asked to wait before retrying a
+// request rejected by the tenant limiter.
+const tenantLimitRetryDelay = time.Second
+
+// tenantLimitedMethods are the RPCs that are expensive enough for their
+// concurrency to be bounded per tenant, each with whether a request is
+// expensive. The requests are keyed by their tenant field, the ones that span
+// every tenant share the key of the empty tenant.
+var tenantLimitedMethods = map[string]func(req any) bool{
+	coordinatorpb.SysDB_DeleteCollections_FullMethodName:         alwaysLimited,
+	coordinatorpb.SysDB_GetCollectionsBySize_FullMethodName:      alwaysLimited,
+	coordinatorpb.SysDB_ListCollectionStats_FullMethodName:       alwaysLimited,
+	coordinatorpb.SysDB_GetStorageAttribution_FullMethodName:     alwaysLimited,
+	coordinatorpb.SysDB_ListDuplicateSegmentFiles_FullMethodName: alwaysLimited,
+	coordinatorpb.SysDB_GetTenantActivity_FullMethodName:         alwaysLimited,
+	coordinatorpb.SysDB_GetCollections_FullMethodName:            isFullCollectionList,
+}
+
+func alwaysLimited(any) bool { return true }
+
+// isFullCollectionList tells whether a GetCollections lists every collection
+// of its tenant or database at once, rather than looking collections up or
+// reading a page.
+func isFullCollectionList(req any) bool {
+	r, ok := req.(*coordinatorpb.GetCollectionsRequest)
+	return ok && r.Id == nil && r.Name == nil && len(r.Ids) == 0 && r.Limit == nil
+}
+
+type tenantRequest interface {
+	GetTenant() string
+}
+
+// tenantLimiter rejects the expensive requests of a tenant that already has
+// maxConcurrent of them running, so that a single tenant cannot take all the
+// workers and connections of the coordinator. Rejected requests are retryable.
+type tenantLimiter struct {
+	maxConcurrent int
+	rejected      metric.Int64Counter
+
+	mu      sync.Mutex
+	running map[string]int
+}
+
+// newTenantLimiter returns nil when maxConcurrent does not enable the limit.
+func newTenantLimiter(maxConcurrent int) (*tenantLimiter, error) {
+	if maxConcurrent <= 0 {
+		return nil, nil
+	}
+	rejected, err := otel.Meter("chroma.coordinator").Int64Counter("sysdb.tenant_limit.rejected",
+		metric.WithDescription("Number of expensive requests rejected because their tenant had too many of them running"))
+	if err != nil {
+		return nil, err
+	}
+	return &tenantLimiter{
+		maxConcurrent: maxConcurrent,
+		rejected:      rejected,
+		running:       make(map[string]int),
+	}, nil
+}
+
+func (l *tenantLimiter) acquire(tenant string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.running[tenant] >= l.maxConcurrent {
+		return false
+	}
+	l.running[tenant]++
+	return true
+}
+
+func (l *tenantLimiter) release(tenant string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	// tenants are dropped once idle, so the map does not grow with every tenant
+	// that ever sent an expensive request
+	if l.running[tenant] <= 1 {
+		delete(l.running, tenant)
+	} else {
+		l.running[tenant]--
+	}
+}
+
+func (l *tenantLimiter) interceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	expensive, ok := tenantLimitedMethods[info.FullMethod]
+	if !ok || !expensive(req) {
+		return handler(ctx, req)
+	}
+	var tenant string
+	if r, ok := req.(tenantRequest); ok {
+		tenant = r.GetTenant()
+	}
+	if !l.acquire(tenant) {
+		log.Warn("rejecting expensive request, tenant has too many running", zap.String("method", info.FullMethod), zap.String("tenant", tenant), zap.Int("maxConcurrent", l.maxConcurrent))
+		l.rejected.Add(ctx, 1, metric.WithAttributes(attribute.String("rpc.method", info.FullMethod)))
+		return nil, grpcutils.BuildOverloadedGrpcError(tenantLimitRetryDelay)
+	}
+	defer l.release(tenant)
+	return handler(ctx, req)
+}
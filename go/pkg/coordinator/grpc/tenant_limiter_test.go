@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTenantLimiter(t *testing.T) {
+	limiter, err := newTenantLimiter(1)
+	assert.NoError(t, err)
+	release := make(chan struct{})
+	started := make(chan struct{})
+	blocking := func(ctx context.Context, req any) (any, error) {
+		close(started)
+		<-release
+		return "ok", nil
+	}
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+	deleteCollections := &grpc.UnaryServerInfo{FullMethod: coordinatorpb.SysDB_DeleteCollections_FullMethodName}
+	getCollections := &grpc.UnaryServerInfo{FullMethod: coordinatorpb.SysDB_GetCollections_FullMethodName}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		limiter.interceptor(context.Background(), &coordinatorpb.DeleteCollectionsRequest{Tenant: "a"}, deleteCollections, blocking)
+	}()
+	<-started
+
+	// a second expensive request of the tenant is rejected, a retryable error
+	_, err = limiter.interceptor(context.Background(), &coordinatorpb.GetCollectionsRequest{Tenant: "a"}, getCollections, handler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// the other tenants and the cheap requests of the tenant are not limited
+	_, err = limiter.interceptor(context.Background(), &coordinatorpb.DeleteCollectionsRequest{Tenant: "b"}, deleteCollections, handler)
+	assert.NoError(t, err)
+	id := "00000000-0000-0000-0000-000000000001"
+	_, err = limiter.interceptor(context.Background(), &coordinatorpb.GetCollectionsRequest{Tenant: "a", Id: &id}, getCollections, handler)
+	assert.NoError(t, err)
+
+	close(release)
+	<-done
+	_, err = limiter.interceptor(context.Background(), &coordinatorpb.GetCollectionsRequest{Tenant: "a"}, getCollections, handler)
+	assert.NoError(t, err)
+	assert.Empty(t, limiter.running)
+}
+
+func TestNewTenantLimiterDisabled(t *testing.T) {
+	limiter, err := newTenantLimiter(0)
+	assert.NoError(t, err)
+	assert.Nil(t, limiter)
+}
@@ -0,0 +1,305 @@
+package coordinator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/notification"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// WriteOperation is a mutating operation of the coordinator that runs the
+// pre-write hooks.
+type WriteOperation string
+
+// The Request of a WriteRequest for each operation.
+const (
+	// *model.CreateTenant
+	WriteCreateTenant WriteOperation = "CreateTenant"
+	// []*model.BatchCreateTenant
+	WriteBatchCreateTenants WriteOperation = "BatchCreateTenants"
+	// *model.DeleteTenant
+	WriteDeleteTenant WriteOperation = "DeleteTenant"
+	// *model.CreateDatabase
+	WriteCreateDatabase WriteOperation = "CreateDatabase"
+	// *model.CreateCollection
+	WriteCreateCollection WriteOperation = "CreateCollection"
+	// *model.UpdateCollection
+	WriteUpdateCollection WriteOperation = "UpdateCollection"
+	// *model.RenameCollection
+	WriteRenameCollection WriteOperation = "RenameCollection"
+	// *model.CollectionMetadataPatch, applied to every collection of the batch.
+	// The hooks run once for every database of the batch.
+	WriteBatchUpdateCollectionMetadata WriteOperation = "BatchUpdateCollectionMetadata"
+	// *model.DeleteCollection
+	WriteDeleteCollection WriteOperation = "DeleteCollection"
+	// []types.UniqueID
+	WriteDeleteCollections WriteOperation = "DeleteCollections"
+	// *string, the name the collection is restored under, nil for its own
+	WriteRestoreCollection WriteOperation = "RestoreCollection"
+	// *model.CollectionAclEntry
+	WriteSetCollectionAclEntry WriteOperation = "SetCollectionAclEntry"
+	// *model.CollectionAclEntry, without permissions
+	WriteDeleteCollectionAclEntry WriteOperation = "DeleteCollectionAclEntry"
+	// []*model.CollectionSchemaField
+	WriteSetCollectionSchema WriteOperation = "SetCollectionSchema"
+	// string, the log address, empty to remove the route
+	WriteSetCollectionLogRoute WriteOperation = "SetCollectionLogRoute"
+	// *model.TenantQuota
+	WriteSetTenantQuota WriteOperation = "SetTenantQuota"
+	// *model.DatabaseQuota
+	WriteSetDatabaseQuota WriteOperation = "SetDatabaseQuota"
+	// *model.EmbeddingFunction
+	WriteCreateEmbeddingFunction WriteOperation = "CreateEmbeddingFunction"
+	// *model.UpdateEmbeddingFunction
+	WriteUpdateEmbeddingFunction WriteOperation = "UpdateEmbeddingFunction"
+	// types.UniqueID, the ID of the embedding function
+	WriteDeleteEmbeddingFunction WriteOperation = "DeleteEmbeddingFunction"
+	// *model.CollectionTemplate
+	WriteCreateCollectionTemplate WriteOperation = "CreateCollectionTemplate"
+	// types.UniqueID, the ID of the template
+	WriteDeleteCollectionTemplate WriteOperation = "DeleteCollectionTemplate"
+)
+
+// WriteRequest is a mutating operation about to be applied. Tenant and Database
+// are those of the collection for the operations on a collection, also when it
+// is only known by its ID. Tenant is empty for the operations that span tenants,
+// e.g. BatchCreateTenants, and Database for those that apply to a whole tenant.
+type WriteRequest struct {
+	Operation WriteOperation
+	Tenant    string
+	Database  string
+	// CollectionID is the collection of the operations on a single existing
+	// collection, nil otherwise.
+	CollectionID types.UniqueID
+	// Request is the request of the operation, see WriteOperation for its type.
+	// Hooks may change it, the operation is validated and applied as changed.
+	Request any
+}
+
+// PreWriteHook is a policy plugin run before every mutating operation of
+// WriteOperation. It rejects the operation by returning an error, which fails
+// it with a *common.WriteRejectedError.
+type PreWriteHook interface {
+	BeforeWrite(ctx context.Context, request *WriteRequest) error
+}
+
+// PreWriteHookFunc adapts a function to a PreWriteHook.
+type PreWriteHookFunc func(ctx context.Context, request *WriteRequest) error
+
+func (f PreWriteHookFunc) BeforeWrite(ctx context.Context, request *WriteRequest) error {
+	return f(ctx, request)
+}
+
+// PreWriteHookFactory creates a hook when a coordinator that enables it starts.
+type PreWriteHookFactory func() (PreWriteHook, error)
+
+// PostCommitHook is an integration plugin that sees the changes committed to the
+// catalog, e.g. to update an inventory of collections. It is fed from the
+// outbox of notifications once they are delivered, a change may be seen again
+// when a delivery is retried. Failures are logged and not retried.
+type PostCommitHook interface {
+	AfterCommit(ctx context.Context, notification model.Notification) error
+}
+
+// PostCommitHookFunc adapts a function to a PostCommitHook.
+type PostCommitHookFunc func(ctx context.Context, notification model.Notification) error
+
+func (f PostCommitHookFunc) AfterCommit(ctx context.Context, notification model.Notification) error {
+	return f(ctx, notification)
+}
+
+// PostCommitHookFactory creates a hook when a coordinator that enables it starts.
+type PostCommitHookFactory func() (PostCommitHook, error)
+
+// hookRegistry holds the hooks of one kind that plugins registered.
+type hookRegistry[F any] struct {
+	kind      string
+	mu        sync.RWMutex
+	factories map[string]F
+}
+
+func (r *hookRegistry[F]) register(name string, factory F) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.factories[name]; ok {
+		panic(fmt.Sprintf("%s hook %s is already registered", r.kind, name))
+	}
+	r.factories[name] = factory
+}
+
+func (r *hookRegistry[F]) names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.factories))
+	for name := range r.factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (r *hookRegistry[F]) get(name string) (F, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	factory, ok := r.factories[name]
+	return factory, ok
+}
+
+var (
+	preWriteHooks   = &hookRegistry[PreWriteHookFactory]{kind: "pre-write", factories: map[string]PreWriteHookFactory{}}
+	postCommitHooks = &hookRegistry[PostCommitHookFactory]{kind: "post-commit", factories: map[string]PostCommitHookFactory{}}
+)
+
+// RegisterPreWriteHook makes a hook available under name, for Config.PreWriteHooks
+// to enable it. Plugins register their hooks from an init function and are
+// compiled into the coordinator binary. It panics when name is already taken.
+func RegisterPreWriteHook(name string, factory PreWriteHookFactory) {
+	preWriteHooks.register(name, factory)
+}
+
+// PreWriteHookNames returns the names of the registered pre-write hooks, sorted.
+func PreWriteHookNames() []string {
+	return preWriteHooks.names()
+}
+
+// RegisterPostCommitHook makes a hook available under name, for
+// Config.PostCommitHooks to enable it, like RegisterPreWriteHook.
+func RegisterPostCommitHook(name string, factory PostCommitHookFactory) {
+	postCommitHooks.register(name, factory)
+}
+
+// PostCommitHookNames returns the names of the registered post-commit hooks,
+// sorted.
+func PostCommitHookNames() []string {
+	return postCommitHooks.names()
+}
+
+type namedPreWriteHook struct {
+	name string
+	hook PreWriteHook
+}
+
+// newPreWriteHooks creates the hooks enabled by names, in their order.
+func newPreWriteHooks(names []string) ([]namedPreWriteHook, error) {
+	hooks := make([]namedPreWriteHook, 0, len(names))
+	for _, name := range names {
+		factory, ok := preWriteHooks.get(name)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", common.ErrUnknownPreWriteHook, name)
+		}
+		hook, err := factory()
+		if err != nil {
+			return nil, fmt.Errorf("create pre-write hook %s: %w", name, err)
+		}
+		hooks = append(hooks, namedPreWriteHook{name: name, hook: hook})
+	}
+	if len(hooks) > 0 {
+		log.Info("pre-write hooks enabled", zap.Strings("hooks", names))
+	}
+	return hooks, nil
+}
+
+type namedPostCommitHook struct {
+	name string
+	hook PostCommitHook
+}
+
+// newPostCommitHooks creates the hooks enabled by names, in their order.
+func newPostCommitHooks(names []string) ([]namedPostCommitHook, error) {
+	hooks := make([]namedPostCommitHook, 0, len(names))
+	for _, name := range names {
+		factory, ok := postCommitHooks.get(name)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", common.ErrUnknownPostCommitHook, name)
+		}
+		hook, err := factory()
+		if err != nil {
+			return nil, fmt.Errorf("create post-commit hook %s: %w", name, err)
+		}
+		hooks = append(hooks, namedPostCommitHook{name: name, hook: hook})
+	}
+	if len(hooks) > 0 {
+		log.Info("post-commit hooks enabled", zap.Strings("hooks", names))
+	}
+	return hooks, nil
+}
+
+// postCommitNotifier runs the post-commit hooks on the notifications that the
+// notifier it wraps delivered. A failing hook does not fail the delivery, which
+// would send the notifications again, nor keep the other hooks from running.
+type postCommitNotifier struct {
+	notifier notification.Notifier
+	hooks    []namedPostCommitHook
+	failures metric.Int64Counter
+}
+
+var _ notification.Notifier = &postCommitNotifier{}
+
+func newPostCommitNotifier(notifier notification.Notifier, hooks []namedPostCommitHook) (*postCommitNotifier, error) {
+	failures, err := otel.Meter("chroma.coordinator").Int64Counter("sysdb.post_commit_hooks.failures",
+		metric.WithDescription("Number of notifications a post-commit hook failed on, by hook"))
+	if err != nil {
+		return nil, err
+	}
+	return &postCommitNotifier{notifier: notifier, hooks: hooks, failures: failures}, nil
+}
+
+func (n *postCommitNotifier) Notify(ctx context.Context, notifications []model.Notification) error {
+	if n.notifier != nil {
+		if err := n.notifier.Notify(ctx, notifications); err != nil {
+			return err
+		}
+	}
+	for _, committed := range notifications {
+		for _, h := range n.hooks {
+			if err := h.hook.AfterCommit(ctx, committed); err != nil {
+				n.failures.Add(ctx, 1, metric.WithAttributes(attribute.String("hook", h.name)))
+				log.Error("post-commit hook failed", zap.String("hook", h.name), zap.Int64("notificationID", committed.ID),
+					zap.String("collectionID", committed.CollectionID), zap.String("type", committed.Type), zap.Error(err))
+			}
+		}
+	}
+	return nil
+}
+
+// beforeWrite runs the pre-write hooks in order, the first hook to fail rejects
+// the operation and the hooks after it do not run.
+func (s *Coordinator) beforeWrite(ctx context.Context, request *WriteRequest) error {
+	for _, h := range s.preWriteHooks {
+		if err := h.hook.BeforeWrite(ctx, request); err != nil {
+			log.Info("write rejected by pre-write hook", zap.String("hook", h.name), zap.String("operation", string(request.Operation)),
+				zap.String("tenant", request.Tenant), zap.String("database", request.Database), zap.Error(err))
+			return &common.WriteRejectedError{Hook: h.name, Err: err}
+		}
+	}
+	return nil
+}
+
+// beforeCollectionWrite runs the pre-write hooks on an operation on the
+// collection of request.CollectionID. The tenant and database of the collection
+// are looked up when the request does not name them, and only when hooks are
+// enabled.
+func (s *Coordinator) beforeCollectionWrite(ctx context.Context, request *WriteRequest) error {
+	if len(s.preWriteHooks) == 0 {
+		return nil
+	}
+	if request.Tenant == "" || request.Database == "" {
+		database, err := s.catalog.GetCollectionDatabase(ctx, request.CollectionID)
+		if err != nil {
+			return err
+		}
+		request.Tenant = database.Tenant
+		request.Database = database.Name
+	}
+	return s.beforeWrite(ctx, request)
+}
@@ -0,0 +1,130 @@
+package coordinator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/mocks"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var errTenantBlocked = errors.New("tenant is blocked")
+
+func init() {
+	// labels new collections with their owner team
+	RegisterPreWriteHook("test-label", func() (PreWriteHook, error) {
+		return PreWriteHookFunc(func(ctx context.Context, request *WriteRequest) error {
+			if createCollection, ok := request.Request.(*model.CreateCollection); ok {
+				if createCollection.Metadata == nil {
+					createCollection.Metadata = model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+				}
+				createCollection.Metadata.Add("team", &model.CollectionMetadataValueStringType{Value: "search"})
+			}
+			return nil
+		}), nil
+	})
+	RegisterPreWriteHook("test-block", func() (PreWriteHook, error) {
+		return PreWriteHookFunc(func(ctx context.Context, request *WriteRequest) error {
+			if request.Tenant == "blocked" {
+				return errTenantBlocked
+			}
+			return nil
+		}), nil
+	})
+}
+
+func TestPreWriteHooks(t *testing.T) {
+	_, err := newPreWriteHooks([]string{"test-block", "missing"})
+	assert.ErrorIs(t, err, common.ErrUnknownPreWriteHook)
+	assert.Subset(t, PreWriteHookNames(), []string{"test-block", "test-label"})
+	assert.Panics(t, func() {
+		RegisterPreWriteHook("test-block", func() (PreWriteHook, error) { return nil, nil })
+	})
+
+	hooks, err := newPreWriteHooks([]string{"test-block", "test-label"})
+	assert.NoError(t, err)
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{ctx: context.Background(), catalog: catalog, preWriteHooks: hooks}
+
+	// a rejected operation is not applied
+	_, err = c.CreateCollection(context.Background(), &model.CreateCollection{ID: types.NewUniqueID(), Name: "collection", TenantID: "blocked", DatabaseName: "database"})
+	var rejected *common.WriteRejectedError
+	assert.ErrorAs(t, err, &rejected)
+	assert.Equal(t, "test-block", rejected.Hook)
+	assert.ErrorIs(t, err, errTenantBlocked)
+	_, err = c.CreateTenant(context.Background(), &model.CreateTenant{Name: "blocked"})
+	assert.True(t, common.IsWriteRejected(err))
+
+	// the operation is applied as changed by the hooks
+	catalog.On("CreateCollection", mock.Anything, mock.MatchedBy(func(createCollection *model.CreateCollection) bool {
+		team, ok := createCollection.Metadata.Get("team").(*model.CollectionMetadataValueStringType)
+		return ok && team.Value == "search"
+	}), types.Timestamp(0)).Return(&model.Collection{Name: "collection"}, nil)
+	_, err = c.CreateCollection(context.Background(), &model.CreateCollection{ID: types.NewUniqueID(), Name: "collection", TenantID: "tenant", DatabaseName: "database"})
+	assert.NoError(t, err)
+
+	// the operations on a collection known by its ID see its tenant
+	collectionID := types.NewUniqueID()
+	catalog.On("GetCollectionDatabase", mock.Anything, collectionID).Return(&model.Database{ID: "database-id", Name: "database", Tenant: "blocked"}, nil)
+	_, _, err = c.UpdateCollection(context.Background(), &model.UpdateCollection{ID: collectionID})
+	assert.ErrorIs(t, err, errTenantBlocked)
+	_, err = c.RenameCollection(context.Background(), &model.RenameCollection{ID: collectionID, Name: "renamed"})
+	assert.ErrorIs(t, err, errTenantBlocked)
+	_, err = c.RestoreCollection(context.Background(), collectionID, nil)
+	assert.ErrorIs(t, err, errTenantBlocked)
+	_, err = c.BatchUpdateCollectionMetadata(context.Background(), []types.UniqueID{collectionID}, &model.CollectionMetadataPatch{})
+	assert.ErrorIs(t, err, errTenantBlocked)
+	assert.ErrorIs(t, c.SetCollectionAclEntry(context.Background(), &model.CollectionAclEntry{CollectionID: collectionID, Principal: "user", Permissions: 1}), errTenantBlocked)
+	catalog.AssertExpectations(t)
+}
+
+type recordingNotifier struct {
+	err      error
+	notified []model.Notification
+}
+
+func (n *recordingNotifier) Notify(ctx context.Context, notifications []model.Notification) error {
+	if n.err != nil {
+		return n.err
+	}
+	n.notified = append(n.notified, notifications...)
+	return nil
+}
+
+func TestPostCommitNotifier(t *testing.T) {
+	_, err := newPostCommitHooks([]string{"missing"})
+	assert.ErrorIs(t, err, common.ErrUnknownPostCommitHook)
+
+	var seen []string
+	hooks := []namedPostCommitHook{
+		{name: "failing", hook: PostCommitHookFunc(func(ctx context.Context, notification model.Notification) error {
+			return errors.New("inventory is down")
+		})},
+		{name: "recording", hook: PostCommitHookFunc(func(ctx context.Context, notification model.Notification) error {
+			seen = append(seen, notification.CollectionID)
+			return nil
+		})},
+	}
+	inner := &recordingNotifier{err: errors.New("connection refused")}
+	notifier, err := newPostCommitNotifier(inner, hooks)
+	assert.NoError(t, err)
+	notifications := []model.Notification{
+		{ID: 1, CollectionID: "collection1", Type: model.NotificationTypeCreateCollection},
+		{ID: 2, CollectionID: "collection2", Type: model.NotificationTypeDeleteCollection},
+	}
+
+	// the hooks only see the notifications once they are delivered
+	assert.Error(t, notifier.Notify(context.Background(), notifications))
+	assert.Empty(t, seen)
+
+	// a failing hook neither fails the delivery nor skips the other hooks
+	inner.err = nil
+	assert.NoError(t, notifier.Notify(context.Background(), notifications))
+	assert.Equal(t, notifications, inner.notified)
+	assert.Equal(t, []string{"collection1", "collection2"}, seen)
+}
@@ -0,0 +1,114 @@
+package coordinator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// jobHandlers are the handlers of the job types the workers run.
+func (s *Coordinator) jobHandlers() map[string]jobHandler {
+	return map[string]jobHandler{
+		model.JobTypeDeleteTenant: s.runDeleteTenantJob,
+	}
+}
+
+// enqueueJob queues a job of jobType with payload encoded as its JSON input,
+// and wakes a worker of this replica to run it.
+func (s *Coordinator) enqueueJob(ctx context.Context, jobType string, tenantID string, payload any) (*model.Job, error) {
+	if s.jobWorker == nil {
+		return nil, common.ErrJobWorkersDisabled
+	}
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	job := &model.Job{
+		ID:          types.NewUniqueID(),
+		Type:        jobType,
+		TenantID:    tenantID,
+		Payload:     encoded,
+		State:       model.JobStatePending,
+		MaxAttempts: defaultJobMaxAttempts,
+		NextRunAt:   s.jobWorker.now(),
+	}
+	if err := s.catalog.EnqueueJob(ctx, job); err != nil {
+		return nil, err
+	}
+	log.Info("job enqueued", zap.String("jobID", job.ID.String()), zap.String("type", jobType), zap.String("tenant", tenantID))
+	s.jobWorker.notify()
+	return job, nil
+}
+
+// GetJob returns the job with its state and the error of its last attempt.
+func (s *Coordinator) GetJob(ctx context.Context, jobID types.UniqueID) (*model.Job, error) {
+	return s.catalog.GetJob(ctx, jobID)
+}
+
+// maxListJobsLimit is the largest page of jobs ListJobs returns.
+const maxListJobsLimit = 1000
+
+// ListJobs returns the jobs matching filter, e.g. the failed jobs of a tenant.
+func (s *Coordinator) ListJobs(ctx context.Context, filter *model.JobFilter) ([]*model.Job, error) {
+	if filter.State != "" && !filter.State.Valid() {
+		return nil, common.ErrInvalidJobState
+	}
+	if filter.Limit <= 0 || filter.Limit > maxListJobsLimit || filter.Offset < 0 {
+		return nil, common.ErrInvalidJobPage
+	}
+	return s.catalog.ListJobs(ctx, filter)
+}
+
+// CancelJob cancels a job that is not finished. A pending job never runs, a
+// running job is stopped when its worker next renews its lease, within a third
+// of the lease. An attempt is cut short by the cancellation of its context, so
+// the operations of a job must be transactional or resumable.
+func (s *Coordinator) CancelJob(ctx context.Context, jobID types.UniqueID) (*model.Job, error) {
+	job, err := s.catalog.CancelJob(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("job canceled", zap.String("jobID", jobID.String()), zap.String("type", job.Type), zap.String("tenant", job.TenantID))
+	return job, nil
+}
+
+// DeleteTenantAsync checks a DeleteTenant like DeleteTenant does and queues
+// the deletion as a job, for the tenants too large to delete within the
+// deadline of a call.
+func (s *Coordinator) DeleteTenantAsync(ctx context.Context, deleteTenant *model.DeleteTenant) (*model.Job, error) {
+	if err := s.verifyDeleteTenant(ctx, deleteTenant); err != nil {
+		return nil, err
+	}
+	// a tenant that does not exist is reported right away rather than by a job
+	// that succeeds without deleting anything
+	if _, err := s.catalog.GetTenants(ctx, &model.GetTenant{Name: deleteTenant.Name}, 0); err != nil {
+		return nil, err
+	}
+	return s.enqueueJob(ctx, model.JobTypeDeleteTenant, deleteTenant.Name, &model.DeleteTenantJob{
+		Name:  deleteTenant.Name,
+		Force: deleteTenant.Force,
+		Actor: deleteTenant.Actor,
+	})
+}
+
+func (s *Coordinator) runDeleteTenantJob(ctx context.Context, job *model.Job) error {
+	var payload model.DeleteTenantJob
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return permanent(err)
+	}
+	err := s.catalog.DeleteTenant(ctx, &model.DeleteTenant{Name: payload.Name, Force: payload.Force, Actor: payload.Actor})
+	if errors.Is(err, common.ErrTenantNotFound) {
+		// deleted by an earlier attempt whose outcome was not recorded
+		return nil
+	}
+	if errors.Is(err, common.ErrTenantNotEmpty) {
+		return permanent(err)
+	}
+	return err
+}
@@ -0,0 +1,232 @@
+package coordinator
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultJobPollInterval = 5 * time.Second
+	defaultJobMaxAttempts  = 5
+	// jobLease is how long a worker holds a job without renewing its lease. It
+	// is renewed every third of it while the job runs, so a job is only claimed
+	// again by another worker once its worker died or lost the database.
+	jobLease = time.Minute
+	// jobRetryBaseDelay is the delay before the retry of the first failed
+	// attempt of a job, doubled for every attempt after it up to jobRetryMaxDelay.
+	jobRetryBaseDelay = 10 * time.Second
+	jobRetryMaxDelay  = 10 * time.Minute
+)
+
+// jobHandler runs an attempt of a job. An error fails the attempt, and the
+// job is retried until it used up its attempts, unless the error is permanent.
+type jobHandler func(ctx context.Context, job *model.Job) error
+
+// permanentJobError fails a job without retrying it, for the errors retrying
+// cannot fix, such as a payload that cannot be decoded.
+type permanentJobError struct {
+	err error
+}
+
+func (e *permanentJobError) Error() string { return e.err.Error() }
+
+func (e *permanentJobError) Unwrap() error { return e.err }
+
+func permanent(err error) error {
+	return &permanentJobError{err: err}
+}
+
+// jobWorker runs the jobs of the job queue with concurrency workers, polling
+// the queue every pollInterval and right away when a job is enqueued by this
+// replica. Each job is held by the worker that claimed it, so the workers of
+// several replicas never run the same job at once.
+type jobWorker struct {
+	catalog      metastore.Catalog
+	handlers     map[string]jobHandler
+	jobTypes     []string
+	workerID     string
+	concurrency  int
+	pollInterval time.Duration
+	now          func() time.Time
+
+	attempts metric.Int64Counter
+
+	wake   chan struct{}
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// newJobWorker returns nil when the config does not enable the workers, the
+// jobs then stay pending until a replica that runs them is the leader.
+func newJobWorker(config Config, catalog metastore.Catalog, handlers map[string]jobHandler) (*jobWorker, error) {
+	if config.JobWorkerConcurrency <= 0 {
+		return nil, nil
+	}
+	pollInterval := config.JobPollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultJobPollInterval
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+	w := &jobWorker{
+		catalog:  catalog,
+		handlers: handlers,
+		// a restarted replica does not take over the jobs its previous process
+		// held before their lease expired, which may still be running
+		workerID:     hostname + "/" + types.NewUniqueID().String(),
+		concurrency:  config.JobWorkerConcurrency,
+		pollInterval: pollInterval,
+		now:          time.Now,
+		wake:         make(chan struct{}, 1),
+	}
+	for jobType := range handlers {
+		w.jobTypes = append(w.jobTypes, jobType)
+	}
+
+	w.attempts, err = otel.Meter("chroma.coordinator").Int64Counter("sysdb.jobs.attempts",
+		metric.WithDescription("Number of job attempts by job type and outcome"))
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *jobWorker) Start(ctx context.Context) {
+	ctx, w.cancel = context.WithCancel(ctx)
+	for i := 0; i < w.concurrency; i++ {
+		w.wg.Add(1)
+		go func() {
+			defer w.wg.Done()
+			w.loop(ctx)
+		}()
+	}
+	log.Info("job workers started", zap.String("workerID", w.workerID), zap.Int("concurrency", w.concurrency), zap.Duration("pollInterval", w.pollInterval))
+}
+
+// Stop waits for the jobs that are running to return. A job cut short keeps
+// its lease, and is claimed again once the lease expires.
+func (w *jobWorker) Stop() {
+	if w.cancel != nil {
+		w.cancel()
+	}
+	w.wg.Wait()
+}
+
+// notify wakes a worker to claim a job that was just enqueued.
+func (w *jobWorker) notify() {
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (w *jobWorker) loop(ctx context.Context) {
+	for ctx.Err() == nil {
+		job, err := w.catalog.ClaimJob(ctx, w.jobTypes, w.workerID, w.now().Add(jobLease))
+		if err != nil && ctx.Err() == nil {
+			log.Error("failed to claim job", zap.String("workerID", w.workerID), zap.Error(err))
+		}
+		if job != nil {
+			w.runJob(ctx, job)
+			continue
+		}
+		select {
+		case <-ctx.Done():
+		case <-w.wake:
+		case <-time.After(w.pollInterval):
+		}
+	}
+}
+
+func (w *jobWorker) runJob(ctx context.Context, job *model.Job) {
+	log.Info("running job", zap.String("jobID", job.ID.String()), zap.String("type", job.Type), zap.Int32("attempt", job.Attempts))
+	jobCtx, cancel := context.WithCancel(ctx)
+	renewed := make(chan struct{})
+	go func() {
+		defer close(renewed)
+		w.renewLease(jobCtx, cancel, job)
+	}()
+	err := w.handlers[job.Type](jobCtx, job)
+	cancel()
+	<-renewed
+	if ctx.Err() != nil {
+		return
+	}
+
+	outcome := w.outcome(job, err)
+	if err != nil {
+		log.Error("job attempt failed", zap.String("jobID", job.ID.String()), zap.String("type", job.Type), zap.Int32("attempt", job.Attempts), zap.String("state", string(outcome.State)), zap.Error(err))
+	}
+	err = w.catalog.FinishJob(ctx, job.ID, w.workerID, outcome)
+	if errors.Is(err, common.ErrJobLeaseLost) {
+		// the job was canceled, or claimed by another worker after the lease
+		// could not be renewed
+		log.Warn("job outcome not recorded, the job is no longer held", zap.String("jobID", job.ID.String()), zap.String("state", string(outcome.State)))
+		outcome = &model.JobOutcome{State: model.JobStateCanceled}
+	} else if err != nil {
+		log.Error("failed to record job outcome", zap.String("jobID", job.ID.String()), zap.String("state", string(outcome.State)), zap.Error(err))
+	}
+	w.attempts.Add(ctx, 1, metric.WithAttributes(attribute.String("type", job.Type), attribute.String("outcome", string(outcome.State))))
+}
+
+// renewLease renews the lease of the job until ctx is done, and cancels the
+// attempt when the job was canceled or another worker claimed it.
+func (w *jobWorker) renewLease(ctx context.Context, cancel context.CancelFunc, job *model.Job) {
+	ticker := time.NewTicker(jobLease / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		err := w.catalog.RenewJobLease(ctx, job.ID, w.workerID, w.now().Add(jobLease))
+		if errors.Is(err, common.ErrJobLeaseLost) {
+			log.Warn("job is no longer held, stopping the attempt", zap.String("jobID", job.ID.String()))
+			cancel()
+			return
+		}
+		if err != nil && ctx.Err() == nil {
+			log.Warn("failed to renew job lease", zap.String("jobID", job.ID.String()), zap.Error(err))
+		}
+	}
+}
+
+func (w *jobWorker) outcome(job *model.Job, err error) *model.JobOutcome {
+	if err == nil {
+		return &model.JobOutcome{State: model.JobStateSucceeded}
+	}
+	var permanentErr *permanentJobError
+	if errors.As(err, &permanentErr) || job.Attempts >= job.MaxAttempts {
+		return &model.JobOutcome{State: model.JobStateFailed, LastError: err.Error()}
+	}
+	return &model.JobOutcome{
+		State:     model.JobStatePending,
+		LastError: err.Error(),
+		NextRunAt: w.now().Add(jobRetryDelay(job.Attempts)),
+	}
+}
+
+// jobRetryDelay is the delay before the attempt after attempt.
+func jobRetryDelay(attempt int32) time.Duration {
+	delay := jobRetryBaseDelay
+	for i := int32(1); i < attempt && delay < jobRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, jobRetryMaxDelay)
+}
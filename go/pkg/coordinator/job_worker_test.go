@@ -0,0 +1,110 @@
+package coordinator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/mocks"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestJobWorker_Outcome(t *testing.T) {
+	now := time.Unix(1000, 0)
+	w := &jobWorker{now: func() time.Time { return now }}
+	job := &model.Job{Attempts: 2, MaxAttempts: 3}
+
+	assert.Equal(t, &model.JobOutcome{State: model.JobStateSucceeded}, w.outcome(job, nil))
+
+	// a failed attempt is retried with backoff while the job has attempts left
+	outcome := w.outcome(job, errors.New("boom"))
+	assert.Equal(t, model.JobStatePending, outcome.State)
+	assert.Equal(t, "boom", outcome.LastError)
+	assert.Equal(t, now.Add(2*jobRetryBaseDelay), outcome.NextRunAt)
+
+	// a permanent error fails the job right away
+	assert.Equal(t, model.JobStateFailed, w.outcome(job, permanent(errors.New("bad payload"))).State)
+
+	job.Attempts = 3
+	assert.Equal(t, model.JobStateFailed, w.outcome(job, errors.New("boom")).State)
+}
+
+func TestJobRetryDelay(t *testing.T) {
+	assert.Equal(t, jobRetryBaseDelay, jobRetryDelay(1))
+	assert.Equal(t, 4*jobRetryBaseDelay, jobRetryDelay(3))
+	assert.Equal(t, jobRetryMaxDelay, jobRetryDelay(30))
+}
+
+func TestJobWorker_RunsClaimedJob(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	ran := make(chan *model.Job, 1)
+	w, err := newJobWorker(Config{JobWorkerConcurrency: 1, JobPollInterval: time.Hour}, catalog, map[string]jobHandler{
+		"test": func(ctx context.Context, job *model.Job) error {
+			ran <- job
+			return nil
+		},
+	})
+	assert.NoError(t, err)
+
+	job := &model.Job{ID: types.NewUniqueID(), Type: "test", Attempts: 1, MaxAttempts: 3}
+	claim := func() *mock.Call {
+		return catalog.On("ClaimJob", mock.Anything, []string{"test"}, w.workerID, mock.Anything)
+	}
+	claim().Return(job, nil).Once()
+	claim().Return(nil, nil)
+	finished := make(chan struct{})
+	catalog.On("FinishJob", mock.Anything, job.ID, w.workerID, &model.JobOutcome{State: model.JobStateSucceeded}).Return(nil).Run(func(mock.Arguments) {
+		close(finished)
+	})
+
+	w.Start(context.Background())
+	defer w.Stop()
+	assert.Equal(t, job, <-ran)
+	select {
+	case <-finished:
+	case <-time.After(time.Second):
+		t.Fatal("job outcome not recorded")
+	}
+}
+
+func TestListJobsValidation(t *testing.T) {
+	c := &Coordinator{catalog: &mocks.Catalog{}}
+	_, err := c.ListJobs(context.Background(), &model.JobFilter{State: "stuck", Limit: 10})
+	assert.ErrorIs(t, err, common.ErrInvalidJobState)
+	_, err = c.ListJobs(context.Background(), &model.JobFilter{Limit: 0})
+	assert.ErrorIs(t, err, common.ErrInvalidJobPage)
+	_, err = c.ListJobs(context.Background(), &model.JobFilter{Limit: maxListJobsLimit + 1})
+	assert.ErrorIs(t, err, common.ErrInvalidJobPage)
+	_, err = c.ListJobs(context.Background(), &model.JobFilter{Limit: 10, Offset: -1})
+	assert.ErrorIs(t, err, common.ErrInvalidJobPage)
+}
+
+func TestNewJobWorkerDisabled(t *testing.T) {
+	w, err := newJobWorker(Config{}, &mocks.Catalog{}, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, w)
+
+	c := &Coordinator{catalog: &mocks.Catalog{}}
+	_, err = c.enqueueJob(context.Background(), model.JobTypeDeleteTenant, "tenant", nil)
+	assert.ErrorIs(t, err, common.ErrJobWorkersDisabled)
+}
+
+func TestRunDeleteTenantJob(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{catalog: catalog}
+	payload, _ := json.Marshal(&model.DeleteTenantJob{Name: "gone", Force: true})
+	catalog.On("DeleteTenant", mock.Anything, &model.DeleteTenant{Name: "gone", Force: true}).Return(common.ErrTenantNotFound)
+
+	// the tenant was deleted by an attempt whose outcome was lost
+	assert.NoError(t, c.runDeleteTenantJob(context.Background(), &model.Job{Payload: payload}))
+
+	err := c.runDeleteTenantJob(context.Background(), &model.Job{Payload: []byte("not json")})
+	var permanentErr *permanentJobError
+	assert.ErrorAs(t, err, &permanentErr)
+}
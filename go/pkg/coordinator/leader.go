@@ -0,0 +1,147 @@
+package coordinator
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// sysdbLeaderLease is the name of the lease held by the sysdb replica that
+// serves writes and runs the background jobs.
+const sysdbLeaderLease = "sysdb-leader"
+
+// leaderElector keeps one sysdb replica the leader by holding a lease in the
+// database. The leader renews the lease every third of its ttl; a standby tries
+// to take it over on the same schedule, which only succeeds once the lease has
+// expired.
+type leaderElector struct {
+	metaDomain dbmodel.IMetaDomain
+	holder     string
+	address    string
+	ttl        time.Duration
+	now        func() time.Time
+
+	// onElected and onDemoted are called from the renew loop when this
+	// replica gains or loses the lease.
+	onElected func()
+	onDemoted func()
+
+	mu       sync.RWMutex
+	lease    *dbmodel.Lease
+	deadline time.Time
+	// elected is whether onElected was called without a matching onDemoted.
+	elected bool
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newLeaderElector(metaDomain dbmodel.IMetaDomain, holder string, address string, ttl time.Duration) *leaderElector {
+	return &leaderElector{
+		metaDomain: metaDomain,
+		holder:     holder,
+		address:    address,
+		ttl:        ttl,
+		now:        time.Now,
+		onElected:  func() {},
+		onDemoted:  func() {},
+	}
+}
+
+func (e *leaderElector) Start(ctx context.Context) {
+	ctx, e.cancel = context.WithCancel(ctx)
+	e.renew(ctx)
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		ticker := time.NewTicker(e.ttl / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				e.renew(ctx)
+			}
+		}
+	}()
+	log.Info("leader elector started", zap.String("holder", e.holder), zap.String("address", e.address), zap.Duration("ttl", e.ttl))
+}
+
+// Stop releases the lease if this replica holds it, so that a standby takes
+// over without waiting for the lease to expire.
+func (e *leaderElector) Stop() {
+	if e.cancel != nil {
+		e.cancel()
+	}
+	e.wg.Wait()
+	wasLeader := e.IsLeader()
+	e.setLease(nil, time.Time{})
+	if wasLeader {
+		err := e.metaDomain.LeaseDb(dbcore.WithBackgroundPool(context.Background())).Release(sysdbLeaderLease, e.holder)
+		if err != nil {
+			log.Error("release leader lease failed", zap.Error(err))
+		}
+	}
+}
+
+// renew acquires or renews the lease. The local deadline is taken before the
+// round trip to the database, so this replica stops acting as the leader no
+// later than the database considers the lease expired.
+func (e *leaderElector) renew(ctx context.Context) {
+	deadline := e.now().Add(e.ttl)
+	lease, err := e.metaDomain.LeaseDb(ctx).TryAcquire(sysdbLeaderLease, e.holder, e.address, e.ttl)
+	if err != nil {
+		log.Error("renew leader lease failed", zap.Error(err))
+		// keep acting on the last known lease until its local deadline passes
+		e.mu.RLock()
+		lease, deadline = e.lease, e.deadline
+		e.mu.RUnlock()
+		e.setLease(lease, deadline)
+		return
+	}
+	if lease.Holder != e.holder {
+		deadline = time.Time{}
+	}
+	e.setLease(lease, deadline)
+}
+
+func (e *leaderElector) setLease(lease *dbmodel.Lease, deadline time.Time) {
+	e.mu.Lock()
+	e.lease = lease
+	e.deadline = deadline
+	e.mu.Unlock()
+	isLeader := e.IsLeader()
+	wasLeader := e.elected
+	e.elected = isLeader
+	if isLeader && !wasLeader {
+		log.Info("acquired leader lease", zap.String("holder", e.holder), zap.Int64("epoch", lease.Epoch))
+		e.onElected()
+	} else if !isLeader && wasLeader {
+		log.Info("lost leader lease", zap.String("holder", e.holder))
+		e.onDemoted()
+	}
+}
+
+// IsLeader reports whether this replica holds an unexpired lease.
+func (e *leaderElector) IsLeader() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.lease != nil && e.lease.Holder == e.holder && e.now().Before(e.deadline)
+}
+
+// LeaderAddress returns the address of the last known leader, or an empty
+// string if there is none.
+func (e *leaderElector) LeaderAddress() string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if e.lease == nil {
+		return ""
+	}
+	return e.lease.Address
+}
@@ -0,0 +1,76 @@
+package coordinator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLeaderElector_Failover(t *testing.T) {
+	mockMetaDomain := &mocks.IMetaDomain{}
+	mockLeaseDb := &mocks.ILeaseDb{}
+	mockMetaDomain.On("LeaseDb", mock.Anything).Return(mockLeaseDb)
+
+	elector := newLeaderElector(mockMetaDomain, "sysdb-1", "sysdb-1:50051", 30*time.Second)
+	now := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+	elector.now = func() time.Time { return now }
+	elected, demoted := 0, 0
+	elector.onElected = func() { elected++ }
+	elector.onDemoted = func() { demoted++ }
+
+	// another replica is the leader
+	mockLeaseDb.On("TryAcquire", sysdbLeaderLease, "sysdb-1", "sysdb-1:50051", 30*time.Second).
+		Return(&dbmodel.Lease{Name: sysdbLeaderLease, Holder: "sysdb-0", Address: "sysdb-0:50051", Epoch: 1}, nil).Once()
+	elector.renew(context.Background())
+	assert.False(t, elector.IsLeader())
+	assert.Equal(t, "sysdb-0:50051", elector.LeaderAddress())
+	assert.Equal(t, 0, elected)
+
+	// its lease expired and this replica took over
+	mockLeaseDb.On("TryAcquire", sysdbLeaderLease, "sysdb-1", "sysdb-1:50051", 30*time.Second).
+		Return(&dbmodel.Lease{Name: sysdbLeaderLease, Holder: "sysdb-1", Address: "sysdb-1:50051", Epoch: 2}, nil).Once()
+	elector.renew(context.Background())
+	assert.True(t, elector.IsLeader())
+	assert.Equal(t, "sysdb-1:50051", elector.LeaderAddress())
+	assert.Equal(t, 1, elected)
+
+	// a failed renewal keeps the lease until the local deadline passes
+	mockLeaseDb.On("TryAcquire", sysdbLeaderLease, "sysdb-1", "sysdb-1:50051", 30*time.Second).
+		Return(nil, errors.New("connection refused"))
+	now = now.Add(10 * time.Second)
+	elector.renew(context.Background())
+	assert.True(t, elector.IsLeader())
+	assert.Equal(t, 0, demoted)
+
+	now = now.Add(25 * time.Second)
+	assert.False(t, elector.IsLeader())
+	elector.renew(context.Background())
+	assert.Equal(t, 1, elected)
+	assert.Equal(t, 1, demoted)
+}
+
+func TestLeaderElector_StopReleasesLease(t *testing.T) {
+	mockMetaDomain := &mocks.IMetaDomain{}
+	mockLeaseDb := &mocks.ILeaseDb{}
+	mockMetaDomain.On("LeaseDb", mock.Anything).Return(mockLeaseDb)
+	mockLeaseDb.On("TryAcquire", sysdbLeaderLease, "sysdb-1", "", time.Minute).
+		Return(&dbmodel.Lease{Name: sysdbLeaderLease, Holder: "sysdb-1", Epoch: 1}, nil)
+	mockLeaseDb.On("Release", sysdbLeaderLease, "sysdb-1").Return(nil)
+
+	elector := newLeaderElector(mockMetaDomain, "sysdb-1", "", time.Minute)
+	demoted := 0
+	elector.onDemoted = func() { demoted++ }
+	elector.Start(context.Background())
+	assert.True(t, elector.IsLeader())
+
+	elector.Stop()
+	assert.False(t, elector.IsLeader())
+	assert.Equal(t, 1, demoted)
+	mockLeaseDb.AssertCalled(t, "Release", sysdbLeaderLease, "sysdb-1")
+}
@@ -0,0 +1,133 @@
+package coordinator
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"google.golang.org/grpc"
+)
+
+const (
+	defaultLogLagCacheTTL = 5 * time.Second
+	// logLagTimeout bounds the log service call, which GetCollections waits for
+	// before it answers.
+	logLagTimeout = time.Second
+)
+
+// logLagSource is the part of the log service client used to read log lag.
+type logLagSource interface {
+	GetCollectionLogLag(ctx context.Context, in *logservicepb.GetCollectionLogLagRequest, opts ...grpc.CallOption) (*logservicepb.GetCollectionLogLagResponse, error)
+}
+
+// logLagCache keeps the log lag of recently read collections so that listing
+// collections with their lag does not call the log service every time.
+type logLagCache struct {
+	source logLagSource
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu      sync.Mutex
+	entries map[types.UniqueID]*logLagCacheEntry
+}
+
+type logLagCacheEntry struct {
+	lag       *model.CollectionLogLag
+	expiresAt time.Time
+}
+
+func newLogLagCache(source logLagSource, ttl time.Duration) *logLagCache {
+	if ttl <= 0 {
+		ttl = defaultLogLagCacheTTL
+	}
+	return &logLagCache{
+		source:  source,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[types.UniqueID]*logLagCacheEntry),
+	}
+}
+
+// get returns the lag of every collection, reading the ones that are not cached
+// from the log service in a single call.
+func (c *logLagCache) get(ctx context.Context, collectionIDs []types.UniqueID) (map[types.UniqueID]*model.CollectionLogLag, error) {
+	now := c.now()
+	lags := make(map[types.UniqueID]*model.CollectionLogLag, len(collectionIDs))
+	var missing []types.UniqueID
+	c.mu.Lock()
+	for _, collectionID := range collectionIDs {
+		if entry, ok := c.entries[collectionID]; ok && now.Before(entry.expiresAt) {
+			lags[collectionID] = entry.lag
+		} else {
+			missing = append(missing, collectionID)
+		}
+	}
+	c.mu.Unlock()
+	if len(missing) == 0 {
+		return lags, nil
+	}
+
+	req := &logservicepb.GetCollectionLogLagRequest{CollectionIds: make([]string, 0, len(missing))}
+	for _, collectionID := range missing {
+		req.CollectionIds = append(req.CollectionIds, collectionID.String())
+	}
+	ctx, cancel := context.WithTimeout(ctx, logLagTimeout)
+	defer cancel()
+	res, err := c.source.GetCollectionLogLag(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	fetched := make(map[string]*model.CollectionLogLag, len(res.Lags))
+	for _, lagpb := range res.Lags {
+		lag := &model.CollectionLogLag{UncompactedRecords: lagpb.UncompactedRecords}
+		if lagpb.OldestUncompactedTs > 0 {
+			oldest := time.Unix(0, lagpb.OldestUncompactedTs).Unix()
+			lag.OldestUncompactedAt = &oldest
+		}
+		fetched[lagpb.CollectionId] = lag
+	}
+	c.mu.Lock()
+	for _, collectionID := range missing {
+		// collections that never received a record have no lag
+		lag, ok := fetched[collectionID.String()]
+		if !ok {
+			lag = &model.CollectionLogLag{}
+		}
+		lags[collectionID] = lag
+		c.entries[collectionID] = &logLagCacheEntry{lag: lag, expiresAt: now.Add(c.ttl)}
+	}
+	for id, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, id)
+		}
+	}
+	c.mu.Unlock()
+	return lags, nil
+}
+
+// LoadCollectionLogLag fills in the log lag of the collections. It fails with
+// ErrLogServiceNotConfigured when the coordinator has no log service address.
+func (s *Coordinator) LoadCollectionLogLag(ctx context.Context, collections []*model.Collection) error {
+	if s.logLags == nil {
+		return common.ErrLogServiceNotConfigured
+	}
+	if len(collections) == 0 {
+		return nil
+	}
+	collectionIDs := make([]types.UniqueID, 0, len(collections))
+	for _, collection := range collections {
+		collectionIDs = append(collectionIDs, collection.ID)
+	}
+	lags, err := s.logLags.get(ctx, collectionIDs)
+	if err != nil {
+		return err
+	}
+	for _, collection := range collections {
+		collection.LogLag = lags[collection.ID]
+	}
+	return nil
+}
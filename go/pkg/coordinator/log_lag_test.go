@@ -0,0 +1,58 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+type fakeLogLagSource struct {
+	lags     []*logservicepb.CollectionLogLag
+	requests [][]string
+	// deadlines of the contexts of the requests
+	deadlines []time.Time
+}
+
+func (f *fakeLogLagSource) GetCollectionLogLag(ctx context.Context, in *logservicepb.GetCollectionLogLagRequest, opts ...grpc.CallOption) (*logservicepb.GetCollectionLogLagResponse, error) {
+	f.requests = append(f.requests, in.CollectionIds)
+	deadline, _ := ctx.Deadline()
+	f.deadlines = append(f.deadlines, deadline)
+	return &logservicepb.GetCollectionLogLagResponse{Lags: f.lags}, nil
+}
+
+func TestLoadCollectionLogLag(t *testing.T) {
+	lagging, compacted := types.NewUniqueID(), types.NewUniqueID()
+	oldest := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+	source := &fakeLogLagSource{lags: []*logservicepb.CollectionLogLag{
+		{CollectionId: lagging.String(), UncompactedRecords: 42, OldestUncompactedTs: oldest.UnixNano()},
+	}}
+	c := &Coordinator{ctx: context.Background(), logLags: newLogLagCache(source, time.Minute)}
+	now := oldest.Add(time.Minute)
+	c.logLags.now = func() time.Time { return now }
+
+	collections := []*model.Collection{{ID: lagging}, {ID: compacted}}
+	assert.NoError(t, c.LoadCollectionLogLag(context.Background(), collections))
+	assert.Equal(t, int64(42), collections[0].LogLag.UncompactedRecords)
+	assert.Equal(t, oldest.Unix(), *collections[0].LogLag.OldestUncompactedAt)
+	// a collection the log does not know has no lag
+	assert.Equal(t, &model.CollectionLogLag{}, collections[1].LogLag)
+	// the call to the log service is bounded
+	assert.WithinDuration(t, time.Now().Add(logLagTimeout), source.deadlines[0], logLagTimeout)
+
+	// served from the cache until the entries expire
+	assert.NoError(t, c.LoadCollectionLogLag(context.Background(), []*model.Collection{{ID: lagging}}))
+	assert.Len(t, source.requests, 1)
+	now = now.Add(2 * time.Minute)
+	assert.NoError(t, c.LoadCollectionLogLag(context.Background(), []*model.Collection{{ID: lagging}}))
+	assert.Equal(t, [][]string{{lagging.String(), compacted.String()}, {lagging.String()}}, source.requests)
+
+	c = &Coordinator{ctx: context.Background()}
+	assert.ErrorIs(t, c.LoadCollectionLogLag(context.Background(), collections), common.ErrLogServiceNotConfigured)
+}
@@ -0,0 +1,174 @@
+package coordinator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
+	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// logOffsetReconcilePageSize is how many collections are compared in one page,
+// with a single call to the log service.
+const logOffsetReconcilePageSize = 500
+
+// logOffsetReconciler compares the log position of every collection in the
+// sysdb with the offset of the last record of its log every interval. A log
+// position past the end of the log is impossible, the flush of a compaction
+// can only move it up to the records that were pulled from the log, so it
+// points to a corrupted or rolled back log or sysdb. The opposite, a log
+// position behind the log, is the normal lag of the compaction.
+type logOffsetReconciler struct {
+	metaDomain dbmodel.IMetaDomain
+	lags       logLagSource
+	interval   time.Duration
+
+	runs  metric.Int64Counter
+	ahead atomic.Int64
+
+	mu       sync.Mutex
+	findings []*model.InconsistentCollection
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// newLogOffsetReconciler returns nil when the config does not enable the job or
+// the coordinator has no log service.
+func newLogOffsetReconciler(config Config, metaDomain dbmodel.IMetaDomain, lags logLagSource) (*logOffsetReconciler, error) {
+	if config.LogOffsetReconcileInterval <= 0 || lags == nil {
+		return nil, nil
+	}
+	j := &logOffsetReconciler{
+		metaDomain: metaDomain,
+		lags:       lags,
+		interval:   config.LogOffsetReconcileInterval,
+	}
+	meter := otel.Meter("chroma.coordinator")
+	var err error
+	j.runs, err = meter.Int64Counter("sysdb.log_offset_reconciler.runs",
+		metric.WithDescription("Number of log offset reconciliations by status"))
+	if err != nil {
+		return nil, err
+	}
+	_, err = meter.Int64ObservableGauge("sysdb.log_offset_reconciler.ahead_of_log",
+		metric.WithDescription("Number of collections whose sysdb log position was past the end of their log at the last reconciliation"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(j.ahead.Load())
+			return nil
+		}))
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (j *logOffsetReconciler) Start(ctx context.Context) {
+	ctx, j.cancel = context.WithCancel(ctx)
+	j.wg.Add(1)
+	go func() {
+		defer j.wg.Done()
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+		for {
+			j.run(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	log.Info("log offset reconciler started", zap.Duration("interval", j.interval))
+}
+
+func (j *logOffsetReconciler) Stop() {
+	if j.cancel != nil {
+		j.cancel()
+	}
+	j.wg.Wait()
+}
+
+func (j *logOffsetReconciler) run(ctx context.Context) {
+	findings, err := j.reconcile(ctx)
+	status := "success"
+	if err != nil {
+		// the findings of the previous run are kept
+		status = "failure"
+		log.Error("log offset reconciliation failed", zap.Error(err))
+	} else {
+		for _, finding := range findings {
+			log.Error("collection log position is ahead of its log", zap.String("collectionID", finding.ID.String()), zap.String("description", finding.Description))
+		}
+		j.mu.Lock()
+		j.findings = findings
+		j.mu.Unlock()
+		j.ahead.Store(int64(len(findings)))
+	}
+	j.runs.Add(ctx, 1, metric.WithAttributes(attribute.String("status", status)))
+}
+
+func (j *logOffsetReconciler) reconcile(ctx context.Context) ([]*model.InconsistentCollection, error) {
+	var findings []*model.InconsistentCollection
+	limit := int32(logOffsetReconcilePageSize)
+	for offset := int32(0); ; offset += limit {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		pageOffset := offset
+		collections, err := j.metaDomain.CollectionDb(ctx).GetCollectionsWithoutMetadata(nil, nil, "", "", &limit, &pageOffset, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(collections) == 0 {
+			return findings, nil
+		}
+		req := &logservicepb.GetCollectionLogLagRequest{CollectionIds: make([]string, 0, len(collections))}
+		for _, collection := range collections {
+			req.CollectionIds = append(req.CollectionIds, collection.Collection.ID.String())
+		}
+		res, err := j.lags.GetCollectionLogLag(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		maxOffsets := make(map[string]int64, len(res.Lags))
+		for _, lagpb := range res.Lags {
+			maxOffsets[lagpb.CollectionId] = lagpb.MaxOffset
+		}
+		for _, collection := range collections {
+			// collections that never received a record have an empty log
+			maxOffset := maxOffsets[collection.Collection.ID.String()]
+			if collection.Collection.LogPosition <= maxOffset {
+				continue
+			}
+			findings = append(findings, &model.InconsistentCollection{
+				ID:           collection.Collection.ID.UniqueID(),
+				Name:         *collection.Collection.Name,
+				TenantID:     collection.TenantID,
+				DatabaseName: collection.DatabaseName,
+				Kind:         model.InconsistencyLogPositionAhead,
+				Description:  fmt.Sprintf("sysdb log position %d is past the last offset %d of the log, the log or the sysdb is corrupted or was restored from an older backup than the other", collection.Collection.LogPosition, maxOffset),
+			})
+		}
+		if len(collections) < logOffsetReconcilePageSize {
+			return findings, nil
+		}
+	}
+}
+
+// latestFindings returns the collections ahead of their log at the last
+// successful reconciliation.
+func (j *logOffsetReconciler) latestFindings() []*model.InconsistentCollection {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.findings
+}
@@ -0,0 +1,68 @@
+package coordinator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel/mocks"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLogOffsetReconciler_Config(t *testing.T) {
+	job, err := newLogOffsetReconciler(Config{LogOffsetReconcileInterval: time.Minute}, nil, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+
+	job, err = newLogOffsetReconciler(Config{}, nil, &fakeLogLagSource{})
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+}
+
+func TestLogOffsetReconciler_Run(t *testing.T) {
+	mockMetaDomain := &mocks.IMetaDomain{}
+	mockCollectionDb := &mocks.ICollectionDb{}
+	mockMetaDomain.On("CollectionDb", mock.Anything).Return(mockCollectionDb)
+
+	collection := func(logPosition int64) *dbmodel.CollectionAndMetadata {
+		name := "collection"
+		return &dbmodel.CollectionAndMetadata{
+			Collection:   &dbmodel.Collection{ID: dbmodel.NewCollectionID(types.NewUniqueID()), Name: &name, LogPosition: logPosition},
+			TenantID:     "tenant",
+			DatabaseName: "database",
+		}
+	}
+	behind, caughtUp, ahead, empty := collection(3), collection(10), collection(12), collection(1)
+	source := &fakeLogLagSource{lags: []*logservicepb.CollectionLogLag{
+		{CollectionId: behind.Collection.ID.String(), MaxOffset: 10},
+		{CollectionId: caughtUp.Collection.ID.String(), MaxOffset: 10},
+		{CollectionId: ahead.Collection.ID.String(), MaxOffset: 10},
+	}}
+	getCollections := func() *mock.Call {
+		return mockCollectionDb.On("GetCollectionsWithoutMetadata", (*dbmodel.CollectionID)(nil), (*string)(nil), "", "", mock.Anything, mock.Anything, ([]*model.CollectionMetadataFilter)(nil))
+	}
+	getCollections().Return(nil, errors.New("connection refused")).Once()
+	getCollections().Return([]*dbmodel.CollectionAndMetadata{behind, caughtUp, ahead, empty}, nil).Once()
+
+	job, err := newLogOffsetReconciler(Config{LogOffsetReconcileInterval: time.Minute}, mockMetaDomain, source)
+	assert.NoError(t, err)
+	job.run(context.Background())
+	assert.Empty(t, job.latestFindings())
+
+	job.run(context.Background())
+	findings := job.latestFindings()
+	assert.Len(t, findings, 2)
+	assert.Equal(t, ahead.Collection.ID.UniqueID(), findings[0].ID)
+	assert.Equal(t, model.InconsistencyLogPositionAhead, findings[0].Kind)
+	assert.Equal(t, "tenant", findings[0].TenantID)
+	// a collection the log does not know has an empty log
+	assert.Equal(t, empty.Collection.ID.UniqueID(), findings[1].ID)
+	assert.Equal(t, int64(2), job.ahead.Load())
+	assert.Len(t, source.requests, 1)
+}
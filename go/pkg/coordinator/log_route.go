@@ -0,0 +1,53 @@
+package coordinator
+
+import (
+	"context"
+	"net"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+)
+
+// SetCollectionLogRoute routes the collection to the log at logAddress. An empty
+// address routes it back to the default log.
+func (s *Coordinator) SetCollectionLogRoute(ctx context.Context, collectionID types.UniqueID, logAddress string) (*model.CollectionLogRoute, error) {
+	if err := s.beforeCollectionWrite(ctx, &WriteRequest{Operation: WriteSetCollectionLogRoute, CollectionID: collectionID, Request: logAddress}); err != nil {
+		return nil, err
+	}
+	if logAddress != "" {
+		if _, _, err := net.SplitHostPort(logAddress); err != nil {
+			return nil, common.ErrInvalidLogAddress
+		}
+	}
+	return s.catalog.SetCollectionLogRoute(ctx, collectionID, logAddress)
+}
+
+// ResolveCollectionLogRoutes returns the routes of the collections, and when
+// changedAfter is set also every route changed after that revision. Frontends
+// cache the routes and pass the returned as-of revision as changedAfter of
+// their next call to learn which cached routes to replace. Changes may be
+// returned twice but are not missed.
+func (s *Coordinator) ResolveCollectionLogRoutes(ctx context.Context, collectionIDs []types.UniqueID, changedAfter *int64) ([]*model.CollectionLogRoute, int64, error) {
+	routes, asOf, err := s.catalog.GetCollectionLogRoutes(ctx, collectionIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+	if changedAfter == nil {
+		return routes, asOf, nil
+	}
+	changes, err := s.catalog.GetCollectionLogRouteChanges(ctx, *changedAfter)
+	if err != nil {
+		return nil, 0, err
+	}
+	requested := make(map[types.UniqueID]struct{}, len(routes))
+	for _, route := range routes {
+		requested[route.CollectionID] = struct{}{}
+	}
+	for _, change := range changes {
+		if _, ok := requested[change.CollectionID]; !ok {
+			routes = append(routes, change)
+		}
+	}
+	return routes, asOf, nil
+}
@@ -0,0 +1,59 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/mocks"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSetCollectionLogRoute_ValidatesAddress(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{ctx: context.Background(), catalog: catalog}
+	collectionID := types.NewUniqueID()
+
+	_, err := c.SetCollectionLogRoute(context.Background(), collectionID, "log-1")
+	assert.ErrorIs(t, err, common.ErrInvalidLogAddress)
+	catalog.AssertNotCalled(t, "SetCollectionLogRoute", mock.Anything, mock.Anything, mock.Anything)
+
+	// an empty address routes the collection back to the default log
+	catalog.On("SetCollectionLogRoute", mock.Anything, collectionID, "").Return(&model.CollectionLogRoute{CollectionID: collectionID, Version: 2}, nil)
+	route, err := c.SetCollectionLogRoute(context.Background(), collectionID, "")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), route.Version)
+}
+
+func TestResolveCollectionLogRoutes(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{ctx: context.Background(), catalog: catalog}
+	routed := types.NewUniqueID()
+	unrouted := types.NewUniqueID()
+	moved := types.NewUniqueID()
+	requested := []types.UniqueID{routed, unrouted}
+	catalog.On("GetCollectionLogRoutes", mock.Anything, requested).Return([]*model.CollectionLogRoute{
+		{CollectionID: routed, LogAddress: "log-1:50051", Version: 1, Revision: 4},
+		{CollectionID: unrouted},
+	}, int64(7), nil)
+
+	routes, asOf, err := c.ResolveCollectionLogRoutes(context.Background(), requested, nil)
+	assert.NoError(t, err)
+	assert.Len(t, routes, 2)
+	assert.Equal(t, int64(7), asOf)
+	catalog.AssertNotCalled(t, "GetCollectionLogRouteChanges", mock.Anything, mock.Anything)
+
+	// changes of other collections are appended, changes of requested ones are
+	// already part of their route
+	changedAfter := asOf
+	catalog.On("GetCollectionLogRouteChanges", mock.Anything, changedAfter).Return([]*model.CollectionLogRoute{
+		{CollectionID: routed, LogAddress: "log-1:50051", Version: 1, Revision: 4},
+		{CollectionID: moved, LogAddress: "log-2:50051", Version: 3, Revision: 8},
+	}, nil)
+	routes, _, err = c.ResolveCollectionLogRoutes(context.Background(), requested, &changedAfter)
+	assert.NoError(t, err)
+	assert.Equal(t, []types.UniqueID{routed, unrouted, moved}, []types.UniqueID{routes[0].CollectionID, routes[1].CollectionID, routes[2].CollectionID})
+}
@@ -0,0 +1,173 @@
+package coordinator
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// maintenanceCheckInterval is how often the job checks whether the maintenance
+// window is open.
+const maintenanceCheckInterval = time.Minute
+
+// defaultMaintenanceTables are the high churn tables whose statistics go stale
+// and which bloat the fastest.
+var defaultMaintenanceTables = []string{"record_logs", "collection_metadata"}
+
+// maintenanceJob runs ANALYZE, or VACUUM (ANALYZE), on a set of tables once per
+// daily maintenance window. The window is meant to be placed at the low traffic
+// hours of the deployment.
+type maintenanceJob struct {
+	metaDomain     dbmodel.IMetaDomain
+	startHour      int
+	windowDuration time.Duration
+	vacuum         bool
+	tables         []string
+	now            func() time.Time
+
+	runs     metric.Int64Counter
+	duration metric.Float64Histogram
+
+	lastRun time.Time
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// newMaintenanceJob returns nil when the config does not enable the job.
+func newMaintenanceJob(config Config, metaDomain dbmodel.IMetaDomain) (*maintenanceJob, error) {
+	if config.MaintenanceWindowDuration <= 0 {
+		return nil, nil
+	}
+	if config.MaintenanceWindowStartHour < 0 || config.MaintenanceWindowStartHour > 23 {
+		return nil, common.ErrInvalidMaintenanceWindow
+	}
+	tables := config.MaintenanceTables
+	if len(tables) == 0 {
+		tables = defaultMaintenanceTables
+	}
+	for _, table := range tables {
+		if !slices.Contains(dbmodel.MaintainableTables, table) {
+			log.Error("unknown maintenance table", zap.String("table", table))
+			return nil, common.ErrUnknownMaintenanceTable
+		}
+	}
+
+	meter := otel.Meter("chroma.coordinator")
+	runs, err := meter.Int64Counter("sysdb.maintenance.runs",
+		metric.WithDescription("Number of table maintenance operations by table, operation and status"))
+	if err != nil {
+		return nil, err
+	}
+	duration, err := meter.Float64Histogram("sysdb.maintenance.duration",
+		metric.WithDescription("Duration of table maintenance operations"),
+		metric.WithUnit("ms"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &maintenanceJob{
+		metaDomain:     metaDomain,
+		startHour:      config.MaintenanceWindowStartHour,
+		windowDuration: config.MaintenanceWindowDuration,
+		vacuum:         config.MaintenanceVacuum,
+		tables:         tables,
+		now:            time.Now,
+		runs:           runs,
+		duration:       duration,
+	}, nil
+}
+
+func (j *maintenanceJob) Start(ctx context.Context) {
+	ctx, j.cancel = context.WithCancel(ctx)
+	j.wg.Add(1)
+	go func() {
+		defer j.wg.Done()
+		ticker := time.NewTicker(maintenanceCheckInterval)
+		defer ticker.Stop()
+		for {
+			j.runIfDue(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	log.Info("maintenance job started", zap.Int("startHour", j.startHour), zap.Duration("windowDuration", j.windowDuration), zap.Bool("vacuum", j.vacuum), zap.Strings("tables", j.tables))
+}
+
+func (j *maintenanceJob) Stop() {
+	if j.cancel != nil {
+		j.cancel()
+	}
+	j.wg.Wait()
+}
+
+// windowStart returns the start of the maintenance window that contains now.
+// The window opens every day at startHour UTC and may run past midnight.
+func (j *maintenanceJob) windowStart(now time.Time) (time.Time, bool) {
+	now = now.UTC()
+	start := time.Date(now.Year(), now.Month(), now.Day(), j.startHour, 0, 0, 0, time.UTC)
+	if start.After(now) {
+		start = start.AddDate(0, 0, -1)
+	}
+	if now.Sub(start) >= j.windowDuration {
+		return time.Time{}, false
+	}
+	return start, true
+}
+
+// runIfDue runs the maintenance when the window is open and it has not run in
+// this window yet.
+func (j *maintenanceJob) runIfDue(ctx context.Context) {
+	now := j.now()
+	start, open := j.windowStart(now)
+	if !open || !j.lastRun.Before(start) {
+		return
+	}
+	j.lastRun = now
+	j.run(ctx)
+}
+
+func (j *maintenanceJob) run(ctx context.Context) {
+	operation := "analyze"
+	if j.vacuum {
+		operation = "vacuum"
+	}
+	for _, table := range j.tables {
+		if ctx.Err() != nil {
+			return
+		}
+		started := time.Now()
+		var err error
+		if j.vacuum {
+			err = j.metaDomain.MaintenanceDb(ctx).Vacuum(table)
+		} else {
+			err = j.metaDomain.MaintenanceDb(ctx).Analyze(table)
+		}
+		elapsed := time.Since(started)
+		status := "success"
+		if err != nil {
+			status = "failure"
+			log.Error("table maintenance failed", zap.String("table", table), zap.String("operation", operation), zap.Error(err))
+		} else {
+			log.Info("table maintenance done", zap.String("table", table), zap.String("operation", operation), zap.Duration("elapsed", elapsed))
+		}
+		attributes := metric.WithAttributes(
+			attribute.String("table", table),
+			attribute.String("operation", operation),
+			attribute.String("status", status),
+		)
+		j.runs.Add(ctx, 1, attributes)
+		j.duration.Record(ctx, float64(elapsed.Microseconds())/1000, attributes)
+	}
+}
@@ -0,0 +1,98 @@
+package coordinator
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+const defaultMaintenanceModeCacheTTL = 5 * time.Second
+
+// maintenanceModeCache serves the maintenance mode for ttl before reading it
+// again, since it is checked by every mutating RPC. When a read fails the mode
+// of the last read is served until the next attempt, and the sysdb is not in
+// maintenance if no read succeeded yet.
+type maintenanceModeCache struct {
+	catalog metastore.Catalog
+	ttl     time.Duration
+	now     func() time.Time
+
+	mu        sync.Mutex
+	mode      *model.MaintenanceMode
+	expiresAt time.Time
+}
+
+func newMaintenanceModeCache(catalog metastore.Catalog, ttl time.Duration) *maintenanceModeCache {
+	if ttl <= 0 {
+		ttl = defaultMaintenanceModeCacheTTL
+	}
+	return &maintenanceModeCache{
+		catalog: catalog,
+		ttl:     ttl,
+		now:     time.Now,
+		mode:    &model.MaintenanceMode{},
+	}
+}
+
+func (c *maintenanceModeCache) get(ctx context.Context) *model.MaintenanceMode {
+	now := c.now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if now.Before(c.expiresAt) {
+		return c.mode
+	}
+	c.expiresAt = now.Add(c.ttl)
+	mode, err := c.catalog.GetMaintenanceMode(ctx)
+	if err != nil {
+		log.Warn("failed to read maintenance mode, serving the last known mode", zap.Error(err))
+		return c.mode
+	}
+	c.mode = mode
+	return c.mode
+}
+
+func (c *maintenanceModeCache) set(mode *model.MaintenanceMode) {
+	c.mu.Lock()
+	c.mode = mode
+	c.expiresAt = c.now().Add(c.ttl)
+	c.mu.Unlock()
+}
+
+// SetMaintenanceMode puts the whole sysdb in maintenance, or takes it out of
+// it. This replica applies the change right away, the others once their cached
+// mode expires. Background jobs are not paused by maintenance.
+func (s *Coordinator) SetMaintenanceMode(ctx context.Context, enabled bool, reason string) error {
+	reason = strings.TrimSpace(reason)
+	if enabled && reason == "" {
+		return common.ErrMaintenanceReasonRequired
+	}
+	mode := &model.MaintenanceMode{Enabled: enabled, Reason: reason}
+	err := s.catalog.SetMaintenanceMode(ctx, mode)
+	if err != nil {
+		return err
+	}
+	log.Info("maintenance mode set", zap.Bool("enabled", enabled), zap.String("reason", reason))
+	mode.UpdatedAt = time.Now()
+	s.maintenanceMode.set(mode)
+	return nil
+}
+
+// GetMaintenanceMode reads the maintenance mode from the sysdb, bypassing the
+// cache.
+func (s *Coordinator) GetMaintenanceMode(ctx context.Context) (*model.MaintenanceMode, error) {
+	return s.catalog.GetMaintenanceMode(ctx)
+}
+
+// InMaintenance tells whether mutations must be rejected, and with which
+// reason. It is served from the cached mode.
+func (s *Coordinator) InMaintenance(ctx context.Context) (bool, string) {
+	mode := s.maintenanceMode.get(ctx)
+	return mode.Enabled, mode.Reason
+}
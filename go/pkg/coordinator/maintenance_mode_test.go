@@ -0,0 +1,53 @@
+package coordinator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/mocks"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMaintenanceModeCache(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	cache := newMaintenanceModeCache(catalog, time.Minute)
+	now := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+
+	// nothing read yet, the sysdb is not in maintenance
+	catalog.On("GetMaintenanceMode", mock.Anything).Return(nil, errors.New("connection refused")).Once()
+	assert.False(t, cache.get(context.Background()).Enabled)
+
+	catalog.On("GetMaintenanceMode", mock.Anything).Return(&model.MaintenanceMode{Enabled: true, Reason: "migration"}, nil).Once()
+	now = now.Add(time.Minute)
+	assert.True(t, cache.get(context.Background()).Enabled)
+	assert.True(t, cache.get(context.Background()).Enabled)
+	catalog.AssertNumberOfCalls(t, "GetMaintenanceMode", 2)
+
+	// the last known mode is served when the read fails
+	catalog.On("GetMaintenanceMode", mock.Anything).Return(nil, errors.New("connection refused")).Once()
+	now = now.Add(time.Minute)
+	mode := cache.get(context.Background())
+	assert.True(t, mode.Enabled)
+	assert.Equal(t, "migration", mode.Reason)
+}
+
+func TestSetMaintenanceMode(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{ctx: context.Background(), catalog: catalog, maintenanceMode: newMaintenanceModeCache(catalog, time.Minute)}
+
+	assert.ErrorIs(t, c.SetMaintenanceMode(context.Background(), true, " "), common.ErrMaintenanceReasonRequired)
+
+	catalog.On("SetMaintenanceMode", mock.Anything, &model.MaintenanceMode{Enabled: true, Reason: "migration"}).Return(nil)
+	assert.NoError(t, c.SetMaintenanceMode(context.Background(), true, "migration"))
+	// applied on this replica without reading it back
+	inMaintenance, reason := c.InMaintenance(context.Background())
+	assert.True(t, inMaintenance)
+	assert.Equal(t, "migration", reason)
+	catalog.AssertNotCalled(t, "GetMaintenanceMode", mock.Anything)
+}
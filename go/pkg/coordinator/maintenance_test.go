@@ -0,0 +1,80 @@
+package coordinator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMaintenanceJob_Config(t *testing.T) {
+	job, err := newMaintenanceJob(Config{}, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+
+	_, err = newMaintenanceJob(Config{MaintenanceWindowStartHour: 24, MaintenanceWindowDuration: time.Hour}, nil)
+	assert.ErrorIs(t, err, common.ErrInvalidMaintenanceWindow)
+
+	_, err = newMaintenanceJob(Config{MaintenanceWindowDuration: time.Hour, MaintenanceTables: []string{"tenants"}}, nil)
+	assert.ErrorIs(t, err, common.ErrUnknownMaintenanceTable)
+
+	job, err = newMaintenanceJob(Config{MaintenanceWindowDuration: time.Hour}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultMaintenanceTables, job.tables)
+}
+
+func TestMaintenanceJob_WindowStart(t *testing.T) {
+	job, err := newMaintenanceJob(Config{MaintenanceWindowStartHour: 23, MaintenanceWindowDuration: 2 * time.Hour}, nil)
+	assert.NoError(t, err)
+
+	// the window runs past midnight
+	start, open := job.windowStart(time.Date(2026, 10, 14, 23, 30, 0, 0, time.UTC))
+	assert.True(t, open)
+	assert.Equal(t, time.Date(2026, 10, 14, 23, 0, 0, 0, time.UTC), start)
+	start, open = job.windowStart(time.Date(2026, 10, 15, 0, 30, 0, 0, time.UTC))
+	assert.True(t, open)
+	assert.Equal(t, time.Date(2026, 10, 14, 23, 0, 0, 0, time.UTC), start)
+
+	_, open = job.windowStart(time.Date(2026, 10, 15, 1, 0, 0, 0, time.UTC))
+	assert.False(t, open)
+	_, open = job.windowStart(time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC))
+	assert.False(t, open)
+}
+
+func TestMaintenanceJob_RunIfDue(t *testing.T) {
+	mockMetaDomain := &mocks.IMetaDomain{}
+	mockMaintenanceDb := &mocks.IMaintenanceDb{}
+	mockMetaDomain.On("MaintenanceDb", mock.Anything).Return(mockMaintenanceDb)
+	mockMaintenanceDb.On("Vacuum", "record_logs").Return(errors.New("canceling statement due to lock timeout"))
+	mockMaintenanceDb.On("Vacuum", "collection_metadata").Return(nil)
+
+	job, err := newMaintenanceJob(Config{MaintenanceWindowStartHour: 3, MaintenanceWindowDuration: time.Hour, MaintenanceVacuum: true}, mockMetaDomain)
+	assert.NoError(t, err)
+	now := time.Date(2026, 10, 14, 2, 0, 0, 0, time.UTC)
+	job.now = func() time.Time { return now }
+
+	// outside the window
+	job.runIfDue(context.Background())
+	mockMaintenanceDb.AssertNotCalled(t, "Vacuum", mock.Anything)
+
+	// a failing table does not stop the others
+	now = now.Add(90 * time.Minute)
+	job.runIfDue(context.Background())
+	mockMaintenanceDb.AssertNumberOfCalls(t, "Vacuum", 2)
+
+	// once per window
+	now = now.Add(10 * time.Minute)
+	job.runIfDue(context.Background())
+	mockMaintenanceDb.AssertNumberOfCalls(t, "Vacuum", 2)
+
+	// and again the next day
+	now = now.Add(24 * time.Hour)
+	job.runIfDue(context.Background())
+	mockMaintenanceDb.AssertNumberOfCalls(t, "Vacuum", 4)
+	mockMaintenanceDb.AssertNotCalled(t, "Analyze", mock.Anything)
+}
@@ -0,0 +1,15 @@
+package coordinator
+
+import "github.com/chroma-core/chroma/go/pkg/common"
+
+// verifyName checks name against the naming policy of kind from the config.
+func (s *Coordinator) verifyName(kind common.NameKind, name string) error {
+	switch kind {
+	case common.NameKindTenant:
+		return s.config.TenantNaming.Validate(kind, name)
+	case common.NameKindDatabase:
+		return s.config.DatabaseNaming.Validate(kind, name)
+	default:
+		return s.config.CollectionNaming.Validate(kind, name)
+	}
+}
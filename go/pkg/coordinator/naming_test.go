@@ -0,0 +1,50 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/mocks"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyName(t *testing.T) {
+	pattern, err := common.CompileNamePattern(`[a-z0-9_]+`)
+	assert.NoError(t, err)
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{
+		ctx:     context.Background(),
+		catalog: catalog,
+		config: Config{
+			TenantNaming:     common.NamingPolicy{ReservedPrefixes: []string{"_system"}},
+			CollectionNaming: common.NamingPolicy{ReservedPrefixes: []string{"_system"}, Pattern: pattern, MaxLength: 8},
+		},
+	}
+
+	var nameErr *common.NameError
+	_, err = c.CreateTenant(context.Background(), &model.CreateTenant{Name: "_system_tenant"})
+	assert.ErrorAs(t, err, &nameErr)
+	assert.Equal(t, common.NameKindTenant, nameErr.Kind)
+	assert.ErrorIs(t, err, common.ErrNameReserved)
+	_, err = c.CreateDatabase(context.Background(), &model.CreateDatabase{Tenant: "tenant"})
+	assert.ErrorIs(t, err, common.ErrNameEmpty)
+
+	_, err = c.CreateCollection(context.Background(), &model.CreateCollection{Name: ""})
+	assert.ErrorIs(t, err, common.ErrCollectionNameEmpty)
+	assert.NoError(t, c.verifyName(common.NameKindCollection, "abc"))
+	assert.ErrorIs(t, c.verifyName(common.NameKindCollection, "abcdefghi"), common.ErrNameTooLong)
+	// the pattern has to match the whole name
+	assert.ErrorIs(t, c.verifyName(common.NameKindCollection, "abc-d"), common.ErrNameInvalid)
+	newName := "ABC"
+	_, _, err = c.UpdateCollection(context.Background(), &model.UpdateCollection{ID: types.NewUniqueID(), Name: &newName})
+	assert.ErrorIs(t, err, common.ErrNameInvalid)
+	_, err = c.RestoreCollection(context.Background(), types.NewUniqueID(), &newName)
+	assert.True(t, common.IsNameError(err))
+
+	_, err = c.BatchCreateTenants(context.Background(), []*model.BatchCreateTenant{{Name: "tenant", DatabaseName: ""}, {Name: "_system"}})
+	assert.ErrorIs(t, err, common.ErrNameReserved)
+	catalog.AssertExpectations(t)
+}
@@ -0,0 +1,165 @@
+package coordinator
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/chroma-core/chroma/go/pkg/utils"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+const defaultDecommissionDrainPeriod = 2 * time.Minute
+
+// DecommissionNode takes a query node out of service. Collections are placed on
+// other nodes right away, and the node is marked draining in the query
+// memberlist so that clients route its collections to other nodes. It is
+// removed from the memberlist once the memberlist places no collection on it
+// and the drain period has passed. Decommissioning a node again returns the
+// progress of the decommission in effect, so callers poll it.
+func (s *Coordinator) DecommissionNode(ctx context.Context, nodeID string) (*model.NodeDecommissionProgress, error) {
+	if s.queryMembers == nil {
+		return nil, common.ErrNoQueryNodes
+	}
+	decommissions, err := s.catalog.GetNodeDecommissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.queryMembers.source(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, decommission := range decommissions {
+		if decommission.NodeID == nodeID {
+			return s.decommissionProgress(ctx, decommission, members)
+		}
+	}
+
+	if !slices.Contains(members, nodeID) {
+		return nil, common.ErrNodeNotFound
+	}
+	if len(membersInService(members, decommissions)) <= 1 {
+		return nil, common.ErrLastQueryNode
+	}
+	collections, err := s.countCollectionsPlacedOn(ctx, members, nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	drainPeriod := s.config.DecommissionDrainPeriod
+	if drainPeriod <= 0 {
+		drainPeriod = defaultDecommissionDrainPeriod
+	}
+	now := time.Now().UTC()
+	decommission, err := s.catalog.CreateNodeDecommission(ctx, &model.NodeDecommission{
+		NodeID:      nodeID,
+		StartedAt:   now,
+		DrainUntil:  now.Add(drainPeriod),
+		Collections: collections,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.queryMembers.invalidate()
+	log.Info("decommissioning node", zap.String("nodeID", nodeID), zap.Int64("collections", collections), zap.Time("drainUntil", decommission.DrainUntil))
+	return s.decommissionProgress(ctx, decommission, members)
+}
+
+// decommissionProgress counts the collections that members, the memberlist
+// members clients route to, still place on the node of decommission.
+func (s *Coordinator) decommissionProgress(ctx context.Context, decommission *model.NodeDecommission, members []string) (*model.NodeDecommissionProgress, error) {
+	progress := &model.NodeDecommissionProgress{Decommission: decommission, State: model.NodeDecommissionDraining}
+	if slices.Contains(members, decommission.NodeID) {
+		// draining until the memberlist marks it, even when it places nothing
+		// on the node, as new collections may still be placed there
+		remaining, err := s.countCollectionsPlacedOn(ctx, members, decommission.NodeID)
+		if err != nil {
+			return nil, err
+		}
+		progress.RemainingCollections = remaining
+		return progress, nil
+	}
+	if !time.Now().Before(decommission.DrainUntil) {
+		progress.State = model.NodeDecommissionRemoved
+	}
+	return progress, nil
+}
+
+// countCollectionsPlacedOn counts the collections that a placement over members
+// puts on nodeID.
+func (s *Coordinator) countCollectionsPlacedOn(ctx context.Context, members []string, nodeID string) (int64, error) {
+	members = slices.Clone(members)
+	slices.Sort(members)
+	count := int64(0)
+	for offset := int32(0); ; offset += simulationPageSize {
+		pageSize, pageOffset := simulationPageSize, offset
+		collections, err := s.catalog.GetCollections(ctx, types.NilUniqueID(), nil, "", "", &pageSize, &pageOffset, nil, &model.CollectionFields{})
+		if err != nil {
+			return 0, err
+		}
+		for _, collection := range collections {
+			nodes, err := utils.AssignN(collection.ID.String(), members, utils.Murmur3Hasher, int(max(collection.ReplicationFactor, 1)))
+			if err != nil {
+				return 0, err
+			}
+			if slices.Contains(nodes, nodeID) {
+				count++
+			}
+		}
+		if int32(len(collections)) < simulationPageSize {
+			return count, nil
+		}
+	}
+}
+
+// CancelNodeDecommission puts a node back in service, or forgets a removed node
+// so that a new node can reuse its id.
+func (s *Coordinator) CancelNodeDecommission(ctx context.Context, nodeID string) error {
+	err := s.catalog.DeleteNodeDecommission(ctx, nodeID)
+	if err != nil {
+		return err
+	}
+	if s.queryMembers != nil {
+		s.queryMembers.invalidate()
+	}
+	return nil
+}
+
+// DecommissionedMembers returns, out of the ready members of the query
+// memberlist, the decommissioned nodes to mark draining and the ones to remove.
+// A node is removed once the memberlist marks it draining and its drain period
+// has passed. A removed node whose pod is gone is forgotten, so that a new pod
+// with its name joins the memberlist.
+func (s *Coordinator) DecommissionedMembers(ctx context.Context, ready []string) ([]string, []string, error) {
+	decommissions, err := s.catalog.GetNodeDecommissions(ctx)
+	if err != nil || len(decommissions) == 0 || s.queryMembers == nil {
+		return nil, nil, err
+	}
+	members, err := s.queryMembers.source(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	var draining, removed []string
+	for _, decommission := range decommissions {
+		switch {
+		case slices.Contains(members, decommission.NodeID) || now.Before(decommission.DrainUntil):
+			draining = append(draining, decommission.NodeID)
+		case slices.Contains(ready, decommission.NodeID):
+			removed = append(removed, decommission.NodeID)
+		default:
+			err := s.catalog.DeleteNodeDecommission(ctx, decommission.NodeID)
+			if err != nil && err != common.ErrNodeDecommissionNotFound {
+				log.Warn("failed to forget removed node", zap.String("nodeID", decommission.NodeID), zap.Error(err))
+				continue
+			}
+			log.Info("forgot removed node", zap.String("nodeID", decommission.NodeID))
+			s.queryMembers.invalidate()
+		}
+	}
+	return draining, removed, nil
+}
@@ -0,0 +1,98 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/mocks"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDecommissionNode(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{ctx: context.Background(), catalog: catalog, config: Config{DecommissionDrainPeriod: time.Minute}}
+	ctx := context.Background()
+
+	_, err := c.DecommissionNode(ctx, "query-0")
+	assert.ErrorIs(t, err, common.ErrNoQueryNodes)
+
+	members := []string{"query-0", "query-1"}
+	c.SetQueryMemberlist(func(ctx context.Context) ([]string, error) { return members, nil })
+	var decommissions []*model.NodeDecommission
+	catalog.On("GetNodeDecommissions", mock.Anything).Return(func(context.Context) []*model.NodeDecommission { return decommissions }, nil)
+	catalog.On("CreateNodeDecommission", mock.Anything, mock.Anything).Return(func(_ context.Context, d *model.NodeDecommission) *model.NodeDecommission {
+		decommissions = append(decommissions, d)
+		return d
+	}, nil)
+
+	_, err = c.DecommissionNode(ctx, "query-9")
+	assert.ErrorIs(t, err, common.ErrNodeNotFound)
+
+	collectionID := types.NewUniqueID()
+	catalog.On("GetCollections", mock.Anything, collectionID, (*string)(nil), "", "", (*int32)(nil), (*int32)(nil), mock.Anything, &model.CollectionFields{}).Return([]*model.Collection{{ID: collectionID, ReplicationFactor: 2}}, nil)
+	catalog.On("GetCollections", mock.Anything, types.NilUniqueID(), (*string)(nil), "", "", mock.Anything, mock.Anything, mock.Anything, &model.CollectionFields{}).Return([]*model.Collection{{ID: collectionID, ReplicationFactor: 2}}, nil)
+	before, err := c.GetCollectionPlacement(ctx, collectionID)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, members, before.QueryNodes)
+
+	progress, err := c.DecommissionNode(ctx, "query-0")
+	assert.NoError(t, err)
+	assert.Equal(t, "query-0", progress.Decommission.NodeID)
+	assert.Equal(t, time.Minute, progress.Decommission.DrainUntil.Sub(progress.Decommission.StartedAt))
+	assert.Equal(t, model.NodeDecommissionDraining, progress.State)
+	assert.Equal(t, int64(1), progress.Decommission.Collections)
+	assert.Equal(t, int64(1), progress.RemainingCollections)
+	assert.Equal(t, float64(0), progress.Progress())
+
+	// the node is no longer placed while it drains
+	after, err := c.GetCollectionPlacement(ctx, collectionID)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"query-1"}, after.QueryNodes)
+	assert.NotEqual(t, before.Version, after.Version)
+
+	// decommissioning the node again returns its progress
+	again, err := c.DecommissionNode(ctx, "query-0")
+	assert.NoError(t, err)
+	assert.Same(t, progress.Decommission, again.Decommission)
+	catalog.AssertNumberOfCalls(t, "CreateNodeDecommission", 1)
+
+	_, err = c.DecommissionNode(ctx, "query-1")
+	assert.ErrorIs(t, err, common.ErrLastQueryNode)
+
+	// the memberlist is told to mark the node draining
+	ready := []string{"query-0", "query-1"}
+	draining, removed, err := c.DecommissionedMembers(ctx, ready)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"query-0"}, draining)
+	assert.Empty(t, removed)
+
+	// once it does, nothing is placed on the node, which is removed after the
+	// drain period
+	members = []string{"query-1"}
+	again, err = c.DecommissionNode(ctx, "query-0")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), again.RemainingCollections)
+	assert.Equal(t, float64(1), again.Progress())
+	assert.Equal(t, model.NodeDecommissionDraining, again.State)
+	progress.Decommission.DrainUntil = time.Now().Add(-time.Second)
+	again, err = c.DecommissionNode(ctx, "query-0")
+	assert.NoError(t, err)
+	assert.Equal(t, model.NodeDecommissionRemoved, again.State)
+	draining, removed, err = c.DecommissionedMembers(ctx, ready)
+	assert.NoError(t, err)
+	assert.Empty(t, draining)
+	assert.Equal(t, []string{"query-0"}, removed)
+
+	// a removed node whose pod is gone is forgotten
+	catalog.On("DeleteNodeDecommission", mock.Anything, "query-0").Return(nil)
+	draining, removed, err = c.DecommissionedMembers(ctx, []string{"query-1"})
+	assert.NoError(t, err)
+	assert.Empty(t, draining)
+	assert.Empty(t, removed)
+	catalog.AssertCalled(t, "DeleteNodeDecommission", mock.Anything, "query-0")
+}
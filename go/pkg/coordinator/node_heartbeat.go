@@ -0,0 +1,80 @@
+package coordinator
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+)
+
+const defaultHeartbeatTimeout = 30 * time.Second
+
+// heartbeatRetention is how many heartbeat timeouts the heartbeat of a node that
+// stopped sending them is kept for.
+const heartbeatRetention = 10
+
+// RecordNodeHeartbeat records that a worker node is alive and deletes the
+// heartbeats of the nodes that stopped sending them long ago, so that nodes
+// that were scaled away do not accumulate.
+func (s *Coordinator) RecordNodeHeartbeat(ctx context.Context, nodeID string) error {
+	if nodeID == "" {
+		return common.ErrInvalidNodeID
+	}
+	now := time.Now().UTC()
+	return s.catalog.RecordNodeHeartbeat(ctx, nodeID, now, now.Add(-heartbeatRetention*s.heartbeatTimeout()))
+}
+
+func (s *Coordinator) heartbeatTimeout() time.Duration {
+	if s.config.HeartbeatTimeout <= 0 {
+		return defaultHeartbeatTimeout
+	}
+	return s.config.HeartbeatTimeout
+}
+
+// GetNodeLiveness returns every member of the query memberlist, decommissioned
+// or not, and the nodes outside of it that sent a heartbeat within the timeout,
+// sorted by id.
+func (s *Coordinator) GetNodeLiveness(ctx context.Context) ([]*model.NodeLiveness, error) {
+	var members []string
+	if s.queryMembers != nil {
+		var err error
+		members, err = s.queryMembers.source(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	heartbeats, err := s.catalog.GetNodeHeartbeats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := s.heartbeatTimeout()
+	now := time.Now()
+	nodes := make(map[string]*model.NodeLiveness, len(members))
+	for _, member := range members {
+		nodes[member] = &model.NodeLiveness{NodeID: member, InMemberlist: true}
+	}
+	for _, heartbeat := range heartbeats {
+		alive := now.Sub(heartbeat.LastSeen) <= timeout
+		node, ok := nodes[heartbeat.NodeID]
+		if !ok {
+			if !alive {
+				// a node that left the memberlist long ago
+				continue
+			}
+			node = &model.NodeLiveness{NodeID: heartbeat.NodeID}
+			nodes[heartbeat.NodeID] = node
+		}
+		node.LastSeen = heartbeat.LastSeen
+		node.Alive = alive
+	}
+
+	result := make([]*model.NodeLiveness, 0, len(nodes))
+	for _, node := range nodes {
+		result = append(result, node)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].NodeID < result[j].NodeID })
+	return result, nil
+}
@@ -0,0 +1,57 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/mocks"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRecordNodeHeartbeat(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{ctx: context.Background(), catalog: catalog}
+	ctx := context.Background()
+
+	assert.ErrorIs(t, c.RecordNodeHeartbeat(ctx, ""), common.ErrInvalidNodeID)
+
+	catalog.On("RecordNodeHeartbeat", mock.Anything, "query-0", mock.Anything,
+		mock.MatchedBy(func(expireBefore time.Time) bool {
+			return time.Since(expireBefore) >= heartbeatRetention*defaultHeartbeatTimeout
+		})).Return(nil)
+	assert.NoError(t, c.RecordNodeHeartbeat(ctx, "query-0"))
+	catalog.AssertExpectations(t)
+}
+
+func TestGetNodeLiveness(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{ctx: context.Background(), catalog: catalog, config: Config{HeartbeatTimeout: time.Minute}}
+	ctx := context.Background()
+	c.SetQueryMemberlist(func(ctx context.Context) ([]string, error) {
+		return []string{"query-1", "query-0", "query-2"}, nil
+	})
+
+	now := time.Now()
+	catalog.On("GetNodeHeartbeats", mock.Anything).Return([]*model.NodeHeartbeat{
+		{NodeID: "query-0", LastSeen: now.Add(-time.Second)},
+		{NodeID: "query-1", LastSeen: now.Add(-time.Hour)},
+		{NodeID: "query-3", LastSeen: now.Add(-time.Second)},
+		{NodeID: "query-4", LastSeen: now.Add(-time.Hour)},
+	}, nil)
+
+	nodes, err := c.GetNodeLiveness(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []*model.NodeLiveness{
+		{NodeID: "query-0", LastSeen: now.Add(-time.Second), InMemberlist: true, Alive: true},
+		// in the memberlist but wedged
+		{NodeID: "query-1", LastSeen: now.Add(-time.Hour), InMemberlist: true},
+		// never sent a heartbeat
+		{NodeID: "query-2", InMemberlist: true},
+		// alive but not yet in the memberlist
+		{NodeID: "query-3", LastSeen: now.Add(-time.Second), Alive: true},
+	}, nodes)
+}
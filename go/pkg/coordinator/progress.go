@@ -0,0 +1,152 @@
+package coordinator
+
+import (
+	"context"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/model"
+)
+
+// progressReportInterval is the shortest interval between two progress
+// reports of an operation, so that an operation processing many small
+// entities does not flood its stream with them.
+const progressReportInterval = time.Second
+
+// progressReporter estimates the time left of an operation from the rate it
+// went at so far, and reports its progress at most every interval.
+type progressReporter struct {
+	report   func(*model.OperationProgress) error
+	interval time.Duration
+	now      func() time.Time
+	start    time.Time
+	last     time.Time
+	// err is the first error of report, which ends the reports
+	err error
+}
+
+func newProgressReporter(report func(*model.OperationProgress) error) *progressReporter {
+	p := &progressReporter{report: report, interval: progressReportInterval, now: time.Now}
+	p.start = p.now()
+	p.last = p.start
+	return p
+}
+
+// progress returns the progress of the operation once it processed processed
+// entities out of total.
+func (p *progressReporter) progress(processed int64, total int64) *model.OperationProgress {
+	progress := &model.OperationProgress{Processed: processed, Total: total}
+	if processed > 0 && total > processed {
+		elapsed := p.now().Sub(p.start)
+		progress.ETA = time.Duration(float64(elapsed) / float64(processed) * float64(total-processed))
+	}
+	return progress
+}
+
+// update reports the progress unless the last report is more recent than the
+// interval.
+func (p *progressReporter) update(processed int64, total int64) {
+	if p.err != nil || p.now().Sub(p.last) < p.interval {
+		return
+	}
+	p.send(p.progress(processed, total))
+}
+
+func (p *progressReporter) send(progress *model.OperationProgress) {
+	if p.err != nil {
+		return
+	}
+	p.last = p.now()
+	p.err = p.report(progress)
+}
+
+// progressUpdate is the progress an operation passed to its ProgressFunc.
+type progressUpdate struct {
+	processed int64
+	total     int64
+}
+
+// runWithProgress runs op in a goroutine of its own and reports the progress
+// it passes to its ProgressFunc from the calling goroutine. The ProgressFunc
+// never blocks, it replaces the update the reporter did not take yet, so that
+// an op that runs in a transaction never holds its locks while a slow client
+// takes a report. It returns the last progress of op.
+func runWithProgress(op func(model.ProgressFunc) error, reporter *progressReporter) (progressUpdate, error) {
+	updates := make(chan progressUpdate, 1)
+	progress := func(processed int64, total int64) {
+		update := progressUpdate{processed: processed, total: total}
+		select {
+		case updates <- update:
+		default:
+			select {
+			case <-updates:
+			default:
+			}
+			// the reporter only takes updates, so there is room for this one
+			updates <- update
+		}
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- op(progress)
+	}()
+	var last progressUpdate
+	for {
+		select {
+		case last = <-updates:
+			reporter.update(last.processed, last.total)
+		case err := <-done:
+			select {
+			case last = <-updates:
+			default:
+			}
+			return last, err
+		}
+	}
+}
+
+// DeleteTenantWithProgress deletes a tenant like DeleteTenant does, and
+// reports the collections purged by a force deletion out of the collections
+// of the tenant as it goes, and once the deletion is committed. The deletion
+// runs in a transaction, so a failed deletion leaves the tenant as it was even
+// when some progress was reported.
+func (s *Coordinator) DeleteTenantWithProgress(ctx context.Context, deleteTenant *model.DeleteTenant, report func(*model.OperationProgress) error) error {
+	reporter := newProgressReporter(report)
+	last, err := runWithProgress(func(progress model.ProgressFunc) error {
+		deleteTenant.Progress = progress
+		return s.DeleteTenant(ctx, deleteTenant)
+	}, reporter)
+	if err != nil {
+		return err
+	}
+	reporter.send(&model.OperationProgress{Processed: last.processed, Total: last.total})
+	return reporter.err
+}
+
+// CheckConsistencyWithProgress finds the collections GetInconsistentCollections
+// finds, one kind of inconsistency at a time, and reports the checks done out
+// of the checks to run along with the collections found by the last one.
+func (s *Coordinator) CheckConsistencyWithProgress(ctx context.Context, minAge time.Duration, report func(*model.OperationProgress, []*model.InconsistentCollection) error) error {
+	if minAge <= 0 {
+		minAge = defaultInconsistentCollectionMinAge
+	}
+	deletedBefore := time.Now().Add(-minAge)
+	total := int64(len(model.InconsistencyCheckKinds))
+	if s.logOffsetReconciler != nil {
+		total++
+	}
+	// every check is reported with its findings, the reporter only estimates
+	reporter := newProgressReporter(nil)
+	for i, kind := range model.InconsistencyCheckKinds {
+		collections, err := s.catalog.GetInconsistentCollections(ctx, deletedBefore, []model.InconsistencyKind{kind})
+		if err != nil {
+			return err
+		}
+		if err := report(reporter.progress(int64(i+1), total), collections); err != nil {
+			return err
+		}
+	}
+	if s.logOffsetReconciler != nil {
+		return report(reporter.progress(total, total), s.logOffsetReconciler.latestFindings())
+	}
+	return nil
+}
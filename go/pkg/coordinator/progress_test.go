@@ -0,0 +1,93 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/mocks"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestProgressReporter(t *testing.T) {
+	now := time.Unix(1000, 0)
+	var reports []*model.OperationProgress
+	p := newProgressReporter(func(progress *model.OperationProgress) error {
+		reports = append(reports, progress)
+		return nil
+	})
+	p.now = func() time.Time { return now }
+	p.start, p.last = now, now
+
+	// reports are throttled to the interval
+	now = now.Add(progressReportInterval / 2)
+	p.update(1, 10)
+	assert.Empty(t, reports)
+
+	// 2 collections in 2s leave 8 collections for 8s
+	now = now.Add(progressReportInterval + progressReportInterval/2)
+	p.update(2, 10)
+	assert.Equal(t, []*model.OperationProgress{{Processed: 2, Total: 10, ETA: 8 * time.Second}}, reports)
+
+	assert.Zero(t, p.progress(0, 10).ETA)
+	assert.Zero(t, p.progress(10, 10).ETA)
+}
+
+func TestRunWithProgress(t *testing.T) {
+	// a client that does not take its reports does not hold up the operation
+	blocked := make(chan struct{})
+	reporter := newProgressReporter(func(*model.OperationProgress) error {
+		<-blocked
+		return nil
+	})
+	reporter.interval = 0
+	opDone := make(chan struct{})
+	finished := make(chan progressUpdate, 1)
+	go func() {
+		last, err := runWithProgress(func(progress model.ProgressFunc) error {
+			for i := int64(1); i <= 100; i++ {
+				progress(i, 100)
+			}
+			close(opDone)
+			return nil
+		}, reporter)
+		assert.NoError(t, err)
+		finished <- last
+	}()
+	select {
+	case <-opDone:
+	case <-time.After(time.Second):
+		t.Fatal("operation blocked on its reports")
+	}
+	close(blocked)
+	assert.Equal(t, progressUpdate{processed: 100, total: 100}, <-finished)
+}
+
+func TestCheckConsistencyWithProgress(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{ctx: context.Background(), catalog: catalog}
+	found := &model.InconsistentCollection{ID: types.NewUniqueID(), Kind: model.InconsistencyMissingSegments}
+	for _, kind := range model.InconsistencyCheckKinds {
+		var collections []*model.InconsistentCollection
+		if kind == model.InconsistencyMissingSegments {
+			collections = []*model.InconsistentCollection{found}
+		}
+		catalog.On("GetInconsistentCollections", mock.Anything, mock.Anything, []model.InconsistencyKind{kind}).Return(collections, nil).Once()
+	}
+
+	var processed []int64
+	var collections []*model.InconsistentCollection
+	err := c.CheckConsistencyWithProgress(context.Background(), 0, func(progress *model.OperationProgress, found []*model.InconsistentCollection) error {
+		assert.Equal(t, int64(len(model.InconsistencyCheckKinds)), progress.Total)
+		processed = append(processed, progress.Processed)
+		collections = append(collections, found...)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 3, 4}, processed)
+	assert.Equal(t, []*model.InconsistentCollection{found}, collections)
+	catalog.AssertExpectations(t)
+}
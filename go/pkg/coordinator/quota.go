@@ -0,0 +1,215 @@
+package coordinator
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+)
+
+const defaultQuotaCacheTTL = 10 * time.Second
+
+// quotaCache keeps the quota and usage of recently checked tenants and
+// databases so that CheckQuota does not scan their collections on every call.
+// Usage is at most ttl stale, which is fine for a check that runs ahead of the
+// write.
+type quotaCache struct {
+	catalog metastore.Catalog
+	ttl     time.Duration
+	now     func() time.Time
+
+	mu      sync.Mutex
+	entries map[quotaCacheKey]*quotaCacheEntry
+}
+
+// quotaCacheKey has an empty databaseName and collectionID for the entry of a
+// whole tenant.
+type quotaCacheKey struct {
+	tenantID     string
+	databaseName string
+	collectionID types.UniqueID
+}
+
+type quotaCacheEntry struct {
+	quota           *model.TenantQuota
+	databaseQuota   *model.DatabaseQuota
+	collectionQuota *model.CollectionQuota
+	usage           *model.QuotaUsage
+	expiresAt       time.Time
+}
+
+func newQuotaCache(catalog metastore.Catalog, ttl time.Duration) *quotaCache {
+	if ttl <= 0 {
+		ttl = defaultQuotaCacheTTL
+	}
+	return &quotaCache{
+		catalog: catalog,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[quotaCacheKey]*quotaCacheEntry),
+	}
+}
+
+func (c *quotaCache) get(ctx context.Context, tenantID string) (*model.TenantQuota, *model.QuotaUsage, error) {
+	entry, err := c.load(quotaCacheKey{tenantID: tenantID}, func() (*quotaCacheEntry, error) {
+		quota, usage, err := c.catalog.GetTenantQuotaAndUsage(ctx, tenantID)
+		return &quotaCacheEntry{quota: quota, usage: usage}, err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return entry.quota, entry.usage, nil
+}
+
+func (c *quotaCache) getDatabase(ctx context.Context, tenantID string, databaseName string) (*model.DatabaseQuota, *model.QuotaUsage, error) {
+	entry, err := c.load(quotaCacheKey{tenantID: tenantID, databaseName: databaseName}, func() (*quotaCacheEntry, error) {
+		quota, usage, err := c.catalog.GetDatabaseQuotaAndUsage(ctx, tenantID, databaseName)
+		return &quotaCacheEntry{databaseQuota: quota, usage: usage}, err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return entry.databaseQuota, entry.usage, nil
+}
+
+func (c *quotaCache) getCollection(ctx context.Context, tenantID string, collectionID types.UniqueID) (*model.CollectionQuota, *model.QuotaUsage, error) {
+	entry, err := c.load(quotaCacheKey{tenantID: tenantID, collectionID: collectionID}, func() (*quotaCacheEntry, error) {
+		quota, usage, err := c.catalog.GetCollectionQuotaAndUsage(ctx, tenantID, collectionID)
+		return &quotaCacheEntry{collectionQuota: quota, usage: usage}, err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return entry.collectionQuota, entry.usage, nil
+}
+
+func (c *quotaCache) load(key quotaCacheKey, fetch func() (*quotaCacheEntry, error)) (*quotaCacheEntry, error) {
+	now := c.now()
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry, nil
+	}
+
+	entry, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	entry.expiresAt = now.Add(c.ttl)
+	c.mu.Lock()
+	c.entries[key] = entry
+	// expired entries of tenants that are no longer checked are dropped on the way
+	for k, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+	c.mu.Unlock()
+	return entry, nil
+}
+
+// invalidate drops the entries of a tenant and of all of its databases and
+// collections.
+func (c *quotaCache) invalidate(tenantID string) {
+	c.mu.Lock()
+	for key := range c.entries {
+		if key.tenantID == tenantID {
+			delete(c.entries, key)
+		}
+	}
+	c.mu.Unlock()
+}
+
+// SetTenantQuota replaces the limits of a tenant. Other replicas pick up the new
+// limits once their cached entry expires.
+func (s *Coordinator) SetTenantQuota(ctx context.Context, quota *model.TenantQuota) error {
+	if err := s.beforeWrite(ctx, &WriteRequest{Operation: WriteSetTenantQuota, Tenant: quota.TenantID, Request: quota}); err != nil {
+		return err
+	}
+	for _, limit := range []*int64{quota.MaxCollections, quota.MaxRecords, quota.MaxSizeBytes} {
+		if limit != nil && *limit < 0 {
+			return common.ErrInvalidTenantQuota
+		}
+	}
+	err := s.catalog.SetTenantQuota(ctx, quota)
+	if err != nil {
+		return err
+	}
+	s.quotas.invalidate(quota.TenantID)
+	return nil
+}
+
+func (s *Coordinator) GetTenantQuota(ctx context.Context, tenantID string) (*model.TenantQuota, error) {
+	return s.catalog.GetTenantQuota(ctx, tenantID)
+}
+
+// SetDatabaseQuota replaces the limits a database overrides. Limits left unset
+// are inherited from the tenant.
+func (s *Coordinator) SetDatabaseQuota(ctx context.Context, quota *model.DatabaseQuota) error {
+	if err := s.beforeWrite(ctx, &WriteRequest{Operation: WriteSetDatabaseQuota, Tenant: quota.TenantID, Database: quota.DatabaseName, Request: quota}); err != nil {
+		return err
+	}
+	for _, limit := range []*int64{quota.MaxCollections, quota.MaxRecords, quota.MaxSizeBytes} {
+		if limit != nil && *limit < 0 {
+			return common.ErrInvalidTenantQuota
+		}
+	}
+	err := s.catalog.SetDatabaseQuota(ctx, quota)
+	if err != nil {
+		return err
+	}
+	s.quotas.invalidate(quota.TenantID)
+	return nil
+}
+
+// GetEffectiveQuota returns the limits that apply to a database, resolved from
+// its overrides and the quota of its tenant.
+func (s *Coordinator) GetEffectiveQuota(ctx context.Context, tenantID string, databaseName string) (*model.EffectiveQuota, error) {
+	tenantQuota, databaseQuota, err := s.catalog.GetDatabaseQuota(ctx, tenantID, databaseName)
+	if err != nil {
+		return nil, err
+	}
+	return model.ResolveQuota(tenantQuota, databaseQuota), nil
+}
+
+// CheckQuota reports whether a write that changes the usage of a tenant by delta
+// stays within its quota and, when databaseName is set, within the limits the
+// database overrides. When delta has a CollectionID, the write is also checked
+// against the limits of the template the collection was created from. It is
+// meant to be called by frontends before they accept a large write and is
+// served from the quota cache.
+func (s *Coordinator) CheckQuota(ctx context.Context, tenantID string, databaseName string, delta *model.QuotaDelta) (*model.QuotaCheck, error) {
+	quota, usage, err := s.quotas.get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	check := &model.QuotaCheck{
+		Usage:      usage,
+		Quota:      quota,
+		Violations: quota.Check(usage, delta),
+	}
+	if databaseName != "" {
+		databaseQuota, databaseUsage, err := s.quotas.getDatabase(ctx, tenantID, databaseName)
+		if err != nil {
+			return nil, err
+		}
+		check.DatabaseUsage = databaseUsage
+		check.DatabaseQuota = model.ResolveQuota(quota, databaseQuota)
+		check.Violations = append(check.Violations, databaseQuota.Check(databaseUsage, delta)...)
+	}
+	if delta.CollectionID != nil {
+		collectionQuota, collectionUsage, err := s.quotas.getCollection(ctx, tenantID, *delta.CollectionID)
+		if err != nil {
+			return nil, err
+		}
+		check.CollectionUsage = collectionUsage
+		check.CollectionQuota = collectionQuota
+		check.Violations = append(check.Violations, collectionQuota.Check(collectionUsage, delta)...)
+	}
+	return check, nil
+}
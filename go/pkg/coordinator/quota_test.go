@@ -0,0 +1,149 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/mocks"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCheckQuota(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{
+		ctx:     context.Background(),
+		catalog: catalog,
+		quotas:  newQuotaCache(catalog, time.Minute),
+	}
+	now := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+	c.quotas.now = func() time.Time { return now }
+
+	maxRecords := int64(100)
+	quota := &model.TenantQuota{TenantID: "tenant", MaxRecords: &maxRecords}
+	catalog.On("GetTenantQuotaAndUsage", mock.Anything, "tenant").Return(quota, &model.QuotaUsage{Collections: 3, Records: 90}, nil)
+
+	check, err := c.CheckQuota(context.Background(), "tenant", "", &model.QuotaDelta{Records: 10, Collections: 1000})
+	assert.NoError(t, err)
+	assert.True(t, check.Allowed())
+
+	check, err = c.CheckQuota(context.Background(), "tenant", "", &model.QuotaDelta{Records: 11})
+	assert.NoError(t, err)
+	assert.False(t, check.Allowed())
+	assert.Equal(t, []*model.QuotaViolation{{Resource: model.QuotaResourceRecords, Limit: 100, Usage: 90, Delta: 11}}, check.Violations)
+
+	// deletes are allowed over the quota
+	check, err = c.CheckQuota(context.Background(), "tenant", "", &model.QuotaDelta{Records: -5})
+	assert.NoError(t, err)
+	assert.True(t, check.Allowed())
+
+	// served from the cache until the entry expires
+	catalog.AssertNumberOfCalls(t, "GetTenantQuotaAndUsage", 1)
+	now = now.Add(time.Minute)
+	_, err = c.CheckQuota(context.Background(), "tenant", "", &model.QuotaDelta{})
+	assert.NoError(t, err)
+	catalog.AssertNumberOfCalls(t, "GetTenantQuotaAndUsage", 2)
+
+	// setting the quota drops the cached entry
+	catalog.On("SetTenantQuota", mock.Anything, mock.Anything).Return(nil)
+	assert.NoError(t, c.SetTenantQuota(context.Background(), quota))
+	_, err = c.CheckQuota(context.Background(), "tenant", "", &model.QuotaDelta{})
+	assert.NoError(t, err)
+	catalog.AssertNumberOfCalls(t, "GetTenantQuotaAndUsage", 3)
+
+	negative := int64(-1)
+	err = c.SetTenantQuota(context.Background(), &model.TenantQuota{TenantID: "tenant", MaxSizeBytes: &negative})
+	assert.ErrorIs(t, err, common.ErrInvalidTenantQuota)
+}
+
+func TestCheckQuota_Database(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{
+		ctx:     context.Background(),
+		catalog: catalog,
+		quotas:  newQuotaCache(catalog, time.Minute),
+	}
+
+	tenantRecords, databaseRecords := int64(1000), int64(100)
+	tenantQuota := &model.TenantQuota{TenantID: "tenant", MaxRecords: &tenantRecords}
+	databaseQuota := &model.DatabaseQuota{TenantID: "tenant", DatabaseName: "small", MaxRecords: &databaseRecords}
+	catalog.On("GetTenantQuotaAndUsage", mock.Anything, "tenant").Return(tenantQuota, &model.QuotaUsage{Records: 500}, nil)
+	catalog.On("GetDatabaseQuotaAndUsage", mock.Anything, "tenant", "small").Return(databaseQuota, &model.QuotaUsage{Records: 90}, nil)
+
+	// within the tenant but over the limit the database overrides
+	check, err := c.CheckQuota(context.Background(), "tenant", "small", &model.QuotaDelta{Records: 20})
+	assert.NoError(t, err)
+	assert.Equal(t, []*model.QuotaViolation{{Resource: model.QuotaResourceRecords, DatabaseName: "small", Limit: 100, Usage: 90, Delta: 20}}, check.Violations)
+	assert.Equal(t, &databaseRecords, check.DatabaseQuota.MaxRecords)
+
+	// without a database only the tenant is checked
+	check, err = c.CheckQuota(context.Background(), "tenant", "", &model.QuotaDelta{Records: 20})
+	assert.NoError(t, err)
+	assert.True(t, check.Allowed())
+	assert.Nil(t, check.DatabaseQuota)
+
+	// setting the quota of a database drops the cached entries of the tenant
+	catalog.On("SetDatabaseQuota", mock.Anything, mock.Anything).Return(nil)
+	assert.NoError(t, c.SetDatabaseQuota(context.Background(), databaseQuota))
+	_, err = c.CheckQuota(context.Background(), "tenant", "small", &model.QuotaDelta{})
+	assert.NoError(t, err)
+	catalog.AssertNumberOfCalls(t, "GetTenantQuotaAndUsage", 2)
+	catalog.AssertNumberOfCalls(t, "GetDatabaseQuotaAndUsage", 2)
+}
+
+func TestCheckQuota_Collection(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{
+		ctx:     context.Background(),
+		catalog: catalog,
+		quotas:  newQuotaCache(catalog, time.Minute),
+	}
+
+	collectionID, templateID := types.NewUniqueID(), types.NewUniqueID()
+	otherID := types.NewUniqueID()
+	templateRecords := int64(100)
+	catalog.On("GetTenantQuotaAndUsage", mock.Anything, "tenant").Return(&model.TenantQuota{TenantID: "tenant"}, &model.QuotaUsage{Records: 500}, nil)
+	catalog.On("GetCollectionQuotaAndUsage", mock.Anything, "tenant", collectionID).Return(
+		&model.CollectionQuota{CollectionID: collectionID, TemplateID: &templateID, MaxRecords: &templateRecords},
+		&model.QuotaUsage{Records: 90},
+		nil,
+	)
+	catalog.On("GetCollectionQuotaAndUsage", mock.Anything, "tenant", otherID).Return(nil, nil, common.ErrCollectionNotFound)
+
+	// within the tenant but over the limit of the template of the collection
+	check, err := c.CheckQuota(context.Background(), "tenant", "", &model.QuotaDelta{Records: 20, CollectionID: &collectionID})
+	assert.NoError(t, err)
+	assert.Equal(t, []*model.QuotaViolation{{Resource: model.QuotaResourceRecords, CollectionID: &collectionID, Limit: 100, Usage: 90, Delta: 20}}, check.Violations)
+	assert.Equal(t, &templateID, check.CollectionQuota.TemplateID)
+
+	check, err = c.CheckQuota(context.Background(), "tenant", "", &model.QuotaDelta{Records: 10, CollectionID: &collectionID})
+	assert.NoError(t, err)
+	assert.True(t, check.Allowed())
+	catalog.AssertNumberOfCalls(t, "GetCollectionQuotaAndUsage", 1)
+
+	_, err = c.CheckQuota(context.Background(), "tenant", "", &model.QuotaDelta{CollectionID: &otherID})
+	assert.ErrorIs(t, err, common.ErrCollectionNotFound)
+}
+
+func TestGetEffectiveQuota(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{ctx: context.Background(), catalog: catalog}
+
+	tenantCollections, tenantRecords, databaseRecords := int64(10), int64(1000), int64(100)
+	catalog.On("GetDatabaseQuota", mock.Anything, "tenant", "db").Return(
+		&model.TenantQuota{TenantID: "tenant", MaxCollections: &tenantCollections, MaxRecords: &tenantRecords},
+		&model.DatabaseQuota{TenantID: "tenant", DatabaseName: "db", MaxRecords: &databaseRecords},
+		nil,
+	)
+
+	quota, err := c.GetEffectiveQuota(context.Background(), "tenant", "db")
+	assert.NoError(t, err)
+	assert.Equal(t, &tenantCollections, quota.MaxCollections)
+	assert.Equal(t, &databaseRecords, quota.MaxRecords)
+	assert.Nil(t, quota.MaxSizeBytes)
+	assert.Equal(t, []model.QuotaResource{model.QuotaResourceRecords}, quota.Overridden)
+}
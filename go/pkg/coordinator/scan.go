@@ -0,0 +1,76 @@
+package coordinator
+
+import (
+	"context"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// maxScanBatchSize is the largest batch of collections ScanCollections
+	// reads at once.
+	maxScanBatchSize = 1000
+
+	// scanSnapshotTTL is how long the snapshot of a scan is held after the
+	// scan last read from it.
+	scanSnapshotTTL = 10 * time.Minute
+)
+
+// newScanLimiter returns nil when collectionsPerSecond does not limit the
+// scans.
+func newScanLimiter(collectionsPerSecond int) *rate.Limiter {
+	if collectionsPerSecond <= 0 {
+		return nil
+	}
+	// a burst of a full batch lets the largest batch through however low the
+	// rate is
+	return rate.NewLimiter(rate.Limit(collectionsPerSecond), maxScanBatchSize)
+}
+
+// ScanCollections reads the live collections of the whole catalog by ID, in
+// batches of batchSize, and passes each batch to send with the cursor that
+// resumes the scan after it. A nil cursor starts a scan from a snapshot of the
+// catalog held on the primary: every batch, resumed ones included, reads the
+// collections live when the scan started, as they were then, so that the scan
+// misses none of them and ends however fast collections are created. A scan
+// resumed more than scanSnapshotTTL after its last batch fails with
+// common.ErrScanSnapshotExpired and must be started again. The scans of this
+// replica are rate limited together to ScanCollectionsRate collections per
+// second.
+func (s *Coordinator) ScanCollections(ctx context.Context, cursor *model.CollectionScanCursor, batchSize int32, send func([]*model.Collection, *model.CollectionScanCursor) error) error {
+	if batchSize <= 0 || batchSize > maxScanBatchSize {
+		return common.ErrInvalidScanBatchSize
+	}
+	if cursor == nil {
+		var err error
+		cursor, err = s.catalog.StartCollectionScan(ctx, scanSnapshotTTL)
+		if err != nil {
+			return err
+		}
+	}
+	for {
+		collections, err := s.catalog.ScanCollections(ctx, cursor, batchSize)
+		if err != nil {
+			return err
+		}
+		if len(collections) == 0 {
+			return nil
+		}
+		if s.scanLimiter != nil {
+			if err := s.scanLimiter.WaitN(ctx, len(collections)); err != nil {
+				return err
+			}
+		}
+		after := collections[len(collections)-1].ID
+		cursor = &model.CollectionScanCursor{Snapshot: cursor.Snapshot, After: &after}
+		if err := send(collections, cursor); err != nil {
+			return err
+		}
+		if len(collections) < int(batchSize) {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/mocks"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestScanCollections(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{catalog: catalog, scanLimiter: newScanLimiter(1000)}
+	catalog.On("StartCollectionScan", mock.Anything, scanSnapshotTTL).Return(&model.CollectionScanCursor{Snapshot: "00000003-0000001B-1"}, nil).Once()
+	first := []*model.Collection{{ID: types.NewUniqueID()}, {ID: types.NewUniqueID()}}
+	last := []*model.Collection{{ID: types.NewUniqueID()}}
+	catalog.On("ScanCollections", mock.Anything, mock.MatchedBy(func(cursor *model.CollectionScanCursor) bool {
+		return cursor.After == nil
+	}), int32(2)).Return(first, nil).Once()
+	catalog.On("ScanCollections", mock.Anything, mock.MatchedBy(func(cursor *model.CollectionScanCursor) bool {
+		return cursor.After != nil && *cursor.After == first[1].ID
+	}), int32(2)).Return(last, nil).Once()
+
+	var batches [][]*model.Collection
+	var cursors []*model.CollectionScanCursor
+	err := c.ScanCollections(context.Background(), nil, 2, func(collections []*model.Collection, next *model.CollectionScanCursor) error {
+		batches = append(batches, collections)
+		cursors = append(cursors, next)
+		return nil
+	})
+	assert.NoError(t, err)
+	// the scan stops after a batch that is not full
+	assert.Equal(t, [][]*model.Collection{first, last}, batches)
+	assert.Equal(t, last[0].ID, *cursors[1].After)
+	// and every batch reads the snapshot held when the scan started
+	assert.Equal(t, "00000003-0000001B-1", cursors[0].Snapshot)
+	assert.Equal(t, cursors[0].Snapshot, cursors[1].Snapshot)
+	catalog.AssertExpectations(t)
+}
+
+func TestScanCollections_Resume(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{catalog: catalog}
+	after := types.NewUniqueID()
+	cursor := &model.CollectionScanCursor{Snapshot: "00000003-0000001B-1", After: &after}
+	catalog.On("ScanCollections", mock.Anything, cursor, int32(10)).Return([]*model.Collection{}, nil).Once()
+
+	err := c.ScanCollections(context.Background(), cursor, 10, func([]*model.Collection, *model.CollectionScanCursor) error {
+		t.Fatal("no batch expected")
+		return nil
+	})
+	assert.NoError(t, err)
+
+	for _, batchSize := range []int32{0, maxScanBatchSize + 1} {
+		err = c.ScanCollections(context.Background(), nil, batchSize, nil)
+		assert.ErrorIs(t, err, common.ErrInvalidScanBatchSize)
+	}
+}
+
+func TestNewScanLimiter(t *testing.T) {
+	assert.Nil(t, newScanLimiter(0))
+	// the largest batch always fits in the burst
+	assert.Equal(t, maxScanBatchSize, newScanLimiter(10).Burst())
+}
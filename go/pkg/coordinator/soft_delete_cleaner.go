@@ -0,0 +1,295 @@
+package coordinator
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultSoftDeleteCleanerInterval  = time.Hour
+	defaultSoftDeleteCleanerBatchSize = 100
+	defaultSoftDeleteCleanerAttempts  = 3
+	// maxSoftDeleteCleanerRunLimit bounds the manual runs, which hold the
+	// request open until they are over.
+	maxSoftDeleteCleanerRunLimit = 1000
+	softDeleteCleanerActor       = "soft_delete_cleaner"
+)
+
+// softDeleteCleaner purges the collections soft deleted for longer than the
+// retention, at most batchSize of them every interval. A collection that fails
+// to purge is left for the next run, until it failed maxAttempts times and is
+// dead lettered, so that one bad collection does not take a slot of every run.
+type softDeleteCleaner struct {
+	catalog     metastore.Catalog
+	retention   time.Duration
+	interval    time.Duration
+	batchSize   int32
+	maxAttempts int32
+	now         func() time.Time
+
+	runs         metric.Int64Counter
+	scanned      metric.Int64Counter
+	purged       metric.Int64Counter
+	failed       metric.Int64Counter
+	deadLettered metric.Int64Counter
+	nextRunAt    atomic.Int64
+
+	mu     sync.Mutex
+	status model.SoftDeleteCleanerStatus
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// newSoftDeleteCleaner returns nil when the config does not enable the cleaner.
+func newSoftDeleteCleaner(config Config, catalog metastore.Catalog) (*softDeleteCleaner, error) {
+	if config.SoftDeleteRetention <= 0 {
+		return nil, nil
+	}
+	interval := config.SoftDeleteCleanerInterval
+	if interval <= 0 {
+		interval = defaultSoftDeleteCleanerInterval
+	}
+	batchSize := config.SoftDeleteCleanerBatchSize
+	if batchSize <= 0 {
+		batchSize = defaultSoftDeleteCleanerBatchSize
+	}
+	maxAttempts := config.SoftDeleteCleanerMaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultSoftDeleteCleanerAttempts
+	}
+	j := &softDeleteCleaner{
+		catalog:     catalog,
+		retention:   config.SoftDeleteRetention,
+		interval:    interval,
+		batchSize:   batchSize,
+		maxAttempts: maxAttempts,
+		now:         time.Now,
+		status:      model.SoftDeleteCleanerStatus{Enabled: true, Retention: config.SoftDeleteRetention},
+	}
+
+	meter := otel.Meter("chroma.coordinator")
+	var err error
+	j.runs, err = meter.Int64Counter("sysdb.soft_delete_cleaner.runs",
+		metric.WithDescription("Number of soft delete cleaner runs by trigger and status"))
+	if err != nil {
+		return nil, err
+	}
+	j.scanned, err = meter.Int64Counter("sysdb.soft_delete_cleaner.scanned",
+		metric.WithDescription("Number of soft deleted collections past the retention scanned by the cleaner"))
+	if err != nil {
+		return nil, err
+	}
+	j.purged, err = meter.Int64Counter("sysdb.soft_delete_cleaner.purged",
+		metric.WithDescription("Number of soft deleted collections purged by the cleaner"))
+	if err != nil {
+		return nil, err
+	}
+	j.failed, err = meter.Int64Counter("sysdb.soft_delete_cleaner.failed",
+		metric.WithDescription("Number of soft deleted collections the cleaner failed to purge"))
+	if err != nil {
+		return nil, err
+	}
+	j.deadLettered, err = meter.Int64Counter("sysdb.soft_delete_cleaner.dead_lettered",
+		metric.WithDescription("Number of soft deleted collections the cleaner stopped retrying after repeated failures"))
+	if err != nil {
+		return nil, err
+	}
+	_, err = meter.Int64ObservableGauge("sysdb.soft_delete_cleaner.next_run",
+		metric.WithDescription("Unix time of the next scheduled run of the soft delete cleaner, 0 when it is not scheduled"),
+		metric.WithUnit("s"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(j.nextRunAt.Load())
+			return nil
+		}))
+	if err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (j *softDeleteCleaner) Start(ctx context.Context) {
+	ctx, j.cancel = context.WithCancel(ctx)
+	j.wg.Add(1)
+	go func() {
+		defer j.wg.Done()
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+		for {
+			j.setNextRunAt(j.now().Add(j.interval))
+			_, err := j.run(ctx, j.batchSize, false)
+			if err == common.ErrSoftDeleteCleanerRunning {
+				log.Info("soft delete cleaner run skipped, a manual run is in progress")
+			}
+			select {
+			case <-ctx.Done():
+				j.setNextRunAt(time.Time{})
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	log.Info("soft delete cleaner started", zap.Duration("retention", j.retention), zap.Duration("interval", j.interval), zap.Int32("batchSize", j.batchSize))
+}
+
+func (j *softDeleteCleaner) Stop() {
+	if j.cancel != nil {
+		j.cancel()
+	}
+	j.wg.Wait()
+}
+
+func (j *softDeleteCleaner) setNextRunAt(next time.Time) {
+	j.mu.Lock()
+	j.status.NextRunAt = next
+	j.mu.Unlock()
+	if next.IsZero() {
+		j.nextRunAt.Store(0)
+	} else {
+		j.nextRunAt.Store(next.Unix())
+	}
+}
+
+// run purges up to limit collections. It fails with ErrSoftDeleteCleanerRunning
+// when another run is in progress, and with the error of the listing of the
+// collections to purge, failures to purge a collection are only counted.
+func (j *softDeleteCleaner) run(ctx context.Context, limit int32, manual bool) (*model.SoftDeleteCleanerRun, error) {
+	j.mu.Lock()
+	if j.status.Running {
+		j.mu.Unlock()
+		return nil, common.ErrSoftDeleteCleanerRunning
+	}
+	run := &model.SoftDeleteCleanerRun{StartedAt: j.now(), Manual: manual}
+	j.status.Running = true
+	j.status.LastRun = run
+	j.mu.Unlock()
+
+	err := j.purge(ctx, run, limit)
+	status := "success"
+	if err != nil {
+		status = "failure"
+		log.Error("soft delete cleaner run failed", zap.Bool("manual", manual), zap.Error(err))
+	} else {
+		log.Info("soft delete cleaner run finished", zap.Bool("manual", manual), zap.Int64("scanned", run.Scanned), zap.Int64("purged", run.Purged), zap.Int64("failed", run.Failed))
+	}
+	trigger := "scheduled"
+	if manual {
+		trigger = "manual"
+	}
+	j.runs.Add(ctx, 1, metric.WithAttributes(attribute.String("trigger", trigger), attribute.String("status", status)))
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	run.FinishedAt = j.now()
+	if err != nil {
+		run.Error = err.Error()
+	}
+	j.status.Running = false
+	j.status.TotalScanned += run.Scanned
+	j.status.TotalPurged += run.Purged
+	j.status.TotalFailed += run.Failed
+	finished := *run
+	return &finished, err
+}
+
+func (j *softDeleteCleaner) purge(ctx context.Context, run *model.SoftDeleteCleanerRun, limit int32) error {
+	deletedBefore := j.now().Add(-j.retention)
+	collectionIDs, err := j.catalog.GetDeletedCollectionIDs(ctx, deletedBefore, limit)
+	if err != nil {
+		return err
+	}
+	for _, collectionID := range collectionIDs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		err := j.catalog.PurgeDeletedCollection(ctx, collectionID, deletedBefore, softDeleteCleanerActor)
+		j.scanned.Add(ctx, 1)
+		// a collection restored since it was listed is neither purged nor failed
+		failed := err != nil && err != common.ErrCollectionNotFound
+		j.mu.Lock()
+		run.Scanned++
+		if err == nil {
+			run.Purged++
+		} else if failed {
+			run.Failed++
+		}
+		j.mu.Unlock()
+		switch {
+		case err == nil:
+			j.purged.Add(ctx, 1)
+		case failed:
+			j.failed.Add(ctx, 1)
+			log.Error("failed to purge soft deleted collection", zap.String("collectionID", collectionID.String()), zap.Error(err))
+			j.recordFailure(ctx, collectionID, err)
+		}
+	}
+	return nil
+}
+
+func (j *softDeleteCleaner) recordFailure(ctx context.Context, collectionID types.UniqueID, cause error) {
+	entry, err := j.catalog.RecordCleanupFailure(ctx, collectionID, dbmodel.CleanupTaskPurge, cause, j.maxAttempts)
+	if err != nil {
+		// the collection is retried at the next run regardless
+		log.Error("failed to record purge failure", zap.String("collectionID", collectionID.String()), zap.Error(err))
+		return
+	}
+	// dead lettered collections are not listed again, so this is the failure
+	// that moved it to the dead letters
+	if entry.DeadLettered {
+		j.deadLettered.Add(ctx, 1)
+		log.Warn("soft deleted collection dead lettered, it is no longer purged until its dead letter is deleted",
+			zap.String("collectionID", collectionID.String()), zap.Int32("attempts", entry.Attempts), zap.String("lastError", entry.LastError))
+	}
+}
+
+// statusSnapshot returns a copy of the status that later runs do not change.
+func (j *softDeleteCleaner) statusSnapshot() *model.SoftDeleteCleanerStatus {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	status := j.status
+	if status.LastRun != nil {
+		lastRun := *status.LastRun
+		status.LastRun = &lastRun
+	}
+	return &status
+}
+
+// GetSoftDeleteCleanerStatus returns the progress of the soft delete cleaner,
+// which only runs on the leader.
+func (s *Coordinator) GetSoftDeleteCleanerStatus(ctx context.Context) *model.SoftDeleteCleanerStatus {
+	if s.softDeleteCleaner == nil {
+		return &model.SoftDeleteCleanerStatus{}
+	}
+	return s.softDeleteCleaner.statusSnapshot()
+}
+
+// RunSoftDeleteCleaner runs the soft delete cleaner right away over at most
+// limit collections, the batch size of the cleaner when limit is zero. Limits
+// above maxSoftDeleteCleanerRunLimit are capped. It fails with
+// ErrSoftDeleteCleanerDisabled when no retention is configured and with
+// ErrSoftDeleteCleanerRunning when a run is in progress.
+func (s *Coordinator) RunSoftDeleteCleaner(ctx context.Context, limit int32) (*model.SoftDeleteCleanerRun, error) {
+	if limit < 0 {
+		return nil, common.ErrInvalidSoftDeleteLimit
+	}
+	if s.softDeleteCleaner == nil {
+		return nil, common.ErrSoftDeleteCleanerDisabled
+	}
+	if limit == 0 {
+		limit = s.softDeleteCleaner.batchSize
+	}
+	return s.softDeleteCleaner.run(ctx, min(limit, maxSoftDeleteCleanerRunLimit), true)
+}
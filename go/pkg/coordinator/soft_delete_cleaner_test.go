@@ -0,0 +1,93 @@
+package coordinator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/metastore/mocks"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSoftDeleteCleaner_Run(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	cleaner, err := newSoftDeleteCleaner(Config{SoftDeleteRetention: 7 * 24 * time.Hour, SoftDeleteCleanerBatchSize: 10}, catalog)
+	assert.NoError(t, err)
+	now := time.Date(2026, 10, 14, 9, 30, 0, 0, time.UTC)
+	cleaner.now = func() time.Time { return now }
+	c := &Coordinator{ctx: context.Background(), catalog: catalog, softDeleteCleaner: cleaner}
+
+	deletedBefore := now.Add(-7 * 24 * time.Hour)
+	purgedID, restoredID, failedID := types.NewUniqueID(), types.NewUniqueID(), types.NewUniqueID()
+	catalog.On("GetDeletedCollectionIDs", mock.Anything, deletedBefore, int32(3)).Return([]types.UniqueID{purgedID, restoredID, failedID}, nil)
+	catalog.On("PurgeDeletedCollection", mock.Anything, purgedID, deletedBefore, softDeleteCleanerActor).Return(nil)
+	catalog.On("PurgeDeletedCollection", mock.Anything, restoredID, deletedBefore, softDeleteCleanerActor).Return(common.ErrCollectionNotFound)
+	purgeErr := errors.New("permission denied")
+	catalog.On("PurgeDeletedCollection", mock.Anything, failedID, deletedBefore, softDeleteCleanerActor).Return(purgeErr)
+	catalog.On("RecordCleanupFailure", mock.Anything, failedID, dbmodel.CleanupTaskPurge, purgeErr, int32(defaultSoftDeleteCleanerAttempts)).Return(&model.CleanupDeadLetter{
+		CollectionID: failedID,
+		Task:         dbmodel.CleanupTaskPurge,
+		Attempts:     defaultSoftDeleteCleanerAttempts,
+		LastError:    purgeErr.Error(),
+		DeadLettered: true,
+	}, nil)
+
+	// a collection that fails to purge does not fail the run
+	run, err := c.RunSoftDeleteCleaner(context.Background(), 3)
+	assert.NoError(t, err)
+	assert.True(t, run.Manual)
+	assert.Equal(t, int64(3), run.Scanned)
+	assert.Equal(t, int64(1), run.Purged)
+	assert.Equal(t, int64(1), run.Failed)
+	assert.Equal(t, now, run.FinishedAt)
+	catalog.AssertNumberOfCalls(t, "RecordCleanupFailure", 1)
+
+	catalog.On("GetDeletedCollectionIDs", mock.Anything, deletedBefore, int32(10)).Return(nil, errors.New("connection reset"))
+	_, err = c.RunSoftDeleteCleaner(context.Background(), 0)
+	assert.Error(t, err)
+
+	status := c.GetSoftDeleteCleanerStatus(context.Background())
+	assert.True(t, status.Enabled)
+	assert.False(t, status.Running)
+	assert.Equal(t, "connection reset", status.LastRun.Error)
+	assert.Equal(t, int64(3), status.TotalScanned)
+	assert.Equal(t, int64(1), status.TotalPurged)
+	assert.Equal(t, int64(1), status.TotalFailed)
+
+	// runs do not overlap
+	cleaner.status.Running = true
+	_, err = c.RunSoftDeleteCleaner(context.Background(), 3)
+	assert.ErrorIs(t, err, common.ErrSoftDeleteCleanerRunning)
+}
+
+func TestRunSoftDeleteCleaner_Disabled(t *testing.T) {
+	cleaner, err := newSoftDeleteCleaner(Config{}, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, cleaner)
+
+	c := &Coordinator{ctx: context.Background()}
+	_, err = c.RunSoftDeleteCleaner(context.Background(), 0)
+	assert.ErrorIs(t, err, common.ErrSoftDeleteCleanerDisabled)
+	_, err = c.RunSoftDeleteCleaner(context.Background(), -1)
+	assert.ErrorIs(t, err, common.ErrInvalidSoftDeleteLimit)
+	assert.False(t, c.GetSoftDeleteCleanerStatus(context.Background()).Enabled)
+}
+
+func TestCleanupDeadLetters_UnknownTask(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{ctx: context.Background(), catalog: catalog}
+	_, err := c.ListCleanupDeadLetters(context.Background(), "vacuum", false)
+	assert.ErrorIs(t, err, common.ErrUnknownCleanupTask)
+	err = c.DeleteCleanupDeadLetter(context.Background(), types.NewUniqueID(), "")
+	assert.ErrorIs(t, err, common.ErrUnknownCleanupTask)
+
+	catalog.On("ListCleanupDeadLetters", mock.Anything, "", true).Return([]*model.CleanupDeadLetter{}, nil)
+	_, err = c.ListCleanupDeadLetters(context.Background(), "", true)
+	assert.NoError(t, err)
+}
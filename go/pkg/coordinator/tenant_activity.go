@@ -0,0 +1,48 @@
+package coordinator
+
+import (
+	"context"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+)
+
+const (
+	defaultTenantActivityDays int32 = 7
+	maxTenantActivityDays     int32 = 366
+)
+
+// GetTenantActivity returns the activity of a tenant on each of the last days,
+// today included and oldest first, and its total over them. Days without
+// activity are zero. Zero days uses a default of seven.
+func (s *Coordinator) GetTenantActivity(ctx context.Context, tenantID string, days int32) ([]*model.TenantActivity, *model.TenantActivity, error) {
+	if days == 0 {
+		days = defaultTenantActivityDays
+	}
+	if days < 0 || days > maxTenantActivityDays {
+		return nil, nil, common.ErrInvalidTenantActivityWindow
+	}
+	to := time.Now().UTC().Truncate(oneDay)
+	from := to.Add(-time.Duration(days-1) * oneDay)
+	activity, err := s.catalog.GetTenantActivity(ctx, tenantID, from, to)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	byDay := make(map[time.Time]*model.TenantActivity, len(activity))
+	for _, dayActivity := range activity {
+		byDay[dayActivity.Day] = dayActivity
+	}
+	result := make([]*model.TenantActivity, 0, days)
+	total := &model.TenantActivity{Day: from}
+	for day := from; !day.After(to); day = day.Add(oneDay) {
+		dayActivity, ok := byDay[day]
+		if !ok {
+			dayActivity = &model.TenantActivity{Day: day}
+		}
+		result = append(result, dayActivity)
+		total.Add(dayActivity)
+	}
+	return result, total, nil
+}
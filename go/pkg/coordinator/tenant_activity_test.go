@@ -0,0 +1,51 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/mocks"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetTenantActivity(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{ctx: context.Background(), catalog: catalog}
+	ctx := context.Background()
+
+	_, _, err := c.GetTenantActivity(ctx, "tenant", -1)
+	assert.ErrorIs(t, err, common.ErrInvalidTenantActivityWindow)
+	_, _, err = c.GetTenantActivity(ctx, "tenant", 367)
+	assert.ErrorIs(t, err, common.ErrInvalidTenantActivityWindow)
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	from := today.Add(-6 * 24 * time.Hour)
+	catalog.On("GetTenantActivity", mock.Anything, "tenant", from, today).Return([]*model.TenantActivity{
+		{Day: from, CollectionsCreated: 3, Compactions: 2, RecordsCompacted: 100},
+		{Day: today, CollectionsDeleted: 1, Compactions: 1, RecordsCompacted: 10},
+	}, nil)
+
+	days, total, err := c.GetTenantActivity(ctx, "tenant", 0)
+	assert.NoError(t, err)
+	assert.Len(t, days, 7)
+	assert.Equal(t, from, days[0].Day)
+	assert.Equal(t, int64(3), days[0].CollectionsCreated)
+	// days without activity are zero
+	assert.Equal(t, &model.TenantActivity{Day: from.Add(24 * time.Hour)}, days[1])
+	assert.Equal(t, today, days[6].Day)
+	assert.Equal(t, &model.TenantActivity{
+		Day:                from,
+		CollectionsCreated: 3,
+		CollectionsDeleted: 1,
+		Compactions:        3,
+		RecordsCompacted:   110,
+	}, total)
+
+	catalog.On("GetTenantActivity", mock.Anything, "missing", mock.Anything, mock.Anything).Return(nil, common.ErrTenantNotFound)
+	_, _, err = c.GetTenantActivity(ctx, "missing", 1)
+	assert.ErrorIs(t, err, common.ErrTenantNotFound)
+}
@@ -0,0 +1,147 @@
+package coordinator
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore"
+	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+const defaultTenantCompactionMaxPending = 1000
+
+// tenantCompactionBatcher coalesces the SetTenantLastCompactionTime calls, which
+// compactors make after every compaction, into one statement per interval, or
+// as soon as maxPending tenants are waiting to be written. Only the latest time
+// of a tenant is written. A failed write is retried with the next batch.
+type tenantCompactionBatcher struct {
+	catalog    metastore.Catalog
+	interval   time.Duration
+	maxPending int
+
+	buffered metric.Int64Counter
+	flushes  metric.Int64Counter
+
+	mu      sync.Mutex
+	pending map[string]int64
+	full    chan struct{}
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// newTenantCompactionBatcher returns nil when the config does not enable
+// batching, the times are then written as they are set.
+func newTenantCompactionBatcher(config Config, catalog metastore.Catalog) (*tenantCompactionBatcher, error) {
+	if config.TenantLastCompactionFlushInterval <= 0 {
+		return nil, nil
+	}
+	maxPending := config.TenantLastCompactionMaxPending
+	if maxPending <= 0 {
+		maxPending = defaultTenantCompactionMaxPending
+	}
+	b := &tenantCompactionBatcher{
+		catalog:    catalog,
+		interval:   config.TenantLastCompactionFlushInterval,
+		maxPending: maxPending,
+		pending:    make(map[string]int64),
+		full:       make(chan struct{}, 1),
+	}
+
+	meter := otel.Meter("chroma.coordinator")
+	var err error
+	b.buffered, err = meter.Int64Counter("sysdb.tenant_last_compaction.buffered",
+		metric.WithDescription("Number of tenant last compaction times buffered to be written in a batch"))
+	if err != nil {
+		return nil, err
+	}
+	b.flushes, err = meter.Int64Counter("sysdb.tenant_last_compaction.flushes",
+		metric.WithDescription("Number of batched writes of tenant last compaction times by status"))
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (b *tenantCompactionBatcher) Start(ctx context.Context) {
+	ctx, b.cancel = context.WithCancel(ctx)
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		ticker := time.NewTicker(b.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			case <-b.full:
+			}
+			b.flush(ctx)
+		}
+	}()
+	log.Info("tenant last compaction batcher started", zap.Duration("interval", b.interval), zap.Int("maxPending", b.maxPending))
+}
+
+// Stop writes the times still pending, so that they are not lost on shutdown.
+func (b *tenantCompactionBatcher) Stop() {
+	if b.cancel != nil {
+		b.cancel()
+	}
+	b.wg.Wait()
+	b.flush(context.Background())
+}
+
+func (b *tenantCompactionBatcher) add(ctx context.Context, tenantID string, lastCompactionTime int64) {
+	b.mu.Lock()
+	if lastCompactionTime > b.pending[tenantID] {
+		b.pending[tenantID] = lastCompactionTime
+	}
+	full := len(b.pending) >= b.maxPending
+	b.mu.Unlock()
+	b.buffered.Add(ctx, 1)
+	if full {
+		select {
+		case b.full <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// latest returns the pending time of the tenant, if it has one.
+func (b *tenantCompactionBatcher) latest(tenantID string) (int64, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	lastCompactionTime, ok := b.pending[tenantID]
+	return lastCompactionTime, ok
+}
+
+func (b *tenantCompactionBatcher) flush(ctx context.Context) {
+	b.mu.Lock()
+	batch := b.pending
+	b.pending = make(map[string]int64, len(batch))
+	b.mu.Unlock()
+	if len(batch) == 0 {
+		return
+	}
+
+	err := b.catalog.SetTenantsLastCompactionTime(ctx, batch)
+	status := "success"
+	if err != nil {
+		status = "failure"
+		log.Error("failed to write tenant last compaction times, retrying with the next batch", zap.Int("tenants", len(batch)), zap.Error(err))
+		b.mu.Lock()
+		for tenantID, lastCompactionTime := range batch {
+			if lastCompactionTime > b.pending[tenantID] {
+				b.pending[tenantID] = lastCompactionTime
+			}
+		}
+		b.mu.Unlock()
+	}
+	b.flushes.Add(ctx, 1, metric.WithAttributes(attribute.String("status", status)))
+}
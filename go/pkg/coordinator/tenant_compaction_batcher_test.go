@@ -0,0 +1,81 @@
+package coordinator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/metastore/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTenantCompactionBatcher(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	batcher, err := newTenantCompactionBatcher(Config{TenantLastCompactionFlushInterval: time.Hour}, catalog)
+	assert.NoError(t, err)
+	c := &Coordinator{ctx: context.Background(), catalog: catalog, compactionBatcher: batcher}
+	ctx := context.Background()
+
+	// only the latest time of a tenant is kept
+	assert.NoError(t, c.SetTenantLastCompactionTime(ctx, "tenant1", 20))
+	assert.NoError(t, c.SetTenantLastCompactionTime(ctx, "tenant1", 10))
+	assert.NoError(t, c.SetTenantLastCompactionTime(ctx, "tenant2", 30))
+	catalog.AssertNotCalled(t, "SetTenantLastCompactionTime", mock.Anything, mock.Anything, mock.Anything)
+
+	// pending times are read back
+	catalog.On("GetTenantsLastCompactionTime", mock.Anything, []string{"tenant1", "tenant3"}).Return([]*dbmodel.Tenant{
+		{ID: "tenant1", LastCompactionTime: 5},
+		{ID: "tenant3", LastCompactionTime: 7},
+	}, nil)
+	tenants, err := c.GetTenantsLastCompactionTime(ctx, []string{"tenant1", "tenant3"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(20), tenants[0].LastCompactionTime)
+	assert.Equal(t, int64(7), tenants[1].LastCompactionTime)
+
+	// a failed write is retried with the next batch
+	catalog.On("SetTenantsLastCompactionTime", mock.Anything, map[string]int64{"tenant1": 20, "tenant2": 30}).Return(errors.New("connection reset")).Once()
+	batcher.flush(ctx)
+	assert.NoError(t, c.SetTenantLastCompactionTime(ctx, "tenant2", 40))
+	catalog.On("SetTenantsLastCompactionTime", mock.Anything, map[string]int64{"tenant1": 20, "tenant2": 40}).Return(nil).Once()
+	batcher.flush(ctx)
+	catalog.AssertNumberOfCalls(t, "SetTenantsLastCompactionTime", 2)
+
+	// nothing is written when nothing is pending
+	batcher.flush(ctx)
+	catalog.AssertNumberOfCalls(t, "SetTenantsLastCompactionTime", 2)
+}
+
+func TestTenantCompactionBatcher_FlushesWhenFull(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	batcher, err := newTenantCompactionBatcher(Config{TenantLastCompactionFlushInterval: time.Hour, TenantLastCompactionMaxPending: 2}, catalog)
+	assert.NoError(t, err)
+	flushed := make(chan struct{})
+	catalog.On("SetTenantsLastCompactionTime", mock.Anything, map[string]int64{"tenant1": 1, "tenant2": 2}).Return(nil).Run(func(mock.Arguments) {
+		close(flushed)
+	}).Once()
+	batcher.Start(context.Background())
+	defer batcher.Stop()
+
+	batcher.add(context.Background(), "tenant1", 1)
+	batcher.add(context.Background(), "tenant2", 2)
+	select {
+	case <-flushed:
+	case <-time.After(time.Second):
+		t.Fatal("the batch was not written when it was full")
+	}
+}
+
+func TestTenantCompactionBatcher_Disabled(t *testing.T) {
+	batcher, err := newTenantCompactionBatcher(Config{}, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, batcher)
+
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{ctx: context.Background(), catalog: catalog}
+	catalog.On("SetTenantLastCompactionTime", mock.Anything, "tenant1", int64(10)).Return(nil)
+	assert.NoError(t, c.SetTenantLastCompactionTime(context.Background(), "tenant1", 10))
+	catalog.AssertExpectations(t)
+}
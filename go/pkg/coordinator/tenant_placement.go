@@ -0,0 +1,33 @@
+package coordinator
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
+)
+
+// SetTenantPlacement replaces the regions the collections of a tenant may be
+// placed in. The regions are stored sorted and without duplicates, and an
+// empty list lifts the constraint. The sysdb only records the constraint, the
+// schedulers and frontends enforce it.
+func (s *Coordinator) SetTenantPlacement(ctx context.Context, placement *model.TenantPlacement) error {
+	for _, region := range placement.AllowedRegions {
+		if region == "" || strings.IndexFunc(region, unicode.IsSpace) >= 0 {
+			return common.ErrInvalidTenantPlacement
+		}
+	}
+	regions := slices.Clone(placement.AllowedRegions)
+	slices.Sort(regions)
+	return s.catalog.SetTenantPlacement(ctx, &model.TenantPlacement{
+		TenantID:       placement.TenantID,
+		AllowedRegions: slices.Compact(regions),
+	})
+}
+
+func (s *Coordinator) GetTenantPlacement(ctx context.Context, tenantID string) (*model.TenantPlacement, error) {
+	return s.catalog.GetTenantPlacement(ctx, tenantID)
+}
@@ -0,0 +1,41 @@
+package coordinator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/mocks"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSetTenantPlacement(t *testing.T) {
+	catalog := &mocks.Catalog{}
+	c := &Coordinator{ctx: context.Background(), catalog: catalog}
+	ctx := context.Background()
+
+	catalog.On("SetTenantPlacement", mock.Anything, &model.TenantPlacement{
+		TenantID:       "tenant",
+		AllowedRegions: []string{"eu-central-1", "eu-west-1"},
+	}).Return(nil)
+	err := c.SetTenantPlacement(ctx, &model.TenantPlacement{
+		TenantID:       "tenant",
+		AllowedRegions: []string{"eu-west-1", "eu-central-1", "eu-west-1"},
+	})
+	assert.NoError(t, err)
+	catalog.AssertExpectations(t)
+
+	for _, region := range []string{"", "eu west", "eu-west-1\n"} {
+		err := c.SetTenantPlacement(ctx, &model.TenantPlacement{TenantID: "tenant", AllowedRegions: []string{region}})
+		assert.ErrorIs(t, err, common.ErrInvalidTenantPlacement)
+	}
+}
+
+func TestTenantPlacementAllows(t *testing.T) {
+	assert.True(t, (&model.TenantPlacement{}).Allows("us-east-1"))
+	placement := &model.TenantPlacement{AllowedRegions: []string{"eu-central-1", "eu-west-1"}}
+	assert.True(t, placement.Allows("eu-west-1"))
+	assert.False(t, placement.Allows("us-east-1"))
+}
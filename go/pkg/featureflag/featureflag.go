@@ -0,0 +1,106 @@
+// Package featureflag evaluates the feature flags stored in the sysdb, so that
+// a risky behavior can be rolled out to some tenants, or to a percentage of
+// them, without a redeploy.
+package featureflag
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+const DefaultTTL = 30 * time.Second
+
+// Loader reads every flag, the defaults and the overrides of the tenants.
+type Loader func(ctx context.Context) ([]*model.FeatureFlag, error)
+
+// Cache serves the flags for ttl before loading them again. When a load fails
+// the flags of the last load are served until the next attempt, and every flag
+// is off if no load succeeded yet, so an unreachable sysdb never turns a
+// behavior on.
+type Cache struct {
+	load Loader
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu        sync.Mutex
+	flags     *model.FeatureFlags
+	expiresAt time.Time
+}
+
+// NewCache returns a cache of the flags read by load. Zero ttl uses a default of
+// 30 seconds.
+func NewCache(load Loader, ttl time.Duration) *Cache {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &Cache{
+		load:  load,
+		ttl:   ttl,
+		now:   time.Now,
+		flags: model.NewFeatureFlags(nil),
+	}
+}
+
+// Enabled tells whether the flag is on for the tenant.
+func (c *Cache) Enabled(ctx context.Context, name string, tenantID string) bool {
+	return c.get(ctx).Enabled(name, tenantID)
+}
+
+func (c *Cache) get(ctx context.Context) *model.FeatureFlags {
+	now := c.now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if now.Before(c.expiresAt) {
+		return c.flags
+	}
+
+	// the next attempt waits for the ttl even when this one fails, so that a
+	// sysdb outage is not hammered by every caller
+	c.expiresAt = now.Add(c.ttl)
+	flags, err := c.load(ctx)
+	if err != nil {
+		log.Warn("failed to load feature flags, serving the last known flags", zap.Error(err))
+		return c.flags
+	}
+	c.flags = model.NewFeatureFlags(flags)
+	return c.flags
+}
+
+// Invalidate makes the next call load the flags again.
+func (c *Cache) Invalidate() {
+	c.mu.Lock()
+	c.expiresAt = time.Time{}
+	c.mu.Unlock()
+}
+
+// SysDBLoader reads the flags from the sysdb, for the services other than the
+// sysdb itself.
+func SysDBLoader(client coordinatorpb.SysDBClient) Loader {
+	return func(ctx context.Context) ([]*model.FeatureFlag, error) {
+		res, err := client.ListFeatureFlags(ctx, &coordinatorpb.ListFeatureFlagsRequest{})
+		if err != nil {
+			return nil, err
+		}
+		if res.GetStatus().GetCode() != 200 {
+			return nil, fmt.Errorf("list feature flags failed: %s", res.GetStatus().GetReason())
+		}
+		flags := make([]*model.FeatureFlag, 0, len(res.GetFlags()))
+		for _, flagpb := range res.GetFlags() {
+			flags = append(flags, &model.FeatureFlag{
+				Name:              flagpb.GetName(),
+				TenantID:          flagpb.GetTenant(),
+				Enabled:           flagpb.GetEnabled(),
+				RolloutPercentage: flagpb.GetRolloutPercentage(),
+				Description:       flagpb.GetDescription(),
+			})
+		}
+		return flags, nil
+	}
+}
@@ -0,0 +1,92 @@
+package featureflag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnabled(t *testing.T) {
+	flags := model.NewFeatureFlags([]*model.FeatureFlag{
+		{Name: "new_cache", Enabled: true, RolloutPercentage: 100},
+		{Name: "new_cache", TenantID: "opted_out", Enabled: false},
+		{Name: "new_gc", Enabled: false, RolloutPercentage: 100},
+		{Name: "new_gc", TenantID: "opted_in", Enabled: true},
+		{Name: "new_pagination", Enabled: true, RolloutPercentage: 0},
+	})
+
+	assert.True(t, flags.Enabled("new_cache", "tenant"))
+	assert.False(t, flags.Enabled("new_cache", "opted_out"))
+	assert.False(t, flags.Enabled("new_gc", "tenant"))
+	assert.True(t, flags.Enabled("new_gc", "opted_in"))
+	assert.False(t, flags.Enabled("new_pagination", "tenant"))
+	assert.False(t, flags.Enabled("unknown", "tenant"))
+}
+
+func TestEnabled_Rollout(t *testing.T) {
+	tenants := make([]string, 1000)
+	for i := range tenants {
+		tenants[i] = fmt.Sprintf("tenant-%d", i)
+	}
+	enabledAt := func(percentage int32) map[string]bool {
+		flags := model.NewFeatureFlags([]*model.FeatureFlag{{Name: "flag", Enabled: true, RolloutPercentage: percentage}})
+		enabled := make(map[string]bool)
+		for _, tenant := range tenants {
+			if flags.Enabled("flag", tenant) {
+				enabled[tenant] = true
+			}
+		}
+		return enabled
+	}
+
+	ten, fifty := enabledAt(10), enabledAt(50)
+	assert.InDelta(t, 100, len(ten), 40)
+	assert.InDelta(t, 500, len(fifty), 80)
+	// raising the percentage keeps the tenants already in the rollout
+	for tenant := range ten {
+		assert.True(t, fifty[tenant], tenant)
+	}
+}
+
+func TestCache(t *testing.T) {
+	loads := 0
+	var loadErr error
+	flags := []*model.FeatureFlag{{Name: "flag", Enabled: true, RolloutPercentage: 100}}
+	cache := NewCache(func(ctx context.Context) ([]*model.FeatureFlag, error) {
+		loads++
+		return flags, loadErr
+	}, time.Minute)
+	now := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	assert.True(t, cache.Enabled(ctx, "flag", "tenant"))
+	assert.True(t, cache.Enabled(ctx, "flag", "other"))
+	assert.Equal(t, 1, loads)
+
+	// a failed load keeps the last known flags
+	now = now.Add(time.Minute)
+	loadErr = errors.New("sysdb is down")
+	assert.True(t, cache.Enabled(ctx, "flag", "tenant"))
+	assert.True(t, cache.Enabled(ctx, "flag", "tenant"))
+	assert.Equal(t, 2, loads)
+
+	// invalidating loads the flags on the next call
+	loadErr = nil
+	flags = nil
+	cache.Invalidate()
+	assert.False(t, cache.Enabled(ctx, "flag", "tenant"))
+	assert.Equal(t, 3, loads)
+}
+
+func TestCache_NeverLoaded(t *testing.T) {
+	cache := NewCache(func(ctx context.Context) ([]*model.FeatureFlag, error) {
+		return nil, errors.New("sysdb is down")
+	}, 0)
+	assert.False(t, cache.Enabled(context.Background(), "flag", "tenant"))
+}
@@ -1,5 +1,7 @@
 package grpcutils
 
+import "google.golang.org/grpc"
+
 type GrpcConfig struct {
 	// BindAddress is the address to bind the GRPC server to.
 	BindAddress string
@@ -8,6 +10,11 @@ type GrpcConfig struct {
 	CertPath string
 	KeyPath  string
 	CAPath   string
+
+	// UnaryInterceptors run after the tracing and metrics interceptors, in order.
+	UnaryInterceptors []grpc.UnaryServerInterceptor
+	// StreamInterceptors are the interceptors of the streaming RPCs, in order.
+	StreamInterceptors []grpc.StreamServerInterceptor
 }
 
 func (c *GrpcConfig) MTLSEnabled() bool {
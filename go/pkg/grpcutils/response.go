@@ -1,12 +1,15 @@
 package grpcutils
 
 import (
+	"time"
+
 	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 func BuildInvalidArgumentGrpcError(fieldName string, desc string) (error, error) {
@@ -31,6 +34,10 @@ func BuildInternalGrpcError(msg string) error {
 	return status.Error(codes.Internal, msg)
 }
 
+func BuildFailedPreconditionGrpcError(msg string) error {
+	return status.Error(codes.FailedPrecondition, msg)
+}
+
 func BuildErrorForUUID(ID types.UniqueID, name string, err error) error {
 	if err != nil || ID == types.NilUniqueID() {
 		log.Error(name+"id format error", zap.String(name+".id", ID.String()))
@@ -43,3 +50,70 @@ func BuildErrorForUUID(ID types.UniqueID, name string, err error) error {
 	}
 	return nil
 }
+
+// BuildNotLeaderGrpcError rejects a write sent to a sysdb replica that does not
+// hold the leader lease. The address of the leader, when known, is attached as
+// a redirect hint.
+func BuildNotLeaderGrpcError(leaderAddress string) error {
+	st := status.New(codes.Unavailable, "NOT_LEADER")
+	st, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   "NOT_LEADER",
+		Domain:   "sysdb",
+		Metadata: map[string]string{"leader": leaderAddress},
+	})
+	if err != nil {
+		log.Error("Unexpected error attaching metadata", zap.Error(err))
+		return status.Error(codes.Unavailable, "NOT_LEADER")
+	}
+	return st.Err()
+}
+
+// BuildReadOnlyReplicaGrpcError rejects a write sent to a read-only sysdb
+// replica. Unlike NOT_LEADER the error is not transient, retries must go to
+// the writable sysdb at primaryAddress, attached when known.
+func BuildReadOnlyReplicaGrpcError(primaryAddress string) error {
+	st := status.New(codes.FailedPrecondition, "READ_ONLY_REPLICA")
+	st, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   "READ_ONLY_REPLICA",
+		Domain:   "sysdb",
+		Metadata: map[string]string{"primary": primaryAddress},
+	})
+	if err != nil {
+		log.Error("Unexpected error attaching metadata", zap.Error(err))
+		return status.Error(codes.FailedPrecondition, "READ_ONLY_REPLICA")
+	}
+	return st.Err()
+}
+
+// BuildMaintenanceGrpcError rejects a write sent while the sysdb is in
+// maintenance. The error is transient: clients should retry it after retryDelay,
+// the reason of the maintenance is attached for the operators.
+func BuildMaintenanceGrpcError(reason string, retryDelay time.Duration) error {
+	st := status.New(codes.Unavailable, "MAINTENANCE")
+	st, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   "MAINTENANCE",
+		Domain:   "sysdb",
+		Metadata: map[string]string{"reason": reason},
+	}, &errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retryDelay),
+	})
+	if err != nil {
+		log.Error("Unexpected error attaching metadata", zap.Error(err))
+		return status.Error(codes.Unavailable, "MAINTENANCE")
+	}
+	return st.Err()
+}
+
+// BuildOverloadedGrpcError rejects a request that was shed because the server
+// is saturated. Clients should retry it after retryDelay.
+func BuildOverloadedGrpcError(retryDelay time.Duration) error {
+	st := status.New(codes.ResourceExhausted, "server overloaded, retry later")
+	st, err := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retryDelay),
+	})
+	if err != nil {
+		log.Error("Unexpected error attaching metadata", zap.Error(err))
+		return status.Error(codes.ResourceExhausted, "server overloaded, retry later")
+	}
+	return st.Err()
+}
@@ -72,7 +72,9 @@ func newDefaultGrpcProvider(name string, grpcConfig *GrpcConfig, registerFunc fu
 
 		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
 	}
-	opts = append(opts, grpc.UnaryInterceptor(otel.ServerGrpcInterceptor))
+	interceptors := append([]grpc.UnaryServerInterceptor{otel.ServerGrpcInterceptor, otel.ServerGrpcMetricsInterceptor}, grpcConfig.UnaryInterceptors...)
+	opts = append(opts, grpc.ChainUnaryInterceptor(interceptors...))
+	opts = append(opts, grpc.ChainStreamInterceptor(grpcConfig.StreamInterceptors...))
 	OPTL_TRACING_ENDPOINT := os.Getenv("OPTL_TRACING_ENDPOINT")
 	if OPTL_TRACING_ENDPOINT != "" {
 		otel.InitTracing(context.Background(), &otel.TracingConfig{
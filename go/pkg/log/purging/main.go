@@ -15,7 +15,9 @@ import (
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 )
 
-func RunPurging(ctx context.Context, lg *repository.LogRepository) {
+// RunPurging purges the log while this instance is the leader. scrubber may be
+// nil to not scrub.
+func RunPurging(ctx context.Context, lg *repository.LogRepository, retention repository.RetentionPolicy, scrubber *Scrubber) {
 	log.Info("starting purging")
 	podName, _ := os.LookupEnv("POD_NAME")
 	if podName == "" {
@@ -33,7 +35,7 @@ func RunPurging(ctx context.Context, lg *repository.LogRepository) {
 		return
 	}
 
-	elector, err := setupLeaderElection(client, namespace, podName, lg)
+	elector, err := setupLeaderElection(client, namespace, podName, lg, retention, scrubber)
 	if err != nil {
 		log.Error("failed to setup leader election", zap.Error(err))
 		return
@@ -51,7 +53,7 @@ func createKubernetesClient() (*kubernetes.Clientset, error) {
 	return kubernetes.NewForConfig(config)
 }
 
-func setupLeaderElection(client *kubernetes.Clientset, namespace, podName string, lg *repository.LogRepository) (lr *leaderelection.LeaderElector, err error) {
+func setupLeaderElection(client *kubernetes.Clientset, namespace, podName string, lg *repository.LogRepository, retention repository.RetentionPolicy, scrubber *Scrubber) (lr *leaderelection.LeaderElector, err error) {
 	lock := &resourcelock.LeaseLock{
 		LeaseMeta: metav1.ObjectMeta{
 			Name:      "log-purging-lock",
@@ -72,7 +74,7 @@ func setupLeaderElection(client *kubernetes.Clientset, namespace, podName string
 		Callbacks: leaderelection.LeaderCallbacks{
 			OnStartedLeading: func(ctx context.Context) {
 				log.Info("started leading")
-				performPurgingLoop(ctx, lr, lg)
+				performPurgingLoop(ctx, lr, lg, retention, scrubber)
 			},
 			OnStoppedLeading: func() {
 				log.Info("stopped leading")
@@ -82,7 +84,8 @@ func setupLeaderElection(client *kubernetes.Clientset, namespace, podName string
 	return
 }
 
-func performPurgingLoop(ctx context.Context, le *leaderelection.LeaderElector, lg *repository.LogRepository) {
+func performPurgingLoop(ctx context.Context, le *leaderelection.LeaderElector, lg *repository.LogRepository, retention repository.RetentionPolicy, scrubber *Scrubber) {
+	metrics := newPurgeMetrics()
 	ticker := time.NewTicker(10 * time.Second)
 	defer ticker.Stop()
 
@@ -94,11 +97,25 @@ func performPurgingLoop(ctx context.Context, le *leaderelection.LeaderElector, l
 			log.Info("checking leader status")
 			if le.IsLeader() {
 				log.Info("leader is active")
-				if err := lg.PurgeRecords(ctx); err != nil {
+				dropped, err := lg.PurgeRecords(ctx, retention)
+				if err != nil {
 					log.Error("failed to purge records", zap.Error(err))
 					continue
 				}
-				log.Info("purged records")
+				metrics.recordDropped(ctx, dropped)
+				log.Info("purged records", zap.Int64("records", dropped.Records), zap.Int64("bytes", dropped.Bytes))
+				retained, err := lg.GetRetainedVolume(ctx)
+				if err != nil {
+					log.Error("failed to get retained log volume", zap.Error(err))
+					continue
+				}
+				metrics.recordRetained(retained)
+				if err := lg.PurgeProducerSequences(ctx); err != nil {
+					log.Error("failed to purge producer sequences", zap.Error(err))
+				}
+				if scrubber != nil {
+					scrubber.runIfDue(ctx, time.Now())
+				}
 			} else {
 				log.Info("leader is inactive")
 				break
@@ -0,0 +1,137 @@
+package purging
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/chroma-core/chroma/go/pkg/log/repository"
+	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// purgeMetrics reports the volume of compacted records dropped by the purge and
+// the volume retained by the retention policies after each purge.
+type purgeMetrics struct {
+	droppedRecords  metric.Int64Counter
+	droppedBytes    metric.Int64Counter
+	retainedRecords atomic.Int64
+	retainedBytes   atomic.Int64
+}
+
+func newPurgeMetrics() *purgeMetrics {
+	m := &purgeMetrics{}
+	meter := otel.Meter("chroma.log")
+	var err error
+	m.droppedRecords, err = meter.Int64Counter("log.purge.dropped_records",
+		metric.WithDescription("Number of compacted records dropped by the purge"))
+	if err != nil {
+		log.Error("failed to create dropped records counter", zap.Error(err))
+	}
+	m.droppedBytes, err = meter.Int64Counter("log.purge.dropped_bytes",
+		metric.WithDescription("Size of the compacted records dropped by the purge"),
+		metric.WithUnit("By"))
+	if err != nil {
+		log.Error("failed to create dropped bytes counter", zap.Error(err))
+	}
+	_, err = meter.Int64ObservableGauge("log.retained_records",
+		metric.WithDescription("Number of compacted records kept by the retention policies"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(m.retainedRecords.Load())
+			return nil
+		}))
+	if err != nil {
+		log.Error("failed to create retained records gauge", zap.Error(err))
+	}
+	_, err = meter.Int64ObservableGauge("log.retained_bytes",
+		metric.WithDescription("Size of the compacted records kept by the retention policies"),
+		metric.WithUnit("By"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(m.retainedBytes.Load())
+			return nil
+		}))
+	if err != nil {
+		log.Error("failed to create retained bytes gauge", zap.Error(err))
+	}
+	return m
+}
+
+func (m *purgeMetrics) recordDropped(ctx context.Context, dropped repository.LogVolume) {
+	if m.droppedRecords != nil {
+		m.droppedRecords.Add(ctx, dropped.Records)
+	}
+	if m.droppedBytes != nil {
+		m.droppedBytes.Add(ctx, dropped.Bytes)
+	}
+}
+
+func (m *purgeMetrics) recordRetained(retained repository.LogVolume) {
+	m.retainedRecords.Store(retained.Records)
+	m.retainedBytes.Store(retained.Bytes)
+}
+
+// scrubMetrics reports the collections with a log the sysdb does not know or
+// has soft deleted, as of the last scrub, and the logs dropped by the scrub.
+type scrubMetrics struct {
+	missingCollections atomic.Int64
+	deletedCollections atomic.Int64
+	purgedCollections  metric.Int64Counter
+	purgedRecords      metric.Int64Counter
+	purgedBytes        metric.Int64Counter
+}
+
+func newScrubMetrics() *scrubMetrics {
+	m := &scrubMetrics{}
+	meter := otel.Meter("chroma.log")
+	var err error
+	_, err = meter.Int64ObservableGauge("log.scrub.missing_collections",
+		metric.WithDescription("Number of collections with a log that are not in the sysdb"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(m.missingCollections.Load())
+			return nil
+		}))
+	if err != nil {
+		log.Error("failed to create missing collections gauge", zap.Error(err))
+	}
+	_, err = meter.Int64ObservableGauge("log.scrub.deleted_collections",
+		metric.WithDescription("Number of collections with a log that are soft deleted in the sysdb"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(m.deletedCollections.Load())
+			return nil
+		}))
+	if err != nil {
+		log.Error("failed to create deleted collections gauge", zap.Error(err))
+	}
+	m.purgedCollections, err = meter.Int64Counter("log.scrub.purged_collections",
+		metric.WithDescription("Number of logs of unknown collections dropped by the scrub"))
+	if err != nil {
+		log.Error("failed to create purged collections counter", zap.Error(err))
+	}
+	m.purgedRecords, err = meter.Int64Counter("log.scrub.purged_records",
+		metric.WithDescription("Number of records dropped with the logs of unknown collections"))
+	if err != nil {
+		log.Error("failed to create purged records counter", zap.Error(err))
+	}
+	m.purgedBytes, err = meter.Int64Counter("log.scrub.purged_bytes",
+		metric.WithDescription("Size of the records dropped with the logs of unknown collections"),
+		metric.WithUnit("By"))
+	if err != nil {
+		log.Error("failed to create purged bytes counter", zap.Error(err))
+	}
+	return m
+}
+
+func (m *scrubMetrics) record(ctx context.Context, report scrubReport) {
+	m.missingCollections.Store(report.Missing)
+	m.deletedCollections.Store(report.Deleted)
+	if m.purgedCollections != nil {
+		m.purgedCollections.Add(ctx, report.Purged)
+	}
+	if m.purgedRecords != nil {
+		m.purgedRecords.Add(ctx, report.Dropped.Records)
+	}
+	if m.purgedBytes != nil {
+		m.purgedBytes.Add(ctx, report.Dropped.Bytes)
+	}
+}
@@ -0,0 +1,143 @@
+package purging
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/log/repository"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+// scrubBatchSize is the number of collections checked against the sysdb per
+// call, at most the batch limit of CheckCollections.
+const scrubBatchSize = 1000
+
+type scrubRepository interface {
+	ListCollectionIds(ctx context.Context, startAfter string, limit int32) ([]string, error)
+	DeleteCollection(ctx context.Context, collectionId string) (repository.LogVolume, error)
+}
+
+type collectionChecker interface {
+	CheckCollections(ctx context.Context, in *coordinatorpb.CheckCollectionsRequest, opts ...grpc.CallOption) (*coordinatorpb.CheckCollectionsResponse, error)
+}
+
+// Scrubber cross-checks the collections with a log against the sysdb. A
+// collection the sysdb does not know is flagged, and its log is dropped when it
+// is still missing at the next scrub and purging is enabled. The second look
+// keeps a collection whose log was written just before it was created in the
+// sysdb from being dropped. Soft deleted collections are only counted, their
+// logs are dropped once the collection is gone from the sysdb.
+type Scrubber struct {
+	lr       scrubRepository
+	sysdb    collectionChecker
+	purge    bool
+	interval time.Duration
+	metrics  *scrubMetrics
+
+	lastRun time.Time
+	flagged map[string]struct{}
+}
+
+// scrubReport is the outcome of one scrub. Missing counts the unknown
+// collections whose logs are left, Purged those whose logs were dropped.
+type scrubReport struct {
+	Checked int64
+	Missing int64
+	Deleted int64
+	Purged  int64
+	Dropped repository.LogVolume
+}
+
+func NewScrubber(lr *repository.LogRepository, sysdb coordinatorpb.SysDBClient, purge bool, interval time.Duration) *Scrubber {
+	return newScrubber(lr, sysdb, purge, interval)
+}
+
+func newScrubber(lr scrubRepository, sysdb collectionChecker, purge bool, interval time.Duration) *Scrubber {
+	return &Scrubber{
+		lr:       lr,
+		sysdb:    sysdb,
+		purge:    purge,
+		interval: interval,
+		metrics:  newScrubMetrics(),
+		flagged:  map[string]struct{}{},
+	}
+}
+
+// runIfDue scrubs when the last scrub is at least interval old.
+func (s *Scrubber) runIfDue(ctx context.Context, now time.Time) {
+	if now.Sub(s.lastRun) < s.interval {
+		return
+	}
+	s.lastRun = now
+	report, err := s.scrub(ctx)
+	if err != nil {
+		log.Error("failed to scrub logs", zap.Error(err))
+		return
+	}
+	s.metrics.record(ctx, report)
+	log.Info("scrubbed logs", zap.Int64("checked", report.Checked), zap.Int64("missing", report.Missing), zap.Int64("deleted", report.Deleted), zap.Int64("purged", report.Purged), zap.Int64("droppedRecords", report.Dropped.Records))
+}
+
+func (s *Scrubber) scrub(ctx context.Context) (report scrubReport, err error) {
+	flagged := map[string]struct{}{}
+	startAfter := ""
+	for {
+		var collectionIds []string
+		collectionIds, err = s.lr.ListCollectionIds(ctx, startAfter, scrubBatchSize)
+		if err != nil || len(collectionIds) == 0 {
+			break
+		}
+		startAfter = collectionIds[len(collectionIds)-1]
+
+		var res *coordinatorpb.CheckCollectionsResponse
+		res, err = s.sysdb.CheckCollections(ctx, &coordinatorpb.CheckCollectionsRequest{CollectionIds: collectionIds})
+		if err != nil {
+			break
+		}
+		if res.GetStatus().GetCode() != 200 {
+			err = fmt.Errorf("check collections failed: %s", res.GetStatus().GetReason())
+			break
+		}
+		for _, collection := range res.Collections {
+			report.Checked++
+			if collection.IsDeleted {
+				report.Deleted++
+			}
+			if collection.Exists {
+				continue
+			}
+			collectionId := collection.CollectionId
+			if _, ok := s.flagged[collectionId]; !ok || !s.purge {
+				if !ok {
+					log.Warn("log of unknown collection", zap.String("collectionId", collectionId))
+				}
+				flagged[collectionId] = struct{}{}
+				report.Missing++
+				continue
+			}
+			var dropped repository.LogVolume
+			dropped, err = s.lr.DeleteCollection(ctx, collectionId)
+			if err != nil {
+				log.Error("failed to drop log of unknown collection", zap.String("collectionId", collectionId), zap.Error(err))
+				flagged[collectionId] = struct{}{}
+				report.Missing++
+				err = nil
+				continue
+			}
+			log.Info("dropped log of unknown collection", zap.String("collectionId", collectionId), zap.Int64("records", dropped.Records))
+			report.Purged++
+			report.Dropped.Records += dropped.Records
+			report.Dropped.Bytes += dropped.Bytes
+		}
+	}
+	if err != nil {
+		// keep what was flagged before, a partial scrub must not reset it
+		return
+	}
+	s.flagged = flagged
+	return
+}
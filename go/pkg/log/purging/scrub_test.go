@@ -0,0 +1,111 @@
+package purging
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/log/repository"
+	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+type fakeLogs struct {
+	records map[string]int64
+}
+
+func (f *fakeLogs) ListCollectionIds(_ context.Context, startAfter string, limit int32) ([]string, error) {
+	var ids []string
+	for id := range f.records {
+		if id > startAfter {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	if len(ids) > int(limit) {
+		ids = ids[:limit]
+	}
+	return ids, nil
+}
+
+func (f *fakeLogs) DeleteCollection(_ context.Context, collectionId string) (repository.LogVolume, error) {
+	dropped := repository.LogVolume{Records: f.records[collectionId], Bytes: 10 * f.records[collectionId]}
+	delete(f.records, collectionId)
+	return dropped, nil
+}
+
+type fakeSysDB struct {
+	collections map[string]bool // id to soft deleted
+	err         error
+}
+
+func (f *fakeSysDB) CheckCollections(_ context.Context, in *coordinatorpb.CheckCollectionsRequest, _ ...grpc.CallOption) (*coordinatorpb.CheckCollectionsResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	res := &coordinatorpb.CheckCollectionsResponse{Status: &coordinatorpb.Status{Code: 200}}
+	for _, id := range in.CollectionIds {
+		deleted, exists := f.collections[id]
+		res.Collections = append(res.Collections, &coordinatorpb.CollectionExistence{CollectionId: id, Exists: exists, IsDeleted: deleted})
+	}
+	return res, nil
+}
+
+func TestScrub(t *testing.T) {
+	logs := &fakeLogs{records: map[string]int64{"live": 1, "deleted": 2, "dropped": 3}}
+	sysdb := &fakeSysDB{collections: map[string]bool{"live": false, "deleted": true}}
+	s := newScrubber(logs, sysdb, true, time.Hour)
+
+	// the first scrub only flags
+	report, err := s.scrub(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, scrubReport{Checked: 3, Missing: 1, Deleted: 1}, report)
+	assert.Contains(t, logs.records, "dropped")
+
+	// a failed scrub keeps the flags
+	sysdb.err = errors.New("unavailable")
+	_, err = s.scrub(context.Background())
+	assert.Error(t, err)
+	sysdb.err = nil
+
+	// the second one drops the log
+	report, err = s.scrub(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, scrubReport{Checked: 3, Deleted: 1, Purged: 1, Dropped: repository.LogVolume{Records: 3, Bytes: 30}}, report)
+	assert.NotContains(t, logs.records, "dropped")
+
+	// a collection that shows up in the sysdb is unflagged
+	logs.records["late"] = 1
+	_, err = s.scrub(context.Background())
+	assert.NoError(t, err)
+	sysdb.collections["late"] = false
+	report, err = s.scrub(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), report.Missing)
+	assert.NotContains(t, s.flagged, "late")
+}
+
+func TestScrub_FlagOnly(t *testing.T) {
+	logs := &fakeLogs{records: map[string]int64{"dropped": 3}}
+	s := newScrubber(logs, &fakeSysDB{}, false, time.Hour)
+	for i := 0; i < 2; i++ {
+		report, err := s.scrub(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, scrubReport{Checked: 1, Missing: 1}, report)
+	}
+	assert.Contains(t, logs.records, "dropped")
+}
+
+func TestScrub_RunIfDue(t *testing.T) {
+	logs := &fakeLogs{records: map[string]int64{"dropped": 3}}
+	s := newScrubber(logs, &fakeSysDB{}, true, time.Hour)
+	now := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+	s.runIfDue(context.Background(), now)
+	s.runIfDue(context.Background(), now.Add(time.Minute))
+	assert.Contains(t, logs.records, "dropped")
+	s.runIfDue(context.Background(), now.Add(time.Hour))
+	assert.NotContains(t, logs.records, "dropped")
+}
@@ -3,25 +3,92 @@ package repository
 import (
 	"context"
 	"errors"
+	"sort"
 	"time"
 
 	log "github.com/chroma-core/chroma/go/database/log/db"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// ErrDuplicateCollectionPush is returned when an atomic push names a collection
+// more than once.
+var ErrDuplicateCollectionPush = errors.New("collection is pushed to more than once")
+
 type LogRepository struct {
 	conn    *pgxpool.Pool
 	queries *log.Queries
 }
 
-func (r *LogRepository) InsertRecords(ctx context.Context, collectionId string, records [][]byte) (insertCount int64, err error) {
+// Producer identifies a push for deduplication. Sequence numbers are per
+// producer and collection and must increase from one push to the next.
+type Producer struct {
+	ID       string
+	Sequence int64
+}
+
+// producerSequenceRetention is how long the last sequence number of a producer
+// is kept, and so how late a retry can still be recognized.
+const producerSequenceRetention = 24 * time.Hour
+
+// InsertRecords appends records to the log of a collection. tenantId may be
+// empty; once known it is kept with the collection to select its retention.
+// When producer is set, a push whose sequence number is not above the last one
+// seen from the producer is a retry: nothing is appended and duplicate is set.
+// A retry of the last push returns the count of records it appended.
+func (r *LogRepository) InsertRecords(ctx context.Context, collectionId string, tenantId string, producer *Producer, records [][]byte) (insertCount int64, duplicate bool, err error) {
+	var results []PushResult
+	results, err = r.InsertRecordsAtomically(ctx, []Push{{
+		CollectionId: collectionId,
+		TenantId:     tenantId,
+		Producer:     producer,
+		Records:      records,
+	}})
+	if err != nil {
+		return
+	}
+	insertCount, duplicate = results[0].InsertCount, results[0].Duplicate
+	return
+}
+
+// Push is an append to the log of one collection, see InsertRecords.
+type Push struct {
+	CollectionId string
+	TenantId     string
+	Producer     *Producer
+	Records      [][]byte
+}
+
+type PushResult struct {
+	InsertCount int64
+	Duplicate   bool
+}
+
+// InsertRecordsAtomically appends to the logs of several collections in one
+// transaction, so either every push is visible or none is. A push that is a
+// retry is skipped without failing the others. Collections are locked in id
+// order so that concurrent calls cannot deadlock.
+func (r *LogRepository) InsertRecordsAtomically(ctx context.Context, pushes []Push) (results []PushResult, err error) {
+	order := make([]int, len(pushes))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool {
+		return pushes[order[i]].CollectionId < pushes[order[j]].CollectionId
+	})
+	for i := 1; i < len(order); i++ {
+		if pushes[order[i]].CollectionId == pushes[order[i-1]].CollectionId {
+			err = ErrDuplicateCollectionPush
+			return
+		}
+	}
+
 	var tx pgx.Tx
 	tx, err = r.conn.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
 		return
 	}
-	var collection log.Collection
 	queriesWithTx := r.queries.WithTx(tx)
 	defer func() {
 		if err != nil {
@@ -30,6 +97,20 @@ func (r *LogRepository) InsertRecords(ctx context.Context, collectionId string,
 			err = tx.Commit(ctx)
 		}
 	}()
+	results = make([]PushResult, len(pushes))
+	for _, i := range order {
+		results[i], err = insertRecords(ctx, queriesWithTx, pushes[i])
+		if err != nil {
+			results = nil
+			return
+		}
+	}
+	return
+}
+
+func insertRecords(ctx context.Context, queriesWithTx *log.Queries, push Push) (result PushResult, err error) {
+	collectionId, tenantId, producer := push.CollectionId, push.TenantId, push.Producer
+	var collection log.Collection
 	collection, err = queriesWithTx.GetCollectionForUpdate(ctx, collectionId)
 	if err != nil {
 		// If no row found, insert one.
@@ -38,6 +119,7 @@ func (r *LogRepository) InsertRecords(ctx context.Context, collectionId string,
 				ID:                              collectionId,
 				RecordEnumerationOffsetPosition: 0,
 				RecordCompactionOffsetPosition:  0,
+				TenantID:                        tenantId,
 			})
 			if err != nil {
 				return
@@ -45,9 +127,35 @@ func (r *LogRepository) InsertRecords(ctx context.Context, collectionId string,
 		} else {
 			return
 		}
+	} else if collection.TenantID == "" && tenantId != "" {
+		err = queriesWithTx.UpdateCollectionTenant(ctx, log.UpdateCollectionTenantParams{
+			ID:       collectionId,
+			TenantID: tenantId,
+		})
+		if err != nil {
+			return
+		}
+	}
+	if producer != nil {
+		var last log.ProducerSequence
+		last, err = queriesWithTx.GetProducerSequence(ctx, log.GetProducerSequenceParams{
+			CollectionID: collectionId,
+			ProducerID:   producer.ID,
+		})
+		if err == nil && producer.Sequence <= last.Sequence {
+			result.Duplicate = true
+			if producer.Sequence == last.Sequence {
+				result.InsertCount = last.RecordCount
+			}
+			return
+		}
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return
+		}
+		err = nil
 	}
-	params := make([]log.InsertRecordParams, len(records))
-	for i, record := range records {
+	params := make([]log.InsertRecordParams, len(push.Records))
+	for i, record := range push.Records {
 		offset := collection.RecordEnumerationOffsetPosition + int64(i) + 1
 		params[i] = log.InsertRecordParams{
 			CollectionID: collectionId,
@@ -56,13 +164,23 @@ func (r *LogRepository) InsertRecords(ctx context.Context, collectionId string,
 			Timestamp:    time.Now().UnixNano(),
 		}
 	}
-	insertCount, err = queriesWithTx.InsertRecord(ctx, params)
+	result.InsertCount, err = queriesWithTx.InsertRecord(ctx, params)
 	if err != nil {
 		return
 	}
 	err = queriesWithTx.UpdateCollectionEnumerationOffsetPosition(ctx, log.UpdateCollectionEnumerationOffsetPositionParams{
 		ID:                              collectionId,
-		RecordEnumerationOffsetPosition: collection.RecordEnumerationOffsetPosition + insertCount,
+		RecordEnumerationOffsetPosition: collection.RecordEnumerationOffsetPosition + result.InsertCount,
+	})
+	if err != nil || producer == nil {
+		return
+	}
+	err = queriesWithTx.UpsertProducerSequence(ctx, log.UpsertProducerSequenceParams{
+		CollectionID: collectionId,
+		ProducerID:   producer.ID,
+		Sequence:     producer.Sequence,
+		RecordCount:  result.InsertCount,
+		UpdatedAt:    time.Now().UnixNano(),
 	})
 	return
 }
@@ -77,13 +195,32 @@ func (r *LogRepository) PullRecords(ctx context.Context, collectionId string, of
 	return
 }
 
-func (r *LogRepository) GetAllCollectionInfoToCompact(ctx context.Context, minCompactionSize uint64) (collectionToCompact []log.GetAllCollectionsToCompactRow, err error) {
-	collectionToCompact, err = r.queries.GetAllCollectionsToCompact(ctx, int64(minCompactionSize))
+// GetAllCollectionInfoToCompact returns the collections with at least
+// minCompactionSize uncompacted records round robin across tenants: the
+// collection with the oldest uncompacted record of every tenant first, then the
+// second oldest of every tenant and so on. A positive maxPerTenant caps the
+// number of collections returned per tenant, so that a tenant with many dirty
+// collections cannot take all the compactors. Collections without a known
+// tenant are their own tenant.
+func (r *LogRepository) GetAllCollectionInfoToCompact(ctx context.Context, minCompactionSize uint64, maxPerTenant uint64) (collectionToCompact []log.GetAllCollectionsToCompactRow, err error) {
+	collectionToCompact, err = r.queries.GetAllCollectionsToCompact(ctx, log.GetAllCollectionsToCompactParams{
+		MinCompactionSize: int64(minCompactionSize),
+		MaxPerTenant:      int64(maxPerTenant),
+	})
 	if collectionToCompact == nil {
 		collectionToCompact = []log.GetAllCollectionsToCompactRow{}
 	}
 	return
 }
+
+// GetCollectionsLogLag returns the number of uncompacted records and the
+// timestamp of the oldest one for the collections known to the log. Collections
+// that never received a record are left out.
+func (r *LogRepository) GetCollectionsLogLag(ctx context.Context, collectionIds []string) (lags []log.GetCollectionsLogLagRow, err error) {
+	lags, err = r.queries.GetCollectionsLogLag(ctx, collectionIds)
+	return
+}
+
 func (r *LogRepository) UpdateCollectionCompactionOffsetPosition(ctx context.Context, collectionId string, offsetPosition int64) (err error) {
 	err = r.queries.UpdateCollectionCompactionOffsetPosition(ctx, log.UpdateCollectionCompactionOffsetPositionParams{
 		ID:                             collectionId,
@@ -92,11 +229,128 @@ func (r *LogRepository) UpdateCollectionCompactionOffsetPosition(ctx context.Con
 	return
 }
 
-func (r *LogRepository) PurgeRecords(ctx context.Context) (err error) {
-	err = r.queries.PurgeRecords(ctx)
+// RetentionPolicy is how long compacted records are kept. A record is dropped
+// once it is older than MaxAge or the newer compacted records of its collection
+// add up to more than MaxBytes. Zero keeps nothing and negative values are
+// unlimited.
+type RetentionPolicy struct {
+	MaxAge   time.Duration
+	MaxBytes int64
+}
+
+// LogVolume is a number of records and their size in bytes.
+type LogVolume struct {
+	Records int64
+	Bytes   int64
+}
+
+// PurgeRecords drops the compacted records that fall outside the retention
+// policy of their tenant, or outside retention when the tenant has none, and
+// returns the dropped volume.
+func (r *LogRepository) PurgeRecords(ctx context.Context, retention RetentionPolicy) (dropped LogVolume, err error) {
+	maxAgeSeconds := int64(retention.MaxAge / time.Second)
+	if retention.MaxAge < 0 {
+		maxAgeSeconds = -1
+	}
+	var row log.PurgeRecordsRow
+	row, err = r.queries.PurgeRecords(ctx, log.PurgeRecordsParams{
+		DefaultMaxAgeSeconds: maxAgeSeconds,
+		DefaultMaxBytes:      retention.MaxBytes,
+		Now:                  time.Now().UnixNano(),
+	})
+	if err != nil {
+		return
+	}
+	dropped = LogVolume{Records: row.RecordCount, Bytes: row.SizeBytes}
+	return
+}
+
+// PurgeProducerSequences forgets the producers that did not push for a while.
+func (r *LogRepository) PurgeProducerSequences(ctx context.Context) (err error) {
+	err = r.queries.PurgeProducerSequences(ctx, time.Now().Add(-producerSequenceRetention).UnixNano())
+	return
+}
+
+// GetRetainedVolume returns the volume of the compacted records that are still
+// in the log.
+func (r *LogRepository) GetRetainedVolume(ctx context.Context) (retained LogVolume, err error) {
+	var row log.GetRetainedLogVolumeRow
+	row, err = r.queries.GetRetainedLogVolume(ctx)
+	if err != nil {
+		return
+	}
+	retained = LogVolume{Records: row.RecordCount, Bytes: row.SizeBytes}
 	return
 }
 
+// SetRetentionPolicy overrides the retention of a tenant. A nil limit falls back
+// to the deployment setting.
+func (r *LogRepository) SetRetentionPolicy(ctx context.Context, tenantId string, maxAgeSeconds *int64, maxBytes *int64) (err error) {
+	err = r.queries.UpsertRetentionPolicy(ctx, log.UpsertRetentionPolicyParams{
+		TenantID:      tenantId,
+		MaxAgeSeconds: optionalInt8(maxAgeSeconds),
+		MaxBytes:      optionalInt8(maxBytes),
+	})
+	return
+}
+
+// ListCollectionIds returns up to limit ids of the collections with a log, in
+// id order, starting after startAfter.
+func (r *LogRepository) ListCollectionIds(ctx context.Context, startAfter string, limit int32) (collectionIds []string, err error) {
+	collectionIds, err = r.queries.GetCollectionIdsAfter(ctx, log.GetCollectionIdsAfterParams{
+		ID:    startAfter,
+		Limit: limit,
+	})
+	return
+}
+
+// DeleteCollection drops the log of a collection including the records that
+// were not compacted yet, and returns the dropped volume.
+func (r *LogRepository) DeleteCollection(ctx context.Context, collectionId string) (dropped LogVolume, err error) {
+	var tx pgx.Tx
+	tx, err = r.conn.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return
+	}
+	queriesWithTx := r.queries.WithTx(tx)
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+	}()
+	_, err = queriesWithTx.GetCollectionForUpdate(ctx, collectionId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = nil
+		}
+		return
+	}
+	var row log.DeleteRecordsForCollectionRow
+	row, err = queriesWithTx.DeleteRecordsForCollection(ctx, collectionId)
+	if err != nil {
+		return
+	}
+	err = queriesWithTx.DeleteProducerSequencesForCollection(ctx, collectionId)
+	if err != nil {
+		return
+	}
+	err = queriesWithTx.DeleteCollection(ctx, collectionId)
+	if err != nil {
+		return
+	}
+	dropped = LogVolume{Records: row.RecordCount, Bytes: row.SizeBytes}
+	return
+}
+
+func optionalInt8(value *int64) pgtype.Int8 {
+	if value == nil {
+		return pgtype.Int8{}
+	}
+	return pgtype.Int8{Int64: *value, Valid: true}
+}
+
 func NewLogRepository(conn *pgxpool.Pool) *LogRepository {
 	return &LogRepository{
 		conn:    conn,
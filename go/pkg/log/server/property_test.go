@@ -16,6 +16,8 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"pgregory.net/rapid"
 )
@@ -55,7 +57,7 @@ func (suite *LogServerTestSuite) SetupSuite() {
 	err = libs2.RunMigration(ctx, connectionString)
 	assert.NoError(suite.t, err, "Failed to run migration")
 	suite.lr = repository.NewLogRepository(conn)
-	suite.logServer = NewLogServer(suite.lr)
+	suite.logServer = NewLogServer(suite.lr, 0)
 	suite.model = ModelState{
 		CollectionEnumerationOffset: map[types.UniqueID]uint64{},
 		CollectionData:              map[types.UniqueID][]ModelLogRecord{},
@@ -439,7 +441,7 @@ func (suite *LogServerTestSuite) TestRecordLogDb_PushLogs() {
 				suite.modelPurgeLogs(ctx, t)
 
 				// Purge the SUT
-				err := suite.lr.PurgeRecords(ctx)
+				_, err := suite.lr.PurgeRecords(ctx, repository.RetentionPolicy{})
 				suite.NoError(err)
 
 				// Verify that all record logs are purged
@@ -463,6 +465,165 @@ func (suite *LogServerTestSuite) TestRecordLogDb_PushLogs() {
 	})
 }
 
+func (suite *LogServerTestSuite) TestPushLogs_DeduplicatesRetries() {
+	ctx := context.Background()
+	collectionId := types.NewUniqueID()
+	producerId := "frontend-0"
+	push := func(sequence int64, count int) *logservicepb.PushLogsResponse {
+		records := make([]*coordinatorpb.OperationRecord, count)
+		for i := range records {
+			records[i] = &coordinatorpb.OperationRecord{Id: "id", Operation: coordinatorpb.Operation_ADD}
+		}
+		res, err := suite.logServer.PushLogs(ctx, &logservicepb.PushLogsRequest{
+			CollectionId: collectionId.String(),
+			Records:      records,
+			ProducerId:   &producerId,
+			Sequence:     &sequence,
+		})
+		suite.NoError(err)
+		return res
+	}
+
+	suite.Equal(&logservicepb.PushLogsResponse{RecordCount: 2}, push(1, 2))
+	// a retry of the last push reports what it appended
+	suite.Equal(&logservicepb.PushLogsResponse{RecordCount: 2, Duplicate: true}, push(1, 2))
+	suite.Equal(&logservicepb.PushLogsResponse{RecordCount: 3}, push(2, 3))
+	suite.Equal(&logservicepb.PushLogsResponse{Duplicate: true}, push(1, 2))
+
+	records, err := suite.lr.PullRecords(ctx, collectionId.String(), 0, 10, time.Now().UnixNano())
+	suite.NoError(err)
+	suite.Len(records, 5)
+
+	// mark the collection compacted so that it does not show up in the model checks
+	_, err = suite.logServer.UpdateCollectionLogOffset(ctx, &logservicepb.UpdateCollectionLogOffsetRequest{
+		CollectionId: collectionId.String(),
+		LogOffset:    5,
+	})
+	suite.NoError(err)
+}
+
+func (suite *LogServerTestSuite) TestPushLogsAtomic() {
+	ctx := context.Background()
+	collectionId, sidecarId := types.NewUniqueID(), types.NewUniqueID()
+	record := &coordinatorpb.OperationRecord{Id: "id", Operation: coordinatorpb.Operation_ADD}
+
+	// a collection pushed twice fails the whole request
+	_, err := suite.logServer.PushLogsAtomic(ctx, &logservicepb.PushLogsAtomicRequest{
+		Pushes: []*logservicepb.PushLogsRequest{
+			{CollectionId: sidecarId.String(), Records: []*coordinatorpb.OperationRecord{record}},
+			{CollectionId: collectionId.String(), Records: []*coordinatorpb.OperationRecord{record}},
+			{CollectionId: sidecarId.String(), Records: []*coordinatorpb.OperationRecord{record}},
+		},
+	})
+	suite.Equal(codes.InvalidArgument, status.Code(err))
+	records, err := suite.lr.PullRecords(ctx, collectionId.String(), 0, 10, time.Now().UnixNano())
+	suite.NoError(err)
+	suite.Len(records, 0)
+
+	res, err := suite.logServer.PushLogsAtomic(ctx, &logservicepb.PushLogsAtomicRequest{
+		Pushes: []*logservicepb.PushLogsRequest{
+			{CollectionId: sidecarId.String(), Records: []*coordinatorpb.OperationRecord{record}},
+			{CollectionId: collectionId.String(), Records: []*coordinatorpb.OperationRecord{record, record}},
+		},
+	})
+	suite.NoError(err)
+	suite.Len(res.Results, 2)
+	suite.Equal(int32(1), res.Results[0].RecordCount)
+	suite.Equal(int32(2), res.Results[1].RecordCount)
+
+	// mark the collections compacted so that they do not show up in the model checks
+	for id, offset := range map[types.UniqueID]int64{collectionId: 2, sidecarId: 1} {
+		_, err = suite.logServer.UpdateCollectionLogOffset(ctx, &logservicepb.UpdateCollectionLogOffsetRequest{
+			CollectionId: id.String(),
+			LogOffset:    offset,
+		})
+		suite.NoError(err)
+	}
+}
+
+func (suite *LogServerTestSuite) TestGetAllCollectionInfoToCompact_TenantFairness() {
+	ctx := context.Background()
+	busy := []types.UniqueID{types.NewUniqueID(), types.NewUniqueID(), types.NewUniqueID()}
+	quiet := types.NewUniqueID()
+	record := &coordinatorpb.OperationRecord{Id: "id", Operation: coordinatorpb.Operation_ADD}
+	push := func(collectionId types.UniqueID, tenant string) {
+		_, err := suite.logServer.PushLogs(ctx, &logservicepb.PushLogsRequest{
+			CollectionId: collectionId.String(),
+			Records:      []*coordinatorpb.OperationRecord{record},
+			Tenant:       tenant,
+		})
+		suite.NoError(err)
+	}
+	for _, collectionId := range busy {
+		push(collectionId, "busy")
+	}
+	push(quiet, "quiet")
+
+	// the oldest collection of every tenant comes first, and the busy tenant gets
+	// no more than two
+	res, err := suite.logServer.GetAllCollectionInfoToCompact(ctx, &logservicepb.GetAllCollectionInfoToCompactRequest{
+		MaxCollectionsPerTenant: 2,
+	})
+	suite.NoError(err)
+	ours := map[string]bool{busy[0].String(): true, busy[1].String(): true, busy[2].String(): true, quiet.String(): true}
+	var returned []string
+	for _, info := range res.AllCollectionInfo {
+		if ours[info.CollectionId] {
+			returned = append(returned, info.CollectionId)
+		}
+	}
+	suite.Equal([]string{busy[0].String(), quiet.String(), busy[1].String()}, returned)
+
+	// mark the collections compacted so that they do not show up in the model checks
+	for _, collectionId := range append(busy, quiet) {
+		_, err = suite.logServer.UpdateCollectionLogOffset(ctx, &logservicepb.UpdateCollectionLogOffsetRequest{
+			CollectionId: collectionId.String(),
+			LogOffset:    1,
+		})
+		suite.NoError(err)
+	}
+}
+
+func (suite *LogServerTestSuite) TestGetCollectionLogLag() {
+	ctx := context.Background()
+	collectionId, unknownId := types.NewUniqueID(), types.NewUniqueID()
+	record := &coordinatorpb.OperationRecord{Id: "id", Operation: coordinatorpb.Operation_ADD}
+	before := time.Now().UnixNano()
+	_, err := suite.logServer.PushLogs(ctx, &logservicepb.PushLogsRequest{
+		CollectionId: collectionId.String(),
+		Records:      []*coordinatorpb.OperationRecord{record, record, record},
+	})
+	suite.NoError(err)
+	_, err = suite.logServer.UpdateCollectionLogOffset(ctx, &logservicepb.UpdateCollectionLogOffsetRequest{
+		CollectionId: collectionId.String(),
+		LogOffset:    1,
+	})
+	suite.NoError(err)
+
+	// collections that never received a record are left out
+	res, err := suite.logServer.GetCollectionLogLag(ctx, &logservicepb.GetCollectionLogLagRequest{
+		CollectionIds: []string{collectionId.String(), unknownId.String()},
+	})
+	suite.NoError(err)
+	suite.Len(res.Lags, 1)
+	suite.Equal(int64(2), res.Lags[0].UncompactedRecords)
+	suite.GreaterOrEqual(res.Lags[0].OldestUncompactedTs, before)
+	suite.Equal(int64(3), res.Lags[0].MaxOffset)
+
+	// mark the collection compacted so that it does not show up in the model checks
+	_, err = suite.logServer.UpdateCollectionLogOffset(ctx, &logservicepb.UpdateCollectionLogOffsetRequest{
+		CollectionId: collectionId.String(),
+		LogOffset:    3,
+	})
+	suite.NoError(err)
+	res, err = suite.logServer.GetCollectionLogLag(ctx, &logservicepb.GetCollectionLogLagRequest{
+		CollectionIds: []string{collectionId.String()},
+	})
+	suite.NoError(err)
+	suite.Equal(int64(0), res.Lags[0].UncompactedRecords)
+	suite.Equal(int64(0), res.Lags[0].OldestUncompactedTs)
+}
+
 func TestLogServerTestSuite(t *testing.T) {
 	testSuite := new(LogServerTestSuite)
 	testSuite.t = t
@@ -2,21 +2,74 @@ package server
 
 import (
 	"context"
+	"errors"
 
 	log "github.com/chroma-core/chroma/go/database/log/db"
 	"github.com/chroma-core/chroma/go/pkg/log/repository"
 	"github.com/chroma-core/chroma/go/pkg/proto/coordinatorpb"
 	"github.com/chroma-core/chroma/go/pkg/proto/logservicepb"
 	"github.com/chroma-core/chroma/go/pkg/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 )
 
 type logServer struct {
 	logservicepb.UnimplementedLogServiceServer
 	lr *repository.LogRepository
+	// maxCollectionsToCompactPerTenant applies to the requests that do not set
+	// their own limit, zero is unlimited.
+	maxCollectionsToCompactPerTenant uint64
 }
 
 func (s *logServer) PushLogs(ctx context.Context, req *logservicepb.PushLogsRequest) (res *logservicepb.PushLogsResponse, err error) {
+	var push repository.Push
+	push, err = pushFromRequest(req)
+	if err != nil {
+		return
+	}
+	var recordCount int64
+	var duplicate bool
+	recordCount, duplicate, err = s.lr.InsertRecords(ctx, push.CollectionId, push.TenantId, push.Producer, push.Records)
+	if err != nil {
+		return
+	}
+	res = &logservicepb.PushLogsResponse{
+		RecordCount: int32(recordCount),
+		Duplicate:   duplicate,
+	}
+	return
+}
+
+func (s *logServer) PushLogsAtomic(ctx context.Context, req *logservicepb.PushLogsAtomicRequest) (res *logservicepb.PushLogsAtomicResponse, err error) {
+	pushes := make([]repository.Push, len(req.Pushes))
+	for i, pushReq := range req.Pushes {
+		pushes[i], err = pushFromRequest(pushReq)
+		if err != nil {
+			return
+		}
+	}
+	var results []repository.PushResult
+	results, err = s.lr.InsertRecordsAtomically(ctx, pushes)
+	if err != nil {
+		if errors.Is(err, repository.ErrDuplicateCollectionPush) {
+			err = status.Error(codes.InvalidArgument, err.Error())
+		}
+		return
+	}
+	res = &logservicepb.PushLogsAtomicResponse{
+		Results: make([]*logservicepb.PushLogsResponse, len(results)),
+	}
+	for i, result := range results {
+		res.Results[i] = &logservicepb.PushLogsResponse{
+			RecordCount: int32(result.InsertCount),
+			Duplicate:   result.Duplicate,
+		}
+	}
+	return
+}
+
+func pushFromRequest(req *logservicepb.PushLogsRequest) (push repository.Push, err error) {
 	var collectionID types.UniqueID
 	collectionID, err = types.ToUniqueID(&req.CollectionId)
 	if err != nil {
@@ -33,13 +86,19 @@ func (s *logServer) PushLogs(ctx context.Context, req *logservicepb.PushLogsRequ
 		}
 		recordsContent = append(recordsContent, data)
 	}
-	var recordCount int64
-	recordCount, err = s.lr.InsertRecords(ctx, collectionID.String(), recordsContent)
-	if err != nil {
-		return
+	var producer *repository.Producer
+	if req.ProducerId != nil || req.Sequence != nil {
+		if req.ProducerId == nil || req.Sequence == nil || *req.ProducerId == "" {
+			err = status.Error(codes.InvalidArgument, "producer_id and sequence must be set together")
+			return
+		}
+		producer = &repository.Producer{ID: *req.ProducerId, Sequence: *req.Sequence}
 	}
-	res = &logservicepb.PushLogsResponse{
-		RecordCount: int32(recordCount),
+	push = repository.Push{
+		CollectionId: collectionID.String(),
+		TenantId:     req.Tenant,
+		Producer:     producer,
+		Records:      recordsContent,
 	}
 	return
 }
@@ -74,7 +133,11 @@ func (s *logServer) PullLogs(ctx context.Context, req *logservicepb.PullLogsRequ
 
 func (s *logServer) GetAllCollectionInfoToCompact(ctx context.Context, req *logservicepb.GetAllCollectionInfoToCompactRequest) (res *logservicepb.GetAllCollectionInfoToCompactResponse, err error) {
 	var collectionToCompact []log.GetAllCollectionsToCompactRow
-	collectionToCompact, err = s.lr.GetAllCollectionInfoToCompact(ctx, req.MinCompactionSize)
+	maxPerTenant := req.MaxCollectionsPerTenant
+	if maxPerTenant == 0 {
+		maxPerTenant = s.maxCollectionsToCompactPerTenant
+	}
+	collectionToCompact, err = s.lr.GetAllCollectionInfoToCompact(ctx, req.MinCompactionSize, maxPerTenant)
 	if err != nil {
 		return
 	}
@@ -105,8 +168,52 @@ func (s *logServer) UpdateCollectionLogOffset(ctx context.Context, req *logservi
 	return
 }
 
-func NewLogServer(lr *repository.LogRepository) logservicepb.LogServiceServer {
+func (s *logServer) SetRetentionPolicy(ctx context.Context, req *logservicepb.SetRetentionPolicyRequest) (res *logservicepb.SetRetentionPolicyResponse, err error) {
+	if req.Tenant == "" {
+		err = status.Error(codes.InvalidArgument, "tenant is required")
+		return
+	}
+	err = s.lr.SetRetentionPolicy(ctx, req.Tenant, req.MaxAgeSeconds, req.MaxBytes)
+	if err != nil {
+		return
+	}
+	res = &logservicepb.SetRetentionPolicyResponse{}
+	return
+}
+
+func (s *logServer) GetCollectionLogLag(ctx context.Context, req *logservicepb.GetCollectionLogLagRequest) (res *logservicepb.GetCollectionLogLagResponse, err error) {
+	collectionIds := make([]string, len(req.CollectionIds))
+	for i := range req.CollectionIds {
+		var collectionID types.UniqueID
+		collectionID, err = types.ToUniqueID(&req.CollectionIds[i])
+		if err != nil {
+			err = status.Error(codes.InvalidArgument, err.Error())
+			return
+		}
+		collectionIds[i] = collectionID.String()
+	}
+	var lags []log.GetCollectionsLogLagRow
+	lags, err = s.lr.GetCollectionsLogLag(ctx, collectionIds)
+	if err != nil {
+		return
+	}
+	res = &logservicepb.GetCollectionLogLagResponse{
+		Lags: make([]*logservicepb.CollectionLogLag, len(lags)),
+	}
+	for index := range lags {
+		res.Lags[index] = &logservicepb.CollectionLogLag{
+			CollectionId:        lags[index].CollectionID,
+			UncompactedRecords:  lags[index].UncompactedRecords,
+			OldestUncompactedTs: lags[index].OldestUncompactedTs,
+			MaxOffset:           lags[index].MaxOffset,
+		}
+	}
+	return
+}
+
+func NewLogServer(lr *repository.LogRepository, maxCollectionsToCompactPerTenant uint64) logservicepb.LogServiceServer {
 	return &logServer{
-		lr: lr,
+		lr:                               lr,
+		maxCollectionsToCompactPerTenant: maxCollectionsToCompactPerTenant,
 	}
 }
@@ -3,6 +3,7 @@ package memberlist_manager
 import (
 	"context"
 	"errors"
+	"slices"
 	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/common"
@@ -29,8 +30,13 @@ type MemberlistManager struct {
 	memberlistStore   IMemberlistStore                // memberlist store for the coordinator
 	reconcileInterval time.Duration                   // interval for reconciliation
 	reconcileCount    uint                            // number of updates to reconcile at once
+	decommissioned    DecommissionedMembers
 }
 
+// DecommissionedMembers returns, out of the ready members, the ones to mark
+// draining and the ones to leave out of the memberlist.
+type DecommissionedMembers func(ctx context.Context, ready []string) (draining []string, removed []string, err error)
+
 func NewMemberlistManager(nodeWatcher IWatcher, memberlistStore IMemberlistStore) *MemberlistManager {
 	queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
 
@@ -66,6 +72,24 @@ func (m *MemberlistManager) reconcileMemberlist(updates map[string]bool) {
 		log.Error("Error while getting ready members", zap.Error(err))
 		return
 	}
+	if m.decommissioned != nil {
+		ready := make([]string, 0, len(newMemberlist))
+		for _, member := range newMemberlist {
+			ready = append(ready, member.id)
+		}
+		draining, removed, err := m.decommissioned(context.Background(), ready)
+		if err != nil {
+			// reconciling without them could add a removed node back
+			log.Error("Error while getting decommissioned members", zap.Error(err))
+			return
+		}
+		newMemberlist = slices.DeleteFunc(newMemberlist, func(member Member) bool {
+			return slices.Contains(removed, member.id)
+		})
+		for i := range newMemberlist {
+			newMemberlist[i].draining = slices.Contains(draining, newMemberlist[i].id)
+		}
+	}
 	// do not update memberlist if there's no change
 	if !memberlistSame(memberlist, newMemberlist) {
 		err = m.updateMemberlist(newMemberlist, *resourceVersion)
@@ -130,12 +154,12 @@ func memberlistSame(oldMemberlist Memberlist, newMemberlist Memberlist) bool {
 		return false
 	}
 	// use a map to check if the new memberlist contains all the old members
-	newMemberlistMap := make(map[string]bool)
+	newMemberlistMap := make(map[string]Member)
 	for _, member := range newMemberlist {
-		newMemberlistMap[member.id] = true
+		newMemberlistMap[member.id] = member
 	}
 	for _, member := range oldMemberlist {
-		if _, ok := newMemberlistMap[member.id]; !ok {
+		if newMember, ok := newMemberlistMap[member.id]; !ok || newMember.draining != member.draining {
 			return false
 		}
 	}
@@ -165,6 +189,13 @@ func (m *MemberlistManager) SetReconcileCount(count uint) {
 	m.reconcileCount = count
 }
 
+// SetDecommissionedMembers marks the members that decommissioned returns as
+// draining, and leaves the removed ones out of the memberlist even while their
+// pods are ready.
+func (m *MemberlistManager) SetDecommissionedMembers(decommissioned DecommissionedMembers) {
+	m.decommissioned = decommissioned
+}
+
 func (m *MemberlistManager) Stop() error {
 	m.workqueue.ShutDown()
 	return nil
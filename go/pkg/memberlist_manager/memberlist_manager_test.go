@@ -117,6 +117,17 @@ func TestMemberlistStore(t *testing.T) {
 	if !memberlistSame(*memberlist, Memberlist{Member{id: "test-pod-0"}, Member{id: "test-pod-1"}}) {
 		t.Fatalf("Memberlist did not update after adding a member")
 	}
+
+	// Draining members are kept, and not listed for routing
+	memberlist_store.UpdateMemberlist(context.Background(), &Memberlist{Member{id: "test-pod-0", draining: true}, Member{id: "test-pod-1"}}, "0")
+	memberlist, _, err = memberlist_store.GetMemberlist(context.Background())
+	if err != nil {
+		t.Fatalf("Error getting memberlist: %v", err)
+	}
+	assert.Equal(t, Memberlist{Member{id: "test-pod-0", draining: true}, Member{id: "test-pod-1"}}, *memberlist)
+	ids, err := memberlist_store.ListMemberIDs(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"test-pod-1"}, ids)
 }
 
 func createFakePod(memberId string, podIp string, clientset kubernetes.Interface) {
@@ -236,6 +247,10 @@ func TestMemberlistSame(t *testing.T) {
 	newMemberlist = Memberlist{Member{id: "test-pod-1"}, Member{id: "test-pod-0"}}
 	assert.True(t, memberlistSame(memberlist, newMemberlist))
 	assert.True(t, memberlistSame(newMemberlist, memberlist))
+
+	newMemberlist = Memberlist{Member{id: "test-pod-1"}, Member{id: "test-pod-0", draining: true}}
+	assert.False(t, memberlistSame(memberlist, newMemberlist))
+	assert.False(t, memberlistSame(newMemberlist, memberlist))
 }
 
 func retryUntilCondition(f func() bool, retry_count int, retry_interval time.Duration) bool {
@@ -19,6 +19,9 @@ type IMemberlistStore interface {
 
 type Member struct {
 	id string
+	// draining members stay in the memberlist while clients move off them, and
+	// are not routed new requests.
+	draining bool
 }
 
 type Memberlist []Member
@@ -60,14 +63,32 @@ func (s *CRMemberlistStore) GetMemberlist(ctx context.Context) (return_memberlis
 		if !ok {
 			return nil, "", errors.New("failed to cast member_id to string")
 		}
+		draining, _ := member_map["draining"].(bool)
 		member := Member{
-			id: member_id,
+			id:       member_id,
+			draining: draining,
 		}
 		memberlist = append(memberlist, member)
 	}
 	return &memberlist, unstrucuted.GetResourceVersion(), nil
 }
 
+// ListMemberIDs returns the ids of the members of the memberlist that are not
+// draining, the ones clients route requests to.
+func (s *CRMemberlistStore) ListMemberIDs(ctx context.Context) ([]string, error) {
+	memberlist, _, err := s.GetMemberlist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(*memberlist))
+	for _, member := range *memberlist {
+		if !member.draining {
+			ids = append(ids, member.id)
+		}
+	}
+	return ids, nil
+}
+
 func (s *CRMemberlistStore) UpdateMemberlist(ctx context.Context, memberlist *Memberlist, resourceVersion string) error {
 	gvr := getGvr()
 	log.Info("Updating memberlist store", zap.Any("memberlist", memberlist))
@@ -87,9 +108,13 @@ func getGvr() schema.GroupVersionResource {
 func memberlistToCr(memberlist *Memberlist, namespace string, memberlistName string, resourceVersion string) *unstructured.Unstructured {
 	members := []interface{}{}
 	for _, member := range *memberlist {
-		members = append(members, map[string]interface{}{
+		entry := map[string]interface{}{
 			"member_id": member.id,
-		})
+		}
+		if member.draining {
+			entry["draining"] = true
+		}
+		members = append(members, entry)
 	}
 
 	resource := &unstructured.Unstructured{
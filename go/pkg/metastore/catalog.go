@@ -2,6 +2,7 @@ package metastore
 
 import (
 	"context"
+	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
 
@@ -15,20 +16,96 @@ import (
 type Catalog interface {
 	ResetState(ctx context.Context) error
 	CreateCollection(ctx context.Context, createCollection *model.CreateCollection, ts types.Timestamp) (*model.Collection, error)
-	GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32) ([]*model.Collection, error)
+	GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32, metadataFilters []*model.CollectionMetadataFilter, fields *model.CollectionFields) ([]*model.Collection, error)
+	GetCollectionsByIDs(ctx context.Context, collectionIDs []types.UniqueID, fields *model.CollectionFields) ([]*model.Collection, error)
 	DeleteCollection(ctx context.Context, deleteCollection *model.DeleteCollection) error
-	UpdateCollection(ctx context.Context, updateCollection *model.UpdateCollection, ts types.Timestamp) (*model.Collection, error)
+	UpdateCollection(ctx context.Context, updateCollection *model.UpdateCollection, ts types.Timestamp) (*model.Collection, []string, error)
+	GetCollectionMetadataDiff(ctx context.Context, collectionID types.UniqueID, revision int64) (*model.CollectionMetadataDiff, error)
+	GetIncompleteCollections(ctx context.Context, createdBefore time.Time) ([]*model.IncompleteCollection, error)
+	RepairIncompleteCollection(ctx context.Context, collectionID types.UniqueID, actor string) error
+	RenameCollection(ctx context.Context, rename *model.RenameCollection) (*model.Collection, error)
+	GetInconsistentCollections(ctx context.Context, deletedBefore time.Time, kinds []model.InconsistencyKind) ([]*model.InconsistentCollection, error)
+	CompleteCollectionReindex(ctx context.Context, collectionID types.UniqueID, dimension *int32) error
 	CreateSegment(ctx context.Context, createSegment *model.CreateSegment, ts types.Timestamp) (*model.Segment, error)
-	GetSegments(ctx context.Context, segmentID types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID) ([]*model.Segment, error)
-	DeleteSegment(ctx context.Context, segmentID types.UniqueID) error
+	GetSegments(ctx context.Context, segmentID types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID, limit *int32, offset *int32) ([]*model.Segment, error)
+	DeleteSegment(ctx context.Context, segmentID types.UniqueID, reason string, actor string) error
 	UpdateSegment(ctx context.Context, segmentInfo *model.UpdateSegment, ts types.Timestamp) (*model.Segment, error)
+	GetDuplicateSegmentFiles(ctx context.Context, tenantID string, limit int32) ([]*model.DuplicateSegmentFiles, error)
+	GetCollectionSegmentFiles(ctx context.Context, collectionID types.UniqueID) ([]*model.SegmentFile, error)
+	GetStorageAttribution(ctx context.Context, tenantID string) (*model.StorageAttribution, error)
 	CreateDatabase(ctx context.Context, createDatabase *model.CreateDatabase, ts types.Timestamp) (*model.Database, error)
 	GetDatabases(ctx context.Context, getDatabase *model.GetDatabase, ts types.Timestamp) (*model.Database, error)
 	GetAllDatabases(ctx context.Context, ts types.Timestamp) ([]*model.Database, error)
 	CreateTenant(ctx context.Context, createTenant *model.CreateTenant, ts types.Timestamp) (*model.Tenant, error)
+	BatchCreateTenants(ctx context.Context, tenants []*model.BatchCreateTenant) []*model.TenantBatchResult
 	GetTenants(ctx context.Context, getTenant *model.GetTenant, ts types.Timestamp) (*model.Tenant, error)
+	DeleteTenant(ctx context.Context, deleteTenant *model.DeleteTenant) error
 	GetAllTenants(ctx context.Context, ts types.Timestamp) ([]*model.Tenant, error)
 	SetTenantLastCompactionTime(ctx context.Context, tenantID string, lastCompactionTime int64) error
+	SetTenantsLastCompactionTime(ctx context.Context, lastCompactionTimes map[string]int64) error
 	GetTenantsLastCompactionTime(ctx context.Context, tenantIDs []string) ([]*dbmodel.Tenant, error)
 	FlushCollectionCompaction(ctx context.Context, flushCollectionCompaction *model.FlushCollectionCompaction) (*model.FlushCollectionInfo, error)
+	AcquireCollectionFencingToken(ctx context.Context, collectionID types.UniqueID, owner string) (int64, error)
+	GetCollectionsBySize(ctx context.Context, tenantID string, databaseName string, orderBy model.CollectionSizeOrderBy, limit int32) ([]*model.CollectionSize, error)
+	SetCollectionAclEntry(ctx context.Context, entry *model.CollectionAclEntry) error
+	DeleteCollectionAclEntry(ctx context.Context, collectionID types.UniqueID, principal string) error
+	GetCollectionAcl(ctx context.Context, collectionID types.UniqueID) ([]*model.CollectionAclEntry, error)
+	DeleteCollections(ctx context.Context, tenantID string, databaseName string, collectionIDs []types.UniqueID, actor string) []*model.CollectionBatchResult
+	RestoreCollection(ctx context.Context, collectionID types.UniqueID, name *string) (*model.Collection, error)
+	GetDeletedCollectionIDs(ctx context.Context, deletedBefore time.Time, limit int32) ([]types.UniqueID, error)
+	PurgeDeletedCollection(ctx context.Context, collectionID types.UniqueID, deletedBefore time.Time, actor string) error
+	RecordCleanupFailure(ctx context.Context, collectionID types.UniqueID, task string, cause error, maxAttempts int32) (*model.CleanupDeadLetter, error)
+	ListCleanupDeadLetters(ctx context.Context, task string, deadLetteredOnly bool) ([]*model.CleanupDeadLetter, error)
+	DeleteCleanupDeadLetter(ctx context.Context, collectionID types.UniqueID, task string) error
+	BatchUpdateCollectionMetadata(ctx context.Context, collectionIDs []types.UniqueID, patch *model.CollectionMetadataPatch, validate func(*model.CollectionMetadata[model.CollectionMetadataValueType]) error) []*model.CollectionBatchResult
+	GetCollectionsToGc(ctx context.Context, filter *model.CollectionsToGcFilter, updatedBefore time.Time) ([]*model.CollectionToGc, error)
+	StartCollectionScan(ctx context.Context, ttl time.Duration) (*model.CollectionScanCursor, error)
+	ScanCollections(ctx context.Context, cursor *model.CollectionScanCursor, limit int32) ([]*model.Collection, error)
+	GetApproximateCounts(ctx context.Context, tenantID string, databaseName string) (*model.ApproximateCounts, error)
+	CountCollections(ctx context.Context, tenantID string, databaseName string, includeSoftDeleted bool) (int64, error)
+	SetTenantQuota(ctx context.Context, quota *model.TenantQuota) error
+	GetTenantQuota(ctx context.Context, tenantID string) (*model.TenantQuota, error)
+	SetTenantPlacement(ctx context.Context, placement *model.TenantPlacement) error
+	GetTenantPlacement(ctx context.Context, tenantID string) (*model.TenantPlacement, error)
+	GetTenantActivity(ctx context.Context, tenantID string, from time.Time, to time.Time) ([]*model.TenantActivity, error)
+	CreateNodeDecommission(ctx context.Context, decommission *model.NodeDecommission) (*model.NodeDecommission, error)
+	DeleteNodeDecommission(ctx context.Context, nodeID string) error
+	GetNodeDecommissions(ctx context.Context) ([]*model.NodeDecommission, error)
+	RecordNodeHeartbeat(ctx context.Context, nodeID string, at time.Time, expireBefore time.Time) error
+	GetNodeHeartbeats(ctx context.Context) ([]*model.NodeHeartbeat, error)
+	ListCollectionStats(ctx context.Context, tenantID string, databaseName string, limit int32, offset int32) ([]*model.CollectionStats, error)
+	GetCollectionSizeHistory(ctx context.Context, collectionID types.UniqueID, since time.Time) ([]*model.CollectionSizeSnapshot, error)
+	SetFeatureFlag(ctx context.Context, flag *model.FeatureFlag) error
+	DeleteFeatureFlag(ctx context.Context, name string, tenantID string) error
+	GetFeatureFlags(ctx context.Context) ([]*model.FeatureFlag, error)
+	SetMaintenanceMode(ctx context.Context, mode *model.MaintenanceMode) error
+	GetMaintenanceMode(ctx context.Context) (*model.MaintenanceMode, error)
+	EnqueueJob(ctx context.Context, job *model.Job) error
+	GetJob(ctx context.Context, jobID types.UniqueID) (*model.Job, error)
+	ClaimJob(ctx context.Context, jobTypes []string, workerID string, lockedUntil time.Time) (*model.Job, error)
+	RenewJobLease(ctx context.Context, jobID types.UniqueID, workerID string, lockedUntil time.Time) error
+	FinishJob(ctx context.Context, jobID types.UniqueID, workerID string, outcome *model.JobOutcome) error
+	ListJobs(ctx context.Context, filter *model.JobFilter) ([]*model.Job, error)
+	CancelJob(ctx context.Context, jobID types.UniqueID) (*model.Job, error)
+	GetTenantQuotaAndUsage(ctx context.Context, tenantID string) (*model.TenantQuota, *model.QuotaUsage, error)
+	SetDatabaseQuota(ctx context.Context, quota *model.DatabaseQuota) error
+	GetDatabaseQuota(ctx context.Context, tenantID string, databaseName string) (*model.TenantQuota, *model.DatabaseQuota, error)
+	GetDatabaseQuotaAndUsage(ctx context.Context, tenantID string, databaseName string) (*model.DatabaseQuota, *model.QuotaUsage, error)
+	GetCollectionQuotaAndUsage(ctx context.Context, tenantID string, collectionID types.UniqueID) (*model.CollectionQuota, *model.QuotaUsage, error)
+	SetCollectionLogRoute(ctx context.Context, collectionID types.UniqueID, logAddress string) (*model.CollectionLogRoute, error)
+	GetCollectionLogRoutes(ctx context.Context, collectionIDs []types.UniqueID) ([]*model.CollectionLogRoute, int64, error)
+	GetCollectionLogRouteChanges(ctx context.Context, changedAfter int64) ([]*model.CollectionLogRoute, error)
+	CheckCollections(ctx context.Context, collectionIDs []types.UniqueID) ([]*model.CollectionExistence, error)
+	GetCollectionDatabase(ctx context.Context, collectionID types.UniqueID) (*model.Database, error)
+	RequestCompaction(ctx context.Context, collectionID types.UniqueID, actor string) error
+	GetCompactionRequests(ctx context.Context, limit int32) ([]*model.CompactionRequest, error)
+	CreateEmbeddingFunction(ctx context.Context, embeddingFunction *model.EmbeddingFunction) (*model.EmbeddingFunction, error)
+	GetEmbeddingFunctions(ctx context.Context, id types.UniqueID, tenantID string, name *string) ([]*model.EmbeddingFunction, error)
+	UpdateEmbeddingFunction(ctx context.Context, update *model.UpdateEmbeddingFunction) (*model.EmbeddingFunction, error)
+	DeleteEmbeddingFunction(ctx context.Context, id types.UniqueID) error
+	CreateCollectionTemplate(ctx context.Context, template *model.CollectionTemplate) (*model.CollectionTemplate, error)
+	GetCollectionTemplates(ctx context.Context, id types.UniqueID, tenantID string, name *string) ([]*model.CollectionTemplate, error)
+	DeleteCollectionTemplate(ctx context.Context, id types.UniqueID) error
+	SetCollectionSchema(ctx context.Context, collectionID types.UniqueID, fields []*model.CollectionSchemaField) error
+	GetCollectionSchema(ctx context.Context, collectionID types.UniqueID) ([]*model.CollectionSchemaField, error)
 }
@@ -0,0 +1,59 @@
+package coordinator
+
+import (
+	"context"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+func newLifecycleCounter() metric.Int64Counter {
+	counter, err := otel.Meter("chroma.coordinator").Int64Counter("sysdb.collection.lifecycle",
+		metric.WithDescription("Number of collections created, deleted, restored and purged"))
+	if err != nil {
+		log.Error("failed to create collection lifecycle counter", zap.Error(err))
+	}
+	return counter
+}
+
+// recordLifecycle counts count collections of tenantID in the daily rollup of
+// event. It must run in the transaction of the event so that the rollup only
+// counts committed events.
+func (tc *Catalog) recordLifecycle(txCtx context.Context, tenantID string, event string, count int64) error {
+	if count == 0 {
+		return nil
+	}
+	return tc.metaDomain.CollectionLifecycleDb(txCtx).Increment(tenantID, time.Now(), event, count)
+}
+
+// recordCompactionActivity counts a committed compaction in the daily rollup of
+// its tenant. It runs after the flush transaction rather than in it, since the
+// rollup row of the tenant is shared by all its compactions and would make the
+// concurrent serializable flushes conflict. The activity is informational, so a
+// failure is only logged.
+func (tc *Catalog) recordCompactionActivity(ctx context.Context, tenantID string, compactedRecords int64) {
+	err := tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		err := tc.recordLifecycle(txCtx, tenantID, dbmodel.LifecycleEventCompacted, 1)
+		if err != nil {
+			return err
+		}
+		return tc.recordLifecycle(txCtx, tenantID, dbmodel.LifecycleEventRecordsCompacted, compactedRecords)
+	})
+	if err != nil {
+		log.Warn("failed to record compaction activity", zap.String("tenantID", tenantID), zap.Error(err))
+	}
+}
+
+// emitLifecycle adds committed events to the lifecycle metric. The metric is
+// not broken down by tenant to bound its cardinality; the rollup table is.
+func (tc *Catalog) emitLifecycle(ctx context.Context, event string, count int64) {
+	if tc.lifecycleCounter == nil || count == 0 {
+		return
+	}
+	tc.lifecycleCounter.Add(ctx, count, metric.WithAttributes(attribute.String("event", event)))
+}
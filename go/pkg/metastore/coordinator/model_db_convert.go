@@ -1,6 +1,11 @@
 package coordinator
 
 import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
 	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/chroma-core/chroma/go/pkg/types"
@@ -15,7 +20,7 @@ func convertCollectionToModel(collectionAndMetadataList []*dbmodel.CollectionAnd
 	collections := make([]*model.Collection, 0, len(collectionAndMetadataList))
 	for _, collectionAndMetadata := range collectionAndMetadataList {
 		collection := &model.Collection{
-			ID:           types.MustParse(collectionAndMetadata.Collection.ID),
+			ID:           collectionAndMetadata.Collection.ID.UniqueID(),
 			Name:         *collectionAndMetadata.Collection.Name,
 			Dimension:    collectionAndMetadata.Collection.Dimension,
 			TenantID:     collectionAndMetadata.TenantID,
@@ -23,6 +28,28 @@ func convertCollectionToModel(collectionAndMetadataList []*dbmodel.CollectionAnd
 			Ts:           collectionAndMetadata.Collection.Ts,
 			LogPosition:  collectionAndMetadata.Collection.LogPosition,
 			Version:      collectionAndMetadata.Collection.Version,
+			Revision:     collectionAndMetadata.Collection.Revision,
+			UpdatedAt:    collectionAndMetadata.Collection.UpdatedAt,
+			State:        model.CollectionState(collectionAndMetadata.Collection.State),
+
+			ReindexPending:       collectionAndMetadata.Collection.ReindexPending,
+			ReindexFromDimension: collectionAndMetadata.Collection.ReindexFromDimension,
+			KmsKeyID:             collectionAndMetadata.Collection.KmsKeyID,
+			ReplicationFactor:    collectionAndMetadata.Collection.ReplicationFactor,
+			DistanceFunction:     collectionAndMetadata.Collection.DistanceFunction,
+			LastCompactor:        collectionAndMetadata.Collection.LastCompactor,
+		}
+		if collectionAndMetadata.Collection.EmbeddingFunctionID != nil {
+			embeddingFunctionID := types.MustParse(*collectionAndMetadata.Collection.EmbeddingFunctionID)
+			collection.EmbeddingFunctionID = &embeddingFunctionID
+		}
+		if collectionAndMetadata.Collection.TemplateID != nil {
+			templateID := types.MustParse(*collectionAndMetadata.Collection.TemplateID)
+			collection.TemplateID = &templateID
+		}
+		if collectionAndMetadata.Collection.LastCompactedAt != nil {
+			lastCompactedAt := collectionAndMetadata.Collection.LastCompactedAt.Unix()
+			collection.LastCompactedAt = &lastCompactedAt
 		}
 		collection.Metadata = convertCollectionMetadataToModel(collectionAndMetadata.CollectionMetadata)
 		collections = append(collections, collection)
@@ -31,6 +58,163 @@ func convertCollectionToModel(collectionAndMetadataList []*dbmodel.CollectionAnd
 	return collections
 }
 
+func convertCollectionAclToModel(aclEntries []*dbmodel.CollectionAcl) []*model.CollectionAclEntry {
+	entries := make([]*model.CollectionAclEntry, 0, len(aclEntries))
+	for _, aclEntry := range aclEntries {
+		entries = append(entries, &model.CollectionAclEntry{
+			CollectionID: aclEntry.CollectionID.UniqueID(),
+			Principal:    aclEntry.Principal,
+			Permissions:  model.CollectionPermission(aclEntry.Permissions),
+		})
+	}
+	return entries
+}
+
+// attachCollectionAcl sets the Acl of every collection to its entries.
+func attachCollectionAcl(collections []*model.Collection, aclEntries []*dbmodel.CollectionAcl) {
+	byCollection := make(map[types.UniqueID][]*model.CollectionAclEntry)
+	for _, entry := range convertCollectionAclToModel(aclEntries) {
+		byCollection[entry.CollectionID] = append(byCollection[entry.CollectionID], entry)
+	}
+	for _, collection := range collections {
+		collection.Acl = byCollection[collection.ID]
+	}
+}
+
+func convertCollectionLogRouteToModel(route *dbmodel.CollectionLogRoute) *model.CollectionLogRoute {
+	return &model.CollectionLogRoute{
+		CollectionID: route.CollectionID.UniqueID(),
+		LogAddress:   route.LogAddress,
+		Version:      route.Version,
+		UpdatedAt:    route.UpdatedAt.UnixMilli(),
+		Revision:     route.Revision,
+	}
+}
+
+func convertCompactionRequestsToModel(requests []*dbmodel.CompactionRequest, collections []*dbmodel.CollectionAndMetadata) []*model.CompactionRequest {
+	collectionsByID := make(map[dbmodel.CollectionID]*dbmodel.Collection, len(collections))
+	for _, collection := range collections {
+		collectionsByID[collection.Collection.ID] = collection.Collection
+	}
+	result := make([]*model.CompactionRequest, 0, len(requests))
+	for _, request := range requests {
+		compactionRequest := &model.CompactionRequest{
+			CollectionID: request.CollectionID.UniqueID(),
+			TenantID:     request.TenantID,
+			RequestedBy:  request.RequestedBy,
+			RequestedAt:  request.RequestedAt.Unix(),
+		}
+		if collection, ok := collectionsByID[request.CollectionID]; ok {
+			compactionRequest.LastCompactor = collection.LastCompactor
+			if collection.LastCompactedAt != nil {
+				lastCompactedAt := collection.LastCompactedAt.Unix()
+				compactionRequest.LastCompactedAt = &lastCompactedAt
+			}
+		}
+		result = append(result, compactionRequest)
+	}
+	return result
+}
+
+func convertCollectionSizeToModel(collections []*dbmodel.Collection) []*model.CollectionSize {
+	collectionSizes := make([]*model.CollectionSize, 0, len(collections))
+	for _, collection := range collections {
+		collectionSize := &model.CollectionSize{
+			ID:                         collection.ID.UniqueID(),
+			TotalRecordsPostCompaction: collection.TotalRecordsPostCompaction,
+			SizeBytesPostCompaction:    collection.SizeBytesPostCompaction,
+		}
+		if collection.Name != nil {
+			collectionSize.Name = *collection.Name
+		}
+		collectionSizes = append(collectionSizes, collectionSize)
+	}
+	return collectionSizes
+}
+
+func convertCollectionToGcToModel(collections []*dbmodel.Collection) []*model.CollectionToGc {
+	collectionsToGc := make([]*model.CollectionToGc, 0, len(collections))
+	for _, collection := range collections {
+		collectionToGc := &model.CollectionToGc{
+			ID:        collection.ID.UniqueID(),
+			TenantID:  collection.TenantID,
+			Version:   collection.Version,
+			UpdatedAt: collection.UpdatedAt.Unix(),
+			IsDeleted: collection.IsDeleted,
+		}
+		if collection.Name != nil {
+			collectionToGc.Name = dbmodel.OriginalCollectionName(*collection.Name, collection.ID)
+		}
+		collectionsToGc = append(collectionsToGc, collectionToGc)
+	}
+	return collectionsToGc
+}
+
+func convertIncompleteCollectionToModel(collections []*dbmodel.CollectionAndMetadata) []*model.IncompleteCollection {
+	incompleteCollections := make([]*model.IncompleteCollection, 0, len(collections))
+	for _, collection := range collections {
+		incompleteCollection := &model.IncompleteCollection{
+			ID:           collection.Collection.ID.UniqueID(),
+			TenantID:     collection.TenantID,
+			DatabaseName: collection.DatabaseName,
+			CreatedAt:    collection.Collection.CreatedAt.Unix(),
+		}
+		if collection.Collection.Name != nil {
+			incompleteCollection.Name = *collection.Collection.Name
+		}
+		incompleteCollections = append(incompleteCollections, incompleteCollection)
+	}
+	return incompleteCollections
+}
+
+func convertInconsistentCollectionToModel(collections []*dbmodel.InconsistentCollection) []*model.InconsistentCollection {
+	inconsistentCollections := make([]*model.InconsistentCollection, 0, len(collections))
+	for _, collection := range collections {
+		inconsistentCollection := &model.InconsistentCollection{
+			ID:           collection.Collection.ID.UniqueID(),
+			TenantID:     collection.TenantID,
+			DatabaseName: collection.DatabaseName,
+			Kind:         collection.Kind,
+			SegmentIDs:   make([]types.UniqueID, 0, len(collection.SegmentIDs)),
+		}
+		if collection.Collection.Name != nil {
+			inconsistentCollection.Name = dbmodel.OriginalCollectionName(*collection.Collection.Name, collection.Collection.ID)
+		}
+		for _, segmentID := range collection.SegmentIDs {
+			inconsistentCollection.SegmentIDs = append(inconsistentCollection.SegmentIDs, segmentID.UniqueID())
+		}
+		inconsistentCollection.Description = describeInconsistentCollection(collection)
+		inconsistentCollections = append(inconsistentCollections, inconsistentCollection)
+	}
+	return inconsistentCollections
+}
+
+// describeInconsistentCollection says what is wrong with the collection and
+// what an operator can do about it.
+func describeInconsistentCollection(collection *dbmodel.InconsistentCollection) string {
+	switch collection.Kind {
+	case model.InconsistencySoftDeletedWithSegments:
+		deletedAt := "an unknown time"
+		if collection.Collection.DeletedAt != nil {
+			deletedAt = collection.Collection.DeletedAt.UTC().Format(time.RFC3339)
+		}
+		return fmt.Sprintf("soft deleted at %s but %d segments are left; check that the delete notification was processed, or restore the collection with RestoreCollection", deletedAt, len(collection.SegmentIDs))
+	case model.InconsistencyDeletedSegments:
+		return fmt.Sprintf("live collection with %d soft deleted segments; delete them with DeleteSegment or delete the collection", len(collection.SegmentIDs))
+	case model.InconsistencyMissingSegments:
+		var missing []string
+		for _, required := range requiredSegmentScopes {
+			if !slices.Contains(collection.Scopes, required) {
+				missing = append(missing, required)
+			}
+		}
+		return fmt.Sprintf("ready collection without a %s segment; create the segment with CreateSegment or delete the collection", strings.Join(missing, " or "))
+	case model.InconsistencyFlushedWithoutFiles:
+		return fmt.Sprintf("compacted to version %d but %s segments have no files; request a compaction with RequestCompaction to rewrite them", collection.Collection.Version, strings.Join(collection.Scopes, ", "))
+	}
+	return string(collection.Kind)
+}
+
 func convertCollectionMetadataToModel(collectionMetadataList []*dbmodel.CollectionMetadata) *model.CollectionMetadata[model.CollectionMetadataValueType] {
 	metadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
 	if collectionMetadataList == nil {
@@ -61,7 +245,7 @@ func convertCollectionMetadataToModel(collectionMetadataList []*dbmodel.Collecti
 
 }
 
-func convertCollectionMetadataToDB(collectionID string, metadata *model.CollectionMetadata[model.CollectionMetadataValueType]) []*dbmodel.CollectionMetadata {
+func convertCollectionMetadataToDB(collectionID dbmodel.CollectionID, tenantID string, metadata *model.CollectionMetadata[model.CollectionMetadataValueType]) []*dbmodel.CollectionMetadata {
 	if metadata == nil {
 		log.Debug("collection metadata to db", zap.Any("collectionMetadata", nil))
 		return nil
@@ -71,6 +255,7 @@ func convertCollectionMetadataToDB(collectionID string, metadata *model.Collecti
 		keyCopy := key
 		dbCollectionMetadata := &dbmodel.CollectionMetadata{
 			CollectionID: collectionID,
+			TenantID:     tenantID,
 			Key:          &keyCopy,
 		}
 		switch v := (value).(type) {
@@ -98,13 +283,13 @@ func convertSegmentToModel(segmentAndMetadataList []*dbmodel.SegmentAndMetadata)
 	segments := make([]*model.Segment, 0, len(segmentAndMetadataList))
 	for _, segmentAndMetadata := range segmentAndMetadataList {
 		segment := &model.Segment{
-			ID:    types.MustParse(segmentAndMetadata.Segment.ID),
+			ID:    segmentAndMetadata.Segment.ID.UniqueID(),
 			Type:  segmentAndMetadata.Segment.Type,
 			Scope: segmentAndMetadata.Segment.Scope,
 			Ts:    segmentAndMetadata.Segment.Ts,
 		}
 		if segmentAndMetadata.Segment.CollectionID != nil {
-			segment.CollectionID = types.MustParse(*segmentAndMetadata.Segment.CollectionID)
+			segment.CollectionID = segmentAndMetadata.Segment.CollectionID.UniqueID()
 		} else {
 			segment.CollectionID = types.NilUniqueID()
 		}
@@ -153,7 +338,7 @@ func convertSegmentMetadataToDB(segmentID string, metadata *model.SegmentMetadat
 	for key, value := range metadata.Metadata {
 		keyCopy := key
 		dbSegmentMetadata := &dbmodel.SegmentMetadata{
-			SegmentID: segmentID,
+			SegmentID: dbmodel.SegmentID(segmentID),
 			Key:       &keyCopy,
 		}
 		switch v := (value).(type) {
@@ -176,7 +361,7 @@ func convertSegmentMetadataToDB(segmentID string, metadata *model.SegmentMetadat
 
 func convertDatabaseToModel(dbDatabase *dbmodel.Database) *model.Database {
 	return &model.Database{
-		ID:     dbDatabase.ID,
+		ID:     dbDatabase.ID.String(),
 		Name:   dbDatabase.Name,
 		Tenant: dbDatabase.TenantID,
 	}
@@ -184,6 +369,307 @@ func convertDatabaseToModel(dbDatabase *dbmodel.Database) *model.Database {
 
 func convertTenantToModel(dbTenant *dbmodel.Tenant) *model.Tenant {
 	return &model.Tenant{
-		Name: dbTenant.ID,
+		Name:     dbTenant.ID,
+		KmsKeyID: dbTenant.KmsKeyID,
+	}
+}
+
+func convertTenantQuotaToModel(quota *dbmodel.TenantQuota) *model.TenantQuota {
+	return &model.TenantQuota{
+		TenantID:       quota.TenantID,
+		MaxCollections: quota.MaxCollections,
+		MaxRecords:     quota.MaxRecords,
+		MaxSizeBytes:   quota.MaxSizeBytes,
+	}
+}
+
+func convertTenantQuotaToDB(quota *model.TenantQuota) *dbmodel.TenantQuota {
+	return &dbmodel.TenantQuota{
+		TenantID:       quota.TenantID,
+		MaxCollections: quota.MaxCollections,
+		MaxRecords:     quota.MaxRecords,
+		MaxSizeBytes:   quota.MaxSizeBytes,
+	}
+}
+
+func convertFeatureFlagToModel(flag *dbmodel.FeatureFlag) *model.FeatureFlag {
+	return &model.FeatureFlag{
+		Name:              flag.Name,
+		TenantID:          flag.TenantID,
+		Enabled:           flag.Enabled,
+		RolloutPercentage: flag.RolloutPercentage,
+		Description:       flag.Description,
+	}
+}
+
+func convertFeatureFlagToDB(flag *model.FeatureFlag) *dbmodel.FeatureFlag {
+	return &dbmodel.FeatureFlag{
+		Name:              flag.Name,
+		TenantID:          flag.TenantID,
+		Enabled:           flag.Enabled,
+		RolloutPercentage: flag.RolloutPercentage,
+		Description:       flag.Description,
+	}
+}
+
+func convertTenantRegionsToModel(tenantID string, regions []*dbmodel.TenantRegion) *model.TenantPlacement {
+	placement := &model.TenantPlacement{TenantID: tenantID, AllowedRegions: make([]string, 0, len(regions))}
+	for _, region := range regions {
+		placement.AllowedRegions = append(placement.AllowedRegions, region.Region)
+	}
+	return placement
+}
+
+func convertCollectionLifecycleCountToModel(count *dbmodel.CollectionLifecycleCount) *model.TenantActivity {
+	return &model.TenantActivity{
+		Day:                 count.Day,
+		CollectionsCreated:  count.Created,
+		CollectionsDeleted:  count.Deleted,
+		CollectionsRestored: count.Restored,
+		CollectionsPurged:   count.Purged,
+		Compactions:         count.Compacted,
+		RecordsCompacted:    count.RecordsCompacted,
+	}
+}
+
+func convertNodeDecommissionToModel(decommission *dbmodel.NodeDecommission) *model.NodeDecommission {
+	return &model.NodeDecommission{
+		NodeID:      decommission.NodeID,
+		StartedAt:   decommission.CreatedAt,
+		DrainUntil:  decommission.DrainUntil,
+		Collections: decommission.Collections,
+	}
+}
+
+func convertCollectionStatsToModel(stats *dbmodel.CollectionStats) *model.CollectionStats {
+	result := &model.CollectionStats{
+		CollectionID:    stats.CollectionID.UniqueID(),
+		TenantID:        stats.TenantID,
+		DatabaseName:    stats.DatabaseName,
+		TotalRecords:    stats.TotalRecords,
+		SizeBytes:       stats.SizeBytes,
+		SegmentCount:    stats.SegmentCount,
+		LastCompactedAt: stats.LastCompactedAt,
+		RefreshedAt:     stats.RefreshedAt,
+	}
+	if stats.UncompactedRecords != nil {
+		result.LogLag = &model.CollectionLogLag{UncompactedRecords: *stats.UncompactedRecords}
+		if stats.OldestUncompactedAt != nil {
+			oldest := stats.OldestUncompactedAt.Unix()
+			result.LogLag.OldestUncompactedAt = &oldest
+		}
+	}
+	return result
+}
+
+// convertDatabaseQuotaToModel returns a quota without limits when quota is nil.
+func convertDatabaseQuotaToModel(tenantID string, databaseName string, quota *dbmodel.DatabaseQuota) *model.DatabaseQuota {
+	result := &model.DatabaseQuota{TenantID: tenantID, DatabaseName: databaseName}
+	if quota != nil {
+		result.MaxCollections = quota.MaxCollections
+		result.MaxRecords = quota.MaxRecords
+		result.MaxSizeBytes = quota.MaxSizeBytes
+	}
+	return result
+}
+
+func convertDatabaseQuotaToDB(databaseID dbmodel.DatabaseID, quota *model.DatabaseQuota) *dbmodel.DatabaseQuota {
+	return &dbmodel.DatabaseQuota{
+		DatabaseID:     databaseID,
+		MaxCollections: quota.MaxCollections,
+		MaxRecords:     quota.MaxRecords,
+		MaxSizeBytes:   quota.MaxSizeBytes,
+	}
+}
+
+// convertFlushSegmentCompactionToSegmentFiles returns the files registered by a
+// flush for one of its segments, ordered by file type.
+func convertFlushSegmentCompactionToSegmentFiles(flush *model.FlushCollectionCompaction, segment *model.FlushSegmentCompaction) []*dbmodel.SegmentFile {
+	fileTypes := make([]string, 0, len(segment.FilePaths))
+	for fileType := range segment.FilePaths {
+		fileTypes = append(fileTypes, fileType)
+	}
+	slices.Sort(fileTypes)
+	var files []*dbmodel.SegmentFile
+	for _, fileType := range fileTypes {
+		hashes := segment.ContentHashes[fileType]
+		sizes := segment.SizeBytes[fileType]
+		for i, path := range segment.FilePaths[fileType] {
+			file := &dbmodel.SegmentFile{
+				Path:         path,
+				CollectionID: dbmodel.NewCollectionID(flush.ID),
+				TenantID:     flush.TenantID,
+				FileType:     fileType,
+			}
+			if i < len(hashes) && hashes[i] != "" {
+				file.ContentHash = &hashes[i]
+			}
+			if i < len(sizes) {
+				file.SizeBytes = sizes[i]
+			}
+			files = append(files, file)
+		}
+	}
+	return files
+}
+
+func convertSegmentFileToModel(file *dbmodel.SegmentFile) *model.SegmentFile {
+	segmentFile := &model.SegmentFile{
+		SegmentID:    file.SegmentID.UniqueID(),
+		CollectionID: file.CollectionID.UniqueID(),
+		FileType:     file.FileType,
+		Path:         file.Path,
+		SizeBytes:    file.SizeBytes,
+	}
+	if file.ContentHash != nil {
+		segmentFile.ContentHash = *file.ContentHash
+	}
+	return segmentFile
+}
+
+func convertSegmentFilesToModel(files []*dbmodel.SegmentFileAndReferences) []*model.SegmentFile {
+	segmentFiles := make([]*model.SegmentFile, 0, len(files))
+	for _, file := range files {
+		segmentFile := convertSegmentFileToModel(file.SegmentFile)
+		segmentFile.OtherCollections = file.OtherCollections
+		segmentFiles = append(segmentFiles, segmentFile)
+	}
+	return segmentFiles
+}
+
+// convertDuplicateSegmentFilesToModel groups files ordered by content hash by
+// their hash.
+func convertDuplicateSegmentFilesToModel(files []*dbmodel.SegmentFile) []*model.DuplicateSegmentFiles {
+	var duplicates []*model.DuplicateSegmentFiles
+	for _, file := range files {
+		if file.ContentHash == nil {
+			continue
+		}
+		if len(duplicates) == 0 || duplicates[len(duplicates)-1].ContentHash != *file.ContentHash {
+			duplicates = append(duplicates, &model.DuplicateSegmentFiles{ContentHash: *file.ContentHash})
+		}
+		last := duplicates[len(duplicates)-1]
+		last.Files = append(last.Files, convertSegmentFileToModel(file))
+	}
+	return duplicates
+}
+
+func convertStorageAttributionToModel(tenantID string, collections []*dbmodel.CollectionStorage, distinctBytes int64) *model.StorageAttribution {
+	attribution := &model.StorageAttribution{
+		TenantID:      tenantID,
+		DistinctBytes: distinctBytes,
+		Collections:   make([]*model.CollectionStorageAttribution, 0, len(collections)),
+	}
+	for _, collection := range collections {
+		attribution.UniqueBytes += collection.UniqueBytes
+		attribution.SharedBytes += collection.SharedBytes
+		attribution.Collections = append(attribution.Collections, &model.CollectionStorageAttribution{
+			CollectionID: collection.CollectionID.UniqueID(),
+			UniqueBytes:  collection.UniqueBytes,
+			SharedBytes:  collection.SharedBytes,
+		})
+	}
+	return attribution
+}
+
+func convertCleanupDeadLetterToModel(entry *dbmodel.CleanupDeadLetter) *model.CleanupDeadLetter {
+	return &model.CleanupDeadLetter{
+		CollectionID:  entry.CollectionID.UniqueID(),
+		Task:          entry.Task,
+		TenantID:      entry.TenantID,
+		Attempts:      entry.Attempts,
+		LastError:     entry.LastError,
+		FirstFailedAt: entry.FirstFailedAt.UTC(),
+		LastFailedAt:  entry.LastFailedAt.UTC(),
+		DeadLettered:  entry.DeadLettered,
+	}
+}
+
+func convertEmbeddingFunctionToModel(embeddingFunction *dbmodel.EmbeddingFunction) *model.EmbeddingFunction {
+	return &model.EmbeddingFunction{
+		ID:        types.MustParse(embeddingFunction.ID),
+		TenantID:  embeddingFunction.TenantID,
+		Name:      embeddingFunction.Name,
+		Provider:  embeddingFunction.Provider,
+		Model:     embeddingFunction.Model,
+		Params:    embeddingFunction.Params,
+		CreatedAt: embeddingFunction.CreatedAt.UTC(),
+		UpdatedAt: embeddingFunction.UpdatedAt.UTC(),
+	}
+}
+
+func convertCollectionSchemaFieldsToDB(fields []*model.CollectionSchemaField) []*dbmodel.CollectionSchemaField {
+	dbFields := make([]*dbmodel.CollectionSchemaField, 0, len(fields))
+	for _, field := range fields {
+		dbFields = append(dbFields, &dbmodel.CollectionSchemaField{
+			Name:    field.Name,
+			Type:    string(field.Type),
+			Indexed: field.Indexed,
+		})
+	}
+	return dbFields
+}
+
+func convertCollectionSchemaFieldsToModel(dbFields []*dbmodel.CollectionSchemaField) []*model.CollectionSchemaField {
+	fields := make([]*model.CollectionSchemaField, 0, len(dbFields))
+	for _, dbField := range dbFields {
+		fields = append(fields, &model.CollectionSchemaField{
+			Name:    dbField.Name,
+			Type:    model.CollectionSchemaFieldType(dbField.Type),
+			Indexed: dbField.Indexed,
+		})
+	}
+	return fields
+}
+
+func convertCollectionTemplateToDB(template *model.CollectionTemplate) *dbmodel.CollectionTemplate {
+	metadata := convertCollectionMetadataToDB("", template.TenantID, template.Metadata)
+	dbMetadata := make([]*dbmodel.CollectionTemplateMetadata, 0, len(metadata))
+	for _, m := range metadata {
+		dbMetadata = append(dbMetadata, &dbmodel.CollectionTemplateMetadata{
+			Key:        *m.Key,
+			StrValue:   m.StrValue,
+			IntValue:   m.IntValue,
+			FloatValue: m.FloatValue,
+			BoolValue:  m.BoolValue,
+		})
+	}
+	slices.SortFunc(dbMetadata, func(a, b *dbmodel.CollectionTemplateMetadata) int { return strings.Compare(a.Key, b.Key) })
+	return &dbmodel.CollectionTemplate{
+		ID:                template.ID.String(),
+		TenantID:          template.TenantID,
+		Name:              template.Name,
+		Dimension:         template.Dimension,
+		DistanceFunction:  template.DistanceFunction,
+		ReplicationFactor: template.ReplicationFactor,
+		Metadata:          dbMetadata,
+		MaxRecords:        template.MaxRecords,
+		MaxSizeBytes:      template.MaxSizeBytes,
+	}
+}
+
+func convertCollectionTemplateToModel(template *dbmodel.CollectionTemplate) *model.CollectionTemplate {
+	metadata := make([]*dbmodel.CollectionMetadata, 0, len(template.Metadata))
+	for _, m := range template.Metadata {
+		key := m.Key
+		metadata = append(metadata, &dbmodel.CollectionMetadata{
+			Key:        &key,
+			StrValue:   m.StrValue,
+			IntValue:   m.IntValue,
+			FloatValue: m.FloatValue,
+			BoolValue:  m.BoolValue,
+		})
+	}
+	return &model.CollectionTemplate{
+		ID:                types.MustParse(template.ID),
+		TenantID:          template.TenantID,
+		Name:              template.Name,
+		Dimension:         template.Dimension,
+		DistanceFunction:  template.DistanceFunction,
+		ReplicationFactor: template.ReplicationFactor,
+		Metadata:          convertCollectionMetadataToModel(metadata),
+		MaxRecords:        template.MaxRecords,
+		MaxSizeBytes:      template.MaxSizeBytes,
+		CreatedAt:         template.CreatedAt.UTC(),
 	}
 }
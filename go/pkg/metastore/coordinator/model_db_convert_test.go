@@ -3,6 +3,7 @@ package coordinator
 import (
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
 	"github.com/chroma-core/chroma/go/pkg/model"
@@ -23,14 +24,14 @@ func TestConvertCollectionMetadataToModel(t *testing.T) {
 
 func TestConvertCollectionMetadataToDB(t *testing.T) {
 	// Test case 1: metadata is nil
-	dbCollectionMetadataList := convertCollectionMetadataToDB("collectionID", nil)
+	dbCollectionMetadataList := convertCollectionMetadataToDB("collectionID", "tenantID", nil)
 	assert.Nil(t, dbCollectionMetadataList)
 
 	// Test case 2: metadata is not nil but empty
 	metadata := &model.CollectionMetadata[model.CollectionMetadataValueType]{
 		Metadata: map[string]model.CollectionMetadataValueType{},
 	}
-	dbCollectionMetadataList = convertCollectionMetadataToDB("collectionID", metadata)
+	dbCollectionMetadataList = convertCollectionMetadataToDB("collectionID", "tenantID", metadata)
 	assert.NotNil(t, dbCollectionMetadataList)
 	assert.Len(t, dbCollectionMetadataList, 0)
 
@@ -42,23 +43,23 @@ func TestConvertCollectionMetadataToDB(t *testing.T) {
 			"key3": &model.CollectionMetadataValueFloat64Type{Value: 3.14},
 		},
 	}
-	dbCollectionMetadataList = convertCollectionMetadataToDB("collectionID", metadata)
+	dbCollectionMetadataList = convertCollectionMetadataToDB("collectionID", "tenantID", metadata)
 	sort.Slice(dbCollectionMetadataList, func(i, j int) bool {
 		return *dbCollectionMetadataList[i].Key < *dbCollectionMetadataList[j].Key
 	})
 	assert.NotNil(t, dbCollectionMetadataList)
 	assert.Len(t, dbCollectionMetadataList, 3)
-	assert.Equal(t, "collectionID", dbCollectionMetadataList[0].CollectionID)
+	assert.Equal(t, dbmodel.CollectionID("collectionID"), dbCollectionMetadataList[0].CollectionID)
 	assert.Equal(t, "key1", *dbCollectionMetadataList[0].Key)
 	assert.Equal(t, "value1", *dbCollectionMetadataList[0].StrValue)
 	assert.Nil(t, dbCollectionMetadataList[0].IntValue)
 	assert.Nil(t, dbCollectionMetadataList[0].FloatValue)
-	assert.Equal(t, "collectionID", dbCollectionMetadataList[1].CollectionID)
+	assert.Equal(t, dbmodel.CollectionID("collectionID"), dbCollectionMetadataList[1].CollectionID)
 	assert.Equal(t, "key2", *dbCollectionMetadataList[1].Key)
 	assert.Nil(t, dbCollectionMetadataList[1].StrValue)
 	assert.Equal(t, int64(123), *dbCollectionMetadataList[1].IntValue)
 	assert.Nil(t, dbCollectionMetadataList[1].FloatValue)
-	assert.Equal(t, "collectionID", dbCollectionMetadataList[2].CollectionID)
+	assert.Equal(t, dbmodel.CollectionID("collectionID"), dbCollectionMetadataList[2].CollectionID)
 	assert.Equal(t, "key3", *dbCollectionMetadataList[2].Key)
 	assert.Nil(t, dbCollectionMetadataList[2].StrValue)
 	assert.Nil(t, dbCollectionMetadataList[2].IntValue)
@@ -76,10 +77,10 @@ func TestConvertSegmentToModel(t *testing.T) {
 
 	// Test case 3: segmentAndMetadataList contains one segment with all fields set
 	segmentID := types.MustParse("515fc331-e117-4b86-bd84-85341128c337")
-	collectionID := "d9a75e2e-2929-45c4-af06-75b15630edd0"
+	collectionID := dbmodel.CollectionID("d9a75e2e-2929-45c4-af06-75b15630edd0")
 	segmentAndMetadata := &dbmodel.SegmentAndMetadata{
 		Segment: &dbmodel.Segment{
-			ID:           segmentID.String(),
+			ID:           dbmodel.NewSegmentID(segmentID),
 			Type:         "segment_type",
 			Scope:        "segment_scope",
 			CollectionID: &collectionID,
@@ -92,7 +93,7 @@ func TestConvertSegmentToModel(t *testing.T) {
 	assert.Equal(t, segmentID, modelSegments[0].ID)
 	assert.Equal(t, "segment_type", modelSegments[0].Type)
 	assert.Equal(t, "segment_scope", modelSegments[0].Scope)
-	assert.Equal(t, types.MustParse(collectionID), modelSegments[0].CollectionID)
+	assert.Equal(t, collectionID.UniqueID(), modelSegments[0].CollectionID)
 	assert.Nil(t, modelSegments[0].Metadata)
 }
 
@@ -111,7 +112,7 @@ func TestConvertSegmentMetadataToModel(t *testing.T) {
 	strKey := "strKey"
 	strValue := "strValue"
 	segmentMetadata := &dbmodel.SegmentMetadata{
-		SegmentID: segmentID.String(),
+		SegmentID: dbmodel.NewSegmentID(segmentID),
 		Key:       &strKey,
 		StrValue:  &strValue,
 	}
@@ -136,7 +137,7 @@ func TestConvertCollectionToModel(t *testing.T) {
 	collectionDimension := int32(3)
 	collectionAndMetadata := &dbmodel.CollectionAndMetadata{
 		Collection: &dbmodel.Collection{
-			ID:        collectionID.String(),
+			ID:        dbmodel.NewCollectionID(collectionID),
 			Name:      &collectionName,
 			Dimension: &collectionDimension,
 		},
@@ -150,3 +151,107 @@ func TestConvertCollectionToModel(t *testing.T) {
 	assert.Equal(t, collectionDimension, *modelCollections[0].Dimension)
 	assert.Nil(t, modelCollections[0].Metadata)
 }
+
+func TestConvertIncompleteCollectionToModel(t *testing.T) {
+	collectionID := types.MustParse("d9a75e2e-2929-45c4-af06-75b15630edd0")
+	collectionName := "collection_name"
+
+	// the same instant in UTC and in a zone ahead of UTC converts to the same value
+	createdAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	zone := time.FixedZone("UTC+9", 9*60*60)
+	for _, ts := range []time.Time{createdAt, createdAt.In(zone)} {
+		incompleteCollections := convertIncompleteCollectionToModel([]*dbmodel.CollectionAndMetadata{
+			{
+				Collection: &dbmodel.Collection{
+					ID:        dbmodel.NewCollectionID(collectionID),
+					Name:      &collectionName,
+					CreatedAt: ts,
+				},
+				TenantID:     "tenant",
+				DatabaseName: "database",
+			},
+		})
+		assert.Len(t, incompleteCollections, 1)
+		assert.Equal(t, collectionID, incompleteCollections[0].ID)
+		assert.Equal(t, collectionName, incompleteCollections[0].Name)
+		assert.Equal(t, createdAt.Unix(), incompleteCollections[0].CreatedAt)
+	}
+}
+
+func TestConvertFlushSegmentCompactionToSegmentFiles(t *testing.T) {
+	collectionID := types.MustParse("d9a75e2e-2929-45c4-af06-75b15630edd0")
+	flush := &model.FlushCollectionCompaction{ID: collectionID, TenantID: "tenant"}
+	files := convertFlushSegmentCompactionToSegmentFiles(flush, &model.FlushSegmentCompaction{
+		FilePaths: map[string][]string{
+			"hnsw":  {"hnsw/a", "hnsw/b"},
+			"blobs": {"blobs/a"},
+		},
+		ContentHashes: map[string][]string{
+			"hnsw": {"hash_a", ""},
+		},
+		SizeBytes: map[string][]int64{
+			"blobs": {10},
+		},
+	})
+	assert.Len(t, files, 3)
+	assert.Equal(t, "blobs/a", files[0].Path)
+	assert.Nil(t, files[0].ContentHash)
+	assert.Equal(t, int64(10), files[0].SizeBytes)
+	assert.Zero(t, files[1].SizeBytes)
+	assert.Equal(t, "hnsw/a", files[1].Path)
+	assert.Equal(t, "hash_a", *files[1].ContentHash)
+	assert.Nil(t, files[2].ContentHash)
+	for _, file := range files {
+		assert.Equal(t, dbmodel.NewCollectionID(collectionID), file.CollectionID)
+		assert.Equal(t, "tenant", file.TenantID)
+	}
+}
+
+func TestConvertDuplicateSegmentFilesToModel(t *testing.T) {
+	hashA, hashB := "hash_a", "hash_b"
+	segmentA, segmentB := types.NewUniqueID(), types.NewUniqueID()
+	collectionID := dbmodel.NewCollectionID(types.NewUniqueID())
+	duplicates := convertDuplicateSegmentFilesToModel([]*dbmodel.SegmentFile{
+		{SegmentID: dbmodel.NewSegmentID(segmentA), CollectionID: collectionID, Path: "a/1", ContentHash: &hashA},
+		{SegmentID: dbmodel.NewSegmentID(segmentB), CollectionID: collectionID, Path: "b/1", ContentHash: &hashA},
+		{SegmentID: dbmodel.NewSegmentID(segmentA), CollectionID: collectionID, Path: "a/2", ContentHash: &hashB},
+		{SegmentID: dbmodel.NewSegmentID(segmentB), CollectionID: collectionID, Path: "b/2", ContentHash: &hashB},
+	})
+	assert.Len(t, duplicates, 2)
+	assert.Equal(t, hashA, duplicates[0].ContentHash)
+	assert.Len(t, duplicates[0].Files, 2)
+	assert.Equal(t, segmentB, duplicates[0].Files[1].SegmentID)
+	assert.Equal(t, hashB, duplicates[1].ContentHash)
+	assert.Equal(t, "b/2", duplicates[1].Files[1].Path)
+}
+
+func TestConvertStorageAttributionToModel(t *testing.T) {
+	collectionA, collectionB := types.NewUniqueID(), types.NewUniqueID()
+	attribution := convertStorageAttributionToModel("tenant", []*dbmodel.CollectionStorage{
+		{CollectionID: dbmodel.NewCollectionID(collectionA), UniqueBytes: 10, SharedBytes: 5},
+		{CollectionID: dbmodel.NewCollectionID(collectionB), UniqueBytes: 1, SharedBytes: 5},
+	}, 16)
+	assert.Equal(t, int64(11), attribution.UniqueBytes)
+	assert.Equal(t, int64(10), attribution.SharedBytes)
+	assert.Equal(t, int64(16), attribution.DistinctBytes)
+	assert.Len(t, attribution.Collections, 2)
+	assert.Equal(t, collectionB, attribution.Collections[1].CollectionID)
+}
+
+func TestConvertCollectionTemplate(t *testing.T) {
+	metadata := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	metadata.Add("team", &model.CollectionMetadataValueStringType{Value: "search"})
+	metadata.Add("hnsw:M", &model.CollectionMetadataValueInt64Type{Value: 32})
+	maxRecords := int64(1000000)
+	template := &model.CollectionTemplate{ID: types.NewUniqueID(), TenantID: "tenant", Name: "documents", Metadata: metadata, MaxRecords: &maxRecords}
+
+	dbTemplate := convertCollectionTemplateToDB(template)
+	// entries are stored in key order, and keep the type of their value
+	assert.Equal(t, "hnsw:M", dbTemplate.Metadata[0].Key)
+	assert.Equal(t, int64(32), *dbTemplate.Metadata[0].IntValue)
+	converted := convertCollectionTemplateToModel(dbTemplate)
+	assert.True(t, metadata.Equals(converted.Metadata))
+	assert.Equal(t, template.ID, converted.ID)
+	assert.Equal(t, &maxRecords, converted.MaxRecords)
+	assert.Nil(t, converted.MaxSizeBytes)
+}
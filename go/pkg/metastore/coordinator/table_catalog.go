@@ -2,6 +2,8 @@ package coordinator
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
 	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/common"
@@ -11,6 +13,7 @@ import (
 	"github.com/chroma-core/chroma/go/pkg/notification"
 	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel/metric"
 	"go.uber.org/zap"
 )
 
@@ -19,12 +22,19 @@ type Catalog struct {
 	metaDomain dbmodel.IMetaDomain
 	txImpl     dbmodel.ITransaction
 	store      notification.NotificationStore
+
+	// maxCollectionsPerDatabase caps the number of collections in a single
+	// database. Zero means no limit.
+	maxCollectionsPerDatabase int64
+
+	lifecycleCounter metric.Int64Counter
 }
 
 func NewTableCatalog(txImpl dbmodel.ITransaction, metaDomain dbmodel.IMetaDomain) *Catalog {
 	return &Catalog{
-		txImpl:     txImpl,
-		metaDomain: metaDomain,
+		txImpl:           txImpl,
+		metaDomain:       metaDomain,
+		lifecycleCounter: newLifecycleCounter(),
 	}
 }
 
@@ -36,13 +46,42 @@ func NewTableCatalogWithNotification(txImpl dbmodel.ITransaction, metaDomain dbm
 
 var _ metastore.Catalog = (*Catalog)(nil)
 
+func (tc *Catalog) SetMaxCollectionsPerDatabase(limit int64) {
+	tc.maxCollectionsPerDatabase = limit
+}
+
 func (tc *Catalog) ResetState(ctx context.Context) error {
-	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+	return tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
 		err := tc.metaDomain.CollectionMetadataDb(txCtx).DeleteAll()
 		if err != nil {
 			log.Error("error reest collection metadata db", zap.Error(err))
 			return err
 		}
+		err = tc.metaDomain.CollectionAclDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset collection acl db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.CollectionSchemaFieldDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset collection schema field db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.CollectionLogRouteDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset collection log route db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.CompactionRequestDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset compaction request db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.SegmentFileDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset segment file db", zap.Error(err))
+			return err
+		}
 		err = tc.metaDomain.CollectionDb(txCtx).DeleteAll()
 		if err != nil {
 			log.Error("error reset collection db", zap.Error(err))
@@ -59,6 +98,11 @@ func (tc *Catalog) ResetState(ctx context.Context) error {
 			return err
 		}
 
+		err = tc.metaDomain.DatabaseQuotaDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset database quota db", zap.Error(err))
+			return err
+		}
 		err = tc.metaDomain.DatabaseDb(txCtx).DeleteAll()
 		if err != nil {
 			log.Error("error reset database db", zap.Error(err))
@@ -67,7 +111,7 @@ func (tc *Catalog) ResetState(ctx context.Context) error {
 
 		// TODO: default database and tenant should be pre-defined object
 		err = tc.metaDomain.DatabaseDb(txCtx).Insert(&dbmodel.Database{
-			ID:       types.NilUniqueID().String(),
+			ID:       dbmodel.NewDatabaseID(types.NilUniqueID()),
 			Name:     common.DefaultDatabase,
 			TenantID: common.DefaultTenant,
 		})
@@ -76,6 +120,76 @@ func (tc *Catalog) ResetState(ctx context.Context) error {
 			return err
 		}
 
+		err = tc.metaDomain.UsageCounterDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset usage counter db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.TenantQuotaDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset tenant quota db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.FeatureFlagDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset feature flag db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.MaintenanceModeDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset maintenance mode db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.JobDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset job db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.TenantRegionDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset tenant region db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.NodeDecommissionDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset node decommission db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.NodeHeartbeatDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset node heartbeat db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.CollectionStatsDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset collection stats db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.CollectionSizeHistoryDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset collection size history db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.CleanupDeadLetterDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset cleanup dead letter db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.CollectionMetadataHistoryDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset collection metadata history db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.EmbeddingFunctionDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset embedding function db", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.CollectionTemplateDb(txCtx).DeleteAll()
+		if err != nil {
+			log.Error("error reset collection template db", zap.Error(err))
+			return err
+		}
 		err = tc.metaDomain.TenantDb(txCtx).DeleteAll()
 		if err != nil {
 			log.Error("error reset tenant db", zap.Error(err))
@@ -97,9 +211,9 @@ func (tc *Catalog) ResetState(ctx context.Context) error {
 func (tc *Catalog) CreateDatabase(ctx context.Context, createDatabase *model.CreateDatabase, ts types.Timestamp) (*model.Database, error) {
 	var result *model.Database
 
-	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+	err := tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
 		dbDatabase := &dbmodel.Database{
-			ID:       createDatabase.ID,
+			ID:       dbmodel.DatabaseID(createDatabase.ID),
 			Name:     createDatabase.Name,
 			TenantID: createDatabase.Tenant,
 			Ts:       ts,
@@ -125,23 +239,45 @@ func (tc *Catalog) CreateDatabase(ctx context.Context, createDatabase *model.Cre
 	return result, nil
 }
 
+// GetDatabases looks the database up by ID when one is given, which stays valid
+// across renames, and by tenant and name otherwise.
 func (tc *Catalog) GetDatabases(ctx context.Context, getDatabase *model.GetDatabase, ts types.Timestamp) (*model.Database, error) {
-	databases, err := tc.metaDomain.DatabaseDb(ctx).GetDatabases(getDatabase.Tenant, getDatabase.Name)
+	var result *model.Database
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		if getDatabase.ID != "" {
+			database, err := tc.metaDomain.DatabaseDb(txCtx).GetDatabaseByID(dbmodel.DatabaseID(getDatabase.ID))
+			if err != nil {
+				return err
+			}
+			if getDatabase.Tenant != "" && database.TenantID != getDatabase.Tenant {
+				return common.ErrDatabaseNotFound
+			}
+			result = convertDatabaseToModel(database)
+			return nil
+		}
+		databases, err := tc.metaDomain.DatabaseDb(txCtx).GetDatabases(getDatabase.Tenant, getDatabase.Name)
+		if err != nil {
+			return err
+		}
+		if len(databases) == 0 {
+			return common.ErrDatabaseNotFound
+		}
+		result = convertDatabaseToModel(databases[0])
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	if len(databases) == 0 {
-		return nil, common.ErrDatabaseNotFound
-	}
-	result := make([]*model.Database, 0, len(databases))
-	for _, database := range databases {
-		result = append(result, convertDatabaseToModel(database))
-	}
-	return result[0], nil
+	return result, nil
 }
 
 func (tc *Catalog) GetAllDatabases(ctx context.Context, ts types.Timestamp) ([]*model.Database, error) {
-	databases, err := tc.metaDomain.DatabaseDb(ctx).GetAllDatabases()
+	var databases []*dbmodel.Database
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		databases, err = tc.metaDomain.DatabaseDb(txCtx).GetAllDatabases()
+		return err
+	})
 	if err != nil {
 		log.Error("error getting all databases", zap.Error(err))
 		return nil, err
@@ -156,12 +292,13 @@ func (tc *Catalog) GetAllDatabases(ctx context.Context, ts types.Timestamp) ([]*
 func (tc *Catalog) CreateTenant(ctx context.Context, createTenant *model.CreateTenant, ts types.Timestamp) (*model.Tenant, error) {
 	var result *model.Tenant
 
-	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+	err := tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
 		// TODO: createTenant has ts, don't need to pass in
 		dbTenant := &dbmodel.Tenant{
 			ID:                 createTenant.Name,
 			Ts:                 ts,
 			LastCompactionTime: time.Now().Unix(),
+			KmsKeyID:           createTenant.KmsKeyID,
 		}
 		err := tc.metaDomain.TenantDb(txCtx).Insert(dbTenant)
 		if err != nil {
@@ -180,8 +317,78 @@ func (tc *Catalog) CreateTenant(ctx context.Context, createTenant *model.CreateT
 	return result, nil
 }
 
+// tenantBatchChunkSize is the number of tenants created in one transaction by
+// BatchCreateTenants.
+const tenantBatchChunkSize = 100
+
+// BatchCreateTenants creates the tenants in chunks of tenantBatchChunkSize per
+// transaction, with every tenant in a savepoint of its own, so that a failing
+// tenant only fails its own result.
+func (tc *Catalog) BatchCreateTenants(ctx context.Context, tenants []*model.BatchCreateTenant) []*model.TenantBatchResult {
+	results := make([]*model.TenantBatchResult, 0, len(tenants))
+	for start := 0; start < len(tenants); start += tenantBatchChunkSize {
+		end := min(start+tenantBatchChunkSize, len(tenants))
+		chunk := make([]*model.TenantBatchResult, 0, end-start)
+		err := tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+			chunk = chunk[:0]
+			for _, tenant := range tenants[start:end] {
+				err := tc.txImpl.WithWriteTx(txCtx, func(tenantCtx context.Context) error {
+					return tc.createBatchTenant(tenantCtx, tenant)
+				})
+				chunk = append(chunk, &model.TenantBatchResult{Name: tenant.Name, Err: err})
+			}
+			return nil
+		})
+		if err != nil {
+			// nothing of the chunk was committed
+			log.Error("tenant batch chunk failed", zap.Int("start", start), zap.Error(err))
+			chunk = chunk[:0]
+			for _, tenant := range tenants[start:end] {
+				chunk = append(chunk, &model.TenantBatchResult{Name: tenant.Name, Err: err})
+			}
+		}
+		results = append(results, chunk...)
+	}
+	return results
+}
+
+func (tc *Catalog) createBatchTenant(txCtx context.Context, tenant *model.BatchCreateTenant) error {
+	err := tc.metaDomain.TenantDb(txCtx).Insert(&dbmodel.Tenant{
+		ID:                 tenant.Name,
+		LastCompactionTime: time.Now().Unix(),
+		KmsKeyID:           tenant.KmsKeyID,
+	})
+	if err != nil {
+		return err
+	}
+	if tenant.DatabaseName != "" {
+		err = tc.metaDomain.DatabaseDb(txCtx).Insert(&dbmodel.Database{
+			ID:       dbmodel.DatabaseID(types.NewUniqueID().String()),
+			Name:     tenant.DatabaseName,
+			TenantID: tenant.Name,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if tenant.Quota != nil {
+		quota := *tenant.Quota
+		quota.TenantID = tenant.Name
+		err = tc.metaDomain.TenantQuotaDb(txCtx).Upsert(convertTenantQuotaToDB(&quota))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (tc *Catalog) GetTenants(ctx context.Context, getTenant *model.GetTenant, ts types.Timestamp) (*model.Tenant, error) {
-	tenants, err := tc.metaDomain.TenantDb(ctx).GetTenants(getTenant.Name)
+	var tenants []*dbmodel.Tenant
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		tenants, err = tc.metaDomain.TenantDb(txCtx).GetTenants(getTenant.Name)
+		return err
+	})
 	if err != nil {
 		log.Error("error getting tenants", zap.Error(err))
 		return nil, err
@@ -198,7 +405,12 @@ func (tc *Catalog) GetTenants(ctx context.Context, getTenant *model.GetTenant, t
 }
 
 func (tc *Catalog) GetAllTenants(ctx context.Context, ts types.Timestamp) ([]*model.Tenant, error) {
-	tenants, err := tc.metaDomain.TenantDb(ctx).GetAllTenants()
+	var tenants []*dbmodel.Tenant
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		tenants, err = tc.metaDomain.TenantDb(txCtx).GetAllTenants()
+		return err
+	})
 	if err != nil {
 		log.Error("error getting all tenants", zap.Error(err))
 		return nil, err
@@ -210,10 +422,208 @@ func (tc *Catalog) GetAllTenants(ctx context.Context, ts types.Timestamp) ([]*mo
 	return result, nil
 }
 
+// DeleteTenant deletes a tenant that has no databases left. With force it first
+// deletes every database of the tenant together with their collections, soft
+// deleted ones included, and segments.
+func (tc *Catalog) DeleteTenant(ctx context.Context, deleteTenant *model.DeleteTenant) error {
+	log.Info("deleting tenant", zap.String("tenant", deleteTenant.Name), zap.Bool("force", deleteTenant.Force))
+	purged := int64(0)
+	err := tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		tenants, err := tc.metaDomain.TenantDb(txCtx).GetTenants(deleteTenant.Name)
+		if err != nil {
+			return err
+		}
+		if len(tenants) == 0 {
+			return common.ErrTenantNotFound
+		}
+
+		databases, err := tc.metaDomain.DatabaseDb(txCtx).GetDatabasesByTenantID(deleteTenant.Name)
+		if err != nil {
+			return err
+		}
+		if len(databases) > 0 && !deleteTenant.Force {
+			return common.ErrTenantNotEmpty
+		}
+		total := int64(0)
+		if deleteTenant.Progress != nil {
+			total, err = tc.metaDomain.CollectionDb(txCtx).CountCollections(deleteTenant.Name, "", true)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, database := range databases {
+			collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(nil, nil, deleteTenant.Name, database.Name, nil, nil, nil)
+			if err != nil {
+				return err
+			}
+			// the soft deleted collections would be left without their database
+			deletedCollections, err := tc.getDeletedCollections(txCtx, database.ID)
+			if err != nil {
+				return err
+			}
+			collections = append(collections, deletedCollections...)
+			for _, collection := range collections {
+				err = tc.purgeCollection(txCtx, collection, dbmodel.ArchiveReasonForceDeleteTenant, deleteTenant.Actor)
+				if err != nil {
+					return err
+				}
+				purged++
+				if deleteTenant.Progress != nil {
+					deleteTenant.Progress(purged, max(total, purged))
+				}
+			}
+			_, err = tc.metaDomain.DatabaseDb(txCtx).DeleteByTenantIdAndName(deleteTenant.Name, database.Name)
+			if err != nil {
+				return err
+			}
+			_, err = tc.metaDomain.DatabaseQuotaDb(txCtx).DeleteByDatabaseID(database.ID)
+			if err != nil {
+				return err
+			}
+			log.Info("database deleted", zap.String("tenant", deleteTenant.Name), zap.String("database", database.Name), zap.Int("collectionDeletedCount", len(collections)))
+		}
+		_, err = tc.metaDomain.EmbeddingFunctionDb(txCtx).DeleteByTenantID(deleteTenant.Name)
+		if err != nil {
+			return err
+		}
+		_, err = tc.metaDomain.CollectionTemplateDb(txCtx).DeleteByTenantID(deleteTenant.Name)
+		if err != nil {
+			return err
+		}
+		// tenants are named, a tenant created again with the name starts afresh
+		_, err = tc.metaDomain.TenantQuotaDb(txCtx).DeleteByTenantID(deleteTenant.Name)
+		if err != nil {
+			return err
+		}
+		_, err = tc.metaDomain.UsageCounterDb(txCtx).DeleteByTenantID(deleteTenant.Name)
+		if err != nil {
+			return err
+		}
+		err = tc.metaDomain.TenantRegionDb(txCtx).Replace(deleteTenant.Name, nil)
+		if err != nil {
+			return err
+		}
+		_, err = tc.metaDomain.FeatureFlagDb(txCtx).DeleteByTenantID(deleteTenant.Name)
+		if err != nil {
+			return err
+		}
+
+		_, err = tc.metaDomain.TenantDb(txCtx).DeleteByID(deleteTenant.Name)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	tc.emitLifecycle(ctx, dbmodel.LifecycleEventPurged, purged)
+	return nil
+}
+
+// getDeletedCollections returns the soft deleted collections of a database with
+// their metadata, to be purged.
+func (tc *Catalog) getDeletedCollections(txCtx context.Context, databaseID dbmodel.DatabaseID) ([]*dbmodel.CollectionAndMetadata, error) {
+	collections, err := tc.metaDomain.CollectionDb(txCtx).GetDeletedCollectionsByDatabaseID(databaseID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*dbmodel.CollectionAndMetadata, 0, len(collections))
+	for _, collection := range collections {
+		metadata, err := tc.metaDomain.CollectionMetadataDb(txCtx).GetByCollectionID(collection.ID)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &dbmodel.CollectionAndMetadata{
+			Collection:         collection,
+			CollectionMetadata: metadata,
+			TenantID:           collection.TenantID,
+		})
+	}
+	return result, nil
+}
+
+// purgeCollection archives and removes a collection with its metadata and segments,
+// queues the delete notification and counts the purge. It must be called inside a
+// transaction.
+func (tc *Catalog) purgeCollection(txCtx context.Context, collection *dbmodel.CollectionAndMetadata, reason string, actor string) error {
+	collectionID := collection.Collection.ID
+	segments, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(types.NilUniqueID(), nil, nil, collectionID.UniqueID(), nil, nil)
+	if err != nil {
+		return err
+	}
+	for _, segment := range segments {
+		err = tc.metaDomain.ArchiveDb(txCtx).ArchiveSegment(segment, reason, actor)
+		if err != nil {
+			return err
+		}
+		err = tc.metaDomain.SegmentDb(txCtx).DeleteSegmentByID(segment.Segment.ID)
+		if err != nil {
+			return err
+		}
+		err = tc.metaDomain.SegmentMetadataDb(txCtx).DeleteBySegmentID(segment.Segment.ID)
+		if err != nil {
+			return err
+		}
+		err = tc.metaDomain.SegmentFileDb(txCtx).DeleteBySegmentID(segment.Segment.ID)
+		if err != nil {
+			return err
+		}
+	}
+	err = tc.metaDomain.ArchiveDb(txCtx).ArchiveCollection(collection, reason, actor)
+	if err != nil {
+		return err
+	}
+	// a soft deleted collection was taken off the usage counters when it was
+	// deleted
+	if !collection.Collection.IsDeleted {
+		err = tc.releaseCollectionUsage(txCtx, collection.TenantID, collectionID)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = tc.metaDomain.CollectionDb(txCtx).DeleteCollectionByID(collectionID)
+	if err != nil {
+		return err
+	}
+	_, err = tc.metaDomain.CollectionMetadataDb(txCtx).DeleteByCollectionID(collectionID)
+	if err != nil {
+		return err
+	}
+	err = tc.metaDomain.CollectionMetadataHistoryDb(txCtx).DeleteByCollectionID(collectionID)
+	if err != nil {
+		return err
+	}
+	_, err = tc.metaDomain.CollectionAclDb(txCtx).DeleteByCollectionID(collectionID)
+	if err != nil {
+		return err
+	}
+	_, err = tc.metaDomain.CollectionSchemaFieldDb(txCtx).DeleteByCollectionID(collectionID)
+	if err != nil {
+		return err
+	}
+	_, err = tc.metaDomain.CollectionLogRouteDb(txCtx).DeleteByCollectionID(collectionID)
+	if err != nil {
+		return err
+	}
+	_, err = tc.metaDomain.CompactionRequestDb(txCtx).DeleteByCollectionID(collectionID)
+	if err != nil {
+		return err
+	}
+	err = tc.metaDomain.NotificationDb(txCtx).Insert(&dbmodel.Notification{
+		CollectionID: collectionID,
+		Type:         dbmodel.NotificationTypeDeleteCollection,
+		Status:       dbmodel.NotificationStatusPending,
+	})
+	if err != nil {
+		return err
+	}
+	return tc.recordLifecycle(txCtx, collection.TenantID, dbmodel.LifecycleEventPurged, 1)
+}
+
 func (tc *Catalog) CreateCollection(ctx context.Context, createCollection *model.CreateCollection, ts types.Timestamp) (*model.Collection, error) {
 	var result *model.Collection
+	created := false
 
-	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+	err := tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
 		// insert collection
 		databaseName := createCollection.DatabaseName
 		tenantID := createCollection.TenantID
@@ -223,12 +633,19 @@ func (tc *Catalog) CreateCollection(ctx context.Context, createCollection *model
 			return err
 		}
 		if len(databases) == 0 {
-			log.Error("database not found", zap.Error(err))
-			return common.ErrDatabaseNotFound
+			if !createCollection.CreateDatabaseIfNotExists {
+				log.Error("database not found", zap.Error(err))
+				return common.ErrDatabaseNotFound
+			}
+			database, err := tc.createMissingDatabase(txCtx, tenantID, databaseName, ts)
+			if err != nil {
+				return err
+			}
+			databases = []*dbmodel.Database{database}
 		}
 
 		collectionName := createCollection.Name
-		existing, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(nil, &collectionName, tenantID, databaseName, nil, nil)
+		existing, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(nil, &collectionName, tenantID, databaseName, nil, nil, nil)
 		if err != nil {
 			log.Error("error getting collection", zap.Error(err))
 			return err
@@ -237,7 +654,7 @@ func (tc *Catalog) CreateCollection(ctx context.Context, createCollection *model
 			if createCollection.GetOrCreate {
 				collection := convertCollectionToModel(existing)[0]
 				if createCollection.Metadata != nil && !createCollection.Metadata.Equals(collection.Metadata) {
-					updatedCollection, err := tc.UpdateCollection(ctx, &model.UpdateCollection{
+					updatedCollection, _, err := tc.UpdateCollection(ctx, &model.UpdateCollection{
 						ID:           collection.ID,
 						Metadata:     createCollection.Metadata,
 						TenantID:     tenantID,
@@ -256,13 +673,63 @@ func (tc *Catalog) CreateCollection(ctx context.Context, createCollection *model
 			}
 		}
 
+		if tc.maxCollectionsPerDatabase > 0 {
+			err = tc.checkCollectionLimit(txCtx, databases[0].ID)
+			if err != nil {
+				return err
+			}
+		}
+
+		kmsKeyID := createCollection.KmsKeyID
+		if kmsKeyID == nil {
+			kmsKeyID, err = tc.tenantKmsKeyID(txCtx, databases[0].TenantID)
+			if err != nil {
+				return err
+			}
+		}
+
+		replicationFactor := int32(1)
+		if createCollection.ReplicationFactor != nil {
+			replicationFactor = *createCollection.ReplicationFactor
+		}
+		distanceFunction := model.DefaultDistanceFunction
+		if createCollection.DistanceFunction != nil {
+			distanceFunction = *createCollection.DistanceFunction
+		}
+		var embeddingFunctionID *string
+		if createCollection.EmbeddingFunctionID != nil {
+			embeddingFunctionID, err = tc.lockEmbeddingFunction(txCtx, *createCollection.EmbeddingFunctionID, databases[0].TenantID)
+			if err != nil {
+				return err
+			}
+		}
+		var templateID *string
+		if createCollection.TemplateID != nil {
+			templateID, err = tc.lockCollectionTemplate(txCtx, *createCollection.TemplateID, databases[0].TenantID)
+			if err != nil {
+				return err
+			}
+		}
+
+		scopes := make([]string, 0, len(createCollection.Segments))
+		for _, createSegment := range createCollection.Segments {
+			scopes = append(scopes, createSegment.Scope)
+		}
 		dbCollection := &dbmodel.Collection{
-			ID:          createCollection.ID.String(),
+			ID:          dbmodel.NewCollectionID(createCollection.ID),
 			Name:        &createCollection.Name,
 			Dimension:   createCollection.Dimension,
 			DatabaseID:  databases[0].ID,
 			Ts:          ts,
 			LogPosition: 0,
+			State:       string(collectionStateForScopes(scopes, model.CollectionStateCreating)),
+			TenantID:    databases[0].TenantID,
+			KmsKeyID:    kmsKeyID,
+
+			ReplicationFactor:   replicationFactor,
+			DistanceFunction:    distanceFunction,
+			EmbeddingFunctionID: embeddingFunctionID,
+			TemplateID:          templateID,
 		}
 
 		err = tc.metaDomain.CollectionDb(txCtx).Insert(dbCollection)
@@ -272,15 +739,30 @@ func (tc *Catalog) CreateCollection(ctx context.Context, createCollection *model
 		}
 		// insert collection metadata
 		metadata := createCollection.Metadata
-		dbCollectionMetadataList := convertCollectionMetadataToDB(createCollection.ID.String(), metadata)
+		dbCollectionMetadataList := convertCollectionMetadataToDB(dbmodel.NewCollectionID(createCollection.ID), databases[0].TenantID, metadata)
 		if len(dbCollectionMetadataList) != 0 {
 			err = tc.metaDomain.CollectionMetadataDb(txCtx).Insert(dbCollectionMetadataList)
 			if err != nil {
 				return err
 			}
 		}
+		err = tc.metaDomain.CollectionMetadataHistoryDb(txCtx).Record(dbCollection.ID, dbCollection.TenantID, 0, dbCollectionMetadataList)
+		if err != nil {
+			return err
+		}
+		// insert segments, any failure rolls back the collection as well
+		for _, createSegment := range createCollection.Segments {
+			if createSegment.CollectionID != createCollection.ID {
+				log.Error("segment collection mismatch", zap.String("segmentID", createSegment.ID.String()), zap.String("collectionID", createCollection.ID.String()))
+				return common.ErrSegmentCollectionMismatch
+			}
+			err = tc.insertSegment(txCtx, createSegment, databases[0].TenantID, ts)
+			if err != nil {
+				return err
+			}
+		}
 		// get collection
-		collectionList, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(createCollection.ID), nil, tenantID, databaseName, nil, nil)
+		collectionList, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(dbmodel.CollectionIDFromUniqueID(createCollection.ID), nil, tenantID, databaseName, nil, nil, nil)
 		if err != nil {
 			log.Error("error getting collection", zap.Error(err))
 			return err
@@ -288,7 +770,7 @@ func (tc *Catalog) CreateCollection(ctx context.Context, createCollection *model
 		result = convertCollectionToModel(collectionList)[0]
 
 		notificationRecord := &dbmodel.Notification{
-			CollectionID: result.ID.String(),
+			CollectionID: dbmodel.NewCollectionID(result.ID),
 			Type:         dbmodel.NotificationTypeCreateCollection,
 			Status:       dbmodel.NotificationStatusPending,
 		}
@@ -296,30 +778,194 @@ func (tc *Catalog) CreateCollection(ctx context.Context, createCollection *model
 		if err != nil {
 			return err
 		}
-		return nil
+		created = true
+		return tc.recordLifecycle(txCtx, databases[0].TenantID, dbmodel.LifecycleEventCreated, 1)
 	})
 	if err != nil {
 		log.Error("error creating collection", zap.Error(err))
 		return nil, err
 	}
+	if created {
+		tc.emitLifecycle(ctx, dbmodel.LifecycleEventCreated, 1)
+	}
 	log.Info("collection created", zap.Any("collection", result))
 	return result, nil
 }
 
-func (tc *Catalog) GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32) ([]*model.Collection, error) {
-	collectionAndMetadataList, err := tc.metaDomain.CollectionDb(ctx).GetCollections(types.FromUniqueID(collectionID), collectionName, tenantID, databaseName, limit, offset)
+// tenantKmsKeyID returns the key new collections of the tenant are encrypted
+// with, or nil when the tenant has none.
+func (tc *Catalog) tenantKmsKeyID(txCtx context.Context, tenantID string) (*string, error) {
+	tenants, err := tc.metaDomain.TenantDb(txCtx).GetTenants(tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if len(tenants) == 0 {
+		return nil, common.ErrTenantNotFound
+	}
+	return tenants[0].KmsKeyID, nil
+}
+
+// createMissingDatabase creates a database on behalf of CreateCollection. It must
+// run inside the CreateCollection transaction so that the database is rolled back
+// together with the collection, e.g. when the collection limit is exceeded.
+func (tc *Catalog) createMissingDatabase(txCtx context.Context, tenantID string, databaseName string, ts types.Timestamp) (*dbmodel.Database, error) {
+	tenants, err := tc.metaDomain.TenantDb(txCtx).GetTenants(tenantID)
+	if err != nil {
+		log.Error("error getting tenant", zap.Error(err))
+		return nil, err
+	}
+	if len(tenants) == 0 {
+		return nil, common.ErrTenantNotFound
+	}
+	database := &dbmodel.Database{
+		ID:       dbmodel.NewDatabaseID(types.NewUniqueID()),
+		Name:     databaseName,
+		TenantID: tenantID,
+		Ts:       ts,
+	}
+	err = tc.metaDomain.DatabaseDb(txCtx).Insert(database)
+	if err != nil {
+		log.Error("error inserting database", zap.Error(err))
+		return nil, err
+	}
+	log.Info("database created for collection", zap.String("tenant", tenantID), zap.String("database", databaseName))
+	return database, nil
+}
+
+// checkCollectionLimit must run inside the CreateCollection transaction. It
+// takes a row lock on the database so that concurrent creates in the same
+// database are serialized and each one counts the collections committed by
+// the previous ones.
+func (tc *Catalog) checkCollectionLimit(txCtx context.Context, databaseID dbmodel.DatabaseID) error {
+	_, err := tc.metaDomain.DatabaseDb(txCtx).GetDatabaseForUpdate(databaseID)
+	if err != nil {
+		log.Error("error locking database", zap.Error(err))
+		return err
+	}
+	count, err := tc.metaDomain.CollectionDb(txCtx).CountCollectionsByDatabaseID(databaseID)
+	if err != nil {
+		log.Error("error counting collections", zap.Error(err))
+		return err
+	}
+	if count >= tc.maxCollectionsPerDatabase {
+		log.Error("collection limit exceeded", zap.String("databaseID", databaseID.String()), zap.Int64("count", count), zap.Int64("limit", tc.maxCollectionsPerDatabase))
+		return common.ErrCollectionLimitExceeded
+	}
+	return nil
+}
+
+// GetCollections only loads the metadata and the acl of the collections when
+// fields includes them, they are left nil otherwise.
+func (tc *Catalog) GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32, metadataFilters []*model.CollectionMetadataFilter, fields *model.CollectionFields) ([]*model.Collection, error) {
+	var collectionAndMetadataList []*dbmodel.CollectionAndMetadata
+	var aclEntries []*dbmodel.CollectionAcl
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		collectionDb := tc.metaDomain.CollectionDb(txCtx)
+		getCollections := collectionDb.GetCollections
+		if !fields.IncludesMetadata() {
+			getCollections = collectionDb.GetCollectionsWithoutMetadata
+		}
+		var err error
+		collectionAndMetadataList, err = getCollections(dbmodel.CollectionIDFromUniqueID(collectionID), collectionName, tenantID, databaseName, limit, offset, metadataFilters)
+		if err != nil || !fields.IncludesAcl() {
+			return err
+		}
+		aclEntries, err = tc.getCollectionAcl(txCtx, collectionAndMetadataList)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	collections := convertCollectionToModel(collectionAndMetadataList)
+	attachCollectionAcl(collections, aclEntries)
+	return collections, nil
+}
+
+// GetCollectionsByIDs returns the live collections among collectionIDs, with
+// a fixed number of queries however many they are. Collections that do not
+// exist or are soft deleted are left out.
+func (tc *Catalog) GetCollectionsByIDs(ctx context.Context, collectionIDs []types.UniqueID, fields *model.CollectionFields) ([]*model.Collection, error) {
+	var collectionAndMetadataList []*dbmodel.CollectionAndMetadata
+	var aclEntries []*dbmodel.CollectionAcl
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		ids := make([]dbmodel.CollectionID, 0, len(collectionIDs))
+		for _, collectionID := range collectionIDs {
+			ids = append(ids, dbmodel.NewCollectionID(collectionID))
+		}
+		var err error
+		collectionAndMetadataList, err = tc.metaDomain.CollectionDb(txCtx).GetLiveCollectionsByIDs(ids, fields.IncludesMetadata())
+		if err != nil || !fields.IncludesAcl() {
+			return err
+		}
+		aclEntries, err = tc.getCollectionAcl(txCtx, collectionAndMetadataList)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 	collections := convertCollectionToModel(collectionAndMetadataList)
+	attachCollectionAcl(collections, aclEntries)
 	return collections, nil
 }
 
+func (tc *Catalog) getCollectionAcl(txCtx context.Context, collectionAndMetadataList []*dbmodel.CollectionAndMetadata) ([]*dbmodel.CollectionAcl, error) {
+	if len(collectionAndMetadataList) == 0 {
+		return nil, nil
+	}
+	collectionIDs := make([]dbmodel.CollectionID, 0, len(collectionAndMetadataList))
+	for _, collectionAndMetadata := range collectionAndMetadataList {
+		collectionIDs = append(collectionIDs, collectionAndMetadata.Collection.ID)
+	}
+	return tc.metaDomain.CollectionAclDb(txCtx).GetByCollectionIDs(collectionIDs)
+}
+
+// SetCollectionAclEntry grants the principal of entry its permissions on the
+// collection, replacing the permissions it had before.
+func (tc *Catalog) SetCollectionAclEntry(ctx context.Context, entry *model.CollectionAclEntry) error {
+	return tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(dbmodel.CollectionIDFromUniqueID(entry.CollectionID), nil, "", "", nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		if len(collections) == 0 {
+			return common.ErrCollectionNotFound
+		}
+		return tc.metaDomain.CollectionAclDb(txCtx).Upsert(&dbmodel.CollectionAcl{
+			CollectionID: dbmodel.NewCollectionID(entry.CollectionID),
+			Principal:    entry.Principal,
+			Permissions:  int32(entry.Permissions),
+			TenantID:     collections[0].TenantID,
+		})
+	})
+}
+
+// DeleteCollectionAclEntry revokes all permissions of principal on the
+// collection. It is a no-op when the principal has none.
+func (tc *Catalog) DeleteCollectionAclEntry(ctx context.Context, collectionID types.UniqueID, principal string) error {
+	return tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		_, err := tc.metaDomain.CollectionAclDb(txCtx).Delete(dbmodel.NewCollectionID(collectionID), principal)
+		return err
+	})
+}
+
+func (tc *Catalog) GetCollectionAcl(ctx context.Context, collectionID types.UniqueID) ([]*model.CollectionAclEntry, error) {
+	var aclEntries []*dbmodel.CollectionAcl
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		aclEntries, err = tc.metaDomain.CollectionAclDb(txCtx).GetByCollectionIDs([]dbmodel.CollectionID{dbmodel.NewCollectionID(collectionID)})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertCollectionAclToModel(aclEntries), nil
+}
+
 func (tc *Catalog) DeleteCollection(ctx context.Context, deleteCollection *model.DeleteCollection) error {
 	log.Info("deleting collection", zap.Any("deleteCollection", deleteCollection))
-	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+	err := tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
 		collectionID := deleteCollection.ID
-		collectionAndMetadata, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(collectionID), nil, deleteCollection.TenantID, deleteCollection.DatabaseName, nil, nil)
+		collectionAndMetadata, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(dbmodel.CollectionIDFromUniqueID(collectionID), nil, deleteCollection.TenantID, deleteCollection.DatabaseName, nil, nil, nil)
 		if err != nil {
 			return err
 		}
@@ -327,18 +973,51 @@ func (tc *Catalog) DeleteCollection(ctx context.Context, deleteCollection *model
 			return common.ErrCollectionDeleteNonExistingCollection
 		}
 
-		collectionDeletedCount, err := tc.metaDomain.CollectionDb(txCtx).DeleteCollectionByID(collectionID.String())
+		reason := deleteCollection.Reason
+		if reason == "" {
+			reason = dbmodel.ArchiveReasonDeleteCollection
+		}
+		err = tc.metaDomain.ArchiveDb(txCtx).ArchiveCollection(collectionAndMetadata[0], reason, deleteCollection.Actor)
+		if err != nil {
+			return err
+		}
+		err = tc.releaseCollectionUsage(txCtx, collectionAndMetadata[0].TenantID, dbmodel.NewCollectionID(collectionID))
+		if err != nil {
+			return err
+		}
+
+		collectionDeletedCount, err := tc.metaDomain.CollectionDb(txCtx).DeleteCollectionByID(dbmodel.NewCollectionID(collectionID))
+		if err != nil {
+			return err
+		}
+		collectionMetadataDeletedCount, err := tc.metaDomain.CollectionMetadataDb(txCtx).DeleteByCollectionID(dbmodel.NewCollectionID(collectionID))
+		if err != nil {
+			return err
+		}
+		err = tc.metaDomain.CollectionMetadataHistoryDb(txCtx).DeleteByCollectionID(dbmodel.NewCollectionID(collectionID))
+		if err != nil {
+			return err
+		}
+		_, err = tc.metaDomain.CollectionAclDb(txCtx).DeleteByCollectionID(dbmodel.NewCollectionID(collectionID))
+		if err != nil {
+			return err
+		}
+		_, err = tc.metaDomain.CollectionSchemaFieldDb(txCtx).DeleteByCollectionID(dbmodel.NewCollectionID(collectionID))
 		if err != nil {
 			return err
 		}
-		collectionMetadataDeletedCount, err := tc.metaDomain.CollectionMetadataDb(txCtx).DeleteByCollectionID(collectionID.String())
+		_, err = tc.metaDomain.CollectionLogRouteDb(txCtx).DeleteByCollectionID(dbmodel.NewCollectionID(collectionID))
+		if err != nil {
+			return err
+		}
+		_, err = tc.metaDomain.CompactionRequestDb(txCtx).DeleteByCollectionID(dbmodel.NewCollectionID(collectionID))
 		if err != nil {
 			return err
 		}
 		log.Info("collection deleted", zap.Any("collection", collectionAndMetadata), zap.Int("collectionDeletedCount", collectionDeletedCount), zap.Int("collectionMetadataDeletedCount", collectionMetadataDeletedCount))
 
 		notificationRecord := &dbmodel.Notification{
-			CollectionID: collectionID.String(),
+			CollectionID: dbmodel.NewCollectionID(collectionID),
 			Type:         dbmodel.NotificationTypeDeleteCollection,
 			Status:       dbmodel.NotificationStatusPending,
 		}
@@ -347,25 +1026,113 @@ func (tc *Catalog) DeleteCollection(ctx context.Context, deleteCollection *model
 			return err
 		}
 
-		return nil
+		return tc.recordLifecycle(txCtx, collectionAndMetadata[0].TenantID, dbmodel.LifecycleEventDeleted, 1)
+	})
+	if err != nil {
+		return err
+	}
+	tc.emitLifecycle(ctx, dbmodel.LifecycleEventDeleted, 1)
+	return nil
+}
+
+// GetIncompleteCollections returns the collections created before createdBefore
+// that have no segments.
+func (tc *Catalog) GetIncompleteCollections(ctx context.Context, createdBefore time.Time) ([]*model.IncompleteCollection, error) {
+	var collections []*dbmodel.CollectionAndMetadata
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		collections, err = tc.metaDomain.CollectionDb(txCtx).GetCollectionsWithoutSegments(createdBefore)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertIncompleteCollectionToModel(collections), nil
+}
+
+// RepairIncompleteCollection archives and deletes a collection that has no
+// segments. It fails with ErrCollectionNotIncomplete if segments were created
+// for the collection in the meantime.
+func (tc *Catalog) RepairIncompleteCollection(ctx context.Context, collectionID types.UniqueID, actor string) error {
+	err := tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(dbmodel.CollectionIDFromUniqueID(collectionID), nil, "", "", nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		if len(collections) == 0 {
+			return common.ErrCollectionNotFound
+		}
+		segments, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(types.NilUniqueID(), nil, nil, collectionID, nil, nil)
+		if err != nil {
+			return err
+		}
+		if len(segments) != 0 {
+			return common.ErrCollectionNotIncomplete
+		}
+		return tc.purgeCollection(txCtx, collections[0], dbmodel.ArchiveReasonRepairIncomplete, actor)
+	})
+	if err != nil {
+		log.Error("error repairing incomplete collection", zap.String("collectionID", collectionID.String()), zap.Error(err))
+		return err
+	}
+	tc.emitLifecycle(ctx, dbmodel.LifecycleEventPurged, 1)
+	log.Info("incomplete collection repaired", zap.String("collectionID", collectionID.String()), zap.String("actor", actor))
+	return nil
+}
+
+// GetInconsistentCollections returns the collections stuck in an intermediate
+// state, ignoring collections soft deleted after deletedBefore whose segments may
+// still be cleaned up. Only the kinds in kinds are checked, all of them when it
+// is empty.
+func (tc *Catalog) GetInconsistentCollections(ctx context.Context, deletedBefore time.Time, kinds []model.InconsistencyKind) ([]*model.InconsistentCollection, error) {
+	var collections []*dbmodel.InconsistentCollection
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		collections, err = tc.metaDomain.CollectionDb(txCtx).GetInconsistentCollections(deletedBefore, requiredSegmentScopes, kinds)
+		return err
 	})
+	if err != nil {
+		return nil, err
+	}
+	return convertInconsistentCollectionToModel(collections), nil
 }
 
-func (tc *Catalog) UpdateCollection(ctx context.Context, updateCollection *model.UpdateCollection, ts types.Timestamp) (*model.Collection, error) {
+// UpdateCollection applies the changes of updateCollection to the collection in
+// one transaction and returns the collection with the fields whose value
+// changed. The revision of the collection is bumped in the same statement as
+// the other columns, and only when a field changed.
+func (tc *Catalog) UpdateCollection(ctx context.Context, updateCollection *model.UpdateCollection, ts types.Timestamp) (*model.Collection, []string, error) {
 	log.Info("updating collection", zap.String("collectionId", updateCollection.ID.String()))
 	var result *model.Collection
+	var changedFields []string
 
-	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
-		dbCollection := &dbmodel.Collection{
-			ID:        updateCollection.ID.String(),
-			Name:      updateCollection.Name,
-			Dimension: updateCollection.Dimension,
-			Ts:        ts,
-		}
-		err := tc.metaDomain.CollectionDb(txCtx).Update(dbCollection)
+	err := tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		changedFields = nil
+		collectionID := dbmodel.NewCollectionID(updateCollection.ID)
+		// the lock keeps the changed fields and the expected revision accurate
+		// against concurrent updates
+		current, err := tc.metaDomain.CollectionDb(txCtx).GetForUpdate(collectionID)
 		if err != nil {
 			return err
 		}
+		if current == nil {
+			return common.ErrCollectionNotFound
+		}
+		if updateCollection.ExpectedRevision != nil && *updateCollection.ExpectedRevision != current.Revision {
+			return common.ErrCollectionRevisionMismatch
+		}
+		if updateCollection.Dimension != nil || updateCollection.RequestReindex {
+			err := tc.markReindexIfNeeded(txCtx, updateCollection)
+			if err != nil {
+				return err
+			}
+		}
+		if updateCollection.Name != nil && (current.Name == nil || *current.Name != *updateCollection.Name) {
+			changedFields = append(changedFields, model.CollectionFieldName)
+		}
+		if updateCollection.Dimension != nil && (current.Dimension == nil || *current.Dimension != *updateCollection.Dimension) {
+			changedFields = append(changedFields, model.CollectionFieldDimension)
+		}
 
 		// Case 1: if ResetMetadata is true, then delete all metadata for the collection
 		// Case 2: if ResetMetadata is true and metadata is not nil -> THIS SHOULD NEVER HAPPEN
@@ -373,33 +1140,90 @@ func (tc *Catalog) UpdateCollection(ctx context.Context, updateCollection *model
 		// Case 4: if ResetMetadata is false and metadata is nil, then leave the metadata as is
 		metadata := updateCollection.Metadata
 		resetMetadata := updateCollection.ResetMetadata
-		if resetMetadata {
-			if metadata != nil { // Case 2
-				return common.ErrInvalidMetadataUpdate
-			} else { // Case 1
-				_, err = tc.metaDomain.CollectionMetadataDb(txCtx).DeleteByCollectionID(updateCollection.ID.String())
-				if err != nil {
-					return err
-				}
+		if resetMetadata && metadata != nil { // Case 2
+			return common.ErrInvalidMetadataUpdate
+		}
+		if resetMetadata || metadata != nil { // Case 1 and 3
+			metadata, err = keepDistanceFunction(current, metadata)
+			if err != nil {
+				return err
 			}
-		} else {
-			if metadata != nil { // Case 3
-				_, err = tc.metaDomain.CollectionMetadataDb(txCtx).DeleteByCollectionID(updateCollection.ID.String())
+			currentMetadata, err := tc.metaDomain.CollectionMetadataDb(txCtx).GetByCollectionID(collectionID)
+			if err != nil {
+				return err
+			}
+			if !sameCollectionMetadata(convertCollectionMetadataToModel(currentMetadata), metadata) {
+				changedFields = append(changedFields, model.CollectionFieldMetadata)
+				_, err = tc.metaDomain.CollectionMetadataDb(txCtx).DeleteByCollectionID(collectionID)
 				if err != nil {
 					return err
 				}
-				dbCollectionMetadataList := convertCollectionMetadataToDB(updateCollection.ID.String(), metadata)
+				dbCollectionMetadataList := convertCollectionMetadataToDB(collectionID, updateCollection.TenantID, metadata)
 				if len(dbCollectionMetadataList) != 0 {
 					err = tc.metaDomain.CollectionMetadataDb(txCtx).Insert(dbCollectionMetadataList)
 					if err != nil {
 						return err
 					}
 				}
+				err = tc.recordMetadataHistory(txCtx, current, currentMetadata, dbCollectionMetadataList)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		if updateCollection.ReplicationFactor != nil && current.ReplicationFactor != *updateCollection.ReplicationFactor {
+			changedFields = append(changedFields, model.CollectionFieldReplicationFactor)
+		}
+		var embeddingFunctionID *string
+		if updateCollection.ResetEmbeddingFunction && updateCollection.EmbeddingFunctionID != nil {
+			return common.ErrInvalidEmbeddingFunctionUpdate
+		}
+		if updateCollection.EmbeddingFunctionID != nil {
+			embeddingFunctionID, err = tc.lockEmbeddingFunction(txCtx, *updateCollection.EmbeddingFunctionID, current.TenantID)
+			if err != nil {
+				return err
+			}
+			if current.EmbeddingFunctionID == nil || *current.EmbeddingFunctionID != *embeddingFunctionID {
+				changedFields = append(changedFields, model.CollectionFieldEmbeddingFunction)
+			}
+		}
+		resetEmbeddingFunction := updateCollection.ResetEmbeddingFunction && current.EmbeddingFunctionID != nil
+		if resetEmbeddingFunction {
+			changedFields = append(changedFields, model.CollectionFieldEmbeddingFunction)
+		}
+
+		if len(changedFields) > 0 {
+			dbCollection := &dbmodel.Collection{
+				ID:        collectionID,
+				Name:      updateCollection.Name,
+				Dimension: updateCollection.Dimension,
+				Ts:        ts,
+				Revision:  current.Revision + 1,
+
+				EmbeddingFunctionID: embeddingFunctionID,
+			}
+			if updateCollection.ReplicationFactor != nil {
+				dbCollection.ReplicationFactor = *updateCollection.ReplicationFactor
+			}
+			err = tc.metaDomain.CollectionDb(txCtx).Update(dbCollection)
+			if err != nil {
+				return err
+			}
+			if resetEmbeddingFunction {
+				err = tc.metaDomain.CollectionDb(txCtx).ClearEmbeddingFunctionID(collectionID)
+				if err != nil {
+					return err
+				}
+			}
+			err = tc.notifyCollectionUpdate(txCtx, collectionID)
+			if err != nil {
+				return err
 			}
 		}
+
 		databaseName := updateCollection.DatabaseName
 		tenantID := updateCollection.TenantID
-		collectionList, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(types.FromUniqueID(updateCollection.ID), nil, tenantID, databaseName, nil, nil)
+		collectionList, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(dbmodel.CollectionIDFromUniqueID(updateCollection.ID), nil, tenantID, databaseName, nil, nil, nil)
 		if err != nil {
 			return err
 		}
@@ -410,141 +1234,763 @@ func (tc *Catalog) UpdateCollection(ctx context.Context, updateCollection *model
 		return nil
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	log.Info("collection updated", zap.String("collectionID", result.ID.String()))
-	return result, nil
+	log.Info("collection updated", zap.String("collectionID", result.ID.String()), zap.Strings("changedFields", changedFields))
+	return result, changedFields, nil
 }
 
-func (tc *Catalog) CreateSegment(ctx context.Context, createSegment *model.CreateSegment, ts types.Timestamp) (*model.Segment, error) {
-	var result *model.Segment
-
-	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
-		// insert segment
-		collectionString := createSegment.CollectionID.String()
-		dbSegment := &dbmodel.Segment{
-			ID:           createSegment.ID.String(),
-			CollectionID: &collectionString,
-			Type:         createSegment.Type,
-			Scope:        createSegment.Scope,
-			Ts:           ts,
-		}
-		err := tc.metaDomain.SegmentDb(txCtx).Insert(dbSegment)
+// RenameCollection renames a collection under the lock of its row, so that the
+// expected revision is checked against concurrent updates, and returns it
+// renamed.
+func (tc *Catalog) RenameCollection(ctx context.Context, rename *model.RenameCollection) (*model.Collection, error) {
+	var result *model.Collection
+	err := tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		collectionID := dbmodel.NewCollectionID(rename.ID)
+		current, err := tc.metaDomain.CollectionDb(txCtx).GetForUpdate(collectionID)
 		if err != nil {
-			log.Error("error inserting segment", zap.Error(err))
 			return err
 		}
-		// insert segment metadata
-		metadata := createSegment.Metadata
-		if metadata != nil {
-			dbSegmentMetadataList := convertSegmentMetadataToDB(createSegment.ID.String(), metadata)
-			if len(dbSegmentMetadataList) != 0 {
-				err = tc.metaDomain.SegmentMetadataDb(txCtx).Insert(dbSegmentMetadataList)
-				if err != nil {
-					log.Error("error inserting segment metadata", zap.Error(err))
-					return err
-				}
+		if current == nil {
+			return common.ErrCollectionNotFound
+		}
+		if rename.ExpectedRevision != nil && *rename.ExpectedRevision != current.Revision {
+			return common.ErrCollectionRevisionMismatch
+		}
+		if current.Name == nil || *current.Name != rename.Name {
+			renamed, err := tc.metaDomain.CollectionDb(txCtx).UpdateCollectionName(collectionID, rename.Name)
+			if err != nil {
+				return err
+			}
+			if renamed == 0 {
+				return common.ErrCollectionNotFound
+			}
+			err = tc.notifyCollectionUpdate(txCtx, collectionID)
+			if err != nil {
+				return err
 			}
 		}
-		// get segment
-		segmentList, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(createSegment.ID, nil, nil, types.NilUniqueID())
+		collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(&collectionID, nil, "", "", nil, nil, nil)
 		if err != nil {
-			log.Error("error getting segment", zap.Error(err))
 			return err
 		}
-		result = convertSegmentToModel(segmentList)[0]
+		if len(collections) == 0 {
+			return common.ErrCollectionNotFound
+		}
+		result = convertCollectionToModel(collections)[0]
 		return nil
 	})
 	if err != nil {
-		log.Error("error creating segment", zap.Error(err))
 		return nil, err
 	}
-	log.Info("segment created", zap.Any("segment", result))
+	log.Info("collection renamed", zap.String("collectionID", rename.ID.String()), zap.String("name", rename.Name))
 	return result, nil
 }
 
-func (tc *Catalog) GetSegments(ctx context.Context, segmentID types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID) ([]*model.Segment, error) {
-	segmentAndMetadataList, err := tc.metaDomain.SegmentDb(ctx).GetSegments(segmentID, segmentType, scope, collectionID)
-	if err != nil {
-		return nil, err
+// sameCollectionMetadata tells whether two metadata hold the same keys and
+// values, a nil metadata being the same as an empty one.
+func sameCollectionMetadata(a *model.CollectionMetadata[model.CollectionMetadataValueType], b *model.CollectionMetadata[model.CollectionMetadataValueType]) bool {
+	if (a == nil || a.Empty()) && (b == nil || b.Empty()) {
+		return true
 	}
-	segments := make([]*model.Segment, 0, len(segmentAndMetadataList))
-	for _, segmentAndMetadata := range segmentAndMetadataList {
-		segment := &model.Segment{
-			ID:        types.MustParse(segmentAndMetadata.Segment.ID),
-			Type:      segmentAndMetadata.Segment.Type,
-			Scope:     segmentAndMetadata.Segment.Scope,
-			Ts:        segmentAndMetadata.Segment.Ts,
-			FilePaths: segmentAndMetadata.Segment.FilePaths,
+	return a.Equals(b)
+}
+
+// collectionBatchChunkSize is the number of collections handled in one
+// transaction by the batch operations.
+const collectionBatchChunkSize = 100
+
+// forEachCollectionInChunks runs fn for every collection in collectionIDs, in
+// chunks of collectionBatchChunkSize per transaction and with every collection in
+// a savepoint of its own, so that a failing collection only fails its own result.
+func (tc *Catalog) forEachCollectionInChunks(ctx context.Context, collectionIDs []types.UniqueID, fn func(txCtx context.Context, collectionID types.UniqueID) error) []*model.CollectionBatchResult {
+	results := make([]*model.CollectionBatchResult, 0, len(collectionIDs))
+	for start := 0; start < len(collectionIDs); start += collectionBatchChunkSize {
+		end := min(start+collectionBatchChunkSize, len(collectionIDs))
+		chunk := make([]*model.CollectionBatchResult, 0, end-start)
+		err := tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+			chunk = chunk[:0]
+			for _, collectionID := range collectionIDs[start:end] {
+				err := tc.txImpl.WithWriteTx(txCtx, func(collectionCtx context.Context) error {
+					return fn(collectionCtx, collectionID)
+				})
+				chunk = append(chunk, &model.CollectionBatchResult{ID: collectionID, Err: err})
+			}
+			return nil
+		})
+		if err != nil {
+			// nothing of the chunk was committed
+			log.Error("collection batch chunk failed", zap.Int("start", start), zap.Error(err))
+			chunk = chunk[:0]
+			for _, collectionID := range collectionIDs[start:end] {
+				chunk = append(chunk, &model.CollectionBatchResult{ID: collectionID, Err: err})
+			}
 		}
+		results = append(results, chunk...)
+	}
+	return results
+}
 
-		if segmentAndMetadata.Segment.CollectionID != nil {
-			segment.CollectionID = types.MustParse(*segmentAndMetadata.Segment.CollectionID)
-		} else {
-			segment.CollectionID = types.NilUniqueID()
+// BatchUpdateCollectionMetadata applies the same metadata patch to every
+// collection in collectionIDs. validate is called with the patched metadata of
+// each collection before it is written.
+func (tc *Catalog) BatchUpdateCollectionMetadata(ctx context.Context, collectionIDs []types.UniqueID, patch *model.CollectionMetadataPatch, validate func(*model.CollectionMetadata[model.CollectionMetadataValueType]) error) []*model.CollectionBatchResult {
+	return tc.forEachCollectionInChunks(ctx, collectionIDs, func(txCtx context.Context, collectionID types.UniqueID) error {
+		return tc.patchCollectionMetadata(txCtx, collectionID, patch, validate)
+	})
+}
+
+// DeleteCollections soft deletes the collections of a database. Soft deleted
+// collections are hidden from reads and left to the garbage collector.
+func (tc *Catalog) DeleteCollections(ctx context.Context, tenantID string, databaseName string, collectionIDs []types.UniqueID, actor string) []*model.CollectionBatchResult {
+	results := tc.forEachCollectionInChunks(ctx, collectionIDs, func(txCtx context.Context, collectionID types.UniqueID) error {
+		return tc.softDeleteCollection(txCtx, tenantID, databaseName, collectionID, actor)
+	})
+	deleted := int64(0)
+	for _, result := range results {
+		if result.Err == nil {
+			deleted++
 		}
-		segment.Metadata = convertSegmentMetadataToModel(segmentAndMetadata.SegmentMetadata)
-		segments = append(segments, segment)
 	}
-	return segments, nil
+	tc.emitLifecycle(ctx, dbmodel.LifecycleEventDeleted, deleted)
+	return results
 }
 
-func (tc *Catalog) DeleteSegment(ctx context.Context, segmentID types.UniqueID) error {
-	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
-		segment, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(segmentID, nil, nil, types.NilUniqueID())
+// RestoreCollection undoes the soft delete of a collection that was not purged
+// yet. The collection gets its original name back, or name when it is set, e.g.
+// because a new collection took the original name in the meantime. Its size is
+// counted in the usage of its tenant again from its next compaction.
+func (tc *Catalog) RestoreCollection(ctx context.Context, collectionID types.UniqueID, name *string) (*model.Collection, error) {
+	var restored []*dbmodel.CollectionAndMetadata
+	err := tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		dbCollectionID := dbmodel.NewCollectionID(collectionID)
+		collection, err := tc.metaDomain.CollectionDb(txCtx).GetDeletedCollection(dbCollectionID)
 		if err != nil {
 			return err
 		}
-		if len(segment) == 0 {
-			return common.ErrSegmentDeleteNonExistingSegment
+		if collection == nil {
+			return common.ErrCollectionNotFound
 		}
-
-		err = tc.metaDomain.SegmentDb(txCtx).DeleteSegmentByID(segmentID.String())
-		if err != nil {
-			log.Error("error deleting segment", zap.Error(err))
-			return err
+		restoredName := ""
+		if collection.Name != nil {
+			restoredName = dbmodel.OriginalCollectionName(*collection.Name, dbCollectionID)
 		}
-		err = tc.metaDomain.SegmentMetadataDb(txCtx).DeleteBySegmentID(segmentID.String())
-		if err != nil {
-			log.Error("error deleting segment metadata", zap.Error(err))
-			return err
+		if name != nil {
+			restoredName = *name
 		}
-		return nil
-	})
-}
-
-func (tc *Catalog) UpdateSegment(ctx context.Context, updateSegment *model.UpdateSegment, ts types.Timestamp) (*model.Segment, error) {
-	var result *model.Segment
-
-	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
-		// TODO: we should push in collection_id here, add a GET to fix test for now
-		if updateSegment.Collection == nil {
-			results, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(updateSegment.ID, nil, nil, types.NilUniqueID())
+		if tc.maxCollectionsPerDatabase > 0 {
+			err = tc.checkCollectionLimit(txCtx, collection.DatabaseID)
 			if err != nil {
 				return err
 			}
-			if results == nil || len(results) == 0 {
-				return common.ErrSegmentUpdateNonExistingSegment
-			}
-			if results != nil && len(results) > 1 {
-				// TODO: fix this error
-				return common.ErrInvalidCollectionUpdate
-			}
-			updateSegment.Collection = results[0].Segment.CollectionID
-		}
-
-		// update segment
-		dbSegment := &dbmodel.UpdateSegment{
-			ID:              updateSegment.ID.String(),
-			Collection:      updateSegment.Collection,
-			ResetCollection: updateSegment.ResetCollection,
 		}
 
-		err := tc.metaDomain.SegmentDb(txCtx).Update(dbSegment)
+		segments, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(types.NilUniqueID(), nil, nil, collectionID, nil, nil)
 		if err != nil {
 			return err
 		}
-
+		scopes := make([]string, 0, len(segments))
+		for _, segment := range segments {
+			scopes = append(scopes, segment.Segment.Scope)
+		}
+		state := collectionStateForScopes(scopes, model.CollectionStateDegraded)
+		updated, err := tc.metaDomain.CollectionDb(txCtx).RestoreCollectionByID(dbCollectionID, restoredName, string(state))
+		if err != nil {
+			return err
+		}
+		if updated == 0 {
+			return common.ErrCollectionNotFound
+		}
+		err = tc.applyCollectionUsage(txCtx, collection.TenantID, collection, 1)
+		if err != nil {
+			return err
+		}
+		// the collection is back for the consumers that saw its delete
+		err = tc.metaDomain.NotificationDb(txCtx).Insert(&dbmodel.Notification{
+			CollectionID: dbCollectionID,
+			Type:         dbmodel.NotificationTypeCreateCollection,
+			Status:       dbmodel.NotificationStatusPending,
+		})
+		if err != nil {
+			return err
+		}
+		err = tc.recordLifecycle(txCtx, collection.TenantID, dbmodel.LifecycleEventRestored, 1)
+		if err != nil {
+			return err
+		}
+		restored, err = tc.metaDomain.CollectionDb(txCtx).GetCollections(&dbCollectionID, nil, "", "", nil, nil, nil)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	tc.emitLifecycle(ctx, dbmodel.LifecycleEventRestored, 1)
+	log.Info("collection restored", zap.String("collectionID", collectionID.String()))
+	return convertCollectionToModel(restored)[0], nil
+}
+
+// GetDeletedCollectionIDs returns up to limit collections soft deleted before
+// deletedBefore, the longest deleted first.
+func (tc *Catalog) GetDeletedCollectionIDs(ctx context.Context, deletedBefore time.Time, limit int32) ([]types.UniqueID, error) {
+	var collectionIDs []dbmodel.CollectionID
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		collectionIDs, err = tc.metaDomain.CollectionDb(txCtx).GetDeletedCollectionIDs(deletedBefore, limit)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]types.UniqueID, 0, len(collectionIDs))
+	for _, collectionID := range collectionIDs {
+		ids = append(ids, collectionID.UniqueID())
+	}
+	return ids, nil
+}
+
+// PurgeDeletedCollection purges a collection that was soft deleted before
+// deletedBefore. It fails with ErrCollectionNotFound when the collection is not
+// soft deleted, e.g. because it was restored in the meantime, or was deleted
+// later.
+func (tc *Catalog) PurgeDeletedCollection(ctx context.Context, collectionID types.UniqueID, deletedBefore time.Time, actor string) error {
+	err := tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		dbCollectionID := dbmodel.NewCollectionID(collectionID)
+		collection, err := tc.metaDomain.CollectionDb(txCtx).GetDeletedCollection(dbCollectionID)
+		if err != nil {
+			return err
+		}
+		if collection == nil || collection.DeletedAt == nil || !collection.DeletedAt.Before(deletedBefore) {
+			return common.ErrCollectionNotFound
+		}
+		metadata, err := tc.metaDomain.CollectionMetadataDb(txCtx).GetByCollectionID(dbCollectionID)
+		if err != nil {
+			return err
+		}
+		err = tc.purgeCollection(txCtx, &dbmodel.CollectionAndMetadata{
+			Collection:         collection,
+			CollectionMetadata: metadata,
+			TenantID:           collection.TenantID,
+		}, dbmodel.ArchiveReasonSoftDeleteExpired, actor)
+		if err != nil {
+			return err
+		}
+		// the failures of earlier attempts are moot once the collection is gone
+		_, err = tc.metaDomain.CleanupDeadLetterDb(txCtx).Delete(dbCollectionID, dbmodel.CleanupTaskPurge)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	tc.emitLifecycle(ctx, dbmodel.LifecycleEventPurged, 1)
+	log.Info("soft deleted collection purged", zap.String("collectionID", collectionID.String()), zap.String("actor", actor))
+	return nil
+}
+
+// RecordCleanupFailure counts a failed attempt of a cleanup task on a
+// collection and returns its entry, dead lettered once the task failed
+// maxAttempts times.
+func (tc *Catalog) RecordCleanupFailure(ctx context.Context, collectionID types.UniqueID, task string, cause error, maxAttempts int32) (*model.CleanupDeadLetter, error) {
+	var entry *dbmodel.CleanupDeadLetter
+	err := tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		dbCollectionID := dbmodel.NewCollectionID(collectionID)
+		tenantID := ""
+		collection, err := tc.metaDomain.CollectionDb(txCtx).GetDeletedCollection(dbCollectionID)
+		if err != nil {
+			return err
+		}
+		if collection != nil {
+			tenantID = collection.TenantID
+		}
+		entry, err = tc.metaDomain.CleanupDeadLetterDb(txCtx).RecordFailure(dbCollectionID, task, tenantID, cause.Error(), time.Now(), maxAttempts)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertCleanupDeadLetterToModel(entry), nil
+}
+
+// ListCleanupDeadLetters returns the failing cleanup tasks, only the dead
+// lettered ones when deadLetteredOnly is set, the most recently failed first.
+func (tc *Catalog) ListCleanupDeadLetters(ctx context.Context, task string, deadLetteredOnly bool) ([]*model.CleanupDeadLetter, error) {
+	var entries []*dbmodel.CleanupDeadLetter
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		entries, err = tc.metaDomain.CleanupDeadLetterDb(txCtx).List(task, deadLetteredOnly)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.CleanupDeadLetter, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, convertCleanupDeadLetterToModel(entry))
+	}
+	return result, nil
+}
+
+// DeleteCleanupDeadLetter forgets the failures of a cleanup task on a
+// collection, so that the task tries the collection again.
+func (tc *Catalog) DeleteCleanupDeadLetter(ctx context.Context, collectionID types.UniqueID, task string) error {
+	return tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		deleted, err := tc.metaDomain.CleanupDeadLetterDb(txCtx).Delete(dbmodel.NewCollectionID(collectionID), task)
+		if err != nil {
+			return err
+		}
+		if deleted == 0 {
+			return common.ErrCleanupDeadLetterNotFound
+		}
+		return nil
+	})
+}
+
+func (tc *Catalog) softDeleteCollection(txCtx context.Context, tenantID string, databaseName string, collectionID types.UniqueID, actor string) error {
+	collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(dbmodel.CollectionIDFromUniqueID(collectionID), nil, tenantID, databaseName, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	if len(collections) == 0 {
+		return common.ErrCollectionDeleteNonExistingCollection
+	}
+	// the collection keeps its size, so that it is counted again when it is
+	// restored
+	collection, err := tc.metaDomain.CollectionDb(txCtx).GetForUpdate(dbmodel.NewCollectionID(collectionID))
+	if err != nil {
+		return err
+	}
+	if collection == nil {
+		return common.ErrCollectionDeleteNonExistingCollection
+	}
+	err = tc.applyCollectionUsage(txCtx, collections[0].TenantID, collection, -1)
+	if err != nil {
+		return err
+	}
+	deleted, err := tc.metaDomain.CollectionDb(txCtx).SoftDeleteCollectionByID(dbmodel.NewCollectionID(collectionID), actor)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return common.ErrCollectionDeleteNonExistingCollection
+	}
+	notificationRecord := &dbmodel.Notification{
+		CollectionID: dbmodel.NewCollectionID(collectionID),
+		Type:         dbmodel.NotificationTypeDeleteCollection,
+		Status:       dbmodel.NotificationStatusPending,
+	}
+	err = tc.metaDomain.NotificationDb(txCtx).Insert(notificationRecord)
+	if err != nil {
+		return err
+	}
+	return tc.recordLifecycle(txCtx, collections[0].TenantID, dbmodel.LifecycleEventDeleted, 1)
+}
+
+func (tc *Catalog) patchCollectionMetadata(txCtx context.Context, collectionID types.UniqueID, patch *model.CollectionMetadataPatch, validate func(*model.CollectionMetadata[model.CollectionMetadataValueType]) error) error {
+	dbCollectionID := dbmodel.NewCollectionID(collectionID)
+	current, err := tc.metaDomain.CollectionDb(txCtx).GetForUpdate(dbCollectionID)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return common.ErrCollectionNotFound
+	}
+	currentMetadata, err := tc.metaDomain.CollectionMetadataDb(txCtx).GetByCollectionID(dbCollectionID)
+	if err != nil {
+		return err
+	}
+	previous := convertCollectionMetadataToModel(currentMetadata)
+	metadata, err := keepDistanceFunction(current, patch.Apply(previous))
+	if err != nil {
+		return err
+	}
+	if err := validate(metadata); err != nil {
+		return err
+	}
+	if sameCollectionMetadata(previous, metadata) {
+		return nil
+	}
+	_, err = tc.metaDomain.CollectionMetadataDb(txCtx).DeleteByCollectionID(dbCollectionID)
+	if err != nil {
+		return err
+	}
+	dbCollectionMetadataList := convertCollectionMetadataToDB(dbCollectionID, current.TenantID, metadata)
+	if len(dbCollectionMetadataList) != 0 {
+		err = tc.metaDomain.CollectionMetadataDb(txCtx).Insert(dbCollectionMetadataList)
+		if err != nil {
+			return err
+		}
+	}
+	err = tc.metaDomain.CollectionDb(txCtx).Update(&dbmodel.Collection{ID: dbCollectionID, Revision: current.Revision + 1})
+	if err != nil {
+		return err
+	}
+	err = tc.notifyCollectionUpdate(txCtx, dbCollectionID)
+	if err != nil {
+		return err
+	}
+	return tc.recordMetadataHistory(txCtx, current, currentMetadata, dbCollectionMetadataList)
+}
+
+// keepDistanceFunction returns the metadata that replaces the metadata of a
+// collection with the hnsw:space of the collection, so that it keeps matching
+// the distance_function column. Metadata that leaves hnsw:space out gets it
+// back, and changing it is rejected since the index of the collection was built
+// for its distance function.
+func keepDistanceFunction(current *dbmodel.Collection, metadata *model.CollectionMetadata[model.CollectionMetadataValueType]) (*model.CollectionMetadata[model.CollectionMetadataValueType], error) {
+	if metadata == nil {
+		metadata = model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	}
+	switch space := metadata.Get(model.DistanceFunctionMetadataKey).(type) {
+	case nil:
+		metadata.Add(model.DistanceFunctionMetadataKey, &model.CollectionMetadataValueStringType{Value: current.DistanceFunction})
+	case *model.CollectionMetadataValueStringType:
+		if space.Value != current.DistanceFunction {
+			return nil, fmt.Errorf("%w: %s cannot be changed from %q", common.ErrInvalidCollectionConfiguration, model.DistanceFunctionMetadataKey, current.DistanceFunction)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s must be a string", common.ErrInvalidCollectionConfiguration, model.DistanceFunctionMetadataKey)
+	}
+	return metadata, nil
+}
+
+// notifyCollectionUpdate adds the update of a collection to the outbox, in the
+// transaction of the update.
+func (tc *Catalog) notifyCollectionUpdate(txCtx context.Context, collectionID dbmodel.CollectionID) error {
+	return tc.metaDomain.NotificationDb(txCtx).Insert(&dbmodel.Notification{
+		CollectionID: collectionID,
+		Type:         dbmodel.NotificationTypeUpdateCollection,
+		Status:       dbmodel.NotificationStatusPending,
+	})
+}
+
+// recordMetadataHistory records the metadata of a collection before and after
+// the change of its metadata that moves it from its current revision to the
+// next. The metadata before is recorded in case the history of the collection
+// was started after its creation, it is kept when it is already recorded.
+func (tc *Catalog) recordMetadataHistory(txCtx context.Context, current *dbmodel.Collection, previous []*dbmodel.CollectionMetadata, next []*dbmodel.CollectionMetadata) error {
+	historyDb := tc.metaDomain.CollectionMetadataHistoryDb(txCtx)
+	err := historyDb.Record(current.ID, current.TenantID, current.Revision, previous)
+	if err != nil {
+		return err
+	}
+	return historyDb.Record(current.ID, current.TenantID, current.Revision+1, next)
+}
+
+// GetCollectionMetadataDiff returns the changes to the metadata of a collection
+// since revision. It fails with ErrCollectionRevisionNotInHistory when revision
+// is ahead of the collection or older than its recorded history.
+func (tc *Catalog) GetCollectionMetadataDiff(ctx context.Context, collectionID types.UniqueID, revision int64) (*model.CollectionMetadataDiff, error) {
+	var diff *model.CollectionMetadataDiff
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(dbmodel.CollectionIDFromUniqueID(collectionID), nil, "", "", nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		if len(collections) == 0 {
+			return common.ErrCollectionNotFound
+		}
+		current := collections[0]
+		if revision > current.Collection.Revision {
+			return common.ErrCollectionRevisionNotInHistory
+		}
+		metadata, found, err := tc.metaDomain.CollectionMetadataHistoryDb(txCtx).GetAt(current.Collection.ID, revision)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return common.ErrCollectionRevisionNotInHistory
+		}
+		diff = &model.CollectionMetadataDiff{
+			FromRevision: revision,
+			ToRevision:   current.Collection.Revision,
+			Changes:      model.DiffCollectionMetadata(convertCollectionMetadataToModel(metadata), convertCollectionMetadataToModel(current.CollectionMetadata)),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
+
+// markReindexIfNeeded records a pending reindex when the update changes the
+// dimension of a collection that already has one, or when it requests a reindex
+// explicitly. It must be called inside the UpdateCollection transaction, before
+// the new dimension is written.
+func (tc *Catalog) markReindexIfNeeded(txCtx context.Context, updateCollection *model.UpdateCollection) error {
+	collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(dbmodel.CollectionIDFromUniqueID(updateCollection.ID), nil, updateCollection.TenantID, updateCollection.DatabaseName, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	if len(collections) == 0 {
+		return common.ErrCollectionNotFound
+	}
+	current := collections[0].Collection.Dimension
+	dimensionChanged := current != nil && updateCollection.Dimension != nil && *current != *updateCollection.Dimension
+	if !dimensionChanged && !updateCollection.RequestReindex {
+		return nil
+	}
+	err = tc.metaDomain.CollectionDb(txCtx).MarkReindexPending(dbmodel.NewCollectionID(updateCollection.ID), current)
+	if err != nil {
+		return err
+	}
+	log.Info("collection marked for reindex", zap.String("collectionID", updateCollection.ID.String()), zap.Bool("dimensionChanged", dimensionChanged))
+	return nil
+}
+
+// CompleteCollectionReindex clears the reindex marker of a collection that was
+// reindexed at dimension. It is a no-op for collections without a marker.
+func (tc *Catalog) CompleteCollectionReindex(ctx context.Context, collectionID types.UniqueID, dimension *int32) error {
+	return tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(dbmodel.CollectionIDFromUniqueID(collectionID), nil, "", "", nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		if len(collections) == 0 {
+			return common.ErrCollectionNotFound
+		}
+		if !collections[0].Collection.ReindexPending {
+			return nil
+		}
+		updated, err := tc.metaDomain.CollectionDb(txCtx).ClearReindexPending(dbmodel.NewCollectionID(collectionID), dimension)
+		if err != nil {
+			return err
+		}
+		if updated == 0 {
+			return common.ErrCollectionReindexStale
+		}
+		log.Info("collection reindex completed", zap.String("collectionID", collectionID.String()))
+		return nil
+	})
+}
+
+func (tc *Catalog) CreateSegment(ctx context.Context, createSegment *model.CreateSegment, ts types.Timestamp) (*model.Segment, error) {
+	var result *model.Segment
+
+	err := tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		err := tc.insertSegment(txCtx, createSegment, "", ts)
+		if err != nil {
+			return err
+		}
+		if createSegment.CollectionID != types.NilUniqueID() {
+			err = tc.refreshCollectionState(txCtx, createSegment.CollectionID)
+			if err != nil {
+				return err
+			}
+		}
+		// get segment
+		segmentList, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(createSegment.ID, nil, nil, types.NilUniqueID(), nil, nil)
+		if err != nil {
+			log.Error("error getting segment", zap.Error(err))
+			return err
+		}
+		result = convertSegmentToModel(segmentList)[0]
+		return nil
+	})
+	if err != nil {
+		log.Error("error creating segment", zap.Error(err))
+		return nil, err
+	}
+	log.Info("segment created", zap.Any("segment", result))
+	return result, nil
+}
+
+// insertSegment inserts a segment and its metadata. It must be called inside a
+// transaction. An empty tenantID is looked up from the collection by the DAO.
+func (tc *Catalog) insertSegment(txCtx context.Context, createSegment *model.CreateSegment, tenantID string, ts types.Timestamp) error {
+	collectionID := dbmodel.NewCollectionID(createSegment.CollectionID)
+	dbSegment := &dbmodel.Segment{
+		ID:           dbmodel.NewSegmentID(createSegment.ID),
+		CollectionID: &collectionID,
+		Type:         createSegment.Type,
+		Scope:        createSegment.Scope,
+		Ts:           ts,
+		TenantID:     tenantID,
+	}
+	err := tc.metaDomain.SegmentDb(txCtx).Insert(dbSegment)
+	if err != nil {
+		log.Error("error inserting segment", zap.Error(err))
+		return err
+	}
+	// insert segment metadata
+	metadata := createSegment.Metadata
+	if metadata != nil {
+		dbSegmentMetadataList := convertSegmentMetadataToDB(createSegment.ID.String(), metadata)
+		if len(dbSegmentMetadataList) != 0 {
+			err = tc.metaDomain.SegmentMetadataDb(txCtx).Insert(dbSegmentMetadataList)
+			if err != nil {
+				log.Error("error inserting segment metadata", zap.Error(err))
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (tc *Catalog) GetSegments(ctx context.Context, segmentID types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID, limit *int32, offset *int32) ([]*model.Segment, error) {
+	var segmentAndMetadataList []*dbmodel.SegmentAndMetadata
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		segmentAndMetadataList, err = tc.metaDomain.SegmentDb(txCtx).GetSegments(segmentID, segmentType, scope, collectionID, limit, offset)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	segments := make([]*model.Segment, 0, len(segmentAndMetadataList))
+	for _, segmentAndMetadata := range segmentAndMetadataList {
+		segment := &model.Segment{
+			ID:        segmentAndMetadata.Segment.ID.UniqueID(),
+			Type:      segmentAndMetadata.Segment.Type,
+			Scope:     segmentAndMetadata.Segment.Scope,
+			Ts:        segmentAndMetadata.Segment.Ts,
+			FilePaths: segmentAndMetadata.Segment.FilePaths,
+		}
+
+		if segmentAndMetadata.Segment.CollectionID != nil {
+			segment.CollectionID = segmentAndMetadata.Segment.CollectionID.UniqueID()
+		} else {
+			segment.CollectionID = types.NilUniqueID()
+		}
+		segment.Metadata = convertSegmentMetadataToModel(segmentAndMetadata.SegmentMetadata)
+		segments = append(segments, segment)
+	}
+	return segments, nil
+}
+
+func (tc *Catalog) DeleteSegment(ctx context.Context, segmentID types.UniqueID, reason string, actor string) error {
+	return tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		segment, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(segmentID, nil, nil, types.NilUniqueID(), nil, nil)
+		if err != nil {
+			return err
+		}
+		if len(segment) == 0 {
+			return common.ErrSegmentDeleteNonExistingSegment
+		}
+
+		if reason == "" {
+			reason = dbmodel.ArchiveReasonDeleteSegment
+		}
+		err = tc.metaDomain.ArchiveDb(txCtx).ArchiveSegment(segment[0], reason, actor)
+		if err != nil {
+			return err
+		}
+
+		err = tc.metaDomain.SegmentDb(txCtx).DeleteSegmentByID(dbmodel.NewSegmentID(segmentID))
+		if err != nil {
+			log.Error("error deleting segment", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.SegmentMetadataDb(txCtx).DeleteBySegmentID(dbmodel.NewSegmentID(segmentID))
+		if err != nil {
+			log.Error("error deleting segment metadata", zap.Error(err))
+			return err
+		}
+		err = tc.metaDomain.SegmentFileDb(txCtx).DeleteBySegmentID(dbmodel.NewSegmentID(segmentID))
+		if err != nil {
+			log.Error("error deleting segment files", zap.Error(err))
+			return err
+		}
+		if segment[0].Segment.CollectionID != nil {
+			err = tc.refreshCollectionState(txCtx, segment[0].Segment.CollectionID.UniqueID())
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// requiredSegmentScopes are the segment scopes a collection needs to be ready.
+var requiredSegmentScopes = []string{"VECTOR", "METADATA"}
+
+// collectionStateForScopes returns ready when scopes cover every required segment
+// scope and incomplete otherwise.
+func collectionStateForScopes(scopes []string, incomplete model.CollectionState) model.CollectionState {
+	for _, required := range requiredSegmentScopes {
+		found := false
+		for _, scope := range scopes {
+			if scope == required {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return incomplete
+		}
+	}
+	return model.CollectionStateReady
+}
+
+// refreshCollectionState recomputes the state of a collection from its segments
+// after a segment was created or deleted. Creating and degraded collections become
+// ready once they have all required segments, and ready collections become degraded
+// when they lose one. It must be called inside a transaction.
+func (tc *Catalog) refreshCollectionState(txCtx context.Context, collectionID types.UniqueID) error {
+	segments, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(types.NilUniqueID(), nil, nil, collectionID, nil, nil)
+	if err != nil {
+		return err
+	}
+	scopes := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		scopes = append(scopes, segment.Segment.Scope)
+	}
+	var fromStates []string
+	state := collectionStateForScopes(scopes, model.CollectionStateDegraded)
+	if state == model.CollectionStateReady {
+		fromStates = []string{string(model.CollectionStateCreating), string(model.CollectionStateDegraded)}
+	} else {
+		fromStates = []string{string(model.CollectionStateReady)}
+	}
+	updated, err := tc.metaDomain.CollectionDb(txCtx).UpdateState(dbmodel.NewCollectionID(collectionID), fromStates, string(state))
+	if err != nil {
+		return err
+	}
+	if updated > 0 {
+		log.Info("collection state changed", zap.String("collectionID", collectionID.String()), zap.String("state", string(state)))
+	}
+	return nil
+}
+
+func (tc *Catalog) UpdateSegment(ctx context.Context, updateSegment *model.UpdateSegment, ts types.Timestamp) (*model.Segment, error) {
+	var result *model.Segment
+
+	err := tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		// TODO: we should push in collection_id here, add a GET to fix test for now
+		if updateSegment.Collection == nil {
+			results, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(updateSegment.ID, nil, nil, types.NilUniqueID(), nil, nil)
+			if err != nil {
+				return err
+			}
+			if results == nil || len(results) == 0 {
+				return common.ErrSegmentUpdateNonExistingSegment
+			}
+			if results != nil && len(results) > 1 {
+				// TODO: fix this error
+				return common.ErrInvalidCollectionUpdate
+			}
+			if results[0].Segment.CollectionID != nil {
+				collection := results[0].Segment.CollectionID.String()
+				updateSegment.Collection = &collection
+			}
+		}
+
+		// update segment
+		var collectionID *dbmodel.CollectionID
+		if updateSegment.Collection != nil {
+			id := dbmodel.CollectionID(*updateSegment.Collection)
+			collectionID = &id
+		}
+		dbSegment := &dbmodel.UpdateSegment{
+			ID:              dbmodel.NewSegmentID(updateSegment.ID),
+			Collection:      collectionID,
+			ResetCollection: updateSegment.ResetCollection,
+		}
+
+		err := tc.metaDomain.SegmentDb(txCtx).Update(dbSegment)
+		if err != nil {
+			return err
+		}
+
 		// Case 1: if ResetMetadata is true, then delete all metadata for the collection
 		// Case 2: if ResetMetadata is true and metadata is not nil -> THIS SHOULD NEVER HAPPEN
 		// Case 3: if ResetMetadata is false, and the metadata is not nil - set the metadata to the value in metadata
@@ -555,96 +2001,1370 @@ func (tc *Catalog) UpdateSegment(ctx context.Context, updateSegment *model.Updat
 			if metadata != nil { // Case 2
 				return common.ErrInvalidMetadataUpdate
 			} else { // Case 1
-				err := tc.metaDomain.SegmentMetadataDb(txCtx).DeleteBySegmentID(updateSegment.ID.String())
+				err := tc.metaDomain.SegmentMetadataDb(txCtx).DeleteBySegmentID(dbmodel.NewSegmentID(updateSegment.ID))
+				if err != nil {
+					return err
+				}
+			}
+		} else {
+			if metadata != nil { // Case 3
+				err := tc.metaDomain.SegmentMetadataDb(txCtx).DeleteBySegmentIDAndKeys(dbmodel.NewSegmentID(updateSegment.ID), metadata.Keys())
 				if err != nil {
+					log.Error("error deleting segment metadata", zap.Error(err))
 					return err
 				}
+				newMetadata := model.NewSegmentMetadata[model.SegmentMetadataValueType]()
+				for _, key := range metadata.Keys() {
+					if metadata.Get(key) == nil {
+						metadata.Remove(key)
+					} else {
+						newMetadata.Set(key, metadata.Get(key))
+					}
+				}
+				dbSegmentMetadataList := convertSegmentMetadataToDB(updateSegment.ID.String(), newMetadata)
+				if len(dbSegmentMetadataList) != 0 {
+					err = tc.metaDomain.SegmentMetadataDb(txCtx).Insert(dbSegmentMetadataList)
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		// get segment
+		segmentList, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(updateSegment.ID, nil, nil, types.NilUniqueID(), nil, nil)
+		if err != nil {
+			log.Error("error getting segment", zap.Error(err))
+			return err
+		}
+		result = convertSegmentToModel(segmentList)[0]
+		return nil
+	})
+	if err != nil {
+		log.Error("error updating segment", zap.Error(err))
+		return nil, err
+	}
+	log.Debug("segment updated", zap.Any("segment", result))
+	return result, nil
+}
+
+func (tc *Catalog) SetTenantLastCompactionTime(ctx context.Context, tenantID string, lastCompactionTime int64) error {
+	return tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		return tc.metaDomain.TenantDb(txCtx).UpdateTenantLastCompactionTime(tenantID, lastCompactionTime)
+	})
+}
+
+// SetTenantsLastCompactionTime is SetTenantLastCompactionTime for several
+// tenants in one statement. The tenants that do not exist are skipped.
+func (tc *Catalog) SetTenantsLastCompactionTime(ctx context.Context, lastCompactionTimes map[string]int64) error {
+	return tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		return tc.metaDomain.TenantDb(txCtx).UpdateTenantsLastCompactionTime(lastCompactionTimes)
+	})
+}
+
+func (tc *Catalog) GetTenantsLastCompactionTime(ctx context.Context, tenantIDs []string) ([]*dbmodel.Tenant, error) {
+	var tenants []*dbmodel.Tenant
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		tenants, err = tc.metaDomain.TenantDb(txCtx).GetTenantsLastCompactionTime(tenantIDs)
+		return err
+	})
+	return tenants, err
+}
+
+func (tc *Catalog) FlushCollectionCompaction(ctx context.Context, flushCollectionCompaction *model.FlushCollectionCompaction) (*model.FlushCollectionInfo, error) {
+	flushCollectionInfo := &model.FlushCollectionInfo{
+		ID: flushCollectionCompaction.ID.String(),
+	}
+	var compactedRecordsCount int64
+
+	// The flush runs serializable so that it cannot interleave with concurrent
+	// metadata updates of the collection. Conflicts are retried by the coordinator.
+	err := tc.txImpl.WithWriteTxIsolation(ctx, sql.LevelSerializable, func(txCtx context.Context) error {
+		// reject flushes from a node that lost ownership of the collection
+		if flushCollectionCompaction.FencingToken != nil {
+			err := tc.metaDomain.CollectionDb(txCtx).CheckFencingToken(dbmodel.NewCollectionID(flushCollectionCompaction.ID), *flushCollectionCompaction.FencingToken)
+			if err != nil {
+				return err
+			}
+		}
+
+		// register files to Segment metadata
+		err := tc.metaDomain.SegmentDb(txCtx).RegisterFilePaths(flushCollectionCompaction.FlushSegmentCompactions)
+		if err != nil {
+			return err
+		}
+		for _, flushSegmentCompaction := range flushCollectionCompaction.FlushSegmentCompactions {
+			files := convertFlushSegmentCompactionToSegmentFiles(flushCollectionCompaction, flushSegmentCompaction)
+			err = tc.metaDomain.SegmentFileDb(txCtx).ReplaceBySegmentID(dbmodel.NewSegmentID(flushSegmentCompaction.ID), files)
+			if err != nil {
+				return err
+			}
+		}
+
+		// update collection log position and version
+		collectionVersion, compactedRecords, err := tc.metaDomain.CollectionDb(txCtx).UpdateLogPositionAndVersion(dbmodel.NewCollectionID(flushCollectionCompaction.ID), flushCollectionCompaction.LogPosition, flushCollectionCompaction.CurrentCollectionVersion)
+		if err != nil {
+			return err
+		}
+		flushCollectionInfo.CollectionVersion = collectionVersion
+
+		compactedRecordsCount = compactedRecords
+
+		// the flush serves the compaction requests made before the compaction
+		// started, the later ones wait for the next compaction
+		if flushCollectionCompaction.CompactionStartedAt != nil {
+			_, err = tc.metaDomain.CompactionRequestDb(txCtx).DeleteRequestedBefore(dbmodel.NewCollectionID(flushCollectionCompaction.ID), *flushCollectionCompaction.CompactionStartedAt)
+		} else {
+			_, err = tc.metaDomain.CompactionRequestDb(txCtx).DeleteByCollectionID(dbmodel.NewCollectionID(flushCollectionCompaction.ID))
+		}
+		if err != nil {
+			return err
+		}
+
+		if flushCollectionCompaction.CompactorID != nil {
+			err = tc.metaDomain.CollectionDb(txCtx).UpdateLastCompactor(dbmodel.NewCollectionID(flushCollectionCompaction.ID), *flushCollectionCompaction.CompactorID)
+			if err != nil {
+				return err
+			}
+		}
+
+		// update collection size reported by the compactor and the usage counters
+		change, err := tc.metaDomain.CollectionDb(txCtx).UpdateCollectionSize(dbmodel.NewCollectionID(flushCollectionCompaction.ID), flushCollectionCompaction.TotalRecordsPostCompaction, flushCollectionCompaction.SizeBytesPostCompaction)
+		if err != nil {
+			return err
+		}
+		err = tc.applyCollectionSizeChange(txCtx, flushCollectionCompaction.TenantID, change)
+		if err != nil {
+			return err
+		}
+
+		// update tenant last compaction time
+		// TODO: add a system configuration to disable
+		// since this might cause resource contention if one tenant has a lot of collection compactions at the same time
+		lastCompactionTime := time.Now().Unix()
+		err = tc.metaDomain.TenantDb(txCtx).UpdateTenantLastCompactionTime(flushCollectionCompaction.TenantID, lastCompactionTime)
+		if err != nil {
+			return err
+		}
+		flushCollectionInfo.TenantLastCompactionTime = lastCompactionTime
+
+		// return nil will commit the transaction
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	tc.recordCompactionActivity(ctx, flushCollectionCompaction.TenantID, compactedRecordsCount)
+	return flushCollectionInfo, nil
+}
+
+// applyCollectionSizeChange adds the change in size of a collection to the usage
+// counters of its database and tenant. It must be called inside the transaction
+// that changed the size.
+func (tc *Catalog) applyCollectionSizeChange(txCtx context.Context, tenantID string, change *dbmodel.CollectionSizeChange) error {
+	if change.Records == 0 && change.SizeBytes == 0 {
+		return nil
+	}
+	err := tc.metaDomain.UsageCounterDb(txCtx).Increment(tenantID, change.DatabaseID.String(), change.Records, change.SizeBytes)
+	if err != nil {
+		return err
+	}
+	return tc.metaDomain.UsageCounterDb(txCtx).Increment(tenantID, "", change.Records, change.SizeBytes)
+}
+
+// releaseCollectionUsage zeroes the size of a collection that is being deleted
+// and takes it off the usage counters.
+func (tc *Catalog) releaseCollectionUsage(txCtx context.Context, tenantID string, collectionID dbmodel.CollectionID) error {
+	zero := int64(0)
+	change, err := tc.metaDomain.CollectionDb(txCtx).UpdateCollectionSize(collectionID, &zero, &zero)
+	if err != nil {
+		return err
+	}
+	return tc.applyCollectionSizeChange(txCtx, tenantID, change)
+}
+
+// applyCollectionUsage adds the size of a collection to the usage counters, or
+// takes it off them when sign is -1, without changing the size of the
+// collection.
+func (tc *Catalog) applyCollectionUsage(txCtx context.Context, tenantID string, collection *dbmodel.Collection, sign int64) error {
+	return tc.applyCollectionSizeChange(txCtx, tenantID, &dbmodel.CollectionSizeChange{
+		DatabaseID: collection.DatabaseID,
+		Records:    sign * collection.TotalRecordsPostCompaction,
+		SizeBytes:  sign * collection.SizeBytesPostCompaction,
+	})
+}
+
+func (tc *Catalog) AcquireCollectionFencingToken(ctx context.Context, collectionID types.UniqueID, owner string) (int64, error) {
+	var token int64
+	err := tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		var err error
+		token, err = tc.metaDomain.CollectionDb(txCtx).AcquireFencingToken(dbmodel.NewCollectionID(collectionID), owner)
+		return err
+	})
+	return token, err
+}
+
+func (tc *Catalog) GetCollectionsBySize(ctx context.Context, tenantID string, databaseName string, orderBy model.CollectionSizeOrderBy, limit int32) ([]*model.CollectionSize, error) {
+	var collections []*dbmodel.Collection
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		collections, err = tc.metaDomain.CollectionDb(txCtx).GetCollectionsBySize(tenantID, databaseName, orderBy, limit)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertCollectionSizeToModel(collections), nil
+}
+
+// GetCollectionsToGc returns a page of the collections last updated before
+// updatedBefore that match filter.
+func (tc *Catalog) GetCollectionsToGc(ctx context.Context, filter *model.CollectionsToGcFilter, updatedBefore time.Time) ([]*model.CollectionToGc, error) {
+	var startAfter *dbmodel.CollectionID
+	if filter.StartAfter != nil {
+		id := dbmodel.NewCollectionID(*filter.StartAfter)
+		startAfter = &id
+	}
+	var collections []*dbmodel.Collection
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		collections, err = tc.metaDomain.CollectionDb(txCtx).GetCollectionsToGc(updatedBefore, filter.TenantID, filter.MinVersions, startAfter, filter.Limit)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertCollectionToGcToModel(collections), nil
+}
+
+// StartCollectionScan holds a snapshot of the catalog for a scan of
+// ScanCollections and returns the cursor of its first batch. The snapshot is
+// released once the scan has not read from it on this replica for ttl.
+func (tc *Catalog) StartCollectionScan(ctx context.Context, ttl time.Duration) (*model.CollectionScanCursor, error) {
+	snapshot, err := tc.txImpl.HoldSnapshot(ctx, ttl)
+	if err != nil {
+		return nil, err
+	}
+	return &model.CollectionScanCursor{Snapshot: snapshot}, nil
+}
+
+// ScanCollections returns the next limit collections of the scan at cursor,
+// with their metadata and ACL, as they are in the snapshot of the scan.
+func (tc *Catalog) ScanCollections(ctx context.Context, cursor *model.CollectionScanCursor, limit int32) ([]*model.Collection, error) {
+	var startAfter *dbmodel.CollectionID
+	if cursor.After != nil {
+		id := dbmodel.NewCollectionID(*cursor.After)
+		startAfter = &id
+	}
+	var collectionAndMetadataList []*dbmodel.CollectionAndMetadata
+	var aclEntries []*dbmodel.CollectionAcl
+	err := tc.txImpl.WithSnapshotTx(ctx, cursor.Snapshot, func(txCtx context.Context) error {
+		var err error
+		collectionAndMetadataList, err = tc.metaDomain.CollectionDb(txCtx).ScanCollections(startAfter, limit)
+		if err != nil {
+			return err
+		}
+		aclEntries, err = tc.getCollectionAcl(txCtx, collectionAndMetadataList)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	collections := convertCollectionToModel(collectionAndMetadataList)
+	attachCollectionAcl(collections, aclEntries)
+	return collections, nil
+}
+
+// GetApproximateCounts returns cheap collection and record counts for dashboards.
+// The collection count is estimated from table statistics. Without a database it
+// covers the whole instance and the record count is left unset, otherwise the
+// record count is the usage counter of the database.
+func (tc *Catalog) GetApproximateCounts(ctx context.Context, tenantID string, databaseName string) (*model.ApproximateCounts, error) {
+	var counts *model.ApproximateCounts
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		if databaseName == "" {
+			collectionCount, err := tc.metaDomain.CollectionDb(txCtx).GetApproximateCollectionCount()
+			if err != nil {
+				return err
 			}
-		} else {
-			if metadata != nil { // Case 3
-				err := tc.metaDomain.SegmentMetadataDb(txCtx).DeleteBySegmentIDAndKeys(updateSegment.ID.String(), metadata.Keys())
-				if err != nil {
-					log.Error("error deleting segment metadata", zap.Error(err))
-					return err
-				}
-				newMetadata := model.NewSegmentMetadata[model.SegmentMetadataValueType]()
-				for _, key := range metadata.Keys() {
-					if metadata.Get(key) == nil {
-						metadata.Remove(key)
-					} else {
-						newMetadata.Set(key, metadata.Get(key))
-					}
-				}
-				dbSegmentMetadataList := convertSegmentMetadataToDB(updateSegment.ID.String(), newMetadata)
-				if len(dbSegmentMetadataList) != 0 {
-					err = tc.metaDomain.SegmentMetadataDb(txCtx).Insert(dbSegmentMetadataList)
-					if err != nil {
-						return err
-					}
-				}
+			counts = &model.ApproximateCounts{CollectionCount: collectionCount}
+			return nil
+		}
+		databases, err := tc.metaDomain.DatabaseDb(txCtx).GetDatabases(tenantID, databaseName)
+		if err != nil {
+			return err
+		}
+		if len(databases) == 0 {
+			counts = &model.ApproximateCounts{}
+			return nil
+		}
+		collectionCount, err := tc.metaDomain.CollectionDb(txCtx).GetApproximateDatabaseCollectionCount(databases[0].ID)
+		if err != nil {
+			return err
+		}
+		usage, err := tc.metaDomain.UsageCounterDb(txCtx).Get(tenantID, databases[0].ID.String())
+		if err != nil {
+			return err
+		}
+		counts = &model.ApproximateCounts{
+			CollectionCount: collectionCount,
+			RecordCount:     usage.RecordCount,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// CountCollections returns the exact number of collections of a tenant, or of
+// one of its databases.
+func (tc *Catalog) CountCollections(ctx context.Context, tenantID string, databaseName string, includeSoftDeleted bool) (int64, error) {
+	var count int64
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		count, err = tc.metaDomain.CollectionDb(txCtx).CountCollections(tenantID, databaseName, includeSoftDeleted)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// SetTenantQuota replaces the limits of an existing tenant.
+func (tc *Catalog) SetTenantQuota(ctx context.Context, quota *model.TenantQuota) error {
+	return tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		tenants, err := tc.metaDomain.TenantDb(txCtx).GetTenants(quota.TenantID)
+		if err != nil {
+			return err
+		}
+		if len(tenants) == 0 {
+			return common.ErrTenantNotFound
+		}
+		return tc.metaDomain.TenantQuotaDb(txCtx).Upsert(convertTenantQuotaToDB(quota))
+	})
+}
+
+// SetTenantPlacement replaces the allowed regions of an existing tenant.
+func (tc *Catalog) SetTenantPlacement(ctx context.Context, placement *model.TenantPlacement) error {
+	return tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		tenants, err := tc.metaDomain.TenantDb(txCtx).GetTenants(placement.TenantID)
+		if err != nil {
+			return err
+		}
+		if len(tenants) == 0 {
+			return common.ErrTenantNotFound
+		}
+		return tc.metaDomain.TenantRegionDb(txCtx).Replace(placement.TenantID, placement.AllowedRegions)
+	})
+}
+
+// GetTenantPlacement returns the allowed regions of a tenant, none when its
+// placement is unconstrained.
+func (tc *Catalog) GetTenantPlacement(ctx context.Context, tenantID string) (*model.TenantPlacement, error) {
+	var regions []*dbmodel.TenantRegion
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		regions, err = tc.metaDomain.TenantRegionDb(txCtx).GetByTenantID(tenantID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertTenantRegionsToModel(tenantID, regions), nil
+}
+
+// GetTenantActivity returns the days from from to to, both included, on which
+// the tenant had activity, oldest first.
+func (tc *Catalog) GetTenantActivity(ctx context.Context, tenantID string, from time.Time, to time.Time) ([]*model.TenantActivity, error) {
+	var counts []*dbmodel.CollectionLifecycleCount
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		tenants, err := tc.metaDomain.TenantDb(txCtx).GetTenants(tenantID)
+		if err != nil {
+			return err
+		}
+		if len(tenants) == 0 {
+			return common.ErrTenantNotFound
+		}
+		counts, err = tc.metaDomain.CollectionLifecycleDb(txCtx).GetCounts(tenantID, from, to)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.TenantActivity, 0, len(counts))
+	for _, count := range counts {
+		result = append(result, convertCollectionLifecycleCountToModel(count))
+	}
+	return result, nil
+}
+
+// CreateNodeDecommission starts the decommission of a node, unless it is already
+// being decommissioned. It returns the decommission in effect.
+func (tc *Catalog) CreateNodeDecommission(ctx context.Context, decommission *model.NodeDecommission) (*model.NodeDecommission, error) {
+	var result *dbmodel.NodeDecommission
+	err := tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		var err error
+		result, err = tc.metaDomain.NodeDecommissionDb(txCtx).Get(decommission.NodeID)
+		if err != nil || result != nil {
+			return err
+		}
+		result = &dbmodel.NodeDecommission{
+			NodeID:      decommission.NodeID,
+			DrainUntil:  decommission.DrainUntil,
+			Collections: decommission.Collections,
+			CreatedAt:   decommission.StartedAt,
+		}
+		return tc.metaDomain.NodeDecommissionDb(txCtx).Insert(result)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertNodeDecommissionToModel(result), nil
+}
+
+func (tc *Catalog) DeleteNodeDecommission(ctx context.Context, nodeID string) error {
+	return tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		deleted, err := tc.metaDomain.NodeDecommissionDb(txCtx).Delete(nodeID)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return common.ErrNodeDecommissionNotFound
+		}
+		return nil
+	})
+}
+
+func (tc *Catalog) GetNodeDecommissions(ctx context.Context) ([]*model.NodeDecommission, error) {
+	var decommissions []*dbmodel.NodeDecommission
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		decommissions, err = tc.metaDomain.NodeDecommissionDb(txCtx).GetAll()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.NodeDecommission, 0, len(decommissions))
+	for _, decommission := range decommissions {
+		result = append(result, convertNodeDecommissionToModel(decommission))
+	}
+	return result, nil
+}
+
+func (tc *Catalog) RecordNodeHeartbeat(ctx context.Context, nodeID string, at time.Time, expireBefore time.Time) error {
+	return tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		if err := tc.metaDomain.NodeHeartbeatDb(txCtx).Upsert(nodeID, at); err != nil {
+			return err
+		}
+		_, err := tc.metaDomain.NodeHeartbeatDb(txCtx).DeleteBefore(expireBefore)
+		return err
+	})
+}
+
+func (tc *Catalog) GetNodeHeartbeats(ctx context.Context) ([]*model.NodeHeartbeat, error) {
+	var heartbeats []*dbmodel.NodeHeartbeat
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		heartbeats, err = tc.metaDomain.NodeHeartbeatDb(txCtx).GetAll()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.NodeHeartbeat, 0, len(heartbeats))
+	for _, heartbeat := range heartbeats {
+		result = append(result, &model.NodeHeartbeat{NodeID: heartbeat.NodeID, LastSeen: heartbeat.LastSeen})
+	}
+	return result, nil
+}
+
+func (tc *Catalog) ListCollectionStats(ctx context.Context, tenantID string, databaseName string, limit int32, offset int32) ([]*model.CollectionStats, error) {
+	var stats []*dbmodel.CollectionStats
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		stats, err = tc.metaDomain.CollectionStatsDb(txCtx).List(tenantID, databaseName, limit, offset)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.CollectionStats, 0, len(stats))
+	for _, collectionStats := range stats {
+		result = append(result, convertCollectionStatsToModel(collectionStats))
+	}
+	return result, nil
+}
+
+func (tc *Catalog) GetCollectionSizeHistory(ctx context.Context, collectionID types.UniqueID, since time.Time) ([]*model.CollectionSizeSnapshot, error) {
+	var history []*dbmodel.CollectionSizeHistory
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		history, err = tc.metaDomain.CollectionSizeHistoryDb(txCtx).Get(dbmodel.NewCollectionID(collectionID), since)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.CollectionSizeSnapshot, 0, len(history))
+	for _, snapshot := range history {
+		result = append(result, &model.CollectionSizeSnapshot{
+			Day:          snapshot.Day.UTC(),
+			TotalRecords: snapshot.TotalRecords,
+			SizeBytes:    snapshot.SizeBytes,
+		})
+	}
+	return result, nil
+}
+
+// SetFeatureFlag replaces the default of a flag, or its override for an
+// existing tenant.
+func (tc *Catalog) SetFeatureFlag(ctx context.Context, flag *model.FeatureFlag) error {
+	return tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		if flag.TenantID != "" {
+			tenants, err := tc.metaDomain.TenantDb(txCtx).GetTenants(flag.TenantID)
+			if err != nil {
+				return err
 			}
+			if len(tenants) == 0 {
+				return common.ErrTenantNotFound
+			}
+		}
+		return tc.metaDomain.FeatureFlagDb(txCtx).Upsert(convertFeatureFlagToDB(flag))
+	})
+}
+
+// DeleteFeatureFlag deletes the default of a flag, or its override for a tenant.
+func (tc *Catalog) DeleteFeatureFlag(ctx context.Context, name string, tenantID string) error {
+	return tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		deleted, err := tc.metaDomain.FeatureFlagDb(txCtx).Delete(name, tenantID)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return common.ErrFeatureFlagNotFound
+		}
+		return nil
+	})
+}
+
+func (tc *Catalog) GetFeatureFlags(ctx context.Context) ([]*model.FeatureFlag, error) {
+	var flags []*dbmodel.FeatureFlag
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		flags, err = tc.metaDomain.FeatureFlagDb(txCtx).GetAll()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.FeatureFlag, 0, len(flags))
+	for _, flag := range flags {
+		result = append(result, convertFeatureFlagToModel(flag))
+	}
+	return result, nil
+}
+
+func (tc *Catalog) SetMaintenanceMode(ctx context.Context, mode *model.MaintenanceMode) error {
+	return tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		return tc.metaDomain.MaintenanceModeDb(txCtx).Upsert(&dbmodel.MaintenanceMode{
+			Enabled: mode.Enabled,
+			Reason:  mode.Reason,
+		})
+	})
+}
+
+// GetMaintenanceMode returns a disabled mode when maintenance was never set.
+func (tc *Catalog) GetMaintenanceMode(ctx context.Context) (*model.MaintenanceMode, error) {
+	var mode *dbmodel.MaintenanceMode
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		mode, err = tc.metaDomain.MaintenanceModeDb(txCtx).Get()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if mode == nil {
+		return &model.MaintenanceMode{}, nil
+	}
+	return &model.MaintenanceMode{
+		Enabled:   mode.Enabled,
+		Reason:    mode.Reason,
+		UpdatedAt: mode.UpdatedAt,
+	}, nil
+}
+
+func (tc *Catalog) EnqueueJob(ctx context.Context, job *model.Job) error {
+	return tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		return tc.metaDomain.JobDb(txCtx).Insert(&dbmodel.Job{
+			ID:          job.ID.String(),
+			Type:        job.Type,
+			TenantID:    job.TenantID,
+			Payload:     string(job.Payload),
+			State:       string(model.JobStatePending),
+			MaxAttempts: job.MaxAttempts,
+			NextRunAt:   job.NextRunAt,
+		})
+	})
+}
+
+func (tc *Catalog) GetJob(ctx context.Context, jobID types.UniqueID) (*model.Job, error) {
+	var job *dbmodel.Job
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		job, err = tc.metaDomain.JobDb(txCtx).Get(jobID.String())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if job == nil {
+		return nil, common.ErrJobNotFound
+	}
+	return convertJobToModel(job)
+}
+
+// ClaimJob holds the next due job of one of jobTypes for the worker until
+// lockedUntil, or returns nil when none is due.
+func (tc *Catalog) ClaimJob(ctx context.Context, jobTypes []string, workerID string, lockedUntil time.Time) (*model.Job, error) {
+	var job *dbmodel.Job
+	err := tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		var err error
+		job, err = tc.metaDomain.JobDb(txCtx).Claim(jobTypes, workerID, time.Now(), lockedUntil)
+		return err
+	})
+	if err != nil || job == nil {
+		return nil, err
+	}
+	return convertJobToModel(job)
+}
+
+// RenewJobLease fails with ErrJobLeaseLost when the lease of the worker expired
+// and another worker claimed the job.
+func (tc *Catalog) RenewJobLease(ctx context.Context, jobID types.UniqueID, workerID string, lockedUntil time.Time) error {
+	return tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		renewed, err := tc.metaDomain.JobDb(txCtx).RenewLease(jobID.String(), workerID, lockedUntil)
+		if err != nil {
+			return err
+		}
+		if !renewed {
+			return common.ErrJobLeaseLost
+		}
+		return nil
+	})
+}
+
+// FinishJob records the outcome of the attempt of the worker, and fails with
+// ErrJobLeaseLost when the worker no longer holds the job.
+func (tc *Catalog) FinishJob(ctx context.Context, jobID types.UniqueID, workerID string, outcome *model.JobOutcome) error {
+	return tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		finished, err := tc.metaDomain.JobDb(txCtx).Finish(jobID.String(), workerID, string(outcome.State), outcome.LastError, outcome.NextRunAt)
+		if err != nil {
+			return err
+		}
+		if !finished {
+			return common.ErrJobLeaseLost
+		}
+		return nil
+	})
+}
+
+func (tc *Catalog) ListJobs(ctx context.Context, filter *model.JobFilter) ([]*model.Job, error) {
+	var jobs []*dbmodel.Job
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		jobs, err = tc.metaDomain.JobDb(txCtx).List(filter.TenantID, filter.Type, string(filter.State), filter.Limit, filter.Offset)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.Job, 0, len(jobs))
+	for _, job := range jobs {
+		converted, err := convertJobToModel(job)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, converted)
+	}
+	return result, nil
+}
+
+// CancelJob cancels a pending or running job and returns it canceled. It fails
+// with ErrJobFinished when the job is over.
+func (tc *Catalog) CancelJob(ctx context.Context, jobID types.UniqueID) (*model.Job, error) {
+	var job *dbmodel.Job
+	err := tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		jobDb := tc.metaDomain.JobDb(txCtx)
+		canceled, err := jobDb.Cancel(jobID.String())
+		if err != nil {
+			return err
+		}
+		job, err = jobDb.Get(jobID.String())
+		if err != nil {
+			return err
+		}
+		if job == nil {
+			return common.ErrJobNotFound
+		}
+		if !canceled {
+			return common.ErrJobFinished
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertJobToModel(job)
+}
+
+func convertJobToModel(job *dbmodel.Job) (*model.Job, error) {
+	id, err := types.Parse(job.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &model.Job{
+		ID:          id,
+		Type:        job.Type,
+		TenantID:    job.TenantID,
+		Payload:     []byte(job.Payload),
+		State:       model.JobState(job.State),
+		Attempts:    job.Attempts,
+		MaxAttempts: job.MaxAttempts,
+		LastError:   job.LastError,
+		NextRunAt:   job.NextRunAt,
+		CreatedAt:   job.CreatedAt,
+		UpdatedAt:   job.UpdatedAt,
+		FinishedAt:  job.FinishedAt,
+	}, nil
+}
+
+// GetTenantQuota returns the limits of a tenant. A tenant without a quota gets
+// one without limits.
+func (tc *Catalog) GetTenantQuota(ctx context.Context, tenantID string) (*model.TenantQuota, error) {
+	var quota *dbmodel.TenantQuota
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		quota, err = tc.metaDomain.TenantQuotaDb(txCtx).Get(tenantID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if quota == nil {
+		return &model.TenantQuota{TenantID: tenantID}, nil
+	}
+	return convertTenantQuotaToModel(quota), nil
+}
+
+// GetTenantQuotaAndUsage returns the limits of a tenant together with its usage,
+// read in the same transaction.
+func (tc *Catalog) GetTenantQuotaAndUsage(ctx context.Context, tenantID string) (*model.TenantQuota, *model.QuotaUsage, error) {
+	var quota *dbmodel.TenantQuota
+	var usage *dbmodel.TenantUsage
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		quota, err = tc.metaDomain.TenantQuotaDb(txCtx).Get(tenantID)
+		if err != nil {
+			return err
 		}
+		usage, err = tc.metaDomain.TenantQuotaDb(txCtx).GetUsage(tenantID)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	result := &model.TenantQuota{TenantID: tenantID}
+	if quota != nil {
+		result = convertTenantQuotaToModel(quota)
+	}
+	return result, &model.QuotaUsage{
+		Collections: usage.CollectionCount,
+		Records:     usage.RecordCount,
+		SizeBytes:   usage.SizeBytes,
+	}, nil
+}
 
-		// get segment
-		segmentList, err := tc.metaDomain.SegmentDb(txCtx).GetSegments(updateSegment.ID, nil, nil, types.NilUniqueID())
+// SetDatabaseQuota replaces the limits an existing database overrides.
+func (tc *Catalog) SetDatabaseQuota(ctx context.Context, quota *model.DatabaseQuota) error {
+	return tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		database, err := tc.getDatabase(txCtx, quota.TenantID, quota.DatabaseName)
 		if err != nil {
-			log.Error("error getting segment", zap.Error(err))
 			return err
 		}
-		result = convertSegmentToModel(segmentList)[0]
-		return nil
+		return tc.metaDomain.DatabaseQuotaDb(txCtx).Upsert(convertDatabaseQuotaToDB(database.ID, quota))
+	})
+}
+
+// GetDatabaseQuota returns the limits of a tenant together with those one of its
+// databases overrides, read in the same transaction. A database that overrides
+// nothing gets a quota without limits.
+func (tc *Catalog) GetDatabaseQuota(ctx context.Context, tenantID string, databaseName string) (*model.TenantQuota, *model.DatabaseQuota, error) {
+	var tenantQuota *dbmodel.TenantQuota
+	var databaseQuota *dbmodel.DatabaseQuota
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		database, err := tc.getDatabase(txCtx, tenantID, databaseName)
+		if err != nil {
+			return err
+		}
+		tenantQuota, err = tc.metaDomain.TenantQuotaDb(txCtx).Get(tenantID)
+		if err != nil {
+			return err
+		}
+		databaseQuota, err = tc.metaDomain.DatabaseQuotaDb(txCtx).Get(database.ID)
+		return err
 	})
 	if err != nil {
-		log.Error("error updating segment", zap.Error(err))
+		return nil, nil, err
+	}
+	tenantResult := &model.TenantQuota{TenantID: tenantID}
+	if tenantQuota != nil {
+		tenantResult = convertTenantQuotaToModel(tenantQuota)
+	}
+	return tenantResult, convertDatabaseQuotaToModel(tenantID, databaseName, databaseQuota), nil
+}
+
+// GetDatabaseQuotaAndUsage returns the limits a database overrides together
+// with its usage, read in the same transaction.
+func (tc *Catalog) GetDatabaseQuotaAndUsage(ctx context.Context, tenantID string, databaseName string) (*model.DatabaseQuota, *model.QuotaUsage, error) {
+	var quota *dbmodel.DatabaseQuota
+	var usage *dbmodel.TenantUsage
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		database, err := tc.getDatabase(txCtx, tenantID, databaseName)
+		if err != nil {
+			return err
+		}
+		quota, err = tc.metaDomain.DatabaseQuotaDb(txCtx).Get(database.ID)
+		if err != nil {
+			return err
+		}
+		usage, err = tc.metaDomain.DatabaseQuotaDb(txCtx).GetUsage(tenantID, database.ID)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return convertDatabaseQuotaToModel(tenantID, databaseName, quota), &model.QuotaUsage{
+		Collections: usage.CollectionCount,
+		Records:     usage.RecordCount,
+		SizeBytes:   usage.SizeBytes,
+	}, nil
+}
+
+// GetCollectionQuotaAndUsage returns the limits of the template a collection of
+// a tenant was created from together with the size of the collection, or
+// ErrCollectionNotFound.
+func (tc *Catalog) GetCollectionQuotaAndUsage(ctx context.Context, tenantID string, collectionID types.UniqueID) (*model.CollectionQuota, *model.QuotaUsage, error) {
+	usage, err := tc.metaDomain.CollectionDb(ctx).GetTemplateUsage(dbmodel.NewCollectionID(collectionID), tenantID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if usage == nil {
+		return nil, nil, common.ErrCollectionNotFound
+	}
+	quota := &model.CollectionQuota{
+		CollectionID: collectionID,
+		MaxRecords:   usage.MaxRecords,
+		MaxSizeBytes: usage.MaxSizeBytes,
+	}
+	if usage.TemplateID != nil {
+		templateID := types.MustParse(*usage.TemplateID)
+		quota.TemplateID = &templateID
+	}
+	return quota, &model.QuotaUsage{
+		Records:   usage.TotalRecordsPostCompaction,
+		SizeBytes: usage.SizeBytesPostCompaction,
+	}, nil
+}
+
+// getDatabase returns a database of a tenant or ErrDatabaseNotFound. It must be
+// called inside a transaction.
+func (tc *Catalog) getDatabase(txCtx context.Context, tenantID string, databaseName string) (*dbmodel.Database, error) {
+	databases, err := tc.metaDomain.DatabaseDb(txCtx).GetDatabases(tenantID, databaseName)
+	if err != nil {
 		return nil, err
 	}
-	log.Debug("segment updated", zap.Any("segment", result))
+	if len(databases) == 0 {
+		return nil, common.ErrDatabaseNotFound
+	}
+	return databases[0], nil
+}
+
+func (tc *Catalog) SetCollectionLogRoute(ctx context.Context, collectionID types.UniqueID, logAddress string) (*model.CollectionLogRoute, error) {
+	var route *dbmodel.CollectionLogRoute
+	err := tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(dbmodel.CollectionIDFromUniqueID(collectionID), nil, "", "", nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		if len(collections) == 0 {
+			return common.ErrCollectionNotFound
+		}
+		route, err = tc.metaDomain.CollectionLogRouteDb(txCtx).Upsert(dbmodel.NewCollectionID(collectionID), logAddress)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertCollectionLogRouteToModel(route), nil
+}
+
+// GetCollectionLogRoutes returns a route for every one of the collections, in
+// the order given, and the revision they are at least as recent as. Collections
+// that were never routed get the default route.
+func (tc *Catalog) GetCollectionLogRoutes(ctx context.Context, collectionIDs []types.UniqueID) ([]*model.CollectionLogRoute, int64, error) {
+	var routes []*dbmodel.CollectionLogRoute
+	var revision int64
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		ids := make([]dbmodel.CollectionID, 0, len(collectionIDs))
+		for _, collectionID := range collectionIDs {
+			ids = append(ids, dbmodel.NewCollectionID(collectionID))
+		}
+		// the revision is read first, the routes may have changed since but not
+		// before it
+		var err error
+		revision, err = tc.metaDomain.CollectionLogRouteDb(txCtx).GetLastRevision()
+		if err != nil {
+			return err
+		}
+		routes, err = tc.metaDomain.CollectionLogRouteDb(txCtx).GetByCollectionIDs(ids)
+		return err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	byCollection := make(map[types.UniqueID]*model.CollectionLogRoute, len(routes))
+	for _, route := range routes {
+		byCollection[route.CollectionID.UniqueID()] = convertCollectionLogRouteToModel(route)
+	}
+	result := make([]*model.CollectionLogRoute, 0, len(collectionIDs))
+	for _, collectionID := range collectionIDs {
+		route, ok := byCollection[collectionID]
+		if !ok {
+			route = &model.CollectionLogRoute{CollectionID: collectionID}
+		}
+		result = append(result, route)
+	}
+	return result, revision, nil
+}
+
+func (tc *Catalog) GetCollectionLogRouteChanges(ctx context.Context, changedAfter int64) ([]*model.CollectionLogRoute, error) {
+	var routes []*dbmodel.CollectionLogRoute
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		routes, err = tc.metaDomain.CollectionLogRouteDb(txCtx).GetChangedAfter(changedAfter)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.CollectionLogRoute, 0, len(routes))
+	for _, route := range routes {
+		result = append(result, convertCollectionLogRouteToModel(route))
+	}
 	return result, nil
 }
 
-func (tc *Catalog) SetTenantLastCompactionTime(ctx context.Context, tenantID string, lastCompactionTime int64) error {
-	return tc.metaDomain.TenantDb(ctx).UpdateTenantLastCompactionTime(tenantID, lastCompactionTime)
+// CheckCollections returns the existence of every one of the collections, in
+// the order given.
+// GetCollectionDatabase returns the database of a collection, also when the
+// collection is soft deleted.
+func (tc *Catalog) GetCollectionDatabase(ctx context.Context, collectionID types.UniqueID) (*model.Database, error) {
+	var database *dbmodel.Database
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollectionsByIDs([]dbmodel.CollectionID{dbmodel.NewCollectionID(collectionID)})
+		if err != nil {
+			return err
+		}
+		if len(collections) == 0 {
+			return common.ErrCollectionNotFound
+		}
+		database, err = tc.metaDomain.DatabaseDb(txCtx).GetDatabaseByID(collections[0].DatabaseID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertDatabaseToModel(database), nil
 }
 
-func (tc *Catalog) GetTenantsLastCompactionTime(ctx context.Context, tenantIDs []string) ([]*dbmodel.Tenant, error) {
-	tenants, err := tc.metaDomain.TenantDb(ctx).GetTenantsLastCompactionTime(tenantIDs)
-	return tenants, err
+func (tc *Catalog) CheckCollections(ctx context.Context, collectionIDs []types.UniqueID) ([]*model.CollectionExistence, error) {
+	var collections []*dbmodel.Collection
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		ids := make([]dbmodel.CollectionID, 0, len(collectionIDs))
+		for _, collectionID := range collectionIDs {
+			ids = append(ids, dbmodel.NewCollectionID(collectionID))
+		}
+		var err error
+		collections, err = tc.metaDomain.CollectionDb(txCtx).GetCollectionsByIDs(ids)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	deleted := make(map[types.UniqueID]bool, len(collections))
+	for _, collection := range collections {
+		deleted[collection.ID.UniqueID()] = collection.IsDeleted
+	}
+	result := make([]*model.CollectionExistence, 0, len(collectionIDs))
+	for _, collectionID := range collectionIDs {
+		isDeleted, exists := deleted[collectionID]
+		result = append(result, &model.CollectionExistence{ID: collectionID, Exists: exists, IsDeleted: isDeleted})
+	}
+	return result, nil
 }
 
-func (tc *Catalog) FlushCollectionCompaction(ctx context.Context, flushCollectionCompaction *model.FlushCollectionCompaction) (*model.FlushCollectionInfo, error) {
-	flushCollectionInfo := &model.FlushCollectionInfo{
-		ID: flushCollectionCompaction.ID.String(),
+func (tc *Catalog) RequestCompaction(ctx context.Context, collectionID types.UniqueID, actor string) error {
+	return tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(dbmodel.CollectionIDFromUniqueID(collectionID), nil, "", "", nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		if len(collections) == 0 {
+			return common.ErrCollectionNotFound
+		}
+		return tc.metaDomain.CompactionRequestDb(txCtx).Insert(&dbmodel.CompactionRequest{
+			CollectionID: dbmodel.NewCollectionID(collectionID),
+			TenantID:     collections[0].TenantID,
+			RequestedBy:  actor,
+		})
+	})
+}
+
+func (tc *Catalog) GetCompactionRequests(ctx context.Context, limit int32) ([]*model.CompactionRequest, error) {
+	var requests []*dbmodel.CompactionRequest
+	var collections []*dbmodel.CollectionAndMetadata
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		requests, err = tc.metaDomain.CompactionRequestDb(txCtx).List(limit)
+		if err != nil {
+			return err
+		}
+		// the last compactor of every queued collection is read at once
+		collectionIDs := make([]dbmodel.CollectionID, 0, len(requests))
+		for _, request := range requests {
+			collectionIDs = append(collectionIDs, request.CollectionID)
+		}
+		collections, err = tc.metaDomain.CollectionDb(txCtx).GetLiveCollectionsByIDs(collectionIDs, false)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
+	return convertCompactionRequestsToModel(requests, collections), nil
+}
 
-	err := tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
-		// register files to Segment metadata
-		err := tc.metaDomain.SegmentDb(txCtx).RegisterFilePaths(flushCollectionCompaction.FlushSegmentCompactions)
+// GetDuplicateSegmentFiles returns the files of up to limit content hashes that
+// are shared by more than one file of tenantID, or of any tenant when empty.
+func (tc *Catalog) GetDuplicateSegmentFiles(ctx context.Context, tenantID string, limit int32) ([]*model.DuplicateSegmentFiles, error) {
+	var files []*dbmodel.SegmentFile
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		files, err = tc.metaDomain.SegmentFileDb(txCtx).GetDuplicates(tenantID, limit)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertDuplicateSegmentFilesToModel(files), nil
+}
+
+// GetCollectionSegmentFiles returns the files of the segments of a collection
+// with the number of files of other collections that share their content.
+func (tc *Catalog) GetCollectionSegmentFiles(ctx context.Context, collectionID types.UniqueID) ([]*model.SegmentFile, error) {
+	var files []*dbmodel.SegmentFileAndReferences
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(dbmodel.CollectionIDFromUniqueID(collectionID), nil, "", "", nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		if len(collections) == 0 {
+			return common.ErrCollectionNotFound
+		}
+		files, err = tc.metaDomain.SegmentFileDb(txCtx).GetByCollectionID(dbmodel.NewCollectionID(collectionID))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertSegmentFilesToModel(files), nil
+}
+
+// GetStorageAttribution returns the storage of the segment files of a tenant,
+// split between unique and shared bytes.
+func (tc *Catalog) GetStorageAttribution(ctx context.Context, tenantID string) (*model.StorageAttribution, error) {
+	var collections []*dbmodel.CollectionStorage
+	var distinctBytes int64
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		collections, distinctBytes, err = tc.metaDomain.SegmentFileDb(txCtx).GetTenantStorage(tenantID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertStorageAttributionToModel(tenantID, collections, distinctBytes), nil
+}
+
+// lockEmbeddingFunction checks that the embedding function exists and belongs
+// to the tenant, and keeps it from being deleted until the end of the
+// transaction. It returns the ID of the embedding function as stored.
+func (tc *Catalog) lockEmbeddingFunction(txCtx context.Context, id types.UniqueID, tenantID string) (*string, error) {
+	embeddingFunction, err := tc.metaDomain.EmbeddingFunctionDb(txCtx).GetForUpdate(id.String())
+	if err != nil {
+		return nil, err
+	}
+	if embeddingFunction == nil || embeddingFunction.TenantID != tenantID {
+		return nil, common.ErrEmbeddingFunctionNotFound
+	}
+	return &embeddingFunction.ID, nil
+}
+
+func (tc *Catalog) CreateEmbeddingFunction(ctx context.Context, embeddingFunction *model.EmbeddingFunction) (*model.EmbeddingFunction, error) {
+	var result *model.EmbeddingFunction
+	err := tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		tenants, err := tc.metaDomain.TenantDb(txCtx).GetTenants(embeddingFunction.TenantID)
+		if err != nil {
+			return err
+		}
+		if len(tenants) == 0 {
+			return common.ErrTenantNotFound
+		}
+		dbEmbeddingFunction := &dbmodel.EmbeddingFunction{
+			ID:       embeddingFunction.ID.String(),
+			TenantID: embeddingFunction.TenantID,
+			Name:     embeddingFunction.Name,
+			Provider: embeddingFunction.Provider,
+			Model:    embeddingFunction.Model,
+			Params:   embeddingFunction.Params,
+		}
+		err = tc.metaDomain.EmbeddingFunctionDb(txCtx).Insert(dbEmbeddingFunction)
+		if err != nil {
+			return err
+		}
+		created, err := tc.metaDomain.EmbeddingFunctionDb(txCtx).GetForUpdate(dbEmbeddingFunction.ID)
 		if err != nil {
 			return err
 		}
+		result = convertEmbeddingFunctionToModel(created)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Info("embedding function created", zap.String("id", result.ID.String()), zap.String("tenant", result.TenantID), zap.String("name", result.Name))
+	return result, nil
+}
 
-		// update collection log position and version
-		collectionVersion, err := tc.metaDomain.CollectionDb(txCtx).UpdateLogPositionAndVersion(flushCollectionCompaction.ID.String(), flushCollectionCompaction.LogPosition, flushCollectionCompaction.CurrentCollectionVersion)
+// GetEmbeddingFunctions returns the embedding functions matching the filters
+// that are set, every embedding function of the tenant when only tenantID is.
+func (tc *Catalog) GetEmbeddingFunctions(ctx context.Context, id types.UniqueID, tenantID string, name *string) ([]*model.EmbeddingFunction, error) {
+	var idFilter *string
+	if id != types.NilUniqueID() {
+		s := id.String()
+		idFilter = &s
+	}
+	var embeddingFunctions []*dbmodel.EmbeddingFunction
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		embeddingFunctions, err = tc.metaDomain.EmbeddingFunctionDb(txCtx).Get(idFilter, tenantID, name)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.EmbeddingFunction, 0, len(embeddingFunctions))
+	for _, embeddingFunction := range embeddingFunctions {
+		result = append(result, convertEmbeddingFunctionToModel(embeddingFunction))
+	}
+	return result, nil
+}
+
+// UpdateEmbeddingFunction changes the configuration shared by every collection
+// that references the embedding function. The collections are not marked for
+// reindexing, the caller requests it when the change alters the embeddings.
+func (tc *Catalog) UpdateEmbeddingFunction(ctx context.Context, update *model.UpdateEmbeddingFunction) (*model.EmbeddingFunction, error) {
+	var result *model.EmbeddingFunction
+	err := tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		embeddingFunctionDb := tc.metaDomain.EmbeddingFunctionDb(txCtx)
+		current, err := embeddingFunctionDb.GetForUpdate(update.ID.String())
 		if err != nil {
 			return err
 		}
-		flushCollectionInfo.CollectionVersion = collectionVersion
+		if current == nil {
+			return common.ErrEmbeddingFunctionNotFound
+		}
+		if update.Name != nil {
+			current.Name = *update.Name
+		}
+		if update.Provider != nil {
+			current.Provider = *update.Provider
+		}
+		if update.Model != nil {
+			current.Model = *update.Model
+		}
+		if update.Params != nil {
+			current.Params = *update.Params
+		}
+		err = embeddingFunctionDb.Update(current)
+		if err != nil {
+			return err
+		}
+		result = convertEmbeddingFunctionToModel(current)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
 
-		// update tenant last compaction time
-		// TODO: add a system configuration to disable
-		// since this might cause resource contention if one tenant has a lot of collection compactions at the same time
-		lastCompactionTime := time.Now().Unix()
-		err = tc.metaDomain.TenantDb(txCtx).UpdateTenantLastCompactionTime(flushCollectionCompaction.TenantID, lastCompactionTime)
+// DeleteEmbeddingFunction fails with ErrEmbeddingFunctionInUse while any
+// collection, including a soft deleted one, references the embedding function.
+func (tc *Catalog) DeleteEmbeddingFunction(ctx context.Context, id types.UniqueID) error {
+	return tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		// the lock keeps collections from starting to reference the embedding
+		// function while it is deleted
+		current, err := tc.metaDomain.EmbeddingFunctionDb(txCtx).GetForUpdate(id.String())
 		if err != nil {
 			return err
 		}
-		flushCollectionInfo.TenantLastCompactionTime = lastCompactionTime
+		if current == nil {
+			return common.ErrEmbeddingFunctionNotFound
+		}
+		count, err := tc.metaDomain.CollectionDb(txCtx).CountCollectionsByEmbeddingFunctionID(current.ID)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return common.ErrEmbeddingFunctionInUse
+		}
+		_, err = tc.metaDomain.EmbeddingFunctionDb(txCtx).Delete(current.ID)
+		return err
+	})
+}
 
-		// return nil will commit the transaction
+// lockCollectionTemplate checks that the template exists and belongs to the
+// tenant, and keeps it from being deleted until the end of the transaction. It
+// returns the ID of the template as stored.
+func (tc *Catalog) lockCollectionTemplate(txCtx context.Context, id types.UniqueID, tenantID string) (*string, error) {
+	template, err := tc.metaDomain.CollectionTemplateDb(txCtx).GetForShare(id.String())
+	if err != nil {
+		return nil, err
+	}
+	if template == nil || template.TenantID != tenantID {
+		return nil, common.ErrCollectionTemplateNotFound
+	}
+	return &template.ID, nil
+}
+
+func (tc *Catalog) CreateCollectionTemplate(ctx context.Context, template *model.CollectionTemplate) (*model.CollectionTemplate, error) {
+	var result *model.CollectionTemplate
+	err := tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		tenants, err := tc.metaDomain.TenantDb(txCtx).GetTenants(template.TenantID)
+		if err != nil {
+			return err
+		}
+		if len(tenants) == 0 {
+			return common.ErrTenantNotFound
+		}
+		dbTemplate := convertCollectionTemplateToDB(template)
+		err = tc.metaDomain.CollectionTemplateDb(txCtx).Insert(dbTemplate)
+		if err != nil {
+			return err
+		}
+		created, err := tc.metaDomain.CollectionTemplateDb(txCtx).GetForShare(dbTemplate.ID)
+		if err != nil {
+			return err
+		}
+		result = convertCollectionTemplateToModel(created)
 		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
-	return flushCollectionInfo, nil
+	log.Info("collection template created", zap.String("id", result.ID.String()), zap.String("tenant", result.TenantID), zap.String("name", result.Name))
+	return result, nil
+}
+
+// GetCollectionTemplates returns the templates matching the filters that are
+// set, every template of the tenant when only tenantID is.
+func (tc *Catalog) GetCollectionTemplates(ctx context.Context, id types.UniqueID, tenantID string, name *string) ([]*model.CollectionTemplate, error) {
+	var idFilter *string
+	if id != types.NilUniqueID() {
+		s := id.String()
+		idFilter = &s
+	}
+	var templates []*dbmodel.CollectionTemplate
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		var err error
+		templates, err = tc.metaDomain.CollectionTemplateDb(txCtx).Get(idFilter, tenantID, name)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.CollectionTemplate, 0, len(templates))
+	for _, template := range templates {
+		result = append(result, convertCollectionTemplateToModel(template))
+	}
+	return result, nil
+}
+
+// DeleteCollectionTemplate detaches the collections created from the template,
+// which keep the configuration they were created with but no longer have the
+// quotas of the template.
+func (tc *Catalog) DeleteCollectionTemplate(ctx context.Context, id types.UniqueID) error {
+	return tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		// deleting the row waits for the collections being created from the
+		// template, which are detached below
+		deleted, err := tc.metaDomain.CollectionTemplateDb(txCtx).Delete(id.String())
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return common.ErrCollectionTemplateNotFound
+		}
+		_, err = tc.metaDomain.CollectionDb(txCtx).ClearTemplateID(id.String())
+		return err
+	})
+}
+
+// SetCollectionSchema replaces the schema of the document metadata of a live
+// collection. An empty list of fields removes the schema.
+func (tc *Catalog) SetCollectionSchema(ctx context.Context, collectionID types.UniqueID, fields []*model.CollectionSchemaField) error {
+	return tc.txImpl.WithWriteTx(ctx, func(txCtx context.Context) error {
+		current, err := tc.metaDomain.CollectionDb(txCtx).GetForUpdate(dbmodel.NewCollectionID(collectionID))
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			return common.ErrCollectionNotFound
+		}
+		return tc.metaDomain.CollectionSchemaFieldDb(txCtx).Replace(current.ID, current.TenantID, convertCollectionSchemaFieldsToDB(fields))
+	})
+}
+
+func (tc *Catalog) GetCollectionSchema(ctx context.Context, collectionID types.UniqueID) ([]*model.CollectionSchemaField, error) {
+	var fields []*dbmodel.CollectionSchemaField
+	err := tc.txImpl.WithReadTx(ctx, func(txCtx context.Context) error {
+		collections, err := tc.metaDomain.CollectionDb(txCtx).GetCollections(dbmodel.CollectionIDFromUniqueID(collectionID), nil, "", "", nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		if len(collections) == 0 {
+			return common.ErrCollectionNotFound
+		}
+		fields, err = tc.metaDomain.CollectionSchemaFieldDb(txCtx).GetByCollectionID(dbmodel.NewCollectionID(collectionID))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertCollectionSchemaFieldsToModel(fields), nil
 }
@@ -2,7 +2,9 @@ package coordinator
 
 import (
 	"context"
+	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
@@ -42,7 +44,7 @@ func TestCatalog_CreateCollection(t *testing.T) {
 
 	// mock the insert collection method
 	name := "test_collection"
-	mockTxImpl.On("Transaction", context.Background(), mock.Anything).Return(nil)
+	mockTxImpl.On("WithWriteTx", context.Background(), mock.Anything).Return(nil)
 	mockMetaDomain.On("CollectionDb", context.Background()).Return(&mocks.ICollectionDb{})
 	mockMetaDomain.CollectionDb(context.Background()).(*mocks.ICollectionDb).On("Insert", &dbmodel.Collection{
 		ID:   "00000000-0000-0000-0000-000000000001",
@@ -74,11 +76,17 @@ func TestCatalog_CreateCollection(t *testing.T) {
 }
 
 func TestCatalog_GetCollections(t *testing.T) {
+	// create a mock transaction implementation that runs the callback
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithReadTx", context.Background(), mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+
 	// create a mock meta domain implementation
 	mockMetaDomain := &mocks.IMetaDomain{}
 
 	// create a new catalog instance
-	catalog := NewTableCatalog(nil, mockMetaDomain)
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
 
 	// create a mock collection ID
 	collectionID := types.MustParse("00000000-0000-0000-0000-000000000001")
@@ -111,10 +119,18 @@ func TestCatalog_GetCollections(t *testing.T) {
 	// mock the get collections method
 	mockMetaDomain.On("CollectionDb", context.Background()).Return(&mocks.ICollectionDb{})
 	var n *int32
-	mockMetaDomain.CollectionDb(context.Background()).(*mocks.ICollectionDb).On("GetCollections", types.FromUniqueID(collectionID), &collectionName, common.DefaultTenant, common.DefaultDatabase, n, n).Return(collectionAndMetadataList, nil)
+	var f []*model.CollectionMetadataFilter
+	mockMetaDomain.CollectionDb(context.Background()).(*mocks.ICollectionDb).On("GetCollections", dbmodel.CollectionIDFromUniqueID(collectionID), &collectionName, common.DefaultTenant, common.DefaultDatabase, n, n, f).Return(collectionAndMetadataList, nil)
+
+	// mock the acl of the collection
+	mockCollectionAclDb := &mocks.ICollectionAclDb{}
+	mockMetaDomain.On("CollectionAclDb", context.Background()).Return(mockCollectionAclDb)
+	mockCollectionAclDb.On("GetByCollectionIDs", []dbmodel.CollectionID{dbmodel.NewCollectionID(collectionID)}).Return([]*dbmodel.CollectionAcl{
+		{CollectionID: dbmodel.NewCollectionID(collectionID), Principal: "alice", Permissions: int32(model.CollectionPermissionRead | model.CollectionPermissionWrite)},
+	}, nil)
 
 	// call the GetCollections method
-	collections, err := catalog.GetCollections(context.Background(), collectionID, &collectionName, defaultTenant, defaultDatabase, nil, nil)
+	collections, err := catalog.GetCollections(context.Background(), collectionID, &collectionName, defaultTenant, defaultDatabase, nil, nil, nil, nil)
 
 	// assert that the method returned no error
 	assert.NoError(t, err)
@@ -128,9 +144,1274 @@ func TestCatalog_GetCollections(t *testing.T) {
 			Name:     "test_collection",
 			Ts:       types.Timestamp(1234567890),
 			Metadata: metadata,
+			Acl: []*model.CollectionAclEntry{
+				{CollectionID: collectionID, Principal: "alice", Permissions: model.CollectionPermissionRead | model.CollectionPermissionWrite},
+			},
 		},
 	}, collections)
 
 	// assert that the mock methods were called as expected
 	mockMetaDomain.AssertExpectations(t)
 }
+
+func TestCatalog_GetCollectionsWithFields(t *testing.T) {
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithReadTx", context.Background(), mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+	mockMetaDomain := &mocks.IMetaDomain{}
+	mockCollectionDb := &mocks.ICollectionDb{}
+	mockMetaDomain.On("CollectionDb", context.Background()).Return(mockCollectionDb)
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+
+	name := "test_collection"
+	var n *int32
+	var f []*model.CollectionMetadataFilter
+	mockCollectionDb.On("GetCollectionsWithoutMetadata", (*dbmodel.CollectionID)(nil), (*string)(nil), defaultTenant, defaultDatabase, n, n, f).Return([]*dbmodel.CollectionAndMetadata{
+		{Collection: &dbmodel.Collection{ID: "00000000-0000-0000-0000-000000000001", Name: &name}},
+	}, nil)
+
+	// neither the metadata nor the acl are loaded
+	collections, err := catalog.GetCollections(context.Background(), types.NilUniqueID(), nil, defaultTenant, defaultDatabase, nil, nil, nil, &model.CollectionFields{})
+	assert.NoError(t, err)
+	assert.Len(t, collections, 1)
+	assert.Equal(t, "test_collection", collections[0].Name)
+	assert.Nil(t, collections[0].Metadata)
+	assert.Nil(t, collections[0].Acl)
+	mockCollectionDb.AssertNotCalled(t, "GetCollections", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockMetaDomain.AssertNotCalled(t, "CollectionAclDb", mock.Anything)
+}
+
+func TestCatalog_GetCollectionsByIDs(t *testing.T) {
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithReadTx", context.Background(), mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+	mockMetaDomain := &mocks.IMetaDomain{}
+	mockCollectionDb := &mocks.ICollectionDb{}
+	mockCollectionAclDb := &mocks.ICollectionAclDb{}
+	mockMetaDomain.On("CollectionDb", context.Background()).Return(mockCollectionDb)
+	mockMetaDomain.On("CollectionAclDb", context.Background()).Return(mockCollectionAclDb)
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+
+	first, second, missing := types.NewUniqueID(), types.NewUniqueID(), types.NewUniqueID()
+	firstName, secondName := "first", "second"
+	ids := []dbmodel.CollectionID{dbmodel.NewCollectionID(first), dbmodel.NewCollectionID(second), dbmodel.NewCollectionID(missing)}
+	mockCollectionDb.On("GetLiveCollectionsByIDs", ids, true).Return([]*dbmodel.CollectionAndMetadata{
+		{Collection: &dbmodel.Collection{ID: dbmodel.NewCollectionID(first), Name: &firstName}},
+		{Collection: &dbmodel.Collection{ID: dbmodel.NewCollectionID(second), Name: &secondName}},
+	}, nil)
+	// the acl of every collection is read at once
+	mockCollectionAclDb.On("GetByCollectionIDs", []dbmodel.CollectionID{dbmodel.NewCollectionID(first), dbmodel.NewCollectionID(second)}).Return([]*dbmodel.CollectionAcl{
+		{CollectionID: dbmodel.NewCollectionID(second), Principal: "reader", Permissions: 1},
+	}, nil)
+
+	collections, err := catalog.GetCollectionsByIDs(context.Background(), []types.UniqueID{first, second, missing}, nil)
+	assert.NoError(t, err)
+	assert.Len(t, collections, 2)
+	assert.Equal(t, "first", collections[0].Name)
+	assert.Empty(t, collections[0].Acl)
+	assert.Equal(t, "second", collections[1].Name)
+	assert.Len(t, collections[1].Acl, 1)
+	mockCollectionDb.AssertNumberOfCalls(t, "GetLiveCollectionsByIDs", 1)
+	mockCollectionAclDb.AssertNumberOfCalls(t, "GetByCollectionIDs", 1)
+}
+
+func TestCatalog_GetCompactionRequests(t *testing.T) {
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithReadTx", context.Background(), mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+	mockMetaDomain := &mocks.IMetaDomain{}
+	mockCollectionDb := &mocks.ICollectionDb{}
+	mockCompactionRequestDb := &mocks.ICompactionRequestDb{}
+	mockMetaDomain.On("CollectionDb", context.Background()).Return(mockCollectionDb)
+	mockMetaDomain.On("CompactionRequestDb", context.Background()).Return(mockCompactionRequestDb)
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+
+	compacted, fresh := types.NewUniqueID(), types.NewUniqueID()
+	requestedAt := time.Unix(1700000000, 0)
+	compactedAt := time.Unix(1600000000, 0)
+	compactor := "compactor-1"
+	mockCompactionRequestDb.On("List", int32(10)).Return([]*dbmodel.CompactionRequest{
+		{CollectionID: dbmodel.NewCollectionID(compacted), RequestedAt: requestedAt},
+		{CollectionID: dbmodel.NewCollectionID(fresh), RequestedAt: requestedAt},
+	}, nil)
+	mockCollectionDb.On("GetLiveCollectionsByIDs", []dbmodel.CollectionID{dbmodel.NewCollectionID(compacted), dbmodel.NewCollectionID(fresh)}, false).Return([]*dbmodel.CollectionAndMetadata{
+		{Collection: &dbmodel.Collection{ID: dbmodel.NewCollectionID(compacted), LastCompactor: &compactor, LastCompactedAt: &compactedAt}},
+		{Collection: &dbmodel.Collection{ID: dbmodel.NewCollectionID(fresh)}},
+	}, nil)
+
+	requests, err := catalog.GetCompactionRequests(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Len(t, requests, 2)
+	assert.Equal(t, &compactor, requests[0].LastCompactor)
+	assert.Equal(t, int64(1600000000), *requests[0].LastCompactedAt)
+	assert.Nil(t, requests[1].LastCompactor)
+	assert.Nil(t, requests[1].LastCompactedAt)
+}
+
+func TestCatalog_CancelJob(t *testing.T) {
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithWriteTx", context.Background(), mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+	mockMetaDomain := &mocks.IMetaDomain{}
+	mockJobDb := &mocks.IJobDb{}
+	mockMetaDomain.On("JobDb", context.Background()).Return(mockJobDb)
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+
+	running, finished, missing := types.NewUniqueID(), types.NewUniqueID(), types.NewUniqueID()
+	mockJobDb.On("Cancel", running.String()).Return(true, nil)
+	mockJobDb.On("Get", running.String()).Return(&dbmodel.Job{ID: running.String(), Type: "test", Payload: "{}", State: dbmodel.JobStateCanceled}, nil)
+	mockJobDb.On("Cancel", finished.String()).Return(false, nil)
+	mockJobDb.On("Get", finished.String()).Return(&dbmodel.Job{ID: finished.String(), Type: "test", Payload: "{}", State: dbmodel.JobStateSucceeded}, nil)
+	mockJobDb.On("Cancel", missing.String()).Return(false, nil)
+	mockJobDb.On("Get", missing.String()).Return(nil, nil)
+
+	job, err := catalog.CancelJob(context.Background(), running)
+	assert.NoError(t, err)
+	assert.Equal(t, model.JobStateCanceled, job.State)
+
+	_, err = catalog.CancelJob(context.Background(), finished)
+	assert.ErrorIs(t, err, common.ErrJobFinished)
+
+	_, err = catalog.CancelJob(context.Background(), missing)
+	assert.ErrorIs(t, err, common.ErrJobNotFound)
+}
+
+func TestCatalog_CheckCollectionLimit(t *testing.T) {
+	// create a mock meta domain implementation
+	mockMetaDomain := &mocks.IMetaDomain{}
+
+	// create a new catalog instance with a limit of two collections per database
+	catalog := NewTableCatalog(nil, mockMetaDomain)
+	catalog.SetMaxCollectionsPerDatabase(2)
+
+	databaseID := dbmodel.DatabaseID("00000000-0000-0000-0000-000000000001")
+	mockDatabaseDb := &mocks.IDatabaseDb{}
+	mockCollectionDb := &mocks.ICollectionDb{}
+	mockMetaDomain.On("DatabaseDb", context.Background()).Return(mockDatabaseDb)
+	mockMetaDomain.On("CollectionDb", context.Background()).Return(mockCollectionDb)
+	mockDatabaseDb.On("GetDatabaseForUpdate", databaseID).Return(&dbmodel.Database{ID: databaseID}, nil)
+
+	// below the limit
+	mockCollectionDb.On("CountCollectionsByDatabaseID", databaseID).Return(int64(1), nil).Once()
+	err := catalog.checkCollectionLimit(context.Background(), databaseID)
+	assert.NoError(t, err)
+
+	// at the limit
+	mockCollectionDb.On("CountCollectionsByDatabaseID", databaseID).Return(int64(2), nil).Once()
+	err = catalog.checkCollectionLimit(context.Background(), databaseID)
+	assert.ErrorIs(t, err, common.ErrCollectionLimitExceeded)
+
+	// assert that the mock methods were called as expected
+	mockMetaDomain.AssertExpectations(t)
+	mockDatabaseDb.AssertExpectations(t)
+	mockCollectionDb.AssertExpectations(t)
+}
+
+func TestCatalog_GetApproximateCounts(t *testing.T) {
+	// create a mock transaction implementation that runs the callback
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithReadTx", context.Background(), mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+
+	// create a mock meta domain implementation
+	mockMetaDomain := &mocks.IMetaDomain{}
+
+	// create a new catalog instance
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+
+	mockCollectionDb := &mocks.ICollectionDb{}
+	mockMetaDomain.On("CollectionDb", context.Background()).Return(mockCollectionDb)
+	mockCollectionDb.On("GetApproximateCollectionCount").Return(int64(1000), nil)
+	mockDatabaseDb := &mocks.IDatabaseDb{}
+	mockUsageCounterDb := &mocks.IUsageCounterDb{}
+	mockMetaDomain.On("DatabaseDb", context.Background()).Return(mockDatabaseDb)
+	mockMetaDomain.On("UsageCounterDb", context.Background()).Return(mockUsageCounterDb)
+	databaseID := dbmodel.DatabaseID(types.NewUniqueID().String())
+	mockDatabaseDb.On("GetDatabases", defaultTenant, defaultDatabase).Return([]*dbmodel.Database{{ID: databaseID, Name: defaultDatabase, TenantID: defaultTenant}}, nil)
+	mockDatabaseDb.On("GetDatabases", defaultTenant, "missing").Return([]*dbmodel.Database{}, nil)
+	mockCollectionDb.On("GetApproximateDatabaseCollectionCount", databaseID).Return(int64(3), nil)
+	mockUsageCounterDb.On("Get", defaultTenant, databaseID.String()).Return(&dbmodel.UsageCounter{RecordCount: 42}, nil)
+
+	// instance wide estimate
+	counts, err := catalog.GetApproximateCounts(context.Background(), "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, &model.ApproximateCounts{CollectionCount: 1000}, counts)
+
+	// database scoped counts
+	counts, err = catalog.GetApproximateCounts(context.Background(), defaultTenant, defaultDatabase)
+	assert.NoError(t, err)
+	assert.Equal(t, &model.ApproximateCounts{CollectionCount: 3, RecordCount: 42}, counts)
+
+	// a missing database has no collections
+	counts, err = catalog.GetApproximateCounts(context.Background(), defaultTenant, "missing")
+	assert.NoError(t, err)
+	assert.Equal(t, &model.ApproximateCounts{}, counts)
+
+	// assert that the mock methods were called as expected
+	mockMetaDomain.AssertExpectations(t)
+	mockCollectionDb.AssertExpectations(t)
+}
+
+func TestCatalog_DeleteTenantNotEmpty(t *testing.T) {
+	// create a mock transaction implementation that runs the callback
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithWriteTx", context.Background(), mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+
+	// create a mock meta domain implementation
+	mockMetaDomain := &mocks.IMetaDomain{}
+
+	// create a new catalog instance
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+
+	mockTenantDb := &mocks.ITenantDb{}
+	mockDatabaseDb := &mocks.IDatabaseDb{}
+	mockMetaDomain.On("TenantDb", context.Background()).Return(mockTenantDb)
+	mockMetaDomain.On("DatabaseDb", context.Background()).Return(mockDatabaseDb)
+	mockTenantDb.On("GetTenants", "tenant1").Return([]*dbmodel.Tenant{{ID: "tenant1"}}, nil)
+	mockDatabaseDb.On("GetDatabasesByTenantID", "tenant1").Return([]*dbmodel.Database{{ID: "database1", Name: "db1", TenantID: "tenant1"}}, nil)
+
+	// a tenant with databases is not deleted without force
+	err := catalog.DeleteTenant(context.Background(), &model.DeleteTenant{Name: "tenant1"})
+	assert.ErrorIs(t, err, common.ErrTenantNotEmpty)
+
+	// assert that the mock methods were called as expected
+	mockTenantDb.AssertExpectations(t)
+	mockDatabaseDb.AssertExpectations(t)
+	mockTenantDb.AssertNotCalled(t, "DeleteByID", mock.Anything)
+}
+
+func TestCatalog_ForceDeleteTenantPurgesSoftDeletedCollections(t *testing.T) {
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithWriteTx", context.Background(), mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+	mockMetaDomain := &mocks.IMetaDomain{}
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+
+	mockTenantDb := &mocks.ITenantDb{}
+	mockDatabaseDb := &mocks.IDatabaseDb{}
+	mockCollectionDb := &mocks.ICollectionDb{}
+	mockCollectionMetadataDb := &mocks.ICollectionMetadataDb{}
+	mockCollectionMetadataHistoryDb := &mocks.ICollectionMetadataHistoryDb{}
+	mockCollectionAclDb := &mocks.ICollectionAclDb{}
+	mockCollectionSchemaFieldDb := &mocks.ICollectionSchemaFieldDb{}
+	mockCollectionLogRouteDb := &mocks.ICollectionLogRouteDb{}
+	mockCompactionRequestDb := &mocks.ICompactionRequestDb{}
+	mockSegmentDb := &mocks.ISegmentDb{}
+	mockArchiveDb := &mocks.IArchiveDb{}
+	mockNotificationDb := &mocks.INotificationDb{}
+	mockLifecycleDb := &mocks.ICollectionLifecycleDb{}
+	mockEmbeddingFunctionDb := &mocks.IEmbeddingFunctionDb{}
+	mockCollectionTemplateDb := &mocks.ICollectionTemplateDb{}
+	mockTenantQuotaDb := &mocks.ITenantQuotaDb{}
+	mockUsageCounterDb := &mocks.IUsageCounterDb{}
+	mockDatabaseQuotaDb := &mocks.IDatabaseQuotaDb{}
+	mockTenantRegionDb := &mocks.ITenantRegionDb{}
+	mockFeatureFlagDb := &mocks.IFeatureFlagDb{}
+	mockMetaDomain.On("TenantDb", context.Background()).Return(mockTenantDb)
+	mockMetaDomain.On("DatabaseDb", context.Background()).Return(mockDatabaseDb)
+	mockMetaDomain.On("CollectionDb", context.Background()).Return(mockCollectionDb)
+	mockMetaDomain.On("CollectionMetadataDb", context.Background()).Return(mockCollectionMetadataDb)
+	mockMetaDomain.On("CollectionMetadataHistoryDb", context.Background()).Return(mockCollectionMetadataHistoryDb)
+	mockMetaDomain.On("CollectionAclDb", context.Background()).Return(mockCollectionAclDb)
+	mockMetaDomain.On("CollectionSchemaFieldDb", context.Background()).Return(mockCollectionSchemaFieldDb)
+	mockMetaDomain.On("CollectionLogRouteDb", context.Background()).Return(mockCollectionLogRouteDb)
+	mockMetaDomain.On("CompactionRequestDb", context.Background()).Return(mockCompactionRequestDb)
+	mockMetaDomain.On("SegmentDb", context.Background()).Return(mockSegmentDb)
+	mockMetaDomain.On("ArchiveDb", context.Background()).Return(mockArchiveDb)
+	mockMetaDomain.On("NotificationDb", context.Background()).Return(mockNotificationDb)
+	mockMetaDomain.On("CollectionLifecycleDb", context.Background()).Return(mockLifecycleDb)
+	mockMetaDomain.On("EmbeddingFunctionDb", context.Background()).Return(mockEmbeddingFunctionDb)
+	mockMetaDomain.On("CollectionTemplateDb", context.Background()).Return(mockCollectionTemplateDb)
+	mockMetaDomain.On("TenantQuotaDb", context.Background()).Return(mockTenantQuotaDb)
+	mockMetaDomain.On("UsageCounterDb", context.Background()).Return(mockUsageCounterDb)
+	mockMetaDomain.On("DatabaseQuotaDb", context.Background()).Return(mockDatabaseQuotaDb)
+	mockMetaDomain.On("TenantRegionDb", context.Background()).Return(mockTenantRegionDb)
+	mockMetaDomain.On("FeatureFlagDb", context.Background()).Return(mockFeatureFlagDb)
+
+	databaseID := dbmodel.NewDatabaseID(types.NewUniqueID())
+	collectionID := dbmodel.NewCollectionID(types.NewUniqueID())
+	embeddingFunctionID := types.NewUniqueID().String()
+	deleted := &dbmodel.Collection{ID: collectionID, TenantID: "tenant1", EmbeddingFunctionID: &embeddingFunctionID}
+	deleted.IsDeleted = true
+	mockTenantDb.On("GetTenants", "tenant1").Return([]*dbmodel.Tenant{{ID: "tenant1"}}, nil)
+	mockDatabaseDb.On("GetDatabasesByTenantID", "tenant1").Return([]*dbmodel.Database{{ID: databaseID, Name: "db1", TenantID: "tenant1"}}, nil)
+	var n *int32
+	var f []*model.CollectionMetadataFilter
+	mockCollectionDb.On("GetCollections", (*dbmodel.CollectionID)(nil), (*string)(nil), "tenant1", "db1", n, n, f).Return([]*dbmodel.CollectionAndMetadata{}, nil)
+	// the soft deleted collection references an embedding function of the tenant
+	mockCollectionDb.On("GetDeletedCollectionsByDatabaseID", databaseID).Return([]*dbmodel.Collection{deleted}, nil)
+	mockCollectionMetadataDb.On("GetByCollectionID", collectionID).Return([]*dbmodel.CollectionMetadata{}, nil)
+
+	mockSegmentDb.On("GetSegments", types.NilUniqueID(), (*string)(nil), (*string)(nil), collectionID.UniqueID(), n, n).Return([]*dbmodel.SegmentAndMetadata{}, nil)
+	archive := mockArchiveDb.On("ArchiveCollection", mock.MatchedBy(func(collection *dbmodel.CollectionAndMetadata) bool {
+		return collection.Collection == deleted
+	}), dbmodel.ArchiveReasonForceDeleteTenant, "admin").Return(nil)
+	purge := mockCollectionDb.On("DeleteCollectionByID", collectionID).Return(1, nil).NotBefore(archive)
+	mockCollectionMetadataDb.On("DeleteByCollectionID", collectionID).Return(0, nil)
+	mockCollectionMetadataHistoryDb.On("DeleteByCollectionID", collectionID).Return(nil)
+	mockCollectionAclDb.On("DeleteByCollectionID", collectionID).Return(int64(0), nil)
+	mockCollectionSchemaFieldDb.On("DeleteByCollectionID", collectionID).Return(int64(0), nil)
+	mockCollectionLogRouteDb.On("DeleteByCollectionID", collectionID).Return(int64(0), nil)
+	mockCompactionRequestDb.On("DeleteByCollectionID", collectionID).Return(int64(0), nil)
+	mockNotificationDb.On("Insert", mock.Anything).Return(nil)
+	mockLifecycleDb.On("Increment", "tenant1", mock.Anything, dbmodel.LifecycleEventPurged, int64(1)).Return(nil)
+	mockDatabaseDb.On("DeleteByTenantIdAndName", "tenant1", "db1").Return(1, nil)
+	mockDatabaseQuotaDb.On("DeleteByDatabaseID", databaseID).Return(int64(1), nil)
+	mockEmbeddingFunctionDb.On("DeleteByTenantID", "tenant1").Return(int64(1), nil).NotBefore(purge)
+	mockCollectionTemplateDb.On("DeleteByTenantID", "tenant1").Return(int64(0), nil)
+	mockTenantQuotaDb.On("DeleteByTenantID", "tenant1").Return(int64(1), nil)
+	mockUsageCounterDb.On("DeleteByTenantID", "tenant1").Return(int64(2), nil)
+	mockTenantRegionDb.On("Replace", "tenant1", []string(nil)).Return(nil)
+	mockFeatureFlagDb.On("DeleteByTenantID", "tenant1").Return(int64(1), nil)
+	mockTenantDb.On("DeleteByID", "tenant1").Return(1, nil)
+
+	err := catalog.DeleteTenant(context.Background(), &model.DeleteTenant{Name: "tenant1", Force: true, Actor: "admin"})
+	assert.NoError(t, err)
+	mockCollectionDb.AssertExpectations(t)
+	mockArchiveDb.AssertExpectations(t)
+	mockEmbeddingFunctionDb.AssertExpectations(t)
+	mockTenantQuotaDb.AssertExpectations(t)
+	mockUsageCounterDb.AssertExpectations(t)
+	mockDatabaseQuotaDb.AssertExpectations(t)
+	mockTenantRegionDb.AssertExpectations(t)
+	mockFeatureFlagDb.AssertExpectations(t)
+	mockTenantDb.AssertExpectations(t)
+}
+
+func TestCatalog_DeleteCollectionArchivesBeforeDelete(t *testing.T) {
+	// create a mock transaction implementation that runs the callback
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithWriteTx", context.Background(), mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+
+	// create a mock meta domain implementation
+	mockMetaDomain := &mocks.IMetaDomain{}
+
+	// create a new catalog instance
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+
+	collectionID := types.MustParse("00000000-0000-0000-0000-000000000001")
+	name := "test_collection"
+	collectionAndMetadata := &dbmodel.CollectionAndMetadata{
+		Collection: &dbmodel.Collection{
+			ID:   dbmodel.NewCollectionID(collectionID),
+			Name: &name,
+		},
+		TenantID: defaultTenant,
+	}
+
+	mockCollectionDb := &mocks.ICollectionDb{}
+	mockCollectionMetadataDb := &mocks.ICollectionMetadataDb{}
+	mockArchiveDb := &mocks.IArchiveDb{}
+	mockNotificationDb := &mocks.INotificationDb{}
+	mockLifecycleDb := &mocks.ICollectionLifecycleDb{}
+	mockMetaDomain.On("CollectionDb", context.Background()).Return(mockCollectionDb)
+	mockMetaDomain.On("CollectionMetadataDb", context.Background()).Return(mockCollectionMetadataDb)
+	mockMetaDomain.On("ArchiveDb", context.Background()).Return(mockArchiveDb)
+	mockMetaDomain.On("NotificationDb", context.Background()).Return(mockNotificationDb)
+	mockMetaDomain.On("CollectionLifecycleDb", context.Background()).Return(mockLifecycleDb)
+
+	var n *int32
+	var f []*model.CollectionMetadataFilter
+	mockCollectionDb.On("GetCollections", dbmodel.CollectionIDFromUniqueID(collectionID), (*string)(nil), defaultTenant, defaultDatabase, n, n, f).Return([]*dbmodel.CollectionAndMetadata{collectionAndMetadata}, nil)
+	archive := mockArchiveDb.On("ArchiveCollection", collectionAndMetadata, dbmodel.ArchiveReasonDeleteCollection, "admin").Return(nil)
+	mockCollectionDb.On("DeleteCollectionByID", dbmodel.NewCollectionID(collectionID)).Return(1, nil).NotBefore(archive)
+	mockCollectionMetadataDb.On("DeleteByCollectionID", dbmodel.NewCollectionID(collectionID)).Return(0, nil)
+	mockNotificationDb.On("Insert", mock.Anything).Return(nil)
+	mockLifecycleDb.On("Increment", defaultTenant, mock.Anything, dbmodel.LifecycleEventDeleted, int64(1)).Return(nil)
+	mockCollectionAclDb := &mocks.ICollectionAclDb{}
+	mockMetaDomain.On("CollectionAclDb", context.Background()).Return(mockCollectionAclDb)
+	mockCollectionAclDb.On("DeleteByCollectionID", dbmodel.NewCollectionID(collectionID)).Return(int64(0), nil)
+	mockCollectionLogRouteDb := &mocks.ICollectionLogRouteDb{}
+	mockMetaDomain.On("CollectionLogRouteDb", context.Background()).Return(mockCollectionLogRouteDb)
+	mockCollectionLogRouteDb.On("DeleteByCollectionID", dbmodel.NewCollectionID(collectionID)).Return(int64(0), nil)
+	mockCollectionSchemaFieldDb := &mocks.ICollectionSchemaFieldDb{}
+	mockMetaDomain.On("CollectionSchemaFieldDb", context.Background()).Return(mockCollectionSchemaFieldDb)
+	mockCollectionSchemaFieldDb.On("DeleteByCollectionID", dbmodel.NewCollectionID(collectionID)).Return(int64(0), nil)
+	mockCompactionRequestDb := &mocks.ICompactionRequestDb{}
+	mockMetaDomain.On("CompactionRequestDb", context.Background()).Return(mockCompactionRequestDb)
+	mockCompactionRequestDb.On("DeleteByCollectionID", dbmodel.NewCollectionID(collectionID)).Return(int64(0), nil)
+	// the metadata history of the collection goes with it
+	mockCollectionMetadataHistoryDb := &mocks.ICollectionMetadataHistoryDb{}
+	mockMetaDomain.On("CollectionMetadataHistoryDb", context.Background()).Return(mockCollectionMetadataHistoryDb)
+	mockCollectionMetadataHistoryDb.On("DeleteByCollectionID", dbmodel.NewCollectionID(collectionID)).Return(nil)
+
+	// the size of the collection is taken off the usage counters
+	databaseID := dbmodel.NewDatabaseID(types.MustParse("00000000-0000-0000-0000-000000000002"))
+	zero := int64(0)
+	mockCollectionDb.On("UpdateCollectionSize", dbmodel.NewCollectionID(collectionID), &zero, &zero).Return(&dbmodel.CollectionSizeChange{DatabaseID: databaseID, Records: -42, SizeBytes: -4096}, nil)
+	mockUsageCounterDb := &mocks.IUsageCounterDb{}
+	mockMetaDomain.On("UsageCounterDb", context.Background()).Return(mockUsageCounterDb)
+	mockUsageCounterDb.On("Increment", defaultTenant, databaseID.String(), int64(-42), int64(-4096)).Return(nil)
+	mockUsageCounterDb.On("Increment", defaultTenant, "", int64(-42), int64(-4096)).Return(nil)
+
+	err := catalog.DeleteCollection(context.Background(), &model.DeleteCollection{
+		ID:           collectionID,
+		TenantID:     defaultTenant,
+		DatabaseName: defaultDatabase,
+		Actor:        "admin",
+	})
+	assert.NoError(t, err)
+
+	// assert that the mock methods were called as expected
+	mockArchiveDb.AssertExpectations(t)
+	mockCollectionDb.AssertExpectations(t)
+	mockLifecycleDb.AssertExpectations(t)
+	mockUsageCounterDb.AssertExpectations(t)
+	mockCollectionMetadataHistoryDb.AssertExpectations(t)
+}
+
+func TestCatalog_GetDatabaseByID(t *testing.T) {
+	// create a mock transaction implementation that runs the callback
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithReadTx", context.Background(), mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+
+	// create a mock meta domain implementation
+	mockMetaDomain := &mocks.IMetaDomain{}
+
+	// create a new catalog instance
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+
+	databaseID := "00000000-0000-0000-0000-000000000001"
+	mockDatabaseDb := &mocks.IDatabaseDb{}
+	mockMetaDomain.On("DatabaseDb", context.Background()).Return(mockDatabaseDb)
+	mockDatabaseDb.On("GetDatabaseByID", dbmodel.DatabaseID(databaseID)).Return(&dbmodel.Database{ID: dbmodel.DatabaseID(databaseID), Name: defaultDatabase, TenantID: defaultTenant}, nil)
+
+	// the id takes precedence over the name
+	database, err := catalog.GetDatabases(context.Background(), &model.GetDatabase{ID: databaseID, Name: "renamed", Tenant: defaultTenant}, types.Timestamp(0))
+	assert.NoError(t, err)
+	assert.Equal(t, databaseID, database.ID)
+	assert.Equal(t, defaultDatabase, database.Name)
+
+	// a database of another tenant is not found
+	_, err = catalog.GetDatabases(context.Background(), &model.GetDatabase{ID: databaseID, Tenant: "other_tenant"}, types.Timestamp(0))
+	assert.ErrorIs(t, err, common.ErrDatabaseNotFound)
+
+	// assert that the mock methods were called as expected
+	mockDatabaseDb.AssertExpectations(t)
+}
+
+func TestCatalog_CreateMissingDatabase(t *testing.T) {
+	// create a mock meta domain implementation
+	mockMetaDomain := &mocks.IMetaDomain{}
+
+	// create a new catalog instance
+	catalog := NewTableCatalog(nil, mockMetaDomain)
+
+	mockTenantDb := &mocks.ITenantDb{}
+	mockDatabaseDb := &mocks.IDatabaseDb{}
+	mockMetaDomain.On("TenantDb", context.Background()).Return(mockTenantDb)
+	mockMetaDomain.On("DatabaseDb", context.Background()).Return(mockDatabaseDb)
+	mockTenantDb.On("GetTenants", defaultTenant).Return([]*dbmodel.Tenant{{ID: defaultTenant}}, nil)
+	mockTenantDb.On("GetTenants", "missing_tenant").Return([]*dbmodel.Tenant{}, nil)
+	mockDatabaseDb.On("Insert", mock.MatchedBy(func(database *dbmodel.Database) bool {
+		return database.Name == "new_database" && database.TenantID == defaultTenant && database.ID != ""
+	})).Return(nil).Once()
+
+	// the database is created in an existing tenant
+	database, err := catalog.createMissingDatabase(context.Background(), defaultTenant, "new_database", types.Timestamp(0))
+	assert.NoError(t, err)
+	assert.Equal(t, "new_database", database.Name)
+
+	// the tenant is never created implicitly
+	_, err = catalog.createMissingDatabase(context.Background(), "missing_tenant", "new_database", types.Timestamp(0))
+	assert.ErrorIs(t, err, common.ErrTenantNotFound)
+
+	// assert that the mock methods were called as expected
+	mockTenantDb.AssertExpectations(t)
+	mockDatabaseDb.AssertExpectations(t)
+}
+
+func TestCatalog_FlushCollectionCompactionStaleFencingToken(t *testing.T) {
+	// create a mock transaction implementation that runs the callback
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithWriteTxIsolation", context.Background(), sql.LevelSerializable, mock.Anything).Return(func(ctx context.Context, isolation sql.IsolationLevel, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+
+	// create a mock meta domain implementation
+	mockMetaDomain := &mocks.IMetaDomain{}
+
+	// create a new catalog instance
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+
+	collectionID := types.MustParse("00000000-0000-0000-0000-000000000001")
+	mockCollectionDb := &mocks.ICollectionDb{}
+	mockMetaDomain.On("CollectionDb", context.Background()).Return(mockCollectionDb)
+	mockCollectionDb.On("CheckFencingToken", dbmodel.NewCollectionID(collectionID), int64(1)).Return(common.ErrCollectionFencingTokenStale)
+
+	// a flush from a node that lost ownership changes nothing
+	fencingToken := int64(1)
+	_, err := catalog.FlushCollectionCompaction(context.Background(), &model.FlushCollectionCompaction{
+		ID:           collectionID,
+		TenantID:     defaultTenant,
+		LogPosition:  10,
+		FencingToken: &fencingToken,
+	})
+	assert.ErrorIs(t, err, common.ErrCollectionFencingTokenStale)
+
+	// assert that the mock methods were called as expected
+	mockCollectionDb.AssertExpectations(t)
+	mockCollectionDb.AssertNotCalled(t, "UpdateLogPositionAndVersion", mock.Anything, mock.Anything, mock.Anything)
+	mockMetaDomain.AssertNotCalled(t, "SegmentDb", mock.Anything)
+}
+
+func TestCatalog_CreateCollectionSegmentFailure(t *testing.T) {
+	// create a mock transaction implementation that runs the callback
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithWriteTx", context.Background(), mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+
+	// create a mock meta domain implementation
+	mockMetaDomain := &mocks.IMetaDomain{}
+
+	// create a new catalog instance
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+
+	collectionID := types.MustParse("00000000-0000-0000-0000-000000000001")
+	segmentID := types.MustParse("00000000-0000-0000-0000-000000000002")
+
+	mockDatabaseDb := &mocks.IDatabaseDb{}
+	mockCollectionDb := &mocks.ICollectionDb{}
+	mockSegmentDb := &mocks.ISegmentDb{}
+	mockNotificationDb := &mocks.INotificationDb{}
+	mockMetaDomain.On("DatabaseDb", context.Background()).Return(mockDatabaseDb)
+	mockMetaDomain.On("CollectionDb", context.Background()).Return(mockCollectionDb)
+	mockMetaDomain.On("SegmentDb", context.Background()).Return(mockSegmentDb)
+	mockMetaDomain.On("NotificationDb", context.Background()).Return(mockNotificationDb)
+	mockTenantDb := &mocks.ITenantDb{}
+	mockMetaDomain.On("TenantDb", context.Background()).Return(mockTenantDb)
+	mockHistoryDb := &mocks.ICollectionMetadataHistoryDb{}
+	mockMetaDomain.On("CollectionMetadataHistoryDb", context.Background()).Return(mockHistoryDb)
+	mockHistoryDb.On("Record", dbmodel.NewCollectionID(collectionID), defaultTenant, int64(0), mock.Anything).Return(nil)
+
+	name := "test_collection"
+	kmsKeyID := "tenant-key"
+	var n *int32
+	var f []*model.CollectionMetadataFilter
+	mockDatabaseDb.On("GetDatabases", defaultTenant, defaultDatabase).Return([]*dbmodel.Database{{ID: "database_id", Name: defaultDatabase, TenantID: defaultTenant}}, nil)
+	mockTenantDb.On("GetTenants", defaultTenant).Return([]*dbmodel.Tenant{{ID: defaultTenant, KmsKeyID: &kmsKeyID}}, nil)
+	mockCollectionDb.On("GetCollections", (*dbmodel.CollectionID)(nil), &name, defaultTenant, defaultDatabase, n, n, f).Return([]*dbmodel.CollectionAndMetadata{}, nil)
+	// the collection inherits the key of its tenant
+	mockCollectionDb.On("Insert", mock.MatchedBy(func(collection *dbmodel.Collection) bool {
+		return collection.KmsKeyID != nil && *collection.KmsKeyID == kmsKeyID
+	})).Return(nil)
+	mockSegmentDb.On("Insert", mock.Anything).Return(common.ErrSegmentUniqueConstraintViolation)
+
+	_, err := catalog.CreateCollection(context.Background(), &model.CreateCollection{
+		ID:           collectionID,
+		Name:         name,
+		TenantID:     defaultTenant,
+		DatabaseName: defaultDatabase,
+		Segments: []*model.CreateSegment{
+			{ID: segmentID, Type: "urn:chroma:segment/vector/hnsw-distributed", Scope: "VECTOR", CollectionID: collectionID},
+		},
+	}, types.Timestamp(0))
+	assert.Equal(t, common.ErrSegmentUniqueConstraintViolation, err)
+
+	// the create notification must not be queued when a segment fails
+	mockNotificationDb.AssertNotCalled(t, "Insert", mock.Anything)
+}
+
+func TestCatalog_RepairIncompleteCollectionWithSegments(t *testing.T) {
+	// create a mock transaction implementation that runs the callback
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithWriteTx", context.Background(), mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+
+	// create a mock meta domain implementation
+	mockMetaDomain := &mocks.IMetaDomain{}
+
+	// create a new catalog instance
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+
+	collectionID := types.MustParse("00000000-0000-0000-0000-000000000001")
+	mockCollectionDb := &mocks.ICollectionDb{}
+	mockSegmentDb := &mocks.ISegmentDb{}
+	mockArchiveDb := &mocks.IArchiveDb{}
+	mockMetaDomain.On("CollectionDb", context.Background()).Return(mockCollectionDb)
+	mockMetaDomain.On("SegmentDb", context.Background()).Return(mockSegmentDb)
+	mockMetaDomain.On("ArchiveDb", context.Background()).Return(mockArchiveDb)
+
+	var n *int32
+	var f []*model.CollectionMetadataFilter
+	mockCollectionDb.On("GetCollections", dbmodel.CollectionIDFromUniqueID(collectionID), (*string)(nil), "", "", n, n, f).Return([]*dbmodel.CollectionAndMetadata{
+		{Collection: &dbmodel.Collection{ID: dbmodel.NewCollectionID(collectionID)}},
+	}, nil)
+	mockSegmentDb.On("GetSegments", types.NilUniqueID(), (*string)(nil), (*string)(nil), collectionID, (*int32)(nil), (*int32)(nil)).Return([]*dbmodel.SegmentAndMetadata{
+		{Segment: &dbmodel.Segment{ID: "00000000-0000-0000-0000-000000000002"}},
+	}, nil)
+
+	err := catalog.RepairIncompleteCollection(context.Background(), collectionID, "admin")
+	assert.Equal(t, common.ErrCollectionNotIncomplete, err)
+	mockArchiveDb.AssertNotCalled(t, "ArchiveCollection", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCatalog_PurgeDeletedCollectionNotExpired(t *testing.T) {
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithWriteTx", context.Background(), mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+	mockMetaDomain := &mocks.IMetaDomain{}
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+
+	restoredID, recentID := types.NewUniqueID(), types.NewUniqueID()
+	deletedBefore := time.Now().Add(-time.Hour)
+	deletedAt := time.Now()
+	mockCollectionDb := &mocks.ICollectionDb{}
+	mockArchiveDb := &mocks.IArchiveDb{}
+	mockMetaDomain.On("CollectionDb", context.Background()).Return(mockCollectionDb)
+	mockMetaDomain.On("ArchiveDb", context.Background()).Return(mockArchiveDb)
+
+	// restored since it was listed
+	mockCollectionDb.On("GetDeletedCollection", dbmodel.NewCollectionID(restoredID)).Return(nil, nil)
+	err := catalog.PurgeDeletedCollection(context.Background(), restoredID, deletedBefore, "soft_delete_cleaner")
+	assert.ErrorIs(t, err, common.ErrCollectionNotFound)
+
+	// deleted again after the cutoff
+	mockCollectionDb.On("GetDeletedCollection", dbmodel.NewCollectionID(recentID)).Return(&dbmodel.Collection{
+		ID:         dbmodel.NewCollectionID(recentID),
+		SoftDelete: dbmodel.SoftDelete{IsDeleted: true, DeletedAt: &deletedAt},
+	}, nil)
+	err = catalog.PurgeDeletedCollection(context.Background(), recentID, deletedBefore, "soft_delete_cleaner")
+	assert.ErrorIs(t, err, common.ErrCollectionNotFound)
+	mockArchiveDb.AssertNotCalled(t, "ArchiveCollection", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCatalog_RefreshCollectionState(t *testing.T) {
+	collectionID := types.MustParse("00000000-0000-0000-0000-000000000001")
+	vector := &dbmodel.SegmentAndMetadata{Segment: &dbmodel.Segment{ID: "00000000-0000-0000-0000-000000000002", Scope: "VECTOR"}}
+	metadata := &dbmodel.SegmentAndMetadata{Segment: &dbmodel.Segment{ID: "00000000-0000-0000-0000-000000000003", Scope: "METADATA"}}
+
+	tests := []struct {
+		name       string
+		segments   []*dbmodel.SegmentAndMetadata
+		fromStates []string
+		toState    model.CollectionState
+	}{
+		{
+			name:       "all required segments",
+			segments:   []*dbmodel.SegmentAndMetadata{vector, metadata},
+			fromStates: []string{string(model.CollectionStateCreating), string(model.CollectionStateDegraded)},
+			toState:    model.CollectionStateReady,
+		},
+		{
+			name:       "missing metadata segment",
+			segments:   []*dbmodel.SegmentAndMetadata{vector},
+			fromStates: []string{string(model.CollectionStateReady)},
+			toState:    model.CollectionStateDegraded,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockMetaDomain := &mocks.IMetaDomain{}
+			catalog := NewTableCatalog(&mocks.ITransaction{}, mockMetaDomain)
+
+			mockCollectionDb := &mocks.ICollectionDb{}
+			mockSegmentDb := &mocks.ISegmentDb{}
+			mockMetaDomain.On("CollectionDb", context.Background()).Return(mockCollectionDb)
+			mockMetaDomain.On("SegmentDb", context.Background()).Return(mockSegmentDb)
+			mockSegmentDb.On("GetSegments", types.NilUniqueID(), (*string)(nil), (*string)(nil), collectionID, (*int32)(nil), (*int32)(nil)).Return(tt.segments, nil)
+			mockCollectionDb.On("UpdateState", dbmodel.NewCollectionID(collectionID), tt.fromStates, string(tt.toState)).Return(int64(1), nil)
+
+			err := catalog.refreshCollectionState(context.Background(), collectionID)
+			assert.NoError(t, err)
+			mockCollectionDb.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCatalog_UpdateCollectionDimensionMarksReindex(t *testing.T) {
+	// create a mock transaction implementation that runs the callback
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithWriteTx", context.Background(), mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+
+	// create a mock meta domain implementation
+	mockMetaDomain := &mocks.IMetaDomain{}
+
+	// create a new catalog instance
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+
+	collectionID := types.MustParse("00000000-0000-0000-0000-000000000001")
+	name := "test_collection"
+	current := int32(128)
+	dimension := int32(256)
+
+	mockCollectionDb := &mocks.ICollectionDb{}
+	mockMetaDomain.On("CollectionDb", context.Background()).Return(mockCollectionDb)
+
+	var n *int32
+	var f []*model.CollectionMetadataFilter
+	mockCollectionDb.On("GetCollections", dbmodel.CollectionIDFromUniqueID(collectionID), (*string)(nil), defaultTenant, defaultDatabase, n, n, f).Return([]*dbmodel.CollectionAndMetadata{
+		{Collection: &dbmodel.Collection{ID: dbmodel.NewCollectionID(collectionID), Name: &name, Dimension: &current}},
+	}, nil)
+	mockCollectionDb.On("GetForUpdate", dbmodel.NewCollectionID(collectionID)).Return(&dbmodel.Collection{ID: dbmodel.NewCollectionID(collectionID), Name: &name, Dimension: &current, Revision: 3}, nil)
+	mark := mockCollectionDb.On("MarkReindexPending", dbmodel.NewCollectionID(collectionID), &current).Return(nil)
+	mockCollectionDb.On("Update", mock.MatchedBy(func(collection *dbmodel.Collection) bool {
+		return collection.Revision == 4
+	})).Return(nil).NotBefore(mark)
+	mockNotificationDb := &mocks.INotificationDb{}
+	mockMetaDomain.On("NotificationDb", context.Background()).Return(mockNotificationDb)
+	mockNotificationDb.On("Insert", mock.MatchedBy(func(notification *dbmodel.Notification) bool {
+		return notification.Type == dbmodel.NotificationTypeUpdateCollection
+	})).Return(nil)
+
+	_, changedFields, err := catalog.UpdateCollection(context.Background(), &model.UpdateCollection{
+		ID:           collectionID,
+		Dimension:    &dimension,
+		TenantID:     defaultTenant,
+		DatabaseName: defaultDatabase,
+	}, types.Timestamp(0))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{model.CollectionFieldDimension}, changedFields)
+	mockCollectionDb.AssertExpectations(t)
+	mockNotificationDb.AssertExpectations(t)
+}
+
+func TestCatalog_UpdateCollectionRevision(t *testing.T) {
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithWriteTx", context.Background(), mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+	mockMetaDomain := &mocks.IMetaDomain{}
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+
+	collectionID := types.MustParse("00000000-0000-0000-0000-000000000001")
+	name := "test_collection"
+	mockCollectionDb := &mocks.ICollectionDb{}
+	mockMetaDomain.On("CollectionDb", context.Background()).Return(mockCollectionDb)
+	mockCollectionDb.On("GetForUpdate", dbmodel.NewCollectionID(collectionID)).Return(&dbmodel.Collection{ID: dbmodel.NewCollectionID(collectionID), Name: &name, ReplicationFactor: 1, Revision: 3}, nil)
+
+	// a stale expected revision fails before anything is written
+	stale := int64(2)
+	_, _, err := catalog.UpdateCollection(context.Background(), &model.UpdateCollection{ID: collectionID, Name: &name, ExpectedRevision: &stale}, types.Timestamp(0))
+	assert.ErrorIs(t, err, common.ErrCollectionRevisionMismatch)
+
+	// fields set to their current value are neither written nor reported
+	var n *int32
+	var f []*model.CollectionMetadataFilter
+	mockCollectionDb.On("GetCollections", dbmodel.CollectionIDFromUniqueID(collectionID), (*string)(nil), "", "", n, n, f).Return([]*dbmodel.CollectionAndMetadata{
+		{Collection: &dbmodel.Collection{ID: dbmodel.NewCollectionID(collectionID), Name: &name, ReplicationFactor: 1, Revision: 3}},
+	}, nil)
+	expected := int64(3)
+	replicationFactor := int32(1)
+	collection, changedFields, err := catalog.UpdateCollection(context.Background(), &model.UpdateCollection{ID: collectionID, Name: &name, ReplicationFactor: &replicationFactor, ExpectedRevision: &expected}, types.Timestamp(0))
+	assert.NoError(t, err)
+	assert.Empty(t, changedFields)
+	assert.Equal(t, int64(3), collection.Revision)
+	mockCollectionDb.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestCatalog_RenameCollection(t *testing.T) {
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithWriteTx", context.Background(), mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+	mockMetaDomain := &mocks.IMetaDomain{}
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+
+	collectionID := types.MustParse("00000000-0000-0000-0000-000000000001")
+	dbCollectionID := dbmodel.NewCollectionID(collectionID)
+	name, renamed, taken := "test_collection", "renamed", "taken"
+	mockCollectionDb := &mocks.ICollectionDb{}
+	mockMetaDomain.On("CollectionDb", context.Background()).Return(mockCollectionDb)
+	mockCollectionDb.On("GetForUpdate", dbCollectionID).Return(&dbmodel.Collection{ID: dbCollectionID, Name: &name, Revision: 3}, nil)
+	mockCollectionDb.On("UpdateCollectionName", dbCollectionID, renamed).Return(int64(1), nil)
+	mockCollectionDb.On("UpdateCollectionName", dbCollectionID, taken).Return(int64(0), &common.CollectionAlreadyExistsError{Name: taken})
+	var n *int32
+	var f []*model.CollectionMetadataFilter
+	mockCollectionDb.On("GetCollections", dbmodel.CollectionIDFromUniqueID(collectionID), (*string)(nil), "", "", n, n, f).Return([]*dbmodel.CollectionAndMetadata{
+		{Collection: &dbmodel.Collection{ID: dbCollectionID, Name: &renamed, Revision: 4}},
+	}, nil)
+	mockNotificationDb := &mocks.INotificationDb{}
+	mockMetaDomain.On("NotificationDb", context.Background()).Return(mockNotificationDb)
+	mockNotificationDb.On("Insert", mock.Anything).Return(nil)
+
+	stale := int64(2)
+	_, err := catalog.RenameCollection(context.Background(), &model.RenameCollection{ID: collectionID, Name: renamed, ExpectedRevision: &stale})
+	assert.ErrorIs(t, err, common.ErrCollectionRevisionMismatch)
+
+	// the name of another collection is a typed error, not a constraint violation
+	_, err = catalog.RenameCollection(context.Background(), &model.RenameCollection{ID: collectionID, Name: taken})
+	assert.True(t, common.IsCollectionAlreadyExists(err))
+	assert.ErrorIs(t, err, common.ErrCollectionUniqueConstraintViolation)
+
+	expected := int64(3)
+	collection, err := catalog.RenameCollection(context.Background(), &model.RenameCollection{ID: collectionID, Name: renamed, ExpectedRevision: &expected})
+	assert.NoError(t, err)
+	assert.Equal(t, renamed, collection.Name)
+	assert.Equal(t, int64(4), collection.Revision)
+
+	// renaming a collection to its name writes nothing
+	_, err = catalog.RenameCollection(context.Background(), &model.RenameCollection{ID: collectionID, Name: name})
+	assert.NoError(t, err)
+	mockCollectionDb.AssertNotCalled(t, "UpdateCollectionName", dbCollectionID, name)
+	// only the rename that changed the name is sent
+	mockNotificationDb.AssertNumberOfCalls(t, "Insert", 1)
+}
+
+func TestCatalog_GetCollectionMetadataDiff(t *testing.T) {
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithReadTx", context.Background(), mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+	mockMetaDomain := &mocks.IMetaDomain{}
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+
+	collectionID := types.MustParse("00000000-0000-0000-0000-000000000001")
+	dbCollectionID := dbmodel.NewCollectionID(collectionID)
+	mockCollectionDb := &mocks.ICollectionDb{}
+	mockHistoryDb := &mocks.ICollectionMetadataHistoryDb{}
+	mockMetaDomain.On("CollectionDb", context.Background()).Return(mockCollectionDb)
+	mockMetaDomain.On("CollectionMetadataHistoryDb", context.Background()).Return(mockHistoryDb)
+
+	name := "test_collection"
+	sameKey, changedKey, addedKey, removedKey := "same", "changed", "added", "removed"
+	oldValue, newValue := "old", "new"
+	one, two := int64(1), int64(2)
+	var n *int32
+	var f []*model.CollectionMetadataFilter
+	mockCollectionDb.On("GetCollections", dbmodel.CollectionIDFromUniqueID(collectionID), (*string)(nil), "", "", n, n, f).Return([]*dbmodel.CollectionAndMetadata{
+		{
+			Collection: &dbmodel.Collection{ID: dbCollectionID, Name: &name, Revision: 5},
+			CollectionMetadata: []*dbmodel.CollectionMetadata{
+				{Key: &sameKey, IntValue: &one},
+				{Key: &changedKey, StrValue: &newValue},
+				{Key: &addedKey, IntValue: &two},
+			},
+		},
+	}, nil)
+	mockHistoryDb.On("GetAt", dbCollectionID, int64(2)).Return([]*dbmodel.CollectionMetadata{
+		{Key: &sameKey, IntValue: &one},
+		{Key: &changedKey, StrValue: &oldValue},
+		{Key: &removedKey, StrValue: &oldValue},
+	}, true, nil)
+	mockHistoryDb.On("GetAt", dbCollectionID, int64(1)).Return(nil, false, nil)
+
+	diff, err := catalog.GetCollectionMetadataDiff(context.Background(), collectionID, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, &model.CollectionMetadataDiff{
+		FromRevision: 2,
+		ToRevision:   5,
+		Changes: []*model.CollectionMetadataChange{
+			{Key: addedKey, To: &model.CollectionMetadataValueInt64Type{Value: 2}},
+			{Key: changedKey, From: &model.CollectionMetadataValueStringType{Value: oldValue}, To: &model.CollectionMetadataValueStringType{Value: newValue}},
+			{Key: removedKey, From: &model.CollectionMetadataValueStringType{Value: oldValue}},
+		},
+	}, diff)
+
+	// revisions before the history and after the collection are not known
+	_, err = catalog.GetCollectionMetadataDiff(context.Background(), collectionID, 1)
+	assert.ErrorIs(t, err, common.ErrCollectionRevisionNotInHistory)
+	_, err = catalog.GetCollectionMetadataDiff(context.Background(), collectionID, 6)
+	assert.ErrorIs(t, err, common.ErrCollectionRevisionNotInHistory)
+}
+
+func TestCatalog_BatchUpdateCollectionMetadata(t *testing.T) {
+	// create a mock transaction implementation that runs the callback
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithWriteTx", context.Background(), mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+
+	// create a mock meta domain implementation
+	mockMetaDomain := &mocks.IMetaDomain{}
+
+	// create a new catalog instance
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+
+	patchedID := types.MustParse("00000000-0000-0000-0000-000000000001")
+	missingID := types.MustParse("00000000-0000-0000-0000-000000000002")
+	name := "test_collection"
+	keepKey, dropKey, value := "keep", "drop", "value"
+
+	mockCollectionDb := &mocks.ICollectionDb{}
+	mockCollectionMetadataDb := &mocks.ICollectionMetadataDb{}
+	mockHistoryDb := &mocks.ICollectionMetadataHistoryDb{}
+	mockMetaDomain.On("CollectionDb", context.Background()).Return(mockCollectionDb)
+	mockMetaDomain.On("CollectionMetadataDb", context.Background()).Return(mockCollectionMetadataDb)
+	mockMetaDomain.On("CollectionMetadataHistoryDb", context.Background()).Return(mockHistoryDb)
+	mockNotificationDb := &mocks.INotificationDb{}
+	mockMetaDomain.On("NotificationDb", context.Background()).Return(mockNotificationDb)
+	mockNotificationDb.On("Insert", mock.Anything).Return(nil)
+
+	mockCollectionDb.On("GetForUpdate", dbmodel.NewCollectionID(patchedID)).Return(&dbmodel.Collection{ID: dbmodel.NewCollectionID(patchedID), Name: &name, TenantID: defaultTenant, Revision: 3, DistanceFunction: "cosine"}, nil)
+	mockCollectionDb.On("GetForUpdate", dbmodel.NewCollectionID(missingID)).Return(nil, nil)
+	previous := []*dbmodel.CollectionMetadata{
+		{Key: &keepKey, StrValue: &value},
+		{Key: &dropKey, StrValue: &value},
+	}
+	mockCollectionMetadataDb.On("GetByCollectionID", dbmodel.NewCollectionID(patchedID)).Return(previous, nil)
+	// the patch is a new revision of the collection, with its metadata recorded
+	mockCollectionDb.On("Update", &dbmodel.Collection{ID: dbmodel.NewCollectionID(patchedID), Revision: 4}).Return(nil)
+	mockHistoryDb.On("Record", dbmodel.NewCollectionID(patchedID), defaultTenant, int64(3), previous).Return(nil)
+	mockHistoryDb.On("Record", dbmodel.NewCollectionID(patchedID), defaultTenant, int64(4), mock.Anything).Return(nil)
+	mockCollectionMetadataDb.On("DeleteByCollectionID", dbmodel.NewCollectionID(patchedID)).Return(2, nil)
+	mockCollectionMetadataDb.On("Insert", mock.MatchedBy(func(in []*dbmodel.CollectionMetadata) bool {
+		keys := map[string]bool{}
+		for _, metadata := range in {
+			keys[*metadata.Key] = true
+		}
+		// the metadata keeps the distance function of the collection
+		return len(keys) == 3 && keys[keepKey] && keys["added"] && keys[model.DistanceFunctionMetadataKey]
+	})).Return(nil)
+
+	set := model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	set.Add("added", &model.CollectionMetadataValueInt64Type{Value: 1})
+	patch := &model.CollectionMetadataPatch{Set: set, Delete: []string{dropKey}}
+	var validated *model.CollectionMetadata[model.CollectionMetadataValueType]
+	results := catalog.BatchUpdateCollectionMetadata(context.Background(), []types.UniqueID{patchedID, missingID}, patch, func(metadata *model.CollectionMetadata[model.CollectionMetadataValueType]) error {
+		validated = metadata
+		return nil
+	})
+	assert.Len(t, results, 2)
+	assert.Equal(t, patchedID, results[0].ID)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, missingID, results[1].ID)
+	assert.ErrorIs(t, results[1].Err, common.ErrCollectionNotFound)
+	assert.Len(t, validated.Metadata, 3)
+	mockCollectionMetadataDb.AssertExpectations(t)
+	mockHistoryDb.AssertExpectations(t)
+
+	// the distance function cannot be changed
+	set = model.NewCollectionMetadata[model.CollectionMetadataValueType]()
+	set.Add(model.DistanceFunctionMetadataKey, &model.CollectionMetadataValueStringType{Value: "l2"})
+	results = catalog.BatchUpdateCollectionMetadata(context.Background(), []types.UniqueID{patchedID}, &model.CollectionMetadataPatch{Set: set}, func(metadata *model.CollectionMetadata[model.CollectionMetadataValueType]) error {
+		return nil
+	})
+	assert.Len(t, results, 1)
+	assert.ErrorIs(t, results[0].Err, common.ErrInvalidCollectionConfiguration)
+}
+
+func TestCatalog_DeleteCollections(t *testing.T) {
+	// create a mock transaction implementation that runs the callback
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithWriteTx", context.Background(), mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+
+	// create a mock meta domain implementation
+	mockMetaDomain := &mocks.IMetaDomain{}
+
+	// create a new catalog instance
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+
+	deletedID := types.MustParse("00000000-0000-0000-0000-000000000001")
+	missingID := types.MustParse("00000000-0000-0000-0000-000000000002")
+	name := "test_collection"
+
+	mockCollectionDb := &mocks.ICollectionDb{}
+	mockNotificationDb := &mocks.INotificationDb{}
+	mockLifecycleDb := &mocks.ICollectionLifecycleDb{}
+	mockMetaDomain.On("CollectionDb", context.Background()).Return(mockCollectionDb)
+	mockMetaDomain.On("NotificationDb", context.Background()).Return(mockNotificationDb)
+	mockMetaDomain.On("CollectionLifecycleDb", context.Background()).Return(mockLifecycleDb)
+
+	var n *int32
+	var f []*model.CollectionMetadataFilter
+	mockCollectionDb.On("GetCollections", dbmodel.CollectionIDFromUniqueID(deletedID), (*string)(nil), defaultTenant, defaultDatabase, n, n, f).Return([]*dbmodel.CollectionAndMetadata{
+		{Collection: &dbmodel.Collection{ID: dbmodel.NewCollectionID(deletedID), Name: &name}, TenantID: defaultTenant},
+	}, nil)
+	mockCollectionDb.On("GetCollections", dbmodel.CollectionIDFromUniqueID(missingID), (*string)(nil), defaultTenant, defaultDatabase, n, n, f).Return(nil, nil)
+	mockCollectionDb.On("SoftDeleteCollectionByID", dbmodel.NewCollectionID(deletedID), "admin").Return(int64(1), nil)
+	// the collection keeps its size and is taken off the usage counters
+	databaseID := dbmodel.NewDatabaseID(types.MustParse("00000000-0000-0000-0000-000000000003"))
+	mockCollectionDb.On("GetForUpdate", dbmodel.NewCollectionID(deletedID)).Return(&dbmodel.Collection{ID: dbmodel.NewCollectionID(deletedID), DatabaseID: databaseID, TotalRecordsPostCompaction: 42, SizeBytesPostCompaction: 4096}, nil)
+	mockUsageCounterDb := &mocks.IUsageCounterDb{}
+	mockMetaDomain.On("UsageCounterDb", context.Background()).Return(mockUsageCounterDb)
+	mockUsageCounterDb.On("Increment", defaultTenant, databaseID.String(), int64(-42), int64(-4096)).Return(nil)
+	mockUsageCounterDb.On("Increment", defaultTenant, "", int64(-42), int64(-4096)).Return(nil)
+	mockNotificationDb.On("Insert", mock.Anything).Return(nil)
+	mockLifecycleDb.On("Increment", defaultTenant, mock.Anything, dbmodel.LifecycleEventDeleted, int64(1)).Return(nil)
+
+	results := catalog.DeleteCollections(context.Background(), defaultTenant, defaultDatabase, []types.UniqueID{deletedID, missingID}, "admin")
+	assert.Len(t, results, 2)
+	assert.NoError(t, results[0].Err)
+	assert.ErrorIs(t, results[1].Err, common.ErrCollectionDeleteNonExistingCollection)
+	mockCollectionDb.AssertExpectations(t)
+	mockCollectionDb.AssertNotCalled(t, "UpdateCollectionSize", mock.Anything, mock.Anything, mock.Anything)
+	mockUsageCounterDb.AssertExpectations(t)
+	mockLifecycleDb.AssertNumberOfCalls(t, "Increment", 1)
+}
+
+func TestCatalog_RestoreCollection(t *testing.T) {
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithWriteTx", context.Background(), mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+	mockMetaDomain := &mocks.IMetaDomain{}
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+
+	collectionID, missingID := types.NewUniqueID(), types.NewUniqueID()
+	dbCollectionID := dbmodel.NewCollectionID(collectionID)
+	deletedName := "test_collection" + dbmodel.DeletedCollectionNameInfix + collectionID.String()
+	mockCollectionDb := &mocks.ICollectionDb{}
+	mockSegmentDb := &mocks.ISegmentDb{}
+	mockLifecycleDb := &mocks.ICollectionLifecycleDb{}
+	mockMetaDomain.On("CollectionDb", context.Background()).Return(mockCollectionDb)
+	mockMetaDomain.On("SegmentDb", context.Background()).Return(mockSegmentDb)
+	mockMetaDomain.On("CollectionLifecycleDb", context.Background()).Return(mockLifecycleDb)
+	mockNotificationDb := &mocks.INotificationDb{}
+	mockMetaDomain.On("NotificationDb", context.Background()).Return(mockNotificationDb)
+	mockNotificationDb.On("Insert", mock.MatchedBy(func(notification *dbmodel.Notification) bool {
+		return notification.Type == dbmodel.NotificationTypeCreateCollection
+	})).Return(nil)
+
+	mockCollectionDb.On("GetDeletedCollection", dbmodel.NewCollectionID(missingID)).Return(nil, nil)
+	_, err := catalog.RestoreCollection(context.Background(), missingID, nil)
+	assert.ErrorIs(t, err, common.ErrCollectionNotFound)
+
+	// the collection gets its original name back, and is degraded without its
+	// segments
+	databaseID := dbmodel.NewDatabaseID(types.NewUniqueID())
+	mockCollectionDb.On("GetDeletedCollection", dbCollectionID).Return(&dbmodel.Collection{ID: dbCollectionID, Name: &deletedName, TenantID: defaultTenant, DatabaseID: databaseID, TotalRecordsPostCompaction: 42, SizeBytesPostCompaction: 4096}, nil)
+	mockSegmentDb.On("GetSegments", types.NilUniqueID(), (*string)(nil), (*string)(nil), collectionID, (*int32)(nil), (*int32)(nil)).Return([]*dbmodel.SegmentAndMetadata{
+		{Segment: &dbmodel.Segment{Scope: "VECTOR"}},
+	}, nil)
+	mockCollectionDb.On("RestoreCollectionByID", dbCollectionID, "test_collection", string(model.CollectionStateDegraded)).Return(int64(0), common.ErrCollectionUniqueConstraintViolation)
+	_, err = catalog.RestoreCollection(context.Background(), collectionID, nil)
+	assert.ErrorIs(t, err, common.ErrCollectionUniqueConstraintViolation)
+
+	// the name is taken, so the collection is restored under another one
+	newName := "test_collection_restored"
+	var n *int32
+	var f []*model.CollectionMetadataFilter
+	mockCollectionDb.On("RestoreCollectionByID", dbCollectionID, newName, string(model.CollectionStateDegraded)).Return(int64(1), nil)
+	mockLifecycleDb.On("Increment", defaultTenant, mock.Anything, dbmodel.LifecycleEventRestored, int64(1)).Return(nil)
+	// the size the collection kept is counted again
+	mockUsageCounterDb := &mocks.IUsageCounterDb{}
+	mockMetaDomain.On("UsageCounterDb", context.Background()).Return(mockUsageCounterDb)
+	mockUsageCounterDb.On("Increment", defaultTenant, databaseID.String(), int64(42), int64(4096)).Return(nil)
+	mockUsageCounterDb.On("Increment", defaultTenant, "", int64(42), int64(4096)).Return(nil)
+	mockCollectionDb.On("GetCollections", &dbCollectionID, (*string)(nil), "", "", n, n, f).Return([]*dbmodel.CollectionAndMetadata{
+		{Collection: &dbmodel.Collection{ID: dbCollectionID, Name: &newName}, TenantID: defaultTenant},
+	}, nil)
+	collection, err := catalog.RestoreCollection(context.Background(), collectionID, &newName)
+	assert.NoError(t, err)
+	assert.Equal(t, newName, collection.Name)
+	mockLifecycleDb.AssertNumberOfCalls(t, "Increment", 1)
+	mockUsageCounterDb.AssertExpectations(t)
+}
+
+func TestCatalog_CheckCollections(t *testing.T) {
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithReadTx", context.Background(), mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+	mockMetaDomain := &mocks.IMetaDomain{}
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+
+	live, deleted, missing := types.NewUniqueID(), types.NewUniqueID(), types.NewUniqueID()
+	mockCollectionDb := &mocks.ICollectionDb{}
+	mockMetaDomain.On("CollectionDb", context.Background()).Return(mockCollectionDb)
+	mockCollectionDb.On("GetCollectionsByIDs", []dbmodel.CollectionID{dbmodel.NewCollectionID(missing), dbmodel.NewCollectionID(live), dbmodel.NewCollectionID(deleted)}).Return([]*dbmodel.Collection{
+		{ID: dbmodel.NewCollectionID(deleted), SoftDelete: dbmodel.SoftDelete{IsDeleted: true}},
+		{ID: dbmodel.NewCollectionID(live)},
+	}, nil)
+
+	// results follow the order of the request
+	collections, err := catalog.CheckCollections(context.Background(), []types.UniqueID{missing, live, deleted})
+	assert.NoError(t, err)
+	assert.Equal(t, []*model.CollectionExistence{
+		{ID: missing},
+		{ID: live, Exists: true},
+		{ID: deleted, Exists: true, IsDeleted: true},
+	}, collections)
+	mockCollectionDb.AssertExpectations(t)
+}
+
+func TestCatalog_GetInconsistentCollections(t *testing.T) {
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithReadTx", context.Background(), mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+	mockMetaDomain := &mocks.IMetaDomain{}
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+	mockCollectionDb := &mocks.ICollectionDb{}
+	mockMetaDomain.On("CollectionDb", context.Background()).Return(mockCollectionDb)
+
+	deletedID, missingID := types.NewUniqueID(), types.NewUniqueID()
+	segmentID := types.NewUniqueID()
+	deletedName := "deleted" + dbmodel.DeletedCollectionNameInfix + deletedID.String()
+	missingName := "missing"
+	deletedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	deletedBefore := time.Now()
+	mockCollectionDb.On("GetInconsistentCollections", deletedBefore, requiredSegmentScopes, []model.InconsistencyKind(nil)).Return([]*dbmodel.InconsistentCollection{
+		{
+			Collection: &dbmodel.Collection{ID: dbmodel.NewCollectionID(deletedID), Name: &deletedName, SoftDelete: dbmodel.SoftDelete{DeletedAt: &deletedAt}},
+			TenantID:   defaultTenant,
+			Kind:       model.InconsistencySoftDeletedWithSegments,
+			SegmentIDs: []dbmodel.SegmentID{dbmodel.NewSegmentID(segmentID)},
+			Scopes:     []string{"VECTOR"},
+		},
+		{
+			Collection: &dbmodel.Collection{ID: dbmodel.NewCollectionID(missingID), Name: &missingName},
+			TenantID:   defaultTenant,
+			Kind:       model.InconsistencyMissingSegments,
+			Scopes:     []string{"VECTOR"},
+		},
+	}, nil)
+
+	collections, err := catalog.GetInconsistentCollections(context.Background(), deletedBefore, nil)
+	assert.NoError(t, err)
+	assert.Len(t, collections, 2)
+	assert.Equal(t, "deleted", collections[0].Name)
+	assert.Equal(t, []types.UniqueID{segmentID}, collections[0].SegmentIDs)
+	assert.Contains(t, collections[0].Description, "2026-01-02T03:04:05Z")
+	assert.Contains(t, collections[0].Description, "RestoreCollection")
+	assert.Equal(t, missingID, collections[1].ID)
+	assert.Contains(t, collections[1].Description, "without a METADATA segment")
+}
+
+func TestCatalog_EmbeddingFunctions(t *testing.T) {
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithWriteTx", context.Background(), mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+	mockMetaDomain := &mocks.IMetaDomain{}
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+
+	mockCollectionDb := &mocks.ICollectionDb{}
+	mockEmbeddingFunctionDb := &mocks.IEmbeddingFunctionDb{}
+	mockMetaDomain.On("CollectionDb", context.Background()).Return(mockCollectionDb)
+	mockMetaDomain.On("EmbeddingFunctionDb", context.Background()).Return(mockEmbeddingFunctionDb)
+
+	usedID, unusedID, missingID := types.NewUniqueID(), types.NewUniqueID(), types.NewUniqueID()
+	mockEmbeddingFunctionDb.On("GetForUpdate", missingID.String()).Return(nil, nil)
+	mockEmbeddingFunctionDb.On("GetForUpdate", usedID.String()).Return(&dbmodel.EmbeddingFunction{ID: usedID.String(), TenantID: "tenant"}, nil)
+	mockEmbeddingFunctionDb.On("GetForUpdate", unusedID.String()).Return(&dbmodel.EmbeddingFunction{ID: unusedID.String(), TenantID: "tenant", Name: "openai", Provider: "openai", Params: "{}"}, nil)
+	mockCollectionDb.On("CountCollectionsByEmbeddingFunctionID", usedID.String()).Return(int64(2), nil)
+	mockCollectionDb.On("CountCollectionsByEmbeddingFunctionID", unusedID.String()).Return(int64(0), nil)
+	mockEmbeddingFunctionDb.On("Delete", unusedID.String()).Return(true, nil)
+
+	err := catalog.DeleteEmbeddingFunction(context.Background(), missingID)
+	assert.ErrorIs(t, err, common.ErrEmbeddingFunctionNotFound)
+	// referenced embedding functions are kept
+	err = catalog.DeleteEmbeddingFunction(context.Background(), usedID)
+	assert.ErrorIs(t, err, common.ErrEmbeddingFunctionInUse)
+	mockEmbeddingFunctionDb.AssertNotCalled(t, "Delete", usedID.String())
+	err = catalog.DeleteEmbeddingFunction(context.Background(), unusedID)
+	assert.NoError(t, err)
+
+	// only the fields that are set change
+	embeddingModel := "text-embedding-3-small"
+	mockEmbeddingFunctionDb.On("Update", mock.MatchedBy(func(in *dbmodel.EmbeddingFunction) bool {
+		return in.ID == unusedID.String() && in.Name == "openai" && in.Model == embeddingModel && in.Params == "{}"
+	})).Return(nil)
+	updated, err := catalog.UpdateEmbeddingFunction(context.Background(), &model.UpdateEmbeddingFunction{ID: unusedID, Model: &embeddingModel})
+	assert.NoError(t, err)
+	assert.Equal(t, embeddingModel, updated.Model)
+	assert.Equal(t, "openai", updated.Provider)
+	_, err = catalog.UpdateEmbeddingFunction(context.Background(), &model.UpdateEmbeddingFunction{ID: missingID, Model: &embeddingModel})
+	assert.ErrorIs(t, err, common.ErrEmbeddingFunctionNotFound)
+
+	// collections only reference the embedding functions of their tenant
+	_, err = catalog.lockEmbeddingFunction(context.Background(), usedID, "other_tenant")
+	assert.ErrorIs(t, err, common.ErrEmbeddingFunctionNotFound)
+	id, err := catalog.lockEmbeddingFunction(context.Background(), usedID, "tenant")
+	assert.NoError(t, err)
+	assert.Equal(t, usedID.String(), *id)
+}
+
+func TestCatalog_SetCollectionSchema(t *testing.T) {
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithWriteTx", context.Background(), mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+	mockMetaDomain := &mocks.IMetaDomain{}
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+
+	mockCollectionDb := &mocks.ICollectionDb{}
+	mockCollectionSchemaFieldDb := &mocks.ICollectionSchemaFieldDb{}
+	mockMetaDomain.On("CollectionDb", context.Background()).Return(mockCollectionDb)
+	mockMetaDomain.On("CollectionSchemaFieldDb", context.Background()).Return(mockCollectionSchemaFieldDb)
+
+	collectionID, missingID := types.NewUniqueID(), types.NewUniqueID()
+	mockCollectionDb.On("GetForUpdate", dbmodel.NewCollectionID(missingID)).Return(nil, nil)
+	mockCollectionDb.On("GetForUpdate", dbmodel.NewCollectionID(collectionID)).Return(&dbmodel.Collection{ID: dbmodel.NewCollectionID(collectionID), TenantID: "tenant"}, nil)
+	mockCollectionSchemaFieldDb.On("Replace", dbmodel.NewCollectionID(collectionID), "tenant", mock.MatchedBy(func(fields []*dbmodel.CollectionSchemaField) bool {
+		return len(fields) == 1 && fields[0].Name == "year" && fields[0].Type == "int" && fields[0].Indexed
+	})).Return(nil)
+
+	fields := []*model.CollectionSchemaField{{Name: "year", Type: model.CollectionSchemaFieldInt, Indexed: true}}
+	err := catalog.SetCollectionSchema(context.Background(), missingID, fields)
+	assert.ErrorIs(t, err, common.ErrCollectionNotFound)
+	mockCollectionSchemaFieldDb.AssertNotCalled(t, "Replace", dbmodel.NewCollectionID(missingID), mock.Anything, mock.Anything)
+	err = catalog.SetCollectionSchema(context.Background(), collectionID, fields)
+	assert.NoError(t, err)
+	mockCollectionSchemaFieldDb.AssertExpectations(t)
+}
+
+func TestCatalog_DeleteCollectionTemplate(t *testing.T) {
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithWriteTx", context.Background(), mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+	mockMetaDomain := &mocks.IMetaDomain{}
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+
+	mockCollectionDb := &mocks.ICollectionDb{}
+	mockCollectionTemplateDb := &mocks.ICollectionTemplateDb{}
+	mockMetaDomain.On("CollectionDb", context.Background()).Return(mockCollectionDb)
+	mockMetaDomain.On("CollectionTemplateDb", context.Background()).Return(mockCollectionTemplateDb)
+
+	templateID, missingID := types.NewUniqueID(), types.NewUniqueID()
+	mockCollectionTemplateDb.On("Delete", missingID.String()).Return(false, nil)
+	mockCollectionTemplateDb.On("Delete", templateID.String()).Return(true, nil)
+	mockCollectionDb.On("ClearTemplateID", templateID.String()).Return(int64(2), nil)
+
+	err := catalog.DeleteCollectionTemplate(context.Background(), missingID)
+	assert.ErrorIs(t, err, common.ErrCollectionTemplateNotFound)
+	// the collections of the template are detached from it
+	err = catalog.DeleteCollectionTemplate(context.Background(), templateID)
+	assert.NoError(t, err)
+	mockCollectionDb.AssertCalled(t, "ClearTemplateID", templateID.String())
+
+	mockCollectionTemplateDb.On("GetForShare", templateID.String()).Return(&dbmodel.CollectionTemplate{ID: templateID.String(), TenantID: "tenant"}, nil)
+	_, err = catalog.lockCollectionTemplate(context.Background(), templateID, "other_tenant")
+	assert.ErrorIs(t, err, common.ErrCollectionTemplateNotFound)
+}
+
+func TestCatalog_SetFeatureFlag(t *testing.T) {
+	mockTxImpl := &mocks.ITransaction{}
+	mockTxImpl.On("WithWriteTx", context.Background(), mock.Anything).Return(func(ctx context.Context, fn func(txCtx context.Context) error) error {
+		return fn(ctx)
+	})
+	mockMetaDomain := &mocks.IMetaDomain{}
+	catalog := NewTableCatalog(mockTxImpl, mockMetaDomain)
+
+	mockTenantDb := &mocks.ITenantDb{}
+	mockFeatureFlagDb := &mocks.IFeatureFlagDb{}
+	mockMetaDomain.On("TenantDb", context.Background()).Return(mockTenantDb)
+	mockMetaDomain.On("FeatureFlagDb", context.Background()).Return(mockFeatureFlagDb)
+
+	// the override of a tenant needs the tenant
+	mockTenantDb.On("GetTenants", "missing").Return([]*dbmodel.Tenant{}, nil)
+	err := catalog.SetFeatureFlag(context.Background(), &model.FeatureFlag{Name: "new_cache", TenantID: "missing", Enabled: true})
+	assert.ErrorIs(t, err, common.ErrTenantNotFound)
+
+	// the default of a flag does not
+	mockFeatureFlagDb.On("Upsert", &dbmodel.FeatureFlag{Name: "new_cache", Enabled: true, RolloutPercentage: 10}).Return(nil)
+	err = catalog.SetFeatureFlag(context.Background(), &model.FeatureFlag{Name: "new_cache", Enabled: true, RolloutPercentage: 10})
+	assert.NoError(t, err)
+	mockTenantDb.AssertNumberOfCalls(t, "GetTenants", 1)
+
+	mockFeatureFlagDb.On("Delete", "new_cache", "tenant").Return(false, nil)
+	err = catalog.DeleteFeatureFlag(context.Background(), "new_cache", "tenant")
+	assert.ErrorIs(t, err, common.ErrFeatureFlagNotFound)
+}
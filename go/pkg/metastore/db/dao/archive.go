@@ -0,0 +1,66 @@
+package dao
+
+import (
+	"encoding/json"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type archiveDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.IArchiveDb = &archiveDb{}
+
+func (s *archiveDb) ArchiveCollection(collection *dbmodel.CollectionAndMetadata, reason string, actor string) error {
+	record, err := json.Marshal(collection)
+	if err != nil {
+		return err
+	}
+	err = s.db.Create(&dbmodel.ArchivedCollection{
+		CollectionID: collection.Collection.ID,
+		DatabaseID:   collection.Collection.DatabaseID,
+		Record:       string(record),
+		Reason:       reason,
+		Actor:        actor,
+	}).Error
+	if err != nil {
+		log.Error("archive collection failed", zap.String("collectionID", collection.Collection.ID.String()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (s *archiveDb) ArchiveSegment(segment *dbmodel.SegmentAndMetadata, reason string, actor string) error {
+	record, err := json.Marshal(segment)
+	if err != nil {
+		return err
+	}
+	err = s.db.Create(&dbmodel.ArchivedSegment{
+		SegmentID:    segment.Segment.ID,
+		CollectionID: segment.Segment.CollectionID,
+		Record:       string(record),
+		Reason:       reason,
+		Actor:        actor,
+	}).Error
+	if err != nil {
+		log.Error("archive segment failed", zap.String("segmentID", segment.Segment.ID.String()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (s *archiveDb) GetArchivedCollections(collectionID dbmodel.CollectionID) ([]*dbmodel.ArchivedCollection, error) {
+	var archivedCollections []*dbmodel.ArchivedCollection
+	err := s.db.Where("collection_id = ?", collectionID).Order("id").Find(&archivedCollections).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, archivedCollection := range archivedCollections {
+		archivedCollection.ArchivedAt = archivedCollection.ArchivedAt.UTC()
+	}
+	return archivedCollections, nil
+}
@@ -0,0 +1,78 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type cleanupDeadLetterDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.ICleanupDeadLetterDb = &cleanupDeadLetterDb{}
+
+// RecordFailure counts a failed attempt of task on the collection and returns
+// the entry, dead lettered once it failed maxAttempts times.
+func (s *cleanupDeadLetterDb) RecordFailure(collectionID dbmodel.CollectionID, task string, tenantID string, lastError string, failedAt time.Time, maxAttempts int32) (*dbmodel.CleanupDeadLetter, error) {
+	entry := &dbmodel.CleanupDeadLetter{
+		CollectionID:  collectionID,
+		Task:          task,
+		TenantID:      tenantID,
+		Attempts:      1,
+		LastError:     lastError,
+		FirstFailedAt: failedAt.UTC(),
+		LastFailedAt:  failedAt.UTC(),
+		DeadLettered:  maxAttempts <= 1,
+	}
+	err := s.db.Clauses(clause.Returning{}, clause.OnConflict{
+		Columns: []clause.Column{{Name: "cluster_id"}, {Name: "collection_id"}, {Name: "task"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"attempts":       gorm.Expr("cleanup_dead_letters.attempts + 1"),
+			"last_error":     gorm.Expr("EXCLUDED.last_error"),
+			"last_failed_at": gorm.Expr("EXCLUDED.last_failed_at"),
+			"dead_lettered":  gorm.Expr("cleanup_dead_letters.attempts + 1 >= ?", maxAttempts),
+		}),
+	}).Create(entry).Error
+	if err != nil {
+		log.Error("record cleanup failure failed", zap.String("collectionID", collectionID.String()), zap.String("task", task), zap.Error(err))
+		return nil, err
+	}
+	return entry, nil
+}
+
+// List returns the entries of task, of every task when it is empty, the most
+// recently failed first.
+func (s *cleanupDeadLetterDb) List(task string, deadLetteredOnly bool) ([]*dbmodel.CleanupDeadLetter, error) {
+	var entries []*dbmodel.CleanupDeadLetter
+	query := s.db.Order("last_failed_at DESC, collection_id")
+	if task != "" {
+		query = query.Where("task = ?", task)
+	}
+	if deadLetteredOnly {
+		query = query.Where("dead_lettered = ?", true)
+	}
+	err := query.Find(&entries).Error
+	if err != nil {
+		log.Error("list cleanup dead letters failed", zap.Error(err))
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (s *cleanupDeadLetterDb) Delete(collectionID dbmodel.CollectionID, task string) (int64, error) {
+	result := s.db.Where("collection_id = ? AND task = ?", collectionID, task).Delete(&dbmodel.CleanupDeadLetter{})
+	if result.Error != nil {
+		log.Error("delete cleanup dead letter failed", zap.String("collectionID", collectionID.String()), zap.String("task", task), zap.Error(result.Error))
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+func (s *cleanupDeadLetterDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.CleanupDeadLetter{}).Error
+}
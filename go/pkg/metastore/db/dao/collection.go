@@ -2,15 +2,22 @@ package dao
 
 import (
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/jackc/pgx/v5/pgconn"
 	"gorm.io/gorm/clause"
 
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
 	"github.com/pingcap/log"
 )
@@ -25,25 +32,57 @@ func (s *collectionDb) DeleteAll() error {
 	return s.db.Where("1 = 1").Delete(&dbmodel.Collection{}).Error
 }
 
-func (s *collectionDb) GetCollections(id *string, name *string, tenantID string, databaseName string, limit *int32, offset *int32) (collectionWithMetdata []*dbmodel.CollectionAndMetadata, err error) {
-	var collections []*dbmodel.Collection
-	query := s.db.Table("collections").
-		Select("collections.id, collections.log_position, collections.version, collections.name, collections.dimension, collections.database_id, databases.name, databases.tenant_id").
+func (s *collectionDb) GetCollections(id *dbmodel.CollectionID, name *string, tenantID string, databaseName string, limit *int32, offset *int32, metadataFilters []*model.CollectionMetadataFilter) ([]*dbmodel.CollectionAndMetadata, error) {
+	return s.getCollections(id, nil, name, tenantID, databaseName, limit, offset, metadataFilters, true)
+}
+
+func (s *collectionDb) GetCollectionsWithoutMetadata(id *dbmodel.CollectionID, name *string, tenantID string, databaseName string, limit *int32, offset *int32, metadataFilters []*model.CollectionMetadataFilter) ([]*dbmodel.CollectionAndMetadata, error) {
+	return s.getCollections(id, nil, name, tenantID, databaseName, limit, offset, metadataFilters, false)
+}
+
+// GetLiveCollectionsByIDs returns the live collections among collectionIDs, with
+// one query for the collections and one for their metadata however many there
+// are. Unlike GetCollectionsByIDs it reads whole rows.
+func (s *collectionDb) GetLiveCollectionsByIDs(collectionIDs []dbmodel.CollectionID, withMetadata bool) ([]*dbmodel.CollectionAndMetadata, error) {
+	if len(collectionIDs) == 0 {
+		return []*dbmodel.CollectionAndMetadata{}, nil
+	}
+	return s.getCollections(nil, collectionIDs, nil, "", "", nil, nil, nil, withMetadata)
+}
+
+// liveCollections selects the columns findCollections reads of the live
+// collections of live databases.
+func (s *collectionDb) liveCollections() *gorm.DB {
+	return s.db.Table("collections").
+		Select("collections.id, collections.log_position, collections.version, collections.revision, collections.name, collections.dimension, collections.database_id, collections.state, collections.reindex_pending, collections.reindex_from_dimension, collections.kms_key_id, collections.replication_factor, collections.distance_function, collections.embedding_function_id, collections.template_id, collections.last_compactor, collections.last_compacted_at, collections.updated_at, databases.name, databases.tenant_id").
 		Joins("INNER JOIN databases ON collections.database_id = databases.id").
-		Order("collections.created_at ASC")
+		Scopes(notDeleted("collections"), notDeleted("databases"))
+}
+
+func (s *collectionDb) getCollections(id *dbmodel.CollectionID, ids []dbmodel.CollectionID, name *string, tenantID string, databaseName string, limit *int32, offset *int32, metadataFilters []*model.CollectionMetadataFilter, withMetadata bool) (collectionWithMetdata []*dbmodel.CollectionAndMetadata, err error) {
+	query := s.liveCollections().Order("collections.created_at ASC")
 
 	if databaseName != "" {
 		query = query.Where("databases.name = ?", databaseName)
 	}
 	if tenantID != "" {
-		query = query.Where("databases.tenant_id = ?", tenantID)
+		query = query.Where("databases.tenant_id = ? AND collections.tenant_id = ?", tenantID, tenantID)
 	}
 	if id != nil {
 		query = query.Where("collections.id = ?", *id)
 	}
+	if ids != nil {
+		query = query.Where("collections.id IN ?", ids)
+	}
 	if name != nil {
 		query = query.Where("collections.name = ?", *name)
 	}
+	for i, filter := range metadataFilters {
+		query, err = applyCollectionMetadataFilter(query, filter, fmt.Sprintf("cm%d", i), tenantID)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if limit != nil {
 		query = query.Limit(int(*limit))
@@ -52,25 +91,59 @@ func (s *collectionDb) GetCollections(id *string, name *string, tenantID string,
 		query = query.Offset(int(*offset))
 
 	}
+	return s.findCollections(query, withMetadata)
+}
+
+// ScanCollections returns the next limit collections after startAfter, by ID.
+func (s *collectionDb) ScanCollections(startAfter *dbmodel.CollectionID, limit int32) ([]*dbmodel.CollectionAndMetadata, error) {
+	query := s.liveCollections().
+		Order("collections.id").
+		Limit(int(limit))
+	if startAfter != nil {
+		query = query.Where("collections.id > ?", *startAfter)
+	}
+	collections, err := s.findCollections(query, true)
+	if err != nil {
+		log.Error("scan collections failed", zap.Error(err))
+		return nil, err
+	}
+	return collections, nil
+}
+
+// findCollections runs a query of liveCollections and loads the metadata of the
+// collections it returns when withMetadata is set.
+func (s *collectionDb) findCollections(query *gorm.DB, withMetadata bool) (collectionWithMetdata []*dbmodel.CollectionAndMetadata, err error) {
 	rows, err := query.Rows()
 	if err != nil {
 		return nil, err
 	}
-	collectionWithMetdata = make([]*dbmodel.CollectionAndMetadata, 0, len(collections))
+	collectionWithMetdata = make([]*dbmodel.CollectionAndMetadata, 0)
 	for rows.Next() {
 		var (
-			collectionID         string
+			collectionID         dbmodel.CollectionID
 			logPosition          int64
 			version              int32
+			revision             int64
 			collectionName       string
 			collectionDimension  sql.NullInt32
-			collectionDatabaseID string
+			collectionDatabaseID dbmodel.DatabaseID
+			collectionState      string
+			reindexPending       bool
+			reindexFromDimension sql.NullInt32
+			kmsKeyID             sql.NullString
+			replicationFactor    int32
+			distanceFunction     string
+			embeddingFunctionID  sql.NullString
+			templateID           sql.NullString
+			lastCompactor        sql.NullString
+			lastCompactedAt      sql.NullTime
 			collectionCreatedAt  sql.NullTime
+			collectionUpdatedAt  time.Time
 			databaseName         string
 			databaseTenantID     string
 		)
 
-		err := rows.Scan(&collectionID, &logPosition, &version, &collectionName, &collectionDimension, &collectionDatabaseID, &databaseName, &databaseTenantID)
+		err := rows.Scan(&collectionID, &logPosition, &version, &revision, &collectionName, &collectionDimension, &collectionDatabaseID, &collectionState, &reindexPending, &reindexFromDimension, &kmsKeyID, &replicationFactor, &distanceFunction, &embeddingFunctionID, &templateID, &lastCompactor, &lastCompactedAt, &collectionUpdatedAt, &databaseName, &databaseTenantID)
 		if err != nil {
 			log.Error("scan collection failed", zap.Error(err))
 			return nil, err
@@ -82,12 +155,39 @@ func (s *collectionDb) GetCollections(id *string, name *string, tenantID string,
 			DatabaseID:  collectionDatabaseID,
 			LogPosition: logPosition,
 			Version:     version,
+			Revision:    revision,
+			State:       collectionState,
+
+			UpdatedAt: collectionUpdatedAt.UTC(),
+
+			ReindexPending:    reindexPending,
+			ReplicationFactor: replicationFactor,
+			DistanceFunction:  distanceFunction,
 		}
 		if collectionDimension.Valid {
 			collection.Dimension = &collectionDimension.Int32
 		}
+		if reindexFromDimension.Valid {
+			collection.ReindexFromDimension = &reindexFromDimension.Int32
+		}
+		if kmsKeyID.Valid {
+			collection.KmsKeyID = &kmsKeyID.String
+		}
+		if embeddingFunctionID.Valid {
+			collection.EmbeddingFunctionID = &embeddingFunctionID.String
+		}
+		if templateID.Valid {
+			collection.TemplateID = &templateID.String
+		}
+		if lastCompactor.Valid {
+			collection.LastCompactor = &lastCompactor.String
+		}
+		if lastCompactedAt.Valid {
+			compactedAt := lastCompactedAt.Time.UTC()
+			collection.LastCompactedAt = &compactedAt
+		}
 		if collectionCreatedAt.Valid {
-			collection.CreatedAt = collectionCreatedAt.Time
+			collection.CreatedAt = collectionCreatedAt.Time.UTC()
 		}
 
 		collectionWithMetdata = append(collectionWithMetdata, &dbmodel.CollectionAndMetadata{
@@ -97,26 +197,318 @@ func (s *collectionDb) GetCollections(id *string, name *string, tenantID string,
 		})
 	}
 	rows.Close()
+	if !withMetadata || len(collectionWithMetdata) == 0 {
+		return
+	}
+	collectionIDs := make([]dbmodel.CollectionID, 0, len(collectionWithMetdata))
 	for _, collection := range collectionWithMetdata {
-		var metadata []*dbmodel.CollectionMetadata
-		err = s.db.Where("collection_id = ?", collection.Collection.ID).Find(&metadata).Error
-		if err != nil {
-			log.Error("get collection metadata failed", zap.Error(err))
-			return nil, err
+		collectionIDs = append(collectionIDs, collection.Collection.ID)
+	}
+	var metadata []*dbmodel.CollectionMetadata
+	err = s.db.Where("collection_id IN ?", collectionIDs).Find(&metadata).Error
+	if err != nil {
+		log.Error("get collection metadata failed", zap.Error(err))
+		return nil, err
+	}
+	metadataByCollection := make(map[dbmodel.CollectionID][]*dbmodel.CollectionMetadata, len(collectionWithMetdata))
+	for _, entry := range metadata {
+		metadataByCollection[entry.CollectionID] = append(metadataByCollection[entry.CollectionID], entry)
+	}
+	for _, collection := range collectionWithMetdata {
+		collection.CollectionMetadata = metadataByCollection[collection.Collection.ID]
+		if collection.CollectionMetadata == nil {
+			collection.CollectionMetadata = []*dbmodel.CollectionMetadata{}
 		}
-		collection.CollectionMetadata = metadata
 	}
 
 	return
 }
 
-func (s *collectionDb) DeleteCollectionByID(collectionID string) (int, error) {
+// applyCollectionMetadataFilter narrows the query with a correlated subquery on
+// collection_metadata, which is served by its (collection_id, key) primary key.
+// Equality filters join collection_metadata as alias instead, so that the
+// planner can start from the (tenant_id, key, value) indexes and only read the
+// collections that match rather than probe the metadata of every collection of
+// the tenant. A collection has at most one row per key, so the join cannot
+// return a collection twice.
+func applyCollectionMetadataFilter(query *gorm.DB, filter *model.CollectionMetadataFilter, alias string, tenantID string) (*gorm.DB, error) {
+	if filter.Field != model.CollectionFilterMetadata {
+		return applyCollectionColumnFilter(query, filter)
+	}
+	const subquery = "SELECT 1 FROM collection_metadata cm WHERE cm.collection_id = collections.id AND cm.key = ?"
+	switch filter.Operator {
+	case model.MetadataFilterExists:
+		return query.Where("EXISTS ("+subquery+")", filter.Key), nil
+	case model.MetadataFilterNotExists:
+		return query.Where("NOT EXISTS ("+subquery+")", filter.Key), nil
+	}
+
+	var column string
+	var value interface{}
+	numeric := false
+	switch v := filter.Value.(type) {
+	case *model.CollectionMetadataValueStringType:
+		column, value = "str_value", v.Value
+	case *model.CollectionMetadataValueInt64Type:
+		column, value, numeric = "int_value", v.Value, true
+	case *model.CollectionMetadataValueFloat64Type:
+		column, value, numeric = "float_value", v.Value, true
+	case *model.CollectionMetadataValueBoolType:
+		column, value = "bool_value", v.Value
+	default:
+		return nil, common.ErrInvalidMetadataFilter
+	}
+
+	var operator string
+	switch filter.Operator {
+	case model.MetadataFilterEQ:
+		join := "INNER JOIN collection_metadata " + alias + " ON " + alias + ".collection_id = collections.id AND " + alias + ".key = ? AND " + alias + "." + column + " = ?"
+		args := []interface{}{filter.Key, value}
+		if column == "str_value" {
+			// the string index is on md5(str_value)
+			join += " AND md5(" + alias + ".str_value) = md5(?)"
+			args = append(args, value)
+		}
+		if tenantID == "" {
+			return query.Joins(join, args...), nil
+		}
+		return query.Joins(join+" AND "+alias+".tenant_id = ?", append(args, tenantID)...), nil
+	case model.MetadataFilterNE:
+		return query.Where("NOT EXISTS ("+subquery+" AND cm."+column+" = ?)", filter.Key, value), nil
+	case model.MetadataFilterGT:
+		operator = ">"
+	case model.MetadataFilterGTE:
+		operator = ">="
+	case model.MetadataFilterLT:
+		operator = "<"
+	case model.MetadataFilterLTE:
+		operator = "<="
+	default:
+		return nil, common.ErrInvalidMetadataFilter
+	}
+	if !numeric {
+		return nil, common.ErrInvalidMetadataFilter
+	}
+	return query.Where("EXISTS ("+subquery+" AND cm."+column+" "+operator+" ?)", filter.Key, value), nil
+}
+
+// applyCollectionColumnFilter narrows the query on the typed column a filter
+// names. A collection without a dimension has no value, it only matches the not
+// exists and NE filters.
+func applyCollectionColumnFilter(query *gorm.DB, filter *model.CollectionMetadataFilter) (*gorm.DB, error) {
+	var column string
+	var value interface{}
+	numeric := false
+	switch filter.Field {
+	case model.CollectionFilterDimension:
+		column, numeric = "collections.dimension", true
+		if v, ok := filter.Value.(*model.CollectionMetadataValueInt64Type); ok {
+			value = v.Value
+		}
+	case model.CollectionFilterDistanceFunction:
+		column = "collections.distance_function"
+		if v, ok := filter.Value.(*model.CollectionMetadataValueStringType); ok {
+			value = v.Value
+		}
+	default:
+		return nil, common.ErrInvalidMetadataFilter
+	}
+	switch filter.Operator {
+	case model.MetadataFilterExists:
+		return query.Where(column + " IS NOT NULL"), nil
+	case model.MetadataFilterNotExists:
+		return query.Where(column + " IS NULL"), nil
+	}
+	if value == nil {
+		return nil, common.ErrInvalidMetadataFilter
+	}
+
+	var operator string
+	switch filter.Operator {
+	case model.MetadataFilterEQ:
+		return query.Where(column+" = ?", value), nil
+	case model.MetadataFilterNE:
+		return query.Where("("+column+" IS NULL OR "+column+" <> ?)", value), nil
+	case model.MetadataFilterGT:
+		operator = ">"
+	case model.MetadataFilterGTE:
+		operator = ">="
+	case model.MetadataFilterLT:
+		operator = "<"
+	case model.MetadataFilterLTE:
+		operator = "<="
+	default:
+		return nil, common.ErrInvalidMetadataFilter
+	}
+	if !numeric {
+		return nil, common.ErrInvalidMetadataFilter
+	}
+	return query.Where(column+" "+operator+" ?", value), nil
+}
+
+func (s *collectionDb) CountCollectionsByDatabaseID(databaseID dbmodel.DatabaseID) (int64, error) {
+	var count int64
+	err := s.db.Model(&dbmodel.Collection{}).
+		Where("database_id = ?", databaseID).
+		Scopes(notDeleted("collections")).
+		Count(&count).Error
+	if err != nil {
+		log.Error("count collections failed", zap.String("databaseID", databaseID.String()), zap.Error(err))
+		return 0, err
+	}
+	return count, nil
+}
+
+func (s *collectionDb) CountCollections(tenantID string, databaseName string, includeSoftDeleted bool) (int64, error) {
+	var count int64
+	query := s.db.Model(&dbmodel.Collection{}).
+		Joins("INNER JOIN databases ON collections.database_id = databases.id").
+		Where("databases.tenant_id = ? AND collections.tenant_id = ?", tenantID, tenantID)
+	if databaseName != "" {
+		query = query.Where("databases.name = ?", databaseName)
+	}
+	if !includeSoftDeleted {
+		query = query.Scopes(notDeleted("collections"), notDeleted("databases"))
+	}
+	err := query.Count(&count).Error
+	if err != nil {
+		log.Error("count collections failed", zap.String("tenantID", tenantID), zap.String("databaseName", databaseName), zap.Error(err))
+		return 0, err
+	}
+	return count, nil
+}
+
+func (s *collectionDb) CountCollectionsByEmbeddingFunctionID(embeddingFunctionID string) (int64, error) {
+	var count int64
+	err := s.db.Model(&dbmodel.Collection{}).
+		Where("embedding_function_id = ?", embeddingFunctionID).
+		Count(&count).Error
+	if err != nil {
+		log.Error("count collections by embedding function failed", zap.String("embeddingFunctionID", embeddingFunctionID), zap.Error(err))
+		return 0, err
+	}
+	return count, nil
+}
+
+func (s *collectionDb) ClearTemplateID(templateID string) (int64, error) {
+	result := s.db.Model(&dbmodel.Collection{}).
+		Where("template_id = ?", templateID).
+		Update("template_id", nil)
+	if result.Error != nil {
+		log.Error("clear collection template failed", zap.String("templateID", templateID), zap.Error(result.Error))
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+func (s *collectionDb) ClearEmbeddingFunctionID(collectionID dbmodel.CollectionID) error {
+	err := s.db.Model(&dbmodel.Collection{}).
+		Where("id = ?", collectionID).
+		Update("embedding_function_id", nil).Error
+	if err != nil {
+		log.Error("clear collection embedding function failed", zap.String("collectionID", collectionID.String()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (s *collectionDb) DeleteCollectionByID(collectionID dbmodel.CollectionID) (int, error) {
 	var collections []dbmodel.Collection
 	err := s.db.Clauses(clause.Returning{}).Where("id = ?", collectionID).Delete(&collections).Error
 	return len(collections), err
 }
 
+// SoftDeleteCollectionByID marks a live collection as deleted by actor and moves it
+// to the deleting state. The collection is renamed to name__deleted__<id> so that
+// the name is free again, and the row is kept until the collection is purged.
+func (s *collectionDb) SoftDeleteCollectionByID(collectionID dbmodel.CollectionID, actor string) (int64, error) {
+	updates := softDeleteUpdates(actor)
+	updates["state"] = string(model.CollectionStateDeleting)
+	updates["name"] = gorm.Expr("name || ? || id::text", dbmodel.DeletedCollectionNameInfix)
+	result := s.db.Model(&dbmodel.Collection{}).
+		Where("id = ?", collectionID).
+		Scopes(notDeleted("collections")).
+		Updates(updates)
+	if result.Error != nil {
+		log.Error("soft delete collection failed", zap.String("collectionID", collectionID.String()), zap.Error(result.Error))
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+// GetDeletedCollection returns a soft deleted collection, or nil when no soft
+// deleted collection has the ID. Metadata is not loaded.
+func (s *collectionDb) GetDeletedCollection(collectionID dbmodel.CollectionID) (*dbmodel.Collection, error) {
+	var collection dbmodel.Collection
+	err := s.db.Where("id = ? AND is_deleted = ?", collectionID, true).First(&collection).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		log.Error("get deleted collection failed", zap.String("collectionID", collectionID.String()), zap.Error(err))
+		return nil, err
+	}
+	return &collection, nil
+}
+
+func (s *collectionDb) GetDeletedCollectionsByDatabaseID(databaseID dbmodel.DatabaseID) ([]*dbmodel.Collection, error) {
+	var collections []*dbmodel.Collection
+	err := s.db.Where("database_id = ? AND is_deleted = ?", databaseID, true).Order("id").Find(&collections).Error
+	if err != nil {
+		log.Error("get deleted collections of database failed", zap.String("databaseID", databaseID.String()), zap.Error(err))
+		return nil, err
+	}
+	return collections, nil
+}
+
+// GetDeletedCollectionIDs returns up to limit collections soft deleted before
+// deletedBefore, the longest deleted first. Collections whose purge is dead
+// lettered are left out.
+func (s *collectionDb) GetDeletedCollectionIDs(deletedBefore time.Time, limit int32) ([]dbmodel.CollectionID, error) {
+	var collectionIDs []dbmodel.CollectionID
+	err := s.db.Model(&dbmodel.Collection{}).
+		Where("is_deleted = ? AND deleted_at < ?", true, deletedBefore.UTC()).
+		Where("NOT EXISTS (SELECT 1 FROM cleanup_dead_letters d WHERE d.cluster_id = ? AND d.collection_id = collections.id AND d.task = ? AND d.dead_lettered)",
+			dbcore.ClusterID(s.db), dbmodel.CleanupTaskPurge).
+		Order("deleted_at ASC, id ASC").
+		Limit(int(limit)).
+		Pluck("id", &collectionIDs).Error
+	if err != nil {
+		log.Error("get deleted collection ids failed", zap.Error(err))
+		return nil, err
+	}
+	return collectionIDs, nil
+}
+
+// RestoreCollectionByID undoes the soft delete of a collection, giving it name
+// and state. It fails with ErrCollectionUniqueConstraintViolation when a live
+// collection of the database has the name.
+func (s *collectionDb) RestoreCollectionByID(collectionID dbmodel.CollectionID, name string, state string) (int64, error) {
+	updates := restoreUpdates()
+	updates["name"] = name
+	updates["state"] = state
+	result := s.db.Model(&dbmodel.Collection{}).
+		Where("id = ? AND is_deleted = ?", collectionID, true).
+		Updates(updates)
+	if result.Error != nil {
+		log.Error("restore collection failed", zap.String("collectionID", collectionID.String()), zap.Error(result.Error))
+		var pgErr *pgconn.PgError
+		if errors.As(result.Error, &pgErr) && pgErr.Code == "23505" {
+			return 0, common.ErrCollectionUniqueConstraintViolation
+		}
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
 func (s *collectionDb) Insert(in *dbmodel.Collection) error {
+	if in.TenantID == "" {
+		tenantID, err := tenantIDForDatabase(s.db, in.DatabaseID)
+		if err != nil {
+			return err
+		}
+		in.TenantID = tenantID
+	}
 	err := s.db.Create(&in).Error
 	if err != nil {
 		log.Error("create collection failed", zap.Error(err))
@@ -145,6 +537,15 @@ func generateCollectionUpdatesWithoutID(in *dbmodel.Collection) map[string]inter
 	if in.Dimension != nil {
 		ret["dimension"] = *in.Dimension
 	}
+	if in.ReplicationFactor > 0 {
+		ret["replication_factor"] = in.ReplicationFactor
+	}
+	if in.Revision > 0 {
+		ret["revision"] = in.Revision
+	}
+	if in.EmbeddingFunctionID != nil {
+		ret["embedding_function_id"] = *in.EmbeddingFunctionID
+	}
 	return ret
 }
 
@@ -171,30 +572,493 @@ func (s *collectionDb) Update(in *dbmodel.Collection) error {
 	return nil
 }
 
-func (s *collectionDb) UpdateLogPositionAndVersion(collectionID string, logPosition int64, currentCollectionVersion int32) (int32, error) {
-	log.Info("update log position and version", zap.String("collectionID", collectionID), zap.Int64("logPosition", logPosition), zap.Int32("currentCollectionVersion", currentCollectionVersion))
+// GetForUpdate returns the collection and locks it until the end of the
+// transaction, nil when it does not exist or is soft deleted.
+func (s *collectionDb) GetForUpdate(collectionID dbmodel.CollectionID) (*dbmodel.Collection, error) {
+	var collections []*dbmodel.Collection
+	err := s.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Scopes(notDeleted("collections")).
+		Where("id = ?", collectionID).
+		Limit(1).
+		Find(&collections).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(collections) == 0 {
+		return nil, nil
+	}
+	return collections[0], nil
+}
+
+// UpdateCollectionName renames a live collection in one statement and bumps
+// its revision. The unique index on the names of a database serializes
+// concurrent renames to the same name: the second waits for the first to
+// commit and then fails with a *common.CollectionAlreadyExistsError.
+func (s *collectionDb) UpdateCollectionName(collectionID dbmodel.CollectionID, name string) (int64, error) {
+	result := s.db.Model(&dbmodel.Collection{}).
+		Where("id = ?", collectionID).
+		Scopes(notDeleted("collections")).
+		Updates(map[string]interface{}{
+			"name":     name,
+			"revision": gorm.Expr("revision + 1"),
+		})
+	if result.Error != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(result.Error, &pgErr) && pgErr.Code == "23505" {
+			log.Info("collection name already taken", zap.String("collectionID", collectionID.String()), zap.String("name", name))
+			return 0, &common.CollectionAlreadyExistsError{Name: name}
+		}
+		log.Error("rename collection failed", zap.String("collectionID", collectionID.String()), zap.Error(result.Error))
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+// UpdateLogPositionAndVersion returns the new version of the collection and the
+// number of log records between the previous and the new log position.
+func (s *collectionDb) UpdateLogPositionAndVersion(collectionID dbmodel.CollectionID, logPosition int64, currentCollectionVersion int32) (int32, int64, error) {
+	log.Info("update log position and version", zap.String("collectionID", collectionID.String()), zap.Int64("logPosition", logPosition), zap.Int32("currentCollectionVersion", currentCollectionVersion))
 	var collection dbmodel.Collection
 	// We use select for update to ensure no lost update happens even for isolation level read committed or below
 	// https://patrick.engineering/posts/postgres-internals/
 	err := s.db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", collectionID).First(&collection).Error
 	if err != nil {
-		return 0, err
+		return 0, 0, err
 	}
 	if collection.LogPosition > logPosition {
-		return 0, common.ErrCollectionLogPositionStale
+		return 0, 0, common.ErrCollectionLogPositionStale
 	}
 	if collection.Version > currentCollectionVersion {
-		return 0, common.ErrCollectionVersionStale
+		return 0, 0, common.ErrCollectionVersionStale
 	}
 	if collection.Version < currentCollectionVersion {
 		// this should not happen, potentially a bug
-		return 0, common.ErrCollectionVersionInvalid
+		return 0, 0, common.ErrCollectionVersionInvalid
 	}
 
 	version := currentCollectionVersion + 1
 	err = s.db.Model(&dbmodel.Collection{}).Where("id = ?", collectionID).Updates(map[string]interface{}{"log_position": logPosition, "version": version}).Error
 	if err != nil {
+		return 0, 0, err
+	}
+	return version, logPosition - collection.LogPosition, nil
+}
+
+// UpdateLastCompactor records compactor as the instance that compacted the
+// collection last.
+func (s *collectionDb) UpdateLastCompactor(collectionID dbmodel.CollectionID, compactor string) error {
+	err := s.db.Model(&dbmodel.Collection{}).Where("id = ?", collectionID).Updates(map[string]interface{}{"last_compactor": compactor, "last_compacted_at": time.Now()}).Error
+	if err != nil {
+		log.Error("update last compactor failed", zap.String("collectionID", collectionID.String()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// AcquireFencingToken records owner as the owner of the collection and returns a
+// fencing token greater than every token issued before for the collection.
+func (s *collectionDb) AcquireFencingToken(collectionID dbmodel.CollectionID, owner string) (int64, error) {
+	var collections []dbmodel.Collection
+	result := s.db.Model(&collections).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "fencing_token"}}}).
+		Where("id = ?", collectionID).
+		Updates(map[string]interface{}{"fencing_token": gorm.Expr("fencing_token + 1"), "fencing_owner": owner})
+	if result.Error != nil {
+		log.Error("acquire fencing token failed", zap.String("collectionID", collectionID.String()), zap.Error(result.Error))
+		return 0, result.Error
+	}
+	if result.RowsAffected == 0 || len(collections) == 0 {
+		return 0, common.ErrCollectionNotFound
+	}
+	log.Info("fencing token acquired", zap.String("collectionID", collectionID.String()), zap.String("owner", owner), zap.Int64("fencingToken", collections[0].FencingToken))
+	return collections[0].FencingToken, nil
+}
+
+// CheckFencingToken fails unless fencingToken is the latest token issued for the
+// collection. It locks the collection row, so when called inside a transaction no
+// new token can be issued until the transaction ends.
+func (s *collectionDb) CheckFencingToken(collectionID dbmodel.CollectionID, fencingToken int64) error {
+	var collection dbmodel.Collection
+	err := s.db.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "fencing_token").Where("id = ?", collectionID).First(&collection).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return common.ErrCollectionNotFound
+		}
+		return err
+	}
+	if collection.FencingToken != fencingToken {
+		log.Error("stale fencing token", zap.String("collectionID", collectionID.String()), zap.Int64("fencingToken", fencingToken), zap.Int64("currentFencingToken", collection.FencingToken))
+		return common.ErrCollectionFencingTokenStale
+	}
+	return nil
+}
+
+// UpdateCollectionSize sets the post compaction size of a collection and returns
+// the change, so that the caller can maintain the usage counters in the same
+// transaction. The row is locked so that concurrent updates cannot both compute
+// their change from the same previous size.
+func (s *collectionDb) UpdateCollectionSize(collectionID dbmodel.CollectionID, totalRecordsPostCompaction *int64, sizeBytesPostCompaction *int64) (*dbmodel.CollectionSizeChange, error) {
+	if totalRecordsPostCompaction == nil && sizeBytesPostCompaction == nil {
+		return &dbmodel.CollectionSizeChange{}, nil
+	}
+	var previous dbmodel.Collection
+	err := s.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("database_id, total_records_post_compaction, size_bytes_post_compaction").
+		Where("id = ?", collectionID).
+		First(&previous).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, common.ErrCollectionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	change := &dbmodel.CollectionSizeChange{DatabaseID: previous.DatabaseID}
+	updates := map[string]interface{}{}
+	if totalRecordsPostCompaction != nil {
+		updates["total_records_post_compaction"] = *totalRecordsPostCompaction
+		change.Records = *totalRecordsPostCompaction - previous.TotalRecordsPostCompaction
+	}
+	if sizeBytesPostCompaction != nil {
+		updates["size_bytes_post_compaction"] = *sizeBytesPostCompaction
+		change.SizeBytes = *sizeBytesPostCompaction - previous.SizeBytesPostCompaction
+	}
+	err = s.db.Model(&dbmodel.Collection{}).Where("id = ?", collectionID).Updates(updates).Error
+	if err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// GetTemplateUsage reads the size of a collection and the limits of its
+// template in one query.
+func (s *collectionDb) GetTemplateUsage(collectionID dbmodel.CollectionID, tenantID string) (*dbmodel.CollectionTemplateUsage, error) {
+	var usages []*dbmodel.CollectionTemplateUsage
+	err := s.db.Table("collections").
+		Select("collections.template_id, collection_templates.max_records, collection_templates.max_size_bytes, collections.total_records_post_compaction, collections.size_bytes_post_compaction").
+		Joins("INNER JOIN databases ON collections.database_id = databases.id").
+		Joins("LEFT JOIN collection_templates ON collections.template_id = collection_templates.id").
+		Scopes(notDeleted("collections"), notDeleted("databases")).
+		Where("collections.id = ? AND databases.tenant_id = ? AND collections.tenant_id = ?", collectionID, tenantID, tenantID).
+		Limit(1).
+		Scan(&usages).Error
+	if err != nil {
+		log.Error("get collection template usage failed", zap.String("collectionID", collectionID.String()), zap.Error(err))
+		return nil, err
+	}
+	if len(usages) == 0 {
+		return nil, nil
+	}
+	return usages[0], nil
+}
+
+// GetCollectionsBySize returns the largest collections of a database. The ordering
+// is served by the (database_id, <size column> DESC) indexes on collections.
+func (s *collectionDb) GetCollectionsBySize(tenantID string, databaseName string, orderBy model.CollectionSizeOrderBy, limit int32) ([]*dbmodel.Collection, error) {
+	var column string
+	switch orderBy {
+	case model.CollectionSizeOrderByTotalRecords:
+		column = "total_records_post_compaction"
+	case model.CollectionSizeOrderBySizeBytes:
+		column = "size_bytes_post_compaction"
+	default:
+		return nil, common.ErrInvalidCollectionSizeOrderBy
+	}
+
+	var collections []*dbmodel.Collection
+	err := s.db.Table("collections").
+		Select("collections.id, collections.name, collections.database_id, collections.total_records_post_compaction, collections.size_bytes_post_compaction").
+		Joins("INNER JOIN databases ON collections.database_id = databases.id").
+		Where("databases.tenant_id = ? AND databases.name = ? AND collections.tenant_id = ?", tenantID, databaseName, tenantID).
+		Scopes(notDeleted("collections"), notDeleted("databases")).
+		Order("collections." + column + " DESC").
+		Order("collections.id").
+		Limit(int(limit)).
+		Find(&collections).Error
+	if err != nil {
+		log.Error("get collections by size failed", zap.Error(err))
+		return nil, err
+	}
+	return collections, nil
+}
+
+// GetApproximateCollectionCount returns the planner's estimate of the number of
+// rows in collections, which includes soft deleted collections. It falls back to
+// an exact count when the table has not been analyzed yet. The estimate covers
+// the collections of every cluster sharing the database.
+func (s *collectionDb) GetApproximateCollectionCount() (int64, error) {
+	var estimate int64
+	err := s.db.Raw("SELECT reltuples::bigint FROM pg_class WHERE oid = 'public.collections'::regclass").Scan(&estimate).Error
+	if err != nil {
+		log.Error("get approximate collection count failed", zap.Error(err))
+		return 0, err
+	}
+	if estimate >= 0 {
+		return estimate, nil
+	}
+	var count int64
+	err = s.db.Model(&dbmodel.Collection{}).Count(&count).Error
+	if err != nil {
+		log.Error("count collections failed", zap.Error(err))
+		return 0, err
+	}
+	return count, nil
+}
+
+// exactDatabaseCollectionCountLimit is the estimate under which
+// GetApproximateDatabaseCollectionCount counts the collections instead, since
+// the planner never estimates less than one row and is the least accurate for
+// small databases.
+const exactDatabaseCollectionCountLimit = 1000
+
+// GetApproximateDatabaseCollectionCount returns the planner's estimate of the
+// number of live collections in a database, from the statistics of collections.
+// Estimates under exactDatabaseCollectionCountLimit are replaced by a count that
+// reads at most that many rows.
+func (s *collectionDb) GetApproximateDatabaseCollectionCount(databaseID dbmodel.DatabaseID) (int64, error) {
+	live := func(tx *gorm.DB) *gorm.DB {
+		return tx.Table("collections").Select("1").
+			Where("collections.database_id = ?", databaseID).
+			Scopes(notDeleted("collections"))
+	}
+	query := s.db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return live(tx).Find(&[]int64{})
+	})
+	var plan string
+	err := s.db.Raw("EXPLAIN (FORMAT JSON) " + query).Row().Scan(&plan)
+	if err != nil {
+		log.Error("estimate database collection count failed", zap.String("databaseID", databaseID.String()), zap.Error(err))
 		return 0, err
 	}
-	return version, nil
+	var plans []struct {
+		Plan struct {
+			Rows float64 `json:"Plan Rows"`
+		} `json:"Plan"`
+	}
+	err = json.Unmarshal([]byte(plan), &plans)
+	if err != nil {
+		return 0, err
+	}
+	if len(plans) > 0 && plans[0].Plan.Rows >= exactDatabaseCollectionCountLimit {
+		return int64(plans[0].Plan.Rows), nil
+	}
+	var count int64
+	err = s.db.Table("(?) AS live_collections", live(s.db).Limit(exactDatabaseCollectionCountLimit)).Count(&count).Error
+	if err != nil {
+		log.Error("count database collections failed", zap.String("databaseID", databaseID.String()), zap.Error(err))
+		return 0, err
+	}
+	return count, nil
+}
+
+// GetCollectionsToGc returns the collections, including soft deleted ones, that
+// were last updated before updatedBefore and have at least minVersions versions.
+// Pages are ordered by ID so that startAfter can be served by the primary key.
+func (s *collectionDb) GetCollectionsToGc(updatedBefore time.Time, tenantID *string, minVersions int32, startAfter *dbmodel.CollectionID, limit int32) ([]*dbmodel.Collection, error) {
+	var collections []*dbmodel.Collection
+	query := s.db.Table("collections").
+		Select("collections.id, collections.name, collections.tenant_id, collections.version, collections.updated_at, collections.is_deleted").
+		Where("collections.updated_at < ? AND collections.version >= ?", updatedBefore, minVersions)
+	if tenantID != nil {
+		query = query.Where("collections.tenant_id = ?", *tenantID)
+	}
+	if startAfter != nil {
+		query = query.Where("collections.id > ?", *startAfter)
+	}
+	err := query.Order("collections.id").Limit(int(limit)).Find(&collections).Error
+	if err != nil {
+		log.Error("get collections to gc failed", zap.Error(err))
+		return nil, err
+	}
+	return collections, nil
+}
+
+// GetCollectionsByIDs returns the ID and soft delete flag of the collections,
+// including soft deleted ones. Missing collections are left out.
+func (s *collectionDb) GetCollectionsByIDs(collectionIDs []dbmodel.CollectionID) ([]*dbmodel.Collection, error) {
+	var collections []*dbmodel.Collection
+	if len(collectionIDs) == 0 {
+		return collections, nil
+	}
+	err := s.db.Table("collections").
+		Select("collections.id, collections.is_deleted").
+		Where("collections.id IN ?", collectionIDs).
+		Find(&collections).Error
+	if err != nil {
+		log.Error("get collections by ids failed", zap.Error(err))
+		return nil, err
+	}
+	return collections, nil
+}
+
+// GetCollectionsWithoutSegments returns the live collections created before
+// createdBefore that have no segments. Metadata is not loaded.
+func (s *collectionDb) GetCollectionsWithoutSegments(createdBefore time.Time) ([]*dbmodel.CollectionAndMetadata, error) {
+	var rows []struct {
+		ID           dbmodel.CollectionID
+		Name         *string
+		DatabaseID   dbmodel.DatabaseID
+		CreatedAt    time.Time
+		DatabaseName string
+		TenantID     string
+	}
+	err := s.db.Table("collections").
+		Select("collections.id, collections.name, collections.database_id, collections.created_at, databases.name AS database_name, databases.tenant_id").
+		Joins("INNER JOIN databases ON collections.database_id = databases.id").
+		Where("collections.created_at < ?", createdBefore.UTC()).
+		Scopes(notDeleted("collections")).
+		Where("NOT EXISTS (SELECT 1 FROM segments WHERE segments.collection_id = collections.id)").
+		Order("collections.created_at ASC").
+		Scan(&rows).Error
+	if err != nil {
+		log.Error("get collections without segments failed", zap.Error(err))
+		return nil, err
+	}
+	collections := make([]*dbmodel.CollectionAndMetadata, 0, len(rows))
+	for _, row := range rows {
+		collections = append(collections, &dbmodel.CollectionAndMetadata{
+			Collection: &dbmodel.Collection{
+				ID:         row.ID,
+				Name:       row.Name,
+				DatabaseID: row.DatabaseID,
+				CreatedAt:  row.CreatedAt.UTC(),
+			},
+			TenantID:     row.TenantID,
+			DatabaseName: row.DatabaseName,
+		})
+	}
+	return collections, nil
+}
+
+// GetInconsistentCollections returns the collections stuck in an intermediate
+// state: soft deleted before deletedBefore with segments left, live with soft
+// deleted segments, ready without one of requiredScopes, or compacted with a
+// segment that has no files. A collection is returned once per problem. Only
+// the kinds of problems in kinds are checked, all of them when it is empty.
+func (s *collectionDb) GetInconsistentCollections(deletedBefore time.Time, requiredScopes []string, kinds []model.InconsistencyKind) ([]*dbmodel.InconsistentCollection, error) {
+	queries := []struct {
+		kind  model.InconsistencyKind
+		query func(*gorm.DB) *gorm.DB
+	}{
+		{model.InconsistencySoftDeletedWithSegments, func(db *gorm.DB) *gorm.DB {
+			return db.Joins("INNER JOIN segments ON segments.collection_id = collections.id").
+				Where("collections.is_deleted = ? AND collections.deleted_at < ?", true, deletedBefore.UTC())
+		}},
+		{model.InconsistencyDeletedSegments, func(db *gorm.DB) *gorm.DB {
+			return db.Joins("INNER JOIN segments ON segments.collection_id = collections.id AND segments.is_deleted = ?", true).
+				Scopes(notDeleted("collections"))
+		}},
+		{model.InconsistencyMissingSegments, func(db *gorm.DB) *gorm.DB {
+			return db.Joins("LEFT JOIN segments ON segments.collection_id = collections.id AND segments.is_deleted = ?", false).
+				Scopes(notDeleted("collections")).
+				Where("collections.state = ?", string(model.CollectionStateReady)).
+				Having("count(DISTINCT segments.scope) FILTER (WHERE segments.scope IN ?) < ?", requiredScopes, len(requiredScopes))
+		}},
+		{model.InconsistencyFlushedWithoutFiles, func(db *gorm.DB) *gorm.DB {
+			return db.Joins("INNER JOIN segments ON segments.collection_id = collections.id AND segments.is_deleted = ?", false).
+				Scopes(notDeleted("collections")).
+				Where("collections.version > 0").
+				Where("(segments.file_paths IS NULL OR segments.file_paths IN ?)", []string{"", "{}", "null"})
+		}},
+	}
+	var collections []*dbmodel.InconsistentCollection
+	for _, q := range queries {
+		if len(kinds) > 0 && !slices.Contains(kinds, q.kind) {
+			continue
+		}
+		var rows []struct {
+			ID           dbmodel.CollectionID
+			Name         *string
+			Version      int32
+			DeletedAt    *time.Time
+			DatabaseName string
+			TenantID     string
+			SegmentIDs   string
+			Scopes       string
+		}
+		err := s.db.Table("collections").
+			Select("collections.id, collections.name, collections.version, collections.deleted_at, databases.name AS database_name, databases.tenant_id, " +
+				"coalesce(string_agg(segments.id::text, ',' ORDER BY segments.id), '') AS segment_ids, " +
+				"coalesce(string_agg(DISTINCT segments.scope, ',' ORDER BY segments.scope), '') AS scopes").
+			Joins("INNER JOIN databases ON collections.database_id = databases.id").
+			Scopes(q.query).
+			Group("collections.id, collections.name, collections.version, collections.deleted_at, databases.name, databases.tenant_id").
+			Order("collections.id").
+			Scan(&rows).Error
+		if err != nil {
+			log.Error("get inconsistent collections failed", zap.String("kind", string(q.kind)), zap.Error(err))
+			return nil, err
+		}
+		for _, row := range rows {
+			collection := &dbmodel.InconsistentCollection{
+				Collection: &dbmodel.Collection{
+					ID:         row.ID,
+					Name:       row.Name,
+					Version:    row.Version,
+					SoftDelete: dbmodel.SoftDelete{DeletedAt: row.DeletedAt},
+				},
+				TenantID:     row.TenantID,
+				DatabaseName: row.DatabaseName,
+				Kind:         q.kind,
+			}
+			if q.kind != model.InconsistencyMissingSegments && row.SegmentIDs != "" {
+				for _, segmentID := range strings.Split(row.SegmentIDs, ",") {
+					collection.SegmentIDs = append(collection.SegmentIDs, dbmodel.SegmentID(segmentID))
+				}
+			}
+			if row.Scopes != "" {
+				collection.Scopes = strings.Split(row.Scopes, ",")
+			}
+			collections = append(collections, collection)
+		}
+	}
+	return collections, nil
+}
+
+// UpdateState moves a collection to toState if it is currently in one of
+// fromStates, and returns the number of updated rows.
+func (s *collectionDb) UpdateState(collectionID dbmodel.CollectionID, fromStates []string, toState string) (int64, error) {
+	result := s.db.Model(&dbmodel.Collection{}).
+		Where("id = ? AND state IN ?", collectionID, fromStates).
+		Update("state", toState)
+	if result.Error != nil {
+		log.Error("update collection state failed", zap.String("collectionID", collectionID.String()), zap.String("state", toState), zap.Error(result.Error))
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+// MarkReindexPending sets the reindex marker of a collection. The dimension of
+// the first pending change is kept when the collection is marked again before
+// the reindex completed, since the records still have that dimension.
+func (s *collectionDb) MarkReindexPending(collectionID dbmodel.CollectionID, fromDimension *int32) error {
+	err := s.db.Model(&dbmodel.Collection{}).Where("id = ?", collectionID).Updates(map[string]interface{}{
+		"reindex_from_dimension": gorm.Expr("CASE WHEN reindex_pending THEN reindex_from_dimension ELSE ? END", fromDimension),
+		"reindex_pending":        true,
+	}).Error
+	if err != nil {
+		log.Error("mark collection reindex pending failed", zap.String("collectionID", collectionID.String()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// ClearReindexPending clears the reindex marker if the collection still has the
+// given dimension, and returns the number of updated rows.
+func (s *collectionDb) ClearReindexPending(collectionID dbmodel.CollectionID, dimension *int32) (int64, error) {
+	query := s.db.Model(&dbmodel.Collection{}).Where("id = ? AND reindex_pending = ?", collectionID, true)
+	if dimension != nil {
+		query = query.Where("dimension = ?", *dimension)
+	} else {
+		query = query.Where("dimension IS NULL")
+	}
+	result := query.Updates(map[string]interface{}{
+		"reindex_pending":        false,
+		"reindex_from_dimension": nil,
+	})
+	if result.Error != nil {
+		log.Error("clear collection reindex pending failed", zap.String("collectionID", collectionID.String()), zap.Error(result.Error))
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
 }
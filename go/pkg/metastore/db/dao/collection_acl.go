@@ -0,0 +1,71 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type collectionAclDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.ICollectionAclDb = &collectionAclDb{}
+
+// Upsert sets the permissions of a principal on a collection, replacing the
+// permissions it had before.
+func (s *collectionAclDb) Upsert(in *dbmodel.CollectionAcl) error {
+	if in.TenantID == "" {
+		tenantID, err := tenantIDForCollection(s.db, in.CollectionID)
+		if err != nil {
+			return err
+		}
+		in.TenantID = tenantID
+	}
+	in.UpdatedAt = time.Now()
+	err := s.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "collection_id"}, {Name: "principal"}},
+		DoUpdates: clause.AssignmentColumns([]string{"permissions", "updated_at"}),
+	}).Create(in).Error
+	if err != nil {
+		log.Error("upsert collection acl failed", zap.String("collectionID", in.CollectionID.String()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (s *collectionAclDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.CollectionAcl{}).Error
+}
+
+func (s *collectionAclDb) Delete(collectionID dbmodel.CollectionID, principal string) (int64, error) {
+	result := s.db.Where("collection_id = ? AND principal = ?", collectionID, principal).Delete(&dbmodel.CollectionAcl{})
+	return result.RowsAffected, result.Error
+}
+
+func (s *collectionAclDb) DeleteByCollectionID(collectionID dbmodel.CollectionID) (int64, error) {
+	result := s.db.Where("collection_id = ?", collectionID).Delete(&dbmodel.CollectionAcl{})
+	return result.RowsAffected, result.Error
+}
+
+// GetByCollectionIDs returns the entries of the collections ordered by
+// collection and principal.
+func (s *collectionAclDb) GetByCollectionIDs(collectionIDs []dbmodel.CollectionID) ([]*dbmodel.CollectionAcl, error) {
+	var entries []*dbmodel.CollectionAcl
+	if len(collectionIDs) == 0 {
+		return entries, nil
+	}
+	err := s.db.Where("collection_id IN ?", collectionIDs).
+		Order("collection_id").
+		Order("principal").
+		Find(&entries).Error
+	if err != nil {
+		log.Error("get collection acls failed", zap.Error(err))
+		return nil, err
+	}
+	return entries, nil
+}
@@ -0,0 +1,59 @@
+package dao
+
+import (
+	"slices"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type collectionLifecycleDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.ICollectionLifecycleDb = &collectionLifecycleDb{}
+
+func lifecycleDay(at time.Time) time.Time {
+	at = at.UTC()
+	return time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Increment adds count to the event counter of tenantID for the UTC day of at.
+func (s *collectionLifecycleDb) Increment(tenantID string, at time.Time, event string, count int64) error {
+	if !slices.Contains(dbmodel.LifecycleEvents, event) {
+		return common.ErrUnknownLifecycleEvent
+	}
+	err := s.db.Table("collection_lifecycle_counts").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "cluster_id"}, {Name: "tenant_id"}, {Name: "day"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{event: gorm.Expr("collection_lifecycle_counts."+event+" + ?", count)}),
+	}).Create(map[string]interface{}{
+		"tenant_id": tenantID,
+		"day":       lifecycleDay(at),
+		event:       count,
+	}).Error
+	if err != nil {
+		log.Error("increment collection lifecycle count failed", zap.String("tenantID", tenantID), zap.String("event", event), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// GetCounts returns the daily counts of tenantID from the day of from to the
+// day of to, both included, oldest first.
+func (s *collectionLifecycleDb) GetCounts(tenantID string, from time.Time, to time.Time) ([]*dbmodel.CollectionLifecycleCount, error) {
+	var counts []*dbmodel.CollectionLifecycleCount
+	err := s.db.Where("tenant_id = ? AND day BETWEEN ? AND ?", tenantID, lifecycleDay(from), lifecycleDay(to)).
+		Order("day").Find(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, count := range counts {
+		count.Day = lifecycleDay(count.Day)
+	}
+	return counts, nil
+}
@@ -0,0 +1,100 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type collectionLogRouteDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.ICollectionLogRouteDb = &collectionLogRouteDb{}
+
+// Upsert must run in a transaction. It locks the table against the other
+// changes until the transaction ends, so that the changes commit in the order
+// of their revision and a poller that saw a revision already saw every change
+// before it. Reads are not blocked.
+func (s *collectionLogRouteDb) Upsert(collectionID dbmodel.CollectionID, logAddress string) (*dbmodel.CollectionLogRoute, error) {
+	err := s.db.Exec("LOCK TABLE collection_log_routes IN SHARE ROW EXCLUSIVE MODE").Error
+	if err != nil {
+		log.Error("lock collection log routes failed", zap.Error(err))
+		return nil, err
+	}
+	var revision int64
+	err = s.db.Raw("SELECT nextval('collection_log_route_revisions')").Scan(&revision).Error
+	if err != nil {
+		log.Error("get collection log route revision failed", zap.Error(err))
+		return nil, err
+	}
+	route := &dbmodel.CollectionLogRoute{
+		CollectionID: collectionID,
+		LogAddress:   logAddress,
+		Version:      1,
+		UpdatedAt:    time.Now(),
+		Revision:     revision,
+	}
+	err = s.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "collection_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"log_address": logAddress,
+			"version":     gorm.Expr("collection_log_routes.version + 1"),
+			"updated_at":  route.UpdatedAt,
+			"revision":    revision,
+		}),
+	}, clause.Returning{}).Create(route).Error
+	if err != nil {
+		log.Error("upsert collection log route failed", zap.String("collectionID", collectionID.String()), zap.Error(err))
+		return nil, err
+	}
+	return route, nil
+}
+
+// GetByCollectionIDs returns the routes of the collections that have one.
+func (s *collectionLogRouteDb) GetByCollectionIDs(collectionIDs []dbmodel.CollectionID) ([]*dbmodel.CollectionLogRoute, error) {
+	var routes []*dbmodel.CollectionLogRoute
+	if len(collectionIDs) == 0 {
+		return routes, nil
+	}
+	err := s.db.Where("collection_id IN ?", collectionIDs).Order("collection_id").Find(&routes).Error
+	if err != nil {
+		log.Error("get collection log routes failed", zap.Error(err))
+		return nil, err
+	}
+	return routes, nil
+}
+
+// GetChangedAfter returns the routes changed after revision, oldest first.
+func (s *collectionLogRouteDb) GetChangedAfter(revision int64) ([]*dbmodel.CollectionLogRoute, error) {
+	var routes []*dbmodel.CollectionLogRoute
+	err := s.db.Where("revision > ?", revision).Order("revision").Find(&routes).Error
+	if err != nil {
+		log.Error("get changed collection log routes failed", zap.Error(err))
+		return nil, err
+	}
+	return routes, nil
+}
+
+func (s *collectionLogRouteDb) GetLastRevision() (int64, error) {
+	var revision int64
+	err := s.db.Model(&dbmodel.CollectionLogRoute{}).Select("COALESCE(MAX(revision), 0)").Scan(&revision).Error
+	if err != nil {
+		log.Error("get last collection log route revision failed", zap.Error(err))
+		return 0, err
+	}
+	return revision, nil
+}
+
+func (s *collectionLogRouteDb) DeleteByCollectionID(collectionID dbmodel.CollectionID) (int64, error) {
+	result := s.db.Where("collection_id = ?", collectionID).Delete(&dbmodel.CollectionLogRoute{})
+	return result.RowsAffected, result.Error
+}
+
+func (s *collectionLogRouteDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.CollectionLogRoute{}).Error
+}
@@ -19,15 +19,39 @@ func (s *collectionMetadataDb) GetForCollection(collectionID string) (metadata [
 	return
 }
 
-func (s *collectionMetadataDb) DeleteByCollectionID(collectionID string) (int, error) {
+func (s *collectionMetadataDb) GetByCollectionID(collectionID dbmodel.CollectionID) ([]*dbmodel.CollectionMetadata, error) {
+	var metadata []*dbmodel.CollectionMetadata
+	err := s.db.Where("collection_id = ?", collectionID).Find(&metadata).Error
+	return metadata, err
+}
+
+func (s *collectionMetadataDb) DeleteByCollectionID(collectionID dbmodel.CollectionID) (int, error) {
 	var metadata []dbmodel.CollectionMetadata
 	err := s.db.Clauses(clause.Returning{}).Where("collection_id = ?", collectionID).Delete(&metadata).Error
 	return len(metadata), err
 }
 
 func (s *collectionMetadataDb) Insert(in []*dbmodel.CollectionMetadata) error {
+	tenantIDs := make(map[dbmodel.CollectionID]string)
+	for _, metadata := range in {
+		if metadata.TenantID != "" {
+			continue
+		}
+		tenantID, ok := tenantIDs[metadata.CollectionID]
+		if !ok {
+			var err error
+			tenantID, err = tenantIDForCollection(s.db, metadata.CollectionID)
+			if err != nil {
+				return err
+			}
+			tenantIDs[metadata.CollectionID] = tenantID
+		}
+		metadata.TenantID = tenantID
+	}
+	// The primary key gains tenant_id when the table is partitioned, so the
+	// conflict target is the constraint rather than its columns.
 	return s.db.Clauses(clause.OnConflict{
-		Columns:   []clause.Column{{Name: "collection_id"}, {Name: "key"}},
-		DoUpdates: clause.AssignmentColumns([]string{"str_value", "int_value", "float_value", "bool_value"}),
+		OnConstraint: "collection_metadata_pkey",
+		DoUpdates:    clause.AssignmentColumns([]string{"str_value", "int_value", "float_value", "bool_value"}),
 	}).Create(in).Error
 }
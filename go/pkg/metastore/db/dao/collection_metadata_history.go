@@ -0,0 +1,86 @@
+package dao
+
+import (
+	"encoding/json"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type collectionMetadataHistoryDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.ICollectionMetadataHistoryDb = &collectionMetadataHistoryDb{}
+
+// metadataHistoryEntry is a metadata entry as recorded in the history, without
+// the columns that only matter to the live metadata.
+type metadataHistoryEntry struct {
+	Key        *string  `json:"key"`
+	StrValue   *string  `json:"str_value,omitempty"`
+	IntValue   *int64   `json:"int_value,omitempty"`
+	FloatValue *float64 `json:"float_value,omitempty"`
+	BoolValue  *bool    `json:"bool_value,omitempty"`
+}
+
+func (s *collectionMetadataHistoryDb) Record(collectionID dbmodel.CollectionID, tenantID string, revision int64, metadata []*dbmodel.CollectionMetadata) error {
+	entries := make([]metadataHistoryEntry, 0, len(metadata))
+	for _, m := range metadata {
+		entries = append(entries, metadataHistoryEntry{Key: m.Key, StrValue: m.StrValue, IntValue: m.IntValue, FloatValue: m.FloatValue, BoolValue: m.BoolValue})
+	}
+	record, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	err = s.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&dbmodel.CollectionMetadataHistory{
+		CollectionID: collectionID,
+		Revision:     revision,
+		TenantID:     tenantID,
+		Metadata:     string(record),
+	}).Error
+	if err != nil {
+		log.Error("record collection metadata history failed", zap.String("collectionID", collectionID.String()), zap.Int64("revision", revision), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (s *collectionMetadataHistoryDb) GetAt(collectionID dbmodel.CollectionID, revision int64) ([]*dbmodel.CollectionMetadata, bool, error) {
+	var history []*dbmodel.CollectionMetadataHistory
+	err := s.db.Where("collection_id = ? AND revision <= ?", collectionID, revision).Order("revision DESC").Limit(1).Find(&history).Error
+	if err != nil {
+		log.Error("get collection metadata history failed", zap.String("collectionID", collectionID.String()), zap.Int64("revision", revision), zap.Error(err))
+		return nil, false, err
+	}
+	if len(history) == 0 {
+		return nil, false, nil
+	}
+	var entries []metadataHistoryEntry
+	if err := json.Unmarshal([]byte(history[0].Metadata), &entries); err != nil {
+		return nil, false, err
+	}
+	metadata := make([]*dbmodel.CollectionMetadata, 0, len(entries))
+	for _, e := range entries {
+		metadata = append(metadata, &dbmodel.CollectionMetadata{
+			CollectionID: collectionID,
+			Key:          e.Key,
+			StrValue:     e.StrValue,
+			IntValue:     e.IntValue,
+			FloatValue:   e.FloatValue,
+			BoolValue:    e.BoolValue,
+			TenantID:     history[0].TenantID,
+		})
+	}
+	return metadata, true, nil
+}
+
+func (s *collectionMetadataHistoryDb) DeleteByCollectionID(collectionID dbmodel.CollectionID) error {
+	return s.db.Where("collection_id = ?", collectionID).Delete(&dbmodel.CollectionMetadataHistory{}).Error
+}
+
+func (s *collectionMetadataHistoryDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.CollectionMetadataHistory{}).Error
+}
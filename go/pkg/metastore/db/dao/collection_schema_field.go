@@ -0,0 +1,54 @@
+package dao
+
+import (
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type collectionSchemaFieldDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.ICollectionSchemaFieldDb = &collectionSchemaFieldDb{}
+
+func (s *collectionSchemaFieldDb) Replace(collectionID dbmodel.CollectionID, tenantID string, fields []*dbmodel.CollectionSchemaField) error {
+	err := s.db.Where("collection_id = ?", collectionID).Delete(&dbmodel.CollectionSchemaField{}).Error
+	if err != nil {
+		log.Error("delete collection schema fields failed", zap.String("collectionID", collectionID.String()), zap.Error(err))
+		return err
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	for _, field := range fields {
+		field.CollectionID = collectionID
+		field.TenantID = tenantID
+	}
+	err = s.db.Create(fields).Error
+	if err != nil {
+		log.Error("insert collection schema fields failed", zap.String("collectionID", collectionID.String()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (s *collectionSchemaFieldDb) GetByCollectionID(collectionID dbmodel.CollectionID) ([]*dbmodel.CollectionSchemaField, error) {
+	var fields []*dbmodel.CollectionSchemaField
+	err := s.db.Where("collection_id = ?", collectionID).Order("name").Find(&fields).Error
+	if err != nil {
+		log.Error("get collection schema fields failed", zap.String("collectionID", collectionID.String()), zap.Error(err))
+		return nil, err
+	}
+	return fields, nil
+}
+
+func (s *collectionSchemaFieldDb) DeleteByCollectionID(collectionID dbmodel.CollectionID) (int64, error) {
+	result := s.db.Where("collection_id = ?", collectionID).Delete(&dbmodel.CollectionSchemaField{})
+	return result.RowsAffected, result.Error
+}
+
+func (s *collectionSchemaFieldDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.CollectionSchemaField{}).Error
+}
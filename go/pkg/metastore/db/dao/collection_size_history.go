@@ -0,0 +1,56 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type collectionSizeHistoryDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.ICollectionSizeHistoryDb = &collectionSizeHistoryDb{}
+
+func (s *collectionSizeHistoryDb) Snapshot(day time.Time) error {
+	err := s.db.Exec(`INSERT INTO "collection_size_history" ("cluster_id", "collection_id", "day", "tenant_id", "total_records", "size_bytes")
+		SELECT collections.cluster_id, collections.id, ?, databases.tenant_id,
+			collections.total_records_post_compaction, collections.size_bytes_post_compaction
+		FROM collections
+		INNER JOIN databases ON collections.database_id = databases.id
+		WHERE collections.cluster_id = ? AND NOT collections.is_deleted AND NOT databases.is_deleted
+		ON CONFLICT ("cluster_id", "collection_id", "day") DO NOTHING`,
+		day, dbcore.ClusterID(s.db)).Error
+	if err != nil {
+		log.Error("snapshot collection sizes failed", zap.Time("day", day), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (s *collectionSizeHistoryDb) Get(collectionID dbmodel.CollectionID, since time.Time) ([]*dbmodel.CollectionSizeHistory, error) {
+	var history []*dbmodel.CollectionSizeHistory
+	err := s.db.Where("collection_id = ? AND day >= ?", collectionID, since).Order("day").Find(&history).Error
+	if err != nil {
+		log.Error("get collection size history failed", zap.String("collectionID", collectionID.String()), zap.Error(err))
+		return nil, err
+	}
+	return history, nil
+}
+
+func (s *collectionSizeHistoryDb) DeleteBefore(day time.Time) error {
+	err := s.db.Where("day < ?", day).Delete(&dbmodel.CollectionSizeHistory{}).Error
+	if err != nil {
+		log.Error("prune collection size history failed", zap.Time("day", day), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (s *collectionSizeHistoryDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.CollectionSizeHistory{}).Error
+}
@@ -0,0 +1,94 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type collectionStatsDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.ICollectionStatsDb = &collectionStatsDb{}
+
+// Refresh keeps the log lag of the collections already rolled up, it is only
+// updated by UpdateLogLag.
+func (s *collectionStatsDb) Refresh(refreshedAt time.Time) error {
+	clusterID := dbcore.ClusterID(s.db)
+	err := s.db.Exec(`INSERT INTO "collection_stats" ("cluster_id", "collection_id", "tenant_id", "database_name", "total_records", "size_bytes", "segment_count", "last_compacted_at", "refreshed_at")
+		SELECT collections.cluster_id, collections.id, databases.tenant_id, databases.name,
+			collections.total_records_post_compaction, collections.size_bytes_post_compaction,
+			(SELECT count(*) FROM segments WHERE segments.cluster_id = collections.cluster_id AND segments.collection_id = collections.id AND NOT segments.is_deleted),
+			collections.last_compacted_at, ?
+		FROM collections
+		INNER JOIN databases ON collections.database_id = databases.id
+		WHERE collections.cluster_id = ? AND NOT collections.is_deleted AND NOT databases.is_deleted
+		ON CONFLICT ("cluster_id", "collection_id") DO UPDATE SET
+			"tenant_id" = EXCLUDED."tenant_id",
+			"database_name" = EXCLUDED."database_name",
+			"total_records" = EXCLUDED."total_records",
+			"size_bytes" = EXCLUDED."size_bytes",
+			"segment_count" = EXCLUDED."segment_count",
+			"last_compacted_at" = EXCLUDED."last_compacted_at",
+			"refreshed_at" = EXCLUDED."refreshed_at"`,
+		refreshedAt, clusterID).Error
+	if err != nil {
+		log.Error("refresh collection stats failed", zap.Error(err))
+		return err
+	}
+	err = s.db.Where("refreshed_at < ?", refreshedAt).Delete(&dbmodel.CollectionStats{}).Error
+	if err != nil {
+		log.Error("delete stale collection stats failed", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (s *collectionStatsDb) UpdateLogLag(lags []*dbmodel.CollectionLogLagStats) error {
+	for _, lag := range lags {
+		err := s.db.Model(&dbmodel.CollectionStats{}).
+			Where("collection_id = ?", lag.CollectionID).
+			Updates(map[string]interface{}{
+				"uncompacted_records":   lag.UncompactedRecords,
+				"oldest_uncompacted_at": lag.OldestUncompactedAt,
+			}).Error
+		if err != nil {
+			log.Error("update collection log lag failed", zap.String("collectionID", lag.CollectionID.String()), zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *collectionStatsDb) GetCollectionIDs() ([]dbmodel.CollectionID, error) {
+	var collectionIDs []dbmodel.CollectionID
+	err := s.db.Model(&dbmodel.CollectionStats{}).Order("collection_id").Pluck("collection_id", &collectionIDs).Error
+	if err != nil {
+		log.Error("get collection stats ids failed", zap.Error(err))
+		return nil, err
+	}
+	return collectionIDs, nil
+}
+
+func (s *collectionStatsDb) List(tenantID string, databaseName string, limit int32, offset int32) ([]*dbmodel.CollectionStats, error) {
+	query := s.db.Where("tenant_id = ?", tenantID)
+	if databaseName != "" {
+		query = query.Where("database_name = ?", databaseName)
+	}
+	var stats []*dbmodel.CollectionStats
+	err := query.Order("size_bytes DESC").Order("collection_id").Limit(int(limit)).Offset(int(offset)).Find(&stats).Error
+	if err != nil {
+		log.Error("list collection stats failed", zap.String("tenantID", tenantID), zap.String("databaseName", databaseName), zap.Error(err))
+		return nil, err
+	}
+	return stats, nil
+}
+
+func (s *collectionStatsDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.CollectionStats{}).Error
+}
@@ -0,0 +1,85 @@
+package dao
+
+import (
+	"errors"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type collectionTemplateDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.ICollectionTemplateDb = &collectionTemplateDb{}
+
+func (s *collectionTemplateDb) Insert(in *dbmodel.CollectionTemplate) error {
+	err := s.db.Create(in).Error
+	if err != nil {
+		log.Error("insert collection template failed", zap.String("tenantID", in.TenantID), zap.String("name", in.Name), zap.Error(err))
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return common.ErrCollectionTemplateUniqueConstraintViolation
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *collectionTemplateDb) Get(id *string, tenantID string, name *string) ([]*dbmodel.CollectionTemplate, error) {
+	query := s.db.Order("tenant_id, name")
+	if id != nil {
+		query = query.Where("id = ?", *id)
+	}
+	if tenantID != "" {
+		query = query.Where("tenant_id = ?", tenantID)
+	}
+	if name != nil {
+		query = query.Where("name = ?", *name)
+	}
+	var templates []*dbmodel.CollectionTemplate
+	err := query.Find(&templates).Error
+	if err != nil {
+		log.Error("get collection templates failed", zap.String("tenantID", tenantID), zap.Error(err))
+		return nil, err
+	}
+	return templates, nil
+}
+
+func (s *collectionTemplateDb) GetForShare(id string) (*dbmodel.CollectionTemplate, error) {
+	var templates []*dbmodel.CollectionTemplate
+	err := s.db.Clauses(clause.Locking{Strength: "SHARE"}).
+		Where("id = ?", id).
+		Limit(1).
+		Find(&templates).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(templates) == 0 {
+		return nil, nil
+	}
+	return templates[0], nil
+}
+
+func (s *collectionTemplateDb) Delete(id string) (bool, error) {
+	result := s.db.Where("id = ?", id).Delete(&dbmodel.CollectionTemplate{})
+	if result.Error != nil {
+		log.Error("delete collection template failed", zap.String("id", id), zap.Error(result.Error))
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (s *collectionTemplateDb) DeleteByTenantID(tenantID string) (int64, error) {
+	result := s.db.Where("tenant_id = ?", tenantID).Delete(&dbmodel.CollectionTemplate{})
+	return result.RowsAffected, result.Error
+}
+
+func (s *collectionTemplateDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.CollectionTemplate{}).Error
+}
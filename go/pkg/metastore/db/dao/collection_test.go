@@ -1,13 +1,20 @@
 package dao
 
 import (
+	"context"
+	"slices"
 	"testing"
+	"time"
 
+	"github.com/chroma-core/chroma/go/migrations"
+	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
 	"github.com/pingcap/log"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/chroma-core/chroma/go/pkg/types"
 	"gorm.io/gorm"
 )
 
@@ -17,7 +24,7 @@ type CollectionDbTestSuite struct {
 	collectionDb *collectionDb
 	tenantName   string
 	databaseName string
-	databaseId   string
+	databaseId   dbmodel.DatabaseID
 }
 
 func (suite *CollectionDbTestSuite) SetupSuite() {
@@ -60,12 +67,12 @@ func (suite *CollectionDbTestSuite) TestCollectionDb_GetCollections() {
 	rows, err := query.Rows()
 	suite.NoError(err)
 	for rows.Next() {
-		var scanedCollectionID string
+		var scanedCollectionID dbmodel.CollectionID
 		err = rows.Scan(&scanedCollectionID)
 		suite.NoError(err)
 		suite.Equal(collectionID, scanedCollectionID)
 	}
-	collections, err := suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, nil, nil)
+	collections, err := suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, nil, nil, nil)
 	suite.NoError(err)
 	suite.Len(collections, 1)
 	suite.Equal(collectionID, collections[0].Collection.ID)
@@ -75,13 +82,13 @@ func (suite *CollectionDbTestSuite) TestCollectionDb_GetCollections() {
 	suite.Equal(metadata.StrValue, collections[0].CollectionMetadata[0].StrValue)
 
 	// Test when filtering by ID
-	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, nil, nil)
+	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, nil, nil, nil)
 	suite.NoError(err)
 	suite.Len(collections, 1)
 	suite.Equal(collectionID, collections[0].Collection.ID)
 
 	// Test when filtering by name
-	collections, err = suite.collectionDb.GetCollections(nil, &collectionName, suite.tenantName, suite.databaseName, nil, nil)
+	collections, err = suite.collectionDb.GetCollections(nil, &collectionName, suite.tenantName, suite.databaseName, nil, nil, nil)
 	suite.NoError(err)
 	suite.Len(collections, 1)
 	suite.Equal(collectionID, collections[0].Collection.ID)
@@ -90,24 +97,24 @@ func (suite *CollectionDbTestSuite) TestCollectionDb_GetCollections() {
 	_, err = CreateTestCollection(suite.db, "test_collection_get_collections2", 128, suite.databaseId)
 	suite.NoError(err)
 
-	allCollections, err := suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, nil, nil)
+	allCollections, err := suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, nil, nil, nil)
 	suite.NoError(err)
 	suite.Len(allCollections, 2)
 
 	limit := int32(1)
 	offset := int32(1)
-	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, &limit, nil)
+	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, &limit, nil, nil)
 	suite.NoError(err)
 	suite.Len(collections, 1)
 	suite.Equal(allCollections[0].Collection.ID, collections[0].Collection.ID)
 
-	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, &limit, &offset)
+	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, &limit, &offset, nil)
 	suite.NoError(err)
 	suite.Len(collections, 1)
 	suite.Equal(allCollections[1].Collection.ID, collections[0].Collection.ID)
 
 	offset = int32(2)
-	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, &limit, &offset)
+	collections, err = suite.collectionDb.GetCollections(nil, nil, suite.tenantName, suite.databaseName, &limit, &offset, nil)
 	suite.NoError(err)
 	suite.Equal(len(collections), 0)
 
@@ -120,29 +127,30 @@ func (suite *CollectionDbTestSuite) TestCollectionDb_UpdateLogPositionAndVersion
 	collectionName := "test_collection_get_collections"
 	collectionID, err := CreateTestCollection(suite.db, collectionName, 128, suite.databaseId)
 	// verify default values
-	collections, err := suite.collectionDb.GetCollections(&collectionID, nil, "", "", nil, nil)
+	collections, err := suite.collectionDb.GetCollections(&collectionID, nil, "", "", nil, nil, nil)
 	suite.NoError(err)
 	suite.Len(collections, 1)
 	suite.Equal(int64(0), collections[0].Collection.LogPosition)
 	suite.Equal(int32(0), collections[0].Collection.Version)
 
 	// update log position and version
-	version, err := suite.collectionDb.UpdateLogPositionAndVersion(collectionID, int64(10), 0)
+	version, compactedRecords, err := suite.collectionDb.UpdateLogPositionAndVersion(collectionID, int64(10), 0)
 	suite.NoError(err)
 	suite.Equal(int32(1), version)
-	collections, err = suite.collectionDb.GetCollections(&collectionID, nil, "", "", nil, nil)
+	suite.Equal(int64(10), compactedRecords)
+	collections, err = suite.collectionDb.GetCollections(&collectionID, nil, "", "", nil, nil, nil)
 	suite.Len(collections, 1)
 	suite.Equal(int64(10), collections[0].Collection.LogPosition)
 	suite.Equal(int32(1), collections[0].Collection.Version)
 
 	// invalid log position
-	_, err = suite.collectionDb.UpdateLogPositionAndVersion(collectionID, int64(5), 0)
+	_, _, err = suite.collectionDb.UpdateLogPositionAndVersion(collectionID, int64(5), 0)
 	suite.Error(err, "collection log position Stale")
 
 	// invalid version
-	_, err = suite.collectionDb.UpdateLogPositionAndVersion(collectionID, int64(20), 0)
+	_, _, err = suite.collectionDb.UpdateLogPositionAndVersion(collectionID, int64(20), 0)
 	suite.Error(err, "collection version invalid")
-	_, err = suite.collectionDb.UpdateLogPositionAndVersion(collectionID, int64(20), 3)
+	_, _, err = suite.collectionDb.UpdateLogPositionAndVersion(collectionID, int64(20), 3)
 	suite.Error(err, "collection version invalid")
 
 	//clean up
@@ -150,6 +158,773 @@ func (suite *CollectionDbTestSuite) TestCollectionDb_UpdateLogPositionAndVersion
 	suite.NoError(err)
 }
 
+func (suite *CollectionDbTestSuite) TestCollectionDb_GetCollectionsBySize() {
+	smallID, err := CreateTestCollection(suite.db, "test_collection_by_size_small", 128, suite.databaseId)
+	suite.NoError(err)
+	largeID, err := CreateTestCollection(suite.db, "test_collection_by_size_large", 128, suite.databaseId)
+	suite.NoError(err)
+
+	smallRecords, smallBytes := int64(10), int64(4096)
+	_, err = suite.collectionDb.UpdateCollectionSize(smallID, &smallRecords, &smallBytes)
+	suite.NoError(err)
+	largeRecords, largeBytes := int64(1000), int64(1024)
+	_, err = suite.collectionDb.UpdateCollectionSize(largeID, &largeRecords, &largeBytes)
+	suite.NoError(err)
+
+	// the change is relative to the previous size
+	largeRecords = 1500
+	change, err := suite.collectionDb.UpdateCollectionSize(largeID, &largeRecords, nil)
+	suite.NoError(err)
+	suite.Equal(&dbmodel.CollectionSizeChange{DatabaseID: suite.databaseId, Records: 500}, change)
+
+	// order by total records
+	collections, err := suite.collectionDb.GetCollectionsBySize(suite.tenantName, suite.databaseName, model.CollectionSizeOrderByTotalRecords, 1)
+	suite.NoError(err)
+	suite.Len(collections, 1)
+	suite.Equal(largeID, collections[0].ID)
+	suite.Equal(largeRecords, collections[0].TotalRecordsPostCompaction)
+
+	// order by size bytes
+	collections, err = suite.collectionDb.GetCollectionsBySize(suite.tenantName, suite.databaseName, model.CollectionSizeOrderBySizeBytes, 2)
+	suite.NoError(err)
+	suite.Len(collections, 2)
+	suite.Equal(smallID, collections[0].ID)
+	suite.Equal(largeID, collections[1].ID)
+
+	// a nil size leaves the stored value untouched
+	_, err = suite.collectionDb.UpdateCollectionSize(smallID, nil, nil)
+	suite.NoError(err)
+	collections, err = suite.collectionDb.GetCollectionsBySize(suite.tenantName, suite.databaseName, model.CollectionSizeOrderBySizeBytes, 1)
+	suite.NoError(err)
+	suite.Equal(smallBytes, collections[0].SizeBytesPostCompaction)
+
+	// clean up
+	err = CleanUpTestCollection(suite.db, smallID)
+	suite.NoError(err)
+	err = CleanUpTestCollection(suite.db, largeID)
+	suite.NoError(err)
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_FencingToken() {
+	collectionID, err := CreateTestCollection(suite.db, "test_collection_fencing_token", 128, suite.databaseId)
+	suite.NoError(err)
+
+	// tokens increase with every ownership change
+	first, err := suite.collectionDb.AcquireFencingToken(collectionID, "compactor-0")
+	suite.NoError(err)
+	second, err := suite.collectionDb.AcquireFencingToken(collectionID, "compactor-1")
+	suite.NoError(err)
+	suite.Greater(second, first)
+
+	// only the latest token is accepted
+	err = suite.collectionDb.CheckFencingToken(collectionID, second)
+	suite.NoError(err)
+	err = suite.collectionDb.CheckFencingToken(collectionID, first)
+	suite.ErrorIs(err, common.ErrCollectionFencingTokenStale)
+
+	// unknown collection
+	_, err = suite.collectionDb.AcquireFencingToken(dbmodel.NewCollectionID(types.NewUniqueID()), "compactor-0")
+	suite.ErrorIs(err, common.ErrCollectionNotFound)
+
+	// clean up
+	err = CleanUpTestCollection(suite.db, collectionID)
+	suite.NoError(err)
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_LogRoute() {
+	collectionID, err := CreateTestCollection(suite.db, "test_collection_log_route", 128, suite.databaseId)
+	suite.NoError(err)
+	routeDb := &collectionLogRouteDb{db: suite.db}
+	before, err := routeDb.GetLastRevision()
+	suite.NoError(err)
+
+	// versions increase with every change
+	route, err := routeDb.Upsert(collectionID, "log-1:50051")
+	suite.NoError(err)
+	suite.Equal(int64(1), route.Version)
+	route, err = routeDb.Upsert(collectionID, "log-2:50051")
+	suite.NoError(err)
+	suite.Equal(int64(2), route.Version)
+	suite.Equal("log-2:50051", route.LogAddress)
+
+	routes, err := routeDb.GetByCollectionIDs([]dbmodel.CollectionID{collectionID, dbmodel.NewCollectionID(types.NewUniqueID())})
+	suite.NoError(err)
+	suite.Len(routes, 1)
+	suite.Equal(int64(2), routes[0].Version)
+
+	// revisions increase with every change of any route
+	last, err := routeDb.GetLastRevision()
+	suite.NoError(err)
+	suite.Equal(route.Revision, last)
+	suite.Greater(last, before)
+	routes, err = routeDb.GetChangedAfter(before)
+	suite.NoError(err)
+	suite.Len(routes, 1)
+	routes, err = routeDb.GetChangedAfter(last)
+	suite.NoError(err)
+	suite.Empty(routes)
+
+	// clean up
+	_, err = routeDb.DeleteByCollectionID(collectionID)
+	suite.NoError(err)
+	err = CleanUpTestCollection(suite.db, collectionID)
+	suite.NoError(err)
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_CompactionRequests() {
+	first, err := CreateTestCollection(suite.db, "test_collection_compaction_request_1", 128, suite.databaseId)
+	suite.NoError(err)
+	second, err := CreateTestCollection(suite.db, "test_collection_compaction_request_2", 128, suite.databaseId)
+	suite.NoError(err)
+	requestDb := &compactionRequestDb{db: suite.db}
+
+	suite.NoError(requestDb.Insert(&dbmodel.CompactionRequest{CollectionID: first, RequestedBy: "alice"}))
+	suite.NoError(requestDb.Insert(&dbmodel.CompactionRequest{CollectionID: second, RequestedBy: "bob"}))
+	// requesting again keeps the original request
+	suite.NoError(requestDb.Insert(&dbmodel.CompactionRequest{CollectionID: first, RequestedBy: "carol"}))
+
+	requests, err := requestDb.List(10)
+	suite.NoError(err)
+	suite.Len(requests, 2)
+	suite.Equal(first, requests[0].CollectionID)
+	suite.Equal("alice", requests[0].RequestedBy)
+	suite.Equal(second, requests[1].CollectionID)
+
+	deleted, err := requestDb.DeleteByCollectionID(first)
+	suite.NoError(err)
+	suite.Equal(int64(1), deleted)
+	requests, err = requestDb.List(10)
+	suite.NoError(err)
+	suite.Len(requests, 1)
+
+	// a compaction that started before the request does not serve it
+	deleted, err = requestDb.DeleteRequestedBefore(second, requests[0].RequestedAt)
+	suite.NoError(err)
+	suite.Equal(int64(0), deleted)
+	deleted, err = requestDb.DeleteRequestedBefore(second, requests[0].RequestedAt.Add(time.Millisecond))
+	suite.NoError(err)
+	suite.Equal(int64(1), deleted)
+
+	// clean up
+	suite.NoError(requestDb.DeleteAll())
+	suite.NoError(CleanUpTestCollection(suite.db, first))
+	suite.NoError(CleanUpTestCollection(suite.db, second))
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_GetApproximateDatabaseCollectionCount() {
+	before, err := suite.collectionDb.GetApproximateDatabaseCollectionCount(suite.databaseId)
+	suite.NoError(err)
+	collectionID, err := CreateTestCollection(suite.db, "test_collection_approximate_count", 128, suite.databaseId)
+	suite.NoError(err)
+
+	// small databases are counted exactly
+	after, err := suite.collectionDb.GetApproximateDatabaseCollectionCount(suite.databaseId)
+	suite.NoError(err)
+	suite.Equal(before+1, after)
+
+	// clean up
+	suite.NoError(CleanUpTestCollection(suite.db, collectionID))
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_LastCompactor() {
+	collectionID, err := CreateTestCollection(suite.db, "test_collection_last_compactor", 128, suite.databaseId)
+	suite.NoError(err)
+
+	collections, err := suite.collectionDb.GetCollections(&collectionID, nil, "", "", nil, nil, nil)
+	suite.NoError(err)
+	suite.Nil(collections[0].Collection.LastCompactor)
+	suite.Nil(collections[0].Collection.LastCompactedAt)
+
+	err = suite.collectionDb.UpdateLastCompactor(collectionID, "compactor-1")
+	suite.NoError(err)
+	collections, err = suite.collectionDb.GetCollections(&collectionID, nil, "", "", nil, nil, nil)
+	suite.NoError(err)
+	suite.Equal("compactor-1", *collections[0].Collection.LastCompactor)
+	suite.NotNil(collections[0].Collection.LastCompactedAt)
+
+	// clean up
+	err = CleanUpTestCollection(suite.db, collectionID)
+	suite.NoError(err)
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_UpdateState() {
+	collectionID, err := CreateTestCollection(suite.db, "test_collection_update_state", 128, suite.databaseId)
+	suite.NoError(err)
+
+	// collections default to ready
+	collections, err := suite.collectionDb.GetCollections(&collectionID, nil, "", "", nil, nil, nil)
+	suite.NoError(err)
+	suite.Equal(string(model.CollectionStateReady), collections[0].Collection.State)
+
+	// the transition only applies from the expected states
+	updated, err := suite.collectionDb.UpdateState(collectionID, []string{string(model.CollectionStateCreating)}, string(model.CollectionStateDegraded))
+	suite.NoError(err)
+	suite.Equal(int64(0), updated)
+	updated, err = suite.collectionDb.UpdateState(collectionID, []string{string(model.CollectionStateReady)}, string(model.CollectionStateDegraded))
+	suite.NoError(err)
+	suite.Equal(int64(1), updated)
+	collections, err = suite.collectionDb.GetCollections(&collectionID, nil, "", "", nil, nil, nil)
+	suite.NoError(err)
+	suite.Equal(string(model.CollectionStateDegraded), collections[0].Collection.State)
+
+	// clean up
+	err = CleanUpTestCollection(suite.db, collectionID)
+	suite.NoError(err)
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_TenantPartitionKey() {
+	collectionID, err := CreateTestCollection(suite.db, "test_collection_tenant_partition_key", 128, suite.databaseId)
+	suite.NoError(err)
+	key := "key"
+	value := "value"
+	collectionMetadataDb := &collectionMetadataDb{db: suite.db}
+	err = collectionMetadataDb.Insert([]*dbmodel.CollectionMetadata{{CollectionID: collectionID, Key: &key, StrValue: &value}})
+	suite.NoError(err)
+
+	// the tenant is copied from the database and the collection when it is not set
+	var collection dbmodel.Collection
+	err = suite.db.Where("id = ?", collectionID).First(&collection).Error
+	suite.NoError(err)
+	suite.Equal(suite.tenantName, collection.TenantID)
+	var segments []dbmodel.Segment
+	err = suite.db.Where("collection_id = ?", collectionID).Find(&segments).Error
+	suite.NoError(err)
+	suite.NotEmpty(segments)
+	for _, segment := range segments {
+		suite.Equal(suite.tenantName, segment.TenantID)
+	}
+	var metadata dbmodel.CollectionMetadata
+	err = suite.db.Where("collection_id = ?", collectionID).First(&metadata).Error
+	suite.NoError(err)
+	suite.Equal(suite.tenantName, metadata.TenantID)
+
+	// clean up
+	err = CleanUpTestCollection(suite.db, collectionID)
+	suite.NoError(err)
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_SoftDelete() {
+	collectionID, err := CreateTestCollection(suite.db, "test_collection_soft_delete", 128, suite.databaseId)
+	suite.NoError(err)
+
+	deleted, err := suite.collectionDb.SoftDeleteCollectionByID(collectionID, "admin")
+	suite.NoError(err)
+	suite.Equal(int64(1), deleted)
+
+	// soft deleted collections are hidden from reads
+	collections, err := suite.collectionDb.GetCollections(&collectionID, nil, "", "", nil, nil, nil)
+	suite.NoError(err)
+	suite.Len(collections, 0)
+
+	// the row is kept with the deletion recorded
+	var collection dbmodel.Collection
+	err = suite.db.Where("id = ?", collectionID).First(&collection).Error
+	suite.NoError(err)
+	suite.True(collection.IsDeleted)
+	suite.NotNil(collection.DeletedAt)
+	suite.Equal("admin", *collection.DeletedBy)
+	suite.Equal(string(model.CollectionStateDeleting), collection.State)
+	suite.Equal("test_collection_soft_delete__deleted__"+collectionID.String(), *collection.Name)
+
+	// a collection is only soft deleted once
+	deleted, err = suite.collectionDb.SoftDeleteCollectionByID(collectionID, "admin")
+	suite.NoError(err)
+	suite.Equal(int64(0), deleted)
+
+	// clean up
+	err = CleanUpTestCollection(suite.db, collectionID)
+	suite.NoError(err)
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_GetDeletedCollectionIDs() {
+	liveID, err := CreateTestCollection(suite.db, "test_collection_deleted_ids_live", 128, suite.databaseId)
+	suite.NoError(err)
+	firstID, err := CreateTestCollection(suite.db, "test_collection_deleted_ids_first", 128, suite.databaseId)
+	suite.NoError(err)
+	secondID, err := CreateTestCollection(suite.db, "test_collection_deleted_ids_second", 128, suite.databaseId)
+	suite.NoError(err)
+	for _, collectionID := range []dbmodel.CollectionID{firstID, secondID} {
+		_, err = suite.collectionDb.SoftDeleteCollectionByID(collectionID, "admin")
+		suite.NoError(err)
+	}
+	err = suite.db.Model(&dbmodel.Collection{}).Where("id = ?", firstID).Update("deleted_at", time.Now().Add(-time.Hour)).Error
+	suite.NoError(err)
+
+	// the longest deleted first, live collections are left out
+	collectionIDs, err := suite.collectionDb.GetDeletedCollectionIDs(time.Now().Add(time.Minute), 10)
+	suite.NoError(err)
+	suite.Equal([]dbmodel.CollectionID{firstID, secondID}, collectionIDs)
+	collectionIDs, err = suite.collectionDb.GetDeletedCollectionIDs(time.Now().Add(time.Minute), 1)
+	suite.NoError(err)
+	suite.Equal([]dbmodel.CollectionID{firstID}, collectionIDs)
+	collectionIDs, err = suite.collectionDb.GetDeletedCollectionIDs(time.Now().Add(-time.Minute), 10)
+	suite.NoError(err)
+	suite.Equal([]dbmodel.CollectionID{firstID}, collectionIDs)
+
+	// dead lettered purges are not retried
+	deadLetterDb := &cleanupDeadLetterDb{db: suite.db}
+	entry, err := deadLetterDb.RecordFailure(firstID, dbmodel.CleanupTaskPurge, "", "permission denied", time.Now(), 2)
+	suite.NoError(err)
+	suite.False(entry.DeadLettered)
+	entry, err = deadLetterDb.RecordFailure(firstID, dbmodel.CleanupTaskPurge, "", "permission denied", time.Now(), 2)
+	suite.NoError(err)
+	suite.True(entry.DeadLettered)
+	suite.Equal(int32(2), entry.Attempts)
+	collectionIDs, err = suite.collectionDb.GetDeletedCollectionIDs(time.Now().Add(time.Minute), 10)
+	suite.NoError(err)
+	suite.Equal([]dbmodel.CollectionID{secondID}, collectionIDs)
+	deleted, err := deadLetterDb.Delete(firstID, dbmodel.CleanupTaskPurge)
+	suite.NoError(err)
+	suite.Equal(int64(1), deleted)
+
+	// clean up
+	for _, collectionID := range []dbmodel.CollectionID{liveID, firstID, secondID} {
+		suite.NoError(CleanUpTestCollection(suite.db, collectionID))
+	}
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_Revision() {
+	collectionID, err := CreateTestCollection(suite.db, "test_collection_revision", 128, suite.databaseId)
+	suite.NoError(err)
+
+	err = suite.db.Transaction(func(tx *gorm.DB) error {
+		collectionDb := &collectionDb{db: tx}
+		collection, err := collectionDb.GetForUpdate(collectionID)
+		suite.NoError(err)
+		suite.Equal(int64(0), collection.Revision)
+		return collectionDb.Update(&dbmodel.Collection{ID: collectionID, Revision: collection.Revision + 1})
+	})
+	suite.NoError(err)
+	collections, err := suite.collectionDb.GetCollections(&collectionID, nil, "", "", nil, nil, nil)
+	suite.NoError(err)
+	suite.Equal(int64(1), collections[0].Collection.Revision)
+
+	// soft deleted collections are not returned
+	_, err = suite.collectionDb.SoftDeleteCollectionByID(collectionID, "admin")
+	suite.NoError(err)
+	collection, err := suite.collectionDb.GetForUpdate(collectionID)
+	suite.NoError(err)
+	suite.Nil(collection)
+	suite.NoError(CleanUpTestCollection(suite.db, collectionID))
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_Restore() {
+	name := "test_collection_restore"
+	deletedID, err := CreateTestCollection(suite.db, name, 128, suite.databaseId)
+	suite.NoError(err)
+	_, err = suite.collectionDb.SoftDeleteCollectionByID(deletedID, "admin")
+	suite.NoError(err)
+
+	// the name is free as soon as the collection is soft deleted
+	reusedID, err := CreateTestCollection(suite.db, name, 128, suite.databaseId)
+	suite.NoError(err)
+
+	collection, err := suite.collectionDb.GetDeletedCollection(deletedID)
+	suite.NoError(err)
+	suite.Equal(name, dbmodel.OriginalCollectionName(*collection.Name, deletedID))
+	collection, err = suite.collectionDb.GetDeletedCollection(reusedID)
+	suite.NoError(err)
+	suite.Nil(collection)
+
+	_, err = suite.collectionDb.RestoreCollectionByID(deletedID, name, string(model.CollectionStateReady))
+	suite.ErrorIs(err, common.ErrCollectionUniqueConstraintViolation)
+	restored, err := suite.collectionDb.RestoreCollectionByID(deletedID, name+"_restored", string(model.CollectionStateReady))
+	suite.NoError(err)
+	suite.Equal(int64(1), restored)
+	collections, err := suite.collectionDb.GetCollections(&deletedID, nil, "", "", nil, nil, nil)
+	suite.NoError(err)
+	suite.Len(collections, 1)
+	suite.Equal(name+"_restored", *collections[0].Collection.Name)
+	suite.Nil(collections[0].Collection.DeletedBy)
+
+	// clean up
+	suite.NoError(CleanUpTestCollection(suite.db, deletedID))
+	suite.NoError(CleanUpTestCollection(suite.db, reusedID))
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_GetInconsistentCollections() {
+	consistentID, err := CreateTestCollection(suite.db, "test_collection_consistent", 128, suite.databaseId)
+	suite.NoError(err)
+	deletedID, err := CreateTestCollection(suite.db, "test_collection_deleted_with_segments", 128, suite.databaseId)
+	suite.NoError(err)
+	_, err = suite.collectionDb.SoftDeleteCollectionByID(deletedID, "admin")
+	suite.NoError(err)
+	deletedSegmentID, err := CreateTestCollection(suite.db, "test_collection_deleted_segment", 128, suite.databaseId)
+	suite.NoError(err)
+	err = suite.db.Model(&dbmodel.Segment{}).Where("collection_id = ? AND scope = ?", deletedSegmentID, "METADATA").Updates(softDeleteUpdates("admin")).Error
+	suite.NoError(err)
+	flushedID, err := CreateTestCollection(suite.db, "test_collection_flushed_without_files", 128, suite.databaseId)
+	suite.NoError(err)
+	err = suite.db.Model(&dbmodel.Collection{}).Where("id = ?", flushedID).Update("version", 1).Error
+	suite.NoError(err)
+
+	requiredScopes := []string{"VECTOR", "METADATA"}
+	kinds := func(deletedBefore time.Time) map[dbmodel.CollectionID][]model.InconsistencyKind {
+		collections, err := suite.collectionDb.GetInconsistentCollections(deletedBefore, requiredScopes, nil)
+		suite.NoError(err)
+		kinds := make(map[dbmodel.CollectionID][]model.InconsistencyKind)
+		for _, collection := range collections {
+			kinds[collection.Collection.ID] = append(kinds[collection.Collection.ID], collection.Kind)
+			if collection.Kind == model.InconsistencyMissingSegments {
+				suite.Equal([]string{"VECTOR"}, collection.Scopes)
+			} else {
+				suite.NotEmpty(collection.SegmentIDs)
+			}
+		}
+		return kinds
+	}
+
+	// the segments of a recently deleted collection may still be cleaned up
+	found := kinds(time.Now().Add(-time.Hour))
+	suite.NotContains(found, deletedID)
+	found = kinds(time.Now().Add(time.Minute))
+	suite.NotContains(found, consistentID)
+	suite.Equal([]model.InconsistencyKind{model.InconsistencySoftDeletedWithSegments}, found[deletedID])
+	suite.ElementsMatch([]model.InconsistencyKind{model.InconsistencyDeletedSegments, model.InconsistencyMissingSegments}, found[deletedSegmentID])
+	suite.Equal([]model.InconsistencyKind{model.InconsistencyFlushedWithoutFiles}, found[flushedID])
+
+	// clean up
+	for _, collectionID := range []dbmodel.CollectionID{consistentID, deletedID, deletedSegmentID, flushedID} {
+		suite.NoError(suite.db.Where("collection_id = ?", collectionID).Delete(&dbmodel.Segment{}).Error)
+		suite.NoError(CleanUpTestCollection(suite.db, collectionID))
+	}
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_GetCollectionsToGc() {
+	oldID, err := CreateTestCollection(suite.db, "test_collection_to_gc_old", 128, suite.databaseId)
+	suite.NoError(err)
+	deletedID, err := CreateTestCollection(suite.db, "test_collection_to_gc_deleted", 128, suite.databaseId)
+	suite.NoError(err)
+	newID, err := CreateTestCollection(suite.db, "test_collection_to_gc_new", 128, suite.databaseId)
+	suite.NoError(err)
+
+	updatedAt := time.Now().Add(-48 * time.Hour)
+	err = suite.db.Model(&dbmodel.Collection{}).Where("id IN ?", []dbmodel.CollectionID{oldID, deletedID}).
+		Updates(map[string]interface{}{"updated_at": updatedAt, "version": 3}).Error
+	suite.NoError(err)
+	_, err = suite.collectionDb.SoftDeleteCollectionByID(deletedID, "admin")
+	suite.NoError(err)
+	err = suite.db.Model(&dbmodel.Collection{}).Where("id = ?", deletedID).Update("updated_at", updatedAt).Error
+	suite.NoError(err)
+
+	// soft deleted collections are included, recently updated ones are not
+	cutoff := time.Now().Add(-24 * time.Hour)
+	collections, err := suite.collectionDb.GetCollectionsToGc(cutoff, &suite.tenantName, 0, nil, 10)
+	suite.NoError(err)
+	suite.Len(collections, 2)
+	for _, collection := range collections {
+		suite.NotEqual(newID, collection.ID)
+		suite.Equal(collection.ID == deletedID, collection.IsDeleted)
+	}
+
+	// keyset pagination
+	first, err := suite.collectionDb.GetCollectionsToGc(cutoff, &suite.tenantName, 0, nil, 1)
+	suite.NoError(err)
+	suite.Len(first, 1)
+	second, err := suite.collectionDb.GetCollectionsToGc(cutoff, &suite.tenantName, 0, &first[0].ID, 1)
+	suite.NoError(err)
+	suite.Len(second, 1)
+	suite.Less(first[0].ID.String(), second[0].ID.String())
+
+	// version and tenant filters
+	collections, err = suite.collectionDb.GetCollectionsToGc(cutoff, &suite.tenantName, 4, nil, 10)
+	suite.NoError(err)
+	suite.Len(collections, 0)
+	otherTenant := "test_collection_to_gc_other_tenant"
+	collections, err = suite.collectionDb.GetCollectionsToGc(cutoff, &otherTenant, 0, nil, 10)
+	suite.NoError(err)
+	suite.Len(collections, 0)
+
+	// clean up
+	for _, id := range []dbmodel.CollectionID{oldID, deletedID, newID} {
+		err = CleanUpTestCollection(suite.db, id)
+		suite.NoError(err)
+	}
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_Acl() {
+	collectionID, err := CreateTestCollection(suite.db, "test_collection_acl", 128, suite.databaseId)
+	suite.NoError(err)
+	aclDb := &collectionAclDb{db: suite.db}
+
+	err = aclDb.Upsert(&dbmodel.CollectionAcl{CollectionID: collectionID, Principal: "alice", Permissions: 1})
+	suite.NoError(err)
+	err = aclDb.Upsert(&dbmodel.CollectionAcl{CollectionID: collectionID, Principal: "bob", Permissions: 1})
+	suite.NoError(err)
+	// a second upsert replaces the permissions
+	err = aclDb.Upsert(&dbmodel.CollectionAcl{CollectionID: collectionID, Principal: "alice", Permissions: 3})
+	suite.NoError(err)
+
+	entries, err := aclDb.GetByCollectionIDs([]dbmodel.CollectionID{collectionID})
+	suite.NoError(err)
+	suite.Len(entries, 2)
+	suite.Equal("alice", entries[0].Principal)
+	suite.Equal(int32(3), entries[0].Permissions)
+	suite.Equal(suite.tenantName, entries[0].TenantID)
+
+	deleted, err := aclDb.Delete(collectionID, "bob")
+	suite.NoError(err)
+	suite.Equal(int64(1), deleted)
+	deleted, err = aclDb.DeleteByCollectionID(collectionID)
+	suite.NoError(err)
+	suite.Equal(int64(1), deleted)
+
+	// clean up
+	err = CleanUpTestCollection(suite.db, collectionID)
+	suite.NoError(err)
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_ReindexPending() {
+	collectionID, err := CreateTestCollection(suite.db, "test_collection_reindex_pending", 128, suite.databaseId)
+	suite.NoError(err)
+
+	// the first marked dimension is kept across repeated changes
+	from := int32(128)
+	err = suite.collectionDb.MarkReindexPending(collectionID, &from)
+	suite.NoError(err)
+	other := int32(256)
+	err = suite.collectionDb.MarkReindexPending(collectionID, &other)
+	suite.NoError(err)
+	collections, err := suite.collectionDb.GetCollections(&collectionID, nil, "", "", nil, nil, nil)
+	suite.NoError(err)
+	suite.True(collections[0].Collection.ReindexPending)
+	suite.Equal(from, *collections[0].Collection.ReindexFromDimension)
+
+	// clearing requires the reindexed dimension to match
+	updated, err := suite.collectionDb.ClearReindexPending(collectionID, &other)
+	suite.NoError(err)
+	suite.Equal(int64(0), updated)
+	dimension := int32(128)
+	updated, err = suite.collectionDb.ClearReindexPending(collectionID, &dimension)
+	suite.NoError(err)
+	suite.Equal(int64(1), updated)
+	collections, err = suite.collectionDb.GetCollections(&collectionID, nil, "", "", nil, nil, nil)
+	suite.NoError(err)
+	suite.False(collections[0].Collection.ReindexPending)
+	suite.Nil(collections[0].Collection.ReindexFromDimension)
+
+	// clean up
+	err = CleanUpTestCollection(suite.db, collectionID)
+	suite.NoError(err)
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_DistanceFunctionFilter() {
+	cosineID, err := CreateTestCollection(suite.db, "test_collection_distance_cosine", 1536, suite.databaseId)
+	suite.NoError(err)
+	err = suite.db.Model(&dbmodel.Collection{}).Where("id = ?", cosineID).Update("distance_function", "cosine").Error
+	suite.NoError(err)
+	l2ID, err := CreateTestCollection(suite.db, "test_collection_distance_l2", 1536, suite.databaseId)
+	suite.NoError(err)
+	smallID, err := CreateTestCollection(suite.db, "test_collection_distance_small", 384, suite.databaseId)
+	suite.NoError(err)
+
+	collections, err := suite.collectionDb.GetCollections(&l2ID, nil, "", "", nil, nil, nil)
+	suite.NoError(err)
+	suite.Equal("l2", collections[0].Collection.DistanceFunction)
+
+	filters := []*model.CollectionMetadataFilter{
+		{Field: model.CollectionFilterDistanceFunction, Operator: model.MetadataFilterEQ, Value: &model.CollectionMetadataValueStringType{Value: "cosine"}},
+		{Field: model.CollectionFilterDimension, Operator: model.MetadataFilterEQ, Value: &model.CollectionMetadataValueInt64Type{Value: 1536}},
+	}
+	collections, err = suite.collectionDb.GetCollections(nil, nil, "", "", nil, nil, filters)
+	suite.NoError(err)
+	suite.Len(collections, 1)
+	suite.Equal(cosineID, collections[0].Collection.ID)
+	suite.Equal("cosine", collections[0].Collection.DistanceFunction)
+
+	filters = []*model.CollectionMetadataFilter{
+		{Field: model.CollectionFilterDimension, Operator: model.MetadataFilterLT, Value: &model.CollectionMetadataValueInt64Type{Value: 1000}},
+	}
+	collections, err = suite.collectionDb.GetCollections(nil, nil, "", "", nil, nil, filters)
+	suite.NoError(err)
+	suite.Len(collections, 1)
+	suite.Equal(smallID, collections[0].Collection.ID)
+
+	// the distance function is not ordered
+	filters = []*model.CollectionMetadataFilter{
+		{Field: model.CollectionFilterDistanceFunction, Operator: model.MetadataFilterGT, Value: &model.CollectionMetadataValueStringType{Value: "cosine"}},
+	}
+	_, err = suite.collectionDb.GetCollections(nil, nil, "", "", nil, nil, filters)
+	suite.ErrorIs(err, common.ErrInvalidMetadataFilter)
+
+	// clean up
+	for _, collectionID := range []dbmodel.CollectionID{cosineID, l2ID, smallID} {
+		err = CleanUpTestCollection(suite.db, collectionID)
+		suite.NoError(err)
+	}
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_MetadataEqualityFilter() {
+	searchID, err := CreateTestCollection(suite.db, "test_collection_metadata_search", 128, suite.databaseId)
+	suite.NoError(err)
+	adsID, err := CreateTestCollection(suite.db, "test_collection_metadata_ads", 128, suite.databaseId)
+	suite.NoError(err)
+	team, tier := "team", "tier"
+	search, ads := "search", "ads"
+	one, two := int64(1), int64(2)
+	for _, metadata := range []*dbmodel.CollectionMetadata{
+		{CollectionID: searchID, Key: &team, StrValue: &search},
+		{CollectionID: searchID, Key: &tier, IntValue: &one},
+		{CollectionID: adsID, Key: &team, StrValue: &ads},
+		{CollectionID: adsID, Key: &tier, IntValue: &two},
+	} {
+		suite.NoError(suite.db.Create(metadata).Error)
+	}
+
+	filters := []*model.CollectionMetadataFilter{
+		{Key: team, Operator: model.MetadataFilterEQ, Value: &model.CollectionMetadataValueStringType{Value: search}},
+	}
+	collections, err := suite.collectionDb.GetCollections(nil, nil, "", "", nil, nil, filters)
+	suite.NoError(err)
+	suite.Len(collections, 1)
+	suite.Equal(searchID, collections[0].Collection.ID)
+	// the join does not drop the other keys of the metadata
+	suite.Len(collections[0].CollectionMetadata, 2)
+
+	// every equality filter is a join of its own
+	filters = []*model.CollectionMetadataFilter{
+		{Key: team, Operator: model.MetadataFilterEQ, Value: &model.CollectionMetadataValueStringType{Value: ads}},
+		{Key: tier, Operator: model.MetadataFilterEQ, Value: &model.CollectionMetadataValueInt64Type{Value: 2}},
+	}
+	collections, err = suite.collectionDb.GetCollections(nil, nil, "", "", nil, nil, filters)
+	suite.NoError(err)
+	suite.Len(collections, 1)
+	suite.Equal(adsID, collections[0].Collection.ID)
+
+	filters[1].Value = &model.CollectionMetadataValueInt64Type{Value: 1}
+	collections, err = suite.collectionDb.GetCollections(nil, nil, "", "", nil, nil, filters)
+	suite.NoError(err)
+	suite.Empty(collections)
+
+	// clean up
+	for _, collectionID := range []dbmodel.CollectionID{searchID, adsID} {
+		err = CleanUpTestCollection(suite.db, collectionID)
+		suite.NoError(err)
+	}
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_CountCollections() {
+	liveID, err := CreateTestCollection(suite.db, "test_collection_count_live", 128, suite.databaseId)
+	suite.NoError(err)
+	deletedID, err := CreateTestCollection(suite.db, "test_collection_count_deleted", 128, suite.databaseId)
+	suite.NoError(err)
+	_, err = suite.collectionDb.SoftDeleteCollectionByID(deletedID, "test")
+	suite.NoError(err)
+
+	count, err := suite.collectionDb.CountCollections(suite.tenantName, suite.databaseName, false)
+	suite.NoError(err)
+	suite.Equal(int64(1), count)
+	count, err = suite.collectionDb.CountCollections(suite.tenantName, "", true)
+	suite.NoError(err)
+	suite.Equal(int64(2), count)
+	count, err = suite.collectionDb.CountCollections(suite.tenantName, "other_database", true)
+	suite.NoError(err)
+	suite.Zero(count)
+
+	// clean up
+	for _, collectionID := range []dbmodel.CollectionID{liveID, deletedID} {
+		err = CleanUpTestCollection(suite.db, collectionID)
+		suite.NoError(err)
+	}
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_UpdateCollectionName() {
+	collectionID, err := CreateTestCollection(suite.db, "test_collection_rename", 128, suite.databaseId)
+	suite.NoError(err)
+	otherID, err := CreateTestCollection(suite.db, "test_collection_rename_other", 128, suite.databaseId)
+	suite.NoError(err)
+
+	renamed, err := suite.collectionDb.UpdateCollectionName(collectionID, "test_collection_renamed")
+	suite.NoError(err)
+	suite.Equal(int64(1), renamed)
+	collection, err := suite.collectionDb.GetForUpdate(collectionID)
+	suite.NoError(err)
+	suite.Equal("test_collection_renamed", *collection.Name)
+	suite.Equal(int64(1), collection.Revision)
+
+	_, err = suite.collectionDb.UpdateCollectionName(otherID, "test_collection_renamed")
+	suite.True(common.IsCollectionAlreadyExists(err))
+
+	// clean up
+	for _, id := range []dbmodel.CollectionID{collectionID, otherID} {
+		err = CleanUpTestCollection(suite.db, id)
+		suite.NoError(err)
+	}
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_ScanCollections() {
+	var ids []dbmodel.CollectionID
+	for _, name := range []string{"test_collection_scan_1", "test_collection_scan_2", "test_collection_scan_3"} {
+		collectionID, err := CreateTestCollection(suite.db, name, 128, suite.databaseId)
+		suite.NoError(err)
+		ids = append(ids, collectionID)
+	}
+	slices.Sort(ids)
+	ctx := context.Background()
+	tx := dbcore.NewTxImpl()
+	snapshot, err := tx.HoldSnapshot(ctx, time.Minute)
+	suite.NoError(err)
+	laterID, err := CreateTestCollection(suite.db, "test_collection_scan_later", 128, suite.databaseId)
+	suite.NoError(err)
+	err = CleanUpTestCollection(suite.db, ids[0])
+	suite.NoError(err)
+
+	// every batch pages by ID in the snapshot: the collection deleted since the
+	// scan started is returned and the one created since is not
+	var scanned []dbmodel.CollectionID
+	var startAfter *dbmodel.CollectionID
+	for {
+		var collections []*dbmodel.CollectionAndMetadata
+		err = tx.WithSnapshotTx(ctx, snapshot, func(txCtx context.Context) error {
+			var err error
+			collections, err = (&collectionDb{db: dbcore.GetDB(txCtx)}).ScanCollections(startAfter, 2)
+			return err
+		})
+		suite.NoError(err)
+		for _, collection := range collections {
+			if slices.Contains(ids, collection.Collection.ID) || collection.Collection.ID == laterID {
+				scanned = append(scanned, collection.Collection.ID)
+			}
+		}
+		if len(collections) < 2 {
+			break
+		}
+		startAfter = &collections[len(collections)-1].Collection.ID
+	}
+	suite.Equal(ids, scanned)
+
+	// a snapshot that is not held cannot be scanned
+	err = tx.WithSnapshotTx(ctx, "00000003-0000001B-1", func(context.Context) error { return nil })
+	suite.ErrorIs(err, common.ErrScanSnapshotExpired)
+
+	// clean up
+	for _, id := range append(ids[1:], laterID) {
+		err = CleanUpTestCollection(suite.db, id)
+		suite.NoError(err)
+	}
+}
+
+func (suite *CollectionDbTestSuite) TestCollectionDb_PartitionSysdbByTenantKeepsIndexes() {
+	procedure, err := migrations.FS.ReadFile("20261016060000.sql")
+	suite.Require().NoError(err)
+	indexes := func(tx *gorm.DB) []string {
+		var names []string
+		err := tx.Raw("SELECT tablename || '.' || indexname FROM pg_indexes WHERE schemaname = 'public' AND tablename IN ('collections', 'collection_metadata', 'segments') ORDER BY 1").
+			Scan(&names).Error
+		suite.Require().NoError(err)
+		return names
+	}
+
+	// the tables are partitioned in a transaction that is rolled back
+	tx := suite.db.Begin()
+	defer tx.Rollback()
+	suite.Require().NoError(tx.Exec(string(procedure)).Error)
+	before := indexes(tx)
+	suite.Contains(before, "collections.idx_embedding_function_id")
+	suite.Require().NoError(tx.Exec("CALL partition_sysdb_by_tenant(2)").Error)
+	suite.Equal(before, indexes(tx))
+}
+
 func TestCollectionDbTestSuiteSuite(t *testing.T) {
 	testSuite := new(CollectionDbTestSuite)
 	suite.Run(t, testSuite)
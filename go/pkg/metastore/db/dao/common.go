@@ -40,3 +40,99 @@ func (*metaDomain) SegmentMetadataDb(ctx context.Context) dbmodel.ISegmentMetada
 func (*metaDomain) NotificationDb(ctx context.Context) dbmodel.INotificationDb {
 	return &notificationDb{dbcore.GetDB(ctx)}
 }
+
+func (*metaDomain) ArchiveDb(ctx context.Context) dbmodel.IArchiveDb {
+	return &archiveDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) MaintenanceDb(ctx context.Context) dbmodel.IMaintenanceDb {
+	return &maintenanceDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) LeaseDb(ctx context.Context) dbmodel.ILeaseDb {
+	return &leaseDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) CollectionLifecycleDb(ctx context.Context) dbmodel.ICollectionLifecycleDb {
+	return &collectionLifecycleDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) CollectionAclDb(ctx context.Context) dbmodel.ICollectionAclDb {
+	return &collectionAclDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) TenantQuotaDb(ctx context.Context) dbmodel.ITenantQuotaDb {
+	return &tenantQuotaDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) UsageCounterDb(ctx context.Context) dbmodel.IUsageCounterDb {
+	return &usageCounterDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) DatabaseQuotaDb(ctx context.Context) dbmodel.IDatabaseQuotaDb {
+	return &databaseQuotaDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) CollectionLogRouteDb(ctx context.Context) dbmodel.ICollectionLogRouteDb {
+	return &collectionLogRouteDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) CompactionRequestDb(ctx context.Context) dbmodel.ICompactionRequestDb {
+	return &compactionRequestDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) SegmentFileDb(ctx context.Context) dbmodel.ISegmentFileDb {
+	return &segmentFileDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) FeatureFlagDb(ctx context.Context) dbmodel.IFeatureFlagDb {
+	return &featureFlagDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) TenantRegionDb(ctx context.Context) dbmodel.ITenantRegionDb {
+	return &tenantRegionDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) NodeDecommissionDb(ctx context.Context) dbmodel.INodeDecommissionDb {
+	return &nodeDecommissionDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) NodeHeartbeatDb(ctx context.Context) dbmodel.INodeHeartbeatDb {
+	return &nodeHeartbeatDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) CollectionStatsDb(ctx context.Context) dbmodel.ICollectionStatsDb {
+	return &collectionStatsDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) CollectionSizeHistoryDb(ctx context.Context) dbmodel.ICollectionSizeHistoryDb {
+	return &collectionSizeHistoryDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) CleanupDeadLetterDb(ctx context.Context) dbmodel.ICleanupDeadLetterDb {
+	return &cleanupDeadLetterDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) CollectionMetadataHistoryDb(ctx context.Context) dbmodel.ICollectionMetadataHistoryDb {
+	return &collectionMetadataHistoryDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) CollectionSchemaFieldDb(ctx context.Context) dbmodel.ICollectionSchemaFieldDb {
+	return &collectionSchemaFieldDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) EmbeddingFunctionDb(ctx context.Context) dbmodel.IEmbeddingFunctionDb {
+	return &embeddingFunctionDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) CollectionTemplateDb(ctx context.Context) dbmodel.ICollectionTemplateDb {
+	return &collectionTemplateDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) MaintenanceModeDb(ctx context.Context) dbmodel.IMaintenanceModeDb {
+	return &maintenanceModeDb{dbcore.GetDB(ctx)}
+}
+
+func (*metaDomain) JobDb(ctx context.Context) dbmodel.IJobDb {
+	return &jobDb{dbcore.GetDB(ctx)}
+}
@@ -0,0 +1,51 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type compactionRequestDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.ICompactionRequestDb = &compactionRequestDb{}
+
+func (s *compactionRequestDb) Insert(in *dbmodel.CompactionRequest) error {
+	in.RequestedAt = time.Now()
+	err := s.db.Clauses(clause.OnConflict{DoNothing: true}).Create(in).Error
+	if err != nil {
+		log.Error("insert compaction request failed", zap.String("collectionID", in.CollectionID.String()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (s *compactionRequestDb) List(limit int32) ([]*dbmodel.CompactionRequest, error) {
+	var requests []*dbmodel.CompactionRequest
+	err := s.db.Order("requested_at").Order("collection_id").Limit(int(limit)).Find(&requests).Error
+	if err != nil {
+		log.Error("list compaction requests failed", zap.Error(err))
+		return nil, err
+	}
+	return requests, nil
+}
+
+func (s *compactionRequestDb) DeleteByCollectionID(collectionID dbmodel.CollectionID) (int64, error) {
+	result := s.db.Where("collection_id = ?", collectionID).Delete(&dbmodel.CompactionRequest{})
+	return result.RowsAffected, result.Error
+}
+
+func (s *compactionRequestDb) DeleteRequestedBefore(collectionID dbmodel.CollectionID, before time.Time) (int64, error) {
+	result := s.db.Where("collection_id = ? AND requested_at < ?", collectionID, before).Delete(&dbmodel.CompactionRequest{})
+	return result.RowsAffected, result.Error
+}
+
+func (s *compactionRequestDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.CompactionRequest{}).Error
+}
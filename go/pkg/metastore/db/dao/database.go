@@ -42,7 +42,8 @@ func (s *databaseDb) GetDatabases(tenantID string, databaseName string) ([]*dbmo
 	query := s.db.Table("databases").
 		Select("databases.id, databases.name, databases.tenant_id").
 		Where("databases.name = ?", databaseName).
-		Where("databases.tenant_id = ?", tenantID)
+		Where("databases.tenant_id = ?", tenantID).
+		Scopes(notDeleted("databases"))
 
 	if err := query.Find(&databases).Error; err != nil {
 		log.Error("GetDatabases", zap.Error(err))
@@ -51,6 +52,39 @@ func (s *databaseDb) GetDatabases(tenantID string, databaseName string) ([]*dbmo
 	return databases, nil
 }
 
+// GetDatabaseForUpdate locks the database row until the surrounding transaction
+// ends. Concurrent writers that need a consistent view of the database's
+// collections serialize on this lock.
+func (s *databaseDb) GetDatabaseForUpdate(databaseID dbmodel.DatabaseID) (*dbmodel.Database, error) {
+	var database dbmodel.Database
+	err := s.db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", databaseID).First(&database).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.ErrDatabaseNotFound
+		}
+		log.Error("GetDatabaseForUpdate", zap.Error(err))
+		return nil, err
+	}
+	return &database, nil
+}
+
+func (s *databaseDb) GetDatabaseByID(databaseID dbmodel.DatabaseID) (*dbmodel.Database, error) {
+	var database dbmodel.Database
+	err := s.db.Table("databases").
+		Select("databases.id, databases.name, databases.tenant_id").
+		Where("databases.id = ?", databaseID).
+		Scopes(notDeleted("databases")).
+		First(&database).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, common.ErrDatabaseNotFound
+		}
+		log.Error("GetDatabaseByID", zap.Error(err))
+		return nil, err
+	}
+	return &database, nil
+}
+
 func (s *databaseDb) Insert(database *dbmodel.Database) error {
 	err := s.db.Create(database).Error
 	if err != nil {
@@ -76,7 +110,8 @@ func (s *databaseDb) GetDatabasesByTenantID(tenantID string) ([]*dbmodel.Databas
 	var databases []*dbmodel.Database
 	query := s.db.Table("databases").
 		Select("databases.id, databases.name, databases.tenant_id").
-		Where("databases.tenant_id = ?", tenantID)
+		Where("databases.tenant_id = ?", tenantID).
+		Scopes(notDeleted("databases"))
 
 	if err := query.Find(&databases).Error; err != nil {
 		log.Error("GetDatabasesByTenantID", zap.Error(err))
@@ -0,0 +1,107 @@
+package dao
+
+import (
+	"errors"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type embeddingFunctionDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.IEmbeddingFunctionDb = &embeddingFunctionDb{}
+
+func (s *embeddingFunctionDb) Insert(in *dbmodel.EmbeddingFunction) error {
+	err := s.db.Create(in).Error
+	if err != nil {
+		log.Error("insert embedding function failed", zap.String("tenantID", in.TenantID), zap.String("name", in.Name), zap.Error(err))
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return common.ErrEmbeddingFunctionUniqueConstraintViolation
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *embeddingFunctionDb) Get(id *string, tenantID string, name *string) ([]*dbmodel.EmbeddingFunction, error) {
+	query := s.db.Order("tenant_id, name")
+	if id != nil {
+		query = query.Where("id = ?", *id)
+	}
+	if tenantID != "" {
+		query = query.Where("tenant_id = ?", tenantID)
+	}
+	if name != nil {
+		query = query.Where("name = ?", *name)
+	}
+	var embeddingFunctions []*dbmodel.EmbeddingFunction
+	err := query.Find(&embeddingFunctions).Error
+	if err != nil {
+		log.Error("get embedding functions failed", zap.String("tenantID", tenantID), zap.Error(err))
+		return nil, err
+	}
+	return embeddingFunctions, nil
+}
+
+func (s *embeddingFunctionDb) GetForUpdate(id string) (*dbmodel.EmbeddingFunction, error) {
+	var embeddingFunctions []*dbmodel.EmbeddingFunction
+	err := s.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		Limit(1).
+		Find(&embeddingFunctions).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(embeddingFunctions) == 0 {
+		return nil, nil
+	}
+	return embeddingFunctions[0], nil
+}
+
+// Update writes every field of in but its ID, tenant and creation time.
+func (s *embeddingFunctionDb) Update(in *dbmodel.EmbeddingFunction) error {
+	in.UpdatedAt = time.Now()
+	err := s.db.Model(&dbmodel.EmbeddingFunction{}).Where("id = ?", in.ID).Updates(map[string]interface{}{
+		"name":       in.Name,
+		"provider":   in.Provider,
+		"model":      in.Model,
+		"params":     in.Params,
+		"updated_at": in.UpdatedAt,
+	}).Error
+	if err != nil {
+		log.Error("update embedding function failed", zap.String("id", in.ID), zap.Error(err))
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return common.ErrEmbeddingFunctionUniqueConstraintViolation
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *embeddingFunctionDb) Delete(id string) (bool, error) {
+	result := s.db.Where("id = ?", id).Delete(&dbmodel.EmbeddingFunction{})
+	if result.Error != nil {
+		log.Error("delete embedding function failed", zap.String("id", id), zap.Error(result.Error))
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (s *embeddingFunctionDb) DeleteByTenantID(tenantID string) (int64, error) {
+	result := s.db.Where("tenant_id = ?", tenantID).Delete(&dbmodel.EmbeddingFunction{})
+	return result.RowsAffected, result.Error
+}
+
+func (s *embeddingFunctionDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.EmbeddingFunction{}).Error
+}
@@ -0,0 +1,61 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type featureFlagDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.IFeatureFlagDb = &featureFlagDb{}
+
+// Upsert replaces the default of a flag, or its override for a tenant.
+func (s *featureFlagDb) Upsert(in *dbmodel.FeatureFlag) error {
+	in.UpdatedAt = time.Now()
+	err := s.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "cluster_id"}, {Name: "name"}, {Name: "tenant_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"enabled", "rollout_percentage", "description", "updated_at"}),
+	}).Create(in).Error
+	if err != nil {
+		log.Error("upsert feature flag failed", zap.String("name", in.Name), zap.String("tenantID", in.TenantID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (s *featureFlagDb) Delete(name string, tenantID string) (bool, error) {
+	result := s.db.Where("name = ? AND tenant_id = ?", name, tenantID).Delete(&dbmodel.FeatureFlag{})
+	if result.Error != nil {
+		log.Error("delete feature flag failed", zap.String("name", name), zap.String("tenantID", tenantID), zap.Error(result.Error))
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (s *featureFlagDb) DeleteByTenantID(tenantID string) (int64, error) {
+	result := s.db.Where("tenant_id = ?", tenantID).Delete(&dbmodel.FeatureFlag{})
+	return result.RowsAffected, result.Error
+}
+
+// GetAll returns the defaults and overrides of every flag, ordered by name with
+// the default of a flag first.
+func (s *featureFlagDb) GetAll() ([]*dbmodel.FeatureFlag, error) {
+	var flags []*dbmodel.FeatureFlag
+	err := s.db.Order("name, tenant_id").Find(&flags).Error
+	if err != nil {
+		log.Error("get feature flags failed", zap.Error(err))
+		return nil, err
+	}
+	return flags, nil
+}
+
+func (s *featureFlagDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.FeatureFlag{}).Error
+}
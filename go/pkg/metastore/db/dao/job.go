@@ -0,0 +1,155 @@
+package dao
+
+import (
+	"errors"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type jobDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.IJobDb = &jobDb{}
+
+func (s *jobDb) Insert(in *dbmodel.Job) error {
+	now := time.Now()
+	in.CreatedAt = now
+	in.UpdatedAt = now
+	err := s.db.Create(in).Error
+	if err != nil {
+		log.Error("insert job failed", zap.String("jobID", in.ID), zap.String("type", in.Type), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (s *jobDb) Get(id string) (*dbmodel.Job, error) {
+	var job dbmodel.Job
+	err := s.db.Where("id = ?", id).First(&job).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		log.Error("get job failed", zap.String("jobID", id), zap.Error(err))
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (s *jobDb) Claim(jobTypes []string, workerID string, now time.Time, lockedUntil time.Time) (*dbmodel.Job, error) {
+	var job dbmodel.Job
+	// the jobs locked by another claim are skipped rather than waited for, so
+	// that concurrent workers each get a job of their own
+	err := s.db.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("job_type IN ?", jobTypes).
+		Where("(state = ? AND next_run_at <= ?) OR (state = ? AND locked_until < ?)", dbmodel.JobStatePending, now, dbmodel.JobStateRunning, now).
+		Order("next_run_at").
+		First(&job).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		log.Error("claim job failed", zap.String("workerID", workerID), zap.Error(err))
+		return nil, err
+	}
+	job.State = dbmodel.JobStateRunning
+	job.Attempts++
+	job.LockedBy = workerID
+	job.LockedUntil = &lockedUntil
+	job.UpdatedAt = now
+	err = s.db.Model(&dbmodel.Job{}).Where("id = ?", job.ID).Updates(map[string]interface{}{
+		"state":        job.State,
+		"attempts":     job.Attempts,
+		"locked_by":    job.LockedBy,
+		"locked_until": lockedUntil,
+		"updated_at":   now,
+	}).Error
+	if err != nil {
+		log.Error("claim job failed", zap.String("jobID", job.ID), zap.String("workerID", workerID), zap.Error(err))
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (s *jobDb) RenewLease(id string, workerID string, lockedUntil time.Time) (bool, error) {
+	result := s.db.Model(&dbmodel.Job{}).
+		Where("id = ? AND state = ? AND locked_by = ?", id, dbmodel.JobStateRunning, workerID).
+		Updates(map[string]interface{}{"locked_until": lockedUntil, "updated_at": time.Now()})
+	if result.Error != nil {
+		log.Error("renew job lease failed", zap.String("jobID", id), zap.String("workerID", workerID), zap.Error(result.Error))
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (s *jobDb) Finish(id string, workerID string, state string, lastError string, nextRunAt time.Time) (bool, error) {
+	now := time.Now()
+	updates := map[string]interface{}{
+		"state":        state,
+		"last_error":   lastError,
+		"locked_by":    "",
+		"locked_until": nil,
+		"updated_at":   now,
+	}
+	if state == dbmodel.JobStatePending {
+		updates["next_run_at"] = nextRunAt
+	} else {
+		updates["finished_at"] = now
+	}
+	result := s.db.Model(&dbmodel.Job{}).
+		Where("id = ? AND state = ? AND locked_by = ?", id, dbmodel.JobStateRunning, workerID).
+		Updates(updates)
+	if result.Error != nil {
+		log.Error("finish job failed", zap.String("jobID", id), zap.String("state", state), zap.Error(result.Error))
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (s *jobDb) List(tenantID string, jobType string, state string, limit int32, offset int32) ([]*dbmodel.Job, error) {
+	var jobs []*dbmodel.Job
+	query := s.db.Order("created_at DESC, id")
+	if tenantID != "" {
+		query = query.Where("tenant_id = ?", tenantID)
+	}
+	if jobType != "" {
+		query = query.Where("job_type = ?", jobType)
+	}
+	if state != "" {
+		query = query.Where("state = ?", state)
+	}
+	err := query.Limit(int(limit)).Offset(int(offset)).Find(&jobs).Error
+	if err != nil {
+		log.Error("list jobs failed", zap.String("tenantID", tenantID), zap.String("type", jobType), zap.String("state", state), zap.Error(err))
+		return nil, err
+	}
+	return jobs, nil
+}
+
+func (s *jobDb) Cancel(id string) (bool, error) {
+	now := time.Now()
+	result := s.db.Model(&dbmodel.Job{}).
+		Where("id = ? AND state IN ?", id, []string{dbmodel.JobStatePending, dbmodel.JobStateRunning}).
+		Updates(map[string]interface{}{
+			"state":        dbmodel.JobStateCanceled,
+			"locked_by":    "",
+			"locked_until": nil,
+			"updated_at":   now,
+			"finished_at":  now,
+		})
+	if result.Error != nil {
+		log.Error("cancel job failed", zap.String("jobID", id), zap.Error(result.Error))
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (s *jobDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.Job{}).Error
+}
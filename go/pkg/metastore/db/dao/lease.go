@@ -0,0 +1,65 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type leaseDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.ILeaseDb = &leaseDb{}
+
+// TryAcquire takes the lease when it is free or expired, and renews it when
+// holder already has it. It returns the lease as it is after the call, so the
+// caller holds the lease only if the returned holder is its own. Expiry is
+// computed with the database clock so that replicas with skewed clocks agree.
+func (s *leaseDb) TryAcquire(name string, holder string, address string, ttl time.Duration) (*dbmodel.Lease, error) {
+	var leases []*dbmodel.Lease
+	err := s.db.Raw(`INSERT INTO "leases" ("cluster_id", "name", "holder", "address", "epoch", "expires_at")
+		VALUES (?, ?, ?, ?, 1, now() + ? * interval '1 microsecond')
+		ON CONFLICT ("cluster_id", "name") DO UPDATE SET
+			"holder" = EXCLUDED."holder",
+			"address" = EXCLUDED."address",
+			"epoch" = CASE WHEN "leases"."holder" = EXCLUDED."holder" THEN "leases"."epoch" ELSE "leases"."epoch" + 1 END,
+			"expires_at" = EXCLUDED."expires_at"
+		WHERE "leases"."holder" = EXCLUDED."holder" OR "leases"."expires_at" < now()
+		RETURNING "name", "holder", "address", "epoch", "expires_at"`,
+		dbcore.ClusterID(s.db), name, holder, address, ttl.Microseconds()).Scan(&leases).Error
+	if err != nil {
+		log.Error("acquire lease failed", zap.String("name", name), zap.String("holder", holder), zap.Error(err))
+		return nil, err
+	}
+	if len(leases) > 0 {
+		leases[0].ExpiresAt = leases[0].ExpiresAt.UTC()
+		return leases[0], nil
+	}
+	// held by another replica
+	var lease dbmodel.Lease
+	err = s.db.Where("name = ?", name).First(&lease).Error
+	if err != nil {
+		log.Error("get lease failed", zap.String("name", name), zap.Error(err))
+		return nil, err
+	}
+	lease.ExpiresAt = lease.ExpiresAt.UTC()
+	return &lease, nil
+}
+
+// Release expires the lease if holder still has it, so that a standby can take
+// over without waiting for the ttl.
+func (s *leaseDb) Release(name string, holder string) error {
+	err := s.db.Model(&dbmodel.Lease{}).
+		Where("name = ? AND holder = ?", name, holder).
+		Update("expires_at", gorm.Expr("now()")).Error
+	if err != nil {
+		log.Error("release lease failed", zap.String("name", name), zap.String("holder", holder), zap.Error(err))
+		return err
+	}
+	return nil
+}
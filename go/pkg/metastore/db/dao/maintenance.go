@@ -0,0 +1,55 @@
+package dao
+
+import (
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type maintenanceDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.IMaintenanceDb = &maintenanceDb{}
+
+// maintainableTable returns the quoted table name, or an error when the table is
+// not one of dbmodel.MaintainableTables. Table names cannot be bound as query
+// parameters, so only known names are ever interpolated.
+func maintainableTable(table string) (string, error) {
+	for _, t := range dbmodel.MaintainableTables {
+		if t == table {
+			return `"public"."` + t + `"`, nil
+		}
+	}
+	return "", common.ErrUnknownMaintenanceTable
+}
+
+func (s *maintenanceDb) Analyze(table string) error {
+	quoted, err := maintainableTable(table)
+	if err != nil {
+		return err
+	}
+	err = s.db.Exec("ANALYZE " + quoted).Error
+	if err != nil {
+		log.Error("analyze table failed", zap.String("table", table), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// Vacuum runs VACUUM (ANALYZE) on the table. VACUUM cannot run inside a
+// transaction, so the DAO must not be bound to one.
+func (s *maintenanceDb) Vacuum(table string) error {
+	quoted, err := maintainableTable(table)
+	if err != nil {
+		return err
+	}
+	err = s.db.Exec("VACUUM (ANALYZE) " + quoted).Error
+	if err != nil {
+		log.Error("vacuum table failed", zap.String("table", table), zap.Error(err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package dao
+
+import (
+	"errors"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type maintenanceModeDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.IMaintenanceModeDb = &maintenanceModeDb{}
+
+func (s *maintenanceModeDb) Upsert(in *dbmodel.MaintenanceMode) error {
+	in.UpdatedAt = time.Now()
+	err := s.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "cluster_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"enabled", "reason", "updated_at"}),
+	}).Create(in).Error
+	if err != nil {
+		log.Error("upsert maintenance mode failed", zap.Bool("enabled", in.Enabled), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (s *maintenanceModeDb) Get() (*dbmodel.MaintenanceMode, error) {
+	var mode dbmodel.MaintenanceMode
+	err := s.db.First(&mode).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		log.Error("get maintenance mode failed", zap.Error(err))
+		return nil, err
+	}
+	return &mode, nil
+}
+
+func (s *maintenanceModeDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.MaintenanceMode{}).Error
+}
@@ -0,0 +1,61 @@
+package dao
+
+import (
+	"errors"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type nodeDecommissionDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.INodeDecommissionDb = &nodeDecommissionDb{}
+
+func (s *nodeDecommissionDb) Insert(in *dbmodel.NodeDecommission) error {
+	err := s.db.Create(in).Error
+	if err != nil {
+		log.Error("insert node decommission failed", zap.String("nodeID", in.NodeID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (s *nodeDecommissionDb) Get(nodeID string) (*dbmodel.NodeDecommission, error) {
+	var decommission dbmodel.NodeDecommission
+	err := s.db.Where("node_id = ?", nodeID).First(&decommission).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		log.Error("get node decommission failed", zap.String("nodeID", nodeID), zap.Error(err))
+		return nil, err
+	}
+	return &decommission, nil
+}
+
+func (s *nodeDecommissionDb) GetAll() ([]*dbmodel.NodeDecommission, error) {
+	var decommissions []*dbmodel.NodeDecommission
+	err := s.db.Order("node_id").Find(&decommissions).Error
+	if err != nil {
+		log.Error("get node decommissions failed", zap.Error(err))
+		return nil, err
+	}
+	return decommissions, nil
+}
+
+func (s *nodeDecommissionDb) Delete(nodeID string) (bool, error) {
+	result := s.db.Where("node_id = ?", nodeID).Delete(&dbmodel.NodeDecommission{})
+	if result.Error != nil {
+		log.Error("delete node decommission failed", zap.String("nodeID", nodeID), zap.Error(result.Error))
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (s *nodeDecommissionDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.NodeDecommission{}).Error
+}
@@ -0,0 +1,58 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type nodeHeartbeatDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.INodeHeartbeatDb = &nodeHeartbeatDb{}
+
+// Upsert never moves last_seen back, so that a delayed heartbeat does not hide
+// a newer one.
+func (s *nodeHeartbeatDb) Upsert(nodeID string, lastSeen time.Time) error {
+	err := s.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "cluster_id"}, {Name: "node_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"last_seen": gorm.Expr("GREATEST(node_heartbeats.last_seen, EXCLUDED.last_seen)"),
+		}),
+	}).Create(&dbmodel.NodeHeartbeat{NodeID: nodeID, LastSeen: lastSeen}).Error
+	if err != nil {
+		log.Error("upsert node heartbeat failed", zap.String("nodeID", nodeID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (s *nodeHeartbeatDb) GetAll() ([]*dbmodel.NodeHeartbeat, error) {
+	var heartbeats []*dbmodel.NodeHeartbeat
+	err := s.db.Order("node_id").Find(&heartbeats).Error
+	if err != nil {
+		log.Error("get node heartbeats failed", zap.Error(err))
+		return nil, err
+	}
+	return heartbeats, nil
+}
+
+// DeleteBefore deletes the heartbeats of the nodes that were last seen before
+// the given time and returns how many were deleted.
+func (s *nodeHeartbeatDb) DeleteBefore(before time.Time) (int64, error) {
+	result := s.db.Where("last_seen < ?", before).Delete(&dbmodel.NodeHeartbeat{})
+	if result.Error != nil {
+		log.Error("delete node heartbeats failed", zap.Time("before", before), zap.Error(result.Error))
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+func (s *nodeHeartbeatDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.NodeHeartbeat{}).Error
+}
@@ -23,7 +23,7 @@ func (s *notificationDb) Insert(in *dbmodel.Notification) error {
 	return s.db.Create(in).Error
 }
 
-func (s *notificationDb) GetNotificationByCollectionID(collectionID string) ([]*dbmodel.Notification, error) {
+func (s *notificationDb) GetNotificationByCollectionID(collectionID dbmodel.CollectionID) ([]*dbmodel.Notification, error) {
 	var notifications []*dbmodel.Notification
 	err := s.db.Where("collection_id = ? AND status = ?", collectionID, dbmodel.NotificationStatusPending).Find(&notifications).Error
 	if err != nil {
@@ -0,0 +1,33 @@
+package dao
+
+import (
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// collections, collection_metadata and segments carry the tenant_id partition key
+// so that they can be hash partitioned by tenant (see the partition_sysdb_by_tenant
+// procedure in the migrations). Writers that do not know the tenant leave it empty
+// and the DAO copies it from the parent row before inserting.
+
+func tenantIDForDatabase(db *gorm.DB, databaseID dbmodel.DatabaseID) (string, error) {
+	var tenantID string
+	err := db.Table("databases").Select("tenant_id").Where("id = ?", databaseID).Scan(&tenantID).Error
+	if err != nil {
+		log.Error("get tenant of database failed", zap.String("databaseID", databaseID.String()), zap.Error(err))
+		return "", err
+	}
+	return tenantID, nil
+}
+
+func tenantIDForCollection(db *gorm.DB, collectionID dbmodel.CollectionID) (string, error) {
+	var tenantID string
+	err := db.Table("collections").Select("tenant_id").Where("id = ?", collectionID).Scan(&tenantID).Error
+	if err != nil {
+		log.Error("get tenant of collection failed", zap.String("collectionID", collectionID.String()), zap.Error(err))
+		return "", err
+	}
+	return tenantID, nil
+}
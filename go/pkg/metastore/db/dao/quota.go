@@ -0,0 +1,141 @@
+package dao
+
+import (
+	"errors"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type tenantQuotaDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.ITenantQuotaDb = &tenantQuotaDb{}
+
+// Upsert replaces the limits of a tenant.
+func (s *tenantQuotaDb) Upsert(in *dbmodel.TenantQuota) error {
+	in.UpdatedAt = time.Now()
+	err := s.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "cluster_id"}, {Name: "tenant_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"max_collections", "max_records", "max_size_bytes", "updated_at"}),
+	}).Create(in).Error
+	if err != nil {
+		log.Error("upsert tenant quota failed", zap.String("tenantID", in.TenantID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// Get returns the limits of a tenant, or nil when none were set.
+func (s *tenantQuotaDb) Get(tenantID string) (*dbmodel.TenantQuota, error) {
+	var quota dbmodel.TenantQuota
+	err := s.db.Where("tenant_id = ?", tenantID).First(&quota).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		log.Error("get tenant quota failed", zap.String("tenantID", tenantID), zap.Error(err))
+		return nil, err
+	}
+	return &quota, nil
+}
+
+// GetUsage counts the live collections of a tenant and reads its usage counter.
+func (s *tenantQuotaDb) GetUsage(tenantID string) (*dbmodel.TenantUsage, error) {
+	var usage dbmodel.TenantUsage
+	err := s.db.Table("collections").
+		Select("COUNT(*) AS collection_count").
+		Where("tenant_id = ?", tenantID).
+		Scopes(notDeleted("collections")).
+		Scan(&usage).Error
+	if err != nil {
+		log.Error("count tenant collections failed", zap.String("tenantID", tenantID), zap.Error(err))
+		return nil, err
+	}
+	counter, err := (&usageCounterDb{db: s.db}).Get(tenantID, "")
+	if err != nil {
+		return nil, err
+	}
+	usage.RecordCount = counter.RecordCount
+	usage.SizeBytes = counter.SizeBytes
+	return &usage, nil
+}
+
+func (s *tenantQuotaDb) DeleteByTenantID(tenantID string) (int64, error) {
+	result := s.db.Where("tenant_id = ?", tenantID).Delete(&dbmodel.TenantQuota{})
+	return result.RowsAffected, result.Error
+}
+
+func (s *tenantQuotaDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.TenantQuota{}).Error
+}
+
+type databaseQuotaDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.IDatabaseQuotaDb = &databaseQuotaDb{}
+
+// Upsert replaces the limits of a database.
+func (s *databaseQuotaDb) Upsert(in *dbmodel.DatabaseQuota) error {
+	in.UpdatedAt = time.Now()
+	err := s.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "database_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"max_collections", "max_records", "max_size_bytes", "updated_at"}),
+	}).Create(in).Error
+	if err != nil {
+		log.Error("upsert database quota failed", zap.String("databaseID", in.DatabaseID.String()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// Get returns the limits of a database, or nil when none were set.
+func (s *databaseQuotaDb) Get(databaseID dbmodel.DatabaseID) (*dbmodel.DatabaseQuota, error) {
+	var quota dbmodel.DatabaseQuota
+	err := s.db.Where("database_id = ?", databaseID).First(&quota).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		log.Error("get database quota failed", zap.String("databaseID", databaseID.String()), zap.Error(err))
+		return nil, err
+	}
+	return &quota, nil
+}
+
+// GetUsage counts the live collections of a database and reads its usage
+// counter.
+func (s *databaseQuotaDb) GetUsage(tenantID string, databaseID dbmodel.DatabaseID) (*dbmodel.TenantUsage, error) {
+	var usage dbmodel.TenantUsage
+	err := s.db.Table("collections").
+		Select("COUNT(*) AS collection_count").
+		Where("database_id = ?", databaseID).
+		Scopes(notDeleted("collections")).
+		Scan(&usage).Error
+	if err != nil {
+		log.Error("count database collections failed", zap.String("databaseID", databaseID.String()), zap.Error(err))
+		return nil, err
+	}
+	counter, err := (&usageCounterDb{db: s.db}).Get(tenantID, databaseID.String())
+	if err != nil {
+		return nil, err
+	}
+	usage.RecordCount = counter.RecordCount
+	usage.SizeBytes = counter.SizeBytes
+	return &usage, nil
+}
+
+func (s *databaseQuotaDb) DeleteByDatabaseID(databaseID dbmodel.DatabaseID) (int64, error) {
+	result := s.db.Where("database_id = ?", databaseID).Delete(&dbmodel.DatabaseQuota{})
+	return result.RowsAffected, result.Error
+}
+
+func (s *databaseQuotaDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.DatabaseQuota{}).Error
+}
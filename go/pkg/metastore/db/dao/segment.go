@@ -24,11 +24,18 @@ func (s *segmentDb) DeleteAll() error {
 	return s.db.Where("1=1").Delete(&dbmodel.Segment{}).Error
 }
 
-func (s *segmentDb) DeleteSegmentByID(id string) error {
+func (s *segmentDb) DeleteSegmentByID(id dbmodel.SegmentID) error {
 	return s.db.Where("id = ?", id).Delete(&dbmodel.Segment{}).Error
 }
 
 func (s *segmentDb) Insert(in *dbmodel.Segment) error {
+	if in.TenantID == "" && in.CollectionID != nil {
+		tenantID, err := tenantIDForCollection(s.db, *in.CollectionID)
+		if err != nil {
+			return err
+		}
+		in.TenantID = tenantID
+	}
 	err := s.db.Create(&in).Error
 
 	if err != nil {
@@ -52,25 +59,42 @@ func (s *segmentDb) Insert(in *dbmodel.Segment) error {
 	return nil
 }
 
-func (s *segmentDb) GetSegments(id types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID) ([]*dbmodel.SegmentAndMetadata, error) {
+func (s *segmentDb) GetSegments(id types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID, limit *int32, offset *int32) ([]*dbmodel.SegmentAndMetadata, error) {
 	var segments []*dbmodel.SegmentAndMetadata
 
+	filter := func(db *gorm.DB) *gorm.DB {
+		db = db.Scopes(notDeleted("segments"))
+		if id != types.NilUniqueID() {
+			db = db.Where("segments.id = ?", id.String())
+		}
+		if segmentType != nil {
+			db = db.Where("segments.type = ?", segmentType)
+		}
+		if scope != nil {
+			db = db.Where("segments.scope = ?", scope)
+		}
+		if collectionID != types.NilUniqueID() {
+			db = db.Where("segments.collection_id = ?", collectionID.String())
+		}
+		return db
+	}
 	query := s.db.Table("segments").
 		Select("segments.id, segments.collection_id, segments.type, segments.scope, segments.file_paths, segment_metadata.key, segment_metadata.str_value, segment_metadata.int_value, segment_metadata.float_value, segment_metadata.bool_value").
 		Joins("LEFT JOIN segment_metadata ON segments.id = segment_metadata.segment_id").
+		Scopes(filter).
 		Order("segments.id")
 
-	if id != types.NilUniqueID() {
-		query = query.Where("id = ?", id.String())
-	}
-	if segmentType != nil {
-		query = query.Where("type = ?", segmentType)
-	}
-	if scope != nil {
-		query = query.Where("scope = ?", scope)
-	}
-	if collectionID != types.NilUniqueID() {
-		query = query.Where("collection_id = ?", collectionID.String())
+	// a segment has one row per metadata key, so the page is selected on the
+	// segments alone
+	if limit != nil || offset != nil {
+		page := s.db.Table("segments").Select("segments.id").Scopes(filter).Order("segments.id")
+		if limit != nil {
+			page = page.Limit(int(*limit))
+		}
+		if offset != nil {
+			page = page.Offset(int(*offset))
+		}
+		query = query.Where("segments.id IN (?)", page)
 	}
 
 	rows, err := query.Rows()
@@ -80,13 +104,13 @@ func (s *segmentDb) GetSegments(id types.UniqueID, segmentType *string, scope *s
 	}
 	defer rows.Close()
 
-	var currentSegmentID string = ""
+	var currentSegmentID dbmodel.SegmentID = ""
 	var metadata []*dbmodel.SegmentMetadata
 	var currentSegment *dbmodel.SegmentAndMetadata
 
 	for rows.Next() {
 		var (
-			segmentID     string
+			segmentID     dbmodel.SegmentID
 			collectionID  sql.NullString
 			segmentType   string
 			scope         string
@@ -121,7 +145,8 @@ func (s *segmentDb) GetSegments(id types.UniqueID, segmentType *string, scope *s
 				SegmentMetadata: metadata,
 			}
 			if collectionID.Valid {
-				currentSegment.Segment.CollectionID = &collectionID.String
+				segmentCollectionID := dbmodel.CollectionID(collectionID.String)
+				currentSegment.Segment.CollectionID = &segmentCollectionID
 			} else {
 				currentSegment.Segment.CollectionID = nil
 			}
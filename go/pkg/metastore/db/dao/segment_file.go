@@ -0,0 +1,138 @@
+package dao
+
+import (
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type segmentFileDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.ISegmentFileDb = &segmentFileDb{}
+
+func (s *segmentFileDb) ReplaceBySegmentID(segmentID dbmodel.SegmentID, files []*dbmodel.SegmentFile) error {
+	err := s.DeleteBySegmentID(segmentID)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return nil
+	}
+	now := time.Now()
+	for _, file := range files {
+		file.SegmentID = segmentID
+		file.CreatedAt = now
+	}
+	err = s.db.Create(files).Error
+	if err != nil {
+		log.Error("insert segment files failed", zap.String("segmentID", segmentID.String()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (s *segmentFileDb) GetDuplicates(tenantID string, limit int32) ([]*dbmodel.SegmentFile, error) {
+	byTenant := func(db *gorm.DB) *gorm.DB {
+		if tenantID != "" {
+			return db.Where("tenant_id = ?", tenantID)
+		}
+		return db
+	}
+	hashes := s.db.Model(&dbmodel.SegmentFile{}).
+		Select("content_hash").
+		Scopes(byTenant).
+		Where("content_hash IS NOT NULL").
+		Group("content_hash").
+		Having("count(*) > 1").
+		Order("content_hash").
+		Limit(int(limit))
+	var files []*dbmodel.SegmentFile
+	err := s.db.Scopes(byTenant).
+		Where("content_hash IN (?)", hashes).
+		Order("content_hash").Order("segment_id").Order("path").
+		Find(&files).Error
+	if err != nil {
+		log.Error("get duplicate segment files failed", zap.String("tenantID", tenantID), zap.Error(err))
+		return nil, err
+	}
+	return files, nil
+}
+
+func (s *segmentFileDb) GetByCollectionID(collectionID dbmodel.CollectionID) ([]*dbmodel.SegmentFileAndReferences, error) {
+	var rows []struct {
+		dbmodel.SegmentFile
+		OtherCollections *int64
+	}
+	// sharing is unknown for the files without a hash, they are left NULL
+	err := s.db.Table("segment_files").
+		Select("segment_files.*, CASE WHEN segment_files.content_hash IS NULL THEN NULL ELSE (SELECT count(*) FROM segment_files AS other WHERE other.cluster_id = segment_files.cluster_id AND other.content_hash = segment_files.content_hash AND other.collection_id <> segment_files.collection_id) END AS other_collections").
+		Where("segment_files.collection_id = ?", collectionID).
+		Order("segment_files.segment_id").
+		Order("segment_files.path").
+		Scan(&rows).Error
+	if err != nil {
+		log.Error("get segment files failed", zap.String("collectionID", collectionID.String()), zap.Error(err))
+		return nil, err
+	}
+	files := make([]*dbmodel.SegmentFileAndReferences, 0, len(rows))
+	for i := range rows {
+		files = append(files, &dbmodel.SegmentFileAndReferences{
+			SegmentFile:      &rows[i].SegmentFile,
+			OtherCollections: rows[i].OtherCollections,
+		})
+	}
+	return files, nil
+}
+
+func (s *segmentFileDb) GetTenantStorage(tenantID string) ([]*dbmodel.CollectionStorage, int64, error) {
+	var collections []*dbmodel.CollectionStorage
+	err := s.db.Raw(`
+		SELECT collection_id,
+			coalesce(sum(size_bytes) FILTER (WHERE NOT shared), 0) AS unique_bytes,
+			coalesce(sum(size_bytes) FILTER (WHERE shared), 0) AS shared_bytes
+		FROM (
+			SELECT segment_files.collection_id, segment_files.size_bytes,
+				segment_files.content_hash IS NOT NULL AND EXISTS (
+					SELECT 1 FROM segment_files AS other
+					WHERE other.cluster_id = segment_files.cluster_id AND other.content_hash = segment_files.content_hash AND other.collection_id <> segment_files.collection_id
+				) AS shared
+			FROM segment_files
+			WHERE segment_files.cluster_id = ? AND segment_files.tenant_id = ?
+		) AS files
+		GROUP BY collection_id
+		ORDER BY collection_id`, dbcore.ClusterID(s.db), tenantID).
+		Scan(&collections).Error
+	if err != nil {
+		log.Error("get tenant storage failed", zap.String("tenantID", tenantID), zap.Error(err))
+		return nil, 0, err
+	}
+	// files without a hash cannot be shared, so each of them is distinct
+	var distinctBytes int64
+	err = s.db.Raw(`
+		SELECT coalesce(sum(size_bytes), 0)
+		FROM (
+			SELECT DISTINCT ON (coalesce(content_hash, segment_id::text || '/' || path)) size_bytes
+			FROM segment_files
+			WHERE cluster_id = ? AND tenant_id = ?
+		) AS distinct_files`, dbcore.ClusterID(s.db), tenantID).
+		Scan(&distinctBytes).Error
+	if err != nil {
+		log.Error("get tenant distinct storage failed", zap.String("tenantID", tenantID), zap.Error(err))
+		return nil, 0, err
+	}
+	return collections, distinctBytes, nil
+}
+
+func (s *segmentFileDb) DeleteBySegmentID(segmentID dbmodel.SegmentID) error {
+	return s.db.Where("segment_id = ?", segmentID).Delete(&dbmodel.SegmentFile{}).Error
+}
+
+func (s *segmentFileDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.SegmentFile{}).Error
+}
@@ -14,11 +14,11 @@ func (s *segmentMetadataDb) DeleteAll() error {
 	return s.db.Where("1 = 1").Delete(&dbmodel.SegmentMetadata{}).Error
 }
 
-func (s *segmentMetadataDb) DeleteBySegmentID(segmentID string) error {
+func (s *segmentMetadataDb) DeleteBySegmentID(segmentID dbmodel.SegmentID) error {
 	return s.db.Where("segment_id = ?", segmentID).Delete(&dbmodel.SegmentMetadata{}).Error
 }
 
-func (s *segmentMetadataDb) DeleteBySegmentIDAndKeys(segmentID string, keys []string) error {
+func (s *segmentMetadataDb) DeleteBySegmentIDAndKeys(segmentID dbmodel.SegmentID, keys []string) error {
 	return s.db.
 		Where("segment_id = ?", segmentID).
 		Where("key IN ?", keys).
@@ -1,6 +1,7 @@
 package dao
 
 import (
+	"sort"
 	"strconv"
 	"testing"
 
@@ -31,9 +32,9 @@ func (suite *SegmentDbTestSuite) SetupSuite() {
 
 func (suite *SegmentDbTestSuite) TestSegmentDb_GetSegments() {
 	uniqueID := types.NewUniqueID()
-	collectionID := uniqueID.String()
+	collectionID := dbmodel.NewCollectionID(uniqueID)
 	segment := &dbmodel.Segment{
-		ID:           uniqueID.String(),
+		ID:           dbmodel.NewSegmentID(uniqueID),
 		CollectionID: &collectionID,
 		Type:         "test_type",
 		Scope:        "test_scope",
@@ -52,7 +53,7 @@ func (suite *SegmentDbTestSuite) TestSegmentDb_GetSegments() {
 	suite.NoError(err)
 
 	// Test when all parameters are nil
-	segments, err := suite.segmentDb.GetSegments(types.NilUniqueID(), nil, nil, types.NilUniqueID())
+	segments, err := suite.segmentDb.GetSegments(types.NilUniqueID(), nil, nil, types.NilUniqueID(), nil, nil)
 	suite.NoError(err)
 	suite.Len(segments, 1)
 	suite.Equal(segment.ID, segments[0].Segment.ID)
@@ -64,25 +65,25 @@ func (suite *SegmentDbTestSuite) TestSegmentDb_GetSegments() {
 	suite.Equal(metadata.StrValue, segments[0].SegmentMetadata[0].StrValue)
 
 	// Test when filtering by ID
-	segments, err = suite.segmentDb.GetSegments(types.MustParse(segment.ID), nil, nil, types.NilUniqueID())
+	segments, err = suite.segmentDb.GetSegments(types.MustParse(segment.ID.String()), nil, nil, types.NilUniqueID(), nil, nil)
 	suite.NoError(err)
 	suite.Len(segments, 1)
 	suite.Equal(segment.ID, segments[0].Segment.ID)
 
 	// Test when filtering by type
-	segments, err = suite.segmentDb.GetSegments(types.NilUniqueID(), &segment.Type, nil, types.NilUniqueID())
+	segments, err = suite.segmentDb.GetSegments(types.NilUniqueID(), &segment.Type, nil, types.NilUniqueID(), nil, nil)
 	suite.NoError(err)
 	suite.Len(segments, 1)
 	suite.Equal(segment.ID, segments[0].Segment.ID)
 
 	// Test when filtering by scope
-	segments, err = suite.segmentDb.GetSegments(types.NilUniqueID(), nil, &segment.Scope, types.NilUniqueID())
+	segments, err = suite.segmentDb.GetSegments(types.NilUniqueID(), nil, &segment.Scope, types.NilUniqueID(), nil, nil)
 	suite.NoError(err)
 	suite.Len(segments, 1)
 	suite.Equal(segment.ID, segments[0].Segment.ID)
 
 	// Test when filtering by collection ID
-	segments, err = suite.segmentDb.GetSegments(types.NilUniqueID(), nil, nil, types.MustParse(*segment.CollectionID))
+	segments, err = suite.segmentDb.GetSegments(types.NilUniqueID(), nil, nil, segment.CollectionID.UniqueID(), nil, nil)
 	suite.NoError(err)
 	suite.Len(segments, 1)
 	suite.Equal(segment.ID, segments[0].Segment.ID)
@@ -94,14 +95,153 @@ func (suite *SegmentDbTestSuite) TestSegmentDb_GetSegments() {
 	suite.NoError(err)
 }
 
+func (suite *SegmentDbTestSuite) TestSegmentDb_GetSegmentsPaged() {
+	collectionID := dbmodel.NewCollectionID(types.NewUniqueID())
+	var segmentIDs []dbmodel.SegmentID
+	for i := 0; i < 3; i++ {
+		segment := &dbmodel.Segment{
+			ID:           dbmodel.NewSegmentID(types.NewUniqueID()),
+			CollectionID: &collectionID,
+			Type:         "test_type",
+			Scope:        "test_scope",
+		}
+		suite.NoError(suite.db.Create(segment).Error)
+		// several metadata keys must not count as several segments
+		for _, key := range []string{"a", "b"} {
+			key := key
+			suite.NoError(suite.db.Create(&dbmodel.SegmentMetadata{SegmentID: segment.ID, Key: &key, StrValue: &key}).Error)
+		}
+		segmentIDs = append(segmentIDs, segment.ID)
+	}
+	sort.Slice(segmentIDs, func(i, j int) bool { return segmentIDs[i] < segmentIDs[j] })
+
+	limit, offset := int32(2), int32(1)
+	segments, err := suite.segmentDb.GetSegments(types.NilUniqueID(), nil, nil, collectionID.UniqueID(), &limit, nil)
+	suite.NoError(err)
+	suite.Len(segments, 2)
+	suite.Equal(segmentIDs[0], segments[0].Segment.ID)
+	suite.Equal(segmentIDs[1], segments[1].Segment.ID)
+	suite.Len(segments[0].SegmentMetadata, 2)
+
+	segments, err = suite.segmentDb.GetSegments(types.NilUniqueID(), nil, nil, collectionID.UniqueID(), &limit, &offset)
+	suite.NoError(err)
+	suite.Len(segments, 2)
+	suite.Equal(segmentIDs[1], segments[0].Segment.ID)
+	suite.Equal(segmentIDs[2], segments[1].Segment.ID)
+
+	scope := "other_scope"
+	segments, err = suite.segmentDb.GetSegments(types.NilUniqueID(), nil, &scope, collectionID.UniqueID(), &limit, nil)
+	suite.NoError(err)
+	suite.Empty(segments)
+
+	// clean up
+	for _, segmentID := range segmentIDs {
+		suite.NoError(suite.segmentDb.DeleteSegmentByID(segmentID))
+		suite.NoError(suite.db.Where("segment_id = ?", segmentID).Delete(&dbmodel.SegmentMetadata{}).Error)
+	}
+}
+
+func (suite *SegmentDbTestSuite) TestSegmentDb_SegmentFiles() {
+	segmentFileDb := &segmentFileDb{db: suite.db}
+	collectionA := dbmodel.NewCollectionID(types.NewUniqueID())
+	collectionB := dbmodel.NewCollectionID(types.NewUniqueID())
+	segmentA := dbmodel.NewSegmentID(types.NewUniqueID())
+	segmentB := dbmodel.NewSegmentID(types.NewUniqueID())
+	shared, own := "shared_hash", "own_hash"
+
+	suite.NoError(segmentFileDb.ReplaceBySegmentID(segmentA, []*dbmodel.SegmentFile{
+		{Path: "a/old", CollectionID: collectionA, TenantID: "tenant_a"},
+	}))
+	// a flush replaces the files of the segment
+	suite.NoError(segmentFileDb.ReplaceBySegmentID(segmentA, []*dbmodel.SegmentFile{
+		{Path: "a/shared", CollectionID: collectionA, TenantID: "tenant_a", ContentHash: &shared},
+		{Path: "a/own", CollectionID: collectionA, TenantID: "tenant_a", ContentHash: &own},
+		{Path: "a/unhashed", CollectionID: collectionA, TenantID: "tenant_a"},
+	}))
+	suite.NoError(segmentFileDb.ReplaceBySegmentID(segmentB, []*dbmodel.SegmentFile{
+		{Path: "b/shared", CollectionID: collectionB, TenantID: "tenant_a", ContentHash: &shared},
+	}))
+
+	files, err := segmentFileDb.GetByCollectionID(collectionA)
+	suite.NoError(err)
+	suite.Len(files, 3)
+	references := make(map[string]*int64)
+	for _, file := range files {
+		references[file.SegmentFile.Path] = file.OtherCollections
+	}
+	suite.Equal(int64(1), *references["a/shared"])
+	suite.Equal(int64(0), *references["a/own"])
+	// whether a file without a hash is shared is unknown
+	suite.Nil(references["a/unhashed"])
+
+	duplicates, err := segmentFileDb.GetDuplicates("tenant_a", 10)
+	suite.NoError(err)
+	suite.Len(duplicates, 2)
+	suite.Equal(shared, *duplicates[0].ContentHash)
+	suite.Equal(shared, *duplicates[1].ContentHash)
+	duplicates, err = segmentFileDb.GetDuplicates("tenant_b", 10)
+	suite.NoError(err)
+	suite.Empty(duplicates)
+
+	// the content of segment A is no longer shared once segment B is deleted
+	suite.NoError(segmentFileDb.DeleteBySegmentID(segmentB))
+	duplicates, err = segmentFileDb.GetDuplicates("", 10)
+	suite.NoError(err)
+	suite.Empty(duplicates)
+
+	// clean up
+	suite.NoError(segmentFileDb.DeleteBySegmentID(segmentA))
+}
+
+func (suite *SegmentDbTestSuite) TestSegmentDb_TenantStorage() {
+	segmentFileDb := &segmentFileDb{db: suite.db}
+	tenantID := "test_tenant_storage"
+	collectionA := dbmodel.NewCollectionID(types.NewUniqueID())
+	collectionB := dbmodel.NewCollectionID(types.NewUniqueID())
+	segmentA := dbmodel.NewSegmentID(types.NewUniqueID())
+	segmentB := dbmodel.NewSegmentID(types.NewUniqueID())
+	shared, own := "storage_shared_hash", "storage_own_hash"
+
+	suite.NoError(segmentFileDb.ReplaceBySegmentID(segmentA, []*dbmodel.SegmentFile{
+		{Path: "a/shared", CollectionID: collectionA, TenantID: tenantID, ContentHash: &shared, SizeBytes: 100},
+		{Path: "a/own", CollectionID: collectionA, TenantID: tenantID, ContentHash: &own, SizeBytes: 10},
+		{Path: "a/unhashed", CollectionID: collectionA, TenantID: tenantID, SizeBytes: 1},
+	}))
+	suite.NoError(segmentFileDb.ReplaceBySegmentID(segmentB, []*dbmodel.SegmentFile{
+		{Path: "b/shared", CollectionID: collectionB, TenantID: tenantID, ContentHash: &shared, SizeBytes: 100},
+	}))
+
+	collections, distinctBytes, err := segmentFileDb.GetTenantStorage(tenantID)
+	suite.NoError(err)
+	suite.Equal(int64(111), distinctBytes)
+	suite.Len(collections, 2)
+	storage := make(map[dbmodel.CollectionID]*dbmodel.CollectionStorage)
+	for _, collection := range collections {
+		storage[collection.CollectionID] = collection
+	}
+	suite.Equal(int64(11), storage[collectionA].UniqueBytes)
+	suite.Equal(int64(100), storage[collectionA].SharedBytes)
+	suite.Equal(int64(0), storage[collectionB].UniqueBytes)
+	suite.Equal(int64(100), storage[collectionB].SharedBytes)
+
+	collections, distinctBytes, err = segmentFileDb.GetTenantStorage("test_tenant_storage_empty")
+	suite.NoError(err)
+	suite.Empty(collections)
+	suite.Zero(distinctBytes)
+
+	// clean up
+	suite.NoError(segmentFileDb.DeleteBySegmentID(segmentA))
+	suite.NoError(segmentFileDb.DeleteBySegmentID(segmentB))
+}
+
 func (suite *SegmentDbTestSuite) TestSegmentDb_RegisterFilePath() {
 	// create a collection for testing
 	databaseId := types.NewUniqueID().String()
 	collectionName := "test_segment_register_file_paths"
-	collectionID, err := CreateTestCollection(suite.db, collectionName, 128, databaseId)
+	collectionID, err := CreateTestCollection(suite.db, collectionName, 128, dbmodel.DatabaseID(databaseId))
 	suite.NoError(err)
 
-	segments, err := suite.segmentDb.GetSegments(types.NilUniqueID(), nil, nil, types.MustParse(collectionID))
+	segments, err := suite.segmentDb.GetSegments(types.NilUniqueID(), nil, nil, types.MustParse(collectionID.String()), nil, nil)
 	suite.NoError(err)
 
 	// create entries to flush
@@ -110,7 +250,7 @@ func (suite *SegmentDbTestSuite) TestSegmentDb_RegisterFilePath() {
 	testFilePathTypes := []string{"TypeA", "TypeB", "TypeC", "TypeD"}
 	for _, segment := range segments {
 		segmentID := segment.Segment.ID
-		segmentsFilePaths[segmentID] = make(map[string][]string)
+		segmentsFilePaths[segmentID.String()] = make(map[string][]string)
 		for i := 0; i < rand.Intn(len(testFilePathTypes)); i++ {
 			filePaths := make([]string, 0)
 			for j := 0; j < rand.Intn(5); j++ {
@@ -118,11 +258,11 @@ func (suite *SegmentDbTestSuite) TestSegmentDb_RegisterFilePath() {
 			}
 			filePathTypeI := rand.Intn(len(testFilePathTypes))
 			filePathType := testFilePathTypes[filePathTypeI]
-			segmentsFilePaths[segmentID][filePathType] = filePaths
+			segmentsFilePaths[segmentID.String()][filePathType] = filePaths
 		}
 		flushSegmentCompaction := &model.FlushSegmentCompaction{
-			ID:        types.MustParse(segmentID),
-			FilePaths: segmentsFilePaths[segmentID],
+			ID:        types.MustParse(segmentID.String()),
+			FilePaths: segmentsFilePaths[segmentID.String()],
 		}
 		flushSegmentCompactions = append(flushSegmentCompactions, flushSegmentCompaction)
 	}
@@ -132,11 +272,11 @@ func (suite *SegmentDbTestSuite) TestSegmentDb_RegisterFilePath() {
 	suite.NoError(err)
 
 	// verify file paths registered
-	segments, err = suite.segmentDb.GetSegments(types.NilUniqueID(), nil, nil, types.MustParse(collectionID))
+	segments, err = suite.segmentDb.GetSegments(types.NilUniqueID(), nil, nil, types.MustParse(collectionID.String()), nil, nil)
 	suite.NoError(err)
 	for _, segment := range segments {
-		suite.Contains(segmentsFilePaths, segment.Segment.ID)
-		suite.Equal(segmentsFilePaths[segment.Segment.ID], segment.Segment.FilePaths)
+		suite.Contains(segmentsFilePaths, segment.Segment.ID.String())
+		suite.Equal(segmentsFilePaths[segment.Segment.ID.String()], segment.Segment.FilePaths)
 	}
 
 	// clean up
@@ -0,0 +1,33 @@
+package dao
+
+import (
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
+	"gorm.io/gorm"
+)
+
+// notDeleted is a scope that keeps the rows of table that are not soft deleted.
+// The table name is required because most queries join several soft deletable tables.
+func notDeleted(table string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(table+".is_deleted = ?", false)
+	}
+}
+
+// softDeleteUpdates returns the column updates that mark a row of any table
+// embedding dbmodel.SoftDelete as deleted by actor.
+func softDeleteUpdates(actor string) map[string]interface{} {
+	return map[string]interface{}{
+		"is_deleted": true,
+		"deleted_at": dbcore.NowUTC(),
+		"deleted_by": actor,
+	}
+}
+
+// restoreUpdates returns the column updates that undo softDeleteUpdates.
+func restoreUpdates() map[string]interface{} {
+	return map[string]interface{}{
+		"is_deleted": false,
+		"deleted_at": nil,
+		"deleted_by": nil,
+	}
+}
@@ -2,6 +2,9 @@ package dao
 
 import (
 	"errors"
+	"slices"
+	"strings"
+
 	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -39,7 +42,7 @@ func (s *tenantDb) GetAllTenants() ([]*dbmodel.Tenant, error) {
 func (s *tenantDb) GetTenants(tenantID string) ([]*dbmodel.Tenant, error) {
 	var tenants []*dbmodel.Tenant
 
-	if err := s.db.Where("id = ?", tenantID).Find(&tenants).Error; err != nil {
+	if err := s.db.Where("id = ?", tenantID).Scopes(notDeleted("tenants")).Find(&tenants).Error; err != nil {
 		return nil, err
 	}
 	return tenants, nil
@@ -84,6 +87,33 @@ func (s *tenantDb) UpdateTenantLastCompactionTime(tenantID string, lastCompactio
 	return nil
 }
 
+func (s *tenantDb) UpdateTenantsLastCompactionTime(lastCompactionTimes map[string]int64) error {
+	if len(lastCompactionTimes) == 0 {
+		return nil
+	}
+	tenantIDs := make([]string, 0, len(lastCompactionTimes))
+	for tenantID := range lastCompactionTimes {
+		tenantIDs = append(tenantIDs, tenantID)
+	}
+	slices.Sort(tenantIDs)
+	var caseExpr strings.Builder
+	args := make([]interface{}, 0, 2*len(tenantIDs))
+	caseExpr.WriteString("CASE id")
+	for _, tenantID := range tenantIDs {
+		caseExpr.WriteString(" WHEN ? THEN ?::bigint")
+		args = append(args, tenantID, lastCompactionTimes[tenantID])
+	}
+	caseExpr.WriteString(" END")
+	err := s.db.Model(&dbmodel.Tenant{}).
+		Where("id IN ?", tenantIDs).
+		Update("last_compaction_time", gorm.Expr(caseExpr.String(), args...)).Error
+	if err != nil {
+		log.Error("UpdateTenantsLastCompactionTime error", zap.Int("tenants", len(tenantIDs)), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
 func (s *tenantDb) GetTenantsLastCompactionTime(tenantIDs []string) ([]*dbmodel.Tenant, error) {
 	log.Info("GetTenantsLastCompactionTime", zap.Any("tenantIDs", tenantIDs))
 	var tenants []*dbmodel.Tenant
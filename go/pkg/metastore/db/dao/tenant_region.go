@@ -0,0 +1,50 @@
+package dao
+
+import (
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type tenantRegionDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.ITenantRegionDb = &tenantRegionDb{}
+
+func (s *tenantRegionDb) Replace(tenantID string, regions []string) error {
+	err := s.db.Where("tenant_id = ?", tenantID).Delete(&dbmodel.TenantRegion{}).Error
+	if err != nil {
+		log.Error("delete tenant regions failed", zap.String("tenantID", tenantID), zap.Error(err))
+		return err
+	}
+	if len(regions) == 0 {
+		return nil
+	}
+	rows := make([]*dbmodel.TenantRegion, 0, len(regions))
+	for _, region := range regions {
+		rows = append(rows, &dbmodel.TenantRegion{TenantID: tenantID, Region: region})
+	}
+	err = s.db.Create(rows).Error
+	if err != nil {
+		log.Error("insert tenant regions failed", zap.String("tenantID", tenantID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// GetByTenantID returns the regions of a tenant ordered by name.
+func (s *tenantRegionDb) GetByTenantID(tenantID string) ([]*dbmodel.TenantRegion, error) {
+	var regions []*dbmodel.TenantRegion
+	err := s.db.Where("tenant_id = ?", tenantID).Order("region").Find(&regions).Error
+	if err != nil {
+		log.Error("get tenant regions failed", zap.String("tenantID", tenantID), zap.Error(err))
+		return nil, err
+	}
+	return regions, nil
+}
+
+func (s *tenantRegionDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.TenantRegion{}).Error
+}
@@ -1,8 +1,10 @@
 package dao
 
 import (
+	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/types"
 	"github.com/pingcap/log"
 	"github.com/stretchr/testify/suite"
 	"gorm.io/gorm"
@@ -59,6 +61,28 @@ func (suite *TenantDbTestSuite) TestTenantDb_UpdateTenantLastCompactionTime() {
 	suite.db.Delete(&tenant, "id = ?", tenantId)
 }
 
+func (suite *TenantDbTestSuite) TestTenantDb_UpdateTenantsLastCompactionTime() {
+	lastCompactionTimes := map[string]int64{}
+	for i := 0; i < 3; i++ {
+		tenantId := "testUpdateTenantsLastCompactionTime" + strconv.Itoa(i)
+		err := suite.Db.Insert(&dbmodel.Tenant{ID: tenantId})
+		suite.Require().NoError(err)
+		lastCompactionTimes[tenantId] = int64(100 + i)
+	}
+	// tenants that do not exist are skipped
+	lastCompactionTimes["testUpdateTenantsLastCompactionTimeMissing"] = 1
+
+	err := suite.Db.UpdateTenantsLastCompactionTime(lastCompactionTimes)
+	suite.Require().NoError(err)
+	for i := 0; i < 3; i++ {
+		var tenant dbmodel.Tenant
+		tenantId := "testUpdateTenantsLastCompactionTime" + strconv.Itoa(i)
+		suite.db.First(&tenant, "id = ?", tenantId)
+		suite.Require().Equal(int64(100+i), tenant.LastCompactionTime)
+		suite.db.Delete(&tenant, "id = ?", tenantId)
+	}
+}
+
 func (suite *TenantDbTestSuite) TestTenantDb_GetTenantsLastCompactionTime() {
 	tenantIds := make([]string, 0)
 	for i := 0; i < 10; i++ {
@@ -95,6 +119,107 @@ func (suite *TenantDbTestSuite) TestTenantDb_GetTenantsLastCompactionTime() {
 	}
 }
 
+func (suite *TenantDbTestSuite) TestTenantDb_CollectionLifecycleCounts() {
+	tenantId := "testCollectionLifecycleCounts"
+	lifecycleDb := &collectionLifecycleDb{db: suite.db}
+	day := time.Date(2026, 10, 14, 23, 30, 0, 0, time.UTC)
+
+	suite.Require().NoError(lifecycleDb.Increment(tenantId, day, dbmodel.LifecycleEventCreated, 2))
+	suite.Require().NoError(lifecycleDb.Increment(tenantId, day.Add(-time.Hour), dbmodel.LifecycleEventCreated, 1))
+	suite.Require().NoError(lifecycleDb.Increment(tenantId, day, dbmodel.LifecycleEventDeleted, 1))
+	suite.Require().NoError(lifecycleDb.Increment(tenantId, day.Add(time.Hour), dbmodel.LifecycleEventPurged, 1))
+	suite.Require().ErrorIs(lifecycleDb.Increment(tenantId, day, "renamed", 1), common.ErrUnknownLifecycleEvent)
+
+	counts, err := lifecycleDb.GetCounts(tenantId, day, day.Add(24*time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().Len(counts, 2)
+	suite.Require().Equal(time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC), counts[0].Day)
+	suite.Require().Equal(int64(3), counts[0].Created)
+	suite.Require().Equal(int64(1), counts[0].Deleted)
+	suite.Require().Equal(int64(1), counts[1].Purged)
+
+	suite.db.Where("tenant_id = ?", tenantId).Delete(&dbmodel.CollectionLifecycleCount{})
+}
+
+func (suite *TenantDbTestSuite) TestTenantDb_Quota() {
+	tenantId := "testTenantQuota"
+	quotaDb := &tenantQuotaDb{db: suite.db}
+
+	quota, err := quotaDb.Get(tenantId)
+	suite.Require().NoError(err)
+	suite.Require().Nil(quota)
+
+	maxRecords := int64(100)
+	suite.Require().NoError(quotaDb.Upsert(&dbmodel.TenantQuota{TenantID: tenantId, MaxRecords: &maxRecords}))
+	maxCollections := int64(2)
+	suite.Require().NoError(quotaDb.Upsert(&dbmodel.TenantQuota{TenantID: tenantId, MaxCollections: &maxCollections}))
+	quota, err = quotaDb.Get(tenantId)
+	suite.Require().NoError(err)
+	// an upsert replaces every limit
+	suite.Require().Nil(quota.MaxRecords)
+	suite.Require().Equal(maxCollections, *quota.MaxCollections)
+
+	for i, deleted := range []bool{false, false, true} {
+		name := "testTenantQuota" + strconv.Itoa(i)
+		err = suite.db.Create(&dbmodel.Collection{
+			ID:         dbmodel.NewCollectionID(types.NewUniqueID()),
+			Name:       &name,
+			TenantID:   tenantId,
+			SoftDelete: dbmodel.SoftDelete{IsDeleted: deleted},
+		}).Error
+		suite.Require().NoError(err)
+	}
+	counterDb := &usageCounterDb{db: suite.db}
+	suite.Require().NoError(counterDb.Increment(tenantId, "", 30, 3000))
+	suite.Require().NoError(counterDb.Increment(tenantId, "", -10, -1000))
+	usage, err := quotaDb.GetUsage(tenantId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&dbmodel.TenantUsage{CollectionCount: 2, RecordCount: 20, SizeBytes: 2000}, usage)
+
+	suite.db.Where("tenant_id = ?", tenantId).Delete(&dbmodel.Collection{})
+	suite.db.Where("tenant_id = ?", tenantId).Delete(&dbmodel.TenantQuota{})
+	suite.db.Where("tenant_id = ?", tenantId).Delete(&dbmodel.UsageCounter{})
+}
+
+func (suite *TenantDbTestSuite) TestTenantDb_DatabaseQuota() {
+	tenantId := "testDatabaseQuota"
+	databaseId := dbmodel.NewDatabaseID(types.NewUniqueID())
+	quotaDb := &databaseQuotaDb{db: suite.db}
+
+	quota, err := quotaDb.Get(databaseId)
+	suite.Require().NoError(err)
+	suite.Require().Nil(quota)
+
+	maxRecords := int64(100)
+	suite.Require().NoError(quotaDb.Upsert(&dbmodel.DatabaseQuota{DatabaseID: databaseId, MaxRecords: &maxRecords}))
+	quota, err = quotaDb.Get(databaseId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(maxRecords, *quota.MaxRecords)
+	suite.Require().Nil(quota.MaxCollections)
+
+	// only the collections and counter of the database count
+	for i, databaseID := range []dbmodel.DatabaseID{databaseId, databaseId, dbmodel.NewDatabaseID(types.NewUniqueID())} {
+		name := "testDatabaseQuota" + strconv.Itoa(i)
+		err = suite.db.Create(&dbmodel.Collection{
+			ID:         dbmodel.NewCollectionID(types.NewUniqueID()),
+			Name:       &name,
+			TenantID:   tenantId,
+			DatabaseID: databaseID,
+		}).Error
+		suite.Require().NoError(err)
+	}
+	counterDb := &usageCounterDb{db: suite.db}
+	suite.Require().NoError(counterDb.Increment(tenantId, databaseId.String(), 30, 3000))
+	suite.Require().NoError(counterDb.Increment(tenantId, "", 50, 5000))
+	usage, err := quotaDb.GetUsage(tenantId, databaseId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&dbmodel.TenantUsage{CollectionCount: 2, RecordCount: 30, SizeBytes: 3000}, usage)
+
+	suite.db.Where("tenant_id = ?", tenantId).Delete(&dbmodel.Collection{})
+	suite.db.Where("database_id = ?", databaseId).Delete(&dbmodel.DatabaseQuota{})
+	suite.db.Where("tenant_id = ?", tenantId).Delete(&dbmodel.UsageCounter{})
+}
+
 func TestTenantDbTestSuite(t *testing.T) {
 	testSuite := new(TenantDbTestSuite)
 	testSuite.t = t
@@ -16,7 +16,7 @@ func GetSegmentScopes() []string {
 	return []string{"VECTOR", "METADATA"}
 }
 
-func CreateTestTenantAndDatabase(db *gorm.DB, tenant string, database string) (string, error) {
+func CreateTestTenantAndDatabase(db *gorm.DB, tenant string, database string) (dbmodel.DatabaseID, error) {
 	log.Info("create test tenant and database", zap.String("tenant", tenant), zap.String("database", database))
 	tenantDb := &tenantDb{
 		db: db,
@@ -33,7 +33,7 @@ func CreateTestTenantAndDatabase(db *gorm.DB, tenant string, database string) (s
 		return "", err
 	}
 
-	databaseId := types.NewUniqueID().String()
+	databaseId := dbmodel.NewDatabaseID(types.NewUniqueID())
 	err = databaseDb.Insert(&dbmodel.Database{
 		ID:       databaseId,
 		Name:     database,
@@ -52,7 +52,7 @@ func CleanUpTestDatabase(db *gorm.DB, tenantName string, databaseName string) er
 	collectionDb := &collectionDb{
 		db: db,
 	}
-	collections, err := collectionDb.GetCollections(nil, nil, tenantName, databaseName, nil, nil)
+	collections, err := collectionDb.GetCollections(nil, nil, tenantName, databaseName, nil, nil, nil)
 	log.Info("clean up test database", zap.Int("collections", len(collections)))
 	if err != nil {
 		return err
@@ -106,32 +106,31 @@ func CleanUpTestTenant(db *gorm.DB, tenantName string) error {
 	return nil
 }
 
-func CreateTestCollection(db *gorm.DB, collectionName string, dimension int32, databaseID string) (string, error) {
-	log.Info("create test collection", zap.String("collectionName", collectionName), zap.Int32("dimension", dimension), zap.String("databaseID", databaseID))
+func CreateTestCollection(db *gorm.DB, collectionName string, dimension int32, databaseID dbmodel.DatabaseID) (dbmodel.CollectionID, error) {
+	log.Info("create test collection", zap.String("collectionName", collectionName), zap.Int32("dimension", dimension), zap.String("databaseID", databaseID.String()))
 	collectionDb := &collectionDb{
 		db: db,
 	}
 	segmentDb := &segmentDb{
 		db: db,
 	}
-	collectionId := types.NewUniqueID().String()
+	collectionId := dbmodel.NewCollectionID(types.NewUniqueID())
 
 	err := collectionDb.Insert(&dbmodel.Collection{
 		ID:         collectionId,
 		Name:       &collectionName,
 		Dimension:  &dimension,
 		DatabaseID: databaseID,
-		CreatedAt:  time.Now(),
+		CreatedAt:  time.Now().UTC(),
 	})
 	if err != nil {
 		return "", err
 	}
 
 	for _, scope := range GetSegmentScopes() {
-		segmentId := types.NewUniqueID().String()
 		err = segmentDb.Insert(&dbmodel.Segment{
 			CollectionID: &collectionId,
-			ID:           segmentId,
+			ID:           dbmodel.NewSegmentID(types.NewUniqueID()),
 			Type:         SegmentType,
 			Scope:        scope,
 		})
@@ -144,8 +143,8 @@ func CreateTestCollection(db *gorm.DB, collectionName string, dimension int32, d
 	return collectionId, nil
 }
 
-func CleanUpTestCollection(db *gorm.DB, collectionId string) error {
-	log.Info("clean up collection", zap.String("collectionId", collectionId))
+func CleanUpTestCollection(db *gorm.DB, collectionId dbmodel.CollectionID) error {
+	log.Info("clean up collection", zap.String("collectionId", collectionId.String()))
 	collectionDb := &collectionDb{
 		db: db,
 	}
@@ -167,7 +166,7 @@ func CleanUpTestCollection(db *gorm.DB, collectionId string) error {
 	if err != nil {
 		return err
 	}
-	segments, err := segmentDb.GetSegments(types.NilUniqueID(), nil, nil, types.MustParse(collectionId))
+	segments, err := segmentDb.GetSegments(types.NilUniqueID(), nil, nil, collectionId.UniqueID(), nil, nil)
 	if err != nil {
 		return err
 	}
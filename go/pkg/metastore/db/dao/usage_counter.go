@@ -0,0 +1,65 @@
+package dao
+
+import (
+	"errors"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type usageCounterDb struct {
+	db *gorm.DB
+}
+
+var _ dbmodel.IUsageCounterDb = &usageCounterDb{}
+
+// Increment adds to a counter, creating it when it does not exist. Negative
+// values decrement it.
+func (s *usageCounterDb) Increment(tenantID string, databaseID string, records int64, sizeBytes int64) error {
+	err := s.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "cluster_id"}, {Name: "tenant_id"}, {Name: "database_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"record_count": gorm.Expr("usage_counters.record_count + EXCLUDED.record_count"),
+			"size_bytes":   gorm.Expr("usage_counters.size_bytes + EXCLUDED.size_bytes"),
+			"updated_at":   gorm.Expr("EXCLUDED.updated_at"),
+		}),
+	}).Create(&dbmodel.UsageCounter{
+		TenantID:    tenantID,
+		DatabaseID:  databaseID,
+		RecordCount: records,
+		SizeBytes:   sizeBytes,
+		UpdatedAt:   time.Now(),
+	}).Error
+	if err != nil {
+		log.Error("increment usage counter failed", zap.String("tenantID", tenantID), zap.String("databaseID", databaseID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// Get returns a counter, or a zero counter when nothing was counted yet.
+func (s *usageCounterDb) Get(tenantID string, databaseID string) (*dbmodel.UsageCounter, error) {
+	var counter dbmodel.UsageCounter
+	err := s.db.Where("tenant_id = ? AND database_id = ?", tenantID, databaseID).First(&counter).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &dbmodel.UsageCounter{TenantID: tenantID, DatabaseID: databaseID}, nil
+	}
+	if err != nil {
+		log.Error("get usage counter failed", zap.String("tenantID", tenantID), zap.String("databaseID", databaseID), zap.Error(err))
+		return nil, err
+	}
+	return &counter, nil
+}
+
+func (s *usageCounterDb) DeleteByTenantID(tenantID string) (int64, error) {
+	result := s.db.Where("tenant_id = ?", tenantID).Delete(&dbmodel.UsageCounter{})
+	return result.RowsAffected, result.Error
+}
+
+func (s *usageCounterDb) DeleteAll() error {
+	return s.db.Where("1 = 1").Delete(&dbmodel.UsageCounter{}).Error
+}
@@ -0,0 +1,115 @@
+package dbcore
+
+import (
+	"slices"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const clusterColumn = "cluster_id"
+
+// clusterScope confines every statement of a handle to the rows of one logical
+// cluster, so that several clusters can share a database. Rows are created in
+// the cluster, queries, updates and deletes only see its rows, and updates never
+// move a row to another cluster. Raw SQL is not rewritten, the DAOs bind
+// ClusterID themselves.
+type clusterScope struct {
+	clusterID string
+}
+
+func (c *clusterScope) Name() string {
+	return "chroma:cluster"
+}
+
+func (c *clusterScope) Initialize(db *gorm.DB) error {
+	callback := db.Callback()
+	err := callback.Create().Before("gorm:create").Register("chroma:cluster", c.assign)
+	if err != nil {
+		return err
+	}
+	err = callback.Update().Before("gorm:update").Register("chroma:cluster", c.scopeUpdate)
+	if err != nil {
+		return err
+	}
+	for _, register := range []func(string, func(*gorm.DB)) error{
+		callback.Query().Before("gorm:query").Register,
+		callback.Row().Before("gorm:row").Register,
+		callback.Delete().Before("gorm:delete").Register,
+	} {
+		err := register("chroma:cluster", c.scope)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClusterID returns the cluster db is scoped to, empty for the default cluster.
+func ClusterID(db *gorm.DB) string {
+	if c, ok := db.Config.Plugins[(&clusterScope{}).Name()].(*clusterScope); ok {
+		return c.clusterID
+	}
+	return ""
+}
+
+// hasClusterColumn is false for raw SQL, which is already built.
+func hasClusterColumn(stmt *gorm.Statement) bool {
+	if stmt.SQL.Len() > 0 {
+		return false
+	}
+	if stmt.Schema != nil {
+		return stmt.Schema.LookUpField(clusterColumn) != nil
+	}
+	return slices.Contains(dbmodel.ClusterTables, stmt.Table)
+}
+
+func (c *clusterScope) assign(db *gorm.DB) {
+	if db.Error != nil || !hasClusterColumn(db.Statement) {
+		return
+	}
+	db.Statement.SetColumn(clusterColumn, c.clusterID, true)
+}
+
+func (c *clusterScope) scope(db *gorm.DB) {
+	if db.Error != nil || !hasClusterColumn(db.Statement) {
+		return
+	}
+	db.Statement.AddClause(clause.Where{Exprs: []clause.Expression{
+		clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: clusterColumn}, Value: c.clusterID},
+	}})
+}
+
+// scopeUpdate also leaves cluster_id out of the assignments of Save, which
+// writes every field of the model.
+func (c *clusterScope) scopeUpdate(db *gorm.DB) {
+	c.scope(db)
+	if db.Error == nil && hasClusterColumn(db.Statement) {
+		db.Statement.Omits = append(db.Statement.Omits, clusterColumn)
+	}
+}
+
+// CreateClusterDefaults creates the default tenant and database of the cluster
+// of db when they do not exist yet. The default cluster gets them from the
+// migrations.
+func CreateClusterDefaults(db *gorm.DB) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("id = ?", common.DefaultTenant).
+			Attrs(dbmodel.Tenant{ID: common.DefaultTenant, LastCompactionTime: time.Now().Unix()}).
+			FirstOrCreate(&dbmodel.Tenant{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Where("tenant_id = ? AND name = ?", common.DefaultTenant, common.DefaultDatabase).
+			Attrs(dbmodel.Database{
+				ID:       dbmodel.DatabaseID(types.NewUniqueID().String()),
+				Name:     common.DefaultDatabase,
+				TenantID: common.DefaultTenant,
+			}).
+			FirstOrCreate(&dbmodel.Database{}).Error
+	})
+}
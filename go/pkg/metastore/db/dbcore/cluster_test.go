@@ -0,0 +1,97 @@
+package dbcore
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func openClusterForTesting(t *testing.T, path string, clusterID string) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Use(&clusterScope{clusterID: clusterID}); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+// createClusterTablesForTesting creates tenants, databases and leases with the
+// column types SQLite reads back as times.
+func createClusterTablesForTesting(t *testing.T, db *gorm.DB) {
+	for _, statement := range []string{
+		`CREATE TABLE tenants (cluster_id text NOT NULL DEFAULT '', id text NOT NULL, ts bigint DEFAULT 0, created_at timestamp DEFAULT CURRENT_TIMESTAMP, updated_at timestamp DEFAULT CURRENT_TIMESTAMP,
+			last_compaction_time bigint NOT NULL, kms_key_id text, is_deleted bool DEFAULT false, deleted_at timestamp, deleted_by text, PRIMARY KEY (cluster_id, id))`,
+		`CREATE TABLE databases (cluster_id text NOT NULL DEFAULT '', id text PRIMARY KEY, name text, tenant_id text, ts bigint DEFAULT 0, created_at timestamp DEFAULT CURRENT_TIMESTAMP, updated_at timestamp DEFAULT CURRENT_TIMESTAMP,
+			is_deleted bool DEFAULT false, deleted_at timestamp, deleted_by text)`,
+		`CREATE UNIQUE INDEX idx_tenantid_name ON databases (cluster_id, name, tenant_id)`,
+		`CREATE TABLE leases (cluster_id text NOT NULL DEFAULT '', name text NOT NULL, holder text NOT NULL, PRIMARY KEY (cluster_id, name))`,
+	} {
+		if err := db.Exec(statement).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestClusterScope(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sysdb.db")
+	a := openClusterForTesting(t, path, "a")
+	b := openClusterForTesting(t, path, "b")
+	createClusterTablesForTesting(t, a)
+	assert.Equal(t, "a", ClusterID(a))
+	assert.Equal(t, "", ClusterID(&gorm.DB{Config: &gorm.Config{}}))
+
+	// both clusters can have a tenant of the same name
+	assert.NoError(t, a.Create(&dbmodel.Tenant{ID: "tenant", LastCompactionTime: 1}).Error)
+	assert.NoError(t, b.Create(&dbmodel.Tenant{ID: "tenant", LastCompactionTime: 2}).Error)
+	assert.NoError(t, b.Create(&dbmodel.Tenant{ID: "other"}).Error)
+
+	var tenants []*dbmodel.Tenant
+	assert.NoError(t, a.Find(&tenants).Error)
+	assert.Len(t, tenants, 1)
+	assert.Equal(t, "a", tenants[0].ClusterID)
+	assert.Equal(t, int64(1), tenants[0].LastCompactionTime)
+
+	// updates and deletes leave the rows of the other cluster alone
+	assert.NoError(t, a.Model(&dbmodel.Tenant{}).Where("id = ?", "tenant").Update("last_compaction_time", 10).Error)
+	tenants[0].LastCompactionTime = 11
+	assert.NoError(t, a.Save(tenants[0]).Error)
+	var tenant dbmodel.Tenant
+	assert.NoError(t, b.Where("id = ?", "tenant").First(&tenant).Error)
+	assert.Equal(t, "b", tenant.ClusterID)
+	assert.Equal(t, int64(2), tenant.LastCompactionTime)
+
+	assert.NoError(t, a.Where("1 = 1").Delete(&dbmodel.Tenant{}).Error)
+	var count int64
+	assert.NoError(t, b.Model(&dbmodel.Tenant{}).Count(&count).Error)
+	assert.Equal(t, int64(2), count)
+
+	// statements that name their table without a model are scoped too
+	assert.NoError(t, a.Table("leases").Create(map[string]interface{}{"name": "leader", "holder": "replica"}).Error)
+	var holders []string
+	assert.NoError(t, b.Table("leases").Select("holder").Scan(&holders).Error)
+	assert.Empty(t, holders)
+	assert.NoError(t, a.Table("leases").Select("holder").Scan(&holders).Error)
+	assert.Equal(t, []string{"replica"}, holders)
+}
+
+func TestCreateClusterDefaults(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sysdb.db")
+	a := openClusterForTesting(t, path, "a")
+	b := openClusterForTesting(t, path, "b")
+	createClusterTablesForTesting(t, a)
+
+	assert.NoError(t, CreateClusterDefaults(a))
+	assert.NoError(t, CreateClusterDefaults(a))
+	assert.NoError(t, CreateClusterDefaults(b))
+	for _, db := range []*gorm.DB{a, b} {
+		var databases []*dbmodel.Database
+		assert.NoError(t, db.Where("tenant_id = ?", "default_tenant").Find(&databases).Error)
+		assert.Len(t, databases, 1)
+	}
+}
@@ -21,10 +21,6 @@ import (
 	"gorm.io/gorm/logger"
 )
 
-var (
-	globalDB *gorm.DB
-)
-
 type DBConfig struct {
 	Username     string
 	Password     string
@@ -34,18 +30,75 @@ type DBConfig struct {
 	MaxIdleConns int
 	MaxOpenConns int
 	SslMode      string
+	// ReadAddress is the address of a read replica used by read
+	// transactions. Empty reads from the primary.
+	ReadAddress string
+	// ReadTimeout and WriteTimeout bound every statement of a read or write
+	// transaction. Zero uses the server's statement_timeout.
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+	// BackgroundMaxOpenConns is the size of a separate pool used by background
+	// jobs, so that they cannot take the connections of the request path. Zero
+	// runs background jobs on the request pool.
+	BackgroundMaxOpenConns int
+	// ClusterID is the logical cluster whose rows the sysdb reads and writes.
+	// Clusters that share a database must have different IDs, empty is the
+	// cluster of the rows written before clusters existed.
+	ClusterID string
+	// ReadOnly opens every session read-only, for a replicated database that
+	// does not accept writes.
+	ReadOnly bool
+}
+
+// NowUTC is the clock used by GORM for created_at and updated_at.
+func NowUTC() time.Time {
+	return time.Now().UTC()
 }
 
 func ConnectPostgres(cfg DBConfig) (*gorm.DB, error) {
+	db, err := openPostgres(cfg, true)
+	if err != nil {
+		return nil, err
+	}
+	readDB := db
+	if cfg.ReadAddress != "" {
+		readCfg := cfg
+		readCfg.Address = cfg.ReadAddress
+		readDB, err = openPostgres(readCfg, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+	setGlobalDB(db, readDB, &cfg)
+	if cfg.BackgroundMaxOpenConns > 0 {
+		backgroundDB, err := openPostgres(backgroundConfig(cfg), true)
+		if err != nil {
+			return nil, err
+		}
+		setGlobalBackgroundDB(backgroundDB)
+	}
+	return db, nil
+}
+
+// openPostgres opens a pool to cfg.Address. The write handle reconnects when it
+// finds itself connected to a read-only server, unless cfg.ReadOnly expects
+// one.
+func openPostgres(cfg DBConfig, writeHandle bool) (*gorm.DB, error) {
 	log.Info("ConnectPostgres", zap.String("host", cfg.Address), zap.String("database", cfg.DBName), zap.Int("port", cfg.Port))
-	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%d sslmode=%s",
+	// Sessions run in UTC so that CURRENT_TIMESTAMP defaults and timestamptz values
+	// read back by the DAOs agree with the UTC times written by GORM.
+	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%d sslmode=%s TimeZone=UTC",
 		cfg.Address, cfg.Username, cfg.Password, cfg.DBName, cfg.Port, cfg.SslMode)
+	if cfg.ReadOnly {
+		dsn += " default_transaction_read_only=on"
+	}
 
 	ormLogger := logger.Default
 	ormLogger.LogMode(logger.Info)
 	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
 		Logger:          ormLogger,
 		CreateBatchSize: 100,
+		NowFunc:         NowUTC,
 	})
 	if err != nil {
 		log.Error("fail to connect db",
@@ -66,7 +119,24 @@ func ConnectPostgres(cfg DBConfig) (*gorm.DB, error) {
 	idb.SetMaxIdleConns(cfg.MaxIdleConns)
 	idb.SetMaxOpenConns(cfg.MaxOpenConns)
 
-	globalDB = db
+	err = db.Use(&clusterScope{clusterID: cfg.ClusterID})
+	if err != nil {
+		return nil, err
+	}
+	err = registerMetricsCallbacks(db)
+	if err != nil {
+		return nil, err
+	}
+	err = registerQuerySampleCallbacks(db)
+	if err != nil {
+		return nil, err
+	}
+	if writeHandle && !cfg.ReadOnly {
+		err = registerFailoverCallbacks(db)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	log.Info("Postgres connected success",
 		zap.String("host", cfg.Address),
@@ -78,7 +148,7 @@ func ConnectPostgres(cfg DBConfig) (*gorm.DB, error) {
 
 // SetGlobalDB Only for test
 func SetGlobalDB(db *gorm.DB) {
-	globalDB = db
+	setGlobalDB(db, db, nil)
 }
 
 type ctxTransactionKey struct{}
@@ -96,15 +166,6 @@ func NewTxImpl() *txImpl {
 	return &txImpl{}
 }
 
-func (*txImpl) Transaction(ctx context.Context, fn func(txctx context.Context) error) error {
-	db := globalDB.WithContext(ctx)
-
-	return db.Transaction(func(tx *gorm.DB) error {
-		txCtx := CtxWithTransaction(ctx, tx)
-		return fn(txCtx)
-	})
-}
-
 func GetDB(ctx context.Context) *gorm.DB {
 	iface := ctx.Value(ctxTransactionKey{})
 
@@ -118,7 +179,7 @@ func GetDB(ctx context.Context) *gorm.DB {
 		return tx
 	}
 
-	return globalDB.WithContext(ctx)
+	return writeDB(ctx).WithContext(ctx)
 }
 
 func CreateDefaultTenantAndDatabase(db *gorm.DB) string {
@@ -140,7 +201,7 @@ func CreateDefaultTenantAndDatabase(db *gorm.DB) string {
 
 	if result.RowsAffected == 0 {
 		db.Create(&dbmodel.Database{
-			ID:       databaseId,
+			ID:       dbmodel.DatabaseID(databaseId),
 			Name:     common.DefaultDatabase,
 			TenantID: common.DefaultTenant,
 		})
@@ -151,7 +212,7 @@ func CreateDefaultTenantAndDatabase(db *gorm.DB) string {
 	if err != nil {
 		return ""
 	}
-	return database[0].ID
+	return database[0].ID.String()
 }
 
 func CreateTestTables(db *gorm.DB) {
@@ -184,6 +245,106 @@ func CreateTestTables(db *gorm.DB) {
 	if !tableExist {
 		db.Migrator().CreateTable(&dbmodel.Notification{})
 	}
+	tableExist = db.Migrator().HasTable(&dbmodel.ArchivedCollection{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.ArchivedCollection{})
+	}
+	tableExist = db.Migrator().HasTable(&dbmodel.ArchivedSegment{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.ArchivedSegment{})
+	}
+	tableExist = db.Migrator().HasTable(&dbmodel.SchemaBackfill{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.SchemaBackfill{})
+	}
+	tableExist = db.Migrator().HasTable(&dbmodel.Lease{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.Lease{})
+	}
+	tableExist = db.Migrator().HasTable(&dbmodel.CollectionLifecycleCount{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.CollectionLifecycleCount{})
+	}
+	tableExist = db.Migrator().HasTable(&dbmodel.CollectionAcl{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.CollectionAcl{})
+	}
+	tableExist = db.Migrator().HasTable(&dbmodel.TenantQuota{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.TenantQuota{})
+	}
+	tableExist = db.Migrator().HasTable(&dbmodel.UsageCounter{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.UsageCounter{})
+	}
+	tableExist = db.Migrator().HasTable(&dbmodel.DatabaseQuota{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.DatabaseQuota{})
+	}
+	tableExist = db.Migrator().HasTable(&dbmodel.CollectionLogRoute{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.CollectionLogRoute{})
+	}
+	tableExist = db.Migrator().HasTable(&dbmodel.CompactionRequest{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.CompactionRequest{})
+	}
+	tableExist = db.Migrator().HasTable(&dbmodel.SegmentFile{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.SegmentFile{})
+	}
+	tableExist = db.Migrator().HasTable(&dbmodel.FeatureFlag{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.FeatureFlag{})
+	}
+	tableExist = db.Migrator().HasTable(&dbmodel.TenantRegion{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.TenantRegion{})
+	}
+	tableExist = db.Migrator().HasTable(&dbmodel.NodeDecommission{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.NodeDecommission{})
+	}
+	tableExist = db.Migrator().HasTable(&dbmodel.NodeHeartbeat{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.NodeHeartbeat{})
+	}
+	tableExist = db.Migrator().HasTable(&dbmodel.CollectionStats{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.CollectionStats{})
+	}
+	tableExist = db.Migrator().HasTable(&dbmodel.CollectionSizeHistory{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.CollectionSizeHistory{})
+	}
+	tableExist = db.Migrator().HasTable(&dbmodel.CleanupDeadLetter{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.CleanupDeadLetter{})
+	}
+	tableExist = db.Migrator().HasTable(&dbmodel.CollectionMetadataHistory{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.CollectionMetadataHistory{})
+	}
+	tableExist = db.Migrator().HasTable(&dbmodel.EmbeddingFunction{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.EmbeddingFunction{})
+	}
+	tableExist = db.Migrator().HasTable(&dbmodel.CollectionSchemaField{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.CollectionSchemaField{})
+	}
+	tableExist = db.Migrator().HasTable(&dbmodel.CollectionTemplate{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.CollectionTemplate{})
+	}
+	tableExist = db.Migrator().HasTable(&dbmodel.MaintenanceMode{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.MaintenanceMode{})
+	}
+	tableExist = db.Migrator().HasTable(&dbmodel.Job{})
+	if !tableExist {
+		db.Migrator().CreateTable(&dbmodel.Job{})
+	}
 
 	// create default tenant and database
 	CreateDefaultTenantAndDatabase(db)
@@ -0,0 +1,254 @@
+package dbcore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	defaultBackfillBatchSize = 1000
+	// expandContractLockTimeout bounds how long the DDL statements wait for their
+	// lock, so that a long running transaction on the table makes the step fail
+	// instead of queueing every other query on the table behind it.
+	expandContractLockTimeout = "5s"
+	// backfillLockedRowsDelay is how long Backfill waits before it retries the
+	// rows that other transactions held locked.
+	backfillLockedRowsDelay = time.Second
+)
+
+var (
+	identifierPattern = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+	columnTypePattern = regexp.MustCompile(`^[a-z][a-z0-9_ ]*(\([0-9, ]+\))?$`)
+)
+
+// ExpandContract adds a NOT NULL column to a large table without holding a long
+// lock on it. The change runs in three phases that must happen in order:
+//
+//   - Expand adds the column as nullable, which only changes the catalog.
+//   - Backfill sets the column in small batches, each in its own transaction,
+//     and records its progress so that it can be interrupted and resumed.
+//   - Contract enforces NOT NULL through a CHECK constraint that is validated
+//     without blocking writes.
+//
+// The application must write the column for new rows before Backfill starts and
+// must not rely on it being set before Contract is done.
+type ExpandContract struct {
+	// Name identifies the migration in schema_backfills.
+	Name       string
+	Table      string
+	Column     string
+	ColumnType string
+	// BackfillExpression is the SQL expression the column is set to. It may reference the
+	// other columns of the row. Rows for which it is NULL are left for Contract
+	// to report.
+	BackfillExpression string
+	// BatchSize is the number of rows updated per transaction.
+	BatchSize int
+}
+
+func (m *ExpandContract) validate() error {
+	if m.Name == "" || m.BackfillExpression == "" {
+		return common.ErrInvalidMigrationIdentifier
+	}
+	if !identifierPattern.MatchString(m.Table) || !identifierPattern.MatchString(m.Column) || !columnTypePattern.MatchString(m.ColumnType) {
+		log.Error("invalid expand/contract migration", zap.String("name", m.Name), zap.String("table", m.Table), zap.String("column", m.Column), zap.String("columnType", m.ColumnType))
+		return common.ErrInvalidMigrationIdentifier
+	}
+	return nil
+}
+
+func (m *ExpandContract) batchSize() int {
+	if m.BatchSize <= 0 {
+		return defaultBackfillBatchSize
+	}
+	return m.BatchSize
+}
+
+func (m *ExpandContract) constraintName() string {
+	return "chk_" + m.Column + "_not_null"
+}
+
+func (m *ExpandContract) expandSQL() string {
+	return fmt.Sprintf(`ALTER TABLE "public"."%s" ADD COLUMN IF NOT EXISTS "%s" %s NULL`, m.Table, m.Column, m.ColumnType)
+}
+
+// backfillSQL updates at most one batch of rows. Rows locked by other
+// transactions are skipped and picked up by a later batch.
+func (m *ExpandContract) backfillSQL() string {
+	return fmt.Sprintf(`UPDATE "public"."%[1]s" SET "%[2]s" = (%[3]s) WHERE ctid = ANY(ARRAY(SELECT ctid FROM "public"."%[1]s" WHERE "%[2]s" IS NULL AND (%[3]s) IS NOT NULL LIMIT %[4]d FOR UPDATE SKIP LOCKED))`,
+		m.Table, m.Column, m.BackfillExpression, m.batchSize())
+}
+
+// remainingSQL tells whether rows are left to backfill, including the rows
+// that backfillSQL skipped because they were locked.
+func (m *ExpandContract) remainingSQL() string {
+	return fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM "public"."%[1]s" WHERE "%[2]s" IS NULL AND (%[3]s) IS NOT NULL)`,
+		m.Table, m.Column, m.BackfillExpression)
+}
+
+func (m *ExpandContract) contractSQL() []string {
+	table := fmt.Sprintf(`"public"."%s"`, m.Table)
+	return []string{
+		fmt.Sprintf(`ALTER TABLE %s ADD CONSTRAINT "%s" CHECK ("%s" IS NOT NULL) NOT VALID`, table, m.constraintName(), m.Column),
+		fmt.Sprintf(`ALTER TABLE %s VALIDATE CONSTRAINT "%s"`, table, m.constraintName()),
+		// The validated constraint lets SET NOT NULL skip the table scan.
+		fmt.Sprintf(`ALTER TABLE %s ALTER COLUMN "%s" SET NOT NULL`, table, m.Column),
+		fmt.Sprintf(`ALTER TABLE %s DROP CONSTRAINT "%s"`, table, m.constraintName()),
+	}
+}
+
+func (m *ExpandContract) progress(db *gorm.DB) (*dbmodel.SchemaBackfill, error) {
+	var progress dbmodel.SchemaBackfill
+	err := db.Where("name = ?", m.Name).First(&progress).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &progress, nil
+}
+
+func (m *ExpandContract) setPhase(db *gorm.DB, phase string) error {
+	return db.Model(&dbmodel.SchemaBackfill{}).Where("name = ?", m.Name).Update("phase", phase).Error
+}
+
+// Expand adds the nullable column. It is a no-op once the migration has expanded.
+func (m *ExpandContract) Expand(ctx context.Context, db *gorm.DB) error {
+	if err := m.validate(); err != nil {
+		return err
+	}
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("SET LOCAL lock_timeout = '" + expandContractLockTimeout + "'").Error
+		if err != nil {
+			return err
+		}
+		err = tx.Exec(m.expandSQL()).Error
+		if err != nil {
+			log.Error("expand failed", zap.String("name", m.Name), zap.Error(err))
+			return err
+		}
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&dbmodel.SchemaBackfill{
+			Name:         m.Name,
+			TargetTable:  m.Table,
+			TargetColumn: m.Column,
+			Phase:        dbmodel.SchemaBackfillPhaseExpanded,
+		}).Error
+	})
+}
+
+// Backfill sets the column of every row in batches. onBatch, when not nil, is
+// called after each batch with the total number of rows backfilled so far,
+// including the rows of earlier interrupted runs.
+func (m *ExpandContract) Backfill(ctx context.Context, db *gorm.DB, onBatch func(rowsBackfilled int64)) error {
+	if err := m.validate(); err != nil {
+		return err
+	}
+	db = db.WithContext(ctx)
+	progress, err := m.progress(db)
+	if err != nil {
+		return err
+	}
+	if progress == nil {
+		return common.ErrMigrationPhaseOrder
+	}
+	switch progress.Phase {
+	case dbmodel.SchemaBackfillPhaseBackfilled, dbmodel.SchemaBackfillPhaseContracted:
+		return nil
+	case dbmodel.SchemaBackfillPhaseExpanded:
+		err = m.setPhase(db, dbmodel.SchemaBackfillPhaseBackfilling)
+		if err != nil {
+			return err
+		}
+	}
+
+	total := progress.RowsBackfilled
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		var updated int64
+		err = db.Transaction(func(tx *gorm.DB) error {
+			result := tx.Exec(m.backfillSQL())
+			if result.Error != nil {
+				return result.Error
+			}
+			updated = result.RowsAffected
+			return tx.Model(&dbmodel.SchemaBackfill{}).Where("name = ?", m.Name).
+				Update("rows_backfilled", gorm.Expr("rows_backfilled + ?", updated)).Error
+		})
+		if err != nil {
+			log.Error("backfill batch failed", zap.String("name", m.Name), zap.Int64("rowsBackfilled", total), zap.Error(err))
+			return err
+		}
+		if updated == 0 {
+			// A batch that updated nothing may only have skipped locked rows.
+			var remaining bool
+			err = db.Raw(m.remainingSQL()).Scan(&remaining).Error
+			if err != nil {
+				log.Error("backfill check failed", zap.String("name", m.Name), zap.Int64("rowsBackfilled", total), zap.Error(err))
+				return err
+			}
+			if !remaining {
+				break
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backfillLockedRowsDelay):
+			}
+			continue
+		}
+		total += updated
+		if onBatch != nil {
+			onBatch(total)
+		}
+	}
+	log.Info("backfill done", zap.String("name", m.Name), zap.Int64("rowsBackfilled", total))
+	return m.setPhase(db, dbmodel.SchemaBackfillPhaseBackfilled)
+}
+
+// Contract makes the column NOT NULL. It fails if the backfill has not finished,
+// or if rows are still NULL because the backfill expression was NULL for them.
+func (m *ExpandContract) Contract(ctx context.Context, db *gorm.DB) error {
+	if err := m.validate(); err != nil {
+		return err
+	}
+	db = db.WithContext(ctx)
+	progress, err := m.progress(db)
+	if err != nil {
+		return err
+	}
+	if progress != nil && progress.Phase == dbmodel.SchemaBackfillPhaseContracted {
+		return nil
+	}
+	if progress == nil || progress.Phase != dbmodel.SchemaBackfillPhaseBackfilled {
+		return common.ErrMigrationPhaseOrder
+	}
+	// Each statement runs in its own transaction so that VALIDATE CONSTRAINT,
+	// which scans the table, only holds a lock that allows concurrent writes.
+	for _, statement := range m.contractSQL() {
+		err = db.Transaction(func(tx *gorm.DB) error {
+			err := tx.Exec("SET LOCAL lock_timeout = '" + expandContractLockTimeout + "'").Error
+			if err != nil {
+				return err
+			}
+			return tx.Exec(statement).Error
+		})
+		if err != nil {
+			log.Error("contract failed", zap.String("name", m.Name), zap.String("statement", statement), zap.Error(err))
+			return err
+		}
+	}
+	return m.setPhase(db, dbmodel.SchemaBackfillPhaseContracted)
+}
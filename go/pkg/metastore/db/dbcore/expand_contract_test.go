@@ -0,0 +1,40 @@
+package dbcore
+
+import (
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandContract_Validate(t *testing.T) {
+	m := &ExpandContract{Name: "collections_tenant_id", Table: "collections", Column: "tenant_id", ColumnType: "varchar(128)", BackfillExpression: "''"}
+	assert.NoError(t, m.validate())
+
+	for _, invalid := range []*ExpandContract{
+		{Name: "", Table: "collections", Column: "tenant_id", ColumnType: "text", BackfillExpression: "''"},
+		{Name: "m", Table: "collections; DROP TABLE tenants", Column: "tenant_id", ColumnType: "text", BackfillExpression: "''"},
+		{Name: "m", Table: "collections", Column: `tenant"id`, ColumnType: "text", BackfillExpression: "''"},
+		{Name: "m", Table: "collections", Column: "tenant_id", ColumnType: "text DEFAULT ''", BackfillExpression: "''"},
+		{Name: "m", Table: "collections", Column: "tenant_id", ColumnType: "text", BackfillExpression: ""},
+	} {
+		assert.ErrorIs(t, invalid.validate(), common.ErrInvalidMigrationIdentifier)
+	}
+}
+
+func TestExpandContract_SQL(t *testing.T) {
+	m := &ExpandContract{Name: "collections_tenant_id", Table: "collections", Column: "tenant_id", ColumnType: "text", BackfillExpression: "(SELECT tenant_id FROM databases WHERE databases.id = collections.database_id)", BatchSize: 500}
+
+	assert.Equal(t, `ALTER TABLE "public"."collections" ADD COLUMN IF NOT EXISTS "tenant_id" text NULL`, m.expandSQL())
+	assert.Equal(t, `UPDATE "public"."collections" SET "tenant_id" = ((SELECT tenant_id FROM databases WHERE databases.id = collections.database_id)) WHERE ctid = ANY(ARRAY(SELECT ctid FROM "public"."collections" WHERE "tenant_id" IS NULL AND ((SELECT tenant_id FROM databases WHERE databases.id = collections.database_id)) IS NOT NULL LIMIT 500 FOR UPDATE SKIP LOCKED))`, m.backfillSQL())
+	assert.Equal(t, `SELECT EXISTS (SELECT 1 FROM "public"."collections" WHERE "tenant_id" IS NULL AND ((SELECT tenant_id FROM databases WHERE databases.id = collections.database_id)) IS NOT NULL)`, m.remainingSQL())
+	assert.Equal(t, []string{
+		`ALTER TABLE "public"."collections" ADD CONSTRAINT "chk_tenant_id_not_null" CHECK ("tenant_id" IS NOT NULL) NOT VALID`,
+		`ALTER TABLE "public"."collections" VALIDATE CONSTRAINT "chk_tenant_id_not_null"`,
+		`ALTER TABLE "public"."collections" ALTER COLUMN "tenant_id" SET NOT NULL`,
+		`ALTER TABLE "public"."collections" DROP CONSTRAINT "chk_tenant_id_not_null"`,
+	}, m.contractSQL())
+
+	m.BatchSize = 0
+	assert.Equal(t, defaultBackfillBatchSize, m.batchSize())
+}
@@ -0,0 +1,166 @@
+package dbcore
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	// readOnlySQLTransaction is the SQLSTATE returned for a write on a server
+	// that is in recovery, which is what the write handle sees after the
+	// primary it was connected to has been demoted by a failover.
+	readOnlySQLTransaction = "25006"
+
+	reconnectMaxAttempts    = 10
+	reconnectInitialBackoff = time.Second
+	reconnectMaxBackoff     = 30 * time.Second
+)
+
+var (
+	globalDBMu     sync.RWMutex
+	globalDB       *gorm.DB
+	globalReadDB   *gorm.DB
+	globalDBConfig *DBConfig
+
+	globalBackgroundDB *gorm.DB
+
+	reconnecting atomic.Bool
+)
+
+func getGlobalDB() *gorm.DB {
+	globalDBMu.RLock()
+	defer globalDBMu.RUnlock()
+	return globalDB
+}
+
+func getGlobalReadDB() *gorm.DB {
+	globalDBMu.RLock()
+	defer globalDBMu.RUnlock()
+	return globalReadDB
+}
+
+func getGlobalDBConfig() *DBConfig {
+	globalDBMu.RLock()
+	defer globalDBMu.RUnlock()
+	return globalDBConfig
+}
+
+// setGlobalDB swaps the global pools. cfg is kept to rebuild the write pool
+// after a failover; a nil cfg disables reconnection.
+func setGlobalDB(db *gorm.DB, readDB *gorm.DB, cfg *DBConfig) {
+	globalDBMu.Lock()
+	defer globalDBMu.Unlock()
+	globalDB = db
+	globalReadDB = readDB
+	globalDBConfig = cfg
+}
+
+func isReadOnlyError(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == readOnlySQLTransaction
+}
+
+// registerFailoverCallbacks checks the result of every statement on db and
+// starts a reconnection when the server rejected it as read-only.
+func registerFailoverCallbacks(db *gorm.DB) error {
+	callback := db.Callback()
+	for _, register := range []func(string, func(*gorm.DB)) error{
+		callback.Create().After("gorm:create").Register,
+		callback.Update().After("gorm:update").Register,
+		callback.Delete().After("gorm:delete").Register,
+		callback.Query().After("gorm:query").Register,
+		callback.Row().After("gorm:row").Register,
+		callback.Raw().After("gorm:raw").Register,
+	} {
+		err := register("chroma:failover", checkFailover)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkFailover(db *gorm.DB) {
+	if isReadOnlyError(db.Error) {
+		triggerReconnect()
+	}
+}
+
+// triggerReconnect starts rebuilding the global pool unless a rebuild is
+// already in progress. The statement that hit the read-only error still fails;
+// callers retry it once the pool points at the new primary.
+func triggerReconnect() {
+	if !reconnecting.CompareAndSwap(false, true) {
+		return
+	}
+	log.Warn("write handle is connected to a read-only server, reconnecting")
+	go func() {
+		defer reconnecting.Store(false)
+		reconnectPostgres()
+	}()
+}
+
+// reconnectPostgres opens a new pool and swaps it in once it reaches a
+// primary. Opening new connections resolves the database address again, so a
+// DNS name or service endpoint that was moved to the promoted replica is
+// followed without restarting the process.
+func reconnectPostgres() {
+	cfg := getGlobalDBConfig()
+	if cfg == nil {
+		return
+	}
+
+	backoff := reconnectInitialBackoff
+	for attempt := 1; attempt <= reconnectMaxAttempts; attempt++ {
+		db, err := openPostgres(*cfg, true)
+		if err == nil {
+			var inRecovery bool
+			err = db.Raw("SELECT pg_is_in_recovery()").Scan(&inRecovery).Error
+			if err == nil && !inRecovery {
+				old, readDB := getGlobalDB(), getGlobalReadDB()
+				if readDB == old {
+					// reads shared the old pool
+					readDB = db
+				}
+				setGlobalDB(db, readDB, cfg)
+				closeDB(old)
+				reconnectBackgroundPool(*cfg)
+				log.Info("reconnected to primary", zap.String("host", cfg.Address), zap.Int("attempt", attempt))
+				return
+			}
+			if err == nil {
+				log.Warn("database is still in recovery", zap.String("host", cfg.Address), zap.Int("attempt", attempt))
+			}
+			closeDB(db)
+		}
+		if err != nil {
+			log.Error("reconnect failed", zap.String("host", cfg.Address), zap.Int("attempt", attempt), zap.Error(err))
+		}
+		time.Sleep(backoff)
+		backoff = min(2*backoff, reconnectMaxBackoff)
+	}
+	log.Error("giving up reconnecting to primary", zap.String("host", cfg.Address))
+}
+
+// closeDB closes the pool behind db. Queries already running on it are
+// allowed to finish.
+func closeDB(db *gorm.DB) {
+	if db == nil {
+		return
+	}
+	idb, err := db.DB()
+	if err != nil {
+		return
+	}
+	err = idb.Close()
+	if err != nil {
+		log.Error("close db failed", zap.Error(err))
+	}
+}
@@ -0,0 +1,19 @@
+package dbcore
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsReadOnlyError(t *testing.T) {
+	readOnly := &pgconn.PgError{Code: readOnlySQLTransaction, Message: "cannot execute INSERT in a read-only transaction"}
+	assert.True(t, isReadOnlyError(readOnly))
+	assert.True(t, isReadOnlyError(fmt.Errorf("insert collection: %w", readOnly)))
+	assert.False(t, isReadOnlyError(&pgconn.PgError{Code: "23505"}))
+	assert.False(t, isReadOnlyError(errors.New("connection refused")))
+	assert.False(t, isReadOnlyError(nil))
+}
@@ -0,0 +1,36 @@
+package dbcore
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// inFlightWriteTx counts the write transactions currently open on the primary.
+var inFlightWriteTx atomic.Int64
+
+// WriteLoad describes how busy the primary pool is.
+type WriteLoad struct {
+	InFlightWriteTx int64
+	// WaitCount and WaitDuration are the cumulative number of connection
+	// acquisitions that had to wait for a free connection and the total time
+	// they waited.
+	WaitCount    int64
+	WaitDuration time.Duration
+}
+
+// GetWriteLoad returns the current load of the primary pool.
+func GetWriteLoad() WriteLoad {
+	load := WriteLoad{InFlightWriteTx: inFlightWriteTx.Load()}
+	db := getGlobalDB()
+	if db == nil {
+		return load
+	}
+	idb, err := db.DB()
+	if err != nil {
+		return load
+	}
+	stats := idb.Stats()
+	load.WaitCount = stats.WaitCount
+	load.WaitDuration = stats.WaitDuration
+	return load
+}
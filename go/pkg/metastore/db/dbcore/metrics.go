@@ -0,0 +1,72 @@
+package dbcore
+
+import (
+	"time"
+
+	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const statementStartKey = "chroma:statement_start"
+
+// registerMetricsCallbacks records the latency of every statement run on db by
+// table and operation. The duration is recorded with the statement context, so
+// a meter provider with exemplars enabled links slow statements to the trace
+// of the request that ran them.
+func registerMetricsCallbacks(db *gorm.DB) error {
+	duration, err := otel.Meter("chroma.sysdb").Float64Histogram("sysdb.dao.duration",
+		metric.WithDescription("Duration of sysdb statements by table and operation"),
+		metric.WithUnit("ms"))
+	if err != nil {
+		return err
+	}
+	before := func(db *gorm.DB) {
+		db.InstanceSet(statementStartKey, time.Now())
+	}
+	after := func(operation string) func(*gorm.DB) {
+		return func(db *gorm.DB) {
+			start, ok := db.InstanceGet(statementStartKey)
+			if !ok {
+				return
+			}
+			status := "ok"
+			if db.Error != nil && db.Error != gorm.ErrRecordNotFound {
+				status = "error"
+			}
+			duration.Record(db.Statement.Context, float64(time.Since(start.(time.Time)).Microseconds())/1000, metric.WithAttributes(
+				attribute.String("table", db.Statement.Table),
+				attribute.String("operation", operation),
+				attribute.String("status", status),
+			))
+		}
+	}
+
+	callback := db.Callback()
+	for _, processor := range []struct {
+		operation string
+		before    func(string, func(*gorm.DB)) error
+		after     func(string, func(*gorm.DB)) error
+	}{
+		{"create", callback.Create().Before("gorm:create").Register, callback.Create().After("gorm:create").Register},
+		{"query", callback.Query().Before("gorm:query").Register, callback.Query().After("gorm:query").Register},
+		{"update", callback.Update().Before("gorm:update").Register, callback.Update().After("gorm:update").Register},
+		{"delete", callback.Delete().Before("gorm:delete").Register, callback.Delete().After("gorm:delete").Register},
+		{"row", callback.Row().Before("gorm:row").Register, callback.Row().After("gorm:row").Register},
+		{"raw", callback.Raw().Before("gorm:raw").Register, callback.Raw().After("gorm:raw").Register},
+	} {
+		err = processor.before("chroma:metrics_start", before)
+		if err != nil {
+			return err
+		}
+		err = processor.after("chroma:metrics_end", after(processor.operation))
+		if err != nil {
+			log.Error("register metrics callback failed", zap.String("operation", processor.operation), zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package dbcore
+
+import (
+	"context"
+
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type ctxBackgroundKey struct{}
+
+// WithBackgroundPool marks ctx as belonging to a background job. Queries and
+// transactions started with it use the background pool when one is
+// configured.
+func WithBackgroundPool(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctxBackgroundKey{}, true)
+}
+
+func isBackground(ctx context.Context) bool {
+	background, _ := ctx.Value(ctxBackgroundKey{}).(bool)
+	return background
+}
+
+func backgroundConfig(cfg DBConfig) DBConfig {
+	cfg.MaxOpenConns = cfg.BackgroundMaxOpenConns
+	cfg.MaxIdleConns = min(cfg.MaxIdleConns, cfg.BackgroundMaxOpenConns)
+	return cfg
+}
+
+func getGlobalBackgroundDB() *gorm.DB {
+	globalDBMu.RLock()
+	defer globalDBMu.RUnlock()
+	return globalBackgroundDB
+}
+
+func setGlobalBackgroundDB(db *gorm.DB) {
+	globalDBMu.Lock()
+	defer globalDBMu.Unlock()
+	globalBackgroundDB = db
+}
+
+// writeDB returns the primary pool that serves ctx.
+func writeDB(ctx context.Context) *gorm.DB {
+	if isBackground(ctx) {
+		if db := getGlobalBackgroundDB(); db != nil {
+			return db
+		}
+	}
+	return getGlobalDB()
+}
+
+// reconnectBackgroundPool points the background pool at the new primary after
+// the request pool was rebuilt.
+func reconnectBackgroundPool(cfg DBConfig) {
+	old := getGlobalBackgroundDB()
+	if old == nil {
+		return
+	}
+	db, err := openPostgres(backgroundConfig(cfg), true)
+	if err != nil {
+		// keep the old pool, its next read-only error reconnects again
+		log.Error("reconnect background pool failed", zap.Error(err))
+		return
+	}
+	setGlobalBackgroundDB(db)
+	closeDB(old)
+}
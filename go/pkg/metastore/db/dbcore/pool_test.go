@@ -0,0 +1,17 @@
+package dbcore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackgroundConfig(t *testing.T) {
+	cfg := backgroundConfig(DBConfig{MaxIdleConns: 10, MaxOpenConns: 10, BackgroundMaxOpenConns: 2})
+	assert.Equal(t, 2, cfg.MaxOpenConns)
+	assert.Equal(t, 2, cfg.MaxIdleConns)
+
+	assert.False(t, isBackground(context.Background()))
+	assert.True(t, isBackground(WithBackgroundPool(context.Background())))
+}
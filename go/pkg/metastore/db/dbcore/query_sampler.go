@@ -0,0 +1,138 @@
+package dbcore
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"gorm.io/gorm"
+)
+
+const defaultQuerySampleBufferSize = 256
+
+type ctxQuerySampleKey struct{}
+
+// querySamples is a ring buffer of the statements run by sampled calls.
+var querySamples = &querySampleBuffer{samples: make([]model.QuerySample, 0, defaultQuerySampleBufferSize), size: defaultQuerySampleBufferSize}
+
+type querySampleBuffer struct {
+	mu      sync.Mutex
+	samples []model.QuerySample
+	size    int
+	next    int
+}
+
+func (b *querySampleBuffer) add(sample model.QuerySample) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.samples) < b.size {
+		b.samples = append(b.samples, sample)
+		return
+	}
+	b.samples[b.next] = sample
+	b.next = (b.next + 1) % b.size
+}
+
+func (b *querySampleBuffer) list() []model.QuerySample {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	samples := make([]model.QuerySample, 0, len(b.samples))
+	samples = append(samples, b.samples[b.next:]...)
+	return append(samples, b.samples[:b.next]...)
+}
+
+func (b *querySampleBuffer) resize(size int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.samples = make([]model.QuerySample, 0, size)
+	b.size = size
+	b.next = 0
+}
+
+// SetQuerySampleBufferSize sets how many samples are kept and drops the current
+// ones.
+func SetQuerySampleBufferSize(size int) {
+	if size <= 0 {
+		size = defaultQuerySampleBufferSize
+	}
+	querySamples.resize(size)
+}
+
+// WithQuerySample marks ctx so that every statement run with it is recorded
+// under operation.
+func WithQuerySample(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, ctxQuerySampleKey{}, operation)
+}
+
+// GetQuerySamples returns the recorded samples, oldest first.
+func GetQuerySamples() []model.QuerySample {
+	return querySamples.list()
+}
+
+// registerQuerySampleCallbacks records the statements of sampled calls. It
+// relies on the start time set by the metrics callbacks.
+func registerQuerySampleCallbacks(db *gorm.DB) error {
+	callback := db.Callback()
+	for _, register := range []func(string, func(*gorm.DB)) error{
+		callback.Query().After("gorm:query").Register,
+		callback.Row().After("gorm:row").Register,
+		callback.Raw().After("gorm:raw").Register,
+	} {
+		err := register("chroma:query_sample", recordQuerySample)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func recordQuerySample(db *gorm.DB) {
+	if db.Statement.Context == nil {
+		return
+	}
+	operation, ok := db.Statement.Context.Value(ctxQuerySampleKey{}).(string)
+	if !ok {
+		return
+	}
+	sample := model.QuerySample{
+		Operation: operation,
+		SQL:       db.Statement.SQL.String(),
+		Params:    sanitizeQueryParams(db.Statement.Vars),
+		Rows:      db.Statement.RowsAffected,
+	}
+	if start, ok := db.InstanceGet(statementStartKey); ok {
+		sample.StartedAt = start.(time.Time).UTC()
+		sample.Duration = time.Since(sample.StartedAt)
+	}
+	if db.Error != nil {
+		sample.Error = db.Error.Error()
+	}
+	querySamples.add(sample)
+}
+
+// sanitizeQueryParams keeps the values that cannot carry user data, such as
+// names or metadata, and replaces the others by their type.
+func sanitizeQueryParams(vars []interface{}) []string {
+	params := make([]string, 0, len(vars))
+	for _, v := range vars {
+		switch v := v.(type) {
+		case nil:
+			params = append(params, "NULL")
+		case bool, int, int32, int64, uint, uint32, uint64, float32, float64:
+			params = append(params, fmt.Sprint(v))
+		case *int32:
+			if v == nil {
+				params = append(params, "NULL")
+			} else {
+				params = append(params, fmt.Sprint(*v))
+			}
+		case time.Time:
+			params = append(params, v.UTC().Format(time.RFC3339Nano))
+		default:
+			params = append(params, fmt.Sprintf("<%T>", v))
+		}
+	}
+	return params
+}
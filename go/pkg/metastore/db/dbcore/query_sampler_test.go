@@ -0,0 +1,25 @@
+package dbcore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	"github.com/chroma-core/chroma/go/pkg/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuerySampleBuffer(t *testing.T) {
+	buffer := &querySampleBuffer{size: 2}
+	buffer.add(model.QuerySample{SQL: "1"})
+	buffer.add(model.QuerySample{SQL: "2"})
+	buffer.add(model.QuerySample{SQL: "3"})
+	assert.Equal(t, []model.QuerySample{{SQL: "2"}, {SQL: "3"}}, buffer.list())
+}
+
+func TestSanitizeQueryParams(t *testing.T) {
+	limit := int32(10)
+	at := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+	params := sanitizeQueryParams([]interface{}{"my_collection", dbmodel.CollectionID("00000000-0000-0000-0000-000000000001"), int64(3), &limit, true, nil, at})
+	assert.Equal(t, []string{"<string>", "<dbmodel.CollectionID>", "3", "10", "true", "NULL", "2026-10-14T12:00:00Z"}, params)
+}
@@ -0,0 +1,119 @@
+package dbcore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	// invalidParameterValue is the SQLSTATE returned by SET TRANSACTION
+	// SNAPSHOT for a snapshot whose exporting transaction has ended.
+	invalidParameterValue = "22023"
+)
+
+// snapshotIDPattern matches the identifiers returned by pg_export_snapshot,
+// which are interpolated into SET TRANSACTION SNAPSHOT since it does not take
+// bind parameters.
+var snapshotIDPattern = regexp.MustCompile(`^[0-9A-Fa-f]+(-[0-9A-Fa-f]+)+$`)
+
+type heldSnapshot struct {
+	tx    *gorm.DB
+	ttl   time.Duration
+	timer *time.Timer
+}
+
+var (
+	heldSnapshotsMu sync.Mutex
+	heldSnapshots   = map[string]*heldSnapshot{}
+)
+
+// HoldSnapshot exports the snapshot of a read-only repeatable read
+// transaction on the primary and keeps that transaction open, so that other
+// transactions can import the snapshot with WithSnapshotTx. The transaction
+// is rolled back once the snapshot has not been imported on this process for
+// ttl. Until then it holds a connection of the write pool and keeps vacuum
+// from removing the rows it can see.
+func (*txImpl) HoldSnapshot(ctx context.Context, ttl time.Duration) (string, error) {
+	tx := writeDB(ctx).WithContext(context.WithoutCancel(ctx)).Begin(&sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if tx.Error != nil {
+		return "", tx.Error
+	}
+	var snapshotID string
+	if err := tx.Raw("SELECT pg_export_snapshot()").Scan(&snapshotID).Error; err != nil {
+		tx.Rollback()
+		return "", err
+	}
+	held := &heldSnapshot{tx: tx, ttl: ttl}
+	heldSnapshotsMu.Lock()
+	heldSnapshots[snapshotID] = held
+	held.timer = time.AfterFunc(ttl, func() { releaseSnapshot(snapshotID) })
+	heldSnapshotsMu.Unlock()
+	return snapshotID, nil
+}
+
+// WithSnapshotTx runs fn in a read-only repeatable read transaction on the
+// primary that sees the snapshot exported by HoldSnapshot. A snapshot that is
+// no longer held, by this or any other process, is reported as
+// common.ErrScanSnapshotExpired.
+func (*txImpl) WithSnapshotTx(ctx context.Context, snapshotID string, fn func(txCtx context.Context) error) error {
+	if !snapshotIDPattern.MatchString(snapshotID) {
+		return common.ErrScanSnapshotExpired
+	}
+	heldSnapshotsMu.Lock()
+	if held, ok := heldSnapshots[snapshotID]; ok {
+		held.timer.Reset(held.ttl)
+	}
+	heldSnapshotsMu.Unlock()
+
+	var timeout time.Duration
+	if cfg := getGlobalDBConfig(); cfg != nil {
+		timeout = cfg.ReadTimeout
+	}
+	err := writeDB(ctx).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// SET TRANSACTION SNAPSHOT must be the first statement of the
+		// transaction and does not take bind parameters
+		if err := tx.Exec(fmt.Sprintf("SET TRANSACTION SNAPSHOT '%s'", snapshotID)).Error; err != nil {
+			return err
+		}
+		if timeout > 0 {
+			err := tx.Exec(fmt.Sprintf("SET LOCAL statement_timeout = %d", timeout.Milliseconds())).Error
+			if err != nil {
+				return err
+			}
+		}
+		return fn(CtxWithTransaction(ctx, tx))
+	}, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if isInvalidSnapshotError(err) {
+		return common.ErrScanSnapshotExpired
+	}
+	return err
+}
+
+func releaseSnapshot(snapshotID string) {
+	heldSnapshotsMu.Lock()
+	held, ok := heldSnapshots[snapshotID]
+	delete(heldSnapshots, snapshotID)
+	heldSnapshotsMu.Unlock()
+	if !ok {
+		return
+	}
+	if err := held.tx.Rollback().Error; err != nil {
+		log.Warn("failed to release held snapshot", zap.String("snapshot", snapshotID), zap.Error(err))
+	}
+}
+
+func isInvalidSnapshotError(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == invalidParameterValue
+}
@@ -0,0 +1,29 @@
+package dbcore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotIDPattern(t *testing.T) {
+	assert.True(t, snapshotIDPattern.MatchString("00000003-0000001B-1"))
+	for _, id := range []string{"", "00000003", "00000003-'; DROP TABLE collections; --", "00000003-0000001B-1'"} {
+		assert.False(t, snapshotIDPattern.MatchString(id), id)
+		err := (&txImpl{}).WithSnapshotTx(context.Background(), id, func(context.Context) error { return nil })
+		assert.ErrorIs(t, err, common.ErrScanSnapshotExpired)
+	}
+}
+
+func TestIsInvalidSnapshotError(t *testing.T) {
+	invalid := &pgconn.PgError{Code: invalidParameterValue, Message: `invalid snapshot identifier: "00000003-0000001B-1"`}
+	assert.True(t, isInvalidSnapshotError(invalid))
+	assert.True(t, isInvalidSnapshotError(fmt.Errorf("scan collections: %w", invalid)))
+	assert.False(t, isInvalidSnapshotError(&pgconn.PgError{Code: readOnlySQLTransaction}))
+	assert.False(t, isInvalidSnapshotError(errors.New("connection refused")))
+}
@@ -0,0 +1,83 @@
+package dbcore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// WithReadTx runs fn in a read-only repeatable read transaction on the read
+// handle, so that every query of fn sees the same snapshot. Inside another
+// transaction fn runs in that transaction and sees its writes.
+func (*txImpl) WithReadTx(ctx context.Context, fn func(txCtx context.Context) error) error {
+	if inTransaction(ctx) {
+		return fn(ctx)
+	}
+	var timeout time.Duration
+	if cfg := getGlobalDBConfig(); cfg != nil {
+		timeout = cfg.ReadTimeout
+	}
+	db := getGlobalReadDB()
+	if isBackground(ctx) {
+		db = writeDB(ctx)
+	}
+	return runTx(ctx, db, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}, timeout, fn)
+}
+
+// WithWriteTx runs fn in a read committed transaction on the primary.
+func (t *txImpl) WithWriteTx(ctx context.Context, fn func(txCtx context.Context) error) error {
+	return t.WithWriteTxIsolation(ctx, sql.LevelDefault, fn)
+}
+
+// WithWriteTxIsolation runs fn in a transaction on the primary at the given
+// isolation level. Inside another transaction fn runs in a savepoint of that
+// transaction, at its isolation level. Serialization failures and deadlocks
+// are returned wrapping common.ErrTransactionConflict so that callers can
+// retry them.
+func (*txImpl) WithWriteTxIsolation(ctx context.Context, isolation sql.IsolationLevel, fn func(txCtx context.Context) error) error {
+	var err error
+	if inTransaction(ctx) {
+		err = GetDB(ctx).Transaction(func(tx *gorm.DB) error {
+			return fn(CtxWithTransaction(ctx, tx))
+		})
+	} else {
+		var timeout time.Duration
+		if cfg := getGlobalDBConfig(); cfg != nil {
+			timeout = cfg.WriteTimeout
+		}
+		inFlightWriteTx.Add(1)
+		defer inFlightWriteTx.Add(-1)
+		err = runTx(ctx, writeDB(ctx), &sql.TxOptions{Isolation: isolation}, timeout, fn)
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "40001", "40P01":
+			return fmt.Errorf("%w: %s", common.ErrTransactionConflict, pgErr.Message)
+		}
+	}
+	return err
+}
+
+func runTx(ctx context.Context, db *gorm.DB, opts *sql.TxOptions, timeout time.Duration, fn func(txCtx context.Context) error) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if timeout > 0 {
+			// SET does not take bind parameters
+			err := tx.Exec(fmt.Sprintf("SET LOCAL statement_timeout = %d", timeout.Milliseconds())).Error
+			if err != nil {
+				return err
+			}
+		}
+		return fn(CtxWithTransaction(ctx, tx))
+	}, opts)
+}
+
+func inTransaction(ctx context.Context) bool {
+	return ctx.Value(ctxTransactionKey{}) != nil
+}
@@ -0,0 +1,56 @@
+package dbmodel
+
+import (
+	"time"
+)
+
+// ArchivedCollection is an append-only copy of a collection row and its metadata
+// taken right before the collection is hard deleted.
+type ArchivedCollection struct {
+	ID           int64        `gorm:"id;primaryKey;autoIncrement"`
+	CollectionID CollectionID `gorm:"collection_id;type:uuid;index:idx_archived_collection_id"`
+	DatabaseID   DatabaseID   `gorm:"database_id;type:uuid"`
+	Record       string       `gorm:"record;type:jsonb;not null"`
+	Reason       string       `gorm:"reason"`
+	Actor        string       `gorm:"actor"`
+	ArchivedAt   time.Time    `gorm:"archived_at;type:timestamptz;not null;default:current_timestamp"`
+
+	Cluster
+}
+
+func (v ArchivedCollection) TableName() string {
+	return "archived_collections"
+}
+
+// ArchivedSegment is an append-only copy of a segment row and its metadata taken
+// right before the segment is hard deleted.
+type ArchivedSegment struct {
+	ID           int64         `gorm:"id;primaryKey;autoIncrement"`
+	SegmentID    SegmentID     `gorm:"segment_id;type:uuid;index:idx_archived_segment_id"`
+	CollectionID *CollectionID `gorm:"collection_id;type:uuid"`
+	Record       string        `gorm:"record;type:jsonb;not null"`
+	Reason       string        `gorm:"reason"`
+	Actor        string        `gorm:"actor"`
+	ArchivedAt   time.Time     `gorm:"archived_at;type:timestamptz;not null;default:current_timestamp"`
+
+	Cluster
+}
+
+func (v ArchivedSegment) TableName() string {
+	return "archived_segments"
+}
+
+const (
+	ArchiveReasonDeleteCollection  = "delete_collection"
+	ArchiveReasonDeleteSegment     = "delete_segment"
+	ArchiveReasonForceDeleteTenant = "force_delete_tenant"
+	ArchiveReasonRepairIncomplete  = "repair_incomplete_collection"
+	ArchiveReasonSoftDeleteExpired = "soft_delete_expired"
+)
+
+//go:generate mockery --name=IArchiveDb
+type IArchiveDb interface {
+	ArchiveCollection(collection *CollectionAndMetadata, reason string, actor string) error
+	ArchiveSegment(segment *SegmentAndMetadata, reason string, actor string) error
+	GetArchivedCollections(collectionID CollectionID) ([]*ArchivedCollection, error)
+}
@@ -0,0 +1,33 @@
+package dbmodel
+
+import "time"
+
+// CleanupTaskPurge is the purge of a soft deleted collection by the cleaner.
+const CleanupTaskPurge = "purge"
+
+// CleanupDeadLetter counts the failed attempts of a background cleanup task on
+// a collection. Once Attempts reaches the limit of the task the entry is dead
+// lettered and the task skips the collection until the entry is deleted.
+type CleanupDeadLetter struct {
+	ClusterID     string       `gorm:"cluster_id;primaryKey;type:text;default:''"`
+	CollectionID  CollectionID `gorm:"collection_id;primaryKey;type:uuid"`
+	Task          string       `gorm:"task;primaryKey;type:text"`
+	TenantID      string       `gorm:"tenant_id;type:text;not null;default:''"`
+	Attempts      int32        `gorm:"attempts;type:integer;not null"`
+	LastError     string       `gorm:"last_error;type:text;not null"`
+	FirstFailedAt time.Time    `gorm:"first_failed_at;type:timestamptz;not null"`
+	LastFailedAt  time.Time    `gorm:"last_failed_at;type:timestamptz;not null"`
+	DeadLettered  bool         `gorm:"dead_lettered;type:bool;not null;default:false"`
+}
+
+func (v CleanupDeadLetter) TableName() string {
+	return "cleanup_dead_letters"
+}
+
+//go:generate mockery --name=ICleanupDeadLetterDb
+type ICleanupDeadLetterDb interface {
+	RecordFailure(collectionID CollectionID, task string, tenantID string, lastError string, failedAt time.Time, maxAttempts int32) (*CleanupDeadLetter, error)
+	List(task string, deadLetteredOnly bool) ([]*CleanupDeadLetter, error)
+	Delete(collectionID CollectionID, task string) (int64, error)
+	DeleteAll() error
+}
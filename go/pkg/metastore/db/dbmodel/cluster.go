@@ -0,0 +1,46 @@
+package dbmodel
+
+// Cluster is embedded by the tables whose rows are keyed by generated IDs, so
+// that several logical clusters can share one database. Tables keyed by names
+// the clusters choose, such as tenants, have a ClusterID field in their primary
+// key instead. The DAOs do not set ClusterID: every statement is scoped to the
+// cluster of the sysdb by the callbacks of dbcore.
+type Cluster struct {
+	ClusterID string `gorm:"cluster_id;type:text;not null;default:''"`
+}
+
+// ClusterTables are the tables that have a cluster_id column, for the statements
+// that name their table without a model.
+var ClusterTables = []string{
+	"archived_collections",
+	"archived_segments",
+	"cleanup_dead_letters",
+	"collections",
+	"collection_acls",
+	"collection_lifecycle_counts",
+	"collection_log_routes",
+	"collection_metadata",
+	"collection_metadata_history",
+	"collection_schema_fields",
+	"collection_size_history",
+	"collection_stats",
+	"collection_templates",
+	"compaction_requests",
+	"databases",
+	"database_quotas",
+	"embedding_functions",
+	"feature_flags",
+	"jobs",
+	"leases",
+	"maintenance_modes",
+	"node_decommissions",
+	"node_heartbeats",
+	"notifications",
+	"segments",
+	"segment_files",
+	"segment_metadata",
+	"tenants",
+	"tenant_quotas",
+	"tenant_regions",
+	"usage_counters",
+}
@@ -1,28 +1,92 @@
 package dbmodel
 
 import (
+	"strings"
 	"time"
 
+	"github.com/chroma-core/chroma/go/pkg/model"
 	"github.com/chroma-core/chroma/go/pkg/types"
 )
 
 type Collection struct {
-	ID          string          `gorm:"id;primaryKey"`
+	ID          CollectionID    `gorm:"id;primaryKey;type:uuid"`
 	Name        *string         `gorm:"name;index:idx_name,unique;"`
-	Dimension   *int32          `gorm:"dimension"`
-	DatabaseID  string          `gorm:"database_id;index:idx_name,unique;"`
+	Dimension   *int32          `gorm:"dimension;index:idx_distance_function_dimension,priority:2"`
+	DatabaseID  DatabaseID      `gorm:"database_id;type:uuid;index:idx_name,unique;index:idx_database_total_records,priority:1;index:idx_database_size_bytes,priority:1"`
 	Ts          types.Timestamp `gorm:"ts;type:bigint;default:0"`
-	IsDeleted   bool            `gorm:"is_deleted;type:bool;default:false"`
-	CreatedAt   time.Time       `gorm:"created_at;type:timestamp;not null;default:current_timestamp"`
-	UpdatedAt   time.Time       `gorm:"updated_at;type:timestamp;not null;default:current_timestamp"`
+	CreatedAt   time.Time       `gorm:"created_at;type:timestamptz;not null;default:current_timestamp"`
+	UpdatedAt   time.Time       `gorm:"updated_at;type:timestamptz;not null;default:current_timestamp"`
 	LogPosition int64           `gorm:"log_position;default:0"`
 	Version     int32           `gorm:"version;default:0"`
+	// Revision is bumped by the updates of the collection by clients, Version
+	// by its compactions.
+	Revision int64 `gorm:"revision;not null;default:0"`
+
+	TotalRecordsPostCompaction int64 `gorm:"total_records_post_compaction;default:0;index:idx_database_total_records,priority:2,sort:desc"`
+	SizeBytesPostCompaction    int64 `gorm:"size_bytes_post_compaction;default:0;index:idx_database_size_bytes,priority:2,sort:desc"`
+
+	// FencingToken is bumped every time a node takes ownership of the collection.
+	FencingToken int64   `gorm:"fencing_token;default:0"`
+	FencingOwner *string `gorm:"fencing_owner"`
+
+	// State is one of the model.CollectionState values.
+	State string `gorm:"state;type:text;not null;default:ready"`
+
+	// ReindexPending is set when the dimension or embedding function changed and
+	// the records have not been reindexed yet. ReindexFromDimension is the
+	// dimension of the records before the first change.
+	ReindexPending       bool   `gorm:"reindex_pending;type:bool;not null;default:false"`
+	ReindexFromDimension *int32 `gorm:"reindex_from_dimension"`
+
+	// KmsKeyID is the customer managed key the segment files of the collection are
+	// encrypted with. It is taken from the tenant when the collection is created.
+	KmsKeyID *string `gorm:"kms_key_id;type:text"`
+
+	// ReplicationFactor is the number of query nodes the collection is assigned
+	// to.
+	ReplicationFactor int32 `gorm:"replication_factor;type:integer;not null;default:1"`
+
+	// DistanceFunction is one of the HNSW spaces, l2, cosine or ip. It duplicates
+	// the hnsw:space metadata so that collections can be filtered by it.
+	DistanceFunction string `gorm:"distance_function;type:text;not null;default:l2;index:idx_distance_function_dimension,priority:1"`
+
+	// EmbeddingFunctionID references the embedding function of the tenant the
+	// collection uses, if it is registered.
+	EmbeddingFunctionID *string `gorm:"embedding_function_id;type:uuid;index:idx_embedding_function_id"`
+
+	// TemplateID is the template the collection was created from, cleared when
+	// the template is deleted.
+	TemplateID *string `gorm:"template_id;type:uuid;index:idx_template_id"`
+
+	// TenantID duplicates the tenant of the database so that the table can be
+	// hash partitioned by tenant. The DAO fills it in on insert when it is empty.
+	TenantID string `gorm:"tenant_id;type:text;not null;default:''"`
+
+	// LastCompactor is the compactor instance that flushed the last compaction of
+	// the collection, a hint for schedulers that prefer the node that has the
+	// segment files of the collection cached.
+	LastCompactor   *string    `gorm:"last_compactor;type:text"`
+	LastCompactedAt *time.Time `gorm:"last_compacted_at;type:timestamptz"`
+
+	SoftDelete
+	Cluster
 }
 
 func (v Collection) TableName() string {
 	return "collections"
 }
 
+// DeletedCollectionNameInfix joins the name of a soft deleted collection and its
+// ID. Soft deleted collections are renamed so that their name can be reused
+// right away.
+const DeletedCollectionNameInfix = "__deleted__"
+
+// OriginalCollectionName returns the name a soft deleted collection had before
+// it was deleted. Names without the suffix are returned as is.
+func OriginalCollectionName(name string, collectionID CollectionID) string {
+	return strings.TrimSuffix(name, DeletedCollectionNameInfix+collectionID.String())
+}
+
 type CollectionAndMetadata struct {
 	Collection         *Collection
 	CollectionMetadata []*CollectionMetadata
@@ -30,12 +94,92 @@ type CollectionAndMetadata struct {
 	DatabaseName       string
 }
 
+// InconsistentCollection is a collection found by GetInconsistentCollections.
+// SegmentIDs and Scopes are the segments involved in the problem, except for a
+// collection missing a required segment where Scopes are those of its segments.
+type InconsistentCollection struct {
+	Collection   *Collection
+	TenantID     string
+	DatabaseName string
+	Kind         model.InconsistencyKind
+	SegmentIDs   []SegmentID
+	Scopes       []string
+}
+
+// CollectionSizeChange is how much UpdateCollectionSize changed the post
+// compaction size of a collection.
+type CollectionSizeChange struct {
+	DatabaseID DatabaseID
+	Records    int64
+	SizeBytes  int64
+}
+
+// CollectionTemplateUsage is the post compaction size of a collection with the
+// limits of the template it was created from. The limits are nil when the
+// collection has no template.
+type CollectionTemplateUsage struct {
+	TemplateID                 *string
+	MaxRecords                 *int64
+	MaxSizeBytes               *int64
+	TotalRecordsPostCompaction int64
+	SizeBytesPostCompaction    int64
+}
+
 //go:generate mockery --name=ICollectionDb
 type ICollectionDb interface {
-	GetCollections(collectionID *string, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32) ([]*CollectionAndMetadata, error)
-	DeleteCollectionByID(collectionID string) (int, error)
+	GetCollections(collectionID *CollectionID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32, metadataFilters []*model.CollectionMetadataFilter) ([]*CollectionAndMetadata, error)
+	// GetCollectionsWithoutMetadata leaves CollectionMetadata nil, which saves
+	// a query per collection.
+	GetCollectionsWithoutMetadata(collectionID *CollectionID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32, metadataFilters []*model.CollectionMetadataFilter) ([]*CollectionAndMetadata, error)
+	CountCollectionsByDatabaseID(databaseID DatabaseID) (int64, error)
+	// CountCollections counts the collections of a tenant, or of one of its
+	// databases when databaseName is set. includeSoftDeleted counts the soft
+	// deleted collections and the collections of soft deleted databases too.
+	CountCollections(tenantID string, databaseName string, includeSoftDeleted bool) (int64, error)
+	// CountCollectionsByEmbeddingFunctionID counts the soft deleted collections
+	// too, which get the embedding function back when they are restored.
+	CountCollectionsByEmbeddingFunctionID(embeddingFunctionID string) (int64, error)
+	// ClearTemplateID detaches the collections, soft deleted ones included,
+	// from a deleted template.
+	ClearTemplateID(templateID string) (int64, error)
+	// ClearEmbeddingFunctionID detaches a collection from its embedding function.
+	ClearEmbeddingFunctionID(collectionID CollectionID) error
+	DeleteCollectionByID(collectionID CollectionID) (int, error)
+	SoftDeleteCollectionByID(collectionID CollectionID, actor string) (int64, error)
 	Insert(in *Collection) error
 	Update(in *Collection) error
+	GetForUpdate(collectionID CollectionID) (*Collection, error)
+	// UpdateCollectionName returns 0 when the collection does not exist or is
+	// soft deleted, and a *common.CollectionAlreadyExistsError when another
+	// collection of its database has name.
+	UpdateCollectionName(collectionID CollectionID, name string) (int64, error)
 	DeleteAll() error
-	UpdateLogPositionAndVersion(collectionID string, logPosition int64, currentCollectionVersion int32) (int32, error)
+	UpdateLogPositionAndVersion(collectionID CollectionID, logPosition int64, currentCollectionVersion int32) (int32, int64, error)
+	AcquireFencingToken(collectionID CollectionID, owner string) (int64, error)
+	CheckFencingToken(collectionID CollectionID, fencingToken int64) error
+	UpdateCollectionSize(collectionID CollectionID, totalRecordsPostCompaction *int64, sizeBytesPostCompaction *int64) (*CollectionSizeChange, error)
+	// GetTemplateUsage returns nil when the live collection does not exist in
+	// the tenant.
+	GetTemplateUsage(collectionID CollectionID, tenantID string) (*CollectionTemplateUsage, error)
+	GetCollectionsBySize(tenantID string, databaseName string, orderBy model.CollectionSizeOrderBy, limit int32) ([]*Collection, error)
+	GetApproximateCollectionCount() (int64, error)
+	GetApproximateDatabaseCollectionCount(databaseID DatabaseID) (int64, error)
+	GetCollectionsWithoutSegments(createdBefore time.Time) ([]*CollectionAndMetadata, error)
+	GetInconsistentCollections(deletedBefore time.Time, requiredScopes []string, kinds []model.InconsistencyKind) ([]*InconsistentCollection, error)
+	GetCollectionsToGc(updatedBefore time.Time, tenantID *string, minVersions int32, startAfter *CollectionID, limit int32) ([]*Collection, error)
+	// ScanCollections returns the next limit live collections after startAfter,
+	// by ID, with their metadata.
+	ScanCollections(startAfter *CollectionID, limit int32) ([]*CollectionAndMetadata, error)
+	UpdateState(collectionID CollectionID, fromStates []string, toState string) (int64, error)
+	MarkReindexPending(collectionID CollectionID, fromDimension *int32) error
+	ClearReindexPending(collectionID CollectionID, dimension *int32) (int64, error)
+	GetCollectionsByIDs(collectionIDs []CollectionID) ([]*Collection, error)
+	GetLiveCollectionsByIDs(collectionIDs []CollectionID, withMetadata bool) ([]*CollectionAndMetadata, error)
+	UpdateLastCompactor(collectionID CollectionID, compactor string) error
+	GetDeletedCollection(collectionID CollectionID) (*Collection, error)
+	GetDeletedCollectionIDs(deletedBefore time.Time, limit int32) ([]CollectionID, error)
+	// GetDeletedCollectionsByDatabaseID returns the soft deleted collections of
+	// a database.
+	GetDeletedCollectionsByDatabaseID(databaseID DatabaseID) ([]*Collection, error)
+	RestoreCollectionByID(collectionID CollectionID, name string, state string) (int64, error)
 }
@@ -0,0 +1,29 @@
+package dbmodel
+
+import "time"
+
+// CollectionAcl grants a principal a set of permissions on a collection.
+// Permissions is a bit set of model.CollectionPermission values.
+type CollectionAcl struct {
+	CollectionID CollectionID `gorm:"collection_id;primaryKey;type:uuid"`
+	Principal    string       `gorm:"principal;primaryKey;type:text"`
+	Permissions  int32        `gorm:"permissions;not null;default:0"`
+	TenantID     string       `gorm:"tenant_id;type:text;not null;default:''"`
+	CreatedAt    time.Time    `gorm:"created_at;type:timestamptz;not null;default:current_timestamp"`
+	UpdatedAt    time.Time    `gorm:"updated_at;type:timestamptz;not null;default:current_timestamp"`
+
+	Cluster
+}
+
+func (v CollectionAcl) TableName() string {
+	return "collection_acls"
+}
+
+//go:generate mockery --name=ICollectionAclDb
+type ICollectionAclDb interface {
+	Upsert(in *CollectionAcl) error
+	Delete(collectionID CollectionID, principal string) (int64, error)
+	DeleteByCollectionID(collectionID CollectionID) (int64, error)
+	GetByCollectionIDs(collectionIDs []CollectionID) ([]*CollectionAcl, error)
+	DeleteAll() error
+}
@@ -0,0 +1,45 @@
+package dbmodel
+
+import "time"
+
+// Collection lifecycle events counted in the daily rollup. Each is also the
+// name of its counter column.
+const (
+	LifecycleEventCreated  = "created"
+	LifecycleEventDeleted  = "deleted"
+	LifecycleEventRestored = "restored"
+	LifecycleEventPurged   = "purged"
+	// LifecycleEventCompacted counts the compactions flushed, and
+	// LifecycleEventRecordsCompacted the log records they compacted, i.e. the
+	// writes to the collections that reached their segments.
+	LifecycleEventCompacted        = "compacted"
+	LifecycleEventRecordsCompacted = "records_compacted"
+)
+
+var LifecycleEvents = []string{LifecycleEventCreated, LifecycleEventDeleted, LifecycleEventRestored, LifecycleEventPurged, LifecycleEventCompacted, LifecycleEventRecordsCompacted}
+
+// CollectionLifecycleCount is the number of collections of a tenant that went
+// through each lifecycle event on a UTC day, and the compaction activity of the
+// collections of the tenant on that day.
+type CollectionLifecycleCount struct {
+	ClusterID string    `gorm:"cluster_id;primaryKey;type:text;default:''"`
+	TenantID  string    `gorm:"tenant_id;primaryKey"`
+	Day       time.Time `gorm:"day;type:date;primaryKey"`
+	Created   int64     `gorm:"created;not null;default:0"`
+	Deleted   int64     `gorm:"deleted;not null;default:0"`
+	Restored  int64     `gorm:"restored;not null;default:0"`
+	Purged    int64     `gorm:"purged;not null;default:0"`
+
+	Compacted        int64 `gorm:"compacted;not null;default:0"`
+	RecordsCompacted int64 `gorm:"records_compacted;not null;default:0"`
+}
+
+func (v CollectionLifecycleCount) TableName() string {
+	return "collection_lifecycle_counts"
+}
+
+//go:generate mockery --name=ICollectionLifecycleDb
+type ICollectionLifecycleDb interface {
+	Increment(tenantID string, at time.Time, event string, count int64) error
+	GetCounts(tenantID string, from time.Time, to time.Time) ([]*CollectionLifecycleCount, error)
+}
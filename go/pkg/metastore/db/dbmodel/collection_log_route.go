@@ -0,0 +1,38 @@
+package dbmodel
+
+import "time"
+
+// CollectionLogRoute is the log instance serving a collection. Version is
+// incremented on every change so that frontends can tell stale cached routes
+// apart. An empty LogAddress routes the collection to the default log; the row
+// is kept so that pollers see the change.
+type CollectionLogRoute struct {
+	CollectionID CollectionID `gorm:"collection_id;primaryKey;type:uuid"`
+	LogAddress   string       `gorm:"log_address;type:text;not null;default:''"`
+	Version      int64        `gorm:"version;not null;default:0"`
+	UpdatedAt    time.Time    `gorm:"updated_at;type:timestamptz;not null;default:current_timestamp;index"`
+	// Revision orders the changes of all the routes. Every change takes the next
+	// value of the collection_log_route_revisions sequence and the changes
+	// commit in the order of their revision, so pollers page by it.
+	Revision int64 `gorm:"revision;not null;default:0;index"`
+
+	Cluster
+}
+
+func (v CollectionLogRoute) TableName() string {
+	return "collection_log_routes"
+}
+
+//go:generate mockery --name=ICollectionLogRouteDb
+type ICollectionLogRouteDb interface {
+	// Upsert sets the log address of the collection and returns the route with
+	// its new version.
+	Upsert(collectionID CollectionID, logAddress string) (*CollectionLogRoute, error)
+	GetByCollectionIDs(collectionIDs []CollectionID) ([]*CollectionLogRoute, error)
+	GetChangedAfter(revision int64) ([]*CollectionLogRoute, error)
+	// GetLastRevision returns the revision of the last committed change, 0
+	// when no route was ever changed.
+	GetLastRevision() (int64, error)
+	DeleteByCollectionID(collectionID CollectionID) (int64, error)
+	DeleteAll() error
+}
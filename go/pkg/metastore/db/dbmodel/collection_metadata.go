@@ -6,16 +6,24 @@ import (
 	"github.com/chroma-core/chroma/go/pkg/types"
 )
 
+// CollectionMetadata is a key of the metadata of a collection. The
+// (tenant_id, key, value) indexes serve the equality filters of GetCollections.
+// String values are unbounded, so their index is on md5(str_value), which
+// always fits in an index row.
 type CollectionMetadata struct {
-	CollectionID string          `gorm:"collection_id;primaryKey"`
-	Key          *string         `gorm:"key;primaryKey"`
-	StrValue     *string         `gorm:"str_value"`
-	IntValue     *int64          `gorm:"int_value"`
-	FloatValue   *float64        `gorm:"float_value"`
+	CollectionID CollectionID    `gorm:"collection_id;primaryKey;type:uuid"`
+	Key          *string         `gorm:"key;primaryKey;index:idx_collection_metadata_tenant_key_str_value,priority:2;index:idx_collection_metadata_tenant_key_int_value,priority:2;index:idx_collection_metadata_tenant_key_float_value,priority:2"`
+	StrValue     *string         `gorm:"str_value;index:idx_collection_metadata_tenant_key_str_value,priority:3,expression:md5(str_value)"`
+	IntValue     *int64          `gorm:"int_value;index:idx_collection_metadata_tenant_key_int_value,priority:3"`
+	FloatValue   *float64        `gorm:"float_value;index:idx_collection_metadata_tenant_key_float_value,priority:3"`
 	Ts           types.Timestamp `gorm:"ts;type:bigint;default:0"`
-	CreatedAt    time.Time       `gorm:"created_at;type:timestamp;not null;default:current_timestamp"`
-	UpdatedAt    time.Time       `gorm:"updated_at;type:timestamp;not null;default:current_timestamp"`
+	CreatedAt    time.Time       `gorm:"created_at;type:timestamptz;not null;default:current_timestamp"`
+	UpdatedAt    time.Time       `gorm:"updated_at;type:timestamptz;not null;default:current_timestamp"`
 	BoolValue    *bool           `gorm:"bool_value"`
+	// TenantID is the partition key, copied from the collection.
+	TenantID string `gorm:"tenant_id;type:text;not null;default:'';index:idx_collection_metadata_tenant_key_str_value,priority:1;index:idx_collection_metadata_tenant_key_int_value,priority:1;index:idx_collection_metadata_tenant_key_float_value,priority:1"`
+
+	Cluster
 }
 
 func (v CollectionMetadata) TableName() string {
@@ -24,7 +32,8 @@ func (v CollectionMetadata) TableName() string {
 
 //go:generate mockery --name=ICollectionMetadataDb
 type ICollectionMetadataDb interface {
-	DeleteByCollectionID(collectionID string) (int, error)
+	GetByCollectionID(collectionID CollectionID) ([]*CollectionMetadata, error)
+	DeleteByCollectionID(collectionID CollectionID) (int, error)
 	Insert(in []*CollectionMetadata) error
 	DeleteAll() error
 }
@@ -0,0 +1,32 @@
+package dbmodel
+
+import "time"
+
+// CollectionMetadataHistory is the metadata of a collection as of a revision of
+// the collection. A revision is only recorded when it changed the metadata, the
+// metadata at any other revision is the one of the latest recorded revision
+// before it. Metadata is the JSON of the metadata entries.
+type CollectionMetadataHistory struct {
+	ClusterID    string       `gorm:"cluster_id;primaryKey;type:text;default:''"`
+	CollectionID CollectionID `gorm:"collection_id;primaryKey;type:uuid"`
+	Revision     int64        `gorm:"revision;primaryKey;type:bigint"`
+	TenantID     string       `gorm:"tenant_id;type:text;not null"`
+	Metadata     string       `gorm:"metadata;type:jsonb;not null"`
+	CreatedAt    time.Time    `gorm:"created_at;type:timestamptz;not null;default:current_timestamp"`
+}
+
+func (v CollectionMetadataHistory) TableName() string {
+	return "collection_metadata_history"
+}
+
+//go:generate mockery --name=ICollectionMetadataHistoryDb
+type ICollectionMetadataHistoryDb interface {
+	// Record stores metadata as the metadata of a collection at revision. A
+	// revision that is already recorded keeps its metadata.
+	Record(collectionID CollectionID, tenantID string, revision int64, metadata []*CollectionMetadata) error
+	// GetAt returns the metadata of a collection at revision, false when no
+	// revision at or before it is recorded.
+	GetAt(collectionID CollectionID, revision int64) ([]*CollectionMetadata, bool, error)
+	DeleteByCollectionID(collectionID CollectionID) error
+	DeleteAll() error
+}
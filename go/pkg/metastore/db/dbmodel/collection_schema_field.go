@@ -0,0 +1,29 @@
+package dbmodel
+
+import "time"
+
+// CollectionSchemaField declares the type of a document metadata key of a
+// collection. Type is one of the model.CollectionSchemaFieldType values.
+type CollectionSchemaField struct {
+	ClusterID    string       `gorm:"cluster_id;primaryKey;type:text;default:''"`
+	CollectionID CollectionID `gorm:"collection_id;primaryKey;type:uuid"`
+	Name         string       `gorm:"name;primaryKey;type:text"`
+	Type         string       `gorm:"type;type:text;not null"`
+	Indexed      bool         `gorm:"indexed;type:bool;not null;default:false"`
+	TenantID     string       `gorm:"tenant_id;type:text;not null"`
+	CreatedAt    time.Time    `gorm:"created_at;type:timestamptz;not null;default:current_timestamp"`
+}
+
+func (v CollectionSchemaField) TableName() string {
+	return "collection_schema_fields"
+}
+
+//go:generate mockery --name=ICollectionSchemaFieldDb
+type ICollectionSchemaFieldDb interface {
+	// Replace deletes the fields of the collection and inserts fields instead.
+	Replace(collectionID CollectionID, tenantID string, fields []*CollectionSchemaField) error
+	// GetByCollectionID returns the fields of the collection ordered by name.
+	GetByCollectionID(collectionID CollectionID) ([]*CollectionSchemaField, error)
+	DeleteByCollectionID(collectionID CollectionID) (int64, error)
+	DeleteAll() error
+}
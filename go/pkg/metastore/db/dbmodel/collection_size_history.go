@@ -0,0 +1,30 @@
+package dbmodel
+
+import "time"
+
+// CollectionSizeHistory is the daily snapshot of the size of a collection. Day
+// is the UTC date of the snapshot.
+type CollectionSizeHistory struct {
+	ClusterID    string       `gorm:"cluster_id;primaryKey;type:text;default:''"`
+	CollectionID CollectionID `gorm:"collection_id;primaryKey;type:uuid"`
+	Day          time.Time    `gorm:"day;primaryKey;type:date;index:idx_collection_size_history_day"`
+	TenantID     string       `gorm:"tenant_id;type:text;not null"`
+	TotalRecords int64        `gorm:"total_records;type:bigint;not null;default:0"`
+	SizeBytes    int64        `gorm:"size_bytes;type:bigint;not null;default:0"`
+}
+
+func (v CollectionSizeHistory) TableName() string {
+	return "collection_size_history"
+}
+
+//go:generate mockery --name=ICollectionSizeHistoryDb
+type ICollectionSizeHistoryDb interface {
+	// Snapshot records the size of every live collection for day. Collections
+	// that already have a snapshot for day keep it.
+	Snapshot(day time.Time) error
+	// Get returns the snapshots of a collection from since on, oldest first.
+	Get(collectionID CollectionID, since time.Time) ([]*CollectionSizeHistory, error)
+	// DeleteBefore prunes the snapshots older than day.
+	DeleteBefore(day time.Time) error
+	DeleteAll() error
+}
@@ -0,0 +1,46 @@
+package dbmodel
+
+import "time"
+
+// CollectionStats is the rollup of a collection read by dashboards, refreshed
+// periodically from the collections, segments and log service so that their
+// aggregates are not computed on the hot tables. The log lag columns are nil
+// until the lag was read from the log service.
+type CollectionStats struct {
+	ClusterID           string       `gorm:"cluster_id;primaryKey;type:text;default:''"`
+	CollectionID        CollectionID `gorm:"collection_id;primaryKey;type:uuid"`
+	TenantID            string       `gorm:"tenant_id;type:text;not null;index:idx_collection_stats_database,priority:1"`
+	DatabaseName        string       `gorm:"database_name;type:text;not null;index:idx_collection_stats_database,priority:2"`
+	TotalRecords        int64        `gorm:"total_records;type:bigint;not null;default:0"`
+	SizeBytes           int64        `gorm:"size_bytes;type:bigint;not null;default:0"`
+	SegmentCount        int32        `gorm:"segment_count;type:integer;not null;default:0"`
+	LastCompactedAt     *time.Time   `gorm:"last_compacted_at;type:timestamptz"`
+	UncompactedRecords  *int64       `gorm:"uncompacted_records;type:bigint"`
+	OldestUncompactedAt *time.Time   `gorm:"oldest_uncompacted_at;type:timestamptz"`
+	RefreshedAt         time.Time    `gorm:"refreshed_at;type:timestamptz;not null"`
+}
+
+func (v CollectionStats) TableName() string {
+	return "collection_stats"
+}
+
+// CollectionLogLagStats is the log lag of a collection, as read from the log
+// service.
+type CollectionLogLagStats struct {
+	CollectionID        CollectionID
+	UncompactedRecords  int64
+	OldestUncompactedAt *time.Time
+}
+
+//go:generate mockery --name=ICollectionStatsDb
+type ICollectionStatsDb interface {
+	// Refresh rolls up every live collection as of refreshedAt, and drops the
+	// rows of the collections that were deleted since the last refresh.
+	Refresh(refreshedAt time.Time) error
+	UpdateLogLag(lags []*CollectionLogLagStats) error
+	GetCollectionIDs() ([]CollectionID, error)
+	// List returns the stats of a tenant, of a database of the tenant when
+	// databaseName is set, largest collections first.
+	List(tenantID string, databaseName string, limit int32, offset int32) ([]*CollectionStats, error)
+	DeleteAll() error
+}
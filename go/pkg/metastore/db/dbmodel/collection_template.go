@@ -0,0 +1,50 @@
+package dbmodel
+
+import "time"
+
+// CollectionTemplate is a collection configuration of a tenant. Names are
+// unique per tenant.
+type CollectionTemplate struct {
+	ID                string                        `gorm:"id;primaryKey;type:uuid"`
+	ClusterID         string                        `gorm:"cluster_id;type:text;not null;default:'';uniqueIndex:idx_collection_template_tenant_name,priority:1"`
+	TenantID          string                        `gorm:"tenant_id;type:text;not null;uniqueIndex:idx_collection_template_tenant_name,priority:2"`
+	Name              string                        `gorm:"name;type:text;not null;uniqueIndex:idx_collection_template_tenant_name,priority:3"`
+	Dimension         *int32                        `gorm:"dimension;type:integer"`
+	DistanceFunction  *string                       `gorm:"distance_function;type:text"`
+	ReplicationFactor *int32                        `gorm:"replication_factor;type:integer"`
+	Metadata          []*CollectionTemplateMetadata `gorm:"metadata;type:jsonb;serializer:json;not null;default:'[]'"`
+	MaxRecords        *int64                        `gorm:"max_records;type:bigint"`
+	MaxSizeBytes      *int64                        `gorm:"max_size_bytes;type:bigint"`
+	CreatedAt         time.Time                     `gorm:"created_at;type:timestamptz;not null;default:current_timestamp"`
+}
+
+func (v CollectionTemplate) TableName() string {
+	return "collection_templates"
+}
+
+// CollectionTemplateMetadata is a default metadata entry of a template, with
+// one of the values set like in CollectionMetadata.
+type CollectionTemplateMetadata struct {
+	Key        string   `json:"key"`
+	StrValue   *string  `json:"str_value,omitempty"`
+	IntValue   *int64   `json:"int_value,omitempty"`
+	FloatValue *float64 `json:"float_value,omitempty"`
+	BoolValue  *bool    `json:"bool_value,omitempty"`
+}
+
+//go:generate mockery --name=ICollectionTemplateDb
+type ICollectionTemplateDb interface {
+	// Insert fails with ErrCollectionTemplateUniqueConstraintViolation when the
+	// tenant has a template of the same name.
+	Insert(in *CollectionTemplate) error
+	// Get returns the templates matching the filters that are set, ordered by
+	// tenant and name.
+	Get(id *string, tenantID string, name *string) ([]*CollectionTemplate, error)
+	// GetForShare locks the template against deletion, nil when it does not
+	// exist.
+	GetForShare(id string) (*CollectionTemplate, error)
+	// Delete returns false when the template does not exist.
+	Delete(id string) (bool, error)
+	DeleteByTenantID(tenantID string) (int64, error)
+	DeleteAll() error
+}
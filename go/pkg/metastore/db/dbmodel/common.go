@@ -2,6 +2,8 @@ package dbmodel
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	_ "ariga.io/atlas-provider-gorm/gormschema"
 )
@@ -15,9 +17,37 @@ type IMetaDomain interface {
 	SegmentDb(ctx context.Context) ISegmentDb
 	SegmentMetadataDb(ctx context.Context) ISegmentMetadataDb
 	NotificationDb(ctx context.Context) INotificationDb
+	ArchiveDb(ctx context.Context) IArchiveDb
+	MaintenanceDb(ctx context.Context) IMaintenanceDb
+	LeaseDb(ctx context.Context) ILeaseDb
+	CollectionLifecycleDb(ctx context.Context) ICollectionLifecycleDb
+	CollectionAclDb(ctx context.Context) ICollectionAclDb
+	TenantQuotaDb(ctx context.Context) ITenantQuotaDb
+	UsageCounterDb(ctx context.Context) IUsageCounterDb
+	DatabaseQuotaDb(ctx context.Context) IDatabaseQuotaDb
+	CollectionLogRouteDb(ctx context.Context) ICollectionLogRouteDb
+	CompactionRequestDb(ctx context.Context) ICompactionRequestDb
+	SegmentFileDb(ctx context.Context) ISegmentFileDb
+	FeatureFlagDb(ctx context.Context) IFeatureFlagDb
+	TenantRegionDb(ctx context.Context) ITenantRegionDb
+	NodeDecommissionDb(ctx context.Context) INodeDecommissionDb
+	NodeHeartbeatDb(ctx context.Context) INodeHeartbeatDb
+	CollectionStatsDb(ctx context.Context) ICollectionStatsDb
+	CollectionSizeHistoryDb(ctx context.Context) ICollectionSizeHistoryDb
+	CleanupDeadLetterDb(ctx context.Context) ICleanupDeadLetterDb
+	CollectionMetadataHistoryDb(ctx context.Context) ICollectionMetadataHistoryDb
+	EmbeddingFunctionDb(ctx context.Context) IEmbeddingFunctionDb
+	CollectionSchemaFieldDb(ctx context.Context) ICollectionSchemaFieldDb
+	CollectionTemplateDb(ctx context.Context) ICollectionTemplateDb
+	MaintenanceModeDb(ctx context.Context) IMaintenanceModeDb
+	JobDb(ctx context.Context) IJobDb
 }
 
 //go:generate mockery --name=ITransaction
 type ITransaction interface {
-	Transaction(ctx context.Context, fn func(txCtx context.Context) error) error
+	WithReadTx(ctx context.Context, fn func(txCtx context.Context) error) error
+	WithWriteTx(ctx context.Context, fn func(txCtx context.Context) error) error
+	WithWriteTxIsolation(ctx context.Context, isolation sql.IsolationLevel, fn func(txCtx context.Context) error) error
+	HoldSnapshot(ctx context.Context, ttl time.Duration) (string, error)
+	WithSnapshotTx(ctx context.Context, snapshotID string, fn func(txCtx context.Context) error) error
 }
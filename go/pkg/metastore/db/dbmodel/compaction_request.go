@@ -0,0 +1,33 @@
+package dbmodel
+
+import "time"
+
+// CompactionRequest queues a collection for compaction ahead of the usual
+// thresholds, e.g. after a bulk load. A collection is queued at most once and
+// leaves the queue with its next flush.
+type CompactionRequest struct {
+	CollectionID CollectionID `gorm:"collection_id;primaryKey;type:uuid"`
+	TenantID     string       `gorm:"tenant_id;type:text;not null;default:''"`
+	RequestedBy  string       `gorm:"requested_by;type:text;not null;default:''"`
+	RequestedAt  time.Time    `gorm:"requested_at;type:timestamptz;not null;default:current_timestamp;index"`
+
+	Cluster
+}
+
+func (v CompactionRequest) TableName() string {
+	return "compaction_requests"
+}
+
+//go:generate mockery --name=ICompactionRequestDb
+type ICompactionRequestDb interface {
+	// Insert queues the collection unless it is queued already, in which case it
+	// keeps its place in the queue.
+	Insert(in *CompactionRequest) error
+	// List returns up to limit queued collections, longest queued first.
+	List(limit int32) ([]*CompactionRequest, error)
+	DeleteByCollectionID(collectionID CollectionID) (int64, error)
+	// DeleteRequestedBefore deletes the request of the collection if it was made
+	// before the given time.
+	DeleteRequestedBefore(collectionID CollectionID, before time.Time) (int64, error)
+	DeleteAll() error
+}
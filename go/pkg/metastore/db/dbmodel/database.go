@@ -7,13 +7,15 @@ import (
 )
 
 type Database struct {
-	ID        string          `gorm:"id;primaryKey;unique"`
+	ID        DatabaseID      `gorm:"id;primaryKey;unique;type:uuid"`
+	ClusterID string          `gorm:"cluster_id;type:text;not null;default:'';uniqueIndex:idx_tenantid_name,priority:1"`
 	Name      string          `gorm:"name;type:varchar(128);not_null;uniqueIndex:idx_tenantid_name"`
 	TenantID  string          `gorm:"tenant_id;type:varchar(128);not_null;uniqueIndex:idx_tenantid_name"`
 	Ts        types.Timestamp `gorm:"ts;type:bigint;default:0"`
-	IsDeleted bool            `gorm:"is_deleted;type:bool;default:false"`
-	CreatedAt time.Time       `gorm:"created_at;type:timestamp;not null;default:current_timestamp"`
-	UpdatedAt time.Time       `gorm:"updated_at;type:timestamp;not null;default:current_timestamp"`
+	CreatedAt time.Time       `gorm:"created_at;type:timestamptz;not null;default:current_timestamp"`
+	UpdatedAt time.Time       `gorm:"updated_at;type:timestamptz;not null;default:current_timestamp"`
+
+	SoftDelete
 }
 
 func (v Database) TableName() string {
@@ -24,6 +26,10 @@ func (v Database) TableName() string {
 type IDatabaseDb interface {
 	GetAllDatabases() ([]*Database, error)
 	GetDatabases(tenantID string, databaseName string) ([]*Database, error)
+	GetDatabaseByID(databaseID DatabaseID) (*Database, error)
+	GetDatabaseForUpdate(databaseID DatabaseID) (*Database, error)
+	GetDatabasesByTenantID(tenantID string) ([]*Database, error)
 	Insert(in *Database) error
+	DeleteByTenantIdAndName(tenantId string, databaseName string) (int, error)
 	DeleteAll() error
 }
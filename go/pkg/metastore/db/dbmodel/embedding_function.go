@@ -0,0 +1,38 @@
+package dbmodel
+
+import "time"
+
+// EmbeddingFunction is an embedding configuration shared by the collections of
+// a tenant, which reference it by ID. Names are unique per tenant.
+type EmbeddingFunction struct {
+	ID        string    `gorm:"id;primaryKey;type:uuid"`
+	ClusterID string    `gorm:"cluster_id;type:text;not null;default:'';uniqueIndex:idx_embedding_function_tenant_name,priority:1"`
+	TenantID  string    `gorm:"tenant_id;type:text;not null;uniqueIndex:idx_embedding_function_tenant_name,priority:2"`
+	Name      string    `gorm:"name;type:text;not null;uniqueIndex:idx_embedding_function_tenant_name,priority:3"`
+	Provider  string    `gorm:"provider;type:text;not null"`
+	Model     string    `gorm:"model;type:text;not null;default:''"`
+	Params    string    `gorm:"params;type:jsonb;not null;default:'{}'"`
+	CreatedAt time.Time `gorm:"created_at;type:timestamptz;not null;default:current_timestamp"`
+	UpdatedAt time.Time `gorm:"updated_at;type:timestamptz;not null;default:current_timestamp"`
+}
+
+func (v EmbeddingFunction) TableName() string {
+	return "embedding_functions"
+}
+
+//go:generate mockery --name=IEmbeddingFunctionDb
+type IEmbeddingFunctionDb interface {
+	// Insert fails with ErrEmbeddingFunctionUniqueConstraintViolation when the
+	// tenant has an embedding function of the same name.
+	Insert(in *EmbeddingFunction) error
+	// Get returns the embedding functions matching the filters that are set,
+	// ordered by tenant and name.
+	Get(id *string, tenantID string, name *string) ([]*EmbeddingFunction, error)
+	// GetForUpdate locks the embedding function, nil when it does not exist.
+	GetForUpdate(id string) (*EmbeddingFunction, error)
+	Update(in *EmbeddingFunction) error
+	// Delete returns false when the embedding function does not exist.
+	Delete(id string) (bool, error)
+	DeleteByTenantID(tenantID string) (int64, error)
+	DeleteAll() error
+}
@@ -0,0 +1,31 @@
+package dbmodel
+
+import "time"
+
+// FeatureFlag is the default of a flag when TenantID is empty, and the override
+// of the flag for a tenant otherwise.
+type FeatureFlag struct {
+	ClusterID         string    `gorm:"cluster_id;primaryKey;type:text;default:''"`
+	Name              string    `gorm:"name;primaryKey;type:text"`
+	TenantID          string    `gorm:"tenant_id;primaryKey;type:text;default:''"`
+	Enabled           bool      `gorm:"enabled;type:bool;not null;default:false"`
+	RolloutPercentage int32     `gorm:"rollout_percentage;type:integer;not null;default:100"`
+	Description       string    `gorm:"description;type:text;not null;default:''"`
+	CreatedAt         time.Time `gorm:"created_at;type:timestamptz;not null;default:current_timestamp"`
+	UpdatedAt         time.Time `gorm:"updated_at;type:timestamptz;not null;default:current_timestamp"`
+}
+
+func (v FeatureFlag) TableName() string {
+	return "feature_flags"
+}
+
+//go:generate mockery --name=IFeatureFlagDb
+type IFeatureFlagDb interface {
+	Upsert(in *FeatureFlag) error
+	// Delete returns false when the flag does not exist.
+	Delete(name string, tenantID string) (bool, error)
+	// DeleteByTenantID deletes the overrides of a tenant.
+	DeleteByTenantID(tenantID string) (int64, error)
+	GetAll() ([]*FeatureFlag, error)
+	DeleteAll() error
+}
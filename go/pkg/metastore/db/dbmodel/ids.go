@@ -0,0 +1,53 @@
+package dbmodel
+
+import "github.com/chroma-core/chroma/go/pkg/types"
+
+// CollectionID, SegmentID and DatabaseID are the ids stored in the uuid columns of
+// the sysdb tables. They are distinct types so that passing one kind of id where
+// another is expected does not compile.
+type CollectionID string
+
+type SegmentID string
+
+type DatabaseID string
+
+func NewCollectionID(id types.UniqueID) CollectionID {
+	return CollectionID(id.String())
+}
+
+func NewSegmentID(id types.UniqueID) SegmentID {
+	return SegmentID(id.String())
+}
+
+func NewDatabaseID(id types.UniqueID) DatabaseID {
+	return DatabaseID(id.String())
+}
+
+// CollectionIDFromUniqueID returns nil for the nil id, like types.FromUniqueID.
+func CollectionIDFromUniqueID(id types.UniqueID) *CollectionID {
+	if id == types.NilUniqueID() {
+		return nil
+	}
+	collectionID := NewCollectionID(id)
+	return &collectionID
+}
+
+func (id CollectionID) UniqueID() types.UniqueID {
+	return types.MustParse(string(id))
+}
+
+func (id SegmentID) UniqueID() types.UniqueID {
+	return types.MustParse(string(id))
+}
+
+func (id CollectionID) String() string {
+	return string(id)
+}
+
+func (id SegmentID) String() string {
+	return string(id)
+}
+
+func (id DatabaseID) String() string {
+	return string(id)
+}
@@ -0,0 +1,65 @@
+package dbmodel
+
+import "time"
+
+// The states of a job. A pending job runs once NextRunAt is past, and goes
+// back to pending when an attempt fails and it has attempts left.
+const (
+	JobStatePending   = "pending"
+	JobStateRunning   = "running"
+	JobStateSucceeded = "succeeded"
+	JobStateFailed    = "failed"
+	JobStateCanceled  = "canceled"
+)
+
+// Job is a long-running operation queued for the job workers of the
+// coordinator. A running job is held by the worker LockedBy until
+// LockedUntil, the lease the worker renews while it runs the job. A job whose
+// lease expired, its worker died, is claimed again by another worker.
+type Job struct {
+	ID          string     `gorm:"id;primaryKey;type:uuid"`
+	ClusterID   string     `gorm:"cluster_id;type:text;not null;default:'';index:idx_jobs_state_next_run_at,priority:1"`
+	Type        string     `gorm:"job_type;type:text;not null"`
+	TenantID    string     `gorm:"tenant_id;type:text;not null;default:'';index:idx_jobs_tenant_id"`
+	Payload     string     `gorm:"payload;type:jsonb;not null;default:'{}'"`
+	State       string     `gorm:"state;type:text;not null;index:idx_jobs_state_next_run_at,priority:2"`
+	Attempts    int32      `gorm:"attempts;type:integer;not null;default:0"`
+	MaxAttempts int32      `gorm:"max_attempts;type:integer;not null"`
+	LastError   string     `gorm:"last_error;type:text;not null;default:''"`
+	NextRunAt   time.Time  `gorm:"next_run_at;type:timestamptz;not null;index:idx_jobs_state_next_run_at,priority:3"`
+	LockedBy    string     `gorm:"locked_by;type:text;not null;default:''"`
+	LockedUntil *time.Time `gorm:"locked_until;type:timestamptz"`
+	CreatedAt   time.Time  `gorm:"created_at;type:timestamptz;not null;default:current_timestamp"`
+	UpdatedAt   time.Time  `gorm:"updated_at;type:timestamptz;not null;default:current_timestamp"`
+	FinishedAt  *time.Time `gorm:"finished_at;type:timestamptz"`
+}
+
+func (v Job) TableName() string {
+	return "jobs"
+}
+
+//go:generate mockery --name=IJobDb
+type IJobDb interface {
+	Insert(in *Job) error
+	// Get returns nil when the job does not exist.
+	Get(id string) (*Job, error)
+	// Claim holds the job of one of jobTypes that is due the longest, or whose
+	// lease expired, for workerID until lockedUntil and counts the attempt. It
+	// returns nil when no job is due. Must run in a transaction, so that the job
+	// stays locked until it is held.
+	Claim(jobTypes []string, workerID string, now time.Time, lockedUntil time.Time) (*Job, error)
+	// RenewLease returns false when workerID no longer holds the job.
+	RenewLease(id string, workerID string, lockedUntil time.Time) (bool, error)
+	// Finish releases the job held by workerID in state, with the error of the
+	// attempt. A pending job runs again at nextRunAt. It returns false when
+	// workerID no longer holds the job.
+	Finish(id string, workerID string, state string, lastError string, nextRunAt time.Time) (bool, error)
+	// List returns the jobs matching the filters that are set, the most
+	// recently created first.
+	List(tenantID string, jobType string, state string, limit int32, offset int32) ([]*Job, error)
+	// Cancel cancels the job unless it is finished, and returns false when it
+	// is. A running job is taken from its worker, whose next lease renewal
+	// fails and stops the attempt.
+	Cancel(id string) (bool, error)
+	DeleteAll() error
+}
@@ -0,0 +1,24 @@
+package dbmodel
+
+import "time"
+
+// Lease is a named lease held by one sysdb replica at a time. Epoch is bumped
+// every time the lease changes hands.
+type Lease struct {
+	ClusterID string    `gorm:"cluster_id;primaryKey;type:text;default:''"`
+	Name      string    `gorm:"name;primaryKey"`
+	Holder    string    `gorm:"holder;type:text;not null"`
+	Address   string    `gorm:"address;type:text;not null;default:''"`
+	Epoch     int64     `gorm:"epoch;not null;default:0"`
+	ExpiresAt time.Time `gorm:"expires_at;type:timestamptz;not null"`
+}
+
+func (v Lease) TableName() string {
+	return "leases"
+}
+
+//go:generate mockery --name=ILeaseDb
+type ILeaseDb interface {
+	TryAcquire(name string, holder string, address string, ttl time.Duration) (*Lease, error)
+	Release(name string, holder string) error
+}
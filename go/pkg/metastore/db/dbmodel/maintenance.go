@@ -0,0 +1,17 @@
+package dbmodel
+
+// MaintainableTables are the tables the maintenance job may analyze or vacuum.
+var MaintainableTables = []string{
+	"collections",
+	"collection_metadata",
+	"segments",
+	"segment_metadata",
+	"notifications",
+	"record_logs",
+}
+
+//go:generate mockery --name=IMaintenanceDb
+type IMaintenanceDb interface {
+	Analyze(table string) error
+	Vacuum(table string) error
+}
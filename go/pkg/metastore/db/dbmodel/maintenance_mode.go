@@ -0,0 +1,24 @@
+package dbmodel
+
+import "time"
+
+// MaintenanceMode is the maintenance state of the sysdb of a cluster, a single
+// row that is only written by SetMaintenanceMode.
+type MaintenanceMode struct {
+	ClusterID string    `gorm:"cluster_id;primaryKey;type:text;default:''"`
+	Enabled   bool      `gorm:"enabled;type:bool;not null;default:false"`
+	Reason    string    `gorm:"reason;type:text;not null;default:''"`
+	UpdatedAt time.Time `gorm:"updated_at;type:timestamptz;not null;default:current_timestamp"`
+}
+
+func (v MaintenanceMode) TableName() string {
+	return "maintenance_modes"
+}
+
+//go:generate mockery --name=IMaintenanceModeDb
+type IMaintenanceModeDb interface {
+	Upsert(in *MaintenanceMode) error
+	// Get returns nil when maintenance was never set.
+	Get() (*MaintenanceMode, error)
+	DeleteAll() error
+}
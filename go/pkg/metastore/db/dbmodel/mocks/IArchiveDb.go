@@ -0,0 +1,93 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IArchiveDb is an autogenerated mock type for the IArchiveDb type
+type IArchiveDb struct {
+	mock.Mock
+}
+
+// ArchiveCollection provides a mock function with given fields: collection, reason, actor
+func (_m *IArchiveDb) ArchiveCollection(collection *dbmodel.CollectionAndMetadata, reason string, actor string) error {
+	ret := _m.Called(collection, reason, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveCollection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dbmodel.CollectionAndMetadata, string, string) error); ok {
+		r0 = rf(collection, reason, actor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ArchiveSegment provides a mock function with given fields: segment, reason, actor
+func (_m *IArchiveDb) ArchiveSegment(segment *dbmodel.SegmentAndMetadata, reason string, actor string) error {
+	ret := _m.Called(segment, reason, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveSegment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dbmodel.SegmentAndMetadata, string, string) error); ok {
+		r0 = rf(segment, reason, actor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetArchivedCollections provides a mock function with given fields: collectionID
+func (_m *IArchiveDb) GetArchivedCollections(collectionID dbmodel.CollectionID) ([]*dbmodel.ArchivedCollection, error) {
+	ret := _m.Called(collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetArchivedCollections")
+	}
+
+	var r0 []*dbmodel.ArchivedCollection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) ([]*dbmodel.ArchivedCollection, error)); ok {
+		return rf(collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) []*dbmodel.ArchivedCollection); ok {
+		r0 = rf(collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.ArchivedCollection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID) error); ok {
+		r1 = rf(collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewIArchiveDb creates a new instance of IArchiveDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIArchiveDb(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IArchiveDb {
+	mock := &IArchiveDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,135 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ICleanupDeadLetterDb is an autogenerated mock type for the ICleanupDeadLetterDb type
+type ICleanupDeadLetterDb struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: collectionID, task
+func (_m *ICleanupDeadLetterDb) Delete(collectionID dbmodel.CollectionID, task string) (int64, error) {
+	ret := _m.Called(collectionID, task)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, string) (int64, error)); ok {
+		return rf(collectionID, task)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, string) int64); ok {
+		r0 = rf(collectionID, task)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID, string) error); ok {
+		r1 = rf(collectionID, task)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteAll provides a mock function with given fields:
+func (_m *ICleanupDeadLetterDb) DeleteAll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: task, deadLetteredOnly
+func (_m *ICleanupDeadLetterDb) List(task string, deadLetteredOnly bool) ([]*dbmodel.CleanupDeadLetter, error) {
+	ret := _m.Called(task, deadLetteredOnly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*dbmodel.CleanupDeadLetter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, bool) ([]*dbmodel.CleanupDeadLetter, error)); ok {
+		return rf(task, deadLetteredOnly)
+	}
+	if rf, ok := ret.Get(0).(func(string, bool) []*dbmodel.CleanupDeadLetter); ok {
+		r0 = rf(task, deadLetteredOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.CleanupDeadLetter)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, bool) error); ok {
+		r1 = rf(task, deadLetteredOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordFailure provides a mock function with given fields: collectionID, task, tenantID, lastError, failedAt, maxAttempts
+func (_m *ICleanupDeadLetterDb) RecordFailure(collectionID dbmodel.CollectionID, task string, tenantID string, lastError string, failedAt time.Time, maxAttempts int32) (*dbmodel.CleanupDeadLetter, error) {
+	ret := _m.Called(collectionID, task, tenantID, lastError, failedAt, maxAttempts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordFailure")
+	}
+
+	var r0 *dbmodel.CleanupDeadLetter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, string, string, string, time.Time, int32) (*dbmodel.CleanupDeadLetter, error)); ok {
+		return rf(collectionID, task, tenantID, lastError, failedAt, maxAttempts)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, string, string, string, time.Time, int32) *dbmodel.CleanupDeadLetter); ok {
+		r0 = rf(collectionID, task, tenantID, lastError, failedAt, maxAttempts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dbmodel.CleanupDeadLetter)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID, string, string, string, time.Time, int32) error); ok {
+		r1 = rf(collectionID, task, tenantID, lastError, failedAt, maxAttempts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewICleanupDeadLetterDb creates a new instance of ICleanupDeadLetterDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewICleanupDeadLetterDb(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ICleanupDeadLetterDb {
+	mock := &ICleanupDeadLetterDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
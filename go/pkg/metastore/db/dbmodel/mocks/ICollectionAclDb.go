@@ -0,0 +1,149 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ICollectionAclDb is an autogenerated mock type for the ICollectionAclDb type
+type ICollectionAclDb struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: collectionID, principal
+func (_m *ICollectionAclDb) Delete(collectionID dbmodel.CollectionID, principal string) (int64, error) {
+	ret := _m.Called(collectionID, principal)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, string) (int64, error)); ok {
+		return rf(collectionID, principal)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, string) int64); ok {
+		r0 = rf(collectionID, principal)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID, string) error); ok {
+		r1 = rf(collectionID, principal)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteAll provides a mock function with given fields:
+func (_m *ICollectionAclDb) DeleteAll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteByCollectionID provides a mock function with given fields: collectionID
+func (_m *ICollectionAclDb) DeleteByCollectionID(collectionID dbmodel.CollectionID) (int64, error) {
+	ret := _m.Called(collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByCollectionID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) (int64, error)); ok {
+		return rf(collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) int64); ok {
+		r0 = rf(collectionID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID) error); ok {
+		r1 = rf(collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByCollectionIDs provides a mock function with given fields: collectionIDs
+func (_m *ICollectionAclDb) GetByCollectionIDs(collectionIDs []dbmodel.CollectionID) ([]*dbmodel.CollectionAcl, error) {
+	ret := _m.Called(collectionIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByCollectionIDs")
+	}
+
+	var r0 []*dbmodel.CollectionAcl
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]dbmodel.CollectionID) ([]*dbmodel.CollectionAcl, error)); ok {
+		return rf(collectionIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]dbmodel.CollectionID) []*dbmodel.CollectionAcl); ok {
+		r0 = rf(collectionIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.CollectionAcl)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]dbmodel.CollectionID) error); ok {
+		r1 = rf(collectionIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields: in
+func (_m *ICollectionAclDb) Upsert(in *dbmodel.CollectionAcl) error {
+	ret := _m.Called(in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dbmodel.CollectionAcl) error); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewICollectionAclDb creates a new instance of ICollectionAclDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewICollectionAclDb(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ICollectionAclDb {
+	mock := &ICollectionAclDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
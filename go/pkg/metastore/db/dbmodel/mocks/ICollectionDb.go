@@ -5,51 +5,525 @@ package mocks
 import (
 	dbmodel "github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
 	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/chroma-core/chroma/go/pkg/model"
+
+	time "time"
 )
 
-// ICollectionDb is an autogenerated mock type for the ICollectionDb type
-type ICollectionDb struct {
-	mock.Mock
+// ICollectionDb is an autogenerated mock type for the ICollectionDb type
+type ICollectionDb struct {
+	mock.Mock
+}
+
+// AcquireFencingToken provides a mock function with given fields: collectionID, owner
+func (_m *ICollectionDb) AcquireFencingToken(collectionID dbmodel.CollectionID, owner string) (int64, error) {
+	ret := _m.Called(collectionID, owner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcquireFencingToken")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, string) (int64, error)); ok {
+		return rf(collectionID, owner)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, string) int64); ok {
+		r0 = rf(collectionID, owner)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID, string) error); ok {
+		r1 = rf(collectionID, owner)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CheckFencingToken provides a mock function with given fields: collectionID, fencingToken
+func (_m *ICollectionDb) CheckFencingToken(collectionID dbmodel.CollectionID, fencingToken int64) error {
+	ret := _m.Called(collectionID, fencingToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckFencingToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, int64) error); ok {
+		r0 = rf(collectionID, fencingToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClearEmbeddingFunctionID provides a mock function with given fields: collectionID
+func (_m *ICollectionDb) ClearEmbeddingFunctionID(collectionID dbmodel.CollectionID) error {
+	ret := _m.Called(collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearEmbeddingFunctionID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) error); ok {
+		r0 = rf(collectionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClearReindexPending provides a mock function with given fields: collectionID, dimension
+func (_m *ICollectionDb) ClearReindexPending(collectionID dbmodel.CollectionID, dimension *int32) (int64, error) {
+	ret := _m.Called(collectionID, dimension)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearReindexPending")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, *int32) (int64, error)); ok {
+		return rf(collectionID, dimension)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, *int32) int64); ok {
+		r0 = rf(collectionID, dimension)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID, *int32) error); ok {
+		r1 = rf(collectionID, dimension)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClearTemplateID provides a mock function with given fields: templateID
+func (_m *ICollectionDb) ClearTemplateID(templateID string) (int64, error) {
+	ret := _m.Called(templateID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClearTemplateID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int64, error)); ok {
+		return rf(templateID)
+	}
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(templateID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(templateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountCollections provides a mock function with given fields: tenantID, databaseName, includeSoftDeleted
+func (_m *ICollectionDb) CountCollections(tenantID string, databaseName string, includeSoftDeleted bool) (int64, error) {
+	ret := _m.Called(tenantID, databaseName, includeSoftDeleted)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountCollections")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, bool) (int64, error)); ok {
+		return rf(tenantID, databaseName, includeSoftDeleted)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, bool) int64); ok {
+		r0 = rf(tenantID, databaseName, includeSoftDeleted)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, bool) error); ok {
+		r1 = rf(tenantID, databaseName, includeSoftDeleted)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountCollectionsByDatabaseID provides a mock function with given fields: databaseID
+func (_m *ICollectionDb) CountCollectionsByDatabaseID(databaseID dbmodel.DatabaseID) (int64, error) {
+	ret := _m.Called(databaseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountCollectionsByDatabaseID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.DatabaseID) (int64, error)); ok {
+		return rf(databaseID)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.DatabaseID) int64); ok {
+		r0 = rf(databaseID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.DatabaseID) error); ok {
+		r1 = rf(databaseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountCollectionsByEmbeddingFunctionID provides a mock function with given fields: embeddingFunctionID
+func (_m *ICollectionDb) CountCollectionsByEmbeddingFunctionID(embeddingFunctionID string) (int64, error) {
+	ret := _m.Called(embeddingFunctionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountCollectionsByEmbeddingFunctionID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int64, error)); ok {
+		return rf(embeddingFunctionID)
+	}
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(embeddingFunctionID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(embeddingFunctionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteAll provides a mock function with given fields:
+func (_m *ICollectionDb) DeleteAll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteCollectionByID provides a mock function with given fields: collectionID
+func (_m *ICollectionDb) DeleteCollectionByID(collectionID dbmodel.CollectionID) (int, error) {
+	ret := _m.Called(collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCollectionByID")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) (int, error)); ok {
+		return rf(collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) int); ok {
+		r0 = rf(collectionID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID) error); ok {
+		r1 = rf(collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetApproximateCollectionCount provides a mock function with given fields:
+func (_m *ICollectionDb) GetApproximateCollectionCount() (int64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetApproximateCollectionCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetApproximateDatabaseCollectionCount provides a mock function with given fields: databaseID
+func (_m *ICollectionDb) GetApproximateDatabaseCollectionCount(databaseID dbmodel.DatabaseID) (int64, error) {
+	ret := _m.Called(databaseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetApproximateDatabaseCollectionCount")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.DatabaseID) (int64, error)); ok {
+		return rf(databaseID)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.DatabaseID) int64); ok {
+		r0 = rf(databaseID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.DatabaseID) error); ok {
+		r1 = rf(databaseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollections provides a mock function with given fields: collectionID, collectionName, tenantID, databaseName, limit, offset, metadataFilters
+func (_m *ICollectionDb) GetCollections(collectionID *dbmodel.CollectionID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32, metadataFilters []*model.CollectionMetadataFilter) ([]*dbmodel.CollectionAndMetadata, error) {
+	ret := _m.Called(collectionID, collectionName, tenantID, databaseName, limit, offset, metadataFilters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollections")
+	}
+
+	var r0 []*dbmodel.CollectionAndMetadata
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*dbmodel.CollectionID, *string, string, string, *int32, *int32, []*model.CollectionMetadataFilter) ([]*dbmodel.CollectionAndMetadata, error)); ok {
+		return rf(collectionID, collectionName, tenantID, databaseName, limit, offset, metadataFilters)
+	}
+	if rf, ok := ret.Get(0).(func(*dbmodel.CollectionID, *string, string, string, *int32, *int32, []*model.CollectionMetadataFilter) []*dbmodel.CollectionAndMetadata); ok {
+		r0 = rf(collectionID, collectionName, tenantID, databaseName, limit, offset, metadataFilters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.CollectionAndMetadata)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*dbmodel.CollectionID, *string, string, string, *int32, *int32, []*model.CollectionMetadataFilter) error); ok {
+		r1 = rf(collectionID, collectionName, tenantID, databaseName, limit, offset, metadataFilters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollectionsByIDs provides a mock function with given fields: collectionIDs
+func (_m *ICollectionDb) GetCollectionsByIDs(collectionIDs []dbmodel.CollectionID) ([]*dbmodel.Collection, error) {
+	ret := _m.Called(collectionIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionsByIDs")
+	}
+
+	var r0 []*dbmodel.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]dbmodel.CollectionID) ([]*dbmodel.Collection, error)); ok {
+		return rf(collectionIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]dbmodel.CollectionID) []*dbmodel.Collection); ok {
+		r0 = rf(collectionIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]dbmodel.CollectionID) error); ok {
+		r1 = rf(collectionIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollectionsBySize provides a mock function with given fields: tenantID, databaseName, orderBy, limit
+func (_m *ICollectionDb) GetCollectionsBySize(tenantID string, databaseName string, orderBy model.CollectionSizeOrderBy, limit int32) ([]*dbmodel.Collection, error) {
+	ret := _m.Called(tenantID, databaseName, orderBy, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionsBySize")
+	}
+
+	var r0 []*dbmodel.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, model.CollectionSizeOrderBy, int32) ([]*dbmodel.Collection, error)); ok {
+		return rf(tenantID, databaseName, orderBy, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, model.CollectionSizeOrderBy, int32) []*dbmodel.Collection); ok {
+		r0 = rf(tenantID, databaseName, orderBy, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, model.CollectionSizeOrderBy, int32) error); ok {
+		r1 = rf(tenantID, databaseName, orderBy, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollectionsToGc provides a mock function with given fields: updatedBefore, tenantID, minVersions, startAfter, limit
+func (_m *ICollectionDb) GetCollectionsToGc(updatedBefore time.Time, tenantID *string, minVersions int32, startAfter *dbmodel.CollectionID, limit int32) ([]*dbmodel.Collection, error) {
+	ret := _m.Called(updatedBefore, tenantID, minVersions, startAfter, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionsToGc")
+	}
+
+	var r0 []*dbmodel.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, *string, int32, *dbmodel.CollectionID, int32) ([]*dbmodel.Collection, error)); ok {
+		return rf(updatedBefore, tenantID, minVersions, startAfter, limit)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, *string, int32, *dbmodel.CollectionID, int32) []*dbmodel.Collection); ok {
+		r0 = rf(updatedBefore, tenantID, minVersions, startAfter, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, *string, int32, *dbmodel.CollectionID, int32) error); ok {
+		r1 = rf(updatedBefore, tenantID, minVersions, startAfter, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollectionsWithoutMetadata provides a mock function with given fields: collectionID, collectionName, tenantID, databaseName, limit, offset, metadataFilters
+func (_m *ICollectionDb) GetCollectionsWithoutMetadata(collectionID *dbmodel.CollectionID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32, metadataFilters []*model.CollectionMetadataFilter) ([]*dbmodel.CollectionAndMetadata, error) {
+	ret := _m.Called(collectionID, collectionName, tenantID, databaseName, limit, offset, metadataFilters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionsWithoutMetadata")
+	}
+
+	var r0 []*dbmodel.CollectionAndMetadata
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*dbmodel.CollectionID, *string, string, string, *int32, *int32, []*model.CollectionMetadataFilter) ([]*dbmodel.CollectionAndMetadata, error)); ok {
+		return rf(collectionID, collectionName, tenantID, databaseName, limit, offset, metadataFilters)
+	}
+	if rf, ok := ret.Get(0).(func(*dbmodel.CollectionID, *string, string, string, *int32, *int32, []*model.CollectionMetadataFilter) []*dbmodel.CollectionAndMetadata); ok {
+		r0 = rf(collectionID, collectionName, tenantID, databaseName, limit, offset, metadataFilters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.CollectionAndMetadata)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*dbmodel.CollectionID, *string, string, string, *int32, *int32, []*model.CollectionMetadataFilter) error); ok {
+		r1 = rf(collectionID, collectionName, tenantID, databaseName, limit, offset, metadataFilters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// DeleteAll provides a mock function with given fields:
-func (_m *ICollectionDb) DeleteAll() error {
-	ret := _m.Called()
+// GetCollectionsWithoutSegments provides a mock function with given fields: createdBefore
+func (_m *ICollectionDb) GetCollectionsWithoutSegments(createdBefore time.Time) ([]*dbmodel.CollectionAndMetadata, error) {
+	ret := _m.Called(createdBefore)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteAll")
+		panic("no return value specified for GetCollectionsWithoutSegments")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
+	var r0 []*dbmodel.CollectionAndMetadata
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) ([]*dbmodel.CollectionAndMetadata, error)); ok {
+		return rf(createdBefore)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) []*dbmodel.CollectionAndMetadata); ok {
+		r0 = rf(createdBefore)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.CollectionAndMetadata)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(createdBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// DeleteCollectionByID provides a mock function with given fields: collectionID
-func (_m *ICollectionDb) DeleteCollectionByID(collectionID string) (int, error) {
+// GetDeletedCollection provides a mock function with given fields: collectionID
+func (_m *ICollectionDb) GetDeletedCollection(collectionID dbmodel.CollectionID) (*dbmodel.Collection, error) {
 	ret := _m.Called(collectionID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteCollectionByID")
+		panic("no return value specified for GetDeletedCollection")
 	}
 
-	var r0 int
+	var r0 *dbmodel.Collection
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (int, error)); ok {
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) (*dbmodel.Collection, error)); ok {
 		return rf(collectionID)
 	}
-	if rf, ok := ret.Get(0).(func(string) int); ok {
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) *dbmodel.Collection); ok {
 		r0 = rf(collectionID)
 	} else {
-		r0 = ret.Get(0).(int)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dbmodel.Collection)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID) error); ok {
 		r1 = rf(collectionID)
 	} else {
 		r1 = ret.Error(1)
@@ -58,29 +532,179 @@ func (_m *ICollectionDb) DeleteCollectionByID(collectionID string) (int, error)
 	return r0, r1
 }
 
-// GetCollections provides a mock function with given fields: collectionID, collectionName, tenantID, databaseName, limit, offset
-func (_m *ICollectionDb) GetCollections(collectionID *string, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32) ([]*dbmodel.CollectionAndMetadata, error) {
-	ret := _m.Called(collectionID, collectionName, tenantID, databaseName, limit, offset)
+// GetDeletedCollectionIDs provides a mock function with given fields: deletedBefore, limit
+func (_m *ICollectionDb) GetDeletedCollectionIDs(deletedBefore time.Time, limit int32) ([]dbmodel.CollectionID, error) {
+	ret := _m.Called(deletedBefore, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetCollections")
+		panic("no return value specified for GetDeletedCollectionIDs")
+	}
+
+	var r0 []dbmodel.CollectionID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, int32) ([]dbmodel.CollectionID, error)); ok {
+		return rf(deletedBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, int32) []dbmodel.CollectionID); ok {
+		r0 = rf(deletedBefore, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dbmodel.CollectionID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, int32) error); ok {
+		r1 = rf(deletedBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDeletedCollectionsByDatabaseID provides a mock function with given fields: databaseID
+func (_m *ICollectionDb) GetDeletedCollectionsByDatabaseID(databaseID dbmodel.DatabaseID) ([]*dbmodel.Collection, error) {
+	ret := _m.Called(databaseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedCollectionsByDatabaseID")
+	}
+
+	var r0 []*dbmodel.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.DatabaseID) ([]*dbmodel.Collection, error)); ok {
+		return rf(databaseID)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.DatabaseID) []*dbmodel.Collection); ok {
+		r0 = rf(databaseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.DatabaseID) error); ok {
+		r1 = rf(databaseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetForUpdate provides a mock function with given fields: collectionID
+func (_m *ICollectionDb) GetForUpdate(collectionID dbmodel.CollectionID) (*dbmodel.Collection, error) {
+	ret := _m.Called(collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForUpdate")
+	}
+
+	var r0 *dbmodel.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) (*dbmodel.Collection, error)); ok {
+		return rf(collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) *dbmodel.Collection); ok {
+		r0 = rf(collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dbmodel.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID) error); ok {
+		r1 = rf(collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInconsistentCollections provides a mock function with given fields: deletedBefore, requiredScopes, kinds
+func (_m *ICollectionDb) GetInconsistentCollections(deletedBefore time.Time, requiredScopes []string, kinds []model.InconsistencyKind) ([]*dbmodel.InconsistentCollection, error) {
+	ret := _m.Called(deletedBefore, requiredScopes, kinds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInconsistentCollections")
+	}
+
+	var r0 []*dbmodel.InconsistentCollection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, []string, []model.InconsistencyKind) ([]*dbmodel.InconsistentCollection, error)); ok {
+		return rf(deletedBefore, requiredScopes, kinds)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, []string, []model.InconsistencyKind) []*dbmodel.InconsistentCollection); ok {
+		r0 = rf(deletedBefore, requiredScopes, kinds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.InconsistentCollection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, []string, []model.InconsistencyKind) error); ok {
+		r1 = rf(deletedBefore, requiredScopes, kinds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLiveCollectionsByIDs provides a mock function with given fields: collectionIDs, withMetadata
+func (_m *ICollectionDb) GetLiveCollectionsByIDs(collectionIDs []dbmodel.CollectionID, withMetadata bool) ([]*dbmodel.CollectionAndMetadata, error) {
+	ret := _m.Called(collectionIDs, withMetadata)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLiveCollectionsByIDs")
 	}
 
 	var r0 []*dbmodel.CollectionAndMetadata
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*string, *string, string, string, *int32, *int32) ([]*dbmodel.CollectionAndMetadata, error)); ok {
-		return rf(collectionID, collectionName, tenantID, databaseName, limit, offset)
+	if rf, ok := ret.Get(0).(func([]dbmodel.CollectionID, bool) ([]*dbmodel.CollectionAndMetadata, error)); ok {
+		return rf(collectionIDs, withMetadata)
 	}
-	if rf, ok := ret.Get(0).(func(*string, *string, string, string, *int32, *int32) []*dbmodel.CollectionAndMetadata); ok {
-		r0 = rf(collectionID, collectionName, tenantID, databaseName, limit, offset)
+	if rf, ok := ret.Get(0).(func([]dbmodel.CollectionID, bool) []*dbmodel.CollectionAndMetadata); ok {
+		r0 = rf(collectionIDs, withMetadata)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*dbmodel.CollectionAndMetadata)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(*string, *string, string, string, *int32, *int32) error); ok {
-		r1 = rf(collectionID, collectionName, tenantID, databaseName, limit, offset)
+	if rf, ok := ret.Get(1).(func([]dbmodel.CollectionID, bool) error); ok {
+		r1 = rf(collectionIDs, withMetadata)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTemplateUsage provides a mock function with given fields: collectionID, tenantID
+func (_m *ICollectionDb) GetTemplateUsage(collectionID dbmodel.CollectionID, tenantID string) (*dbmodel.CollectionTemplateUsage, error) {
+	ret := _m.Called(collectionID, tenantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemplateUsage")
+	}
+
+	var r0 *dbmodel.CollectionTemplateUsage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, string) (*dbmodel.CollectionTemplateUsage, error)); ok {
+		return rf(collectionID, tenantID)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, string) *dbmodel.CollectionTemplateUsage); ok {
+		r0 = rf(collectionID, tenantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dbmodel.CollectionTemplateUsage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID, string) error); ok {
+		r1 = rf(collectionID, tenantID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -106,6 +730,110 @@ func (_m *ICollectionDb) Insert(in *dbmodel.Collection) error {
 	return r0
 }
 
+// MarkReindexPending provides a mock function with given fields: collectionID, fromDimension
+func (_m *ICollectionDb) MarkReindexPending(collectionID dbmodel.CollectionID, fromDimension *int32) error {
+	ret := _m.Called(collectionID, fromDimension)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkReindexPending")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, *int32) error); ok {
+		r0 = rf(collectionID, fromDimension)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestoreCollectionByID provides a mock function with given fields: collectionID, name, state
+func (_m *ICollectionDb) RestoreCollectionByID(collectionID dbmodel.CollectionID, name string, state string) (int64, error) {
+	ret := _m.Called(collectionID, name, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreCollectionByID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, string, string) (int64, error)); ok {
+		return rf(collectionID, name, state)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, string, string) int64); ok {
+		r0 = rf(collectionID, name, state)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID, string, string) error); ok {
+		r1 = rf(collectionID, name, state)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScanCollections provides a mock function with given fields: startAfter, limit
+func (_m *ICollectionDb) ScanCollections(startAfter *dbmodel.CollectionID, limit int32) ([]*dbmodel.CollectionAndMetadata, error) {
+	ret := _m.Called(startAfter, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScanCollections")
+	}
+
+	var r0 []*dbmodel.CollectionAndMetadata
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*dbmodel.CollectionID, int32) ([]*dbmodel.CollectionAndMetadata, error)); ok {
+		return rf(startAfter, limit)
+	}
+	if rf, ok := ret.Get(0).(func(*dbmodel.CollectionID, int32) []*dbmodel.CollectionAndMetadata); ok {
+		r0 = rf(startAfter, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.CollectionAndMetadata)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*dbmodel.CollectionID, int32) error); ok {
+		r1 = rf(startAfter, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SoftDeleteCollectionByID provides a mock function with given fields: collectionID, actor
+func (_m *ICollectionDb) SoftDeleteCollectionByID(collectionID dbmodel.CollectionID, actor string) (int64, error) {
+	ret := _m.Called(collectionID, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDeleteCollectionByID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, string) (int64, error)); ok {
+		return rf(collectionID, actor)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, string) int64); ok {
+		r0 = rf(collectionID, actor)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID, string) error); ok {
+		r1 = rf(collectionID, actor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: in
 func (_m *ICollectionDb) Update(in *dbmodel.Collection) error {
 	ret := _m.Called(in)
@@ -124,8 +852,84 @@ func (_m *ICollectionDb) Update(in *dbmodel.Collection) error {
 	return r0
 }
 
+// UpdateCollectionName provides a mock function with given fields: collectionID, name
+func (_m *ICollectionDb) UpdateCollectionName(collectionID dbmodel.CollectionID, name string) (int64, error) {
+	ret := _m.Called(collectionID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCollectionName")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, string) (int64, error)); ok {
+		return rf(collectionID, name)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, string) int64); ok {
+		r0 = rf(collectionID, name)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID, string) error); ok {
+		r1 = rf(collectionID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateCollectionSize provides a mock function with given fields: collectionID, totalRecordsPostCompaction, sizeBytesPostCompaction
+func (_m *ICollectionDb) UpdateCollectionSize(collectionID dbmodel.CollectionID, totalRecordsPostCompaction *int64, sizeBytesPostCompaction *int64) (*dbmodel.CollectionSizeChange, error) {
+	ret := _m.Called(collectionID, totalRecordsPostCompaction, sizeBytesPostCompaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCollectionSize")
+	}
+
+	var r0 *dbmodel.CollectionSizeChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, *int64, *int64) (*dbmodel.CollectionSizeChange, error)); ok {
+		return rf(collectionID, totalRecordsPostCompaction, sizeBytesPostCompaction)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, *int64, *int64) *dbmodel.CollectionSizeChange); ok {
+		r0 = rf(collectionID, totalRecordsPostCompaction, sizeBytesPostCompaction)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dbmodel.CollectionSizeChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID, *int64, *int64) error); ok {
+		r1 = rf(collectionID, totalRecordsPostCompaction, sizeBytesPostCompaction)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateLastCompactor provides a mock function with given fields: collectionID, compactor
+func (_m *ICollectionDb) UpdateLastCompactor(collectionID dbmodel.CollectionID, compactor string) error {
+	ret := _m.Called(collectionID, compactor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLastCompactor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, string) error); ok {
+		r0 = rf(collectionID, compactor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateLogPositionAndVersion provides a mock function with given fields: collectionID, logPosition, currentCollectionVersion
-func (_m *ICollectionDb) UpdateLogPositionAndVersion(collectionID string, logPosition int64, currentCollectionVersion int32) (int32, error) {
+func (_m *ICollectionDb) UpdateLogPositionAndVersion(collectionID dbmodel.CollectionID, logPosition int64, currentCollectionVersion int32) (int32, int64, error) {
 	ret := _m.Called(collectionID, logPosition, currentCollectionVersion)
 
 	if len(ret) == 0 {
@@ -133,18 +937,53 @@ func (_m *ICollectionDb) UpdateLogPositionAndVersion(collectionID string, logPos
 	}
 
 	var r0 int32
-	var r1 error
-	if rf, ok := ret.Get(0).(func(string, int64, int32) (int32, error)); ok {
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, int64, int32) (int32, int64, error)); ok {
 		return rf(collectionID, logPosition, currentCollectionVersion)
 	}
-	if rf, ok := ret.Get(0).(func(string, int64, int32) int32); ok {
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, int64, int32) int32); ok {
 		r0 = rf(collectionID, logPosition, currentCollectionVersion)
 	} else {
 		r0 = ret.Get(0).(int32)
 	}
 
-	if rf, ok := ret.Get(1).(func(string, int64, int32) error); ok {
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID, int64, int32) int64); ok {
 		r1 = rf(collectionID, logPosition, currentCollectionVersion)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(dbmodel.CollectionID, int64, int32) error); ok {
+		r2 = rf(collectionID, logPosition, currentCollectionVersion)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// UpdateState provides a mock function with given fields: collectionID, fromStates, toState
+func (_m *ICollectionDb) UpdateState(collectionID dbmodel.CollectionID, fromStates []string, toState string) (int64, error) {
+	ret := _m.Called(collectionID, fromStates, toState)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateState")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, []string, string) (int64, error)); ok {
+		return rf(collectionID, fromStates, toState)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, []string, string) int64); ok {
+		r0 = rf(collectionID, fromStates, toState)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID, []string, string) error); ok {
+		r1 = rf(collectionID, fromStates, toState)
 	} else {
 		r1 = ret.Error(1)
 	}
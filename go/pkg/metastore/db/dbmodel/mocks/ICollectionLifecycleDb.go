@@ -0,0 +1,77 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ICollectionLifecycleDb is an autogenerated mock type for the ICollectionLifecycleDb type
+type ICollectionLifecycleDb struct {
+	mock.Mock
+}
+
+// GetCounts provides a mock function with given fields: tenantID, from, to
+func (_m *ICollectionLifecycleDb) GetCounts(tenantID string, from time.Time, to time.Time) ([]*dbmodel.CollectionLifecycleCount, error) {
+	ret := _m.Called(tenantID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCounts")
+	}
+
+	var r0 []*dbmodel.CollectionLifecycleCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time, time.Time) ([]*dbmodel.CollectionLifecycleCount, error)); ok {
+		return rf(tenantID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time, time.Time) []*dbmodel.CollectionLifecycleCount); ok {
+		r0 = rf(tenantID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.CollectionLifecycleCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time, time.Time) error); ok {
+		r1 = rf(tenantID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Increment provides a mock function with given fields: tenantID, at, event, count
+func (_m *ICollectionLifecycleDb) Increment(tenantID string, at time.Time, event string, count int64) error {
+	ret := _m.Called(tenantID, at, event, count)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Increment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, time.Time, string, int64) error); ok {
+		r0 = rf(tenantID, at, event, count)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewICollectionLifecycleDb creates a new instance of ICollectionLifecycleDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewICollectionLifecycleDb(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ICollectionLifecycleDb {
+	mock := &ICollectionLifecycleDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,191 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ICollectionLogRouteDb is an autogenerated mock type for the ICollectionLogRouteDb type
+type ICollectionLogRouteDb struct {
+	mock.Mock
+}
+
+// DeleteAll provides a mock function with given fields:
+func (_m *ICollectionLogRouteDb) DeleteAll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteByCollectionID provides a mock function with given fields: collectionID
+func (_m *ICollectionLogRouteDb) DeleteByCollectionID(collectionID dbmodel.CollectionID) (int64, error) {
+	ret := _m.Called(collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByCollectionID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) (int64, error)); ok {
+		return rf(collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) int64); ok {
+		r0 = rf(collectionID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID) error); ok {
+		r1 = rf(collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByCollectionIDs provides a mock function with given fields: collectionIDs
+func (_m *ICollectionLogRouteDb) GetByCollectionIDs(collectionIDs []dbmodel.CollectionID) ([]*dbmodel.CollectionLogRoute, error) {
+	ret := _m.Called(collectionIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByCollectionIDs")
+	}
+
+	var r0 []*dbmodel.CollectionLogRoute
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]dbmodel.CollectionID) ([]*dbmodel.CollectionLogRoute, error)); ok {
+		return rf(collectionIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]dbmodel.CollectionID) []*dbmodel.CollectionLogRoute); ok {
+		r0 = rf(collectionIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.CollectionLogRoute)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]dbmodel.CollectionID) error); ok {
+		r1 = rf(collectionIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChangedAfter provides a mock function with given fields: revision
+func (_m *ICollectionLogRouteDb) GetChangedAfter(revision int64) ([]*dbmodel.CollectionLogRoute, error) {
+	ret := _m.Called(revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChangedAfter")
+	}
+
+	var r0 []*dbmodel.CollectionLogRoute
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) ([]*dbmodel.CollectionLogRoute, error)); ok {
+		return rf(revision)
+	}
+	if rf, ok := ret.Get(0).(func(int64) []*dbmodel.CollectionLogRoute); ok {
+		r0 = rf(revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.CollectionLogRoute)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLastRevision provides a mock function with given fields:
+func (_m *ICollectionLogRouteDb) GetLastRevision() (int64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastRevision")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields: collectionID, logAddress
+func (_m *ICollectionLogRouteDb) Upsert(collectionID dbmodel.CollectionID, logAddress string) (*dbmodel.CollectionLogRoute, error) {
+	ret := _m.Called(collectionID, logAddress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 *dbmodel.CollectionLogRoute
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, string) (*dbmodel.CollectionLogRoute, error)); ok {
+		return rf(collectionID, logAddress)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, string) *dbmodel.CollectionLogRoute); ok {
+		r0 = rf(collectionID, logAddress)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dbmodel.CollectionLogRoute)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID, string) error); ok {
+		r1 = rf(collectionID, logAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewICollectionLogRouteDb creates a new instance of ICollectionLogRouteDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewICollectionLogRouteDb(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ICollectionLogRouteDb {
+	mock := &ICollectionLogRouteDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.42.0. DO NOT EDIT.
+// Code generated by mockery v2.42.2. DO NOT EDIT.
 
 package mocks
 
@@ -31,7 +31,7 @@ func (_m *ICollectionMetadataDb) DeleteAll() error {
 }
 
 // DeleteByCollectionID provides a mock function with given fields: collectionID
-func (_m *ICollectionMetadataDb) DeleteByCollectionID(collectionID string) (int, error) {
+func (_m *ICollectionMetadataDb) DeleteByCollectionID(collectionID dbmodel.CollectionID) (int, error) {
 	ret := _m.Called(collectionID)
 
 	if len(ret) == 0 {
@@ -40,16 +40,46 @@ func (_m *ICollectionMetadataDb) DeleteByCollectionID(collectionID string) (int,
 
 	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (int, error)); ok {
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) (int, error)); ok {
 		return rf(collectionID)
 	}
-	if rf, ok := ret.Get(0).(func(string) int); ok {
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) int); ok {
 		r0 = rf(collectionID)
 	} else {
 		r0 = ret.Get(0).(int)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID) error); ok {
+		r1 = rf(collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByCollectionID provides a mock function with given fields: collectionID
+func (_m *ICollectionMetadataDb) GetByCollectionID(collectionID dbmodel.CollectionID) ([]*dbmodel.CollectionMetadata, error) {
+	ret := _m.Called(collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByCollectionID")
+	}
+
+	var r0 []*dbmodel.CollectionMetadata
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) ([]*dbmodel.CollectionMetadata, error)); ok {
+		return rf(collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) []*dbmodel.CollectionMetadata); ok {
+		r0 = rf(collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.CollectionMetadata)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID) error); ok {
 		r1 = rf(collectionID)
 	} else {
 		r1 = ret.Error(1)
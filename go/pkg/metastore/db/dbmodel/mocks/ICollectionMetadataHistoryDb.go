@@ -0,0 +1,118 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ICollectionMetadataHistoryDb is an autogenerated mock type for the ICollectionMetadataHistoryDb type
+type ICollectionMetadataHistoryDb struct {
+	mock.Mock
+}
+
+// DeleteAll provides a mock function with given fields:
+func (_m *ICollectionMetadataHistoryDb) DeleteAll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteByCollectionID provides a mock function with given fields: collectionID
+func (_m *ICollectionMetadataHistoryDb) DeleteByCollectionID(collectionID dbmodel.CollectionID) error {
+	ret := _m.Called(collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByCollectionID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) error); ok {
+		r0 = rf(collectionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAt provides a mock function with given fields: collectionID, revision
+func (_m *ICollectionMetadataHistoryDb) GetAt(collectionID dbmodel.CollectionID, revision int64) ([]*dbmodel.CollectionMetadata, bool, error) {
+	ret := _m.Called(collectionID, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAt")
+	}
+
+	var r0 []*dbmodel.CollectionMetadata
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, int64) ([]*dbmodel.CollectionMetadata, bool, error)); ok {
+		return rf(collectionID, revision)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, int64) []*dbmodel.CollectionMetadata); ok {
+		r0 = rf(collectionID, revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.CollectionMetadata)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID, int64) bool); ok {
+		r1 = rf(collectionID, revision)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(dbmodel.CollectionID, int64) error); ok {
+		r2 = rf(collectionID, revision)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Record provides a mock function with given fields: collectionID, tenantID, revision, metadata
+func (_m *ICollectionMetadataHistoryDb) Record(collectionID dbmodel.CollectionID, tenantID string, revision int64, metadata []*dbmodel.CollectionMetadata) error {
+	ret := _m.Called(collectionID, tenantID, revision, metadata)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Record")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, string, int64, []*dbmodel.CollectionMetadata) error); ok {
+		r0 = rf(collectionID, tenantID, revision, metadata)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewICollectionMetadataHistoryDb creates a new instance of ICollectionMetadataHistoryDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewICollectionMetadataHistoryDb(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ICollectionMetadataHistoryDb {
+	mock := &ICollectionMetadataHistoryDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
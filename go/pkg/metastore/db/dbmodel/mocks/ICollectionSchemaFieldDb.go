@@ -0,0 +1,121 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ICollectionSchemaFieldDb is an autogenerated mock type for the ICollectionSchemaFieldDb type
+type ICollectionSchemaFieldDb struct {
+	mock.Mock
+}
+
+// DeleteAll provides a mock function with given fields:
+func (_m *ICollectionSchemaFieldDb) DeleteAll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteByCollectionID provides a mock function with given fields: collectionID
+func (_m *ICollectionSchemaFieldDb) DeleteByCollectionID(collectionID dbmodel.CollectionID) (int64, error) {
+	ret := _m.Called(collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByCollectionID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) (int64, error)); ok {
+		return rf(collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) int64); ok {
+		r0 = rf(collectionID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID) error); ok {
+		r1 = rf(collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByCollectionID provides a mock function with given fields: collectionID
+func (_m *ICollectionSchemaFieldDb) GetByCollectionID(collectionID dbmodel.CollectionID) ([]*dbmodel.CollectionSchemaField, error) {
+	ret := _m.Called(collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByCollectionID")
+	}
+
+	var r0 []*dbmodel.CollectionSchemaField
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) ([]*dbmodel.CollectionSchemaField, error)); ok {
+		return rf(collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) []*dbmodel.CollectionSchemaField); ok {
+		r0 = rf(collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.CollectionSchemaField)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID) error); ok {
+		r1 = rf(collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Replace provides a mock function with given fields: collectionID, tenantID, fields
+func (_m *ICollectionSchemaFieldDb) Replace(collectionID dbmodel.CollectionID, tenantID string, fields []*dbmodel.CollectionSchemaField) error {
+	ret := _m.Called(collectionID, tenantID, fields)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Replace")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, string, []*dbmodel.CollectionSchemaField) error); ok {
+		r0 = rf(collectionID, tenantID, fields)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewICollectionSchemaFieldDb creates a new instance of ICollectionSchemaFieldDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewICollectionSchemaFieldDb(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ICollectionSchemaFieldDb {
+	mock := &ICollectionSchemaFieldDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,113 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ICollectionSizeHistoryDb is an autogenerated mock type for the ICollectionSizeHistoryDb type
+type ICollectionSizeHistoryDb struct {
+	mock.Mock
+}
+
+// DeleteAll provides a mock function with given fields:
+func (_m *ICollectionSizeHistoryDb) DeleteAll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteBefore provides a mock function with given fields: day
+func (_m *ICollectionSizeHistoryDb) DeleteBefore(day time.Time) error {
+	ret := _m.Called(day)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBefore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Time) error); ok {
+		r0 = rf(day)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: collectionID, since
+func (_m *ICollectionSizeHistoryDb) Get(collectionID dbmodel.CollectionID, since time.Time) ([]*dbmodel.CollectionSizeHistory, error) {
+	ret := _m.Called(collectionID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 []*dbmodel.CollectionSizeHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, time.Time) ([]*dbmodel.CollectionSizeHistory, error)); ok {
+		return rf(collectionID, since)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, time.Time) []*dbmodel.CollectionSizeHistory); ok {
+		r0 = rf(collectionID, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.CollectionSizeHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID, time.Time) error); ok {
+		r1 = rf(collectionID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Snapshot provides a mock function with given fields: day
+func (_m *ICollectionSizeHistoryDb) Snapshot(day time.Time) error {
+	ret := _m.Called(day)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Snapshot")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Time) error); ok {
+		r0 = rf(day)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewICollectionSizeHistoryDb creates a new instance of ICollectionSizeHistoryDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewICollectionSizeHistoryDb(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ICollectionSizeHistoryDb {
+	mock := &ICollectionSizeHistoryDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,143 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ICollectionStatsDb is an autogenerated mock type for the ICollectionStatsDb type
+type ICollectionStatsDb struct {
+	mock.Mock
+}
+
+// DeleteAll provides a mock function with given fields:
+func (_m *ICollectionStatsDb) DeleteAll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetCollectionIDs provides a mock function with given fields:
+func (_m *ICollectionStatsDb) GetCollectionIDs() ([]dbmodel.CollectionID, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionIDs")
+	}
+
+	var r0 []dbmodel.CollectionID
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]dbmodel.CollectionID, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []dbmodel.CollectionID); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dbmodel.CollectionID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: tenantID, databaseName, limit, offset
+func (_m *ICollectionStatsDb) List(tenantID string, databaseName string, limit int32, offset int32) ([]*dbmodel.CollectionStats, error) {
+	ret := _m.Called(tenantID, databaseName, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*dbmodel.CollectionStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int32, int32) ([]*dbmodel.CollectionStats, error)); ok {
+		return rf(tenantID, databaseName, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int32, int32) []*dbmodel.CollectionStats); ok {
+		r0 = rf(tenantID, databaseName, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.CollectionStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int32, int32) error); ok {
+		r1 = rf(tenantID, databaseName, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Refresh provides a mock function with given fields: refreshedAt
+func (_m *ICollectionStatsDb) Refresh(refreshedAt time.Time) error {
+	ret := _m.Called(refreshedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Time) error); ok {
+		r0 = rf(refreshedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateLogLag provides a mock function with given fields: lags
+func (_m *ICollectionStatsDb) UpdateLogLag(lags []*dbmodel.CollectionLogLagStats) error {
+	ret := _m.Called(lags)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLogLag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]*dbmodel.CollectionLogLagStats) error); ok {
+		r0 = rf(lags)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewICollectionStatsDb creates a new instance of ICollectionStatsDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewICollectionStatsDb(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ICollectionStatsDb {
+	mock := &ICollectionStatsDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,179 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ICollectionTemplateDb is an autogenerated mock type for the ICollectionTemplateDb type
+type ICollectionTemplateDb struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *ICollectionTemplateDb) Delete(id string) (bool, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteAll provides a mock function with given fields:
+func (_m *ICollectionTemplateDb) DeleteAll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteByTenantID provides a mock function with given fields: tenantID
+func (_m *ICollectionTemplateDb) DeleteByTenantID(tenantID string) (int64, error) {
+	ret := _m.Called(tenantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByTenantID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int64, error)); ok {
+		return rf(tenantID)
+	}
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(tenantID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: id, tenantID, name
+func (_m *ICollectionTemplateDb) Get(id *string, tenantID string, name *string) ([]*dbmodel.CollectionTemplate, error) {
+	ret := _m.Called(id, tenantID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 []*dbmodel.CollectionTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*string, string, *string) ([]*dbmodel.CollectionTemplate, error)); ok {
+		return rf(id, tenantID, name)
+	}
+	if rf, ok := ret.Get(0).(func(*string, string, *string) []*dbmodel.CollectionTemplate); ok {
+		r0 = rf(id, tenantID, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.CollectionTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*string, string, *string) error); ok {
+		r1 = rf(id, tenantID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetForShare provides a mock function with given fields: id
+func (_m *ICollectionTemplateDb) GetForShare(id string) (*dbmodel.CollectionTemplate, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForShare")
+	}
+
+	var r0 *dbmodel.CollectionTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*dbmodel.CollectionTemplate, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *dbmodel.CollectionTemplate); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dbmodel.CollectionTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: in
+func (_m *ICollectionTemplateDb) Insert(in *dbmodel.CollectionTemplate) error {
+	ret := _m.Called(in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dbmodel.CollectionTemplate) error); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewICollectionTemplateDb creates a new instance of ICollectionTemplateDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewICollectionTemplateDb(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ICollectionTemplateDb {
+	mock := &ICollectionTemplateDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,151 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ICompactionRequestDb is an autogenerated mock type for the ICompactionRequestDb type
+type ICompactionRequestDb struct {
+	mock.Mock
+}
+
+// DeleteAll provides a mock function with given fields:
+func (_m *ICompactionRequestDb) DeleteAll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteByCollectionID provides a mock function with given fields: collectionID
+func (_m *ICompactionRequestDb) DeleteByCollectionID(collectionID dbmodel.CollectionID) (int64, error) {
+	ret := _m.Called(collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByCollectionID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) (int64, error)); ok {
+		return rf(collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) int64); ok {
+		r0 = rf(collectionID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID) error); ok {
+		r1 = rf(collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRequestedBefore provides a mock function with given fields: collectionID, before
+func (_m *ICompactionRequestDb) DeleteRequestedBefore(collectionID dbmodel.CollectionID, before time.Time) (int64, error) {
+	ret := _m.Called(collectionID, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRequestedBefore")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, time.Time) (int64, error)); ok {
+		return rf(collectionID, before)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID, time.Time) int64); ok {
+		r0 = rf(collectionID, before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID, time.Time) error); ok {
+		r1 = rf(collectionID, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: in
+func (_m *ICompactionRequestDb) Insert(in *dbmodel.CompactionRequest) error {
+	ret := _m.Called(in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dbmodel.CompactionRequest) error); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: limit
+func (_m *ICompactionRequestDb) List(limit int32) ([]*dbmodel.CompactionRequest, error) {
+	ret := _m.Called(limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*dbmodel.CompactionRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int32) ([]*dbmodel.CompactionRequest, error)); ok {
+		return rf(limit)
+	}
+	if rf, ok := ret.Get(0).(func(int32) []*dbmodel.CompactionRequest); ok {
+		r0 = rf(limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.CompactionRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int32) error); ok {
+		r1 = rf(limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewICompactionRequestDb creates a new instance of ICompactionRequestDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewICompactionRequestDb(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ICompactionRequestDb {
+	mock := &ICompactionRequestDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
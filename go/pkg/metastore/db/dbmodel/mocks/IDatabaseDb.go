@@ -1,4 +1,4 @@
-// Code generated by mockery v2.33.3. DO NOT EDIT.
+// Code generated by mockery v2.42.2. DO NOT EDIT.
 
 package mocks
 
@@ -16,6 +16,10 @@ type IDatabaseDb struct {
 func (_m *IDatabaseDb) DeleteAll() error {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAll")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func() error); ok {
 		r0 = rf()
@@ -26,10 +30,42 @@ func (_m *IDatabaseDb) DeleteAll() error {
 	return r0
 }
 
+// DeleteByTenantIdAndName provides a mock function with given fields: tenantId, databaseName
+func (_m *IDatabaseDb) DeleteByTenantIdAndName(tenantId string, databaseName string) (int, error) {
+	ret := _m.Called(tenantId, databaseName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByTenantIdAndName")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (int, error)); ok {
+		return rf(tenantId, databaseName)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) int); ok {
+		r0 = rf(tenantId, databaseName)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(tenantId, databaseName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllDatabases provides a mock function with given fields:
 func (_m *IDatabaseDb) GetAllDatabases() ([]*dbmodel.Database, error) {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllDatabases")
+	}
+
 	var r0 []*dbmodel.Database
 	var r1 error
 	if rf, ok := ret.Get(0).(func() ([]*dbmodel.Database, error)); ok {
@@ -52,10 +88,74 @@ func (_m *IDatabaseDb) GetAllDatabases() ([]*dbmodel.Database, error) {
 	return r0, r1
 }
 
+// GetDatabaseByID provides a mock function with given fields: databaseID
+func (_m *IDatabaseDb) GetDatabaseByID(databaseID dbmodel.DatabaseID) (*dbmodel.Database, error) {
+	ret := _m.Called(databaseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatabaseByID")
+	}
+
+	var r0 *dbmodel.Database
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.DatabaseID) (*dbmodel.Database, error)); ok {
+		return rf(databaseID)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.DatabaseID) *dbmodel.Database); ok {
+		r0 = rf(databaseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dbmodel.Database)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.DatabaseID) error); ok {
+		r1 = rf(databaseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDatabaseForUpdate provides a mock function with given fields: databaseID
+func (_m *IDatabaseDb) GetDatabaseForUpdate(databaseID dbmodel.DatabaseID) (*dbmodel.Database, error) {
+	ret := _m.Called(databaseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatabaseForUpdate")
+	}
+
+	var r0 *dbmodel.Database
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.DatabaseID) (*dbmodel.Database, error)); ok {
+		return rf(databaseID)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.DatabaseID) *dbmodel.Database); ok {
+		r0 = rf(databaseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dbmodel.Database)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.DatabaseID) error); ok {
+		r1 = rf(databaseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDatabases provides a mock function with given fields: tenantID, databaseName
 func (_m *IDatabaseDb) GetDatabases(tenantID string, databaseName string) ([]*dbmodel.Database, error) {
 	ret := _m.Called(tenantID, databaseName)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatabases")
+	}
+
 	var r0 []*dbmodel.Database
 	var r1 error
 	if rf, ok := ret.Get(0).(func(string, string) ([]*dbmodel.Database, error)); ok {
@@ -78,10 +178,44 @@ func (_m *IDatabaseDb) GetDatabases(tenantID string, databaseName string) ([]*db
 	return r0, r1
 }
 
+// GetDatabasesByTenantID provides a mock function with given fields: tenantID
+func (_m *IDatabaseDb) GetDatabasesByTenantID(tenantID string) ([]*dbmodel.Database, error) {
+	ret := _m.Called(tenantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatabasesByTenantID")
+	}
+
+	var r0 []*dbmodel.Database
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*dbmodel.Database, error)); ok {
+		return rf(tenantID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*dbmodel.Database); ok {
+		r0 = rf(tenantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Database)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Insert provides a mock function with given fields: in
 func (_m *IDatabaseDb) Insert(in *dbmodel.Database) error {
 	ret := _m.Called(in)
 
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(*dbmodel.Database) error); ok {
 		r0 = rf(in)
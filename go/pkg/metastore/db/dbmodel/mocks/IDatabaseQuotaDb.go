@@ -0,0 +1,151 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IDatabaseQuotaDb is an autogenerated mock type for the IDatabaseQuotaDb type
+type IDatabaseQuotaDb struct {
+	mock.Mock
+}
+
+// DeleteAll provides a mock function with given fields:
+func (_m *IDatabaseQuotaDb) DeleteAll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteByDatabaseID provides a mock function with given fields: databaseID
+func (_m *IDatabaseQuotaDb) DeleteByDatabaseID(databaseID dbmodel.DatabaseID) (int64, error) {
+	ret := _m.Called(databaseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByDatabaseID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.DatabaseID) (int64, error)); ok {
+		return rf(databaseID)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.DatabaseID) int64); ok {
+		r0 = rf(databaseID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.DatabaseID) error); ok {
+		r1 = rf(databaseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: databaseID
+func (_m *IDatabaseQuotaDb) Get(databaseID dbmodel.DatabaseID) (*dbmodel.DatabaseQuota, error) {
+	ret := _m.Called(databaseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *dbmodel.DatabaseQuota
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.DatabaseID) (*dbmodel.DatabaseQuota, error)); ok {
+		return rf(databaseID)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.DatabaseID) *dbmodel.DatabaseQuota); ok {
+		r0 = rf(databaseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dbmodel.DatabaseQuota)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.DatabaseID) error); ok {
+		r1 = rf(databaseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUsage provides a mock function with given fields: tenantID, databaseID
+func (_m *IDatabaseQuotaDb) GetUsage(tenantID string, databaseID dbmodel.DatabaseID) (*dbmodel.TenantUsage, error) {
+	ret := _m.Called(tenantID, databaseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsage")
+	}
+
+	var r0 *dbmodel.TenantUsage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, dbmodel.DatabaseID) (*dbmodel.TenantUsage, error)); ok {
+		return rf(tenantID, databaseID)
+	}
+	if rf, ok := ret.Get(0).(func(string, dbmodel.DatabaseID) *dbmodel.TenantUsage); ok {
+		r0 = rf(tenantID, databaseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dbmodel.TenantUsage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dbmodel.DatabaseID) error); ok {
+		r1 = rf(tenantID, databaseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields: in
+func (_m *IDatabaseQuotaDb) Upsert(in *dbmodel.DatabaseQuota) error {
+	ret := _m.Called(in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dbmodel.DatabaseQuota) error); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewIDatabaseQuotaDb creates a new instance of IDatabaseQuotaDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIDatabaseQuotaDb(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IDatabaseQuotaDb {
+	mock := &IDatabaseQuotaDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
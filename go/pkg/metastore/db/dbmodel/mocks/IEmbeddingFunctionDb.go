@@ -0,0 +1,197 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IEmbeddingFunctionDb is an autogenerated mock type for the IEmbeddingFunctionDb type
+type IEmbeddingFunctionDb struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: id
+func (_m *IEmbeddingFunctionDb) Delete(id string) (bool, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteAll provides a mock function with given fields:
+func (_m *IEmbeddingFunctionDb) DeleteAll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteByTenantID provides a mock function with given fields: tenantID
+func (_m *IEmbeddingFunctionDb) DeleteByTenantID(tenantID string) (int64, error) {
+	ret := _m.Called(tenantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByTenantID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int64, error)); ok {
+		return rf(tenantID)
+	}
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(tenantID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: id, tenantID, name
+func (_m *IEmbeddingFunctionDb) Get(id *string, tenantID string, name *string) ([]*dbmodel.EmbeddingFunction, error) {
+	ret := _m.Called(id, tenantID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 []*dbmodel.EmbeddingFunction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*string, string, *string) ([]*dbmodel.EmbeddingFunction, error)); ok {
+		return rf(id, tenantID, name)
+	}
+	if rf, ok := ret.Get(0).(func(*string, string, *string) []*dbmodel.EmbeddingFunction); ok {
+		r0 = rf(id, tenantID, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.EmbeddingFunction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*string, string, *string) error); ok {
+		r1 = rf(id, tenantID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetForUpdate provides a mock function with given fields: id
+func (_m *IEmbeddingFunctionDb) GetForUpdate(id string) (*dbmodel.EmbeddingFunction, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForUpdate")
+	}
+
+	var r0 *dbmodel.EmbeddingFunction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*dbmodel.EmbeddingFunction, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *dbmodel.EmbeddingFunction); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dbmodel.EmbeddingFunction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: in
+func (_m *IEmbeddingFunctionDb) Insert(in *dbmodel.EmbeddingFunction) error {
+	ret := _m.Called(in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dbmodel.EmbeddingFunction) error); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: in
+func (_m *IEmbeddingFunctionDb) Update(in *dbmodel.EmbeddingFunction) error {
+	ret := _m.Called(in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dbmodel.EmbeddingFunction) error); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewIEmbeddingFunctionDb creates a new instance of IEmbeddingFunctionDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIEmbeddingFunctionDb(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IEmbeddingFunctionDb {
+	mock := &IEmbeddingFunctionDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
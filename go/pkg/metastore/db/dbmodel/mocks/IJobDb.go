@@ -0,0 +1,239 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// IJobDb is an autogenerated mock type for the IJobDb type
+type IJobDb struct {
+	mock.Mock
+}
+
+// Cancel provides a mock function with given fields: id
+func (_m *IJobDb) Cancel(id string) (bool, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cancel")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Claim provides a mock function with given fields: jobTypes, workerID, now, lockedUntil
+func (_m *IJobDb) Claim(jobTypes []string, workerID string, now time.Time, lockedUntil time.Time) (*dbmodel.Job, error) {
+	ret := _m.Called(jobTypes, workerID, now, lockedUntil)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Claim")
+	}
+
+	var r0 *dbmodel.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string, string, time.Time, time.Time) (*dbmodel.Job, error)); ok {
+		return rf(jobTypes, workerID, now, lockedUntil)
+	}
+	if rf, ok := ret.Get(0).(func([]string, string, time.Time, time.Time) *dbmodel.Job); ok {
+		r0 = rf(jobTypes, workerID, now, lockedUntil)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dbmodel.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string, string, time.Time, time.Time) error); ok {
+		r1 = rf(jobTypes, workerID, now, lockedUntil)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteAll provides a mock function with given fields:
+func (_m *IJobDb) DeleteAll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Finish provides a mock function with given fields: id, workerID, state, lastError, nextRunAt
+func (_m *IJobDb) Finish(id string, workerID string, state string, lastError string, nextRunAt time.Time) (bool, error) {
+	ret := _m.Called(id, workerID, state, lastError, nextRunAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Finish")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, time.Time) (bool, error)); ok {
+		return rf(id, workerID, state, lastError, nextRunAt)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string, time.Time) bool); ok {
+		r0 = rf(id, workerID, state, lastError, nextRunAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string, time.Time) error); ok {
+		r1 = rf(id, workerID, state, lastError, nextRunAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: id
+func (_m *IJobDb) Get(id string) (*dbmodel.Job, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *dbmodel.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*dbmodel.Job, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *dbmodel.Job); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dbmodel.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: in
+func (_m *IJobDb) Insert(in *dbmodel.Job) error {
+	ret := _m.Called(in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dbmodel.Job) error); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: tenantID, jobType, state, limit, offset
+func (_m *IJobDb) List(tenantID string, jobType string, state string, limit int32, offset int32) ([]*dbmodel.Job, error) {
+	ret := _m.Called(tenantID, jobType, state, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*dbmodel.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, int32, int32) ([]*dbmodel.Job, error)); ok {
+		return rf(tenantID, jobType, state, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, int32, int32) []*dbmodel.Job); ok {
+		r0 = rf(tenantID, jobType, state, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, int32, int32) error); ok {
+		r1 = rf(tenantID, jobType, state, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RenewLease provides a mock function with given fields: id, workerID, lockedUntil
+func (_m *IJobDb) RenewLease(id string, workerID string, lockedUntil time.Time) (bool, error) {
+	ret := _m.Called(id, workerID, lockedUntil)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenewLease")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) (bool, error)); ok {
+		return rf(id, workerID, lockedUntil)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) bool); ok {
+		r0 = rf(id, workerID, lockedUntil)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, time.Time) error); ok {
+		r1 = rf(id, workerID, lockedUntil)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewIJobDb creates a new instance of IJobDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIJobDb(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IJobDb {
+	mock := &IJobDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
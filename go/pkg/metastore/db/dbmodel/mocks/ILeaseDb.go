@@ -0,0 +1,77 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ILeaseDb is an autogenerated mock type for the ILeaseDb type
+type ILeaseDb struct {
+	mock.Mock
+}
+
+// Release provides a mock function with given fields: name, holder
+func (_m *ILeaseDb) Release(name string, holder string) error {
+	ret := _m.Called(name, holder)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Release")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(name, holder)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TryAcquire provides a mock function with given fields: name, holder, address, ttl
+func (_m *ILeaseDb) TryAcquire(name string, holder string, address string, ttl time.Duration) (*dbmodel.Lease, error) {
+	ret := _m.Called(name, holder, address, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TryAcquire")
+	}
+
+	var r0 *dbmodel.Lease
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, time.Duration) (*dbmodel.Lease, error)); ok {
+		return rf(name, holder, address, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, time.Duration) *dbmodel.Lease); ok {
+		r0 = rf(name, holder, address, ttl)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dbmodel.Lease)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, time.Duration) error); ok {
+		r1 = rf(name, holder, address, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewILeaseDb creates a new instance of ILeaseDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewILeaseDb(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ILeaseDb {
+	mock := &ILeaseDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
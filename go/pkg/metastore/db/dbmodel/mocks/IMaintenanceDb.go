@@ -0,0 +1,60 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// IMaintenanceDb is an autogenerated mock type for the IMaintenanceDb type
+type IMaintenanceDb struct {
+	mock.Mock
+}
+
+// Analyze provides a mock function with given fields: table
+func (_m *IMaintenanceDb) Analyze(table string) error {
+	ret := _m.Called(table)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Analyze")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(table)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Vacuum provides a mock function with given fields: table
+func (_m *IMaintenanceDb) Vacuum(table string) error {
+	ret := _m.Called(table)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Vacuum")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(table)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewIMaintenanceDb creates a new instance of IMaintenanceDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIMaintenanceDb(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IMaintenanceDb {
+	mock := &IMaintenanceDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
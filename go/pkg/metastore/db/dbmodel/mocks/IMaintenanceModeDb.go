@@ -0,0 +1,93 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IMaintenanceModeDb is an autogenerated mock type for the IMaintenanceModeDb type
+type IMaintenanceModeDb struct {
+	mock.Mock
+}
+
+// DeleteAll provides a mock function with given fields:
+func (_m *IMaintenanceModeDb) DeleteAll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields:
+func (_m *IMaintenanceModeDb) Get() (*dbmodel.MaintenanceMode, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *dbmodel.MaintenanceMode
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*dbmodel.MaintenanceMode, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *dbmodel.MaintenanceMode); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dbmodel.MaintenanceMode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields: in
+func (_m *IMaintenanceModeDb) Upsert(in *dbmodel.MaintenanceMode) error {
+	ret := _m.Called(in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dbmodel.MaintenanceMode) error); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewIMaintenanceModeDb creates a new instance of IMaintenanceModeDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIMaintenanceModeDb(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IMaintenanceModeDb {
+	mock := &IMaintenanceModeDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
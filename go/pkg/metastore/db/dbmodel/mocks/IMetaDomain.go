@@ -1,4 +1,4 @@
-// Code generated by mockery v2.33.3. DO NOT EDIT.
+// Code generated by mockery v2.42.2. DO NOT EDIT.
 
 package mocks
 
@@ -14,10 +14,74 @@ type IMetaDomain struct {
 	mock.Mock
 }
 
+// ArchiveDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) ArchiveDb(ctx context.Context) dbmodel.IArchiveDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveDb")
+	}
+
+	var r0 dbmodel.IArchiveDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.IArchiveDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.IArchiveDb)
+		}
+	}
+
+	return r0
+}
+
+// CleanupDeadLetterDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) CleanupDeadLetterDb(ctx context.Context) dbmodel.ICleanupDeadLetterDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CleanupDeadLetterDb")
+	}
+
+	var r0 dbmodel.ICleanupDeadLetterDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ICleanupDeadLetterDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.ICleanupDeadLetterDb)
+		}
+	}
+
+	return r0
+}
+
+// CollectionAclDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) CollectionAclDb(ctx context.Context) dbmodel.ICollectionAclDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CollectionAclDb")
+	}
+
+	var r0 dbmodel.ICollectionAclDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ICollectionAclDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.ICollectionAclDb)
+		}
+	}
+
+	return r0
+}
+
 // CollectionDb provides a mock function with given fields: ctx
 func (_m *IMetaDomain) CollectionDb(ctx context.Context) dbmodel.ICollectionDb {
 	ret := _m.Called(ctx)
 
+	if len(ret) == 0 {
+		panic("no return value specified for CollectionDb")
+	}
+
 	var r0 dbmodel.ICollectionDb
 	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ICollectionDb); ok {
 		r0 = rf(ctx)
@@ -30,10 +94,54 @@ func (_m *IMetaDomain) CollectionDb(ctx context.Context) dbmodel.ICollectionDb {
 	return r0
 }
 
+// CollectionLifecycleDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) CollectionLifecycleDb(ctx context.Context) dbmodel.ICollectionLifecycleDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CollectionLifecycleDb")
+	}
+
+	var r0 dbmodel.ICollectionLifecycleDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ICollectionLifecycleDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.ICollectionLifecycleDb)
+		}
+	}
+
+	return r0
+}
+
+// CollectionLogRouteDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) CollectionLogRouteDb(ctx context.Context) dbmodel.ICollectionLogRouteDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CollectionLogRouteDb")
+	}
+
+	var r0 dbmodel.ICollectionLogRouteDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ICollectionLogRouteDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.ICollectionLogRouteDb)
+		}
+	}
+
+	return r0
+}
+
 // CollectionMetadataDb provides a mock function with given fields: ctx
 func (_m *IMetaDomain) CollectionMetadataDb(ctx context.Context) dbmodel.ICollectionMetadataDb {
 	ret := _m.Called(ctx)
 
+	if len(ret) == 0 {
+		panic("no return value specified for CollectionMetadataDb")
+	}
+
 	var r0 dbmodel.ICollectionMetadataDb
 	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ICollectionMetadataDb); ok {
 		r0 = rf(ctx)
@@ -46,10 +154,134 @@ func (_m *IMetaDomain) CollectionMetadataDb(ctx context.Context) dbmodel.ICollec
 	return r0
 }
 
+// CollectionMetadataHistoryDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) CollectionMetadataHistoryDb(ctx context.Context) dbmodel.ICollectionMetadataHistoryDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CollectionMetadataHistoryDb")
+	}
+
+	var r0 dbmodel.ICollectionMetadataHistoryDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ICollectionMetadataHistoryDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.ICollectionMetadataHistoryDb)
+		}
+	}
+
+	return r0
+}
+
+// CollectionSchemaFieldDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) CollectionSchemaFieldDb(ctx context.Context) dbmodel.ICollectionSchemaFieldDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CollectionSchemaFieldDb")
+	}
+
+	var r0 dbmodel.ICollectionSchemaFieldDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ICollectionSchemaFieldDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.ICollectionSchemaFieldDb)
+		}
+	}
+
+	return r0
+}
+
+// CollectionSizeHistoryDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) CollectionSizeHistoryDb(ctx context.Context) dbmodel.ICollectionSizeHistoryDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CollectionSizeHistoryDb")
+	}
+
+	var r0 dbmodel.ICollectionSizeHistoryDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ICollectionSizeHistoryDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.ICollectionSizeHistoryDb)
+		}
+	}
+
+	return r0
+}
+
+// CollectionStatsDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) CollectionStatsDb(ctx context.Context) dbmodel.ICollectionStatsDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CollectionStatsDb")
+	}
+
+	var r0 dbmodel.ICollectionStatsDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ICollectionStatsDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.ICollectionStatsDb)
+		}
+	}
+
+	return r0
+}
+
+// CollectionTemplateDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) CollectionTemplateDb(ctx context.Context) dbmodel.ICollectionTemplateDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CollectionTemplateDb")
+	}
+
+	var r0 dbmodel.ICollectionTemplateDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ICollectionTemplateDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.ICollectionTemplateDb)
+		}
+	}
+
+	return r0
+}
+
+// CompactionRequestDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) CompactionRequestDb(ctx context.Context) dbmodel.ICompactionRequestDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompactionRequestDb")
+	}
+
+	var r0 dbmodel.ICompactionRequestDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ICompactionRequestDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.ICompactionRequestDb)
+		}
+	}
+
+	return r0
+}
+
 // DatabaseDb provides a mock function with given fields: ctx
 func (_m *IMetaDomain) DatabaseDb(ctx context.Context) dbmodel.IDatabaseDb {
 	ret := _m.Called(ctx)
 
+	if len(ret) == 0 {
+		panic("no return value specified for DatabaseDb")
+	}
+
 	var r0 dbmodel.IDatabaseDb
 	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.IDatabaseDb); ok {
 		r0 = rf(ctx)
@@ -62,10 +294,194 @@ func (_m *IMetaDomain) DatabaseDb(ctx context.Context) dbmodel.IDatabaseDb {
 	return r0
 }
 
+// DatabaseQuotaDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) DatabaseQuotaDb(ctx context.Context) dbmodel.IDatabaseQuotaDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DatabaseQuotaDb")
+	}
+
+	var r0 dbmodel.IDatabaseQuotaDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.IDatabaseQuotaDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.IDatabaseQuotaDb)
+		}
+	}
+
+	return r0
+}
+
+// EmbeddingFunctionDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) EmbeddingFunctionDb(ctx context.Context) dbmodel.IEmbeddingFunctionDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EmbeddingFunctionDb")
+	}
+
+	var r0 dbmodel.IEmbeddingFunctionDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.IEmbeddingFunctionDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.IEmbeddingFunctionDb)
+		}
+	}
+
+	return r0
+}
+
+// FeatureFlagDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) FeatureFlagDb(ctx context.Context) dbmodel.IFeatureFlagDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FeatureFlagDb")
+	}
+
+	var r0 dbmodel.IFeatureFlagDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.IFeatureFlagDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.IFeatureFlagDb)
+		}
+	}
+
+	return r0
+}
+
+// JobDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) JobDb(ctx context.Context) dbmodel.IJobDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for JobDb")
+	}
+
+	var r0 dbmodel.IJobDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.IJobDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.IJobDb)
+		}
+	}
+
+	return r0
+}
+
+// LeaseDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) LeaseDb(ctx context.Context) dbmodel.ILeaseDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LeaseDb")
+	}
+
+	var r0 dbmodel.ILeaseDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ILeaseDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.ILeaseDb)
+		}
+	}
+
+	return r0
+}
+
+// MaintenanceDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) MaintenanceDb(ctx context.Context) dbmodel.IMaintenanceDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaintenanceDb")
+	}
+
+	var r0 dbmodel.IMaintenanceDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.IMaintenanceDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.IMaintenanceDb)
+		}
+	}
+
+	return r0
+}
+
+// MaintenanceModeDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) MaintenanceModeDb(ctx context.Context) dbmodel.IMaintenanceModeDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaintenanceModeDb")
+	}
+
+	var r0 dbmodel.IMaintenanceModeDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.IMaintenanceModeDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.IMaintenanceModeDb)
+		}
+	}
+
+	return r0
+}
+
+// NodeDecommissionDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) NodeDecommissionDb(ctx context.Context) dbmodel.INodeDecommissionDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NodeDecommissionDb")
+	}
+
+	var r0 dbmodel.INodeDecommissionDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.INodeDecommissionDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.INodeDecommissionDb)
+		}
+	}
+
+	return r0
+}
+
+// NodeHeartbeatDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) NodeHeartbeatDb(ctx context.Context) dbmodel.INodeHeartbeatDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NodeHeartbeatDb")
+	}
+
+	var r0 dbmodel.INodeHeartbeatDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.INodeHeartbeatDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.INodeHeartbeatDb)
+		}
+	}
+
+	return r0
+}
+
 // NotificationDb provides a mock function with given fields: ctx
 func (_m *IMetaDomain) NotificationDb(ctx context.Context) dbmodel.INotificationDb {
 	ret := _m.Called(ctx)
 
+	if len(ret) == 0 {
+		panic("no return value specified for NotificationDb")
+	}
+
 	var r0 dbmodel.INotificationDb
 	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.INotificationDb); ok {
 		r0 = rf(ctx)
@@ -82,6 +498,10 @@ func (_m *IMetaDomain) NotificationDb(ctx context.Context) dbmodel.INotification
 func (_m *IMetaDomain) SegmentDb(ctx context.Context) dbmodel.ISegmentDb {
 	ret := _m.Called(ctx)
 
+	if len(ret) == 0 {
+		panic("no return value specified for SegmentDb")
+	}
+
 	var r0 dbmodel.ISegmentDb
 	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ISegmentDb); ok {
 		r0 = rf(ctx)
@@ -94,10 +514,34 @@ func (_m *IMetaDomain) SegmentDb(ctx context.Context) dbmodel.ISegmentDb {
 	return r0
 }
 
+// SegmentFileDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) SegmentFileDb(ctx context.Context) dbmodel.ISegmentFileDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SegmentFileDb")
+	}
+
+	var r0 dbmodel.ISegmentFileDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ISegmentFileDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.ISegmentFileDb)
+		}
+	}
+
+	return r0
+}
+
 // SegmentMetadataDb provides a mock function with given fields: ctx
 func (_m *IMetaDomain) SegmentMetadataDb(ctx context.Context) dbmodel.ISegmentMetadataDb {
 	ret := _m.Called(ctx)
 
+	if len(ret) == 0 {
+		panic("no return value specified for SegmentMetadataDb")
+	}
+
 	var r0 dbmodel.ISegmentMetadataDb
 	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ISegmentMetadataDb); ok {
 		r0 = rf(ctx)
@@ -114,6 +558,10 @@ func (_m *IMetaDomain) SegmentMetadataDb(ctx context.Context) dbmodel.ISegmentMe
 func (_m *IMetaDomain) TenantDb(ctx context.Context) dbmodel.ITenantDb {
 	ret := _m.Called(ctx)
 
+	if len(ret) == 0 {
+		panic("no return value specified for TenantDb")
+	}
+
 	var r0 dbmodel.ITenantDb
 	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ITenantDb); ok {
 		r0 = rf(ctx)
@@ -126,6 +574,66 @@ func (_m *IMetaDomain) TenantDb(ctx context.Context) dbmodel.ITenantDb {
 	return r0
 }
 
+// TenantQuotaDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) TenantQuotaDb(ctx context.Context) dbmodel.ITenantQuotaDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TenantQuotaDb")
+	}
+
+	var r0 dbmodel.ITenantQuotaDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ITenantQuotaDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.ITenantQuotaDb)
+		}
+	}
+
+	return r0
+}
+
+// TenantRegionDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) TenantRegionDb(ctx context.Context) dbmodel.ITenantRegionDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TenantRegionDb")
+	}
+
+	var r0 dbmodel.ITenantRegionDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.ITenantRegionDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.ITenantRegionDb)
+		}
+	}
+
+	return r0
+}
+
+// UsageCounterDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) UsageCounterDb(ctx context.Context) dbmodel.IUsageCounterDb {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UsageCounterDb")
+	}
+
+	var r0 dbmodel.IUsageCounterDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.IUsageCounterDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.IUsageCounterDb)
+		}
+	}
+
+	return r0
+}
+
 // NewIMetaDomain creates a new instance of IMetaDomain. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewIMetaDomain(t interface {
@@ -0,0 +1,151 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// INodeDecommissionDb is an autogenerated mock type for the INodeDecommissionDb type
+type INodeDecommissionDb struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: nodeID
+func (_m *INodeDecommissionDb) Delete(nodeID string) (bool, error) {
+	ret := _m.Called(nodeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return rf(nodeID)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(nodeID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(nodeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteAll provides a mock function with given fields:
+func (_m *INodeDecommissionDb) DeleteAll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: nodeID
+func (_m *INodeDecommissionDb) Get(nodeID string) (*dbmodel.NodeDecommission, error) {
+	ret := _m.Called(nodeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *dbmodel.NodeDecommission
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*dbmodel.NodeDecommission, error)); ok {
+		return rf(nodeID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *dbmodel.NodeDecommission); ok {
+		r0 = rf(nodeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dbmodel.NodeDecommission)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(nodeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAll provides a mock function with given fields:
+func (_m *INodeDecommissionDb) GetAll() ([]*dbmodel.NodeDecommission, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []*dbmodel.NodeDecommission
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*dbmodel.NodeDecommission, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*dbmodel.NodeDecommission); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.NodeDecommission)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: in
+func (_m *INodeDecommissionDb) Insert(in *dbmodel.NodeDecommission) error {
+	ret := _m.Called(in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*dbmodel.NodeDecommission) error); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewINodeDecommissionDb creates a new instance of INodeDecommissionDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewINodeDecommissionDb(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *INodeDecommissionDb {
+	mock := &INodeDecommissionDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
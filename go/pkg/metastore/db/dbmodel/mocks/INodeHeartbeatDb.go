@@ -0,0 +1,123 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// INodeHeartbeatDb is an autogenerated mock type for the INodeHeartbeatDb type
+type INodeHeartbeatDb struct {
+	mock.Mock
+}
+
+// DeleteAll provides a mock function with given fields:
+func (_m *INodeHeartbeatDb) DeleteAll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteBefore provides a mock function with given fields: before
+func (_m *INodeHeartbeatDb) DeleteBefore(before time.Time) (int64, error) {
+	ret := _m.Called(before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBefore")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) (int64, error)); ok {
+		return rf(before)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) int64); ok {
+		r0 = rf(before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAll provides a mock function with given fields:
+func (_m *INodeHeartbeatDb) GetAll() ([]*dbmodel.NodeHeartbeat, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []*dbmodel.NodeHeartbeat
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*dbmodel.NodeHeartbeat, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*dbmodel.NodeHeartbeat); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.NodeHeartbeat)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields: nodeID, lastSeen
+func (_m *INodeHeartbeatDb) Upsert(nodeID string, lastSeen time.Time) error {
+	ret := _m.Called(nodeID, lastSeen)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) error); ok {
+		r0 = rf(nodeID, lastSeen)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewINodeHeartbeatDb creates a new instance of INodeHeartbeatDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewINodeHeartbeatDb(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *INodeHeartbeatDb {
+	mock := &INodeHeartbeatDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.33.3. DO NOT EDIT.
+// Code generated by mockery v2.42.2. DO NOT EDIT.
 
 package mocks
 
@@ -16,6 +16,10 @@ type INotificationDb struct {
 func (_m *INotificationDb) Delete(id []int64) error {
 	ret := _m.Called(id)
 
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func([]int64) error); ok {
 		r0 = rf(id)
@@ -30,6 +34,10 @@ func (_m *INotificationDb) Delete(id []int64) error {
 func (_m *INotificationDb) DeleteAll() error {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAll")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func() error); ok {
 		r0 = rf()
@@ -44,6 +52,10 @@ func (_m *INotificationDb) DeleteAll() error {
 func (_m *INotificationDb) GetAllPendingNotifications() ([]*dbmodel.Notification, error) {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllPendingNotifications")
+	}
+
 	var r0 []*dbmodel.Notification
 	var r1 error
 	if rf, ok := ret.Get(0).(func() ([]*dbmodel.Notification, error)); ok {
@@ -67,15 +79,19 @@ func (_m *INotificationDb) GetAllPendingNotifications() ([]*dbmodel.Notification
 }
 
 // GetNotificationByCollectionID provides a mock function with given fields: collectionID
-func (_m *INotificationDb) GetNotificationByCollectionID(collectionID string) ([]*dbmodel.Notification, error) {
+func (_m *INotificationDb) GetNotificationByCollectionID(collectionID dbmodel.CollectionID) ([]*dbmodel.Notification, error) {
 	ret := _m.Called(collectionID)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationByCollectionID")
+	}
+
 	var r0 []*dbmodel.Notification
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) ([]*dbmodel.Notification, error)); ok {
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) ([]*dbmodel.Notification, error)); ok {
 		return rf(collectionID)
 	}
-	if rf, ok := ret.Get(0).(func(string) []*dbmodel.Notification); ok {
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) []*dbmodel.Notification); ok {
 		r0 = rf(collectionID)
 	} else {
 		if ret.Get(0) != nil {
@@ -83,7 +99,7 @@ func (_m *INotificationDb) GetNotificationByCollectionID(collectionID string) ([
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID) error); ok {
 		r1 = rf(collectionID)
 	} else {
 		r1 = ret.Error(1)
@@ -96,6 +112,10 @@ func (_m *INotificationDb) GetNotificationByCollectionID(collectionID string) ([
 func (_m *INotificationDb) Insert(in *dbmodel.Notification) error {
 	ret := _m.Called(in)
 
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(*dbmodel.Notification) error); ok {
 		r0 = rf(in)
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.33.3. DO NOT EDIT.
+// Code generated by mockery v2.42.2. DO NOT EDIT.
 
 package mocks
 
@@ -6,6 +6,8 @@ import (
 	dbmodel "github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
 	mock "github.com/stretchr/testify/mock"
 
+	model "github.com/chroma-core/chroma/go/pkg/model"
+
 	types "github.com/chroma-core/chroma/go/pkg/types"
 )
 
@@ -18,6 +20,10 @@ type ISegmentDb struct {
 func (_m *ISegmentDb) DeleteAll() error {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAll")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func() error); ok {
 		r0 = rf()
@@ -29,11 +35,15 @@ func (_m *ISegmentDb) DeleteAll() error {
 }
 
 // DeleteSegmentByID provides a mock function with given fields: id
-func (_m *ISegmentDb) DeleteSegmentByID(id string) error {
+func (_m *ISegmentDb) DeleteSegmentByID(id dbmodel.SegmentID) error {
 	ret := _m.Called(id)
 
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSegmentByID")
+	}
+
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
+	if rf, ok := ret.Get(0).(func(dbmodel.SegmentID) error); ok {
 		r0 = rf(id)
 	} else {
 		r0 = ret.Error(0)
@@ -42,25 +52,29 @@ func (_m *ISegmentDb) DeleteSegmentByID(id string) error {
 	return r0
 }
 
-// GetSegments provides a mock function with given fields: id, segmentType, scope, collectionID
-func (_m *ISegmentDb) GetSegments(id types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID) ([]*dbmodel.SegmentAndMetadata, error) {
-	ret := _m.Called(id, segmentType, scope, collectionID)
+// GetSegments provides a mock function with given fields: id, segmentType, scope, collectionID, limit, offset
+func (_m *ISegmentDb) GetSegments(id types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID, limit *int32, offset *int32) ([]*dbmodel.SegmentAndMetadata, error) {
+	ret := _m.Called(id, segmentType, scope, collectionID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSegments")
+	}
 
 	var r0 []*dbmodel.SegmentAndMetadata
 	var r1 error
-	if rf, ok := ret.Get(0).(func(types.UniqueID, *string, *string, types.UniqueID) ([]*dbmodel.SegmentAndMetadata, error)); ok {
-		return rf(id, segmentType, scope, collectionID)
+	if rf, ok := ret.Get(0).(func(types.UniqueID, *string, *string, types.UniqueID, *int32, *int32) ([]*dbmodel.SegmentAndMetadata, error)); ok {
+		return rf(id, segmentType, scope, collectionID, limit, offset)
 	}
-	if rf, ok := ret.Get(0).(func(types.UniqueID, *string, *string, types.UniqueID) []*dbmodel.SegmentAndMetadata); ok {
-		r0 = rf(id, segmentType, scope, collectionID)
+	if rf, ok := ret.Get(0).(func(types.UniqueID, *string, *string, types.UniqueID, *int32, *int32) []*dbmodel.SegmentAndMetadata); ok {
+		r0 = rf(id, segmentType, scope, collectionID, limit, offset)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*dbmodel.SegmentAndMetadata)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(types.UniqueID, *string, *string, types.UniqueID) error); ok {
-		r1 = rf(id, segmentType, scope, collectionID)
+	if rf, ok := ret.Get(1).(func(types.UniqueID, *string, *string, types.UniqueID, *int32, *int32) error); ok {
+		r1 = rf(id, segmentType, scope, collectionID, limit, offset)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -72,6 +86,10 @@ func (_m *ISegmentDb) GetSegments(id types.UniqueID, segmentType *string, scope
 func (_m *ISegmentDb) Insert(_a0 *dbmodel.Segment) error {
 	ret := _m.Called(_a0)
 
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(*dbmodel.Segment) error); ok {
 		r0 = rf(_a0)
@@ -82,10 +100,32 @@ func (_m *ISegmentDb) Insert(_a0 *dbmodel.Segment) error {
 	return r0
 }
 
+// RegisterFilePaths provides a mock function with given fields: flushSegmentCompactions
+func (_m *ISegmentDb) RegisterFilePaths(flushSegmentCompactions []*model.FlushSegmentCompaction) error {
+	ret := _m.Called(flushSegmentCompactions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterFilePaths")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]*model.FlushSegmentCompaction) error); ok {
+		r0 = rf(flushSegmentCompactions)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: _a0
 func (_m *ISegmentDb) Update(_a0 *dbmodel.UpdateSegment) error {
 	ret := _m.Called(_a0)
 
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(*dbmodel.UpdateSegment) error); ok {
 		r0 = rf(_a0)
@@ -0,0 +1,178 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ISegmentFileDb is an autogenerated mock type for the ISegmentFileDb type
+type ISegmentFileDb struct {
+	mock.Mock
+}
+
+// DeleteAll provides a mock function with given fields:
+func (_m *ISegmentFileDb) DeleteAll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteBySegmentID provides a mock function with given fields: segmentID
+func (_m *ISegmentFileDb) DeleteBySegmentID(segmentID dbmodel.SegmentID) error {
+	ret := _m.Called(segmentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBySegmentID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(dbmodel.SegmentID) error); ok {
+		r0 = rf(segmentID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByCollectionID provides a mock function with given fields: collectionID
+func (_m *ISegmentFileDb) GetByCollectionID(collectionID dbmodel.CollectionID) ([]*dbmodel.SegmentFileAndReferences, error) {
+	ret := _m.Called(collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByCollectionID")
+	}
+
+	var r0 []*dbmodel.SegmentFileAndReferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) ([]*dbmodel.SegmentFileAndReferences, error)); ok {
+		return rf(collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(dbmodel.CollectionID) []*dbmodel.SegmentFileAndReferences); ok {
+		r0 = rf(collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.SegmentFileAndReferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(dbmodel.CollectionID) error); ok {
+		r1 = rf(collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDuplicates provides a mock function with given fields: tenantID, limit
+func (_m *ISegmentFileDb) GetDuplicates(tenantID string, limit int32) ([]*dbmodel.SegmentFile, error) {
+	ret := _m.Called(tenantID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDuplicates")
+	}
+
+	var r0 []*dbmodel.SegmentFile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int32) ([]*dbmodel.SegmentFile, error)); ok {
+		return rf(tenantID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int32) []*dbmodel.SegmentFile); ok {
+		r0 = rf(tenantID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.SegmentFile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int32) error); ok {
+		r1 = rf(tenantID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTenantStorage provides a mock function with given fields: tenantID
+func (_m *ISegmentFileDb) GetTenantStorage(tenantID string) ([]*dbmodel.CollectionStorage, int64, error) {
+	ret := _m.Called(tenantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTenantStorage")
+	}
+
+	var r0 []*dbmodel.CollectionStorage
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string) ([]*dbmodel.CollectionStorage, int64, error)); ok {
+		return rf(tenantID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*dbmodel.CollectionStorage); ok {
+		r0 = rf(tenantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.CollectionStorage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) int64); ok {
+		r1 = rf(tenantID)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(string) error); ok {
+		r2 = rf(tenantID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ReplaceBySegmentID provides a mock function with given fields: segmentID, files
+func (_m *ISegmentFileDb) ReplaceBySegmentID(segmentID dbmodel.SegmentID, files []*dbmodel.SegmentFile) error {
+	ret := _m.Called(segmentID, files)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceBySegmentID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(dbmodel.SegmentID, []*dbmodel.SegmentFile) error); ok {
+		r0 = rf(segmentID, files)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewISegmentFileDb creates a new instance of ISegmentFileDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewISegmentFileDb(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ISegmentFileDb {
+	mock := &ISegmentFileDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.33.3. DO NOT EDIT.
+// Code generated by mockery v2.42.2. DO NOT EDIT.
 
 package mocks
 
@@ -16,6 +16,10 @@ type ISegmentMetadataDb struct {
 func (_m *ISegmentMetadataDb) DeleteAll() error {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAll")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func() error); ok {
 		r0 = rf()
@@ -27,11 +31,15 @@ func (_m *ISegmentMetadataDb) DeleteAll() error {
 }
 
 // DeleteBySegmentID provides a mock function with given fields: segmentID
-func (_m *ISegmentMetadataDb) DeleteBySegmentID(segmentID string) error {
+func (_m *ISegmentMetadataDb) DeleteBySegmentID(segmentID dbmodel.SegmentID) error {
 	ret := _m.Called(segmentID)
 
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBySegmentID")
+	}
+
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string) error); ok {
+	if rf, ok := ret.Get(0).(func(dbmodel.SegmentID) error); ok {
 		r0 = rf(segmentID)
 	} else {
 		r0 = ret.Error(0)
@@ -41,11 +49,15 @@ func (_m *ISegmentMetadataDb) DeleteBySegmentID(segmentID string) error {
 }
 
 // DeleteBySegmentIDAndKeys provides a mock function with given fields: segmentID, keys
-func (_m *ISegmentMetadataDb) DeleteBySegmentIDAndKeys(segmentID string, keys []string) error {
+func (_m *ISegmentMetadataDb) DeleteBySegmentIDAndKeys(segmentID dbmodel.SegmentID, keys []string) error {
 	ret := _m.Called(segmentID, keys)
 
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBySegmentIDAndKeys")
+	}
+
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, []string) error); ok {
+	if rf, ok := ret.Get(0).(func(dbmodel.SegmentID, []string) error); ok {
 		r0 = rf(segmentID, keys)
 	} else {
 		r0 = ret.Error(0)
@@ -58,6 +70,10 @@ func (_m *ISegmentMetadataDb) DeleteBySegmentIDAndKeys(segmentID string, keys []
 func (_m *ISegmentMetadataDb) Insert(in []*dbmodel.SegmentMetadata) error {
 	ret := _m.Called(in)
 
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func([]*dbmodel.SegmentMetadata) error); ok {
 		r0 = rf(in)
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.33.3. DO NOT EDIT.
+// Code generated by mockery v2.42.2. DO NOT EDIT.
 
 package mocks
 
@@ -16,6 +16,10 @@ type ITenantDb struct {
 func (_m *ITenantDb) DeleteAll() error {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAll")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func() error); ok {
 		r0 = rf()
@@ -26,10 +30,42 @@ func (_m *ITenantDb) DeleteAll() error {
 	return r0
 }
 
+// DeleteByID provides a mock function with given fields: tenantID
+func (_m *ITenantDb) DeleteByID(tenantID string) (int, error) {
+	ret := _m.Called(tenantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByID")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int, error)); ok {
+		return rf(tenantID)
+	}
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(tenantID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllTenants provides a mock function with given fields:
 func (_m *ITenantDb) GetAllTenants() ([]*dbmodel.Tenant, error) {
 	ret := _m.Called()
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllTenants")
+	}
+
 	var r0 []*dbmodel.Tenant
 	var r1 error
 	if rf, ok := ret.Get(0).(func() ([]*dbmodel.Tenant, error)); ok {
@@ -56,6 +92,10 @@ func (_m *ITenantDb) GetAllTenants() ([]*dbmodel.Tenant, error) {
 func (_m *ITenantDb) GetTenants(tenantID string) ([]*dbmodel.Tenant, error) {
 	ret := _m.Called(tenantID)
 
+	if len(ret) == 0 {
+		panic("no return value specified for GetTenants")
+	}
+
 	var r0 []*dbmodel.Tenant
 	var r1 error
 	if rf, ok := ret.Get(0).(func(string) ([]*dbmodel.Tenant, error)); ok {
@@ -78,10 +118,44 @@ func (_m *ITenantDb) GetTenants(tenantID string) ([]*dbmodel.Tenant, error) {
 	return r0, r1
 }
 
+// GetTenantsLastCompactionTime provides a mock function with given fields: tenantIDs
+func (_m *ITenantDb) GetTenantsLastCompactionTime(tenantIDs []string) ([]*dbmodel.Tenant, error) {
+	ret := _m.Called(tenantIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTenantsLastCompactionTime")
+	}
+
+	var r0 []*dbmodel.Tenant
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) ([]*dbmodel.Tenant, error)); ok {
+		return rf(tenantIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]string) []*dbmodel.Tenant); ok {
+		r0 = rf(tenantIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Tenant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(tenantIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Insert provides a mock function with given fields: in
 func (_m *ITenantDb) Insert(in *dbmodel.Tenant) error {
 	ret := _m.Called(in)
 
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(*dbmodel.Tenant) error); ok {
 		r0 = rf(in)
@@ -92,6 +166,42 @@ func (_m *ITenantDb) Insert(in *dbmodel.Tenant) error {
 	return r0
 }
 
+// UpdateTenantLastCompactionTime provides a mock function with given fields: tenantID, lastCompactionTime
+func (_m *ITenantDb) UpdateTenantLastCompactionTime(tenantID string, lastCompactionTime int64) error {
+	ret := _m.Called(tenantID, lastCompactionTime)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTenantLastCompactionTime")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(tenantID, lastCompactionTime)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateTenantsLastCompactionTime provides a mock function with given fields: lastCompactionTimes
+func (_m *ITenantDb) UpdateTenantsLastCompactionTime(lastCompactionTimes map[string]int64) error {
+	ret := _m.Called(lastCompactionTimes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTenantsLastCompactionTime")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(map[string]int64) error); ok {
+		r0 = rf(lastCompactionTimes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewITenantDb creates a new instance of ITenantDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewITenantDb(t interface {
@@ -0,0 +1,93 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ITenantRegionDb is an autogenerated mock type for the ITenantRegionDb type
+type ITenantRegionDb struct {
+	mock.Mock
+}
+
+// DeleteAll provides a mock function with given fields:
+func (_m *ITenantRegionDb) DeleteAll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByTenantID provides a mock function with given fields: tenantID
+func (_m *ITenantRegionDb) GetByTenantID(tenantID string) ([]*dbmodel.TenantRegion, error) {
+	ret := _m.Called(tenantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTenantID")
+	}
+
+	var r0 []*dbmodel.TenantRegion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*dbmodel.TenantRegion, error)); ok {
+		return rf(tenantID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*dbmodel.TenantRegion); ok {
+		r0 = rf(tenantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.TenantRegion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Replace provides a mock function with given fields: tenantID, regions
+func (_m *ITenantRegionDb) Replace(tenantID string, regions []string) error {
+	ret := _m.Called(tenantID, regions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Replace")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []string) error); ok {
+		r0 = rf(tenantID, regions)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewITenantRegionDb creates a new instance of ITenantRegionDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewITenantRegionDb(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ITenantRegionDb {
+	mock := &ITenantRegionDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1,4 +1,4 @@
-// Code generated by mockery v2.33.3. DO NOT EDIT.
+// Code generated by mockery v2.42.2. DO NOT EDIT.
 
 package mocks
 
@@ -6,6 +6,10 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	sql "database/sql"
+
+	time "time"
 )
 
 // ITransaction is an autogenerated mock type for the ITransaction type
@@ -13,10 +17,78 @@ type ITransaction struct {
 	mock.Mock
 }
 
-// Transaction provides a mock function with given fields: ctx, fn
-func (_m *ITransaction) Transaction(ctx context.Context, fn func(context.Context) error) error {
+// HoldSnapshot provides a mock function with given fields: ctx, ttl
+func (_m *ITransaction) HoldSnapshot(ctx context.Context, ttl time.Duration) (string, error) {
+	ret := _m.Called(ctx, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HoldSnapshot")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) (string, error)); ok {
+		return rf(ctx, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) string); ok {
+		r0 = rf(ctx, ttl)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = rf(ctx, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WithReadTx provides a mock function with given fields: ctx, fn
+func (_m *ITransaction) WithReadTx(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithReadTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WithSnapshotTx provides a mock function with given fields: ctx, snapshotID, fn
+func (_m *ITransaction) WithSnapshotTx(ctx context.Context, snapshotID string, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, snapshotID, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithSnapshotTx")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, func(context.Context) error) error); ok {
+		r0 = rf(ctx, snapshotID, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WithWriteTx provides a mock function with given fields: ctx, fn
+func (_m *ITransaction) WithWriteTx(ctx context.Context, fn func(context.Context) error) error {
 	ret := _m.Called(ctx, fn)
 
+	if len(ret) == 0 {
+		panic("no return value specified for WithWriteTx")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
 		r0 = rf(ctx, fn)
@@ -27,6 +99,24 @@ func (_m *ITransaction) Transaction(ctx context.Context, fn func(context.Context
 	return r0
 }
 
+// WithWriteTxIsolation provides a mock function with given fields: ctx, isolation, fn
+func (_m *ITransaction) WithWriteTxIsolation(ctx context.Context, isolation sql.IsolationLevel, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, isolation, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithWriteTxIsolation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, sql.IsolationLevel, func(context.Context) error) error); ok {
+		r0 = rf(ctx, isolation, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewITransaction creates a new instance of ITransaction. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewITransaction(t interface {
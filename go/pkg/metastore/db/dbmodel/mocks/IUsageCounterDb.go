@@ -0,0 +1,121 @@
+// Code generated by mockery v2.42.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbmodel "github.com/chroma-core/chroma/go/pkg/metastore/db/dbmodel"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IUsageCounterDb is an autogenerated mock type for the IUsageCounterDb type
+type IUsageCounterDb struct {
+	mock.Mock
+}
+
+// DeleteAll provides a mock function with given fields:
+func (_m *IUsageCounterDb) DeleteAll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteByTenantID provides a mock function with given fields: tenantID
+func (_m *IUsageCounterDb) DeleteByTenantID(tenantID string) (int64, error) {
+	ret := _m.Called(tenantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByTenantID")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int64, error)); ok {
+		return rf(tenantID)
+	}
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(tenantID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Get provides a mock function with given fields: tenantID, databaseID
+func (_m *IUsageCounterDb) Get(tenantID string, databaseID string) (*dbmodel.UsageCounter, error) {
+	ret := _m.Called(tenantID, databaseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *dbmodel.UsageCounter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*dbmodel.UsageCounter, error)); ok {
+		return rf(tenantID, databaseID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *dbmodel.UsageCounter); ok {
+		r0 = rf(tenantID, databaseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dbmodel.UsageCounter)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(tenantID, databaseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Increment provides a mock function with given fields: tenantID, databaseID, records, sizeBytes
+func (_m *IUsageCounterDb) Increment(tenantID string, databaseID string, records int64, sizeBytes int64) error {
+	ret := _m.Called(tenantID, databaseID, records, sizeBytes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Increment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, int64, int64) error); ok {
+		r0 = rf(tenantID, databaseID, records, sizeBytes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewIUsageCounterDb creates a new instance of IUsageCounterDb. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIUsageCounterDb(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IUsageCounterDb {
+	mock := &IUsageCounterDb{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
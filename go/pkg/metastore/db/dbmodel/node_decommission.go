@@ -0,0 +1,29 @@
+package dbmodel
+
+import "time"
+
+// NodeDecommission is a query node that no collection is placed on anymore. It
+// is removed from the query memberlist once it drained and DrainUntil has
+// passed. Collections is the number of collections placed on it at the start.
+type NodeDecommission struct {
+	ClusterID   string    `gorm:"cluster_id;primaryKey;type:text;default:''"`
+	NodeID      string    `gorm:"node_id;primaryKey;type:text"`
+	DrainUntil  time.Time `gorm:"drain_until;type:timestamptz;not null"`
+	Collections int64     `gorm:"collections;type:bigint;not null;default:0"`
+	CreatedAt   time.Time `gorm:"created_at;type:timestamptz;not null;default:current_timestamp"`
+}
+
+func (v NodeDecommission) TableName() string {
+	return "node_decommissions"
+}
+
+//go:generate mockery --name=INodeDecommissionDb
+type INodeDecommissionDb interface {
+	Insert(in *NodeDecommission) error
+	// Get returns nil when the node is not being decommissioned.
+	Get(nodeID string) (*NodeDecommission, error)
+	GetAll() ([]*NodeDecommission, error)
+	// Delete returns false when the node was not being decommissioned.
+	Delete(nodeID string) (bool, error)
+	DeleteAll() error
+}
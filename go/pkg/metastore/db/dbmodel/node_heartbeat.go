@@ -0,0 +1,22 @@
+package dbmodel
+
+import "time"
+
+// NodeHeartbeat is the last time a worker node reported that it is alive.
+type NodeHeartbeat struct {
+	ClusterID string    `gorm:"cluster_id;primaryKey;type:text;default:''"`
+	NodeID    string    `gorm:"node_id;primaryKey;type:text"`
+	LastSeen  time.Time `gorm:"last_seen;type:timestamptz;not null"`
+}
+
+func (v NodeHeartbeat) TableName() string {
+	return "node_heartbeats"
+}
+
+//go:generate mockery --name=INodeHeartbeatDb
+type INodeHeartbeatDb interface {
+	Upsert(nodeID string, lastSeen time.Time) error
+	GetAll() ([]*NodeHeartbeat, error)
+	DeleteBefore(before time.Time) (int64, error)
+	DeleteAll() error
+}
@@ -1,15 +1,20 @@
 package dbmodel
 
 type Notification struct {
-	ID           int64  `gorm:"id;primaryKey;autoIncrement"`
-	CollectionID string `gorm:"collection_id"`
-	Type         string `gorm:"notification_type"`
-	Status       string `gorm:"status"`
+	ID           int64        `gorm:"id;primaryKey;autoIncrement"`
+	CollectionID CollectionID `gorm:"collection_id;type:uuid"`
+	Type         string       `gorm:"notification_type"`
+	Status       string       `gorm:"status"`
+
+	Cluster
 }
 
 const (
 	NotificationTypeCreateCollection = "create_collection"
 	NotificationTypeDeleteCollection = "delete_collection"
+	// NotificationTypeUpdateCollection is sent when the name, configuration or
+	// metadata of a collection changes.
+	NotificationTypeUpdateCollection = "update_collection"
 )
 
 const (
@@ -22,5 +27,5 @@ type INotificationDb interface {
 	Delete(id []int64) error
 	Insert(in *Notification) error
 	GetAllPendingNotifications() ([]*Notification, error)
-	GetNotificationByCollectionID(collectionID string) ([]*Notification, error)
+	GetNotificationByCollectionID(collectionID CollectionID) ([]*Notification, error)
 }
@@ -0,0 +1,62 @@
+package dbmodel
+
+import "time"
+
+// TenantQuota holds the limits of a tenant. A nil limit is unlimited.
+type TenantQuota struct {
+	ClusterID      string    `gorm:"cluster_id;primaryKey;type:text;default:''"`
+	TenantID       string    `gorm:"tenant_id;primaryKey;type:text"`
+	MaxCollections *int64    `gorm:"max_collections;type:bigint"`
+	MaxRecords     *int64    `gorm:"max_records;type:bigint"`
+	MaxSizeBytes   *int64    `gorm:"max_size_bytes;type:bigint"`
+	CreatedAt      time.Time `gorm:"created_at;type:timestamptz;not null;default:current_timestamp"`
+	UpdatedAt      time.Time `gorm:"updated_at;type:timestamptz;not null;default:current_timestamp"`
+}
+
+func (v TenantQuota) TableName() string {
+	return "tenant_quotas"
+}
+
+// DatabaseQuota overrides the limits of a tenant for one of its databases. A
+// nil limit is inherited from the tenant.
+type DatabaseQuota struct {
+	DatabaseID     DatabaseID `gorm:"database_id;primaryKey;type:uuid"`
+	MaxCollections *int64     `gorm:"max_collections;type:bigint"`
+	MaxRecords     *int64     `gorm:"max_records;type:bigint"`
+	MaxSizeBytes   *int64     `gorm:"max_size_bytes;type:bigint"`
+	CreatedAt      time.Time  `gorm:"created_at;type:timestamptz;not null;default:current_timestamp"`
+	UpdatedAt      time.Time  `gorm:"updated_at;type:timestamptz;not null;default:current_timestamp"`
+
+	Cluster
+}
+
+func (v DatabaseQuota) TableName() string {
+	return "database_quotas"
+}
+
+// TenantUsage is what a tenant uses of its quota. Records and bytes come from
+// the usage counter of the tenant.
+type TenantUsage struct {
+	CollectionCount int64
+	RecordCount     int64
+	SizeBytes       int64
+}
+
+//go:generate mockery --name=ITenantQuotaDb
+type ITenantQuotaDb interface {
+	Upsert(in *TenantQuota) error
+	Get(tenantID string) (*TenantQuota, error)
+	GetUsage(tenantID string) (*TenantUsage, error)
+	DeleteByTenantID(tenantID string) (int64, error)
+	DeleteAll() error
+}
+
+//go:generate mockery --name=IDatabaseQuotaDb
+type IDatabaseQuotaDb interface {
+	Upsert(in *DatabaseQuota) error
+	Get(databaseID DatabaseID) (*DatabaseQuota, error)
+	// GetUsage is what a database uses, in the same shape as the usage of a tenant.
+	GetUsage(tenantID string, databaseID DatabaseID) (*TenantUsage, error)
+	DeleteByDatabaseID(databaseID DatabaseID) (int64, error)
+	DeleteAll() error
+}
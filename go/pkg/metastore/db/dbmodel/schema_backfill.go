@@ -0,0 +1,27 @@
+package dbmodel
+
+import "time"
+
+// The phases of an expand/contract migration, in the order they run.
+const (
+	SchemaBackfillPhaseExpanded    = "expanded"
+	SchemaBackfillPhaseBackfilling = "backfilling"
+	SchemaBackfillPhaseBackfilled  = "backfilled"
+	SchemaBackfillPhaseContracted  = "contracted"
+)
+
+// SchemaBackfill records the progress of an expand/contract migration so that an
+// interrupted backfill resumes where it stopped and the phases cannot be skipped.
+type SchemaBackfill struct {
+	Name           string    `gorm:"name;primaryKey"`
+	TargetTable    string    `gorm:"target_table;type:text;not null"`
+	TargetColumn   string    `gorm:"target_column;type:text;not null"`
+	Phase          string    `gorm:"phase;type:text;not null"`
+	RowsBackfilled int64     `gorm:"rows_backfilled;not null;default:0"`
+	CreatedAt      time.Time `gorm:"created_at;type:timestamptz;not null;default:current_timestamp"`
+	UpdatedAt      time.Time `gorm:"updated_at;type:timestamptz;not null;default:current_timestamp"`
+}
+
+func (v SchemaBackfill) TableName() string {
+	return "schema_backfills"
+}
@@ -13,15 +13,19 @@ type Segment struct {
 	   This requires us to push down CollectionID from the caller. We don't think there is
 	   need to modify CollectionID in the near future. Each Segment should always have a
 	   collection as a parent and cannot be modified. */
-	CollectionID *string             `gorm:"collection_id;primaryKey"`
-	ID           string              `gorm:"id;primaryKey"`
+	CollectionID *CollectionID       `gorm:"collection_id;primaryKey;type:uuid"`
+	ID           SegmentID           `gorm:"id;primaryKey;type:uuid"`
 	Type         string              `gorm:"type;type:string;not null"`
 	Scope        string              `gorm:"scope"`
 	Ts           types.Timestamp     `gorm:"ts;type:bigint;default:0"`
-	IsDeleted    bool                `gorm:"is_deleted;type:bool;default:false"`
-	CreatedAt    time.Time           `gorm:"created_at;type:timestamp;not null;default:current_timestamp"`
-	UpdatedAt    time.Time           `gorm:"updated_at;type:timestamp;not null;default:current_timestamp"`
+	CreatedAt    time.Time           `gorm:"created_at;type:timestamptz;not null;default:current_timestamp"`
+	UpdatedAt    time.Time           `gorm:"updated_at;type:timestamptz;not null;default:current_timestamp"`
 	FilePaths    map[string][]string `gorm:"file_paths;serializer:json;default:'{}'"`
+	// TenantID is the partition key, copied from the collection.
+	TenantID string `gorm:"tenant_id;type:text;not null;default:''"`
+
+	SoftDelete
+	Cluster
 }
 
 func (s Segment) TableName() string {
@@ -34,15 +38,15 @@ type SegmentAndMetadata struct {
 }
 
 type UpdateSegment struct {
-	ID              string
-	Collection      *string
+	ID              SegmentID
+	Collection      *CollectionID
 	ResetCollection bool
 }
 
 //go:generate mockery --name=ISegmentDb
 type ISegmentDb interface {
-	GetSegments(id types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID) ([]*SegmentAndMetadata, error)
-	DeleteSegmentByID(id string) error
+	GetSegments(id types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID, limit *int32, offset *int32) ([]*SegmentAndMetadata, error)
+	DeleteSegmentByID(id SegmentID) error
 	Insert(*Segment) error
 	Update(*UpdateSegment) error
 	DeleteAll() error
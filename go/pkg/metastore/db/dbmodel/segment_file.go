@@ -0,0 +1,55 @@
+package dbmodel
+
+import "time"
+
+// SegmentFile is a file registered for a segment by a compaction flush. The
+// files of a segment mirror its file paths. ContentHash is nil when the
+// compactor did not hash the file, and equal hashes mean the files share their
+// content, e.g. when collections share files.
+type SegmentFile struct {
+	SegmentID    SegmentID    `gorm:"segment_id;primaryKey;type:uuid"`
+	Path         string       `gorm:"path;primaryKey;type:text"`
+	CollectionID CollectionID `gorm:"collection_id;type:uuid;not null;index"`
+	TenantID     string       `gorm:"tenant_id;type:text;not null;default:''"`
+	FileType     string       `gorm:"file_type;type:text;not null;default:''"`
+	ContentHash  *string      `gorm:"content_hash;type:text;index"`
+	SizeBytes    int64        `gorm:"size_bytes;type:bigint;not null;default:0"`
+	CreatedAt    time.Time    `gorm:"created_at;type:timestamptz;not null;default:current_timestamp"`
+
+	Cluster
+}
+
+func (v SegmentFile) TableName() string {
+	return "segment_files"
+}
+
+// SegmentFileAndReferences is a file of a collection with the number of files
+// of other collections that share its content, nil when the file has no hash.
+type SegmentFileAndReferences struct {
+	SegmentFile      *SegmentFile
+	OtherCollections *int64
+}
+
+// CollectionStorage is the storage of the files of a collection, split as in
+// model.StorageAttribution.
+type CollectionStorage struct {
+	CollectionID CollectionID
+	UniqueBytes  int64
+	SharedBytes  int64
+}
+
+//go:generate mockery --name=ISegmentFileDb
+type ISegmentFileDb interface {
+	// ReplaceBySegmentID sets the files of the segment to files.
+	ReplaceBySegmentID(segmentID SegmentID, files []*SegmentFile) error
+	// GetDuplicates returns the files of up to limit content hashes that are
+	// referenced by more than one file, ordered by content hash. An empty
+	// tenantID looks at the files of every tenant.
+	GetDuplicates(tenantID string, limit int32) ([]*SegmentFile, error)
+	GetByCollectionID(collectionID CollectionID) ([]*SegmentFileAndReferences, error)
+	// GetTenantStorage returns the storage of each collection of the tenant that
+	// has files, and the bytes of the tenant counting each content once.
+	GetTenantStorage(tenantID string) ([]*CollectionStorage, int64, error)
+	DeleteBySegmentID(segmentID SegmentID) error
+	DeleteAll() error
+}
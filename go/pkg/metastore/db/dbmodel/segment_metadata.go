@@ -7,15 +7,17 @@ import (
 )
 
 type SegmentMetadata struct {
-	SegmentID  string          `gorm:"segment_id;primaryKey"`
+	SegmentID  SegmentID       `gorm:"segment_id;primaryKey;type:uuid"`
 	Key        *string         `gorm:"key;primaryKey"`
 	StrValue   *string         `gorm:"str_value"`
 	IntValue   *int64          `gorm:"int_value"`
 	FloatValue *float64        `gorm:"float_value"`
 	Ts         types.Timestamp `gorm:"ts;type:bigint;default:0"`
-	CreatedAt  time.Time       `gorm:"created_at;type:timestamp;not null;default:current_timestamp"`
-	UpdatedAt  time.Time       `gorm:"updated_at;type:timestamp;not null;default:current_timestamp"`
+	CreatedAt  time.Time       `gorm:"created_at;type:timestamptz;not null;default:current_timestamp"`
+	UpdatedAt  time.Time       `gorm:"updated_at;type:timestamptz;not null;default:current_timestamp"`
 	BoolValue  *bool           `gorm:"bool_value"`
+
+	Cluster
 }
 
 func (SegmentMetadata) TableName() string {
@@ -24,8 +26,8 @@ func (SegmentMetadata) TableName() string {
 
 //go:generate mockery --name=ISegmentMetadataDb
 type ISegmentMetadataDb interface {
-	DeleteBySegmentID(segmentID string) error
-	DeleteBySegmentIDAndKeys(segmentID string, keys []string) error
+	DeleteBySegmentID(segmentID SegmentID) error
+	DeleteBySegmentIDAndKeys(segmentID SegmentID, keys []string) error
 	Insert(in []*SegmentMetadata) error
 	DeleteAll() error
 }
@@ -0,0 +1,11 @@
+package dbmodel
+
+import "time"
+
+// SoftDelete is embedded by the tables whose rows are marked deleted before they
+// are removed. DeletedAt and DeletedBy are only set while IsDeleted is true.
+type SoftDelete struct {
+	IsDeleted bool       `gorm:"is_deleted;type:bool;default:false"`
+	DeletedAt *time.Time `gorm:"deleted_at;type:timestamptz"`
+	DeletedBy *string    `gorm:"deleted_by;type:text"`
+}
@@ -7,12 +7,16 @@ import (
 )
 
 type Tenant struct {
-	ID                 string          `gorm:"id;primaryKey;unique"`
+	ClusterID          string          `gorm:"cluster_id;primaryKey;type:text;default:''"`
+	ID                 string          `gorm:"id;primaryKey"`
 	Ts                 types.Timestamp `gorm:"ts;type:bigint;default:0"`
-	IsDeleted          bool            `gorm:"is_deleted;type:bool;default:false"`
-	CreatedAt          time.Time       `gorm:"created_at;type:timestamp;not null;default:current_timestamp"`
-	UpdatedAt          time.Time       `gorm:"updated_at;type:timestamp;not null;default:current_timestamp"`
+	CreatedAt          time.Time       `gorm:"created_at;type:timestamptz;not null;default:current_timestamp"`
+	UpdatedAt          time.Time       `gorm:"updated_at;type:timestamptz;not null;default:current_timestamp"`
 	LastCompactionTime int64           `gorm:"last_compaction_time;not null"`
+	// KmsKeyID is the customer managed key new collections of the tenant use.
+	KmsKeyID *string `gorm:"kms_key_id;type:text"`
+
+	SoftDelete
 }
 
 func (v Tenant) TableName() string {
@@ -24,7 +28,11 @@ type ITenantDb interface {
 	GetAllTenants() ([]*Tenant, error)
 	GetTenants(tenantID string) ([]*Tenant, error)
 	Insert(in *Tenant) error
+	DeleteByID(tenantID string) (int, error)
 	DeleteAll() error
 	UpdateTenantLastCompactionTime(tenantID string, lastCompactionTime int64) error
+	// UpdateTenantsLastCompactionTime updates several tenants in one statement,
+	// skipping the ones that do not exist.
+	UpdateTenantsLastCompactionTime(lastCompactionTimes map[string]int64) error
 	GetTenantsLastCompactionTime(tenantIDs []string) ([]*Tenant, error)
 }
@@ -0,0 +1,24 @@
+package dbmodel
+
+import "time"
+
+// TenantRegion is a region the data of a tenant may be placed in. A tenant
+// without regions may be placed anywhere.
+type TenantRegion struct {
+	ClusterID string    `gorm:"cluster_id;primaryKey;type:text;default:''"`
+	TenantID  string    `gorm:"tenant_id;primaryKey;type:text"`
+	Region    string    `gorm:"region;primaryKey;type:text"`
+	CreatedAt time.Time `gorm:"created_at;type:timestamptz;not null;default:current_timestamp"`
+}
+
+func (v TenantRegion) TableName() string {
+	return "tenant_regions"
+}
+
+//go:generate mockery --name=ITenantRegionDb
+type ITenantRegionDb interface {
+	// Replace sets the regions of a tenant, an empty list removes them.
+	Replace(tenantID string, regions []string) error
+	GetByTenantID(tenantID string) ([]*TenantRegion, error)
+	DeleteAll() error
+}
@@ -0,0 +1,29 @@
+package dbmodel
+
+import "time"
+
+// UsageCounter is the running total of the post compaction size of the live
+// collections of a database. The counter of a whole tenant has an empty
+// DatabaseID.
+type UsageCounter struct {
+	ClusterID   string    `gorm:"cluster_id;primaryKey;type:text;default:''"`
+	TenantID    string    `gorm:"tenant_id;primaryKey;type:text"`
+	DatabaseID  string    `gorm:"database_id;primaryKey;type:text"`
+	RecordCount int64     `gorm:"record_count;not null;default:0"`
+	SizeBytes   int64     `gorm:"size_bytes;not null;default:0"`
+	UpdatedAt   time.Time `gorm:"updated_at;type:timestamptz;not null;default:current_timestamp"`
+}
+
+func (v UsageCounter) TableName() string {
+	return "usage_counters"
+}
+
+//go:generate mockery --name=IUsageCounterDb
+type IUsageCounterDb interface {
+	Increment(tenantID string, databaseID string, records int64, sizeBytes int64) error
+	Get(tenantID string, databaseID string) (*UsageCounter, error)
+	// DeleteByTenantID deletes the counters of a tenant, those of its databases
+	// included.
+	DeleteByTenantID(tenantID string) (int64, error)
+	DeleteAll() error
+}
@@ -11,6 +11,8 @@ import (
 
 	model "github.com/chroma-core/chroma/go/pkg/model"
 
+	time "time"
+
 	types "github.com/chroma-core/chroma/go/pkg/types"
 )
 
@@ -19,29 +21,27 @@ type Catalog struct {
 	mock.Mock
 }
 
-// CreateCollection provides a mock function with given fields: ctx, createCollection, ts
-func (_m *Catalog) CreateCollection(ctx context.Context, createCollection *model.CreateCollection, ts int64) (*model.Collection, error) {
-	ret := _m.Called(ctx, createCollection, ts)
+// AcquireCollectionFencingToken provides a mock function with given fields: ctx, collectionID, owner
+func (_m *Catalog) AcquireCollectionFencingToken(ctx context.Context, collectionID types.UniqueID, owner string) (int64, error) {
+	ret := _m.Called(ctx, collectionID, owner)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateCollection")
+		panic("no return value specified for AcquireCollectionFencingToken")
 	}
 
-	var r0 *model.Collection
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateCollection, int64) (*model.Collection, error)); ok {
-		return rf(ctx, createCollection, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, string) (int64, error)); ok {
+		return rf(ctx, collectionID, owner)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateCollection, int64) *model.Collection); ok {
-		r0 = rf(ctx, createCollection, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, string) int64); ok {
+		r0 = rf(ctx, collectionID, owner)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Collection)
-		}
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.CreateCollection, int64) error); ok {
-		r1 = rf(ctx, createCollection, ts)
+	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID, string) error); ok {
+		r1 = rf(ctx, collectionID, owner)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -49,59 +49,69 @@ func (_m *Catalog) CreateCollection(ctx context.Context, createCollection *model
 	return r0, r1
 }
 
-// CreateDatabase provides a mock function with given fields: ctx, createDatabase, ts
-func (_m *Catalog) CreateDatabase(ctx context.Context, createDatabase *model.CreateDatabase, ts int64) (*model.Database, error) {
-	ret := _m.Called(ctx, createDatabase, ts)
+// BatchCreateTenants provides a mock function with given fields: ctx, tenants
+func (_m *Catalog) BatchCreateTenants(ctx context.Context, tenants []*model.BatchCreateTenant) []*model.TenantBatchResult {
+	ret := _m.Called(ctx, tenants)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateDatabase")
+		panic("no return value specified for BatchCreateTenants")
 	}
 
-	var r0 *model.Database
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateDatabase, int64) (*model.Database, error)); ok {
-		return rf(ctx, createDatabase, ts)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateDatabase, int64) *model.Database); ok {
-		r0 = rf(ctx, createDatabase, ts)
+	var r0 []*model.TenantBatchResult
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.BatchCreateTenant) []*model.TenantBatchResult); ok {
+		r0 = rf(ctx, tenants)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Database)
+			r0 = ret.Get(0).([]*model.TenantBatchResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.CreateDatabase, int64) error); ok {
-		r1 = rf(ctx, createDatabase, ts)
+	return r0
+}
+
+// BatchUpdateCollectionMetadata provides a mock function with given fields: ctx, collectionIDs, patch, validate
+func (_m *Catalog) BatchUpdateCollectionMetadata(ctx context.Context, collectionIDs []types.UniqueID, patch *model.CollectionMetadataPatch, validate func(*model.CollectionMetadata[model.CollectionMetadataValueType]) error) []*model.CollectionBatchResult {
+	ret := _m.Called(ctx, collectionIDs, patch, validate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchUpdateCollectionMetadata")
+	}
+
+	var r0 []*model.CollectionBatchResult
+	if rf, ok := ret.Get(0).(func(context.Context, []types.UniqueID, *model.CollectionMetadataPatch, func(*model.CollectionMetadata[model.CollectionMetadataValueType]) error) []*model.CollectionBatchResult); ok {
+		r0 = rf(ctx, collectionIDs, patch, validate)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CollectionBatchResult)
+		}
 	}
 
-	return r0, r1
+	return r0
 }
 
-// CreateSegment provides a mock function with given fields: ctx, createSegment, ts
-func (_m *Catalog) CreateSegment(ctx context.Context, createSegment *model.CreateSegment, ts int64) (*model.Segment, error) {
-	ret := _m.Called(ctx, createSegment, ts)
+// CancelJob provides a mock function with given fields: ctx, jobID
+func (_m *Catalog) CancelJob(ctx context.Context, jobID types.UniqueID) (*model.Job, error) {
+	ret := _m.Called(ctx, jobID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateSegment")
+		panic("no return value specified for CancelJob")
 	}
 
-	var r0 *model.Segment
+	var r0 *model.Job
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateSegment, int64) (*model.Segment, error)); ok {
-		return rf(ctx, createSegment, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID) (*model.Job, error)); ok {
+		return rf(ctx, jobID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateSegment, int64) *model.Segment); ok {
-		r0 = rf(ctx, createSegment, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID) *model.Job); ok {
+		r0 = rf(ctx, jobID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Segment)
+			r0 = ret.Get(0).(*model.Job)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.CreateSegment, int64) error); ok {
-		r1 = rf(ctx, createSegment, ts)
+	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID) error); ok {
+		r1 = rf(ctx, jobID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -109,29 +119,29 @@ func (_m *Catalog) CreateSegment(ctx context.Context, createSegment *model.Creat
 	return r0, r1
 }
 
-// CreateTenant provides a mock function with given fields: ctx, createTenant, ts
-func (_m *Catalog) CreateTenant(ctx context.Context, createTenant *model.CreateTenant, ts int64) (*model.Tenant, error) {
-	ret := _m.Called(ctx, createTenant, ts)
+// CheckCollections provides a mock function with given fields: ctx, collectionIDs
+func (_m *Catalog) CheckCollections(ctx context.Context, collectionIDs []types.UniqueID) ([]*model.CollectionExistence, error) {
+	ret := _m.Called(ctx, collectionIDs)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateTenant")
+		panic("no return value specified for CheckCollections")
 	}
 
-	var r0 *model.Tenant
+	var r0 []*model.CollectionExistence
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateTenant, int64) (*model.Tenant, error)); ok {
-		return rf(ctx, createTenant, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, []types.UniqueID) ([]*model.CollectionExistence, error)); ok {
+		return rf(ctx, collectionIDs)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateTenant, int64) *model.Tenant); ok {
-		r0 = rf(ctx, createTenant, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, []types.UniqueID) []*model.CollectionExistence); ok {
+		r0 = rf(ctx, collectionIDs)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Tenant)
+			r0 = ret.Get(0).([]*model.CollectionExistence)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.CreateTenant, int64) error); ok {
-		r1 = rf(ctx, createTenant, ts)
+	if rf, ok := ret.Get(1).(func(context.Context, []types.UniqueID) error); ok {
+		r1 = rf(ctx, collectionIDs)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -139,35 +149,47 @@ func (_m *Catalog) CreateTenant(ctx context.Context, createTenant *model.CreateT
 	return r0, r1
 }
 
-// DeleteCollection provides a mock function with given fields: ctx, deleteCollection
-func (_m *Catalog) DeleteCollection(ctx context.Context, deleteCollection *model.DeleteCollection) error {
-	ret := _m.Called(ctx, deleteCollection)
+// ClaimJob provides a mock function with given fields: ctx, jobTypes, workerID, lockedUntil
+func (_m *Catalog) ClaimJob(ctx context.Context, jobTypes []string, workerID string, lockedUntil time.Time) (*model.Job, error) {
+	ret := _m.Called(ctx, jobTypes, workerID, lockedUntil)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteCollection")
+		panic("no return value specified for ClaimJob")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.DeleteCollection) error); ok {
-		r0 = rf(ctx, deleteCollection)
+	var r0 *model.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, time.Time) (*model.Job, error)); ok {
+		return rf(ctx, jobTypes, workerID, lockedUntil)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, time.Time) *model.Job); ok {
+		r0 = rf(ctx, jobTypes, workerID, lockedUntil)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Job)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, []string, string, time.Time) error); ok {
+		r1 = rf(ctx, jobTypes, workerID, lockedUntil)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// DeleteSegment provides a mock function with given fields: ctx, segmentID
-func (_m *Catalog) DeleteSegment(ctx context.Context, segmentID types.UniqueID) error {
-	ret := _m.Called(ctx, segmentID)
+// CompleteCollectionReindex provides a mock function with given fields: ctx, collectionID, dimension
+func (_m *Catalog) CompleteCollectionReindex(ctx context.Context, collectionID types.UniqueID, dimension *int32) error {
+	ret := _m.Called(ctx, collectionID, dimension)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteSegment")
+		panic("no return value specified for CompleteCollectionReindex")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID) error); ok {
-		r0 = rf(ctx, segmentID)
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *int32) error); ok {
+		r0 = rf(ctx, collectionID, dimension)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -175,29 +197,27 @@ func (_m *Catalog) DeleteSegment(ctx context.Context, segmentID types.UniqueID)
 	return r0
 }
 
-// FlushCollectionCompaction provides a mock function with given fields: ctx, flushCollectionCompaction
-func (_m *Catalog) FlushCollectionCompaction(ctx context.Context, flushCollectionCompaction *model.FlushCollectionCompaction) (*model.FlushCollectionInfo, error) {
-	ret := _m.Called(ctx, flushCollectionCompaction)
+// CountCollections provides a mock function with given fields: ctx, tenantID, databaseName, includeSoftDeleted
+func (_m *Catalog) CountCollections(ctx context.Context, tenantID string, databaseName string, includeSoftDeleted bool) (int64, error) {
+	ret := _m.Called(ctx, tenantID, databaseName, includeSoftDeleted)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FlushCollectionCompaction")
+		panic("no return value specified for CountCollections")
 	}
 
-	var r0 *model.FlushCollectionInfo
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.FlushCollectionCompaction) (*model.FlushCollectionInfo, error)); ok {
-		return rf(ctx, flushCollectionCompaction)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool) (int64, error)); ok {
+		return rf(ctx, tenantID, databaseName, includeSoftDeleted)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.FlushCollectionCompaction) *model.FlushCollectionInfo); ok {
-		r0 = rf(ctx, flushCollectionCompaction)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool) int64); ok {
+		r0 = rf(ctx, tenantID, databaseName, includeSoftDeleted)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.FlushCollectionInfo)
-		}
+		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.FlushCollectionCompaction) error); ok {
-		r1 = rf(ctx, flushCollectionCompaction)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, bool) error); ok {
+		r1 = rf(ctx, tenantID, databaseName, includeSoftDeleted)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -205,29 +225,29 @@ func (_m *Catalog) FlushCollectionCompaction(ctx context.Context, flushCollectio
 	return r0, r1
 }
 
-// GetAllDatabases provides a mock function with given fields: ctx, ts
-func (_m *Catalog) GetAllDatabases(ctx context.Context, ts int64) ([]*model.Database, error) {
-	ret := _m.Called(ctx, ts)
+// CreateCollection provides a mock function with given fields: ctx, createCollection, ts
+func (_m *Catalog) CreateCollection(ctx context.Context, createCollection *model.CreateCollection, ts int64) (*model.Collection, error) {
+	ret := _m.Called(ctx, createCollection, ts)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAllDatabases")
+		panic("no return value specified for CreateCollection")
 	}
 
-	var r0 []*model.Database
+	var r0 *model.Collection
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*model.Database, error)); ok {
-		return rf(ctx, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateCollection, int64) (*model.Collection, error)); ok {
+		return rf(ctx, createCollection, ts)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) []*model.Database); ok {
-		r0 = rf(ctx, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateCollection, int64) *model.Collection); ok {
+		r0 = rf(ctx, createCollection, ts)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Database)
+			r0 = ret.Get(0).(*model.Collection)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, ts)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CreateCollection, int64) error); ok {
+		r1 = rf(ctx, createCollection, ts)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -235,29 +255,29 @@ func (_m *Catalog) GetAllDatabases(ctx context.Context, ts int64) ([]*model.Data
 	return r0, r1
 }
 
-// GetAllTenants provides a mock function with given fields: ctx, ts
-func (_m *Catalog) GetAllTenants(ctx context.Context, ts int64) ([]*model.Tenant, error) {
-	ret := _m.Called(ctx, ts)
+// CreateCollectionTemplate provides a mock function with given fields: ctx, template
+func (_m *Catalog) CreateCollectionTemplate(ctx context.Context, template *model.CollectionTemplate) (*model.CollectionTemplate, error) {
+	ret := _m.Called(ctx, template)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAllTenants")
+		panic("no return value specified for CreateCollectionTemplate")
 	}
 
-	var r0 []*model.Tenant
+	var r0 *model.CollectionTemplate
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*model.Tenant, error)); ok {
-		return rf(ctx, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CollectionTemplate) (*model.CollectionTemplate, error)); ok {
+		return rf(ctx, template)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) []*model.Tenant); ok {
-		r0 = rf(ctx, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CollectionTemplate) *model.CollectionTemplate); ok {
+		r0 = rf(ctx, template)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Tenant)
+			r0 = ret.Get(0).(*model.CollectionTemplate)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, ts)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CollectionTemplate) error); ok {
+		r1 = rf(ctx, template)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -265,29 +285,29 @@ func (_m *Catalog) GetAllTenants(ctx context.Context, ts int64) ([]*model.Tenant
 	return r0, r1
 }
 
-// GetCollections provides a mock function with given fields: ctx, collectionID, collectionName, tenantID, databaseName, limit, offset
-func (_m *Catalog) GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32) ([]*model.Collection, error) {
-	ret := _m.Called(ctx, collectionID, collectionName, tenantID, databaseName, limit, offset)
+// CreateDatabase provides a mock function with given fields: ctx, createDatabase, ts
+func (_m *Catalog) CreateDatabase(ctx context.Context, createDatabase *model.CreateDatabase, ts int64) (*model.Database, error) {
+	ret := _m.Called(ctx, createDatabase, ts)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetCollections")
+		panic("no return value specified for CreateDatabase")
 	}
 
-	var r0 []*model.Collection
+	var r0 *model.Database
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *string, string, string, *int32, *int32) ([]*model.Collection, error)); ok {
-		return rf(ctx, collectionID, collectionName, tenantID, databaseName, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateDatabase, int64) (*model.Database, error)); ok {
+		return rf(ctx, createDatabase, ts)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *string, string, string, *int32, *int32) []*model.Collection); ok {
-		r0 = rf(ctx, collectionID, collectionName, tenantID, databaseName, limit, offset)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateDatabase, int64) *model.Database); ok {
+		r0 = rf(ctx, createDatabase, ts)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Collection)
+			r0 = ret.Get(0).(*model.Database)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID, *string, string, string, *int32, *int32) error); ok {
-		r1 = rf(ctx, collectionID, collectionName, tenantID, databaseName, limit, offset)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CreateDatabase, int64) error); ok {
+		r1 = rf(ctx, createDatabase, ts)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -295,29 +315,29 @@ func (_m *Catalog) GetCollections(ctx context.Context, collectionID types.Unique
 	return r0, r1
 }
 
-// GetDatabases provides a mock function with given fields: ctx, getDatabase, ts
-func (_m *Catalog) GetDatabases(ctx context.Context, getDatabase *model.GetDatabase, ts int64) (*model.Database, error) {
-	ret := _m.Called(ctx, getDatabase, ts)
+// CreateEmbeddingFunction provides a mock function with given fields: ctx, embeddingFunction
+func (_m *Catalog) CreateEmbeddingFunction(ctx context.Context, embeddingFunction *model.EmbeddingFunction) (*model.EmbeddingFunction, error) {
+	ret := _m.Called(ctx, embeddingFunction)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetDatabases")
+		panic("no return value specified for CreateEmbeddingFunction")
 	}
 
-	var r0 *model.Database
+	var r0 *model.EmbeddingFunction
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.GetDatabase, int64) (*model.Database, error)); ok {
-		return rf(ctx, getDatabase, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.EmbeddingFunction) (*model.EmbeddingFunction, error)); ok {
+		return rf(ctx, embeddingFunction)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.GetDatabase, int64) *model.Database); ok {
-		r0 = rf(ctx, getDatabase, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.EmbeddingFunction) *model.EmbeddingFunction); ok {
+		r0 = rf(ctx, embeddingFunction)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Database)
+			r0 = ret.Get(0).(*model.EmbeddingFunction)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.GetDatabase, int64) error); ok {
-		r1 = rf(ctx, getDatabase, ts)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.EmbeddingFunction) error); ok {
+		r1 = rf(ctx, embeddingFunction)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -325,29 +345,29 @@ func (_m *Catalog) GetDatabases(ctx context.Context, getDatabase *model.GetDatab
 	return r0, r1
 }
 
-// GetSegments provides a mock function with given fields: ctx, segmentID, segmentType, scope, collectionID
-func (_m *Catalog) GetSegments(ctx context.Context, segmentID types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID) ([]*model.Segment, error) {
-	ret := _m.Called(ctx, segmentID, segmentType, scope, collectionID)
+// CreateNodeDecommission provides a mock function with given fields: ctx, decommission
+func (_m *Catalog) CreateNodeDecommission(ctx context.Context, decommission *model.NodeDecommission) (*model.NodeDecommission, error) {
+	ret := _m.Called(ctx, decommission)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSegments")
+		panic("no return value specified for CreateNodeDecommission")
 	}
 
-	var r0 []*model.Segment
+	var r0 *model.NodeDecommission
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *string, *string, types.UniqueID) ([]*model.Segment, error)); ok {
-		return rf(ctx, segmentID, segmentType, scope, collectionID)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.NodeDecommission) (*model.NodeDecommission, error)); ok {
+		return rf(ctx, decommission)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *string, *string, types.UniqueID) []*model.Segment); ok {
-		r0 = rf(ctx, segmentID, segmentType, scope, collectionID)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.NodeDecommission) *model.NodeDecommission); ok {
+		r0 = rf(ctx, decommission)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Segment)
+			r0 = ret.Get(0).(*model.NodeDecommission)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID, *string, *string, types.UniqueID) error); ok {
-		r1 = rf(ctx, segmentID, segmentType, scope, collectionID)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.NodeDecommission) error); ok {
+		r1 = rf(ctx, decommission)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -355,29 +375,29 @@ func (_m *Catalog) GetSegments(ctx context.Context, segmentID types.UniqueID, se
 	return r0, r1
 }
 
-// GetTenants provides a mock function with given fields: ctx, getTenant, ts
-func (_m *Catalog) GetTenants(ctx context.Context, getTenant *model.GetTenant, ts int64) (*model.Tenant, error) {
-	ret := _m.Called(ctx, getTenant, ts)
+// CreateSegment provides a mock function with given fields: ctx, createSegment, ts
+func (_m *Catalog) CreateSegment(ctx context.Context, createSegment *model.CreateSegment, ts int64) (*model.Segment, error) {
+	ret := _m.Called(ctx, createSegment, ts)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTenants")
+		panic("no return value specified for CreateSegment")
 	}
 
-	var r0 *model.Tenant
+	var r0 *model.Segment
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.GetTenant, int64) (*model.Tenant, error)); ok {
-		return rf(ctx, getTenant, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateSegment, int64) (*model.Segment, error)); ok {
+		return rf(ctx, createSegment, ts)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.GetTenant, int64) *model.Tenant); ok {
-		r0 = rf(ctx, getTenant, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateSegment, int64) *model.Segment); ok {
+		r0 = rf(ctx, createSegment, ts)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Tenant)
+			r0 = ret.Get(0).(*model.Segment)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.GetTenant, int64) error); ok {
-		r1 = rf(ctx, getTenant, ts)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CreateSegment, int64) error); ok {
+		r1 = rf(ctx, createSegment, ts)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -385,29 +405,29 @@ func (_m *Catalog) GetTenants(ctx context.Context, getTenant *model.GetTenant, t
 	return r0, r1
 }
 
-// GetTenantsLastCompactionTime provides a mock function with given fields: ctx, tenantIDs
-func (_m *Catalog) GetTenantsLastCompactionTime(ctx context.Context, tenantIDs []string) ([]*dbmodel.Tenant, error) {
-	ret := _m.Called(ctx, tenantIDs)
+// CreateTenant provides a mock function with given fields: ctx, createTenant, ts
+func (_m *Catalog) CreateTenant(ctx context.Context, createTenant *model.CreateTenant, ts int64) (*model.Tenant, error) {
+	ret := _m.Called(ctx, createTenant, ts)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTenantsLastCompactionTime")
+		panic("no return value specified for CreateTenant")
 	}
 
-	var r0 []*dbmodel.Tenant
+	var r0 *model.Tenant
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*dbmodel.Tenant, error)); ok {
-		return rf(ctx, tenantIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateTenant, int64) (*model.Tenant, error)); ok {
+		return rf(ctx, createTenant, ts)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, []string) []*dbmodel.Tenant); ok {
-		r0 = rf(ctx, tenantIDs)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CreateTenant, int64) *model.Tenant); ok {
+		r0 = rf(ctx, createTenant, ts)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*dbmodel.Tenant)
+			r0 = ret.Get(0).(*model.Tenant)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
-		r1 = rf(ctx, tenantIDs)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CreateTenant, int64) error); ok {
+		r1 = rf(ctx, createTenant, ts)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -415,17 +435,17 @@ func (_m *Catalog) GetTenantsLastCompactionTime(ctx context.Context, tenantIDs [
 	return r0, r1
 }
 
-// ResetState provides a mock function with given fields: ctx
-func (_m *Catalog) ResetState(ctx context.Context) error {
-	ret := _m.Called(ctx)
+// DeleteCleanupDeadLetter provides a mock function with given fields: ctx, collectionID, task
+func (_m *Catalog) DeleteCleanupDeadLetter(ctx context.Context, collectionID types.UniqueID, task string) error {
+	ret := _m.Called(ctx, collectionID, task)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ResetState")
+		panic("no return value specified for DeleteCleanupDeadLetter")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, string) error); ok {
+		r0 = rf(ctx, collectionID, task)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -433,17 +453,17 @@ func (_m *Catalog) ResetState(ctx context.Context) error {
 	return r0
 }
 
-// SetTenantLastCompactionTime provides a mock function with given fields: ctx, tenantID, lastCompactionTime
-func (_m *Catalog) SetTenantLastCompactionTime(ctx context.Context, tenantID string, lastCompactionTime int64) error {
-	ret := _m.Called(ctx, tenantID, lastCompactionTime)
+// DeleteCollection provides a mock function with given fields: ctx, deleteCollection
+func (_m *Catalog) DeleteCollection(ctx context.Context, deleteCollection *model.DeleteCollection) error {
+	ret := _m.Called(ctx, deleteCollection)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetTenantLastCompactionTime")
+		panic("no return value specified for DeleteCollection")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
-		r0 = rf(ctx, tenantID, lastCompactionTime)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DeleteCollection) error); ok {
+		r0 = rf(ctx, deleteCollection)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -451,29 +471,2033 @@ func (_m *Catalog) SetTenantLastCompactionTime(ctx context.Context, tenantID str
 	return r0
 }
 
-// UpdateCollection provides a mock function with given fields: ctx, updateCollection, ts
-func (_m *Catalog) UpdateCollection(ctx context.Context, updateCollection *model.UpdateCollection, ts int64) (*model.Collection, error) {
-	ret := _m.Called(ctx, updateCollection, ts)
+// DeleteCollectionAclEntry provides a mock function with given fields: ctx, collectionID, principal
+func (_m *Catalog) DeleteCollectionAclEntry(ctx context.Context, collectionID types.UniqueID, principal string) error {
+	ret := _m.Called(ctx, collectionID, principal)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateCollection")
+		panic("no return value specified for DeleteCollectionAclEntry")
 	}
 
-	var r0 *model.Collection
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.UpdateCollection, int64) (*model.Collection, error)); ok {
-		return rf(ctx, updateCollection, ts)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, string) error); ok {
+		r0 = rf(ctx, collectionID, principal)
+	} else {
+		r0 = ret.Error(0)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.UpdateCollection, int64) *model.Collection); ok {
-		r0 = rf(ctx, updateCollection, ts)
+
+	return r0
+}
+
+// DeleteCollectionTemplate provides a mock function with given fields: ctx, id
+func (_m *Catalog) DeleteCollectionTemplate(ctx context.Context, id types.UniqueID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCollectionTemplate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID) error); ok {
+		r0 = rf(ctx, id)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Collection)
-		}
+		r0 = ret.Error(0)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.UpdateCollection, int64) error); ok {
+	return r0
+}
+
+// DeleteCollections provides a mock function with given fields: ctx, tenantID, databaseName, collectionIDs, actor
+func (_m *Catalog) DeleteCollections(ctx context.Context, tenantID string, databaseName string, collectionIDs []types.UniqueID, actor string) []*model.CollectionBatchResult {
+	ret := _m.Called(ctx, tenantID, databaseName, collectionIDs, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCollections")
+	}
+
+	var r0 []*model.CollectionBatchResult
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []types.UniqueID, string) []*model.CollectionBatchResult); ok {
+		r0 = rf(ctx, tenantID, databaseName, collectionIDs, actor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CollectionBatchResult)
+		}
+	}
+
+	return r0
+}
+
+// DeleteEmbeddingFunction provides a mock function with given fields: ctx, id
+func (_m *Catalog) DeleteEmbeddingFunction(ctx context.Context, id types.UniqueID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEmbeddingFunction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteFeatureFlag provides a mock function with given fields: ctx, name, tenantID
+func (_m *Catalog) DeleteFeatureFlag(ctx context.Context, name string, tenantID string) error {
+	ret := _m.Called(ctx, name, tenantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFeatureFlag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, name, tenantID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteNodeDecommission provides a mock function with given fields: ctx, nodeID
+func (_m *Catalog) DeleteNodeDecommission(ctx context.Context, nodeID string) error {
+	ret := _m.Called(ctx, nodeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteNodeDecommission")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, nodeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteSegment provides a mock function with given fields: ctx, segmentID, reason, actor
+func (_m *Catalog) DeleteSegment(ctx context.Context, segmentID types.UniqueID, reason string, actor string) error {
+	ret := _m.Called(ctx, segmentID, reason, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSegment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, string, string) error); ok {
+		r0 = rf(ctx, segmentID, reason, actor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteTenant provides a mock function with given fields: ctx, deleteTenant
+func (_m *Catalog) DeleteTenant(ctx context.Context, deleteTenant *model.DeleteTenant) error {
+	ret := _m.Called(ctx, deleteTenant)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTenant")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DeleteTenant) error); ok {
+		r0 = rf(ctx, deleteTenant)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EnqueueJob provides a mock function with given fields: ctx, job
+func (_m *Catalog) EnqueueJob(ctx context.Context, job *model.Job) error {
+	ret := _m.Called(ctx, job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Job) error); ok {
+		r0 = rf(ctx, job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FinishJob provides a mock function with given fields: ctx, jobID, workerID, outcome
+func (_m *Catalog) FinishJob(ctx context.Context, jobID types.UniqueID, workerID string, outcome *model.JobOutcome) error {
+	ret := _m.Called(ctx, jobID, workerID, outcome)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, string, *model.JobOutcome) error); ok {
+		r0 = rf(ctx, jobID, workerID, outcome)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FlushCollectionCompaction provides a mock function with given fields: ctx, flushCollectionCompaction
+func (_m *Catalog) FlushCollectionCompaction(ctx context.Context, flushCollectionCompaction *model.FlushCollectionCompaction) (*model.FlushCollectionInfo, error) {
+	ret := _m.Called(ctx, flushCollectionCompaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlushCollectionCompaction")
+	}
+
+	var r0 *model.FlushCollectionInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.FlushCollectionCompaction) (*model.FlushCollectionInfo, error)); ok {
+		return rf(ctx, flushCollectionCompaction)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.FlushCollectionCompaction) *model.FlushCollectionInfo); ok {
+		r0 = rf(ctx, flushCollectionCompaction)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.FlushCollectionInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.FlushCollectionCompaction) error); ok {
+		r1 = rf(ctx, flushCollectionCompaction)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllDatabases provides a mock function with given fields: ctx, ts
+func (_m *Catalog) GetAllDatabases(ctx context.Context, ts int64) ([]*model.Database, error) {
+	ret := _m.Called(ctx, ts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllDatabases")
+	}
+
+	var r0 []*model.Database
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*model.Database, error)); ok {
+		return rf(ctx, ts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*model.Database); ok {
+		r0 = rf(ctx, ts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Database)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, ts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllTenants provides a mock function with given fields: ctx, ts
+func (_m *Catalog) GetAllTenants(ctx context.Context, ts int64) ([]*model.Tenant, error) {
+	ret := _m.Called(ctx, ts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllTenants")
+	}
+
+	var r0 []*model.Tenant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*model.Tenant, error)); ok {
+		return rf(ctx, ts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*model.Tenant); ok {
+		r0 = rf(ctx, ts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Tenant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, ts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetApproximateCounts provides a mock function with given fields: ctx, tenantID, databaseName
+func (_m *Catalog) GetApproximateCounts(ctx context.Context, tenantID string, databaseName string) (*model.ApproximateCounts, error) {
+	ret := _m.Called(ctx, tenantID, databaseName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetApproximateCounts")
+	}
+
+	var r0 *model.ApproximateCounts
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*model.ApproximateCounts, error)); ok {
+		return rf(ctx, tenantID, databaseName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.ApproximateCounts); ok {
+		r0 = rf(ctx, tenantID, databaseName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApproximateCounts)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenantID, databaseName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollectionAcl provides a mock function with given fields: ctx, collectionID
+func (_m *Catalog) GetCollectionAcl(ctx context.Context, collectionID types.UniqueID) ([]*model.CollectionAclEntry, error) {
+	ret := _m.Called(ctx, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionAcl")
+	}
+
+	var r0 []*model.CollectionAclEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID) ([]*model.CollectionAclEntry, error)); ok {
+		return rf(ctx, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID) []*model.CollectionAclEntry); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CollectionAclEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID) error); ok {
+		r1 = rf(ctx, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollectionDatabase provides a mock function with given fields: ctx, collectionID
+func (_m *Catalog) GetCollectionDatabase(ctx context.Context, collectionID types.UniqueID) (*model.Database, error) {
+	ret := _m.Called(ctx, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionDatabase")
+	}
+
+	var r0 *model.Database
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID) (*model.Database, error)); ok {
+		return rf(ctx, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID) *model.Database); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Database)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID) error); ok {
+		r1 = rf(ctx, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollectionLogRouteChanges provides a mock function with given fields: ctx, changedAfter
+func (_m *Catalog) GetCollectionLogRouteChanges(ctx context.Context, changedAfter int64) ([]*model.CollectionLogRoute, error) {
+	ret := _m.Called(ctx, changedAfter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionLogRouteChanges")
+	}
+
+	var r0 []*model.CollectionLogRoute
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*model.CollectionLogRoute, error)); ok {
+		return rf(ctx, changedAfter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*model.CollectionLogRoute); ok {
+		r0 = rf(ctx, changedAfter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CollectionLogRoute)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, changedAfter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollectionLogRoutes provides a mock function with given fields: ctx, collectionIDs
+func (_m *Catalog) GetCollectionLogRoutes(ctx context.Context, collectionIDs []types.UniqueID) ([]*model.CollectionLogRoute, int64, error) {
+	ret := _m.Called(ctx, collectionIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionLogRoutes")
+	}
+
+	var r0 []*model.CollectionLogRoute
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, []types.UniqueID) ([]*model.CollectionLogRoute, int64, error)); ok {
+		return rf(ctx, collectionIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []types.UniqueID) []*model.CollectionLogRoute); ok {
+		r0 = rf(ctx, collectionIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CollectionLogRoute)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []types.UniqueID) int64); ok {
+		r1 = rf(ctx, collectionIDs)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []types.UniqueID) error); ok {
+		r2 = rf(ctx, collectionIDs)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetCollectionMetadataDiff provides a mock function with given fields: ctx, collectionID, revision
+func (_m *Catalog) GetCollectionMetadataDiff(ctx context.Context, collectionID types.UniqueID, revision int64) (*model.CollectionMetadataDiff, error) {
+	ret := _m.Called(ctx, collectionID, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionMetadataDiff")
+	}
+
+	var r0 *model.CollectionMetadataDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, int64) (*model.CollectionMetadataDiff, error)); ok {
+		return rf(ctx, collectionID, revision)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, int64) *model.CollectionMetadataDiff); ok {
+		r0 = rf(ctx, collectionID, revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CollectionMetadataDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID, int64) error); ok {
+		r1 = rf(ctx, collectionID, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollectionQuotaAndUsage provides a mock function with given fields: ctx, tenantID, collectionID
+func (_m *Catalog) GetCollectionQuotaAndUsage(ctx context.Context, tenantID string, collectionID types.UniqueID) (*model.CollectionQuota, *model.QuotaUsage, error) {
+	ret := _m.Called(ctx, tenantID, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionQuotaAndUsage")
+	}
+
+	var r0 *model.CollectionQuota
+	var r1 *model.QuotaUsage
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, types.UniqueID) (*model.CollectionQuota, *model.QuotaUsage, error)); ok {
+		return rf(ctx, tenantID, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, types.UniqueID) *model.CollectionQuota); ok {
+		r0 = rf(ctx, tenantID, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CollectionQuota)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, types.UniqueID) *model.QuotaUsage); ok {
+		r1 = rf(ctx, tenantID, collectionID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.QuotaUsage)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, types.UniqueID) error); ok {
+		r2 = rf(ctx, tenantID, collectionID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetCollectionSchema provides a mock function with given fields: ctx, collectionID
+func (_m *Catalog) GetCollectionSchema(ctx context.Context, collectionID types.UniqueID) ([]*model.CollectionSchemaField, error) {
+	ret := _m.Called(ctx, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionSchema")
+	}
+
+	var r0 []*model.CollectionSchemaField
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID) ([]*model.CollectionSchemaField, error)); ok {
+		return rf(ctx, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID) []*model.CollectionSchemaField); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CollectionSchemaField)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID) error); ok {
+		r1 = rf(ctx, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollectionSegmentFiles provides a mock function with given fields: ctx, collectionID
+func (_m *Catalog) GetCollectionSegmentFiles(ctx context.Context, collectionID types.UniqueID) ([]*model.SegmentFile, error) {
+	ret := _m.Called(ctx, collectionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionSegmentFiles")
+	}
+
+	var r0 []*model.SegmentFile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID) ([]*model.SegmentFile, error)); ok {
+		return rf(ctx, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID) []*model.SegmentFile); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SegmentFile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID) error); ok {
+		r1 = rf(ctx, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollectionSizeHistory provides a mock function with given fields: ctx, collectionID, since
+func (_m *Catalog) GetCollectionSizeHistory(ctx context.Context, collectionID types.UniqueID, since time.Time) ([]*model.CollectionSizeSnapshot, error) {
+	ret := _m.Called(ctx, collectionID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionSizeHistory")
+	}
+
+	var r0 []*model.CollectionSizeSnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, time.Time) ([]*model.CollectionSizeSnapshot, error)); ok {
+		return rf(ctx, collectionID, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, time.Time) []*model.CollectionSizeSnapshot); ok {
+		r0 = rf(ctx, collectionID, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CollectionSizeSnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID, time.Time) error); ok {
+		r1 = rf(ctx, collectionID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollectionTemplates provides a mock function with given fields: ctx, id, tenantID, name
+func (_m *Catalog) GetCollectionTemplates(ctx context.Context, id types.UniqueID, tenantID string, name *string) ([]*model.CollectionTemplate, error) {
+	ret := _m.Called(ctx, id, tenantID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionTemplates")
+	}
+
+	var r0 []*model.CollectionTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, string, *string) ([]*model.CollectionTemplate, error)); ok {
+		return rf(ctx, id, tenantID, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, string, *string) []*model.CollectionTemplate); ok {
+		r0 = rf(ctx, id, tenantID, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CollectionTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID, string, *string) error); ok {
+		r1 = rf(ctx, id, tenantID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollections provides a mock function with given fields: ctx, collectionID, collectionName, tenantID, databaseName, limit, offset, metadataFilters, fields
+func (_m *Catalog) GetCollections(ctx context.Context, collectionID types.UniqueID, collectionName *string, tenantID string, databaseName string, limit *int32, offset *int32, metadataFilters []*model.CollectionMetadataFilter, fields *model.CollectionFields) ([]*model.Collection, error) {
+	ret := _m.Called(ctx, collectionID, collectionName, tenantID, databaseName, limit, offset, metadataFilters, fields)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollections")
+	}
+
+	var r0 []*model.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *string, string, string, *int32, *int32, []*model.CollectionMetadataFilter, *model.CollectionFields) ([]*model.Collection, error)); ok {
+		return rf(ctx, collectionID, collectionName, tenantID, databaseName, limit, offset, metadataFilters, fields)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *string, string, string, *int32, *int32, []*model.CollectionMetadataFilter, *model.CollectionFields) []*model.Collection); ok {
+		r0 = rf(ctx, collectionID, collectionName, tenantID, databaseName, limit, offset, metadataFilters, fields)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID, *string, string, string, *int32, *int32, []*model.CollectionMetadataFilter, *model.CollectionFields) error); ok {
+		r1 = rf(ctx, collectionID, collectionName, tenantID, databaseName, limit, offset, metadataFilters, fields)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollectionsByIDs provides a mock function with given fields: ctx, collectionIDs, fields
+func (_m *Catalog) GetCollectionsByIDs(ctx context.Context, collectionIDs []types.UniqueID, fields *model.CollectionFields) ([]*model.Collection, error) {
+	ret := _m.Called(ctx, collectionIDs, fields)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionsByIDs")
+	}
+
+	var r0 []*model.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []types.UniqueID, *model.CollectionFields) ([]*model.Collection, error)); ok {
+		return rf(ctx, collectionIDs, fields)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []types.UniqueID, *model.CollectionFields) []*model.Collection); ok {
+		r0 = rf(ctx, collectionIDs, fields)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []types.UniqueID, *model.CollectionFields) error); ok {
+		r1 = rf(ctx, collectionIDs, fields)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollectionsBySize provides a mock function with given fields: ctx, tenantID, databaseName, orderBy, limit
+func (_m *Catalog) GetCollectionsBySize(ctx context.Context, tenantID string, databaseName string, orderBy model.CollectionSizeOrderBy, limit int32) ([]*model.CollectionSize, error) {
+	ret := _m.Called(ctx, tenantID, databaseName, orderBy, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionsBySize")
+	}
+
+	var r0 []*model.CollectionSize
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.CollectionSizeOrderBy, int32) ([]*model.CollectionSize, error)); ok {
+		return rf(ctx, tenantID, databaseName, orderBy, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.CollectionSizeOrderBy, int32) []*model.CollectionSize); ok {
+		r0 = rf(ctx, tenantID, databaseName, orderBy, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CollectionSize)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, model.CollectionSizeOrderBy, int32) error); ok {
+		r1 = rf(ctx, tenantID, databaseName, orderBy, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCollectionsToGc provides a mock function with given fields: ctx, filter, updatedBefore
+func (_m *Catalog) GetCollectionsToGc(ctx context.Context, filter *model.CollectionsToGcFilter, updatedBefore time.Time) ([]*model.CollectionToGc, error) {
+	ret := _m.Called(ctx, filter, updatedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCollectionsToGc")
+	}
+
+	var r0 []*model.CollectionToGc
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CollectionsToGcFilter, time.Time) ([]*model.CollectionToGc, error)); ok {
+		return rf(ctx, filter, updatedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CollectionsToGcFilter, time.Time) []*model.CollectionToGc); ok {
+		r0 = rf(ctx, filter, updatedBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CollectionToGc)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CollectionsToGcFilter, time.Time) error); ok {
+		r1 = rf(ctx, filter, updatedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCompactionRequests provides a mock function with given fields: ctx, limit
+func (_m *Catalog) GetCompactionRequests(ctx context.Context, limit int32) ([]*model.CompactionRequest, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCompactionRequests")
+	}
+
+	var r0 []*model.CompactionRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) ([]*model.CompactionRequest, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int32) []*model.CompactionRequest); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CompactionRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDatabaseQuota provides a mock function with given fields: ctx, tenantID, databaseName
+func (_m *Catalog) GetDatabaseQuota(ctx context.Context, tenantID string, databaseName string) (*model.TenantQuota, *model.DatabaseQuota, error) {
+	ret := _m.Called(ctx, tenantID, databaseName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatabaseQuota")
+	}
+
+	var r0 *model.TenantQuota
+	var r1 *model.DatabaseQuota
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*model.TenantQuota, *model.DatabaseQuota, error)); ok {
+		return rf(ctx, tenantID, databaseName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.TenantQuota); ok {
+		r0 = rf(ctx, tenantID, databaseName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TenantQuota)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) *model.DatabaseQuota); ok {
+		r1 = rf(ctx, tenantID, databaseName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.DatabaseQuota)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = rf(ctx, tenantID, databaseName)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetDatabaseQuotaAndUsage provides a mock function with given fields: ctx, tenantID, databaseName
+func (_m *Catalog) GetDatabaseQuotaAndUsage(ctx context.Context, tenantID string, databaseName string) (*model.DatabaseQuota, *model.QuotaUsage, error) {
+	ret := _m.Called(ctx, tenantID, databaseName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatabaseQuotaAndUsage")
+	}
+
+	var r0 *model.DatabaseQuota
+	var r1 *model.QuotaUsage
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*model.DatabaseQuota, *model.QuotaUsage, error)); ok {
+		return rf(ctx, tenantID, databaseName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.DatabaseQuota); ok {
+		r0 = rf(ctx, tenantID, databaseName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.DatabaseQuota)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) *model.QuotaUsage); ok {
+		r1 = rf(ctx, tenantID, databaseName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.QuotaUsage)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = rf(ctx, tenantID, databaseName)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetDatabases provides a mock function with given fields: ctx, getDatabase, ts
+func (_m *Catalog) GetDatabases(ctx context.Context, getDatabase *model.GetDatabase, ts int64) (*model.Database, error) {
+	ret := _m.Called(ctx, getDatabase, ts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDatabases")
+	}
+
+	var r0 *model.Database
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetDatabase, int64) (*model.Database, error)); ok {
+		return rf(ctx, getDatabase, ts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetDatabase, int64) *model.Database); ok {
+		r0 = rf(ctx, getDatabase, ts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Database)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.GetDatabase, int64) error); ok {
+		r1 = rf(ctx, getDatabase, ts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDeletedCollectionIDs provides a mock function with given fields: ctx, deletedBefore, limit
+func (_m *Catalog) GetDeletedCollectionIDs(ctx context.Context, deletedBefore time.Time, limit int32) ([]types.UniqueID, error) {
+	ret := _m.Called(ctx, deletedBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeletedCollectionIDs")
+	}
+
+	var r0 []types.UniqueID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int32) ([]types.UniqueID, error)); ok {
+		return rf(ctx, deletedBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int32) []types.UniqueID); ok {
+		r0 = rf(ctx, deletedBefore, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.UniqueID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int32) error); ok {
+		r1 = rf(ctx, deletedBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDuplicateSegmentFiles provides a mock function with given fields: ctx, tenantID, limit
+func (_m *Catalog) GetDuplicateSegmentFiles(ctx context.Context, tenantID string, limit int32) ([]*model.DuplicateSegmentFiles, error) {
+	ret := _m.Called(ctx, tenantID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDuplicateSegmentFiles")
+	}
+
+	var r0 []*model.DuplicateSegmentFiles
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) ([]*model.DuplicateSegmentFiles, error)); ok {
+		return rf(ctx, tenantID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int32) []*model.DuplicateSegmentFiles); ok {
+		r0 = rf(ctx, tenantID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.DuplicateSegmentFiles)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int32) error); ok {
+		r1 = rf(ctx, tenantID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEmbeddingFunctions provides a mock function with given fields: ctx, id, tenantID, name
+func (_m *Catalog) GetEmbeddingFunctions(ctx context.Context, id types.UniqueID, tenantID string, name *string) ([]*model.EmbeddingFunction, error) {
+	ret := _m.Called(ctx, id, tenantID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEmbeddingFunctions")
+	}
+
+	var r0 []*model.EmbeddingFunction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, string, *string) ([]*model.EmbeddingFunction, error)); ok {
+		return rf(ctx, id, tenantID, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, string, *string) []*model.EmbeddingFunction); ok {
+		r0 = rf(ctx, id, tenantID, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.EmbeddingFunction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID, string, *string) error); ok {
+		r1 = rf(ctx, id, tenantID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFeatureFlags provides a mock function with given fields: ctx
+func (_m *Catalog) GetFeatureFlags(ctx context.Context) ([]*model.FeatureFlag, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFeatureFlags")
+	}
+
+	var r0 []*model.FeatureFlag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.FeatureFlag, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.FeatureFlag); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.FeatureFlag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetIncompleteCollections provides a mock function with given fields: ctx, createdBefore
+func (_m *Catalog) GetIncompleteCollections(ctx context.Context, createdBefore time.Time) ([]*model.IncompleteCollection, error) {
+	ret := _m.Called(ctx, createdBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIncompleteCollections")
+	}
+
+	var r0 []*model.IncompleteCollection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]*model.IncompleteCollection, error)); ok {
+		return rf(ctx, createdBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []*model.IncompleteCollection); ok {
+		r0 = rf(ctx, createdBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.IncompleteCollection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, createdBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInconsistentCollections provides a mock function with given fields: ctx, deletedBefore, kinds
+func (_m *Catalog) GetInconsistentCollections(ctx context.Context, deletedBefore time.Time, kinds []model.InconsistencyKind) ([]*model.InconsistentCollection, error) {
+	ret := _m.Called(ctx, deletedBefore, kinds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInconsistentCollections")
+	}
+
+	var r0 []*model.InconsistentCollection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, []model.InconsistencyKind) ([]*model.InconsistentCollection, error)); ok {
+		return rf(ctx, deletedBefore, kinds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, []model.InconsistencyKind) []*model.InconsistentCollection); ok {
+		r0 = rf(ctx, deletedBefore, kinds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.InconsistentCollection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, []model.InconsistencyKind) error); ok {
+		r1 = rf(ctx, deletedBefore, kinds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetJob provides a mock function with given fields: ctx, jobID
+func (_m *Catalog) GetJob(ctx context.Context, jobID types.UniqueID) (*model.Job, error) {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJob")
+	}
+
+	var r0 *model.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID) (*model.Job, error)); ok {
+		return rf(ctx, jobID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID) *model.Job); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID) error); ok {
+		r1 = rf(ctx, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMaintenanceMode provides a mock function with given fields: ctx
+func (_m *Catalog) GetMaintenanceMode(ctx context.Context) (*model.MaintenanceMode, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMaintenanceMode")
+	}
+
+	var r0 *model.MaintenanceMode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*model.MaintenanceMode, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *model.MaintenanceMode); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.MaintenanceMode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNodeDecommissions provides a mock function with given fields: ctx
+func (_m *Catalog) GetNodeDecommissions(ctx context.Context) ([]*model.NodeDecommission, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNodeDecommissions")
+	}
+
+	var r0 []*model.NodeDecommission
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.NodeDecommission, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.NodeDecommission); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.NodeDecommission)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNodeHeartbeats provides a mock function with given fields: ctx
+func (_m *Catalog) GetNodeHeartbeats(ctx context.Context) ([]*model.NodeHeartbeat, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNodeHeartbeats")
+	}
+
+	var r0 []*model.NodeHeartbeat
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.NodeHeartbeat, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.NodeHeartbeat); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.NodeHeartbeat)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSegments provides a mock function with given fields: ctx, segmentID, segmentType, scope, collectionID, limit, offset
+func (_m *Catalog) GetSegments(ctx context.Context, segmentID types.UniqueID, segmentType *string, scope *string, collectionID types.UniqueID, limit *int32, offset *int32) ([]*model.Segment, error) {
+	ret := _m.Called(ctx, segmentID, segmentType, scope, collectionID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSegments")
+	}
+
+	var r0 []*model.Segment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *string, *string, types.UniqueID, *int32, *int32) ([]*model.Segment, error)); ok {
+		return rf(ctx, segmentID, segmentType, scope, collectionID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *string, *string, types.UniqueID, *int32, *int32) []*model.Segment); ok {
+		r0 = rf(ctx, segmentID, segmentType, scope, collectionID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Segment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID, *string, *string, types.UniqueID, *int32, *int32) error); ok {
+		r1 = rf(ctx, segmentID, segmentType, scope, collectionID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStorageAttribution provides a mock function with given fields: ctx, tenantID
+func (_m *Catalog) GetStorageAttribution(ctx context.Context, tenantID string) (*model.StorageAttribution, error) {
+	ret := _m.Called(ctx, tenantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStorageAttribution")
+	}
+
+	var r0 *model.StorageAttribution
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.StorageAttribution, error)); ok {
+		return rf(ctx, tenantID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.StorageAttribution); ok {
+		r0 = rf(ctx, tenantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.StorageAttribution)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTenantActivity provides a mock function with given fields: ctx, tenantID, from, to
+func (_m *Catalog) GetTenantActivity(ctx context.Context, tenantID string, from time.Time, to time.Time) ([]*model.TenantActivity, error) {
+	ret := _m.Called(ctx, tenantID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTenantActivity")
+	}
+
+	var r0 []*model.TenantActivity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) ([]*model.TenantActivity, error)); ok {
+		return rf(ctx, tenantID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) []*model.TenantActivity); ok {
+		r0 = rf(ctx, tenantID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TenantActivity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, tenantID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTenantPlacement provides a mock function with given fields: ctx, tenantID
+func (_m *Catalog) GetTenantPlacement(ctx context.Context, tenantID string) (*model.TenantPlacement, error) {
+	ret := _m.Called(ctx, tenantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTenantPlacement")
+	}
+
+	var r0 *model.TenantPlacement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.TenantPlacement, error)); ok {
+		return rf(ctx, tenantID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.TenantPlacement); ok {
+		r0 = rf(ctx, tenantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TenantPlacement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTenantQuota provides a mock function with given fields: ctx, tenantID
+func (_m *Catalog) GetTenantQuota(ctx context.Context, tenantID string) (*model.TenantQuota, error) {
+	ret := _m.Called(ctx, tenantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTenantQuota")
+	}
+
+	var r0 *model.TenantQuota
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.TenantQuota, error)); ok {
+		return rf(ctx, tenantID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.TenantQuota); ok {
+		r0 = rf(ctx, tenantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TenantQuota)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tenantID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTenantQuotaAndUsage provides a mock function with given fields: ctx, tenantID
+func (_m *Catalog) GetTenantQuotaAndUsage(ctx context.Context, tenantID string) (*model.TenantQuota, *model.QuotaUsage, error) {
+	ret := _m.Called(ctx, tenantID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTenantQuotaAndUsage")
+	}
+
+	var r0 *model.TenantQuota
+	var r1 *model.QuotaUsage
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.TenantQuota, *model.QuotaUsage, error)); ok {
+		return rf(ctx, tenantID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.TenantQuota); ok {
+		r0 = rf(ctx, tenantID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TenantQuota)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) *model.QuotaUsage); ok {
+		r1 = rf(ctx, tenantID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.QuotaUsage)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, tenantID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetTenants provides a mock function with given fields: ctx, getTenant, ts
+func (_m *Catalog) GetTenants(ctx context.Context, getTenant *model.GetTenant, ts int64) (*model.Tenant, error) {
+	ret := _m.Called(ctx, getTenant, ts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTenants")
+	}
+
+	var r0 *model.Tenant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetTenant, int64) (*model.Tenant, error)); ok {
+		return rf(ctx, getTenant, ts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.GetTenant, int64) *model.Tenant); ok {
+		r0 = rf(ctx, getTenant, ts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Tenant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.GetTenant, int64) error); ok {
+		r1 = rf(ctx, getTenant, ts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTenantsLastCompactionTime provides a mock function with given fields: ctx, tenantIDs
+func (_m *Catalog) GetTenantsLastCompactionTime(ctx context.Context, tenantIDs []string) ([]*dbmodel.Tenant, error) {
+	ret := _m.Called(ctx, tenantIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTenantsLastCompactionTime")
+	}
+
+	var r0 []*dbmodel.Tenant
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*dbmodel.Tenant, error)); ok {
+		return rf(ctx, tenantIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*dbmodel.Tenant); ok {
+		r0 = rf(ctx, tenantIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*dbmodel.Tenant)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, tenantIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCleanupDeadLetters provides a mock function with given fields: ctx, task, deadLetteredOnly
+func (_m *Catalog) ListCleanupDeadLetters(ctx context.Context, task string, deadLetteredOnly bool) ([]*model.CleanupDeadLetter, error) {
+	ret := _m.Called(ctx, task, deadLetteredOnly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCleanupDeadLetters")
+	}
+
+	var r0 []*model.CleanupDeadLetter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) ([]*model.CleanupDeadLetter, error)); ok {
+		return rf(ctx, task, deadLetteredOnly)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) []*model.CleanupDeadLetter); ok {
+		r0 = rf(ctx, task, deadLetteredOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CleanupDeadLetter)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, task, deadLetteredOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCollectionStats provides a mock function with given fields: ctx, tenantID, databaseName, limit, offset
+func (_m *Catalog) ListCollectionStats(ctx context.Context, tenantID string, databaseName string, limit int32, offset int32) ([]*model.CollectionStats, error) {
+	ret := _m.Called(ctx, tenantID, databaseName, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCollectionStats")
+	}
+
+	var r0 []*model.CollectionStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32, int32) ([]*model.CollectionStats, error)); ok {
+		return rf(ctx, tenantID, databaseName, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int32, int32) []*model.CollectionStats); ok {
+		r0 = rf(ctx, tenantID, databaseName, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CollectionStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int32, int32) error); ok {
+		r1 = rf(ctx, tenantID, databaseName, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListJobs provides a mock function with given fields: ctx, filter
+func (_m *Catalog) ListJobs(ctx context.Context, filter *model.JobFilter) ([]*model.Job, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListJobs")
+	}
+
+	var r0 []*model.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.JobFilter) ([]*model.Job, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.JobFilter) []*model.Job); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.JobFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeDeletedCollection provides a mock function with given fields: ctx, collectionID, deletedBefore, actor
+func (_m *Catalog) PurgeDeletedCollection(ctx context.Context, collectionID types.UniqueID, deletedBefore time.Time, actor string) error {
+	ret := _m.Called(ctx, collectionID, deletedBefore, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeletedCollection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, time.Time, string) error); ok {
+		r0 = rf(ctx, collectionID, deletedBefore, actor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RecordCleanupFailure provides a mock function with given fields: ctx, collectionID, task, cause, maxAttempts
+func (_m *Catalog) RecordCleanupFailure(ctx context.Context, collectionID types.UniqueID, task string, cause error, maxAttempts int32) (*model.CleanupDeadLetter, error) {
+	ret := _m.Called(ctx, collectionID, task, cause, maxAttempts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordCleanupFailure")
+	}
+
+	var r0 *model.CleanupDeadLetter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, string, error, int32) (*model.CleanupDeadLetter, error)); ok {
+		return rf(ctx, collectionID, task, cause, maxAttempts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, string, error, int32) *model.CleanupDeadLetter); ok {
+		r0 = rf(ctx, collectionID, task, cause, maxAttempts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CleanupDeadLetter)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID, string, error, int32) error); ok {
+		r1 = rf(ctx, collectionID, task, cause, maxAttempts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordNodeHeartbeat provides a mock function with given fields: ctx, nodeID, at, expireBefore
+func (_m *Catalog) RecordNodeHeartbeat(ctx context.Context, nodeID string, at time.Time, expireBefore time.Time) error {
+	ret := _m.Called(ctx, nodeID, at, expireBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordNodeHeartbeat")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r0 = rf(ctx, nodeID, at, expireBefore)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RenameCollection provides a mock function with given fields: ctx, rename
+func (_m *Catalog) RenameCollection(ctx context.Context, rename *model.RenameCollection) (*model.Collection, error) {
+	ret := _m.Called(ctx, rename)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameCollection")
+	}
+
+	var r0 *model.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.RenameCollection) (*model.Collection, error)); ok {
+		return rf(ctx, rename)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.RenameCollection) *model.Collection); ok {
+		r0 = rf(ctx, rename)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.RenameCollection) error); ok {
+		r1 = rf(ctx, rename)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RenewJobLease provides a mock function with given fields: ctx, jobID, workerID, lockedUntil
+func (_m *Catalog) RenewJobLease(ctx context.Context, jobID types.UniqueID, workerID string, lockedUntil time.Time) error {
+	ret := _m.Called(ctx, jobID, workerID, lockedUntil)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenewJobLease")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, string, time.Time) error); ok {
+		r0 = rf(ctx, jobID, workerID, lockedUntil)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepairIncompleteCollection provides a mock function with given fields: ctx, collectionID, actor
+func (_m *Catalog) RepairIncompleteCollection(ctx context.Context, collectionID types.UniqueID, actor string) error {
+	ret := _m.Called(ctx, collectionID, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RepairIncompleteCollection")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, string) error); ok {
+		r0 = rf(ctx, collectionID, actor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RequestCompaction provides a mock function with given fields: ctx, collectionID, actor
+func (_m *Catalog) RequestCompaction(ctx context.Context, collectionID types.UniqueID, actor string) error {
+	ret := _m.Called(ctx, collectionID, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestCompaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, string) error); ok {
+		r0 = rf(ctx, collectionID, actor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResetState provides a mock function with given fields: ctx
+func (_m *Catalog) ResetState(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestoreCollection provides a mock function with given fields: ctx, collectionID, name
+func (_m *Catalog) RestoreCollection(ctx context.Context, collectionID types.UniqueID, name *string) (*model.Collection, error) {
+	ret := _m.Called(ctx, collectionID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreCollection")
+	}
+
+	var r0 *model.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *string) (*model.Collection, error)); ok {
+		return rf(ctx, collectionID, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, *string) *model.Collection); ok {
+		r0 = rf(ctx, collectionID, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID, *string) error); ok {
+		r1 = rf(ctx, collectionID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScanCollections provides a mock function with given fields: ctx, cursor, limit
+func (_m *Catalog) ScanCollections(ctx context.Context, cursor *model.CollectionScanCursor, limit int32) ([]*model.Collection, error) {
+	ret := _m.Called(ctx, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScanCollections")
+	}
+
+	var r0 []*model.Collection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CollectionScanCursor, int32) ([]*model.Collection, error)); ok {
+		return rf(ctx, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CollectionScanCursor, int32) []*model.Collection); ok {
+		r0 = rf(ctx, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CollectionScanCursor, int32) error); ok {
+		r1 = rf(ctx, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetCollectionAclEntry provides a mock function with given fields: ctx, entry
+func (_m *Catalog) SetCollectionAclEntry(ctx context.Context, entry *model.CollectionAclEntry) error {
+	ret := _m.Called(ctx, entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCollectionAclEntry")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CollectionAclEntry) error); ok {
+		r0 = rf(ctx, entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetCollectionLogRoute provides a mock function with given fields: ctx, collectionID, logAddress
+func (_m *Catalog) SetCollectionLogRoute(ctx context.Context, collectionID types.UniqueID, logAddress string) (*model.CollectionLogRoute, error) {
+	ret := _m.Called(ctx, collectionID, logAddress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCollectionLogRoute")
+	}
+
+	var r0 *model.CollectionLogRoute
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, string) (*model.CollectionLogRoute, error)); ok {
+		return rf(ctx, collectionID, logAddress)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, string) *model.CollectionLogRoute); ok {
+		r0 = rf(ctx, collectionID, logAddress)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CollectionLogRoute)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, types.UniqueID, string) error); ok {
+		r1 = rf(ctx, collectionID, logAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetCollectionSchema provides a mock function with given fields: ctx, collectionID, fields
+func (_m *Catalog) SetCollectionSchema(ctx context.Context, collectionID types.UniqueID, fields []*model.CollectionSchemaField) error {
+	ret := _m.Called(ctx, collectionID, fields)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCollectionSchema")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.UniqueID, []*model.CollectionSchemaField) error); ok {
+		r0 = rf(ctx, collectionID, fields)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetDatabaseQuota provides a mock function with given fields: ctx, quota
+func (_m *Catalog) SetDatabaseQuota(ctx context.Context, quota *model.DatabaseQuota) error {
+	ret := _m.Called(ctx, quota)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetDatabaseQuota")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DatabaseQuota) error); ok {
+		r0 = rf(ctx, quota)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetFeatureFlag provides a mock function with given fields: ctx, flag
+func (_m *Catalog) SetFeatureFlag(ctx context.Context, flag *model.FeatureFlag) error {
+	ret := _m.Called(ctx, flag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetFeatureFlag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.FeatureFlag) error); ok {
+		r0 = rf(ctx, flag)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetMaintenanceMode provides a mock function with given fields: ctx, mode
+func (_m *Catalog) SetMaintenanceMode(ctx context.Context, mode *model.MaintenanceMode) error {
+	ret := _m.Called(ctx, mode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMaintenanceMode")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.MaintenanceMode) error); ok {
+		r0 = rf(ctx, mode)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetTenantLastCompactionTime provides a mock function with given fields: ctx, tenantID, lastCompactionTime
+func (_m *Catalog) SetTenantLastCompactionTime(ctx context.Context, tenantID string, lastCompactionTime int64) error {
+	ret := _m.Called(ctx, tenantID, lastCompactionTime)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTenantLastCompactionTime")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
+		r0 = rf(ctx, tenantID, lastCompactionTime)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetTenantPlacement provides a mock function with given fields: ctx, placement
+func (_m *Catalog) SetTenantPlacement(ctx context.Context, placement *model.TenantPlacement) error {
+	ret := _m.Called(ctx, placement)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTenantPlacement")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.TenantPlacement) error); ok {
+		r0 = rf(ctx, placement)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetTenantQuota provides a mock function with given fields: ctx, quota
+func (_m *Catalog) SetTenantQuota(ctx context.Context, quota *model.TenantQuota) error {
+	ret := _m.Called(ctx, quota)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTenantQuota")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.TenantQuota) error); ok {
+		r0 = rf(ctx, quota)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetTenantsLastCompactionTime provides a mock function with given fields: ctx, lastCompactionTimes
+func (_m *Catalog) SetTenantsLastCompactionTime(ctx context.Context, lastCompactionTimes map[string]int64) error {
+	ret := _m.Called(ctx, lastCompactionTimes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTenantsLastCompactionTime")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]int64) error); ok {
+		r0 = rf(ctx, lastCompactionTimes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StartCollectionScan provides a mock function with given fields: ctx, ttl
+func (_m *Catalog) StartCollectionScan(ctx context.Context, ttl time.Duration) (*model.CollectionScanCursor, error) {
+	ret := _m.Called(ctx, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartCollectionScan")
+	}
+
+	var r0 *model.CollectionScanCursor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) (*model.CollectionScanCursor, error)); ok {
+		return rf(ctx, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) *model.CollectionScanCursor); ok {
+		r0 = rf(ctx, ttl)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CollectionScanCursor)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Duration) error); ok {
+		r1 = rf(ctx, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateCollection provides a mock function with given fields: ctx, updateCollection, ts
+func (_m *Catalog) UpdateCollection(ctx context.Context, updateCollection *model.UpdateCollection, ts int64) (*model.Collection, []string, error) {
+	ret := _m.Called(ctx, updateCollection, ts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCollection")
+	}
+
+	var r0 *model.Collection
+	var r1 []string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.UpdateCollection, int64) (*model.Collection, []string, error)); ok {
+		return rf(ctx, updateCollection, ts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.UpdateCollection, int64) *model.Collection); ok {
+		r0 = rf(ctx, updateCollection, ts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.UpdateCollection, int64) []string); ok {
 		r1 = rf(ctx, updateCollection, ts)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *model.UpdateCollection, int64) error); ok {
+		r2 = rf(ctx, updateCollection, ts)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// UpdateEmbeddingFunction provides a mock function with given fields: ctx, update
+func (_m *Catalog) UpdateEmbeddingFunction(ctx context.Context, update *model.UpdateEmbeddingFunction) (*model.EmbeddingFunction, error) {
+	ret := _m.Called(ctx, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEmbeddingFunction")
+	}
+
+	var r0 *model.EmbeddingFunction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.UpdateEmbeddingFunction) (*model.EmbeddingFunction, error)); ok {
+		return rf(ctx, update)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.UpdateEmbeddingFunction) *model.EmbeddingFunction); ok {
+		r0 = rf(ctx, update)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.EmbeddingFunction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.UpdateEmbeddingFunction) error); ok {
+		r1 = rf(ctx, update)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/types"
+)
+
+// CleanupDeadLetter is a background cleanup task that keeps failing on a
+// collection, e.g. the purge of a soft deleted collection whose files the
+// object store refuses to delete. A dead lettered task is no longer retried
+// until its entry is deleted.
+type CleanupDeadLetter struct {
+	CollectionID  types.UniqueID
+	Task          string
+	TenantID      string
+	Attempts      int32
+	LastError     string
+	FirstFailedAt time.Time
+	LastFailedAt  time.Time
+	DeadLettered  bool
+}
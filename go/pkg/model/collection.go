@@ -1,6 +1,9 @@
 package model
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/chroma-core/chroma/go/pkg/types"
 )
 
@@ -13,9 +16,99 @@ type Collection struct {
 	DatabaseName string
 	Ts           types.Timestamp
 	LogPosition  int64
-	Version      int32
+	// DistanceFunction is the HNSW space of the collection, fixed when the
+	// collection is created.
+	DistanceFunction string
+	// EmbeddingFunctionID is the registered embedding function of the
+	// collection, nil when it has none.
+	EmbeddingFunctionID *types.UniqueID
+	// TemplateID is the template the collection was created from, nil when it
+	// was not or the template is deleted.
+	TemplateID *types.UniqueID
+	Version    int32
+	// Revision is bumped by every UpdateCollection that changes the collection.
+	Revision int64
+	// UpdatedAt is the time of the last update of the collection row.
+	UpdatedAt time.Time
+	State     CollectionState
+	// ReindexPending is set while the records of the collection still have to be
+	// reindexed after a dimension or embedding function change.
+	ReindexPending       bool
+	ReindexFromDimension *int32
+	KmsKeyID             *string
+	// ReplicationFactor is the number of query nodes the collection is assigned
+	// to, at least 1.
+	ReplicationFactor int32
+	// Acl is only loaded by GetCollections.
+	Acl []*CollectionAclEntry
+	// LastCompactor is the compactor instance that flushed the last compaction,
+	// and LastCompactedAt when, in unix seconds. Nil until the first flush that
+	// names its compactor.
+	LastCompactor   *string
+	LastCompactedAt *int64
+	// LogLag is only loaded by GetCollections when asked for.
+	LogLag *CollectionLogLag
+}
+
+// ETag identifies the state of the collection row, it changes whenever the
+// collection is updated or compacted.
+func (c *Collection) ETag() string {
+	return fmt.Sprintf("%d-%d", c.Version, c.UpdatedAt.UnixMicro())
+}
+
+// DefaultDistanceFunction is the distance function of the collections that do
+// not set one, the default HNSW space.
+const DefaultDistanceFunction = "l2"
+
+// DistanceFunctionMetadataKey is the collection metadata key the query and
+// compaction nodes read the distance function from.
+const DistanceFunctionMetadataKey = "hnsw:space"
+
+// CollectionLogLag is how far the index of a collection is behind its log.
+// OldestUncompactedAt is in unix seconds and nil when every record is compacted.
+type CollectionLogLag struct {
+	UncompactedRecords  int64
+	OldestUncompactedAt *int64
+}
+
+// CollectionFields are the parts of a collection that GetCollections loads with
+// queries of their own, so that callers which do not need them can skip them.
+// A nil *CollectionFields loads all of them.
+type CollectionFields struct {
+	Metadata bool
+	Acl      bool
+	// LogLag is loaded from the log service, by the callers that ask for it.
+	LogLag bool
+}
+
+func (f *CollectionFields) IncludesMetadata() bool {
+	return f == nil || f.Metadata
+}
+
+func (f *CollectionFields) IncludesAcl() bool {
+	return f == nil || f.Acl
+}
+
+func (f *CollectionFields) IncludesLogLag() bool {
+	return f == nil || f.LogLag
 }
 
+// CollectionState is the lifecycle state of a collection, maintained by the
+// coordinator as the segments of the collection are created and deleted.
+type CollectionState string
+
+const (
+	// CollectionStateCreating is a collection whose required segments have not
+	// all been created yet.
+	CollectionStateCreating CollectionState = "creating"
+	// CollectionStateReady is a collection with all of its required segments.
+	CollectionStateReady CollectionState = "ready"
+	// CollectionStateDegraded is a ready collection that lost a required segment.
+	CollectionStateDegraded CollectionState = "degraded"
+	// CollectionStateDeleting is a collection that is being deleted.
+	CollectionStateDeleting CollectionState = "deleting"
+)
+
 type CreateCollection struct {
 	ID           types.UniqueID
 	Name         string
@@ -25,6 +118,24 @@ type CreateCollection struct {
 	TenantID     string
 	DatabaseName string
 	Ts           types.Timestamp
+	// CreateDatabaseIfNotExists creates the database in the same transaction when
+	// it does not exist yet. The tenant must exist.
+	CreateDatabaseIfNotExists bool
+	// Segments are created in the same transaction as the collection, so either
+	// the collection is created with all of its segments or nothing is created.
+	Segments []*CreateSegment
+	// KmsKeyID defaults to the key of the tenant.
+	KmsKeyID *string
+	// ReplicationFactor defaults to 1.
+	ReplicationFactor *int32
+	// DistanceFunction defaults to the hnsw:space metadata of the collection, or
+	// l2 when the metadata does not set it.
+	DistanceFunction *string
+	// EmbeddingFunctionID must be an embedding function of the tenant.
+	EmbeddingFunctionID *types.UniqueID
+	// TemplateID must be a template of the tenant, it is set by
+	// CreateCollectionFromTemplate.
+	TemplateID *types.UniqueID
 }
 
 type DeleteCollection struct {
@@ -32,6 +143,92 @@ type DeleteCollection struct {
 	TenantID     string
 	DatabaseName string
 	Ts           types.Timestamp
+	// Reason and Actor are recorded with the archived copy of the collection.
+	Reason string
+	Actor  string
+}
+
+// IncompleteCollection is a collection that has no segments, typically left
+// behind when a client created the collection and failed before creating its
+// segments with separate CreateSegment calls.
+type IncompleteCollection struct {
+	ID           types.UniqueID
+	Name         string
+	TenantID     string
+	DatabaseName string
+	CreatedAt    int64
+}
+
+// InconsistencyKind is the intermediate state an InconsistentCollection is stuck in.
+type InconsistencyKind string
+
+const (
+	// InconsistencySoftDeletedWithSegments is a soft deleted collection whose
+	// segments were not cleaned up.
+	InconsistencySoftDeletedWithSegments InconsistencyKind = "soft_deleted_with_segments"
+	// InconsistencyDeletedSegments is a live collection with soft deleted segments.
+	InconsistencyDeletedSegments InconsistencyKind = "deleted_segments"
+	// InconsistencyMissingSegments is a ready collection without one of its
+	// required segments.
+	InconsistencyMissingSegments InconsistencyKind = "missing_segments"
+	// InconsistencyFlushedWithoutFiles is a compacted collection with a segment
+	// that has no files.
+	InconsistencyFlushedWithoutFiles InconsistencyKind = "flushed_without_files"
+	// InconsistencyLogPositionAhead is a collection whose log position is past
+	// the last offset of its log, found by the log offset reconciler.
+	InconsistencyLogPositionAhead InconsistencyKind = "log_position_ahead"
+)
+
+// InconsistencyCheckKinds are the kinds found by querying the catalog, in the
+// order they are checked. InconsistencyLogPositionAhead is found by the log
+// offset reconciler instead.
+var InconsistencyCheckKinds = []InconsistencyKind{
+	InconsistencySoftDeletedWithSegments,
+	InconsistencyDeletedSegments,
+	InconsistencyMissingSegments,
+	InconsistencyFlushedWithoutFiles,
+}
+
+// InconsistentCollection is a collection that normal operation should have moved
+// out of an intermediate state. Description says what is wrong and how to fix it.
+type InconsistentCollection struct {
+	ID           types.UniqueID
+	Name         string
+	TenantID     string
+	DatabaseName string
+	Kind         InconsistencyKind
+	Description  string
+	SegmentIDs   []types.UniqueID
+}
+
+// CollectionToGc is a collection, live or soft deleted, whose older versions the
+// garbage collector may clean up.
+type CollectionToGc struct {
+	ID        types.UniqueID
+	Name      string
+	TenantID  string
+	Version   int32
+	UpdatedAt int64
+	IsDeleted bool
+}
+
+// CollectionsToGcFilter narrows down the collections returned to the garbage
+// collector. Zero values disable the corresponding filter. Pages are ordered by
+// collection ID and StartAfter is the last ID of the previous page.
+type CollectionsToGcFilter struct {
+	OlderThan   time.Duration
+	TenantID    *string
+	MinVersions int32
+	StartAfter  *types.UniqueID
+	Limit       int32
+}
+
+// CollectionScanCursor is where a scan of the catalog resumes: after the
+// collection After, by ID, in the database snapshot Snapshot held since the
+// scan started. A nil After starts at the first collection.
+type CollectionScanCursor struct {
+	Snapshot string
+	After    *types.UniqueID
 }
 
 type UpdateCollection struct {
@@ -43,14 +240,102 @@ type UpdateCollection struct {
 	TenantID      string
 	DatabaseName  string
 	Ts            types.Timestamp
+	// RequestReindex marks the collection for reindexing even if the dimension
+	// does not change, e.g. when the embedding function changed.
+	RequestReindex bool
+	// ReplicationFactor is left as is when nil.
+	ReplicationFactor *int32
+	// ExpectedRevision fails the update with ErrCollectionRevisionMismatch
+	// unless the collection is still at this revision.
+	ExpectedRevision *int64
+	// EmbeddingFunctionID is left as is when nil, and must be an embedding
+	// function of the tenant otherwise.
+	EmbeddingFunctionID *types.UniqueID
+	// ResetEmbeddingFunction detaches the collection from its embedding
+	// function, it cannot be combined with EmbeddingFunctionID.
+	ResetEmbeddingFunction bool
+}
+
+// RenameCollection gives a live collection a name that no other collection of
+// its database has.
+type RenameCollection struct {
+	ID   types.UniqueID
+	Name string
+	// ExpectedRevision fails the rename with ErrCollectionRevisionMismatch
+	// unless the collection is still at this revision.
+	ExpectedRevision *int64
 }
 
+// The fields UpdateCollection reports as changed.
+const (
+	CollectionFieldName              = "name"
+	CollectionFieldDimension         = "dimension"
+	CollectionFieldMetadata          = "metadata"
+	CollectionFieldReplicationFactor = "replication_factor"
+	CollectionFieldEmbeddingFunction = "embedding_function_id"
+)
+
 type FlushCollectionCompaction struct {
 	ID                       types.UniqueID
 	TenantID                 string
 	LogPosition              int64
 	CurrentCollectionVersion int32
 	FlushSegmentCompactions  []*FlushSegmentCompaction
+	// Collection size after the compaction, nil when the compactor did not report it.
+	TotalRecordsPostCompaction *int64
+	SizeBytesPostCompaction    *int64
+	// FencingToken, when set, must be the latest token issued for the collection.
+	FencingToken *int64
+	// CompactorID, when set, is recorded as the last compactor of the collection.
+	CompactorID *string
+	// CompactionStartedAt, when set, limits the compaction requests the flush
+	// serves to those made before it.
+	CompactionStartedAt *time.Time
+}
+
+// CollectionMetadataPatch sets and deletes metadata keys of a collection and
+// leaves the rest of its metadata as is.
+type CollectionMetadataPatch struct {
+	Set    *CollectionMetadata[CollectionMetadataValueType]
+	Delete []string
+}
+
+// Apply returns a copy of metadata with the patch applied, or nil when no keys
+// are left.
+func (p *CollectionMetadataPatch) Apply(metadata *CollectionMetadata[CollectionMetadataValueType]) *CollectionMetadata[CollectionMetadataValueType] {
+	patched := NewCollectionMetadata[CollectionMetadataValueType]()
+	if metadata != nil {
+		for key, value := range metadata.Metadata {
+			patched.Add(key, value)
+		}
+	}
+	for _, key := range p.Delete {
+		patched.Remove(key)
+	}
+	if p.Set != nil {
+		for key, value := range p.Set.Metadata {
+			patched.Add(key, value)
+		}
+	}
+	if patched.Empty() {
+		return nil
+	}
+	return patched
+}
+
+// CollectionBatchResult is the outcome of a batch operation for one of its
+// collections. Err is nil when the operation succeeded for the collection.
+type CollectionBatchResult struct {
+	ID  types.UniqueID
+	Err error
+}
+
+// CollectionExistence tells whether a collection exists and whether it is soft
+// deleted.
+type CollectionExistence struct {
+	ID        types.UniqueID
+	Exists    bool
+	IsDeleted bool
 }
 
 type FlushCollectionInfo struct {
@@ -68,3 +353,22 @@ func FilterCollection(collection *Collection, collectionID types.UniqueID, colle
 	}
 	return true
 }
+
+type CollectionSizeOrderBy int
+
+const (
+	CollectionSizeOrderByTotalRecords CollectionSizeOrderBy = iota
+	CollectionSizeOrderBySizeBytes
+)
+
+type ApproximateCounts struct {
+	CollectionCount int64
+	RecordCount     int64
+}
+
+type CollectionSize struct {
+	ID                         types.UniqueID
+	Name                       string
+	TotalRecordsPostCompaction int64
+	SizeBytesPostCompaction    int64
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/types"
+)
+
+// CollectionPermission is a bit set of the permissions a principal has on a
+// collection. The sysdb only stores them, frontends enforce them.
+type CollectionPermission int32
+
+const (
+	CollectionPermissionRead CollectionPermission = 1 << iota
+	CollectionPermissionWrite
+	CollectionPermissionAdmin
+)
+
+var collectionPermissionNames = []struct {
+	permission CollectionPermission
+	name       string
+}{
+	{CollectionPermissionRead, "read"},
+	{CollectionPermissionWrite, "write"},
+	{CollectionPermissionAdmin, "admin"},
+}
+
+// ParseCollectionPermissions returns the set of the named permissions.
+func ParseCollectionPermissions(names []string) (CollectionPermission, error) {
+	var permissions CollectionPermission
+	for _, name := range names {
+		found := false
+		for _, p := range collectionPermissionNames {
+			if p.name == name {
+				permissions |= p.permission
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, common.ErrUnknownCollectionPermission
+		}
+	}
+	return permissions, nil
+}
+
+// Names returns the names of the permissions in the set.
+func (p CollectionPermission) Names() []string {
+	names := make([]string, 0, len(collectionPermissionNames))
+	for _, permission := range collectionPermissionNames {
+		if p&permission.permission != 0 {
+			names = append(names, permission.name)
+		}
+	}
+	return names
+}
+
+type CollectionAclEntry struct {
+	CollectionID types.UniqueID
+	Principal    string
+	Permissions  CollectionPermission
+}
@@ -0,0 +1,17 @@
+package model
+
+import "github.com/chroma-core/chroma/go/pkg/types"
+
+// CollectionLogRoute is the log instance that serves a collection. An empty
+// LogAddress means the collection is served by the default log. Version is 0
+// for collections that were never routed and is incremented on every change.
+type CollectionLogRoute struct {
+	CollectionID types.UniqueID
+	LogAddress   string
+	Version      int64
+	// UpdatedAt is in unix milliseconds.
+	UpdatedAt int64
+	// Revision is the revision of the last change of the route, 0 for
+	// collections that were never routed.
+	Revision int64
+}
@@ -0,0 +1,45 @@
+package model
+
+import "sort"
+
+// CollectionMetadataChange is a metadata key whose value differs between two
+// metadata. From is nil for an added key and To is nil for a removed key.
+type CollectionMetadataChange struct {
+	Key  string
+	From CollectionMetadataValueType
+	To   CollectionMetadataValueType
+}
+
+// CollectionMetadataDiff is what changed in the metadata of a collection from
+// FromRevision to its current revision, ToRevision.
+type CollectionMetadataDiff struct {
+	FromRevision int64
+	ToRevision   int64
+	Changes      []*CollectionMetadataChange
+}
+
+// DiffCollectionMetadata returns the changes from one metadata to the other,
+// sorted by key. A nil metadata is an empty one.
+func DiffCollectionMetadata(from *CollectionMetadata[CollectionMetadataValueType], to *CollectionMetadata[CollectionMetadataValueType]) []*CollectionMetadataChange {
+	changes := []*CollectionMetadataChange{}
+	if from != nil {
+		for key, value := range from.Metadata {
+			var next CollectionMetadataValueType
+			if to != nil {
+				next = to.Metadata[key]
+			}
+			if next == nil || !value.Equals(next) {
+				changes = append(changes, &CollectionMetadataChange{Key: key, From: value, To: next})
+			}
+		}
+	}
+	if to != nil {
+		for key, value := range to.Metadata {
+			if from == nil || from.Metadata[key] == nil {
+				changes = append(changes, &CollectionMetadataChange{Key: key, To: value})
+			}
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Key < changes[j].Key })
+	return changes
+}
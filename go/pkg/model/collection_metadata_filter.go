@@ -0,0 +1,38 @@
+package model
+
+type MetadataFilterOperator int
+
+const (
+	MetadataFilterEQ MetadataFilterOperator = iota
+	MetadataFilterNE
+	MetadataFilterGT
+	MetadataFilterGTE
+	MetadataFilterLT
+	MetadataFilterLTE
+	MetadataFilterExists
+	MetadataFilterNotExists
+)
+
+// CollectionFilterField is what a CollectionMetadataFilter compares, a metadata
+// key by default.
+type CollectionFilterField int
+
+const (
+	CollectionFilterMetadata CollectionFilterField = iota
+	// CollectionFilterDimension compares the dimension column with an int64 value.
+	CollectionFilterDimension
+	// CollectionFilterDistanceFunction compares the distance function column with
+	// a string value.
+	CollectionFilterDistanceFunction
+)
+
+// CollectionMetadataFilter is a predicate on a single collection metadata key,
+// or on a typed column of the collection when Field is set, in which case Key
+// is ignored. Value is nil for the exists and not exists operators. NE matches
+// collections that either lack the key or hold a different value for it.
+type CollectionMetadataFilter struct {
+	Field    CollectionFilterField
+	Key      string
+	Operator MetadataFilterOperator
+	Value    CollectionMetadataValueType
+}
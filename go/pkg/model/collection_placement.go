@@ -0,0 +1,41 @@
+package model
+
+import "github.com/chroma-core/chroma/go/pkg/types"
+
+// CollectionPlacement is the query nodes a collection is assigned to, as many as
+// its replication factor allows. Version changes whenever the memberlist or the
+// replication factor the placement was computed from changes, so clients can
+// keep a placement until they see another version.
+type CollectionPlacement struct {
+	CollectionID      types.UniqueID
+	ReplicationFactor int32
+	QueryNodes        []string
+	Version           uint64
+}
+
+// PlacementSimulation compares the current placement of collections with the
+// one a hypothetical query memberlist would give them. Moves lists at most the
+// requested number of the MovedCollections, in the order collections were
+// created.
+type PlacementSimulation struct {
+	TotalCollections int64
+	MovedCollections int64
+	Moves            []*CollectionPlacementMove
+	NodeLoads        []*NodeLoad
+}
+
+// CollectionPlacementMove is a collection that the simulated memberlist places
+// on other query nodes than the current one.
+type CollectionPlacementMove struct {
+	CollectionID   types.UniqueID
+	CurrentNodes   []string
+	SimulatedNodes []string
+}
+
+// NodeLoad is how many collections are placed on a query node, currently and
+// with the simulated memberlist.
+type NodeLoad struct {
+	NodeID               string
+	CurrentCollections   int64
+	SimulatedCollections int64
+}
@@ -0,0 +1,30 @@
+package model
+
+// CollectionSchemaFieldType is the type of the values of a document metadata
+// key, one of the metadata value types.
+type CollectionSchemaFieldType string
+
+const (
+	CollectionSchemaFieldString CollectionSchemaFieldType = "string"
+	CollectionSchemaFieldInt    CollectionSchemaFieldType = "int"
+	CollectionSchemaFieldFloat  CollectionSchemaFieldType = "float"
+	CollectionSchemaFieldBool   CollectionSchemaFieldType = "bool"
+)
+
+// CollectionSchemaField declares the type of a document metadata key of a
+// collection. Indexed is a hint for query planners, the sysdb only stores it.
+type CollectionSchemaField struct {
+	Name    string
+	Type    CollectionSchemaFieldType
+	Indexed bool
+}
+
+// CollectionSchemaViolation is a document metadata value whose type is not the
+// one the schema of the collection declares for its key. Index is the position
+// of the metadata in the validated batch.
+type CollectionSchemaViolation struct {
+	Index    int32
+	Key      string
+	Expected CollectionSchemaFieldType
+	Actual   CollectionSchemaFieldType
+}
@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// CollectionSizeSnapshot is the size of a collection on a day, the UTC midnight
+// the snapshot was taken for.
+type CollectionSizeSnapshot struct {
+	Day          time.Time
+	TotalRecords int64
+	SizeBytes    int64
+}
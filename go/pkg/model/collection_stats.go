@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/types"
+)
+
+// CollectionStats is the rollup of a collection as of RefreshedAt, for
+// dashboards. LogLag is nil when the lag was not read from the log service yet.
+type CollectionStats struct {
+	CollectionID    types.UniqueID
+	TenantID        string
+	DatabaseName    string
+	TotalRecords    int64
+	SizeBytes       int64
+	SegmentCount    int32
+	LastCompactedAt *time.Time
+	LogLag          *CollectionLogLag
+	RefreshedAt     time.Time
+}
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/types"
+)
+
+// CollectionTemplate is a collection configuration of a tenant that collections
+// are created from. The collections copy the configuration, and keep a
+// reference to the template for its quotas: MaxRecords and MaxSizeBytes limit
+// each collection created from the template, and are checked by CheckQuota for
+// writes to the collection. Nil limits are not enforced.
+type CollectionTemplate struct {
+	ID                types.UniqueID
+	TenantID          string
+	Name              string
+	Dimension         *int32
+	DistanceFunction  *string
+	ReplicationFactor *int32
+	// Metadata is the default metadata of the collections, including the HNSW
+	// parameters of their index.
+	Metadata     *CollectionMetadata[CollectionMetadataValueType]
+	MaxRecords   *int64
+	MaxSizeBytes *int64
+	CreatedAt    time.Time
+}
+
+// CreateCollectionFromTemplate creates Collection with the configuration of the
+// template with TemplateID, or of the template of the tenant named TemplateName
+// when TemplateID is nil. The metadata of Collection is merged over the default
+// metadata of the template, and must not change its HNSW parameters.
+type CreateCollectionFromTemplate struct {
+	TemplateID   types.UniqueID
+	TemplateName string
+	Collection   *CreateCollection
+}
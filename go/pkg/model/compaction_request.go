@@ -0,0 +1,16 @@
+package model
+
+import "github.com/chroma-core/chroma/go/pkg/types"
+
+// CompactionRequest is a collection queued for compaction ahead of the usual
+// thresholds. RequestedAt is in unix seconds. LastCompactor and
+// LastCompactedAt are those of the collection, so that the scheduler can prefer
+// the compactor that likely has its segment files cached.
+type CompactionRequest struct {
+	CollectionID    types.UniqueID
+	TenantID        string
+	RequestedBy     string
+	RequestedAt     int64
+	LastCompactor   *string
+	LastCompactedAt *int64
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/types"
+)
+
+// EmbeddingFunction is an embedding configuration of a tenant that collections
+// reference by ID, rather than each holding a copy in their metadata. Params is
+// the JSON object the provider is configured with, it is not interpreted by the
+// sysdb.
+type EmbeddingFunction struct {
+	ID        types.UniqueID
+	TenantID  string
+	Name      string
+	Provider  string
+	Model     string
+	Params    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// UpdateEmbeddingFunction leaves the fields that are nil as they are.
+type UpdateEmbeddingFunction struct {
+	ID       types.UniqueID
+	Name     *string
+	Provider *string
+	Model    *string
+	Params   *string
+}
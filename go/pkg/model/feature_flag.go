@@ -0,0 +1,64 @@
+package model
+
+import "hash/fnv"
+
+// FeatureFlag turns a risky behavior on without a redeploy. A flag with an
+// empty TenantID is the default of the flag: when Enabled, it is on for
+// RolloutPercentage percent of the tenants. A flag with a TenantID overrides
+// the default for that tenant and RolloutPercentage is ignored.
+type FeatureFlag struct {
+	Name              string
+	TenantID          string
+	Enabled           bool
+	RolloutPercentage int32
+	Description       string
+}
+
+// FeatureFlags evaluates a set of flags. Flags that are not in the set are off.
+type FeatureFlags struct {
+	defaults  map[string]*FeatureFlag
+	overrides map[featureFlagKey]*FeatureFlag
+}
+
+type featureFlagKey struct {
+	name     string
+	tenantID string
+}
+
+func NewFeatureFlags(flags []*FeatureFlag) *FeatureFlags {
+	f := &FeatureFlags{
+		defaults:  make(map[string]*FeatureFlag),
+		overrides: make(map[featureFlagKey]*FeatureFlag),
+	}
+	for _, flag := range flags {
+		if flag.TenantID == "" {
+			f.defaults[flag.Name] = flag
+		} else {
+			f.overrides[featureFlagKey{name: flag.Name, tenantID: flag.TenantID}] = flag
+		}
+	}
+	return f
+}
+
+// Enabled tells whether the flag is on for the tenant. A tenant stays in or out
+// of a partial rollout as the percentage changes, and the tenants of a rollout
+// differ from one flag to another.
+func (f *FeatureFlags) Enabled(name string, tenantID string) bool {
+	if override, ok := f.overrides[featureFlagKey{name: name, tenantID: tenantID}]; ok {
+		return override.Enabled
+	}
+	flag, ok := f.defaults[name]
+	if !ok || !flag.Enabled {
+		return false
+	}
+	return rolloutBucket(name, tenantID) < flag.RolloutPercentage
+}
+
+// rolloutBucket places a tenant in one of 100 buckets of a flag.
+func rolloutBucket(name string, tenantID string) int32 {
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	h.Write([]byte{0})
+	h.Write([]byte(tenantID))
+	return int32(h.Sum32() % 100)
+}
@@ -0,0 +1,85 @@
+package model
+
+import (
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/types"
+)
+
+// JobState is where a job of the job queue is in its lifecycle.
+type JobState string
+
+const (
+	// JobStatePending is a job waiting for a worker, either not run yet or
+	// waiting to retry a failed attempt.
+	JobStatePending JobState = "pending"
+	// JobStateRunning is a job a worker is running.
+	JobStateRunning JobState = "running"
+	// JobStateSucceeded is a job whose last attempt succeeded.
+	JobStateSucceeded JobState = "succeeded"
+	// JobStateFailed is a job that failed all of its attempts, or failed with an
+	// error that retrying cannot fix.
+	JobStateFailed JobState = "failed"
+	// JobStateCanceled is a job canceled by CancelJob before it finished.
+	JobStateCanceled JobState = "canceled"
+)
+
+// Finished tells whether a job in the state is over and cannot be canceled.
+func (s JobState) Finished() bool {
+	return s == JobStateSucceeded || s == JobStateFailed || s == JobStateCanceled
+}
+
+// Valid tells whether s is one of the states of a job.
+func (s JobState) Valid() bool {
+	return s == JobStatePending || s == JobStateRunning || s.Finished()
+}
+
+// The types of the jobs of the job queue.
+const (
+	// JobTypeDeleteTenant force deletes a tenant, with a DeleteTenantJob payload.
+	JobTypeDeleteTenant = "delete_tenant"
+)
+
+// Job is a long-running operation run in the background by the job workers of
+// the coordinator. Payload is the JSON encoded input of the job, whose format
+// depends on its Type.
+type Job struct {
+	ID          types.UniqueID
+	Type        string
+	TenantID    string
+	Payload     []byte
+	State       JobState
+	Attempts    int32
+	MaxAttempts int32
+	LastError   string
+	NextRunAt   time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	// FinishedAt is nil until the job succeeded or failed.
+	FinishedAt *time.Time
+}
+
+// JobFilter narrows down the jobs returned by ListJobs, the empty fields match
+// every job. Jobs are ordered from the most recently created.
+type JobFilter struct {
+	TenantID string
+	Type     string
+	State    JobState
+	Limit    int32
+	Offset   int32
+}
+
+// JobOutcome is how an attempt of a job ended. A pending outcome retries the
+// job at NextRunAt.
+type JobOutcome struct {
+	State     JobState
+	LastError string
+	NextRunAt time.Time
+}
+
+// DeleteTenantJob is the payload of a JobTypeDeleteTenant job.
+type DeleteTenantJob struct {
+	Name  string `json:"name"`
+	Force bool   `json:"force"`
+	Actor string `json:"actor"`
+}
@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// MaintenanceMode is the maintenance state of the whole sysdb. While Enabled,
+// mutating RPCs are rejected with Reason. UpdatedAt is zero when maintenance
+// was never set.
+type MaintenanceMode struct {
+	Enabled   bool
+	Reason    string
+	UpdatedAt time.Time
+}
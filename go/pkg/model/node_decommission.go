@@ -0,0 +1,44 @@
+package model
+
+import "time"
+
+type NodeDecommissionState string
+
+const (
+	// NodeDecommissionDraining is a node whose collections are being placed on
+	// other nodes. Clients move off it as they refresh their placements.
+	NodeDecommissionDraining NodeDecommissionState = "draining"
+	// NodeDecommissionRemoved is a node that was removed from the memberlist.
+	NodeDecommissionRemoved NodeDecommissionState = "removed"
+)
+
+// NodeDecommission takes a query node out of service: no collection is placed
+// on it from StartedAt, it is marked draining in the query memberlist so that
+// clients move its collections elsewhere, and it is removed from the memberlist
+// once no collection is placed on it there and DrainUntil has passed.
+type NodeDecommission struct {
+	NodeID     string
+	StartedAt  time.Time
+	DrainUntil time.Time
+	// Collections is the number of collections placed on the node when the
+	// decommission started.
+	Collections int64
+}
+
+// NodeDecommissionProgress is where a decommission stands.
+type NodeDecommissionProgress struct {
+	Decommission *NodeDecommission
+	State        NodeDecommissionState
+	// RemainingCollections is the number of collections that the memberlist
+	// clients read still places on the node.
+	RemainingCollections int64
+}
+
+// Progress is the fraction of the collections of the node that were placed on
+// other nodes, between 0 and 1.
+func (p *NodeDecommissionProgress) Progress() float64 {
+	if p.Decommission.Collections <= 0 || p.RemainingCollections <= 0 {
+		return 1
+	}
+	return max(1-float64(p.RemainingCollections)/float64(p.Decommission.Collections), 0)
+}
@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// NodeHeartbeat is the last time a worker node reported that it is alive.
+type NodeHeartbeat struct {
+	NodeID   string
+	LastSeen time.Time
+}
+
+// NodeLiveness tells apart the query nodes that are in the memberlist but no
+// longer send heartbeats, e.g. a pod that exists but is wedged, from the
+// healthy ones. LastSeen is zero when the node never sent a heartbeat.
+type NodeLiveness struct {
+	NodeID       string
+	LastSeen     time.Time
+	InMemberlist bool
+	Alive        bool
+}
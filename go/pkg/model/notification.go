@@ -3,6 +3,9 @@ package model
 const (
 	NotificationTypeCreateCollection = "create_collection"
 	NotificationTypeDeleteCollection = "delete_collection"
+	// NotificationTypeUpdateCollection is sent when the name, configuration or
+	// metadata of a collection changes.
+	NotificationTypeUpdateCollection = "update_collection"
 )
 
 const (
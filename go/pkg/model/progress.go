@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// OperationProgress is the progress of a long-running operation, streamed to
+// its caller. ETA is zero until it can be estimated.
+type OperationProgress struct {
+	Processed int64
+	Total     int64
+	ETA       time.Duration
+}
+
+// ProgressFunc is called by a long-running operation as it goes, with the
+// number of entities processed so far out of total.
+type ProgressFunc func(processed int64, total int64)
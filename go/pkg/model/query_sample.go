@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// QuerySample is one statement run by a sampled GetCollections or GetSegments
+// call. Params only keep numeric, boolean and time values; every other value is
+// replaced by its type name.
+type QuerySample struct {
+	Operation string
+	SQL       string
+	Params    []string
+	Rows      int64
+	Duration  time.Duration
+	StartedAt time.Time
+	Error     string
+}
@@ -0,0 +1,166 @@
+package model
+
+import "github.com/chroma-core/chroma/go/pkg/types"
+
+// QuotaResource is a resource whose use is limited by a quota.
+type QuotaResource string
+
+const (
+	QuotaResourceCollections QuotaResource = "collections"
+	QuotaResourceRecords     QuotaResource = "records"
+	QuotaResourceSizeBytes   QuotaResource = "size_bytes"
+)
+
+// TenantQuota holds the limits of a tenant. A nil limit is unlimited.
+type TenantQuota struct {
+	TenantID       string
+	MaxCollections *int64
+	MaxRecords     *int64
+	MaxSizeBytes   *int64
+}
+
+// DatabaseQuota overrides the limits of a tenant for one of its databases. A nil
+// limit is inherited from the tenant.
+type DatabaseQuota struct {
+	TenantID       string
+	DatabaseName   string
+	MaxCollections *int64
+	MaxRecords     *int64
+	MaxSizeBytes   *int64
+}
+
+// EffectiveQuota is the quota that applies to a database: the limits of the
+// database where it sets them and those of its tenant otherwise.
+type EffectiveQuota struct {
+	TenantID       string
+	DatabaseName   string
+	MaxCollections *int64
+	MaxRecords     *int64
+	MaxSizeBytes   *int64
+	// Overridden are the resources whose limit is set on the database.
+	Overridden []QuotaResource
+}
+
+// ResolveQuota returns the effective quota of a database.
+func ResolveQuota(tenant *TenantQuota, database *DatabaseQuota) *EffectiveQuota {
+	effective := &EffectiveQuota{
+		TenantID:       tenant.TenantID,
+		DatabaseName:   database.DatabaseName,
+		MaxCollections: tenant.MaxCollections,
+		MaxRecords:     tenant.MaxRecords,
+		MaxSizeBytes:   tenant.MaxSizeBytes,
+	}
+	override := func(resource QuotaResource, limit **int64, databaseLimit *int64) {
+		if databaseLimit != nil {
+			*limit = databaseLimit
+			effective.Overridden = append(effective.Overridden, resource)
+		}
+	}
+	override(QuotaResourceCollections, &effective.MaxCollections, database.MaxCollections)
+	override(QuotaResourceRecords, &effective.MaxRecords, database.MaxRecords)
+	override(QuotaResourceSizeBytes, &effective.MaxSizeBytes, database.MaxSizeBytes)
+	return effective
+}
+
+// QuotaUsage is what a tenant or a database currently uses. Records and bytes
+// only count compacted data.
+type QuotaUsage struct {
+	Collections int64
+	Records     int64
+	SizeBytes   int64
+}
+
+// CollectionQuota holds the per collection limits of the template a collection
+// was created from. A nil limit is unlimited.
+type CollectionQuota struct {
+	CollectionID types.UniqueID
+	TemplateID   *types.UniqueID
+	MaxRecords   *int64
+	MaxSizeBytes *int64
+}
+
+// QuotaDelta is the change in usage a write would cause.
+type QuotaDelta struct {
+	Collections int64
+	Records     int64
+	SizeBytes   int64
+	// CollectionID is the collection the write goes to, when it goes to one.
+	CollectionID *types.UniqueID
+}
+
+// QuotaViolation is a resource whose projected usage exceeds its limit.
+type QuotaViolation struct {
+	Resource QuotaResource
+	// DatabaseName is set when the limit is the one of a database rather than
+	// the tenant.
+	DatabaseName string
+	// CollectionID is set when the limit is the one of the template of a
+	// collection.
+	CollectionID *types.UniqueID
+	Limit        int64
+	Usage        int64
+	Delta        int64
+}
+
+// QuotaCheck is the outcome of checking a write against the quota of a tenant,
+// against the effective quota of the database the write goes to when one was
+// given, and against the quota of the collection it goes to when one was given.
+type QuotaCheck struct {
+	Usage           *QuotaUsage
+	Quota           *TenantQuota
+	DatabaseUsage   *QuotaUsage
+	DatabaseQuota   *EffectiveQuota
+	CollectionUsage *QuotaUsage
+	CollectionQuota *CollectionQuota
+	Violations      []*QuotaViolation
+}
+
+func (c *QuotaCheck) Allowed() bool {
+	return len(c.Violations) == 0
+}
+
+// Check returns the resources whose usage would exceed their limit once delta
+// is applied. A delta that does not increase usage never violates the quota, so
+// that tenants over their quota can still delete data.
+func (q *TenantQuota) Check(usage *QuotaUsage, delta *QuotaDelta) []*QuotaViolation {
+	var violations []*QuotaViolation
+	check := func(resource QuotaResource, limit *int64, used int64, change int64) {
+		if limit == nil || change <= 0 || used+change <= *limit {
+			return
+		}
+		violations = append(violations, &QuotaViolation{
+			Resource: resource,
+			Limit:    *limit,
+			Usage:    used,
+			Delta:    change,
+		})
+	}
+	check(QuotaResourceCollections, q.MaxCollections, usage.Collections, delta.Collections)
+	check(QuotaResourceRecords, q.MaxRecords, usage.Records, delta.Records)
+	check(QuotaResourceSizeBytes, q.MaxSizeBytes, usage.SizeBytes, delta.SizeBytes)
+	return violations
+}
+
+// Check returns the resources whose usage of the database would exceed a limit
+// the database overrides once delta is applied. Inherited limits apply to the
+// usage of the whole tenant and are checked against it.
+func (q *DatabaseQuota) Check(usage *QuotaUsage, delta *QuotaDelta) []*QuotaViolation {
+	limits := &TenantQuota{MaxCollections: q.MaxCollections, MaxRecords: q.MaxRecords, MaxSizeBytes: q.MaxSizeBytes}
+	violations := limits.Check(usage, delta)
+	for _, violation := range violations {
+		violation.DatabaseName = q.DatabaseName
+	}
+	return violations
+}
+
+// Check returns the per collection limits of the template that the usage of the
+// collection would exceed once delta is applied.
+func (q *CollectionQuota) Check(usage *QuotaUsage, delta *QuotaDelta) []*QuotaViolation {
+	limits := &TenantQuota{MaxRecords: q.MaxRecords, MaxSizeBytes: q.MaxSizeBytes}
+	violations := limits.Check(usage, &QuotaDelta{Records: delta.Records, SizeBytes: delta.SizeBytes})
+	for _, violation := range violations {
+		collectionID := q.CollectionID
+		violation.CollectionID = &collectionID
+	}
+	return violations
+}
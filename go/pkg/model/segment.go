@@ -43,6 +43,48 @@ type GetSegments struct {
 type FlushSegmentCompaction struct {
 	ID        types.UniqueID
 	FilePaths map[string][]string
+	// ContentHashes and SizeBytes are the hashes and sizes of FilePaths, with
+	// the same keys and in the same order. A key is missing when unknown.
+	ContentHashes map[string][]string
+	SizeBytes     map[string][]int64
+}
+
+// SegmentFile is a file of a segment. ContentHash is empty when the file was not
+// hashed. OtherCollections is the number of files of other collections with the
+// same content; the file can only be deleted from storage when it is zero. It is
+// nil when the file was not hashed, since whether it is shared is unknown.
+type SegmentFile struct {
+	SegmentID        types.UniqueID
+	CollectionID     types.UniqueID
+	FileType         string
+	Path             string
+	ContentHash      string
+	SizeBytes        int64
+	OtherCollections *int64
+}
+
+// StorageAttribution splits the bytes of the segment files of a tenant between
+// files whose content only their collection references (UniqueBytes) and files
+// whose content other collections reference too (SharedBytes). DistinctBytes
+// counts each content once, i.e. what the tenant actually stores.
+type StorageAttribution struct {
+	TenantID      string
+	UniqueBytes   int64
+	SharedBytes   int64
+	DistinctBytes int64
+	Collections   []*CollectionStorageAttribution
+}
+
+type CollectionStorageAttribution struct {
+	CollectionID types.UniqueID
+	UniqueBytes  int64
+	SharedBytes  int64
+}
+
+// DuplicateSegmentFiles are the files that share ContentHash.
+type DuplicateSegmentFiles struct {
+	ContentHash string
+	Files       []*SegmentFile
 }
 
 func FilterSegments(segment *Segment, segmentID types.UniqueID, segmentType *string, scope *string, topic *string, collectionID types.UniqueID) bool {
@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+// SoftDeleteCleanerRun counts the collections a run of the soft delete cleaner
+// went through. FinishedAt is zero while the run is in progress, and Error is
+// set when the run could not list the collections to purge.
+type SoftDeleteCleanerRun struct {
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Manual     bool
+	Scanned    int64
+	Purged     int64
+	Failed     int64
+	Error      string
+}
+
+// SoftDeleteCleanerStatus is the progress of the soft delete cleaner of the
+// leader. LastRun is nil before the first run and the totals count every run
+// since the cleaner started.
+type SoftDeleteCleanerStatus struct {
+	Enabled      bool
+	Running      bool
+	Retention    time.Duration
+	NextRunAt    time.Time
+	LastRun      *SoftDeleteCleanerRun
+	TotalScanned int64
+	TotalPurged  int64
+	TotalFailed  int64
+}
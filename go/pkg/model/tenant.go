@@ -3,12 +3,40 @@ package model
 import "github.com/chroma-core/chroma/go/pkg/types"
 
 type Tenant struct {
-	Name string
+	Name     string
+	KmsKeyID *string
 }
 
 type CreateTenant struct {
+	Name     string
+	Ts       types.Timestamp
+	KmsKeyID *string
+}
+
+// BatchCreateTenant is a tenant of a batch creation, created with a database
+// named DatabaseName when it is set and with Quota when it is not nil.
+type BatchCreateTenant struct {
+	Name         string
+	KmsKeyID     *string
+	DatabaseName string
+	Quota        *TenantQuota
+}
+
+// TenantBatchResult is the outcome of a batch operation for one of its tenants.
+// Err is nil when the operation succeeded for the tenant.
+type TenantBatchResult struct {
 	Name string
-	Ts   types.Timestamp
+	Err  error
+}
+
+type DeleteTenant struct {
+	Name              string
+	Force             bool
+	ConfirmationToken string
+	Actor             string
+	// Progress, when set, is called after each collection of a force deletion
+	// is purged, within the transaction of the deletion.
+	Progress ProgressFunc
 }
 
 type GetTenant struct {
@@ -20,3 +48,16 @@ type TenantLastCompactionTime struct {
 	ID string
 	Ts types.Timestamp
 }
+
+// DestructiveOperation identifies an operation that needs a confirmation token
+// issued by a previous call before it is carried out.
+type DestructiveOperation int
+
+const (
+	ForceDeleteTenant DestructiveOperation = iota
+)
+
+type ConfirmationToken struct {
+	Token     string
+	ExpiresAt int64
+}
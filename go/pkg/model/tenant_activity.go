@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+// TenantActivity is what happened to the collections of a tenant on a UTC day,
+// or over a window of days: the collections that went through each lifecycle
+// event, the compactions flushed and the log records they compacted.
+type TenantActivity struct {
+	Day                 time.Time
+	CollectionsCreated  int64
+	CollectionsDeleted  int64
+	CollectionsRestored int64
+	CollectionsPurged   int64
+	Compactions         int64
+	RecordsCompacted    int64
+}
+
+// Add adds the activity of other to a.
+func (a *TenantActivity) Add(other *TenantActivity) {
+	a.CollectionsCreated += other.CollectionsCreated
+	a.CollectionsDeleted += other.CollectionsDeleted
+	a.CollectionsRestored += other.CollectionsRestored
+	a.CollectionsPurged += other.CollectionsPurged
+	a.Compactions += other.Compactions
+	a.RecordsCompacted += other.RecordsCompacted
+}
@@ -0,0 +1,16 @@
+package model
+
+import "slices"
+
+// TenantPlacement constrains the regions the collections of a tenant may be
+// placed in, for data residency. A placement without regions allows every
+// region.
+type TenantPlacement struct {
+	TenantID       string
+	AllowedRegions []string
+}
+
+// Allows tells whether the data of the tenant may be placed in region.
+func (p *TenantPlacement) Allows(region string) bool {
+	return len(p.AllowedRegions) == 0 || slices.Contains(p.AllowedRegions, region)
+}
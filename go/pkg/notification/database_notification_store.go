@@ -30,22 +30,23 @@ func (d *DatabaseNotificationStore) GetAllPendingNotifications(ctx context.Conte
 
 	notificationMap := make(map[string][]model.Notification)
 	for _, notification := range notifications {
-		notificationMap[notification.CollectionID] = append(notificationMap[notification.CollectionID], model.Notification{
+		collectionID := notification.CollectionID.String()
+		notificationMap[collectionID] = append(notificationMap[collectionID], model.Notification{
 			ID:           notification.ID,
-			CollectionID: notification.CollectionID,
+			CollectionID: collectionID,
 			Type:         notification.Type,
 			Status:       notification.Status,
 		})
 		// sort notifications by ID, this is ok because of the small number of notifications
-		sort.Slice(notificationMap[notification.CollectionID], func(i, j int) bool {
-			return notificationMap[notification.CollectionID][i].ID < notificationMap[notification.CollectionID][j].ID
+		sort.Slice(notificationMap[collectionID], func(i, j int) bool {
+			return notificationMap[collectionID][i].ID < notificationMap[collectionID][j].ID
 		})
 	}
 	return notificationMap, nil
 }
 
 func (d *DatabaseNotificationStore) GetNotifications(ctx context.Context, collectionID string) ([]model.Notification, error) {
-	notifications, err := d.metaDomain.NotificationDb(ctx).GetNotificationByCollectionID(collectionID)
+	notifications, err := d.metaDomain.NotificationDb(ctx).GetNotificationByCollectionID(dbmodel.CollectionID(collectionID))
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +55,7 @@ func (d *DatabaseNotificationStore) GetNotifications(ctx context.Context, collec
 	for _, notification := range notifications {
 		result = append(result, model.Notification{
 			ID:           notification.ID,
-			CollectionID: notification.CollectionID,
+			CollectionID: notification.CollectionID.String(),
 			Type:         notification.Type,
 			Status:       notification.Status,
 		})
@@ -67,9 +68,9 @@ func (d *DatabaseNotificationStore) GetNotifications(ctx context.Context, collec
 }
 
 func (d *DatabaseNotificationStore) AddNotification(ctx context.Context, notification model.Notification) error {
-	return d.txImpl.Transaction(ctx, func(ctx context.Context) error {
+	return d.txImpl.WithWriteTx(ctx, func(ctx context.Context) error {
 		err := d.metaDomain.NotificationDb(ctx).Insert(&dbmodel.Notification{
-			CollectionID: notification.CollectionID,
+			CollectionID: dbmodel.CollectionID(notification.CollectionID),
 			Type:         notification.Type,
 			Status:       notification.Status,
 		})
@@ -81,7 +82,7 @@ func (d *DatabaseNotificationStore) AddNotification(ctx context.Context, notific
 }
 
 func (d *DatabaseNotificationStore) RemoveNotifications(ctx context.Context, notification []model.Notification) error {
-	return d.txImpl.Transaction(ctx, func(ctx context.Context) error {
+	return d.txImpl.WithWriteTx(ctx, func(ctx context.Context) error {
 		ids := make([]int64, 0, len(notification))
 		for _, n := range notification {
 			ids = append(ids, n.ID)
@@ -41,7 +41,7 @@ func TestDatabaseNotificationStore_GetAllPendingNotifications(t *testing.T) {
 	expectedDBResult := []*dbmodel.Notification{&dbNotification1, &dbNotification2, &dbNotification3}
 
 	// Set up the mock implementation to return the expected result
-	// mockTxImpl.On("Transaction", context.Background(), mock.Anything).Return(nil)
+	// mockTxImpl.On("WithWriteTx", context.Background(), mock.Anything).Return(nil)
 	mockMetaDomain.On("NotificationDb", context.Background()).Return(&mocks.INotificationDb{})
 	mockMetaDomain.NotificationDb(context.Background()).(*mocks.INotificationDb).On("GetAllPendingNotifications").Return(expectedDBResult, nil)
 
@@ -92,9 +92,9 @@ func TestDatabaseNotificationStore_GetNotifications(t *testing.T) {
 	expectedDBResult := []*dbmodel.Notification{&dbNotification1, &dbNotification2}
 
 	// Set up the mock implementation to return the expected result
-	// mockTxImpl.On("Transaction", context.Background(), mock.Anything).Return(nil)
+	// mockTxImpl.On("WithWriteTx", context.Background(), mock.Anything).Return(nil)
 	mockMetaDomain.On("NotificationDb", context.Background()).Return(&mocks.INotificationDb{})
-	mockMetaDomain.NotificationDb(context.Background()).(*mocks.INotificationDb).On("GetNotificationByCollectionID", "collection1").Return(expectedDBResult, nil)
+	mockMetaDomain.NotificationDb(context.Background()).(*mocks.INotificationDb).On("GetNotificationByCollectionID", dbmodel.CollectionID("collection1")).Return(expectedDBResult, nil)
 
 	// Call the method under test
 	result, err := store.GetNotifications(ctx, "collection1")
@@ -136,7 +136,7 @@ func TestDatabaseNotificationStore_AddNotification(t *testing.T) {
 	dbNotification1 := dbmodel.Notification{ID: 1, CollectionID: "collection1", Status: dbmodel.NotificationStatusPending}
 
 	// Set up the mock implementation to return the expected result
-	mockTxImpl.On("Transaction", context.Background(), mock.Anything).Return(nil)
+	mockTxImpl.On("WithWriteTx", context.Background(), mock.Anything).Return(nil)
 	mockMetaDomain.On("NotificationDb", context.Background()).Return(&mocks.INotificationDb{})
 	mockMetaDomain.NotificationDb(context.Background()).(*mocks.INotificationDb).On("AddNotification", &dbNotification1).Return(nil)
 
@@ -173,7 +173,7 @@ func TestDatabaseNotificationStore_RemoveNotifications(t *testing.T) {
 	dbNotification2 := dbmodel.Notification{ID: 2, CollectionID: "collection1", Status: dbmodel.NotificationStatusPending}
 
 	// Set up the mock implementation to return the expected result
-	mockTxImpl.On("Transaction", context.Background(), mock.Anything).Return(nil)
+	mockTxImpl.On("WithWriteTx", context.Background(), mock.Anything).Return(nil)
 	mockMetaDomain.On("NotificationDb", context.Background()).Return(&mocks.INotificationDb{})
 	mockMetaDomain.NotificationDb(context.Background()).(*mocks.INotificationDb).On("DeleteNotification", &dbNotification1).Return(nil)
 	mockMetaDomain.NotificationDb(context.Background()).(*mocks.INotificationDb).On("DeleteNotification", &dbNotification2).Return(nil)
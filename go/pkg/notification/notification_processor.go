@@ -3,6 +3,7 @@ package notification
 import (
 	"context"
 	"sync/atomic"
+	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/common"
 	"github.com/chroma-core/chroma/go/pkg/model"
@@ -23,6 +24,9 @@ type SimpleNotificationProcessor struct {
 	channel     chan TriggerMessage
 	doneChannel chan bool
 	running     atomic.Bool
+	// pollInterval is how often the pending notifications are delivered
+	// without a trigger, never when it is zero.
+	pollInterval time.Duration
 }
 
 type TriggerMessage struct {
@@ -44,6 +48,13 @@ func NewSimpleNotificationProcessor(ctx context.Context, store NotificationStore
 	}
 }
 
+// SetPollInterval makes the processor deliver the pending notifications every
+// interval, so that notifications that were never triggered are not only sent
+// at the next start. It must be called before Start.
+func (n *SimpleNotificationProcessor) SetPollInterval(interval time.Duration) {
+	n.pollInterval = interval
+}
+
 func (n *SimpleNotificationProcessor) Start() error {
 	// During startup, first sending all pending notifications in the store to the notification topic
 	log.Info("Starting notification processor")
@@ -57,16 +68,28 @@ func (n *SimpleNotificationProcessor) Start() error {
 	return nil
 }
 
+// Stop does nothing when the processor is not running, e.g. because its start
+// failed.
 func (n *SimpleNotificationProcessor) Stop() error {
-	n.running.Store(false)
+	if !n.running.Swap(false) {
+		return nil
+	}
 	n.doneChannel <- true
 	return nil
 }
 
 func (n *SimpleNotificationProcessor) Process(ctx context.Context) error {
 	log.Info("Waiting for new notifications")
+	var poll <-chan time.Time
+	if n.pollInterval > 0 {
+		ticker := time.NewTicker(n.pollInterval)
+		defer ticker.Stop()
+		poll = ticker.C
+	}
 	for {
 		select {
+		case <-poll:
+			n.deliverPendingNotifications(ctx)
 		case triggerMsg := <-n.channel:
 			msg := triggerMsg.Msg
 			log.Info("Received notification", zap.Any("msg", msg))
@@ -116,6 +139,24 @@ func (n *SimpleNotificationProcessor) Trigger(ctx context.Context, triggerMsg Tr
 	n.channel <- triggerMsg
 }
 
+// deliverPendingNotifications makes one attempt to send the pending
+// notifications, the ones that fail are sent again at the next poll.
+func (n *SimpleNotificationProcessor) deliverPendingNotifications(ctx context.Context) {
+	notificationMap, err := n.store.GetAllPendingNotifications(ctx)
+	if err != nil {
+		log.Error("Failed to get all pending notifications", zap.Error(err))
+		return
+	}
+	for collectionID, notifications := range notificationMap {
+		err = n.notifer.Notify(ctx, notifications)
+		if err != nil {
+			log.Error("Failed to send pending notifications", zap.String("collectionID", collectionID), zap.Error(err))
+			continue
+		}
+		n.store.RemoveNotifications(ctx, notifications)
+	}
+}
+
 func (n *SimpleNotificationProcessor) sendPendingNotifications(ctx context.Context) error {
 	notificationMap, err := n.store.GetAllPendingNotifications(ctx)
 	if err != nil {
@@ -3,6 +3,7 @@ package notification
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dao"
 	"github.com/chroma-core/chroma/go/pkg/metastore/db/dbcore"
@@ -121,6 +122,44 @@ func TestSimpleNotificationProcessorWithExistingNotification(t *testing.T) {
 	cleanupDatabase(db)
 }
 
+func TestSimpleNotificationProcessorPoll(t *testing.T) {
+	ctx := context.Background()
+	db := setupDatabase()
+	txnImpl := dbcore.NewTxImpl()
+	metaDomain := dao.NewMetaDomain()
+	notificationStore := NewDatabaseNotificationStore(txnImpl, metaDomain)
+	notifier := NewMemoryNotifier()
+	notificationProcessor := NewSimpleNotificationProcessor(ctx, notificationStore, notifier)
+	notificationProcessor.SetPollInterval(10 * time.Millisecond)
+	notificationProcessor.Start()
+
+	// Added after the start and never triggered, only the poll sends it.
+	notificationStore.AddNotification(ctx, model.Notification{
+		CollectionID: "collection1",
+		Type:         model.NotificationTypeCreateCollection,
+		Status:       model.NotificationStatusPending,
+	})
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		pending, err := notificationStore.GetAllPendingNotifications(ctx)
+		if err != nil {
+			t.Fatalf("Failed to get pending notifications %v", err)
+		}
+		if len(pending) == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Notification is not sent by the poll")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	notificationProcessor.Stop()
+	if len(notifier.queue) != 1 {
+		t.Errorf("Notification is not sent by the notifier")
+	}
+	cleanupDatabase(db)
+}
+
 func setupDatabase() *gorm.DB {
 	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Info),
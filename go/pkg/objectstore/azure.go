@@ -0,0 +1,178 @@
+package objectstore
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+type AzureConfig struct {
+	Container string
+	// ConnectionString, when set, holds the endpoint and the shared key of the
+	// account, e.g. for Azurite. Otherwise the store connects to ServiceURL,
+	// e.g. https://<account>.blob.core.windows.net, with the default Azure
+	// credential.
+	ConnectionString string
+	ServiceURL       string
+	// MaxAttempts is the number of attempts of a request, the first one
+	// included, and MaxBackoff the longest wait between two attempts.
+	MaxAttempts int
+	MaxBackoff  time.Duration
+	// RequestTimeout bounds each call with its retries, reading the object
+	// returned by Get and every block of an upload included.
+	RequestTimeout time.Duration
+	// Objects larger than PartSize are uploaded in blocks of PartSize bytes,
+	// UploadConcurrency of them at a time. PartSize is at least 1 MiB.
+	PartSize          int64
+	UploadConcurrency int
+}
+
+// AzureBlobStore is a Store backed by an Azure Blob Storage container.
+type AzureBlobStore struct {
+	client      *azblob.Client
+	container   string
+	timeout     time.Duration
+	blockSize   int64
+	concurrency int
+	metrics     *storeMetrics
+}
+
+var _ Store = &AzureBlobStore{}
+
+func NewAzureBlobStore(ctx context.Context, cfg AzureConfig) (*AzureBlobStore, error) {
+	if cfg.Container == "" {
+		return nil, common.ErrObjectStoreNoBucket
+	}
+	if cfg.ConnectionString == "" && cfg.ServiceURL == "" {
+		return nil, common.ErrObjectStoreNoEndpoint
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = defaultMaxAttempts
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = defaultMaxBackoff
+	}
+	if cfg.RequestTimeout <= 0 {
+		cfg.RequestTimeout = defaultRequestTimeout
+	}
+	options := &azblob.ClientOptions{ClientOptions: azcore.ClientOptions{
+		Retry: policy.RetryOptions{
+			MaxRetries:    int32(cfg.MaxAttempts - 1),
+			RetryDelay:    min(defaultInitialBackoff, cfg.MaxBackoff),
+			MaxRetryDelay: cfg.MaxBackoff,
+		},
+	}}
+	var client *azblob.Client
+	var err error
+	if cfg.ConnectionString != "" {
+		client, err = azblob.NewClientFromConnectionString(cfg.ConnectionString, options)
+	} else {
+		var credential *azidentity.DefaultAzureCredential
+		credential, err = azidentity.NewDefaultAzureCredential(nil)
+		if err != nil {
+			return nil, err
+		}
+		client, err = azblob.NewClient(cfg.ServiceURL, credential, options)
+	}
+	if err != nil {
+		return nil, err
+	}
+	log.Info("object store configured", zap.String("provider", ProviderAzure), zap.String("container", cfg.Container), zap.String("endpoint", client.URL()), zap.Int("maxAttempts", cfg.MaxAttempts))
+	return &AzureBlobStore{
+		client:      client,
+		container:   cfg.Container,
+		timeout:     cfg.RequestTimeout,
+		blockSize:   cfg.PartSize,
+		concurrency: cfg.UploadConcurrency,
+		metrics:     newStoreMetrics(),
+	}, nil
+}
+
+// Put ignores size, objects that fit in one block are uploaded in a single
+// request.
+func (s *AzureBlobStore) Put(ctx context.Context, key string, body io.Reader, size int64) (err error) {
+	start := time.Now()
+	defer func() { s.metrics.record(ctx, "put", start, err) }()
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	counted := &countingReader{reader: body}
+	_, err = s.client.UploadStream(ctx, s.container, key, counted, &azblob.UploadStreamOptions{
+		BlockSize:   s.blockSize,
+		Concurrency: s.concurrency,
+	})
+	s.metrics.recordBytes(ctx, "put", counted.n)
+	if err != nil {
+		log.Error("put object failed", zap.String("key", key), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (s *AzureBlobStore) Get(ctx context.Context, key string) (_ io.ReadCloser, err error) {
+	start := time.Now()
+	defer func() { s.metrics.record(ctx, "get", start, err) }()
+	requestCtx, cancel := context.WithTimeout(ctx, s.timeout)
+
+	output, err := s.client.DownloadStream(requestCtx, s.container, key, nil)
+	if err != nil {
+		cancel()
+		if bloberror.HasCode(err, bloberror.BlobNotFound) {
+			return nil, common.ErrObjectNotFound
+		}
+		log.Error("get object failed", zap.String("key", key), zap.Error(err))
+		return nil, err
+	}
+	return &objectReader{
+		countingReader: countingReader{reader: output.Body},
+		body:           output.Body,
+		close: func(n int64) {
+			cancel()
+			s.metrics.recordBytes(ctx, "get", n)
+		},
+	}, nil
+}
+
+func (s *AzureBlobStore) Delete(ctx context.Context, key string) (err error) {
+	start := time.Now()
+	defer func() { s.metrics.record(ctx, "delete", start, err) }()
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	_, err = s.client.DeleteBlob(ctx, s.container, key, nil)
+	if err != nil && !bloberror.HasCode(err, bloberror.BlobNotFound) {
+		log.Error("delete object failed", zap.String("key", key), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (s *AzureBlobStore) List(ctx context.Context, prefix string) (_ []string, err error) {
+	start := time.Now()
+	defer func() { s.metrics.record(ctx, "list", start, err) }()
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var keys []string
+	pager := s.client.NewListBlobsFlatPager(s.container, &azblob.ListBlobsFlatOptions{Prefix: &prefix})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			log.Error("list objects failed", zap.String("prefix", prefix), zap.Error(err))
+			return nil, err
+		}
+		for _, item := range page.Segment.BlobItems {
+			keys = append(keys, *item.Name)
+		}
+	}
+	return keys, nil
+}
@@ -0,0 +1,184 @@
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/stretchr/testify/assert"
+)
+
+const testAzureAccount = "devstoreaccount1"
+
+// testAzureConnectionString is the connection string of the well known Azurite
+// account at endpoint.
+func testAzureConnectionString(endpoint string) string {
+	return fmt.Sprintf("DefaultEndpointsProtocol=http;AccountName=%s;AccountKey=Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw==;BlobEndpoint=%s/%s;",
+		testAzureAccount, endpoint, testAzureAccount)
+}
+
+// fakeAzureBlob serves the subset of the Blob service API used by
+// AzureBlobStore, for the container "container". It fails the next failures
+// requests with 503 so that retries can be tested.
+type fakeAzureBlob struct {
+	mu       sync.Mutex
+	objects  map[string][]byte
+	blocks   map[string][]byte
+	failures int
+	requests int
+}
+
+func newFakeAzureBlob() *fakeAzureBlob {
+	return &fakeAzureBlob{objects: make(map[string][]byte), blocks: make(map[string][]byte)}
+}
+
+func (f *fakeAzureBlob) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests++
+	if f.failures > 0 {
+		f.failures--
+		writeAzureError(w, http.StatusServiceUnavailable, "ServerBusy")
+		return
+	}
+	// paths are /account/container/key
+	key := strings.TrimPrefix(r.URL.Path, "/"+testAzureAccount+"/container")
+	key = strings.TrimPrefix(key, "/")
+	query := r.URL.Query()
+	body, _ := io.ReadAll(r.Body)
+	switch {
+	case r.Method == http.MethodGet && query.Get("comp") == "list":
+		var keys []string
+		for k := range f.objects {
+			if strings.HasPrefix(k, query.Get("prefix")) {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		w.Header().Set("Content-Type", "application/xml")
+		fmt.Fprint(w, `<EnumerationResults ContainerName="container"><Blobs>`)
+		for _, k := range keys {
+			fmt.Fprintf(w, `<Blob><Name>%s</Name><Properties></Properties></Blob>`, k)
+		}
+		fmt.Fprint(w, `</Blobs><NextMarker/></EnumerationResults>`)
+	case r.Method == http.MethodPut && query.Get("comp") == "block":
+		f.blocks[query.Get("blockid")] = body
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodPut && query.Get("comp") == "blocklist":
+		var blockList struct {
+			Latest []string `xml:"Latest"`
+		}
+		if err := xml.Unmarshal(body, &blockList); err != nil {
+			writeAzureError(w, http.StatusBadRequest, "InvalidXmlDocument")
+			return
+		}
+		var object []byte
+		for _, id := range blockList.Latest {
+			object = append(object, f.blocks[id]...)
+		}
+		f.objects[key] = object
+		w.Header().Set("ETag", `"done"`)
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodPut:
+		f.objects[key] = body
+		w.Header().Set("ETag", `"done"`)
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodGet:
+		object, ok := f.objects[key]
+		if !ok {
+			writeAzureError(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		w.Header().Set("Content-Length", fmt.Sprint(len(object)))
+		w.Header().Set("ETag", `"done"`)
+		w.Write(object)
+	case r.Method == http.MethodDelete:
+		if _, ok := f.objects[key]; !ok {
+			writeAzureError(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+func writeAzureError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("x-ms-error-code", code)
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `<Error><Code>%s</Code><Message>%s</Message></Error>`, code, http.StatusText(status))
+}
+
+func newTestAzureBlobStore(t *testing.T, fake *fakeAzureBlob) *AzureBlobStore {
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	store, err := NewAzureBlobStore(context.Background(), AzureConfig{
+		Container:        "container",
+		ConnectionString: testAzureConnectionString(server.URL),
+		MaxAttempts:      3,
+		MaxBackoff:       time.Millisecond,
+		PartSize:         1024 * 1024,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return store
+}
+
+func TestAzureBlobStore(t *testing.T) {
+	testStore(t, newTestAzureBlobStore(t, newFakeAzureBlob()))
+}
+
+func TestAzureBlobStore_Retries(t *testing.T) {
+	fake := newFakeAzureBlob()
+	store := newTestAzureBlobStore(t, fake)
+	ctx := context.Background()
+
+	fake.failures = 2
+	assert.NoError(t, store.Put(ctx, "key", strings.NewReader("content"), 7))
+	assert.Equal(t, 3, fake.requests)
+
+	// the request fails once every attempt failed
+	fake.failures = 3
+	_, err := store.Get(ctx, "key")
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, common.ErrObjectNotFound)
+}
+
+func TestAzureBlobStore_Blocks(t *testing.T) {
+	fake := newFakeAzureBlob()
+	store := newTestAzureBlobStore(t, fake)
+	ctx := context.Background()
+
+	content := bytes.Repeat([]byte("0123456789"), 1024*1024/4)
+	assert.NoError(t, store.Put(ctx, "large", bytes.NewReader(content), int64(len(content))))
+	assert.Len(t, fake.blocks, 3)
+
+	body, err := store.Get(ctx, "large")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer body.Close()
+	read, err := io.ReadAll(body)
+	assert.NoError(t, err)
+	assert.Equal(t, content, read)
+}
+
+func TestNewAzureBlobStore_Validation(t *testing.T) {
+	_, err := NewAzureBlobStore(context.Background(), AzureConfig{ConnectionString: testAzureConnectionString("http://localhost:1")})
+	assert.ErrorIs(t, err, common.ErrObjectStoreNoBucket)
+	_, err = NewAzureBlobStore(context.Background(), AzureConfig{Container: "container"})
+	assert.ErrorIs(t, err, common.ErrObjectStoreNoEndpoint)
+}
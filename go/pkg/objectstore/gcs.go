@@ -0,0 +1,165 @@
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/googleapis/gax-go/v2"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+	"google.golang.org/api/iterator"
+)
+
+type GCSConfig struct {
+	Bucket string
+	// MaxAttempts is the number of attempts of a request, the first one
+	// included, and MaxBackoff the longest wait between two attempts.
+	MaxAttempts int
+	MaxBackoff  time.Duration
+	// RequestTimeout bounds each call with its retries, reading the object
+	// returned by Get included.
+	RequestTimeout time.Duration
+	// Objects larger than PartSize are uploaded in chunks of PartSize bytes,
+	// rounded up to a multiple of 256 KiB. Zero uses the default of the client.
+	PartSize int64
+}
+
+// GCSStore is a Store backed by a Google Cloud Storage bucket. Credentials are
+// read from the application default credentials, and STORAGE_EMULATOR_HOST
+// points the store to an emulator.
+type GCSStore struct {
+	bucket    *storage.BucketHandle
+	timeout   time.Duration
+	chunkSize int64
+	metrics   *storeMetrics
+}
+
+var _ Store = &GCSStore{}
+
+func NewGCSStore(ctx context.Context, cfg GCSConfig) (*GCSStore, error) {
+	if cfg.Bucket == "" {
+		return nil, common.ErrObjectStoreNoBucket
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = defaultMaxAttempts
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = defaultMaxBackoff
+	}
+	if cfg.RequestTimeout <= 0 {
+		cfg.RequestTimeout = defaultRequestTimeout
+	}
+	client, err := storage.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Put replaces the whole object, so retrying it is safe even though the
+	// client does not consider uploads without preconditions idempotent.
+	bucket := client.Bucket(cfg.Bucket).Retryer(
+		storage.WithMaxAttempts(cfg.MaxAttempts),
+		storage.WithBackoff(gax.Backoff{Initial: min(defaultInitialBackoff, cfg.MaxBackoff), Max: cfg.MaxBackoff}),
+		storage.WithPolicy(storage.RetryAlways))
+	log.Info("object store configured", zap.String("provider", ProviderGCS), zap.String("bucket", cfg.Bucket), zap.Int("maxAttempts", cfg.MaxAttempts))
+	return &GCSStore{
+		bucket:    bucket,
+		timeout:   cfg.RequestTimeout,
+		chunkSize: cfg.PartSize,
+		metrics:   newStoreMetrics(),
+	}, nil
+}
+
+// Put ignores size, the client uploads in a single request whatever fits in
+// one chunk.
+func (s *GCSStore) Put(ctx context.Context, key string, body io.Reader, size int64) (err error) {
+	start := time.Now()
+	defer func() { s.metrics.record(ctx, "put", start, err) }()
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	writer := s.bucket.Object(key).NewWriter(ctx)
+	if s.chunkSize > 0 {
+		writer.ChunkSize = int(s.chunkSize)
+	}
+	n, err := io.Copy(writer, body)
+	if err != nil {
+		// cancelling the context aborts the upload
+		cancel()
+		writer.Close()
+	} else {
+		err = writer.Close()
+	}
+	s.metrics.recordBytes(ctx, "put", n)
+	if err != nil {
+		log.Error("put object failed", zap.String("key", key), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (s *GCSStore) Get(ctx context.Context, key string) (_ io.ReadCloser, err error) {
+	start := time.Now()
+	defer func() { s.metrics.record(ctx, "get", start, err) }()
+	requestCtx, cancel := context.WithTimeout(ctx, s.timeout)
+
+	reader, err := s.bucket.Object(key).NewReader(requestCtx)
+	if err != nil {
+		cancel()
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil, common.ErrObjectNotFound
+		}
+		log.Error("get object failed", zap.String("key", key), zap.Error(err))
+		return nil, err
+	}
+	return &objectReader{
+		countingReader: countingReader{reader: reader},
+		body:           reader,
+		close: func(n int64) {
+			cancel()
+			s.metrics.recordBytes(ctx, "get", n)
+		},
+	}, nil
+}
+
+func (s *GCSStore) Delete(ctx context.Context, key string) (err error) {
+	start := time.Now()
+	defer func() { s.metrics.record(ctx, "delete", start, err) }()
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	err = s.bucket.Object(key).Delete(ctx)
+	if err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+		log.Error("delete object failed", zap.String("key", key), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (s *GCSStore) List(ctx context.Context, prefix string) (_ []string, err error) {
+	start := time.Now()
+	defer func() { s.metrics.record(ctx, "list", start, err) }()
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	query := &storage.Query{Prefix: prefix}
+	if err := query.SetAttrSelection([]string{"Name"}); err != nil {
+		return nil, err
+	}
+	var keys []string
+	objects := s.bucket.Objects(ctx, query)
+	for {
+		attrs, err := objects.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			log.Error("list objects failed", zap.String("prefix", prefix), zap.Error(err))
+			return nil, err
+		}
+		keys = append(keys, attrs.Name)
+	}
+	return keys, nil
+}
@@ -0,0 +1,157 @@
+package objectstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeGCS serves the subset of the GCS JSON and XML APIs used by GCSStore,
+// for objects small enough to be uploaded in a single request. It fails the
+// next failures requests with 503 so that retries can be tested.
+type fakeGCS struct {
+	mu       sync.Mutex
+	objects  map[string][]byte
+	failures int
+	requests int
+}
+
+func newFakeGCS() *fakeGCS {
+	return &fakeGCS{objects: make(map[string][]byte)}
+}
+
+func (f *fakeGCS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests++
+	if f.failures > 0 {
+		f.failures--
+		f.writeError(w, http.StatusServiceUnavailable)
+		return
+	}
+	const objectsPath = "/storage/v1/b/bucket/o"
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/upload"+objectsPath:
+		name, content, err := readMultipartUpload(r)
+		if err != nil {
+			f.writeError(w, http.StatusBadRequest)
+			return
+		}
+		f.objects[name] = content
+		json.NewEncoder(w).Encode(map[string]string{"bucket": "bucket", "name": name, "size": fmt.Sprint(len(content))})
+	case r.Method == http.MethodGet && r.URL.Path == objectsPath:
+		var keys []string
+		for k := range f.objects {
+			if strings.HasPrefix(k, r.URL.Query().Get("prefix")) {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		items := make([]map[string]string, 0, len(keys))
+		for _, k := range keys {
+			items = append(items, map[string]string{"name": k})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"items": items})
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, objectsPath+"/"):
+		key := strings.TrimPrefix(r.URL.Path, objectsPath+"/")
+		if _, ok := f.objects[key]; !ok {
+			f.writeError(w, http.StatusNotFound)
+			return
+		}
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/bucket/"):
+		object, ok := f.objects[strings.TrimPrefix(r.URL.Path, "/bucket/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", fmt.Sprint(len(object)))
+		w.Write(object)
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+func (f *fakeGCS) writeError(w http.ResponseWriter, code int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	fmt.Fprintf(w, `{"error": {"code": %d, "message": "%s"}}`, code, http.StatusText(code))
+}
+
+// readMultipartUpload returns the name and the content of an object uploaded
+// in a single request, as the metadata part followed by the media part.
+func readMultipartUpload(r *http.Request) (string, []byte, error) {
+	_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return "", nil, err
+	}
+	reader := multipart.NewReader(r.Body, params["boundary"])
+	part, err := reader.NextPart()
+	if err != nil {
+		return "", nil, err
+	}
+	var metadata struct{ Name string }
+	if err := json.NewDecoder(part).Decode(&metadata); err != nil {
+		return "", nil, err
+	}
+	part, err = reader.NextPart()
+	if err != nil {
+		return "", nil, err
+	}
+	content, err := io.ReadAll(part)
+	return metadata.Name, content, err
+}
+
+func newTestGCSStore(t *testing.T, fake *fakeGCS) *GCSStore {
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	t.Setenv("STORAGE_EMULATOR_HOST", server.URL)
+	store, err := NewGCSStore(context.Background(), GCSConfig{
+		Bucket:      "bucket",
+		MaxAttempts: 3,
+		MaxBackoff:  time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return store
+}
+
+func TestGCSStore(t *testing.T) {
+	testStore(t, newTestGCSStore(t, newFakeGCS()))
+}
+
+func TestGCSStore_Retries(t *testing.T) {
+	fake := newFakeGCS()
+	store := newTestGCSStore(t, fake)
+	ctx := context.Background()
+
+	fake.failures = 2
+	assert.NoError(t, store.Put(ctx, "key", strings.NewReader("content"), 7))
+	assert.Equal(t, 3, fake.requests)
+
+	// the request fails once every attempt failed
+	fake.failures = 3
+	_, err := store.Get(ctx, "key")
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, common.ErrObjectNotFound)
+}
+
+func TestNewGCSStore_Validation(t *testing.T) {
+	_, err := NewGCSStore(context.Background(), GCSConfig{})
+	assert.ErrorIs(t, err, common.ErrObjectStoreNoBucket)
+}
@@ -0,0 +1,59 @@
+package objectstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+)
+
+// storeMetrics reports the duration and outcome of object storage requests and
+// the bytes they moved, by operation.
+type storeMetrics struct {
+	duration metric.Float64Histogram
+	bytes    metric.Int64Counter
+}
+
+func newStoreMetrics() *storeMetrics {
+	m := &storeMetrics{}
+	meter := otel.Meter("chroma.objectstore")
+	var err error
+	m.duration, err = meter.Float64Histogram("objectstore.duration",
+		metric.WithDescription("Duration of object storage requests by operation and status, retries included"),
+		metric.WithUnit("ms"))
+	if err != nil {
+		log.Error("failed to create object store duration histogram", zap.Error(err))
+	}
+	m.bytes, err = meter.Int64Counter("objectstore.bytes",
+		metric.WithDescription("Bytes written and read by object storage requests"),
+		metric.WithUnit("By"))
+	if err != nil {
+		log.Error("failed to create object store bytes counter", zap.Error(err))
+	}
+	return m
+}
+
+func (m *storeMetrics) record(ctx context.Context, operation string, start time.Time, err error) {
+	if m.duration == nil {
+		return
+	}
+	status := "ok"
+	if err != nil {
+		status = "error"
+	}
+	m.duration.Record(ctx, float64(time.Since(start).Microseconds())/1000, metric.WithAttributes(
+		attribute.String("operation", operation),
+		attribute.String("status", status),
+	))
+}
+
+func (m *storeMetrics) recordBytes(ctx context.Context, operation string, n int64) {
+	if m.bytes == nil || n == 0 {
+		return
+	}
+	m.bytes.Add(ctx, n, metric.WithAttributes(attribute.String("operation", operation)))
+}
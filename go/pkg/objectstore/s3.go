@@ -0,0 +1,294 @@
+package objectstore
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+const (
+	// minPartSize is the smallest part S3 accepts in a multipart upload.
+	minPartSize = manager.MinUploadPartSize
+	// defaultEndpointRegion is the region signed with when a custom endpoint
+	// is set without one. MinIO and Ceph accept it unless configured otherwise.
+	defaultEndpointRegion = "us-east-1"
+)
+
+type S3Config struct {
+	Bucket string
+	Region string
+	// Endpoint overrides the S3 endpoint, e.g. https://minio.local:9000 for
+	// MinIO or Ceph. UsePathStyle is usually needed with it, as these rarely
+	// serve buckets as subdomains.
+	Endpoint     string
+	UsePathStyle bool
+	// CACertFile is a PEM file of the certificates trusted in addition to the
+	// system ones, e.g. the self-signed certificate of an on-prem endpoint.
+	// InsecureSkipVerify disables the verification of the certificate of the
+	// endpoint altogether and is meant for testing only.
+	CACertFile         string
+	InsecureSkipVerify bool
+	// MaxAttempts is the number of attempts of a request, the first one
+	// included, and MaxBackoff the longest wait between two attempts.
+	MaxAttempts int
+	MaxBackoff  time.Duration
+	// RequestTimeout bounds each call with its retries, reading the object
+	// returned by Get and every part of a multipart upload included.
+	RequestTimeout time.Duration
+	// Objects larger than PartSize are uploaded in parts of PartSize bytes,
+	// UploadConcurrency of them at a time. PartSize is at least 5 MiB.
+	PartSize          int64
+	UploadConcurrency int
+}
+
+// S3Store is a Store backed by an S3 bucket. Credentials are read from the
+// default AWS credential chain.
+type S3Store struct {
+	client   *s3.Client
+	uploader *manager.Uploader
+	bucket   string
+	timeout  time.Duration
+	metrics  *storeMetrics
+}
+
+var _ Store = &S3Store{}
+
+func NewS3Store(ctx context.Context, cfg S3Config) (*S3Store, error) {
+	if cfg.Bucket == "" {
+		return nil, common.ErrObjectStoreNoBucket
+	}
+	if cfg.PartSize != 0 && cfg.PartSize < minPartSize {
+		return nil, common.ErrInvalidObjectPartSize
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = defaultMaxAttempts
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = defaultMaxBackoff
+	}
+	if cfg.RequestTimeout <= 0 {
+		cfg.RequestTimeout = defaultRequestTimeout
+	}
+	if cfg.Endpoint != "" && cfg.Region == "" {
+		cfg.Region = defaultEndpointRegion
+	}
+	options := []func(*config.LoadOptions) error{
+		config.WithRegion(cfg.Region),
+		config.WithRetryer(func() aws.Retryer {
+			return retry.NewStandard(func(o *retry.StandardOptions) {
+				o.MaxAttempts = cfg.MaxAttempts
+				o.MaxBackoff = cfg.MaxBackoff
+			})
+		}),
+	}
+	if cfg.CACertFile != "" || cfg.InsecureSkipVerify {
+		tlsConfig, err := newTLSConfig(cfg.CACertFile, cfg.InsecureSkipVerify)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, config.WithHTTPClient(awshttp.NewBuildableClient().WithTransportOptions(func(tr *http.Transport) {
+			tr.TLSClientConfig = tlsConfig
+		})))
+	}
+	awsConfig, err := config.LoadDefaultConfig(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	client := s3.NewFromConfig(awsConfig, func(o *s3.Options) {
+		if cfg.Endpoint != "" {
+			o.BaseEndpoint = aws.String(cfg.Endpoint)
+		}
+		o.UsePathStyle = cfg.UsePathStyle
+	})
+	uploader := manager.NewUploader(client, func(u *manager.Uploader) {
+		if cfg.PartSize != 0 {
+			u.PartSize = cfg.PartSize
+		}
+		if cfg.UploadConcurrency > 0 {
+			u.Concurrency = cfg.UploadConcurrency
+		}
+	})
+	log.Info("object store configured", zap.String("provider", ProviderS3), zap.String("bucket", cfg.Bucket), zap.String("endpoint", cfg.Endpoint), zap.Bool("usePathStyle", cfg.UsePathStyle), zap.Int("maxAttempts", cfg.MaxAttempts))
+	if cfg.InsecureSkipVerify {
+		log.Warn("object store does not verify the certificate of the endpoint", zap.String("endpoint", cfg.Endpoint))
+	}
+	return &S3Store{
+		client:   client,
+		uploader: uploader,
+		bucket:   cfg.Bucket,
+		timeout:  cfg.RequestTimeout,
+		metrics:  newStoreMetrics(),
+	}, nil
+}
+
+func (s *S3Store) Put(ctx context.Context, key string, body io.Reader, size int64) (err error) {
+	start := time.Now()
+	defer func() { s.metrics.record(ctx, "put", start, err) }()
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	counted := &countingReader{reader: body}
+	input := &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+		Body:   counted,
+	}
+	if size >= 0 {
+		input.ContentLength = aws.Int64(size)
+	}
+	_, err = s.uploader.Upload(ctx, input)
+	s.metrics.recordBytes(ctx, "put", counted.n)
+	if err != nil {
+		log.Error("put object failed", zap.String("key", key), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (s *S3Store) Get(ctx context.Context, key string) (_ io.ReadCloser, err error) {
+	start := time.Now()
+	defer func() { s.metrics.record(ctx, "get", start, err) }()
+	requestCtx, cancel := context.WithTimeout(ctx, s.timeout)
+
+	output, err := s.client.GetObject(requestCtx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		cancel()
+		if isNotFound(err) {
+			return nil, common.ErrObjectNotFound
+		}
+		log.Error("get object failed", zap.String("key", key), zap.Error(err))
+		return nil, err
+	}
+	return &objectReader{
+		countingReader: countingReader{reader: output.Body},
+		body:           output.Body,
+		close: func(n int64) {
+			cancel()
+			s.metrics.recordBytes(ctx, "get", n)
+		},
+	}, nil
+}
+
+func (s *S3Store) Delete(ctx context.Context, key string) (err error) {
+	start := time.Now()
+	defer func() { s.metrics.record(ctx, "delete", start, err) }()
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	_, err = s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil && !isNotFound(err) {
+		log.Error("delete object failed", zap.String("key", key), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (s *S3Store) List(ctx context.Context, prefix string) (_ []string, err error) {
+	start := time.Now()
+	defer func() { s.metrics.record(ctx, "list", start, err) }()
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var keys []string
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			log.Error("list objects failed", zap.String("prefix", prefix), zap.Error(err))
+			return nil, err
+		}
+		for _, object := range page.Contents {
+			keys = append(keys, aws.ToString(object.Key))
+		}
+	}
+	return keys, nil
+}
+
+// newTLSConfig returns the TLS configuration trusting the system certificates
+// and the ones of caCertFile.
+func newTLSConfig(caCertFile string, insecureSkipVerify bool) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: insecureSkipVerify,
+	}
+	if caCertFile == "" {
+		return tlsConfig, nil
+	}
+	pem, err := os.ReadFile(caCertFile)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, common.ErrInvalidObjectStoreCACert
+	}
+	tlsConfig.RootCAs = pool
+	return tlsConfig, nil
+}
+
+func isNotFound(err error) bool {
+	var noSuchKey *s3types.NoSuchKey
+	if errors.As(err, &noSuchKey) {
+		return true
+	}
+	var notFound *s3types.NotFound
+	if errors.As(err, &notFound) {
+		return true
+	}
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode() == "NoSuchKey"
+}
+
+type countingReader struct {
+	reader io.Reader
+	n      int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.n += int64(n)
+	return n, err
+}
+
+// objectReader is the body of an object returned by Get. Closing it releases
+// the request and records the bytes read.
+type objectReader struct {
+	countingReader
+	body  io.Closer
+	close func(n int64)
+}
+
+func (r *objectReader) Close() error {
+	err := r.body.Close()
+	r.close(r.n)
+	return err
+}
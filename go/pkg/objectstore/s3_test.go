@@ -0,0 +1,206 @@
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeS3 serves the subset of the path style S3 API used by S3Store. It fails
+// the next failures requests with 503 so that retries can be tested.
+type fakeS3 struct {
+	mu       sync.Mutex
+	objects  map[string][]byte
+	uploads  map[string]map[int][]byte
+	failures int
+	requests int
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{objects: make(map[string][]byte), uploads: make(map[string]map[int][]byte)}
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests++
+	if f.failures > 0 {
+		f.failures--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprint(w, `<Error><Code>SlowDown</Code><Message>slow down</Message></Error>`)
+		return
+	}
+	// paths are /bucket/key
+	key := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)[1:]
+	query := r.URL.Query()
+	body, _ := io.ReadAll(r.Body)
+	switch {
+	case r.Method == http.MethodGet && query.Get("list-type") == "2":
+		var keys []string
+		for k := range f.objects {
+			if strings.HasPrefix(k, query.Get("prefix")) {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		fmt.Fprint(w, `<ListBucketResult><IsTruncated>false</IsTruncated>`)
+		for _, k := range keys {
+			fmt.Fprintf(w, `<Contents><Key>%s</Key></Contents>`, k)
+		}
+		fmt.Fprint(w, `</ListBucketResult>`)
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		uploadID := strconv.Itoa(len(f.uploads) + 1)
+		f.uploads[uploadID] = make(map[int][]byte)
+		fmt.Fprintf(w, `<InitiateMultipartUploadResult><UploadId>%s</UploadId></InitiateMultipartUploadResult>`, uploadID)
+	case r.Method == http.MethodPut && query.Has("partNumber"):
+		part, _ := strconv.Atoi(query.Get("partNumber"))
+		f.uploads[query.Get("uploadId")][part] = body
+		w.Header().Set("ETag", fmt.Sprintf(`"%d"`, part))
+	case r.Method == http.MethodPost && query.Has("uploadId"):
+		parts := f.uploads[query.Get("uploadId")]
+		var object []byte
+		for i := 1; i <= len(parts); i++ {
+			object = append(object, parts[i]...)
+		}
+		f.objects[key[0]] = object
+		fmt.Fprint(w, `<CompleteMultipartUploadResult><ETag>"done"</ETag></CompleteMultipartUploadResult>`)
+	case r.Method == http.MethodPut:
+		f.objects[key[0]] = body
+	case r.Method == http.MethodGet:
+		object, ok := f.objects[key[0]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `<Error><Code>NoSuchKey</Code><Message>not found</Message></Error>`)
+			return
+		}
+		w.Write(object)
+	case r.Method == http.MethodDelete:
+		delete(f.objects, key[0])
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+func newTestS3Store(t *testing.T, fake *fakeS3) *S3Store {
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+	store, err := NewS3Store(context.Background(), S3Config{
+		Bucket:       "bucket",
+		Region:       "us-east-1",
+		Endpoint:     server.URL,
+		UsePathStyle: true,
+		MaxAttempts:  3,
+		MaxBackoff:   time.Millisecond,
+		PartSize:     minPartSize,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return store
+}
+
+func TestS3Store(t *testing.T) {
+	testStore(t, newTestS3Store(t, newFakeS3()))
+}
+
+func TestS3Store_Retries(t *testing.T) {
+	fake := newFakeS3()
+	store := newTestS3Store(t, fake)
+	ctx := context.Background()
+
+	fake.failures = 2
+	assert.NoError(t, store.Put(ctx, "key", strings.NewReader("content"), 7))
+	assert.Equal(t, 3, fake.requests)
+
+	// the request fails once every attempt failed
+	fake.failures = 3
+	_, err := store.Get(ctx, "key")
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, common.ErrObjectNotFound)
+}
+
+func TestS3Store_Multipart(t *testing.T) {
+	fake := newFakeS3()
+	store := newTestS3Store(t, fake)
+	ctx := context.Background()
+
+	content := bytes.Repeat([]byte("0123456789"), int(minPartSize)/4)
+	assert.NoError(t, store.Put(ctx, "large", bytes.NewReader(content), int64(len(content))))
+	assert.Len(t, fake.uploads, 1)
+	assert.Len(t, fake.uploads["1"], 3)
+
+	body, err := store.Get(ctx, "large")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer body.Close()
+	read, err := io.ReadAll(body)
+	assert.NoError(t, err)
+	assert.Equal(t, content, read)
+}
+
+func TestS3Store_SelfSignedEndpoint(t *testing.T) {
+	server := httptest.NewTLSServer(newFakeS3())
+	t.Cleanup(server.Close)
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+	ctx := context.Background()
+	caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+	err := os.WriteFile(caCertFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := S3Config{Bucket: "bucket", Endpoint: server.URL, UsePathStyle: true, MaxAttempts: 1}
+
+	// the certificate of the endpoint is not trusted by default
+	store, err := NewS3Store(ctx, cfg)
+	assert.NoError(t, err)
+	assert.Error(t, store.Put(ctx, "key", strings.NewReader("content"), 7))
+
+	// the region defaults when only the endpoint is set
+	trusted := cfg
+	trusted.CACertFile = caCertFile
+	store, err = NewS3Store(ctx, trusted)
+	assert.NoError(t, err)
+	assert.NoError(t, store.Put(ctx, "key", strings.NewReader("content"), 7))
+
+	insecure := cfg
+	insecure.InsecureSkipVerify = true
+	store, err = NewS3Store(ctx, insecure)
+	assert.NoError(t, err)
+	keys, err := store.List(ctx, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"key"}, keys)
+}
+
+func TestNewS3Store_Validation(t *testing.T) {
+	_, err := NewS3Store(context.Background(), S3Config{})
+	assert.ErrorIs(t, err, common.ErrObjectStoreNoBucket)
+	_, err = NewS3Store(context.Background(), S3Config{Bucket: "bucket", PartSize: 1024})
+	assert.ErrorIs(t, err, common.ErrInvalidObjectPartSize)
+
+	caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caCertFile, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	_, err = NewS3Store(context.Background(), S3Config{Bucket: "bucket", CACertFile: caCertFile})
+	assert.ErrorIs(t, err, common.ErrInvalidObjectStoreCACert)
+}
@@ -0,0 +1,69 @@
+// Package objectstore is the object storage shared by the Go components, e.g.
+// for version files, exports and log archival. Use it instead of calling the
+// storage SDK directly so that every component gets the same retries, timeouts
+// and metrics whatever the provider.
+package objectstore
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+)
+
+const (
+	defaultMaxAttempts    = 3
+	defaultInitialBackoff = 100 * time.Millisecond
+	defaultMaxBackoff     = 5 * time.Second
+	defaultRequestTimeout = time.Minute
+)
+
+const (
+	ProviderS3    = "s3"
+	ProviderGCS   = "gcs"
+	ProviderAzure = "azure"
+)
+
+// Store reads and writes objects by key. Get fails with common.ErrObjectNotFound
+// when the object does not exist, and deleting it succeeds.
+type Store interface {
+	// Put writes the object, replacing it if it exists. size is the length of
+	// body, or -1 when unknown; large objects are uploaded in parts.
+	Put(ctx context.Context, key string, body io.Reader, size int64) error
+	// Get returns the content of the object. The caller must close it.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+	// List returns the keys that start with prefix, in lexicographic order.
+	List(ctx context.Context, prefix string) ([]string, error)
+}
+
+// Config selects the provider of the store. Only the configuration of the
+// selected provider is used.
+type Config struct {
+	Provider string
+	S3       S3Config
+	GCS      GCSConfig
+	Azure    AzureConfig
+}
+
+// New returns the store of the configured provider. It fails with
+// common.ErrUnknownObjectStoreProvider for any other provider.
+func New(ctx context.Context, cfg Config) (Store, error) {
+	var store Store
+	var err error
+	switch cfg.Provider {
+	case ProviderS3:
+		store, err = NewS3Store(ctx, cfg.S3)
+	case ProviderGCS:
+		store, err = NewGCSStore(ctx, cfg.GCS)
+	case ProviderAzure:
+		store, err = NewAzureBlobStore(ctx, cfg.Azure)
+	default:
+		return nil, common.ErrUnknownObjectStoreProvider
+	}
+	if err != nil {
+		return nil, err
+	}
+	return store, nil
+}
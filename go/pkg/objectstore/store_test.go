@@ -0,0 +1,63 @@
+package objectstore
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// testStore checks the behavior every Store implementation must have.
+func testStore(t *testing.T, store Store) {
+	ctx := context.Background()
+
+	assert.NoError(t, store.Put(ctx, "versions/a", strings.NewReader("content a"), -1))
+	assert.NoError(t, store.Put(ctx, "versions/b", strings.NewReader("content b"), 9))
+	assert.NoError(t, store.Put(ctx, "exports/c", strings.NewReader("content c"), 9))
+
+	body, err := store.Get(ctx, "versions/a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := io.ReadAll(body)
+	assert.NoError(t, err)
+	assert.NoError(t, body.Close())
+	assert.Equal(t, "content a", string(content))
+
+	keys, err := store.List(ctx, "versions/")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"versions/a", "versions/b"}, keys)
+
+	assert.NoError(t, store.Delete(ctx, "versions/a"))
+	_, err = store.Get(ctx, "versions/a")
+	assert.ErrorIs(t, err, common.ErrObjectNotFound)
+	// deleting a missing object succeeds
+	assert.NoError(t, store.Delete(ctx, "versions/a"))
+}
+
+func TestNew(t *testing.T) {
+	ctx := context.Background()
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+	t.Setenv("STORAGE_EMULATOR_HOST", "localhost:1")
+
+	store, err := New(ctx, Config{Provider: ProviderS3, S3: S3Config{Bucket: "bucket", Region: "us-east-1"}})
+	assert.NoError(t, err)
+	assert.IsType(t, &S3Store{}, store)
+	store, err = New(ctx, Config{Provider: ProviderGCS, GCS: GCSConfig{Bucket: "bucket"}})
+	assert.NoError(t, err)
+	assert.IsType(t, &GCSStore{}, store)
+	store, err = New(ctx, Config{Provider: ProviderAzure, Azure: AzureConfig{Container: "container", ConnectionString: testAzureConnectionString("http://localhost:1")}})
+	assert.NoError(t, err)
+	assert.IsType(t, &AzureBlobStore{}, store)
+
+	// the configuration of the other providers is ignored
+	_, err = New(ctx, Config{Provider: ProviderGCS, S3: S3Config{Bucket: "bucket"}})
+	assert.ErrorIs(t, err, common.ErrObjectStoreNoBucket)
+	store, err = New(ctx, Config{Provider: "hdfs"})
+	assert.ErrorIs(t, err, common.ErrUnknownObjectStoreProvider)
+	assert.Nil(t, store)
+}
@@ -171,6 +171,60 @@ func (SegmentScope) EnumDescriptor() ([]byte, []int) {
 	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{2}
 }
 
+// Lifecycle state of a collection. Collections created without segments stay in
+// CREATING until their vector and metadata segments exist.
+type CollectionState int32
+
+const (
+	CollectionState_READY    CollectionState = 0
+	CollectionState_CREATING CollectionState = 1
+	CollectionState_DEGRADED CollectionState = 2
+	CollectionState_DELETING CollectionState = 3
+)
+
+// Enum value maps for CollectionState.
+var (
+	CollectionState_name = map[int32]string{
+		0: "READY",
+		1: "CREATING",
+		2: "DEGRADED",
+		3: "DELETING",
+	}
+	CollectionState_value = map[string]int32{
+		"READY":    0,
+		"CREATING": 1,
+		"DEGRADED": 2,
+		"DELETING": 3,
+	}
+)
+
+func (x CollectionState) Enum() *CollectionState {
+	p := new(CollectionState)
+	*p = x
+	return p
+}
+
+func (x CollectionState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CollectionState) Descriptor() protoreflect.EnumDescriptor {
+	return file_chromadb_proto_chroma_proto_enumTypes[3].Descriptor()
+}
+
+func (CollectionState) Type() protoreflect.EnumType {
+	return &file_chromadb_proto_chroma_proto_enumTypes[3]
+}
+
+func (x CollectionState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CollectionState.Descriptor instead.
+func (CollectionState) EnumDescriptor() ([]byte, []int) {
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{3}
+}
+
 // Types of operators for `WhereDocument` clauses. A `WhereDocument` clause can
 // either require that a document contains a value or that it does not contain
 // a value.
@@ -204,11 +258,11 @@ func (x WhereDocumentOperator) String() string {
 }
 
 func (WhereDocumentOperator) Descriptor() protoreflect.EnumDescriptor {
-	return file_chromadb_proto_chroma_proto_enumTypes[3].Descriptor()
+	return file_chromadb_proto_chroma_proto_enumTypes[4].Descriptor()
 }
 
 func (WhereDocumentOperator) Type() protoreflect.EnumType {
-	return &file_chromadb_proto_chroma_proto_enumTypes[3]
+	return &file_chromadb_proto_chroma_proto_enumTypes[4]
 }
 
 func (x WhereDocumentOperator) Number() protoreflect.EnumNumber {
@@ -217,7 +271,7 @@ func (x WhereDocumentOperator) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use WhereDocumentOperator.Descriptor instead.
 func (WhereDocumentOperator) EnumDescriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{3}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{4}
 }
 
 // A `Where` clause may have a list of children. This enum specifies how the
@@ -252,11 +306,11 @@ func (x BooleanOperator) String() string {
 }
 
 func (BooleanOperator) Descriptor() protoreflect.EnumDescriptor {
-	return file_chromadb_proto_chroma_proto_enumTypes[4].Descriptor()
+	return file_chromadb_proto_chroma_proto_enumTypes[5].Descriptor()
 }
 
 func (BooleanOperator) Type() protoreflect.EnumType {
-	return &file_chromadb_proto_chroma_proto_enumTypes[4]
+	return &file_chromadb_proto_chroma_proto_enumTypes[5]
 }
 
 func (x BooleanOperator) Number() protoreflect.EnumNumber {
@@ -265,7 +319,7 @@ func (x BooleanOperator) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use BooleanOperator.Descriptor instead.
 func (BooleanOperator) EnumDescriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{4}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{5}
 }
 
 // A `Where` clause may have a list of allowed or disallowed values. This enum
@@ -300,11 +354,11 @@ func (x ListOperator) String() string {
 }
 
 func (ListOperator) Descriptor() protoreflect.EnumDescriptor {
-	return file_chromadb_proto_chroma_proto_enumTypes[5].Descriptor()
+	return file_chromadb_proto_chroma_proto_enumTypes[6].Descriptor()
 }
 
 func (ListOperator) Type() protoreflect.EnumType {
-	return &file_chromadb_proto_chroma_proto_enumTypes[5]
+	return &file_chromadb_proto_chroma_proto_enumTypes[6]
 }
 
 func (x ListOperator) Number() protoreflect.EnumNumber {
@@ -313,7 +367,7 @@ func (x ListOperator) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ListOperator.Descriptor instead.
 func (ListOperator) EnumDescriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{5}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{6}
 }
 
 // A leaf-node `Where` clause may compare a string, int, or float to a single
@@ -348,11 +402,11 @@ func (x GenericComparator) String() string {
 }
 
 func (GenericComparator) Descriptor() protoreflect.EnumDescriptor {
-	return file_chromadb_proto_chroma_proto_enumTypes[6].Descriptor()
+	return file_chromadb_proto_chroma_proto_enumTypes[7].Descriptor()
 }
 
 func (GenericComparator) Type() protoreflect.EnumType {
-	return &file_chromadb_proto_chroma_proto_enumTypes[6]
+	return &file_chromadb_proto_chroma_proto_enumTypes[7]
 }
 
 func (x GenericComparator) Number() protoreflect.EnumNumber {
@@ -361,7 +415,7 @@ func (x GenericComparator) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GenericComparator.Descriptor instead.
 func (GenericComparator) EnumDescriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{6}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{7}
 }
 
 // Used when a leaf-node `Where` clause compares an int or float to a single
@@ -402,11 +456,11 @@ func (x NumberComparator) String() string {
 }
 
 func (NumberComparator) Descriptor() protoreflect.EnumDescriptor {
-	return file_chromadb_proto_chroma_proto_enumTypes[7].Descriptor()
+	return file_chromadb_proto_chroma_proto_enumTypes[8].Descriptor()
 }
 
 func (NumberComparator) Type() protoreflect.EnumType {
-	return &file_chromadb_proto_chroma_proto_enumTypes[7]
+	return &file_chromadb_proto_chroma_proto_enumTypes[8]
 }
 
 func (x NumberComparator) Number() protoreflect.EnumNumber {
@@ -415,7 +469,7 @@ func (x NumberComparator) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NumberComparator.Descriptor instead.
 func (NumberComparator) EnumDescriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{7}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{8}
 }
 
 type Status struct {
@@ -542,6 +596,11 @@ type FilePaths struct {
 	unknownFields protoimpl.UnknownFields
 
 	Paths []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	// Hash of the content of each path, in the same order. Empty when the
+	// files were not hashed.
+	ContentHashes []string `protobuf:"bytes,2,rep,name=content_hashes,json=contentHashes,proto3" json:"content_hashes,omitempty"`
+	// Size of each path in bytes, in the same order. Empty when unknown.
+	SizeBytes []int64 `protobuf:"varint,3,rep,packed,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
 }
 
 func (x *FilePaths) Reset() {
@@ -583,6 +642,20 @@ func (x *FilePaths) GetPaths() []string {
 	return nil
 }
 
+func (x *FilePaths) GetContentHashes() []string {
+	if x != nil {
+		return x.ContentHashes
+	}
+	return nil
+}
+
+func (x *FilePaths) GetSizeBytes() []int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return nil
+}
+
 type Segment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -683,6 +756,42 @@ type Collection struct {
 	Database    string          `protobuf:"bytes,7,opt,name=database,proto3" json:"database,omitempty"`
 	LogPosition int64           `protobuf:"varint,8,opt,name=log_position,json=logPosition,proto3" json:"log_position,omitempty"`
 	Version     int32           `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+	State       CollectionState `protobuf:"varint,10,opt,name=state,proto3,enum=chroma.CollectionState" json:"state,omitempty"`
+	// Set when the dimension or embedding function changed and the records still
+	// have to be reindexed. reindex_from_dimension is the dimension of the existing
+	// records.
+	ReindexPending       bool   `protobuf:"varint,11,opt,name=reindex_pending,json=reindexPending,proto3" json:"reindex_pending,omitempty"`
+	ReindexFromDimension *int32 `protobuf:"varint,12,opt,name=reindex_from_dimension,json=reindexFromDimension,proto3,oneof" json:"reindex_from_dimension,omitempty"`
+	// Only set by GetCollections.
+	Acl []*CollectionAclEntry `protobuf:"bytes,13,rep,name=acl,proto3" json:"acl,omitempty"`
+	// The customer managed key the segment files are encrypted with.
+	KmsKeyId *string `protobuf:"bytes,14,opt,name=kms_key_id,json=kmsKeyId,proto3,oneof" json:"kms_key_id,omitempty"`
+	// The compactor instance that flushed the last compaction of the collection and
+	// when, in unix seconds. Schedulers may prefer it while it is still a member,
+	// since it likely has the segment files cached.
+	LastCompactor   *string `protobuf:"bytes,15,opt,name=last_compactor,json=lastCompactor,proto3,oneof" json:"last_compactor,omitempty"`
+	LastCompactedAt *int64  `protobuf:"varint,16,opt,name=last_compacted_at,json=lastCompactedAt,proto3,oneof" json:"last_compacted_at,omitempty"`
+	// Only set by GetCollections with include_log_lag, and left unset when the log
+	// service could not be reached: the number of records not compacted yet and
+	// the time of the oldest one in unix seconds. A fully compacted collection has
+	// zero records and no oldest time. The coordinator caches the values of each
+	// collection for log-lag-cache-ttl, 5 seconds by default.
+	UncompactedRecords  *int64 `protobuf:"varint,17,opt,name=uncompacted_records,json=uncompactedRecords,proto3,oneof" json:"uncompacted_records,omitempty"`
+	OldestUncompactedAt *int64 `protobuf:"varint,18,opt,name=oldest_uncompacted_at,json=oldestUncompactedAt,proto3,oneof" json:"oldest_uncompacted_at,omitempty"`
+	// The number of query nodes the collection is assigned to.
+	ReplicationFactor int32 `protobuf:"varint,19,opt,name=replication_factor,json=replicationFactor,proto3" json:"replication_factor,omitempty"`
+	// Bumped by every UpdateCollection that changes the collection, unlike the
+	// version, which is bumped by compactions.
+	Revision int64 `protobuf:"varint,20,opt,name=revision,proto3" json:"revision,omitempty"`
+	// One of l2, cosine and ip, fixed when the collection is created.
+	DistanceFunction string `protobuf:"bytes,21,opt,name=distance_function,json=distanceFunction,proto3" json:"distance_function,omitempty"`
+	// The registered embedding function of the collection, if it has one.
+	EmbeddingFunctionId *string `protobuf:"bytes,22,opt,name=embedding_function_id,json=embeddingFunctionId,proto3,oneof" json:"embedding_function_id,omitempty"`
+	// The template the collection was created from, unset once it is deleted.
+	TemplateId *string `protobuf:"bytes,23,opt,name=template_id,json=templateId,proto3,oneof" json:"template_id,omitempty"`
+	// Changes whenever the collection row is updated, derived from the version
+	// and the time of the last update. It does not cover the acl.
+	Etag string `protobuf:"bytes,24,opt,name=etag,proto3" json:"etag,omitempty"`
 }
 
 func (x *Collection) Reset() {
@@ -773,6 +882,168 @@ func (x *Collection) GetVersion() int32 {
 	return 0
 }
 
+func (x *Collection) GetState() CollectionState {
+	if x != nil {
+		return x.State
+	}
+	return CollectionState_READY
+}
+
+func (x *Collection) GetReindexPending() bool {
+	if x != nil {
+		return x.ReindexPending
+	}
+	return false
+}
+
+func (x *Collection) GetReindexFromDimension() int32 {
+	if x != nil && x.ReindexFromDimension != nil {
+		return *x.ReindexFromDimension
+	}
+	return 0
+}
+
+func (x *Collection) GetAcl() []*CollectionAclEntry {
+	if x != nil {
+		return x.Acl
+	}
+	return nil
+}
+
+func (x *Collection) GetKmsKeyId() string {
+	if x != nil && x.KmsKeyId != nil {
+		return *x.KmsKeyId
+	}
+	return ""
+}
+
+func (x *Collection) GetLastCompactor() string {
+	if x != nil && x.LastCompactor != nil {
+		return *x.LastCompactor
+	}
+	return ""
+}
+
+func (x *Collection) GetLastCompactedAt() int64 {
+	if x != nil && x.LastCompactedAt != nil {
+		return *x.LastCompactedAt
+	}
+	return 0
+}
+
+func (x *Collection) GetUncompactedRecords() int64 {
+	if x != nil && x.UncompactedRecords != nil {
+		return *x.UncompactedRecords
+	}
+	return 0
+}
+
+func (x *Collection) GetOldestUncompactedAt() int64 {
+	if x != nil && x.OldestUncompactedAt != nil {
+		return *x.OldestUncompactedAt
+	}
+	return 0
+}
+
+func (x *Collection) GetReplicationFactor() int32 {
+	if x != nil {
+		return x.ReplicationFactor
+	}
+	return 0
+}
+
+func (x *Collection) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *Collection) GetDistanceFunction() string {
+	if x != nil {
+		return x.DistanceFunction
+	}
+	return ""
+}
+
+func (x *Collection) GetEmbeddingFunctionId() string {
+	if x != nil && x.EmbeddingFunctionId != nil {
+		return *x.EmbeddingFunctionId
+	}
+	return ""
+}
+
+func (x *Collection) GetTemplateId() string {
+	if x != nil && x.TemplateId != nil {
+		return *x.TemplateId
+	}
+	return ""
+}
+
+func (x *Collection) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// Permissions are "read", "write" and "admin". The sysdb only stores them,
+// frontends enforce them.
+type CollectionAclEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Principal   string   `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+	Permissions []string `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
+}
+
+func (x *CollectionAclEntry) Reset() {
+	*x = CollectionAclEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionAclEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionAclEntry) ProtoMessage() {}
+
+func (x *CollectionAclEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionAclEntry.ProtoReflect.Descriptor instead.
+func (*CollectionAclEntry) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CollectionAclEntry) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+func (x *CollectionAclEntry) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
 type Database struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -786,7 +1057,7 @@ type Database struct {
 func (x *Database) Reset() {
 	*x = Database{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[5]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -799,7 +1070,7 @@ func (x *Database) String() string {
 func (*Database) ProtoMessage() {}
 
 func (x *Database) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[5]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -812,7 +1083,7 @@ func (x *Database) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Database.ProtoReflect.Descriptor instead.
 func (*Database) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{5}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Database) GetId() string {
@@ -842,12 +1113,14 @@ type Tenant struct {
 	unknownFields protoimpl.UnknownFields
 
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The customer managed key new collections of the tenant use.
+	KmsKeyId *string `protobuf:"bytes,2,opt,name=kms_key_id,json=kmsKeyId,proto3,oneof" json:"kms_key_id,omitempty"`
 }
 
 func (x *Tenant) Reset() {
 	*x = Tenant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[6]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -860,7 +1133,7 @@ func (x *Tenant) String() string {
 func (*Tenant) ProtoMessage() {}
 
 func (x *Tenant) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[6]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -873,7 +1146,7 @@ func (x *Tenant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Tenant.ProtoReflect.Descriptor instead.
 func (*Tenant) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{6}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Tenant) GetName() string {
@@ -883,6 +1156,13 @@ func (x *Tenant) GetName() string {
 	return ""
 }
 
+func (x *Tenant) GetKmsKeyId() string {
+	if x != nil && x.KmsKeyId != nil {
+		return *x.KmsKeyId
+	}
+	return ""
+}
+
 type UpdateMetadataValue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -903,7 +1183,7 @@ type UpdateMetadataValue struct {
 func (x *UpdateMetadataValue) Reset() {
 	*x = UpdateMetadataValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[7]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -916,7 +1196,7 @@ func (x *UpdateMetadataValue) String() string {
 func (*UpdateMetadataValue) ProtoMessage() {}
 
 func (x *UpdateMetadataValue) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[7]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -929,7 +1209,7 @@ func (x *UpdateMetadataValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMetadataValue.ProtoReflect.Descriptor instead.
 func (*UpdateMetadataValue) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{7}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{8}
 }
 
 func (m *UpdateMetadataValue) GetValue() isUpdateMetadataValue_Value {
@@ -1006,7 +1286,7 @@ type UpdateMetadata struct {
 func (x *UpdateMetadata) Reset() {
 	*x = UpdateMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[8]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1019,7 +1299,7 @@ func (x *UpdateMetadata) String() string {
 func (*UpdateMetadata) ProtoMessage() {}
 
 func (x *UpdateMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[8]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1032,7 +1312,7 @@ func (x *UpdateMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMetadata.ProtoReflect.Descriptor instead.
 func (*UpdateMetadata) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{8}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateMetadata) GetMetadata() map[string]*UpdateMetadataValue {
@@ -1057,7 +1337,7 @@ type OperationRecord struct {
 func (x *OperationRecord) Reset() {
 	*x = OperationRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[9]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1070,7 +1350,7 @@ func (x *OperationRecord) String() string {
 func (*OperationRecord) ProtoMessage() {}
 
 func (x *OperationRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[9]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1083,7 +1363,7 @@ func (x *OperationRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OperationRecord.ProtoReflect.Descriptor instead.
 func (*OperationRecord) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{9}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *OperationRecord) GetId() string {
@@ -1125,7 +1405,7 @@ type CountRecordsRequest struct {
 func (x *CountRecordsRequest) Reset() {
 	*x = CountRecordsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[10]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1138,7 +1418,7 @@ func (x *CountRecordsRequest) String() string {
 func (*CountRecordsRequest) ProtoMessage() {}
 
 func (x *CountRecordsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[10]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1151,7 +1431,7 @@ func (x *CountRecordsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CountRecordsRequest.ProtoReflect.Descriptor instead.
 func (*CountRecordsRequest) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{10}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CountRecordsRequest) GetSegmentId() string {
@@ -1173,7 +1453,7 @@ type CountRecordsResponse struct {
 func (x *CountRecordsResponse) Reset() {
 	*x = CountRecordsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[11]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1186,7 +1466,7 @@ func (x *CountRecordsResponse) String() string {
 func (*CountRecordsResponse) ProtoMessage() {}
 
 func (x *CountRecordsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[11]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1199,7 +1479,7 @@ func (x *CountRecordsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CountRecordsResponse.ProtoReflect.Descriptor instead.
 func (*CountRecordsResponse) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{11}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CountRecordsResponse) GetCount() uint32 {
@@ -1225,7 +1505,7 @@ type QueryMetadataRequest struct {
 func (x *QueryMetadataRequest) Reset() {
 	*x = QueryMetadataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[12]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1238,7 +1518,7 @@ func (x *QueryMetadataRequest) String() string {
 func (*QueryMetadataRequest) ProtoMessage() {}
 
 func (x *QueryMetadataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[12]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1251,7 +1531,7 @@ func (x *QueryMetadataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryMetadataRequest.ProtoReflect.Descriptor instead.
 func (*QueryMetadataRequest) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{12}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *QueryMetadataRequest) GetSegmentId() string {
@@ -1307,7 +1587,7 @@ type QueryMetadataResponse struct {
 func (x *QueryMetadataResponse) Reset() {
 	*x = QueryMetadataResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[13]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1320,7 +1600,7 @@ func (x *QueryMetadataResponse) String() string {
 func (*QueryMetadataResponse) ProtoMessage() {}
 
 func (x *QueryMetadataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[13]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1333,7 +1613,7 @@ func (x *QueryMetadataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryMetadataResponse.ProtoReflect.Descriptor instead.
 func (*QueryMetadataResponse) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{13}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *QueryMetadataResponse) GetRecords() []*MetadataEmbeddingRecord {
@@ -1355,7 +1635,7 @@ type MetadataEmbeddingRecord struct {
 func (x *MetadataEmbeddingRecord) Reset() {
 	*x = MetadataEmbeddingRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[14]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1368,7 +1648,7 @@ func (x *MetadataEmbeddingRecord) String() string {
 func (*MetadataEmbeddingRecord) ProtoMessage() {}
 
 func (x *MetadataEmbeddingRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[14]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1381,7 +1661,7 @@ func (x *MetadataEmbeddingRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetadataEmbeddingRecord.ProtoReflect.Descriptor instead.
 func (*MetadataEmbeddingRecord) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{14}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *MetadataEmbeddingRecord) GetId() string {
@@ -1417,7 +1697,7 @@ type WhereDocument struct {
 func (x *WhereDocument) Reset() {
 	*x = WhereDocument{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[15]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1430,7 +1710,7 @@ func (x *WhereDocument) String() string {
 func (*WhereDocument) ProtoMessage() {}
 
 func (x *WhereDocument) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[15]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1443,7 +1723,7 @@ func (x *WhereDocument) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WhereDocument.ProtoReflect.Descriptor instead.
 func (*WhereDocument) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{15}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{16}
 }
 
 func (m *WhereDocument) GetWhereDocument() isWhereDocument_WhereDocument {
@@ -1496,7 +1776,7 @@ type DirectWhereDocument struct {
 func (x *DirectWhereDocument) Reset() {
 	*x = DirectWhereDocument{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[16]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1509,7 +1789,7 @@ func (x *DirectWhereDocument) String() string {
 func (*DirectWhereDocument) ProtoMessage() {}
 
 func (x *DirectWhereDocument) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[16]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1522,7 +1802,7 @@ func (x *DirectWhereDocument) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DirectWhereDocument.ProtoReflect.Descriptor instead.
 func (*DirectWhereDocument) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{16}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DirectWhereDocument) GetDocument() string {
@@ -1552,7 +1832,7 @@ type WhereDocumentChildren struct {
 func (x *WhereDocumentChildren) Reset() {
 	*x = WhereDocumentChildren{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[17]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1565,7 +1845,7 @@ func (x *WhereDocumentChildren) String() string {
 func (*WhereDocumentChildren) ProtoMessage() {}
 
 func (x *WhereDocumentChildren) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[17]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1578,7 +1858,7 @@ func (x *WhereDocumentChildren) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WhereDocumentChildren.ProtoReflect.Descriptor instead.
 func (*WhereDocumentChildren) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{17}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *WhereDocumentChildren) GetChildren() []*WhereDocument {
@@ -1615,7 +1895,7 @@ type Where struct {
 func (x *Where) Reset() {
 	*x = Where{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[18]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1628,7 +1908,7 @@ func (x *Where) String() string {
 func (*Where) ProtoMessage() {}
 
 func (x *Where) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[18]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1641,7 +1921,7 @@ func (x *Where) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Where.ProtoReflect.Descriptor instead.
 func (*Where) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{18}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{19}
 }
 
 func (m *Where) GetWhere() isWhere_Where {
@@ -1704,7 +1984,7 @@ type DirectComparison struct {
 func (x *DirectComparison) Reset() {
 	*x = DirectComparison{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[19]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1717,7 +1997,7 @@ func (x *DirectComparison) String() string {
 func (*DirectComparison) ProtoMessage() {}
 
 func (x *DirectComparison) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[19]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1730,7 +2010,7 @@ func (x *DirectComparison) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DirectComparison.ProtoReflect.Descriptor instead.
 func (*DirectComparison) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{19}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DirectComparison) GetKey() string {
@@ -1869,7 +2149,7 @@ type WhereChildren struct {
 func (x *WhereChildren) Reset() {
 	*x = WhereChildren{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[20]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1882,7 +2162,7 @@ func (x *WhereChildren) String() string {
 func (*WhereChildren) ProtoMessage() {}
 
 func (x *WhereChildren) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[20]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1895,7 +2175,7 @@ func (x *WhereChildren) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WhereChildren.ProtoReflect.Descriptor instead.
 func (*WhereChildren) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{20}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *WhereChildren) GetChildren() []*Where {
@@ -1926,7 +2206,7 @@ type StringListComparison struct {
 func (x *StringListComparison) Reset() {
 	*x = StringListComparison{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[21]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1939,7 +2219,7 @@ func (x *StringListComparison) String() string {
 func (*StringListComparison) ProtoMessage() {}
 
 func (x *StringListComparison) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[21]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1952,7 +2232,7 @@ func (x *StringListComparison) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StringListComparison.ProtoReflect.Descriptor instead.
 func (*StringListComparison) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{21}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *StringListComparison) GetValues() []string {
@@ -1982,7 +2262,7 @@ type SingleStringComparison struct {
 func (x *SingleStringComparison) Reset() {
 	*x = SingleStringComparison{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[22]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1995,7 +2275,7 @@ func (x *SingleStringComparison) String() string {
 func (*SingleStringComparison) ProtoMessage() {}
 
 func (x *SingleStringComparison) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[22]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2008,7 +2288,7 @@ func (x *SingleStringComparison) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SingleStringComparison.ProtoReflect.Descriptor instead.
 func (*SingleStringComparison) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{22}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *SingleStringComparison) GetValue() string {
@@ -2037,7 +2317,7 @@ type SingleBoolComparison struct {
 func (x *SingleBoolComparison) Reset() {
 	*x = SingleBoolComparison{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[23]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2050,7 +2330,7 @@ func (x *SingleBoolComparison) String() string {
 func (*SingleBoolComparison) ProtoMessage() {}
 
 func (x *SingleBoolComparison) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[23]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2063,7 +2343,7 @@ func (x *SingleBoolComparison) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SingleBoolComparison.ProtoReflect.Descriptor instead.
 func (*SingleBoolComparison) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{23}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *SingleBoolComparison) GetValue() bool {
@@ -2094,7 +2374,7 @@ type IntListComparison struct {
 func (x *IntListComparison) Reset() {
 	*x = IntListComparison{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[24]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2107,7 +2387,7 @@ func (x *IntListComparison) String() string {
 func (*IntListComparison) ProtoMessage() {}
 
 func (x *IntListComparison) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[24]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2120,7 +2400,7 @@ func (x *IntListComparison) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntListComparison.ProtoReflect.Descriptor instead.
 func (*IntListComparison) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{24}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *IntListComparison) GetValues() []int64 {
@@ -2154,7 +2434,7 @@ type SingleIntComparison struct {
 func (x *SingleIntComparison) Reset() {
 	*x = SingleIntComparison{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[25]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2167,7 +2447,7 @@ func (x *SingleIntComparison) String() string {
 func (*SingleIntComparison) ProtoMessage() {}
 
 func (x *SingleIntComparison) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[25]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2180,7 +2460,7 @@ func (x *SingleIntComparison) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SingleIntComparison.ProtoReflect.Descriptor instead.
 func (*SingleIntComparison) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{25}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *SingleIntComparison) GetValue() int64 {
@@ -2241,7 +2521,7 @@ type DoubleListComparison struct {
 func (x *DoubleListComparison) Reset() {
 	*x = DoubleListComparison{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[26]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2254,7 +2534,7 @@ func (x *DoubleListComparison) String() string {
 func (*DoubleListComparison) ProtoMessage() {}
 
 func (x *DoubleListComparison) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[26]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2267,7 +2547,7 @@ func (x *DoubleListComparison) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DoubleListComparison.ProtoReflect.Descriptor instead.
 func (*DoubleListComparison) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{26}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *DoubleListComparison) GetValues() []float64 {
@@ -2296,7 +2576,7 @@ type BoolListComparison struct {
 func (x *BoolListComparison) Reset() {
 	*x = BoolListComparison{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[27]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2309,7 +2589,7 @@ func (x *BoolListComparison) String() string {
 func (*BoolListComparison) ProtoMessage() {}
 
 func (x *BoolListComparison) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[27]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2322,7 +2602,7 @@ func (x *BoolListComparison) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BoolListComparison.ProtoReflect.Descriptor instead.
 func (*BoolListComparison) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{27}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *BoolListComparison) GetValues() []bool {
@@ -2355,7 +2635,7 @@ type SingleDoubleComparison struct {
 func (x *SingleDoubleComparison) Reset() {
 	*x = SingleDoubleComparison{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[28]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2368,7 +2648,7 @@ func (x *SingleDoubleComparison) String() string {
 func (*SingleDoubleComparison) ProtoMessage() {}
 
 func (x *SingleDoubleComparison) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[28]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2381,7 +2661,7 @@ func (x *SingleDoubleComparison) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SingleDoubleComparison.ProtoReflect.Descriptor instead.
 func (*SingleDoubleComparison) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{28}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *SingleDoubleComparison) GetValue() float64 {
@@ -2440,7 +2720,7 @@ type GetVectorsRequest struct {
 func (x *GetVectorsRequest) Reset() {
 	*x = GetVectorsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[29]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2453,7 +2733,7 @@ func (x *GetVectorsRequest) String() string {
 func (*GetVectorsRequest) ProtoMessage() {}
 
 func (x *GetVectorsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[29]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2466,7 +2746,7 @@ func (x *GetVectorsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVectorsRequest.ProtoReflect.Descriptor instead.
 func (*GetVectorsRequest) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{29}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetVectorsRequest) GetIds() []string {
@@ -2494,7 +2774,7 @@ type GetVectorsResponse struct {
 func (x *GetVectorsResponse) Reset() {
 	*x = GetVectorsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[30]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2507,7 +2787,7 @@ func (x *GetVectorsResponse) String() string {
 func (*GetVectorsResponse) ProtoMessage() {}
 
 func (x *GetVectorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[30]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2520,7 +2800,7 @@ func (x *GetVectorsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVectorsResponse.ProtoReflect.Descriptor instead.
 func (*GetVectorsResponse) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{30}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetVectorsResponse) GetRecords() []*VectorEmbeddingRecord {
@@ -2542,7 +2822,7 @@ type VectorEmbeddingRecord struct {
 func (x *VectorEmbeddingRecord) Reset() {
 	*x = VectorEmbeddingRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[31]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2555,7 +2835,7 @@ func (x *VectorEmbeddingRecord) String() string {
 func (*VectorEmbeddingRecord) ProtoMessage() {}
 
 func (x *VectorEmbeddingRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[31]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2568,7 +2848,7 @@ func (x *VectorEmbeddingRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VectorEmbeddingRecord.ProtoReflect.Descriptor instead.
 func (*VectorEmbeddingRecord) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{31}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *VectorEmbeddingRecord) GetId() string {
@@ -2600,7 +2880,7 @@ type QueryVectorsRequest struct {
 func (x *QueryVectorsRequest) Reset() {
 	*x = QueryVectorsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[32]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2613,7 +2893,7 @@ func (x *QueryVectorsRequest) String() string {
 func (*QueryVectorsRequest) ProtoMessage() {}
 
 func (x *QueryVectorsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[32]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2626,7 +2906,7 @@ func (x *QueryVectorsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryVectorsRequest.ProtoReflect.Descriptor instead.
 func (*QueryVectorsRequest) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{32}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *QueryVectorsRequest) GetVectors() []*Vector {
@@ -2675,7 +2955,7 @@ type QueryVectorsResponse struct {
 func (x *QueryVectorsResponse) Reset() {
 	*x = QueryVectorsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[33]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2688,7 +2968,7 @@ func (x *QueryVectorsResponse) String() string {
 func (*QueryVectorsResponse) ProtoMessage() {}
 
 func (x *QueryVectorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[33]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2701,7 +2981,7 @@ func (x *QueryVectorsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryVectorsResponse.ProtoReflect.Descriptor instead.
 func (*QueryVectorsResponse) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{33}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *QueryVectorsResponse) GetResults() []*VectorQueryResults {
@@ -2722,7 +3002,7 @@ type VectorQueryResults struct {
 func (x *VectorQueryResults) Reset() {
 	*x = VectorQueryResults{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[34]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2735,7 +3015,7 @@ func (x *VectorQueryResults) String() string {
 func (*VectorQueryResults) ProtoMessage() {}
 
 func (x *VectorQueryResults) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[34]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2748,7 +3028,7 @@ func (x *VectorQueryResults) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VectorQueryResults.ProtoReflect.Descriptor instead.
 func (*VectorQueryResults) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{34}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *VectorQueryResults) GetResults() []*VectorQueryResult {
@@ -2771,7 +3051,7 @@ type VectorQueryResult struct {
 func (x *VectorQueryResult) Reset() {
 	*x = VectorQueryResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_chroma_proto_msgTypes[35]
+		mi := &file_chromadb_proto_chroma_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2784,7 +3064,7 @@ func (x *VectorQueryResult) String() string {
 func (*VectorQueryResult) ProtoMessage() {}
 
 func (x *VectorQueryResult) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_chroma_proto_msgTypes[35]
+	mi := &file_chromadb_proto_chroma_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2797,7 +3077,7 @@ func (x *VectorQueryResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VectorQueryResult.ProtoReflect.Descriptor instead.
 func (*VectorQueryResult) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{35}
+	return file_chromadb_proto_chroma_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *VectorQueryResult) GetId() string {
@@ -2837,262 +3117,301 @@ var file_chromadb_proto_chroma_proto_rawDesc = []byte{
 	0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
 	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x45, 0x6e, 0x63,
 	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22,
-	0x21, 0x0a, 0x09, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x67, 0x0a, 0x09, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x14, 0x0a, 0x05,
 	0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x74,
-	0x68, 0x73, 0x22, 0xe3, 0x02, 0x0a, 0x07, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x23,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x01, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x0a,
-	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x1a, 0x4f, 0x0a, 0x0e, 0x46,
-	0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x27, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68,
-	0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0d, 0x0a, 0x0b,
-	0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x98, 0x02, 0x0a, 0x0a, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6c,
-	0x6f, 0x67, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
-	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73,
-	0x69, 0x6f, 0x6e, 0x22, 0x46, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0x1c, 0x0a, 0x06, 0x54,
-	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x13, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x6c,
-	0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c,
-	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09,
-	0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x58, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f,
-	0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0xd0, 0x01, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x56,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88,
-	0x01, 0x01, 0x12, 0x37, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x01, 0x52, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x09, 0x6f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
-	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07,
-	0x5f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0x34, 0x0a, 0x13, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x14, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf7, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
-	0x12, 0x23, 0x0a, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x57, 0x68, 0x65, 0x72, 0x65, 0x52, 0x05,
-	0x77, 0x68, 0x65, 0x72, 0x65, 0x12, 0x3c, 0x0a, 0x0e, 0x77, 0x68, 0x65, 0x72, 0x65, 0x5f, 0x64,
-	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x57, 0x68, 0x65, 0x72, 0x65, 0x44, 0x6f, 0x63, 0x75,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x77, 0x68, 0x65, 0x72, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01,
-	0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
-	0x48, 0x01, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a,
-	0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x22, 0x52, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x72,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63,
-	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6d,
-	0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x5d, 0x0a, 0x17, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x68, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a,
+	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x73,
+	0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xe3, 0x02, 0x0a, 0x07, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x48, 0x01, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x88,
+	0x01, 0x01, 0x12, 0x3d, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68,
+	0x73, 0x1a, 0x4f, 0x0a, 0x0e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xe2,
+	0x08, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x37, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x95, 0x01, 0x0a, 0x0d, 0x57, 0x68, 0x65, 0x72, 0x65, 0x44,
-	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
-	0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x57, 0x68, 0x65, 0x72, 0x65, 0x44, 0x6f, 0x63, 0x75,
-	0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x3b,
-	0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x57, 0x68, 0x65, 0x72, 0x65, 0x44,
-	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x48,
-	0x00, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x77,
-	0x68, 0x65, 0x72, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x6c, 0x0a,
-	0x13, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x57, 0x68, 0x65, 0x72, 0x65, 0x44, 0x6f, 0x63, 0x75,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x39, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x57, 0x68, 0x65, 0x72,
-	0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x7f, 0x0a, 0x15, 0x57,
-	0x68, 0x65, 0x72, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x69, 0x6c,
-	0x64, 0x72, 0x65, 0x6e, 0x12, 0x31, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
-	0x57, 0x68, 0x65, 0x72, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63,
-	0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x33, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x72, 0x6f,
-	0x6d, 0x61, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x8e, 0x01, 0x0a,
-	0x05, 0x57, 0x68, 0x65, 0x72, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x10, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12,
-	0x33, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x57, 0x68, 0x65, 0x72, 0x65,
-	0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c,
-	0x64, 0x72, 0x65, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x22, 0xac, 0x05,
-	0x0a, 0x10, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73,
-	0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x54, 0x0a, 0x15, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x69, 0x6e,
-	0x67, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69,
-	0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x13, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x4e, 0x0a, 0x13, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61,
-	0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x11, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c,
-	0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x4b, 0x0a, 0x12, 0x73, 0x69,
-	0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
-	0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69,
-	0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x10, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x49, 0x6e, 0x74,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x45, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x5f, 0x6c,
-	0x69, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x49, 0x6e, 0x74, 0x4c, 0x69,
-	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0e,
-	0x69, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x54,
-	0x0a, 0x15, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f,
-	0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x44, 0x6f, 0x75,
-	0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52,
-	0x13, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x6e, 0x64, 0x12, 0x4e, 0x0a, 0x13, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6c,
-	0x69, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48,
-	0x00, 0x52, 0x11, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x6e, 0x64, 0x12, 0x48, 0x0a, 0x11, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x73,
-	0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x73,
-	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0f, 0x62,
-	0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x4e,
-	0x0a, 0x13, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x68,
-	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x43,
-	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x11, 0x73, 0x69, 0x6e,
-	0x67, 0x6c, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x42, 0x0c,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x22, 0x6f, 0x0a, 0x0d,
-	0x57, 0x68, 0x65, 0x72, 0x65, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x29, 0x0a,
-	0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x57, 0x68, 0x65, 0x72, 0x65, 0x52, 0x08,
-	0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x33, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x72,
-	0x6f, 0x6d, 0x61, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x69, 0x0a,
-	0x14, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61,
-	0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x39, 0x0a,
-	0x0d, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x69, 0x0a, 0x16, 0x53, 0x69, 0x6e, 0x67,
-	0x6c, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73,
-	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70,
-	0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63,
-	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6d,
-	0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x22, 0x67, 0x0a, 0x14, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x42, 0x6f, 0x6f,
-	0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x66, 0x0a, 0x11,
-	0x49, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f,
-	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x03, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x6c, 0x69, 0x73,
-	0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x14, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x6f, 0x72, 0x22, 0xce, 0x01, 0x0a, 0x13, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x49,
-	0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x4a, 0x0a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x5f, 0x63, 0x6f,
-	0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
-	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x43,
-	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x11, 0x67, 0x65, 0x6e,
-	0x65, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x47,
-	0x0a, 0x11, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x72, 0x6f,
-	0x6d, 0x61, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x10, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x6d,
-	0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x69, 0x0a, 0x14, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4c,
+	0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x64, 0x69,
+	0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52,
+	0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x67, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x72, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x39, 0x0a, 0x16, 0x72, 0x65, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x14, 0x72, 0x65, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x2c, 0x0a, 0x03, 0x61, 0x63, 0x6c, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x63, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x61, 0x63, 0x6c, 0x12,
+	0x21, 0x0a, 0x0a, 0x6b, 0x6d, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x08, 0x6b, 0x6d, 0x73, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0d, 0x6c, 0x61,
+	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2f,
+	0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x48, 0x05, 0x52, 0x0f, 0x6c, 0x61, 0x73,
+	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x34, 0x0a, 0x13, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x48, 0x06, 0x52, 0x12,
+	0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x15, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x5f,
+	0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x07, 0x52, 0x13, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x55, 0x6e,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2d,
+	0x0a, 0x12, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x72, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x75,
+	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x15, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x13, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69,
+	0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x24, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x17,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x18, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x69, 0x6d, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x72, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x6b, 0x6d, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x42, 0x11,
+	0x0a, 0x0f, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x75, 0x6e, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x42,
+	0x18, 0x0a, 0x16, 0x5f, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x65, 0x6d,
+	0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x5f, 0x69, 0x64, 0x22, 0x54, 0x0a, 0x12, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x63, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69,
+	0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
+	0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x46, 0x0a, 0x08, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x22, 0x4e, 0x0a, 0x06, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x21, 0x0a, 0x0a, 0x6b, 0x6d, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x6b, 0x6d, 0x73, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x88,
+	0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6b, 0x6d, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69,
+	0x64, 0x22, 0xa6, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d,
+	0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a,
+	0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1f, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xac, 0x01, 0x0a, 0x0e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a,
+	0x58, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd0, 0x01, 0x0a, 0x0f, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a,
+	0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52,
+	0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x48, 0x01, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x34, 0x0a, 0x13,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x22, 0x2c, 0x0a, 0x14, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xf7, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x05, 0x77, 0x68, 0x65, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x57, 0x68, 0x65, 0x72, 0x65, 0x52, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x12, 0x3c, 0x0a,
+	0x0e, 0x77, 0x68, 0x65, 0x72, 0x65, 0x5f, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x57,
+	0x68, 0x65, 0x72, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0d, 0x77, 0x68,
+	0x65, 0x72, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69,
+	0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x19, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x52, 0x0a, 0x15, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x5d,
+	0x0a, 0x17, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x95, 0x01,
+	0x0a, 0x0d, 0x57, 0x68, 0x65, 0x72, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x35, 0x0a, 0x06, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x57,
+	0x68, 0x65, 0x72, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x57, 0x68, 0x65, 0x72, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43,
+	0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x72, 0x65, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x77, 0x68, 0x65, 0x72, 0x65, 0x5f, 0x64, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x6c, 0x0a, 0x13, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x57,
+	0x68, 0x65, 0x72, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x57, 0x68, 0x65, 0x72, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x22, 0x7f, 0x0a, 0x15, 0x57, 0x68, 0x65, 0x72, 0x65, 0x44, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x31, 0x0a, 0x08,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x57, 0x68, 0x65, 0x72, 0x65, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12,
+	0x33, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x65,
+	0x61, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x22, 0x8e, 0x01, 0x0a, 0x05, 0x57, 0x68, 0x65, 0x72, 0x65, 0x12, 0x47,
+	0x0a, 0x11, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69,
+	0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69,
+	0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x10, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x72, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x57, 0x68, 0x65, 0x72, 0x65, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
+	0x48, 0x00, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x42, 0x07, 0x0a, 0x05,
+	0x77, 0x68, 0x65, 0x72, 0x65, 0x22, 0xac, 0x05, 0x0a, 0x10, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x54, 0x0a, 0x15,
+	0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x13, 0x73,
+	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x6e, 0x64, 0x12, 0x4e, 0x0a, 0x13, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52,
+	0x11, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x6e, 0x64, 0x12, 0x4b, 0x0a, 0x12, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x74,
+	0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x49, 0x6e,
+	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x10, 0x73,
+	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x12,
+	0x45, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x49, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x54, 0x0a, 0x15, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65,
+	0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53,
+	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x13, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x4e, 0x0a, 0x13,
+	0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x11, 0x64, 0x6f, 0x75, 0x62, 0x6c,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x48, 0x0a, 0x11,
+	0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69,
+	0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0f, 0x62, 0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x4e, 0x0a, 0x13, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65,
+	0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x69, 0x6e,
+	0x67, 0x6c, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f,
+	0x6e, 0x48, 0x00, 0x52, 0x11, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x69, 0x73, 0x6f, 0x6e, 0x22, 0x6f, 0x0a, 0x0d, 0x57, 0x68, 0x65, 0x72, 0x65, 0x43, 0x68, 0x69,
+	0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x29, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x57, 0x68, 0x65, 0x72, 0x65, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
+	0x12, 0x33, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
+	0x65, 0x61, 0x6e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x69, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c,
 	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
-	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x76,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76,
 	0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6f, 0x70,
 	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63,
 	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
 	0x6f, 0x72, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x22, 0x67, 0x0a, 0x12, 0x42, 0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70,
-	0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x08, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x39,
-	0x0a, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0c, 0x6c, 0x69, 0x73,
-	0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xd1, 0x01, 0x0a, 0x16, 0x53, 0x69,
-	0x6e, 0x67, 0x6c, 0x65, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x22, 0x69, 0x0a, 0x16, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x67, 0x0a, 0x14, 0x53,
+	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69,
+	0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x22, 0x66, 0x0a, 0x11, 0x49, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x12, 0x39, 0x0a, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0c,
+	0x6c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xce, 0x01, 0x0a,
+	0x13, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72,
 	0x69, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4a, 0x0a, 0x12, 0x67, 0x65,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x4a, 0x0a, 0x12, 0x67, 0x65,
 	0x6e, 0x65, 0x72, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
 	0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f,
@@ -3102,61 +3421,92 @@ var file_chromadb_proto_chroma_proto_rawDesc = []byte{
 	0x0e, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65,
 	0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x10, 0x6e,
 	0x75, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x42,
-	0x0c, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x44, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x03, 0x69, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x72,
-	0x6f, 0x6d, 0x61, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x73, 0x22, 0x4f, 0x0a, 0x15, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6d, 0x62, 0x65,
-	0x64, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x76,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68,
-	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x06, 0x76, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x22, 0xbc, 0x01, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x07, 0x76,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63,
-	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x07, 0x76, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x0c, 0x0a, 0x01, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x01, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x69,
-	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
-	0x64, 0x49, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
-	0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69,
-	0x6e, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x22, 0x4c, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x68,
-	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
-	0x22, 0x49, 0x0a, 0x12, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
-	0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x77, 0x0a, 0x11, 0x56,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x02, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x06,
-	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63,
-	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x06,
-	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x76, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x2a, 0x38, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x44, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50,
-	0x44, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x53, 0x45, 0x52, 0x54,
-	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x2a, 0x28,
-	0x0a, 0x0e, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
-	0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x33, 0x32, 0x10, 0x00, 0x12, 0x09, 0x0a,
-	0x05, 0x49, 0x4e, 0x54, 0x33, 0x32, 0x10, 0x01, 0x2a, 0x40, 0x0a, 0x0c, 0x53, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x45, 0x43, 0x54,
-	0x4f, 0x52, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x45, 0x54, 0x41, 0x44, 0x41, 0x54, 0x41,
-	0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x10, 0x02, 0x12, 0x0a,
-	0x0a, 0x06, 0x53, 0x51, 0x4c, 0x49, 0x54, 0x45, 0x10, 0x03, 0x2a, 0x37, 0x0a, 0x15, 0x57, 0x68,
+	0x0c, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x69, 0x0a,
+	0x14, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x39, 0x0a,
+	0x0d, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x67, 0x0a, 0x12, 0x42, 0x6f, 0x6f, 0x6c,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x08, 0x52, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x0c, 0x6c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x22, 0xd1, 0x01, 0x0a, 0x16, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x44, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x4a, 0x0a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x5f, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x11, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x47,
+	0x0a, 0x11, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x10, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x44, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x56, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x56, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x4f, 0x0a, 0x15, 0x56, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x56, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0xbc, 0x01, 0x0a, 0x13,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x07, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x56, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x07, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x0c, 0x0a,
+	0x01, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x12,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x14, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x56, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x52,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x49, 0x0a, 0x12, 0x56, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x33,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x22, 0x77, 0x0a, 0x11, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x56, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x06, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01,
+	0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2a, 0x38, 0x0a, 0x09,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x44, 0x44,
+	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x0a,
+	0x0a, 0x06, 0x55, 0x50, 0x53, 0x45, 0x52, 0x54, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45,
+	0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x2a, 0x28, 0x0a, 0x0e, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4c, 0x4f, 0x41,
+	0x54, 0x33, 0x32, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x54, 0x33, 0x32, 0x10, 0x01,
+	0x2a, 0x40, 0x0a, 0x0c, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x12, 0x0a, 0x0a, 0x06, 0x56, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
+	0x4d, 0x45, 0x54, 0x41, 0x44, 0x41, 0x54, 0x41, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45,
+	0x43, 0x4f, 0x52, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x51, 0x4c, 0x49, 0x54, 0x45,
+	0x10, 0x03, 0x2a, 0x46, 0x0a, 0x0f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x00,
+	0x12, 0x0c, 0x0a, 0x08, 0x43, 0x52, 0x45, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c,
+	0x0a, 0x08, 0x44, 0x45, 0x47, 0x52, 0x41, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08,
+	0x44, 0x45, 0x4c, 0x45, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x2a, 0x37, 0x0a, 0x15, 0x57, 0x68,
 	0x65, 0x72, 0x65, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61,
 	0x74, 0x6f, 0x72, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x53, 0x10,
 	0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e,
@@ -3210,118 +3560,122 @@ func file_chromadb_proto_chroma_proto_rawDescGZIP() []byte {
 	return file_chromadb_proto_chroma_proto_rawDescData
 }
 
-var file_chromadb_proto_chroma_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
-var file_chromadb_proto_chroma_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_chromadb_proto_chroma_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_chromadb_proto_chroma_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_chromadb_proto_chroma_proto_goTypes = []interface{}{
 	(Operation)(0),                  // 0: chroma.Operation
 	(ScalarEncoding)(0),             // 1: chroma.ScalarEncoding
 	(SegmentScope)(0),               // 2: chroma.SegmentScope
-	(WhereDocumentOperator)(0),      // 3: chroma.WhereDocumentOperator
-	(BooleanOperator)(0),            // 4: chroma.BooleanOperator
-	(ListOperator)(0),               // 5: chroma.ListOperator
-	(GenericComparator)(0),          // 6: chroma.GenericComparator
-	(NumberComparator)(0),           // 7: chroma.NumberComparator
-	(*Status)(nil),                  // 8: chroma.Status
-	(*Vector)(nil),                  // 9: chroma.Vector
-	(*FilePaths)(nil),               // 10: chroma.FilePaths
-	(*Segment)(nil),                 // 11: chroma.Segment
-	(*Collection)(nil),              // 12: chroma.Collection
-	(*Database)(nil),                // 13: chroma.Database
-	(*Tenant)(nil),                  // 14: chroma.Tenant
-	(*UpdateMetadataValue)(nil),     // 15: chroma.UpdateMetadataValue
-	(*UpdateMetadata)(nil),          // 16: chroma.UpdateMetadata
-	(*OperationRecord)(nil),         // 17: chroma.OperationRecord
-	(*CountRecordsRequest)(nil),     // 18: chroma.CountRecordsRequest
-	(*CountRecordsResponse)(nil),    // 19: chroma.CountRecordsResponse
-	(*QueryMetadataRequest)(nil),    // 20: chroma.QueryMetadataRequest
-	(*QueryMetadataResponse)(nil),   // 21: chroma.QueryMetadataResponse
-	(*MetadataEmbeddingRecord)(nil), // 22: chroma.MetadataEmbeddingRecord
-	(*WhereDocument)(nil),           // 23: chroma.WhereDocument
-	(*DirectWhereDocument)(nil),     // 24: chroma.DirectWhereDocument
-	(*WhereDocumentChildren)(nil),   // 25: chroma.WhereDocumentChildren
-	(*Where)(nil),                   // 26: chroma.Where
-	(*DirectComparison)(nil),        // 27: chroma.DirectComparison
-	(*WhereChildren)(nil),           // 28: chroma.WhereChildren
-	(*StringListComparison)(nil),    // 29: chroma.StringListComparison
-	(*SingleStringComparison)(nil),  // 30: chroma.SingleStringComparison
-	(*SingleBoolComparison)(nil),    // 31: chroma.SingleBoolComparison
-	(*IntListComparison)(nil),       // 32: chroma.IntListComparison
-	(*SingleIntComparison)(nil),     // 33: chroma.SingleIntComparison
-	(*DoubleListComparison)(nil),    // 34: chroma.DoubleListComparison
-	(*BoolListComparison)(nil),      // 35: chroma.BoolListComparison
-	(*SingleDoubleComparison)(nil),  // 36: chroma.SingleDoubleComparison
-	(*GetVectorsRequest)(nil),       // 37: chroma.GetVectorsRequest
-	(*GetVectorsResponse)(nil),      // 38: chroma.GetVectorsResponse
-	(*VectorEmbeddingRecord)(nil),   // 39: chroma.VectorEmbeddingRecord
-	(*QueryVectorsRequest)(nil),     // 40: chroma.QueryVectorsRequest
-	(*QueryVectorsResponse)(nil),    // 41: chroma.QueryVectorsResponse
-	(*VectorQueryResults)(nil),      // 42: chroma.VectorQueryResults
-	(*VectorQueryResult)(nil),       // 43: chroma.VectorQueryResult
-	nil,                             // 44: chroma.Segment.FilePathsEntry
-	nil,                             // 45: chroma.UpdateMetadata.MetadataEntry
+	(CollectionState)(0),            // 3: chroma.CollectionState
+	(WhereDocumentOperator)(0),      // 4: chroma.WhereDocumentOperator
+	(BooleanOperator)(0),            // 5: chroma.BooleanOperator
+	(ListOperator)(0),               // 6: chroma.ListOperator
+	(GenericComparator)(0),          // 7: chroma.GenericComparator
+	(NumberComparator)(0),           // 8: chroma.NumberComparator
+	(*Status)(nil),                  // 9: chroma.Status
+	(*Vector)(nil),                  // 10: chroma.Vector
+	(*FilePaths)(nil),               // 11: chroma.FilePaths
+	(*Segment)(nil),                 // 12: chroma.Segment
+	(*Collection)(nil),              // 13: chroma.Collection
+	(*CollectionAclEntry)(nil),      // 14: chroma.CollectionAclEntry
+	(*Database)(nil),                // 15: chroma.Database
+	(*Tenant)(nil),                  // 16: chroma.Tenant
+	(*UpdateMetadataValue)(nil),     // 17: chroma.UpdateMetadataValue
+	(*UpdateMetadata)(nil),          // 18: chroma.UpdateMetadata
+	(*OperationRecord)(nil),         // 19: chroma.OperationRecord
+	(*CountRecordsRequest)(nil),     // 20: chroma.CountRecordsRequest
+	(*CountRecordsResponse)(nil),    // 21: chroma.CountRecordsResponse
+	(*QueryMetadataRequest)(nil),    // 22: chroma.QueryMetadataRequest
+	(*QueryMetadataResponse)(nil),   // 23: chroma.QueryMetadataResponse
+	(*MetadataEmbeddingRecord)(nil), // 24: chroma.MetadataEmbeddingRecord
+	(*WhereDocument)(nil),           // 25: chroma.WhereDocument
+	(*DirectWhereDocument)(nil),     // 26: chroma.DirectWhereDocument
+	(*WhereDocumentChildren)(nil),   // 27: chroma.WhereDocumentChildren
+	(*Where)(nil),                   // 28: chroma.Where
+	(*DirectComparison)(nil),        // 29: chroma.DirectComparison
+	(*WhereChildren)(nil),           // 30: chroma.WhereChildren
+	(*StringListComparison)(nil),    // 31: chroma.StringListComparison
+	(*SingleStringComparison)(nil),  // 32: chroma.SingleStringComparison
+	(*SingleBoolComparison)(nil),    // 33: chroma.SingleBoolComparison
+	(*IntListComparison)(nil),       // 34: chroma.IntListComparison
+	(*SingleIntComparison)(nil),     // 35: chroma.SingleIntComparison
+	(*DoubleListComparison)(nil),    // 36: chroma.DoubleListComparison
+	(*BoolListComparison)(nil),      // 37: chroma.BoolListComparison
+	(*SingleDoubleComparison)(nil),  // 38: chroma.SingleDoubleComparison
+	(*GetVectorsRequest)(nil),       // 39: chroma.GetVectorsRequest
+	(*GetVectorsResponse)(nil),      // 40: chroma.GetVectorsResponse
+	(*VectorEmbeddingRecord)(nil),   // 41: chroma.VectorEmbeddingRecord
+	(*QueryVectorsRequest)(nil),     // 42: chroma.QueryVectorsRequest
+	(*QueryVectorsResponse)(nil),    // 43: chroma.QueryVectorsResponse
+	(*VectorQueryResults)(nil),      // 44: chroma.VectorQueryResults
+	(*VectorQueryResult)(nil),       // 45: chroma.VectorQueryResult
+	nil,                             // 46: chroma.Segment.FilePathsEntry
+	nil,                             // 47: chroma.UpdateMetadata.MetadataEntry
 }
 var file_chromadb_proto_chroma_proto_depIdxs = []int32{
 	1,  // 0: chroma.Vector.encoding:type_name -> chroma.ScalarEncoding
 	2,  // 1: chroma.Segment.scope:type_name -> chroma.SegmentScope
-	16, // 2: chroma.Segment.metadata:type_name -> chroma.UpdateMetadata
-	44, // 3: chroma.Segment.file_paths:type_name -> chroma.Segment.FilePathsEntry
-	16, // 4: chroma.Collection.metadata:type_name -> chroma.UpdateMetadata
-	45, // 5: chroma.UpdateMetadata.metadata:type_name -> chroma.UpdateMetadata.MetadataEntry
-	9,  // 6: chroma.OperationRecord.vector:type_name -> chroma.Vector
-	16, // 7: chroma.OperationRecord.metadata:type_name -> chroma.UpdateMetadata
-	0,  // 8: chroma.OperationRecord.operation:type_name -> chroma.Operation
-	26, // 9: chroma.QueryMetadataRequest.where:type_name -> chroma.Where
-	23, // 10: chroma.QueryMetadataRequest.where_document:type_name -> chroma.WhereDocument
-	22, // 11: chroma.QueryMetadataResponse.records:type_name -> chroma.MetadataEmbeddingRecord
-	16, // 12: chroma.MetadataEmbeddingRecord.metadata:type_name -> chroma.UpdateMetadata
-	24, // 13: chroma.WhereDocument.direct:type_name -> chroma.DirectWhereDocument
-	25, // 14: chroma.WhereDocument.children:type_name -> chroma.WhereDocumentChildren
-	3,  // 15: chroma.DirectWhereDocument.operator:type_name -> chroma.WhereDocumentOperator
-	23, // 16: chroma.WhereDocumentChildren.children:type_name -> chroma.WhereDocument
-	4,  // 17: chroma.WhereDocumentChildren.operator:type_name -> chroma.BooleanOperator
-	27, // 18: chroma.Where.direct_comparison:type_name -> chroma.DirectComparison
-	28, // 19: chroma.Where.children:type_name -> chroma.WhereChildren
-	30, // 20: chroma.DirectComparison.single_string_operand:type_name -> chroma.SingleStringComparison
-	29, // 21: chroma.DirectComparison.string_list_operand:type_name -> chroma.StringListComparison
-	33, // 22: chroma.DirectComparison.single_int_operand:type_name -> chroma.SingleIntComparison
-	32, // 23: chroma.DirectComparison.int_list_operand:type_name -> chroma.IntListComparison
-	36, // 24: chroma.DirectComparison.single_double_operand:type_name -> chroma.SingleDoubleComparison
-	34, // 25: chroma.DirectComparison.double_list_operand:type_name -> chroma.DoubleListComparison
-	35, // 26: chroma.DirectComparison.bool_list_operand:type_name -> chroma.BoolListComparison
-	31, // 27: chroma.DirectComparison.single_bool_operand:type_name -> chroma.SingleBoolComparison
-	26, // 28: chroma.WhereChildren.children:type_name -> chroma.Where
-	4,  // 29: chroma.WhereChildren.operator:type_name -> chroma.BooleanOperator
-	5,  // 30: chroma.StringListComparison.list_operator:type_name -> chroma.ListOperator
-	6,  // 31: chroma.SingleStringComparison.comparator:type_name -> chroma.GenericComparator
-	6,  // 32: chroma.SingleBoolComparison.comparator:type_name -> chroma.GenericComparator
-	5,  // 33: chroma.IntListComparison.list_operator:type_name -> chroma.ListOperator
-	6,  // 34: chroma.SingleIntComparison.generic_comparator:type_name -> chroma.GenericComparator
-	7,  // 35: chroma.SingleIntComparison.number_comparator:type_name -> chroma.NumberComparator
-	5,  // 36: chroma.DoubleListComparison.list_operator:type_name -> chroma.ListOperator
-	5,  // 37: chroma.BoolListComparison.list_operator:type_name -> chroma.ListOperator
-	6,  // 38: chroma.SingleDoubleComparison.generic_comparator:type_name -> chroma.GenericComparator
-	7,  // 39: chroma.SingleDoubleComparison.number_comparator:type_name -> chroma.NumberComparator
-	39, // 40: chroma.GetVectorsResponse.records:type_name -> chroma.VectorEmbeddingRecord
-	9,  // 41: chroma.VectorEmbeddingRecord.vector:type_name -> chroma.Vector
-	9,  // 42: chroma.QueryVectorsRequest.vectors:type_name -> chroma.Vector
-	42, // 43: chroma.QueryVectorsResponse.results:type_name -> chroma.VectorQueryResults
-	43, // 44: chroma.VectorQueryResults.results:type_name -> chroma.VectorQueryResult
-	9,  // 45: chroma.VectorQueryResult.vector:type_name -> chroma.Vector
-	10, // 46: chroma.Segment.FilePathsEntry.value:type_name -> chroma.FilePaths
-	15, // 47: chroma.UpdateMetadata.MetadataEntry.value:type_name -> chroma.UpdateMetadataValue
-	20, // 48: chroma.MetadataReader.QueryMetadata:input_type -> chroma.QueryMetadataRequest
-	18, // 49: chroma.MetadataReader.CountRecords:input_type -> chroma.CountRecordsRequest
-	37, // 50: chroma.VectorReader.GetVectors:input_type -> chroma.GetVectorsRequest
-	40, // 51: chroma.VectorReader.QueryVectors:input_type -> chroma.QueryVectorsRequest
-	21, // 52: chroma.MetadataReader.QueryMetadata:output_type -> chroma.QueryMetadataResponse
-	19, // 53: chroma.MetadataReader.CountRecords:output_type -> chroma.CountRecordsResponse
-	38, // 54: chroma.VectorReader.GetVectors:output_type -> chroma.GetVectorsResponse
-	41, // 55: chroma.VectorReader.QueryVectors:output_type -> chroma.QueryVectorsResponse
-	52, // [52:56] is the sub-list for method output_type
-	48, // [48:52] is the sub-list for method input_type
-	48, // [48:48] is the sub-list for extension type_name
-	48, // [48:48] is the sub-list for extension extendee
-	0,  // [0:48] is the sub-list for field type_name
+	18, // 2: chroma.Segment.metadata:type_name -> chroma.UpdateMetadata
+	46, // 3: chroma.Segment.file_paths:type_name -> chroma.Segment.FilePathsEntry
+	18, // 4: chroma.Collection.metadata:type_name -> chroma.UpdateMetadata
+	3,  // 5: chroma.Collection.state:type_name -> chroma.CollectionState
+	14, // 6: chroma.Collection.acl:type_name -> chroma.CollectionAclEntry
+	47, // 7: chroma.UpdateMetadata.metadata:type_name -> chroma.UpdateMetadata.MetadataEntry
+	10, // 8: chroma.OperationRecord.vector:type_name -> chroma.Vector
+	18, // 9: chroma.OperationRecord.metadata:type_name -> chroma.UpdateMetadata
+	0,  // 10: chroma.OperationRecord.operation:type_name -> chroma.Operation
+	28, // 11: chroma.QueryMetadataRequest.where:type_name -> chroma.Where
+	25, // 12: chroma.QueryMetadataRequest.where_document:type_name -> chroma.WhereDocument
+	24, // 13: chroma.QueryMetadataResponse.records:type_name -> chroma.MetadataEmbeddingRecord
+	18, // 14: chroma.MetadataEmbeddingRecord.metadata:type_name -> chroma.UpdateMetadata
+	26, // 15: chroma.WhereDocument.direct:type_name -> chroma.DirectWhereDocument
+	27, // 16: chroma.WhereDocument.children:type_name -> chroma.WhereDocumentChildren
+	4,  // 17: chroma.DirectWhereDocument.operator:type_name -> chroma.WhereDocumentOperator
+	25, // 18: chroma.WhereDocumentChildren.children:type_name -> chroma.WhereDocument
+	5,  // 19: chroma.WhereDocumentChildren.operator:type_name -> chroma.BooleanOperator
+	29, // 20: chroma.Where.direct_comparison:type_name -> chroma.DirectComparison
+	30, // 21: chroma.Where.children:type_name -> chroma.WhereChildren
+	32, // 22: chroma.DirectComparison.single_string_operand:type_name -> chroma.SingleStringComparison
+	31, // 23: chroma.DirectComparison.string_list_operand:type_name -> chroma.StringListComparison
+	35, // 24: chroma.DirectComparison.single_int_operand:type_name -> chroma.SingleIntComparison
+	34, // 25: chroma.DirectComparison.int_list_operand:type_name -> chroma.IntListComparison
+	38, // 26: chroma.DirectComparison.single_double_operand:type_name -> chroma.SingleDoubleComparison
+	36, // 27: chroma.DirectComparison.double_list_operand:type_name -> chroma.DoubleListComparison
+	37, // 28: chroma.DirectComparison.bool_list_operand:type_name -> chroma.BoolListComparison
+	33, // 29: chroma.DirectComparison.single_bool_operand:type_name -> chroma.SingleBoolComparison
+	28, // 30: chroma.WhereChildren.children:type_name -> chroma.Where
+	5,  // 31: chroma.WhereChildren.operator:type_name -> chroma.BooleanOperator
+	6,  // 32: chroma.StringListComparison.list_operator:type_name -> chroma.ListOperator
+	7,  // 33: chroma.SingleStringComparison.comparator:type_name -> chroma.GenericComparator
+	7,  // 34: chroma.SingleBoolComparison.comparator:type_name -> chroma.GenericComparator
+	6,  // 35: chroma.IntListComparison.list_operator:type_name -> chroma.ListOperator
+	7,  // 36: chroma.SingleIntComparison.generic_comparator:type_name -> chroma.GenericComparator
+	8,  // 37: chroma.SingleIntComparison.number_comparator:type_name -> chroma.NumberComparator
+	6,  // 38: chroma.DoubleListComparison.list_operator:type_name -> chroma.ListOperator
+	6,  // 39: chroma.BoolListComparison.list_operator:type_name -> chroma.ListOperator
+	7,  // 40: chroma.SingleDoubleComparison.generic_comparator:type_name -> chroma.GenericComparator
+	8,  // 41: chroma.SingleDoubleComparison.number_comparator:type_name -> chroma.NumberComparator
+	41, // 42: chroma.GetVectorsResponse.records:type_name -> chroma.VectorEmbeddingRecord
+	10, // 43: chroma.VectorEmbeddingRecord.vector:type_name -> chroma.Vector
+	10, // 44: chroma.QueryVectorsRequest.vectors:type_name -> chroma.Vector
+	44, // 45: chroma.QueryVectorsResponse.results:type_name -> chroma.VectorQueryResults
+	45, // 46: chroma.VectorQueryResults.results:type_name -> chroma.VectorQueryResult
+	10, // 47: chroma.VectorQueryResult.vector:type_name -> chroma.Vector
+	11, // 48: chroma.Segment.FilePathsEntry.value:type_name -> chroma.FilePaths
+	17, // 49: chroma.UpdateMetadata.MetadataEntry.value:type_name -> chroma.UpdateMetadataValue
+	22, // 50: chroma.MetadataReader.QueryMetadata:input_type -> chroma.QueryMetadataRequest
+	20, // 51: chroma.MetadataReader.CountRecords:input_type -> chroma.CountRecordsRequest
+	39, // 52: chroma.VectorReader.GetVectors:input_type -> chroma.GetVectorsRequest
+	42, // 53: chroma.VectorReader.QueryVectors:input_type -> chroma.QueryVectorsRequest
+	23, // 54: chroma.MetadataReader.QueryMetadata:output_type -> chroma.QueryMetadataResponse
+	21, // 55: chroma.MetadataReader.CountRecords:output_type -> chroma.CountRecordsResponse
+	40, // 56: chroma.VectorReader.GetVectors:output_type -> chroma.GetVectorsResponse
+	43, // 57: chroma.VectorReader.QueryVectors:output_type -> chroma.QueryVectorsResponse
+	54, // [54:58] is the sub-list for method output_type
+	50, // [50:54] is the sub-list for method input_type
+	50, // [50:50] is the sub-list for extension type_name
+	50, // [50:50] is the sub-list for extension extendee
+	0,  // [0:50] is the sub-list for field type_name
 }
 
 func init() { file_chromadb_proto_chroma_proto_init() }
@@ -3391,7 +3745,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Database); i {
+			switch v := v.(*CollectionAclEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3403,7 +3757,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Tenant); i {
+			switch v := v.(*Database); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3415,7 +3769,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateMetadataValue); i {
+			switch v := v.(*Tenant); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3427,7 +3781,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateMetadata); i {
+			switch v := v.(*UpdateMetadataValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3439,7 +3793,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperationRecord); i {
+			switch v := v.(*UpdateMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3451,7 +3805,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountRecordsRequest); i {
+			switch v := v.(*OperationRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3463,7 +3817,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CountRecordsResponse); i {
+			switch v := v.(*CountRecordsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3475,7 +3829,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryMetadataRequest); i {
+			switch v := v.(*CountRecordsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3487,7 +3841,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryMetadataResponse); i {
+			switch v := v.(*QueryMetadataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3499,7 +3853,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MetadataEmbeddingRecord); i {
+			switch v := v.(*QueryMetadataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3511,7 +3865,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WhereDocument); i {
+			switch v := v.(*MetadataEmbeddingRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3523,7 +3877,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DirectWhereDocument); i {
+			switch v := v.(*WhereDocument); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3535,7 +3889,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WhereDocumentChildren); i {
+			switch v := v.(*DirectWhereDocument); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3547,7 +3901,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Where); i {
+			switch v := v.(*WhereDocumentChildren); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3559,7 +3913,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DirectComparison); i {
+			switch v := v.(*Where); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3571,7 +3925,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WhereChildren); i {
+			switch v := v.(*DirectComparison); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3583,7 +3937,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StringListComparison); i {
+			switch v := v.(*WhereChildren); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3595,7 +3949,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleStringComparison); i {
+			switch v := v.(*StringListComparison); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3607,7 +3961,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleBoolComparison); i {
+			switch v := v.(*SingleStringComparison); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3619,7 +3973,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IntListComparison); i {
+			switch v := v.(*SingleBoolComparison); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3631,7 +3985,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleIntComparison); i {
+			switch v := v.(*IntListComparison); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3643,7 +3997,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DoubleListComparison); i {
+			switch v := v.(*SingleIntComparison); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3655,7 +4009,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoolListComparison); i {
+			switch v := v.(*DoubleListComparison); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3667,7 +4021,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleDoubleComparison); i {
+			switch v := v.(*BoolListComparison); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3679,7 +4033,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVectorsRequest); i {
+			switch v := v.(*SingleDoubleComparison); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3691,7 +4045,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVectorsResponse); i {
+			switch v := v.(*GetVectorsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3703,7 +4057,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VectorEmbeddingRecord); i {
+			switch v := v.(*GetVectorsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3715,7 +4069,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryVectorsRequest); i {
+			switch v := v.(*VectorEmbeddingRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3727,7 +4081,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryVectorsResponse); i {
+			switch v := v.(*QueryVectorsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3739,7 +4093,7 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VectorQueryResults); i {
+			switch v := v.(*QueryVectorsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3751,6 +4105,18 @@ func file_chromadb_proto_chroma_proto_init() {
 			}
 		}
 		file_chromadb_proto_chroma_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VectorQueryResults); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_chroma_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VectorQueryResult); i {
 			case 0:
 				return &v.state
@@ -3765,23 +4131,24 @@ func file_chromadb_proto_chroma_proto_init() {
 	}
 	file_chromadb_proto_chroma_proto_msgTypes[3].OneofWrappers = []interface{}{}
 	file_chromadb_proto_chroma_proto_msgTypes[4].OneofWrappers = []interface{}{}
-	file_chromadb_proto_chroma_proto_msgTypes[7].OneofWrappers = []interface{}{
+	file_chromadb_proto_chroma_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_chromadb_proto_chroma_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*UpdateMetadataValue_StringValue)(nil),
 		(*UpdateMetadataValue_IntValue)(nil),
 		(*UpdateMetadataValue_FloatValue)(nil),
 		(*UpdateMetadataValue_BoolValue)(nil),
 	}
-	file_chromadb_proto_chroma_proto_msgTypes[9].OneofWrappers = []interface{}{}
-	file_chromadb_proto_chroma_proto_msgTypes[12].OneofWrappers = []interface{}{}
-	file_chromadb_proto_chroma_proto_msgTypes[15].OneofWrappers = []interface{}{
+	file_chromadb_proto_chroma_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	file_chromadb_proto_chroma_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_chromadb_proto_chroma_proto_msgTypes[16].OneofWrappers = []interface{}{
 		(*WhereDocument_Direct)(nil),
 		(*WhereDocument_Children)(nil),
 	}
-	file_chromadb_proto_chroma_proto_msgTypes[18].OneofWrappers = []interface{}{
+	file_chromadb_proto_chroma_proto_msgTypes[19].OneofWrappers = []interface{}{
 		(*Where_DirectComparison)(nil),
 		(*Where_Children)(nil),
 	}
-	file_chromadb_proto_chroma_proto_msgTypes[19].OneofWrappers = []interface{}{
+	file_chromadb_proto_chroma_proto_msgTypes[20].OneofWrappers = []interface{}{
 		(*DirectComparison_SingleStringOperand)(nil),
 		(*DirectComparison_StringListOperand)(nil),
 		(*DirectComparison_SingleIntOperand)(nil),
@@ -3791,22 +4158,22 @@ func file_chromadb_proto_chroma_proto_init() {
 		(*DirectComparison_BoolListOperand)(nil),
 		(*DirectComparison_SingleBoolOperand)(nil),
 	}
-	file_chromadb_proto_chroma_proto_msgTypes[25].OneofWrappers = []interface{}{
+	file_chromadb_proto_chroma_proto_msgTypes[26].OneofWrappers = []interface{}{
 		(*SingleIntComparison_GenericComparator)(nil),
 		(*SingleIntComparison_NumberComparator)(nil),
 	}
-	file_chromadb_proto_chroma_proto_msgTypes[28].OneofWrappers = []interface{}{
+	file_chromadb_proto_chroma_proto_msgTypes[29].OneofWrappers = []interface{}{
 		(*SingleDoubleComparison_GenericComparator)(nil),
 		(*SingleDoubleComparison_NumberComparator)(nil),
 	}
-	file_chromadb_proto_chroma_proto_msgTypes[35].OneofWrappers = []interface{}{}
+	file_chromadb_proto_chroma_proto_msgTypes[36].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_chromadb_proto_chroma_proto_rawDesc,
-			NumEnums:      8,
-			NumMessages:   38,
+			NumEnums:      9,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
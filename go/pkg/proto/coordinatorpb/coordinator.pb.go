@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -21,6 +22,148 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DestructiveOperation int32
+
+const (
+	DestructiveOperation_FORCE_DELETE_TENANT DestructiveOperation = 0
+)
+
+// Enum value maps for DestructiveOperation.
+var (
+	DestructiveOperation_name = map[int32]string{
+		0: "FORCE_DELETE_TENANT",
+	}
+	DestructiveOperation_value = map[string]int32{
+		"FORCE_DELETE_TENANT": 0,
+	}
+)
+
+func (x DestructiveOperation) Enum() *DestructiveOperation {
+	p := new(DestructiveOperation)
+	*p = x
+	return p
+}
+
+func (x DestructiveOperation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DestructiveOperation) Descriptor() protoreflect.EnumDescriptor {
+	return file_chromadb_proto_coordinator_proto_enumTypes[0].Descriptor()
+}
+
+func (DestructiveOperation) Type() protoreflect.EnumType {
+	return &file_chromadb_proto_coordinator_proto_enumTypes[0]
+}
+
+func (x DestructiveOperation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DestructiveOperation.Descriptor instead.
+func (DestructiveOperation) EnumDescriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{0}
+}
+
+type CollectionSizeOrderBy int32
+
+const (
+	CollectionSizeOrderBy_TOTAL_RECORDS CollectionSizeOrderBy = 0
+	CollectionSizeOrderBy_SIZE_BYTES    CollectionSizeOrderBy = 1
+)
+
+// Enum value maps for CollectionSizeOrderBy.
+var (
+	CollectionSizeOrderBy_name = map[int32]string{
+		0: "TOTAL_RECORDS",
+		1: "SIZE_BYTES",
+	}
+	CollectionSizeOrderBy_value = map[string]int32{
+		"TOTAL_RECORDS": 0,
+		"SIZE_BYTES":    1,
+	}
+)
+
+func (x CollectionSizeOrderBy) Enum() *CollectionSizeOrderBy {
+	p := new(CollectionSizeOrderBy)
+	*p = x
+	return p
+}
+
+func (x CollectionSizeOrderBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CollectionSizeOrderBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_chromadb_proto_coordinator_proto_enumTypes[1].Descriptor()
+}
+
+func (CollectionSizeOrderBy) Type() protoreflect.EnumType {
+	return &file_chromadb_proto_coordinator_proto_enumTypes[1]
+}
+
+func (x CollectionSizeOrderBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CollectionSizeOrderBy.Descriptor instead.
+func (CollectionSizeOrderBy) EnumDescriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{1}
+}
+
+// The type of the values of a document metadata key.
+type CollectionSchemaFieldType int32
+
+const (
+	CollectionSchemaFieldType_FIELD_TYPE_STRING CollectionSchemaFieldType = 0
+	CollectionSchemaFieldType_FIELD_TYPE_INT    CollectionSchemaFieldType = 1
+	CollectionSchemaFieldType_FIELD_TYPE_FLOAT  CollectionSchemaFieldType = 2
+	CollectionSchemaFieldType_FIELD_TYPE_BOOL   CollectionSchemaFieldType = 3
+)
+
+// Enum value maps for CollectionSchemaFieldType.
+var (
+	CollectionSchemaFieldType_name = map[int32]string{
+		0: "FIELD_TYPE_STRING",
+		1: "FIELD_TYPE_INT",
+		2: "FIELD_TYPE_FLOAT",
+		3: "FIELD_TYPE_BOOL",
+	}
+	CollectionSchemaFieldType_value = map[string]int32{
+		"FIELD_TYPE_STRING": 0,
+		"FIELD_TYPE_INT":    1,
+		"FIELD_TYPE_FLOAT":  2,
+		"FIELD_TYPE_BOOL":   3,
+	}
+)
+
+func (x CollectionSchemaFieldType) Enum() *CollectionSchemaFieldType {
+	p := new(CollectionSchemaFieldType)
+	*p = x
+	return p
+}
+
+func (x CollectionSchemaFieldType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CollectionSchemaFieldType) Descriptor() protoreflect.EnumDescriptor {
+	return file_chromadb_proto_coordinator_proto_enumTypes[2].Descriptor()
+}
+
+func (CollectionSchemaFieldType) Type() protoreflect.EnumType {
+	return &file_chromadb_proto_coordinator_proto_enumTypes[2]
+}
+
+func (x CollectionSchemaFieldType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CollectionSchemaFieldType.Descriptor instead.
+func (CollectionSchemaFieldType) EnumDescriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{2}
+}
+
 type CreateDatabaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -89,7 +232,8 @@ type CreateDatabaseResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Status   *Status   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Database *Database `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
 }
 
 func (x *CreateDatabaseResponse) Reset() {
@@ -131,13 +275,23 @@ func (x *CreateDatabaseResponse) GetStatus() *Status {
 	return nil
 }
 
+func (x *CreateDatabaseResponse) GetDatabase() *Database {
+	if x != nil {
+		return x.Database
+	}
+	return nil
+}
+
+// When id is set the database is looked up by id, and tenant, if set, must
+// match. name is ignored in that case.
 type GetDatabaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Name   string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tenant string  `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Id     *string `protobuf:"bytes,3,opt,name=id,proto3,oneof" json:"id,omitempty"`
 }
 
 func (x *GetDatabaseRequest) Reset() {
@@ -186,6 +340,13 @@ func (x *GetDatabaseRequest) GetTenant() string {
 	return ""
 }
 
+func (x *GetDatabaseRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
 type GetDatabaseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -246,7 +407,8 @@ type CreateTenantRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Names are globally unique
+	Name     string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Names are globally unique
+	KmsKeyId *string `protobuf:"bytes,3,opt,name=kms_key_id,json=kmsKeyId,proto3,oneof" json:"kms_key_id,omitempty"`
 }
 
 func (x *CreateTenantRequest) Reset() {
@@ -288,6 +450,13 @@ func (x *CreateTenantRequest) GetName() string {
 	return ""
 }
 
+func (x *CreateTenantRequest) GetKmsKeyId() string {
+	if x != nil && x.KmsKeyId != nil {
+		return *x.KmsKeyId
+	}
+	return ""
+}
+
 type CreateTenantResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -335,16 +504,20 @@ func (x *CreateTenantResponse) GetStatus() *Status {
 	return nil
 }
 
-type GetTenantRequest struct {
+// The progress of a long-running operation, in the entities it processed so
+// far out of total. eta_seconds is 0 until the time left can be estimated.
+type OperationProgress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Processed  int64 `protobuf:"varint,1,opt,name=processed,proto3" json:"processed,omitempty"`
+	Total      int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	EtaSeconds int64 `protobuf:"varint,3,opt,name=eta_seconds,json=etaSeconds,proto3" json:"eta_seconds,omitempty"`
 }
 
-func (x *GetTenantRequest) Reset() {
-	*x = GetTenantRequest{}
+func (x *OperationProgress) Reset() {
+	*x = OperationProgress{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -352,13 +525,13 @@ func (x *GetTenantRequest) Reset() {
 	}
 }
 
-func (x *GetTenantRequest) String() string {
+func (x *OperationProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTenantRequest) ProtoMessage() {}
+func (*OperationProgress) ProtoMessage() {}
 
-func (x *GetTenantRequest) ProtoReflect() protoreflect.Message {
+func (x *OperationProgress) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -370,29 +543,42 @@ func (x *GetTenantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTenantRequest.ProtoReflect.Descriptor instead.
-func (*GetTenantRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OperationProgress.ProtoReflect.Descriptor instead.
+func (*OperationProgress) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetTenantRequest) GetName() string {
+func (x *OperationProgress) GetProcessed() int64 {
 	if x != nil {
-		return x.Name
+		return x.Processed
 	}
-	return ""
+	return 0
 }
 
-type GetTenantResponse struct {
+func (x *OperationProgress) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *OperationProgress) GetEtaSeconds() int64 {
+	if x != nil {
+		return x.EtaSeconds
+	}
+	return 0
+}
+
+type GetTenantRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tenant *Tenant `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
-	Status *Status `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *GetTenantResponse) Reset() {
-	*x = GetTenantResponse{}
+func (x *GetTenantRequest) Reset() {
+	*x = GetTenantRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -400,13 +586,13 @@ func (x *GetTenantResponse) Reset() {
 	}
 }
 
-func (x *GetTenantResponse) String() string {
+func (x *GetTenantRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTenantResponse) ProtoMessage() {}
+func (*GetTenantRequest) ProtoMessage() {}
 
-func (x *GetTenantResponse) ProtoReflect() protoreflect.Message {
+func (x *GetTenantRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -418,35 +604,29 @@ func (x *GetTenantResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTenantResponse.ProtoReflect.Descriptor instead.
-func (*GetTenantResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTenantRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantRequest) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetTenantResponse) GetTenant() *Tenant {
-	if x != nil {
-		return x.Tenant
-	}
-	return nil
-}
-
-func (x *GetTenantResponse) GetStatus() *Status {
+func (x *GetTenantRequest) GetName() string {
 	if x != nil {
-		return x.Status
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type CreateSegmentRequest struct {
+type GetTenantResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Segment *Segment `protobuf:"bytes,1,opt,name=segment,proto3" json:"segment,omitempty"`
+	Tenant *Tenant `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Status *Status `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *CreateSegmentRequest) Reset() {
-	*x = CreateSegmentRequest{}
+func (x *GetTenantResponse) Reset() {
+	*x = GetTenantResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -454,13 +634,13 @@ func (x *CreateSegmentRequest) Reset() {
 	}
 }
 
-func (x *CreateSegmentRequest) String() string {
+func (x *GetTenantResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSegmentRequest) ProtoMessage() {}
+func (*GetTenantResponse) ProtoMessage() {}
 
-func (x *CreateSegmentRequest) ProtoReflect() protoreflect.Message {
+func (x *GetTenantResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -472,28 +652,45 @@ func (x *CreateSegmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSegmentRequest.ProtoReflect.Descriptor instead.
-func (*CreateSegmentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTenantResponse.ProtoReflect.Descriptor instead.
+func (*GetTenantResponse) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CreateSegmentRequest) GetSegment() *Segment {
+func (x *GetTenantResponse) GetTenant() *Tenant {
 	if x != nil {
-		return x.Segment
+		return x.Tenant
 	}
 	return nil
 }
 
-type CreateSegmentResponse struct {
+func (x *GetTenantResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Deleting a tenant that still has databases requires force and a token from
+// RequestConfirmationToken for FORCE_DELETE_TENANT on the tenant name.
+type DeleteTenantRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Name              string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Force             bool   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	ConfirmationToken string `protobuf:"bytes,3,opt,name=confirmation_token,json=confirmationToken,proto3" json:"confirmation_token,omitempty"`
+	Actor             string `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Queue the deletion as a job instead of deleting the tenant within the
+	// call, for the tenants too large to delete before the deadline. The
+	// request is checked before it is queued, and the job is tracked with
+	// GetJobStatus.
+	Async bool `protobuf:"varint,5,opt,name=async,proto3" json:"async,omitempty"`
 }
 
-func (x *CreateSegmentResponse) Reset() {
-	*x = CreateSegmentResponse{}
+func (x *DeleteTenantRequest) Reset() {
+	*x = DeleteTenantRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -501,13 +698,13 @@ func (x *CreateSegmentResponse) Reset() {
 	}
 }
 
-func (x *CreateSegmentResponse) String() string {
+func (x *DeleteTenantRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSegmentResponse) ProtoMessage() {}
+func (*DeleteTenantRequest) ProtoMessage() {}
 
-func (x *CreateSegmentResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteTenantRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -519,28 +716,58 @@ func (x *CreateSegmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSegmentResponse.ProtoReflect.Descriptor instead.
-func (*CreateSegmentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteTenantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTenantRequest) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *CreateSegmentResponse) GetStatus() *Status {
+func (x *DeleteTenantRequest) GetName() string {
 	if x != nil {
-		return x.Status
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type DeleteSegmentRequest struct {
+func (x *DeleteTenantRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *DeleteTenantRequest) GetConfirmationToken() string {
+	if x != nil {
+		return x.ConfirmationToken
+	}
+	return ""
+}
+
+func (x *DeleteTenantRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *DeleteTenantRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+type DeleteTenantResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The job deleting the tenant, set for an async request.
+	JobId string `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 }
 
-func (x *DeleteSegmentRequest) Reset() {
-	*x = DeleteSegmentRequest{}
+func (x *DeleteTenantResponse) Reset() {
+	*x = DeleteTenantResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -548,13 +775,13 @@ func (x *DeleteSegmentRequest) Reset() {
 	}
 }
 
-func (x *DeleteSegmentRequest) String() string {
+func (x *DeleteTenantResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSegmentRequest) ProtoMessage() {}
+func (*DeleteTenantResponse) ProtoMessage() {}
 
-func (x *DeleteSegmentRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteTenantResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -566,28 +793,39 @@ func (x *DeleteSegmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSegmentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSegmentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteTenantResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTenantResponse) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DeleteSegmentRequest) GetId() string {
+func (x *DeleteTenantResponse) GetStatus() *Status {
 	if x != nil {
-		return x.Id
+		return x.Status
+	}
+	return nil
+}
+
+func (x *DeleteTenantResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
 	}
 	return ""
 }
 
-type DeleteSegmentResponse struct {
+// A message of DeleteTenantWithProgress, which reports the collections of the
+// tenant purged so far at most every second. The last message carries the
+// status of the deletion, and the progress once it is committed.
+type DeleteTenantProgress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Progress *OperationProgress `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress,omitempty"`
+	Status   *Status            `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *DeleteSegmentResponse) Reset() {
-	*x = DeleteSegmentResponse{}
+func (x *DeleteTenantProgress) Reset() {
+	*x = DeleteTenantProgress{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -595,13 +833,13 @@ func (x *DeleteSegmentResponse) Reset() {
 	}
 }
 
-func (x *DeleteSegmentResponse) String() string {
+func (x *DeleteTenantProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSegmentResponse) ProtoMessage() {}
+func (*DeleteTenantProgress) ProtoMessage() {}
 
-func (x *DeleteSegmentResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteTenantProgress) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -613,31 +851,36 @@ func (x *DeleteSegmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSegmentResponse.ProtoReflect.Descriptor instead.
-func (*DeleteSegmentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteTenantProgress.ProtoReflect.Descriptor instead.
+func (*DeleteTenantProgress) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *DeleteSegmentResponse) GetStatus() *Status {
+func (x *DeleteTenantProgress) GetProgress() *OperationProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *DeleteTenantProgress) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-type GetSegmentsRequest struct {
+type RequestConfirmationTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         *string       `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
-	Type       *string       `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
-	Scope      *SegmentScope `protobuf:"varint,3,opt,name=scope,proto3,enum=chroma.SegmentScope,oneof" json:"scope,omitempty"`
-	Collection *string       `protobuf:"bytes,5,opt,name=collection,proto3,oneof" json:"collection,omitempty"` // Collection ID
+	Operation DestructiveOperation `protobuf:"varint,1,opt,name=operation,proto3,enum=chroma.DestructiveOperation" json:"operation,omitempty"`
+	Target    string               `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
 }
 
-func (x *GetSegmentsRequest) Reset() {
-	*x = GetSegmentsRequest{}
+func (x *RequestConfirmationTokenRequest) Reset() {
+	*x = RequestConfirmationTokenRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -645,13 +888,13 @@ func (x *GetSegmentsRequest) Reset() {
 	}
 }
 
-func (x *GetSegmentsRequest) String() string {
+func (x *RequestConfirmationTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSegmentsRequest) ProtoMessage() {}
+func (*RequestConfirmationTokenRequest) ProtoMessage() {}
 
-func (x *GetSegmentsRequest) ProtoReflect() protoreflect.Message {
+func (x *RequestConfirmationTokenRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -663,50 +906,37 @@ func (x *GetSegmentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSegmentsRequest.ProtoReflect.Descriptor instead.
-func (*GetSegmentsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestConfirmationTokenRequest.ProtoReflect.Descriptor instead.
+func (*RequestConfirmationTokenRequest) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetSegmentsRequest) GetId() string {
-	if x != nil && x.Id != nil {
-		return *x.Id
+func (x *RequestConfirmationTokenRequest) GetOperation() DestructiveOperation {
+	if x != nil {
+		return x.Operation
 	}
-	return ""
+	return DestructiveOperation_FORCE_DELETE_TENANT
 }
 
-func (x *GetSegmentsRequest) GetType() string {
-	if x != nil && x.Type != nil {
-		return *x.Type
-	}
-	return ""
-}
-
-func (x *GetSegmentsRequest) GetScope() SegmentScope {
-	if x != nil && x.Scope != nil {
-		return *x.Scope
-	}
-	return SegmentScope_VECTOR
-}
-
-func (x *GetSegmentsRequest) GetCollection() string {
-	if x != nil && x.Collection != nil {
-		return *x.Collection
+func (x *RequestConfirmationTokenRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
 	}
 	return ""
 }
 
-type GetSegmentsResponse struct {
+type RequestConfirmationTokenResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Segments []*Segment `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
-	Status   *Status    `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Token     string  `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt int64   `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Status    *Status `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *GetSegmentsResponse) Reset() {
-	*x = GetSegmentsResponse{}
+func (x *RequestConfirmationTokenResponse) Reset() {
+	*x = RequestConfirmationTokenResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -714,13 +944,13 @@ func (x *GetSegmentsResponse) Reset() {
 	}
 }
 
-func (x *GetSegmentsResponse) String() string {
+func (x *RequestConfirmationTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSegmentsResponse) ProtoMessage() {}
+func (*RequestConfirmationTokenResponse) ProtoMessage() {}
 
-func (x *GetSegmentsResponse) ProtoReflect() protoreflect.Message {
+func (x *RequestConfirmationTokenResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -732,45 +962,42 @@ func (x *GetSegmentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSegmentsResponse.ProtoReflect.Descriptor instead.
-func (*GetSegmentsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestConfirmationTokenResponse.ProtoReflect.Descriptor instead.
+func (*RequestConfirmationTokenResponse) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetSegmentsResponse) GetSegments() []*Segment {
+func (x *RequestConfirmationTokenResponse) GetToken() string {
 	if x != nil {
-		return x.Segments
+		return x.Token
 	}
-	return nil
+	return ""
 }
 
-func (x *GetSegmentsResponse) GetStatus() *Status {
+func (x *RequestConfirmationTokenResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *RequestConfirmationTokenResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-type UpdateSegmentRequest struct {
+type CreateSegmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Types that are assignable to CollectionUpdate:
-	//
-	//	*UpdateSegmentRequest_Collection
-	//	*UpdateSegmentRequest_ResetCollection
-	CollectionUpdate isUpdateSegmentRequest_CollectionUpdate `protobuf_oneof:"collection_update"`
-	// Types that are assignable to MetadataUpdate:
-	//
-	//	*UpdateSegmentRequest_Metadata
-	//	*UpdateSegmentRequest_ResetMetadata
-	MetadataUpdate isUpdateSegmentRequest_MetadataUpdate `protobuf_oneof:"metadata_update"`
+	Segment *Segment `protobuf:"bytes,1,opt,name=segment,proto3" json:"segment,omitempty"`
 }
 
-func (x *UpdateSegmentRequest) Reset() {
-	*x = UpdateSegmentRequest{}
+func (x *CreateSegmentRequest) Reset() {
+	*x = CreateSegmentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -778,13 +1005,13 @@ func (x *UpdateSegmentRequest) Reset() {
 	}
 }
 
-func (x *UpdateSegmentRequest) String() string {
+func (x *CreateSegmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSegmentRequest) ProtoMessage() {}
+func (*CreateSegmentRequest) ProtoMessage() {}
 
-func (x *UpdateSegmentRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateSegmentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -796,93 +1023,19 @@ func (x *UpdateSegmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSegmentRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSegmentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSegmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateSegmentRequest) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *UpdateSegmentRequest) GetId() string {
+func (x *CreateSegmentRequest) GetSegment() *Segment {
 	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (m *UpdateSegmentRequest) GetCollectionUpdate() isUpdateSegmentRequest_CollectionUpdate {
-	if m != nil {
-		return m.CollectionUpdate
-	}
-	return nil
-}
-
-func (x *UpdateSegmentRequest) GetCollection() string {
-	if x, ok := x.GetCollectionUpdate().(*UpdateSegmentRequest_Collection); ok {
-		return x.Collection
-	}
-	return ""
-}
-
-func (x *UpdateSegmentRequest) GetResetCollection() bool {
-	if x, ok := x.GetCollectionUpdate().(*UpdateSegmentRequest_ResetCollection); ok {
-		return x.ResetCollection
-	}
-	return false
-}
-
-func (m *UpdateSegmentRequest) GetMetadataUpdate() isUpdateSegmentRequest_MetadataUpdate {
-	if m != nil {
-		return m.MetadataUpdate
-	}
-	return nil
-}
-
-func (x *UpdateSegmentRequest) GetMetadata() *UpdateMetadata {
-	if x, ok := x.GetMetadataUpdate().(*UpdateSegmentRequest_Metadata); ok {
-		return x.Metadata
+		return x.Segment
 	}
 	return nil
 }
 
-func (x *UpdateSegmentRequest) GetResetMetadata() bool {
-	if x, ok := x.GetMetadataUpdate().(*UpdateSegmentRequest_ResetMetadata); ok {
-		return x.ResetMetadata
-	}
-	return false
-}
-
-type isUpdateSegmentRequest_CollectionUpdate interface {
-	isUpdateSegmentRequest_CollectionUpdate()
-}
-
-type UpdateSegmentRequest_Collection struct {
-	Collection string `protobuf:"bytes,4,opt,name=collection,proto3,oneof"`
-}
-
-type UpdateSegmentRequest_ResetCollection struct {
-	ResetCollection bool `protobuf:"varint,5,opt,name=reset_collection,json=resetCollection,proto3,oneof"`
-}
-
-func (*UpdateSegmentRequest_Collection) isUpdateSegmentRequest_CollectionUpdate() {}
-
-func (*UpdateSegmentRequest_ResetCollection) isUpdateSegmentRequest_CollectionUpdate() {}
-
-type isUpdateSegmentRequest_MetadataUpdate interface {
-	isUpdateSegmentRequest_MetadataUpdate()
-}
-
-type UpdateSegmentRequest_Metadata struct {
-	Metadata *UpdateMetadata `protobuf:"bytes,6,opt,name=metadata,proto3,oneof"`
-}
-
-type UpdateSegmentRequest_ResetMetadata struct {
-	ResetMetadata bool `protobuf:"varint,7,opt,name=reset_metadata,json=resetMetadata,proto3,oneof"`
-}
-
-func (*UpdateSegmentRequest_Metadata) isUpdateSegmentRequest_MetadataUpdate() {}
-
-func (*UpdateSegmentRequest_ResetMetadata) isUpdateSegmentRequest_MetadataUpdate() {}
-
-type UpdateSegmentResponse struct {
+type CreateSegmentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -890,8 +1043,8 @@ type UpdateSegmentResponse struct {
 	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *UpdateSegmentResponse) Reset() {
-	*x = UpdateSegmentResponse{}
+func (x *CreateSegmentResponse) Reset() {
+	*x = CreateSegmentResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -899,13 +1052,13 @@ func (x *UpdateSegmentResponse) Reset() {
 	}
 }
 
-func (x *UpdateSegmentResponse) String() string {
+func (x *CreateSegmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSegmentResponse) ProtoMessage() {}
+func (*CreateSegmentResponse) ProtoMessage() {}
 
-func (x *UpdateSegmentResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateSegmentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -917,34 +1070,31 @@ func (x *UpdateSegmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSegmentResponse.ProtoReflect.Descriptor instead.
-func (*UpdateSegmentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateSegmentResponse.ProtoReflect.Descriptor instead.
+func (*CreateSegmentResponse) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *UpdateSegmentResponse) GetStatus() *Status {
+func (x *CreateSegmentResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-type CreateCollectionRequest struct {
+// reason and actor are stored with the archived copy of the deleted rows.
+type DeleteSegmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Metadata    *UpdateMetadata `protobuf:"bytes,3,opt,name=metadata,proto3,oneof" json:"metadata,omitempty"`
-	Dimension   *int32          `protobuf:"varint,4,opt,name=dimension,proto3,oneof" json:"dimension,omitempty"`
-	GetOrCreate *bool           `protobuf:"varint,5,opt,name=get_or_create,json=getOrCreate,proto3,oneof" json:"get_or_create,omitempty"`
-	Tenant      string          `protobuf:"bytes,6,opt,name=tenant,proto3" json:"tenant,omitempty"`
-	Database    string          `protobuf:"bytes,7,opt,name=database,proto3" json:"database,omitempty"`
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Actor  string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
 }
 
-func (x *CreateCollectionRequest) Reset() {
-	*x = CreateCollectionRequest{}
+func (x *DeleteSegmentRequest) Reset() {
+	*x = DeleteSegmentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -952,13 +1102,13 @@ func (x *CreateCollectionRequest) Reset() {
 	}
 }
 
-func (x *CreateCollectionRequest) String() string {
+func (x *DeleteSegmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCollectionRequest) ProtoMessage() {}
+func (*DeleteSegmentRequest) ProtoMessage() {}
 
-func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteSegmentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -970,72 +1120,42 @@ func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCollectionRequest.ProtoReflect.Descriptor instead.
-func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteSegmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSegmentRequest) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *CreateCollectionRequest) GetId() string {
+func (x *DeleteSegmentRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateCollectionRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateCollectionRequest) GetMetadata() *UpdateMetadata {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
-}
-
-func (x *CreateCollectionRequest) GetDimension() int32 {
-	if x != nil && x.Dimension != nil {
-		return *x.Dimension
-	}
-	return 0
-}
-
-func (x *CreateCollectionRequest) GetGetOrCreate() bool {
-	if x != nil && x.GetOrCreate != nil {
-		return *x.GetOrCreate
-	}
-	return false
-}
-
-func (x *CreateCollectionRequest) GetTenant() string {
+func (x *DeleteSegmentRequest) GetReason() string {
 	if x != nil {
-		return x.Tenant
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *CreateCollectionRequest) GetDatabase() string {
+func (x *DeleteSegmentRequest) GetActor() string {
 	if x != nil {
-		return x.Database
+		return x.Actor
 	}
 	return ""
 }
 
-type CreateCollectionResponse struct {
+type DeleteSegmentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Collection *Collection `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
-	Created    bool        `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
-	Status     *Status     `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *CreateCollectionResponse) Reset() {
-	*x = CreateCollectionResponse{}
+func (x *DeleteSegmentResponse) Reset() {
+	*x = DeleteSegmentResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1043,13 +1163,13 @@ func (x *CreateCollectionResponse) Reset() {
 	}
 }
 
-func (x *CreateCollectionResponse) String() string {
+func (x *DeleteSegmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCollectionResponse) ProtoMessage() {}
+func (*DeleteSegmentResponse) ProtoMessage() {}
 
-func (x *CreateCollectionResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteSegmentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1061,44 +1181,37 @@ func (x *CreateCollectionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCollectionResponse.ProtoReflect.Descriptor instead.
-func (*CreateCollectionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteSegmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSegmentResponse) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *CreateCollectionResponse) GetCollection() *Collection {
-	if x != nil {
-		return x.Collection
-	}
-	return nil
-}
-
-func (x *CreateCollectionResponse) GetCreated() bool {
-	if x != nil {
-		return x.Created
-	}
-	return false
-}
-
-func (x *CreateCollectionResponse) GetStatus() *Status {
+func (x *DeleteSegmentResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-type DeleteCollectionRequest struct {
+type GetSegmentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Tenant   string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
-	Database string `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
+	Id         *string       `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Type       *string       `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Scope      *SegmentScope `protobuf:"varint,3,opt,name=scope,proto3,enum=chroma.SegmentScope,oneof" json:"scope,omitempty"`
+	Collection *string       `protobuf:"bytes,5,opt,name=collection,proto3,oneof" json:"collection,omitempty"` // Collection ID
+	// Segments are ordered by ID.
+	Limit  *int32 `protobuf:"varint,6,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset *int32 `protobuf:"varint,7,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	// The next_page_token of the previous page, instead of offset. Tokens are
+	// signed and bound to the filters of the request they were returned for.
+	PageToken *string `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
 }
 
-func (x *DeleteCollectionRequest) Reset() {
-	*x = DeleteCollectionRequest{}
+func (x *GetSegmentsRequest) Reset() {
+	*x = GetSegmentsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1106,13 +1219,13 @@ func (x *DeleteCollectionRequest) Reset() {
 	}
 }
 
-func (x *DeleteCollectionRequest) String() string {
+func (x *GetSegmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCollectionRequest) ProtoMessage() {}
+func (*GetSegmentsRequest) ProtoMessage() {}
 
-func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSegmentsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1124,42 +1237,73 @@ func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCollectionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSegmentsRequest.ProtoReflect.Descriptor instead.
+func (*GetSegmentsRequest) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DeleteCollectionRequest) GetId() string {
-	if x != nil {
-		return x.Id
+func (x *GetSegmentsRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
 	}
 	return ""
 }
 
-func (x *DeleteCollectionRequest) GetTenant() string {
-	if x != nil {
-		return x.Tenant
+func (x *GetSegmentsRequest) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
 	}
 	return ""
 }
 
-func (x *DeleteCollectionRequest) GetDatabase() string {
-	if x != nil {
-		return x.Database
+func (x *GetSegmentsRequest) GetScope() SegmentScope {
+	if x != nil && x.Scope != nil {
+		return *x.Scope
+	}
+	return SegmentScope_VECTOR
+}
+
+func (x *GetSegmentsRequest) GetCollection() string {
+	if x != nil && x.Collection != nil {
+		return *x.Collection
 	}
 	return ""
 }
 
-type DeleteCollectionResponse struct {
+func (x *GetSegmentsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *GetSegmentsRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+func (x *GetSegmentsRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+type GetSegmentsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Segments []*Segment `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
+	Status   *Status    `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Set when the page is full, there may be more segments.
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
-func (x *DeleteCollectionResponse) Reset() {
-	*x = DeleteCollectionResponse{}
+func (x *GetSegmentsResponse) Reset() {
+	*x = GetSegmentsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1167,13 +1311,13 @@ func (x *DeleteCollectionResponse) Reset() {
 	}
 }
 
-func (x *DeleteCollectionResponse) String() string {
+func (x *GetSegmentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCollectionResponse) ProtoMessage() {}
+func (*GetSegmentsResponse) ProtoMessage() {}
 
-func (x *DeleteCollectionResponse) ProtoReflect() protoreflect.Message {
+func (x *GetSegmentsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1185,33 +1329,52 @@ func (x *DeleteCollectionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCollectionResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCollectionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSegmentsResponse.ProtoReflect.Descriptor instead.
+func (*GetSegmentsResponse) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *DeleteCollectionResponse) GetStatus() *Status {
+func (x *GetSegmentsResponse) GetSegments() []*Segment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+func (x *GetSegmentsResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-type GetCollectionsRequest struct {
+func (x *GetSegmentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateSegmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
-	Name     *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Tenant   string  `protobuf:"bytes,4,opt,name=tenant,proto3" json:"tenant,omitempty"`
-	Database string  `protobuf:"bytes,5,opt,name=database,proto3" json:"database,omitempty"`
-	Limit    *int32  `protobuf:"varint,6,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	Offset   *int32  `protobuf:"varint,7,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are assignable to CollectionUpdate:
+	//
+	//	*UpdateSegmentRequest_Collection
+	//	*UpdateSegmentRequest_ResetCollection
+	CollectionUpdate isUpdateSegmentRequest_CollectionUpdate `protobuf_oneof:"collection_update"`
+	// Types that are assignable to MetadataUpdate:
+	//
+	//	*UpdateSegmentRequest_Metadata
+	//	*UpdateSegmentRequest_ResetMetadata
+	MetadataUpdate isUpdateSegmentRequest_MetadataUpdate `protobuf_oneof:"metadata_update"`
 }
 
-func (x *GetCollectionsRequest) Reset() {
-	*x = GetCollectionsRequest{}
+func (x *UpdateSegmentRequest) Reset() {
+	*x = UpdateSegmentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1219,13 +1382,13 @@ func (x *GetCollectionsRequest) Reset() {
 	}
 }
 
-func (x *GetCollectionsRequest) String() string {
+func (x *UpdateSegmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCollectionsRequest) ProtoMessage() {}
+func (*UpdateSegmentRequest) ProtoMessage() {}
 
-func (x *GetCollectionsRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateSegmentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1237,64 +1400,102 @@ func (x *GetCollectionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCollectionsRequest.ProtoReflect.Descriptor instead.
-func (*GetCollectionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateSegmentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSegmentRequest) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetCollectionsRequest) GetId() string {
-	if x != nil && x.Id != nil {
-		return *x.Id
+func (x *UpdateSegmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetCollectionsRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (m *UpdateSegmentRequest) GetCollectionUpdate() isUpdateSegmentRequest_CollectionUpdate {
+	if m != nil {
+		return m.CollectionUpdate
 	}
-	return ""
+	return nil
 }
 
-func (x *GetCollectionsRequest) GetTenant() string {
-	if x != nil {
-		return x.Tenant
+func (x *UpdateSegmentRequest) GetCollection() string {
+	if x, ok := x.GetCollectionUpdate().(*UpdateSegmentRequest_Collection); ok {
+		return x.Collection
 	}
 	return ""
 }
 
-func (x *GetCollectionsRequest) GetDatabase() string {
-	if x != nil {
-		return x.Database
+func (x *UpdateSegmentRequest) GetResetCollection() bool {
+	if x, ok := x.GetCollectionUpdate().(*UpdateSegmentRequest_ResetCollection); ok {
+		return x.ResetCollection
 	}
-	return ""
+	return false
 }
 
-func (x *GetCollectionsRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+func (m *UpdateSegmentRequest) GetMetadataUpdate() isUpdateSegmentRequest_MetadataUpdate {
+	if m != nil {
+		return m.MetadataUpdate
 	}
-	return 0
+	return nil
 }
 
-func (x *GetCollectionsRequest) GetOffset() int32 {
-	if x != nil && x.Offset != nil {
-		return *x.Offset
+func (x *UpdateSegmentRequest) GetMetadata() *UpdateMetadata {
+	if x, ok := x.GetMetadataUpdate().(*UpdateSegmentRequest_Metadata); ok {
+		return x.Metadata
 	}
-	return 0
+	return nil
 }
 
-type GetCollectionsResponse struct {
+func (x *UpdateSegmentRequest) GetResetMetadata() bool {
+	if x, ok := x.GetMetadataUpdate().(*UpdateSegmentRequest_ResetMetadata); ok {
+		return x.ResetMetadata
+	}
+	return false
+}
+
+type isUpdateSegmentRequest_CollectionUpdate interface {
+	isUpdateSegmentRequest_CollectionUpdate()
+}
+
+type UpdateSegmentRequest_Collection struct {
+	Collection string `protobuf:"bytes,4,opt,name=collection,proto3,oneof"`
+}
+
+type UpdateSegmentRequest_ResetCollection struct {
+	ResetCollection bool `protobuf:"varint,5,opt,name=reset_collection,json=resetCollection,proto3,oneof"`
+}
+
+func (*UpdateSegmentRequest_Collection) isUpdateSegmentRequest_CollectionUpdate() {}
+
+func (*UpdateSegmentRequest_ResetCollection) isUpdateSegmentRequest_CollectionUpdate() {}
+
+type isUpdateSegmentRequest_MetadataUpdate interface {
+	isUpdateSegmentRequest_MetadataUpdate()
+}
+
+type UpdateSegmentRequest_Metadata struct {
+	Metadata *UpdateMetadata `protobuf:"bytes,6,opt,name=metadata,proto3,oneof"`
+}
+
+type UpdateSegmentRequest_ResetMetadata struct {
+	ResetMetadata bool `protobuf:"varint,7,opt,name=reset_metadata,json=resetMetadata,proto3,oneof"`
+}
+
+func (*UpdateSegmentRequest_Metadata) isUpdateSegmentRequest_MetadataUpdate() {}
+
+func (*UpdateSegmentRequest_ResetMetadata) isUpdateSegmentRequest_MetadataUpdate() {}
+
+type UpdateSegmentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Collections []*Collection `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
-	Status      *Status       `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *GetCollectionsResponse) Reset() {
-	*x = GetCollectionsResponse{}
+func (x *UpdateSegmentResponse) Reset() {
+	*x = UpdateSegmentResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1302,13 +1503,13 @@ func (x *GetCollectionsResponse) Reset() {
 	}
 }
 
-func (x *GetCollectionsResponse) String() string {
+func (x *UpdateSegmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCollectionsResponse) ProtoMessage() {}
+func (*UpdateSegmentResponse) ProtoMessage() {}
 
-func (x *GetCollectionsResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateSegmentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1320,42 +1521,48 @@ func (x *GetCollectionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCollectionsResponse.ProtoReflect.Descriptor instead.
-func (*GetCollectionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateSegmentResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSegmentResponse) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetCollectionsResponse) GetCollections() []*Collection {
-	if x != nil {
-		return x.Collections
-	}
-	return nil
-}
-
-func (x *GetCollectionsResponse) GetStatus() *Status {
+func (x *UpdateSegmentResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-type UpdateCollectionRequest struct {
+type CreateCollectionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name      *string `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Dimension *int32  `protobuf:"varint,4,opt,name=dimension,proto3,oneof" json:"dimension,omitempty"`
-	// Types that are assignable to MetadataUpdate:
-	//
-	//	*UpdateCollectionRequest_Metadata
-	//	*UpdateCollectionRequest_ResetMetadata
-	MetadataUpdate isUpdateCollectionRequest_MetadataUpdate `protobuf_oneof:"metadata_update"`
+	Id          string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Metadata    *UpdateMetadata `protobuf:"bytes,3,opt,name=metadata,proto3,oneof" json:"metadata,omitempty"`
+	Dimension   *int32          `protobuf:"varint,4,opt,name=dimension,proto3,oneof" json:"dimension,omitempty"`
+	GetOrCreate *bool           `protobuf:"varint,5,opt,name=get_or_create,json=getOrCreate,proto3,oneof" json:"get_or_create,omitempty"`
+	Tenant      string          `protobuf:"bytes,6,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Database    string          `protobuf:"bytes,7,opt,name=database,proto3" json:"database,omitempty"`
+	// Create the database if it does not exist. The tenant must exist.
+	CreateDatabaseIfNotExists *bool `protobuf:"varint,8,opt,name=create_database_if_not_exists,json=createDatabaseIfNotExists,proto3,oneof" json:"create_database_if_not_exists,omitempty"`
+	// Segments created in the same transaction as the collection. Their collection
+	// must be the id of the new collection.
+	Segments []*Segment `protobuf:"bytes,9,rep,name=segments,proto3" json:"segments,omitempty"`
+	// Defaults to the key of the tenant.
+	KmsKeyId *string `protobuf:"bytes,10,opt,name=kms_key_id,json=kmsKeyId,proto3,oneof" json:"kms_key_id,omitempty"`
+	// The number of query nodes the collection is assigned to, 1 when unset.
+	ReplicationFactor *int32 `protobuf:"varint,11,opt,name=replication_factor,json=replicationFactor,proto3,oneof" json:"replication_factor,omitempty"`
+	// One of l2, cosine and ip. Defaults to the hnsw:space metadata, or l2 when
+	// the metadata does not set it, and must match the metadata when both are set.
+	DistanceFunction *string `protobuf:"bytes,12,opt,name=distance_function,json=distanceFunction,proto3,oneof" json:"distance_function,omitempty"`
+	// An embedding function of the tenant.
+	EmbeddingFunctionId *string `protobuf:"bytes,13,opt,name=embedding_function_id,json=embeddingFunctionId,proto3,oneof" json:"embedding_function_id,omitempty"`
 }
 
-func (x *UpdateCollectionRequest) Reset() {
-	*x = UpdateCollectionRequest{}
+func (x *CreateCollectionRequest) Reset() {
+	*x = CreateCollectionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1363,13 +1570,13 @@ func (x *UpdateCollectionRequest) Reset() {
 	}
 }
 
-func (x *UpdateCollectionRequest) String() string {
+func (x *CreateCollectionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCollectionRequest) ProtoMessage() {}
+func (*CreateCollectionRequest) ProtoMessage() {}
 
-func (x *UpdateCollectionRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1381,79 +1588,114 @@ func (x *UpdateCollectionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCollectionRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCollectionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *UpdateCollectionRequest) GetId() string {
+func (x *CreateCollectionRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateCollectionRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *CreateCollectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateCollectionRequest) GetDimension() int32 {
+func (x *CreateCollectionRequest) GetMetadata() *UpdateMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CreateCollectionRequest) GetDimension() int32 {
 	if x != nil && x.Dimension != nil {
 		return *x.Dimension
 	}
 	return 0
 }
 
-func (m *UpdateCollectionRequest) GetMetadataUpdate() isUpdateCollectionRequest_MetadataUpdate {
-	if m != nil {
-		return m.MetadataUpdate
+func (x *CreateCollectionRequest) GetGetOrCreate() bool {
+	if x != nil && x.GetOrCreate != nil {
+		return *x.GetOrCreate
 	}
-	return nil
+	return false
 }
 
-func (x *UpdateCollectionRequest) GetMetadata() *UpdateMetadata {
-	if x, ok := x.GetMetadataUpdate().(*UpdateCollectionRequest_Metadata); ok {
-		return x.Metadata
+func (x *CreateCollectionRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateCollectionRequest) GetResetMetadata() bool {
-	if x, ok := x.GetMetadataUpdate().(*UpdateCollectionRequest_ResetMetadata); ok {
-		return x.ResetMetadata
+func (x *CreateCollectionRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *CreateCollectionRequest) GetCreateDatabaseIfNotExists() bool {
+	if x != nil && x.CreateDatabaseIfNotExists != nil {
+		return *x.CreateDatabaseIfNotExists
 	}
 	return false
 }
 
-type isUpdateCollectionRequest_MetadataUpdate interface {
-	isUpdateCollectionRequest_MetadataUpdate()
+func (x *CreateCollectionRequest) GetSegments() []*Segment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
 }
 
-type UpdateCollectionRequest_Metadata struct {
-	Metadata *UpdateMetadata `protobuf:"bytes,5,opt,name=metadata,proto3,oneof"`
+func (x *CreateCollectionRequest) GetKmsKeyId() string {
+	if x != nil && x.KmsKeyId != nil {
+		return *x.KmsKeyId
+	}
+	return ""
 }
 
-type UpdateCollectionRequest_ResetMetadata struct {
-	ResetMetadata bool `protobuf:"varint,6,opt,name=reset_metadata,json=resetMetadata,proto3,oneof"`
+func (x *CreateCollectionRequest) GetReplicationFactor() int32 {
+	if x != nil && x.ReplicationFactor != nil {
+		return *x.ReplicationFactor
+	}
+	return 0
 }
 
-func (*UpdateCollectionRequest_Metadata) isUpdateCollectionRequest_MetadataUpdate() {}
+func (x *CreateCollectionRequest) GetDistanceFunction() string {
+	if x != nil && x.DistanceFunction != nil {
+		return *x.DistanceFunction
+	}
+	return ""
+}
 
-func (*UpdateCollectionRequest_ResetMetadata) isUpdateCollectionRequest_MetadataUpdate() {}
+func (x *CreateCollectionRequest) GetEmbeddingFunctionId() string {
+	if x != nil && x.EmbeddingFunctionId != nil {
+		return *x.EmbeddingFunctionId
+	}
+	return ""
+}
 
-type UpdateCollectionResponse struct {
+type CreateCollectionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Collection *Collection `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Created    bool        `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Status     *Status     `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *UpdateCollectionResponse) Reset() {
-	*x = UpdateCollectionResponse{}
+func (x *CreateCollectionResponse) Reset() {
+	*x = CreateCollectionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1461,13 +1703,13 @@ func (x *UpdateCollectionResponse) Reset() {
 	}
 }
 
-func (x *UpdateCollectionResponse) String() string {
+func (x *CreateCollectionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCollectionResponse) ProtoMessage() {}
+func (*CreateCollectionResponse) ProtoMessage() {}
 
-func (x *UpdateCollectionResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateCollectionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1479,31 +1721,47 @@ func (x *UpdateCollectionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCollectionResponse.ProtoReflect.Descriptor instead.
-func (*UpdateCollectionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateCollectionResponse.ProtoReflect.Descriptor instead.
+func (*CreateCollectionResponse) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *UpdateCollectionResponse) GetStatus() *Status {
+func (x *CreateCollectionResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+func (x *CreateCollectionResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+func (x *CreateCollectionResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-type Notification struct {
+// reason and actor are stored with the archived copy of the deleted rows.
+type DeleteCollectionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CollectionId string `protobuf:"bytes,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
-	Type         string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Status       string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tenant   string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Database string `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
+	Reason   string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Actor    string `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
 }
 
-func (x *Notification) Reset() {
-	*x = Notification{}
+func (x *DeleteCollectionRequest) Reset() {
+	*x = DeleteCollectionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1511,13 +1769,13 @@ func (x *Notification) Reset() {
 	}
 }
 
-func (x *Notification) String() string {
+func (x *DeleteCollectionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Notification) ProtoMessage() {}
+func (*DeleteCollectionRequest) ProtoMessage() {}
 
-func (x *Notification) ProtoReflect() protoreflect.Message {
+func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1529,40 +1787,47 @@ func (x *Notification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
-func (*Notification) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteCollectionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *Notification) GetId() int64 {
+func (x *DeleteCollectionRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *Notification) GetCollectionId() string {
+func (x *DeleteCollectionRequest) GetTenant() string {
 	if x != nil {
-		return x.CollectionId
+		return x.Tenant
 	}
 	return ""
 }
 
-func (x *Notification) GetType() string {
+func (x *DeleteCollectionRequest) GetDatabase() string {
 	if x != nil {
-		return x.Type
+		return x.Database
 	}
 	return ""
 }
 
-func (x *Notification) GetStatus() string {
+func (x *DeleteCollectionRequest) GetReason() string {
 	if x != nil {
-		return x.Status
+		return x.Reason
 	}
 	return ""
 }
 
-type ResetStateResponse struct {
+func (x *DeleteCollectionRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type DeleteCollectionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1570,8 +1835,8 @@ type ResetStateResponse struct {
 	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *ResetStateResponse) Reset() {
-	*x = ResetStateResponse{}
+func (x *DeleteCollectionResponse) Reset() {
+	*x = DeleteCollectionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1579,13 +1844,13 @@ func (x *ResetStateResponse) Reset() {
 	}
 }
 
-func (x *ResetStateResponse) String() string {
+func (x *DeleteCollectionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetStateResponse) ProtoMessage() {}
+func (*DeleteCollectionResponse) ProtoMessage() {}
 
-func (x *ResetStateResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteCollectionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1597,28 +1862,39 @@ func (x *ResetStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetStateResponse.ProtoReflect.Descriptor instead.
-func (*ResetStateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteCollectionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionResponse) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ResetStateResponse) GetStatus() *Status {
+func (x *DeleteCollectionResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-type GetLastCompactionTimeForTenantRequest struct {
+// A predicate on a single collection metadata key. Scalar comparisons only
+// match values of the same type, e.g. an int comparison never matches a float
+// value. `exists` matches on the presence (true) or absence (false) of the key.
+type CollectionMetadataFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TenantId []string `protobuf:"bytes,1,rep,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Types that are assignable to Comparison:
+	//
+	//	*CollectionMetadataFilter_SingleStringOperand
+	//	*CollectionMetadataFilter_SingleIntOperand
+	//	*CollectionMetadataFilter_SingleDoubleOperand
+	//	*CollectionMetadataFilter_SingleBoolOperand
+	//	*CollectionMetadataFilter_Exists
+	Comparison isCollectionMetadataFilter_Comparison `protobuf_oneof:"comparison"`
 }
 
-func (x *GetLastCompactionTimeForTenantRequest) Reset() {
-	*x = GetLastCompactionTimeForTenantRequest{}
+func (x *CollectionMetadataFilter) Reset() {
+	*x = CollectionMetadataFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1626,13 +1902,13 @@ func (x *GetLastCompactionTimeForTenantRequest) Reset() {
 	}
 }
 
-func (x *GetLastCompactionTimeForTenantRequest) String() string {
+func (x *CollectionMetadataFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLastCompactionTimeForTenantRequest) ProtoMessage() {}
+func (*CollectionMetadataFilter) ProtoMessage() {}
 
-func (x *GetLastCompactionTimeForTenantRequest) ProtoReflect() protoreflect.Message {
+func (x *CollectionMetadataFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1644,29 +1920,139 @@ func (x *GetLastCompactionTimeForTenantRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLastCompactionTimeForTenantRequest.ProtoReflect.Descriptor instead.
-func (*GetLastCompactionTimeForTenantRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CollectionMetadataFilter.ProtoReflect.Descriptor instead.
+func (*CollectionMetadataFilter) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetLastCompactionTimeForTenantRequest) GetTenantId() []string {
+func (x *CollectionMetadataFilter) GetKey() string {
 	if x != nil {
-		return x.TenantId
+		return x.Key
+	}
+	return ""
+}
+
+func (m *CollectionMetadataFilter) GetComparison() isCollectionMetadataFilter_Comparison {
+	if m != nil {
+		return m.Comparison
 	}
 	return nil
 }
 
-type TenantLastCompactionTime struct {
+func (x *CollectionMetadataFilter) GetSingleStringOperand() *SingleStringComparison {
+	if x, ok := x.GetComparison().(*CollectionMetadataFilter_SingleStringOperand); ok {
+		return x.SingleStringOperand
+	}
+	return nil
+}
+
+func (x *CollectionMetadataFilter) GetSingleIntOperand() *SingleIntComparison {
+	if x, ok := x.GetComparison().(*CollectionMetadataFilter_SingleIntOperand); ok {
+		return x.SingleIntOperand
+	}
+	return nil
+}
+
+func (x *CollectionMetadataFilter) GetSingleDoubleOperand() *SingleDoubleComparison {
+	if x, ok := x.GetComparison().(*CollectionMetadataFilter_SingleDoubleOperand); ok {
+		return x.SingleDoubleOperand
+	}
+	return nil
+}
+
+func (x *CollectionMetadataFilter) GetSingleBoolOperand() *SingleBoolComparison {
+	if x, ok := x.GetComparison().(*CollectionMetadataFilter_SingleBoolOperand); ok {
+		return x.SingleBoolOperand
+	}
+	return nil
+}
+
+func (x *CollectionMetadataFilter) GetExists() bool {
+	if x, ok := x.GetComparison().(*CollectionMetadataFilter_Exists); ok {
+		return x.Exists
+	}
+	return false
+}
+
+type isCollectionMetadataFilter_Comparison interface {
+	isCollectionMetadataFilter_Comparison()
+}
+
+type CollectionMetadataFilter_SingleStringOperand struct {
+	SingleStringOperand *SingleStringComparison `protobuf:"bytes,2,opt,name=single_string_operand,json=singleStringOperand,proto3,oneof"`
+}
+
+type CollectionMetadataFilter_SingleIntOperand struct {
+	SingleIntOperand *SingleIntComparison `protobuf:"bytes,3,opt,name=single_int_operand,json=singleIntOperand,proto3,oneof"`
+}
+
+type CollectionMetadataFilter_SingleDoubleOperand struct {
+	SingleDoubleOperand *SingleDoubleComparison `protobuf:"bytes,4,opt,name=single_double_operand,json=singleDoubleOperand,proto3,oneof"`
+}
+
+type CollectionMetadataFilter_SingleBoolOperand struct {
+	SingleBoolOperand *SingleBoolComparison `protobuf:"bytes,5,opt,name=single_bool_operand,json=singleBoolOperand,proto3,oneof"`
+}
+
+type CollectionMetadataFilter_Exists struct {
+	Exists bool `protobuf:"varint,6,opt,name=exists,proto3,oneof"`
+}
+
+func (*CollectionMetadataFilter_SingleStringOperand) isCollectionMetadataFilter_Comparison() {}
+
+func (*CollectionMetadataFilter_SingleIntOperand) isCollectionMetadataFilter_Comparison() {}
+
+func (*CollectionMetadataFilter_SingleDoubleOperand) isCollectionMetadataFilter_Comparison() {}
+
+func (*CollectionMetadataFilter_SingleBoolOperand) isCollectionMetadataFilter_Comparison() {}
+
+func (*CollectionMetadataFilter_Exists) isCollectionMetadataFilter_Comparison() {}
+
+type GetCollectionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TenantId           string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	LastCompactionTime int64  `protobuf:"varint,2,opt,name=last_compaction_time,json=lastCompactionTime,proto3" json:"last_compaction_time,omitempty"`
+	Id       *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Name     *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Tenant   string  `protobuf:"bytes,4,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Database string  `protobuf:"bytes,5,opt,name=database,proto3" json:"database,omitempty"`
+	Limit    *int32  `protobuf:"varint,6,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset   *int32  `protobuf:"varint,7,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	// All filters must match for a collection to be returned.
+	MetadataFilters []*CollectionMetadataFilter `protobuf:"bytes,8,rep,name=metadata_filters,json=metadataFilters,proto3" json:"metadata_filters,omitempty"`
+	// Fill in the log lag of the collections from the log service. The lag is
+	// left unset when the log service cannot be reached.
+	IncludeLogLag bool `protobuf:"varint,9,opt,name=include_log_lag,json=includeLogLag,proto3" json:"include_log_lag,omitempty"`
+	// Only return the collections with this dimension and distance function.
+	Dimension        *int32  `protobuf:"varint,10,opt,name=dimension,proto3,oneof" json:"dimension,omitempty"`
+	DistanceFunction *string `protobuf:"bytes,11,opt,name=distance_function,json=distanceFunction,proto3,oneof" json:"distance_function,omitempty"`
+	// Only return these fields of the collections, named as in Collection. The
+	// other fields are left unset, and metadata and acl are not loaded when they
+	// are not asked for. Every field is returned when the mask is not set.
+	FieldMask *fieldmaskpb.FieldMask `protobuf:"bytes,12,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	// The etag of a previous response to the same request. When the response
+	// would have the same etag, it is returned with status code 304 and without
+	// collections.
+	IfNoneMatch *string `protobuf:"bytes,13,opt,name=if_none_match,json=ifNoneMatch,proto3,oneof" json:"if_none_match,omitempty"`
+	// Wait until the version of the collection is at least this one before
+	// returning it, at most until shortly before the deadline of the call or 30
+	// seconds. The collection is returned as it is when the wait is over, callers
+	// compare its version. Requires id.
+	MinVersion *int32 `protobuf:"varint,14,opt,name=min_version,json=minVersion,proto3,oneof" json:"min_version,omitempty"`
+	// The next_page_token of the previous page, instead of offset. Tokens are
+	// signed and bound to the filters of the request they were returned for.
+	PageToken *string `protobuf:"bytes,15,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+	// Return the collections with these ids, at most 1000, in a fixed number of
+	// queries instead of one call per collection. The ids that do not name a live
+	// collection are left out. Cannot be combined with id, name, limit, offset,
+	// page_token, metadata_filters, dimension, distance_function or min_version.
+	// tenant and database still narrow down the collections when they are set.
+	Ids []string `protobuf:"bytes,16,rep,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (x *TenantLastCompactionTime) Reset() {
-	*x = TenantLastCompactionTime{}
+func (x *GetCollectionsRequest) Reset() {
+	*x = GetCollectionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chromadb_proto_coordinator_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1674,13 +2060,13 @@ func (x *TenantLastCompactionTime) Reset() {
 	}
 }
 
-func (x *TenantLastCompactionTime) String() string {
+func (x *GetCollectionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TenantLastCompactionTime) ProtoMessage() {}
+func (*GetCollectionsRequest) ProtoMessage() {}
 
-func (x *TenantLastCompactionTime) ProtoReflect() protoreflect.Message {
+func (x *GetCollectionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_chromadb_proto_coordinator_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1692,145 +2078,147 @@ func (x *TenantLastCompactionTime) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TenantLastCompactionTime.ProtoReflect.Descriptor instead.
-func (*TenantLastCompactionTime) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionsRequest) Descriptor() ([]byte, []int) {
 	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *TenantLastCompactionTime) GetTenantId() string {
-	if x != nil {
-		return x.TenantId
+func (x *GetCollectionsRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
 	}
 	return ""
 }
 
-func (x *TenantLastCompactionTime) GetLastCompactionTime() int64 {
-	if x != nil {
-		return x.LastCompactionTime
+func (x *GetCollectionsRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
-	return 0
-}
-
-type GetLastCompactionTimeForTenantResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	TenantLastCompactionTime []*TenantLastCompactionTime `protobuf:"bytes,1,rep,name=tenant_last_compaction_time,json=tenantLastCompactionTime,proto3" json:"tenant_last_compaction_time,omitempty"`
+	return ""
 }
 
-func (x *GetLastCompactionTimeForTenantResponse) Reset() {
-	*x = GetLastCompactionTimeForTenantResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_coordinator_proto_msgTypes[28]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetCollectionsRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
 	}
+	return ""
 }
 
-func (x *GetLastCompactionTimeForTenantResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetCollectionsRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
 }
 
-func (*GetLastCompactionTimeForTenantResponse) ProtoMessage() {}
-
-func (x *GetLastCompactionTimeForTenantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_coordinator_proto_msgTypes[28]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetCollectionsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetLastCompactionTimeForTenantResponse.ProtoReflect.Descriptor instead.
-func (*GetLastCompactionTimeForTenantResponse) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{28}
+func (x *GetCollectionsRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
 }
 
-func (x *GetLastCompactionTimeForTenantResponse) GetTenantLastCompactionTime() []*TenantLastCompactionTime {
+func (x *GetCollectionsRequest) GetMetadataFilters() []*CollectionMetadataFilter {
 	if x != nil {
-		return x.TenantLastCompactionTime
+		return x.MetadataFilters
 	}
 	return nil
 }
 
-type SetLastCompactionTimeForTenantRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GetCollectionsRequest) GetIncludeLogLag() bool {
+	if x != nil {
+		return x.IncludeLogLag
+	}
+	return false
+}
 
-	TenantLastCompactionTime *TenantLastCompactionTime `protobuf:"bytes,1,opt,name=tenant_last_compaction_time,json=tenantLastCompactionTime,proto3" json:"tenant_last_compaction_time,omitempty"`
+func (x *GetCollectionsRequest) GetDimension() int32 {
+	if x != nil && x.Dimension != nil {
+		return *x.Dimension
+	}
+	return 0
 }
 
-func (x *SetLastCompactionTimeForTenantRequest) Reset() {
-	*x = SetLastCompactionTimeForTenantRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_coordinator_proto_msgTypes[29]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetCollectionsRequest) GetDistanceFunction() string {
+	if x != nil && x.DistanceFunction != nil {
+		return *x.DistanceFunction
 	}
+	return ""
 }
 
-func (x *SetLastCompactionTimeForTenantRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetCollectionsRequest) GetFieldMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.FieldMask
+	}
+	return nil
 }
 
-func (*SetLastCompactionTimeForTenantRequest) ProtoMessage() {}
+func (x *GetCollectionsRequest) GetIfNoneMatch() string {
+	if x != nil && x.IfNoneMatch != nil {
+		return *x.IfNoneMatch
+	}
+	return ""
+}
 
-func (x *SetLastCompactionTimeForTenantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_coordinator_proto_msgTypes[29]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetCollectionsRequest) GetMinVersion() int32 {
+	if x != nil && x.MinVersion != nil {
+		return *x.MinVersion
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use SetLastCompactionTimeForTenantRequest.ProtoReflect.Descriptor instead.
-func (*SetLastCompactionTimeForTenantRequest) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{29}
+func (x *GetCollectionsRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
 }
 
-func (x *SetLastCompactionTimeForTenantRequest) GetTenantLastCompactionTime() *TenantLastCompactionTime {
+func (x *GetCollectionsRequest) GetIds() []string {
 	if x != nil {
-		return x.TenantLastCompactionTime
+		return x.Ids
 	}
 	return nil
 }
 
-type FlushSegmentCompactionInfo struct {
+type GetCollectionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SegmentId string                `protobuf:"bytes,1,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
-	FilePaths map[string]*FilePaths `protobuf:"bytes,2,rep,name=file_paths,json=filePaths,proto3" json:"file_paths,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Collections []*Collection `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	Status      *Status       `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Changes whenever the collections of the response do, including their acl
+	// and log lag. Set on 304 responses too.
+	Etag string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	// Set when the page is full, there may be more collections.
+	NextPageToken string `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
-func (x *FlushSegmentCompactionInfo) Reset() {
-	*x = FlushSegmentCompactionInfo{}
+func (x *GetCollectionsResponse) Reset() {
+	*x = GetCollectionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_coordinator_proto_msgTypes[30]
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FlushSegmentCompactionInfo) String() string {
+func (x *GetCollectionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FlushSegmentCompactionInfo) ProtoMessage() {}
+func (*GetCollectionsResponse) ProtoMessage() {}
 
-func (x *FlushSegmentCompactionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_coordinator_proto_msgTypes[30]
+func (x *GetCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1841,54 +2229,86 @@ func (x *FlushSegmentCompactionInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FlushSegmentCompactionInfo.ProtoReflect.Descriptor instead.
-func (*FlushSegmentCompactionInfo) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use GetCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *FlushSegmentCompactionInfo) GetSegmentId() string {
+func (x *GetCollectionsResponse) GetCollections() []*Collection {
 	if x != nil {
-		return x.SegmentId
+		return x.Collections
 	}
-	return ""
+	return nil
 }
 
-func (x *FlushSegmentCompactionInfo) GetFilePaths() map[string]*FilePaths {
+func (x *GetCollectionsResponse) GetStatus() *Status {
 	if x != nil {
-		return x.FilePaths
+		return x.Status
 	}
 	return nil
 }
 
-type FlushCollectionCompactionRequest struct {
+func (x *GetCollectionsResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *GetCollectionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateCollectionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TenantId              string                        `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	CollectionId          string                        `protobuf:"bytes,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
-	LogPosition           int64                         `protobuf:"varint,3,opt,name=log_position,json=logPosition,proto3" json:"log_position,omitempty"`
-	CollectionVersion     int32                         `protobuf:"varint,4,opt,name=collection_version,json=collectionVersion,proto3" json:"collection_version,omitempty"`
-	SegmentCompactionInfo []*FlushSegmentCompactionInfo `protobuf:"bytes,5,rep,name=segment_compaction_info,json=segmentCompactionInfo,proto3" json:"segment_compaction_info,omitempty"`
+	Id        string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      *string `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Dimension *int32  `protobuf:"varint,4,opt,name=dimension,proto3,oneof" json:"dimension,omitempty"`
+	// Types that are assignable to MetadataUpdate:
+	//
+	//	*UpdateCollectionRequest_Metadata
+	//	*UpdateCollectionRequest_ResetMetadata
+	MetadataUpdate isUpdateCollectionRequest_MetadataUpdate `protobuf_oneof:"metadata_update"`
+	// Mark the collection for reindexing, e.g. after its embedding function changed.
+	// Changing the dimension of a collection always marks it.
+	RequestReindex *bool `protobuf:"varint,7,opt,name=request_reindex,json=requestReindex,proto3,oneof" json:"request_reindex,omitempty"`
+	// Changes the number of query nodes the collection is assigned to.
+	ReplicationFactor *int32 `protobuf:"varint,8,opt,name=replication_factor,json=replicationFactor,proto3,oneof" json:"replication_factor,omitempty"`
+	// Fails the update with a 409 unless the collection is still at this
+	// revision, for clients that update what they read.
+	ExpectedRevision *int64 `protobuf:"varint,9,opt,name=expected_revision,json=expectedRevision,proto3,oneof" json:"expected_revision,omitempty"`
+	// An embedding function of the tenant, left as is when unset.
+	//
+	// Types that are assignable to EmbeddingFunctionUpdate:
+	//
+	//	*UpdateCollectionRequest_EmbeddingFunctionId
+	//	*UpdateCollectionRequest_ResetEmbeddingFunction
+	EmbeddingFunctionUpdate isUpdateCollectionRequest_EmbeddingFunctionUpdate `protobuf_oneof:"embedding_function_update"`
 }
 
-func (x *FlushCollectionCompactionRequest) Reset() {
-	*x = FlushCollectionCompactionRequest{}
+func (x *UpdateCollectionRequest) Reset() {
+	*x = UpdateCollectionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_coordinator_proto_msgTypes[31]
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FlushCollectionCompactionRequest) String() string {
+func (x *UpdateCollectionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FlushCollectionCompactionRequest) ProtoMessage() {}
+func (*UpdateCollectionRequest) ProtoMessage() {}
 
-func (x *FlushCollectionCompactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_coordinator_proto_msgTypes[31]
+func (x *UpdateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1899,597 +2319,16135 @@ func (x *FlushCollectionCompactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FlushCollectionCompactionRequest.ProtoReflect.Descriptor instead.
-func (*FlushCollectionCompactionRequest) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use UpdateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *FlushCollectionCompactionRequest) GetTenantId() string {
+func (x *UpdateCollectionRequest) GetId() string {
 	if x != nil {
-		return x.TenantId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *FlushCollectionCompactionRequest) GetCollectionId() string {
-	if x != nil {
-		return x.CollectionId
+func (x *UpdateCollectionRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
 	return ""
 }
 
-func (x *FlushCollectionCompactionRequest) GetLogPosition() int64 {
-	if x != nil {
-		return x.LogPosition
+func (x *UpdateCollectionRequest) GetDimension() int32 {
+	if x != nil && x.Dimension != nil {
+		return *x.Dimension
 	}
 	return 0
 }
 
-func (x *FlushCollectionCompactionRequest) GetCollectionVersion() int32 {
-	if x != nil {
-		return x.CollectionVersion
+func (m *UpdateCollectionRequest) GetMetadataUpdate() isUpdateCollectionRequest_MetadataUpdate {
+	if m != nil {
+		return m.MetadataUpdate
 	}
-	return 0
+	return nil
 }
 
-func (x *FlushCollectionCompactionRequest) GetSegmentCompactionInfo() []*FlushSegmentCompactionInfo {
-	if x != nil {
-		return x.SegmentCompactionInfo
+func (x *UpdateCollectionRequest) GetMetadata() *UpdateMetadata {
+	if x, ok := x.GetMetadataUpdate().(*UpdateCollectionRequest_Metadata); ok {
+		return x.Metadata
 	}
 	return nil
 }
 
-type FlushCollectionCompactionResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *UpdateCollectionRequest) GetResetMetadata() bool {
+	if x, ok := x.GetMetadataUpdate().(*UpdateCollectionRequest_ResetMetadata); ok {
+		return x.ResetMetadata
+	}
+	return false
+}
 
-	CollectionId       string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
-	CollectionVersion  int32  `protobuf:"varint,2,opt,name=collection_version,json=collectionVersion,proto3" json:"collection_version,omitempty"`
-	LastCompactionTime int64  `protobuf:"varint,3,opt,name=last_compaction_time,json=lastCompactionTime,proto3" json:"last_compaction_time,omitempty"`
+func (x *UpdateCollectionRequest) GetRequestReindex() bool {
+	if x != nil && x.RequestReindex != nil {
+		return *x.RequestReindex
+	}
+	return false
 }
 
-func (x *FlushCollectionCompactionResponse) Reset() {
-	*x = FlushCollectionCompactionResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chromadb_proto_coordinator_proto_msgTypes[32]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *UpdateCollectionRequest) GetReplicationFactor() int32 {
+	if x != nil && x.ReplicationFactor != nil {
+		return *x.ReplicationFactor
 	}
+	return 0
 }
 
-func (x *FlushCollectionCompactionResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UpdateCollectionRequest) GetExpectedRevision() int64 {
+	if x != nil && x.ExpectedRevision != nil {
+		return *x.ExpectedRevision
+	}
+	return 0
 }
 
-func (*FlushCollectionCompactionResponse) ProtoMessage() {}
+func (m *UpdateCollectionRequest) GetEmbeddingFunctionUpdate() isUpdateCollectionRequest_EmbeddingFunctionUpdate {
+	if m != nil {
+		return m.EmbeddingFunctionUpdate
+	}
+	return nil
+}
 
-func (x *FlushCollectionCompactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chromadb_proto_coordinator_proto_msgTypes[32]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+func (x *UpdateCollectionRequest) GetEmbeddingFunctionId() string {
+	if x, ok := x.GetEmbeddingFunctionUpdate().(*UpdateCollectionRequest_EmbeddingFunctionId); ok {
+		return x.EmbeddingFunctionId
+	}
+	return ""
+}
+
+func (x *UpdateCollectionRequest) GetResetEmbeddingFunction() bool {
+	if x, ok := x.GetEmbeddingFunctionUpdate().(*UpdateCollectionRequest_ResetEmbeddingFunction); ok {
+		return x.ResetEmbeddingFunction
+	}
+	return false
+}
+
+type isUpdateCollectionRequest_MetadataUpdate interface {
+	isUpdateCollectionRequest_MetadataUpdate()
+}
+
+type UpdateCollectionRequest_Metadata struct {
+	Metadata *UpdateMetadata `protobuf:"bytes,5,opt,name=metadata,proto3,oneof"`
+}
+
+type UpdateCollectionRequest_ResetMetadata struct {
+	ResetMetadata bool `protobuf:"varint,6,opt,name=reset_metadata,json=resetMetadata,proto3,oneof"`
+}
+
+func (*UpdateCollectionRequest_Metadata) isUpdateCollectionRequest_MetadataUpdate() {}
+
+func (*UpdateCollectionRequest_ResetMetadata) isUpdateCollectionRequest_MetadataUpdate() {}
+
+type isUpdateCollectionRequest_EmbeddingFunctionUpdate interface {
+	isUpdateCollectionRequest_EmbeddingFunctionUpdate()
+}
+
+type UpdateCollectionRequest_EmbeddingFunctionId struct {
+	EmbeddingFunctionId string `protobuf:"bytes,10,opt,name=embedding_function_id,json=embeddingFunctionId,proto3,oneof"`
+}
+
+type UpdateCollectionRequest_ResetEmbeddingFunction struct {
+	// Detaches the collection from its embedding function.
+	ResetEmbeddingFunction bool `protobuf:"varint,11,opt,name=reset_embedding_function,json=resetEmbeddingFunction,proto3,oneof"`
+}
+
+func (*UpdateCollectionRequest_EmbeddingFunctionId) isUpdateCollectionRequest_EmbeddingFunctionUpdate() {
+}
+
+func (*UpdateCollectionRequest_ResetEmbeddingFunction) isUpdateCollectionRequest_EmbeddingFunctionUpdate() {
+}
+
+type UpdateCollectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The fields whose value changed, among "name", "dimension", "metadata" and
+	// "replication_factor". Fields set to their current value are not changed,
+	// and the revision is only bumped when a field changed.
+	ChangedFields []string `protobuf:"bytes,2,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"`
+	Revision      int64    `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *UpdateCollectionResponse) Reset() {
+	*x = UpdateCollectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCollectionResponse) ProtoMessage() {}
+
+func (x *UpdateCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCollectionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateCollectionResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *UpdateCollectionResponse) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+func (x *UpdateCollectionResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+// Renames a collection atomically. A name taken by another collection of the
+// database fails with a 409, also when a concurrent rename or create took it
+// first.
+type RenameCollectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Fails the rename with a 409 unless the collection is still at this
+	// revision.
+	ExpectedRevision *int64 `protobuf:"varint,3,opt,name=expected_revision,json=expectedRevision,proto3,oneof" json:"expected_revision,omitempty"`
+}
+
+func (x *RenameCollectionRequest) Reset() {
+	*x = RenameCollectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameCollectionRequest) ProtoMessage() {}
+
+func (x *RenameCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameCollectionRequest.ProtoReflect.Descriptor instead.
+func (*RenameCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RenameCollectionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RenameCollectionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RenameCollectionRequest) GetExpectedRevision() int64 {
+	if x != nil && x.ExpectedRevision != nil {
+		return *x.ExpectedRevision
+	}
+	return 0
+}
+
+type RenameCollectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection *Collection `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Status     *Status     `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *RenameCollectionResponse) Reset() {
+	*x = RenameCollectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameCollectionResponse) ProtoMessage() {}
+
+func (x *RenameCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameCollectionResponse.ProtoReflect.Descriptor instead.
+func (*RenameCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RenameCollectionResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+func (x *RenameCollectionResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type Notification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CollectionId string `protobuf:"bytes,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Type         string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Status       string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Notification) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Notification) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *Notification) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Notification) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ResetStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ResetStateResponse) Reset() {
+	*x = ResetStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetStateResponse) ProtoMessage() {}
+
+func (x *ResetStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetStateResponse.ProtoReflect.Descriptor instead.
+func (*ResetStateResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ResetStateResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type GetLastCompactionTimeForTenantRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId []string `protobuf:"bytes,1,rep,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+}
+
+func (x *GetLastCompactionTimeForTenantRequest) Reset() {
+	*x = GetLastCompactionTimeForTenantRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLastCompactionTimeForTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLastCompactionTimeForTenantRequest) ProtoMessage() {}
+
+func (x *GetLastCompactionTimeForTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLastCompactionTimeForTenantRequest.ProtoReflect.Descriptor instead.
+func (*GetLastCompactionTimeForTenantRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetLastCompactionTimeForTenantRequest) GetTenantId() []string {
+	if x != nil {
+		return x.TenantId
+	}
+	return nil
+}
+
+type TenantLastCompactionTime struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId           string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	LastCompactionTime int64  `protobuf:"varint,2,opt,name=last_compaction_time,json=lastCompactionTime,proto3" json:"last_compaction_time,omitempty"`
+}
+
+func (x *TenantLastCompactionTime) Reset() {
+	*x = TenantLastCompactionTime{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TenantLastCompactionTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantLastCompactionTime) ProtoMessage() {}
+
+func (x *TenantLastCompactionTime) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantLastCompactionTime.ProtoReflect.Descriptor instead.
+func (*TenantLastCompactionTime) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *TenantLastCompactionTime) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TenantLastCompactionTime) GetLastCompactionTime() int64 {
+	if x != nil {
+		return x.LastCompactionTime
+	}
+	return 0
+}
+
+type GetLastCompactionTimeForTenantResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantLastCompactionTime []*TenantLastCompactionTime `protobuf:"bytes,1,rep,name=tenant_last_compaction_time,json=tenantLastCompactionTime,proto3" json:"tenant_last_compaction_time,omitempty"`
+}
+
+func (x *GetLastCompactionTimeForTenantResponse) Reset() {
+	*x = GetLastCompactionTimeForTenantResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLastCompactionTimeForTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLastCompactionTimeForTenantResponse) ProtoMessage() {}
+
+func (x *GetLastCompactionTimeForTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLastCompactionTimeForTenantResponse.ProtoReflect.Descriptor instead.
+func (*GetLastCompactionTimeForTenantResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetLastCompactionTimeForTenantResponse) GetTenantLastCompactionTime() []*TenantLastCompactionTime {
+	if x != nil {
+		return x.TenantLastCompactionTime
+	}
+	return nil
+}
+
+type SetLastCompactionTimeForTenantRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantLastCompactionTime *TenantLastCompactionTime `protobuf:"bytes,1,opt,name=tenant_last_compaction_time,json=tenantLastCompactionTime,proto3" json:"tenant_last_compaction_time,omitempty"`
+}
+
+func (x *SetLastCompactionTimeForTenantRequest) Reset() {
+	*x = SetLastCompactionTimeForTenantRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLastCompactionTimeForTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLastCompactionTimeForTenantRequest) ProtoMessage() {}
+
+func (x *SetLastCompactionTimeForTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLastCompactionTimeForTenantRequest.ProtoReflect.Descriptor instead.
+func (*SetLastCompactionTimeForTenantRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SetLastCompactionTimeForTenantRequest) GetTenantLastCompactionTime() *TenantLastCompactionTime {
+	if x != nil {
+		return x.TenantLastCompactionTime
+	}
+	return nil
+}
+
+type FlushSegmentCompactionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SegmentId string                `protobuf:"bytes,1,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	FilePaths map[string]*FilePaths `protobuf:"bytes,2,rep,name=file_paths,json=filePaths,proto3" json:"file_paths,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *FlushSegmentCompactionInfo) Reset() {
+	*x = FlushSegmentCompactionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlushSegmentCompactionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushSegmentCompactionInfo) ProtoMessage() {}
+
+func (x *FlushSegmentCompactionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushSegmentCompactionInfo.ProtoReflect.Descriptor instead.
+func (*FlushSegmentCompactionInfo) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *FlushSegmentCompactionInfo) GetSegmentId() string {
+	if x != nil {
+		return x.SegmentId
+	}
+	return ""
+}
+
+func (x *FlushSegmentCompactionInfo) GetFilePaths() map[string]*FilePaths {
+	if x != nil {
+		return x.FilePaths
+	}
+	return nil
+}
+
+type FlushCollectionCompactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId                   string                        `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	CollectionId               string                        `protobuf:"bytes,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	LogPosition                int64                         `protobuf:"varint,3,opt,name=log_position,json=logPosition,proto3" json:"log_position,omitempty"`
+	CollectionVersion          int32                         `protobuf:"varint,4,opt,name=collection_version,json=collectionVersion,proto3" json:"collection_version,omitempty"`
+	SegmentCompactionInfo      []*FlushSegmentCompactionInfo `protobuf:"bytes,5,rep,name=segment_compaction_info,json=segmentCompactionInfo,proto3" json:"segment_compaction_info,omitempty"`
+	TotalRecordsPostCompaction *int64                        `protobuf:"varint,6,opt,name=total_records_post_compaction,json=totalRecordsPostCompaction,proto3,oneof" json:"total_records_post_compaction,omitempty"`
+	SizeBytesPostCompaction    *int64                        `protobuf:"varint,7,opt,name=size_bytes_post_compaction,json=sizeBytesPostCompaction,proto3,oneof" json:"size_bytes_post_compaction,omitempty"`
+	// Token from AcquireCollectionFencingToken. The flush is rejected when a newer
+	// token has been issued for the collection since.
+	FencingToken *int64 `protobuf:"varint,8,opt,name=fencing_token,json=fencingToken,proto3,oneof" json:"fencing_token,omitempty"`
+	// Recorded as the last compactor of the collection, see Collection.
+	CompactorId *string `protobuf:"bytes,9,opt,name=compactor_id,json=compactorId,proto3,oneof" json:"compactor_id,omitempty"`
+	// When the compaction pulled the log, in unix milliseconds. Only the
+	// compaction requests made before it are served by the flush. When unset,
+	// every pending request of the collection is.
+	CompactionStartedAt *int64 `protobuf:"varint,10,opt,name=compaction_started_at,json=compactionStartedAt,proto3,oneof" json:"compaction_started_at,omitempty"`
+}
+
+func (x *FlushCollectionCompactionRequest) Reset() {
+	*x = FlushCollectionCompactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlushCollectionCompactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushCollectionCompactionRequest) ProtoMessage() {}
+
+func (x *FlushCollectionCompactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushCollectionCompactionRequest.ProtoReflect.Descriptor instead.
+func (*FlushCollectionCompactionRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *FlushCollectionCompactionRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *FlushCollectionCompactionRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *FlushCollectionCompactionRequest) GetLogPosition() int64 {
+	if x != nil {
+		return x.LogPosition
+	}
+	return 0
+}
+
+func (x *FlushCollectionCompactionRequest) GetCollectionVersion() int32 {
+	if x != nil {
+		return x.CollectionVersion
+	}
+	return 0
+}
+
+func (x *FlushCollectionCompactionRequest) GetSegmentCompactionInfo() []*FlushSegmentCompactionInfo {
+	if x != nil {
+		return x.SegmentCompactionInfo
+	}
+	return nil
+}
+
+func (x *FlushCollectionCompactionRequest) GetTotalRecordsPostCompaction() int64 {
+	if x != nil && x.TotalRecordsPostCompaction != nil {
+		return *x.TotalRecordsPostCompaction
+	}
+	return 0
+}
+
+func (x *FlushCollectionCompactionRequest) GetSizeBytesPostCompaction() int64 {
+	if x != nil && x.SizeBytesPostCompaction != nil {
+		return *x.SizeBytesPostCompaction
+	}
+	return 0
+}
+
+func (x *FlushCollectionCompactionRequest) GetFencingToken() int64 {
+	if x != nil && x.FencingToken != nil {
+		return *x.FencingToken
+	}
+	return 0
+}
+
+func (x *FlushCollectionCompactionRequest) GetCompactorId() string {
+	if x != nil && x.CompactorId != nil {
+		return *x.CompactorId
+	}
+	return ""
+}
+
+func (x *FlushCollectionCompactionRequest) GetCompactionStartedAt() int64 {
+	if x != nil && x.CompactionStartedAt != nil {
+		return *x.CompactionStartedAt
+	}
+	return 0
+}
+
+type FlushCollectionCompactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId       string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	CollectionVersion  int32  `protobuf:"varint,2,opt,name=collection_version,json=collectionVersion,proto3" json:"collection_version,omitempty"`
+	LastCompactionTime int64  `protobuf:"varint,3,opt,name=last_compaction_time,json=lastCompactionTime,proto3" json:"last_compaction_time,omitempty"`
+}
+
+func (x *FlushCollectionCompactionResponse) Reset() {
+	*x = FlushCollectionCompactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlushCollectionCompactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushCollectionCompactionResponse) ProtoMessage() {}
+
+func (x *FlushCollectionCompactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushCollectionCompactionResponse.ProtoReflect.Descriptor instead.
+func (*FlushCollectionCompactionResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *FlushCollectionCompactionResponse) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *FlushCollectionCompactionResponse) GetCollectionVersion() int32 {
+	if x != nil {
+		return x.CollectionVersion
+	}
+	return 0
+}
+
+func (x *FlushCollectionCompactionResponse) GetLastCompactionTime() int64 {
+	if x != nil {
+		return x.LastCompactionTime
+	}
+	return 0
+}
+
+// Called by a compactor or query node when it takes ownership of a collection.
+type AcquireCollectionFencingTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Owner        string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (x *AcquireCollectionFencingTokenRequest) Reset() {
+	*x = AcquireCollectionFencingTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcquireCollectionFencingTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcquireCollectionFencingTokenRequest) ProtoMessage() {}
+
+func (x *AcquireCollectionFencingTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcquireCollectionFencingTokenRequest.ProtoReflect.Descriptor instead.
+func (*AcquireCollectionFencingTokenRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AcquireCollectionFencingTokenRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *AcquireCollectionFencingTokenRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+type AcquireCollectionFencingTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FencingToken int64   `protobuf:"varint,1,opt,name=fencing_token,json=fencingToken,proto3" json:"fencing_token,omitempty"`
+	Status       *Status `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *AcquireCollectionFencingTokenResponse) Reset() {
+	*x = AcquireCollectionFencingTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcquireCollectionFencingTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcquireCollectionFencingTokenResponse) ProtoMessage() {}
+
+func (x *AcquireCollectionFencingTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcquireCollectionFencingTokenResponse.ProtoReflect.Descriptor instead.
+func (*AcquireCollectionFencingTokenResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AcquireCollectionFencingTokenResponse) GetFencingToken() int64 {
+	if x != nil {
+		return x.FencingToken
+	}
+	return 0
+}
+
+func (x *AcquireCollectionFencingTokenResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type GetCollectionsBySizeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant   string                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Database string                `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	OrderBy  CollectionSizeOrderBy `protobuf:"varint,3,opt,name=order_by,json=orderBy,proto3,enum=chroma.CollectionSizeOrderBy" json:"order_by,omitempty"`
+	Limit    int32                 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetCollectionsBySizeRequest) Reset() {
+	*x = GetCollectionsBySizeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionsBySizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionsBySizeRequest) ProtoMessage() {}
+
+func (x *GetCollectionsBySizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionsBySizeRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionsBySizeRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetCollectionsBySizeRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *GetCollectionsBySizeRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *GetCollectionsBySizeRequest) GetOrderBy() CollectionSizeOrderBy {
+	if x != nil {
+		return x.OrderBy
+	}
+	return CollectionSizeOrderBy_TOTAL_RECORDS
+}
+
+func (x *GetCollectionsBySizeRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type CollectionSize struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TotalRecordsPostCompaction int64  `protobuf:"varint,3,opt,name=total_records_post_compaction,json=totalRecordsPostCompaction,proto3" json:"total_records_post_compaction,omitempty"`
+	SizeBytesPostCompaction    int64  `protobuf:"varint,4,opt,name=size_bytes_post_compaction,json=sizeBytesPostCompaction,proto3" json:"size_bytes_post_compaction,omitempty"`
+}
+
+func (x *CollectionSize) Reset() {
+	*x = CollectionSize{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionSize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionSize) ProtoMessage() {}
+
+func (x *CollectionSize) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionSize.ProtoReflect.Descriptor instead.
+func (*CollectionSize) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CollectionSize) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CollectionSize) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CollectionSize) GetTotalRecordsPostCompaction() int64 {
+	if x != nil {
+		return x.TotalRecordsPostCompaction
+	}
+	return 0
+}
+
+func (x *CollectionSize) GetSizeBytesPostCompaction() int64 {
+	if x != nil {
+		return x.SizeBytesPostCompaction
+	}
+	return 0
+}
+
+type GetCollectionsBySizeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collections []*CollectionSize `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	Status      *Status           `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetCollectionsBySizeResponse) Reset() {
+	*x = GetCollectionsBySizeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionsBySizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionsBySizeResponse) ProtoMessage() {}
+
+func (x *GetCollectionsBySizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionsBySizeResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionsBySizeResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetCollectionsBySizeResponse) GetCollections() []*CollectionSize {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *GetCollectionsBySizeResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Leave database empty for an instance wide estimate, which only sets
+// collection_count and includes soft deleted collections.
+type GetApproximateCountsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant   string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Database string `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+}
+
+func (x *GetApproximateCountsRequest) Reset() {
+	*x = GetApproximateCountsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetApproximateCountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApproximateCountsRequest) ProtoMessage() {}
+
+func (x *GetApproximateCountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApproximateCountsRequest.ProtoReflect.Descriptor instead.
+func (*GetApproximateCountsRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetApproximateCountsRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *GetApproximateCountsRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+type GetApproximateCountsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionCount int64   `protobuf:"varint,1,opt,name=collection_count,json=collectionCount,proto3" json:"collection_count,omitempty"`
+	RecordCount     int64   `protobuf:"varint,2,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
+	Status          *Status `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetApproximateCountsResponse) Reset() {
+	*x = GetApproximateCountsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetApproximateCountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApproximateCountsResponse) ProtoMessage() {}
+
+func (x *GetApproximateCountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApproximateCountsResponse.ProtoReflect.Descriptor instead.
+func (*GetApproximateCountsResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetApproximateCountsResponse) GetCollectionCount() int64 {
+	if x != nil {
+		return x.CollectionCount
+	}
+	return 0
+}
+
+func (x *GetApproximateCountsResponse) GetRecordCount() int64 {
+	if x != nil {
+		return x.RecordCount
+	}
+	return 0
+}
+
+func (x *GetApproximateCountsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Counts the collections of a tenant, or of one of its databases, exactly.
+// include_soft_deleted counts the soft deleted collections too.
+type CountCollectionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant             string  `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Database           *string `protobuf:"bytes,2,opt,name=database,proto3,oneof" json:"database,omitempty"`
+	IncludeSoftDeleted bool    `protobuf:"varint,3,opt,name=include_soft_deleted,json=includeSoftDeleted,proto3" json:"include_soft_deleted,omitempty"`
+}
+
+func (x *CountCollectionsRequest) Reset() {
+	*x = CountCollectionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountCollectionsRequest) ProtoMessage() {}
+
+func (x *CountCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*CountCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CountCollectionsRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *CountCollectionsRequest) GetDatabase() string {
+	if x != nil && x.Database != nil {
+		return *x.Database
+	}
+	return ""
+}
+
+func (x *CountCollectionsRequest) GetIncludeSoftDeleted() bool {
+	if x != nil {
+		return x.IncludeSoftDeleted
+	}
+	return false
+}
+
+type CountCollectionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count  int64   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Status *Status `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *CountCollectionsResponse) Reset() {
+	*x = CountCollectionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountCollectionsResponse) ProtoMessage() {}
+
+func (x *CountCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*CountCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CountCollectionsResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CountCollectionsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Scans the live collections of the catalog by id, for backup and indexing
+// jobs, in batches of batch_size, 1 to 1000. resume_token resumes the scan that
+// returned it, after its batch.
+//
+// A scan reads a snapshot of the catalog taken when it started: every batch,
+// resumed ones included, returns the collections that were live then, as they
+// were then, and changes made during the scan are not visible. A scan resumed
+// more than 10 minutes after its last batch fails with status 409 and must be
+// started again without a resume token.
+type ScanCollectionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BatchSize   int32   `protobuf:"varint,1,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	ResumeToken *string `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3,oneof" json:"resume_token,omitempty"`
+}
+
+func (x *ScanCollectionsRequest) Reset() {
+	*x = ScanCollectionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanCollectionsRequest) ProtoMessage() {}
+
+func (x *ScanCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ScanCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ScanCollectionsRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *ScanCollectionsRequest) GetResumeToken() string {
+	if x != nil && x.ResumeToken != nil {
+		return *x.ResumeToken
+	}
+	return ""
+}
+
+// A batch of ScanCollections. The last message of a failed scan carries no
+// collections and the status of the failure.
+type ScanCollectionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collections []*Collection `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	ResumeToken string        `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	Status      *Status       `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ScanCollectionsResponse) Reset() {
+	*x = ScanCollectionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanCollectionsResponse) ProtoMessage() {}
+
+func (x *ScanCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*ScanCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ScanCollectionsResponse) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *ScanCollectionsResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *ScanCollectionsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Collections without segments that were created at least min_age_seconds ago.
+// Defaults to ten minutes when unset.
+type ListIncompleteCollectionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinAgeSeconds *int64 `protobuf:"varint,1,opt,name=min_age_seconds,json=minAgeSeconds,proto3,oneof" json:"min_age_seconds,omitempty"`
+}
+
+func (x *ListIncompleteCollectionsRequest) Reset() {
+	*x = ListIncompleteCollectionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListIncompleteCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIncompleteCollectionsRequest) ProtoMessage() {}
+
+func (x *ListIncompleteCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIncompleteCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListIncompleteCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListIncompleteCollectionsRequest) GetMinAgeSeconds() int64 {
+	if x != nil && x.MinAgeSeconds != nil {
+		return *x.MinAgeSeconds
+	}
+	return 0
+}
+
+type IncompleteCollection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Tenant    string `protobuf:"bytes,3,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Database  string `protobuf:"bytes,4,opt,name=database,proto3" json:"database,omitempty"`
+	CreatedAt int64  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *IncompleteCollection) Reset() {
+	*x = IncompleteCollection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IncompleteCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncompleteCollection) ProtoMessage() {}
+
+func (x *IncompleteCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncompleteCollection.ProtoReflect.Descriptor instead.
+func (*IncompleteCollection) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *IncompleteCollection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IncompleteCollection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IncompleteCollection) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *IncompleteCollection) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *IncompleteCollection) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListIncompleteCollectionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collections []*IncompleteCollection `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	Status      *Status                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ListIncompleteCollectionsResponse) Reset() {
+	*x = ListIncompleteCollectionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListIncompleteCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIncompleteCollectionsResponse) ProtoMessage() {}
+
+func (x *ListIncompleteCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIncompleteCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListIncompleteCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListIncompleteCollectionsResponse) GetCollections() []*IncompleteCollection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *ListIncompleteCollectionsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type RepairIncompleteCollectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Actor string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+}
+
+func (x *RepairIncompleteCollectionRequest) Reset() {
+	*x = RepairIncompleteCollectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepairIncompleteCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairIncompleteCollectionRequest) ProtoMessage() {}
+
+func (x *RepairIncompleteCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairIncompleteCollectionRequest.ProtoReflect.Descriptor instead.
+func (*RepairIncompleteCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RepairIncompleteCollectionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RepairIncompleteCollectionRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type RepairIncompleteCollectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *RepairIncompleteCollectionResponse) Reset() {
+	*x = RepairIncompleteCollectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepairIncompleteCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairIncompleteCollectionResponse) ProtoMessage() {}
+
+func (x *RepairIncompleteCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairIncompleteCollectionResponse.ProtoReflect.Descriptor instead.
+func (*RepairIncompleteCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RepairIncompleteCollectionResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Collections stuck in an intermediate state. Soft deleted collections are only
+// reported once deleted at least min_age_seconds ago, one hour when unset.
+type ListInconsistentCollectionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinAgeSeconds *int64 `protobuf:"varint,1,opt,name=min_age_seconds,json=minAgeSeconds,proto3,oneof" json:"min_age_seconds,omitempty"`
+}
+
+func (x *ListInconsistentCollectionsRequest) Reset() {
+	*x = ListInconsistentCollectionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInconsistentCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInconsistentCollectionsRequest) ProtoMessage() {}
+
+func (x *ListInconsistentCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInconsistentCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListInconsistentCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListInconsistentCollectionsRequest) GetMinAgeSeconds() int64 {
+	if x != nil && x.MinAgeSeconds != nil {
+		return *x.MinAgeSeconds
+	}
+	return 0
+}
+
+type InconsistentCollection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Tenant   string `protobuf:"bytes,3,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Database string `protobuf:"bytes,4,opt,name=database,proto3" json:"database,omitempty"`
+	// One of soft_deleted_with_segments, deleted_segments, missing_segments,
+	// flushed_without_files and log_position_ahead. log_position_ahead is only
+	// found by the leader, ListInconsistentCollections is routed to it.
+	Kind        string   `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`
+	Description string   `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	SegmentIds  []string `protobuf:"bytes,7,rep,name=segment_ids,json=segmentIds,proto3" json:"segment_ids,omitempty"`
+}
+
+func (x *InconsistentCollection) Reset() {
+	*x = InconsistentCollection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InconsistentCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InconsistentCollection) ProtoMessage() {}
+
+func (x *InconsistentCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InconsistentCollection.ProtoReflect.Descriptor instead.
+func (*InconsistentCollection) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *InconsistentCollection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InconsistentCollection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InconsistentCollection) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *InconsistentCollection) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *InconsistentCollection) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *InconsistentCollection) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *InconsistentCollection) GetSegmentIds() []string {
+	if x != nil {
+		return x.SegmentIds
+	}
+	return nil
+}
+
+type ListInconsistentCollectionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collections []*InconsistentCollection `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	Status      *Status                   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ListInconsistentCollectionsResponse) Reset() {
+	*x = ListInconsistentCollectionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInconsistentCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInconsistentCollectionsResponse) ProtoMessage() {}
+
+func (x *ListInconsistentCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInconsistentCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListInconsistentCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListInconsistentCollectionsResponse) GetCollections() []*InconsistentCollection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *ListInconsistentCollectionsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// A message of CheckConsistencyWithProgress, sent after each kind of
+// inconsistency is checked with the collections it found. The last message
+// carries the status of the check.
+type CheckConsistencyProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Progress    *OperationProgress        `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress,omitempty"`
+	Collections []*InconsistentCollection `protobuf:"bytes,2,rep,name=collections,proto3" json:"collections,omitempty"`
+	Status      *Status                   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *CheckConsistencyProgress) Reset() {
+	*x = CheckConsistencyProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckConsistencyProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckConsistencyProgress) ProtoMessage() {}
+
+func (x *CheckConsistencyProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckConsistencyProgress.ProtoReflect.Descriptor instead.
+func (*CheckConsistencyProgress) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CheckConsistencyProgress) GetProgress() *OperationProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *CheckConsistencyProgress) GetCollections() []*InconsistentCollection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *CheckConsistencyProgress) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Clears the reindex marker once the collection was reindexed at dimension. Fails
+// if the dimension changed again while the reindex was running.
+type CompleteCollectionReindexRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Dimension    *int32 `protobuf:"varint,2,opt,name=dimension,proto3,oneof" json:"dimension,omitempty"`
+}
+
+func (x *CompleteCollectionReindexRequest) Reset() {
+	*x = CompleteCollectionReindexRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteCollectionReindexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteCollectionReindexRequest) ProtoMessage() {}
+
+func (x *CompleteCollectionReindexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteCollectionReindexRequest.ProtoReflect.Descriptor instead.
+func (*CompleteCollectionReindexRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CompleteCollectionReindexRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *CompleteCollectionReindexRequest) GetDimension() int32 {
+	if x != nil && x.Dimension != nil {
+		return *x.Dimension
+	}
+	return 0
+}
+
+type CompleteCollectionReindexResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *CompleteCollectionReindexResponse) Reset() {
+	*x = CompleteCollectionReindexResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteCollectionReindexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteCollectionReindexResponse) ProtoMessage() {}
+
+func (x *CompleteCollectionReindexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteCollectionReindexResponse.ProtoReflect.Descriptor instead.
+func (*CompleteCollectionReindexResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CompleteCollectionReindexResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type GetQuerySamplesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetQuerySamplesRequest) Reset() {
+	*x = GetQuerySamplesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuerySamplesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuerySamplesRequest) ProtoMessage() {}
+
+func (x *GetQuerySamplesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuerySamplesRequest.ProtoReflect.Descriptor instead.
+func (*GetQuerySamplesRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{64}
+}
+
+// A statement run by a sampled GetCollections or GetSegments call. Params that
+// may carry user data are replaced by their type.
+type QuerySample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operation   string   `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	Sql         string   `protobuf:"bytes,2,opt,name=sql,proto3" json:"sql,omitempty"`
+	Params      []string `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
+	Rows        int64    `protobuf:"varint,4,opt,name=rows,proto3" json:"rows,omitempty"`
+	DurationUs  int64    `protobuf:"varint,5,opt,name=duration_us,json=durationUs,proto3" json:"duration_us,omitempty"`
+	StartedAtUs int64    `protobuf:"varint,6,opt,name=started_at_us,json=startedAtUs,proto3" json:"started_at_us,omitempty"`
+	Error       string   `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *QuerySample) Reset() {
+	*x = QuerySample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuerySample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySample) ProtoMessage() {}
+
+func (x *QuerySample) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuerySample.ProtoReflect.Descriptor instead.
+func (*QuerySample) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *QuerySample) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *QuerySample) GetSql() string {
+	if x != nil {
+		return x.Sql
+	}
+	return ""
+}
+
+func (x *QuerySample) GetParams() []string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *QuerySample) GetRows() int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *QuerySample) GetDurationUs() int64 {
+	if x != nil {
+		return x.DurationUs
+	}
+	return 0
+}
+
+func (x *QuerySample) GetStartedAtUs() int64 {
+	if x != nil {
+		return x.StartedAtUs
+	}
+	return 0
+}
+
+func (x *QuerySample) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetQuerySamplesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Samples []*QuerySample `protobuf:"bytes,1,rep,name=samples,proto3" json:"samples,omitempty"`
+	Status  *Status        `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetQuerySamplesResponse) Reset() {
+	*x = GetQuerySamplesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuerySamplesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuerySamplesResponse) ProtoMessage() {}
+
+func (x *GetQuerySamplesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuerySamplesResponse.ProtoReflect.Descriptor instead.
+func (*GetQuerySamplesResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetQuerySamplesResponse) GetSamples() []*QuerySample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+func (x *GetQuerySamplesResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// The outcome of a batch operation for one collection.
+type CollectionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId string  `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Status       *Status `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *CollectionResult) Reset() {
+	*x = CollectionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionResult) ProtoMessage() {}
+
+func (x *CollectionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionResult.ProtoReflect.Descriptor instead.
+func (*CollectionResult) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CollectionResult) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *CollectionResult) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Soft deletes up to 1000 collections of a database. The collections are deleted
+// independently and results has one entry per id, in request order.
+type DeleteCollectionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant   string   `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Database string   `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	Ids      []string `protobuf:"bytes,3,rep,name=ids,proto3" json:"ids,omitempty"`
+	Actor    string   `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+}
+
+func (x *DeleteCollectionsRequest) Reset() {
+	*x = DeleteCollectionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionsRequest) ProtoMessage() {}
+
+func (x *DeleteCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DeleteCollectionsRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *DeleteCollectionsRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *DeleteCollectionsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *DeleteCollectionsRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type DeleteCollectionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*CollectionResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Status  *Status             `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *DeleteCollectionsResponse) Reset() {
+	*x = DeleteCollectionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionsResponse) ProtoMessage() {}
+
+func (x *DeleteCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DeleteCollectionsResponse) GetResults() []*CollectionResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *DeleteCollectionsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Undoes the soft delete of a collection that was not purged yet. Soft deleted
+// collections give up their name, so restoring fails with 409 when a collection
+// with the original name was created in the meantime; set name to restore the
+// collection under another one.
+type RestoreCollectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+}
+
+func (x *RestoreCollectionRequest) Reset() {
+	*x = RestoreCollectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCollectionRequest) ProtoMessage() {}
+
+func (x *RestoreCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCollectionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *RestoreCollectionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreCollectionRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type RestoreCollectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection *Collection `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Status     *Status     `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *RestoreCollectionResponse) Reset() {
+	*x = RestoreCollectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCollectionResponse) ProtoMessage() {}
+
+func (x *RestoreCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCollectionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RestoreCollectionResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+func (x *RestoreCollectionResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Sets the keys of set_metadata and deletes delete_keys on every collection,
+// leaving their other keys as is. The collections are patched independently and
+// results has one entry per collection id, in request order.
+type BatchUpdateCollectionMetadataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionIds []string        `protobuf:"bytes,1,rep,name=collection_ids,json=collectionIds,proto3" json:"collection_ids,omitempty"`
+	SetMetadata   *UpdateMetadata `protobuf:"bytes,2,opt,name=set_metadata,json=setMetadata,proto3" json:"set_metadata,omitempty"`
+	DeleteKeys    []string        `protobuf:"bytes,3,rep,name=delete_keys,json=deleteKeys,proto3" json:"delete_keys,omitempty"`
+}
+
+func (x *BatchUpdateCollectionMetadataRequest) Reset() {
+	*x = BatchUpdateCollectionMetadataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchUpdateCollectionMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateCollectionMetadataRequest) ProtoMessage() {}
+
+func (x *BatchUpdateCollectionMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateCollectionMetadataRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateCollectionMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *BatchUpdateCollectionMetadataRequest) GetCollectionIds() []string {
+	if x != nil {
+		return x.CollectionIds
+	}
+	return nil
+}
+
+func (x *BatchUpdateCollectionMetadataRequest) GetSetMetadata() *UpdateMetadata {
+	if x != nil {
+		return x.SetMetadata
+	}
+	return nil
+}
+
+func (x *BatchUpdateCollectionMetadataRequest) GetDeleteKeys() []string {
+	if x != nil {
+		return x.DeleteKeys
+	}
+	return nil
+}
+
+type BatchUpdateCollectionMetadataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*CollectionResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Status  *Status             `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *BatchUpdateCollectionMetadataResponse) Reset() {
+	*x = BatchUpdateCollectionMetadataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchUpdateCollectionMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateCollectionMetadataResponse) ProtoMessage() {}
+
+func (x *BatchUpdateCollectionMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateCollectionMetadataResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateCollectionMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *BatchUpdateCollectionMetadataResponse) GetResults() []*CollectionResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchUpdateCollectionMetadataResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Replaces the permissions of principal on the collection.
+type SetCollectionAclEntryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId string              `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Entry        *CollectionAclEntry `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *SetCollectionAclEntryRequest) Reset() {
+	*x = SetCollectionAclEntryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCollectionAclEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollectionAclEntryRequest) ProtoMessage() {}
+
+func (x *SetCollectionAclEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCollectionAclEntryRequest.ProtoReflect.Descriptor instead.
+func (*SetCollectionAclEntryRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SetCollectionAclEntryRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *SetCollectionAclEntryRequest) GetEntry() *CollectionAclEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type SetCollectionAclEntryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *SetCollectionAclEntryResponse) Reset() {
+	*x = SetCollectionAclEntryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCollectionAclEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollectionAclEntryResponse) ProtoMessage() {}
+
+func (x *SetCollectionAclEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCollectionAclEntryResponse.ProtoReflect.Descriptor instead.
+func (*SetCollectionAclEntryResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SetCollectionAclEntryResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type DeleteCollectionAclEntryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Principal    string `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
+}
+
+func (x *DeleteCollectionAclEntryRequest) Reset() {
+	*x = DeleteCollectionAclEntryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCollectionAclEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionAclEntryRequest) ProtoMessage() {}
+
+func (x *DeleteCollectionAclEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionAclEntryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionAclEntryRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DeleteCollectionAclEntryRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *DeleteCollectionAclEntryRequest) GetPrincipal() string {
+	if x != nil {
+		return x.Principal
+	}
+	return ""
+}
+
+type DeleteCollectionAclEntryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *DeleteCollectionAclEntryResponse) Reset() {
+	*x = DeleteCollectionAclEntryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCollectionAclEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionAclEntryResponse) ProtoMessage() {}
+
+func (x *DeleteCollectionAclEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionAclEntryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionAclEntryResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DeleteCollectionAclEntryResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type GetCollectionAclRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+}
+
+func (x *GetCollectionAclRequest) Reset() {
+	*x = GetCollectionAclRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionAclRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionAclRequest) ProtoMessage() {}
+
+func (x *GetCollectionAclRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionAclRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionAclRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetCollectionAclRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+type GetCollectionAclResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*CollectionAclEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Status  *Status               `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetCollectionAclResponse) Reset() {
+	*x = GetCollectionAclResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionAclResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionAclResponse) ProtoMessage() {}
+
+func (x *GetCollectionAclResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionAclResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionAclResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetCollectionAclResponse) GetEntries() []*CollectionAclEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetCollectionAclResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Collections, live or soft deleted, last updated at least older_than_seconds ago
+// with at least min_versions versions. Pages are ordered by collection id; pass
+// the next_start_after of a response as start_after to get the next page. The
+// limit defaults to 100 and is capped at 1000.
+type GetCollectionsToGcRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OlderThanSeconds *int64  `protobuf:"varint,1,opt,name=older_than_seconds,json=olderThanSeconds,proto3,oneof" json:"older_than_seconds,omitempty"`
+	TenantId         *string `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	MinVersions      *int32  `protobuf:"varint,3,opt,name=min_versions,json=minVersions,proto3,oneof" json:"min_versions,omitempty"`
+	Limit            *int32  `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	StartAfter       *string `protobuf:"bytes,5,opt,name=start_after,json=startAfter,proto3,oneof" json:"start_after,omitempty"`
+	// The next_page_token of the previous page, instead of start_after. Tokens
+	// are signed and bound to the filters of the request they were returned for.
+	PageToken *string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+}
+
+func (x *GetCollectionsToGcRequest) Reset() {
+	*x = GetCollectionsToGcRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionsToGcRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionsToGcRequest) ProtoMessage() {}
+
+func (x *GetCollectionsToGcRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionsToGcRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionsToGcRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetCollectionsToGcRequest) GetOlderThanSeconds() int64 {
+	if x != nil && x.OlderThanSeconds != nil {
+		return *x.OlderThanSeconds
+	}
+	return 0
+}
+
+func (x *GetCollectionsToGcRequest) GetTenantId() string {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return ""
+}
+
+func (x *GetCollectionsToGcRequest) GetMinVersions() int32 {
+	if x != nil && x.MinVersions != nil {
+		return *x.MinVersions
+	}
+	return 0
+}
+
+func (x *GetCollectionsToGcRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *GetCollectionsToGcRequest) GetStartAfter() string {
+	if x != nil && x.StartAfter != nil {
+		return *x.StartAfter
+	}
+	return ""
+}
+
+func (x *GetCollectionsToGcRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+type CollectionToGc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TenantId  string `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Version   int32  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	UpdatedAt int64  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	IsDeleted bool   `protobuf:"varint,6,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
+}
+
+func (x *CollectionToGc) Reset() {
+	*x = CollectionToGc{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionToGc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionToGc) ProtoMessage() {}
+
+func (x *CollectionToGc) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionToGc.ProtoReflect.Descriptor instead.
+func (*CollectionToGc) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CollectionToGc) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CollectionToGc) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CollectionToGc) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CollectionToGc) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CollectionToGc) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *CollectionToGc) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+type GetCollectionsToGcResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collections []*CollectionToGc `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	// Unset on the last page.
+	NextStartAfter *string `protobuf:"bytes,2,opt,name=next_start_after,json=nextStartAfter,proto3,oneof" json:"next_start_after,omitempty"`
+	Status         *Status `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// Unset on the last page.
+	NextPageToken string `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetCollectionsToGcResponse) Reset() {
+	*x = GetCollectionsToGcResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionsToGcResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionsToGcResponse) ProtoMessage() {}
+
+func (x *GetCollectionsToGcResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionsToGcResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionsToGcResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetCollectionsToGcResponse) GetCollections() []*CollectionToGc {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *GetCollectionsToGcResponse) GetNextStartAfter() string {
+	if x != nil && x.NextStartAfter != nil {
+		return *x.NextStartAfter
+	}
+	return ""
+}
+
+func (x *GetCollectionsToGcResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetCollectionsToGcResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Limits of a tenant. An unset limit is unlimited.
+type TenantQuota struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxCollections *int64 `protobuf:"varint,1,opt,name=max_collections,json=maxCollections,proto3,oneof" json:"max_collections,omitempty"`
+	MaxRecords     *int64 `protobuf:"varint,2,opt,name=max_records,json=maxRecords,proto3,oneof" json:"max_records,omitempty"`
+	MaxSizeBytes   *int64 `protobuf:"varint,3,opt,name=max_size_bytes,json=maxSizeBytes,proto3,oneof" json:"max_size_bytes,omitempty"`
+}
+
+func (x *TenantQuota) Reset() {
+	*x = TenantQuota{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TenantQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantQuota) ProtoMessage() {}
+
+func (x *TenantQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantQuota.ProtoReflect.Descriptor instead.
+func (*TenantQuota) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *TenantQuota) GetMaxCollections() int64 {
+	if x != nil && x.MaxCollections != nil {
+		return *x.MaxCollections
+	}
+	return 0
+}
+
+func (x *TenantQuota) GetMaxRecords() int64 {
+	if x != nil && x.MaxRecords != nil {
+		return *x.MaxRecords
+	}
+	return 0
+}
+
+func (x *TenantQuota) GetMaxSizeBytes() int64 {
+	if x != nil && x.MaxSizeBytes != nil {
+		return *x.MaxSizeBytes
+	}
+	return 0
+}
+
+// Replaces the limits of a tenant, so limits left unset are removed.
+type SetTenantQuotaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant string       `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Quota  *TenantQuota `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota,omitempty"`
+}
+
+func (x *SetTenantQuotaRequest) Reset() {
+	*x = SetTenantQuotaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTenantQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTenantQuotaRequest) ProtoMessage() {}
+
+func (x *SetTenantQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTenantQuotaRequest.ProtoReflect.Descriptor instead.
+func (*SetTenantQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SetTenantQuotaRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *SetTenantQuotaRequest) GetQuota() *TenantQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type SetTenantQuotaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *SetTenantQuotaResponse) Reset() {
+	*x = SetTenantQuotaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTenantQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTenantQuotaResponse) ProtoMessage() {}
+
+func (x *SetTenantQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTenantQuotaResponse.ProtoReflect.Descriptor instead.
+func (*SetTenantQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SetTenantQuotaResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type GetTenantQuotaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+}
+
+func (x *GetTenantQuotaRequest) Reset() {
+	*x = GetTenantQuotaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTenantQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantQuotaRequest) ProtoMessage() {}
+
+func (x *GetTenantQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantQuotaRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetTenantQuotaRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+type GetTenantQuotaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Quota  *TenantQuota `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	Status *Status      `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetTenantQuotaResponse) Reset() {
+	*x = GetTenantQuotaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTenantQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantQuotaResponse) ProtoMessage() {}
+
+func (x *GetTenantQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantQuotaResponse.ProtoReflect.Descriptor instead.
+func (*GetTenantQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetTenantQuotaResponse) GetQuota() *TenantQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *GetTenantQuotaResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// The projected change in usage of a write. Records and bytes are checked
+// against counters maintained on compaction, and usage is cached for a few
+// seconds, so the check is approximate.
+type CheckQuotaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant           string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	CollectionsDelta int64  `protobuf:"varint,2,opt,name=collections_delta,json=collectionsDelta,proto3" json:"collections_delta,omitempty"`
+	RecordsDelta     int64  `protobuf:"varint,3,opt,name=records_delta,json=recordsDelta,proto3" json:"records_delta,omitempty"`
+	SizeBytesDelta   int64  `protobuf:"varint,4,opt,name=size_bytes_delta,json=sizeBytesDelta,proto3" json:"size_bytes_delta,omitempty"`
+	// The database the write goes to. When set, the write is also checked against
+	// the usage of the database for the limits the database overrides.
+	Database string `protobuf:"bytes,5,opt,name=database,proto3" json:"database,omitempty"`
+	// The collection the write goes to. When set, the write is also checked
+	// against the size of the collection for the limits of the template it was
+	// created from.
+	CollectionId *string `protobuf:"bytes,6,opt,name=collection_id,json=collectionId,proto3,oneof" json:"collection_id,omitempty"`
+}
+
+func (x *CheckQuotaRequest) Reset() {
+	*x = CheckQuotaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckQuotaRequest) ProtoMessage() {}
+
+func (x *CheckQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckQuotaRequest.ProtoReflect.Descriptor instead.
+func (*CheckQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *CheckQuotaRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *CheckQuotaRequest) GetCollectionsDelta() int64 {
+	if x != nil {
+		return x.CollectionsDelta
+	}
+	return 0
+}
+
+func (x *CheckQuotaRequest) GetRecordsDelta() int64 {
+	if x != nil {
+		return x.RecordsDelta
+	}
+	return 0
+}
+
+func (x *CheckQuotaRequest) GetSizeBytesDelta() int64 {
+	if x != nil {
+		return x.SizeBytesDelta
+	}
+	return 0
+}
+
+func (x *CheckQuotaRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *CheckQuotaRequest) GetCollectionId() string {
+	if x != nil && x.CollectionId != nil {
+		return *x.CollectionId
+	}
+	return ""
+}
+
+type QuotaViolation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resource string `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Limit    int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Usage    int64  `protobuf:"varint,3,opt,name=usage,proto3" json:"usage,omitempty"`
+	Delta    int64  `protobuf:"varint,4,opt,name=delta,proto3" json:"delta,omitempty"`
+	// Set when the limit is one the database overrides.
+	Database string `protobuf:"bytes,5,opt,name=database,proto3" json:"database,omitempty"`
+	// Set when the limit is the one of the template of the collection.
+	CollectionId *string `protobuf:"bytes,6,opt,name=collection_id,json=collectionId,proto3,oneof" json:"collection_id,omitempty"`
+}
+
+func (x *QuotaViolation) Reset() {
+	*x = QuotaViolation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuotaViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaViolation) ProtoMessage() {}
+
+func (x *QuotaViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaViolation.ProtoReflect.Descriptor instead.
+func (*QuotaViolation) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *QuotaViolation) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *QuotaViolation) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *QuotaViolation) GetUsage() int64 {
+	if x != nil {
+		return x.Usage
+	}
+	return 0
+}
+
+func (x *QuotaViolation) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *QuotaViolation) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *QuotaViolation) GetCollectionId() string {
+	if x != nil && x.CollectionId != nil {
+		return *x.CollectionId
+	}
+	return ""
+}
+
+type CheckQuotaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Allowed    bool              `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Violations []*QuotaViolation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
+	Status     *Status           `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *CheckQuotaResponse) Reset() {
+	*x = CheckQuotaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckQuotaResponse) ProtoMessage() {}
+
+func (x *CheckQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckQuotaResponse.ProtoReflect.Descriptor instead.
+func (*CheckQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *CheckQuotaResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *CheckQuotaResponse) GetViolations() []*QuotaViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+func (x *CheckQuotaResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Replaces the limits a database overrides. Limits left unset are inherited
+// from the tenant.
+type SetDatabaseQuotaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant   string       `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Database string       `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	Quota    *TenantQuota `protobuf:"bytes,3,opt,name=quota,proto3" json:"quota,omitempty"`
+}
+
+func (x *SetDatabaseQuotaRequest) Reset() {
+	*x = SetDatabaseQuotaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDatabaseQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDatabaseQuotaRequest) ProtoMessage() {}
+
+func (x *SetDatabaseQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDatabaseQuotaRequest.ProtoReflect.Descriptor instead.
+func (*SetDatabaseQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *SetDatabaseQuotaRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *SetDatabaseQuotaRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *SetDatabaseQuotaRequest) GetQuota() *TenantQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type SetDatabaseQuotaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *SetDatabaseQuotaResponse) Reset() {
+	*x = SetDatabaseQuotaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDatabaseQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDatabaseQuotaResponse) ProtoMessage() {}
+
+func (x *SetDatabaseQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDatabaseQuotaResponse.ProtoReflect.Descriptor instead.
+func (*SetDatabaseQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *SetDatabaseQuotaResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type GetEffectiveQuotaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant   string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Database string `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+}
+
+func (x *GetEffectiveQuotaRequest) Reset() {
+	*x = GetEffectiveQuotaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEffectiveQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEffectiveQuotaRequest) ProtoMessage() {}
+
+func (x *GetEffectiveQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEffectiveQuotaRequest.ProtoReflect.Descriptor instead.
+func (*GetEffectiveQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetEffectiveQuotaRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *GetEffectiveQuotaRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+// The limits that apply to a database: those it overrides and the limits of its
+// tenant otherwise. overridden lists the resources whose limit is set on the
+// database.
+type GetEffectiveQuotaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Quota      *TenantQuota `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	Overridden []string     `protobuf:"bytes,2,rep,name=overridden,proto3" json:"overridden,omitempty"`
+	Status     *Status      `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetEffectiveQuotaResponse) Reset() {
+	*x = GetEffectiveQuotaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEffectiveQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEffectiveQuotaResponse) ProtoMessage() {}
+
+func (x *GetEffectiveQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEffectiveQuotaResponse.ProtoReflect.Descriptor instead.
+func (*GetEffectiveQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetEffectiveQuotaResponse) GetQuota() *TenantQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *GetEffectiveQuotaResponse) GetOverridden() []string {
+	if x != nil {
+		return x.Overridden
+	}
+	return nil
+}
+
+func (x *GetEffectiveQuotaResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// The log instance serving a collection. An empty log_address means the default
+// log. version is 0 for collections that were never routed and increases on
+// every change; updated_at is in unix milliseconds.
+type CollectionLogRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	LogAddress   string `protobuf:"bytes,2,opt,name=log_address,json=logAddress,proto3" json:"log_address,omitempty"`
+	Version      int64  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	UpdatedAt    int64  `protobuf:"varint,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// The revision of the last change of the route, 0 when it was never routed.
+	Revision int64 `protobuf:"varint,5,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *CollectionLogRoute) Reset() {
+	*x = CollectionLogRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionLogRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionLogRoute) ProtoMessage() {}
+
+func (x *CollectionLogRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionLogRoute.ProtoReflect.Descriptor instead.
+func (*CollectionLogRoute) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *CollectionLogRoute) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *CollectionLogRoute) GetLogAddress() string {
+	if x != nil {
+		return x.LogAddress
+	}
+	return ""
+}
+
+func (x *CollectionLogRoute) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CollectionLogRoute) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *CollectionLogRoute) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type SetCollectionLogRouteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	LogAddress   string `protobuf:"bytes,2,opt,name=log_address,json=logAddress,proto3" json:"log_address,omitempty"`
+}
+
+func (x *SetCollectionLogRouteRequest) Reset() {
+	*x = SetCollectionLogRouteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCollectionLogRouteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollectionLogRouteRequest) ProtoMessage() {}
+
+func (x *SetCollectionLogRouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCollectionLogRouteRequest.ProtoReflect.Descriptor instead.
+func (*SetCollectionLogRouteRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *SetCollectionLogRouteRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *SetCollectionLogRouteRequest) GetLogAddress() string {
+	if x != nil {
+		return x.LogAddress
+	}
+	return ""
+}
+
+type SetCollectionLogRouteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Route  *CollectionLogRoute `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	Status *Status             `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *SetCollectionLogRouteResponse) Reset() {
+	*x = SetCollectionLogRouteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCollectionLogRouteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollectionLogRouteResponse) ProtoMessage() {}
+
+func (x *SetCollectionLogRouteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCollectionLogRouteResponse.ProtoReflect.Descriptor instead.
+func (*SetCollectionLogRouteResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *SetCollectionLogRouteResponse) GetRoute() *CollectionLogRoute {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *SetCollectionLogRouteResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Returns a route for each of collection_ids and, when changed_after is set,
+// also every route changed after that revision. Frontends cache the routes and
+// pass the as_of revision of the previous response as changed_after to
+// invalidate their cache. Revisions order the changes of all the routes, a
+// change may be returned twice but is never missed.
+type ResolveCollectionLogRoutesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionIds []string `protobuf:"bytes,1,rep,name=collection_ids,json=collectionIds,proto3" json:"collection_ids,omitempty"`
+	ChangedAfter  *int64   `protobuf:"varint,2,opt,name=changed_after,json=changedAfter,proto3,oneof" json:"changed_after,omitempty"`
+}
+
+func (x *ResolveCollectionLogRoutesRequest) Reset() {
+	*x = ResolveCollectionLogRoutesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveCollectionLogRoutesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveCollectionLogRoutesRequest) ProtoMessage() {}
+
+func (x *ResolveCollectionLogRoutesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveCollectionLogRoutesRequest.ProtoReflect.Descriptor instead.
+func (*ResolveCollectionLogRoutesRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ResolveCollectionLogRoutesRequest) GetCollectionIds() []string {
+	if x != nil {
+		return x.CollectionIds
+	}
+	return nil
+}
+
+func (x *ResolveCollectionLogRoutesRequest) GetChangedAfter() int64 {
+	if x != nil && x.ChangedAfter != nil {
+		return *x.ChangedAfter
+	}
+	return 0
+}
+
+type ResolveCollectionLogRoutesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Routes []*CollectionLogRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	AsOf   int64                 `protobuf:"varint,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	Status *Status               `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ResolveCollectionLogRoutesResponse) Reset() {
+	*x = ResolveCollectionLogRoutesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveCollectionLogRoutesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveCollectionLogRoutesResponse) ProtoMessage() {}
+
+func (x *ResolveCollectionLogRoutesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveCollectionLogRoutesResponse.ProtoReflect.Descriptor instead.
+func (*ResolveCollectionLogRoutesResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ResolveCollectionLogRoutesResponse) GetRoutes() []*CollectionLogRoute {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+func (x *ResolveCollectionLogRoutesResponse) GetAsOf() int64 {
+	if x != nil {
+		return x.AsOf
+	}
+	return 0
+}
+
+func (x *ResolveCollectionLogRoutesResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Tells for each of collection_ids whether the collection exists, e.g. for the
+// log service to find the logs of dropped collections. At most 1000 ids.
+type CheckCollectionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionIds []string `protobuf:"bytes,1,rep,name=collection_ids,json=collectionIds,proto3" json:"collection_ids,omitempty"`
+}
+
+func (x *CheckCollectionsRequest) Reset() {
+	*x = CheckCollectionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckCollectionsRequest) ProtoMessage() {}
+
+func (x *CheckCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*CheckCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *CheckCollectionsRequest) GetCollectionIds() []string {
+	if x != nil {
+		return x.CollectionIds
+	}
+	return nil
+}
+
+type CollectionExistence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Exists       bool   `protobuf:"varint,2,opt,name=exists,proto3" json:"exists,omitempty"`
+	IsDeleted    bool   `protobuf:"varint,3,opt,name=is_deleted,json=isDeleted,proto3" json:"is_deleted,omitempty"`
+}
+
+func (x *CollectionExistence) Reset() {
+	*x = CollectionExistence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionExistence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionExistence) ProtoMessage() {}
+
+func (x *CollectionExistence) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionExistence.ProtoReflect.Descriptor instead.
+func (*CollectionExistence) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *CollectionExistence) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *CollectionExistence) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+func (x *CollectionExistence) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+type CheckCollectionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collections []*CollectionExistence `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	Status      *Status                `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *CheckCollectionsResponse) Reset() {
+	*x = CheckCollectionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckCollectionsResponse) ProtoMessage() {}
+
+func (x *CheckCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*CheckCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *CheckCollectionsResponse) GetCollections() []*CollectionExistence {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *CheckCollectionsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Queues a collection to be compacted ahead of the usual thresholds, e.g. after
+// a bulk load. The next flush of the collection takes it off the queue.
+type RequestCompactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Actor        string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+}
+
+func (x *RequestCompactionRequest) Reset() {
+	*x = RequestCompactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestCompactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestCompactionRequest) ProtoMessage() {}
+
+func (x *RequestCompactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestCompactionRequest.ProtoReflect.Descriptor instead.
+func (*RequestCompactionRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *RequestCompactionRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *RequestCompactionRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type RequestCompactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *RequestCompactionResponse) Reset() {
+	*x = RequestCompactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestCompactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestCompactionResponse) ProtoMessage() {}
+
+func (x *RequestCompactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestCompactionResponse.ProtoReflect.Descriptor instead.
+func (*RequestCompactionResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *RequestCompactionResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// requested_at is in unix seconds.
+type CompactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Tenant       string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	RequestedBy  string `protobuf:"bytes,3,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	RequestedAt  int64  `protobuf:"varint,4,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	// The last compactor of the collection and when it compacted it, see
+	// Collection. Unset until the first flush that reported its compactor.
+	LastCompactor   *string `protobuf:"bytes,5,opt,name=last_compactor,json=lastCompactor,proto3,oneof" json:"last_compactor,omitempty"`
+	LastCompactedAt *int64  `protobuf:"varint,6,opt,name=last_compacted_at,json=lastCompactedAt,proto3,oneof" json:"last_compacted_at,omitempty"`
+}
+
+func (x *CompactionRequest) Reset() {
+	*x = CompactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactionRequest) ProtoMessage() {}
+
+func (x *CompactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompactionRequest.ProtoReflect.Descriptor instead.
+func (*CompactionRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *CompactionRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *CompactionRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *CompactionRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *CompactionRequest) GetRequestedAt() int64 {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return 0
+}
+
+func (x *CompactionRequest) GetLastCompactor() string {
+	if x != nil && x.LastCompactor != nil {
+		return *x.LastCompactor
+	}
+	return ""
+}
+
+func (x *CompactionRequest) GetLastCompactedAt() int64 {
+	if x != nil && x.LastCompactedAt != nil {
+		return *x.LastCompactedAt
+	}
+	return 0
+}
+
+// Returns the queued collections, longest queued first. The limit defaults to
+// 100 and is capped at 1000.
+type GetCompactionRequestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit *int32 `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+}
+
+func (x *GetCompactionRequestsRequest) Reset() {
+	*x = GetCompactionRequestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCompactionRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompactionRequestsRequest) ProtoMessage() {}
+
+func (x *GetCompactionRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompactionRequestsRequest.ProtoReflect.Descriptor instead.
+func (*GetCompactionRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetCompactionRequestsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type GetCompactionRequestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*CompactionRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	Status   *Status              `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetCompactionRequestsResponse) Reset() {
+	*x = GetCompactionRequestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCompactionRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompactionRequestsResponse) ProtoMessage() {}
+
+func (x *GetCompactionRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompactionRequestsResponse.ProtoReflect.Descriptor instead.
+func (*GetCompactionRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetCompactionRequestsResponse) GetRequests() []*CompactionRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *GetCompactionRequestsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type SegmentFile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SegmentId    string `protobuf:"bytes,1,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	CollectionId string `protobuf:"bytes,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	FileType     string `protobuf:"bytes,3,opt,name=file_type,json=fileType,proto3" json:"file_type,omitempty"`
+	Path         string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	// Unset when the file was not hashed.
+	ContentHash *string `protobuf:"bytes,5,opt,name=content_hash,json=contentHash,proto3,oneof" json:"content_hash,omitempty"`
+	// Number of files of other collections with the same content. Only set by
+	// GetCollectionSegmentFiles, and left unset for the files that were not
+	// hashed since whether they are shared is unknown.
+	OtherCollections *int64 `protobuf:"varint,6,opt,name=other_collections,json=otherCollections,proto3,oneof" json:"other_collections,omitempty"`
+	SizeBytes        int64  `protobuf:"varint,7,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+}
+
+func (x *SegmentFile) Reset() {
+	*x = SegmentFile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SegmentFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentFile) ProtoMessage() {}
+
+func (x *SegmentFile) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentFile.ProtoReflect.Descriptor instead.
+func (*SegmentFile) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *SegmentFile) GetSegmentId() string {
+	if x != nil {
+		return x.SegmentId
+	}
+	return ""
+}
+
+func (x *SegmentFile) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *SegmentFile) GetFileType() string {
+	if x != nil {
+		return x.FileType
+	}
+	return ""
+}
+
+func (x *SegmentFile) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SegmentFile) GetContentHash() string {
+	if x != nil && x.ContentHash != nil {
+		return *x.ContentHash
+	}
+	return ""
+}
+
+func (x *SegmentFile) GetOtherCollections() int64 {
+	if x != nil && x.OtherCollections != nil {
+		return *x.OtherCollections
+	}
+	return 0
+}
+
+func (x *SegmentFile) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+// Content hashes shared by more than one segment file, of the tenant when set.
+// At most limit hashes are returned, 100 when unset.
+type ListDuplicateSegmentFilesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant *string `protobuf:"bytes,1,opt,name=tenant,proto3,oneof" json:"tenant,omitempty"`
+	Limit  *int32  `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+}
+
+func (x *ListDuplicateSegmentFilesRequest) Reset() {
+	*x = ListDuplicateSegmentFilesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDuplicateSegmentFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDuplicateSegmentFilesRequest) ProtoMessage() {}
+
+func (x *ListDuplicateSegmentFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDuplicateSegmentFilesRequest.ProtoReflect.Descriptor instead.
+func (*ListDuplicateSegmentFilesRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ListDuplicateSegmentFilesRequest) GetTenant() string {
+	if x != nil && x.Tenant != nil {
+		return *x.Tenant
+	}
+	return ""
+}
+
+func (x *ListDuplicateSegmentFilesRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type DuplicateSegmentFiles struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContentHash string         `protobuf:"bytes,1,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	Files       []*SegmentFile `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+}
+
+func (x *DuplicateSegmentFiles) Reset() {
+	*x = DuplicateSegmentFiles{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DuplicateSegmentFiles) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateSegmentFiles) ProtoMessage() {}
+
+func (x *DuplicateSegmentFiles) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateSegmentFiles.ProtoReflect.Descriptor instead.
+func (*DuplicateSegmentFiles) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *DuplicateSegmentFiles) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+func (x *DuplicateSegmentFiles) GetFiles() []*SegmentFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type ListDuplicateSegmentFilesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Duplicates []*DuplicateSegmentFiles `protobuf:"bytes,1,rep,name=duplicates,proto3" json:"duplicates,omitempty"`
+	Status     *Status                  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ListDuplicateSegmentFilesResponse) Reset() {
+	*x = ListDuplicateSegmentFilesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDuplicateSegmentFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDuplicateSegmentFilesResponse) ProtoMessage() {}
+
+func (x *ListDuplicateSegmentFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDuplicateSegmentFilesResponse.ProtoReflect.Descriptor instead.
+func (*ListDuplicateSegmentFilesResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ListDuplicateSegmentFilesResponse) GetDuplicates() []*DuplicateSegmentFiles {
+	if x != nil {
+		return x.Duplicates
+	}
+	return nil
+}
+
+func (x *ListDuplicateSegmentFilesResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type GetCollectionSegmentFilesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+}
+
+func (x *GetCollectionSegmentFilesRequest) Reset() {
+	*x = GetCollectionSegmentFilesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionSegmentFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionSegmentFilesRequest) ProtoMessage() {}
+
+func (x *GetCollectionSegmentFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionSegmentFilesRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionSegmentFilesRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetCollectionSegmentFilesRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+type GetCollectionSegmentFilesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Files  []*SegmentFile `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	Status *Status        `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetCollectionSegmentFilesResponse) Reset() {
+	*x = GetCollectionSegmentFilesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionSegmentFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionSegmentFilesResponse) ProtoMessage() {}
+
+func (x *GetCollectionSegmentFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionSegmentFilesResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionSegmentFilesResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetCollectionSegmentFilesResponse) GetFiles() []*SegmentFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *GetCollectionSegmentFilesResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Storage of the segment files of a tenant. Unique bytes are in files whose
+// content no other collection references, shared bytes in files whose content
+// other collections reference too. Distinct bytes count each content once.
+type GetStorageAttributionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+}
+
+func (x *GetStorageAttributionRequest) Reset() {
+	*x = GetStorageAttributionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStorageAttributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorageAttributionRequest) ProtoMessage() {}
+
+func (x *GetStorageAttributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorageAttributionRequest.ProtoReflect.Descriptor instead.
+func (*GetStorageAttributionRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *GetStorageAttributionRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+type CollectionStorageAttribution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	UniqueBytes  int64  `protobuf:"varint,2,opt,name=unique_bytes,json=uniqueBytes,proto3" json:"unique_bytes,omitempty"`
+	SharedBytes  int64  `protobuf:"varint,3,opt,name=shared_bytes,json=sharedBytes,proto3" json:"shared_bytes,omitempty"`
+}
+
+func (x *CollectionStorageAttribution) Reset() {
+	*x = CollectionStorageAttribution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionStorageAttribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionStorageAttribution) ProtoMessage() {}
+
+func (x *CollectionStorageAttribution) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionStorageAttribution.ProtoReflect.Descriptor instead.
+func (*CollectionStorageAttribution) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *CollectionStorageAttribution) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *CollectionStorageAttribution) GetUniqueBytes() int64 {
+	if x != nil {
+		return x.UniqueBytes
+	}
+	return 0
+}
+
+func (x *CollectionStorageAttribution) GetSharedBytes() int64 {
+	if x != nil {
+		return x.SharedBytes
+	}
+	return 0
+}
+
+type GetStorageAttributionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UniqueBytes   int64                           `protobuf:"varint,1,opt,name=unique_bytes,json=uniqueBytes,proto3" json:"unique_bytes,omitempty"`
+	SharedBytes   int64                           `protobuf:"varint,2,opt,name=shared_bytes,json=sharedBytes,proto3" json:"shared_bytes,omitempty"`
+	DistinctBytes int64                           `protobuf:"varint,3,opt,name=distinct_bytes,json=distinctBytes,proto3" json:"distinct_bytes,omitempty"`
+	Collections   []*CollectionStorageAttribution `protobuf:"bytes,4,rep,name=collections,proto3" json:"collections,omitempty"`
+	Status        *Status                         `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetStorageAttributionResponse) Reset() {
+	*x = GetStorageAttributionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStorageAttributionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorageAttributionResponse) ProtoMessage() {}
+
+func (x *GetStorageAttributionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorageAttributionResponse.ProtoReflect.Descriptor instead.
+func (*GetStorageAttributionResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *GetStorageAttributionResponse) GetUniqueBytes() int64 {
+	if x != nil {
+		return x.UniqueBytes
+	}
+	return 0
+}
+
+func (x *GetStorageAttributionResponse) GetSharedBytes() int64 {
+	if x != nil {
+		return x.SharedBytes
+	}
+	return 0
+}
+
+func (x *GetStorageAttributionResponse) GetDistinctBytes() int64 {
+	if x != nil {
+		return x.DistinctBytes
+	}
+	return 0
+}
+
+func (x *GetStorageAttributionResponse) GetCollections() []*CollectionStorageAttribution {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *GetStorageAttributionResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// A flag without a tenant is the default of the flag, on for rollout_percentage
+// percent of the tenants when enabled. A flag with a tenant overrides the
+// default for that tenant.
+type FeatureFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tenant  string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Enabled bool   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Defaults to 100, ignored for the override of a tenant.
+	RolloutPercentage *int32 `protobuf:"varint,4,opt,name=rollout_percentage,json=rolloutPercentage,proto3,oneof" json:"rollout_percentage,omitempty"`
+	Description       string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *FeatureFlag) Reset() {
+	*x = FeatureFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeatureFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeatureFlag) ProtoMessage() {}
+
+func (x *FeatureFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeatureFlag.ProtoReflect.Descriptor instead.
+func (*FeatureFlag) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *FeatureFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FeatureFlag) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *FeatureFlag) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *FeatureFlag) GetRolloutPercentage() int32 {
+	if x != nil && x.RolloutPercentage != nil {
+		return *x.RolloutPercentage
+	}
+	return 0
+}
+
+func (x *FeatureFlag) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type SetFeatureFlagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flag *FeatureFlag `protobuf:"bytes,1,opt,name=flag,proto3" json:"flag,omitempty"`
+}
+
+func (x *SetFeatureFlagRequest) Reset() {
+	*x = SetFeatureFlagRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFeatureFlagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFeatureFlagRequest) ProtoMessage() {}
+
+func (x *SetFeatureFlagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFeatureFlagRequest.ProtoReflect.Descriptor instead.
+func (*SetFeatureFlagRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *SetFeatureFlagRequest) GetFlag() *FeatureFlag {
+	if x != nil {
+		return x.Flag
+	}
+	return nil
+}
+
+type SetFeatureFlagResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *SetFeatureFlagResponse) Reset() {
+	*x = SetFeatureFlagResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFeatureFlagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFeatureFlagResponse) ProtoMessage() {}
+
+func (x *SetFeatureFlagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFeatureFlagResponse.ProtoReflect.Descriptor instead.
+func (*SetFeatureFlagResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *SetFeatureFlagResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type DeleteFeatureFlagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tenant string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+}
+
+func (x *DeleteFeatureFlagRequest) Reset() {
+	*x = DeleteFeatureFlagRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFeatureFlagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFeatureFlagRequest) ProtoMessage() {}
+
+func (x *DeleteFeatureFlagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFeatureFlagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFeatureFlagRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *DeleteFeatureFlagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteFeatureFlagRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+type DeleteFeatureFlagResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *DeleteFeatureFlagResponse) Reset() {
+	*x = DeleteFeatureFlagResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFeatureFlagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFeatureFlagResponse) ProtoMessage() {}
+
+func (x *DeleteFeatureFlagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFeatureFlagResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFeatureFlagResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *DeleteFeatureFlagResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ListFeatureFlagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListFeatureFlagsRequest) Reset() {
+	*x = ListFeatureFlagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFeatureFlagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeatureFlagsRequest) ProtoMessage() {}
+
+func (x *ListFeatureFlagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeatureFlagsRequest.ProtoReflect.Descriptor instead.
+func (*ListFeatureFlagsRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{122}
+}
+
+type ListFeatureFlagsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flags  []*FeatureFlag `protobuf:"bytes,1,rep,name=flags,proto3" json:"flags,omitempty"`
+	Status *Status        `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ListFeatureFlagsResponse) Reset() {
+	*x = ListFeatureFlagsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFeatureFlagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeatureFlagsResponse) ProtoMessage() {}
+
+func (x *ListFeatureFlagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeatureFlagsResponse.ProtoReflect.Descriptor instead.
+func (*ListFeatureFlagsResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ListFeatureFlagsResponse) GetFlags() []*FeatureFlag {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *ListFeatureFlagsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// The regions the collections of a tenant may be placed in, for data
+// residency. No regions means every region is allowed.
+type TenantPlacement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AllowedRegions []string `protobuf:"bytes,1,rep,name=allowed_regions,json=allowedRegions,proto3" json:"allowed_regions,omitempty"`
+}
+
+func (x *TenantPlacement) Reset() {
+	*x = TenantPlacement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TenantPlacement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantPlacement) ProtoMessage() {}
+
+func (x *TenantPlacement) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantPlacement.ProtoReflect.Descriptor instead.
+func (*TenantPlacement) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *TenantPlacement) GetAllowedRegions() []string {
+	if x != nil {
+		return x.AllowedRegions
+	}
+	return nil
+}
+
+// Replaces the allowed regions of a tenant.
+type SetTenantPlacementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant    string           `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Placement *TenantPlacement `protobuf:"bytes,2,opt,name=placement,proto3" json:"placement,omitempty"`
+}
+
+func (x *SetTenantPlacementRequest) Reset() {
+	*x = SetTenantPlacementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTenantPlacementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTenantPlacementRequest) ProtoMessage() {}
+
+func (x *SetTenantPlacementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTenantPlacementRequest.ProtoReflect.Descriptor instead.
+func (*SetTenantPlacementRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *SetTenantPlacementRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *SetTenantPlacementRequest) GetPlacement() *TenantPlacement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+type SetTenantPlacementResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *SetTenantPlacementResponse) Reset() {
+	*x = SetTenantPlacementResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTenantPlacementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTenantPlacementResponse) ProtoMessage() {}
+
+func (x *SetTenantPlacementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTenantPlacementResponse.ProtoReflect.Descriptor instead.
+func (*SetTenantPlacementResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *SetTenantPlacementResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type GetTenantPlacementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+}
+
+func (x *GetTenantPlacementRequest) Reset() {
+	*x = GetTenantPlacementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTenantPlacementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantPlacementRequest) ProtoMessage() {}
+
+func (x *GetTenantPlacementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantPlacementRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantPlacementRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GetTenantPlacementRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+type GetTenantPlacementResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Placement *TenantPlacement `protobuf:"bytes,1,opt,name=placement,proto3" json:"placement,omitempty"`
+	Status    *Status          `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetTenantPlacementResponse) Reset() {
+	*x = GetTenantPlacementResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTenantPlacementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantPlacementResponse) ProtoMessage() {}
+
+func (x *GetTenantPlacementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantPlacementResponse.ProtoReflect.Descriptor instead.
+func (*GetTenantPlacementResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *GetTenantPlacementResponse) GetPlacement() *TenantPlacement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *GetTenantPlacementResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Whether the collections of a tenant may be placed in region, for schedulers
+// and frontends that choose where to place them.
+type CheckTenantPlacementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Region string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+}
+
+func (x *CheckTenantPlacementRequest) Reset() {
+	*x = CheckTenantPlacementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckTenantPlacementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckTenantPlacementRequest) ProtoMessage() {}
+
+func (x *CheckTenantPlacementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckTenantPlacementRequest.ProtoReflect.Descriptor instead.
+func (*CheckTenantPlacementRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *CheckTenantPlacementRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *CheckTenantPlacementRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+type CheckTenantPlacementResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Allowed   bool             `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Placement *TenantPlacement `protobuf:"bytes,2,opt,name=placement,proto3" json:"placement,omitempty"`
+	Status    *Status          `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *CheckTenantPlacementResponse) Reset() {
+	*x = CheckTenantPlacementResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckTenantPlacementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckTenantPlacementResponse) ProtoMessage() {}
+
+func (x *CheckTenantPlacementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckTenantPlacementResponse.ProtoReflect.Descriptor instead.
+func (*CheckTenantPlacementResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *CheckTenantPlacementResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *CheckTenantPlacementResponse) GetPlacement() *TenantPlacement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *CheckTenantPlacementResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type GetCollectionPlacementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+}
+
+func (x *GetCollectionPlacementRequest) Reset() {
+	*x = GetCollectionPlacementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionPlacementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionPlacementRequest) ProtoMessage() {}
+
+func (x *GetCollectionPlacementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionPlacementRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionPlacementRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *GetCollectionPlacementRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+// The query nodes a collection is assigned to, as many as its replication
+// factor and the query memberlist allow. version changes whenever the placement
+// may have changed, so clients can cache the placement until they see another
+// version.
+type GetCollectionPlacementResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QueryNodes        []string `protobuf:"bytes,1,rep,name=query_nodes,json=queryNodes,proto3" json:"query_nodes,omitempty"`
+	ReplicationFactor int32    `protobuf:"varint,2,opt,name=replication_factor,json=replicationFactor,proto3" json:"replication_factor,omitempty"`
+	Version           uint64   `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Status            *Status  `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetCollectionPlacementResponse) Reset() {
+	*x = GetCollectionPlacementResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionPlacementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionPlacementResponse) ProtoMessage() {}
+
+func (x *GetCollectionPlacementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionPlacementResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionPlacementResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *GetCollectionPlacementResponse) GetQueryNodes() []string {
+	if x != nil {
+		return x.QueryNodes
+	}
+	return nil
+}
+
+func (x *GetCollectionPlacementResponse) GetReplicationFactor() int32 {
+	if x != nil {
+		return x.ReplicationFactor
+	}
+	return 0
+}
+
+func (x *GetCollectionPlacementResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetCollectionPlacementResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Takes a query node out of service: collections are placed on other nodes
+// right away, and the node is removed from the query memberlist once the drain
+// period has passed. Decommissioning a node again returns its progress. cancel
+// puts the node back in service, or forgets a removed node so that a new node
+// can reuse its id.
+type DecommissionNodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Cancel bool   `protobuf:"varint,2,opt,name=cancel,proto3" json:"cancel,omitempty"`
+}
+
+func (x *DecommissionNodeRequest) Reset() {
+	*x = DecommissionNodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecommissionNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecommissionNodeRequest) ProtoMessage() {}
+
+func (x *DecommissionNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecommissionNodeRequest.ProtoReflect.Descriptor instead.
+func (*DecommissionNodeRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *DecommissionNodeRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *DecommissionNodeRequest) GetCancel() bool {
+	if x != nil {
+		return x.Cancel
+	}
+	return false
+}
+
+// state is "draining" or "removed", and empty when the decommission was
+// cancelled. started_at and drain_until are in unix seconds. collections is the
+// number of collections placed on the node when the decommission started,
+// remaining_collections the number the memberlist still places on it, and
+// progress the fraction of them that were placed on other nodes.
+type DecommissionNodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State                string  `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	StartedAt            int64   `protobuf:"varint,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	DrainUntil           int64   `protobuf:"varint,3,opt,name=drain_until,json=drainUntil,proto3" json:"drain_until,omitempty"`
+	Progress             float64 `protobuf:"fixed64,4,opt,name=progress,proto3" json:"progress,omitempty"`
+	Status               *Status `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Collections          int64   `protobuf:"varint,6,opt,name=collections,proto3" json:"collections,omitempty"`
+	RemainingCollections int64   `protobuf:"varint,7,opt,name=remaining_collections,json=remainingCollections,proto3" json:"remaining_collections,omitempty"`
+}
+
+func (x *DecommissionNodeResponse) Reset() {
+	*x = DecommissionNodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecommissionNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecommissionNodeResponse) ProtoMessage() {}
+
+func (x *DecommissionNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecommissionNodeResponse.ProtoReflect.Descriptor instead.
+func (*DecommissionNodeResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *DecommissionNodeResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *DecommissionNodeResponse) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *DecommissionNodeResponse) GetDrainUntil() int64 {
+	if x != nil {
+		return x.DrainUntil
+	}
+	return 0
+}
+
+func (x *DecommissionNodeResponse) GetProgress() float64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *DecommissionNodeResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *DecommissionNodeResponse) GetCollections() int64 {
+	if x != nil {
+		return x.Collections
+	}
+	return 0
+}
+
+func (x *DecommissionNodeResponse) GetRemainingCollections() int64 {
+	if x != nil {
+		return x.RemainingCollections
+	}
+	return 0
+}
+
+// Sent by worker nodes every few seconds, so that a node that is in the
+// memberlist but wedged can be told apart from a healthy one.
+type HeartbeatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+}
+
+func (x *HeartbeatRequest) Reset() {
+	*x = HeartbeatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatRequest) ProtoMessage() {}
+
+func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *HeartbeatRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+type HeartbeatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *HeartbeatResponse) Reset() {
+	*x = HeartbeatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeartbeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatResponse) ProtoMessage() {}
+
+func (x *HeartbeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatResponse.ProtoReflect.Descriptor instead.
+func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *HeartbeatResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type GetNodeLivenessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetNodeLivenessRequest) Reset() {
+	*x = GetNodeLivenessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodeLivenessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeLivenessRequest) ProtoMessage() {}
+
+func (x *GetNodeLivenessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeLivenessRequest.ProtoReflect.Descriptor instead.
+func (*GetNodeLivenessRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{137}
+}
+
+// last_seen is the time of the last heartbeat in unix seconds, 0 when the node
+// never sent one. alive is false once the heartbeat timeout has passed.
+type NodeLiveness struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId       string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	LastSeen     int64  `protobuf:"varint,2,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	InMemberlist bool   `protobuf:"varint,3,opt,name=in_memberlist,json=inMemberlist,proto3" json:"in_memberlist,omitempty"`
+	Alive        bool   `protobuf:"varint,4,opt,name=alive,proto3" json:"alive,omitempty"`
+}
+
+func (x *NodeLiveness) Reset() {
+	*x = NodeLiveness{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeLiveness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeLiveness) ProtoMessage() {}
+
+func (x *NodeLiveness) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeLiveness.ProtoReflect.Descriptor instead.
+func (*NodeLiveness) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *NodeLiveness) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *NodeLiveness) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+func (x *NodeLiveness) GetInMemberlist() bool {
+	if x != nil {
+		return x.InMemberlist
+	}
+	return false
+}
+
+func (x *NodeLiveness) GetAlive() bool {
+	if x != nil {
+		return x.Alive
+	}
+	return false
+}
+
+type GetNodeLivenessResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nodes  []*NodeLiveness `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Status *Status         `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetNodeLivenessResponse) Reset() {
+	*x = GetNodeLivenessResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodeLivenessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeLivenessResponse) ProtoMessage() {}
+
+func (x *GetNodeLivenessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeLivenessResponse.ProtoReflect.Descriptor instead.
+func (*GetNodeLivenessResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *GetNodeLivenessResponse) GetNodes() []*NodeLiveness {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *GetNodeLivenessResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Compares the current placement of the collections of a tenant and database,
+// of every collection when they are empty, with the one query_nodes would give
+// them. At most limit moves are returned, 100 by default, the counts cover
+// every collection.
+type SimulateCollectionPlacementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QueryNodes []string `protobuf:"bytes,1,rep,name=query_nodes,json=queryNodes,proto3" json:"query_nodes,omitempty"`
+	Tenant     string   `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Database   string   `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
+	Limit      *int32   `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+}
+
+func (x *SimulateCollectionPlacementRequest) Reset() {
+	*x = SimulateCollectionPlacementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulateCollectionPlacementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateCollectionPlacementRequest) ProtoMessage() {}
+
+func (x *SimulateCollectionPlacementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateCollectionPlacementRequest.ProtoReflect.Descriptor instead.
+func (*SimulateCollectionPlacementRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *SimulateCollectionPlacementRequest) GetQueryNodes() []string {
+	if x != nil {
+		return x.QueryNodes
+	}
+	return nil
+}
+
+func (x *SimulateCollectionPlacementRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *SimulateCollectionPlacementRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *SimulateCollectionPlacementRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type CollectionPlacementMove struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId   string   `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	CurrentNodes   []string `protobuf:"bytes,2,rep,name=current_nodes,json=currentNodes,proto3" json:"current_nodes,omitempty"`
+	SimulatedNodes []string `protobuf:"bytes,3,rep,name=simulated_nodes,json=simulatedNodes,proto3" json:"simulated_nodes,omitempty"`
+}
+
+func (x *CollectionPlacementMove) Reset() {
+	*x = CollectionPlacementMove{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionPlacementMove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionPlacementMove) ProtoMessage() {}
+
+func (x *CollectionPlacementMove) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionPlacementMove.ProtoReflect.Descriptor instead.
+func (*CollectionPlacementMove) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *CollectionPlacementMove) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *CollectionPlacementMove) GetCurrentNodes() []string {
+	if x != nil {
+		return x.CurrentNodes
+	}
+	return nil
+}
+
+func (x *CollectionPlacementMove) GetSimulatedNodes() []string {
+	if x != nil {
+		return x.SimulatedNodes
+	}
+	return nil
+}
+
+type NodeLoad struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeId               string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	CurrentCollections   int64  `protobuf:"varint,2,opt,name=current_collections,json=currentCollections,proto3" json:"current_collections,omitempty"`
+	SimulatedCollections int64  `protobuf:"varint,3,opt,name=simulated_collections,json=simulatedCollections,proto3" json:"simulated_collections,omitempty"`
+}
+
+func (x *NodeLoad) Reset() {
+	*x = NodeLoad{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeLoad) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeLoad) ProtoMessage() {}
+
+func (x *NodeLoad) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeLoad.ProtoReflect.Descriptor instead.
+func (*NodeLoad) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *NodeLoad) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *NodeLoad) GetCurrentCollections() int64 {
+	if x != nil {
+		return x.CurrentCollections
+	}
+	return 0
+}
+
+func (x *NodeLoad) GetSimulatedCollections() int64 {
+	if x != nil {
+		return x.SimulatedCollections
+	}
+	return 0
+}
+
+type SimulateCollectionPlacementResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalCollections int64                      `protobuf:"varint,1,opt,name=total_collections,json=totalCollections,proto3" json:"total_collections,omitempty"`
+	MovedCollections int64                      `protobuf:"varint,2,opt,name=moved_collections,json=movedCollections,proto3" json:"moved_collections,omitempty"`
+	Moves            []*CollectionPlacementMove `protobuf:"bytes,3,rep,name=moves,proto3" json:"moves,omitempty"`
+	NodeLoads        []*NodeLoad                `protobuf:"bytes,4,rep,name=node_loads,json=nodeLoads,proto3" json:"node_loads,omitempty"`
+	Status           *Status                    `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *SimulateCollectionPlacementResponse) Reset() {
+	*x = SimulateCollectionPlacementResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulateCollectionPlacementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateCollectionPlacementResponse) ProtoMessage() {}
+
+func (x *SimulateCollectionPlacementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateCollectionPlacementResponse.ProtoReflect.Descriptor instead.
+func (*SimulateCollectionPlacementResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *SimulateCollectionPlacementResponse) GetTotalCollections() int64 {
+	if x != nil {
+		return x.TotalCollections
+	}
+	return 0
+}
+
+func (x *SimulateCollectionPlacementResponse) GetMovedCollections() int64 {
+	if x != nil {
+		return x.MovedCollections
+	}
+	return 0
+}
+
+func (x *SimulateCollectionPlacementResponse) GetMoves() []*CollectionPlacementMove {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+func (x *SimulateCollectionPlacementResponse) GetNodeLoads() []*NodeLoad {
+	if x != nil {
+		return x.NodeLoads
+	}
+	return nil
+}
+
+func (x *SimulateCollectionPlacementResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Lists the collections of a tenant, of one of its databases when database is
+// set, from the collection_stats rollup, largest first.
+type ListCollectionStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant   string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Database string `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	Limit    int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset   int32  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// The next_page_token of the previous page, instead of offset. Tokens are
+	// signed and bound to the filters of the request they were returned for.
+	PageToken *string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+}
+
+func (x *ListCollectionStatsRequest) Reset() {
+	*x = ListCollectionStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCollectionStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionStatsRequest) ProtoMessage() {}
+
+func (x *ListCollectionStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionStatsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionStatsRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *ListCollectionStatsRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *ListCollectionStatsRequest) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *ListCollectionStatsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListCollectionStatsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListCollectionStatsRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+// The log lag fields are only set once the lag was read from the log service.
+// Times are in unix seconds.
+type CollectionStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId        string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Tenant              string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Database            string `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
+	TotalRecords        int64  `protobuf:"varint,4,opt,name=total_records,json=totalRecords,proto3" json:"total_records,omitempty"`
+	SizeBytes           int64  `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	SegmentCount        int32  `protobuf:"varint,6,opt,name=segment_count,json=segmentCount,proto3" json:"segment_count,omitempty"`
+	LastCompactedAt     *int64 `protobuf:"varint,7,opt,name=last_compacted_at,json=lastCompactedAt,proto3,oneof" json:"last_compacted_at,omitempty"`
+	UncompactedRecords  *int64 `protobuf:"varint,8,opt,name=uncompacted_records,json=uncompactedRecords,proto3,oneof" json:"uncompacted_records,omitempty"`
+	OldestUncompactedAt *int64 `protobuf:"varint,9,opt,name=oldest_uncompacted_at,json=oldestUncompactedAt,proto3,oneof" json:"oldest_uncompacted_at,omitempty"`
+	RefreshedAt         int64  `protobuf:"varint,10,opt,name=refreshed_at,json=refreshedAt,proto3" json:"refreshed_at,omitempty"`
+}
+
+func (x *CollectionStats) Reset() {
+	*x = CollectionStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionStats) ProtoMessage() {}
+
+func (x *CollectionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionStats.ProtoReflect.Descriptor instead.
+func (*CollectionStats) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *CollectionStats) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *CollectionStats) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *CollectionStats) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *CollectionStats) GetTotalRecords() int64 {
+	if x != nil {
+		return x.TotalRecords
+	}
+	return 0
+}
+
+func (x *CollectionStats) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *CollectionStats) GetSegmentCount() int32 {
+	if x != nil {
+		return x.SegmentCount
+	}
+	return 0
+}
+
+func (x *CollectionStats) GetLastCompactedAt() int64 {
+	if x != nil && x.LastCompactedAt != nil {
+		return *x.LastCompactedAt
+	}
+	return 0
+}
+
+func (x *CollectionStats) GetUncompactedRecords() int64 {
+	if x != nil && x.UncompactedRecords != nil {
+		return *x.UncompactedRecords
+	}
+	return 0
+}
+
+func (x *CollectionStats) GetOldestUncompactedAt() int64 {
+	if x != nil && x.OldestUncompactedAt != nil {
+		return *x.OldestUncompactedAt
+	}
+	return 0
+}
+
+func (x *CollectionStats) GetRefreshedAt() int64 {
+	if x != nil {
+		return x.RefreshedAt
+	}
+	return 0
+}
+
+type ListCollectionStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats  []*CollectionStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	Status *Status            `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Set when the page is full, there may be more collections.
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListCollectionStatsResponse) Reset() {
+	*x = ListCollectionStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCollectionStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionStatsResponse) ProtoMessage() {}
+
+func (x *ListCollectionStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionStatsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionStatsResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *ListCollectionStatsResponse) GetStats() []*CollectionStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *ListCollectionStatsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListCollectionStatsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Returns the daily snapshots of the size of a collection over the last days,
+// oldest first, every snapshot retained when days is 0.
+type GetCollectionSizeHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Days         int32  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+}
+
+func (x *GetCollectionSizeHistoryRequest) Reset() {
+	*x = GetCollectionSizeHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionSizeHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionSizeHistoryRequest) ProtoMessage() {}
+
+func (x *GetCollectionSizeHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionSizeHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionSizeHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *GetCollectionSizeHistoryRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *GetCollectionSizeHistoryRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+// day is the UTC midnight of the snapshot in unix seconds.
+type CollectionSizeSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Day          int64 `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	TotalRecords int64 `protobuf:"varint,2,opt,name=total_records,json=totalRecords,proto3" json:"total_records,omitempty"`
+	SizeBytes    int64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+}
+
+func (x *CollectionSizeSnapshot) Reset() {
+	*x = CollectionSizeSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionSizeSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionSizeSnapshot) ProtoMessage() {}
+
+func (x *CollectionSizeSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionSizeSnapshot.ProtoReflect.Descriptor instead.
+func (*CollectionSizeSnapshot) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *CollectionSizeSnapshot) GetDay() int64 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *CollectionSizeSnapshot) GetTotalRecords() int64 {
+	if x != nil {
+		return x.TotalRecords
+	}
+	return 0
+}
+
+func (x *CollectionSizeSnapshot) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type GetCollectionSizeHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snapshots []*CollectionSizeSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	Status    *Status                   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetCollectionSizeHistoryResponse) Reset() {
+	*x = GetCollectionSizeHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionSizeHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionSizeHistoryResponse) ProtoMessage() {}
+
+func (x *GetCollectionSizeHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionSizeHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionSizeHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *GetCollectionSizeHistoryResponse) GetSnapshots() []*CollectionSizeSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+func (x *GetCollectionSizeHistoryResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Summarizes the activity of a tenant on each of the last days, 7 by default
+// and at most 366, today included.
+type GetTenantActivityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Days   int32  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+}
+
+func (x *GetTenantActivityRequest) Reset() {
+	*x = GetTenantActivityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTenantActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantActivityRequest) ProtoMessage() {}
+
+func (x *GetTenantActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantActivityRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantActivityRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *GetTenantActivityRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *GetTenantActivityRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+// day is the UTC midnight the activity starts at in unix seconds. Compactions
+// are the compactions flushed, records_compacted the log records they
+// compacted, i.e. the writes to the collections of the tenant.
+type TenantActivity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Day                 int64 `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	CollectionsCreated  int64 `protobuf:"varint,2,opt,name=collections_created,json=collectionsCreated,proto3" json:"collections_created,omitempty"`
+	CollectionsDeleted  int64 `protobuf:"varint,3,opt,name=collections_deleted,json=collectionsDeleted,proto3" json:"collections_deleted,omitempty"`
+	CollectionsRestored int64 `protobuf:"varint,4,opt,name=collections_restored,json=collectionsRestored,proto3" json:"collections_restored,omitempty"`
+	CollectionsPurged   int64 `protobuf:"varint,5,opt,name=collections_purged,json=collectionsPurged,proto3" json:"collections_purged,omitempty"`
+	Compactions         int64 `protobuf:"varint,6,opt,name=compactions,proto3" json:"compactions,omitempty"`
+	RecordsCompacted    int64 `protobuf:"varint,7,opt,name=records_compacted,json=recordsCompacted,proto3" json:"records_compacted,omitempty"`
+}
+
+func (x *TenantActivity) Reset() {
+	*x = TenantActivity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TenantActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantActivity) ProtoMessage() {}
+
+func (x *TenantActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantActivity.ProtoReflect.Descriptor instead.
+func (*TenantActivity) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *TenantActivity) GetDay() int64 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *TenantActivity) GetCollectionsCreated() int64 {
+	if x != nil {
+		return x.CollectionsCreated
+	}
+	return 0
+}
+
+func (x *TenantActivity) GetCollectionsDeleted() int64 {
+	if x != nil {
+		return x.CollectionsDeleted
+	}
+	return 0
+}
+
+func (x *TenantActivity) GetCollectionsRestored() int64 {
+	if x != nil {
+		return x.CollectionsRestored
+	}
+	return 0
+}
+
+func (x *TenantActivity) GetCollectionsPurged() int64 {
+	if x != nil {
+		return x.CollectionsPurged
+	}
+	return 0
+}
+
+func (x *TenantActivity) GetCompactions() int64 {
+	if x != nil {
+		return x.Compactions
+	}
+	return 0
+}
+
+func (x *TenantActivity) GetRecordsCompacted() int64 {
+	if x != nil {
+		return x.RecordsCompacted
+	}
+	return 0
+}
+
+// total is the activity over every day, starting at the first.
+type GetTenantActivityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Days   []*TenantActivity `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	Total  *TenantActivity   `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	Status *Status           `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetTenantActivityResponse) Reset() {
+	*x = GetTenantActivityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTenantActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantActivityResponse) ProtoMessage() {}
+
+func (x *GetTenantActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantActivityResponse.ProtoReflect.Descriptor instead.
+func (*GetTenantActivityResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *GetTenantActivityResponse) GetDays() []*TenantActivity {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *GetTenantActivityResponse) GetTotal() *TenantActivity {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *GetTenantActivityResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// The counts of a run of the soft delete cleaner. Times are in unix seconds,
+// finished_at is 0 while the run is in progress. Collections restored while the
+// run listed them are scanned but neither purged nor failed.
+type SoftDeleteCleanerRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartedAt  int64  `protobuf:"varint,1,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt int64  `protobuf:"varint,2,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Manual     bool   `protobuf:"varint,3,opt,name=manual,proto3" json:"manual,omitempty"`
+	Scanned    int64  `protobuf:"varint,4,opt,name=scanned,proto3" json:"scanned,omitempty"`
+	Purged     int64  `protobuf:"varint,5,opt,name=purged,proto3" json:"purged,omitempty"`
+	Failed     int64  `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+	Error      string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *SoftDeleteCleanerRun) Reset() {
+	*x = SoftDeleteCleanerRun{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SoftDeleteCleanerRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoftDeleteCleanerRun) ProtoMessage() {}
+
+func (x *SoftDeleteCleanerRun) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoftDeleteCleanerRun.ProtoReflect.Descriptor instead.
+func (*SoftDeleteCleanerRun) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *SoftDeleteCleanerRun) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *SoftDeleteCleanerRun) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *SoftDeleteCleanerRun) GetManual() bool {
+	if x != nil {
+		return x.Manual
+	}
+	return false
+}
+
+func (x *SoftDeleteCleanerRun) GetScanned() int64 {
+	if x != nil {
+		return x.Scanned
+	}
+	return 0
+}
+
+func (x *SoftDeleteCleanerRun) GetPurged() int64 {
+	if x != nil {
+		return x.Purged
+	}
+	return 0
+}
+
+func (x *SoftDeleteCleanerRun) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *SoftDeleteCleanerRun) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetSoftDeleteCleanerStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetSoftDeleteCleanerStatusRequest) Reset() {
+	*x = GetSoftDeleteCleanerStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSoftDeleteCleanerStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSoftDeleteCleanerStatusRequest) ProtoMessage() {}
+
+func (x *GetSoftDeleteCleanerStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSoftDeleteCleanerStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetSoftDeleteCleanerStatusRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{154}
+}
+
+// The totals count every run since the leader started the cleaner.
+// next_run_at is 0 when the cleaner is disabled.
+type GetSoftDeleteCleanerStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled          bool                  `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Running          bool                  `protobuf:"varint,2,opt,name=running,proto3" json:"running,omitempty"`
+	RetentionSeconds int64                 `protobuf:"varint,3,opt,name=retention_seconds,json=retentionSeconds,proto3" json:"retention_seconds,omitempty"`
+	NextRunAt        int64                 `protobuf:"varint,4,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	LastRun          *SoftDeleteCleanerRun `protobuf:"bytes,5,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"`
+	TotalScanned     int64                 `protobuf:"varint,6,opt,name=total_scanned,json=totalScanned,proto3" json:"total_scanned,omitempty"`
+	TotalPurged      int64                 `protobuf:"varint,7,opt,name=total_purged,json=totalPurged,proto3" json:"total_purged,omitempty"`
+	TotalFailed      int64                 `protobuf:"varint,8,opt,name=total_failed,json=totalFailed,proto3" json:"total_failed,omitempty"`
+	Status           *Status               `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetSoftDeleteCleanerStatusResponse) Reset() {
+	*x = GetSoftDeleteCleanerStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSoftDeleteCleanerStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSoftDeleteCleanerStatusResponse) ProtoMessage() {}
+
+func (x *GetSoftDeleteCleanerStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSoftDeleteCleanerStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetSoftDeleteCleanerStatusResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *GetSoftDeleteCleanerStatusResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *GetSoftDeleteCleanerStatusResponse) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *GetSoftDeleteCleanerStatusResponse) GetRetentionSeconds() int64 {
+	if x != nil {
+		return x.RetentionSeconds
+	}
+	return 0
+}
+
+func (x *GetSoftDeleteCleanerStatusResponse) GetNextRunAt() int64 {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return 0
+}
+
+func (x *GetSoftDeleteCleanerStatusResponse) GetLastRun() *SoftDeleteCleanerRun {
+	if x != nil {
+		return x.LastRun
+	}
+	return nil
+}
+
+func (x *GetSoftDeleteCleanerStatusResponse) GetTotalScanned() int64 {
+	if x != nil {
+		return x.TotalScanned
+	}
+	return 0
+}
+
+func (x *GetSoftDeleteCleanerStatusResponse) GetTotalPurged() int64 {
+	if x != nil {
+		return x.TotalPurged
+	}
+	return 0
+}
+
+func (x *GetSoftDeleteCleanerStatusResponse) GetTotalFailed() int64 {
+	if x != nil {
+		return x.TotalFailed
+	}
+	return 0
+}
+
+func (x *GetSoftDeleteCleanerStatusResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Runs the soft delete cleaner right away over at most limit collections, the
+// batch size of the cleaner when 0. The response is sent when the run is over.
+type RunSoftDeleteCleanerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *RunSoftDeleteCleanerRequest) Reset() {
+	*x = RunSoftDeleteCleanerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunSoftDeleteCleanerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunSoftDeleteCleanerRequest) ProtoMessage() {}
+
+func (x *RunSoftDeleteCleanerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunSoftDeleteCleanerRequest.ProtoReflect.Descriptor instead.
+func (*RunSoftDeleteCleanerRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *RunSoftDeleteCleanerRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type RunSoftDeleteCleanerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Run    *SoftDeleteCleanerRun `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	Status *Status               `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *RunSoftDeleteCleanerResponse) Reset() {
+	*x = RunSoftDeleteCleanerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RunSoftDeleteCleanerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunSoftDeleteCleanerResponse) ProtoMessage() {}
+
+func (x *RunSoftDeleteCleanerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunSoftDeleteCleanerResponse.ProtoReflect.Descriptor instead.
+func (*RunSoftDeleteCleanerResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *RunSoftDeleteCleanerResponse) GetRun() *SoftDeleteCleanerRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+func (x *RunSoftDeleteCleanerResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Lists the collections a background cleanup task keeps failing on, of every
+// task when task is empty. Only the ones the task stopped retrying are listed
+// when dead_lettered_only is set.
+type ListCleanupDeadLettersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Task             string `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	DeadLetteredOnly bool   `protobuf:"varint,2,opt,name=dead_lettered_only,json=deadLetteredOnly,proto3" json:"dead_lettered_only,omitempty"`
+}
+
+func (x *ListCleanupDeadLettersRequest) Reset() {
+	*x = ListCleanupDeadLettersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCleanupDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCleanupDeadLettersRequest) ProtoMessage() {}
+
+func (x *ListCleanupDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCleanupDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ListCleanupDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *ListCleanupDeadLettersRequest) GetTask() string {
+	if x != nil {
+		return x.Task
+	}
+	return ""
+}
+
+func (x *ListCleanupDeadLettersRequest) GetDeadLetteredOnly() bool {
+	if x != nil {
+		return x.DeadLetteredOnly
+	}
+	return false
+}
+
+// Times are in unix seconds.
+type CleanupDeadLetter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId  string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Task          string `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	Tenant        string `protobuf:"bytes,3,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Attempts      int32  `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError     string `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	FirstFailedAt int64  `protobuf:"varint,6,opt,name=first_failed_at,json=firstFailedAt,proto3" json:"first_failed_at,omitempty"`
+	LastFailedAt  int64  `protobuf:"varint,7,opt,name=last_failed_at,json=lastFailedAt,proto3" json:"last_failed_at,omitempty"`
+	DeadLettered  bool   `protobuf:"varint,8,opt,name=dead_lettered,json=deadLettered,proto3" json:"dead_lettered,omitempty"`
+}
+
+func (x *CleanupDeadLetter) Reset() {
+	*x = CleanupDeadLetter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CleanupDeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CleanupDeadLetter) ProtoMessage() {}
+
+func (x *CleanupDeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CleanupDeadLetter.ProtoReflect.Descriptor instead.
+func (*CleanupDeadLetter) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *CleanupDeadLetter) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *CleanupDeadLetter) GetTask() string {
+	if x != nil {
+		return x.Task
+	}
+	return ""
+}
+
+func (x *CleanupDeadLetter) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *CleanupDeadLetter) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *CleanupDeadLetter) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *CleanupDeadLetter) GetFirstFailedAt() int64 {
+	if x != nil {
+		return x.FirstFailedAt
+	}
+	return 0
+}
+
+func (x *CleanupDeadLetter) GetLastFailedAt() int64 {
+	if x != nil {
+		return x.LastFailedAt
+	}
+	return 0
+}
+
+func (x *CleanupDeadLetter) GetDeadLettered() bool {
+	if x != nil {
+		return x.DeadLettered
+	}
+	return false
+}
+
+type ListCleanupDeadLettersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*CleanupDeadLetter `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Status  *Status              `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ListCleanupDeadLettersResponse) Reset() {
+	*x = ListCleanupDeadLettersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCleanupDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCleanupDeadLettersResponse) ProtoMessage() {}
+
+func (x *ListCleanupDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCleanupDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ListCleanupDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *ListCleanupDeadLettersResponse) GetEntries() []*CleanupDeadLetter {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListCleanupDeadLettersResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Forgets the failures of a cleanup task on a collection, so that the task
+// retries it at its next run.
+type DeleteCleanupDeadLetterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Task         string `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+}
+
+func (x *DeleteCleanupDeadLetterRequest) Reset() {
+	*x = DeleteCleanupDeadLetterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCleanupDeadLetterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCleanupDeadLetterRequest) ProtoMessage() {}
+
+func (x *DeleteCleanupDeadLetterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCleanupDeadLetterRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCleanupDeadLetterRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *DeleteCleanupDeadLetterRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *DeleteCleanupDeadLetterRequest) GetTask() string {
+	if x != nil {
+		return x.Task
+	}
+	return ""
+}
+
+type DeleteCleanupDeadLetterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *DeleteCleanupDeadLetterResponse) Reset() {
+	*x = DeleteCleanupDeadLetterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCleanupDeadLetterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCleanupDeadLetterResponse) ProtoMessage() {}
+
+func (x *DeleteCleanupDeadLetterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCleanupDeadLetterResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCleanupDeadLetterResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *DeleteCleanupDeadLetterResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// A tenant of BatchCreateTenants, created with a database named database and
+// with quota when they are set.
+type BatchCreateTenant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	KmsKeyId *string      `protobuf:"bytes,2,opt,name=kms_key_id,json=kmsKeyId,proto3,oneof" json:"kms_key_id,omitempty"`
+	Database *string      `protobuf:"bytes,3,opt,name=database,proto3,oneof" json:"database,omitempty"`
+	Quota    *TenantQuota `protobuf:"bytes,4,opt,name=quota,proto3" json:"quota,omitempty"`
+}
+
+func (x *BatchCreateTenant) Reset() {
+	*x = BatchCreateTenant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateTenant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateTenant) ProtoMessage() {}
+
+func (x *BatchCreateTenant) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateTenant.ProtoReflect.Descriptor instead.
+func (*BatchCreateTenant) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *BatchCreateTenant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BatchCreateTenant) GetKmsKeyId() string {
+	if x != nil && x.KmsKeyId != nil {
+		return *x.KmsKeyId
+	}
+	return ""
+}
+
+func (x *BatchCreateTenant) GetDatabase() string {
+	if x != nil && x.Database != nil {
+		return *x.Database
+	}
+	return ""
+}
+
+func (x *BatchCreateTenant) GetQuota() *TenantQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+// Creates up to 1000 tenants, 100 per transaction. Every tenant is created on
+// its own, so a tenant that fails, e.g. with 409 because it exists, only fails
+// its own result.
+type BatchCreateTenantsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenants []*BatchCreateTenant `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
+}
+
+func (x *BatchCreateTenantsRequest) Reset() {
+	*x = BatchCreateTenantsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateTenantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateTenantsRequest) ProtoMessage() {}
+
+func (x *BatchCreateTenantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateTenantsRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateTenantsRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *BatchCreateTenantsRequest) GetTenants() []*BatchCreateTenant {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+type TenantResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant string  `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Status *Status `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *TenantResult) Reset() {
+	*x = TenantResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TenantResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantResult) ProtoMessage() {}
+
+func (x *TenantResult) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantResult.ProtoReflect.Descriptor instead.
+func (*TenantResult) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *TenantResult) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *TenantResult) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type BatchCreateTenantsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*TenantResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Status  *Status         `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *BatchCreateTenantsResponse) Reset() {
+	*x = BatchCreateTenantsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateTenantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateTenantsResponse) ProtoMessage() {}
+
+func (x *BatchCreateTenantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateTenantsResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateTenantsResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *BatchCreateTenantsResponse) GetResults() []*TenantResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchCreateTenantsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Returns the changes to the metadata of a collection from its given revision
+// to its current one, from the metadata history of the collection.
+type GetCollectionMetadataDiffRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Revision     int64  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *GetCollectionMetadataDiffRequest) Reset() {
+	*x = GetCollectionMetadataDiffRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionMetadataDiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionMetadataDiffRequest) ProtoMessage() {}
+
+func (x *GetCollectionMetadataDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionMetadataDiffRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionMetadataDiffRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *GetCollectionMetadataDiffRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *GetCollectionMetadataDiffRequest) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+// from is not set for a key that was added, to for a key that was removed.
+type CollectionMetadataChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key  string               `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	From *UpdateMetadataValue `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To   *UpdateMetadataValue `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *CollectionMetadataChange) Reset() {
+	*x = CollectionMetadataChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionMetadataChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionMetadataChange) ProtoMessage() {}
+
+func (x *CollectionMetadataChange) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionMetadataChange.ProtoReflect.Descriptor instead.
+func (*CollectionMetadataChange) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *CollectionMetadataChange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CollectionMetadataChange) GetFrom() *UpdateMetadataValue {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *CollectionMetadataChange) GetTo() *UpdateMetadataValue {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type GetCollectionMetadataDiffResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes      []*CollectionMetadataChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	FromRevision int64                       `protobuf:"varint,2,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"`
+	ToRevision   int64                       `protobuf:"varint,3,opt,name=to_revision,json=toRevision,proto3" json:"to_revision,omitempty"`
+	Status       *Status                     `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetCollectionMetadataDiffResponse) Reset() {
+	*x = GetCollectionMetadataDiffResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionMetadataDiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionMetadataDiffResponse) ProtoMessage() {}
+
+func (x *GetCollectionMetadataDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionMetadataDiffResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionMetadataDiffResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *GetCollectionMetadataDiffResponse) GetChanges() []*CollectionMetadataChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *GetCollectionMetadataDiffResponse) GetFromRevision() int64 {
+	if x != nil {
+		return x.FromRevision
+	}
+	return 0
+}
+
+func (x *GetCollectionMetadataDiffResponse) GetToRevision() int64 {
+	if x != nil {
+		return x.ToRevision
+	}
+	return 0
+}
+
+func (x *GetCollectionMetadataDiffResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// An embedding configuration of a tenant, referenced by its collections
+// instead of being copied into the metadata of each of them. params is the JSON
+// object the provider is configured with, the sysdb does not interpret it.
+// created_at and updated_at are in unix seconds.
+type EmbeddingFunction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tenant    string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Provider  string `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	Model     string `protobuf:"bytes,5,opt,name=model,proto3" json:"model,omitempty"`
+	Params    string `protobuf:"bytes,6,opt,name=params,proto3" json:"params,omitempty"`
+	CreatedAt int64  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt int64  `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *EmbeddingFunction) Reset() {
+	*x = EmbeddingFunction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmbeddingFunction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmbeddingFunction) ProtoMessage() {}
+
+func (x *EmbeddingFunction) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmbeddingFunction.ProtoReflect.Descriptor instead.
+func (*EmbeddingFunction) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *EmbeddingFunction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EmbeddingFunction) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *EmbeddingFunction) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EmbeddingFunction) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *EmbeddingFunction) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *EmbeddingFunction) GetParams() string {
+	if x != nil {
+		return x.Params
+	}
+	return ""
+}
+
+func (x *EmbeddingFunction) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *EmbeddingFunction) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// The id is generated when it is empty, params default to an empty object.
+// Names are unique per tenant.
+type CreateEmbeddingFunctionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmbeddingFunction *EmbeddingFunction `protobuf:"bytes,1,opt,name=embedding_function,json=embeddingFunction,proto3" json:"embedding_function,omitempty"`
+}
+
+func (x *CreateEmbeddingFunctionRequest) Reset() {
+	*x = CreateEmbeddingFunctionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateEmbeddingFunctionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmbeddingFunctionRequest) ProtoMessage() {}
+
+func (x *CreateEmbeddingFunctionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmbeddingFunctionRequest.ProtoReflect.Descriptor instead.
+func (*CreateEmbeddingFunctionRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *CreateEmbeddingFunctionRequest) GetEmbeddingFunction() *EmbeddingFunction {
+	if x != nil {
+		return x.EmbeddingFunction
+	}
+	return nil
+}
+
+type CreateEmbeddingFunctionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmbeddingFunction *EmbeddingFunction `protobuf:"bytes,1,opt,name=embedding_function,json=embeddingFunction,proto3" json:"embedding_function,omitempty"`
+	Status            *Status            `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *CreateEmbeddingFunctionResponse) Reset() {
+	*x = CreateEmbeddingFunctionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateEmbeddingFunctionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmbeddingFunctionResponse) ProtoMessage() {}
+
+func (x *CreateEmbeddingFunctionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmbeddingFunctionResponse.ProtoReflect.Descriptor instead.
+func (*CreateEmbeddingFunctionResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *CreateEmbeddingFunctionResponse) GetEmbeddingFunction() *EmbeddingFunction {
+	if x != nil {
+		return x.EmbeddingFunction
+	}
+	return nil
+}
+
+func (x *CreateEmbeddingFunctionResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Returns the embedding function with the id when it is set, and the embedding
+// functions of the tenant otherwise, or only the one with the name.
+type GetEmbeddingFunctionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Tenant string  `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Name   *string `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+}
+
+func (x *GetEmbeddingFunctionsRequest) Reset() {
+	*x = GetEmbeddingFunctionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEmbeddingFunctionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmbeddingFunctionsRequest) ProtoMessage() {}
+
+func (x *GetEmbeddingFunctionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmbeddingFunctionsRequest.ProtoReflect.Descriptor instead.
+func (*GetEmbeddingFunctionsRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *GetEmbeddingFunctionsRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *GetEmbeddingFunctionsRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *GetEmbeddingFunctionsRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type GetEmbeddingFunctionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmbeddingFunctions []*EmbeddingFunction `protobuf:"bytes,1,rep,name=embedding_functions,json=embeddingFunctions,proto3" json:"embedding_functions,omitempty"`
+	Status             *Status              `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetEmbeddingFunctionsResponse) Reset() {
+	*x = GetEmbeddingFunctionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEmbeddingFunctionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmbeddingFunctionsResponse) ProtoMessage() {}
+
+func (x *GetEmbeddingFunctionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmbeddingFunctionsResponse.ProtoReflect.Descriptor instead.
+func (*GetEmbeddingFunctionsResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *GetEmbeddingFunctionsResponse) GetEmbeddingFunctions() []*EmbeddingFunction {
+	if x != nil {
+		return x.EmbeddingFunctions
+	}
+	return nil
+}
+
+func (x *GetEmbeddingFunctionsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// The fields that are not set are left as they are. The collections that
+// reference the embedding function are not marked for reindexing.
+type UpdateEmbeddingFunctionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Provider *string `protobuf:"bytes,3,opt,name=provider,proto3,oneof" json:"provider,omitempty"`
+	Model    *string `protobuf:"bytes,4,opt,name=model,proto3,oneof" json:"model,omitempty"`
+	Params   *string `protobuf:"bytes,5,opt,name=params,proto3,oneof" json:"params,omitempty"`
+}
+
+func (x *UpdateEmbeddingFunctionRequest) Reset() {
+	*x = UpdateEmbeddingFunctionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateEmbeddingFunctionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEmbeddingFunctionRequest) ProtoMessage() {}
+
+func (x *UpdateEmbeddingFunctionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEmbeddingFunctionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEmbeddingFunctionRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *UpdateEmbeddingFunctionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateEmbeddingFunctionRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateEmbeddingFunctionRequest) GetProvider() string {
+	if x != nil && x.Provider != nil {
+		return *x.Provider
+	}
+	return ""
+}
+
+func (x *UpdateEmbeddingFunctionRequest) GetModel() string {
+	if x != nil && x.Model != nil {
+		return *x.Model
+	}
+	return ""
+}
+
+func (x *UpdateEmbeddingFunctionRequest) GetParams() string {
+	if x != nil && x.Params != nil {
+		return *x.Params
+	}
+	return ""
+}
+
+type UpdateEmbeddingFunctionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmbeddingFunction *EmbeddingFunction `protobuf:"bytes,1,opt,name=embedding_function,json=embeddingFunction,proto3" json:"embedding_function,omitempty"`
+	Status            *Status            `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *UpdateEmbeddingFunctionResponse) Reset() {
+	*x = UpdateEmbeddingFunctionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateEmbeddingFunctionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEmbeddingFunctionResponse) ProtoMessage() {}
+
+func (x *UpdateEmbeddingFunctionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEmbeddingFunctionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEmbeddingFunctionResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *UpdateEmbeddingFunctionResponse) GetEmbeddingFunction() *EmbeddingFunction {
+	if x != nil {
+		return x.EmbeddingFunction
+	}
+	return nil
+}
+
+func (x *UpdateEmbeddingFunctionResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Fails with 409 while collections, soft deleted ones included, reference the
+// embedding function.
+type DeleteEmbeddingFunctionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteEmbeddingFunctionRequest) Reset() {
+	*x = DeleteEmbeddingFunctionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteEmbeddingFunctionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEmbeddingFunctionRequest) ProtoMessage() {}
+
+func (x *DeleteEmbeddingFunctionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEmbeddingFunctionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEmbeddingFunctionRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *DeleteEmbeddingFunctionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteEmbeddingFunctionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *DeleteEmbeddingFunctionResponse) Reset() {
+	*x = DeleteEmbeddingFunctionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteEmbeddingFunctionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEmbeddingFunctionResponse) ProtoMessage() {}
+
+func (x *DeleteEmbeddingFunctionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEmbeddingFunctionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEmbeddingFunctionResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *DeleteEmbeddingFunctionResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Declares the type of a document metadata key. indexed is a hint for query
+// planners, the sysdb only stores it.
+type CollectionSchemaField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type    CollectionSchemaFieldType `protobuf:"varint,2,opt,name=type,proto3,enum=chroma.CollectionSchemaFieldType" json:"type,omitempty"`
+	Indexed bool                      `protobuf:"varint,3,opt,name=indexed,proto3" json:"indexed,omitempty"`
+}
+
+func (x *CollectionSchemaField) Reset() {
+	*x = CollectionSchemaField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[179]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionSchemaField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionSchemaField) ProtoMessage() {}
+
+func (x *CollectionSchemaField) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[179]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionSchemaField.ProtoReflect.Descriptor instead.
+func (*CollectionSchemaField) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *CollectionSchemaField) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CollectionSchemaField) GetType() CollectionSchemaFieldType {
+	if x != nil {
+		return x.Type
+	}
+	return CollectionSchemaFieldType_FIELD_TYPE_STRING
+}
+
+func (x *CollectionSchemaField) GetIndexed() bool {
+	if x != nil {
+		return x.Indexed
+	}
+	return false
+}
+
+// Replaces the schema of the collection, an empty list of fields removes it.
+// Field names must be distinct.
+type SetCollectionSchemaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId string                   `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Fields       []*CollectionSchemaField `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *SetCollectionSchemaRequest) Reset() {
+	*x = SetCollectionSchemaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCollectionSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollectionSchemaRequest) ProtoMessage() {}
+
+func (x *SetCollectionSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCollectionSchemaRequest.ProtoReflect.Descriptor instead.
+func (*SetCollectionSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *SetCollectionSchemaRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *SetCollectionSchemaRequest) GetFields() []*CollectionSchemaField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type SetCollectionSchemaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *SetCollectionSchemaResponse) Reset() {
+	*x = SetCollectionSchemaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCollectionSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollectionSchemaResponse) ProtoMessage() {}
+
+func (x *SetCollectionSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCollectionSchemaResponse.ProtoReflect.Descriptor instead.
+func (*SetCollectionSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *SetCollectionSchemaResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type GetCollectionSchemaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId string `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+}
+
+func (x *GetCollectionSchemaRequest) Reset() {
+	*x = GetCollectionSchemaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionSchemaRequest) ProtoMessage() {}
+
+func (x *GetCollectionSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionSchemaRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *GetCollectionSchemaRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+// The fields are ordered by name.
+type GetCollectionSchemaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields []*CollectionSchemaField `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	Status *Status                  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetCollectionSchemaResponse) Reset() {
+	*x = GetCollectionSchemaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[183]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionSchemaResponse) ProtoMessage() {}
+
+func (x *GetCollectionSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[183]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionSchemaResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *GetCollectionSchemaResponse) GetFields() []*CollectionSchemaField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *GetCollectionSchemaResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Checks up to 1000 document metadata against the schema of the collection.
+// Keys the schema does not declare can hold any type, and int values are
+// accepted for float fields.
+type ValidateCollectionMetadataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId string            `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Metadatas    []*UpdateMetadata `protobuf:"bytes,2,rep,name=metadatas,proto3" json:"metadatas,omitempty"`
+}
+
+func (x *ValidateCollectionMetadataRequest) Reset() {
+	*x = ValidateCollectionMetadataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[184]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateCollectionMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateCollectionMetadataRequest) ProtoMessage() {}
+
+func (x *ValidateCollectionMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[184]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateCollectionMetadataRequest.ProtoReflect.Descriptor instead.
+func (*ValidateCollectionMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *ValidateCollectionMetadataRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *ValidateCollectionMetadataRequest) GetMetadatas() []*UpdateMetadata {
+	if x != nil {
+		return x.Metadatas
+	}
+	return nil
+}
+
+// A value whose type is not the one the schema declares for its key. index is
+// the position of the metadata in the request.
+type CollectionSchemaViolation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index    int32                     `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Key      string                    `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Expected CollectionSchemaFieldType `protobuf:"varint,3,opt,name=expected,proto3,enum=chroma.CollectionSchemaFieldType" json:"expected,omitempty"`
+	Actual   CollectionSchemaFieldType `protobuf:"varint,4,opt,name=actual,proto3,enum=chroma.CollectionSchemaFieldType" json:"actual,omitempty"`
+}
+
+func (x *CollectionSchemaViolation) Reset() {
+	*x = CollectionSchemaViolation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[185]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionSchemaViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionSchemaViolation) ProtoMessage() {}
+
+func (x *CollectionSchemaViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[185]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionSchemaViolation.ProtoReflect.Descriptor instead.
+func (*CollectionSchemaViolation) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *CollectionSchemaViolation) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *CollectionSchemaViolation) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CollectionSchemaViolation) GetExpected() CollectionSchemaFieldType {
+	if x != nil {
+		return x.Expected
+	}
+	return CollectionSchemaFieldType_FIELD_TYPE_STRING
+}
+
+func (x *CollectionSchemaViolation) GetActual() CollectionSchemaFieldType {
+	if x != nil {
+		return x.Actual
+	}
+	return CollectionSchemaFieldType_FIELD_TYPE_STRING
+}
+
+// The metadata are valid when there are no violations.
+type ValidateCollectionMetadataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Violations []*CollectionSchemaViolation `protobuf:"bytes,1,rep,name=violations,proto3" json:"violations,omitempty"`
+	Status     *Status                      `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ValidateCollectionMetadataResponse) Reset() {
+	*x = ValidateCollectionMetadataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[186]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateCollectionMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateCollectionMetadataResponse) ProtoMessage() {}
+
+func (x *ValidateCollectionMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[186]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateCollectionMetadataResponse.ProtoReflect.Descriptor instead.
+func (*ValidateCollectionMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *ValidateCollectionMetadataResponse) GetViolations() []*CollectionSchemaViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+func (x *ValidateCollectionMetadataResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// A collection configuration of a tenant that collections are created from.
+// max_records and max_size_bytes limit each collection created from the
+// template, the collections keep a reference to it for them. created_at is in
+// unix seconds.
+type CollectionTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tenant            string  `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Name              string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Dimension         *int32  `protobuf:"varint,4,opt,name=dimension,proto3,oneof" json:"dimension,omitempty"`
+	DistanceFunction  *string `protobuf:"bytes,5,opt,name=distance_function,json=distanceFunction,proto3,oneof" json:"distance_function,omitempty"`
+	ReplicationFactor *int32  `protobuf:"varint,6,opt,name=replication_factor,json=replicationFactor,proto3,oneof" json:"replication_factor,omitempty"`
+	// The default metadata of the collections, including their HNSW parameters.
+	Metadata     *UpdateMetadata `protobuf:"bytes,7,opt,name=metadata,proto3,oneof" json:"metadata,omitempty"`
+	MaxRecords   *int64          `protobuf:"varint,8,opt,name=max_records,json=maxRecords,proto3,oneof" json:"max_records,omitempty"`
+	MaxSizeBytes *int64          `protobuf:"varint,9,opt,name=max_size_bytes,json=maxSizeBytes,proto3,oneof" json:"max_size_bytes,omitempty"`
+	CreatedAt    int64           `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *CollectionTemplate) Reset() {
+	*x = CollectionTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[187]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionTemplate) ProtoMessage() {}
+
+func (x *CollectionTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[187]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionTemplate.ProtoReflect.Descriptor instead.
+func (*CollectionTemplate) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *CollectionTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CollectionTemplate) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *CollectionTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CollectionTemplate) GetDimension() int32 {
+	if x != nil && x.Dimension != nil {
+		return *x.Dimension
+	}
+	return 0
+}
+
+func (x *CollectionTemplate) GetDistanceFunction() string {
+	if x != nil && x.DistanceFunction != nil {
+		return *x.DistanceFunction
+	}
+	return ""
+}
+
+func (x *CollectionTemplate) GetReplicationFactor() int32 {
+	if x != nil && x.ReplicationFactor != nil {
+		return *x.ReplicationFactor
+	}
+	return 0
+}
+
+func (x *CollectionTemplate) GetMetadata() *UpdateMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CollectionTemplate) GetMaxRecords() int64 {
+	if x != nil && x.MaxRecords != nil {
+		return *x.MaxRecords
+	}
+	return 0
+}
+
+func (x *CollectionTemplate) GetMaxSizeBytes() int64 {
+	if x != nil && x.MaxSizeBytes != nil {
+		return *x.MaxSizeBytes
+	}
+	return 0
+}
+
+func (x *CollectionTemplate) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// The id is generated when it is empty. Names are unique per tenant.
+type CreateCollectionTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Template *CollectionTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (x *CreateCollectionTemplateRequest) Reset() {
+	*x = CreateCollectionTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[188]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCollectionTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionTemplateRequest) ProtoMessage() {}
+
+func (x *CreateCollectionTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[188]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectionTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *CreateCollectionTemplateRequest) GetTemplate() *CollectionTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type CreateCollectionTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Template *CollectionTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	Status   *Status             `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *CreateCollectionTemplateResponse) Reset() {
+	*x = CreateCollectionTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[189]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCollectionTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionTemplateResponse) ProtoMessage() {}
+
+func (x *CreateCollectionTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[189]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateCollectionTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *CreateCollectionTemplateResponse) GetTemplate() *CollectionTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *CreateCollectionTemplateResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Returns the template with the id when it is set, and the templates of the
+// tenant otherwise, or only the one with the name.
+type GetCollectionTemplatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Tenant string  `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Name   *string `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+}
+
+func (x *GetCollectionTemplatesRequest) Reset() {
+	*x = GetCollectionTemplatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[190]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionTemplatesRequest) ProtoMessage() {}
+
+func (x *GetCollectionTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[190]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *GetCollectionTemplatesRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *GetCollectionTemplatesRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *GetCollectionTemplatesRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type GetCollectionTemplatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Templates []*CollectionTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	Status    *Status               `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetCollectionTemplatesResponse) Reset() {
+	*x = GetCollectionTemplatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[191]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionTemplatesResponse) ProtoMessage() {}
+
+func (x *GetCollectionTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[191]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *GetCollectionTemplatesResponse) GetTemplates() []*CollectionTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+func (x *GetCollectionTemplatesResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// The collections created from the template keep their configuration but no
+// longer reference it.
+type DeleteCollectionTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteCollectionTemplateRequest) Reset() {
+	*x = DeleteCollectionTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[192]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCollectionTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteCollectionTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[192]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *DeleteCollectionTemplateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteCollectionTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *DeleteCollectionTemplateResponse) Reset() {
+	*x = DeleteCollectionTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[193]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCollectionTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteCollectionTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[193]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *DeleteCollectionTemplateResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Creates collection with the configuration of the template with template_id,
+// or of the template of its tenant named template_name. The metadata of the
+// collection is merged over the default metadata of the template but must not
+// change its HNSW parameters, and dimension, distance_function and
+// replication_factor must not be set.
+type CreateCollectionFromTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TemplateId   *string                  `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3,oneof" json:"template_id,omitempty"`
+	TemplateName string                   `protobuf:"bytes,2,opt,name=template_name,json=templateName,proto3" json:"template_name,omitempty"`
+	Collection   *CreateCollectionRequest `protobuf:"bytes,3,opt,name=collection,proto3" json:"collection,omitempty"`
+}
+
+func (x *CreateCollectionFromTemplateRequest) Reset() {
+	*x = CreateCollectionFromTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[194]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCollectionFromTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionFromTemplateRequest) ProtoMessage() {}
+
+func (x *CreateCollectionFromTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[194]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionFromTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectionFromTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *CreateCollectionFromTemplateRequest) GetTemplateId() string {
+	if x != nil && x.TemplateId != nil {
+		return *x.TemplateId
+	}
+	return ""
+}
+
+func (x *CreateCollectionFromTemplateRequest) GetTemplateName() string {
+	if x != nil {
+		return x.TemplateName
+	}
+	return ""
+}
+
+func (x *CreateCollectionFromTemplateRequest) GetCollection() *CreateCollectionRequest {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+type CreateCollectionFromTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection *Collection `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Status     *Status     `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *CreateCollectionFromTemplateResponse) Reset() {
+	*x = CreateCollectionFromTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[195]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCollectionFromTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionFromTemplateResponse) ProtoMessage() {}
+
+func (x *CreateCollectionFromTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[195]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionFromTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateCollectionFromTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *CreateCollectionFromTemplateResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+func (x *CreateCollectionFromTemplateResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Puts the whole sysdb in maintenance, or takes it out of it, e.g. for a
+// migration or a region failover. In maintenance every mutating RPC but this one
+// fails with a retryable UNAVAILABLE MAINTENANCE error, reads are served as
+// usual. reason is required to enable maintenance and is returned to the
+// rejected callers. Replicas see the change within maintenance-mode-cache-ttl.
+type SetMaintenanceModeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *SetMaintenanceModeRequest) Reset() {
+	*x = SetMaintenanceModeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[196]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMaintenanceModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaintenanceModeRequest) ProtoMessage() {}
+
+func (x *SetMaintenanceModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[196]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaintenanceModeRequest.ProtoReflect.Descriptor instead.
+func (*SetMaintenanceModeRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *SetMaintenanceModeRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetMaintenanceModeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SetMaintenanceModeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *SetMaintenanceModeResponse) Reset() {
+	*x = SetMaintenanceModeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[197]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMaintenanceModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaintenanceModeResponse) ProtoMessage() {}
+
+func (x *SetMaintenanceModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[197]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaintenanceModeResponse.ProtoReflect.Descriptor instead.
+func (*SetMaintenanceModeResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *SetMaintenanceModeResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type GetMaintenanceModeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetMaintenanceModeRequest) Reset() {
+	*x = GetMaintenanceModeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[198]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMaintenanceModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceModeRequest) ProtoMessage() {}
+
+func (x *GetMaintenanceModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[198]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceModeRequest.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceModeRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{198}
+}
+
+// updated_at is in unix seconds, zero when maintenance was never set.
+type GetMaintenanceModeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled   bool    `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Reason    string  `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	UpdatedAt int64   `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Status    *Status `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetMaintenanceModeResponse) Reset() {
+	*x = GetMaintenanceModeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[199]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMaintenanceModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceModeResponse) ProtoMessage() {}
+
+func (x *GetMaintenanceModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[199]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceModeResponse.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceModeResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *GetMaintenanceModeResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *GetMaintenanceModeResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *GetMaintenanceModeResponse) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *GetMaintenanceModeResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// A long-running operation run in the background by the coordinator. Times
+// are in unix seconds, finished_at is 0 until the job succeeded, failed or was canceled.
+type Job struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Tenant string `protobuf:"bytes,3,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// One of pending, running, succeeded, failed and canceled. A pending job
+	// that failed an attempt is retried at next_run_at.
+	State       string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Attempts    int32  `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	MaxAttempts int32  `protobuf:"varint,6,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// The error of the last failed attempt.
+	LastError  string `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	NextRunAt  int64  `protobuf:"varint,8,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	CreatedAt  int64  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  int64  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	FinishedAt int64  `protobuf:"varint,11,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[200]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[200]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *Job) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Job) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Job) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *Job) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Job) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Job) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *Job) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Job) GetNextRunAt() int64 {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return 0
+}
+
+func (x *Job) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Job) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Job) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+type GetJobStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetJobStatusRequest) Reset() {
+	*x = GetJobStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[201]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJobStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobStatusRequest) ProtoMessage() {}
+
+func (x *GetJobStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[201]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetJobStatusRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *GetJobStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetJobStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job    *Job    `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Status *Status `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *GetJobStatusResponse) Reset() {
+	*x = GetJobStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[202]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJobStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobStatusResponse) ProtoMessage() {}
+
+func (x *GetJobStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[202]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetJobStatusResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{202}
+}
+
+func (x *GetJobStatusResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *GetJobStatusResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Lists the jobs matching the filters that are set, the most recently created
+// first.
+type ListJobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenant *string `protobuf:"bytes,1,opt,name=tenant,proto3,oneof" json:"tenant,omitempty"`
+	Type   *string `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	State  *string `protobuf:"bytes,3,opt,name=state,proto3,oneof" json:"state,omitempty"`
+	Limit  int32   `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32   `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	// The next_page_token of the previous page, which fixes the filters and the
+	// offset of the next page.
+	PageToken *string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+}
+
+func (x *ListJobsRequest) Reset() {
+	*x = ListJobsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[203]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsRequest) ProtoMessage() {}
+
+func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[203]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{203}
+}
+
+func (x *ListJobsRequest) GetTenant() string {
+	if x != nil && x.Tenant != nil {
+		return *x.Tenant
+	}
+	return ""
+}
+
+func (x *ListJobsRequest) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *ListJobsRequest) GetState() string {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return ""
+}
+
+func (x *ListJobsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListJobsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListJobsRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+type ListJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jobs   []*Job  `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	Status *Status `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Empty on the last page.
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListJobsResponse) Reset() {
+	*x = ListJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[204]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsResponse) ProtoMessage() {}
+
+func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[204]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{204}
+}
+
+func (x *ListJobsResponse) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *ListJobsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListJobsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Cancels a pending or running job. A running job stops when its worker next
+// renews its lease, what it did so far is not undone.
+type CancelJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CancelJobRequest) Reset() {
+	*x = CancelJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[205]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobRequest) ProtoMessage() {}
+
+func (x *CancelJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[205]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelJobRequest) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{205}
+}
+
+func (x *CancelJobRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelJobResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Job    *Job    `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Status *Status `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *CancelJobResponse) Reset() {
+	*x = CancelJobResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chromadb_proto_coordinator_proto_msgTypes[206]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobResponse) ProtoMessage() {}
+
+func (x *CancelJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chromadb_proto_coordinator_proto_msgTypes[206]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobResponse.ProtoReflect.Descriptor instead.
+func (*CancelJobResponse) Descriptor() ([]byte, []int) {
+	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{206}
+}
+
+func (x *CancelJobResponse) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *CancelJobResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+var File_chromadb_proto_coordinator_proto protoreflect.FileDescriptor
+
+var file_chromadb_proto_coordinator_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x64, 0x62, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x06, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x1a, 0x1b, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x64, 0x62, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x53, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0x6e, 0x0a, 0x16, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a,
+	0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x22, 0x5c, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x13, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88,
+	0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x22, 0x6b, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x26,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x5b, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x21, 0x0a, 0x0a, 0x6b, 0x6d, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x6b, 0x6d, 0x73, 0x4b, 0x65, 0x79, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6b, 0x6d, 0x73, 0x5f, 0x6b, 0x65, 0x79,
+	0x5f, 0x69, 0x64, 0x22, 0x3e, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x68, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b,
+	0x65, 0x74, 0x61, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x65, 0x74, 0x61, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x26, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x63, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x13, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x2d, 0x0a, 0x12,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x22, 0x55, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x75,
+	0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x75, 0x0a, 0x1f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x7f, 0x0a, 0x20,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x41, 0x0a,
+	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0x3f, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x54, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x3f, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xc1, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x48, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x23,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b,
+	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x48, 0x05,
+	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x06, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42,
+	0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x92, 0x01, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0xfc, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x10,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x48, 0x01, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x27, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x13, 0x0a, 0x11, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x11, 0x0a,
+	0x0f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x22, 0x3f, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0xd1, 0x05, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x37, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x64, 0x69,
+	0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52,
+	0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a,
+	0x0d, 0x67, 0x65, 0x74, 0x5f, 0x6f, 0x72, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x0b, 0x67, 0x65, 0x74, 0x4f, 0x72, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x1d, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x66,
+	0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x03, 0x52, 0x19, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x49, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x2b, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21,
+	0x0a, 0x0a, 0x6b, 0x6d, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x04, 0x52, 0x08, 0x6b, 0x6d, 0x73, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x32, 0x0a, 0x12, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x48, 0x05, 0x52,
+	0x11, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x06, 0x52, 0x10, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x15, 0x65, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x13, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64,
+	0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x67, 0x65, 0x74, 0x5f, 0x6f, 0x72, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x20, 0x0a,
+	0x1e, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x6b, 0x6d, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x42, 0x15,
+	0x0a, 0x13, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x18, 0x0a, 0x16, 0x5f,
+	0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x90, 0x01, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x17, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x42, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x9d, 0x03, 0x0a, 0x18, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x54, 0x0a, 0x15, 0x73, 0x69, 0x6e,
+	0x67, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x13, 0x73, 0x69, 0x6e, 0x67,
+	0x6c, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x12,
+	0x4b, 0x0a, 0x12, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x5f, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x10, 0x73, 0x69, 0x6e, 0x67,
+	0x6c, 0x65, 0x49, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x54, 0x0a, 0x15,
+	0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x44, 0x6f, 0x75, 0x62, 0x6c,
+	0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x13, 0x73,
+	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x6e, 0x64, 0x12, 0x4e, 0x0a, 0x13, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x62, 0x6f, 0x6f,
+	0x6c, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x42,
+	0x6f, 0x6f, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x48, 0x00, 0x52,
+	0x11, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x6e, 0x64, 0x12, 0x18, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x42, 0x0c, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x22, 0xb5, 0x05, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4b,
+	0x0a, 0x10, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0f, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x61, 0x67, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4c, 0x6f, 0x67,
+	0x4c, 0x61, 0x67, 0x12, 0x21, 0x0a, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x48, 0x04, 0x52, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x05, 0x52, 0x10, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4d,
+	0x61, 0x73, 0x6b, 0x12, 0x27, 0x0a, 0x0d, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x6e, 0x65, 0x5f, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x0b, 0x69, 0x66,
+	0x4e, 0x6f, 0x6e, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b,
+	0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x07, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x10, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x14, 0x0a, 0x12, 0x5f,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x6e, 0x65, 0x5f, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0xb2, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x65,
+	0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xd2, 0x04, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09,
+	0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48,
+	0x03, 0x52, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x34, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
+	0x0d, 0x72, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2c,
+	0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x12,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x05, 0x52, 0x11, 0x72, 0x65, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01,
+	0x12, 0x30, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x48, 0x06, 0x52, 0x10, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x34, 0x0a, 0x15, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x13, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x75,
+	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x18, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x5f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x16, 0x72, 0x65,
+	0x73, 0x65, 0x74, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x11, 0x0a, 0x0f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x1b, 0x0a, 0x19, 0x65, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x12, 0x0a, 0x10, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x42,
+	0x15, 0x0a, 0x13, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x85, 0x01, 0x0a,
+	0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x85, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x00, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x76, 0x0a, 0x18,
+	0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x6f, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3c, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x44, 0x0a, 0x25, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x69, 0x0a, 0x18, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x6f,
+	0x72, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5f, 0x0a, 0x1b, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x18, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4c, 0x61,
+	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0x88, 0x01, 0x0a, 0x25, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5f, 0x0a, 0x1b, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4c,
+	0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x52, 0x18, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xde, 0x01, 0x0a, 0x1a,
+	0x46, 0x6c, 0x75, 0x73, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x50, 0x0a, 0x0a, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x1a, 0x4f, 0x0a, 0x0e, 0x46,
+	0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x27, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68,
+	0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa5, 0x05, 0x0a,
+	0x20, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x67, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x5a, 0x0a, 0x17, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x46, 0x6c, 0x75, 0x73, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x15, 0x73, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x46, 0x0a, 0x1d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x1a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x1a, 0x73, 0x69, 0x7a,
+	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52,
+	0x17, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x66,
+	0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x02, 0x52, 0x0c, 0x66, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0b, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a,
+	0x15, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x13,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x20, 0x0a, 0x1e, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x66, 0x65, 0x6e, 0x63,
+	0x69, 0x6e, 0x67, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x22, 0xa9, 0x01, 0x0a, 0x21, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30,
+	0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6c, 0x61,
+	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0x61, 0x0a, 0x24, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x22, 0x74, 0x0a, 0x25, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x66, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x1b, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x53, 0x69,
+	0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x38, 0x0a,
+	0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x52, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xb4, 0x01,
+	0x0a, 0x0e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x1d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x1a, 0x73, 0x69, 0x7a, 0x65, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x73, 0x69, 0x7a,
+	0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x80, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69,
+	0x7a, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x51, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x1c, 0x47,
+	0x65, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x91, 0x01, 0x0a, 0x17, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x5f, 0x73, 0x6f, 0x66, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x6f, 0x66,
+	0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x22, 0x58, 0x0a, 0x18, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x70, 0x0a, 0x16, 0x53, 0x63, 0x61, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x26, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x9a, 0x01, 0x0a, 0x17, 0x53, 0x63, 0x61, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x63,
+	0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0d, 0x6d,
+	0x69, 0x6e, 0x41, 0x67, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x42,
+	0x12, 0x0a, 0x10, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x14, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x49, 0x0a, 0x21, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x49, 0x6e, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x4c, 0x0a, 0x22,
+	0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x65, 0x0a, 0x22, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2b, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0d, 0x6d, 0x69, 0x6e,
+	0x41, 0x67, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a,
+	0x10, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x22, 0xc7, 0x01, 0x0a, 0x16, 0x49, 0x6e, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x23,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x49, 0x6e, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xbb, 0x01,
+	0x0a, 0x18, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x40, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x49, 0x6e, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x78, 0x0a, 0x20, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x69, 0x6d, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4b, 0x0a, 0x21, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc4, 0x01, 0x0a,
+	0x0b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x71,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x55, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x70, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d,
+	0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x26, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x5f, 0x0a, 0x10, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x26,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x76, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x77,
+	0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12,
+	0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4c, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x77, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xa9,
+	0x01, 0x0a, 0x24, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x39,
+	0x0a, 0x0c, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x73, 0x65,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x25, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x75, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x63, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x47, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x64, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e,
+	0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x69,
+	0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x22, 0x4a, 0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6c, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x3e, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x22, 0x78, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34,
+	0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xdc, 0x02, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x54,
+	0x6f, 0x47, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x12, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x10, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x54,
+	0x68, 0x61, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a,
+	0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x26, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x15, 0x0a, 0x13,
+	0x5f, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x61, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa9, 0x01, 0x0a, 0x0e,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x47, 0x63, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0xea, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x54, 0x6f, 0x47, 0x63, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x6f, 0x47, 0x63, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x2d, 0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x6e, 0x65,
+	0x78, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42,
+	0x13, 0x0a, 0x11, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x22, 0xc3, 0x01, 0x0a, 0x0b, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x12, 0x2c, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52,
+	0x0e, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x02, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x5a, 0x0a, 0x15, 0x53, 0x65,
+	0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x71,
+	0x75, 0x6f, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52,
+	0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x40, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0x6b, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x26,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xff, 0x01, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x65, 0x6c, 0x74,
+	0x61, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x5f, 0x64, 0x65, 0x6c,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x44, 0x65, 0x6c, 0x74, 0x61,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0xc6, 0x01, 0x0a, 0x0e, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x22, 0x8e, 0x01, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x12, 0x36, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x78, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x12, 0x29, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x42, 0x0a, 0x18,
+	0x53, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x4e, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x22, 0x8e, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29,
+	0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0xaf, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x6f, 0x67, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6c, 0x6f, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x64, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x67, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c,
+	0x6f, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x79, 0x0a, 0x1d, 0x53, 0x65, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x21, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x73, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x95, 0x01,
+	0x0a, 0x22, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x13, 0x0a, 0x05, 0x61, 0x73, 0x5f, 0x6f,
+	0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x12, 0x26, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x40, 0x0a, 0x17, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x71, 0x0a, 0x13, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
+	0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x18, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x78, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x55,
+	0x0a, 0x18, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x43, 0x0a, 0x19, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x9c, 0x02, 0x0a, 0x11, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x2a, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x6c,
+	0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x2f, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0f, 0x6c, 0x61,
+	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01,
+	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x43, 0x0a, 0x1c, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x7e,
+	0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x35, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xa2,
+	0x02, 0x0a, 0x0b, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x6f,
+	0x74, 0x68, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x10, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x42, 0x14, 0x0a,
+	0x12, 0x5f, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x6f, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x75, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0x65, 0x0a, 0x15, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x29, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x21,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44,
+	0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x52, 0x0a, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x47, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x22, 0x76, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x36, 0x0a, 0x1c, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x22, 0x89, 0x01, 0x0a, 0x1c, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xfc, 0x01,
+	0x0a, 0x1d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63,
+	0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xc0, 0x01, 0x0a,
+	0x0b, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x32, 0x0a, 0x12, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00,
+	0x52, 0x11, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x72, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22,
+	0x40, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x04, 0x66, 0x6c, 0x61,
+	0x67, 0x22, 0x40, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46,
+	0x6c, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x46, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0x43, 0x0a, 0x19, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x19, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46,
+	0x6c, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6d, 0x0a, 0x18, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x05, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3a, 0x0a, 0x0f, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x52,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6a, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x09, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x22, 0x44, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x33, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0x7b, 0x0a,
+	0x1a, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4d, 0x0a, 0x1b, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x22, 0x97, 0x01, 0x0a, 0x1c, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x44, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xb2, 0x01, 0x0a, 0x1e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2d, 0x0a,
+	0x12, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4a,
+	0x0a, 0x17, 0x44, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x22, 0x8b, 0x02, 0x0a, 0x18, 0x44,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x64, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x33, 0x0a, 0x15, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x14, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2b, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x72,
+	0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e,
+	0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x11, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x76,
+	0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7f, 0x0a, 0x0c,
+	0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x07,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e,
+	0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65,
+	0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x76, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x22, 0x6d, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x05, 0x6e,
+	0x6f, 0x64, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x9e, 0x01, 0x0a,
+	0x22, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x6e, 0x6f, 0x64,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x4e,
+	0x6f, 0x64, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x8c, 0x01,
+	0x0a, 0x17, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4e, 0x6f,
+	0x64, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x69,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x89, 0x01, 0x0a,
+	0x08, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65,
+	0x49, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x33, 0x0a, 0x15, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x14, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8f, 0x02, 0x0a, 0x23, 0x53, 0x69, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2b, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a,
+	0x11, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x0a, 0x05, 0x6d, 0x6f,
+	0x76, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x52, 0x05, 0x6d, 0x6f, 0x76, 0x65,
+	0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x4c, 0x6f, 0x61,
+	0x64, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x1a, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xde,
+	0x03, 0x0a, 0x0f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x00, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x13, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x01, 0x52, 0x12, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x15, 0x6f,
+	0x6c, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x13, 0x6f, 0x6c,
+	0x64, 0x65, 0x73, 0x74, 0x55, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x16, 0x0a,
+	0x14, 0x5f, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74,
+	0x5f, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22,
+	0x9c, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x26,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5a,
+	0x0a, 0x1f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x69, 0x7a, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x22, 0x6e, 0x0a, 0x16, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x20, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3c, 0x0a, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x26, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x46, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x22, 0xb5, 0x02,
+	0x0a, 0x0e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x64,
+	0x61, 0x79, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x70, 0x75, 0x72, 0x67, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x50, 0x75, 0x72, 0x67, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x63, 0x74, 0x65, 0x64, 0x22, 0x9d, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12,
+	0x2c, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x26, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xce, 0x01, 0x0a, 0x14, 0x53, 0x6f, 0x66, 0x74, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x72, 0x67, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x70, 0x75, 0x72, 0x67, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x23, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x66,
+	0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xf1, 0x02, 0x0a, 0x22,
+	0x47, 0x65, 0x74, 0x53, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x65,
+	0x61, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72,
+	0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75,
+	0x6e, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53,
+	0x6f, 0x66, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72,
+	0x52, 0x75, 0x6e, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x75, 0x6e, 0x12, 0x23, 0x0a, 0x0d,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x75, 0x72, 0x67, 0x65,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x75,
+	0x72, 0x67, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x33, 0x0a, 0x1b, 0x52, 0x75, 0x6e, 0x53, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0x76, 0x0a, 0x1c, 0x52, 0x75, 0x6e, 0x53, 0x6f, 0x66, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x03, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x6f, 0x66, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x52, 0x75, 0x6e, 0x52,
+	0x03, 0x72, 0x75, 0x6e, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x61, 0x0a, 0x1d,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x73,
+	0x6b, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x64,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x22,
+	0x92, 0x02, 0x0a, 0x11, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61,
+	0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x22, 0x7d, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x65, 0x61,
+	0x6e, 0x75, 0x70, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x59, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x65,
+	0x61, 0x6e, 0x75, 0x70, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61,
+	0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x22, 0x49,
+	0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x44,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x11, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0a, 0x6b, 0x6d, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x6b, 0x6d, 0x73, 0x4b, 0x65,
+	0x79, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x05, 0x71, 0x75, 0x6f,
+	0x74, 0x61, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6b, 0x6d, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69,
+	0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x22, 0x50,
+	0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x07, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73,
+	0x22, 0x4e, 0x0a, 0x0c, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x74, 0x0a, 0x1a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x26,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x63, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x44,
+	0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8a, 0x01, 0x0a, 0x18,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2b, 0x0a, 0x02, 0x74,
+	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x02, 0x74, 0x6f, 0x22, 0xcd, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a,
+	0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xd7, 0x01, 0x0a, 0x11, 0x45, 0x6d, 0x62,
+	0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x6a, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x62, 0x65,
+	0x64, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x12, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64,
+	0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x65, 0x6d, 0x62,
+	0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x93,
+	0x01, 0x0a, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69,
+	0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e,
+	0x67, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x65, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x74, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69,
+	0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x1d, 0x47,
+	0x65, 0x74, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x13,
+	0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x46,
+	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0xcd, 0x01, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a,
+	0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x05,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x22, 0x93, 0x01, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64,
+	0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x65, 0x6d, 0x62,
+	0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x30, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x49, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x7c, 0x0a, 0x15, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x35, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x64, 0x22, 0x78, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x45, 0x0a, 0x1b, 0x53,
+	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x41, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x7c, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x7e, 0x0a, 0x21, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x34, 0x0a,
+	0x09, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x09, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x19, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3d, 0x0a, 0x08, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x75,
+	0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x61, 0x63, 0x74,
+	0x75, 0x61, 0x6c, 0x22, 0x8f, 0x01, 0x0a, 0x22, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x76, 0x69,
+	0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xed, 0x03, 0x0a, 0x12, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x09, 0x64, 0x69, 0x6d, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x09, 0x64,
+	0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x64,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x10, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a,
+	0x12, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x11, 0x72, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x88, 0x01,
+	0x01, 0x12, 0x37, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x03, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x6d, 0x61,
+	0x78, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x29, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x48, 0x05, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x53,
+	0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64,
+	0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x64, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x15,
+	0x0a, 0x13, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x59, 0x0a, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x22, 0x82, 0x01, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x75, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03,
+	0x5f, 0x69, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x82, 0x01, 0x0a,
+	0x1e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x38, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x09,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x31, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x4a, 0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0xc1, 0x01, 0x0a, 0x23, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x23,
+	0x0a, 0x0d, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x5f, 0x69, 0x64, 0x22, 0x82, 0x01, 0x0a, 0x24, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4d, 0x0a, 0x19, 0x53, 0x65, 0x74,
+	0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x44, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x4d,
+	0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x1b,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0xb4, 0x02, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78,
+	0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0b, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x22, 0x25, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x5d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x03, 0x6a, 0x6f, 0x62,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0xe1, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x6a, 0x6f, 0x62,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x22, 0x0a, 0x10, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5a,
+	0x0a, 0x11, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a,
+	0x6f, 0x62, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0x2f, 0x0a, 0x14, 0x44, 0x65,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45,
+	0x54, 0x45, 0x5f, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x54, 0x10, 0x00, 0x2a, 0x3a, 0x0a, 0x15, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x42, 0x79, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x52, 0x45,
+	0x43, 0x4f, 0x52, 0x44, 0x53, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x49, 0x5a, 0x45, 0x5f,
+	0x42, 0x59, 0x54, 0x45, 0x53, 0x10, 0x01, 0x2a, 0x71, 0x0a, 0x19, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x46,
+	0x49, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x10, 0x01, 0x12,
+	0x14, 0x0a, 0x10, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x4c,
+	0x4f, 0x41, 0x54, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x42, 0x4f, 0x4f, 0x4c, 0x10, 0x03, 0x32, 0xba, 0x42, 0x0a, 0x05, 0x53,
+	0x79, 0x73, 0x44, 0x42, 0x12, 0x51, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x12, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42,
+	0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x59, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x57,
+	0x69, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x00, 0x30, 0x01, 0x12, 0x6f, 0x0a, 0x18, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0d, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0d, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57,
+	0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0a,
+	0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x81, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74,
+	0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x4c,
+	0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x53, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x72, 0x0a, 0x19, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x46, 0x6c, 0x75, 0x73, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x7e, 0x0a, 0x1d, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x41, 0x63,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x65, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x41, 0x63, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x6e,
+	0x63, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x23, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x42, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x12, 0x23, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a,
+	0x10, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0f, 0x53, 0x63, 0x61, 0x6e, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x72,
+	0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x75, 0x0a, 0x1a, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x49, 0x6e, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x29, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72,
+	0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x49, 0x6e, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x19, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x28, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73,
+	0x12, 0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x54, 0x6f, 0x47, 0x63, 0x12, 0x21, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x54, 0x6f, 0x47, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x54, 0x6f, 0x47, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x7e, 0x0a, 0x1d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x2c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66,
+	0x0a, 0x15, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x63, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x24, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63,
+	0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6c, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x27, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6c, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6c, 0x12, 0x1f, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x63, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x51, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x12, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a,
+	0x15, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f,
+	0x67, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f,
+	0x67, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x1f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x66, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x53, 0x65, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1f, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a,
+	0x0a, 0x11, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x78, 0x0a, 0x1b, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x1c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x57, 0x69, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43,
+	0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0x00, 0x30, 0x01, 0x12, 0x72, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x75,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x12, 0x28, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x75, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x19, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x28, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x46, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x53, 0x65, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x20,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1f, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c,
+	0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46,
+	0x6c, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d,
+	0x0a, 0x12, 0x53, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65,
+	0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x53, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x14,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x69, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10,
+	0x44, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x1f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x12, 0x18, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x48, 0x65, 0x61, 0x72,
+	0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x1e, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x76,
+	0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x76,
+	0x65, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x78, 0x0a, 0x1b, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x13, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x22, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x27, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69,
+	0x7a, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x12, 0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53,
+	0x6f, 0x66, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x65,
+	0x61, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f,
+	0x66, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x63, 0x0a, 0x14, 0x52, 0x75, 0x6e, 0x53, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x52, 0x75, 0x6e, 0x53, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c,
+	0x65, 0x61, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x52, 0x75, 0x6e, 0x53, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x65, 0x61,
+	0x6e, 0x75, 0x70, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x25,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x65, 0x61,
+	0x6e, 0x75, 0x70, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x6c, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70,
+	0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75,
+	0x70, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a,
+	0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x44, 0x69, 0x66, 0x66, 0x12, 0x28, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x6c, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64,
+	0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64,
+	0x64, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x75,
+	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64,
+	0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x26, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69,
+	0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6d,
+	0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x26, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45,
+	0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67,
+	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x60, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x22, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x22, 0x2e, 0x63, 0x68,
+	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x1a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x29, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a,
+	0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x27, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x18, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x27, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7b, 0x0a, 0x1c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72,
+	0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x2e, 0x63,
+	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47,
+	0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x4a,
+	0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x3f, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x17,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x09, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62,
+	0x12, 0x18, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x68, 0x72,
+	0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2d, 0x63, 0x6f, 0x72,
+	0x65, 0x2f, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f,
+	0x72, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_chromadb_proto_coordinator_proto_rawDescOnce sync.Once
+	file_chromadb_proto_coordinator_proto_rawDescData = file_chromadb_proto_coordinator_proto_rawDesc
+)
+
+func file_chromadb_proto_coordinator_proto_rawDescGZIP() []byte {
+	file_chromadb_proto_coordinator_proto_rawDescOnce.Do(func() {
+		file_chromadb_proto_coordinator_proto_rawDescData = protoimpl.X.CompressGZIP(file_chromadb_proto_coordinator_proto_rawDescData)
+	})
+	return file_chromadb_proto_coordinator_proto_rawDescData
+}
+
+var file_chromadb_proto_coordinator_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_chromadb_proto_coordinator_proto_msgTypes = make([]protoimpl.MessageInfo, 208)
+var file_chromadb_proto_coordinator_proto_goTypes = []interface{}{
+	(DestructiveOperation)(0),                      // 0: chroma.DestructiveOperation
+	(CollectionSizeOrderBy)(0),                     // 1: chroma.CollectionSizeOrderBy
+	(CollectionSchemaFieldType)(0),                 // 2: chroma.CollectionSchemaFieldType
+	(*CreateDatabaseRequest)(nil),                  // 3: chroma.CreateDatabaseRequest
+	(*CreateDatabaseResponse)(nil),                 // 4: chroma.CreateDatabaseResponse
+	(*GetDatabaseRequest)(nil),                     // 5: chroma.GetDatabaseRequest
+	(*GetDatabaseResponse)(nil),                    // 6: chroma.GetDatabaseResponse
+	(*CreateTenantRequest)(nil),                    // 7: chroma.CreateTenantRequest
+	(*CreateTenantResponse)(nil),                   // 8: chroma.CreateTenantResponse
+	(*OperationProgress)(nil),                      // 9: chroma.OperationProgress
+	(*GetTenantRequest)(nil),                       // 10: chroma.GetTenantRequest
+	(*GetTenantResponse)(nil),                      // 11: chroma.GetTenantResponse
+	(*DeleteTenantRequest)(nil),                    // 12: chroma.DeleteTenantRequest
+	(*DeleteTenantResponse)(nil),                   // 13: chroma.DeleteTenantResponse
+	(*DeleteTenantProgress)(nil),                   // 14: chroma.DeleteTenantProgress
+	(*RequestConfirmationTokenRequest)(nil),        // 15: chroma.RequestConfirmationTokenRequest
+	(*RequestConfirmationTokenResponse)(nil),       // 16: chroma.RequestConfirmationTokenResponse
+	(*CreateSegmentRequest)(nil),                   // 17: chroma.CreateSegmentRequest
+	(*CreateSegmentResponse)(nil),                  // 18: chroma.CreateSegmentResponse
+	(*DeleteSegmentRequest)(nil),                   // 19: chroma.DeleteSegmentRequest
+	(*DeleteSegmentResponse)(nil),                  // 20: chroma.DeleteSegmentResponse
+	(*GetSegmentsRequest)(nil),                     // 21: chroma.GetSegmentsRequest
+	(*GetSegmentsResponse)(nil),                    // 22: chroma.GetSegmentsResponse
+	(*UpdateSegmentRequest)(nil),                   // 23: chroma.UpdateSegmentRequest
+	(*UpdateSegmentResponse)(nil),                  // 24: chroma.UpdateSegmentResponse
+	(*CreateCollectionRequest)(nil),                // 25: chroma.CreateCollectionRequest
+	(*CreateCollectionResponse)(nil),               // 26: chroma.CreateCollectionResponse
+	(*DeleteCollectionRequest)(nil),                // 27: chroma.DeleteCollectionRequest
+	(*DeleteCollectionResponse)(nil),               // 28: chroma.DeleteCollectionResponse
+	(*CollectionMetadataFilter)(nil),               // 29: chroma.CollectionMetadataFilter
+	(*GetCollectionsRequest)(nil),                  // 30: chroma.GetCollectionsRequest
+	(*GetCollectionsResponse)(nil),                 // 31: chroma.GetCollectionsResponse
+	(*UpdateCollectionRequest)(nil),                // 32: chroma.UpdateCollectionRequest
+	(*UpdateCollectionResponse)(nil),               // 33: chroma.UpdateCollectionResponse
+	(*RenameCollectionRequest)(nil),                // 34: chroma.RenameCollectionRequest
+	(*RenameCollectionResponse)(nil),               // 35: chroma.RenameCollectionResponse
+	(*Notification)(nil),                           // 36: chroma.Notification
+	(*ResetStateResponse)(nil),                     // 37: chroma.ResetStateResponse
+	(*GetLastCompactionTimeForTenantRequest)(nil),  // 38: chroma.GetLastCompactionTimeForTenantRequest
+	(*TenantLastCompactionTime)(nil),               // 39: chroma.TenantLastCompactionTime
+	(*GetLastCompactionTimeForTenantResponse)(nil), // 40: chroma.GetLastCompactionTimeForTenantResponse
+	(*SetLastCompactionTimeForTenantRequest)(nil),  // 41: chroma.SetLastCompactionTimeForTenantRequest
+	(*FlushSegmentCompactionInfo)(nil),             // 42: chroma.FlushSegmentCompactionInfo
+	(*FlushCollectionCompactionRequest)(nil),       // 43: chroma.FlushCollectionCompactionRequest
+	(*FlushCollectionCompactionResponse)(nil),      // 44: chroma.FlushCollectionCompactionResponse
+	(*AcquireCollectionFencingTokenRequest)(nil),   // 45: chroma.AcquireCollectionFencingTokenRequest
+	(*AcquireCollectionFencingTokenResponse)(nil),  // 46: chroma.AcquireCollectionFencingTokenResponse
+	(*GetCollectionsBySizeRequest)(nil),            // 47: chroma.GetCollectionsBySizeRequest
+	(*CollectionSize)(nil),                         // 48: chroma.CollectionSize
+	(*GetCollectionsBySizeResponse)(nil),           // 49: chroma.GetCollectionsBySizeResponse
+	(*GetApproximateCountsRequest)(nil),            // 50: chroma.GetApproximateCountsRequest
+	(*GetApproximateCountsResponse)(nil),           // 51: chroma.GetApproximateCountsResponse
+	(*CountCollectionsRequest)(nil),                // 52: chroma.CountCollectionsRequest
+	(*CountCollectionsResponse)(nil),               // 53: chroma.CountCollectionsResponse
+	(*ScanCollectionsRequest)(nil),                 // 54: chroma.ScanCollectionsRequest
+	(*ScanCollectionsResponse)(nil),                // 55: chroma.ScanCollectionsResponse
+	(*ListIncompleteCollectionsRequest)(nil),       // 56: chroma.ListIncompleteCollectionsRequest
+	(*IncompleteCollection)(nil),                   // 57: chroma.IncompleteCollection
+	(*ListIncompleteCollectionsResponse)(nil),      // 58: chroma.ListIncompleteCollectionsResponse
+	(*RepairIncompleteCollectionRequest)(nil),      // 59: chroma.RepairIncompleteCollectionRequest
+	(*RepairIncompleteCollectionResponse)(nil),     // 60: chroma.RepairIncompleteCollectionResponse
+	(*ListInconsistentCollectionsRequest)(nil),     // 61: chroma.ListInconsistentCollectionsRequest
+	(*InconsistentCollection)(nil),                 // 62: chroma.InconsistentCollection
+	(*ListInconsistentCollectionsResponse)(nil),    // 63: chroma.ListInconsistentCollectionsResponse
+	(*CheckConsistencyProgress)(nil),               // 64: chroma.CheckConsistencyProgress
+	(*CompleteCollectionReindexRequest)(nil),       // 65: chroma.CompleteCollectionReindexRequest
+	(*CompleteCollectionReindexResponse)(nil),      // 66: chroma.CompleteCollectionReindexResponse
+	(*GetQuerySamplesRequest)(nil),                 // 67: chroma.GetQuerySamplesRequest
+	(*QuerySample)(nil),                            // 68: chroma.QuerySample
+	(*GetQuerySamplesResponse)(nil),                // 69: chroma.GetQuerySamplesResponse
+	(*CollectionResult)(nil),                       // 70: chroma.CollectionResult
+	(*DeleteCollectionsRequest)(nil),               // 71: chroma.DeleteCollectionsRequest
+	(*DeleteCollectionsResponse)(nil),              // 72: chroma.DeleteCollectionsResponse
+	(*RestoreCollectionRequest)(nil),               // 73: chroma.RestoreCollectionRequest
+	(*RestoreCollectionResponse)(nil),              // 74: chroma.RestoreCollectionResponse
+	(*BatchUpdateCollectionMetadataRequest)(nil),   // 75: chroma.BatchUpdateCollectionMetadataRequest
+	(*BatchUpdateCollectionMetadataResponse)(nil),  // 76: chroma.BatchUpdateCollectionMetadataResponse
+	(*SetCollectionAclEntryRequest)(nil),           // 77: chroma.SetCollectionAclEntryRequest
+	(*SetCollectionAclEntryResponse)(nil),          // 78: chroma.SetCollectionAclEntryResponse
+	(*DeleteCollectionAclEntryRequest)(nil),        // 79: chroma.DeleteCollectionAclEntryRequest
+	(*DeleteCollectionAclEntryResponse)(nil),       // 80: chroma.DeleteCollectionAclEntryResponse
+	(*GetCollectionAclRequest)(nil),                // 81: chroma.GetCollectionAclRequest
+	(*GetCollectionAclResponse)(nil),               // 82: chroma.GetCollectionAclResponse
+	(*GetCollectionsToGcRequest)(nil),              // 83: chroma.GetCollectionsToGcRequest
+	(*CollectionToGc)(nil),                         // 84: chroma.CollectionToGc
+	(*GetCollectionsToGcResponse)(nil),             // 85: chroma.GetCollectionsToGcResponse
+	(*TenantQuota)(nil),                            // 86: chroma.TenantQuota
+	(*SetTenantQuotaRequest)(nil),                  // 87: chroma.SetTenantQuotaRequest
+	(*SetTenantQuotaResponse)(nil),                 // 88: chroma.SetTenantQuotaResponse
+	(*GetTenantQuotaRequest)(nil),                  // 89: chroma.GetTenantQuotaRequest
+	(*GetTenantQuotaResponse)(nil),                 // 90: chroma.GetTenantQuotaResponse
+	(*CheckQuotaRequest)(nil),                      // 91: chroma.CheckQuotaRequest
+	(*QuotaViolation)(nil),                         // 92: chroma.QuotaViolation
+	(*CheckQuotaResponse)(nil),                     // 93: chroma.CheckQuotaResponse
+	(*SetDatabaseQuotaRequest)(nil),                // 94: chroma.SetDatabaseQuotaRequest
+	(*SetDatabaseQuotaResponse)(nil),               // 95: chroma.SetDatabaseQuotaResponse
+	(*GetEffectiveQuotaRequest)(nil),               // 96: chroma.GetEffectiveQuotaRequest
+	(*GetEffectiveQuotaResponse)(nil),              // 97: chroma.GetEffectiveQuotaResponse
+	(*CollectionLogRoute)(nil),                     // 98: chroma.CollectionLogRoute
+	(*SetCollectionLogRouteRequest)(nil),           // 99: chroma.SetCollectionLogRouteRequest
+	(*SetCollectionLogRouteResponse)(nil),          // 100: chroma.SetCollectionLogRouteResponse
+	(*ResolveCollectionLogRoutesRequest)(nil),      // 101: chroma.ResolveCollectionLogRoutesRequest
+	(*ResolveCollectionLogRoutesResponse)(nil),     // 102: chroma.ResolveCollectionLogRoutesResponse
+	(*CheckCollectionsRequest)(nil),                // 103: chroma.CheckCollectionsRequest
+	(*CollectionExistence)(nil),                    // 104: chroma.CollectionExistence
+	(*CheckCollectionsResponse)(nil),               // 105: chroma.CheckCollectionsResponse
+	(*RequestCompactionRequest)(nil),               // 106: chroma.RequestCompactionRequest
+	(*RequestCompactionResponse)(nil),              // 107: chroma.RequestCompactionResponse
+	(*CompactionRequest)(nil),                      // 108: chroma.CompactionRequest
+	(*GetCompactionRequestsRequest)(nil),           // 109: chroma.GetCompactionRequestsRequest
+	(*GetCompactionRequestsResponse)(nil),          // 110: chroma.GetCompactionRequestsResponse
+	(*SegmentFile)(nil),                            // 111: chroma.SegmentFile
+	(*ListDuplicateSegmentFilesRequest)(nil),       // 112: chroma.ListDuplicateSegmentFilesRequest
+	(*DuplicateSegmentFiles)(nil),                  // 113: chroma.DuplicateSegmentFiles
+	(*ListDuplicateSegmentFilesResponse)(nil),      // 114: chroma.ListDuplicateSegmentFilesResponse
+	(*GetCollectionSegmentFilesRequest)(nil),       // 115: chroma.GetCollectionSegmentFilesRequest
+	(*GetCollectionSegmentFilesResponse)(nil),      // 116: chroma.GetCollectionSegmentFilesResponse
+	(*GetStorageAttributionRequest)(nil),           // 117: chroma.GetStorageAttributionRequest
+	(*CollectionStorageAttribution)(nil),           // 118: chroma.CollectionStorageAttribution
+	(*GetStorageAttributionResponse)(nil),          // 119: chroma.GetStorageAttributionResponse
+	(*FeatureFlag)(nil),                            // 120: chroma.FeatureFlag
+	(*SetFeatureFlagRequest)(nil),                  // 121: chroma.SetFeatureFlagRequest
+	(*SetFeatureFlagResponse)(nil),                 // 122: chroma.SetFeatureFlagResponse
+	(*DeleteFeatureFlagRequest)(nil),               // 123: chroma.DeleteFeatureFlagRequest
+	(*DeleteFeatureFlagResponse)(nil),              // 124: chroma.DeleteFeatureFlagResponse
+	(*ListFeatureFlagsRequest)(nil),                // 125: chroma.ListFeatureFlagsRequest
+	(*ListFeatureFlagsResponse)(nil),               // 126: chroma.ListFeatureFlagsResponse
+	(*TenantPlacement)(nil),                        // 127: chroma.TenantPlacement
+	(*SetTenantPlacementRequest)(nil),              // 128: chroma.SetTenantPlacementRequest
+	(*SetTenantPlacementResponse)(nil),             // 129: chroma.SetTenantPlacementResponse
+	(*GetTenantPlacementRequest)(nil),              // 130: chroma.GetTenantPlacementRequest
+	(*GetTenantPlacementResponse)(nil),             // 131: chroma.GetTenantPlacementResponse
+	(*CheckTenantPlacementRequest)(nil),            // 132: chroma.CheckTenantPlacementRequest
+	(*CheckTenantPlacementResponse)(nil),           // 133: chroma.CheckTenantPlacementResponse
+	(*GetCollectionPlacementRequest)(nil),          // 134: chroma.GetCollectionPlacementRequest
+	(*GetCollectionPlacementResponse)(nil),         // 135: chroma.GetCollectionPlacementResponse
+	(*DecommissionNodeRequest)(nil),                // 136: chroma.DecommissionNodeRequest
+	(*DecommissionNodeResponse)(nil),               // 137: chroma.DecommissionNodeResponse
+	(*HeartbeatRequest)(nil),                       // 138: chroma.HeartbeatRequest
+	(*HeartbeatResponse)(nil),                      // 139: chroma.HeartbeatResponse
+	(*GetNodeLivenessRequest)(nil),                 // 140: chroma.GetNodeLivenessRequest
+	(*NodeLiveness)(nil),                           // 141: chroma.NodeLiveness
+	(*GetNodeLivenessResponse)(nil),                // 142: chroma.GetNodeLivenessResponse
+	(*SimulateCollectionPlacementRequest)(nil),     // 143: chroma.SimulateCollectionPlacementRequest
+	(*CollectionPlacementMove)(nil),                // 144: chroma.CollectionPlacementMove
+	(*NodeLoad)(nil),                               // 145: chroma.NodeLoad
+	(*SimulateCollectionPlacementResponse)(nil),    // 146: chroma.SimulateCollectionPlacementResponse
+	(*ListCollectionStatsRequest)(nil),             // 147: chroma.ListCollectionStatsRequest
+	(*CollectionStats)(nil),                        // 148: chroma.CollectionStats
+	(*ListCollectionStatsResponse)(nil),            // 149: chroma.ListCollectionStatsResponse
+	(*GetCollectionSizeHistoryRequest)(nil),        // 150: chroma.GetCollectionSizeHistoryRequest
+	(*CollectionSizeSnapshot)(nil),                 // 151: chroma.CollectionSizeSnapshot
+	(*GetCollectionSizeHistoryResponse)(nil),       // 152: chroma.GetCollectionSizeHistoryResponse
+	(*GetTenantActivityRequest)(nil),               // 153: chroma.GetTenantActivityRequest
+	(*TenantActivity)(nil),                         // 154: chroma.TenantActivity
+	(*GetTenantActivityResponse)(nil),              // 155: chroma.GetTenantActivityResponse
+	(*SoftDeleteCleanerRun)(nil),                   // 156: chroma.SoftDeleteCleanerRun
+	(*GetSoftDeleteCleanerStatusRequest)(nil),      // 157: chroma.GetSoftDeleteCleanerStatusRequest
+	(*GetSoftDeleteCleanerStatusResponse)(nil),     // 158: chroma.GetSoftDeleteCleanerStatusResponse
+	(*RunSoftDeleteCleanerRequest)(nil),            // 159: chroma.RunSoftDeleteCleanerRequest
+	(*RunSoftDeleteCleanerResponse)(nil),           // 160: chroma.RunSoftDeleteCleanerResponse
+	(*ListCleanupDeadLettersRequest)(nil),          // 161: chroma.ListCleanupDeadLettersRequest
+	(*CleanupDeadLetter)(nil),                      // 162: chroma.CleanupDeadLetter
+	(*ListCleanupDeadLettersResponse)(nil),         // 163: chroma.ListCleanupDeadLettersResponse
+	(*DeleteCleanupDeadLetterRequest)(nil),         // 164: chroma.DeleteCleanupDeadLetterRequest
+	(*DeleteCleanupDeadLetterResponse)(nil),        // 165: chroma.DeleteCleanupDeadLetterResponse
+	(*BatchCreateTenant)(nil),                      // 166: chroma.BatchCreateTenant
+	(*BatchCreateTenantsRequest)(nil),              // 167: chroma.BatchCreateTenantsRequest
+	(*TenantResult)(nil),                           // 168: chroma.TenantResult
+	(*BatchCreateTenantsResponse)(nil),             // 169: chroma.BatchCreateTenantsResponse
+	(*GetCollectionMetadataDiffRequest)(nil),       // 170: chroma.GetCollectionMetadataDiffRequest
+	(*CollectionMetadataChange)(nil),               // 171: chroma.CollectionMetadataChange
+	(*GetCollectionMetadataDiffResponse)(nil),      // 172: chroma.GetCollectionMetadataDiffResponse
+	(*EmbeddingFunction)(nil),                      // 173: chroma.EmbeddingFunction
+	(*CreateEmbeddingFunctionRequest)(nil),         // 174: chroma.CreateEmbeddingFunctionRequest
+	(*CreateEmbeddingFunctionResponse)(nil),        // 175: chroma.CreateEmbeddingFunctionResponse
+	(*GetEmbeddingFunctionsRequest)(nil),           // 176: chroma.GetEmbeddingFunctionsRequest
+	(*GetEmbeddingFunctionsResponse)(nil),          // 177: chroma.GetEmbeddingFunctionsResponse
+	(*UpdateEmbeddingFunctionRequest)(nil),         // 178: chroma.UpdateEmbeddingFunctionRequest
+	(*UpdateEmbeddingFunctionResponse)(nil),        // 179: chroma.UpdateEmbeddingFunctionResponse
+	(*DeleteEmbeddingFunctionRequest)(nil),         // 180: chroma.DeleteEmbeddingFunctionRequest
+	(*DeleteEmbeddingFunctionResponse)(nil),        // 181: chroma.DeleteEmbeddingFunctionResponse
+	(*CollectionSchemaField)(nil),                  // 182: chroma.CollectionSchemaField
+	(*SetCollectionSchemaRequest)(nil),             // 183: chroma.SetCollectionSchemaRequest
+	(*SetCollectionSchemaResponse)(nil),            // 184: chroma.SetCollectionSchemaResponse
+	(*GetCollectionSchemaRequest)(nil),             // 185: chroma.GetCollectionSchemaRequest
+	(*GetCollectionSchemaResponse)(nil),            // 186: chroma.GetCollectionSchemaResponse
+	(*ValidateCollectionMetadataRequest)(nil),      // 187: chroma.ValidateCollectionMetadataRequest
+	(*CollectionSchemaViolation)(nil),              // 188: chroma.CollectionSchemaViolation
+	(*ValidateCollectionMetadataResponse)(nil),     // 189: chroma.ValidateCollectionMetadataResponse
+	(*CollectionTemplate)(nil),                     // 190: chroma.CollectionTemplate
+	(*CreateCollectionTemplateRequest)(nil),        // 191: chroma.CreateCollectionTemplateRequest
+	(*CreateCollectionTemplateResponse)(nil),       // 192: chroma.CreateCollectionTemplateResponse
+	(*GetCollectionTemplatesRequest)(nil),          // 193: chroma.GetCollectionTemplatesRequest
+	(*GetCollectionTemplatesResponse)(nil),         // 194: chroma.GetCollectionTemplatesResponse
+	(*DeleteCollectionTemplateRequest)(nil),        // 195: chroma.DeleteCollectionTemplateRequest
+	(*DeleteCollectionTemplateResponse)(nil),       // 196: chroma.DeleteCollectionTemplateResponse
+	(*CreateCollectionFromTemplateRequest)(nil),    // 197: chroma.CreateCollectionFromTemplateRequest
+	(*CreateCollectionFromTemplateResponse)(nil),   // 198: chroma.CreateCollectionFromTemplateResponse
+	(*SetMaintenanceModeRequest)(nil),              // 199: chroma.SetMaintenanceModeRequest
+	(*SetMaintenanceModeResponse)(nil),             // 200: chroma.SetMaintenanceModeResponse
+	(*GetMaintenanceModeRequest)(nil),              // 201: chroma.GetMaintenanceModeRequest
+	(*GetMaintenanceModeResponse)(nil),             // 202: chroma.GetMaintenanceModeResponse
+	(*Job)(nil),                                    // 203: chroma.Job
+	(*GetJobStatusRequest)(nil),                    // 204: chroma.GetJobStatusRequest
+	(*GetJobStatusResponse)(nil),                   // 205: chroma.GetJobStatusResponse
+	(*ListJobsRequest)(nil),                        // 206: chroma.ListJobsRequest
+	(*ListJobsResponse)(nil),                       // 207: chroma.ListJobsResponse
+	(*CancelJobRequest)(nil),                       // 208: chroma.CancelJobRequest
+	(*CancelJobResponse)(nil),                      // 209: chroma.CancelJobResponse
+	nil,                                            // 210: chroma.FlushSegmentCompactionInfo.FilePathsEntry
+	(*Status)(nil),                                 // 211: chroma.Status
+	(*Database)(nil),                               // 212: chroma.Database
+	(*Tenant)(nil),                                 // 213: chroma.Tenant
+	(*Segment)(nil),                                // 214: chroma.Segment
+	(SegmentScope)(0),                              // 215: chroma.SegmentScope
+	(*UpdateMetadata)(nil),                         // 216: chroma.UpdateMetadata
+	(*Collection)(nil),                             // 217: chroma.Collection
+	(*SingleStringComparison)(nil),                 // 218: chroma.SingleStringComparison
+	(*SingleIntComparison)(nil),                    // 219: chroma.SingleIntComparison
+	(*SingleDoubleComparison)(nil),                 // 220: chroma.SingleDoubleComparison
+	(*SingleBoolComparison)(nil),                   // 221: chroma.SingleBoolComparison
+	(*fieldmaskpb.FieldMask)(nil),                  // 222: google.protobuf.FieldMask
+	(*CollectionAclEntry)(nil),                     // 223: chroma.CollectionAclEntry
+	(*UpdateMetadataValue)(nil),                    // 224: chroma.UpdateMetadataValue
+	(*FilePaths)(nil),                              // 225: chroma.FilePaths
+	(*emptypb.Empty)(nil),                          // 226: google.protobuf.Empty
+}
+var file_chromadb_proto_coordinator_proto_depIdxs = []int32{
+	211, // 0: chroma.CreateDatabaseResponse.status:type_name -> chroma.Status
+	212, // 1: chroma.CreateDatabaseResponse.database:type_name -> chroma.Database
+	212, // 2: chroma.GetDatabaseResponse.database:type_name -> chroma.Database
+	211, // 3: chroma.GetDatabaseResponse.status:type_name -> chroma.Status
+	211, // 4: chroma.CreateTenantResponse.status:type_name -> chroma.Status
+	213, // 5: chroma.GetTenantResponse.tenant:type_name -> chroma.Tenant
+	211, // 6: chroma.GetTenantResponse.status:type_name -> chroma.Status
+	211, // 7: chroma.DeleteTenantResponse.status:type_name -> chroma.Status
+	9,   // 8: chroma.DeleteTenantProgress.progress:type_name -> chroma.OperationProgress
+	211, // 9: chroma.DeleteTenantProgress.status:type_name -> chroma.Status
+	0,   // 10: chroma.RequestConfirmationTokenRequest.operation:type_name -> chroma.DestructiveOperation
+	211, // 11: chroma.RequestConfirmationTokenResponse.status:type_name -> chroma.Status
+	214, // 12: chroma.CreateSegmentRequest.segment:type_name -> chroma.Segment
+	211, // 13: chroma.CreateSegmentResponse.status:type_name -> chroma.Status
+	211, // 14: chroma.DeleteSegmentResponse.status:type_name -> chroma.Status
+	215, // 15: chroma.GetSegmentsRequest.scope:type_name -> chroma.SegmentScope
+	214, // 16: chroma.GetSegmentsResponse.segments:type_name -> chroma.Segment
+	211, // 17: chroma.GetSegmentsResponse.status:type_name -> chroma.Status
+	216, // 18: chroma.UpdateSegmentRequest.metadata:type_name -> chroma.UpdateMetadata
+	211, // 19: chroma.UpdateSegmentResponse.status:type_name -> chroma.Status
+	216, // 20: chroma.CreateCollectionRequest.metadata:type_name -> chroma.UpdateMetadata
+	214, // 21: chroma.CreateCollectionRequest.segments:type_name -> chroma.Segment
+	217, // 22: chroma.CreateCollectionResponse.collection:type_name -> chroma.Collection
+	211, // 23: chroma.CreateCollectionResponse.status:type_name -> chroma.Status
+	211, // 24: chroma.DeleteCollectionResponse.status:type_name -> chroma.Status
+	218, // 25: chroma.CollectionMetadataFilter.single_string_operand:type_name -> chroma.SingleStringComparison
+	219, // 26: chroma.CollectionMetadataFilter.single_int_operand:type_name -> chroma.SingleIntComparison
+	220, // 27: chroma.CollectionMetadataFilter.single_double_operand:type_name -> chroma.SingleDoubleComparison
+	221, // 28: chroma.CollectionMetadataFilter.single_bool_operand:type_name -> chroma.SingleBoolComparison
+	29,  // 29: chroma.GetCollectionsRequest.metadata_filters:type_name -> chroma.CollectionMetadataFilter
+	222, // 30: chroma.GetCollectionsRequest.field_mask:type_name -> google.protobuf.FieldMask
+	217, // 31: chroma.GetCollectionsResponse.collections:type_name -> chroma.Collection
+	211, // 32: chroma.GetCollectionsResponse.status:type_name -> chroma.Status
+	216, // 33: chroma.UpdateCollectionRequest.metadata:type_name -> chroma.UpdateMetadata
+	211, // 34: chroma.UpdateCollectionResponse.status:type_name -> chroma.Status
+	217, // 35: chroma.RenameCollectionResponse.collection:type_name -> chroma.Collection
+	211, // 36: chroma.RenameCollectionResponse.status:type_name -> chroma.Status
+	211, // 37: chroma.ResetStateResponse.status:type_name -> chroma.Status
+	39,  // 38: chroma.GetLastCompactionTimeForTenantResponse.tenant_last_compaction_time:type_name -> chroma.TenantLastCompactionTime
+	39,  // 39: chroma.SetLastCompactionTimeForTenantRequest.tenant_last_compaction_time:type_name -> chroma.TenantLastCompactionTime
+	210, // 40: chroma.FlushSegmentCompactionInfo.file_paths:type_name -> chroma.FlushSegmentCompactionInfo.FilePathsEntry
+	42,  // 41: chroma.FlushCollectionCompactionRequest.segment_compaction_info:type_name -> chroma.FlushSegmentCompactionInfo
+	211, // 42: chroma.AcquireCollectionFencingTokenResponse.status:type_name -> chroma.Status
+	1,   // 43: chroma.GetCollectionsBySizeRequest.order_by:type_name -> chroma.CollectionSizeOrderBy
+	48,  // 44: chroma.GetCollectionsBySizeResponse.collections:type_name -> chroma.CollectionSize
+	211, // 45: chroma.GetCollectionsBySizeResponse.status:type_name -> chroma.Status
+	211, // 46: chroma.GetApproximateCountsResponse.status:type_name -> chroma.Status
+	211, // 47: chroma.CountCollectionsResponse.status:type_name -> chroma.Status
+	217, // 48: chroma.ScanCollectionsResponse.collections:type_name -> chroma.Collection
+	211, // 49: chroma.ScanCollectionsResponse.status:type_name -> chroma.Status
+	57,  // 50: chroma.ListIncompleteCollectionsResponse.collections:type_name -> chroma.IncompleteCollection
+	211, // 51: chroma.ListIncompleteCollectionsResponse.status:type_name -> chroma.Status
+	211, // 52: chroma.RepairIncompleteCollectionResponse.status:type_name -> chroma.Status
+	62,  // 53: chroma.ListInconsistentCollectionsResponse.collections:type_name -> chroma.InconsistentCollection
+	211, // 54: chroma.ListInconsistentCollectionsResponse.status:type_name -> chroma.Status
+	9,   // 55: chroma.CheckConsistencyProgress.progress:type_name -> chroma.OperationProgress
+	62,  // 56: chroma.CheckConsistencyProgress.collections:type_name -> chroma.InconsistentCollection
+	211, // 57: chroma.CheckConsistencyProgress.status:type_name -> chroma.Status
+	211, // 58: chroma.CompleteCollectionReindexResponse.status:type_name -> chroma.Status
+	68,  // 59: chroma.GetQuerySamplesResponse.samples:type_name -> chroma.QuerySample
+	211, // 60: chroma.GetQuerySamplesResponse.status:type_name -> chroma.Status
+	211, // 61: chroma.CollectionResult.status:type_name -> chroma.Status
+	70,  // 62: chroma.DeleteCollectionsResponse.results:type_name -> chroma.CollectionResult
+	211, // 63: chroma.DeleteCollectionsResponse.status:type_name -> chroma.Status
+	217, // 64: chroma.RestoreCollectionResponse.collection:type_name -> chroma.Collection
+	211, // 65: chroma.RestoreCollectionResponse.status:type_name -> chroma.Status
+	216, // 66: chroma.BatchUpdateCollectionMetadataRequest.set_metadata:type_name -> chroma.UpdateMetadata
+	70,  // 67: chroma.BatchUpdateCollectionMetadataResponse.results:type_name -> chroma.CollectionResult
+	211, // 68: chroma.BatchUpdateCollectionMetadataResponse.status:type_name -> chroma.Status
+	223, // 69: chroma.SetCollectionAclEntryRequest.entry:type_name -> chroma.CollectionAclEntry
+	211, // 70: chroma.SetCollectionAclEntryResponse.status:type_name -> chroma.Status
+	211, // 71: chroma.DeleteCollectionAclEntryResponse.status:type_name -> chroma.Status
+	223, // 72: chroma.GetCollectionAclResponse.entries:type_name -> chroma.CollectionAclEntry
+	211, // 73: chroma.GetCollectionAclResponse.status:type_name -> chroma.Status
+	84,  // 74: chroma.GetCollectionsToGcResponse.collections:type_name -> chroma.CollectionToGc
+	211, // 75: chroma.GetCollectionsToGcResponse.status:type_name -> chroma.Status
+	86,  // 76: chroma.SetTenantQuotaRequest.quota:type_name -> chroma.TenantQuota
+	211, // 77: chroma.SetTenantQuotaResponse.status:type_name -> chroma.Status
+	86,  // 78: chroma.GetTenantQuotaResponse.quota:type_name -> chroma.TenantQuota
+	211, // 79: chroma.GetTenantQuotaResponse.status:type_name -> chroma.Status
+	92,  // 80: chroma.CheckQuotaResponse.violations:type_name -> chroma.QuotaViolation
+	211, // 81: chroma.CheckQuotaResponse.status:type_name -> chroma.Status
+	86,  // 82: chroma.SetDatabaseQuotaRequest.quota:type_name -> chroma.TenantQuota
+	211, // 83: chroma.SetDatabaseQuotaResponse.status:type_name -> chroma.Status
+	86,  // 84: chroma.GetEffectiveQuotaResponse.quota:type_name -> chroma.TenantQuota
+	211, // 85: chroma.GetEffectiveQuotaResponse.status:type_name -> chroma.Status
+	98,  // 86: chroma.SetCollectionLogRouteResponse.route:type_name -> chroma.CollectionLogRoute
+	211, // 87: chroma.SetCollectionLogRouteResponse.status:type_name -> chroma.Status
+	98,  // 88: chroma.ResolveCollectionLogRoutesResponse.routes:type_name -> chroma.CollectionLogRoute
+	211, // 89: chroma.ResolveCollectionLogRoutesResponse.status:type_name -> chroma.Status
+	104, // 90: chroma.CheckCollectionsResponse.collections:type_name -> chroma.CollectionExistence
+	211, // 91: chroma.CheckCollectionsResponse.status:type_name -> chroma.Status
+	211, // 92: chroma.RequestCompactionResponse.status:type_name -> chroma.Status
+	108, // 93: chroma.GetCompactionRequestsResponse.requests:type_name -> chroma.CompactionRequest
+	211, // 94: chroma.GetCompactionRequestsResponse.status:type_name -> chroma.Status
+	111, // 95: chroma.DuplicateSegmentFiles.files:type_name -> chroma.SegmentFile
+	113, // 96: chroma.ListDuplicateSegmentFilesResponse.duplicates:type_name -> chroma.DuplicateSegmentFiles
+	211, // 97: chroma.ListDuplicateSegmentFilesResponse.status:type_name -> chroma.Status
+	111, // 98: chroma.GetCollectionSegmentFilesResponse.files:type_name -> chroma.SegmentFile
+	211, // 99: chroma.GetCollectionSegmentFilesResponse.status:type_name -> chroma.Status
+	118, // 100: chroma.GetStorageAttributionResponse.collections:type_name -> chroma.CollectionStorageAttribution
+	211, // 101: chroma.GetStorageAttributionResponse.status:type_name -> chroma.Status
+	120, // 102: chroma.SetFeatureFlagRequest.flag:type_name -> chroma.FeatureFlag
+	211, // 103: chroma.SetFeatureFlagResponse.status:type_name -> chroma.Status
+	211, // 104: chroma.DeleteFeatureFlagResponse.status:type_name -> chroma.Status
+	120, // 105: chroma.ListFeatureFlagsResponse.flags:type_name -> chroma.FeatureFlag
+	211, // 106: chroma.ListFeatureFlagsResponse.status:type_name -> chroma.Status
+	127, // 107: chroma.SetTenantPlacementRequest.placement:type_name -> chroma.TenantPlacement
+	211, // 108: chroma.SetTenantPlacementResponse.status:type_name -> chroma.Status
+	127, // 109: chroma.GetTenantPlacementResponse.placement:type_name -> chroma.TenantPlacement
+	211, // 110: chroma.GetTenantPlacementResponse.status:type_name -> chroma.Status
+	127, // 111: chroma.CheckTenantPlacementResponse.placement:type_name -> chroma.TenantPlacement
+	211, // 112: chroma.CheckTenantPlacementResponse.status:type_name -> chroma.Status
+	211, // 113: chroma.GetCollectionPlacementResponse.status:type_name -> chroma.Status
+	211, // 114: chroma.DecommissionNodeResponse.status:type_name -> chroma.Status
+	211, // 115: chroma.HeartbeatResponse.status:type_name -> chroma.Status
+	141, // 116: chroma.GetNodeLivenessResponse.nodes:type_name -> chroma.NodeLiveness
+	211, // 117: chroma.GetNodeLivenessResponse.status:type_name -> chroma.Status
+	144, // 118: chroma.SimulateCollectionPlacementResponse.moves:type_name -> chroma.CollectionPlacementMove
+	145, // 119: chroma.SimulateCollectionPlacementResponse.node_loads:type_name -> chroma.NodeLoad
+	211, // 120: chroma.SimulateCollectionPlacementResponse.status:type_name -> chroma.Status
+	148, // 121: chroma.ListCollectionStatsResponse.stats:type_name -> chroma.CollectionStats
+	211, // 122: chroma.ListCollectionStatsResponse.status:type_name -> chroma.Status
+	151, // 123: chroma.GetCollectionSizeHistoryResponse.snapshots:type_name -> chroma.CollectionSizeSnapshot
+	211, // 124: chroma.GetCollectionSizeHistoryResponse.status:type_name -> chroma.Status
+	154, // 125: chroma.GetTenantActivityResponse.days:type_name -> chroma.TenantActivity
+	154, // 126: chroma.GetTenantActivityResponse.total:type_name -> chroma.TenantActivity
+	211, // 127: chroma.GetTenantActivityResponse.status:type_name -> chroma.Status
+	156, // 128: chroma.GetSoftDeleteCleanerStatusResponse.last_run:type_name -> chroma.SoftDeleteCleanerRun
+	211, // 129: chroma.GetSoftDeleteCleanerStatusResponse.status:type_name -> chroma.Status
+	156, // 130: chroma.RunSoftDeleteCleanerResponse.run:type_name -> chroma.SoftDeleteCleanerRun
+	211, // 131: chroma.RunSoftDeleteCleanerResponse.status:type_name -> chroma.Status
+	162, // 132: chroma.ListCleanupDeadLettersResponse.entries:type_name -> chroma.CleanupDeadLetter
+	211, // 133: chroma.ListCleanupDeadLettersResponse.status:type_name -> chroma.Status
+	211, // 134: chroma.DeleteCleanupDeadLetterResponse.status:type_name -> chroma.Status
+	86,  // 135: chroma.BatchCreateTenant.quota:type_name -> chroma.TenantQuota
+	166, // 136: chroma.BatchCreateTenantsRequest.tenants:type_name -> chroma.BatchCreateTenant
+	211, // 137: chroma.TenantResult.status:type_name -> chroma.Status
+	168, // 138: chroma.BatchCreateTenantsResponse.results:type_name -> chroma.TenantResult
+	211, // 139: chroma.BatchCreateTenantsResponse.status:type_name -> chroma.Status
+	224, // 140: chroma.CollectionMetadataChange.from:type_name -> chroma.UpdateMetadataValue
+	224, // 141: chroma.CollectionMetadataChange.to:type_name -> chroma.UpdateMetadataValue
+	171, // 142: chroma.GetCollectionMetadataDiffResponse.changes:type_name -> chroma.CollectionMetadataChange
+	211, // 143: chroma.GetCollectionMetadataDiffResponse.status:type_name -> chroma.Status
+	173, // 144: chroma.CreateEmbeddingFunctionRequest.embedding_function:type_name -> chroma.EmbeddingFunction
+	173, // 145: chroma.CreateEmbeddingFunctionResponse.embedding_function:type_name -> chroma.EmbeddingFunction
+	211, // 146: chroma.CreateEmbeddingFunctionResponse.status:type_name -> chroma.Status
+	173, // 147: chroma.GetEmbeddingFunctionsResponse.embedding_functions:type_name -> chroma.EmbeddingFunction
+	211, // 148: chroma.GetEmbeddingFunctionsResponse.status:type_name -> chroma.Status
+	173, // 149: chroma.UpdateEmbeddingFunctionResponse.embedding_function:type_name -> chroma.EmbeddingFunction
+	211, // 150: chroma.UpdateEmbeddingFunctionResponse.status:type_name -> chroma.Status
+	211, // 151: chroma.DeleteEmbeddingFunctionResponse.status:type_name -> chroma.Status
+	2,   // 152: chroma.CollectionSchemaField.type:type_name -> chroma.CollectionSchemaFieldType
+	182, // 153: chroma.SetCollectionSchemaRequest.fields:type_name -> chroma.CollectionSchemaField
+	211, // 154: chroma.SetCollectionSchemaResponse.status:type_name -> chroma.Status
+	182, // 155: chroma.GetCollectionSchemaResponse.fields:type_name -> chroma.CollectionSchemaField
+	211, // 156: chroma.GetCollectionSchemaResponse.status:type_name -> chroma.Status
+	216, // 157: chroma.ValidateCollectionMetadataRequest.metadatas:type_name -> chroma.UpdateMetadata
+	2,   // 158: chroma.CollectionSchemaViolation.expected:type_name -> chroma.CollectionSchemaFieldType
+	2,   // 159: chroma.CollectionSchemaViolation.actual:type_name -> chroma.CollectionSchemaFieldType
+	188, // 160: chroma.ValidateCollectionMetadataResponse.violations:type_name -> chroma.CollectionSchemaViolation
+	211, // 161: chroma.ValidateCollectionMetadataResponse.status:type_name -> chroma.Status
+	216, // 162: chroma.CollectionTemplate.metadata:type_name -> chroma.UpdateMetadata
+	190, // 163: chroma.CreateCollectionTemplateRequest.template:type_name -> chroma.CollectionTemplate
+	190, // 164: chroma.CreateCollectionTemplateResponse.template:type_name -> chroma.CollectionTemplate
+	211, // 165: chroma.CreateCollectionTemplateResponse.status:type_name -> chroma.Status
+	190, // 166: chroma.GetCollectionTemplatesResponse.templates:type_name -> chroma.CollectionTemplate
+	211, // 167: chroma.GetCollectionTemplatesResponse.status:type_name -> chroma.Status
+	211, // 168: chroma.DeleteCollectionTemplateResponse.status:type_name -> chroma.Status
+	25,  // 169: chroma.CreateCollectionFromTemplateRequest.collection:type_name -> chroma.CreateCollectionRequest
+	217, // 170: chroma.CreateCollectionFromTemplateResponse.collection:type_name -> chroma.Collection
+	211, // 171: chroma.CreateCollectionFromTemplateResponse.status:type_name -> chroma.Status
+	211, // 172: chroma.SetMaintenanceModeResponse.status:type_name -> chroma.Status
+	211, // 173: chroma.GetMaintenanceModeResponse.status:type_name -> chroma.Status
+	203, // 174: chroma.GetJobStatusResponse.job:type_name -> chroma.Job
+	211, // 175: chroma.GetJobStatusResponse.status:type_name -> chroma.Status
+	203, // 176: chroma.ListJobsResponse.jobs:type_name -> chroma.Job
+	211, // 177: chroma.ListJobsResponse.status:type_name -> chroma.Status
+	203, // 178: chroma.CancelJobResponse.job:type_name -> chroma.Job
+	211, // 179: chroma.CancelJobResponse.status:type_name -> chroma.Status
+	225, // 180: chroma.FlushSegmentCompactionInfo.FilePathsEntry.value:type_name -> chroma.FilePaths
+	3,   // 181: chroma.SysDB.CreateDatabase:input_type -> chroma.CreateDatabaseRequest
+	5,   // 182: chroma.SysDB.GetDatabase:input_type -> chroma.GetDatabaseRequest
+	7,   // 183: chroma.SysDB.CreateTenant:input_type -> chroma.CreateTenantRequest
+	10,  // 184: chroma.SysDB.GetTenant:input_type -> chroma.GetTenantRequest
+	12,  // 185: chroma.SysDB.DeleteTenant:input_type -> chroma.DeleteTenantRequest
+	12,  // 186: chroma.SysDB.DeleteTenantWithProgress:input_type -> chroma.DeleteTenantRequest
+	15,  // 187: chroma.SysDB.RequestConfirmationToken:input_type -> chroma.RequestConfirmationTokenRequest
+	17,  // 188: chroma.SysDB.CreateSegment:input_type -> chroma.CreateSegmentRequest
+	19,  // 189: chroma.SysDB.DeleteSegment:input_type -> chroma.DeleteSegmentRequest
+	21,  // 190: chroma.SysDB.GetSegments:input_type -> chroma.GetSegmentsRequest
+	23,  // 191: chroma.SysDB.UpdateSegment:input_type -> chroma.UpdateSegmentRequest
+	25,  // 192: chroma.SysDB.CreateCollection:input_type -> chroma.CreateCollectionRequest
+	27,  // 193: chroma.SysDB.DeleteCollection:input_type -> chroma.DeleteCollectionRequest
+	30,  // 194: chroma.SysDB.GetCollections:input_type -> chroma.GetCollectionsRequest
+	32,  // 195: chroma.SysDB.UpdateCollection:input_type -> chroma.UpdateCollectionRequest
+	34,  // 196: chroma.SysDB.RenameCollection:input_type -> chroma.RenameCollectionRequest
+	226, // 197: chroma.SysDB.ResetState:input_type -> google.protobuf.Empty
+	38,  // 198: chroma.SysDB.GetLastCompactionTimeForTenant:input_type -> chroma.GetLastCompactionTimeForTenantRequest
+	41,  // 199: chroma.SysDB.SetLastCompactionTimeForTenant:input_type -> chroma.SetLastCompactionTimeForTenantRequest
+	43,  // 200: chroma.SysDB.FlushCollectionCompaction:input_type -> chroma.FlushCollectionCompactionRequest
+	45,  // 201: chroma.SysDB.AcquireCollectionFencingToken:input_type -> chroma.AcquireCollectionFencingTokenRequest
+	47,  // 202: chroma.SysDB.GetCollectionsBySize:input_type -> chroma.GetCollectionsBySizeRequest
+	50,  // 203: chroma.SysDB.GetApproximateCounts:input_type -> chroma.GetApproximateCountsRequest
+	52,  // 204: chroma.SysDB.CountCollections:input_type -> chroma.CountCollectionsRequest
+	54,  // 205: chroma.SysDB.ScanCollections:input_type -> chroma.ScanCollectionsRequest
+	56,  // 206: chroma.SysDB.ListIncompleteCollections:input_type -> chroma.ListIncompleteCollectionsRequest
+	59,  // 207: chroma.SysDB.RepairIncompleteCollection:input_type -> chroma.RepairIncompleteCollectionRequest
+	65,  // 208: chroma.SysDB.CompleteCollectionReindex:input_type -> chroma.CompleteCollectionReindexRequest
+	67,  // 209: chroma.SysDB.GetQuerySamples:input_type -> chroma.GetQuerySamplesRequest
+	83,  // 210: chroma.SysDB.GetCollectionsToGc:input_type -> chroma.GetCollectionsToGcRequest
+	75,  // 211: chroma.SysDB.BatchUpdateCollectionMetadata:input_type -> chroma.BatchUpdateCollectionMetadataRequest
+	71,  // 212: chroma.SysDB.DeleteCollections:input_type -> chroma.DeleteCollectionsRequest
+	77,  // 213: chroma.SysDB.SetCollectionAclEntry:input_type -> chroma.SetCollectionAclEntryRequest
+	79,  // 214: chroma.SysDB.DeleteCollectionAclEntry:input_type -> chroma.DeleteCollectionAclEntryRequest
+	81,  // 215: chroma.SysDB.GetCollectionAcl:input_type -> chroma.GetCollectionAclRequest
+	87,  // 216: chroma.SysDB.SetTenantQuota:input_type -> chroma.SetTenantQuotaRequest
+	89,  // 217: chroma.SysDB.GetTenantQuota:input_type -> chroma.GetTenantQuotaRequest
+	91,  // 218: chroma.SysDB.CheckQuota:input_type -> chroma.CheckQuotaRequest
+	99,  // 219: chroma.SysDB.SetCollectionLogRoute:input_type -> chroma.SetCollectionLogRouteRequest
+	101, // 220: chroma.SysDB.ResolveCollectionLogRoutes:input_type -> chroma.ResolveCollectionLogRoutesRequest
+	103, // 221: chroma.SysDB.CheckCollections:input_type -> chroma.CheckCollectionsRequest
+	106, // 222: chroma.SysDB.RequestCompaction:input_type -> chroma.RequestCompactionRequest
+	109, // 223: chroma.SysDB.GetCompactionRequests:input_type -> chroma.GetCompactionRequestsRequest
+	94,  // 224: chroma.SysDB.SetDatabaseQuota:input_type -> chroma.SetDatabaseQuotaRequest
+	96,  // 225: chroma.SysDB.GetEffectiveQuota:input_type -> chroma.GetEffectiveQuotaRequest
+	73,  // 226: chroma.SysDB.RestoreCollection:input_type -> chroma.RestoreCollectionRequest
+	61,  // 227: chroma.SysDB.ListInconsistentCollections:input_type -> chroma.ListInconsistentCollectionsRequest
+	61,  // 228: chroma.SysDB.CheckConsistencyWithProgress:input_type -> chroma.ListInconsistentCollectionsRequest
+	112, // 229: chroma.SysDB.ListDuplicateSegmentFiles:input_type -> chroma.ListDuplicateSegmentFilesRequest
+	115, // 230: chroma.SysDB.GetCollectionSegmentFiles:input_type -> chroma.GetCollectionSegmentFilesRequest
+	117, // 231: chroma.SysDB.GetStorageAttribution:input_type -> chroma.GetStorageAttributionRequest
+	121, // 232: chroma.SysDB.SetFeatureFlag:input_type -> chroma.SetFeatureFlagRequest
+	123, // 233: chroma.SysDB.DeleteFeatureFlag:input_type -> chroma.DeleteFeatureFlagRequest
+	125, // 234: chroma.SysDB.ListFeatureFlags:input_type -> chroma.ListFeatureFlagsRequest
+	128, // 235: chroma.SysDB.SetTenantPlacement:input_type -> chroma.SetTenantPlacementRequest
+	130, // 236: chroma.SysDB.GetTenantPlacement:input_type -> chroma.GetTenantPlacementRequest
+	132, // 237: chroma.SysDB.CheckTenantPlacement:input_type -> chroma.CheckTenantPlacementRequest
+	134, // 238: chroma.SysDB.GetCollectionPlacement:input_type -> chroma.GetCollectionPlacementRequest
+	136, // 239: chroma.SysDB.DecommissionNode:input_type -> chroma.DecommissionNodeRequest
+	138, // 240: chroma.SysDB.Heartbeat:input_type -> chroma.HeartbeatRequest
+	140, // 241: chroma.SysDB.GetNodeLiveness:input_type -> chroma.GetNodeLivenessRequest
+	143, // 242: chroma.SysDB.SimulateCollectionPlacement:input_type -> chroma.SimulateCollectionPlacementRequest
+	147, // 243: chroma.SysDB.ListCollectionStats:input_type -> chroma.ListCollectionStatsRequest
+	150, // 244: chroma.SysDB.GetCollectionSizeHistory:input_type -> chroma.GetCollectionSizeHistoryRequest
+	153, // 245: chroma.SysDB.GetTenantActivity:input_type -> chroma.GetTenantActivityRequest
+	157, // 246: chroma.SysDB.GetSoftDeleteCleanerStatus:input_type -> chroma.GetSoftDeleteCleanerStatusRequest
+	159, // 247: chroma.SysDB.RunSoftDeleteCleaner:input_type -> chroma.RunSoftDeleteCleanerRequest
+	161, // 248: chroma.SysDB.ListCleanupDeadLetters:input_type -> chroma.ListCleanupDeadLettersRequest
+	164, // 249: chroma.SysDB.DeleteCleanupDeadLetter:input_type -> chroma.DeleteCleanupDeadLetterRequest
+	167, // 250: chroma.SysDB.BatchCreateTenants:input_type -> chroma.BatchCreateTenantsRequest
+	170, // 251: chroma.SysDB.GetCollectionMetadataDiff:input_type -> chroma.GetCollectionMetadataDiffRequest
+	174, // 252: chroma.SysDB.CreateEmbeddingFunction:input_type -> chroma.CreateEmbeddingFunctionRequest
+	176, // 253: chroma.SysDB.GetEmbeddingFunctions:input_type -> chroma.GetEmbeddingFunctionsRequest
+	178, // 254: chroma.SysDB.UpdateEmbeddingFunction:input_type -> chroma.UpdateEmbeddingFunctionRequest
+	180, // 255: chroma.SysDB.DeleteEmbeddingFunction:input_type -> chroma.DeleteEmbeddingFunctionRequest
+	183, // 256: chroma.SysDB.SetCollectionSchema:input_type -> chroma.SetCollectionSchemaRequest
+	185, // 257: chroma.SysDB.GetCollectionSchema:input_type -> chroma.GetCollectionSchemaRequest
+	187, // 258: chroma.SysDB.ValidateCollectionMetadata:input_type -> chroma.ValidateCollectionMetadataRequest
+	191, // 259: chroma.SysDB.CreateCollectionTemplate:input_type -> chroma.CreateCollectionTemplateRequest
+	193, // 260: chroma.SysDB.GetCollectionTemplates:input_type -> chroma.GetCollectionTemplatesRequest
+	195, // 261: chroma.SysDB.DeleteCollectionTemplate:input_type -> chroma.DeleteCollectionTemplateRequest
+	197, // 262: chroma.SysDB.CreateCollectionFromTemplate:input_type -> chroma.CreateCollectionFromTemplateRequest
+	199, // 263: chroma.SysDB.SetMaintenanceMode:input_type -> chroma.SetMaintenanceModeRequest
+	201, // 264: chroma.SysDB.GetMaintenanceMode:input_type -> chroma.GetMaintenanceModeRequest
+	204, // 265: chroma.SysDB.GetJobStatus:input_type -> chroma.GetJobStatusRequest
+	206, // 266: chroma.SysDB.ListJobs:input_type -> chroma.ListJobsRequest
+	208, // 267: chroma.SysDB.CancelJob:input_type -> chroma.CancelJobRequest
+	4,   // 268: chroma.SysDB.CreateDatabase:output_type -> chroma.CreateDatabaseResponse
+	6,   // 269: chroma.SysDB.GetDatabase:output_type -> chroma.GetDatabaseResponse
+	8,   // 270: chroma.SysDB.CreateTenant:output_type -> chroma.CreateTenantResponse
+	11,  // 271: chroma.SysDB.GetTenant:output_type -> chroma.GetTenantResponse
+	13,  // 272: chroma.SysDB.DeleteTenant:output_type -> chroma.DeleteTenantResponse
+	14,  // 273: chroma.SysDB.DeleteTenantWithProgress:output_type -> chroma.DeleteTenantProgress
+	16,  // 274: chroma.SysDB.RequestConfirmationToken:output_type -> chroma.RequestConfirmationTokenResponse
+	18,  // 275: chroma.SysDB.CreateSegment:output_type -> chroma.CreateSegmentResponse
+	20,  // 276: chroma.SysDB.DeleteSegment:output_type -> chroma.DeleteSegmentResponse
+	22,  // 277: chroma.SysDB.GetSegments:output_type -> chroma.GetSegmentsResponse
+	24,  // 278: chroma.SysDB.UpdateSegment:output_type -> chroma.UpdateSegmentResponse
+	26,  // 279: chroma.SysDB.CreateCollection:output_type -> chroma.CreateCollectionResponse
+	28,  // 280: chroma.SysDB.DeleteCollection:output_type -> chroma.DeleteCollectionResponse
+	31,  // 281: chroma.SysDB.GetCollections:output_type -> chroma.GetCollectionsResponse
+	33,  // 282: chroma.SysDB.UpdateCollection:output_type -> chroma.UpdateCollectionResponse
+	35,  // 283: chroma.SysDB.RenameCollection:output_type -> chroma.RenameCollectionResponse
+	37,  // 284: chroma.SysDB.ResetState:output_type -> chroma.ResetStateResponse
+	40,  // 285: chroma.SysDB.GetLastCompactionTimeForTenant:output_type -> chroma.GetLastCompactionTimeForTenantResponse
+	226, // 286: chroma.SysDB.SetLastCompactionTimeForTenant:output_type -> google.protobuf.Empty
+	44,  // 287: chroma.SysDB.FlushCollectionCompaction:output_type -> chroma.FlushCollectionCompactionResponse
+	46,  // 288: chroma.SysDB.AcquireCollectionFencingToken:output_type -> chroma.AcquireCollectionFencingTokenResponse
+	49,  // 289: chroma.SysDB.GetCollectionsBySize:output_type -> chroma.GetCollectionsBySizeResponse
+	51,  // 290: chroma.SysDB.GetApproximateCounts:output_type -> chroma.GetApproximateCountsResponse
+	53,  // 291: chroma.SysDB.CountCollections:output_type -> chroma.CountCollectionsResponse
+	55,  // 292: chroma.SysDB.ScanCollections:output_type -> chroma.ScanCollectionsResponse
+	58,  // 293: chroma.SysDB.ListIncompleteCollections:output_type -> chroma.ListIncompleteCollectionsResponse
+	60,  // 294: chroma.SysDB.RepairIncompleteCollection:output_type -> chroma.RepairIncompleteCollectionResponse
+	66,  // 295: chroma.SysDB.CompleteCollectionReindex:output_type -> chroma.CompleteCollectionReindexResponse
+	69,  // 296: chroma.SysDB.GetQuerySamples:output_type -> chroma.GetQuerySamplesResponse
+	85,  // 297: chroma.SysDB.GetCollectionsToGc:output_type -> chroma.GetCollectionsToGcResponse
+	76,  // 298: chroma.SysDB.BatchUpdateCollectionMetadata:output_type -> chroma.BatchUpdateCollectionMetadataResponse
+	72,  // 299: chroma.SysDB.DeleteCollections:output_type -> chroma.DeleteCollectionsResponse
+	78,  // 300: chroma.SysDB.SetCollectionAclEntry:output_type -> chroma.SetCollectionAclEntryResponse
+	80,  // 301: chroma.SysDB.DeleteCollectionAclEntry:output_type -> chroma.DeleteCollectionAclEntryResponse
+	82,  // 302: chroma.SysDB.GetCollectionAcl:output_type -> chroma.GetCollectionAclResponse
+	88,  // 303: chroma.SysDB.SetTenantQuota:output_type -> chroma.SetTenantQuotaResponse
+	90,  // 304: chroma.SysDB.GetTenantQuota:output_type -> chroma.GetTenantQuotaResponse
+	93,  // 305: chroma.SysDB.CheckQuota:output_type -> chroma.CheckQuotaResponse
+	100, // 306: chroma.SysDB.SetCollectionLogRoute:output_type -> chroma.SetCollectionLogRouteResponse
+	102, // 307: chroma.SysDB.ResolveCollectionLogRoutes:output_type -> chroma.ResolveCollectionLogRoutesResponse
+	105, // 308: chroma.SysDB.CheckCollections:output_type -> chroma.CheckCollectionsResponse
+	107, // 309: chroma.SysDB.RequestCompaction:output_type -> chroma.RequestCompactionResponse
+	110, // 310: chroma.SysDB.GetCompactionRequests:output_type -> chroma.GetCompactionRequestsResponse
+	95,  // 311: chroma.SysDB.SetDatabaseQuota:output_type -> chroma.SetDatabaseQuotaResponse
+	97,  // 312: chroma.SysDB.GetEffectiveQuota:output_type -> chroma.GetEffectiveQuotaResponse
+	74,  // 313: chroma.SysDB.RestoreCollection:output_type -> chroma.RestoreCollectionResponse
+	63,  // 314: chroma.SysDB.ListInconsistentCollections:output_type -> chroma.ListInconsistentCollectionsResponse
+	64,  // 315: chroma.SysDB.CheckConsistencyWithProgress:output_type -> chroma.CheckConsistencyProgress
+	114, // 316: chroma.SysDB.ListDuplicateSegmentFiles:output_type -> chroma.ListDuplicateSegmentFilesResponse
+	116, // 317: chroma.SysDB.GetCollectionSegmentFiles:output_type -> chroma.GetCollectionSegmentFilesResponse
+	119, // 318: chroma.SysDB.GetStorageAttribution:output_type -> chroma.GetStorageAttributionResponse
+	122, // 319: chroma.SysDB.SetFeatureFlag:output_type -> chroma.SetFeatureFlagResponse
+	124, // 320: chroma.SysDB.DeleteFeatureFlag:output_type -> chroma.DeleteFeatureFlagResponse
+	126, // 321: chroma.SysDB.ListFeatureFlags:output_type -> chroma.ListFeatureFlagsResponse
+	129, // 322: chroma.SysDB.SetTenantPlacement:output_type -> chroma.SetTenantPlacementResponse
+	131, // 323: chroma.SysDB.GetTenantPlacement:output_type -> chroma.GetTenantPlacementResponse
+	133, // 324: chroma.SysDB.CheckTenantPlacement:output_type -> chroma.CheckTenantPlacementResponse
+	135, // 325: chroma.SysDB.GetCollectionPlacement:output_type -> chroma.GetCollectionPlacementResponse
+	137, // 326: chroma.SysDB.DecommissionNode:output_type -> chroma.DecommissionNodeResponse
+	139, // 327: chroma.SysDB.Heartbeat:output_type -> chroma.HeartbeatResponse
+	142, // 328: chroma.SysDB.GetNodeLiveness:output_type -> chroma.GetNodeLivenessResponse
+	146, // 329: chroma.SysDB.SimulateCollectionPlacement:output_type -> chroma.SimulateCollectionPlacementResponse
+	149, // 330: chroma.SysDB.ListCollectionStats:output_type -> chroma.ListCollectionStatsResponse
+	152, // 331: chroma.SysDB.GetCollectionSizeHistory:output_type -> chroma.GetCollectionSizeHistoryResponse
+	155, // 332: chroma.SysDB.GetTenantActivity:output_type -> chroma.GetTenantActivityResponse
+	158, // 333: chroma.SysDB.GetSoftDeleteCleanerStatus:output_type -> chroma.GetSoftDeleteCleanerStatusResponse
+	160, // 334: chroma.SysDB.RunSoftDeleteCleaner:output_type -> chroma.RunSoftDeleteCleanerResponse
+	163, // 335: chroma.SysDB.ListCleanupDeadLetters:output_type -> chroma.ListCleanupDeadLettersResponse
+	165, // 336: chroma.SysDB.DeleteCleanupDeadLetter:output_type -> chroma.DeleteCleanupDeadLetterResponse
+	169, // 337: chroma.SysDB.BatchCreateTenants:output_type -> chroma.BatchCreateTenantsResponse
+	172, // 338: chroma.SysDB.GetCollectionMetadataDiff:output_type -> chroma.GetCollectionMetadataDiffResponse
+	175, // 339: chroma.SysDB.CreateEmbeddingFunction:output_type -> chroma.CreateEmbeddingFunctionResponse
+	177, // 340: chroma.SysDB.GetEmbeddingFunctions:output_type -> chroma.GetEmbeddingFunctionsResponse
+	179, // 341: chroma.SysDB.UpdateEmbeddingFunction:output_type -> chroma.UpdateEmbeddingFunctionResponse
+	181, // 342: chroma.SysDB.DeleteEmbeddingFunction:output_type -> chroma.DeleteEmbeddingFunctionResponse
+	184, // 343: chroma.SysDB.SetCollectionSchema:output_type -> chroma.SetCollectionSchemaResponse
+	186, // 344: chroma.SysDB.GetCollectionSchema:output_type -> chroma.GetCollectionSchemaResponse
+	189, // 345: chroma.SysDB.ValidateCollectionMetadata:output_type -> chroma.ValidateCollectionMetadataResponse
+	192, // 346: chroma.SysDB.CreateCollectionTemplate:output_type -> chroma.CreateCollectionTemplateResponse
+	194, // 347: chroma.SysDB.GetCollectionTemplates:output_type -> chroma.GetCollectionTemplatesResponse
+	196, // 348: chroma.SysDB.DeleteCollectionTemplate:output_type -> chroma.DeleteCollectionTemplateResponse
+	198, // 349: chroma.SysDB.CreateCollectionFromTemplate:output_type -> chroma.CreateCollectionFromTemplateResponse
+	200, // 350: chroma.SysDB.SetMaintenanceMode:output_type -> chroma.SetMaintenanceModeResponse
+	202, // 351: chroma.SysDB.GetMaintenanceMode:output_type -> chroma.GetMaintenanceModeResponse
+	205, // 352: chroma.SysDB.GetJobStatus:output_type -> chroma.GetJobStatusResponse
+	207, // 353: chroma.SysDB.ListJobs:output_type -> chroma.ListJobsResponse
+	209, // 354: chroma.SysDB.CancelJob:output_type -> chroma.CancelJobResponse
+	268, // [268:355] is the sub-list for method output_type
+	181, // [181:268] is the sub-list for method input_type
+	181, // [181:181] is the sub-list for extension type_name
+	181, // [181:181] is the sub-list for extension extendee
+	0,   // [0:181] is the sub-list for field type_name
+}
+
+func init() { file_chromadb_proto_coordinator_proto_init() }
+func file_chromadb_proto_coordinator_proto_init() {
+	if File_chromadb_proto_coordinator_proto != nil {
+		return
+	}
+	file_chromadb_proto_chroma_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_chromadb_proto_coordinator_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateDatabaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateDatabaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDatabaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDatabaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTenantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTenantResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTenantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTenantResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTenantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTenantResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTenantProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestConfirmationTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestConfirmationTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSegmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSegmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSegmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSegmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSegmentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSegmentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSegmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSegmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCollectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCollectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCollectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCollectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionMetadataFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCollectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCollectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameCollectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameCollectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Notification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLastCompactionTimeForTenantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantLastCompactionTime); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLastCompactionTimeForTenantResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLastCompactionTimeForTenantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlushSegmentCompactionInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlushCollectionCompactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlushCollectionCompactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcquireCollectionFencingTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcquireCollectionFencingTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionsBySizeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionSize); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionsBySizeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetApproximateCountsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetApproximateCountsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountCollectionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountCollectionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanCollectionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanCollectionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListIncompleteCollectionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IncompleteCollection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListIncompleteCollectionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepairIncompleteCollectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepairIncompleteCollectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInconsistentCollectionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InconsistentCollection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInconsistentCollectionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckConsistencyProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompleteCollectionReindexRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompleteCollectionReindexResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQuerySamplesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQuerySamplesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCollectionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCollectionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreCollectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreCollectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchUpdateCollectionMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchUpdateCollectionMetadataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCollectionAclEntryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCollectionAclEntryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCollectionAclEntryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCollectionAclEntryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionAclRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionAclResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionsToGcRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionToGc); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionsToGcResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantQuota); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTenantQuotaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTenantQuotaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTenantQuotaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTenantQuotaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckQuotaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuotaViolation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckQuotaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDatabaseQuotaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDatabaseQuotaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEffectiveQuotaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEffectiveQuotaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionLogRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCollectionLogRouteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCollectionLogRouteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveCollectionLogRoutesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveCollectionLogRoutesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckCollectionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionExistence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckCollectionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestCompactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestCompactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCompactionRequestsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCompactionRequestsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SegmentFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDuplicateSegmentFilesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DuplicateSegmentFiles); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDuplicateSegmentFilesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionSegmentFilesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionSegmentFilesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStorageAttributionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionStorageAttribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStorageAttributionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeatureFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFeatureFlagRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFeatureFlagResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFeatureFlagRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFeatureFlagResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFeatureFlagsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFeatureFlagsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantPlacement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTenantPlacementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTenantPlacementResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTenantPlacementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTenantPlacementResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckTenantPlacementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckTenantPlacementResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionPlacementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionPlacementResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecommissionNodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecommissionNodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeartbeatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodeLivenessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeLiveness); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodeLivenessResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulateCollectionPlacementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionPlacementMove); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeLoad); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulateCollectionPlacementResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCollectionStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCollectionStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionSizeHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionSizeSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionSizeHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTenantActivityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantActivity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTenantActivityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SoftDeleteCleanerRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSoftDeleteCleanerStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSoftDeleteCleanerStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunSoftDeleteCleanerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RunSoftDeleteCleanerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCleanupDeadLettersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CleanupDeadLetter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCleanupDeadLettersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCleanupDeadLetterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCleanupDeadLetterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateTenant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateTenantsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateTenantsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionMetadataDiffRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionMetadataChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use FlushCollectionCompactionResponse.ProtoReflect.Descriptor instead.
-func (*FlushCollectionCompactionResponse) Descriptor() ([]byte, []int) {
-	return file_chromadb_proto_coordinator_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *FlushCollectionCompactionResponse) GetCollectionId() string {
-	if x != nil {
-		return x.CollectionId
-	}
-	return ""
-}
-
-func (x *FlushCollectionCompactionResponse) GetCollectionVersion() int32 {
-	if x != nil {
-		return x.CollectionVersion
-	}
-	return 0
-}
-
-func (x *FlushCollectionCompactionResponse) GetLastCompactionTime() int64 {
-	if x != nil {
-		return x.LastCompactionTime
-	}
-	return 0
-}
-
-var File_chromadb_proto_coordinator_proto protoreflect.FileDescriptor
-
-var file_chromadb_proto_coordinator_proto_rawDesc = []byte{
-	0x0a, 0x20, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x64, 0x62, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x06, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x1a, 0x1b, 0x63, 0x68, 0x72, 0x6f,
-	0x6d, 0x61, 0x64, 0x62, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x68, 0x72, 0x6f, 0x6d,
-	0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x53, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0x40, 0x0a, 0x16, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x40, 0x0a, 0x12, 0x47,
-	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0x6b, 0x0a,
-	0x13, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
-	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
-	0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x29, 0x0a, 0x13, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3e, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
-	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x26, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x63, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x54, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72,
-	0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x41, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x68,
-	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x73, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x3f, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3f,
-	0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0xc1, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x67,
-	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x48, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f,
-	0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69,
-	0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73,
-	0x63, 0x6f, 0x70, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x6a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x73, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63,
-	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0xfc, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a,
-	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x10, 0x72, 0x65,
-	0x73, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x72, 0x6f,
-	0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x48, 0x01, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a,
-	0x0e, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x13, 0x0a, 0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x3f,
-	0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0xa3, 0x02, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x37, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x64, 0x69, 0x6d, 0x65,
-	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x09, 0x64,
-	0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0d, 0x67,
-	0x65, 0x74, 0x5f, 0x6f, 0x72, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x48, 0x02, 0x52, 0x0b, 0x67, 0x65, 0x74, 0x4f, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08,
-	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73,
-	0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x67, 0x65, 0x74, 0x5f, 0x6f, 0x72, 0x5f, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x5d, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x22, 0x42, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x15,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88,
-	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x05, 0x48, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x42,
-	0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42,
-	0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x22, 0x76, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34,
-	0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xee, 0x01, 0x0a,
-	0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01,
-	0x01, 0x12, 0x21, 0x0a, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x09, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f,
-	0x6e, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00,
-	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x0e, 0x72, 0x65,
-	0x73, 0x65, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x08, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x42, 0x11, 0x0a, 0x0f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42,
-	0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x42, 0x0a,
-	0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f,
-	0x6d, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x6f, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x3c, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
-	0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x44, 0x0a, 0x25, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e,
-	0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x69, 0x0a, 0x18, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
-	0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12,
-	0x30, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6c,
-	0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d,
-	0x65, 0x22, 0x89, 0x01, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d,
-	0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x54, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x1b,
-	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e,
-	0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x69, 0x6d, 0x65, 0x52, 0x18, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43,
-	0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x88, 0x01,
-	0x0a, 0x25, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5f, 0x0a, 0x1b, 0x74, 0x65, 0x6e, 0x61, 0x6e,
-	0x74, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63,
-	0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4c, 0x61, 0x73, 0x74,
-	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x18,
-	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xde, 0x01, 0x0a, 0x1a, 0x46, 0x6c, 0x75,
-	0x73, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x67,
-	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x50, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x63, 0x68, 0x72,
-	0x6f, 0x6d, 0x61, 0x2e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x46,
-	0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x66,
-	0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x1a, 0x4f, 0x0a, 0x0e, 0x46, 0x69, 0x6c, 0x65,
-	0x50, 0x61, 0x74, 0x68, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68,
-	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x92, 0x02, 0x0a, 0x20, 0x46, 0x6c,
-	0x75, 0x73, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d,
-	0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x67, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x5a, 0x0a, 0x17, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f,
-	0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x46, 0x6c, 0x75,
-	0x73, 0x68, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x15, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xa9,
-	0x01, 0x0a, 0x21, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74,
-	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x32, 0xf4, 0x0a, 0x0a, 0x05, 0x53,
-	0x79, 0x73, 0x44, 0x42, 0x12, 0x51, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x44, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
-	0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x4b, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e,
-	0x74, 0x12, 0x1b, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
-	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42,
-	0x0a, 0x09, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x63, 0x68,
-	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47,
-	0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x12, 0x1a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
-	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0d,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e,
-	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x68,
-	0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x63, 0x68, 0x72, 0x6f,
-	0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x68, 0x72,
-	0x6f, 0x6d, 0x61, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x1d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x57, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0a, 0x52, 0x65,
-	0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x1a, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x81,
-	0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x6e, 0x61, 0x6e,
-	0x74, 0x12, 0x2d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61,
-	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
-	0x46, 0x6f, 0x72, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2e, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73,
-	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x46,
-	0x6f, 0x72, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x69, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d,
-	0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x54, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x53, 0x65,
-	0x74, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x69, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x72, 0x0a,
-	0x19, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x63, 0x68, 0x72,
-	0x6f, 0x6d, 0x61, 0x2e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2e, 0x46, 0x6c,
-	0x75, 0x73, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d,
-	0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x61, 0x2d, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x63, 0x68, 0x72, 0x6f,
-	0x6d, 0x61, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x70, 0x62, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_chromadb_proto_coordinator_proto_rawDescOnce sync.Once
-	file_chromadb_proto_coordinator_proto_rawDescData = file_chromadb_proto_coordinator_proto_rawDesc
-)
-
-func file_chromadb_proto_coordinator_proto_rawDescGZIP() []byte {
-	file_chromadb_proto_coordinator_proto_rawDescOnce.Do(func() {
-		file_chromadb_proto_coordinator_proto_rawDescData = protoimpl.X.CompressGZIP(file_chromadb_proto_coordinator_proto_rawDescData)
-	})
-	return file_chromadb_proto_coordinator_proto_rawDescData
-}
-
-var file_chromadb_proto_coordinator_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
-var file_chromadb_proto_coordinator_proto_goTypes = []interface{}{
-	(*CreateDatabaseRequest)(nil),                  // 0: chroma.CreateDatabaseRequest
-	(*CreateDatabaseResponse)(nil),                 // 1: chroma.CreateDatabaseResponse
-	(*GetDatabaseRequest)(nil),                     // 2: chroma.GetDatabaseRequest
-	(*GetDatabaseResponse)(nil),                    // 3: chroma.GetDatabaseResponse
-	(*CreateTenantRequest)(nil),                    // 4: chroma.CreateTenantRequest
-	(*CreateTenantResponse)(nil),                   // 5: chroma.CreateTenantResponse
-	(*GetTenantRequest)(nil),                       // 6: chroma.GetTenantRequest
-	(*GetTenantResponse)(nil),                      // 7: chroma.GetTenantResponse
-	(*CreateSegmentRequest)(nil),                   // 8: chroma.CreateSegmentRequest
-	(*CreateSegmentResponse)(nil),                  // 9: chroma.CreateSegmentResponse
-	(*DeleteSegmentRequest)(nil),                   // 10: chroma.DeleteSegmentRequest
-	(*DeleteSegmentResponse)(nil),                  // 11: chroma.DeleteSegmentResponse
-	(*GetSegmentsRequest)(nil),                     // 12: chroma.GetSegmentsRequest
-	(*GetSegmentsResponse)(nil),                    // 13: chroma.GetSegmentsResponse
-	(*UpdateSegmentRequest)(nil),                   // 14: chroma.UpdateSegmentRequest
-	(*UpdateSegmentResponse)(nil),                  // 15: chroma.UpdateSegmentResponse
-	(*CreateCollectionRequest)(nil),                // 16: chroma.CreateCollectionRequest
-	(*CreateCollectionResponse)(nil),               // 17: chroma.CreateCollectionResponse
-	(*DeleteCollectionRequest)(nil),                // 18: chroma.DeleteCollectionRequest
-	(*DeleteCollectionResponse)(nil),               // 19: chroma.DeleteCollectionResponse
-	(*GetCollectionsRequest)(nil),                  // 20: chroma.GetCollectionsRequest
-	(*GetCollectionsResponse)(nil),                 // 21: chroma.GetCollectionsResponse
-	(*UpdateCollectionRequest)(nil),                // 22: chroma.UpdateCollectionRequest
-	(*UpdateCollectionResponse)(nil),               // 23: chroma.UpdateCollectionResponse
-	(*Notification)(nil),                           // 24: chroma.Notification
-	(*ResetStateResponse)(nil),                     // 25: chroma.ResetStateResponse
-	(*GetLastCompactionTimeForTenantRequest)(nil),  // 26: chroma.GetLastCompactionTimeForTenantRequest
-	(*TenantLastCompactionTime)(nil),               // 27: chroma.TenantLastCompactionTime
-	(*GetLastCompactionTimeForTenantResponse)(nil), // 28: chroma.GetLastCompactionTimeForTenantResponse
-	(*SetLastCompactionTimeForTenantRequest)(nil),  // 29: chroma.SetLastCompactionTimeForTenantRequest
-	(*FlushSegmentCompactionInfo)(nil),             // 30: chroma.FlushSegmentCompactionInfo
-	(*FlushCollectionCompactionRequest)(nil),       // 31: chroma.FlushCollectionCompactionRequest
-	(*FlushCollectionCompactionResponse)(nil),      // 32: chroma.FlushCollectionCompactionResponse
-	nil,                    // 33: chroma.FlushSegmentCompactionInfo.FilePathsEntry
-	(*Status)(nil),         // 34: chroma.Status
-	(*Database)(nil),       // 35: chroma.Database
-	(*Tenant)(nil),         // 36: chroma.Tenant
-	(*Segment)(nil),        // 37: chroma.Segment
-	(SegmentScope)(0),      // 38: chroma.SegmentScope
-	(*UpdateMetadata)(nil), // 39: chroma.UpdateMetadata
-	(*Collection)(nil),     // 40: chroma.Collection
-	(*FilePaths)(nil),      // 41: chroma.FilePaths
-	(*emptypb.Empty)(nil),  // 42: google.protobuf.Empty
-}
-var file_chromadb_proto_coordinator_proto_depIdxs = []int32{
-	34, // 0: chroma.CreateDatabaseResponse.status:type_name -> chroma.Status
-	35, // 1: chroma.GetDatabaseResponse.database:type_name -> chroma.Database
-	34, // 2: chroma.GetDatabaseResponse.status:type_name -> chroma.Status
-	34, // 3: chroma.CreateTenantResponse.status:type_name -> chroma.Status
-	36, // 4: chroma.GetTenantResponse.tenant:type_name -> chroma.Tenant
-	34, // 5: chroma.GetTenantResponse.status:type_name -> chroma.Status
-	37, // 6: chroma.CreateSegmentRequest.segment:type_name -> chroma.Segment
-	34, // 7: chroma.CreateSegmentResponse.status:type_name -> chroma.Status
-	34, // 8: chroma.DeleteSegmentResponse.status:type_name -> chroma.Status
-	38, // 9: chroma.GetSegmentsRequest.scope:type_name -> chroma.SegmentScope
-	37, // 10: chroma.GetSegmentsResponse.segments:type_name -> chroma.Segment
-	34, // 11: chroma.GetSegmentsResponse.status:type_name -> chroma.Status
-	39, // 12: chroma.UpdateSegmentRequest.metadata:type_name -> chroma.UpdateMetadata
-	34, // 13: chroma.UpdateSegmentResponse.status:type_name -> chroma.Status
-	39, // 14: chroma.CreateCollectionRequest.metadata:type_name -> chroma.UpdateMetadata
-	40, // 15: chroma.CreateCollectionResponse.collection:type_name -> chroma.Collection
-	34, // 16: chroma.CreateCollectionResponse.status:type_name -> chroma.Status
-	34, // 17: chroma.DeleteCollectionResponse.status:type_name -> chroma.Status
-	40, // 18: chroma.GetCollectionsResponse.collections:type_name -> chroma.Collection
-	34, // 19: chroma.GetCollectionsResponse.status:type_name -> chroma.Status
-	39, // 20: chroma.UpdateCollectionRequest.metadata:type_name -> chroma.UpdateMetadata
-	34, // 21: chroma.UpdateCollectionResponse.status:type_name -> chroma.Status
-	34, // 22: chroma.ResetStateResponse.status:type_name -> chroma.Status
-	27, // 23: chroma.GetLastCompactionTimeForTenantResponse.tenant_last_compaction_time:type_name -> chroma.TenantLastCompactionTime
-	27, // 24: chroma.SetLastCompactionTimeForTenantRequest.tenant_last_compaction_time:type_name -> chroma.TenantLastCompactionTime
-	33, // 25: chroma.FlushSegmentCompactionInfo.file_paths:type_name -> chroma.FlushSegmentCompactionInfo.FilePathsEntry
-	30, // 26: chroma.FlushCollectionCompactionRequest.segment_compaction_info:type_name -> chroma.FlushSegmentCompactionInfo
-	41, // 27: chroma.FlushSegmentCompactionInfo.FilePathsEntry.value:type_name -> chroma.FilePaths
-	0,  // 28: chroma.SysDB.CreateDatabase:input_type -> chroma.CreateDatabaseRequest
-	2,  // 29: chroma.SysDB.GetDatabase:input_type -> chroma.GetDatabaseRequest
-	4,  // 30: chroma.SysDB.CreateTenant:input_type -> chroma.CreateTenantRequest
-	6,  // 31: chroma.SysDB.GetTenant:input_type -> chroma.GetTenantRequest
-	8,  // 32: chroma.SysDB.CreateSegment:input_type -> chroma.CreateSegmentRequest
-	10, // 33: chroma.SysDB.DeleteSegment:input_type -> chroma.DeleteSegmentRequest
-	12, // 34: chroma.SysDB.GetSegments:input_type -> chroma.GetSegmentsRequest
-	14, // 35: chroma.SysDB.UpdateSegment:input_type -> chroma.UpdateSegmentRequest
-	16, // 36: chroma.SysDB.CreateCollection:input_type -> chroma.CreateCollectionRequest
-	18, // 37: chroma.SysDB.DeleteCollection:input_type -> chroma.DeleteCollectionRequest
-	20, // 38: chroma.SysDB.GetCollections:input_type -> chroma.GetCollectionsRequest
-	22, // 39: chroma.SysDB.UpdateCollection:input_type -> chroma.UpdateCollectionRequest
-	42, // 40: chroma.SysDB.ResetState:input_type -> google.protobuf.Empty
-	26, // 41: chroma.SysDB.GetLastCompactionTimeForTenant:input_type -> chroma.GetLastCompactionTimeForTenantRequest
-	29, // 42: chroma.SysDB.SetLastCompactionTimeForTenant:input_type -> chroma.SetLastCompactionTimeForTenantRequest
-	31, // 43: chroma.SysDB.FlushCollectionCompaction:input_type -> chroma.FlushCollectionCompactionRequest
-	1,  // 44: chroma.SysDB.CreateDatabase:output_type -> chroma.CreateDatabaseResponse
-	3,  // 45: chroma.SysDB.GetDatabase:output_type -> chroma.GetDatabaseResponse
-	5,  // 46: chroma.SysDB.CreateTenant:output_type -> chroma.CreateTenantResponse
-	7,  // 47: chroma.SysDB.GetTenant:output_type -> chroma.GetTenantResponse
-	9,  // 48: chroma.SysDB.CreateSegment:output_type -> chroma.CreateSegmentResponse
-	11, // 49: chroma.SysDB.DeleteSegment:output_type -> chroma.DeleteSegmentResponse
-	13, // 50: chroma.SysDB.GetSegments:output_type -> chroma.GetSegmentsResponse
-	15, // 51: chroma.SysDB.UpdateSegment:output_type -> chroma.UpdateSegmentResponse
-	17, // 52: chroma.SysDB.CreateCollection:output_type -> chroma.CreateCollectionResponse
-	19, // 53: chroma.SysDB.DeleteCollection:output_type -> chroma.DeleteCollectionResponse
-	21, // 54: chroma.SysDB.GetCollections:output_type -> chroma.GetCollectionsResponse
-	23, // 55: chroma.SysDB.UpdateCollection:output_type -> chroma.UpdateCollectionResponse
-	25, // 56: chroma.SysDB.ResetState:output_type -> chroma.ResetStateResponse
-	28, // 57: chroma.SysDB.GetLastCompactionTimeForTenant:output_type -> chroma.GetLastCompactionTimeForTenantResponse
-	42, // 58: chroma.SysDB.SetLastCompactionTimeForTenant:output_type -> google.protobuf.Empty
-	32, // 59: chroma.SysDB.FlushCollectionCompaction:output_type -> chroma.FlushCollectionCompactionResponse
-	44, // [44:60] is the sub-list for method output_type
-	28, // [28:44] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
-}
-
-func init() { file_chromadb_proto_coordinator_proto_init() }
-func file_chromadb_proto_coordinator_proto_init() {
-	if File_chromadb_proto_coordinator_proto != nil {
-		return
-	}
-	file_chromadb_proto_chroma_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_chromadb_proto_coordinator_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateDatabaseRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionMetadataDiffResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2500,8 +18458,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateDatabaseResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmbeddingFunction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2512,8 +18470,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDatabaseRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateEmbeddingFunctionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2524,8 +18482,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDatabaseResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateEmbeddingFunctionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2536,8 +18494,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTenantRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEmbeddingFunctionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2548,8 +18506,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTenantResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEmbeddingFunctionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2560,8 +18518,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTenantRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateEmbeddingFunctionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2572,8 +18530,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTenantResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateEmbeddingFunctionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2584,8 +18542,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSegmentRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteEmbeddingFunctionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2596,8 +18554,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSegmentResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteEmbeddingFunctionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2608,8 +18566,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSegmentRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[179].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionSchemaField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2620,8 +18578,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSegmentResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[180].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCollectionSchemaRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2632,8 +18590,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSegmentsRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[181].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCollectionSchemaResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2644,8 +18602,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSegmentsResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[182].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionSchemaRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2656,8 +18614,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSegmentRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[183].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionSchemaResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2668,8 +18626,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateSegmentResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[184].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateCollectionMetadataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2680,8 +18638,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCollectionRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[185].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionSchemaViolation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2692,8 +18650,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCollectionResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[186].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateCollectionMetadataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2704,8 +18662,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCollectionRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[187].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionTemplate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2716,8 +18674,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCollectionResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[188].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCollectionTemplateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2728,8 +18686,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCollectionsRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[189].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCollectionTemplateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2740,8 +18698,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCollectionsResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[190].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionTemplatesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2752,8 +18710,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateCollectionRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[191].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionTemplatesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2764,8 +18722,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateCollectionResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[192].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCollectionTemplateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2776,8 +18734,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Notification); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[193].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCollectionTemplateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2788,8 +18746,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetStateResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[194].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCollectionFromTemplateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2800,8 +18758,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLastCompactionTimeForTenantRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[195].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCollectionFromTemplateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2812,8 +18770,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TenantLastCompactionTime); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[196].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMaintenanceModeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2824,8 +18782,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLastCompactionTimeForTenantResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[197].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMaintenanceModeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2836,8 +18794,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetLastCompactionTimeForTenantRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[198].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMaintenanceModeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2848,8 +18806,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FlushSegmentCompactionInfo); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[199].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMaintenanceModeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2860,8 +18818,8 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FlushCollectionCompactionRequest); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[200].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Job); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2872,8 +18830,68 @@ func file_chromadb_proto_coordinator_proto_init() {
 				return nil
 			}
 		}
-		file_chromadb_proto_coordinator_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FlushCollectionCompactionResponse); i {
+		file_chromadb_proto_coordinator_proto_msgTypes[201].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJobStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[202].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetJobStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[203].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJobsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[204].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJobsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[205].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chromadb_proto_coordinator_proto_msgTypes[206].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelJobResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2885,31 +18903,72 @@ func file_chromadb_proto_coordinator_proto_init() {
 			}
 		}
 	}
-	file_chromadb_proto_coordinator_proto_msgTypes[12].OneofWrappers = []interface{}{}
-	file_chromadb_proto_coordinator_proto_msgTypes[14].OneofWrappers = []interface{}{
+	file_chromadb_proto_coordinator_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[20].OneofWrappers = []interface{}{
 		(*UpdateSegmentRequest_Collection)(nil),
 		(*UpdateSegmentRequest_ResetCollection)(nil),
 		(*UpdateSegmentRequest_Metadata)(nil),
 		(*UpdateSegmentRequest_ResetMetadata)(nil),
 	}
-	file_chromadb_proto_coordinator_proto_msgTypes[16].OneofWrappers = []interface{}{}
-	file_chromadb_proto_coordinator_proto_msgTypes[20].OneofWrappers = []interface{}{}
-	file_chromadb_proto_coordinator_proto_msgTypes[22].OneofWrappers = []interface{}{
+	file_chromadb_proto_coordinator_proto_msgTypes[22].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[26].OneofWrappers = []interface{}{
+		(*CollectionMetadataFilter_SingleStringOperand)(nil),
+		(*CollectionMetadataFilter_SingleIntOperand)(nil),
+		(*CollectionMetadataFilter_SingleDoubleOperand)(nil),
+		(*CollectionMetadataFilter_SingleBoolOperand)(nil),
+		(*CollectionMetadataFilter_Exists)(nil),
+	}
+	file_chromadb_proto_coordinator_proto_msgTypes[27].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[29].OneofWrappers = []interface{}{
 		(*UpdateCollectionRequest_Metadata)(nil),
 		(*UpdateCollectionRequest_ResetMetadata)(nil),
+		(*UpdateCollectionRequest_EmbeddingFunctionId)(nil),
+		(*UpdateCollectionRequest_ResetEmbeddingFunction)(nil),
 	}
+	file_chromadb_proto_coordinator_proto_msgTypes[31].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[40].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[49].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[51].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[53].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[58].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[62].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[70].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[80].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[82].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[83].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[88].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[89].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[98].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[105].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[106].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[108].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[109].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[117].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[140].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[144].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[145].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[163].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[173].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[175].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[187].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[190].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[194].OneofWrappers = []interface{}{}
+	file_chromadb_proto_coordinator_proto_msgTypes[203].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_chromadb_proto_coordinator_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   34,
+			NumEnums:      3,
+			NumMessages:   208,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_chromadb_proto_coordinator_proto_goTypes,
 		DependencyIndexes: file_chromadb_proto_coordinator_proto_depIdxs,
+		EnumInfos:         file_chromadb_proto_coordinator_proto_enumTypes,
 		MessageInfos:      file_chromadb_proto_coordinator_proto_msgTypes,
 	}.Build()
 	File_chromadb_proto_coordinator_proto = out.File
@@ -24,6 +24,9 @@ const (
 	SysDB_GetDatabase_FullMethodName                    = "/chroma.SysDB/GetDatabase"
 	SysDB_CreateTenant_FullMethodName                   = "/chroma.SysDB/CreateTenant"
 	SysDB_GetTenant_FullMethodName                      = "/chroma.SysDB/GetTenant"
+	SysDB_DeleteTenant_FullMethodName                   = "/chroma.SysDB/DeleteTenant"
+	SysDB_DeleteTenantWithProgress_FullMethodName       = "/chroma.SysDB/DeleteTenantWithProgress"
+	SysDB_RequestConfirmationToken_FullMethodName       = "/chroma.SysDB/RequestConfirmationToken"
 	SysDB_CreateSegment_FullMethodName                  = "/chroma.SysDB/CreateSegment"
 	SysDB_DeleteSegment_FullMethodName                  = "/chroma.SysDB/DeleteSegment"
 	SysDB_GetSegments_FullMethodName                    = "/chroma.SysDB/GetSegments"
@@ -32,10 +35,78 @@ const (
 	SysDB_DeleteCollection_FullMethodName               = "/chroma.SysDB/DeleteCollection"
 	SysDB_GetCollections_FullMethodName                 = "/chroma.SysDB/GetCollections"
 	SysDB_UpdateCollection_FullMethodName               = "/chroma.SysDB/UpdateCollection"
+	SysDB_RenameCollection_FullMethodName               = "/chroma.SysDB/RenameCollection"
 	SysDB_ResetState_FullMethodName                     = "/chroma.SysDB/ResetState"
 	SysDB_GetLastCompactionTimeForTenant_FullMethodName = "/chroma.SysDB/GetLastCompactionTimeForTenant"
 	SysDB_SetLastCompactionTimeForTenant_FullMethodName = "/chroma.SysDB/SetLastCompactionTimeForTenant"
 	SysDB_FlushCollectionCompaction_FullMethodName      = "/chroma.SysDB/FlushCollectionCompaction"
+	SysDB_AcquireCollectionFencingToken_FullMethodName  = "/chroma.SysDB/AcquireCollectionFencingToken"
+	SysDB_GetCollectionsBySize_FullMethodName           = "/chroma.SysDB/GetCollectionsBySize"
+	SysDB_GetApproximateCounts_FullMethodName           = "/chroma.SysDB/GetApproximateCounts"
+	SysDB_CountCollections_FullMethodName               = "/chroma.SysDB/CountCollections"
+	SysDB_ScanCollections_FullMethodName                = "/chroma.SysDB/ScanCollections"
+	SysDB_ListIncompleteCollections_FullMethodName      = "/chroma.SysDB/ListIncompleteCollections"
+	SysDB_RepairIncompleteCollection_FullMethodName     = "/chroma.SysDB/RepairIncompleteCollection"
+	SysDB_CompleteCollectionReindex_FullMethodName      = "/chroma.SysDB/CompleteCollectionReindex"
+	SysDB_GetQuerySamples_FullMethodName                = "/chroma.SysDB/GetQuerySamples"
+	SysDB_GetCollectionsToGc_FullMethodName             = "/chroma.SysDB/GetCollectionsToGc"
+	SysDB_BatchUpdateCollectionMetadata_FullMethodName  = "/chroma.SysDB/BatchUpdateCollectionMetadata"
+	SysDB_DeleteCollections_FullMethodName              = "/chroma.SysDB/DeleteCollections"
+	SysDB_SetCollectionAclEntry_FullMethodName          = "/chroma.SysDB/SetCollectionAclEntry"
+	SysDB_DeleteCollectionAclEntry_FullMethodName       = "/chroma.SysDB/DeleteCollectionAclEntry"
+	SysDB_GetCollectionAcl_FullMethodName               = "/chroma.SysDB/GetCollectionAcl"
+	SysDB_SetTenantQuota_FullMethodName                 = "/chroma.SysDB/SetTenantQuota"
+	SysDB_GetTenantQuota_FullMethodName                 = "/chroma.SysDB/GetTenantQuota"
+	SysDB_CheckQuota_FullMethodName                     = "/chroma.SysDB/CheckQuota"
+	SysDB_SetCollectionLogRoute_FullMethodName          = "/chroma.SysDB/SetCollectionLogRoute"
+	SysDB_ResolveCollectionLogRoutes_FullMethodName     = "/chroma.SysDB/ResolveCollectionLogRoutes"
+	SysDB_CheckCollections_FullMethodName               = "/chroma.SysDB/CheckCollections"
+	SysDB_RequestCompaction_FullMethodName              = "/chroma.SysDB/RequestCompaction"
+	SysDB_GetCompactionRequests_FullMethodName          = "/chroma.SysDB/GetCompactionRequests"
+	SysDB_SetDatabaseQuota_FullMethodName               = "/chroma.SysDB/SetDatabaseQuota"
+	SysDB_GetEffectiveQuota_FullMethodName              = "/chroma.SysDB/GetEffectiveQuota"
+	SysDB_RestoreCollection_FullMethodName              = "/chroma.SysDB/RestoreCollection"
+	SysDB_ListInconsistentCollections_FullMethodName    = "/chroma.SysDB/ListInconsistentCollections"
+	SysDB_CheckConsistencyWithProgress_FullMethodName   = "/chroma.SysDB/CheckConsistencyWithProgress"
+	SysDB_ListDuplicateSegmentFiles_FullMethodName      = "/chroma.SysDB/ListDuplicateSegmentFiles"
+	SysDB_GetCollectionSegmentFiles_FullMethodName      = "/chroma.SysDB/GetCollectionSegmentFiles"
+	SysDB_GetStorageAttribution_FullMethodName          = "/chroma.SysDB/GetStorageAttribution"
+	SysDB_SetFeatureFlag_FullMethodName                 = "/chroma.SysDB/SetFeatureFlag"
+	SysDB_DeleteFeatureFlag_FullMethodName              = "/chroma.SysDB/DeleteFeatureFlag"
+	SysDB_ListFeatureFlags_FullMethodName               = "/chroma.SysDB/ListFeatureFlags"
+	SysDB_SetTenantPlacement_FullMethodName             = "/chroma.SysDB/SetTenantPlacement"
+	SysDB_GetTenantPlacement_FullMethodName             = "/chroma.SysDB/GetTenantPlacement"
+	SysDB_CheckTenantPlacement_FullMethodName           = "/chroma.SysDB/CheckTenantPlacement"
+	SysDB_GetCollectionPlacement_FullMethodName         = "/chroma.SysDB/GetCollectionPlacement"
+	SysDB_DecommissionNode_FullMethodName               = "/chroma.SysDB/DecommissionNode"
+	SysDB_Heartbeat_FullMethodName                      = "/chroma.SysDB/Heartbeat"
+	SysDB_GetNodeLiveness_FullMethodName                = "/chroma.SysDB/GetNodeLiveness"
+	SysDB_SimulateCollectionPlacement_FullMethodName    = "/chroma.SysDB/SimulateCollectionPlacement"
+	SysDB_ListCollectionStats_FullMethodName            = "/chroma.SysDB/ListCollectionStats"
+	SysDB_GetCollectionSizeHistory_FullMethodName       = "/chroma.SysDB/GetCollectionSizeHistory"
+	SysDB_GetTenantActivity_FullMethodName              = "/chroma.SysDB/GetTenantActivity"
+	SysDB_GetSoftDeleteCleanerStatus_FullMethodName     = "/chroma.SysDB/GetSoftDeleteCleanerStatus"
+	SysDB_RunSoftDeleteCleaner_FullMethodName           = "/chroma.SysDB/RunSoftDeleteCleaner"
+	SysDB_ListCleanupDeadLetters_FullMethodName         = "/chroma.SysDB/ListCleanupDeadLetters"
+	SysDB_DeleteCleanupDeadLetter_FullMethodName        = "/chroma.SysDB/DeleteCleanupDeadLetter"
+	SysDB_BatchCreateTenants_FullMethodName             = "/chroma.SysDB/BatchCreateTenants"
+	SysDB_GetCollectionMetadataDiff_FullMethodName      = "/chroma.SysDB/GetCollectionMetadataDiff"
+	SysDB_CreateEmbeddingFunction_FullMethodName        = "/chroma.SysDB/CreateEmbeddingFunction"
+	SysDB_GetEmbeddingFunctions_FullMethodName          = "/chroma.SysDB/GetEmbeddingFunctions"
+	SysDB_UpdateEmbeddingFunction_FullMethodName        = "/chroma.SysDB/UpdateEmbeddingFunction"
+	SysDB_DeleteEmbeddingFunction_FullMethodName        = "/chroma.SysDB/DeleteEmbeddingFunction"
+	SysDB_SetCollectionSchema_FullMethodName            = "/chroma.SysDB/SetCollectionSchema"
+	SysDB_GetCollectionSchema_FullMethodName            = "/chroma.SysDB/GetCollectionSchema"
+	SysDB_ValidateCollectionMetadata_FullMethodName     = "/chroma.SysDB/ValidateCollectionMetadata"
+	SysDB_CreateCollectionTemplate_FullMethodName       = "/chroma.SysDB/CreateCollectionTemplate"
+	SysDB_GetCollectionTemplates_FullMethodName         = "/chroma.SysDB/GetCollectionTemplates"
+	SysDB_DeleteCollectionTemplate_FullMethodName       = "/chroma.SysDB/DeleteCollectionTemplate"
+	SysDB_CreateCollectionFromTemplate_FullMethodName   = "/chroma.SysDB/CreateCollectionFromTemplate"
+	SysDB_SetMaintenanceMode_FullMethodName             = "/chroma.SysDB/SetMaintenanceMode"
+	SysDB_GetMaintenanceMode_FullMethodName             = "/chroma.SysDB/GetMaintenanceMode"
+	SysDB_GetJobStatus_FullMethodName                   = "/chroma.SysDB/GetJobStatus"
+	SysDB_ListJobs_FullMethodName                       = "/chroma.SysDB/ListJobs"
+	SysDB_CancelJob_FullMethodName                      = "/chroma.SysDB/CancelJob"
 )
 
 // SysDBClient is the client API for SysDB service.
@@ -46,6 +117,12 @@ type SysDBClient interface {
 	GetDatabase(ctx context.Context, in *GetDatabaseRequest, opts ...grpc.CallOption) (*GetDatabaseResponse, error)
 	CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*CreateTenantResponse, error)
 	GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*GetTenantResponse, error)
+	DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (*DeleteTenantResponse, error)
+	// DeleteTenantWithProgress deletes a tenant within the call like
+	// DeleteTenant, streaming its progress instead of leaving the client blind
+	// until the deadline. async is not supported.
+	DeleteTenantWithProgress(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (SysDB_DeleteTenantWithProgressClient, error)
+	RequestConfirmationToken(ctx context.Context, in *RequestConfirmationTokenRequest, opts ...grpc.CallOption) (*RequestConfirmationTokenResponse, error)
 	CreateSegment(ctx context.Context, in *CreateSegmentRequest, opts ...grpc.CallOption) (*CreateSegmentResponse, error)
 	DeleteSegment(ctx context.Context, in *DeleteSegmentRequest, opts ...grpc.CallOption) (*DeleteSegmentResponse, error)
 	GetSegments(ctx context.Context, in *GetSegmentsRequest, opts ...grpc.CallOption) (*GetSegmentsResponse, error)
@@ -54,10 +131,78 @@ type SysDBClient interface {
 	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error)
 	GetCollections(ctx context.Context, in *GetCollectionsRequest, opts ...grpc.CallOption) (*GetCollectionsResponse, error)
 	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*UpdateCollectionResponse, error)
+	RenameCollection(ctx context.Context, in *RenameCollectionRequest, opts ...grpc.CallOption) (*RenameCollectionResponse, error)
 	ResetState(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ResetStateResponse, error)
 	GetLastCompactionTimeForTenant(ctx context.Context, in *GetLastCompactionTimeForTenantRequest, opts ...grpc.CallOption) (*GetLastCompactionTimeForTenantResponse, error)
 	SetLastCompactionTimeForTenant(ctx context.Context, in *SetLastCompactionTimeForTenantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	FlushCollectionCompaction(ctx context.Context, in *FlushCollectionCompactionRequest, opts ...grpc.CallOption) (*FlushCollectionCompactionResponse, error)
+	AcquireCollectionFencingToken(ctx context.Context, in *AcquireCollectionFencingTokenRequest, opts ...grpc.CallOption) (*AcquireCollectionFencingTokenResponse, error)
+	GetCollectionsBySize(ctx context.Context, in *GetCollectionsBySizeRequest, opts ...grpc.CallOption) (*GetCollectionsBySizeResponse, error)
+	GetApproximateCounts(ctx context.Context, in *GetApproximateCountsRequest, opts ...grpc.CallOption) (*GetApproximateCountsResponse, error)
+	CountCollections(ctx context.Context, in *CountCollectionsRequest, opts ...grpc.CallOption) (*CountCollectionsResponse, error)
+	ScanCollections(ctx context.Context, in *ScanCollectionsRequest, opts ...grpc.CallOption) (SysDB_ScanCollectionsClient, error)
+	ListIncompleteCollections(ctx context.Context, in *ListIncompleteCollectionsRequest, opts ...grpc.CallOption) (*ListIncompleteCollectionsResponse, error)
+	RepairIncompleteCollection(ctx context.Context, in *RepairIncompleteCollectionRequest, opts ...grpc.CallOption) (*RepairIncompleteCollectionResponse, error)
+	CompleteCollectionReindex(ctx context.Context, in *CompleteCollectionReindexRequest, opts ...grpc.CallOption) (*CompleteCollectionReindexResponse, error)
+	GetQuerySamples(ctx context.Context, in *GetQuerySamplesRequest, opts ...grpc.CallOption) (*GetQuerySamplesResponse, error)
+	GetCollectionsToGc(ctx context.Context, in *GetCollectionsToGcRequest, opts ...grpc.CallOption) (*GetCollectionsToGcResponse, error)
+	BatchUpdateCollectionMetadata(ctx context.Context, in *BatchUpdateCollectionMetadataRequest, opts ...grpc.CallOption) (*BatchUpdateCollectionMetadataResponse, error)
+	DeleteCollections(ctx context.Context, in *DeleteCollectionsRequest, opts ...grpc.CallOption) (*DeleteCollectionsResponse, error)
+	SetCollectionAclEntry(ctx context.Context, in *SetCollectionAclEntryRequest, opts ...grpc.CallOption) (*SetCollectionAclEntryResponse, error)
+	DeleteCollectionAclEntry(ctx context.Context, in *DeleteCollectionAclEntryRequest, opts ...grpc.CallOption) (*DeleteCollectionAclEntryResponse, error)
+	GetCollectionAcl(ctx context.Context, in *GetCollectionAclRequest, opts ...grpc.CallOption) (*GetCollectionAclResponse, error)
+	SetTenantQuota(ctx context.Context, in *SetTenantQuotaRequest, opts ...grpc.CallOption) (*SetTenantQuotaResponse, error)
+	GetTenantQuota(ctx context.Context, in *GetTenantQuotaRequest, opts ...grpc.CallOption) (*GetTenantQuotaResponse, error)
+	CheckQuota(ctx context.Context, in *CheckQuotaRequest, opts ...grpc.CallOption) (*CheckQuotaResponse, error)
+	SetCollectionLogRoute(ctx context.Context, in *SetCollectionLogRouteRequest, opts ...grpc.CallOption) (*SetCollectionLogRouteResponse, error)
+	ResolveCollectionLogRoutes(ctx context.Context, in *ResolveCollectionLogRoutesRequest, opts ...grpc.CallOption) (*ResolveCollectionLogRoutesResponse, error)
+	CheckCollections(ctx context.Context, in *CheckCollectionsRequest, opts ...grpc.CallOption) (*CheckCollectionsResponse, error)
+	RequestCompaction(ctx context.Context, in *RequestCompactionRequest, opts ...grpc.CallOption) (*RequestCompactionResponse, error)
+	GetCompactionRequests(ctx context.Context, in *GetCompactionRequestsRequest, opts ...grpc.CallOption) (*GetCompactionRequestsResponse, error)
+	SetDatabaseQuota(ctx context.Context, in *SetDatabaseQuotaRequest, opts ...grpc.CallOption) (*SetDatabaseQuotaResponse, error)
+	GetEffectiveQuota(ctx context.Context, in *GetEffectiveQuotaRequest, opts ...grpc.CallOption) (*GetEffectiveQuotaResponse, error)
+	RestoreCollection(ctx context.Context, in *RestoreCollectionRequest, opts ...grpc.CallOption) (*RestoreCollectionResponse, error)
+	ListInconsistentCollections(ctx context.Context, in *ListInconsistentCollectionsRequest, opts ...grpc.CallOption) (*ListInconsistentCollectionsResponse, error)
+	CheckConsistencyWithProgress(ctx context.Context, in *ListInconsistentCollectionsRequest, opts ...grpc.CallOption) (SysDB_CheckConsistencyWithProgressClient, error)
+	ListDuplicateSegmentFiles(ctx context.Context, in *ListDuplicateSegmentFilesRequest, opts ...grpc.CallOption) (*ListDuplicateSegmentFilesResponse, error)
+	GetCollectionSegmentFiles(ctx context.Context, in *GetCollectionSegmentFilesRequest, opts ...grpc.CallOption) (*GetCollectionSegmentFilesResponse, error)
+	GetStorageAttribution(ctx context.Context, in *GetStorageAttributionRequest, opts ...grpc.CallOption) (*GetStorageAttributionResponse, error)
+	SetFeatureFlag(ctx context.Context, in *SetFeatureFlagRequest, opts ...grpc.CallOption) (*SetFeatureFlagResponse, error)
+	DeleteFeatureFlag(ctx context.Context, in *DeleteFeatureFlagRequest, opts ...grpc.CallOption) (*DeleteFeatureFlagResponse, error)
+	ListFeatureFlags(ctx context.Context, in *ListFeatureFlagsRequest, opts ...grpc.CallOption) (*ListFeatureFlagsResponse, error)
+	SetTenantPlacement(ctx context.Context, in *SetTenantPlacementRequest, opts ...grpc.CallOption) (*SetTenantPlacementResponse, error)
+	GetTenantPlacement(ctx context.Context, in *GetTenantPlacementRequest, opts ...grpc.CallOption) (*GetTenantPlacementResponse, error)
+	CheckTenantPlacement(ctx context.Context, in *CheckTenantPlacementRequest, opts ...grpc.CallOption) (*CheckTenantPlacementResponse, error)
+	GetCollectionPlacement(ctx context.Context, in *GetCollectionPlacementRequest, opts ...grpc.CallOption) (*GetCollectionPlacementResponse, error)
+	DecommissionNode(ctx context.Context, in *DecommissionNodeRequest, opts ...grpc.CallOption) (*DecommissionNodeResponse, error)
+	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
+	GetNodeLiveness(ctx context.Context, in *GetNodeLivenessRequest, opts ...grpc.CallOption) (*GetNodeLivenessResponse, error)
+	SimulateCollectionPlacement(ctx context.Context, in *SimulateCollectionPlacementRequest, opts ...grpc.CallOption) (*SimulateCollectionPlacementResponse, error)
+	ListCollectionStats(ctx context.Context, in *ListCollectionStatsRequest, opts ...grpc.CallOption) (*ListCollectionStatsResponse, error)
+	GetCollectionSizeHistory(ctx context.Context, in *GetCollectionSizeHistoryRequest, opts ...grpc.CallOption) (*GetCollectionSizeHistoryResponse, error)
+	GetTenantActivity(ctx context.Context, in *GetTenantActivityRequest, opts ...grpc.CallOption) (*GetTenantActivityResponse, error)
+	GetSoftDeleteCleanerStatus(ctx context.Context, in *GetSoftDeleteCleanerStatusRequest, opts ...grpc.CallOption) (*GetSoftDeleteCleanerStatusResponse, error)
+	RunSoftDeleteCleaner(ctx context.Context, in *RunSoftDeleteCleanerRequest, opts ...grpc.CallOption) (*RunSoftDeleteCleanerResponse, error)
+	ListCleanupDeadLetters(ctx context.Context, in *ListCleanupDeadLettersRequest, opts ...grpc.CallOption) (*ListCleanupDeadLettersResponse, error)
+	DeleteCleanupDeadLetter(ctx context.Context, in *DeleteCleanupDeadLetterRequest, opts ...grpc.CallOption) (*DeleteCleanupDeadLetterResponse, error)
+	BatchCreateTenants(ctx context.Context, in *BatchCreateTenantsRequest, opts ...grpc.CallOption) (*BatchCreateTenantsResponse, error)
+	GetCollectionMetadataDiff(ctx context.Context, in *GetCollectionMetadataDiffRequest, opts ...grpc.CallOption) (*GetCollectionMetadataDiffResponse, error)
+	CreateEmbeddingFunction(ctx context.Context, in *CreateEmbeddingFunctionRequest, opts ...grpc.CallOption) (*CreateEmbeddingFunctionResponse, error)
+	GetEmbeddingFunctions(ctx context.Context, in *GetEmbeddingFunctionsRequest, opts ...grpc.CallOption) (*GetEmbeddingFunctionsResponse, error)
+	UpdateEmbeddingFunction(ctx context.Context, in *UpdateEmbeddingFunctionRequest, opts ...grpc.CallOption) (*UpdateEmbeddingFunctionResponse, error)
+	DeleteEmbeddingFunction(ctx context.Context, in *DeleteEmbeddingFunctionRequest, opts ...grpc.CallOption) (*DeleteEmbeddingFunctionResponse, error)
+	SetCollectionSchema(ctx context.Context, in *SetCollectionSchemaRequest, opts ...grpc.CallOption) (*SetCollectionSchemaResponse, error)
+	GetCollectionSchema(ctx context.Context, in *GetCollectionSchemaRequest, opts ...grpc.CallOption) (*GetCollectionSchemaResponse, error)
+	ValidateCollectionMetadata(ctx context.Context, in *ValidateCollectionMetadataRequest, opts ...grpc.CallOption) (*ValidateCollectionMetadataResponse, error)
+	CreateCollectionTemplate(ctx context.Context, in *CreateCollectionTemplateRequest, opts ...grpc.CallOption) (*CreateCollectionTemplateResponse, error)
+	GetCollectionTemplates(ctx context.Context, in *GetCollectionTemplatesRequest, opts ...grpc.CallOption) (*GetCollectionTemplatesResponse, error)
+	DeleteCollectionTemplate(ctx context.Context, in *DeleteCollectionTemplateRequest, opts ...grpc.CallOption) (*DeleteCollectionTemplateResponse, error)
+	CreateCollectionFromTemplate(ctx context.Context, in *CreateCollectionFromTemplateRequest, opts ...grpc.CallOption) (*CreateCollectionFromTemplateResponse, error)
+	SetMaintenanceMode(ctx context.Context, in *SetMaintenanceModeRequest, opts ...grpc.CallOption) (*SetMaintenanceModeResponse, error)
+	GetMaintenanceMode(ctx context.Context, in *GetMaintenanceModeRequest, opts ...grpc.CallOption) (*GetMaintenanceModeResponse, error)
+	GetJobStatus(ctx context.Context, in *GetJobStatusRequest, opts ...grpc.CallOption) (*GetJobStatusResponse, error)
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error)
 }
 
 type sysDBClient struct {
@@ -104,6 +249,56 @@ func (c *sysDBClient) GetTenant(ctx context.Context, in *GetTenantRequest, opts
 	return out, nil
 }
 
+func (c *sysDBClient) DeleteTenant(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (*DeleteTenantResponse, error) {
+	out := new(DeleteTenantResponse)
+	err := c.cc.Invoke(ctx, SysDB_DeleteTenant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) DeleteTenantWithProgress(ctx context.Context, in *DeleteTenantRequest, opts ...grpc.CallOption) (SysDB_DeleteTenantWithProgressClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SysDB_ServiceDesc.Streams[0], SysDB_DeleteTenantWithProgress_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sysDBDeleteTenantWithProgressClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SysDB_DeleteTenantWithProgressClient interface {
+	Recv() (*DeleteTenantProgress, error)
+	grpc.ClientStream
+}
+
+type sysDBDeleteTenantWithProgressClient struct {
+	grpc.ClientStream
+}
+
+func (x *sysDBDeleteTenantWithProgressClient) Recv() (*DeleteTenantProgress, error) {
+	m := new(DeleteTenantProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sysDBClient) RequestConfirmationToken(ctx context.Context, in *RequestConfirmationTokenRequest, opts ...grpc.CallOption) (*RequestConfirmationTokenResponse, error) {
+	out := new(RequestConfirmationTokenResponse)
+	err := c.cc.Invoke(ctx, SysDB_RequestConfirmationToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sysDBClient) CreateSegment(ctx context.Context, in *CreateSegmentRequest, opts ...grpc.CallOption) (*CreateSegmentResponse, error) {
 	out := new(CreateSegmentResponse)
 	err := c.cc.Invoke(ctx, SysDB_CreateSegment_FullMethodName, in, out, opts...)
@@ -176,6 +371,15 @@ func (c *sysDBClient) UpdateCollection(ctx context.Context, in *UpdateCollection
 	return out, nil
 }
 
+func (c *sysDBClient) RenameCollection(ctx context.Context, in *RenameCollectionRequest, opts ...grpc.CallOption) (*RenameCollectionResponse, error) {
+	out := new(RenameCollectionResponse)
+	err := c.cc.Invoke(ctx, SysDB_RenameCollection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sysDBClient) ResetState(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ResetStateResponse, error) {
 	out := new(ResetStateResponse)
 	err := c.cc.Invoke(ctx, SysDB_ResetState_FullMethodName, in, out, opts...)
@@ -212,378 +416,2601 @@ func (c *sysDBClient) FlushCollectionCompaction(ctx context.Context, in *FlushCo
 	return out, nil
 }
 
-// SysDBServer is the server API for SysDB service.
-// All implementations must embed UnimplementedSysDBServer
-// for forward compatibility
-type SysDBServer interface {
-	CreateDatabase(context.Context, *CreateDatabaseRequest) (*CreateDatabaseResponse, error)
-	GetDatabase(context.Context, *GetDatabaseRequest) (*GetDatabaseResponse, error)
-	CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error)
-	GetTenant(context.Context, *GetTenantRequest) (*GetTenantResponse, error)
-	CreateSegment(context.Context, *CreateSegmentRequest) (*CreateSegmentResponse, error)
-	DeleteSegment(context.Context, *DeleteSegmentRequest) (*DeleteSegmentResponse, error)
-	GetSegments(context.Context, *GetSegmentsRequest) (*GetSegmentsResponse, error)
-	UpdateSegment(context.Context, *UpdateSegmentRequest) (*UpdateSegmentResponse, error)
-	CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error)
-	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error)
-	GetCollections(context.Context, *GetCollectionsRequest) (*GetCollectionsResponse, error)
-	UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error)
-	ResetState(context.Context, *emptypb.Empty) (*ResetStateResponse, error)
-	GetLastCompactionTimeForTenant(context.Context, *GetLastCompactionTimeForTenantRequest) (*GetLastCompactionTimeForTenantResponse, error)
-	SetLastCompactionTimeForTenant(context.Context, *SetLastCompactionTimeForTenantRequest) (*emptypb.Empty, error)
-	FlushCollectionCompaction(context.Context, *FlushCollectionCompactionRequest) (*FlushCollectionCompactionResponse, error)
-	mustEmbedUnimplementedSysDBServer()
+func (c *sysDBClient) AcquireCollectionFencingToken(ctx context.Context, in *AcquireCollectionFencingTokenRequest, opts ...grpc.CallOption) (*AcquireCollectionFencingTokenResponse, error) {
+	out := new(AcquireCollectionFencingTokenResponse)
+	err := c.cc.Invoke(ctx, SysDB_AcquireCollectionFencingToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedSysDBServer must be embedded to have forward compatible implementations.
-type UnimplementedSysDBServer struct {
+func (c *sysDBClient) GetCollectionsBySize(ctx context.Context, in *GetCollectionsBySizeRequest, opts ...grpc.CallOption) (*GetCollectionsBySizeResponse, error) {
+	out := new(GetCollectionsBySizeResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetCollectionsBySize_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedSysDBServer) CreateDatabase(context.Context, *CreateDatabaseRequest) (*CreateDatabaseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabase not implemented")
+func (c *sysDBClient) GetApproximateCounts(ctx context.Context, in *GetApproximateCountsRequest, opts ...grpc.CallOption) (*GetApproximateCountsResponse, error) {
+	out := new(GetApproximateCountsResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetApproximateCounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSysDBServer) GetDatabase(context.Context, *GetDatabaseRequest) (*GetDatabaseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDatabase not implemented")
+
+func (c *sysDBClient) CountCollections(ctx context.Context, in *CountCollectionsRequest, opts ...grpc.CallOption) (*CountCollectionsResponse, error) {
+	out := new(CountCollectionsResponse)
+	err := c.cc.Invoke(ctx, SysDB_CountCollections_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSysDBServer) CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTenant not implemented")
+
+func (c *sysDBClient) ScanCollections(ctx context.Context, in *ScanCollectionsRequest, opts ...grpc.CallOption) (SysDB_ScanCollectionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SysDB_ServiceDesc.Streams[1], SysDB_ScanCollections_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sysDBScanCollectionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedSysDBServer) GetTenant(context.Context, *GetTenantRequest) (*GetTenantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTenant not implemented")
+
+type SysDB_ScanCollectionsClient interface {
+	Recv() (*ScanCollectionsResponse, error)
+	grpc.ClientStream
 }
-func (UnimplementedSysDBServer) CreateSegment(context.Context, *CreateSegmentRequest) (*CreateSegmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateSegment not implemented")
+
+type sysDBScanCollectionsClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedSysDBServer) DeleteSegment(context.Context, *DeleteSegmentRequest) (*DeleteSegmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteSegment not implemented")
+
+func (x *sysDBScanCollectionsClient) Recv() (*ScanCollectionsResponse, error) {
+	m := new(ScanCollectionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedSysDBServer) GetSegments(context.Context, *GetSegmentsRequest) (*GetSegmentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSegments not implemented")
+
+func (c *sysDBClient) ListIncompleteCollections(ctx context.Context, in *ListIncompleteCollectionsRequest, opts ...grpc.CallOption) (*ListIncompleteCollectionsResponse, error) {
+	out := new(ListIncompleteCollectionsResponse)
+	err := c.cc.Invoke(ctx, SysDB_ListIncompleteCollections_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSysDBServer) UpdateSegment(context.Context, *UpdateSegmentRequest) (*UpdateSegmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSegment not implemented")
+
+func (c *sysDBClient) RepairIncompleteCollection(ctx context.Context, in *RepairIncompleteCollectionRequest, opts ...grpc.CallOption) (*RepairIncompleteCollectionResponse, error) {
+	out := new(RepairIncompleteCollectionResponse)
+	err := c.cc.Invoke(ctx, SysDB_RepairIncompleteCollection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSysDBServer) CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCollection not implemented")
+
+func (c *sysDBClient) CompleteCollectionReindex(ctx context.Context, in *CompleteCollectionReindexRequest, opts ...grpc.CallOption) (*CompleteCollectionReindexResponse, error) {
+	out := new(CompleteCollectionReindexResponse)
+	err := c.cc.Invoke(ctx, SysDB_CompleteCollectionReindex_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSysDBServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollection not implemented")
+
+func (c *sysDBClient) GetQuerySamples(ctx context.Context, in *GetQuerySamplesRequest, opts ...grpc.CallOption) (*GetQuerySamplesResponse, error) {
+	out := new(GetQuerySamplesResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetQuerySamples_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSysDBServer) GetCollections(context.Context, *GetCollectionsRequest) (*GetCollectionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCollections not implemented")
+
+func (c *sysDBClient) GetCollectionsToGc(ctx context.Context, in *GetCollectionsToGcRequest, opts ...grpc.CallOption) (*GetCollectionsToGcResponse, error) {
+	out := new(GetCollectionsToGcResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetCollectionsToGc_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSysDBServer) UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollection not implemented")
+
+func (c *sysDBClient) BatchUpdateCollectionMetadata(ctx context.Context, in *BatchUpdateCollectionMetadataRequest, opts ...grpc.CallOption) (*BatchUpdateCollectionMetadataResponse, error) {
+	out := new(BatchUpdateCollectionMetadataResponse)
+	err := c.cc.Invoke(ctx, SysDB_BatchUpdateCollectionMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSysDBServer) ResetState(context.Context, *emptypb.Empty) (*ResetStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResetState not implemented")
+
+func (c *sysDBClient) DeleteCollections(ctx context.Context, in *DeleteCollectionsRequest, opts ...grpc.CallOption) (*DeleteCollectionsResponse, error) {
+	out := new(DeleteCollectionsResponse)
+	err := c.cc.Invoke(ctx, SysDB_DeleteCollections_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSysDBServer) GetLastCompactionTimeForTenant(context.Context, *GetLastCompactionTimeForTenantRequest) (*GetLastCompactionTimeForTenantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLastCompactionTimeForTenant not implemented")
+
+func (c *sysDBClient) SetCollectionAclEntry(ctx context.Context, in *SetCollectionAclEntryRequest, opts ...grpc.CallOption) (*SetCollectionAclEntryResponse, error) {
+	out := new(SetCollectionAclEntryResponse)
+	err := c.cc.Invoke(ctx, SysDB_SetCollectionAclEntry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSysDBServer) SetLastCompactionTimeForTenant(context.Context, *SetLastCompactionTimeForTenantRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetLastCompactionTimeForTenant not implemented")
+
+func (c *sysDBClient) DeleteCollectionAclEntry(ctx context.Context, in *DeleteCollectionAclEntryRequest, opts ...grpc.CallOption) (*DeleteCollectionAclEntryResponse, error) {
+	out := new(DeleteCollectionAclEntryResponse)
+	err := c.cc.Invoke(ctx, SysDB_DeleteCollectionAclEntry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSysDBServer) FlushCollectionCompaction(context.Context, *FlushCollectionCompactionRequest) (*FlushCollectionCompactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FlushCollectionCompaction not implemented")
+
+func (c *sysDBClient) GetCollectionAcl(ctx context.Context, in *GetCollectionAclRequest, opts ...grpc.CallOption) (*GetCollectionAclResponse, error) {
+	out := new(GetCollectionAclResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetCollectionAcl_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) SetTenantQuota(ctx context.Context, in *SetTenantQuotaRequest, opts ...grpc.CallOption) (*SetTenantQuotaResponse, error) {
+	out := new(SetTenantQuotaResponse)
+	err := c.cc.Invoke(ctx, SysDB_SetTenantQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) GetTenantQuota(ctx context.Context, in *GetTenantQuotaRequest, opts ...grpc.CallOption) (*GetTenantQuotaResponse, error) {
+	out := new(GetTenantQuotaResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetTenantQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) CheckQuota(ctx context.Context, in *CheckQuotaRequest, opts ...grpc.CallOption) (*CheckQuotaResponse, error) {
+	out := new(CheckQuotaResponse)
+	err := c.cc.Invoke(ctx, SysDB_CheckQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) SetCollectionLogRoute(ctx context.Context, in *SetCollectionLogRouteRequest, opts ...grpc.CallOption) (*SetCollectionLogRouteResponse, error) {
+	out := new(SetCollectionLogRouteResponse)
+	err := c.cc.Invoke(ctx, SysDB_SetCollectionLogRoute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) ResolveCollectionLogRoutes(ctx context.Context, in *ResolveCollectionLogRoutesRequest, opts ...grpc.CallOption) (*ResolveCollectionLogRoutesResponse, error) {
+	out := new(ResolveCollectionLogRoutesResponse)
+	err := c.cc.Invoke(ctx, SysDB_ResolveCollectionLogRoutes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) CheckCollections(ctx context.Context, in *CheckCollectionsRequest, opts ...grpc.CallOption) (*CheckCollectionsResponse, error) {
+	out := new(CheckCollectionsResponse)
+	err := c.cc.Invoke(ctx, SysDB_CheckCollections_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) RequestCompaction(ctx context.Context, in *RequestCompactionRequest, opts ...grpc.CallOption) (*RequestCompactionResponse, error) {
+	out := new(RequestCompactionResponse)
+	err := c.cc.Invoke(ctx, SysDB_RequestCompaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) GetCompactionRequests(ctx context.Context, in *GetCompactionRequestsRequest, opts ...grpc.CallOption) (*GetCompactionRequestsResponse, error) {
+	out := new(GetCompactionRequestsResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetCompactionRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) SetDatabaseQuota(ctx context.Context, in *SetDatabaseQuotaRequest, opts ...grpc.CallOption) (*SetDatabaseQuotaResponse, error) {
+	out := new(SetDatabaseQuotaResponse)
+	err := c.cc.Invoke(ctx, SysDB_SetDatabaseQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) GetEffectiveQuota(ctx context.Context, in *GetEffectiveQuotaRequest, opts ...grpc.CallOption) (*GetEffectiveQuotaResponse, error) {
+	out := new(GetEffectiveQuotaResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetEffectiveQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) RestoreCollection(ctx context.Context, in *RestoreCollectionRequest, opts ...grpc.CallOption) (*RestoreCollectionResponse, error) {
+	out := new(RestoreCollectionResponse)
+	err := c.cc.Invoke(ctx, SysDB_RestoreCollection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) ListInconsistentCollections(ctx context.Context, in *ListInconsistentCollectionsRequest, opts ...grpc.CallOption) (*ListInconsistentCollectionsResponse, error) {
+	out := new(ListInconsistentCollectionsResponse)
+	err := c.cc.Invoke(ctx, SysDB_ListInconsistentCollections_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) CheckConsistencyWithProgress(ctx context.Context, in *ListInconsistentCollectionsRequest, opts ...grpc.CallOption) (SysDB_CheckConsistencyWithProgressClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SysDB_ServiceDesc.Streams[2], SysDB_CheckConsistencyWithProgress_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sysDBCheckConsistencyWithProgressClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SysDB_CheckConsistencyWithProgressClient interface {
+	Recv() (*CheckConsistencyProgress, error)
+	grpc.ClientStream
+}
+
+type sysDBCheckConsistencyWithProgressClient struct {
+	grpc.ClientStream
+}
+
+func (x *sysDBCheckConsistencyWithProgressClient) Recv() (*CheckConsistencyProgress, error) {
+	m := new(CheckConsistencyProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sysDBClient) ListDuplicateSegmentFiles(ctx context.Context, in *ListDuplicateSegmentFilesRequest, opts ...grpc.CallOption) (*ListDuplicateSegmentFilesResponse, error) {
+	out := new(ListDuplicateSegmentFilesResponse)
+	err := c.cc.Invoke(ctx, SysDB_ListDuplicateSegmentFiles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) GetCollectionSegmentFiles(ctx context.Context, in *GetCollectionSegmentFilesRequest, opts ...grpc.CallOption) (*GetCollectionSegmentFilesResponse, error) {
+	out := new(GetCollectionSegmentFilesResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetCollectionSegmentFiles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) GetStorageAttribution(ctx context.Context, in *GetStorageAttributionRequest, opts ...grpc.CallOption) (*GetStorageAttributionResponse, error) {
+	out := new(GetStorageAttributionResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetStorageAttribution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) SetFeatureFlag(ctx context.Context, in *SetFeatureFlagRequest, opts ...grpc.CallOption) (*SetFeatureFlagResponse, error) {
+	out := new(SetFeatureFlagResponse)
+	err := c.cc.Invoke(ctx, SysDB_SetFeatureFlag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) DeleteFeatureFlag(ctx context.Context, in *DeleteFeatureFlagRequest, opts ...grpc.CallOption) (*DeleteFeatureFlagResponse, error) {
+	out := new(DeleteFeatureFlagResponse)
+	err := c.cc.Invoke(ctx, SysDB_DeleteFeatureFlag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) ListFeatureFlags(ctx context.Context, in *ListFeatureFlagsRequest, opts ...grpc.CallOption) (*ListFeatureFlagsResponse, error) {
+	out := new(ListFeatureFlagsResponse)
+	err := c.cc.Invoke(ctx, SysDB_ListFeatureFlags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) SetTenantPlacement(ctx context.Context, in *SetTenantPlacementRequest, opts ...grpc.CallOption) (*SetTenantPlacementResponse, error) {
+	out := new(SetTenantPlacementResponse)
+	err := c.cc.Invoke(ctx, SysDB_SetTenantPlacement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) GetTenantPlacement(ctx context.Context, in *GetTenantPlacementRequest, opts ...grpc.CallOption) (*GetTenantPlacementResponse, error) {
+	out := new(GetTenantPlacementResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetTenantPlacement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) CheckTenantPlacement(ctx context.Context, in *CheckTenantPlacementRequest, opts ...grpc.CallOption) (*CheckTenantPlacementResponse, error) {
+	out := new(CheckTenantPlacementResponse)
+	err := c.cc.Invoke(ctx, SysDB_CheckTenantPlacement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) GetCollectionPlacement(ctx context.Context, in *GetCollectionPlacementRequest, opts ...grpc.CallOption) (*GetCollectionPlacementResponse, error) {
+	out := new(GetCollectionPlacementResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetCollectionPlacement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) DecommissionNode(ctx context.Context, in *DecommissionNodeRequest, opts ...grpc.CallOption) (*DecommissionNodeResponse, error) {
+	out := new(DecommissionNodeResponse)
+	err := c.cc.Invoke(ctx, SysDB_DecommissionNode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error) {
+	out := new(HeartbeatResponse)
+	err := c.cc.Invoke(ctx, SysDB_Heartbeat_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) GetNodeLiveness(ctx context.Context, in *GetNodeLivenessRequest, opts ...grpc.CallOption) (*GetNodeLivenessResponse, error) {
+	out := new(GetNodeLivenessResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetNodeLiveness_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) SimulateCollectionPlacement(ctx context.Context, in *SimulateCollectionPlacementRequest, opts ...grpc.CallOption) (*SimulateCollectionPlacementResponse, error) {
+	out := new(SimulateCollectionPlacementResponse)
+	err := c.cc.Invoke(ctx, SysDB_SimulateCollectionPlacement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) ListCollectionStats(ctx context.Context, in *ListCollectionStatsRequest, opts ...grpc.CallOption) (*ListCollectionStatsResponse, error) {
+	out := new(ListCollectionStatsResponse)
+	err := c.cc.Invoke(ctx, SysDB_ListCollectionStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) GetCollectionSizeHistory(ctx context.Context, in *GetCollectionSizeHistoryRequest, opts ...grpc.CallOption) (*GetCollectionSizeHistoryResponse, error) {
+	out := new(GetCollectionSizeHistoryResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetCollectionSizeHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) GetTenantActivity(ctx context.Context, in *GetTenantActivityRequest, opts ...grpc.CallOption) (*GetTenantActivityResponse, error) {
+	out := new(GetTenantActivityResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetTenantActivity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) GetSoftDeleteCleanerStatus(ctx context.Context, in *GetSoftDeleteCleanerStatusRequest, opts ...grpc.CallOption) (*GetSoftDeleteCleanerStatusResponse, error) {
+	out := new(GetSoftDeleteCleanerStatusResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetSoftDeleteCleanerStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) RunSoftDeleteCleaner(ctx context.Context, in *RunSoftDeleteCleanerRequest, opts ...grpc.CallOption) (*RunSoftDeleteCleanerResponse, error) {
+	out := new(RunSoftDeleteCleanerResponse)
+	err := c.cc.Invoke(ctx, SysDB_RunSoftDeleteCleaner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) ListCleanupDeadLetters(ctx context.Context, in *ListCleanupDeadLettersRequest, opts ...grpc.CallOption) (*ListCleanupDeadLettersResponse, error) {
+	out := new(ListCleanupDeadLettersResponse)
+	err := c.cc.Invoke(ctx, SysDB_ListCleanupDeadLetters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) DeleteCleanupDeadLetter(ctx context.Context, in *DeleteCleanupDeadLetterRequest, opts ...grpc.CallOption) (*DeleteCleanupDeadLetterResponse, error) {
+	out := new(DeleteCleanupDeadLetterResponse)
+	err := c.cc.Invoke(ctx, SysDB_DeleteCleanupDeadLetter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) BatchCreateTenants(ctx context.Context, in *BatchCreateTenantsRequest, opts ...grpc.CallOption) (*BatchCreateTenantsResponse, error) {
+	out := new(BatchCreateTenantsResponse)
+	err := c.cc.Invoke(ctx, SysDB_BatchCreateTenants_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) GetCollectionMetadataDiff(ctx context.Context, in *GetCollectionMetadataDiffRequest, opts ...grpc.CallOption) (*GetCollectionMetadataDiffResponse, error) {
+	out := new(GetCollectionMetadataDiffResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetCollectionMetadataDiff_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) CreateEmbeddingFunction(ctx context.Context, in *CreateEmbeddingFunctionRequest, opts ...grpc.CallOption) (*CreateEmbeddingFunctionResponse, error) {
+	out := new(CreateEmbeddingFunctionResponse)
+	err := c.cc.Invoke(ctx, SysDB_CreateEmbeddingFunction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) GetEmbeddingFunctions(ctx context.Context, in *GetEmbeddingFunctionsRequest, opts ...grpc.CallOption) (*GetEmbeddingFunctionsResponse, error) {
+	out := new(GetEmbeddingFunctionsResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetEmbeddingFunctions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) UpdateEmbeddingFunction(ctx context.Context, in *UpdateEmbeddingFunctionRequest, opts ...grpc.CallOption) (*UpdateEmbeddingFunctionResponse, error) {
+	out := new(UpdateEmbeddingFunctionResponse)
+	err := c.cc.Invoke(ctx, SysDB_UpdateEmbeddingFunction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) DeleteEmbeddingFunction(ctx context.Context, in *DeleteEmbeddingFunctionRequest, opts ...grpc.CallOption) (*DeleteEmbeddingFunctionResponse, error) {
+	out := new(DeleteEmbeddingFunctionResponse)
+	err := c.cc.Invoke(ctx, SysDB_DeleteEmbeddingFunction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) SetCollectionSchema(ctx context.Context, in *SetCollectionSchemaRequest, opts ...grpc.CallOption) (*SetCollectionSchemaResponse, error) {
+	out := new(SetCollectionSchemaResponse)
+	err := c.cc.Invoke(ctx, SysDB_SetCollectionSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) GetCollectionSchema(ctx context.Context, in *GetCollectionSchemaRequest, opts ...grpc.CallOption) (*GetCollectionSchemaResponse, error) {
+	out := new(GetCollectionSchemaResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetCollectionSchema_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) ValidateCollectionMetadata(ctx context.Context, in *ValidateCollectionMetadataRequest, opts ...grpc.CallOption) (*ValidateCollectionMetadataResponse, error) {
+	out := new(ValidateCollectionMetadataResponse)
+	err := c.cc.Invoke(ctx, SysDB_ValidateCollectionMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) CreateCollectionTemplate(ctx context.Context, in *CreateCollectionTemplateRequest, opts ...grpc.CallOption) (*CreateCollectionTemplateResponse, error) {
+	out := new(CreateCollectionTemplateResponse)
+	err := c.cc.Invoke(ctx, SysDB_CreateCollectionTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) GetCollectionTemplates(ctx context.Context, in *GetCollectionTemplatesRequest, opts ...grpc.CallOption) (*GetCollectionTemplatesResponse, error) {
+	out := new(GetCollectionTemplatesResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetCollectionTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) DeleteCollectionTemplate(ctx context.Context, in *DeleteCollectionTemplateRequest, opts ...grpc.CallOption) (*DeleteCollectionTemplateResponse, error) {
+	out := new(DeleteCollectionTemplateResponse)
+	err := c.cc.Invoke(ctx, SysDB_DeleteCollectionTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) CreateCollectionFromTemplate(ctx context.Context, in *CreateCollectionFromTemplateRequest, opts ...grpc.CallOption) (*CreateCollectionFromTemplateResponse, error) {
+	out := new(CreateCollectionFromTemplateResponse)
+	err := c.cc.Invoke(ctx, SysDB_CreateCollectionFromTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) SetMaintenanceMode(ctx context.Context, in *SetMaintenanceModeRequest, opts ...grpc.CallOption) (*SetMaintenanceModeResponse, error) {
+	out := new(SetMaintenanceModeResponse)
+	err := c.cc.Invoke(ctx, SysDB_SetMaintenanceMode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) GetMaintenanceMode(ctx context.Context, in *GetMaintenanceModeRequest, opts ...grpc.CallOption) (*GetMaintenanceModeResponse, error) {
+	out := new(GetMaintenanceModeResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetMaintenanceMode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) GetJobStatus(ctx context.Context, in *GetJobStatusRequest, opts ...grpc.CallOption) (*GetJobStatusResponse, error) {
+	out := new(GetJobStatusResponse)
+	err := c.cc.Invoke(ctx, SysDB_GetJobStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, SysDB_ListJobs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysDBClient) CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error) {
+	out := new(CancelJobResponse)
+	err := c.cc.Invoke(ctx, SysDB_CancelJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SysDBServer is the server API for SysDB service.
+// All implementations must embed UnimplementedSysDBServer
+// for forward compatibility
+type SysDBServer interface {
+	CreateDatabase(context.Context, *CreateDatabaseRequest) (*CreateDatabaseResponse, error)
+	GetDatabase(context.Context, *GetDatabaseRequest) (*GetDatabaseResponse, error)
+	CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error)
+	GetTenant(context.Context, *GetTenantRequest) (*GetTenantResponse, error)
+	DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteTenantResponse, error)
+	// DeleteTenantWithProgress deletes a tenant within the call like
+	// DeleteTenant, streaming its progress instead of leaving the client blind
+	// until the deadline. async is not supported.
+	DeleteTenantWithProgress(*DeleteTenantRequest, SysDB_DeleteTenantWithProgressServer) error
+	RequestConfirmationToken(context.Context, *RequestConfirmationTokenRequest) (*RequestConfirmationTokenResponse, error)
+	CreateSegment(context.Context, *CreateSegmentRequest) (*CreateSegmentResponse, error)
+	DeleteSegment(context.Context, *DeleteSegmentRequest) (*DeleteSegmentResponse, error)
+	GetSegments(context.Context, *GetSegmentsRequest) (*GetSegmentsResponse, error)
+	UpdateSegment(context.Context, *UpdateSegmentRequest) (*UpdateSegmentResponse, error)
+	CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error)
+	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error)
+	GetCollections(context.Context, *GetCollectionsRequest) (*GetCollectionsResponse, error)
+	UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error)
+	RenameCollection(context.Context, *RenameCollectionRequest) (*RenameCollectionResponse, error)
+	ResetState(context.Context, *emptypb.Empty) (*ResetStateResponse, error)
+	GetLastCompactionTimeForTenant(context.Context, *GetLastCompactionTimeForTenantRequest) (*GetLastCompactionTimeForTenantResponse, error)
+	SetLastCompactionTimeForTenant(context.Context, *SetLastCompactionTimeForTenantRequest) (*emptypb.Empty, error)
+	FlushCollectionCompaction(context.Context, *FlushCollectionCompactionRequest) (*FlushCollectionCompactionResponse, error)
+	AcquireCollectionFencingToken(context.Context, *AcquireCollectionFencingTokenRequest) (*AcquireCollectionFencingTokenResponse, error)
+	GetCollectionsBySize(context.Context, *GetCollectionsBySizeRequest) (*GetCollectionsBySizeResponse, error)
+	GetApproximateCounts(context.Context, *GetApproximateCountsRequest) (*GetApproximateCountsResponse, error)
+	CountCollections(context.Context, *CountCollectionsRequest) (*CountCollectionsResponse, error)
+	ScanCollections(*ScanCollectionsRequest, SysDB_ScanCollectionsServer) error
+	ListIncompleteCollections(context.Context, *ListIncompleteCollectionsRequest) (*ListIncompleteCollectionsResponse, error)
+	RepairIncompleteCollection(context.Context, *RepairIncompleteCollectionRequest) (*RepairIncompleteCollectionResponse, error)
+	CompleteCollectionReindex(context.Context, *CompleteCollectionReindexRequest) (*CompleteCollectionReindexResponse, error)
+	GetQuerySamples(context.Context, *GetQuerySamplesRequest) (*GetQuerySamplesResponse, error)
+	GetCollectionsToGc(context.Context, *GetCollectionsToGcRequest) (*GetCollectionsToGcResponse, error)
+	BatchUpdateCollectionMetadata(context.Context, *BatchUpdateCollectionMetadataRequest) (*BatchUpdateCollectionMetadataResponse, error)
+	DeleteCollections(context.Context, *DeleteCollectionsRequest) (*DeleteCollectionsResponse, error)
+	SetCollectionAclEntry(context.Context, *SetCollectionAclEntryRequest) (*SetCollectionAclEntryResponse, error)
+	DeleteCollectionAclEntry(context.Context, *DeleteCollectionAclEntryRequest) (*DeleteCollectionAclEntryResponse, error)
+	GetCollectionAcl(context.Context, *GetCollectionAclRequest) (*GetCollectionAclResponse, error)
+	SetTenantQuota(context.Context, *SetTenantQuotaRequest) (*SetTenantQuotaResponse, error)
+	GetTenantQuota(context.Context, *GetTenantQuotaRequest) (*GetTenantQuotaResponse, error)
+	CheckQuota(context.Context, *CheckQuotaRequest) (*CheckQuotaResponse, error)
+	SetCollectionLogRoute(context.Context, *SetCollectionLogRouteRequest) (*SetCollectionLogRouteResponse, error)
+	ResolveCollectionLogRoutes(context.Context, *ResolveCollectionLogRoutesRequest) (*ResolveCollectionLogRoutesResponse, error)
+	CheckCollections(context.Context, *CheckCollectionsRequest) (*CheckCollectionsResponse, error)
+	RequestCompaction(context.Context, *RequestCompactionRequest) (*RequestCompactionResponse, error)
+	GetCompactionRequests(context.Context, *GetCompactionRequestsRequest) (*GetCompactionRequestsResponse, error)
+	SetDatabaseQuota(context.Context, *SetDatabaseQuotaRequest) (*SetDatabaseQuotaResponse, error)
+	GetEffectiveQuota(context.Context, *GetEffectiveQuotaRequest) (*GetEffectiveQuotaResponse, error)
+	RestoreCollection(context.Context, *RestoreCollectionRequest) (*RestoreCollectionResponse, error)
+	ListInconsistentCollections(context.Context, *ListInconsistentCollectionsRequest) (*ListInconsistentCollectionsResponse, error)
+	CheckConsistencyWithProgress(*ListInconsistentCollectionsRequest, SysDB_CheckConsistencyWithProgressServer) error
+	ListDuplicateSegmentFiles(context.Context, *ListDuplicateSegmentFilesRequest) (*ListDuplicateSegmentFilesResponse, error)
+	GetCollectionSegmentFiles(context.Context, *GetCollectionSegmentFilesRequest) (*GetCollectionSegmentFilesResponse, error)
+	GetStorageAttribution(context.Context, *GetStorageAttributionRequest) (*GetStorageAttributionResponse, error)
+	SetFeatureFlag(context.Context, *SetFeatureFlagRequest) (*SetFeatureFlagResponse, error)
+	DeleteFeatureFlag(context.Context, *DeleteFeatureFlagRequest) (*DeleteFeatureFlagResponse, error)
+	ListFeatureFlags(context.Context, *ListFeatureFlagsRequest) (*ListFeatureFlagsResponse, error)
+	SetTenantPlacement(context.Context, *SetTenantPlacementRequest) (*SetTenantPlacementResponse, error)
+	GetTenantPlacement(context.Context, *GetTenantPlacementRequest) (*GetTenantPlacementResponse, error)
+	CheckTenantPlacement(context.Context, *CheckTenantPlacementRequest) (*CheckTenantPlacementResponse, error)
+	GetCollectionPlacement(context.Context, *GetCollectionPlacementRequest) (*GetCollectionPlacementResponse, error)
+	DecommissionNode(context.Context, *DecommissionNodeRequest) (*DecommissionNodeResponse, error)
+	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
+	GetNodeLiveness(context.Context, *GetNodeLivenessRequest) (*GetNodeLivenessResponse, error)
+	SimulateCollectionPlacement(context.Context, *SimulateCollectionPlacementRequest) (*SimulateCollectionPlacementResponse, error)
+	ListCollectionStats(context.Context, *ListCollectionStatsRequest) (*ListCollectionStatsResponse, error)
+	GetCollectionSizeHistory(context.Context, *GetCollectionSizeHistoryRequest) (*GetCollectionSizeHistoryResponse, error)
+	GetTenantActivity(context.Context, *GetTenantActivityRequest) (*GetTenantActivityResponse, error)
+	GetSoftDeleteCleanerStatus(context.Context, *GetSoftDeleteCleanerStatusRequest) (*GetSoftDeleteCleanerStatusResponse, error)
+	RunSoftDeleteCleaner(context.Context, *RunSoftDeleteCleanerRequest) (*RunSoftDeleteCleanerResponse, error)
+	ListCleanupDeadLetters(context.Context, *ListCleanupDeadLettersRequest) (*ListCleanupDeadLettersResponse, error)
+	DeleteCleanupDeadLetter(context.Context, *DeleteCleanupDeadLetterRequest) (*DeleteCleanupDeadLetterResponse, error)
+	BatchCreateTenants(context.Context, *BatchCreateTenantsRequest) (*BatchCreateTenantsResponse, error)
+	GetCollectionMetadataDiff(context.Context, *GetCollectionMetadataDiffRequest) (*GetCollectionMetadataDiffResponse, error)
+	CreateEmbeddingFunction(context.Context, *CreateEmbeddingFunctionRequest) (*CreateEmbeddingFunctionResponse, error)
+	GetEmbeddingFunctions(context.Context, *GetEmbeddingFunctionsRequest) (*GetEmbeddingFunctionsResponse, error)
+	UpdateEmbeddingFunction(context.Context, *UpdateEmbeddingFunctionRequest) (*UpdateEmbeddingFunctionResponse, error)
+	DeleteEmbeddingFunction(context.Context, *DeleteEmbeddingFunctionRequest) (*DeleteEmbeddingFunctionResponse, error)
+	SetCollectionSchema(context.Context, *SetCollectionSchemaRequest) (*SetCollectionSchemaResponse, error)
+	GetCollectionSchema(context.Context, *GetCollectionSchemaRequest) (*GetCollectionSchemaResponse, error)
+	ValidateCollectionMetadata(context.Context, *ValidateCollectionMetadataRequest) (*ValidateCollectionMetadataResponse, error)
+	CreateCollectionTemplate(context.Context, *CreateCollectionTemplateRequest) (*CreateCollectionTemplateResponse, error)
+	GetCollectionTemplates(context.Context, *GetCollectionTemplatesRequest) (*GetCollectionTemplatesResponse, error)
+	DeleteCollectionTemplate(context.Context, *DeleteCollectionTemplateRequest) (*DeleteCollectionTemplateResponse, error)
+	CreateCollectionFromTemplate(context.Context, *CreateCollectionFromTemplateRequest) (*CreateCollectionFromTemplateResponse, error)
+	SetMaintenanceMode(context.Context, *SetMaintenanceModeRequest) (*SetMaintenanceModeResponse, error)
+	GetMaintenanceMode(context.Context, *GetMaintenanceModeRequest) (*GetMaintenanceModeResponse, error)
+	GetJobStatus(context.Context, *GetJobStatusRequest) (*GetJobStatusResponse, error)
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
+	CancelJob(context.Context, *CancelJobRequest) (*CancelJobResponse, error)
+	mustEmbedUnimplementedSysDBServer()
+}
+
+// UnimplementedSysDBServer must be embedded to have forward compatible implementations.
+type UnimplementedSysDBServer struct {
+}
+
+func (UnimplementedSysDBServer) CreateDatabase(context.Context, *CreateDatabaseRequest) (*CreateDatabaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabase not implemented")
+}
+func (UnimplementedSysDBServer) GetDatabase(context.Context, *GetDatabaseRequest) (*GetDatabaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDatabase not implemented")
+}
+func (UnimplementedSysDBServer) CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTenant not implemented")
+}
+func (UnimplementedSysDBServer) GetTenant(context.Context, *GetTenantRequest) (*GetTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenant not implemented")
+}
+func (UnimplementedSysDBServer) DeleteTenant(context.Context, *DeleteTenantRequest) (*DeleteTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTenant not implemented")
+}
+func (UnimplementedSysDBServer) DeleteTenantWithProgress(*DeleteTenantRequest, SysDB_DeleteTenantWithProgressServer) error {
+	return status.Errorf(codes.Unimplemented, "method DeleteTenantWithProgress not implemented")
+}
+func (UnimplementedSysDBServer) RequestConfirmationToken(context.Context, *RequestConfirmationTokenRequest) (*RequestConfirmationTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestConfirmationToken not implemented")
+}
+func (UnimplementedSysDBServer) CreateSegment(context.Context, *CreateSegmentRequest) (*CreateSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSegment not implemented")
+}
+func (UnimplementedSysDBServer) DeleteSegment(context.Context, *DeleteSegmentRequest) (*DeleteSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSegment not implemented")
+}
+func (UnimplementedSysDBServer) GetSegments(context.Context, *GetSegmentsRequest) (*GetSegmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegments not implemented")
+}
+func (UnimplementedSysDBServer) UpdateSegment(context.Context, *UpdateSegmentRequest) (*UpdateSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSegment not implemented")
+}
+func (UnimplementedSysDBServer) CreateCollection(context.Context, *CreateCollectionRequest) (*CreateCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollection not implemented")
+}
+func (UnimplementedSysDBServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollection not implemented")
+}
+func (UnimplementedSysDBServer) GetCollections(context.Context, *GetCollectionsRequest) (*GetCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollections not implemented")
+}
+func (UnimplementedSysDBServer) UpdateCollection(context.Context, *UpdateCollectionRequest) (*UpdateCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollection not implemented")
+}
+func (UnimplementedSysDBServer) RenameCollection(context.Context, *RenameCollectionRequest) (*RenameCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameCollection not implemented")
+}
+func (UnimplementedSysDBServer) ResetState(context.Context, *emptypb.Empty) (*ResetStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetState not implemented")
+}
+func (UnimplementedSysDBServer) GetLastCompactionTimeForTenant(context.Context, *GetLastCompactionTimeForTenantRequest) (*GetLastCompactionTimeForTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLastCompactionTimeForTenant not implemented")
+}
+func (UnimplementedSysDBServer) SetLastCompactionTimeForTenant(context.Context, *SetLastCompactionTimeForTenantRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLastCompactionTimeForTenant not implemented")
+}
+func (UnimplementedSysDBServer) FlushCollectionCompaction(context.Context, *FlushCollectionCompactionRequest) (*FlushCollectionCompactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushCollectionCompaction not implemented")
+}
+func (UnimplementedSysDBServer) AcquireCollectionFencingToken(context.Context, *AcquireCollectionFencingTokenRequest) (*AcquireCollectionFencingTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcquireCollectionFencingToken not implemented")
+}
+func (UnimplementedSysDBServer) GetCollectionsBySize(context.Context, *GetCollectionsBySizeRequest) (*GetCollectionsBySizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionsBySize not implemented")
+}
+func (UnimplementedSysDBServer) GetApproximateCounts(context.Context, *GetApproximateCountsRequest) (*GetApproximateCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApproximateCounts not implemented")
+}
+func (UnimplementedSysDBServer) CountCollections(context.Context, *CountCollectionsRequest) (*CountCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountCollections not implemented")
+}
+func (UnimplementedSysDBServer) ScanCollections(*ScanCollectionsRequest, SysDB_ScanCollectionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ScanCollections not implemented")
+}
+func (UnimplementedSysDBServer) ListIncompleteCollections(context.Context, *ListIncompleteCollectionsRequest) (*ListIncompleteCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIncompleteCollections not implemented")
+}
+func (UnimplementedSysDBServer) RepairIncompleteCollection(context.Context, *RepairIncompleteCollectionRequest) (*RepairIncompleteCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepairIncompleteCollection not implemented")
+}
+func (UnimplementedSysDBServer) CompleteCollectionReindex(context.Context, *CompleteCollectionReindexRequest) (*CompleteCollectionReindexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteCollectionReindex not implemented")
+}
+func (UnimplementedSysDBServer) GetQuerySamples(context.Context, *GetQuerySamplesRequest) (*GetQuerySamplesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuerySamples not implemented")
+}
+func (UnimplementedSysDBServer) GetCollectionsToGc(context.Context, *GetCollectionsToGcRequest) (*GetCollectionsToGcResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionsToGc not implemented")
+}
+func (UnimplementedSysDBServer) BatchUpdateCollectionMetadata(context.Context, *BatchUpdateCollectionMetadataRequest) (*BatchUpdateCollectionMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateCollectionMetadata not implemented")
+}
+func (UnimplementedSysDBServer) DeleteCollections(context.Context, *DeleteCollectionsRequest) (*DeleteCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollections not implemented")
+}
+func (UnimplementedSysDBServer) SetCollectionAclEntry(context.Context, *SetCollectionAclEntryRequest) (*SetCollectionAclEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollectionAclEntry not implemented")
+}
+func (UnimplementedSysDBServer) DeleteCollectionAclEntry(context.Context, *DeleteCollectionAclEntryRequest) (*DeleteCollectionAclEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollectionAclEntry not implemented")
+}
+func (UnimplementedSysDBServer) GetCollectionAcl(context.Context, *GetCollectionAclRequest) (*GetCollectionAclResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionAcl not implemented")
+}
+func (UnimplementedSysDBServer) SetTenantQuota(context.Context, *SetTenantQuotaRequest) (*SetTenantQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTenantQuota not implemented")
+}
+func (UnimplementedSysDBServer) GetTenantQuota(context.Context, *GetTenantQuotaRequest) (*GetTenantQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantQuota not implemented")
+}
+func (UnimplementedSysDBServer) CheckQuota(context.Context, *CheckQuotaRequest) (*CheckQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckQuota not implemented")
+}
+func (UnimplementedSysDBServer) SetCollectionLogRoute(context.Context, *SetCollectionLogRouteRequest) (*SetCollectionLogRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollectionLogRoute not implemented")
+}
+func (UnimplementedSysDBServer) ResolveCollectionLogRoutes(context.Context, *ResolveCollectionLogRoutesRequest) (*ResolveCollectionLogRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveCollectionLogRoutes not implemented")
+}
+func (UnimplementedSysDBServer) CheckCollections(context.Context, *CheckCollectionsRequest) (*CheckCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckCollections not implemented")
+}
+func (UnimplementedSysDBServer) RequestCompaction(context.Context, *RequestCompactionRequest) (*RequestCompactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestCompaction not implemented")
+}
+func (UnimplementedSysDBServer) GetCompactionRequests(context.Context, *GetCompactionRequestsRequest) (*GetCompactionRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionRequests not implemented")
+}
+func (UnimplementedSysDBServer) SetDatabaseQuota(context.Context, *SetDatabaseQuotaRequest) (*SetDatabaseQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDatabaseQuota not implemented")
+}
+func (UnimplementedSysDBServer) GetEffectiveQuota(context.Context, *GetEffectiveQuotaRequest) (*GetEffectiveQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEffectiveQuota not implemented")
+}
+func (UnimplementedSysDBServer) RestoreCollection(context.Context, *RestoreCollectionRequest) (*RestoreCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCollection not implemented")
+}
+func (UnimplementedSysDBServer) ListInconsistentCollections(context.Context, *ListInconsistentCollectionsRequest) (*ListInconsistentCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInconsistentCollections not implemented")
+}
+func (UnimplementedSysDBServer) CheckConsistencyWithProgress(*ListInconsistentCollectionsRequest, SysDB_CheckConsistencyWithProgressServer) error {
+	return status.Errorf(codes.Unimplemented, "method CheckConsistencyWithProgress not implemented")
+}
+func (UnimplementedSysDBServer) ListDuplicateSegmentFiles(context.Context, *ListDuplicateSegmentFilesRequest) (*ListDuplicateSegmentFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDuplicateSegmentFiles not implemented")
+}
+func (UnimplementedSysDBServer) GetCollectionSegmentFiles(context.Context, *GetCollectionSegmentFilesRequest) (*GetCollectionSegmentFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionSegmentFiles not implemented")
+}
+func (UnimplementedSysDBServer) GetStorageAttribution(context.Context, *GetStorageAttributionRequest) (*GetStorageAttributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageAttribution not implemented")
+}
+func (UnimplementedSysDBServer) SetFeatureFlag(context.Context, *SetFeatureFlagRequest) (*SetFeatureFlagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeatureFlag not implemented")
+}
+func (UnimplementedSysDBServer) DeleteFeatureFlag(context.Context, *DeleteFeatureFlagRequest) (*DeleteFeatureFlagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFeatureFlag not implemented")
+}
+func (UnimplementedSysDBServer) ListFeatureFlags(context.Context, *ListFeatureFlagsRequest) (*ListFeatureFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFeatureFlags not implemented")
+}
+func (UnimplementedSysDBServer) SetTenantPlacement(context.Context, *SetTenantPlacementRequest) (*SetTenantPlacementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTenantPlacement not implemented")
+}
+func (UnimplementedSysDBServer) GetTenantPlacement(context.Context, *GetTenantPlacementRequest) (*GetTenantPlacementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantPlacement not implemented")
+}
+func (UnimplementedSysDBServer) CheckTenantPlacement(context.Context, *CheckTenantPlacementRequest) (*CheckTenantPlacementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckTenantPlacement not implemented")
+}
+func (UnimplementedSysDBServer) GetCollectionPlacement(context.Context, *GetCollectionPlacementRequest) (*GetCollectionPlacementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionPlacement not implemented")
+}
+func (UnimplementedSysDBServer) DecommissionNode(context.Context, *DecommissionNodeRequest) (*DecommissionNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecommissionNode not implemented")
+}
+func (UnimplementedSysDBServer) Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedSysDBServer) GetNodeLiveness(context.Context, *GetNodeLivenessRequest) (*GetNodeLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeLiveness not implemented")
+}
+func (UnimplementedSysDBServer) SimulateCollectionPlacement(context.Context, *SimulateCollectionPlacementRequest) (*SimulateCollectionPlacementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateCollectionPlacement not implemented")
+}
+func (UnimplementedSysDBServer) ListCollectionStats(context.Context, *ListCollectionStatsRequest) (*ListCollectionStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollectionStats not implemented")
+}
+func (UnimplementedSysDBServer) GetCollectionSizeHistory(context.Context, *GetCollectionSizeHistoryRequest) (*GetCollectionSizeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionSizeHistory not implemented")
+}
+func (UnimplementedSysDBServer) GetTenantActivity(context.Context, *GetTenantActivityRequest) (*GetTenantActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantActivity not implemented")
+}
+func (UnimplementedSysDBServer) GetSoftDeleteCleanerStatus(context.Context, *GetSoftDeleteCleanerStatusRequest) (*GetSoftDeleteCleanerStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSoftDeleteCleanerStatus not implemented")
+}
+func (UnimplementedSysDBServer) RunSoftDeleteCleaner(context.Context, *RunSoftDeleteCleanerRequest) (*RunSoftDeleteCleanerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunSoftDeleteCleaner not implemented")
+}
+func (UnimplementedSysDBServer) ListCleanupDeadLetters(context.Context, *ListCleanupDeadLettersRequest) (*ListCleanupDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCleanupDeadLetters not implemented")
+}
+func (UnimplementedSysDBServer) DeleteCleanupDeadLetter(context.Context, *DeleteCleanupDeadLetterRequest) (*DeleteCleanupDeadLetterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCleanupDeadLetter not implemented")
+}
+func (UnimplementedSysDBServer) BatchCreateTenants(context.Context, *BatchCreateTenantsRequest) (*BatchCreateTenantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateTenants not implemented")
+}
+func (UnimplementedSysDBServer) GetCollectionMetadataDiff(context.Context, *GetCollectionMetadataDiffRequest) (*GetCollectionMetadataDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionMetadataDiff not implemented")
+}
+func (UnimplementedSysDBServer) CreateEmbeddingFunction(context.Context, *CreateEmbeddingFunctionRequest) (*CreateEmbeddingFunctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEmbeddingFunction not implemented")
+}
+func (UnimplementedSysDBServer) GetEmbeddingFunctions(context.Context, *GetEmbeddingFunctionsRequest) (*GetEmbeddingFunctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmbeddingFunctions not implemented")
+}
+func (UnimplementedSysDBServer) UpdateEmbeddingFunction(context.Context, *UpdateEmbeddingFunctionRequest) (*UpdateEmbeddingFunctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEmbeddingFunction not implemented")
+}
+func (UnimplementedSysDBServer) DeleteEmbeddingFunction(context.Context, *DeleteEmbeddingFunctionRequest) (*DeleteEmbeddingFunctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEmbeddingFunction not implemented")
+}
+func (UnimplementedSysDBServer) SetCollectionSchema(context.Context, *SetCollectionSchemaRequest) (*SetCollectionSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollectionSchema not implemented")
+}
+func (UnimplementedSysDBServer) GetCollectionSchema(context.Context, *GetCollectionSchemaRequest) (*GetCollectionSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionSchema not implemented")
+}
+func (UnimplementedSysDBServer) ValidateCollectionMetadata(context.Context, *ValidateCollectionMetadataRequest) (*ValidateCollectionMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateCollectionMetadata not implemented")
+}
+func (UnimplementedSysDBServer) CreateCollectionTemplate(context.Context, *CreateCollectionTemplateRequest) (*CreateCollectionTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollectionTemplate not implemented")
+}
+func (UnimplementedSysDBServer) GetCollectionTemplates(context.Context, *GetCollectionTemplatesRequest) (*GetCollectionTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionTemplates not implemented")
+}
+func (UnimplementedSysDBServer) DeleteCollectionTemplate(context.Context, *DeleteCollectionTemplateRequest) (*DeleteCollectionTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollectionTemplate not implemented")
+}
+func (UnimplementedSysDBServer) CreateCollectionFromTemplate(context.Context, *CreateCollectionFromTemplateRequest) (*CreateCollectionFromTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollectionFromTemplate not implemented")
+}
+func (UnimplementedSysDBServer) SetMaintenanceMode(context.Context, *SetMaintenanceModeRequest) (*SetMaintenanceModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaintenanceMode not implemented")
+}
+func (UnimplementedSysDBServer) GetMaintenanceMode(context.Context, *GetMaintenanceModeRequest) (*GetMaintenanceModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaintenanceMode not implemented")
+}
+func (UnimplementedSysDBServer) GetJobStatus(context.Context, *GetJobStatusRequest) (*GetJobStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobStatus not implemented")
+}
+func (UnimplementedSysDBServer) ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedSysDBServer) CancelJob(context.Context, *CancelJobRequest) (*CancelJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJob not implemented")
+}
+func (UnimplementedSysDBServer) mustEmbedUnimplementedSysDBServer() {}
+
+// UnsafeSysDBServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SysDBServer will
+// result in compilation errors.
+type UnsafeSysDBServer interface {
+	mustEmbedUnimplementedSysDBServer()
+}
+
+func RegisterSysDBServer(s grpc.ServiceRegistrar, srv SysDBServer) {
+	s.RegisterService(&SysDB_ServiceDesc, srv)
+}
+
+func _SysDB_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).CreateDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_CreateDatabase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).CreateDatabase(ctx, req.(*CreateDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetDatabase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetDatabase(ctx, req.(*GetDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_CreateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).CreateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_CreateTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).CreateTenant(ctx, req.(*CreateTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetTenant(ctx, req.(*GetTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_DeleteTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).DeleteTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_DeleteTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).DeleteTenant(ctx, req.(*DeleteTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_DeleteTenantWithProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DeleteTenantRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SysDBServer).DeleteTenantWithProgress(m, &sysDBDeleteTenantWithProgressServer{stream})
+}
+
+type SysDB_DeleteTenantWithProgressServer interface {
+	Send(*DeleteTenantProgress) error
+	grpc.ServerStream
+}
+
+type sysDBDeleteTenantWithProgressServer struct {
+	grpc.ServerStream
+}
+
+func (x *sysDBDeleteTenantWithProgressServer) Send(m *DeleteTenantProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SysDB_RequestConfirmationToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestConfirmationTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).RequestConfirmationToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_RequestConfirmationToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).RequestConfirmationToken(ctx, req.(*RequestConfirmationTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_CreateSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).CreateSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_CreateSegment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).CreateSegment(ctx, req.(*CreateSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_DeleteSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).DeleteSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_DeleteSegment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).DeleteSegment(ctx, req.(*DeleteSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetSegments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetSegments(ctx, req.(*GetSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_UpdateSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).UpdateSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_UpdateSegment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).UpdateSegment(ctx, req.(*UpdateSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_CreateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).DeleteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_DeleteCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetCollections(ctx, req.(*GetCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).UpdateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_UpdateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).UpdateCollection(ctx, req.(*UpdateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_RenameCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).RenameCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_RenameCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).RenameCollection(ctx, req.(*RenameCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_ResetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).ResetState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_ResetState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).ResetState(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetLastCompactionTimeForTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLastCompactionTimeForTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetLastCompactionTimeForTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetLastCompactionTimeForTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetLastCompactionTimeForTenant(ctx, req.(*GetLastCompactionTimeForTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_SetLastCompactionTimeForTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLastCompactionTimeForTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).SetLastCompactionTimeForTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_SetLastCompactionTimeForTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).SetLastCompactionTimeForTenant(ctx, req.(*SetLastCompactionTimeForTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_FlushCollectionCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushCollectionCompactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).FlushCollectionCompaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_FlushCollectionCompaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).FlushCollectionCompaction(ctx, req.(*FlushCollectionCompactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_AcquireCollectionFencingToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcquireCollectionFencingTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).AcquireCollectionFencingToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_AcquireCollectionFencingToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).AcquireCollectionFencingToken(ctx, req.(*AcquireCollectionFencingTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetCollectionsBySize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionsBySizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetCollectionsBySize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetCollectionsBySize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetCollectionsBySize(ctx, req.(*GetCollectionsBySizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetApproximateCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApproximateCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetApproximateCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetApproximateCounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetApproximateCounts(ctx, req.(*GetApproximateCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_CountCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).CountCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_CountCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).CountCollections(ctx, req.(*CountCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_ScanCollections_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ScanCollectionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SysDBServer).ScanCollections(m, &sysDBScanCollectionsServer{stream})
+}
+
+type SysDB_ScanCollectionsServer interface {
+	Send(*ScanCollectionsResponse) error
+	grpc.ServerStream
+}
+
+type sysDBScanCollectionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *sysDBScanCollectionsServer) Send(m *ScanCollectionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SysDB_ListIncompleteCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIncompleteCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).ListIncompleteCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_ListIncompleteCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).ListIncompleteCollections(ctx, req.(*ListIncompleteCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_RepairIncompleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepairIncompleteCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).RepairIncompleteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_RepairIncompleteCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).RepairIncompleteCollection(ctx, req.(*RepairIncompleteCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_CompleteCollectionReindex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteCollectionReindexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).CompleteCollectionReindex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_CompleteCollectionReindex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).CompleteCollectionReindex(ctx, req.(*CompleteCollectionReindexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetQuerySamples_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuerySamplesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetQuerySamples(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetQuerySamples_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetQuerySamples(ctx, req.(*GetQuerySamplesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetCollectionsToGc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionsToGcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetCollectionsToGc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetCollectionsToGc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetCollectionsToGc(ctx, req.(*GetCollectionsToGcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_BatchUpdateCollectionMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateCollectionMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).BatchUpdateCollectionMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_BatchUpdateCollectionMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).BatchUpdateCollectionMetadata(ctx, req.(*BatchUpdateCollectionMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_DeleteCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).DeleteCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_DeleteCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).DeleteCollections(ctx, req.(*DeleteCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_SetCollectionAclEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCollectionAclEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).SetCollectionAclEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_SetCollectionAclEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).SetCollectionAclEntry(ctx, req.(*SetCollectionAclEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_DeleteCollectionAclEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionAclEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).DeleteCollectionAclEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_DeleteCollectionAclEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).DeleteCollectionAclEntry(ctx, req.(*DeleteCollectionAclEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetCollectionAcl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionAclRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetCollectionAcl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetCollectionAcl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetCollectionAcl(ctx, req.(*GetCollectionAclRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_SetTenantQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTenantQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).SetTenantQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_SetTenantQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).SetTenantQuota(ctx, req.(*SetTenantQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetTenantQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetTenantQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetTenantQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetTenantQuota(ctx, req.(*GetTenantQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_CheckQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).CheckQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_CheckQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).CheckQuota(ctx, req.(*CheckQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_SetCollectionLogRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCollectionLogRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).SetCollectionLogRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_SetCollectionLogRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).SetCollectionLogRoute(ctx, req.(*SetCollectionLogRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_ResolveCollectionLogRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveCollectionLogRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).ResolveCollectionLogRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_ResolveCollectionLogRoutes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).ResolveCollectionLogRoutes(ctx, req.(*ResolveCollectionLogRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_CheckCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).CheckCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_CheckCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).CheckCollections(ctx, req.(*CheckCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_RequestCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestCompactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).RequestCompaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_RequestCompaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).RequestCompaction(ctx, req.(*RequestCompactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetCompactionRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompactionRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetCompactionRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetCompactionRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetCompactionRequests(ctx, req.(*GetCompactionRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_SetDatabaseQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDatabaseQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).SetDatabaseQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_SetDatabaseQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).SetDatabaseQuota(ctx, req.(*SetDatabaseQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetEffectiveQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEffectiveQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetEffectiveQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetEffectiveQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetEffectiveQuota(ctx, req.(*GetEffectiveQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_RestoreCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).RestoreCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_RestoreCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).RestoreCollection(ctx, req.(*RestoreCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_ListInconsistentCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInconsistentCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).ListInconsistentCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_ListInconsistentCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).ListInconsistentCollections(ctx, req.(*ListInconsistentCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_CheckConsistencyWithProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListInconsistentCollectionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SysDBServer).CheckConsistencyWithProgress(m, &sysDBCheckConsistencyWithProgressServer{stream})
+}
+
+type SysDB_CheckConsistencyWithProgressServer interface {
+	Send(*CheckConsistencyProgress) error
+	grpc.ServerStream
+}
+
+type sysDBCheckConsistencyWithProgressServer struct {
+	grpc.ServerStream
+}
+
+func (x *sysDBCheckConsistencyWithProgressServer) Send(m *CheckConsistencyProgress) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SysDB_ListDuplicateSegmentFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDuplicateSegmentFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).ListDuplicateSegmentFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_ListDuplicateSegmentFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).ListDuplicateSegmentFiles(ctx, req.(*ListDuplicateSegmentFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetCollectionSegmentFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionSegmentFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetCollectionSegmentFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetCollectionSegmentFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetCollectionSegmentFiles(ctx, req.(*GetCollectionSegmentFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetStorageAttribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorageAttributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetStorageAttribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetStorageAttribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetStorageAttribution(ctx, req.(*GetStorageAttributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_SetFeatureFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFeatureFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).SetFeatureFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_SetFeatureFlag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).SetFeatureFlag(ctx, req.(*SetFeatureFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_DeleteFeatureFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFeatureFlagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).DeleteFeatureFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_DeleteFeatureFlag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).DeleteFeatureFlag(ctx, req.(*DeleteFeatureFlagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_ListFeatureFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFeatureFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).ListFeatureFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_ListFeatureFlags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).ListFeatureFlags(ctx, req.(*ListFeatureFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_SetTenantPlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTenantPlacementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).SetTenantPlacement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_SetTenantPlacement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).SetTenantPlacement(ctx, req.(*SetTenantPlacementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetTenantPlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantPlacementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetTenantPlacement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetTenantPlacement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetTenantPlacement(ctx, req.(*GetTenantPlacementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_CheckTenantPlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckTenantPlacementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).CheckTenantPlacement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_CheckTenantPlacement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).CheckTenantPlacement(ctx, req.(*CheckTenantPlacementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetCollectionPlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionPlacementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetCollectionPlacement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetCollectionPlacement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetCollectionPlacement(ctx, req.(*GetCollectionPlacementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_DecommissionNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecommissionNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).DecommissionNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_DecommissionNode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).DecommissionNode(ctx, req.(*DecommissionNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_Heartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).Heartbeat(ctx, req.(*HeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetNodeLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetNodeLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetNodeLiveness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetNodeLiveness(ctx, req.(*GetNodeLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_SimulateCollectionPlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateCollectionPlacementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).SimulateCollectionPlacement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_SimulateCollectionPlacement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).SimulateCollectionPlacement(ctx, req.(*SimulateCollectionPlacementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_ListCollectionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).ListCollectionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_ListCollectionStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).ListCollectionStats(ctx, req.(*ListCollectionStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetCollectionSizeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionSizeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetCollectionSizeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetCollectionSizeHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetCollectionSizeHistory(ctx, req.(*GetCollectionSizeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetTenantActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetTenantActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetTenantActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetTenantActivity(ctx, req.(*GetTenantActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_GetSoftDeleteCleanerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSoftDeleteCleanerStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetSoftDeleteCleanerStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetSoftDeleteCleanerStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetSoftDeleteCleanerStatus(ctx, req.(*GetSoftDeleteCleanerStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_RunSoftDeleteCleaner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunSoftDeleteCleanerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).RunSoftDeleteCleaner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_RunSoftDeleteCleaner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).RunSoftDeleteCleaner(ctx, req.(*RunSoftDeleteCleanerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_ListCleanupDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCleanupDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).ListCleanupDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_ListCleanupDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).ListCleanupDeadLetters(ctx, req.(*ListCleanupDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysDB_DeleteCleanupDeadLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCleanupDeadLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).DeleteCleanupDeadLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_DeleteCleanupDeadLetter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).DeleteCleanupDeadLetter(ctx, req.(*DeleteCleanupDeadLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSysDBServer) mustEmbedUnimplementedSysDBServer() {}
 
-// UnsafeSysDBServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to SysDBServer will
-// result in compilation errors.
-type UnsafeSysDBServer interface {
-	mustEmbedUnimplementedSysDBServer()
+func _SysDB_BatchCreateTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateTenantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).BatchCreateTenants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_BatchCreateTenants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).BatchCreateTenants(ctx, req.(*BatchCreateTenantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterSysDBServer(s grpc.ServiceRegistrar, srv SysDBServer) {
-	s.RegisterService(&SysDB_ServiceDesc, srv)
+func _SysDB_GetCollectionMetadataDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionMetadataDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysDBServer).GetCollectionMetadataDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysDB_GetCollectionMetadataDiff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysDBServer).GetCollectionMetadataDiff(ctx, req.(*GetCollectionMetadataDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateDatabaseRequest)
+func _SysDB_CreateEmbeddingFunction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEmbeddingFunctionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).CreateDatabase(ctx, in)
+		return srv.(SysDBServer).CreateEmbeddingFunction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_CreateDatabase_FullMethodName,
+		FullMethod: SysDB_CreateEmbeddingFunction_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).CreateDatabase(ctx, req.(*CreateDatabaseRequest))
+		return srv.(SysDBServer).CreateEmbeddingFunction(ctx, req.(*CreateEmbeddingFunctionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_GetDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDatabaseRequest)
+func _SysDB_GetEmbeddingFunctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEmbeddingFunctionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).GetDatabase(ctx, in)
+		return srv.(SysDBServer).GetEmbeddingFunctions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_GetDatabase_FullMethodName,
+		FullMethod: SysDB_GetEmbeddingFunctions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).GetDatabase(ctx, req.(*GetDatabaseRequest))
+		return srv.(SysDBServer).GetEmbeddingFunctions(ctx, req.(*GetEmbeddingFunctionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_CreateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTenantRequest)
+func _SysDB_UpdateEmbeddingFunction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEmbeddingFunctionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).CreateTenant(ctx, in)
+		return srv.(SysDBServer).UpdateEmbeddingFunction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_CreateTenant_FullMethodName,
+		FullMethod: SysDB_UpdateEmbeddingFunction_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).CreateTenant(ctx, req.(*CreateTenantRequest))
+		return srv.(SysDBServer).UpdateEmbeddingFunction(ctx, req.(*UpdateEmbeddingFunctionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_GetTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTenantRequest)
+func _SysDB_DeleteEmbeddingFunction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEmbeddingFunctionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).GetTenant(ctx, in)
+		return srv.(SysDBServer).DeleteEmbeddingFunction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_GetTenant_FullMethodName,
+		FullMethod: SysDB_DeleteEmbeddingFunction_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).GetTenant(ctx, req.(*GetTenantRequest))
+		return srv.(SysDBServer).DeleteEmbeddingFunction(ctx, req.(*DeleteEmbeddingFunctionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_CreateSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSegmentRequest)
+func _SysDB_SetCollectionSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCollectionSchemaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).CreateSegment(ctx, in)
+		return srv.(SysDBServer).SetCollectionSchema(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_CreateSegment_FullMethodName,
+		FullMethod: SysDB_SetCollectionSchema_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).CreateSegment(ctx, req.(*CreateSegmentRequest))
+		return srv.(SysDBServer).SetCollectionSchema(ctx, req.(*SetCollectionSchemaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_DeleteSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteSegmentRequest)
+func _SysDB_GetCollectionSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionSchemaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).DeleteSegment(ctx, in)
+		return srv.(SysDBServer).GetCollectionSchema(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_DeleteSegment_FullMethodName,
+		FullMethod: SysDB_GetCollectionSchema_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).DeleteSegment(ctx, req.(*DeleteSegmentRequest))
+		return srv.(SysDBServer).GetCollectionSchema(ctx, req.(*GetCollectionSchemaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_GetSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSegmentsRequest)
+func _SysDB_ValidateCollectionMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateCollectionMetadataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).GetSegments(ctx, in)
+		return srv.(SysDBServer).ValidateCollectionMetadata(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_GetSegments_FullMethodName,
+		FullMethod: SysDB_ValidateCollectionMetadata_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).GetSegments(ctx, req.(*GetSegmentsRequest))
+		return srv.(SysDBServer).ValidateCollectionMetadata(ctx, req.(*ValidateCollectionMetadataRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_UpdateSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateSegmentRequest)
+func _SysDB_CreateCollectionTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).UpdateSegment(ctx, in)
+		return srv.(SysDBServer).CreateCollectionTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_UpdateSegment_FullMethodName,
+		FullMethod: SysDB_CreateCollectionTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).UpdateSegment(ctx, req.(*UpdateSegmentRequest))
+		return srv.(SysDBServer).CreateCollectionTemplate(ctx, req.(*CreateCollectionTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCollectionRequest)
+func _SysDB_GetCollectionTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionTemplatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).CreateCollection(ctx, in)
+		return srv.(SysDBServer).GetCollectionTemplates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_CreateCollection_FullMethodName,
+		FullMethod: SysDB_GetCollectionTemplates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+		return srv.(SysDBServer).GetCollectionTemplates(ctx, req.(*GetCollectionTemplatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCollectionRequest)
+func _SysDB_DeleteCollectionTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).DeleteCollection(ctx, in)
+		return srv.(SysDBServer).DeleteCollectionTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_DeleteCollection_FullMethodName,
+		FullMethod: SysDB_DeleteCollectionTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+		return srv.(SysDBServer).DeleteCollectionTemplate(ctx, req.(*DeleteCollectionTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_GetCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCollectionsRequest)
+func _SysDB_CreateCollectionFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionFromTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).GetCollections(ctx, in)
+		return srv.(SysDBServer).CreateCollectionFromTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_GetCollections_FullMethodName,
+		FullMethod: SysDB_CreateCollectionFromTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).GetCollections(ctx, req.(*GetCollectionsRequest))
+		return srv.(SysDBServer).CreateCollectionFromTemplate(ctx, req.(*CreateCollectionFromTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCollectionRequest)
+func _SysDB_SetMaintenanceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMaintenanceModeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).UpdateCollection(ctx, in)
+		return srv.(SysDBServer).SetMaintenanceMode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_UpdateCollection_FullMethodName,
+		FullMethod: SysDB_SetMaintenanceMode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).UpdateCollection(ctx, req.(*UpdateCollectionRequest))
+		return srv.(SysDBServer).SetMaintenanceMode(ctx, req.(*SetMaintenanceModeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_ResetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _SysDB_GetMaintenanceMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaintenanceModeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).ResetState(ctx, in)
+		return srv.(SysDBServer).GetMaintenanceMode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_ResetState_FullMethodName,
+		FullMethod: SysDB_GetMaintenanceMode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).ResetState(ctx, req.(*emptypb.Empty))
+		return srv.(SysDBServer).GetMaintenanceMode(ctx, req.(*GetMaintenanceModeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_GetLastCompactionTimeForTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLastCompactionTimeForTenantRequest)
+func _SysDB_GetJobStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).GetLastCompactionTimeForTenant(ctx, in)
+		return srv.(SysDBServer).GetJobStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_GetLastCompactionTimeForTenant_FullMethodName,
+		FullMethod: SysDB_GetJobStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).GetLastCompactionTimeForTenant(ctx, req.(*GetLastCompactionTimeForTenantRequest))
+		return srv.(SysDBServer).GetJobStatus(ctx, req.(*GetJobStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_SetLastCompactionTimeForTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetLastCompactionTimeForTenantRequest)
+func _SysDB_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).SetLastCompactionTimeForTenant(ctx, in)
+		return srv.(SysDBServer).ListJobs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_SetLastCompactionTimeForTenant_FullMethodName,
+		FullMethod: SysDB_ListJobs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).SetLastCompactionTimeForTenant(ctx, req.(*SetLastCompactionTimeForTenantRequest))
+		return srv.(SysDBServer).ListJobs(ctx, req.(*ListJobsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SysDB_FlushCollectionCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FlushCollectionCompactionRequest)
+func _SysDB_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SysDBServer).FlushCollectionCompaction(ctx, in)
+		return srv.(SysDBServer).CancelJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SysDB_FlushCollectionCompaction_FullMethodName,
+		FullMethod: SysDB_CancelJob_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SysDBServer).FlushCollectionCompaction(ctx, req.(*FlushCollectionCompactionRequest))
+		return srv.(SysDBServer).CancelJob(ctx, req.(*CancelJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -611,6 +3038,14 @@ var SysDB_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTenant",
 			Handler:    _SysDB_GetTenant_Handler,
 		},
+		{
+			MethodName: "DeleteTenant",
+			Handler:    _SysDB_DeleteTenant_Handler,
+		},
+		{
+			MethodName: "RequestConfirmationToken",
+			Handler:    _SysDB_RequestConfirmationToken_Handler,
+		},
 		{
 			MethodName: "CreateSegment",
 			Handler:    _SysDB_CreateSegment_Handler,
@@ -643,6 +3078,10 @@ var SysDB_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCollection",
 			Handler:    _SysDB_UpdateCollection_Handler,
 		},
+		{
+			MethodName: "RenameCollection",
+			Handler:    _SysDB_RenameCollection_Handler,
+		},
 		{
 			MethodName: "ResetState",
 			Handler:    _SysDB_ResetState_Handler,
@@ -659,7 +3098,283 @@ var SysDB_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FlushCollectionCompaction",
 			Handler:    _SysDB_FlushCollectionCompaction_Handler,
 		},
+		{
+			MethodName: "AcquireCollectionFencingToken",
+			Handler:    _SysDB_AcquireCollectionFencingToken_Handler,
+		},
+		{
+			MethodName: "GetCollectionsBySize",
+			Handler:    _SysDB_GetCollectionsBySize_Handler,
+		},
+		{
+			MethodName: "GetApproximateCounts",
+			Handler:    _SysDB_GetApproximateCounts_Handler,
+		},
+		{
+			MethodName: "CountCollections",
+			Handler:    _SysDB_CountCollections_Handler,
+		},
+		{
+			MethodName: "ListIncompleteCollections",
+			Handler:    _SysDB_ListIncompleteCollections_Handler,
+		},
+		{
+			MethodName: "RepairIncompleteCollection",
+			Handler:    _SysDB_RepairIncompleteCollection_Handler,
+		},
+		{
+			MethodName: "CompleteCollectionReindex",
+			Handler:    _SysDB_CompleteCollectionReindex_Handler,
+		},
+		{
+			MethodName: "GetQuerySamples",
+			Handler:    _SysDB_GetQuerySamples_Handler,
+		},
+		{
+			MethodName: "GetCollectionsToGc",
+			Handler:    _SysDB_GetCollectionsToGc_Handler,
+		},
+		{
+			MethodName: "BatchUpdateCollectionMetadata",
+			Handler:    _SysDB_BatchUpdateCollectionMetadata_Handler,
+		},
+		{
+			MethodName: "DeleteCollections",
+			Handler:    _SysDB_DeleteCollections_Handler,
+		},
+		{
+			MethodName: "SetCollectionAclEntry",
+			Handler:    _SysDB_SetCollectionAclEntry_Handler,
+		},
+		{
+			MethodName: "DeleteCollectionAclEntry",
+			Handler:    _SysDB_DeleteCollectionAclEntry_Handler,
+		},
+		{
+			MethodName: "GetCollectionAcl",
+			Handler:    _SysDB_GetCollectionAcl_Handler,
+		},
+		{
+			MethodName: "SetTenantQuota",
+			Handler:    _SysDB_SetTenantQuota_Handler,
+		},
+		{
+			MethodName: "GetTenantQuota",
+			Handler:    _SysDB_GetTenantQuota_Handler,
+		},
+		{
+			MethodName: "CheckQuota",
+			Handler:    _SysDB_CheckQuota_Handler,
+		},
+		{
+			MethodName: "SetCollectionLogRoute",
+			Handler:    _SysDB_SetCollectionLogRoute_Handler,
+		},
+		{
+			MethodName: "ResolveCollectionLogRoutes",
+			Handler:    _SysDB_ResolveCollectionLogRoutes_Handler,
+		},
+		{
+			MethodName: "CheckCollections",
+			Handler:    _SysDB_CheckCollections_Handler,
+		},
+		{
+			MethodName: "RequestCompaction",
+			Handler:    _SysDB_RequestCompaction_Handler,
+		},
+		{
+			MethodName: "GetCompactionRequests",
+			Handler:    _SysDB_GetCompactionRequests_Handler,
+		},
+		{
+			MethodName: "SetDatabaseQuota",
+			Handler:    _SysDB_SetDatabaseQuota_Handler,
+		},
+		{
+			MethodName: "GetEffectiveQuota",
+			Handler:    _SysDB_GetEffectiveQuota_Handler,
+		},
+		{
+			MethodName: "RestoreCollection",
+			Handler:    _SysDB_RestoreCollection_Handler,
+		},
+		{
+			MethodName: "ListInconsistentCollections",
+			Handler:    _SysDB_ListInconsistentCollections_Handler,
+		},
+		{
+			MethodName: "ListDuplicateSegmentFiles",
+			Handler:    _SysDB_ListDuplicateSegmentFiles_Handler,
+		},
+		{
+			MethodName: "GetCollectionSegmentFiles",
+			Handler:    _SysDB_GetCollectionSegmentFiles_Handler,
+		},
+		{
+			MethodName: "GetStorageAttribution",
+			Handler:    _SysDB_GetStorageAttribution_Handler,
+		},
+		{
+			MethodName: "SetFeatureFlag",
+			Handler:    _SysDB_SetFeatureFlag_Handler,
+		},
+		{
+			MethodName: "DeleteFeatureFlag",
+			Handler:    _SysDB_DeleteFeatureFlag_Handler,
+		},
+		{
+			MethodName: "ListFeatureFlags",
+			Handler:    _SysDB_ListFeatureFlags_Handler,
+		},
+		{
+			MethodName: "SetTenantPlacement",
+			Handler:    _SysDB_SetTenantPlacement_Handler,
+		},
+		{
+			MethodName: "GetTenantPlacement",
+			Handler:    _SysDB_GetTenantPlacement_Handler,
+		},
+		{
+			MethodName: "CheckTenantPlacement",
+			Handler:    _SysDB_CheckTenantPlacement_Handler,
+		},
+		{
+			MethodName: "GetCollectionPlacement",
+			Handler:    _SysDB_GetCollectionPlacement_Handler,
+		},
+		{
+			MethodName: "DecommissionNode",
+			Handler:    _SysDB_DecommissionNode_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _SysDB_Heartbeat_Handler,
+		},
+		{
+			MethodName: "GetNodeLiveness",
+			Handler:    _SysDB_GetNodeLiveness_Handler,
+		},
+		{
+			MethodName: "SimulateCollectionPlacement",
+			Handler:    _SysDB_SimulateCollectionPlacement_Handler,
+		},
+		{
+			MethodName: "ListCollectionStats",
+			Handler:    _SysDB_ListCollectionStats_Handler,
+		},
+		{
+			MethodName: "GetCollectionSizeHistory",
+			Handler:    _SysDB_GetCollectionSizeHistory_Handler,
+		},
+		{
+			MethodName: "GetTenantActivity",
+			Handler:    _SysDB_GetTenantActivity_Handler,
+		},
+		{
+			MethodName: "GetSoftDeleteCleanerStatus",
+			Handler:    _SysDB_GetSoftDeleteCleanerStatus_Handler,
+		},
+		{
+			MethodName: "RunSoftDeleteCleaner",
+			Handler:    _SysDB_RunSoftDeleteCleaner_Handler,
+		},
+		{
+			MethodName: "ListCleanupDeadLetters",
+			Handler:    _SysDB_ListCleanupDeadLetters_Handler,
+		},
+		{
+			MethodName: "DeleteCleanupDeadLetter",
+			Handler:    _SysDB_DeleteCleanupDeadLetter_Handler,
+		},
+		{
+			MethodName: "BatchCreateTenants",
+			Handler:    _SysDB_BatchCreateTenants_Handler,
+		},
+		{
+			MethodName: "GetCollectionMetadataDiff",
+			Handler:    _SysDB_GetCollectionMetadataDiff_Handler,
+		},
+		{
+			MethodName: "CreateEmbeddingFunction",
+			Handler:    _SysDB_CreateEmbeddingFunction_Handler,
+		},
+		{
+			MethodName: "GetEmbeddingFunctions",
+			Handler:    _SysDB_GetEmbeddingFunctions_Handler,
+		},
+		{
+			MethodName: "UpdateEmbeddingFunction",
+			Handler:    _SysDB_UpdateEmbeddingFunction_Handler,
+		},
+		{
+			MethodName: "DeleteEmbeddingFunction",
+			Handler:    _SysDB_DeleteEmbeddingFunction_Handler,
+		},
+		{
+			MethodName: "SetCollectionSchema",
+			Handler:    _SysDB_SetCollectionSchema_Handler,
+		},
+		{
+			MethodName: "GetCollectionSchema",
+			Handler:    _SysDB_GetCollectionSchema_Handler,
+		},
+		{
+			MethodName: "ValidateCollectionMetadata",
+			Handler:    _SysDB_ValidateCollectionMetadata_Handler,
+		},
+		{
+			MethodName: "CreateCollectionTemplate",
+			Handler:    _SysDB_CreateCollectionTemplate_Handler,
+		},
+		{
+			MethodName: "GetCollectionTemplates",
+			Handler:    _SysDB_GetCollectionTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteCollectionTemplate",
+			Handler:    _SysDB_DeleteCollectionTemplate_Handler,
+		},
+		{
+			MethodName: "CreateCollectionFromTemplate",
+			Handler:    _SysDB_CreateCollectionFromTemplate_Handler,
+		},
+		{
+			MethodName: "SetMaintenanceMode",
+			Handler:    _SysDB_SetMaintenanceMode_Handler,
+		},
+		{
+			MethodName: "GetMaintenanceMode",
+			Handler:    _SysDB_GetMaintenanceMode_Handler,
+		},
+		{
+			MethodName: "GetJobStatus",
+			Handler:    _SysDB_GetJobStatus_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _SysDB_ListJobs_Handler,
+		},
+		{
+			MethodName: "CancelJob",
+			Handler:    _SysDB_CancelJob_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DeleteTenantWithProgress",
+			Handler:       _SysDB_DeleteTenantWithProgress_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ScanCollections",
+			Handler:       _SysDB_ScanCollections_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CheckConsistencyWithProgress",
+			Handler:       _SysDB_CheckConsistencyWithProgress_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "chromadb/proto/coordinator.proto",
 }
@@ -23,6 +23,9 @@ const (
 	LogService_PullLogs_FullMethodName                      = "/chroma.LogService/PullLogs"
 	LogService_GetAllCollectionInfoToCompact_FullMethodName = "/chroma.LogService/GetAllCollectionInfoToCompact"
 	LogService_UpdateCollectionLogOffset_FullMethodName     = "/chroma.LogService/UpdateCollectionLogOffset"
+	LogService_SetRetentionPolicy_FullMethodName            = "/chroma.LogService/SetRetentionPolicy"
+	LogService_PushLogsAtomic_FullMethodName                = "/chroma.LogService/PushLogsAtomic"
+	LogService_GetCollectionLogLag_FullMethodName           = "/chroma.LogService/GetCollectionLogLag"
 )
 
 // LogServiceClient is the client API for LogService service.
@@ -33,6 +36,9 @@ type LogServiceClient interface {
 	PullLogs(ctx context.Context, in *PullLogsRequest, opts ...grpc.CallOption) (*PullLogsResponse, error)
 	GetAllCollectionInfoToCompact(ctx context.Context, in *GetAllCollectionInfoToCompactRequest, opts ...grpc.CallOption) (*GetAllCollectionInfoToCompactResponse, error)
 	UpdateCollectionLogOffset(ctx context.Context, in *UpdateCollectionLogOffsetRequest, opts ...grpc.CallOption) (*UpdateCollectionLogOffsetResponse, error)
+	SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*SetRetentionPolicyResponse, error)
+	PushLogsAtomic(ctx context.Context, in *PushLogsAtomicRequest, opts ...grpc.CallOption) (*PushLogsAtomicResponse, error)
+	GetCollectionLogLag(ctx context.Context, in *GetCollectionLogLagRequest, opts ...grpc.CallOption) (*GetCollectionLogLagResponse, error)
 }
 
 type logServiceClient struct {
@@ -79,6 +85,33 @@ func (c *logServiceClient) UpdateCollectionLogOffset(ctx context.Context, in *Up
 	return out, nil
 }
 
+func (c *logServiceClient) SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*SetRetentionPolicyResponse, error) {
+	out := new(SetRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, LogService_SetRetentionPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logServiceClient) PushLogsAtomic(ctx context.Context, in *PushLogsAtomicRequest, opts ...grpc.CallOption) (*PushLogsAtomicResponse, error) {
+	out := new(PushLogsAtomicResponse)
+	err := c.cc.Invoke(ctx, LogService_PushLogsAtomic_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logServiceClient) GetCollectionLogLag(ctx context.Context, in *GetCollectionLogLagRequest, opts ...grpc.CallOption) (*GetCollectionLogLagResponse, error) {
+	out := new(GetCollectionLogLagResponse)
+	err := c.cc.Invoke(ctx, LogService_GetCollectionLogLag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LogServiceServer is the server API for LogService service.
 // All implementations must embed UnimplementedLogServiceServer
 // for forward compatibility
@@ -87,6 +120,9 @@ type LogServiceServer interface {
 	PullLogs(context.Context, *PullLogsRequest) (*PullLogsResponse, error)
 	GetAllCollectionInfoToCompact(context.Context, *GetAllCollectionInfoToCompactRequest) (*GetAllCollectionInfoToCompactResponse, error)
 	UpdateCollectionLogOffset(context.Context, *UpdateCollectionLogOffsetRequest) (*UpdateCollectionLogOffsetResponse, error)
+	SetRetentionPolicy(context.Context, *SetRetentionPolicyRequest) (*SetRetentionPolicyResponse, error)
+	PushLogsAtomic(context.Context, *PushLogsAtomicRequest) (*PushLogsAtomicResponse, error)
+	GetCollectionLogLag(context.Context, *GetCollectionLogLagRequest) (*GetCollectionLogLagResponse, error)
 	mustEmbedUnimplementedLogServiceServer()
 }
 
@@ -106,6 +142,15 @@ func (UnimplementedLogServiceServer) GetAllCollectionInfoToCompact(context.Conte
 func (UnimplementedLogServiceServer) UpdateCollectionLogOffset(context.Context, *UpdateCollectionLogOffsetRequest) (*UpdateCollectionLogOffsetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollectionLogOffset not implemented")
 }
+func (UnimplementedLogServiceServer) SetRetentionPolicy(context.Context, *SetRetentionPolicyRequest) (*SetRetentionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRetentionPolicy not implemented")
+}
+func (UnimplementedLogServiceServer) PushLogsAtomic(context.Context, *PushLogsAtomicRequest) (*PushLogsAtomicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushLogsAtomic not implemented")
+}
+func (UnimplementedLogServiceServer) GetCollectionLogLag(context.Context, *GetCollectionLogLagRequest) (*GetCollectionLogLagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionLogLag not implemented")
+}
 func (UnimplementedLogServiceServer) mustEmbedUnimplementedLogServiceServer() {}
 
 // UnsafeLogServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -191,6 +236,60 @@ func _LogService_UpdateCollectionLogOffset_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LogService_SetRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogServiceServer).SetRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogService_SetRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogServiceServer).SetRetentionPolicy(ctx, req.(*SetRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogService_PushLogsAtomic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushLogsAtomicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogServiceServer).PushLogsAtomic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogService_PushLogsAtomic_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogServiceServer).PushLogsAtomic(ctx, req.(*PushLogsAtomicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogService_GetCollectionLogLag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionLogLagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogServiceServer).GetCollectionLogLag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogService_GetCollectionLogLag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogServiceServer).GetCollectionLogLag(ctx, req.(*GetCollectionLogLagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LogService_ServiceDesc is the grpc.ServiceDesc for LogService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -214,6 +313,18 @@ var LogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCollectionLogOffset",
 			Handler:    _LogService_UpdateCollectionLogOffset_Handler,
 		},
+		{
+			MethodName: "SetRetentionPolicy",
+			Handler:    _LogService_SetRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "PushLogsAtomic",
+			Handler:    _LogService_PushLogsAtomic_Handler,
+		},
+		{
+			MethodName: "GetCollectionLogLag",
+			Handler:    _LogService_GetCollectionLogLag_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "chromadb/proto/logservice.proto",
@@ -0,0 +1,188 @@
+package selftest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/chroma-core/chroma/go/pkg/objectstore"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// AtlasRevisionQuery returns the version of the latest migration applied with
+// atlas migrate apply.
+const AtlasRevisionQuery = "SELECT version FROM atlas_schema_revisions.atlas_schema_revisions ORDER BY version DESC LIMIT 1"
+
+// PortBinding checks that the service can listen on addr, i.e. that the port is
+// free and the service is allowed to bind it.
+func PortBinding(addr string) Check {
+	return Check{
+		Name: "port_binding",
+		Run: func(ctx context.Context) error {
+			listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", addr)
+			if err != nil {
+				return err
+			}
+			return listener.Close()
+		},
+	}
+}
+
+// Ping checks that the database answers.
+func Ping(ping func(ctx context.Context) error) Check {
+	return Check{Name: "db_connectivity", Run: ping}
+}
+
+// latestMigration returns the version of the latest atlas migration in
+// migrations, the part of the file name before the first underscore.
+func latestMigration(migrations fs.FS) (string, error) {
+	names, err := fs.Glob(migrations, "*.sql")
+	if err != nil {
+		return "", err
+	}
+	versions := make([]string, 0, len(names))
+	for _, name := range names {
+		version, _, _ := strings.Cut(strings.TrimSuffix(name, ".sql"), "_")
+		versions = append(versions, version)
+	}
+	if len(versions) == 0 {
+		return "", nil
+	}
+	sort.Strings(versions)
+	return versions[len(versions)-1], nil
+}
+
+// SchemaVersion checks that the migrations applied to the database are at
+// least the latest of migrations, the ones the binary was built with. applied
+// returns the version of the latest applied migration.
+func SchemaVersion(applied func(ctx context.Context) (string, error), migrations fs.FS) Check {
+	return Check{
+		Name: "schema_version",
+		Run: func(ctx context.Context) error {
+			latest, err := latestMigration(migrations)
+			if err != nil {
+				return err
+			}
+			version, err := applied(ctx)
+			if err != nil {
+				return err
+			}
+			if version == "" {
+				return fmt.Errorf("no migration is applied to the database, the binary expects %s", latest)
+			}
+			// migration versions are timestamps of the same length
+			if version < latest {
+				return fmt.Errorf("database schema is at migration %s, the binary expects %s", version, latest)
+			}
+			return nil
+		},
+	}
+}
+
+// ObjectStore checks that the service can write, read and delete an object in
+// the configured store. The check is skipped when no provider is configured.
+func ObjectStore(cfg objectstore.Config) Check {
+	return Check{
+		Name: "object_store",
+		Run: func(ctx context.Context) error {
+			if cfg.Provider == "" {
+				return Skip("object store is not configured")
+			}
+			store, err := objectstore.New(ctx, cfg)
+			if err != nil {
+				return err
+			}
+			return probeObjectStore(ctx, store)
+		},
+	}
+}
+
+func probeObjectStore(ctx context.Context, store objectstore.Store) error {
+	hostname, _ := os.Hostname()
+	key := fmt.Sprintf("selftest/%s-%d", hostname, time.Now().UnixNano())
+	content := []byte("chroma selftest")
+	if err := store.Put(ctx, key, bytes.NewReader(content), int64(len(content))); err != nil {
+		return fmt.Errorf("put: %w", err)
+	}
+	body, err := store.Get(ctx, key)
+	if err != nil {
+		return fmt.Errorf("get: %w", err)
+	}
+	read, err := io.ReadAll(body)
+	body.Close()
+	if err != nil {
+		return fmt.Errorf("get: %w", err)
+	}
+	if !bytes.Equal(read, content) {
+		return fmt.Errorf("get: read %d bytes instead of %d", len(read), len(content))
+	}
+	if err := store.Delete(ctx, key); err != nil {
+		return fmt.Errorf("delete: %w", err)
+	}
+	return nil
+}
+
+// Permission is an action the service must be allowed to take on a Kubernetes
+// resource.
+type Permission struct {
+	Group    string
+	Resource string
+	Name     string
+	Verbs    []string
+}
+
+func (p Permission) String() string {
+	if p.Name == "" {
+		return p.Resource
+	}
+	return p.Resource + "/" + p.Name
+}
+
+// KubernetesPermissions checks that the service account of the service is
+// allowed every verb of the permissions in namespace.
+func KubernetesPermissions(name string, clientset func() (kubernetes.Interface, error), namespace string, permissions []Permission) Check {
+	return Check{
+		Name: name,
+		Run: func(ctx context.Context) error {
+			client, err := clientset()
+			if err != nil {
+				return err
+			}
+			var denied []string
+			for _, permission := range permissions {
+				for _, verb := range permission.Verbs {
+					review, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+						Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+							ResourceAttributes: &authorizationv1.ResourceAttributes{
+								Namespace: namespace,
+								Verb:      verb,
+								Group:     permission.Group,
+								Resource:  permission.Resource,
+								Name:      permission.Name,
+							},
+						},
+					}, metav1.CreateOptions{})
+					if err != nil {
+						return err
+					}
+					if !review.Status.Allowed {
+						denied = append(denied, verb+" "+permission.String())
+					}
+				}
+			}
+			if len(denied) > 0 {
+				return fmt.Errorf("not allowed to %s in namespace %s", strings.Join(denied, ", "), namespace)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,93 @@
+// Package selftest checks that a service can reach everything it depends on
+// before it is started, e.g. from a Helm hook or during incident triage, and
+// reports the outcome of every check.
+package selftest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+)
+
+const defaultCheckTimeout = 10 * time.Second
+
+type Status string
+
+const (
+	StatusOK      Status = "ok"
+	StatusFailed  Status = "failed"
+	StatusSkipped Status = "skipped"
+)
+
+// Check is one dependency of the service. Run returns nil when the dependency
+// is usable, and an error made with Skip when it does not apply to the
+// configuration of the service.
+type Check struct {
+	Name string
+	Run  func(ctx context.Context) error
+}
+
+type Result struct {
+	Name   string `json:"name"`
+	Status Status `json:"status"`
+	// Message is the error of a failed check or the reason a check was skipped.
+	Message    string `json:"message,omitempty"`
+	DurationMs int64  `json:"duration_ms"`
+}
+
+// Report is the outcome of the checks of a service. OK is set when no check
+// failed.
+type Report struct {
+	Service string    `json:"service"`
+	OK      bool      `json:"ok"`
+	Checks  []*Result `json:"checks"`
+}
+
+type skipError struct {
+	reason string
+}
+
+func (e *skipError) Error() string {
+	return e.reason
+}
+
+// Skip returns the error of a check that does not apply.
+func Skip(reason string) error {
+	return &skipError{reason: reason}
+}
+
+// Run runs the checks one after the other, each bounded by timeout, and
+// reports all of them even when one fails. Zero uses a timeout of 10 seconds.
+func Run(ctx context.Context, service string, timeout time.Duration, checks []Check) *Report {
+	if timeout <= 0 {
+		timeout = defaultCheckTimeout
+	}
+	report := &Report{Service: service, OK: true, Checks: make([]*Result, 0, len(checks))}
+	for _, check := range checks {
+		start := time.Now()
+		checkCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := check.Run(checkCtx)
+		cancel()
+		result := &Result{Name: check.Name, Status: StatusOK, DurationMs: time.Since(start).Milliseconds()}
+		var skip *skipError
+		if errors.As(err, &skip) {
+			result.Status = StatusSkipped
+			result.Message = skip.reason
+		} else if err != nil {
+			result.Status = StatusFailed
+			result.Message = err.Error()
+			report.OK = false
+		}
+		report.Checks = append(report.Checks, result)
+	}
+	return report
+}
+
+// Write writes the report as indented JSON.
+func (r *Report) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
@@ -0,0 +1,166 @@
+package selftest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+
+	"github.com/chroma-core/chroma/go/pkg/common"
+	"github.com/chroma-core/chroma/go/pkg/objectstore"
+	"github.com/stretchr/testify/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestRun(t *testing.T) {
+	report := Run(context.Background(), "sysdb", 0, []Check{
+		{Name: "ok", Run: func(ctx context.Context) error { return nil }},
+		{Name: "failed", Run: func(ctx context.Context) error { return errors.New("unreachable") }},
+		{Name: "skipped", Run: func(ctx context.Context) error { return Skip("not configured") }},
+	})
+	assert.False(t, report.OK)
+	assert.Len(t, report.Checks, 3)
+	assert.Equal(t, StatusOK, report.Checks[0].Status)
+	assert.Equal(t, StatusFailed, report.Checks[1].Status)
+	assert.Equal(t, "unreachable", report.Checks[1].Message)
+	assert.Equal(t, StatusSkipped, report.Checks[2].Status)
+	assert.Equal(t, "not configured", report.Checks[2].Message)
+
+	var buf bytes.Buffer
+	assert.NoError(t, report.Write(&buf))
+	var decoded Report
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, "sysdb", decoded.Service)
+	assert.Equal(t, StatusFailed, decoded.Checks[1].Status)
+
+	// skipped checks do not fail the report
+	report = Run(context.Background(), "sysdb", 0, []Check{
+		{Name: "skipped", Run: func(ctx context.Context) error { return Skip("not configured") }},
+	})
+	assert.True(t, report.OK)
+}
+
+func TestPortBinding(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	ctx := context.Background()
+
+	assert.Error(t, PortBinding(listener.Addr().String()).Run(ctx))
+	assert.NoError(t, PortBinding("127.0.0.1:0").Run(ctx))
+}
+
+func TestSchemaVersion(t *testing.T) {
+	migrations := fstest.MapFS{
+		"20240404181827_initial.sql":   {},
+		"20261015050000_producer.sql":  {},
+		"20261015040000_retention.sql": {},
+		"atlas.sum":                    {},
+	}
+	applied := func(version string) func(ctx context.Context) (string, error) {
+		return func(ctx context.Context) (string, error) { return version, nil }
+	}
+	ctx := context.Background()
+
+	assert.NoError(t, SchemaVersion(applied("20261015050000"), migrations).Run(ctx))
+	// a database migrated by a newer binary is fine
+	assert.NoError(t, SchemaVersion(applied("20261016000000"), migrations).Run(ctx))
+	err := SchemaVersion(applied("20261015040000"), migrations).Run(ctx)
+	assert.ErrorContains(t, err, "20261015050000")
+	assert.Error(t, SchemaVersion(applied(""), migrations).Run(ctx))
+}
+
+// memStore is an in-memory objectstore.Store.
+type memStore struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	putErr  error
+}
+
+func (s *memStore) Put(ctx context.Context, key string, body io.Reader, size int64) error {
+	if s.putErr != nil {
+		return s.putErr
+	}
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[key] = content
+	return nil
+}
+
+func (s *memStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	content, ok := s.objects[key]
+	if !ok {
+		return nil, common.ErrObjectNotFound
+	}
+	return io.NopCloser(bytes.NewReader(content)), nil
+}
+
+func (s *memStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.objects, key)
+	return nil
+}
+
+func (s *memStore) List(ctx context.Context, prefix string) ([]string, error) {
+	return nil, nil
+}
+
+func TestObjectStore(t *testing.T) {
+	ctx := context.Background()
+	store := &memStore{objects: make(map[string][]byte)}
+	assert.NoError(t, probeObjectStore(ctx, store))
+	// the probe object is deleted
+	assert.Empty(t, store.objects)
+
+	store.putErr = errors.New("access denied")
+	assert.ErrorContains(t, probeObjectStore(ctx, store), "access denied")
+
+	var skip *skipError
+	assert.ErrorAs(t, ObjectStore(objectstore.Config{}).Run(ctx), &skip)
+	assert.ErrorIs(t, ObjectStore(objectstore.Config{Provider: "hdfs"}).Run(ctx), common.ErrUnknownObjectStoreProvider)
+}
+
+func TestKubernetesPermissions(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	// pods may be listed but not watched
+	clientset.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		attributes := review.Spec.ResourceAttributes
+		review.Status.Allowed = attributes.Resource != "pods" || attributes.Verb == "list"
+		return true, review, nil
+	})
+	newClientset := func() (kubernetes.Interface, error) { return clientset, nil }
+	ctx := context.Background()
+
+	check := KubernetesPermissions("memberlist_permissions", newClientset, "chroma", []Permission{
+		{Group: "chroma.cluster", Resource: "memberlists", Name: "query-service-memberlist", Verbs: []string{"get", "update"}},
+	})
+	assert.NoError(t, check.Run(ctx))
+
+	check = KubernetesPermissions("memberlist_permissions", newClientset, "chroma", []Permission{
+		{Resource: "pods", Verbs: []string{"list", "watch"}},
+	})
+	err := check.Run(ctx)
+	assert.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "watch pods"))
+	assert.False(t, strings.Contains(err.Error(), "list pods"))
+}
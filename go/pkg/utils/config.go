@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Redacted replaces secrets in printed configurations.
+const Redacted = "REDACTED"
+
+// ConfigError lists every invalid field of a configuration, so that they can
+// all be fixed before the next start.
+type ConfigError struct {
+	Problems []string
+}
+
+func (e *ConfigError) Error() string {
+	return "invalid configuration:\n  " + strings.Join(e.Problems, "\n  ")
+}
+
+// ConfigValidator collects the problems of a configuration.
+type ConfigValidator struct {
+	problems []string
+}
+
+// Add records that field is invalid.
+func (v *ConfigValidator) Add(field string, format string, args ...interface{}) {
+	v.problems = append(v.problems, field+": "+fmt.Sprintf(format, args...))
+}
+
+// Check records that field is invalid unless ok.
+func (v *ConfigValidator) Check(ok bool, field string, format string, args ...interface{}) {
+	if !ok {
+		v.Add(field, format, args...)
+	}
+}
+
+// OneOf records that field is invalid unless value is one of values.
+func (v *ConfigValidator) OneOf(field string, value string, values ...string) {
+	for _, allowed := range values {
+		if value == allowed {
+			return
+		}
+	}
+	v.Add(field, "is %q, must be one of %s", value, strings.Join(values, ", "))
+}
+
+// Err returns a *ConfigError with the problems found, nil when there are none.
+func (v *ConfigValidator) Err() error {
+	if len(v.problems) == 0 {
+		return nil
+	}
+	return &ConfigError{Problems: v.problems}
+}
+
+// RedactURL replaces the password of a URL, e.g. a database URL.
+func RedactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		// the URL may hold a secret we cannot locate
+		return Redacted
+	}
+	if u.User == nil {
+		return raw
+	}
+	if _, ok := u.User.Password(); !ok {
+		return raw
+	}
+	u.User = url.UserPassword(u.User.Username(), Redacted)
+	return u.String()
+}
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"errors"
+	"sort"
 
 	"github.com/spaolacci/murmur3"
 )
@@ -37,6 +38,34 @@ func Assign(key Key, members Members, hasher Hasher) (Member, error) {
 	return maxMember, nil
 }
 
+// AssignN assigns a key to the n members with the highest scores, e.g. the query
+// nodes of a collection with a replication factor of n. The first member is the
+// one Assign returns, and every member is returned when there are fewer than n.
+func AssignN(key Key, members Members, hasher Hasher, n int) (Members, error) {
+	if len(members) == 0 {
+		return nil, errors.New("cannot assign key to empty member list")
+	}
+	if n < 1 {
+		return nil, errors.New("cannot assign key to less than one member")
+	}
+	if len(members) == 1 {
+		return Members{members[0]}, nil
+	}
+	if key == "" {
+		return nil, errors.New("cannot assign empty key")
+	}
+
+	scores := make(map[Member]uint64, len(members))
+	for _, member := range members {
+		scores[member] = hasher(string(member), string(key))
+	}
+	ranked := append(Members{}, members...)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return scores[ranked[i]] > scores[ranked[j]]
+	})
+	return ranked[:min(n, len(ranked))], nil
+}
+
 func mergeHashes(a uint64, b uint64) uint64 {
 	acc := a ^ b
 	acc ^= acc >> 33
@@ -60,3 +60,38 @@ func TestEvenDistribution(t *testing.T) {
 		}
 	}
 }
+
+func TestAssignN(t *testing.T) {
+	members := []string{"a", "b", "c"}
+
+	nodes, err := AssignN("key", members, mockHasher, 2)
+	if err != nil {
+		t.Errorf("AssignN() returned an error: %v", err)
+	}
+	if fmt.Sprint(nodes) != "[c b]" {
+		t.Errorf("AssignN() = %v, want %v", nodes, "[c b]")
+	}
+
+	// a factor above the member count assigns every member
+	nodes, err = AssignN("key", members, mockHasher, 5)
+	if err != nil {
+		t.Errorf("AssignN() returned an error: %v", err)
+	}
+	if fmt.Sprint(nodes) != "[c b a]" {
+		t.Errorf("AssignN() = %v, want %v", nodes, "[c b a]")
+	}
+
+	// the first member is the one Assign picks
+	for i := 0; i < 100; i++ {
+		key := "key_" + fmt.Sprint(i)
+		node, _ := Assign(key, members, Murmur3Hasher)
+		nodes, _ := AssignN(key, members, Murmur3Hasher, 2)
+		if nodes[0] != node {
+			t.Errorf("AssignN()[0] = %v, want %v", nodes[0], node)
+		}
+	}
+
+	if _, err := AssignN("key", members, mockHasher, 0); err == nil {
+		t.Errorf("AssignN() with n = 0 did not return an error")
+	}
+}
@@ -0,0 +1,98 @@
+package otel
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pingcap/log"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/prometheus"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	rpcDurationOnce sync.Once
+	rpcDuration     metric.Float64Histogram
+)
+
+func getRpcDuration() metric.Float64Histogram {
+	rpcDurationOnce.Do(func() {
+		var err error
+		rpcDuration, err = otel.Meter("chroma.grpc").Float64Histogram("rpc.server.duration",
+			metric.WithDescription("Duration of unary RPCs by method and status code"),
+			metric.WithUnit("ms"))
+		if err != nil {
+			log.Error("failed to create rpc duration histogram", zap.Error(err))
+		}
+	})
+	return rpcDuration
+}
+
+// ServerGrpcMetricsInterceptor records the latency of every unary RPC. It must
+// run after ServerGrpcInterceptor: the duration is recorded with the context of
+// the request span, so a meter provider with exemplars enabled attaches the
+// trace ID of the request to the histogram bucket it lands in.
+func ServerGrpcMetricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	h, err := handler(ctx, req)
+	if histogram := getRpcDuration(); histogram != nil {
+		histogram.Record(ctx, float64(time.Since(start).Microseconds())/1000, metric.WithAttributes(
+			attribute.String("rpc.method", info.FullMethod),
+			attribute.String("rpc.status_code", status.Code(err).String()),
+		))
+	}
+	return h, err
+}
+
+// MetricsServer serves the metrics of the process for Prometheus to scrape.
+type MetricsServer struct {
+	listener net.Listener
+	server   *http.Server
+}
+
+// InitMetrics backs the global meter provider with a Prometheus exporter and
+// serves it on /metrics at address. Instruments created before it is called
+// are backed by the exporter too.
+func InitMetrics(address string) (*MetricsServer, error) {
+	exporter, err := prometheus.New()
+	if err != nil {
+		return nil, err
+	}
+	otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(exporter)))
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	s := &MetricsServer{
+		listener: listener,
+		server:   &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second},
+	}
+	go func() {
+		if err := s.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("metrics server failed", zap.Error(err))
+		}
+	}()
+	log.Info("serving metrics", zap.String("address", listener.Addr().String()))
+	return s, nil
+}
+
+func (s *MetricsServer) Addr() net.Addr {
+	return s.listener.Addr()
+}
+
+func (s *MetricsServer) Close() error {
+	return s.server.Close()
+}
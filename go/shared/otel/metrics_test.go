@@ -0,0 +1,29 @@
+package otel
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+)
+
+func TestInitMetrics(t *testing.T) {
+	// created before the provider is set, as the components do
+	counter, err := otel.Meter("chroma.test").Int64Counter("test.requests")
+	assert.NoError(t, err)
+
+	server, err := InitMetrics("127.0.0.1:0")
+	assert.NoError(t, err)
+	defer server.Close()
+	counter.Add(context.Background(), 3)
+
+	res, err := http.Get("http://" + server.Addr().String() + "/metrics")
+	assert.NoError(t, err)
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), "test_requests_total")
+}
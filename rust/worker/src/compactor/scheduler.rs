@@ -6,6 +6,8 @@ use crate::log::log::CollectionRecord;
 use crate::log::log::Log;
 use crate::memberlist::Memberlist;
 use crate::sysdb::sysdb::SysDb;
+use crate::types::Collection;
+use std::collections::HashMap;
 use uuid::Uuid;
 
 pub(crate) struct Scheduler {
@@ -65,72 +67,77 @@ impl Scheduler {
         collections: Vec<CollectionInfo>,
     ) -> Vec<CollectionRecord> {
         let mut collection_records = Vec::new();
+        let mut collection_infos = Vec::with_capacity(collections.len());
         for collection_info in collections {
-            let collection_id = Uuid::parse_str(collection_info.collection_id.as_str());
-            if collection_id.is_err() {
+            match Uuid::parse_str(collection_info.collection_id.as_str()) {
+                Ok(collection_id) => collection_infos.push((collection_id, collection_info)),
+                Err(e) => {
+                    // TODO: Log error
+                    println!("Error: {:?}", e);
+                }
+            }
+        }
+        // every collection is read in one batch rather than with a call each
+        let collection_ids = collection_infos.iter().map(|(id, _)| *id).collect();
+        let sysdb_collections = match self.sysdb.get_collections_by_ids(collection_ids).await {
+            Ok(sysdb_collections) => sysdb_collections,
+            Err(e) => {
                 // TODO: Log error
-                println!("Error: {:?}", collection_id.err());
-                continue;
+                println!("Error: {:?}", e);
+                return Vec::new();
             }
-            let collection_id = Some(collection_id.unwrap());
-            // TODO: add a cache to avoid fetching the same collection multiple times
-            let result = self
-                .sysdb
-                .get_collections(collection_id, None, None, None)
-                .await;
-
-            match result {
-                Ok(collection) => {
-                    if collection.is_empty() {
-                        // TODO: Log error
-                        println!("Collection not found: {:?}", collection_info.collection_id);
-                        continue;
-                    }
+        };
+        let sysdb_collections: HashMap<Uuid, Collection> = sysdb_collections
+            .into_iter()
+            .map(|collection| (collection.id, collection))
+            .collect();
+
+        for (collection_id, collection_info) in collection_infos {
+            let collection = match sysdb_collections.get(&collection_id) {
+                Some(collection) => collection,
+                None => {
+                    // TODO: Log error
+                    println!("Collection not found: {:?}", collection_info.collection_id);
+                    continue;
+                }
+            };
 
-                    // TODO: make querying the last compaction time in batch
-                    let log_position_in_collecion = collection[0].log_position;
-                    let tenant_ids = vec![collection[0].tenant.clone()];
-                    let tenant = self.sysdb.get_last_compaction_time(tenant_ids).await;
-
-                    let last_compaction_time = match tenant {
-                        Ok(tenant) => tenant[0].last_compaction_time,
-                        Err(e) => {
-                            // TODO: Log error
-                            println!("Error: {:?}", e);
-                            // Ignore this collection id for this compaction iteration
-                            println!("Ignoring collection: {:?}", collection_info.collection_id);
-                            continue;
-                        }
-                    };
-
-                    let mut offset = collection_info.first_log_offset;
-                    // offset in log is the first offset in the log that has not been compacted. Note that
-                    // since the offset is the first offset of log we get from the log service, we should
-                    // use this offset to pull data from the log service.
-                    if log_position_in_collecion + 1 < offset {
-                        panic!(
-                            "offset in sysdb is less than offset in log, this should not happen!"
-                        )
-                    } else {
-                        // The offset in sysdb is the last offset that has been compacted.
-                        // We need to start from the next offset.
-                        offset = log_position_in_collecion + 1;
-                    }
+            // TODO: make querying the last compaction time in batch
+            let log_position_in_collecion = collection.log_position;
+            let tenant_ids = vec![collection.tenant.clone()];
+            let tenant = self.sysdb.get_last_compaction_time(tenant_ids).await;
 
-                    collection_records.push(CollectionRecord {
-                        id: collection[0].id,
-                        tenant_id: collection[0].tenant.clone(),
-                        last_compaction_time,
-                        first_record_time: collection_info.first_log_ts,
-                        offset,
-                        collection_version: collection[0].version,
-                    });
-                }
+            let last_compaction_time = match tenant {
+                Ok(tenant) => tenant[0].last_compaction_time,
                 Err(e) => {
                     // TODO: Log error
                     println!("Error: {:?}", e);
+                    // Ignore this collection id for this compaction iteration
+                    println!("Ignoring collection: {:?}", collection_info.collection_id);
+                    continue;
                 }
+            };
+
+            let mut offset = collection_info.first_log_offset;
+            // offset in log is the first offset in the log that has not been compacted. Note that
+            // since the offset is the first offset of log we get from the log service, we should
+            // use this offset to pull data from the log service.
+            if log_position_in_collecion + 1 < offset {
+                panic!("offset in sysdb is less than offset in log, this should not happen!")
+            } else {
+                // The offset in sysdb is the last offset that has been compacted.
+                // We need to start from the next offset.
+                offset = log_position_in_collecion + 1;
             }
+
+            collection_records.push(CollectionRecord {
+                id: collection.id,
+                tenant_id: collection.tenant.clone(),
+                last_compaction_time,
+                first_record_time: collection_info.first_log_ts,
+                offset,
+                collection_version: collection.version,
+            });
         }
         self.filter_collections(collection_records)
     }
@@ -37,6 +37,8 @@ impl RegisterOperator {
 /// collection version in sysdb is not the same as the current collection version, the flush operation
 /// will fail.
 /// * `segment_flush_info` - The segment flush info.
+/// * `compaction_started_at` - When the compaction pulled the log, in unix milliseconds.
+/// Only the compaction requests made before it are served by the flush.
 /// * `sysdb` - The sysdb client.
 /// * `log` - The log client.
 pub struct RegisterInput {
@@ -45,6 +47,7 @@ pub struct RegisterInput {
     log_position: i64,
     collection_version: i32,
     segment_flush_info: Arc<[SegmentFlushInfo]>,
+    compaction_started_at: Option<i64>,
     sysdb: Box<SysDb>,
     log: Box<Log>,
 }
@@ -57,6 +60,7 @@ impl RegisterInput {
         log_position: i64,
         collection_version: i32,
         segment_flush_info: Arc<[SegmentFlushInfo]>,
+        compaction_started_at: Option<i64>,
         sysdb: Box<SysDb>,
         log: Box<Log>,
     ) -> Self {
@@ -66,6 +70,7 @@ impl RegisterInput {
             log_position,
             collection_version,
             segment_flush_info,
+            compaction_started_at,
             sysdb,
             log,
         }
@@ -111,6 +116,7 @@ impl Operator<RegisterInput, RegisterOutput> for RegisterOperator {
                 input.log_position,
                 input.collection_version,
                 input.segment_flush_info.clone(),
+                input.compaction_started_at,
             )
             .await;
 
@@ -243,6 +249,7 @@ mod tests {
             log_position,
             collection_version,
             segment_flush_info.into(),
+            None,
             sysdb.clone(),
             log.clone(),
         );
@@ -90,6 +90,8 @@ pub struct CompactOrchestrator {
     hnsw_index_provider: HnswIndexProvider,
     // State we hold across the execution
     pulled_log_offset: Option<i64>,
+    // When the logs were pulled, in unix milliseconds
+    compaction_started_at: Option<i64>,
     record_segment: Option<Segment>,
     // Dispatcher
     dispatcher: Box<dyn Receiver<TaskMessage>>,
@@ -167,6 +169,7 @@ impl CompactOrchestrator {
             blockfile_provider,
             hnsw_index_provider,
             pulled_log_offset: None,
+            compaction_started_at: None,
             dispatcher,
             num_write_tasks: 0,
             result_channel,
@@ -194,6 +197,7 @@ impl CompactOrchestrator {
                 return;
             }
         };
+        self.compaction_started_at = Some(end_timestamp / 1_000_000);
         let input = PullLogsInput::new(
             collection_id,
             // Here we do not need to be inclusive since the compaction job
@@ -316,6 +320,7 @@ impl CompactOrchestrator {
             log_position,
             self.compaction_job.collection_version,
             segment_flush_info.into(),
+            self.compaction_started_at,
             self.sysdb.clone(),
             self.log.clone(),
         );
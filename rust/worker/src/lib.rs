@@ -63,6 +63,16 @@ pub async fn query_service_entrypoint() {
     worker_server.set_system(system.clone());
     worker_server.set_dispatcher(dispatcher_handle.receiver());
 
+    let heartbeat_sysdb = match sysdb::from_config(&config.sysdb).await {
+        Ok(sysdb) => sysdb,
+        Err(err) => {
+            println!("Failed to create sysdb component: {:?}", err);
+            return;
+        }
+    };
+    let heartbeat_handle =
+        sysdb::heartbeat::spawn_heartbeat(heartbeat_sysdb, config.my_member_id.clone());
+
     let server_join_handle = tokio::spawn(async move {
         let _ = crate::server::WorkerServer::run(worker_server).await;
     });
@@ -80,6 +90,7 @@ pub async fn query_service_entrypoint() {
         // Kubernetes will send SIGTERM to stop the pod gracefully
         // TODO: add more signal handling
         _ = sigterm.recv() => {
+            heartbeat_handle.abort();
             dispatcher_handle.stop();
             dispatcher_handle.join().await;
             system.stop().await;
@@ -0,0 +1,28 @@
+use super::sysdb::SysDb;
+use std::time::Duration;
+
+// The coordinator considers a node wedged once it has not heard from it for its
+// heartbeat timeout, 30 seconds by default.
+const HEARTBEAT_INTERVAL: Duration = Duration::from_secs(10);
+
+/// # Description
+/// Sends the heartbeats of a worker node to the sysdb until the returned task is
+/// aborted, so that the coordinator can tell a node that is in the memberlist
+/// but wedged apart from a healthy one. Failed heartbeats are logged and the
+/// next one is sent at the next interval.
+pub(crate) fn spawn_heartbeat(
+    mut sysdb: Box<SysDb>,
+    node_id: String,
+) -> tokio::task::JoinHandle<()> {
+    tokio::spawn(async move {
+        loop {
+            match sysdb.heartbeat(node_id.clone()).await {
+                Ok(_) => {}
+                Err(e) => {
+                    println!("Failed to send heartbeat: {:?}", e);
+                }
+            }
+            tokio::time::sleep(HEARTBEAT_INTERVAL).await;
+        }
+    })
+}
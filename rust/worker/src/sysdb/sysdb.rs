@@ -30,6 +30,8 @@ use uuid::Uuid;
 
 const DEFAULT_DATBASE: &str = "default_database";
 const DEFAULT_TENANT: &str = "default_tenant";
+// The largest number of collections GetCollections reads by id at once.
+const MAX_BATCH_GET_COLLECTIONS: usize = 1000;
 
 #[derive(Debug, Clone)]
 pub(crate) enum SysDb {
@@ -59,6 +61,22 @@ impl SysDb {
         }
     }
 
+    /// Returns the live collections among `collection_ids`, in as few calls as
+    /// the sysdb allows. The ids that do not name a live collection are left out.
+    pub(crate) async fn get_collections_by_ids(
+        &mut self,
+        collection_ids: Vec<Uuid>,
+    ) -> Result<Vec<Collection>, GetCollectionsError> {
+        match self {
+            SysDb::Grpc(grpc) => {
+                return grpc.get_collections_by_ids(collection_ids).await;
+            }
+            SysDb::Test(test) => {
+                return test.get_collections_by_ids(collection_ids).await;
+            }
+        }
+    }
+
     pub(crate) async fn get_segments(
         &mut self,
         id: Option<Uuid>,
@@ -97,6 +115,7 @@ impl SysDb {
         log_position: i64,
         collection_version: i32,
         segment_flush_info: Arc<[SegmentFlushInfo]>,
+        compaction_started_at: Option<i64>,
     ) -> Result<FlushCompactionResponse, FlushCompactionError> {
         match self {
             SysDb::Grpc(grpc) => {
@@ -107,6 +126,7 @@ impl SysDb {
                         log_position,
                         collection_version,
                         segment_flush_info,
+                        compaction_started_at,
                     )
                     .await;
             }
@@ -118,11 +138,23 @@ impl SysDb {
                         log_position,
                         collection_version,
                         segment_flush_info,
+                        compaction_started_at,
                     )
                     .await;
             }
         }
     }
+
+    pub(crate) async fn heartbeat(&mut self, node_id: String) -> Result<(), HeartbeatError> {
+        match self {
+            SysDb::Grpc(grpc) => {
+                return grpc.heartbeat(node_id).await;
+            }
+            SysDb::Test(test) => {
+                return test.heartbeat(node_id).await;
+            }
+        }
+    }
 }
 
 #[derive(Clone, Debug)]
@@ -229,6 +261,7 @@ impl GrpcSysDb {
                 } else {
                     "".to_string()
                 },
+                ..Default::default()
             })
             .await;
 
@@ -256,6 +289,37 @@ impl GrpcSysDb {
         }
     }
 
+    async fn get_collections_by_ids(
+        &mut self,
+        collection_ids: Vec<Uuid>,
+    ) -> Result<Vec<Collection>, GetCollectionsError> {
+        let mut collections = Vec::with_capacity(collection_ids.len());
+        for chunk in collection_ids.chunks(MAX_BATCH_GET_COLLECTIONS) {
+            let res = self
+                .client
+                .get_collections(chroma_proto::GetCollectionsRequest {
+                    ids: chunk.iter().map(|id| id.to_string()).collect(),
+                    ..Default::default()
+                })
+                .await;
+            let res = match res {
+                Ok(res) => res,
+                Err(e) => {
+                    return Err(GetCollectionsError::FailedToGetCollections(e));
+                }
+            };
+            for proto_collection in res.into_inner().collections {
+                match proto_collection.try_into() {
+                    Ok(collection) => collections.push(collection),
+                    Err(e) => {
+                        return Err(GetCollectionsError::ConversionError(e));
+                    }
+                }
+            }
+        }
+        Ok(collections)
+    }
+
     async fn get_segments(
         &mut self,
         id: Option<Uuid>,
@@ -342,6 +406,7 @@ impl GrpcSysDb {
         log_position: i64,
         collection_version: i32,
         segment_flush_info: Arc<[SegmentFlushInfo]>,
+        compaction_started_at: Option<i64>,
     ) -> Result<FlushCompactionResponse, FlushCompactionError> {
         let segment_compaction_info =
             segment_flush_info
@@ -365,6 +430,8 @@ impl GrpcSysDb {
             log_position,
             collection_version,
             segment_compaction_info,
+            compaction_started_at,
+            ..Default::default()
         };
 
         let res = self.client.flush_collection_compaction(req).await;
@@ -386,6 +453,26 @@ impl GrpcSysDb {
             }
         }
     }
+
+    async fn heartbeat(&mut self, node_id: String) -> Result<(), HeartbeatError> {
+        let res = self
+            .client
+            .heartbeat(chroma_proto::HeartbeatRequest { node_id })
+            .await;
+        match res {
+            Ok(res) => match res.into_inner().status {
+                Some(status) if status.code != 200 => {
+                    return Err(HeartbeatError::Rejected(status.reason));
+                }
+                _ => {
+                    return Ok(());
+                }
+            },
+            Err(e) => {
+                return Err(HeartbeatError::FailedToSendHeartbeat(e));
+            }
+        }
+    }
 }
 
 #[derive(Error, Debug)]
@@ -469,3 +556,20 @@ impl ChromaError for FlushCompactionError {
         }
     }
 }
+
+#[derive(Error, Debug)]
+pub(crate) enum HeartbeatError {
+    #[error("Failed to send heartbeat")]
+    FailedToSendHeartbeat(#[from] tonic::Status),
+    #[error("Heartbeat rejected: {0}")]
+    Rejected(String),
+}
+
+impl ChromaError for HeartbeatError {
+    fn code(&self) -> ErrorCodes {
+        match self {
+            HeartbeatError::FailedToSendHeartbeat(_) => ErrorCodes::Internal,
+            HeartbeatError::Rejected(_) => ErrorCodes::Internal,
+        }
+    }
+}
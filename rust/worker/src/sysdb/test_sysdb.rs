@@ -14,6 +14,7 @@ use super::sysdb::FlushCompactionError;
 use super::sysdb::GetCollectionsError;
 use super::sysdb::GetLastCompactionTimeError;
 use super::sysdb::GetSegmentsError;
+use super::sysdb::HeartbeatError;
 
 #[derive(Clone, Debug)]
 pub(crate) struct TestSysDb {
@@ -131,6 +132,20 @@ impl TestSysDb {
         Ok(collections)
     }
 
+    pub(crate) async fn get_collections_by_ids(
+        &mut self,
+        collection_ids: Vec<Uuid>,
+    ) -> Result<Vec<Collection>, GetCollectionsError> {
+        let inner = self.inner.lock();
+        let mut collections = Vec::new();
+        for collection_id in collection_ids {
+            if let Some(collection) = inner.collections.get(&collection_id) {
+                collections.push(collection.clone());
+            }
+        }
+        Ok(collections)
+    }
+
     pub(crate) async fn get_segments(
         &mut self,
         id: Option<Uuid>,
@@ -179,6 +194,7 @@ impl TestSysDb {
         log_position: i64,
         collection_version: i32,
         segment_flush_info: Arc<[SegmentFlushInfo]>,
+        _compaction_started_at: Option<i64>,
     ) -> Result<FlushCompactionResponse, FlushCompactionError> {
         let mut inner = self.inner.lock();
         let collection = inner.collections.get(&collection_id);
@@ -214,4 +230,8 @@ impl TestSysDb {
             last_compaction_time,
         ))
     }
+
+    pub(crate) async fn heartbeat(&mut self, _node_id: String) -> Result<(), HeartbeatError> {
+        Ok(())
+    }
 }